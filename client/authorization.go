@@ -4,14 +4,28 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
 	"time"
 )
 
 var ErrNotSupportedAuthorizationState = errors.New("not supported state")
 
+// Deprecated: authorization states are received from updates, the timeout isn't used anymore
 const AuthCheckTimeout = 1 * time.Millisecond
 
+// ErrNeedsLogin is returned by session authorizer when TDLib asks for a user input
+var ErrNeedsLogin = errors.New("needs login")
+
+// errAuthorizationStateOutdated stops waiting for the user input when the next authorization state is received
+var errAuthorizationStateOutdated = errors.New("authorization state is outdated")
+
+var (
+	ErrInvalidPhoneNumber  = errors.New("invalid phone number")
+	ErrInvalidEmailAddress = errors.New("invalid email address")
+	ErrInvalidCode         = errors.New("invalid code")
+	ErrInvalidPassword     = errors.New("invalid password")
+	ErrInvalidToken        = errors.New("invalid token")
+)
+
 // Contains parameters for TDLib initialization
 type TdlibParameters struct {
 	// Pass true to use Telegram test environment instead of the production environment
@@ -55,133 +69,390 @@ type AuthorizationStateHandler interface {
 	Close()
 }
 
+// Authorize handles authorization states until the client is ready or closed.
+// Any error is reported to the handler before it is closed
 func Authorize(client *Client, authorizationStateHandler AuthorizationStateHandler) error {
 	defer authorizationStateHandler.Close()
 
+	err := authorize(client, authorizationStateHandler)
+	if err != nil {
+		authorizationStateHandler.Error(err)
+	}
+
+	return err
+}
+
+func authorize(client *Client, authorizationStateHandler AuthorizationStateHandler) error {
+	ctx := authorizationStateHandler.Context()
+
+	states, stop := authorizationStates(ctx, client.authorizationListener)
+	defer stop()
+
+	// TDLib instance doesn't send updates until the first request is sent
+	_, err := client.GetAuthorizationStateContext(ctx)
+	if err != nil {
+		return err
+	}
+
 	var authorizationError error
 
 	for {
 		select {
-		case <-time.After(AuthCheckTimeout):
-			state, err := client.GetAuthorizationState()
-			if err != nil {
-				return err
+		case state, ok := <-states:
+			if !ok {
+				// the listener is closed on shutdown
+				return ErrClientClosed
+			}
+
+			client.authorizationCtx = state.ctx
+
+			err := authorizationStateHandler.Handle(client, state.state)
+			if ctx.Err() != nil {
+				return ctx.Err()
 			}
 
-			err = authorizationStateHandler.Handle(client, state)
-			if err != nil {
+			if err != nil && !errors.Is(err, errAuthorizationStateOutdated) {
 				authorizationError = err
 				client.Close()
 			}
 
-			if state.AuthorizationStateType() == TypeAuthorizationStateClosed {
+			if state.state.AuthorizationStateType() == TypeAuthorizationStateClosed {
 				return authorizationError
 			}
 
-			if state.AuthorizationStateType() == TypeAuthorizationStateReady {
-				// dirty hack for db flush after authorization
-				time.Sleep(1 * time.Second)
+			if state.state.AuthorizationStateType() == TypeAuthorizationStateReady {
 				return nil
 			}
 
-		case <-authorizationStateHandler.Context().Done():
-			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// authorizationState is the state with the context of its handling
+type authorizationState struct {
+	state AuthorizationState
+	// cancelled with errAuthorizationStateOutdated when the next state is received and with ErrClientClosed on shutdown
+	ctx context.Context
+}
+
+// authorizationStates drains the listener and passes through authorization states only,
+// so the client receiver is never blocked by a handler waiting for user input.
+// The states channel is closed when the listener is closed
+func authorizationStates(ctx context.Context, listener *Listener) (chan authorizationState, func()) {
+	states := make(chan authorizationState, 10)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(states)
+
+		// cancels the context of the last state
+		cancelState := func(cause error) {}
+		defer func() {
+			cancelState(ErrClientClosed)
+		}()
+
+		for update := range listener.Updates {
+			updateAuthorizationState, ok := update.(*UpdateAuthorizationState)
+			if !ok {
+				continue
+			}
+
+			cancelState(errAuthorizationStateOutdated)
+
+			stateCtx, cancel := context.WithCancelCause(ctx)
+			cancelState = cancel
+
+			select {
+			case states <- authorizationState{state: updateAuthorizationState.AuthorizationState, ctx: stateCtx}:
+			case <-done:
+				return
+			}
 		}
+	}()
+
+	stop := func() {
+		close(done)
+		listener.Close()
+	}
+
+	return states, stop
+}
+
+// authorizationContext returns the context of the authorization state passed to the handler.
+// Authorizers stop waiting for the user input when it is done, see authorizationState
+func (client *Client) authorizationContext() context.Context {
+	if client.authorizationCtx == nil {
+		return context.Background()
+	}
+
+	return client.authorizationCtx
+}
+
+func setTdlibParameters(client *Client, p *TdlibParameters) error {
+	_, err := client.SetTdlibParameters(&SetTdlibParametersRequest{
+		UseTestDc:              p.UseTestDc,
+		DatabaseDirectory:      p.DatabaseDirectory,
+		FilesDirectory:         p.FilesDirectory,
+		DatabaseEncryptionKey:  p.DatabaseEncryptionKey,
+		UseFileDatabase:        p.UseFileDatabase,
+		UseChatInfoDatabase:    p.UseChatInfoDatabase,
+		UseMessageDatabase:     p.UseMessageDatabase,
+		UseSecretChats:         p.UseSecretChats,
+		ApiId:                  p.ApiId,
+		ApiHash:                p.ApiHash,
+		SystemLanguageCode:     p.SystemLanguageCode,
+		DeviceModel:            p.DeviceModel,
+		SystemVersion:          p.SystemVersion,
+		ApplicationVersion:     p.ApplicationVersion,
+		EnableStorageOptimizer: p.EnableStorageOptimizer,
+		IgnoreFileNames:        p.IgnoreFileNames,
+	})
+
+	return err
+}
+
+// authorizationError wraps TDLib error with one of typed authorization errors if any
+func authorizationError(err error) error {
+	var responseError ResponseError
+	if !errors.As(err, &responseError) {
+		return err
+	}
+
+	switch responseError.Err.Message {
+	case "PHONE_NUMBER_INVALID":
+		return fmt.Errorf("%w: %w", ErrInvalidPhoneNumber, err)
+
+	case "EMAIL_INVALID":
+		return fmt.Errorf("%w: %w", ErrInvalidEmailAddress, err)
+
+	case "PHONE_CODE_INVALID", "PHONE_CODE_EMPTY", "EMAIL_CODE_INVALID":
+		return fmt.Errorf("%w: %w", ErrInvalidCode, err)
+
+	case "PASSWORD_HASH_INVALID":
+		return fmt.Errorf("%w: %w", ErrInvalidPassword, err)
+
+	case "ACCESS_TOKEN_INVALID", "ACCESS_TOKEN_EXPIRED":
+		return fmt.Errorf("%w: %w", ErrInvalidToken, err)
 	}
+
+	return err
+}
+
+// IsRetryableAuthorizationError reports whether the authorization step can be repeated with another user input
+func IsRetryableAuthorizationError(err error) bool {
+	return errors.Is(err, ErrInvalidPhoneNumber) ||
+		errors.Is(err, ErrInvalidEmailAddress) ||
+		errors.Is(err, ErrInvalidCode) ||
+		errors.Is(err, ErrInvalidPassword) ||
+		errors.Is(err, ErrFloodWait)
+}
+
+// authorizerChannels passes authorization states and errors of the built-in authorizers to the application.
+// Errors are dropped if the Errors channel is full
+type authorizerChannels struct {
+	State  chan AuthorizationState
+	Errors chan error
+}
+
+func newAuthorizerChannels() authorizerChannels {
+	return authorizerChannels{
+		State:  make(chan AuthorizationState, 10),
+		Errors: make(chan error, 10),
+	}
+}
+
+func (channels *authorizerChannels) Error(err error) {
+	select {
+	case channels.Errors <- err:
+	default:
+	}
+}
+
+func (channels *authorizerChannels) Context() context.Context {
+	return context.Background()
+}
+
+// passState waits until the state is passed to the State channel or ctx is done
+func (channels *authorizerChannels) passState(ctx context.Context, state AuthorizationState) error {
+	select {
+	case channels.State <- state:
+		return nil
+	case <-ctx.Done():
+		return context.Cause(ctx)
+	}
+}
+
+func (channels *authorizerChannels) close() {
+	close(channels.State)
+	close(channels.Errors)
 }
 
 type clientAuthorizer struct {
+	authorizerChannels
 	TdlibParameters chan *TdlibParameters
 	PhoneNumber     chan string
-	Code            chan string
-	State           chan AuthorizationState
-	Password        chan string
+	// Identifiers of other users which are already logged in; send instead of phone number to log in via QR code
+	QrCode       chan []int64
+	EmailAddress chan string
+	EmailCode    chan string
+	Code         chan string
+	FirstName    chan string
+	LastName     chan string
+	Password     chan string
 }
 
 func ClientAuthorizer() *clientAuthorizer {
 	return &clientAuthorizer{
-		TdlibParameters: make(chan *TdlibParameters, 1),
-		PhoneNumber:     make(chan string, 1),
-		Code:            make(chan string, 1),
-		State:           make(chan AuthorizationState, 10),
-		Password:        make(chan string, 1),
+		authorizerChannels: newAuthorizerChannels(),
+		TdlibParameters:    make(chan *TdlibParameters, 1),
+		PhoneNumber:        make(chan string, 1),
+		QrCode:             make(chan []int64, 1),
+		EmailAddress:       make(chan string, 1),
+		EmailCode:          make(chan string, 1),
+		Code:               make(chan string, 1),
+		FirstName:          make(chan string, 1),
+		LastName:           make(chan string, 1),
+		Password:           make(chan string, 1),
 	}
 }
 
-func (stateHandler *clientAuthorizer) Error(err error) {
-	log.Fatalf("Authorization error: %s", err)
-}
+// Handle passes the state to the State channel and waits for the user input.
+// The state is passed again on a retryable error, so the input can be corrected.
+// Waiting stops when the next state is received or the client is shut down
+func (stateHandler *clientAuthorizer) Handle(client *Client, state AuthorizationState) error {
+	ctx := client.authorizationContext()
 
-func (stateHandler *clientAuthorizer) Context() context.Context {
-	return context.Background()
-}
+	for {
+		err := stateHandler.passState(ctx, state)
+		if err != nil {
+			return err
+		}
 
-func (stateHandler *clientAuthorizer) Handle(client *Client, state AuthorizationState) error {
-	stateHandler.State <- state
+		err = authorizationError(stateHandler.handle(ctx, client, state))
+		if err == nil || !IsRetryableAuthorizationError(err) {
+			return err
+		}
+
+		stateHandler.Error(err)
+	}
+}
 
+func (stateHandler *clientAuthorizer) handle(ctx context.Context, client *Client, state AuthorizationState) error {
 	switch state.AuthorizationStateType() {
 	case TypeAuthorizationStateWaitTdlibParameters:
-		p := <-stateHandler.TdlibParameters
-		_, err := client.SetTdlibParameters(&SetTdlibParametersRequest{
-			UseTestDc:              p.UseTestDc,
-			DatabaseDirectory:      p.DatabaseDirectory,
-			FilesDirectory:         p.FilesDirectory,
-			DatabaseEncryptionKey:  p.DatabaseEncryptionKey,
-			UseFileDatabase:        p.UseFileDatabase,
-			UseChatInfoDatabase:    p.UseChatInfoDatabase,
-			UseMessageDatabase:     p.UseMessageDatabase,
-			UseSecretChats:         p.UseSecretChats,
-			ApiId:                  p.ApiId,
-			ApiHash:                p.ApiHash,
-			SystemLanguageCode:     p.SystemLanguageCode,
-			DeviceModel:            p.DeviceModel,
-			SystemVersion:          p.SystemVersion,
-			ApplicationVersion:     p.ApplicationVersion,
-			EnableStorageOptimizer: p.EnableStorageOptimizer,
-			IgnoreFileNames:        p.IgnoreFileNames,
-		})
-		return err
+		select {
+		case parameters := <-stateHandler.TdlibParameters:
+			return setTdlibParameters(client, parameters)
+
+		case <-ctx.Done():
+			return context.Cause(ctx)
+		}
 
 	case TypeAuthorizationStateWaitPhoneNumber:
-		_, err := client.SetAuthenticationPhoneNumber(&SetAuthenticationPhoneNumberRequest{
-			PhoneNumber: <-stateHandler.PhoneNumber,
-			Settings: &PhoneNumberAuthenticationSettings{
-				AllowFlashCall:       false,
-				IsCurrentPhoneNumber: false,
-				AllowSmsRetrieverApi: false,
-			},
-		})
-		return err
+		select {
+		case phoneNumber := <-stateHandler.PhoneNumber:
+			_, err := client.SetAuthenticationPhoneNumberContext(ctx, &SetAuthenticationPhoneNumberRequest{
+				PhoneNumber: phoneNumber,
+				Settings: &PhoneNumberAuthenticationSettings{
+					AllowFlashCall:       false,
+					IsCurrentPhoneNumber: false,
+					AllowSmsRetrieverApi: false,
+				},
+			})
+			return err
+
+		case otherUserIds := <-stateHandler.QrCode:
+			_, err := client.RequestQrCodeAuthenticationContext(ctx, &RequestQrCodeAuthenticationRequest{
+				OtherUserIds: otherUserIds,
+			})
+			return err
+
+		case <-ctx.Done():
+			return context.Cause(ctx)
+		}
 
 	case TypeAuthorizationStateWaitEmailAddress:
-		return ErrNotSupportedAuthorizationState
+		select {
+		case emailAddress := <-stateHandler.EmailAddress:
+			_, err := client.SetAuthenticationEmailAddressContext(ctx, &SetAuthenticationEmailAddressRequest{
+				EmailAddress: emailAddress,
+			})
+			return err
+
+		case <-ctx.Done():
+			return context.Cause(ctx)
+		}
 
 	case TypeAuthorizationStateWaitEmailCode:
-		return ErrNotSupportedAuthorizationState
+		select {
+		case code := <-stateHandler.EmailCode:
+			_, err := client.CheckAuthenticationEmailCodeContext(ctx, &CheckAuthenticationEmailCodeRequest{
+				Code: &EmailAddressAuthenticationCode{
+					Code: code,
+				},
+			})
+			return err
+
+		case <-ctx.Done():
+			return context.Cause(ctx)
+		}
 
 	case TypeAuthorizationStateWaitCode:
-		_, err := client.CheckAuthenticationCode(&CheckAuthenticationCodeRequest{
-			Code: <-stateHandler.Code,
-		})
-		return err
+		select {
+		case code := <-stateHandler.Code:
+			_, err := client.CheckAuthenticationCodeContext(ctx, &CheckAuthenticationCodeRequest{
+				Code: code,
+			})
+			return err
+
+		case <-ctx.Done():
+			return context.Cause(ctx)
+		}
 
 	case TypeAuthorizationStateWaitOtherDeviceConfirmation:
-		return ErrNotSupportedAuthorizationState
+		// the link is passed through the State channel, a new state is sent on the link update
+		return nil
 
 	case TypeAuthorizationStateWaitRegistration:
-		return ErrNotSupportedAuthorizationState
+		var firstName, lastName string
 
-	case TypeAuthorizationStateWaitPassword:
-		_, err := client.CheckAuthenticationPassword(&CheckAuthenticationPasswordRequest{
-			Password: <-stateHandler.Password,
+		select {
+		case firstName = <-stateHandler.FirstName:
+		case <-ctx.Done():
+			return context.Cause(ctx)
+		}
+
+		select {
+		case lastName = <-stateHandler.LastName:
+		case <-ctx.Done():
+			return context.Cause(ctx)
+		}
+
+		_, err := client.RegisterUserContext(ctx, &RegisterUserRequest{
+			FirstName: firstName,
+			LastName:  lastName,
 		})
 		return err
 
+	case TypeAuthorizationStateWaitPassword:
+		select {
+		case password := <-stateHandler.Password:
+			_, err := client.CheckAuthenticationPasswordContext(ctx, &CheckAuthenticationPasswordRequest{
+				Password: password,
+			})
+			return err
+
+		case <-ctx.Done():
+			return context.Cause(ctx)
+		}
+
 	case TypeAuthorizationStateReady:
 		return nil
 
 	case TypeAuthorizationStateLoggingOut:
-		return ErrNotSupportedAuthorizationState
+		return nil
 
 	case TypeAuthorizationStateClosing:
 		return nil
@@ -196,9 +467,14 @@ func (stateHandler *clientAuthorizer) Handle(client *Client, state Authorization
 func (stateHandler *clientAuthorizer) Close() {
 	close(stateHandler.TdlibParameters)
 	close(stateHandler.PhoneNumber)
+	close(stateHandler.QrCode)
+	close(stateHandler.EmailAddress)
+	close(stateHandler.EmailCode)
 	close(stateHandler.Code)
-	close(stateHandler.State)
+	close(stateHandler.FirstName)
+	close(stateHandler.LastName)
 	close(stateHandler.Password)
+	stateHandler.close()
 }
 
 func CliInteractor(clientAuthorizer *clientAuthorizer) {
@@ -209,19 +485,35 @@ func CliInteractor(clientAuthorizer *clientAuthorizer) {
 				return
 			}
 
+			printAuthorizationErrors(clientAuthorizer.Errors)
+
 			switch state.AuthorizationStateType() {
 			case TypeAuthorizationStateWaitPhoneNumber:
-				fmt.Println("Enter phone number: ")
+				fmt.Println("Enter phone number or \"qr\" to log in via QR code: ")
 				var phoneNumber string
 				fmt.Scanln(&phoneNumber)
 
-				clientAuthorizer.PhoneNumber <- phoneNumber
+				if phoneNumber == "qr" {
+					clientAuthorizer.QrCode <- nil
+				} else {
+					clientAuthorizer.PhoneNumber <- phoneNumber
+				}
 
 			case TypeAuthorizationStateWaitEmailAddress:
-				return
+				fmt.Println("Enter email address: ")
+				var emailAddress string
+				fmt.Scanln(&emailAddress)
+
+				clientAuthorizer.EmailAddress <- emailAddress
 
 			case TypeAuthorizationStateWaitEmailCode:
-				return
+				codeInfo := state.(*AuthorizationStateWaitEmailCode).CodeInfo
+
+				fmt.Printf("Enter code sent to %s: \n", codeInfo.EmailAddressPattern)
+				var code string
+				fmt.Scanln(&code)
+
+				clientAuthorizer.EmailCode <- code
 
 			case TypeAuthorizationStateWaitCode:
 				var code string
@@ -232,10 +524,21 @@ func CliInteractor(clientAuthorizer *clientAuthorizer) {
 				clientAuthorizer.Code <- code
 
 			case TypeAuthorizationStateWaitOtherDeviceConfirmation:
-				return
+				link := state.(*AuthorizationStateWaitOtherDeviceConfirmation).Link
+
+				fmt.Printf("Confirm login on other device by scanning QR code of the link: %s\n", link)
 
 			case TypeAuthorizationStateWaitRegistration:
-				return
+				fmt.Println("Enter first name: ")
+				var firstName string
+				fmt.Scanln(&firstName)
+
+				fmt.Println("Enter last name: ")
+				var lastName string
+				fmt.Scanln(&lastName)
+
+				clientAuthorizer.FirstName <- firstName
+				clientAuthorizer.LastName <- lastName
 
 			case TypeAuthorizationStateWaitPassword:
 				fmt.Println("Enter password: ")
@@ -247,21 +550,44 @@ func CliInteractor(clientAuthorizer *clientAuthorizer) {
 			case TypeAuthorizationStateReady:
 				return
 			}
+
+		case err, ok := <-clientAuthorizer.Errors:
+			if !ok {
+				return
+			}
+
+			fmt.Printf("Authorization error: %s\n", err)
+		}
+	}
+}
+
+func printAuthorizationErrors(errs chan error) {
+	for {
+		select {
+		case err, ok := <-errs:
+			if !ok {
+				return
+			}
+
+			fmt.Printf("Authorization error: %s\n", err)
+
+		default:
+			return
 		}
 	}
 }
 
 type botAuthorizer struct {
+	authorizerChannels
 	TdlibParameters chan *TdlibParameters
 	Token           chan string
-	State           chan AuthorizationState
 }
 
 func BotAuthorizer(token string) *botAuthorizer {
 	botAuthorizer := &botAuthorizer{
-		TdlibParameters: make(chan *TdlibParameters, 1),
-		Token:           make(chan string, 1),
-		State:           make(chan AuthorizationState, 10),
+		authorizerChannels: newAuthorizerChannels(),
+		TdlibParameters:    make(chan *TdlibParameters, 1),
+		Token:              make(chan string, 1),
 	}
 
 	botAuthorizer.Token <- token
@@ -269,45 +595,40 @@ func BotAuthorizer(token string) *botAuthorizer {
 	return botAuthorizer
 }
 
-func (stateHandler *botAuthorizer) Error(err error) {
-	log.Fatalf("Authorization error: %s", err)
-}
+// Handle passes the state to the State channel. Waiting stops when the next state is received or the client is shut down
+func (stateHandler *botAuthorizer) Handle(client *Client, state AuthorizationState) error {
+	ctx := client.authorizationContext()
 
-func (stateHandler *botAuthorizer) Context() context.Context {
-	return context.Background()
-}
+	err := stateHandler.passState(ctx, state)
+	if err != nil {
+		return err
+	}
 
-func (stateHandler *botAuthorizer) Handle(client *Client, state AuthorizationState) error {
-	stateHandler.State <- state
+	return authorizationError(stateHandler.handle(ctx, client, state))
+}
 
+func (stateHandler *botAuthorizer) handle(ctx context.Context, client *Client, state AuthorizationState) error {
 	switch state.AuthorizationStateType() {
 	case TypeAuthorizationStateWaitTdlibParameters:
-		p := <-stateHandler.TdlibParameters
-		_, err := client.SetTdlibParameters(&SetTdlibParametersRequest{
-			UseTestDc:              p.UseTestDc,
-			DatabaseDirectory:      p.DatabaseDirectory,
-			FilesDirectory:         p.FilesDirectory,
-			DatabaseEncryptionKey:  p.DatabaseEncryptionKey,
-			UseFileDatabase:        p.UseFileDatabase,
-			UseChatInfoDatabase:    p.UseChatInfoDatabase,
-			UseMessageDatabase:     p.UseMessageDatabase,
-			UseSecretChats:         p.UseSecretChats,
-			ApiId:                  p.ApiId,
-			ApiHash:                p.ApiHash,
-			SystemLanguageCode:     p.SystemLanguageCode,
-			DeviceModel:            p.DeviceModel,
-			SystemVersion:          p.SystemVersion,
-			ApplicationVersion:     p.ApplicationVersion,
-			EnableStorageOptimizer: p.EnableStorageOptimizer,
-			IgnoreFileNames:        p.IgnoreFileNames,
-		})
-		return err
+		select {
+		case parameters := <-stateHandler.TdlibParameters:
+			return setTdlibParameters(client, parameters)
+
+		case <-ctx.Done():
+			return context.Cause(ctx)
+		}
 
 	case TypeAuthorizationStateWaitPhoneNumber:
-		_, err := client.CheckAuthenticationBotToken(&CheckAuthenticationBotTokenRequest{
-			Token: <-stateHandler.Token,
-		})
-		return err
+		select {
+		case token := <-stateHandler.Token:
+			_, err := client.CheckAuthenticationBotTokenContext(ctx, &CheckAuthenticationBotTokenRequest{
+				Token: token,
+			})
+			return err
+
+		case <-ctx.Done():
+			return context.Cause(ctx)
+		}
 
 	case TypeAuthorizationStateWaitCode:
 		return ErrNotSupportedAuthorizationState
@@ -319,13 +640,13 @@ func (stateHandler *botAuthorizer) Handle(client *Client, state AuthorizationSta
 		return nil
 
 	case TypeAuthorizationStateLoggingOut:
-		return ErrNotSupportedAuthorizationState
+		return nil
 
 	case TypeAuthorizationStateClosing:
-		return ErrNotSupportedAuthorizationState
+		return nil
 
 	case TypeAuthorizationStateClosed:
-		return ErrNotSupportedAuthorizationState
+		return nil
 	}
 
 	return ErrNotSupportedAuthorizationState
@@ -334,5 +655,63 @@ func (stateHandler *botAuthorizer) Handle(client *Client, state AuthorizationSta
 func (stateHandler *botAuthorizer) Close() {
 	close(stateHandler.TdlibParameters)
 	close(stateHandler.Token)
-	close(stateHandler.State)
+	stateHandler.close()
+}
+
+type sessionAuthorizer struct {
+	authorizerChannels
+	parameters *TdlibParameters
+}
+
+// SessionAuthorizer authorizes the client with an existing database only.
+// Authorization fails with ErrNeedsLogin if TDLib asks for a phone number or other user input
+func SessionAuthorizer(parameters *TdlibParameters) *sessionAuthorizer {
+	return &sessionAuthorizer{
+		authorizerChannels: newAuthorizerChannels(),
+		parameters:         parameters,
+	}
+}
+
+// Handle passes the state to the State channel. The state is dropped if the channel is full
+func (stateHandler *sessionAuthorizer) Handle(client *Client, state AuthorizationState) error {
+	select {
+	case stateHandler.State <- state:
+	default:
+	}
+
+	return authorizationError(stateHandler.handle(client, state))
+}
+
+func (stateHandler *sessionAuthorizer) handle(client *Client, state AuthorizationState) error {
+	switch state.AuthorizationStateType() {
+	case TypeAuthorizationStateWaitTdlibParameters:
+		return setTdlibParameters(client, stateHandler.parameters)
+
+	case TypeAuthorizationStateWaitPhoneNumber,
+		TypeAuthorizationStateWaitEmailAddress,
+		TypeAuthorizationStateWaitEmailCode,
+		TypeAuthorizationStateWaitCode,
+		TypeAuthorizationStateWaitOtherDeviceConfirmation,
+		TypeAuthorizationStateWaitRegistration,
+		TypeAuthorizationStateWaitPassword:
+		return fmt.Errorf("%w: %s", ErrNeedsLogin, state.AuthorizationStateType())
+
+	case TypeAuthorizationStateReady:
+		return nil
+
+	case TypeAuthorizationStateLoggingOut:
+		return nil
+
+	case TypeAuthorizationStateClosing:
+		return nil
+
+	case TypeAuthorizationStateClosed:
+		return nil
+	}
+
+	return ErrNotSupportedAuthorizationState
+}
+
+func (stateHandler *sessionAuthorizer) Close() {
+	stateHandler.close()
 }
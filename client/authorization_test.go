@@ -0,0 +1,292 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/megaplan/go-tdlib/client"
+	"github.com/megaplan/go-tdlib/client/tdtest"
+)
+
+func TestAuthorizeShutdown(t *testing.T) {
+	server := tdtest.NewServer()
+	server.SetAuthorizationState(&client.AuthorizationStateWaitOtherDeviceConfirmation{
+		Link: "tg://login?token=test",
+	})
+
+	authorizer := client.ClientAuthorizer()
+	tdlibClient := client.NewClientAsync(authorizer, client.WithTransport(server))
+
+	select {
+	case <-authorizer.State:
+	case <-time.After(time.Second):
+		t.Fatal("authorization state isn't received")
+	}
+
+	tdlibClient.Shutdown()
+
+	select {
+	case err := <-authorizer.Errors:
+		if !errors.Is(err, client.ErrClientClosed) {
+			t.Fatalf("expected ErrClientClosed, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("authorization isn't finished on shutdown")
+	}
+
+	// the handler is closed after the error
+	select {
+	case _, ok := <-authorizer.State:
+		if ok {
+			t.Fatal("unexpected authorization state")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("authorizer isn't closed")
+	}
+}
+
+// waitState waits for the authorization state of the type passed by the authorizer
+func waitState(t *testing.T, states chan client.AuthorizationState, typ string) {
+	t.Helper()
+
+	timeout := time.After(time.Second)
+	for {
+		select {
+		case state, ok := <-states:
+			if !ok {
+				t.Fatalf("authorizer is closed before %s", typ)
+			}
+
+			if state.AuthorizationStateType() == typ {
+				return
+			}
+
+		case <-timeout:
+			t.Fatalf("%s isn't received", typ)
+		}
+	}
+}
+
+func TestAuthorizeInputShutdown(t *testing.T) {
+	server := tdtest.NewServer()
+	server.SetAuthorizationState(&client.AuthorizationStateWaitCode{})
+
+	authorizer := client.ClientAuthorizer()
+	tdlibClient := client.NewClientAsync(authorizer, client.WithTransport(server))
+
+	waitState(t, authorizer.State, client.TypeAuthorizationStateWaitCode)
+
+	// the authorizer is waiting for the code
+	tdlibClient.Shutdown()
+
+	select {
+	case err := <-authorizer.Errors:
+		if !errors.Is(err, client.ErrClientClosed) {
+			t.Fatalf("expected ErrClientClosed, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("waiting for the code isn't stopped on shutdown")
+	}
+}
+
+func TestAuthorizeInputClose(t *testing.T) {
+	server := tdtest.NewServer()
+	server.SetAuthorizationState(&client.AuthorizationStateWaitPassword{})
+	server.Handle("close", func(req *tdtest.Request) client.Type {
+		server.SendUpdate(req.ClientId, &client.UpdateAuthorizationState{
+			AuthorizationState: &client.AuthorizationStateClosed{},
+		})
+
+		return &client.Ok{}
+	})
+
+	authorizer := client.ClientAuthorizer()
+	tdlibClient := client.NewClientAsync(authorizer, client.WithTransport(server))
+	defer tdlibClient.Shutdown()
+
+	waitState(t, authorizer.State, client.TypeAuthorizationStateWaitPassword)
+
+	// the authorizer is waiting for the password, the next state stops it
+	_, err := tdlibClient.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	waitState(t, authorizer.State, client.TypeAuthorizationStateClosed)
+
+	select {
+	case err, ok := <-authorizer.Errors:
+		if ok {
+			t.Fatalf("unexpected error %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("authorizer isn't closed")
+	}
+}
+
+// contextAuthorizer handles no states and reports the authorization error
+type contextAuthorizer struct {
+	ctx context.Context
+	err chan error
+}
+
+func (authorizer *contextAuthorizer) Context() context.Context {
+	return authorizer.ctx
+}
+
+func (authorizer *contextAuthorizer) Handle(client *client.Client, state client.AuthorizationState) error {
+	return nil
+}
+
+func (authorizer *contextAuthorizer) Error(err error) {
+	authorizer.err <- err
+}
+
+func (authorizer *contextAuthorizer) Close() {
+	close(authorizer.err)
+}
+
+func TestAuthorizeContext(t *testing.T) {
+	server := tdtest.NewServer()
+	server.SetAuthorizationState(&client.AuthorizationStateWaitCode{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	authorizer := &contextAuthorizer{
+		ctx: ctx,
+		err: make(chan error, 1),
+	}
+	tdlibClient := client.NewClientAsync(authorizer, client.WithTransport(server))
+	defer tdlibClient.Shutdown()
+
+	cancel()
+
+	select {
+	case err := <-authorizer.err:
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected context.Canceled, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("authorization isn't finished on cancel")
+	}
+}
+
+// setState changes the authorization state of the client like TDLib does
+func setState(server *tdtest.Server, clientId int, state client.AuthorizationState) {
+	server.SetAuthorizationState(state)
+	server.SendUpdate(clientId, &client.UpdateAuthorizationState{AuthorizationState: state})
+}
+
+// moveTo is a handler changing the authorization state of the client
+func moveTo(server *tdtest.Server, state client.AuthorizationState) tdtest.Handler {
+	return func(req *tdtest.Request) client.Type {
+		setState(server, req.ClientId, state)
+
+		return &client.Ok{}
+	}
+}
+
+func TestAuthorizeQrCodeRegistration(t *testing.T) {
+	server := tdtest.NewServer()
+	server.SetAuthorizationState(&client.AuthorizationStateWaitPhoneNumber{})
+	server.Handle("requestQrCodeAuthentication", moveTo(server, &client.AuthorizationStateWaitOtherDeviceConfirmation{
+		Link: "tg://login?token=test",
+	}))
+	server.Handle("registerUser", moveTo(server, &client.AuthorizationStateReady{}))
+
+	authorizer := client.ClientAuthorizer()
+	tdlibClient := client.NewClientAsync(authorizer, client.WithTransport(server))
+	defer tdlibClient.Shutdown()
+
+	waitState(t, authorizer.State, client.TypeAuthorizationStateWaitPhoneNumber)
+	authorizer.QrCode <- []int64{7}
+
+	select {
+	case state := <-authorizer.State:
+		confirmation, ok := state.(*client.AuthorizationStateWaitOtherDeviceConfirmation)
+		if !ok || confirmation.Link != "tg://login?token=test" {
+			t.Fatalf("expected the login link, got %#v", state)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("the login link isn't received")
+	}
+
+	// the link is scanned by a new account
+	setState(server, server.ClientIds()[0], &client.AuthorizationStateWaitRegistration{})
+
+	waitState(t, authorizer.State, client.TypeAuthorizationStateWaitRegistration)
+	authorizer.FirstName <- "First"
+	authorizer.LastName <- "Last"
+
+	waitState(t, authorizer.State, client.TypeAuthorizationStateReady)
+
+	var qrCodeRequest client.RequestQrCodeAuthenticationRequest
+
+	err := server.Requests("requestQrCodeAuthentication")[0].Decode(&qrCodeRequest)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(qrCodeRequest.OtherUserIds) != 1 || qrCodeRequest.OtherUserIds[0] != 7 {
+		t.Fatalf("unexpected other users %v", qrCodeRequest.OtherUserIds)
+	}
+
+	var registerRequest client.RegisterUserRequest
+
+	err = server.Requests("registerUser")[0].Decode(&registerRequest)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if registerRequest.FirstName != "First" || registerRequest.LastName != "Last" {
+		t.Fatalf("unexpected name %q %q", registerRequest.FirstName, registerRequest.LastName)
+	}
+}
+
+func TestAuthorizeEmail(t *testing.T) {
+	server := tdtest.NewServer()
+	server.SetAuthorizationState(&client.AuthorizationStateWaitEmailAddress{})
+	server.Handle("setAuthenticationEmailAddress", moveTo(server, &client.AuthorizationStateWaitEmailCode{}))
+	server.Handle("checkAuthenticationEmailCode", moveTo(server, &client.AuthorizationStateReady{}))
+
+	authorizer := client.ClientAuthorizer()
+	tdlibClient := client.NewClientAsync(authorizer, client.WithTransport(server))
+	defer tdlibClient.Shutdown()
+
+	waitState(t, authorizer.State, client.TypeAuthorizationStateWaitEmailAddress)
+	authorizer.EmailAddress <- "user@example.com"
+
+	waitState(t, authorizer.State, client.TypeAuthorizationStateWaitEmailCode)
+	authorizer.EmailCode <- "12345"
+
+	waitState(t, authorizer.State, client.TypeAuthorizationStateReady)
+
+	var emailRequest client.SetAuthenticationEmailAddressRequest
+
+	err := server.Requests("setAuthenticationEmailAddress")[0].Decode(&emailRequest)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if emailRequest.EmailAddress != "user@example.com" {
+		t.Fatalf("unexpected email address %q", emailRequest.EmailAddress)
+	}
+
+	var codeRequest struct {
+		Code struct {
+			Type string `json:"@type"`
+			Code string `json:"code"`
+		} `json:"code"`
+	}
+
+	err = server.Requests("checkAuthenticationEmailCode")[0].Decode(&codeRequest)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if codeRequest.Code.Type != client.TypeEmailAddressAuthenticationCode || codeRequest.Code.Code != "12345" {
+		t.Fatalf("unexpected code %+v", codeRequest.Code)
+	}
+}
@@ -7,14 +7,32 @@ import (
 	"time"
 )
 
+var ErrClientClosed = errors.New("client is closed")
+
 type Client struct {
+	transport      Transport
+	tdlib          *tdlib
 	jsonClient     *JsonClient
 	extraGenerator ExtraGenerator
 	responses      chan *Response
+	done           chan struct{}
+	shutdownOnce   sync.Once
 	listenerStore  *listenerStore
 	catchersStore  *sync.Map
 	updatesTimeout time.Duration
 	catchTimeout   time.Duration
+	// receives authorization state updates from the start of the TDLib instance until the end of the authorization
+	authorizationListener *Listener
+	// context of the authorization state handled by the authorizer, see authorizationContext
+	authorizationCtx   context.Context
+	interceptors       []Interceptor
+	diagnosticsHandler DiagnosticsHandler
+	startFuncs         []func()
+	// whether the client is authorized as a user or a bot, see watchSessionType
+	sessionType int32
+	// the account and whether the authorization state is ready, used only by the receiver
+	myId    int64
+	isReady bool
 }
 
 type Option func(*Client)
@@ -33,20 +51,25 @@ func WithCatchTimeout(timeout time.Duration) Option {
 
 func WithProxy(req *AddProxyRequest) Option {
 	return func(client *Client) {
-		client.AddProxy(req)
+		client.onStart(func() {
+			client.AddProxy(req)
+		})
 	}
 }
 
 func WithLogVerbosity(req *SetLogVerbosityLevelRequest) Option {
 	return func(client *Client) {
-		client.SetLogVerbosityLevel(req)
+		client.onStart(func() {
+			client.SetLogVerbosityLevel(req)
+		})
 	}
 }
 
 func createClient(options ...Option) *Client {
 	client := &Client{
-		jsonClient:    NewJsonClient(),
+		transport:     defaultTransport,
 		responses:     make(chan *Response, 1000),
+		done:          make(chan struct{}),
 		listenerStore: newListenerStore(),
 		catchersStore: &sync.Map{},
 	}
@@ -58,19 +81,33 @@ func createClient(options ...Option) *Client {
 		option(client)
 	}
 
-	tdlibInstance.addClient(client)
+	client.jsonClient = newJsonClient(client.transport)
+
+	// must be added before the first request is sent
+	client.authorizationListener = client.GetListener(WithFilter(TypeFilter(TypeUpdateAuthorizationState)))
+
+	client.tdlib = addTdlibClient(client)
 
 	go client.receiver()
 
+	for _, start := range client.startFuncs {
+		start()
+	}
+
 	return client
 }
 
+// onStart defers the function of the option until the client is ready to send requests
+func (client *Client) onStart(start func()) {
+	client.startFuncs = append(client.startFuncs, start)
+}
+
 func NewClient(authorizationStateHandler AuthorizationStateHandler, options ...Option) (*Client, error) {
 	client := createClient(options...)
 
 	err := Authorize(client, authorizationStateHandler)
 	if err != nil {
-		authorizationStateHandler.Error(err)
+		client.Shutdown()
 		return nil, err
 	}
 
@@ -80,86 +117,198 @@ func NewClient(authorizationStateHandler AuthorizationStateHandler, options ...O
 func NewClientAsync(authorizationStateHandler AuthorizationStateHandler, options ...Option) *Client {
 	client := createClient(options...)
 
-	go func() {
-		err := Authorize(client, authorizationStateHandler)
-		if err != nil {
-			authorizationStateHandler.Error(err)
-		}
-	}()
+	go Authorize(client, authorizationStateHandler)
 
 	return client
 }
 
 func (client *Client) receiver() {
-	for response := range client.responses {
-		if response.Extra != "" {
-			value, ok := client.catchersStore.Load(response.Extra)
-			if ok {
-				value.(chan *Response) <- response
-			}
+	for {
+		select {
+		case response := <-client.responses:
+			client.receive(response)
+
+		case <-client.done:
+			client.listenerStore.Close()
+			return
 		}
+	}
+}
 
-		typ, err := UnmarshalType(response.Data)
-		if err != nil {
+// deliver passes the response from TDLib instance to the receiver unless the client is shut down
+func (client *Client) deliver(response *Response) {
+	select {
+	case client.responses <- response:
+	case <-client.done:
+	}
+}
+
+func (client *Client) receive(response *Response) {
+	client.watchSessionType(response)
+
+	if response.Extra != "" {
+		value, ok := client.catchersStore.Load(response.Extra)
+		if ok {
+			value.(chan *Response) <- response
+		} else {
+			client.diagnose(Diagnostic{
+				Kind:     DiagnosticLateResponse,
+				Type:     response.Type,
+				Extra:    response.Extra,
+				ClientId: response.ClientId,
+				Data:     response.Data,
+			})
+		}
+	}
+
+	// the response is unmarshaled only if any listener receives it. Every listener gets its own object,
+	// so the listeners and the request don't share it
+	unmarshaled := false
+	needGc := false
+	for _, listener := range client.listenerStore.Listeners() {
+		if !listener.IsActive() {
+			needGc = true
+			continue
+		}
+
+		if !listener.Accepts(response.Type) {
 			continue
 		}
 
-		needGc := false
-		for _, listener := range client.listenerStore.Listeners() {
-			if listener.IsActive() {
-				listener.Updates <- typ
-			} else {
-				needGc = true
-			}
+		unmarshaled = true
+
+		typ, err := UnmarshalType(response.Data)
+		if err != nil {
+			client.diagnoseUnmarshalError(response, err)
+			break
 		}
-		if needGc {
-			client.listenerStore.gc()
+
+		listener.deliver(typ)
+	}
+	if needGc {
+		client.listenerStore.gc()
+	}
+
+	// the update nobody receives is unmarshaled only to report the error, the request gets the error of its response itself
+	if !unmarshaled && response.Extra == "" && client.diagnosticsHandler != nil {
+		_, err := UnmarshalType(response.Data)
+		if err != nil {
+			client.diagnoseUnmarshalError(response, err)
 		}
 	}
 }
 
 func (client *Client) Send(req Request) (*Response, error) {
+	return client.SendContext(context.Background(), req)
+}
+
+// SendContext sends the request through the interceptors and waits for the response until ctx is done or catch timeout is expired
+func (client *Client) SendContext(ctx context.Context, req Request) (*Response, error) {
 	req.Extra = client.extraGenerator()
 
+	return chainInterceptors(client.interceptors, client.send)(ctx, req)
+}
+
+// Execute synchronously executes the request through the interceptors
+func (client *Client) Execute(req Request) (*Response, error) {
+	return chainInterceptors(client.interceptors, client.execute)(context.Background(), req)
+}
+
+func (client *Client) send(ctx context.Context, req Request) (*Response, error) {
+	err := ctx.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case <-client.done:
+		return nil, ErrClientClosed
+	default:
+	}
+
 	catcher := make(chan *Response, 1)
 
 	client.catchersStore.Store(req.Extra, catcher)
-
-	defer func() {
-		client.catchersStore.Delete(req.Extra)
-		close(catcher)
-	}()
+	defer client.catchersStore.Delete(req.Extra)
 
 	client.jsonClient.Send(req)
 
-	ctx, cancel := context.WithTimeout(context.Background(), client.catchTimeout)
-	defer cancel()
+	timer := time.NewTimer(client.catchTimeout)
+	defer timer.Stop()
 
 	select {
 	case response := <-catcher:
 		return response, nil
 
 	case <-ctx.Done():
+		return nil, ctx.Err()
+
+	case <-client.done:
+		return nil, ErrClientClosed
+
+	case <-timer.C:
 		return nil, errors.New("response catching timeout")
 	}
 }
 
-func (client *Client) GetListener() *Listener {
-	listener := &Listener{
-		isActive: true,
-		Updates:  make(chan Type, 1000),
-	}
+func (client *Client) execute(ctx context.Context, req Request) (*Response, error) {
+	return client.jsonClient.Execute(req)
+}
+
+// GetListener returns a listener receiving all updates unless a filter is passed
+func (client *Client) GetListener(options ...ListenerOption) *Listener {
+	listener := newListener(options...)
+	listener.clientDone = client.done
 	client.listenerStore.Add(listener)
 
 	return listener
 }
 
+// GetListenerContext returns a listener which is closed when ctx is done
+func (client *Client) GetListenerContext(ctx context.Context, options ...ListenerOption) *Listener {
+	listener := client.GetListener(options...)
+	listener.closeOnDone(ctx)
+
+	return listener
+}
+
+// Stop destroys TDLib instance with all local data. Use CloseAndWait to keep the database
 func (client *Client) Stop() {
 	client.Destroy()
 }
 
+// CloseAndWait closes TDLib instance and waits until it flushes the database and reaches authorizationStateClosed or ctx is done.
+// The client is shut down in both cases
+func (client *Client) CloseAndWait(ctx context.Context) error {
+	defer client.Shutdown()
+
+	listener := client.GetListenerContext(ctx, WithFilter(TypeFilter(TypeUpdateAuthorizationState)))
+	defer listener.Close()
+
+	_, err := client.CloseContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	for update := range listener.Updates {
+		if update.(*UpdateAuthorizationState).AuthorizationState.AuthorizationStateType() == TypeAuthorizationStateClosed {
+			return nil
+		}
+	}
+
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	return ErrClientClosed
+}
+
+// Shutdown unregisters the client from TDLib instance, closes all listeners and fails requests waiting for responses with ErrClientClosed.
+// May be called multiple times
 func (client *Client) Shutdown() {
-	tdlibInstance.removeClient(client)
+	client.shutdownOnce.Do(func() {
+		client.tdlib.removeClient(client)
 
-	close(client.responses)
+		close(client.done)
+	})
 }
@@ -0,0 +1,78 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func catchersCount(client *Client) int {
+	count := 0
+	client.catchersStore.Range(func(key, value interface{}) bool {
+		count++
+		return true
+	})
+
+	return count
+}
+
+func TestSendContextCancel(t *testing.T) {
+	// the transport never answers
+	client := createClient(WithTransport(&pointerTransport{}))
+	defer client.Shutdown()
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := client.GetChatContext(ctx, &GetChatRequest{ChatId: 1})
+		done <- err
+	}()
+
+	deadline := time.Now().Add(time.Second)
+	for catchersCount(client) == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("the request isn't sent")
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+
+	cancel()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected context.Canceled, got %v", err)
+		}
+
+	case <-time.After(time.Second):
+		t.Fatal("the request isn't canceled")
+	}
+
+	if catchersCount(client) != 0 {
+		t.Fatal("the catcher isn't released")
+	}
+
+	// the request isn't sent with the context which is already done
+	_, err := client.GetChatContext(ctx, &GetChatRequest{ChatId: 1})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestSendCatchTimeout(t *testing.T) {
+	client := createClient(WithTransport(&pointerTransport{}), WithCatchTimeout(10*time.Millisecond))
+	defer client.Shutdown()
+
+	// the catch timeout is applied even if the context has no deadline
+	_, err := client.GetChatContext(context.Background(), &GetChatRequest{ChatId: 1})
+	if err == nil || err.Error() != "response catching timeout" {
+		t.Fatalf("expected the catch timeout, got %v", err)
+	}
+
+	if catchersCount(client) != 0 {
+		t.Fatal("the catcher isn't released")
+	}
+}
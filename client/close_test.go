@@ -0,0 +1,102 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/megaplan/go-tdlib/client"
+	"github.com/megaplan/go-tdlib/client/tdtest"
+)
+
+func TestCloseAndWait(t *testing.T) {
+	server := tdtest.NewServer()
+	server.Handle("close", func(req *tdtest.Request) client.Type {
+		setState(server, req.ClientId, &client.AuthorizationStateClosing{})
+		setState(server, req.ClientId, &client.AuthorizationStateClosed{})
+
+		return &client.Ok{}
+	})
+
+	tdlibClient := newTestClient(t, server)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	err := tdlibClient.CloseAndWait(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(server.Requests("close")) != 1 {
+		t.Fatal("close isn't sent")
+	}
+
+	// the client is shut down after the closed state
+	_, err = tdlibClient.GetMe()
+	if !errors.Is(err, client.ErrClientClosed) {
+		t.Fatalf("expected ErrClientClosed, got %v", err)
+	}
+}
+
+func TestCloseAndWaitDeadline(t *testing.T) {
+	server := tdtest.NewServer()
+	// the database is never flushed
+	server.Handle("close", moveTo(server, &client.AuthorizationStateClosing{}))
+
+	tdlibClient := newTestClient(t, server)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	err := tdlibClient.CloseAndWait(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+
+	_, err = tdlibClient.GetMe()
+	if !errors.Is(err, client.ErrClientClosed) {
+		t.Fatalf("expected ErrClientClosed, got %v", err)
+	}
+}
+
+func TestShutdownFailsPendingRequests(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+
+	server := tdtest.NewServer()
+	server.Handle("getChat", func(req *tdtest.Request) client.Type {
+		<-release
+
+		return &client.Chat{Id: 1}
+	})
+
+	tdlibClient := newTestClient(t, server)
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := tdlibClient.GetChat(&client.GetChatRequest{ChatId: 1})
+		done <- err
+	}()
+
+	deadline := time.Now().Add(time.Second)
+	for len(server.Requests("getChat")) == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("the request isn't sent")
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+
+	tdlibClient.Shutdown()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, client.ErrClientClosed) {
+			t.Fatalf("expected ErrClientClosed, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("the request isn't failed on shutdown")
+	}
+}
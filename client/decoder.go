@@ -0,0 +1,492 @@
+package client
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+var errUnexpectedEnd = errors.New("unexpected end of JSON input")
+
+// decoder reads JSON in one pass. Generated decode methods build typed objects with it
+type decoder struct {
+	data []byte
+	pos  int
+	err  error
+}
+
+func newDecoder(data []byte) *decoder {
+	return &decoder{
+		data: data,
+	}
+}
+
+// finish returns the first error or an error if anything except whitespace is left
+func (decoder *decoder) finish() error {
+	if decoder.err != nil {
+		return decoder.err
+	}
+
+	if decoder.peek() != 0 {
+		return decoder.syntaxError("after top-level value")
+	}
+
+	return nil
+}
+
+// fail stops decoding keeping the first error
+func (decoder *decoder) fail(err error) {
+	if decoder.err == nil {
+		decoder.err = err
+	}
+	decoder.pos = len(decoder.data)
+}
+
+func (decoder *decoder) syntaxError(context string) error {
+	if decoder.pos >= len(decoder.data) {
+		return errUnexpectedEnd
+	}
+
+	return fmt.Errorf("invalid character %q %s at offset %d", decoder.data[decoder.pos], context, decoder.pos)
+}
+
+// peek skips whitespace and returns the next character or 0 at the end
+func (decoder *decoder) peek() byte {
+	data := decoder.data
+	for pos := decoder.pos; pos < len(data); pos++ {
+		switch data[pos] {
+		case ' ', '\t', '\n', '\r':
+		default:
+			decoder.pos = pos
+			return data[pos]
+		}
+	}
+	decoder.pos = len(data)
+
+	return 0
+}
+
+func (decoder *decoder) expect(c byte, context string) bool {
+	if decoder.peek() != c {
+		decoder.fail(decoder.syntaxError(context))
+		return false
+	}
+	decoder.pos++
+
+	return true
+}
+
+// null consumes null if it is the next value
+func (decoder *decoder) null() bool {
+	if decoder.peek() == 'n' && decoder.literal("null") {
+		return true
+	}
+
+	return false
+}
+
+func (decoder *decoder) literal(literal string) bool {
+	if len(decoder.data)-decoder.pos < len(literal) || string(decoder.data[decoder.pos:decoder.pos+len(literal)]) != literal {
+		return false
+	}
+	decoder.pos += len(literal)
+
+	return true
+}
+
+// beginObject consumes the beginning of the object. Returns false for null
+func (decoder *decoder) beginObject() bool {
+	if decoder.null() {
+		return false
+	}
+
+	return decoder.expect('{', "looking for beginning of object")
+}
+
+// beginArray consumes the beginning of the array. Returns false for null
+func (decoder *decoder) beginArray() bool {
+	if decoder.null() {
+		return false
+	}
+
+	return decoder.expect('[', "looking for beginning of array")
+}
+
+// more reports whether the object or array has the next element, the end is consumed otherwise.
+// Elements must be separated by commas, a trailing comma is an error
+func (decoder *decoder) more(end byte) bool {
+	c := decoder.peek()
+	if c == end {
+		decoder.pos++
+		return false
+	}
+
+	if c == 0 {
+		decoder.fail(errUnexpectedEnd)
+		return false
+	}
+
+	if !decoder.first() {
+		if c != ',' {
+			decoder.fail(decoder.syntaxError("after element"))
+			return false
+		}
+		decoder.pos++
+
+		// the element is read by the caller, so a trailing comma fails there
+	}
+
+	return decoder.err == nil
+}
+
+// first reports whether the next element is the first one, so it isn't preceded by a comma
+func (decoder *decoder) first() bool {
+	for pos := decoder.pos - 1; pos >= 0; pos-- {
+		switch decoder.data[pos] {
+		case ' ', '\t', '\n', '\r':
+		case '{', '[':
+			return true
+		default:
+			return false
+		}
+	}
+
+	return false
+}
+
+// key returns the key of the next object member. The value is the next one to read
+func (decoder *decoder) key() []byte {
+	key := decoder.stringBytes()
+	decoder.expect(':', "after object key")
+
+	return key
+}
+
+// objectType returns @type of the next object without consuming it
+func (decoder *decoder) objectType() []byte {
+	if decoder.peek() != '{' {
+		return nil
+	}
+
+	pos := decoder.pos
+	decoder.pos++
+
+	var typ []byte
+	for decoder.more('}') {
+		if string(decoder.key()) == "@type" {
+			typ = decoder.stringBytes()
+			break
+		}
+		decoder.skip()
+	}
+
+	if decoder.err == nil {
+		decoder.pos = pos
+	}
+
+	return typ
+}
+
+// meta reads @type, @extra and @client_id of the object skipping other members
+func (decoder *decoder) meta() meta {
+	var meta meta
+
+	if !decoder.beginObject() {
+		return meta
+	}
+
+	for decoder.more('}') {
+		decoder.member(&meta, decoder.key())
+	}
+
+	return meta
+}
+
+// member reads the member of meta. Members missing in the schema are skipped
+func (decoder *decoder) member(meta *meta, key []byte) {
+	switch string(key) {
+	case "@type":
+		// the type is usually known already
+		typ := decoder.stringBytes()
+		if string(typ) != meta.Type {
+			meta.Type = string(typ)
+		}
+	case "@extra":
+		meta.Extra = decoder.String()
+	case "@client_id":
+		meta.ClientId = int(decoder.Int64())
+	default:
+		decoder.skip()
+	}
+}
+
+// unknown returns the object of the type missing in the schema
+func (decoder *decoder) unknown() (meta, json.RawMessage) {
+	data := decoder.raw()
+
+	return newDecoder(data).meta(), data
+}
+
+// raw returns the next value as is
+func (decoder *decoder) raw() []byte {
+	decoder.peek()
+	start := decoder.pos
+	decoder.skip()
+
+	return decoder.data[start:decoder.pos]
+}
+
+// skip consumes the next value
+func (decoder *decoder) skip() {
+	switch decoder.peek() {
+	case '{':
+		decoder.pos++
+		for decoder.more('}') {
+			decoder.key()
+			decoder.skip()
+		}
+
+	case '[':
+		decoder.pos++
+		for decoder.more(']') {
+			decoder.skip()
+		}
+
+	case '"':
+		decoder.stringBytes()
+
+	case 't':
+		decoder.Bool()
+
+	case 'f':
+		decoder.Bool()
+
+	case 'n':
+		if !decoder.null() {
+			decoder.fail(decoder.syntaxError("in literal null"))
+		}
+
+	default:
+		decoder.number()
+	}
+}
+
+// stringBytes returns the unescaped string. The result refers to the data if the string has no escapes
+func (decoder *decoder) stringBytes() []byte {
+	if !decoder.expect('"', "looking for beginning of string") {
+		return nil
+	}
+
+	data := decoder.data
+	start := decoder.pos
+	for pos := start; pos < len(data); pos++ {
+		switch data[pos] {
+		case '"':
+			decoder.pos = pos + 1
+			return data[start:pos]
+
+		case '\\':
+			decoder.pos = pos
+			return decoder.unescape(start)
+		}
+	}
+
+	decoder.fail(errUnexpectedEnd)
+
+	return nil
+}
+
+func (decoder *decoder) unescape(start int) []byte {
+	buf := append([]byte(nil), decoder.data[start:decoder.pos]...)
+
+	for decoder.pos < len(decoder.data) {
+		c := decoder.data[decoder.pos]
+		switch {
+		case c == '"':
+			decoder.pos++
+			return buf
+
+		case c != '\\':
+			buf = append(buf, c)
+			decoder.pos++
+			continue
+		}
+
+		decoder.pos++
+		if decoder.pos >= len(decoder.data) {
+			break
+		}
+
+		c = decoder.data[decoder.pos]
+		decoder.pos++
+
+		switch c {
+		case '"', '\\', '/':
+			buf = append(buf, c)
+		case 'b':
+			buf = append(buf, '\b')
+		case 'f':
+			buf = append(buf, '\f')
+		case 'n':
+			buf = append(buf, '\n')
+		case 'r':
+			buf = append(buf, '\r')
+		case 't':
+			buf = append(buf, '\t')
+		case 'u':
+			r := decoder.hex()
+			if utf16.IsSurrogate(r) && decoder.literal(`\u`) {
+				r = utf16.DecodeRune(r, decoder.hex())
+			}
+			buf = utf8.AppendRune(buf, r)
+		default:
+			decoder.pos--
+			decoder.fail(decoder.syntaxError("in string escape code"))
+			return nil
+		}
+	}
+
+	decoder.fail(errUnexpectedEnd)
+
+	return nil
+}
+
+func (decoder *decoder) hex() rune {
+	if len(decoder.data)-decoder.pos < 4 {
+		decoder.fail(errUnexpectedEnd)
+		return utf8.RuneError
+	}
+
+	r, err := strconv.ParseUint(string(decoder.data[decoder.pos:decoder.pos+4]), 16, 32)
+	if err != nil {
+		decoder.fail(decoder.syntaxError("in \\u hexadecimal character escape"))
+		return utf8.RuneError
+	}
+	decoder.pos += 4
+
+	return rune(r)
+}
+
+// number returns the next number
+func (decoder *decoder) number() []byte {
+	decoder.peek()
+	start := decoder.pos
+	for decoder.pos < len(decoder.data) {
+		c := decoder.data[decoder.pos]
+		if (c < '0' || c > '9') && c != '-' && c != '+' && c != '.' && c != 'e' && c != 'E' {
+			break
+		}
+		decoder.pos++
+	}
+
+	if start == decoder.pos {
+		decoder.fail(decoder.syntaxError("looking for beginning of value"))
+		return nil
+	}
+
+	return decoder.data[start:decoder.pos]
+}
+
+func (decoder *decoder) String() string {
+	if decoder.null() {
+		return ""
+	}
+
+	return string(decoder.stringBytes())
+}
+
+func (decoder *decoder) Bool() bool {
+	switch {
+	case decoder.null():
+		return false
+	case decoder.peek() == 't' && decoder.literal("true"):
+		return true
+	case decoder.peek() == 'f' && decoder.literal("false"):
+		return false
+	}
+
+	decoder.fail(decoder.syntaxError("looking for boolean"))
+
+	return false
+}
+
+func (decoder *decoder) Int32() int32 {
+	if decoder.null() {
+		return 0
+	}
+
+	value, err := strconv.ParseInt(string(decoder.number()), 10, 32)
+	if err != nil {
+		decoder.fail(err)
+	}
+
+	return int32(value)
+}
+
+func (decoder *decoder) Int64() int64 {
+	if decoder.null() {
+		return 0
+	}
+
+	value, err := strconv.ParseInt(string(decoder.number()), 10, 64)
+	if err != nil {
+		decoder.fail(err)
+	}
+
+	return value
+}
+
+// JsonInt64 is a string or a number, see JsonInt64.UnmarshalJSON
+func (decoder *decoder) JsonInt64() JsonInt64 {
+	if decoder.null() {
+		return 0
+	}
+
+	var number []byte
+	if decoder.peek() == '"' {
+		number = decoder.stringBytes()
+	} else {
+		number = decoder.number()
+	}
+
+	value, err := strconv.ParseInt(string(number), 10, 64)
+	if err != nil {
+		decoder.fail(err)
+	}
+
+	return JsonInt64(value)
+}
+
+func (decoder *decoder) Float64() float64 {
+	if decoder.null() {
+		return 0
+	}
+
+	value, err := strconv.ParseFloat(string(decoder.number()), 64)
+	if err != nil {
+		decoder.fail(err)
+	}
+
+	return value
+}
+
+// Bytes are a base64 string like encoding/json expects
+func (decoder *decoder) Bytes() []byte {
+	if decoder.null() {
+		return nil
+	}
+
+	str := decoder.stringBytes()
+
+	value := make([]byte, base64.StdEncoding.DecodedLen(len(str)))
+	n, err := base64.StdEncoding.Decode(value, str)
+	if err != nil {
+		decoder.fail(err)
+	}
+
+	return value[:n]
+}
@@ -0,0 +1,248 @@
+package client
+
+import (
+	"encoding/json"
+	"math/rand"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// an update as TDLib sends it
+var updateNewMessage = []byte(`{"@type":"updateNewMessage","message":{"@type":"message","id":1048576,"sender_id":{"@type":"messageSenderUser","user_id":123456789},"chat_id":-1001234567890,"is_outgoing":false,"is_pinned":false,"can_be_edited":false,"can_be_forwarded":true,"can_be_saved":true,"can_be_deleted_only_for_self":true,"can_be_deleted_for_all_users":true,"can_get_added_reactions":false,"can_get_statistics":false,"can_get_message_thread":true,"can_get_viewers":false,"can_get_media_timestamp_links":true,"can_report_reactions":false,"has_timestamped_media":true,"is_channel_post":false,"is_topic_message":false,"contains_unread_mention":false,"date":1690000000,"edit_date":0,"unread_reactions":[],"reply_in_chat_id":0,"reply_to_message_id":0,"message_thread_id":0,"self_destruct_time":0,"self_destruct_in":0.000000,"auto_delete_in":0.000000,"via_bot_user_id":0,"author_signature":"","media_album_id":"0","restriction_reason":"","content":{"@type":"messageText","text":{"@type":"formattedText","text":"Hello, world! See https://example.com for \"details\"","entities":[{"@type":"textEntity","offset":18,"length":19,"type":{"@type":"textEntityTypeUrl"}},{"@type":"textEntity","offset":0,"length":5,"type":{"@type":"textEntityTypeBold"}}]}}},"@client_id":1}`)
+
+func TestDecodeTypes(t *testing.T) {
+	types := schemaTypes(t)
+
+	filler := &filler{
+		rand:  rand.New(rand.NewSource(1)),
+		types: types,
+	}
+
+	for i := 0; i < 5; i++ {
+		for _, typ := range types {
+			value := reflect.New(reflect.TypeOf(typ).Elem())
+			if i > 0 {
+				filler.fill(value.Elem(), 0)
+			}
+
+			data, err := json.Marshal(value.Interface())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			expected := reflect.New(reflect.TypeOf(typ).Elem()).Interface()
+			err = json.Unmarshal(data, expected)
+			if err != nil {
+				t.Fatalf("encoding/json fails to unmarshal %s: %s", data, err)
+			}
+
+			decoded, err := UnmarshalType(data)
+			if err != nil {
+				t.Fatalf("decoder fails to unmarshal %s: %s", data, err)
+			}
+
+			// the generated UnmarshalJSON of types with class properties doesn't keep the meta, so the values are compared encoded
+			decodedData, err := json.Marshal(decoded)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			expectedData, err := json.Marshal(expected)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assertSameJSON(t, decodedData, expectedData)
+		}
+	}
+}
+
+func TestDecoderInvalidJSON(t *testing.T) {
+	invalid := []string{
+		``,
+		`[1,]`,
+		`[,1]`,
+		`[1 2]`,
+		`[1,,2]`,
+		`{"a":1,}`,
+		`{,"a":1}`,
+		`{"a":1 "b":2}`,
+		`{"a" 1}`,
+		`{"a":}`,
+		`[{} {}]`,
+		`["a" "b"]`,
+		`{"a":[1,2}`,
+		`[1] [2]`,
+		`"unterminated`,
+		`"bad \x escape"`,
+	}
+
+	for _, data := range invalid {
+		decoder := newDecoder([]byte(data))
+		decoder.skip()
+
+		if decoder.finish() == nil {
+			t.Errorf("%s is decoded", data)
+		}
+	}
+
+	valid := []string{
+		`[]`,
+		`[ ]`,
+		`{}`,
+		`{ }`,
+		`[1,2]`,
+		` [ 1 , 2 ] `,
+		"[\n\t1,\r\n2\n]",
+		`{"a":[{},{"b":null}],"c":"d,e"}`,
+		`{ "a" : 1 , "b" : [ "[" , "{" ] }`,
+	}
+
+	for _, data := range valid {
+		decoder := newDecoder([]byte(data))
+		decoder.skip()
+
+		err := decoder.finish()
+		if err != nil {
+			t.Errorf("%s isn't decoded: %s", data, err)
+		}
+	}
+
+	_, err := UnmarshalType([]byte(`{"@type":"textEntity","offset":1 "length":2}`))
+	if err == nil {
+		t.Error("object without a comma is decoded")
+	}
+
+	_, err = UnmarshalType([]byte(`{"@type":"formattedText","text":"","entities":[{"@type":"textEntity"},]}`))
+	if err == nil {
+		t.Error("list with a trailing comma is decoded")
+	}
+}
+
+func TestParseResponse(t *testing.T) {
+	response, err := parseResponse(updateNewMessage)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if response.Type != TypeUpdateNewMessage || response.ClientId != 1 {
+		t.Fatalf("unexpected meta %#v", response.meta)
+	}
+
+	object, err := UnmarshalType(response.Data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	update, ok := object.(*UpdateNewMessage)
+	if !ok || update.Message.Id != 1048576 {
+		t.Fatalf("unexpected object %#v", object)
+	}
+
+	// only the meta is read, the object failed to decode is passed to the request
+	response, err = parseResponse([]byte(`{"@type":"chat","@extra":"extra","id":"text","@client_id":2}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if response.Type != TypeChat || response.Extra != "extra" || response.ClientId != 2 {
+		t.Fatalf("unexpected meta %#v", response.meta)
+	}
+
+	_, err = UnmarshalChat(response.Data)
+	if err == nil {
+		t.Fatal("expected error of the object")
+	}
+
+	_, err = parseResponse([]byte(`{"@type":"chat",`))
+	if err == nil {
+		t.Fatal("invalid JSON is parsed")
+	}
+
+	// the result of the method returning Vector<message> is decoded like the generated function does
+	response, err = parseResponse([]byte(` [{"@type":"message","id":1},{"@type":"message","id":2}]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if response.Type != "" {
+		t.Fatalf("unexpected meta %#v", response.meta)
+	}
+
+	decoder := newDecoder(response.Data)
+	messages := decodeListOfMessage(decoder)
+
+	err = decoder.finish()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(messages) != 2 || messages[0].Id != 1 || messages[1].Id != 2 {
+		t.Fatalf("unexpected messages %#v", messages)
+	}
+
+	_, err = parseResponse([]byte(`[{"@type":"message"},]`))
+	if err == nil {
+		t.Fatal("invalid array is parsed")
+	}
+}
+
+func BenchmarkDecoder(b *testing.B) {
+	b.Run("encoding/json", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			var meta meta
+
+			err := json.Unmarshal(updateNewMessage, &meta)
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			var update UpdateNewMessage
+
+			err = json.Unmarshal(updateNewMessage, &update)
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("decoder", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, err := UnmarshalType(updateNewMessage)
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+// BenchmarkReceive measures the path of the update from TDLib instance to the listener
+func BenchmarkReceive(b *testing.B) {
+	client := &Client{
+		done:          make(chan struct{}),
+		listenerStore: newListenerStore(),
+		catchersStore: &sync.Map{},
+	}
+	defer close(client.done)
+
+	listener := client.GetListener(WithFilter(TypeFilter(TypeUpdateNewMessage)))
+	defer listener.Close()
+
+	go func() {
+		for range listener.Updates {
+		}
+	}()
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		response, err := parseResponse(updateNewMessage)
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		client.receive(response)
+	}
+}
@@ -0,0 +1,103 @@
+package client
+
+import (
+	"encoding/json"
+	"log"
+	"sync"
+)
+
+type DiagnosticKind int
+
+const (
+	// The update or response can't be unmarshaled
+	DiagnosticUnmarshalError DiagnosticKind = iota
+	// The response is received after the request is cancelled or timed out
+	DiagnosticLateResponse
+	// The update or response is received for the client which isn't registered anymore
+	DiagnosticUnknownClient
+)
+
+func (kind DiagnosticKind) String() string {
+	switch kind {
+	case DiagnosticUnmarshalError:
+		return "unmarshal error"
+
+	case DiagnosticLateResponse:
+		return "late response"
+
+	case DiagnosticUnknownClient:
+		return "unknown client"
+	}
+
+	return "unknown"
+}
+
+// Diagnostic describes an update or response which is dropped by the client
+type Diagnostic struct {
+	Kind     DiagnosticKind
+	Type     string
+	Extra    string
+	ClientId int
+	Err      error
+	// Raw JSON of the update or response
+	Data json.RawMessage
+}
+
+// DiagnosticsHandler is called from the receiving goroutine, so it must not block
+type DiagnosticsHandler func(diagnostic Diagnostic)
+
+var (
+	diagnosticsHandlerMu sync.Mutex
+	diagnosticsHandler   DiagnosticsHandler
+)
+
+// SetDiagnosticsHandler sets the handler of updates and responses which don't belong to any client:
+// the ones of unknown clients and the ones which can't be parsed at all. They are logged if the handler isn't set
+func SetDiagnosticsHandler(handler DiagnosticsHandler) {
+	diagnosticsHandlerMu.Lock()
+	defer diagnosticsHandlerMu.Unlock()
+
+	diagnosticsHandler = handler
+}
+
+// diagnose passes the diagnostic which doesn't belong to any client to the handler set by SetDiagnosticsHandler
+func diagnose(diagnostic Diagnostic) {
+	diagnosticsHandlerMu.Lock()
+	handler := diagnosticsHandler
+	diagnosticsHandlerMu.Unlock()
+
+	if handler == nil {
+		log.Printf("%s: %v", diagnostic.Kind, diagnostic.Err)
+		return
+	}
+
+	handler(diagnostic)
+}
+
+// WithDiagnosticsHandler sets the handler of dropped updates and responses of the client.
+// Updates and responses of unknown clients are passed to the handler set by SetDiagnosticsHandler
+func WithDiagnosticsHandler(handler DiagnosticsHandler) Option {
+	return func(client *Client) {
+		client.diagnosticsHandler = handler
+	}
+}
+
+// diagnose passes the diagnostic to the handler of the client if any
+func (client *Client) diagnose(diagnostic Diagnostic) {
+	if client.diagnosticsHandler == nil {
+		return
+	}
+
+	client.diagnosticsHandler(diagnostic)
+}
+
+func (client *Client) diagnoseUnmarshalError(response *Response, err error) {
+	client.diagnose(Diagnostic{
+		Kind:     DiagnosticUnmarshalError,
+		Type:     response.Type,
+		Extra:    response.Extra,
+		ClientId: response.ClientId,
+		Err:      err,
+		Data:     response.Data,
+	})
+}
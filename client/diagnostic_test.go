@@ -0,0 +1,121 @@
+package client_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/megaplan/go-tdlib/client"
+	"github.com/megaplan/go-tdlib/client/tdtest"
+)
+
+func TestDiagnosticsOfUnknownClient(t *testing.T) {
+	diagnostics := make(chan client.Diagnostic, 10)
+	clientDiagnostics := make(chan client.Diagnostic, 10)
+
+	client.SetDiagnosticsHandler(func(diagnostic client.Diagnostic) {
+		diagnostics <- diagnostic
+	})
+	t.Cleanup(func() {
+		client.SetDiagnosticsHandler(nil)
+	})
+
+	server := tdtest.NewServer()
+
+	tdlibClient, err := client.NewClient(
+		client.SessionAuthorizer(&client.TdlibParameters{}),
+		client.WithTransport(server),
+		client.WithDiagnosticsHandler(func(diagnostic client.Diagnostic) {
+			clientDiagnostics <- diagnostic
+		}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tdlibClient.Shutdown()
+
+	err = server.SendUpdate(100, &client.UpdateNewChat{Chat: &client.Chat{Id: 1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case diagnostic := <-diagnostics:
+		if diagnostic.Kind != client.DiagnosticUnknownClient || diagnostic.ClientId != 100 || diagnostic.Type != client.TypeUpdateNewChat {
+			t.Fatalf("unexpected diagnostic %+v", diagnostic)
+		}
+
+	case <-time.After(time.Second):
+		t.Fatal("diagnostic isn't received")
+	}
+
+	// the response is received after the update
+	_, err = tdlibClient.GetAuthorizationState()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(clientDiagnostics) != 0 {
+		t.Fatalf("the diagnostic of another client is passed to the client: %+v", <-clientDiagnostics)
+	}
+}
+
+// invalidUpdate is updateNewChat with the chat of a wrong type
+type invalidUpdate struct{}
+
+func (invalidUpdate) GetType() string {
+	return client.TypeUpdateNewChat
+}
+
+func (invalidUpdate) GetClass() string {
+	return client.ClassUpdate
+}
+
+func (invalidUpdate) MarshalJSON() ([]byte, error) {
+	return []byte(`{"@type":"updateNewChat","chat":"text"}`), nil
+}
+
+func TestDiagnosticsOfUnmarshalError(t *testing.T) {
+	diagnostics := make(chan client.Diagnostic, 10)
+
+	server := tdtest.NewServer()
+
+	tdlibClient, err := client.NewClient(
+		client.SessionAuthorizer(&client.TdlibParameters{}),
+		client.WithTransport(server),
+		client.WithDiagnosticsHandler(func(diagnostic client.Diagnostic) {
+			diagnostics <- diagnostic
+		}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tdlibClient.Shutdown()
+
+	// the error is reported without listeners of the update and once with them
+	for _, listeners := range []int{0, 2} {
+		for i := 0; i < listeners; i++ {
+			listener := tdlibClient.GetListener(client.WithFilter(client.TypeFilter(client.TypeUpdateNewChat)))
+			defer listener.Close()
+		}
+
+		err = server.SendUpdate(server.ClientIds()[0], invalidUpdate{})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// the response is received after the update
+		_, err = tdlibClient.GetAuthorizationState()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(diagnostics) != 1 {
+			t.Fatalf("%d listeners: expected 1 diagnostic, got %d", listeners, len(diagnostics))
+		}
+
+		diagnostic := <-diagnostics
+		if diagnostic.Kind != client.DiagnosticUnmarshalError || diagnostic.Type != client.TypeUpdateNewChat || diagnostic.Err == nil {
+			t.Fatalf("unexpected diagnostic %+v", diagnostic)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package client
+
+import (
+	"context"
+	"log"
+	"sync"
+)
+
+// Dispatcher routes updates to the handlers registered for their types.
+// Typed registration methods like OnNewMessage are generated for every Update subtype
+type Dispatcher struct {
+	mu           sync.RWMutex
+	handlers     map[string][]func(update Type)
+	concurrency  int
+	panicHandler func(update Type, recovered interface{})
+}
+
+type DispatcherOption func(*Dispatcher)
+
+// WithConcurrency sets the maximum number of updates handled simultaneously. Updates are handled in order if the concurrency is 1
+func WithConcurrency(concurrency int) DispatcherOption {
+	return func(dispatcher *Dispatcher) {
+		dispatcher.concurrency = concurrency
+	}
+}
+
+// WithPanicHandler sets the function called when a handler panics. The panic is logged by default
+func WithPanicHandler(panicHandler func(update Type, recovered interface{})) DispatcherOption {
+	return func(dispatcher *Dispatcher) {
+		dispatcher.panicHandler = panicHandler
+	}
+}
+
+func NewDispatcher(options ...DispatcherOption) *Dispatcher {
+	dispatcher := &Dispatcher{
+		handlers:    map[string][]func(update Type){},
+		concurrency: 1,
+		panicHandler: func(update Type, recovered interface{}) {
+			log.Printf("update %s handler panic: %v", update.GetType(), recovered)
+		},
+	}
+
+	for _, option := range options {
+		option(dispatcher)
+	}
+
+	if dispatcher.concurrency < 1 {
+		dispatcher.concurrency = 1
+	}
+
+	return dispatcher
+}
+
+// Handle registers the handler of the updates of the type, for example TypeUpdateNewMessage
+func (dispatcher *Dispatcher) Handle(typ string, handler func(update Type)) {
+	dispatcher.mu.Lock()
+	defer dispatcher.mu.Unlock()
+
+	dispatcher.handlers[typ] = append(dispatcher.handlers[typ], handler)
+}
+
+// Dispatch calls the handlers of the update in the current goroutine
+func (dispatcher *Dispatcher) Dispatch(update Type) {
+	dispatcher.mu.RLock()
+	handlers := dispatcher.handlers[update.GetType()]
+	dispatcher.mu.RUnlock()
+
+	for _, handler := range handlers {
+		dispatcher.call(handler, update)
+	}
+}
+
+// Filter accepts the updates having handlers, so the listener doesn't receive other updates
+func (dispatcher *Dispatcher) Filter() ListenerFilter {
+	return func(typ string) bool {
+		dispatcher.mu.RLock()
+		defer dispatcher.mu.RUnlock()
+
+		return len(dispatcher.handlers[typ]) > 0
+	}
+}
+
+func (dispatcher *Dispatcher) call(handler func(update Type), update Type) {
+	defer func() {
+		recovered := recover()
+		if recovered != nil {
+			dispatcher.panicHandler(update, recovered)
+		}
+	}()
+
+	handler(update)
+}
+
+// Run dispatches the updates of the listener until it is closed or ctx is done.
+// Returns after all started handlers are finished
+func (dispatcher *Dispatcher) Run(ctx context.Context, listener *Listener) error {
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	semaphore := make(chan struct{}, dispatcher.concurrency)
+
+	for {
+		select {
+		case update, ok := <-listener.Updates:
+			if !ok {
+				return nil
+			}
+
+			if dispatcher.concurrency == 1 {
+				dispatcher.Dispatch(update)
+				continue
+			}
+
+			select {
+			case semaphore <- struct{}{}:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+
+			wg.Add(1)
+			go func() {
+				defer func() {
+					<-semaphore
+					wg.Done()
+				}()
+
+				dispatcher.Dispatch(update)
+			}()
+
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
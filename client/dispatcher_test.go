@@ -0,0 +1,171 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/megaplan/go-tdlib/client"
+	"github.com/megaplan/go-tdlib/client/tdtest"
+)
+
+// runDispatcher runs the dispatcher in the background and returns the channel of the result of Run
+func runDispatcher(ctx context.Context, dispatcher *client.Dispatcher, listener *client.Listener) chan error {
+	done := make(chan error, 1)
+	go func() {
+		done <- dispatcher.Run(ctx, listener)
+	}()
+
+	return done
+}
+
+func TestDispatcherRouting(t *testing.T) {
+	server := tdtest.NewServer()
+	tdlibClient := newTestClient(t, server)
+
+	chats := make(chan int64, 10)
+	titles := make(chan string, 10)
+
+	dispatcher := client.NewDispatcher()
+	dispatcher.OnNewChat(func(update *client.UpdateNewChat) {
+		chats <- update.Chat.Id
+	})
+	dispatcher.OnChatTitle(func(update *client.UpdateChatTitle) {
+		titles <- update.Title
+	})
+
+	if dispatcher.Filter()(client.TypeUpdateChatPosition) {
+		t.Fatal("the filter accepts the update without handlers")
+	}
+
+	listener := tdlibClient.GetListener(client.WithFilter(dispatcher.Filter()))
+	done := runDispatcher(context.Background(), dispatcher, listener)
+
+	clientId := server.ClientIds()[0]
+	updates := []client.Type{
+		&client.UpdateChatPosition{ChatId: 3},
+		&client.UpdateNewChat{Chat: &client.Chat{Id: 1}},
+		&client.UpdateChatTitle{ChatId: 1, Title: "title"},
+		&client.UpdateNewChat{Chat: &client.Chat{Id: 2}},
+	}
+	for _, update := range updates {
+		err := server.SendUpdate(clientId, update)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, expected := range []int64{1, 2} {
+		select {
+		case chatId := <-chats:
+			if chatId != expected {
+				t.Fatalf("expected chat %d, got %d", expected, chatId)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("the update isn't handled")
+		}
+	}
+
+	select {
+	case title := <-titles:
+		if title != "title" {
+			t.Fatalf("unexpected title %q", title)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("the update isn't handled")
+	}
+
+	// Run returns when the listener is closed
+	listener.Close()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("the dispatcher isn't stopped")
+	}
+}
+
+func TestDispatcherPanic(t *testing.T) {
+	panics := make(chan interface{}, 1)
+	dispatcher := client.NewDispatcher(client.WithPanicHandler(func(update client.Type, recovered interface{}) {
+		panics <- recovered
+	}))
+
+	handled := []int64{}
+	dispatcher.OnNewChat(func(update *client.UpdateNewChat) {
+		if update.Chat.Id == 1 {
+			panic("handler panic")
+		}
+		handled = append(handled, update.Chat.Id)
+	})
+	// the panic doesn't prevent other handlers of the update
+	dispatcher.OnNewChat(func(update *client.UpdateNewChat) {
+		handled = append(handled, -update.Chat.Id)
+	})
+
+	dispatcher.Dispatch(&client.UpdateNewChat{Chat: &client.Chat{Id: 1}})
+	dispatcher.Dispatch(&client.UpdateNewChat{Chat: &client.Chat{Id: 2}})
+
+	if recovered := <-panics; recovered != "handler panic" {
+		t.Fatalf("unexpected panic %v", recovered)
+	}
+
+	if !equalChatIds(handled, -1, 2, -2) {
+		t.Fatalf("unexpected handled updates %v", handled)
+	}
+}
+
+func TestDispatcherConcurrency(t *testing.T) {
+	server := tdtest.NewServer()
+	tdlibClient := newTestClient(t, server)
+
+	started := make(chan int64, 2)
+	release := make(chan struct{})
+
+	dispatcher := client.NewDispatcher(client.WithConcurrency(2))
+	dispatcher.OnNewChat(func(update *client.UpdateNewChat) {
+		started <- update.Chat.Id
+		<-release
+	})
+
+	listener := tdlibClient.GetListener(client.WithFilter(dispatcher.Filter()))
+	defer listener.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := runDispatcher(ctx, dispatcher, listener)
+
+	sendUpdates(t, server, tdlibClient, 1, 2)
+
+	// both handlers are running at the same time
+	for i := 0; i < 2; i++ {
+		select {
+		case <-started:
+		case <-time.After(time.Second):
+			t.Fatal("the updates aren't handled concurrently")
+		}
+	}
+
+	cancel()
+
+	// Run waits for the started handlers
+	select {
+	case <-done:
+		t.Fatal("the dispatcher is stopped before the handlers")
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	close(release)
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected context.Canceled, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("the dispatcher isn't stopped")
+	}
+}
@@ -0,0 +1,390 @@
+package client
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"sync"
+	"unicode/utf8"
+)
+
+// requestData is implemented by generated requests, so they are encoded without reflection
+type requestData interface {
+	encode(encoder *encoder)
+}
+
+var encoderPool = sync.Pool{
+	New: func() interface{} {
+		return &encoder{
+			buf: make([]byte, 0, 1024),
+		}
+	},
+}
+
+// encoder writes a JSON object field by field
+type encoder struct {
+	buf []byte
+	err error
+}
+
+// encodeRequest returns JSON of the request parameters with @type and @extra
+func encodeRequest(typ string, extra string, data interface{}) ([]byte, error) {
+	encoder := encoderPool.Get().(*encoder)
+	defer encoderPool.Put(encoder)
+
+	encoder.buf = append(encoder.buf[:0], '{')
+	encoder.err = nil
+
+	encoder.String("@type", typ)
+	encoder.String("@extra", extra)
+
+	switch data := data.(type) {
+	case nil:
+
+	case requestData:
+		data.encode(encoder)
+
+	case map[string]interface{}:
+		encodeMembers(encoder, data)
+
+	default:
+		return nil, fmt.Errorf("unsupported request data: %T", data)
+	}
+
+	if encoder.err != nil {
+		return nil, encoder.err
+	}
+
+	encoder.buf = append(encoder.buf, '}')
+
+	return append([]byte(nil), encoder.buf...), nil
+}
+
+// fail keeps the first error
+func (encoder *encoder) fail(err error) {
+	if encoder.err == nil {
+		encoder.err = err
+	}
+}
+
+// name writes the name of the next object member. Members are separated unless the name is the first one in the object
+func (encoder *encoder) name(name string) {
+	if encoder.buf[len(encoder.buf)-1] != '{' {
+		encoder.buf = append(encoder.buf, ',')
+	}
+
+	encoder.buf = appendString(encoder.buf, name)
+	encoder.buf = append(encoder.buf, ':')
+}
+
+func (encoder *encoder) null() {
+	encoder.buf = append(encoder.buf, "null"...)
+}
+
+func (encoder *encoder) beginObject() {
+	encoder.buf = append(encoder.buf, '{')
+}
+
+func (encoder *encoder) endObject() {
+	encoder.buf = append(encoder.buf, '}')
+}
+
+func (encoder *encoder) beginArray() {
+	encoder.buf = append(encoder.buf, '[')
+}
+
+func (encoder *encoder) endArray() {
+	encoder.buf = append(encoder.buf, ']')
+}
+
+// comma separates array elements
+func (encoder *encoder) comma() {
+	encoder.buf = append(encoder.buf, ',')
+}
+
+// meta writes the meta of the object like encoding/json does. @type is always the type of the object
+func (encoder *encoder) meta(typ string, meta meta) {
+	encoder.String("@type", typ)
+	encoder.String("@extra", meta.Extra)
+	encoder.name("@client_id")
+	encoder.buf = strconv.AppendInt(encoder.buf, int64(meta.ClientId), 10)
+}
+
+// marshaler writes the value implementing json.Marshaler, for example a type missing in the schema
+func (encoder *encoder) marshaler(value interface{}) {
+	marshaler, ok := value.(json.Marshaler)
+	if !ok {
+		encoder.fail(fmt.Errorf("unsupported value: %T", value))
+		encoder.null()
+		return
+	}
+
+	data, err := marshaler.MarshalJSON()
+	if err != nil {
+		encoder.fail(err)
+		encoder.null()
+		return
+	}
+
+	encoder.buf = append(encoder.buf, data...)
+}
+
+func (encoder *encoder) String(name string, value string) {
+	encoder.name(name)
+	encodeString(encoder, value)
+}
+
+func (encoder *encoder) Bool(name string, value bool) {
+	encoder.name(name)
+	encodeBool(encoder, value)
+}
+
+func (encoder *encoder) Int32(name string, value int32) {
+	encoder.name(name)
+	encodeInt32(encoder, value)
+}
+
+func (encoder *encoder) Int64(name string, value int64) {
+	encoder.name(name)
+	encodeInt64(encoder, value)
+}
+
+func (encoder *encoder) JsonInt64(name string, value JsonInt64) {
+	encoder.name(name)
+	encodeJsonInt64(encoder, value)
+}
+
+func (encoder *encoder) Float64(name string, value float64) {
+	encoder.name(name)
+	encodeFloat64(encoder, value)
+}
+
+// Bytes are encoded as a base64 string like encoding/json does
+func (encoder *encoder) Bytes(name string, value []byte) {
+	encoder.name(name)
+	encodeBytes(encoder, value)
+}
+
+func (encoder *encoder) Int32s(name string, values []int32) {
+	encoder.name(name)
+	encodeListOfInt32(encoder, values)
+}
+
+func (encoder *encoder) Int64s(name string, values []int64) {
+	encoder.name(name)
+	encodeListOfInt64(encoder, values)
+}
+
+func (encoder *encoder) Strings(name string, values []string) {
+	encoder.name(name)
+	encodeListOfString(encoder, values)
+}
+
+// Value writes a member of the request parameters passed as a map
+func (encoder *encoder) Value(name string, value interface{}) {
+	encoder.name(name)
+	encodeValue(encoder, value)
+}
+
+func encodeMembers(encoder *encoder, members map[string]interface{}) {
+	for name, value := range members {
+		encoder.Value(name, value)
+	}
+}
+
+// encodeValue writes generated types, JSON values built of maps and slices and the values of Go types used by generated types
+func encodeValue(encoder *encoder, value interface{}) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case string:
+		encodeString(encoder, value)
+
+	case bool:
+		encodeBool(encoder, value)
+
+	case int:
+		encodeInt64(encoder, int64(value))
+
+	case int32:
+		encodeInt32(encoder, value)
+
+	case int64:
+		encodeInt64(encoder, value)
+
+	case JsonInt64:
+		encodeJsonInt64(encoder, value)
+
+	case float64:
+		encodeFloat64(encoder, value)
+
+	case []byte:
+		encodeBytes(encoder, value)
+
+	case []int32:
+		encodeListOfInt32(encoder, value)
+
+	case []int64:
+		encodeListOfInt64(encoder, value)
+
+	case []string:
+		encodeListOfString(encoder, value)
+
+	case []interface{}:
+		if value == nil {
+			encoder.null()
+			return
+		}
+
+		encoder.beginArray()
+		for i, element := range value {
+			if i > 0 {
+				encoder.comma()
+			}
+			encodeValue(encoder, element)
+		}
+		encoder.endArray()
+
+	case map[string]interface{}:
+		if value == nil {
+			encoder.null()
+			return
+		}
+
+		encoder.beginObject()
+		encodeMembers(encoder, value)
+		encoder.endObject()
+
+	case Type:
+		encodeType(encoder, value)
+
+	default:
+		encoder.marshaler(value)
+	}
+}
+
+func encodeString(encoder *encoder, value string) {
+	encoder.buf = appendString(encoder.buf, value)
+}
+
+func encodeBool(encoder *encoder, value bool) {
+	encoder.buf = strconv.AppendBool(encoder.buf, value)
+}
+
+func encodeInt32(encoder *encoder, value int32) {
+	encoder.buf = strconv.AppendInt(encoder.buf, int64(value), 10)
+}
+
+func encodeInt64(encoder *encoder, value int64) {
+	encoder.buf = strconv.AppendInt(encoder.buf, value, 10)
+}
+
+// JsonInt64 is encoded as a string, see JsonInt64.MarshalJSON
+func encodeJsonInt64(encoder *encoder, value JsonInt64) {
+	encoder.buf = append(encoder.buf, '"')
+	encoder.buf = strconv.AppendInt(encoder.buf, int64(value), 10)
+	encoder.buf = append(encoder.buf, '"')
+}
+
+func encodeFloat64(encoder *encoder, value float64) {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		encoder.fail(fmt.Errorf("unsupported value: %v", value))
+		encoder.null()
+		return
+	}
+
+	// the format of encoding/json
+	format := byte('f')
+	abs := math.Abs(value)
+	if abs != 0 && (abs < 1e-6 || abs >= 1e21) {
+		format = 'e'
+	}
+
+	encoder.buf = strconv.AppendFloat(encoder.buf, value, format, -1, 64)
+
+	if format == 'e' {
+		// clean up e-09 to e-9
+		n := len(encoder.buf)
+		if n >= 4 && encoder.buf[n-4] == 'e' && encoder.buf[n-3] == '-' && encoder.buf[n-2] == '0' {
+			encoder.buf[n-2] = encoder.buf[n-1]
+			encoder.buf = encoder.buf[:n-1]
+		}
+	}
+}
+
+// Bytes are encoded as a base64 string like encoding/json does
+func encodeBytes(encoder *encoder, value []byte) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.buf = append(encoder.buf, '"')
+	start := len(encoder.buf)
+	// the compiler doesn't allocate the appended slice
+	encoder.buf = append(encoder.buf, make([]byte, base64.StdEncoding.EncodedLen(len(value)))...)
+	base64.StdEncoding.Encode(encoder.buf[start:], value)
+	encoder.buf = append(encoder.buf, '"')
+}
+
+const hex = "0123456789abcdef"
+
+// appendString appends JSON string escaped like encoding/json does without HTML escaping
+func appendString(buf []byte, value string) []byte {
+	buf = append(buf, '"')
+
+	start := 0
+	for i := 0; i < len(value); {
+		b := value[i]
+		if b < utf8.RuneSelf {
+			if b >= 0x20 && b != '"' && b != '\\' {
+				i++
+				continue
+			}
+
+			buf = append(buf, value[start:i]...)
+			switch b {
+			case '"', '\\':
+				buf = append(buf, '\\', b)
+			case '\n':
+				buf = append(buf, '\\', 'n')
+			case '\r':
+				buf = append(buf, '\\', 'r')
+			case '\t':
+				buf = append(buf, '\\', 't')
+			default:
+				buf = append(buf, '\\', 'u', '0', '0', hex[b>>4], hex[b&0xf])
+			}
+			i++
+			start = i
+			continue
+		}
+
+		r, size := utf8.DecodeRuneInString(value[i:])
+		if r == utf8.RuneError && size == 1 {
+			buf = append(buf, value[start:i]...)
+			buf = append(buf, `\ufffd`...)
+			i += size
+			start = i
+			continue
+		}
+
+		// U+2028 and U+2029 are escaped for JSONP like encoding/json does
+		if r == '\u2028' || r == '\u2029' {
+			buf = append(buf, value[start:i]...)
+			buf = append(buf, '\\', 'u', '2', '0', '2', hex[r&0xf])
+			i += size
+			start = i
+			continue
+		}
+
+		i += size
+	}
+
+	buf = append(buf, value[start:]...)
+
+	return append(buf, '"')
+}
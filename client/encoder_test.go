@@ -0,0 +1,267 @@
+package client
+
+import (
+	"encoding/json"
+	"math"
+	"math/rand"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/megaplan/go-tdlib/tlparser"
+)
+
+// schemaTypes returns an empty object of every type of the schema
+func schemaTypes(t testing.TB) []Type {
+	file, err := os.Open("../data/td_api.tl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	schema, err := tlparser.Parse(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	types := []Type{}
+	for _, typ := range schema.Types {
+		value, err := UnmarshalType(json.RawMessage(`{"@type":"` + typ.Name + `"}`))
+		if err != nil {
+			continue
+		}
+
+		_, ok := value.(*UnknownType)
+		if !ok {
+			types = append(types, value)
+		}
+	}
+
+	return types
+}
+
+// filler sets random values to all fields of generated types
+type filler struct {
+	rand  *rand.Rand
+	types []Type
+}
+
+func (filler *filler) fill(value reflect.Value, depth int) {
+	switch value.Kind() {
+	case reflect.String:
+		strings := []string{"", "text", "quote \" backslash \\ <html> & \n\t  юникод 🙂", "\x01\x1f", "invalid \xff utf-8"}
+		value.SetString(strings[filler.rand.Intn(len(strings))])
+
+	case reflect.Bool:
+		value.SetBool(filler.rand.Intn(2) == 1)
+
+	case reflect.Int32:
+		value.SetInt(int64(filler.rand.Int31()) - math.MaxInt32/2)
+
+	case reflect.Int64:
+		value.SetInt(filler.rand.Int63() - math.MaxInt64/2)
+
+	case reflect.Float64:
+		floats := []float64{0, 1.5, -0.000001, 1e-7, 1e21, 123456789.123, math.MaxFloat64}
+		value.SetFloat(floats[filler.rand.Intn(len(floats))])
+
+	case reflect.Slice:
+		if filler.rand.Intn(4) == 0 || depth > 4 {
+			return
+		}
+
+		n := filler.rand.Intn(3)
+		value.Set(reflect.MakeSlice(value.Type(), n, n))
+		for i := 0; i < n; i++ {
+			// null elements fail lists of classes in encoding/json, so they aren't generated
+			if value.Type().Elem().Kind() == reflect.Interface {
+				filler.implement(value.Index(i), depth+1)
+			} else {
+				filler.fill(value.Index(i), depth+1)
+			}
+		}
+
+	case reflect.Ptr:
+		if filler.rand.Intn(4) == 0 || depth > 4 {
+			return
+		}
+
+		value.Set(reflect.New(value.Type().Elem()))
+		filler.fill(value.Elem(), depth+1)
+
+	case reflect.Interface:
+		if filler.rand.Intn(4) == 0 || depth > 4 {
+			return
+		}
+
+		filler.implement(value, depth)
+
+	case reflect.Struct:
+		for i := 0; i < value.NumField(); i++ {
+			if value.Type().Field(i).IsExported() {
+				filler.fill(value.Field(i), depth)
+			}
+		}
+	}
+}
+
+// implement sets a random type implementing the interface
+func (filler *filler) implement(value reflect.Value, depth int) {
+	implementations := []reflect.Type{}
+	for _, typ := range filler.types {
+		if reflect.TypeOf(typ).Implements(value.Type()) {
+			implementations = append(implementations, reflect.TypeOf(typ))
+		}
+	}
+
+	implementation := reflect.New(implementations[filler.rand.Intn(len(implementations))].Elem())
+	filler.fill(implementation.Elem(), depth+1)
+	value.Set(implementation)
+}
+
+// assertSameJSON compares values of JSON documents, so the escaping and the order of members don't matter
+func assertSameJSON(t *testing.T, data []byte, expected []byte) {
+	t.Helper()
+
+	var value, expectedValue interface{}
+
+	err := json.Unmarshal(data, &value)
+	if err != nil {
+		t.Fatalf("invalid JSON %s: %s", data, err)
+	}
+
+	err = json.Unmarshal(expected, &expectedValue)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(value, expectedValue) {
+		t.Fatalf("unexpected JSON\n%s\nexpected\n%s", data, expected)
+	}
+}
+
+func encodeTypeJSON(t *testing.T, value Type) []byte {
+	t.Helper()
+
+	encoder := &encoder{}
+	encoder.beginObject()
+	encoder.name("value")
+	encodeType(encoder, value)
+	encoder.endObject()
+
+	if encoder.err != nil {
+		t.Fatal(encoder.err)
+	}
+
+	return encoder.buf
+}
+
+func TestEncodeTypes(t *testing.T) {
+	types := schemaTypes(t)
+	if len(types) < 1000 {
+		t.Fatalf("only %d types are found", len(types))
+	}
+
+	filler := &filler{
+		rand:  rand.New(rand.NewSource(1)),
+		types: types,
+	}
+
+	for i := 0; i < 5; i++ {
+		for _, typ := range types {
+			value := reflect.New(reflect.TypeOf(typ).Elem())
+			if i > 0 {
+				filler.fill(value.Elem(), 0)
+			}
+
+			expected, err := json.Marshal(map[string]interface{}{"value": value.Interface()})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assertSameJSON(t, encodeTypeJSON(t, value.Interface().(Type)), expected)
+		}
+	}
+}
+
+func TestEncodeRequest(t *testing.T) {
+	req := &SendMessageRequest{
+		ChatId: -1001234567890,
+		ReplyMarkup: &ReplyMarkupInlineKeyboard{
+			Rows: [][]*InlineKeyboardButton{
+				{
+					{Text: "<b>", Type: &InlineKeyboardButtonTypeCallback{Data: []byte{0, 1, 2}}},
+					nil,
+				},
+				{},
+			},
+		},
+		InputMessageContent: &InputMessageText{
+			Text: &FormattedText{
+				Text: "Hello, world!",
+				Entities: []*TextEntity{
+					{Offset: 0, Length: 5, Type: &TextEntityTypeBold{}},
+				},
+			},
+		},
+	}
+
+	data, err := encodeRequest("sendMessage", "extra", req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected, err := json.Marshal(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var members map[string]interface{}
+	json.Unmarshal(expected, &members)
+	members["@type"] = "sendMessage"
+	members["@extra"] = "extra"
+	expected, _ = json.Marshal(members)
+
+	assertSameJSON(t, data, expected)
+}
+
+func TestEncodeRequestMap(t *testing.T) {
+	data, err := encodeRequest("setOption", "extra", map[string]interface{}{
+		"name":  "online",
+		"value": &OptionValueBoolean{Value: true},
+		"list":  []interface{}{1, "two", JsonInt64(3), nil},
+		"map":   map[string]interface{}{"ids": []int64{1, 2}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertSameJSON(t, data, []byte(`{"@type":"setOption","@extra":"extra","name":"online","value":{"@type":"optionValueBoolean","@extra":"","@client_id":0,"value":true},"list":[1,"two","3",null],"map":{"ids":[1,2]}}`))
+
+	_, err = encodeRequest("setOption", "extra", map[string]interface{}{
+		"value": struct{}{},
+	})
+	if err == nil {
+		t.Fatal("expected error for unsupported value")
+	}
+
+	_, err = encodeRequest("setOption", "extra", map[string]interface{}{
+		"value": math.NaN(),
+	})
+	if err == nil {
+		t.Fatal("expected error for NaN")
+	}
+}
+
+func TestEncodeUnknownType(t *testing.T) {
+	raw := json.RawMessage(`{"@type":"inputMessageFuture","value":1}`)
+
+	data, err := encodeRequest("sendMessage", "extra", &SendMessageRequest{
+		InputMessageContent: &UnknownInputMessageContent{meta: meta{Type: "inputMessageFuture"}, Raw: raw},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertSameJSON(t, data, []byte(`{"@type":"sendMessage","@extra":"extra","chat_id":0,"message_thread_id":0,"reply_to_message_id":0,"options":null,"reply_markup":null,"input_message_content":{"@type":"inputMessageFuture","value":1}}`))
+}
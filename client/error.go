@@ -0,0 +1,140 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrBadRequest      = errors.New("bad request")
+	ErrUnauthorized    = errors.New("unauthorized")
+	ErrForbidden       = errors.New("forbidden")
+	ErrNotFound        = errors.New("not found")
+	ErrNotAcceptable   = errors.New("not acceptable")
+	ErrFlood           = errors.New("flood")
+	ErrTooManyRequests = errors.New("too many requests")
+	ErrInternal        = errors.New("internal server error")
+	// Matches any error with code 420 or 429. FloodWaitError is returned if the duration to wait is known
+	ErrFloodWait = errors.New("flood wait")
+	// Returned by visit functions for the types missing in the schema
+	ErrUnknownType = errors.New("unknown type")
+)
+
+var errorsByCode = map[int32]error{
+	400: ErrBadRequest,
+	401: ErrUnauthorized,
+	403: ErrForbidden,
+	404: ErrNotFound,
+	406: ErrNotAcceptable,
+	420: ErrFlood,
+	429: ErrTooManyRequests,
+	500: ErrInternal,
+}
+
+var retryAfterPrefixes = []string{
+	"Too Many Requests: retry after ",
+	"FLOOD_WAIT_",
+}
+
+type ResponseError struct {
+	Err *Error
+}
+
+func (responseError ResponseError) Error() string {
+	return fmt.Sprintf("%d %s", responseError.Err.Code, responseError.Err.Message)
+}
+
+// Is matches the sentinel error of the error code. Codes 420 and 429 match ErrFloodWait too
+func (responseError ResponseError) Is(target error) bool {
+	if target == ErrFloodWait {
+		return responseError.Err.Code == 420 || responseError.Err.Code == 429
+	}
+
+	err, ok := errorsByCode[responseError.Err.Code]
+
+	return ok && err == target
+}
+
+// FloodWaitError is returned when the request must be repeated not earlier than RetryAfter
+type FloodWaitError struct {
+	ResponseError
+	RetryAfter time.Duration
+}
+
+func (floodWaitError FloodWaitError) Unwrap() error {
+	return floodWaitError.ResponseError
+}
+
+func buildResponseError(data json.RawMessage) error {
+	respErr, err := UnmarshalError(data)
+	if err != nil {
+		return err
+	}
+
+	responseError := ResponseError{
+		Err: respErr,
+	}
+
+	if respErr.Code == 420 || respErr.Code == 429 {
+		retryAfter, ok := parseRetryAfter(respErr.Message)
+		if ok {
+			return FloodWaitError{
+				ResponseError: responseError,
+				RetryAfter:    retryAfter,
+			}
+		}
+	}
+
+	return responseError
+}
+
+func parseRetryAfter(message string) (time.Duration, bool) {
+	for _, prefix := range retryAfterPrefixes {
+		if !strings.HasPrefix(message, prefix) {
+			continue
+		}
+
+		seconds, err := strconv.Atoi(strings.TrimPrefix(message, prefix))
+		if err != nil {
+			return 0, false
+		}
+
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	return 0, false
+}
+
+func IsBadRequest(err error) bool {
+	return errors.Is(err, ErrBadRequest)
+}
+
+func IsUnauthorized(err error) bool {
+	return errors.Is(err, ErrUnauthorized)
+}
+
+func IsForbidden(err error) bool {
+	return errors.Is(err, ErrForbidden)
+}
+
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+func IsFloodWait(err error) bool {
+	return errors.Is(err, ErrFloodWait)
+}
+
+// RetryAfter returns the duration requested by the server in FloodWaitError
+func RetryAfter(err error) (time.Duration, bool) {
+	var floodWaitError FloodWaitError
+	if !errors.As(err, &floodWaitError) {
+		return 0, false
+	}
+
+	return floodWaitError.RetryAfter, true
+}
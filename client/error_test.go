@@ -0,0 +1,86 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestBuildResponseError(t *testing.T) {
+	tests := []struct {
+		data       string
+		target     error
+		retryAfter time.Duration
+		floodWait  bool
+	}{
+		{`{"@type":"error","code":400,"message":"CHAT_ID_INVALID"}`, ErrBadRequest, 0, false},
+		{`{"@type":"error","code":404,"message":"Not Found"}`, ErrNotFound, 0, false},
+		{`{"@type":"error","code":429,"message":"Too Many Requests: retry after 15"}`, ErrTooManyRequests, 15 * time.Second, true},
+		{`{"@type":"error","code":420,"message":"FLOOD_WAIT_7"}`, ErrFlood, 7 * time.Second, true},
+		{`{"@type":"error","code":420,"message":"FLOOD_WAIT_X"}`, ErrFlood, 0, true},
+		{`{"@type":"error","code":429,"message":"Too Many Requests"}`, ErrTooManyRequests, 0, true},
+	}
+
+	for _, test := range tests {
+		err := buildResponseError([]byte(test.data))
+
+		if !errors.Is(err, test.target) {
+			t.Errorf("%s: expected %v, got %v", test.data, test.target, err)
+		}
+
+		if IsFloodWait(err) != test.floodWait {
+			t.Errorf("%s: expected IsFloodWait %t", test.data, test.floodWait)
+		}
+
+		retryAfter, ok := RetryAfter(err)
+		if ok != (test.retryAfter > 0) || retryAfter != test.retryAfter {
+			t.Errorf("%s: expected retry after %s, got %s", test.data, test.retryAfter, retryAfter)
+		}
+	}
+}
+
+func TestFloodWaitIsRetryableAuthorizationError(t *testing.T) {
+	for _, data := range []string{
+		`{"@type":"error","code":420,"message":"FLOOD_WAIT_30"}`,
+		`{"@type":"error","code":429,"message":"Too Many Requests"}`,
+	} {
+		err := authorizationError(buildResponseError([]byte(data)))
+		if !IsRetryableAuthorizationError(err) {
+			t.Errorf("%s: expected retryable error", data)
+		}
+	}
+
+	err := authorizationError(buildResponseError([]byte(`{"@type":"error","code":400,"message":"PHONE_CODE_INVALID"}`)))
+	if !errors.Is(err, ErrInvalidCode) || !IsRetryableAuthorizationError(err) {
+		t.Errorf("expected ErrInvalidCode, got %v", err)
+	}
+
+	err = authorizationError(buildResponseError([]byte(`{"@type":"error","code":401,"message":"AUTH_KEY_UNREGISTERED"}`)))
+	if IsRetryableAuthorizationError(err) {
+		t.Errorf("unexpected retryable error %v", err)
+	}
+}
+
+func TestAuthorizationError(t *testing.T) {
+	tests := []struct {
+		err    error
+		target error
+	}{
+		{ResponseError{Err: &Error{Code: 400, Message: "PHONE_CODE_INVALID"}}, ErrInvalidCode},
+		{fmt.Errorf("check code: %w", ResponseError{Err: &Error{Code: 400, Message: "PASSWORD_HASH_INVALID"}}), ErrInvalidPassword},
+		{fmt.Errorf("set token: %w", ResponseError{Err: &Error{Code: 401, Message: "ACCESS_TOKEN_INVALID"}}), ErrInvalidToken},
+	}
+
+	for _, test := range tests {
+		err := authorizationError(test.err)
+
+		if !errors.Is(err, test.target) {
+			t.Errorf("%v: expected %v, got %v", test.err, test.target, err)
+		}
+
+		if !errors.Is(err, ErrBadRequest) && !errors.Is(err, ErrUnauthorized) {
+			t.Errorf("%v: response error isn't kept in %v", test.err, err)
+		}
+	}
+}
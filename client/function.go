@@ -3,16 +3,20 @@
 package client
 
 import (
-	"errors"
+	"context"
 )
 
 // Returns the current authorization state; this is an offline request. For informational purposes only. Use updateAuthorizationState instead to maintain the current authorization state. Can be called before initialization
 func (client *Client) GetAuthorizationState() (AuthorizationState, error) {
-	result, err := client.Send(Request{
+	return client.GetAuthorizationStateContext(context.Background())
+}
+
+// Returns the current authorization state; this is an offline request. For informational purposes only. Use updateAuthorizationState instead to maintain the current authorization state. Can be called before initialization
+func (client *Client) GetAuthorizationStateContext(ctx context.Context) (AuthorizationState, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getAuthorizationState",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -22,46 +26,7 @@ func (client *Client) GetAuthorizationState() (AuthorizationState, error) {
 		return nil, buildResponseError(result.Data)
 	}
 
-	switch result.Type {
-	case TypeAuthorizationStateWaitTdlibParameters:
-		return UnmarshalAuthorizationStateWaitTdlibParameters(result.Data)
-
-	case TypeAuthorizationStateWaitPhoneNumber:
-		return UnmarshalAuthorizationStateWaitPhoneNumber(result.Data)
-
-	case TypeAuthorizationStateWaitEmailAddress:
-		return UnmarshalAuthorizationStateWaitEmailAddress(result.Data)
-
-	case TypeAuthorizationStateWaitEmailCode:
-		return UnmarshalAuthorizationStateWaitEmailCode(result.Data)
-
-	case TypeAuthorizationStateWaitCode:
-		return UnmarshalAuthorizationStateWaitCode(result.Data)
-
-	case TypeAuthorizationStateWaitOtherDeviceConfirmation:
-		return UnmarshalAuthorizationStateWaitOtherDeviceConfirmation(result.Data)
-
-	case TypeAuthorizationStateWaitRegistration:
-		return UnmarshalAuthorizationStateWaitRegistration(result.Data)
-
-	case TypeAuthorizationStateWaitPassword:
-		return UnmarshalAuthorizationStateWaitPassword(result.Data)
-
-	case TypeAuthorizationStateReady:
-		return UnmarshalAuthorizationStateReady(result.Data)
-
-	case TypeAuthorizationStateLoggingOut:
-		return UnmarshalAuthorizationStateLoggingOut(result.Data)
-
-	case TypeAuthorizationStateClosing:
-		return UnmarshalAuthorizationStateClosing(result.Data)
-
-	case TypeAuthorizationStateClosed:
-		return UnmarshalAuthorizationStateClosed(result.Data)
-
-	default:
-		return nil, errors.New("invalid type")
-	}
+	return UnmarshalAuthorizationState(result.Data)
 }
 
 type SetTdlibParametersRequest struct {
@@ -99,30 +64,37 @@ type SetTdlibParametersRequest struct {
 	IgnoreFileNames bool `json:"ignore_file_names"`
 }
 
+func (req *SetTdlibParametersRequest) encode(encoder *encoder) {
+	encoder.Bool("use_test_dc", req.UseTestDc)
+	encoder.String("database_directory", req.DatabaseDirectory)
+	encoder.String("files_directory", req.FilesDirectory)
+	encoder.Bytes("database_encryption_key", req.DatabaseEncryptionKey)
+	encoder.Bool("use_file_database", req.UseFileDatabase)
+	encoder.Bool("use_chat_info_database", req.UseChatInfoDatabase)
+	encoder.Bool("use_message_database", req.UseMessageDatabase)
+	encoder.Bool("use_secret_chats", req.UseSecretChats)
+	encoder.Int32("api_id", req.ApiId)
+	encoder.String("api_hash", req.ApiHash)
+	encoder.String("system_language_code", req.SystemLanguageCode)
+	encoder.String("device_model", req.DeviceModel)
+	encoder.String("system_version", req.SystemVersion)
+	encoder.String("application_version", req.ApplicationVersion)
+	encoder.Bool("enable_storage_optimizer", req.EnableStorageOptimizer)
+	encoder.Bool("ignore_file_names", req.IgnoreFileNames)
+}
+
 // Sets the parameters for TDLib initialization. Works only when the current authorization state is authorizationStateWaitTdlibParameters
 func (client *Client) SetTdlibParameters(req *SetTdlibParametersRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetTdlibParametersContext(context.Background(), req)
+}
+
+// Sets the parameters for TDLib initialization. Works only when the current authorization state is authorizationStateWaitTdlibParameters
+func (client *Client) SetTdlibParametersContext(ctx context.Context, req *SetTdlibParametersRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setTdlibParameters",
 		},
-		Data: map[string]interface{}{
-			"use_test_dc":              req.UseTestDc,
-			"database_directory":       req.DatabaseDirectory,
-			"files_directory":          req.FilesDirectory,
-			"database_encryption_key":  req.DatabaseEncryptionKey,
-			"use_file_database":        req.UseFileDatabase,
-			"use_chat_info_database":   req.UseChatInfoDatabase,
-			"use_message_database":     req.UseMessageDatabase,
-			"use_secret_chats":         req.UseSecretChats,
-			"api_id":                   req.ApiId,
-			"api_hash":                 req.ApiHash,
-			"system_language_code":     req.SystemLanguageCode,
-			"device_model":             req.DeviceModel,
-			"system_version":           req.SystemVersion,
-			"application_version":      req.ApplicationVersion,
-			"enable_storage_optimizer": req.EnableStorageOptimizer,
-			"ignore_file_names":        req.IgnoreFileNames,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -142,16 +114,24 @@ type SetAuthenticationPhoneNumberRequest struct {
 	Settings *PhoneNumberAuthenticationSettings `json:"settings"`
 }
 
+func (req *SetAuthenticationPhoneNumberRequest) encode(encoder *encoder) {
+	encoder.String("phone_number", req.PhoneNumber)
+	encoder.name("settings")
+	encodePhoneNumberAuthenticationSettings(encoder, req.Settings)
+}
+
 // Sets the phone number of the user and sends an authentication code to the user. Works only when the current authorization state is authorizationStateWaitPhoneNumber, or if there is no pending authentication query and the current authorization state is authorizationStateWaitEmailAddress, authorizationStateWaitEmailCode, authorizationStateWaitCode, authorizationStateWaitRegistration, or authorizationStateWaitPassword
 func (client *Client) SetAuthenticationPhoneNumber(req *SetAuthenticationPhoneNumberRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetAuthenticationPhoneNumberContext(context.Background(), req)
+}
+
+// Sets the phone number of the user and sends an authentication code to the user. Works only when the current authorization state is authorizationStateWaitPhoneNumber, or if there is no pending authentication query and the current authorization state is authorizationStateWaitEmailAddress, authorizationStateWaitEmailCode, authorizationStateWaitCode, authorizationStateWaitRegistration, or authorizationStateWaitPassword
+func (client *Client) SetAuthenticationPhoneNumberContext(ctx context.Context, req *SetAuthenticationPhoneNumberRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setAuthenticationPhoneNumber",
 		},
-		Data: map[string]interface{}{
-			"phone_number": req.PhoneNumber,
-			"settings":     req.Settings,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -169,15 +149,22 @@ type SetAuthenticationEmailAddressRequest struct {
 	EmailAddress string `json:"email_address"`
 }
 
+func (req *SetAuthenticationEmailAddressRequest) encode(encoder *encoder) {
+	encoder.String("email_address", req.EmailAddress)
+}
+
 // Sets the email address of the user and sends an authentication code to the email address. Works only when the current authorization state is authorizationStateWaitEmailAddress
 func (client *Client) SetAuthenticationEmailAddress(req *SetAuthenticationEmailAddressRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetAuthenticationEmailAddressContext(context.Background(), req)
+}
+
+// Sets the email address of the user and sends an authentication code to the email address. Works only when the current authorization state is authorizationStateWaitEmailAddress
+func (client *Client) SetAuthenticationEmailAddressContext(ctx context.Context, req *SetAuthenticationEmailAddressRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setAuthenticationEmailAddress",
 		},
-		Data: map[string]interface{}{
-			"email_address": req.EmailAddress,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -192,11 +179,15 @@ func (client *Client) SetAuthenticationEmailAddress(req *SetAuthenticationEmailA
 
 // Resends an authentication code to the user. Works only when the current authorization state is authorizationStateWaitCode, the next_code_type of the result is not null and the server-specified timeout has passed, or when the current authorization state is authorizationStateWaitEmailCode
 func (client *Client) ResendAuthenticationCode() (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ResendAuthenticationCodeContext(context.Background())
+}
+
+// Resends an authentication code to the user. Works only when the current authorization state is authorizationStateWaitCode, the next_code_type of the result is not null and the server-specified timeout has passed, or when the current authorization state is authorizationStateWaitEmailCode
+func (client *Client) ResendAuthenticationCodeContext(ctx context.Context) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "resendAuthenticationCode",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -214,15 +205,23 @@ type CheckAuthenticationEmailCodeRequest struct {
 	Code EmailAddressAuthentication `json:"code"`
 }
 
+func (req *CheckAuthenticationEmailCodeRequest) encode(encoder *encoder) {
+	encoder.name("code")
+	encodeEmailAddressAuthentication(encoder, req.Code)
+}
+
 // Checks the authentication of a email address. Works only when the current authorization state is authorizationStateWaitEmailCode
 func (client *Client) CheckAuthenticationEmailCode(req *CheckAuthenticationEmailCodeRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.CheckAuthenticationEmailCodeContext(context.Background(), req)
+}
+
+// Checks the authentication of a email address. Works only when the current authorization state is authorizationStateWaitEmailCode
+func (client *Client) CheckAuthenticationEmailCodeContext(ctx context.Context, req *CheckAuthenticationEmailCodeRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "checkAuthenticationEmailCode",
 		},
-		Data: map[string]interface{}{
-			"code": req.Code,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -240,15 +239,22 @@ type CheckAuthenticationCodeRequest struct {
 	Code string `json:"code"`
 }
 
+func (req *CheckAuthenticationCodeRequest) encode(encoder *encoder) {
+	encoder.String("code", req.Code)
+}
+
 // Checks the authentication code. Works only when the current authorization state is authorizationStateWaitCode
 func (client *Client) CheckAuthenticationCode(req *CheckAuthenticationCodeRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.CheckAuthenticationCodeContext(context.Background(), req)
+}
+
+// Checks the authentication code. Works only when the current authorization state is authorizationStateWaitCode
+func (client *Client) CheckAuthenticationCodeContext(ctx context.Context, req *CheckAuthenticationCodeRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "checkAuthenticationCode",
 		},
-		Data: map[string]interface{}{
-			"code": req.Code,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -266,15 +272,22 @@ type RequestQrCodeAuthenticationRequest struct {
 	OtherUserIds []int64 `json:"other_user_ids"`
 }
 
+func (req *RequestQrCodeAuthenticationRequest) encode(encoder *encoder) {
+	encoder.Int64s("other_user_ids", req.OtherUserIds)
+}
+
 // Requests QR code authentication by scanning a QR code on another logged in device. Works only when the current authorization state is authorizationStateWaitPhoneNumber, or if there is no pending authentication query and the current authorization state is authorizationStateWaitEmailAddress, authorizationStateWaitEmailCode, authorizationStateWaitCode, authorizationStateWaitRegistration, or authorizationStateWaitPassword
 func (client *Client) RequestQrCodeAuthentication(req *RequestQrCodeAuthenticationRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.RequestQrCodeAuthenticationContext(context.Background(), req)
+}
+
+// Requests QR code authentication by scanning a QR code on another logged in device. Works only when the current authorization state is authorizationStateWaitPhoneNumber, or if there is no pending authentication query and the current authorization state is authorizationStateWaitEmailAddress, authorizationStateWaitEmailCode, authorizationStateWaitCode, authorizationStateWaitRegistration, or authorizationStateWaitPassword
+func (client *Client) RequestQrCodeAuthenticationContext(ctx context.Context, req *RequestQrCodeAuthenticationRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "requestQrCodeAuthentication",
 		},
-		Data: map[string]interface{}{
-			"other_user_ids": req.OtherUserIds,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -294,16 +307,23 @@ type RegisterUserRequest struct {
 	LastName string `json:"last_name"`
 }
 
+func (req *RegisterUserRequest) encode(encoder *encoder) {
+	encoder.String("first_name", req.FirstName)
+	encoder.String("last_name", req.LastName)
+}
+
 // Finishes user registration. Works only when the current authorization state is authorizationStateWaitRegistration
 func (client *Client) RegisterUser(req *RegisterUserRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.RegisterUserContext(context.Background(), req)
+}
+
+// Finishes user registration. Works only when the current authorization state is authorizationStateWaitRegistration
+func (client *Client) RegisterUserContext(ctx context.Context, req *RegisterUserRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "registerUser",
 		},
-		Data: map[string]interface{}{
-			"first_name": req.FirstName,
-			"last_name":  req.LastName,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -318,11 +338,15 @@ func (client *Client) RegisterUser(req *RegisterUserRequest) (*Ok, error) {
 
 // Resets the login email address. May return an error with a message "TASK_ALREADY_EXISTS" if reset is still pending. Works only when the current authorization state is authorizationStateWaitEmailCode and authorization_state.can_reset_email_address == true
 func (client *Client) ResetAuthenticationEmailAddress() (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ResetAuthenticationEmailAddressContext(context.Background())
+}
+
+// Resets the login email address. May return an error with a message "TASK_ALREADY_EXISTS" if reset is still pending. Works only when the current authorization state is authorizationStateWaitEmailCode and authorization_state.can_reset_email_address == true
+func (client *Client) ResetAuthenticationEmailAddressContext(ctx context.Context) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "resetAuthenticationEmailAddress",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -340,15 +364,22 @@ type CheckAuthenticationPasswordRequest struct {
 	Password string `json:"password"`
 }
 
+func (req *CheckAuthenticationPasswordRequest) encode(encoder *encoder) {
+	encoder.String("password", req.Password)
+}
+
 // Checks the 2-step verification password for correctness. Works only when the current authorization state is authorizationStateWaitPassword
 func (client *Client) CheckAuthenticationPassword(req *CheckAuthenticationPasswordRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.CheckAuthenticationPasswordContext(context.Background(), req)
+}
+
+// Checks the 2-step verification password for correctness. Works only when the current authorization state is authorizationStateWaitPassword
+func (client *Client) CheckAuthenticationPasswordContext(ctx context.Context, req *CheckAuthenticationPasswordRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "checkAuthenticationPassword",
 		},
-		Data: map[string]interface{}{
-			"password": req.Password,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -363,11 +394,15 @@ func (client *Client) CheckAuthenticationPassword(req *CheckAuthenticationPasswo
 
 // Requests to send a 2-step verification password recovery code to an email address that was previously set up. Works only when the current authorization state is authorizationStateWaitPassword
 func (client *Client) RequestAuthenticationPasswordRecovery() (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.RequestAuthenticationPasswordRecoveryContext(context.Background())
+}
+
+// Requests to send a 2-step verification password recovery code to an email address that was previously set up. Works only when the current authorization state is authorizationStateWaitPassword
+func (client *Client) RequestAuthenticationPasswordRecoveryContext(ctx context.Context) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "requestAuthenticationPasswordRecovery",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -385,15 +420,22 @@ type CheckAuthenticationPasswordRecoveryCodeRequest struct {
 	RecoveryCode string `json:"recovery_code"`
 }
 
+func (req *CheckAuthenticationPasswordRecoveryCodeRequest) encode(encoder *encoder) {
+	encoder.String("recovery_code", req.RecoveryCode)
+}
+
 // Checks whether a 2-step verification password recovery code sent to an email address is valid. Works only when the current authorization state is authorizationStateWaitPassword
 func (client *Client) CheckAuthenticationPasswordRecoveryCode(req *CheckAuthenticationPasswordRecoveryCodeRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.CheckAuthenticationPasswordRecoveryCodeContext(context.Background(), req)
+}
+
+// Checks whether a 2-step verification password recovery code sent to an email address is valid. Works only when the current authorization state is authorizationStateWaitPassword
+func (client *Client) CheckAuthenticationPasswordRecoveryCodeContext(ctx context.Context, req *CheckAuthenticationPasswordRecoveryCodeRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "checkAuthenticationPasswordRecoveryCode",
 		},
-		Data: map[string]interface{}{
-			"recovery_code": req.RecoveryCode,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -415,17 +457,24 @@ type RecoverAuthenticationPasswordRequest struct {
 	NewHint string `json:"new_hint"`
 }
 
+func (req *RecoverAuthenticationPasswordRequest) encode(encoder *encoder) {
+	encoder.String("recovery_code", req.RecoveryCode)
+	encoder.String("new_password", req.NewPassword)
+	encoder.String("new_hint", req.NewHint)
+}
+
 // Recovers the 2-step verification password with a password recovery code sent to an email address that was previously set up. Works only when the current authorization state is authorizationStateWaitPassword
 func (client *Client) RecoverAuthenticationPassword(req *RecoverAuthenticationPasswordRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.RecoverAuthenticationPasswordContext(context.Background(), req)
+}
+
+// Recovers the 2-step verification password with a password recovery code sent to an email address that was previously set up. Works only when the current authorization state is authorizationStateWaitPassword
+func (client *Client) RecoverAuthenticationPasswordContext(ctx context.Context, req *RecoverAuthenticationPasswordRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "recoverAuthenticationPassword",
 		},
-		Data: map[string]interface{}{
-			"recovery_code": req.RecoveryCode,
-			"new_password":  req.NewPassword,
-			"new_hint":      req.NewHint,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -443,15 +492,22 @@ type SendAuthenticationFirebaseSmsRequest struct {
 	Token string `json:"token"`
 }
 
+func (req *SendAuthenticationFirebaseSmsRequest) encode(encoder *encoder) {
+	encoder.String("token", req.Token)
+}
+
 // Sends Firebase Authentication SMS to the phone number of the user. Works only when the current authorization state is authorizationStateWaitCode and the server returned code of the type authenticationCodeTypeFirebaseAndroid or authenticationCodeTypeFirebaseIos
 func (client *Client) SendAuthenticationFirebaseSms(req *SendAuthenticationFirebaseSmsRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SendAuthenticationFirebaseSmsContext(context.Background(), req)
+}
+
+// Sends Firebase Authentication SMS to the phone number of the user. Works only when the current authorization state is authorizationStateWaitCode and the server returned code of the type authenticationCodeTypeFirebaseAndroid or authenticationCodeTypeFirebaseIos
+func (client *Client) SendAuthenticationFirebaseSmsContext(ctx context.Context, req *SendAuthenticationFirebaseSmsRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "sendAuthenticationFirebaseSms",
 		},
-		Data: map[string]interface{}{
-			"token": req.Token,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -469,15 +525,22 @@ type CheckAuthenticationBotTokenRequest struct {
 	Token string `json:"token"`
 }
 
+func (req *CheckAuthenticationBotTokenRequest) encode(encoder *encoder) {
+	encoder.String("token", req.Token)
+}
+
 // Checks the authentication token of a bot; to log in as a bot. Works only when the current authorization state is authorizationStateWaitPhoneNumber. Can be used instead of setAuthenticationPhoneNumber and checkAuthenticationCode to log in
 func (client *Client) CheckAuthenticationBotToken(req *CheckAuthenticationBotTokenRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.CheckAuthenticationBotTokenContext(context.Background(), req)
+}
+
+// Checks the authentication token of a bot; to log in as a bot. Works only when the current authorization state is authorizationStateWaitPhoneNumber. Can be used instead of setAuthenticationPhoneNumber and checkAuthenticationCode to log in
+func (client *Client) CheckAuthenticationBotTokenContext(ctx context.Context, req *CheckAuthenticationBotTokenRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "checkAuthenticationBotToken",
 		},
-		Data: map[string]interface{}{
-			"token": req.Token,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -492,11 +555,15 @@ func (client *Client) CheckAuthenticationBotToken(req *CheckAuthenticationBotTok
 
 // Closes the TDLib instance after a proper logout. Requires an available network connection. All local data will be destroyed. After the logout completes, updateAuthorizationState with authorizationStateClosed will be sent
 func (client *Client) LogOut() (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.LogOutContext(context.Background())
+}
+
+// Closes the TDLib instance after a proper logout. Requires an available network connection. All local data will be destroyed. After the logout completes, updateAuthorizationState with authorizationStateClosed will be sent
+func (client *Client) LogOutContext(ctx context.Context) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "logOut",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -511,11 +578,15 @@ func (client *Client) LogOut() (*Ok, error) {
 
 // Closes the TDLib instance. All databases will be flushed to disk and properly closed. After the close completes, updateAuthorizationState with authorizationStateClosed will be sent. Can be called before initialization
 func (client *Client) Close() (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.CloseContext(context.Background())
+}
+
+// Closes the TDLib instance. All databases will be flushed to disk and properly closed. After the close completes, updateAuthorizationState with authorizationStateClosed will be sent. Can be called before initialization
+func (client *Client) CloseContext(ctx context.Context) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "close",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -530,11 +601,15 @@ func (client *Client) Close() (*Ok, error) {
 
 // Closes the TDLib instance, destroying all local data without a proper logout. The current user session will remain in the list of all active sessions. All local data will be destroyed. After the destruction completes updateAuthorizationState with authorizationStateClosed will be sent. Can be called before authorization
 func (client *Client) Destroy() (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.DestroyContext(context.Background())
+}
+
+// Closes the TDLib instance, destroying all local data without a proper logout. The current user session will remain in the list of all active sessions. All local data will be destroyed. After the destruction completes updateAuthorizationState with authorizationStateClosed will be sent. Can be called before authorization
+func (client *Client) DestroyContext(ctx context.Context) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "destroy",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -552,15 +627,22 @@ type ConfirmQrCodeAuthenticationRequest struct {
 	Link string `json:"link"`
 }
 
+func (req *ConfirmQrCodeAuthenticationRequest) encode(encoder *encoder) {
+	encoder.String("link", req.Link)
+}
+
 // Confirms QR code authentication on another device. Returns created session on success
 func (client *Client) ConfirmQrCodeAuthentication(req *ConfirmQrCodeAuthenticationRequest) (*Session, error) {
-	result, err := client.Send(Request{
+	return client.ConfirmQrCodeAuthenticationContext(context.Background(), req)
+}
+
+// Confirms QR code authentication on another device. Returns created session on success
+func (client *Client) ConfirmQrCodeAuthenticationContext(ctx context.Context, req *ConfirmQrCodeAuthenticationRequest) (*Session, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "confirmQrCodeAuthentication",
 		},
-		Data: map[string]interface{}{
-			"link": req.Link,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -575,11 +657,15 @@ func (client *Client) ConfirmQrCodeAuthentication(req *ConfirmQrCodeAuthenticati
 
 // Returns all updates needed to restore current TDLib state, i.e. all actual updateAuthorizationState/updateUser/updateNewChat and others. This is especially useful if TDLib is run in a separate process. Can be called before initialization
 func (client *Client) GetCurrentState() (*Updates, error) {
-	result, err := client.Send(Request{
+	return client.GetCurrentStateContext(context.Background())
+}
+
+// Returns all updates needed to restore current TDLib state, i.e. all actual updateAuthorizationState/updateUser/updateNewChat and others. This is especially useful if TDLib is run in a separate process. Can be called before initialization
+func (client *Client) GetCurrentStateContext(ctx context.Context) (*Updates, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getCurrentState",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -597,15 +683,22 @@ type SetDatabaseEncryptionKeyRequest struct {
 	NewEncryptionKey []byte `json:"new_encryption_key"`
 }
 
+func (req *SetDatabaseEncryptionKeyRequest) encode(encoder *encoder) {
+	encoder.Bytes("new_encryption_key", req.NewEncryptionKey)
+}
+
 // Changes the database encryption key. Usually the encryption key is never changed and is stored in some OS keychain
 func (client *Client) SetDatabaseEncryptionKey(req *SetDatabaseEncryptionKeyRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetDatabaseEncryptionKeyContext(context.Background(), req)
+}
+
+// Changes the database encryption key. Usually the encryption key is never changed and is stored in some OS keychain
+func (client *Client) SetDatabaseEncryptionKeyContext(ctx context.Context, req *SetDatabaseEncryptionKeyRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setDatabaseEncryptionKey",
 		},
-		Data: map[string]interface{}{
-			"new_encryption_key": req.NewEncryptionKey,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -619,12 +712,25 @@ func (client *Client) SetDatabaseEncryptionKey(req *SetDatabaseEncryptionKeyRequ
 }
 
 // Returns the current state of 2-step verification
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetPasswordState() (*PasswordState, error) {
-	result, err := client.Send(Request{
+	return client.GetPasswordStateContext(context.Background())
+}
+
+// Returns the current state of 2-step verification
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetPasswordStateContext(ctx context.Context) (*PasswordState, error) {
+	err := client.checkUsersOnly("getPasswordState")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getPasswordState",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -650,19 +756,35 @@ type SetPasswordRequest struct {
 	NewRecoveryEmailAddress string `json:"new_recovery_email_address"`
 }
 
+func (req *SetPasswordRequest) encode(encoder *encoder) {
+	encoder.String("old_password", req.OldPassword)
+	encoder.String("new_password", req.NewPassword)
+	encoder.String("new_hint", req.NewHint)
+	encoder.Bool("set_recovery_email_address", req.SetRecoveryEmailAddress)
+	encoder.String("new_recovery_email_address", req.NewRecoveryEmailAddress)
+}
+
 // Changes the 2-step verification password for the current user. If a new recovery email address is specified, then the change will not be applied until the new recovery email address is confirmed
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SetPassword(req *SetPasswordRequest) (*PasswordState, error) {
-	result, err := client.Send(Request{
+	return client.SetPasswordContext(context.Background(), req)
+}
+
+// Changes the 2-step verification password for the current user. If a new recovery email address is specified, then the change will not be applied until the new recovery email address is confirmed
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SetPasswordContext(ctx context.Context, req *SetPasswordRequest) (*PasswordState, error) {
+	err := client.checkUsersOnly("setPassword")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setPassword",
 		},
-		Data: map[string]interface{}{
-			"old_password":               req.OldPassword,
-			"new_password":               req.NewPassword,
-			"new_hint":                   req.NewHint,
-			"set_recovery_email_address": req.SetRecoveryEmailAddress,
-			"new_recovery_email_address": req.NewRecoveryEmailAddress,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -680,15 +802,31 @@ type SetLoginEmailAddressRequest struct {
 	NewLoginEmailAddress string `json:"new_login_email_address"`
 }
 
+func (req *SetLoginEmailAddressRequest) encode(encoder *encoder) {
+	encoder.String("new_login_email_address", req.NewLoginEmailAddress)
+}
+
 // Changes the login email address of the user. The email address can be changed only if the current user already has login email and passwordState.login_email_address_pattern is non-empty. The change will not be applied until the new login email address is confirmed with checkLoginEmailAddressCode. To use Apple ID/Google ID instead of a email address, call checkLoginEmailAddressCode directly
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SetLoginEmailAddress(req *SetLoginEmailAddressRequest) (*EmailAddressAuthenticationCodeInfo, error) {
-	result, err := client.Send(Request{
+	return client.SetLoginEmailAddressContext(context.Background(), req)
+}
+
+// Changes the login email address of the user. The email address can be changed only if the current user already has login email and passwordState.login_email_address_pattern is non-empty. The change will not be applied until the new login email address is confirmed with checkLoginEmailAddressCode. To use Apple ID/Google ID instead of a email address, call checkLoginEmailAddressCode directly
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SetLoginEmailAddressContext(ctx context.Context, req *SetLoginEmailAddressRequest) (*EmailAddressAuthenticationCodeInfo, error) {
+	err := client.checkUsersOnly("setLoginEmailAddress")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setLoginEmailAddress",
 		},
-		Data: map[string]interface{}{
-			"new_login_email_address": req.NewLoginEmailAddress,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -702,12 +840,25 @@ func (client *Client) SetLoginEmailAddress(req *SetLoginEmailAddressRequest) (*E
 }
 
 // Resends the login email address verification code
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ResendLoginEmailAddressCode() (*EmailAddressAuthenticationCodeInfo, error) {
-	result, err := client.Send(Request{
+	return client.ResendLoginEmailAddressCodeContext(context.Background())
+}
+
+// Resends the login email address verification code
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ResendLoginEmailAddressCodeContext(ctx context.Context) (*EmailAddressAuthenticationCodeInfo, error) {
+	err := client.checkUsersOnly("resendLoginEmailAddressCode")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "resendLoginEmailAddressCode",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -725,15 +876,32 @@ type CheckLoginEmailAddressCodeRequest struct {
 	Code EmailAddressAuthentication `json:"code"`
 }
 
+func (req *CheckLoginEmailAddressCodeRequest) encode(encoder *encoder) {
+	encoder.name("code")
+	encodeEmailAddressAuthentication(encoder, req.Code)
+}
+
 // Checks the login email address authentication
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) CheckLoginEmailAddressCode(req *CheckLoginEmailAddressCodeRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.CheckLoginEmailAddressCodeContext(context.Background(), req)
+}
+
+// Checks the login email address authentication
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) CheckLoginEmailAddressCodeContext(ctx context.Context, req *CheckLoginEmailAddressCodeRequest) (*Ok, error) {
+	err := client.checkUsersOnly("checkLoginEmailAddressCode")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "checkLoginEmailAddressCode",
 		},
-		Data: map[string]interface{}{
-			"code": req.Code,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -751,15 +919,31 @@ type GetRecoveryEmailAddressRequest struct {
 	Password string `json:"password"`
 }
 
+func (req *GetRecoveryEmailAddressRequest) encode(encoder *encoder) {
+	encoder.String("password", req.Password)
+}
+
 // Returns a 2-step verification recovery email address that was previously set up. This method can be used to verify a password provided by the user
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetRecoveryEmailAddress(req *GetRecoveryEmailAddressRequest) (*RecoveryEmailAddress, error) {
-	result, err := client.Send(Request{
+	return client.GetRecoveryEmailAddressContext(context.Background(), req)
+}
+
+// Returns a 2-step verification recovery email address that was previously set up. This method can be used to verify a password provided by the user
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetRecoveryEmailAddressContext(ctx context.Context, req *GetRecoveryEmailAddressRequest) (*RecoveryEmailAddress, error) {
+	err := client.checkUsersOnly("getRecoveryEmailAddress")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getRecoveryEmailAddress",
 		},
-		Data: map[string]interface{}{
-			"password": req.Password,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -779,16 +963,32 @@ type SetRecoveryEmailAddressRequest struct {
 	NewRecoveryEmailAddress string `json:"new_recovery_email_address"`
 }
 
+func (req *SetRecoveryEmailAddressRequest) encode(encoder *encoder) {
+	encoder.String("password", req.Password)
+	encoder.String("new_recovery_email_address", req.NewRecoveryEmailAddress)
+}
+
 // Changes the 2-step verification recovery email address of the user. If a new recovery email address is specified, then the change will not be applied until the new recovery email address is confirmed. If new_recovery_email_address is the same as the email address that is currently set up, this call succeeds immediately and aborts all other requests waiting for an email confirmation
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SetRecoveryEmailAddress(req *SetRecoveryEmailAddressRequest) (*PasswordState, error) {
-	result, err := client.Send(Request{
+	return client.SetRecoveryEmailAddressContext(context.Background(), req)
+}
+
+// Changes the 2-step verification recovery email address of the user. If a new recovery email address is specified, then the change will not be applied until the new recovery email address is confirmed. If new_recovery_email_address is the same as the email address that is currently set up, this call succeeds immediately and aborts all other requests waiting for an email confirmation
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SetRecoveryEmailAddressContext(ctx context.Context, req *SetRecoveryEmailAddressRequest) (*PasswordState, error) {
+	err := client.checkUsersOnly("setRecoveryEmailAddress")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setRecoveryEmailAddress",
 		},
-		Data: map[string]interface{}{
-			"password":                   req.Password,
-			"new_recovery_email_address": req.NewRecoveryEmailAddress,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -806,15 +1006,31 @@ type CheckRecoveryEmailAddressCodeRequest struct {
 	Code string `json:"code"`
 }
 
+func (req *CheckRecoveryEmailAddressCodeRequest) encode(encoder *encoder) {
+	encoder.String("code", req.Code)
+}
+
 // Checks the 2-step verification recovery email address verification code
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) CheckRecoveryEmailAddressCode(req *CheckRecoveryEmailAddressCodeRequest) (*PasswordState, error) {
-	result, err := client.Send(Request{
+	return client.CheckRecoveryEmailAddressCodeContext(context.Background(), req)
+}
+
+// Checks the 2-step verification recovery email address verification code
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) CheckRecoveryEmailAddressCodeContext(ctx context.Context, req *CheckRecoveryEmailAddressCodeRequest) (*PasswordState, error) {
+	err := client.checkUsersOnly("checkRecoveryEmailAddressCode")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "checkRecoveryEmailAddressCode",
 		},
-		Data: map[string]interface{}{
-			"code": req.Code,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -828,12 +1044,25 @@ func (client *Client) CheckRecoveryEmailAddressCode(req *CheckRecoveryEmailAddre
 }
 
 // Resends the 2-step verification recovery email address verification code
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ResendRecoveryEmailAddressCode() (*PasswordState, error) {
-	result, err := client.Send(Request{
+	return client.ResendRecoveryEmailAddressCodeContext(context.Background())
+}
+
+// Resends the 2-step verification recovery email address verification code
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ResendRecoveryEmailAddressCodeContext(ctx context.Context) (*PasswordState, error) {
+	err := client.checkUsersOnly("resendRecoveryEmailAddressCode")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "resendRecoveryEmailAddressCode",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -847,12 +1076,25 @@ func (client *Client) ResendRecoveryEmailAddressCode() (*PasswordState, error) {
 }
 
 // Requests to send a 2-step verification password recovery code to an email address that was previously set up
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) RequestPasswordRecovery() (*EmailAddressAuthenticationCodeInfo, error) {
-	result, err := client.Send(Request{
+	return client.RequestPasswordRecoveryContext(context.Background())
+}
+
+// Requests to send a 2-step verification password recovery code to an email address that was previously set up
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) RequestPasswordRecoveryContext(ctx context.Context) (*EmailAddressAuthenticationCodeInfo, error) {
+	err := client.checkUsersOnly("requestPasswordRecovery")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "requestPasswordRecovery",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -870,15 +1112,31 @@ type CheckPasswordRecoveryCodeRequest struct {
 	RecoveryCode string `json:"recovery_code"`
 }
 
+func (req *CheckPasswordRecoveryCodeRequest) encode(encoder *encoder) {
+	encoder.String("recovery_code", req.RecoveryCode)
+}
+
 // Checks whether a 2-step verification password recovery code sent to an email address is valid
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) CheckPasswordRecoveryCode(req *CheckPasswordRecoveryCodeRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.CheckPasswordRecoveryCodeContext(context.Background(), req)
+}
+
+// Checks whether a 2-step verification password recovery code sent to an email address is valid
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) CheckPasswordRecoveryCodeContext(ctx context.Context, req *CheckPasswordRecoveryCodeRequest) (*Ok, error) {
+	err := client.checkUsersOnly("checkPasswordRecoveryCode")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "checkPasswordRecoveryCode",
 		},
-		Data: map[string]interface{}{
-			"recovery_code": req.RecoveryCode,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -900,17 +1158,33 @@ type RecoverPasswordRequest struct {
 	NewHint string `json:"new_hint"`
 }
 
+func (req *RecoverPasswordRequest) encode(encoder *encoder) {
+	encoder.String("recovery_code", req.RecoveryCode)
+	encoder.String("new_password", req.NewPassword)
+	encoder.String("new_hint", req.NewHint)
+}
+
 // Recovers the 2-step verification password using a recovery code sent to an email address that was previously set up
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) RecoverPassword(req *RecoverPasswordRequest) (*PasswordState, error) {
-	result, err := client.Send(Request{
+	return client.RecoverPasswordContext(context.Background(), req)
+}
+
+// Recovers the 2-step verification password using a recovery code sent to an email address that was previously set up
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) RecoverPasswordContext(ctx context.Context, req *RecoverPasswordRequest) (*PasswordState, error) {
+	err := client.checkUsersOnly("recoverPassword")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "recoverPassword",
 		},
-		Data: map[string]interface{}{
-			"recovery_code": req.RecoveryCode,
-			"new_password":  req.NewPassword,
-			"new_hint":      req.NewHint,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -924,12 +1198,25 @@ func (client *Client) RecoverPassword(req *RecoverPasswordRequest) (*PasswordSta
 }
 
 // Removes 2-step verification password without previous password and access to recovery email address. The password can't be reset immediately and the request needs to be repeated after the specified time
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ResetPassword() (ResetPasswordResult, error) {
-	result, err := client.Send(Request{
+	return client.ResetPasswordContext(context.Background())
+}
+
+// Removes 2-step verification password without previous password and access to recovery email address. The password can't be reset immediately and the request needs to be repeated after the specified time
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ResetPasswordContext(ctx context.Context) (ResetPasswordResult, error) {
+	err := client.checkUsersOnly("resetPassword")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "resetPassword",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -939,28 +1226,29 @@ func (client *Client) ResetPassword() (ResetPasswordResult, error) {
 		return nil, buildResponseError(result.Data)
 	}
 
-	switch result.Type {
-	case TypeResetPasswordResultOk:
-		return UnmarshalResetPasswordResultOk(result.Data)
-
-	case TypeResetPasswordResultPending:
-		return UnmarshalResetPasswordResultPending(result.Data)
-
-	case TypeResetPasswordResultDeclined:
-		return UnmarshalResetPasswordResultDeclined(result.Data)
-
-	default:
-		return nil, errors.New("invalid type")
-	}
+	return UnmarshalResetPasswordResult(result.Data)
 }
 
 // Cancels reset of 2-step verification password. The method can be called if passwordState.pending_reset_date > 0
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) CancelPasswordReset() (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.CancelPasswordResetContext(context.Background())
+}
+
+// Cancels reset of 2-step verification password. The method can be called if passwordState.pending_reset_date > 0
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) CancelPasswordResetContext(ctx context.Context) (*Ok, error) {
+	err := client.checkUsersOnly("cancelPasswordReset")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "cancelPasswordReset",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -980,16 +1268,32 @@ type CreateTemporaryPasswordRequest struct {
 	ValidFor int32 `json:"valid_for"`
 }
 
+func (req *CreateTemporaryPasswordRequest) encode(encoder *encoder) {
+	encoder.String("password", req.Password)
+	encoder.Int32("valid_for", req.ValidFor)
+}
+
 // Creates a new temporary password for processing payments
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) CreateTemporaryPassword(req *CreateTemporaryPasswordRequest) (*TemporaryPasswordState, error) {
-	result, err := client.Send(Request{
+	return client.CreateTemporaryPasswordContext(context.Background(), req)
+}
+
+// Creates a new temporary password for processing payments
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) CreateTemporaryPasswordContext(ctx context.Context, req *CreateTemporaryPasswordRequest) (*TemporaryPasswordState, error) {
+	err := client.checkUsersOnly("createTemporaryPassword")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "createTemporaryPassword",
 		},
-		Data: map[string]interface{}{
-			"password":  req.Password,
-			"valid_for": req.ValidFor,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -1003,12 +1307,25 @@ func (client *Client) CreateTemporaryPassword(req *CreateTemporaryPasswordReques
 }
 
 // Returns information about the current temporary password
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetTemporaryPasswordState() (*TemporaryPasswordState, error) {
-	result, err := client.Send(Request{
+	return client.GetTemporaryPasswordStateContext(context.Background())
+}
+
+// Returns information about the current temporary password
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetTemporaryPasswordStateContext(ctx context.Context) (*TemporaryPasswordState, error) {
+	err := client.checkUsersOnly("getTemporaryPasswordState")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getTemporaryPasswordState",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -1023,11 +1340,15 @@ func (client *Client) GetTemporaryPasswordState() (*TemporaryPasswordState, erro
 
 // Returns the current user
 func (client *Client) GetMe() (*User, error) {
-	result, err := client.Send(Request{
+	return client.GetMeContext(context.Background())
+}
+
+// Returns the current user
+func (client *Client) GetMeContext(ctx context.Context) (*User, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getMe",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -1045,15 +1366,22 @@ type GetUserRequest struct {
 	UserId int64 `json:"user_id"`
 }
 
+func (req *GetUserRequest) encode(encoder *encoder) {
+	encoder.Int64("user_id", req.UserId)
+}
+
 // Returns information about a user by their identifier. This is an offline request if the current user is not a bot
 func (client *Client) GetUser(req *GetUserRequest) (*User, error) {
-	result, err := client.Send(Request{
+	return client.GetUserContext(context.Background(), req)
+}
+
+// Returns information about a user by their identifier. This is an offline request if the current user is not a bot
+func (client *Client) GetUserContext(ctx context.Context, req *GetUserRequest) (*User, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getUser",
 		},
-		Data: map[string]interface{}{
-			"user_id": req.UserId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -1071,15 +1399,22 @@ type GetUserFullInfoRequest struct {
 	UserId int64 `json:"user_id"`
 }
 
+func (req *GetUserFullInfoRequest) encode(encoder *encoder) {
+	encoder.Int64("user_id", req.UserId)
+}
+
 // Returns full information about a user by their identifier
 func (client *Client) GetUserFullInfo(req *GetUserFullInfoRequest) (*UserFullInfo, error) {
-	result, err := client.Send(Request{
+	return client.GetUserFullInfoContext(context.Background(), req)
+}
+
+// Returns full information about a user by their identifier
+func (client *Client) GetUserFullInfoContext(ctx context.Context, req *GetUserFullInfoRequest) (*UserFullInfo, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getUserFullInfo",
 		},
-		Data: map[string]interface{}{
-			"user_id": req.UserId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -1097,15 +1432,22 @@ type GetBasicGroupRequest struct {
 	BasicGroupId int64 `json:"basic_group_id"`
 }
 
+func (req *GetBasicGroupRequest) encode(encoder *encoder) {
+	encoder.Int64("basic_group_id", req.BasicGroupId)
+}
+
 // Returns information about a basic group by its identifier. This is an offline request if the current user is not a bot
 func (client *Client) GetBasicGroup(req *GetBasicGroupRequest) (*BasicGroup, error) {
-	result, err := client.Send(Request{
+	return client.GetBasicGroupContext(context.Background(), req)
+}
+
+// Returns information about a basic group by its identifier. This is an offline request if the current user is not a bot
+func (client *Client) GetBasicGroupContext(ctx context.Context, req *GetBasicGroupRequest) (*BasicGroup, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getBasicGroup",
 		},
-		Data: map[string]interface{}{
-			"basic_group_id": req.BasicGroupId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -1123,15 +1465,22 @@ type GetBasicGroupFullInfoRequest struct {
 	BasicGroupId int64 `json:"basic_group_id"`
 }
 
+func (req *GetBasicGroupFullInfoRequest) encode(encoder *encoder) {
+	encoder.Int64("basic_group_id", req.BasicGroupId)
+}
+
 // Returns full information about a basic group by its identifier
 func (client *Client) GetBasicGroupFullInfo(req *GetBasicGroupFullInfoRequest) (*BasicGroupFullInfo, error) {
-	result, err := client.Send(Request{
+	return client.GetBasicGroupFullInfoContext(context.Background(), req)
+}
+
+// Returns full information about a basic group by its identifier
+func (client *Client) GetBasicGroupFullInfoContext(ctx context.Context, req *GetBasicGroupFullInfoRequest) (*BasicGroupFullInfo, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getBasicGroupFullInfo",
 		},
-		Data: map[string]interface{}{
-			"basic_group_id": req.BasicGroupId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -1149,15 +1498,22 @@ type GetSupergroupRequest struct {
 	SupergroupId int64 `json:"supergroup_id"`
 }
 
+func (req *GetSupergroupRequest) encode(encoder *encoder) {
+	encoder.Int64("supergroup_id", req.SupergroupId)
+}
+
 // Returns information about a supergroup or a channel by its identifier. This is an offline request if the current user is not a bot
 func (client *Client) GetSupergroup(req *GetSupergroupRequest) (*Supergroup, error) {
-	result, err := client.Send(Request{
+	return client.GetSupergroupContext(context.Background(), req)
+}
+
+// Returns information about a supergroup or a channel by its identifier. This is an offline request if the current user is not a bot
+func (client *Client) GetSupergroupContext(ctx context.Context, req *GetSupergroupRequest) (*Supergroup, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getSupergroup",
 		},
-		Data: map[string]interface{}{
-			"supergroup_id": req.SupergroupId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -1175,15 +1531,22 @@ type GetSupergroupFullInfoRequest struct {
 	SupergroupId int64 `json:"supergroup_id"`
 }
 
+func (req *GetSupergroupFullInfoRequest) encode(encoder *encoder) {
+	encoder.Int64("supergroup_id", req.SupergroupId)
+}
+
 // Returns full information about a supergroup or a channel by its identifier, cached for up to 1 minute
 func (client *Client) GetSupergroupFullInfo(req *GetSupergroupFullInfoRequest) (*SupergroupFullInfo, error) {
-	result, err := client.Send(Request{
+	return client.GetSupergroupFullInfoContext(context.Background(), req)
+}
+
+// Returns full information about a supergroup or a channel by its identifier, cached for up to 1 minute
+func (client *Client) GetSupergroupFullInfoContext(ctx context.Context, req *GetSupergroupFullInfoRequest) (*SupergroupFullInfo, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getSupergroupFullInfo",
 		},
-		Data: map[string]interface{}{
-			"supergroup_id": req.SupergroupId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -1201,15 +1564,22 @@ type GetSecretChatRequest struct {
 	SecretChatId int32 `json:"secret_chat_id"`
 }
 
+func (req *GetSecretChatRequest) encode(encoder *encoder) {
+	encoder.Int32("secret_chat_id", req.SecretChatId)
+}
+
 // Returns information about a secret chat by its identifier. This is an offline request
 func (client *Client) GetSecretChat(req *GetSecretChatRequest) (*SecretChat, error) {
-	result, err := client.Send(Request{
+	return client.GetSecretChatContext(context.Background(), req)
+}
+
+// Returns information about a secret chat by its identifier. This is an offline request
+func (client *Client) GetSecretChatContext(ctx context.Context, req *GetSecretChatRequest) (*SecretChat, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getSecretChat",
 		},
-		Data: map[string]interface{}{
-			"secret_chat_id": req.SecretChatId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -1227,15 +1597,26 @@ type GetChatRequest struct {
 	ChatId int64 `json:"chat_id"`
 }
 
+func (req *GetChatRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+}
+
+func (req *GetChatRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns information about a chat by its identifier, this is an offline request if the current user is not a bot
 func (client *Client) GetChat(req *GetChatRequest) (*Chat, error) {
-	result, err := client.Send(Request{
+	return client.GetChatContext(context.Background(), req)
+}
+
+// Returns information about a chat by its identifier, this is an offline request if the current user is not a bot
+func (client *Client) GetChatContext(ctx context.Context, req *GetChatRequest) (*Chat, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getChat",
 		},
-		Data: map[string]interface{}{
-			"chat_id": req.ChatId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -1255,16 +1636,27 @@ type GetMessageRequest struct {
 	MessageId int64 `json:"message_id"`
 }
 
+func (req *GetMessageRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_id", req.MessageId)
+}
+
+func (req *GetMessageRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns information about a message
 func (client *Client) GetMessage(req *GetMessageRequest) (*Message, error) {
-	result, err := client.Send(Request{
+	return client.GetMessageContext(context.Background(), req)
+}
+
+// Returns information about a message
+func (client *Client) GetMessageContext(ctx context.Context, req *GetMessageRequest) (*Message, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getMessage",
 		},
-		Data: map[string]interface{}{
-			"chat_id":    req.ChatId,
-			"message_id": req.MessageId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -1284,16 +1676,27 @@ type GetMessageLocallyRequest struct {
 	MessageId int64 `json:"message_id"`
 }
 
+func (req *GetMessageLocallyRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_id", req.MessageId)
+}
+
+func (req *GetMessageLocallyRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns information about a message, if it is available without sending network request. This is an offline request
 func (client *Client) GetMessageLocally(req *GetMessageLocallyRequest) (*Message, error) {
-	result, err := client.Send(Request{
+	return client.GetMessageLocallyContext(context.Background(), req)
+}
+
+// Returns information about a message, if it is available without sending network request. This is an offline request
+func (client *Client) GetMessageLocallyContext(ctx context.Context, req *GetMessageLocallyRequest) (*Message, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getMessageLocally",
 		},
-		Data: map[string]interface{}{
-			"chat_id":    req.ChatId,
-			"message_id": req.MessageId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -1313,16 +1716,27 @@ type GetRepliedMessageRequest struct {
 	MessageId int64 `json:"message_id"`
 }
 
+func (req *GetRepliedMessageRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_id", req.MessageId)
+}
+
+func (req *GetRepliedMessageRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns information about a message that is replied by a given message. Also, returns the pinned message, the game message, the invoice message, and the topic creation message for messages of the types messagePinMessage, messageGameScore, messagePaymentSuccessful, messageChatSetBackground and topic messages without replied message respectively
 func (client *Client) GetRepliedMessage(req *GetRepliedMessageRequest) (*Message, error) {
-	result, err := client.Send(Request{
+	return client.GetRepliedMessageContext(context.Background(), req)
+}
+
+// Returns information about a message that is replied by a given message. Also, returns the pinned message, the game message, the invoice message, and the topic creation message for messages of the types messagePinMessage, messageGameScore, messagePaymentSuccessful, messageChatSetBackground and topic messages without replied message respectively
+func (client *Client) GetRepliedMessageContext(ctx context.Context, req *GetRepliedMessageRequest) (*Message, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getRepliedMessage",
 		},
-		Data: map[string]interface{}{
-			"chat_id":    req.ChatId,
-			"message_id": req.MessageId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -1340,15 +1754,26 @@ type GetChatPinnedMessageRequest struct {
 	ChatId int64 `json:"chat_id"`
 }
 
+func (req *GetChatPinnedMessageRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+}
+
+func (req *GetChatPinnedMessageRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns information about a newest pinned message in the chat
 func (client *Client) GetChatPinnedMessage(req *GetChatPinnedMessageRequest) (*Message, error) {
-	result, err := client.Send(Request{
+	return client.GetChatPinnedMessageContext(context.Background(), req)
+}
+
+// Returns information about a newest pinned message in the chat
+func (client *Client) GetChatPinnedMessageContext(ctx context.Context, req *GetChatPinnedMessageRequest) (*Message, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getChatPinnedMessage",
 		},
-		Data: map[string]interface{}{
-			"chat_id": req.ChatId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -1370,17 +1795,37 @@ type GetCallbackQueryMessageRequest struct {
 	CallbackQueryId JsonInt64 `json:"callback_query_id"`
 }
 
+func (req *GetCallbackQueryMessageRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_id", req.MessageId)
+	encoder.JsonInt64("callback_query_id", req.CallbackQueryId)
+}
+
+func (req *GetCallbackQueryMessageRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns information about a message with the callback button that originated a callback query; for bots only
+//
+// Available only to bots, users get FunctionTypeError
 func (client *Client) GetCallbackQueryMessage(req *GetCallbackQueryMessageRequest) (*Message, error) {
-	result, err := client.Send(Request{
+	return client.GetCallbackQueryMessageContext(context.Background(), req)
+}
+
+// Returns information about a message with the callback button that originated a callback query; for bots only
+//
+// Available only to bots, users get FunctionTypeError
+func (client *Client) GetCallbackQueryMessageContext(ctx context.Context, req *GetCallbackQueryMessageRequest) (*Message, error) {
+	err := client.checkBotsOnly("getCallbackQueryMessage")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getCallbackQueryMessage",
 		},
-		Data: map[string]interface{}{
-			"chat_id":           req.ChatId,
-			"message_id":        req.MessageId,
-			"callback_query_id": req.CallbackQueryId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -1400,16 +1845,27 @@ type GetMessagesRequest struct {
 	MessageIds []int64 `json:"message_ids"`
 }
 
+func (req *GetMessagesRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64s("message_ids", req.MessageIds)
+}
+
+func (req *GetMessagesRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns information about messages. If a message is not found, returns null on the corresponding position of the result
 func (client *Client) GetMessages(req *GetMessagesRequest) (*Messages, error) {
-	result, err := client.Send(Request{
+	return client.GetMessagesContext(context.Background(), req)
+}
+
+// Returns information about messages. If a message is not found, returns null on the corresponding position of the result
+func (client *Client) GetMessagesContext(ctx context.Context, req *GetMessagesRequest) (*Messages, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getMessages",
 		},
-		Data: map[string]interface{}{
-			"chat_id":     req.ChatId,
-			"message_ids": req.MessageIds,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -1429,16 +1885,36 @@ type GetMessageThreadRequest struct {
 	MessageId int64 `json:"message_id"`
 }
 
+func (req *GetMessageThreadRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_id", req.MessageId)
+}
+
+func (req *GetMessageThreadRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns information about a message thread. Can be used only if message.can_get_message_thread == true
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetMessageThread(req *GetMessageThreadRequest) (*MessageThreadInfo, error) {
-	result, err := client.Send(Request{
+	return client.GetMessageThreadContext(context.Background(), req)
+}
+
+// Returns information about a message thread. Can be used only if message.can_get_message_thread == true
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetMessageThreadContext(ctx context.Context, req *GetMessageThreadRequest) (*MessageThreadInfo, error) {
+	err := client.checkUsersOnly("getMessageThread")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getMessageThread",
 		},
-		Data: map[string]interface{}{
-			"chat_id":    req.ChatId,
-			"message_id": req.MessageId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -1458,16 +1934,36 @@ type GetMessageViewersRequest struct {
 	MessageId int64 `json:"message_id"`
 }
 
+func (req *GetMessageViewersRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_id", req.MessageId)
+}
+
+func (req *GetMessageViewersRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns viewers of a recent outgoing message in a basic group or a supergroup chat. For video notes and voice notes only users, opened content of the message, are returned. The method can be called if message.can_get_viewers == true
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetMessageViewers(req *GetMessageViewersRequest) (*MessageViewers, error) {
-	result, err := client.Send(Request{
+	return client.GetMessageViewersContext(context.Background(), req)
+}
+
+// Returns viewers of a recent outgoing message in a basic group or a supergroup chat. For video notes and voice notes only users, opened content of the message, are returned. The method can be called if message.can_get_viewers == true
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetMessageViewersContext(ctx context.Context, req *GetMessageViewersRequest) (*MessageViewers, error) {
+	err := client.checkUsersOnly("getMessageViewers")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getMessageViewers",
 		},
-		Data: map[string]interface{}{
-			"chat_id":    req.ChatId,
-			"message_id": req.MessageId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -1485,15 +1981,22 @@ type GetFileRequest struct {
 	FileId int32 `json:"file_id"`
 }
 
+func (req *GetFileRequest) encode(encoder *encoder) {
+	encoder.Int32("file_id", req.FileId)
+}
+
 // Returns information about a file; this is an offline request
 func (client *Client) GetFile(req *GetFileRequest) (*File, error) {
-	result, err := client.Send(Request{
+	return client.GetFileContext(context.Background(), req)
+}
+
+// Returns information about a file; this is an offline request
+func (client *Client) GetFileContext(ctx context.Context, req *GetFileRequest) (*File, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getFile",
 		},
-		Data: map[string]interface{}{
-			"file_id": req.FileId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -1513,16 +2016,24 @@ type GetRemoteFileRequest struct {
 	FileType FileType `json:"file_type"`
 }
 
+func (req *GetRemoteFileRequest) encode(encoder *encoder) {
+	encoder.String("remote_file_id", req.RemoteFileId)
+	encoder.name("file_type")
+	encodeFileType(encoder, req.FileType)
+}
+
 // Returns information about a file by its remote ID; this is an offline request. Can be used to register a URL as a file for further uploading, or sending as a message. Even the request succeeds, the file can be used only if it is still accessible to the user. For example, if the file is from a message, then the message must be not deleted and accessible to the user. If the file database is disabled, then the corresponding object with the file must be preloaded by the application
 func (client *Client) GetRemoteFile(req *GetRemoteFileRequest) (*File, error) {
-	result, err := client.Send(Request{
+	return client.GetRemoteFileContext(context.Background(), req)
+}
+
+// Returns information about a file by its remote ID; this is an offline request. Can be used to register a URL as a file for further uploading, or sending as a message. Even the request succeeds, the file can be used only if it is still accessible to the user. For example, if the file is from a message, then the message must be not deleted and accessible to the user. If the file database is disabled, then the corresponding object with the file must be preloaded by the application
+func (client *Client) GetRemoteFileContext(ctx context.Context, req *GetRemoteFileRequest) (*File, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getRemoteFile",
 		},
-		Data: map[string]interface{}{
-			"remote_file_id": req.RemoteFileId,
-			"file_type":      req.FileType,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -1542,16 +2053,33 @@ type LoadChatsRequest struct {
 	Limit int32 `json:"limit"`
 }
 
+func (req *LoadChatsRequest) encode(encoder *encoder) {
+	encoder.name("chat_list")
+	encodeChatList(encoder, req.ChatList)
+	encoder.Int32("limit", req.Limit)
+}
+
 // Loads more chats from a chat list. The loaded chats and their positions in the chat list will be sent through updates. Chats are sorted by the pair (chat.position.order, chat.id) in descending order. Returns a 404 error if all chats have been loaded
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) LoadChats(req *LoadChatsRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.LoadChatsContext(context.Background(), req)
+}
+
+// Loads more chats from a chat list. The loaded chats and their positions in the chat list will be sent through updates. Chats are sorted by the pair (chat.position.order, chat.id) in descending order. Returns a 404 error if all chats have been loaded
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) LoadChatsContext(ctx context.Context, req *LoadChatsRequest) (*Ok, error) {
+	err := client.checkUsersOnly("loadChats")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "loadChats",
 		},
-		Data: map[string]interface{}{
-			"chat_list": req.ChatList,
-			"limit":     req.Limit,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -1571,16 +2099,33 @@ type GetChatsRequest struct {
 	Limit int32 `json:"limit"`
 }
 
+func (req *GetChatsRequest) encode(encoder *encoder) {
+	encoder.name("chat_list")
+	encodeChatList(encoder, req.ChatList)
+	encoder.Int32("limit", req.Limit)
+}
+
 // Returns an ordered list of chats from the beginning of a chat list. For informational purposes only. Use loadChats and updates processing instead to maintain chat lists in a consistent state
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetChats(req *GetChatsRequest) (*Chats, error) {
-	result, err := client.Send(Request{
+	return client.GetChatsContext(context.Background(), req)
+}
+
+// Returns an ordered list of chats from the beginning of a chat list. For informational purposes only. Use loadChats and updates processing instead to maintain chat lists in a consistent state
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetChatsContext(ctx context.Context, req *GetChatsRequest) (*Chats, error) {
+	err := client.checkUsersOnly("getChats")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getChats",
 		},
-		Data: map[string]interface{}{
-			"chat_list": req.ChatList,
-			"limit":     req.Limit,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -1598,15 +2143,22 @@ type SearchPublicChatRequest struct {
 	Username string `json:"username"`
 }
 
+func (req *SearchPublicChatRequest) encode(encoder *encoder) {
+	encoder.String("username", req.Username)
+}
+
 // Searches a public chat by its username. Currently, only private chats, supergroups and channels can be public. Returns the chat if found; otherwise, an error is returned
 func (client *Client) SearchPublicChat(req *SearchPublicChatRequest) (*Chat, error) {
-	result, err := client.Send(Request{
+	return client.SearchPublicChatContext(context.Background(), req)
+}
+
+// Searches a public chat by its username. Currently, only private chats, supergroups and channels can be public. Returns the chat if found; otherwise, an error is returned
+func (client *Client) SearchPublicChatContext(ctx context.Context, req *SearchPublicChatRequest) (*Chat, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "searchPublicChat",
 		},
-		Data: map[string]interface{}{
-			"username": req.Username,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -1624,15 +2176,31 @@ type SearchPublicChatsRequest struct {
 	Query string `json:"query"`
 }
 
+func (req *SearchPublicChatsRequest) encode(encoder *encoder) {
+	encoder.String("query", req.Query)
+}
+
 // Searches public chats by looking for specified query in their username and title. Currently, only private chats, supergroups and channels can be public. Returns a meaningful number of results. Excludes private chats with contacts and chats from the chat list from the results
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SearchPublicChats(req *SearchPublicChatsRequest) (*Chats, error) {
-	result, err := client.Send(Request{
+	return client.SearchPublicChatsContext(context.Background(), req)
+}
+
+// Searches public chats by looking for specified query in their username and title. Currently, only private chats, supergroups and channels can be public. Returns a meaningful number of results. Excludes private chats with contacts and chats from the chat list from the results
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SearchPublicChatsContext(ctx context.Context, req *SearchPublicChatsRequest) (*Chats, error) {
+	err := client.checkUsersOnly("searchPublicChats")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "searchPublicChats",
 		},
-		Data: map[string]interface{}{
-			"query": req.Query,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -1652,16 +2220,32 @@ type SearchChatsRequest struct {
 	Limit int32 `json:"limit"`
 }
 
+func (req *SearchChatsRequest) encode(encoder *encoder) {
+	encoder.String("query", req.Query)
+	encoder.Int32("limit", req.Limit)
+}
+
 // Searches for the specified query in the title and username of already known chats, this is an offline request. Returns chats in the order seen in the main chat list
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SearchChats(req *SearchChatsRequest) (*Chats, error) {
-	result, err := client.Send(Request{
+	return client.SearchChatsContext(context.Background(), req)
+}
+
+// Searches for the specified query in the title and username of already known chats, this is an offline request. Returns chats in the order seen in the main chat list
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SearchChatsContext(ctx context.Context, req *SearchChatsRequest) (*Chats, error) {
+	err := client.checkUsersOnly("searchChats")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "searchChats",
 		},
-		Data: map[string]interface{}{
-			"query": req.Query,
-			"limit": req.Limit,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -1681,16 +2265,32 @@ type SearchChatsOnServerRequest struct {
 	Limit int32 `json:"limit"`
 }
 
+func (req *SearchChatsOnServerRequest) encode(encoder *encoder) {
+	encoder.String("query", req.Query)
+	encoder.Int32("limit", req.Limit)
+}
+
 // Searches for the specified query in the title and username of already known chats via request to the server. Returns chats in the order seen in the main chat list
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SearchChatsOnServer(req *SearchChatsOnServerRequest) (*Chats, error) {
-	result, err := client.Send(Request{
+	return client.SearchChatsOnServerContext(context.Background(), req)
+}
+
+// Searches for the specified query in the title and username of already known chats via request to the server. Returns chats in the order seen in the main chat list
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SearchChatsOnServerContext(ctx context.Context, req *SearchChatsOnServerRequest) (*Chats, error) {
+	err := client.checkUsersOnly("searchChatsOnServer")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "searchChatsOnServer",
 		},
-		Data: map[string]interface{}{
-			"query": req.Query,
-			"limit": req.Limit,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -1708,15 +2308,32 @@ type SearchChatsNearbyRequest struct {
 	Location *Location `json:"location"`
 }
 
+func (req *SearchChatsNearbyRequest) encode(encoder *encoder) {
+	encoder.name("location")
+	encodeLocation(encoder, req.Location)
+}
+
 // Returns a list of users and location-based supergroups nearby. The list of users nearby will be updated for 60 seconds after the request by the updates updateUsersNearby. The request must be sent again every 25 seconds with adjusted location to not miss new chats
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SearchChatsNearby(req *SearchChatsNearbyRequest) (*ChatsNearby, error) {
-	result, err := client.Send(Request{
+	return client.SearchChatsNearbyContext(context.Background(), req)
+}
+
+// Returns a list of users and location-based supergroups nearby. The list of users nearby will be updated for 60 seconds after the request by the updates updateUsersNearby. The request must be sent again every 25 seconds with adjusted location to not miss new chats
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SearchChatsNearbyContext(ctx context.Context, req *SearchChatsNearbyRequest) (*ChatsNearby, error) {
+	err := client.checkUsersOnly("searchChatsNearby")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "searchChatsNearby",
 		},
-		Data: map[string]interface{}{
-			"location": req.Location,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -1736,16 +2353,33 @@ type GetTopChatsRequest struct {
 	Limit int32 `json:"limit"`
 }
 
+func (req *GetTopChatsRequest) encode(encoder *encoder) {
+	encoder.name("category")
+	encodeTopChatCategory(encoder, req.Category)
+	encoder.Int32("limit", req.Limit)
+}
+
 // Returns a list of frequently used chats. Supported only if the chat info database is enabled
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetTopChats(req *GetTopChatsRequest) (*Chats, error) {
-	result, err := client.Send(Request{
+	return client.GetTopChatsContext(context.Background(), req)
+}
+
+// Returns a list of frequently used chats. Supported only if the chat info database is enabled
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetTopChatsContext(ctx context.Context, req *GetTopChatsRequest) (*Chats, error) {
+	err := client.checkUsersOnly("getTopChats")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getTopChats",
 		},
-		Data: map[string]interface{}{
-			"category": req.Category,
-			"limit":    req.Limit,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -1765,16 +2399,37 @@ type RemoveTopChatRequest struct {
 	ChatId int64 `json:"chat_id"`
 }
 
+func (req *RemoveTopChatRequest) encode(encoder *encoder) {
+	encoder.name("category")
+	encodeTopChatCategory(encoder, req.Category)
+	encoder.Int64("chat_id", req.ChatId)
+}
+
+func (req *RemoveTopChatRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Removes a chat from the list of frequently used chats. Supported only if the chat info database is enabled
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) RemoveTopChat(req *RemoveTopChatRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.RemoveTopChatContext(context.Background(), req)
+}
+
+// Removes a chat from the list of frequently used chats. Supported only if the chat info database is enabled
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) RemoveTopChatContext(ctx context.Context, req *RemoveTopChatRequest) (*Ok, error) {
+	err := client.checkUsersOnly("removeTopChat")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "removeTopChat",
 		},
-		Data: map[string]interface{}{
-			"category": req.Category,
-			"chat_id":  req.ChatId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -1792,15 +2447,35 @@ type AddRecentlyFoundChatRequest struct {
 	ChatId int64 `json:"chat_id"`
 }
 
+func (req *AddRecentlyFoundChatRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+}
+
+func (req *AddRecentlyFoundChatRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Adds a chat to the list of recently found chats. The chat is added to the beginning of the list. If the chat is already in the list, it will be removed from the list first
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) AddRecentlyFoundChat(req *AddRecentlyFoundChatRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.AddRecentlyFoundChatContext(context.Background(), req)
+}
+
+// Adds a chat to the list of recently found chats. The chat is added to the beginning of the list. If the chat is already in the list, it will be removed from the list first
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) AddRecentlyFoundChatContext(ctx context.Context, req *AddRecentlyFoundChatRequest) (*Ok, error) {
+	err := client.checkUsersOnly("addRecentlyFoundChat")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "addRecentlyFoundChat",
 		},
-		Data: map[string]interface{}{
-			"chat_id": req.ChatId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -1818,15 +2493,35 @@ type RemoveRecentlyFoundChatRequest struct {
 	ChatId int64 `json:"chat_id"`
 }
 
+func (req *RemoveRecentlyFoundChatRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+}
+
+func (req *RemoveRecentlyFoundChatRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Removes a chat from the list of recently found chats
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) RemoveRecentlyFoundChat(req *RemoveRecentlyFoundChatRequest) (*Ok, error) {
-	result, err := client.Send(Request{
-		meta: meta{
-			Type: "removeRecentlyFoundChat",
-		},
-		Data: map[string]interface{}{
-			"chat_id": req.ChatId,
+	return client.RemoveRecentlyFoundChatContext(context.Background(), req)
+}
+
+// Removes a chat from the list of recently found chats
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) RemoveRecentlyFoundChatContext(ctx context.Context, req *RemoveRecentlyFoundChatRequest) (*Ok, error) {
+	err := client.checkUsersOnly("removeRecentlyFoundChat")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
+		meta: meta{
+			Type: "removeRecentlyFoundChat",
 		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -1840,12 +2535,25 @@ func (client *Client) RemoveRecentlyFoundChat(req *RemoveRecentlyFoundChatReques
 }
 
 // Clears the list of recently found chats
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ClearRecentlyFoundChats() (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ClearRecentlyFoundChatsContext(context.Background())
+}
+
+// Clears the list of recently found chats
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ClearRecentlyFoundChatsContext(ctx context.Context) (*Ok, error) {
+	err := client.checkUsersOnly("clearRecentlyFoundChats")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "clearRecentlyFoundChats",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -1863,15 +2571,31 @@ type GetRecentlyOpenedChatsRequest struct {
 	Limit int32 `json:"limit"`
 }
 
+func (req *GetRecentlyOpenedChatsRequest) encode(encoder *encoder) {
+	encoder.Int32("limit", req.Limit)
+}
+
 // Returns recently opened chats, this is an offline request. Returns chats in the order of last opening
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetRecentlyOpenedChats(req *GetRecentlyOpenedChatsRequest) (*Chats, error) {
-	result, err := client.Send(Request{
+	return client.GetRecentlyOpenedChatsContext(context.Background(), req)
+}
+
+// Returns recently opened chats, this is an offline request. Returns chats in the order of last opening
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetRecentlyOpenedChatsContext(ctx context.Context, req *GetRecentlyOpenedChatsRequest) (*Chats, error) {
+	err := client.checkUsersOnly("getRecentlyOpenedChats")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getRecentlyOpenedChats",
 		},
-		Data: map[string]interface{}{
-			"limit": req.Limit,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -1891,16 +2615,36 @@ type CheckChatUsernameRequest struct {
 	Username string `json:"username"`
 }
 
+func (req *CheckChatUsernameRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.String("username", req.Username)
+}
+
+func (req *CheckChatUsernameRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Checks whether a username can be set for a chat
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) CheckChatUsername(req *CheckChatUsernameRequest) (CheckChatUsernameResult, error) {
-	result, err := client.Send(Request{
+	return client.CheckChatUsernameContext(context.Background(), req)
+}
+
+// Checks whether a username can be set for a chat
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) CheckChatUsernameContext(ctx context.Context, req *CheckChatUsernameRequest) (CheckChatUsernameResult, error) {
+	err := client.checkUsersOnly("checkChatUsername")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "checkChatUsername",
 		},
-		Data: map[string]interface{}{
-			"chat_id":  req.ChatId,
-			"username": req.Username,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -1910,28 +2654,7 @@ func (client *Client) CheckChatUsername(req *CheckChatUsernameRequest) (CheckCha
 		return nil, buildResponseError(result.Data)
 	}
 
-	switch result.Type {
-	case TypeCheckChatUsernameResultOk:
-		return UnmarshalCheckChatUsernameResultOk(result.Data)
-
-	case TypeCheckChatUsernameResultUsernameInvalid:
-		return UnmarshalCheckChatUsernameResultUsernameInvalid(result.Data)
-
-	case TypeCheckChatUsernameResultUsernameOccupied:
-		return UnmarshalCheckChatUsernameResultUsernameOccupied(result.Data)
-
-	case TypeCheckChatUsernameResultUsernamePurchasable:
-		return UnmarshalCheckChatUsernameResultUsernamePurchasable(result.Data)
-
-	case TypeCheckChatUsernameResultPublicChatsTooMany:
-		return UnmarshalCheckChatUsernameResultPublicChatsTooMany(result.Data)
-
-	case TypeCheckChatUsernameResultPublicGroupsUnavailable:
-		return UnmarshalCheckChatUsernameResultPublicGroupsUnavailable(result.Data)
-
-	default:
-		return nil, errors.New("invalid type")
-	}
+	return UnmarshalCheckChatUsernameResult(result.Data)
 }
 
 type GetCreatedPublicChatsRequest struct {
@@ -1939,15 +2662,32 @@ type GetCreatedPublicChatsRequest struct {
 	Type PublicChatType `json:"type"`
 }
 
+func (req *GetCreatedPublicChatsRequest) encode(encoder *encoder) {
+	encoder.name("type")
+	encodePublicChatType(encoder, req.Type)
+}
+
 // Returns a list of public chats of the specified type, owned by the user
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetCreatedPublicChats(req *GetCreatedPublicChatsRequest) (*Chats, error) {
-	result, err := client.Send(Request{
+	return client.GetCreatedPublicChatsContext(context.Background(), req)
+}
+
+// Returns a list of public chats of the specified type, owned by the user
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetCreatedPublicChatsContext(ctx context.Context, req *GetCreatedPublicChatsRequest) (*Chats, error) {
+	err := client.checkUsersOnly("getCreatedPublicChats")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getCreatedPublicChats",
 		},
-		Data: map[string]interface{}{
-			"type": req.Type,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -1965,15 +2705,32 @@ type CheckCreatedPublicChatsLimitRequest struct {
 	Type PublicChatType `json:"type"`
 }
 
+func (req *CheckCreatedPublicChatsLimitRequest) encode(encoder *encoder) {
+	encoder.name("type")
+	encodePublicChatType(encoder, req.Type)
+}
+
 // Checks whether the maximum number of owned public chats has been reached. Returns corresponding error if the limit was reached. The limit can be increased with Telegram Premium
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) CheckCreatedPublicChatsLimit(req *CheckCreatedPublicChatsLimitRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.CheckCreatedPublicChatsLimitContext(context.Background(), req)
+}
+
+// Checks whether the maximum number of owned public chats has been reached. Returns corresponding error if the limit was reached. The limit can be increased with Telegram Premium
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) CheckCreatedPublicChatsLimitContext(ctx context.Context, req *CheckCreatedPublicChatsLimitRequest) (*Ok, error) {
+	err := client.checkUsersOnly("checkCreatedPublicChatsLimit")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "checkCreatedPublicChatsLimit",
 		},
-		Data: map[string]interface{}{
-			"type": req.Type,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -1987,12 +2744,25 @@ func (client *Client) CheckCreatedPublicChatsLimit(req *CheckCreatedPublicChatsL
 }
 
 // Returns a list of basic group and supergroup chats, which can be used as a discussion group for a channel. Returned basic group chats must be first upgraded to supergroups before they can be set as a discussion group. To set a returned supergroup as a discussion group, access to its old messages must be enabled using toggleSupergroupIsAllHistoryAvailable first
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetSuitableDiscussionChats() (*Chats, error) {
-	result, err := client.Send(Request{
+	return client.GetSuitableDiscussionChatsContext(context.Background())
+}
+
+// Returns a list of basic group and supergroup chats, which can be used as a discussion group for a channel. Returned basic group chats must be first upgraded to supergroups before they can be set as a discussion group. To set a returned supergroup as a discussion group, access to its old messages must be enabled using toggleSupergroupIsAllHistoryAvailable first
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetSuitableDiscussionChatsContext(ctx context.Context) (*Chats, error) {
+	err := client.checkUsersOnly("getSuitableDiscussionChats")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getSuitableDiscussionChats",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -2006,12 +2776,25 @@ func (client *Client) GetSuitableDiscussionChats() (*Chats, error) {
 }
 
 // Returns a list of recently inactive supergroups and channels. Can be used when user reaches limit on the number of joined supergroups and channels and receives CHANNELS_TOO_MUCH error. Also, the limit can be increased with Telegram Premium
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetInactiveSupergroupChats() (*Chats, error) {
-	result, err := client.Send(Request{
+	return client.GetInactiveSupergroupChatsContext(context.Background())
+}
+
+// Returns a list of recently inactive supergroups and channels. Can be used when user reaches limit on the number of joined supergroups and channels and receives CHANNELS_TOO_MUCH error. Also, the limit can be increased with Telegram Premium
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetInactiveSupergroupChatsContext(ctx context.Context) (*Chats, error) {
+	err := client.checkUsersOnly("getInactiveSupergroupChats")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getInactiveSupergroupChats",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -2033,17 +2816,33 @@ type GetGroupsInCommonRequest struct {
 	Limit int32 `json:"limit"`
 }
 
+func (req *GetGroupsInCommonRequest) encode(encoder *encoder) {
+	encoder.Int64("user_id", req.UserId)
+	encoder.Int64("offset_chat_id", req.OffsetChatId)
+	encoder.Int32("limit", req.Limit)
+}
+
 // Returns a list of common group chats with a given user. Chats are sorted by their type and creation date
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetGroupsInCommon(req *GetGroupsInCommonRequest) (*Chats, error) {
-	result, err := client.Send(Request{
+	return client.GetGroupsInCommonContext(context.Background(), req)
+}
+
+// Returns a list of common group chats with a given user. Chats are sorted by their type and creation date
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetGroupsInCommonContext(ctx context.Context, req *GetGroupsInCommonRequest) (*Chats, error) {
+	err := client.checkUsersOnly("getGroupsInCommon")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getGroupsInCommon",
 		},
-		Data: map[string]interface{}{
-			"user_id":        req.UserId,
-			"offset_chat_id": req.OffsetChatId,
-			"limit":          req.Limit,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -2069,19 +2868,39 @@ type GetChatHistoryRequest struct {
 	OnlyLocal bool `json:"only_local"`
 }
 
+func (req *GetChatHistoryRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("from_message_id", req.FromMessageId)
+	encoder.Int32("offset", req.Offset)
+	encoder.Int32("limit", req.Limit)
+	encoder.Bool("only_local", req.OnlyLocal)
+}
+
+func (req *GetChatHistoryRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns messages in a chat. The messages are returned in a reverse chronological order (i.e., in order of decreasing message_id). For optimal performance, the number of returned messages is chosen by TDLib. This is an offline request if only_local is true
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetChatHistory(req *GetChatHistoryRequest) (*Messages, error) {
-	result, err := client.Send(Request{
+	return client.GetChatHistoryContext(context.Background(), req)
+}
+
+// Returns messages in a chat. The messages are returned in a reverse chronological order (i.e., in order of decreasing message_id). For optimal performance, the number of returned messages is chosen by TDLib. This is an offline request if only_local is true
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetChatHistoryContext(ctx context.Context, req *GetChatHistoryRequest) (*Messages, error) {
+	err := client.checkUsersOnly("getChatHistory")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getChatHistory",
 		},
-		Data: map[string]interface{}{
-			"chat_id":         req.ChatId,
-			"from_message_id": req.FromMessageId,
-			"offset":          req.Offset,
-			"limit":           req.Limit,
-			"only_local":      req.OnlyLocal,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -2107,19 +2926,39 @@ type GetMessageThreadHistoryRequest struct {
 	Limit int32 `json:"limit"`
 }
 
+func (req *GetMessageThreadHistoryRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_id", req.MessageId)
+	encoder.Int64("from_message_id", req.FromMessageId)
+	encoder.Int32("offset", req.Offset)
+	encoder.Int32("limit", req.Limit)
+}
+
+func (req *GetMessageThreadHistoryRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns messages in a message thread of a message. Can be used only if message.can_get_message_thread == true. Message thread of a channel message is in the channel's linked supergroup. The messages are returned in a reverse chronological order (i.e., in order of decreasing message_id). For optimal performance, the number of returned messages is chosen by TDLib
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetMessageThreadHistory(req *GetMessageThreadHistoryRequest) (*Messages, error) {
-	result, err := client.Send(Request{
+	return client.GetMessageThreadHistoryContext(context.Background(), req)
+}
+
+// Returns messages in a message thread of a message. Can be used only if message.can_get_message_thread == true. Message thread of a channel message is in the channel's linked supergroup. The messages are returned in a reverse chronological order (i.e., in order of decreasing message_id). For optimal performance, the number of returned messages is chosen by TDLib
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetMessageThreadHistoryContext(ctx context.Context, req *GetMessageThreadHistoryRequest) (*Messages, error) {
+	err := client.checkUsersOnly("getMessageThreadHistory")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getMessageThreadHistory",
 		},
-		Data: map[string]interface{}{
-			"chat_id":         req.ChatId,
-			"message_id":      req.MessageId,
-			"from_message_id": req.FromMessageId,
-			"offset":          req.Offset,
-			"limit":           req.Limit,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -2141,17 +2980,37 @@ type DeleteChatHistoryRequest struct {
 	Revoke bool `json:"revoke"`
 }
 
+func (req *DeleteChatHistoryRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Bool("remove_from_chat_list", req.RemoveFromChatList)
+	encoder.Bool("revoke", req.Revoke)
+}
+
+func (req *DeleteChatHistoryRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Deletes all messages in the chat. Use chat.can_be_deleted_only_for_self and chat.can_be_deleted_for_all_users fields to find whether and how the method can be applied to the chat
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) DeleteChatHistory(req *DeleteChatHistoryRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.DeleteChatHistoryContext(context.Background(), req)
+}
+
+// Deletes all messages in the chat. Use chat.can_be_deleted_only_for_self and chat.can_be_deleted_for_all_users fields to find whether and how the method can be applied to the chat
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) DeleteChatHistoryContext(ctx context.Context, req *DeleteChatHistoryRequest) (*Ok, error) {
+	err := client.checkUsersOnly("deleteChatHistory")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "deleteChatHistory",
 		},
-		Data: map[string]interface{}{
-			"chat_id":               req.ChatId,
-			"remove_from_chat_list": req.RemoveFromChatList,
-			"revoke":                req.Revoke,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -2169,15 +3028,35 @@ type DeleteChatRequest struct {
 	ChatId int64 `json:"chat_id"`
 }
 
+func (req *DeleteChatRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+}
+
+func (req *DeleteChatRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Deletes a chat along with all messages in the corresponding chat for all chat members. For group chats this will release the usernames and remove all members. Use the field chat.can_be_deleted_for_all_users to find whether the method can be applied to the chat
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) DeleteChat(req *DeleteChatRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.DeleteChatContext(context.Background(), req)
+}
+
+// Deletes a chat along with all messages in the corresponding chat for all chat members. For group chats this will release the usernames and remove all members. Use the field chat.can_be_deleted_for_all_users to find whether the method can be applied to the chat
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) DeleteChatContext(ctx context.Context, req *DeleteChatRequest) (*Ok, error) {
+	err := client.checkUsersOnly("deleteChat")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "deleteChat",
 		},
-		Data: map[string]interface{}{
-			"chat_id": req.ChatId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -2209,22 +3088,44 @@ type SearchChatMessagesRequest struct {
 	MessageThreadId int64 `json:"message_thread_id"`
 }
 
+func (req *SearchChatMessagesRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.String("query", req.Query)
+	encoder.name("sender_id")
+	encodeMessageSender(encoder, req.SenderId)
+	encoder.Int64("from_message_id", req.FromMessageId)
+	encoder.Int32("offset", req.Offset)
+	encoder.Int32("limit", req.Limit)
+	encoder.name("filter")
+	encodeSearchMessagesFilter(encoder, req.Filter)
+	encoder.Int64("message_thread_id", req.MessageThreadId)
+}
+
+func (req *SearchChatMessagesRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Searches for messages with given words in the chat. Returns the results in reverse chronological order, i.e. in order of decreasing message_id. Cannot be used in secret chats with a non-empty query (searchSecretMessages must be used instead), or without an enabled message database. For optimal performance, the number of returned messages is chosen by TDLib and can be smaller than the specified limit. A combination of query, sender_id, filter and message_thread_id search criteria is expected to be supported, only if it is required for Telegram official application implementation
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SearchChatMessages(req *SearchChatMessagesRequest) (*FoundChatMessages, error) {
-	result, err := client.Send(Request{
+	return client.SearchChatMessagesContext(context.Background(), req)
+}
+
+// Searches for messages with given words in the chat. Returns the results in reverse chronological order, i.e. in order of decreasing message_id. Cannot be used in secret chats with a non-empty query (searchSecretMessages must be used instead), or without an enabled message database. For optimal performance, the number of returned messages is chosen by TDLib and can be smaller than the specified limit. A combination of query, sender_id, filter and message_thread_id search criteria is expected to be supported, only if it is required for Telegram official application implementation
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SearchChatMessagesContext(ctx context.Context, req *SearchChatMessagesRequest) (*FoundChatMessages, error) {
+	err := client.checkUsersOnly("searchChatMessages")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "searchChatMessages",
 		},
-		Data: map[string]interface{}{
-			"chat_id":           req.ChatId,
-			"query":             req.Query,
-			"sender_id":         req.SenderId,
-			"from_message_id":   req.FromMessageId,
-			"offset":            req.Offset,
-			"limit":             req.Limit,
-			"filter":            req.Filter,
-			"message_thread_id": req.MessageThreadId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -2254,21 +3155,39 @@ type SearchMessagesRequest struct {
 	MaxDate int32 `json:"max_date"`
 }
 
+func (req *SearchMessagesRequest) encode(encoder *encoder) {
+	encoder.name("chat_list")
+	encodeChatList(encoder, req.ChatList)
+	encoder.String("query", req.Query)
+	encoder.String("offset", req.Offset)
+	encoder.Int32("limit", req.Limit)
+	encoder.name("filter")
+	encodeSearchMessagesFilter(encoder, req.Filter)
+	encoder.Int32("min_date", req.MinDate)
+	encoder.Int32("max_date", req.MaxDate)
+}
+
 // Searches for messages in all chats except secret chats. Returns the results in reverse chronological order (i.e., in order of decreasing (date, chat_id, message_id)). For optimal performance, the number of returned messages is chosen by TDLib and can be smaller than the specified limit
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SearchMessages(req *SearchMessagesRequest) (*FoundMessages, error) {
-	result, err := client.Send(Request{
+	return client.SearchMessagesContext(context.Background(), req)
+}
+
+// Searches for messages in all chats except secret chats. Returns the results in reverse chronological order (i.e., in order of decreasing (date, chat_id, message_id)). For optimal performance, the number of returned messages is chosen by TDLib and can be smaller than the specified limit
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SearchMessagesContext(ctx context.Context, req *SearchMessagesRequest) (*FoundMessages, error) {
+	err := client.checkUsersOnly("searchMessages")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "searchMessages",
 		},
-		Data: map[string]interface{}{
-			"chat_list": req.ChatList,
-			"query":     req.Query,
-			"offset":    req.Offset,
-			"limit":     req.Limit,
-			"filter":    req.Filter,
-			"min_date":  req.MinDate,
-			"max_date":  req.MaxDate,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -2294,19 +3213,40 @@ type SearchSecretMessagesRequest struct {
 	Filter SearchMessagesFilter `json:"filter"`
 }
 
+func (req *SearchSecretMessagesRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.String("query", req.Query)
+	encoder.String("offset", req.Offset)
+	encoder.Int32("limit", req.Limit)
+	encoder.name("filter")
+	encodeSearchMessagesFilter(encoder, req.Filter)
+}
+
+func (req *SearchSecretMessagesRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Searches for messages in secret chats. Returns the results in reverse chronological order. For optimal performance, the number of returned messages is chosen by TDLib
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SearchSecretMessages(req *SearchSecretMessagesRequest) (*FoundMessages, error) {
-	result, err := client.Send(Request{
+	return client.SearchSecretMessagesContext(context.Background(), req)
+}
+
+// Searches for messages in secret chats. Returns the results in reverse chronological order. For optimal performance, the number of returned messages is chosen by TDLib
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SearchSecretMessagesContext(ctx context.Context, req *SearchSecretMessagesRequest) (*FoundMessages, error) {
+	err := client.checkUsersOnly("searchSecretMessages")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "searchSecretMessages",
 		},
-		Data: map[string]interface{}{
-			"chat_id": req.ChatId,
-			"query":   req.Query,
-			"offset":  req.Offset,
-			"limit":   req.Limit,
-			"filter":  req.Filter,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -2328,17 +3268,33 @@ type SearchCallMessagesRequest struct {
 	OnlyMissed bool `json:"only_missed"`
 }
 
+func (req *SearchCallMessagesRequest) encode(encoder *encoder) {
+	encoder.String("offset", req.Offset)
+	encoder.Int32("limit", req.Limit)
+	encoder.Bool("only_missed", req.OnlyMissed)
+}
+
 // Searches for call messages. Returns the results in reverse chronological order (i.e., in order of decreasing message_id). For optimal performance, the number of returned messages is chosen by TDLib
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SearchCallMessages(req *SearchCallMessagesRequest) (*FoundMessages, error) {
-	result, err := client.Send(Request{
+	return client.SearchCallMessagesContext(context.Background(), req)
+}
+
+// Searches for call messages. Returns the results in reverse chronological order (i.e., in order of decreasing message_id). For optimal performance, the number of returned messages is chosen by TDLib
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SearchCallMessagesContext(ctx context.Context, req *SearchCallMessagesRequest) (*FoundMessages, error) {
+	err := client.checkUsersOnly("searchCallMessages")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "searchCallMessages",
 		},
-		Data: map[string]interface{}{
-			"offset":      req.Offset,
-			"limit":       req.Limit,
-			"only_missed": req.OnlyMissed,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -2358,16 +3314,32 @@ type SearchOutgoingDocumentMessagesRequest struct {
 	Limit int32 `json:"limit"`
 }
 
+func (req *SearchOutgoingDocumentMessagesRequest) encode(encoder *encoder) {
+	encoder.String("query", req.Query)
+	encoder.Int32("limit", req.Limit)
+}
+
 // Searches for outgoing messages with content of the type messageDocument in all chats except secret chats. Returns the results in reverse chronological order
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SearchOutgoingDocumentMessages(req *SearchOutgoingDocumentMessagesRequest) (*FoundMessages, error) {
-	result, err := client.Send(Request{
+	return client.SearchOutgoingDocumentMessagesContext(context.Background(), req)
+}
+
+// Searches for outgoing messages with content of the type messageDocument in all chats except secret chats. Returns the results in reverse chronological order
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SearchOutgoingDocumentMessagesContext(ctx context.Context, req *SearchOutgoingDocumentMessagesRequest) (*FoundMessages, error) {
+	err := client.checkUsersOnly("searchOutgoingDocumentMessages")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "searchOutgoingDocumentMessages",
 		},
-		Data: map[string]interface{}{
-			"query": req.Query,
-			"limit": req.Limit,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -2385,15 +3357,31 @@ type DeleteAllCallMessagesRequest struct {
 	Revoke bool `json:"revoke"`
 }
 
+func (req *DeleteAllCallMessagesRequest) encode(encoder *encoder) {
+	encoder.Bool("revoke", req.Revoke)
+}
+
 // Deletes all call messages
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) DeleteAllCallMessages(req *DeleteAllCallMessagesRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.DeleteAllCallMessagesContext(context.Background(), req)
+}
+
+// Deletes all call messages
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) DeleteAllCallMessagesContext(ctx context.Context, req *DeleteAllCallMessagesRequest) (*Ok, error) {
+	err := client.checkUsersOnly("deleteAllCallMessages")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "deleteAllCallMessages",
 		},
-		Data: map[string]interface{}{
-			"revoke": req.Revoke,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -2413,16 +3401,36 @@ type SearchChatRecentLocationMessagesRequest struct {
 	Limit int32 `json:"limit"`
 }
 
+func (req *SearchChatRecentLocationMessagesRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int32("limit", req.Limit)
+}
+
+func (req *SearchChatRecentLocationMessagesRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns information about the recent locations of chat members that were sent to the chat. Returns up to 1 location message per user
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SearchChatRecentLocationMessages(req *SearchChatRecentLocationMessagesRequest) (*Messages, error) {
-	result, err := client.Send(Request{
+	return client.SearchChatRecentLocationMessagesContext(context.Background(), req)
+}
+
+// Returns information about the recent locations of chat members that were sent to the chat. Returns up to 1 location message per user
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SearchChatRecentLocationMessagesContext(ctx context.Context, req *SearchChatRecentLocationMessagesRequest) (*Messages, error) {
+	err := client.checkUsersOnly("searchChatRecentLocationMessages")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "searchChatRecentLocationMessages",
 		},
-		Data: map[string]interface{}{
-			"chat_id": req.ChatId,
-			"limit":   req.Limit,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -2436,12 +3444,25 @@ func (client *Client) SearchChatRecentLocationMessages(req *SearchChatRecentLoca
 }
 
 // Returns all active live locations that need to be updated by the application. The list is persistent across application restarts only if the message database is used
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetActiveLiveLocationMessages() (*Messages, error) {
-	result, err := client.Send(Request{
+	return client.GetActiveLiveLocationMessagesContext(context.Background())
+}
+
+// Returns all active live locations that need to be updated by the application. The list is persistent across application restarts only if the message database is used
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetActiveLiveLocationMessagesContext(ctx context.Context) (*Messages, error) {
+	err := client.checkUsersOnly("getActiveLiveLocationMessages")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getActiveLiveLocationMessages",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -2461,16 +3482,27 @@ type GetChatMessageByDateRequest struct {
 	Date int32 `json:"date"`
 }
 
+func (req *GetChatMessageByDateRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int32("date", req.Date)
+}
+
+func (req *GetChatMessageByDateRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns the last message sent in a chat no later than the specified date
 func (client *Client) GetChatMessageByDate(req *GetChatMessageByDateRequest) (*Message, error) {
-	result, err := client.Send(Request{
+	return client.GetChatMessageByDateContext(context.Background(), req)
+}
+
+// Returns the last message sent in a chat no later than the specified date
+func (client *Client) GetChatMessageByDateContext(ctx context.Context, req *GetChatMessageByDateRequest) (*Message, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getChatMessageByDate",
 		},
-		Data: map[string]interface{}{
-			"chat_id": req.ChatId,
-			"date":    req.Date,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -2494,18 +3526,39 @@ type GetChatSparseMessagePositionsRequest struct {
 	Limit int32 `json:"limit"`
 }
 
+func (req *GetChatSparseMessagePositionsRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.name("filter")
+	encodeSearchMessagesFilter(encoder, req.Filter)
+	encoder.Int64("from_message_id", req.FromMessageId)
+	encoder.Int32("limit", req.Limit)
+}
+
+func (req *GetChatSparseMessagePositionsRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns sparse positions of messages of the specified type in the chat to be used for shared media scroll implementation. Returns the results in reverse chronological order (i.e., in order of decreasing message_id). Cannot be used in secret chats or with searchMessagesFilterFailedToSend filter without an enabled message database
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetChatSparseMessagePositions(req *GetChatSparseMessagePositionsRequest) (*MessagePositions, error) {
-	result, err := client.Send(Request{
+	return client.GetChatSparseMessagePositionsContext(context.Background(), req)
+}
+
+// Returns sparse positions of messages of the specified type in the chat to be used for shared media scroll implementation. Returns the results in reverse chronological order (i.e., in order of decreasing message_id). Cannot be used in secret chats or with searchMessagesFilterFailedToSend filter without an enabled message database
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetChatSparseMessagePositionsContext(ctx context.Context, req *GetChatSparseMessagePositionsRequest) (*MessagePositions, error) {
+	err := client.checkUsersOnly("getChatSparseMessagePositions")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getChatSparseMessagePositions",
 		},
-		Data: map[string]interface{}{
-			"chat_id":         req.ChatId,
-			"filter":          req.Filter,
-			"from_message_id": req.FromMessageId,
-			"limit":           req.Limit,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -2527,17 +3580,38 @@ type GetChatMessageCalendarRequest struct {
 	FromMessageId int64 `json:"from_message_id"`
 }
 
+func (req *GetChatMessageCalendarRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.name("filter")
+	encodeSearchMessagesFilter(encoder, req.Filter)
+	encoder.Int64("from_message_id", req.FromMessageId)
+}
+
+func (req *GetChatMessageCalendarRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns information about the next messages of the specified type in the chat split by days. Returns the results in reverse chronological order. Can return partial result for the last returned day. Behavior of this method depends on the value of the option "utc_time_offset"
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetChatMessageCalendar(req *GetChatMessageCalendarRequest) (*MessageCalendar, error) {
-	result, err := client.Send(Request{
+	return client.GetChatMessageCalendarContext(context.Background(), req)
+}
+
+// Returns information about the next messages of the specified type in the chat split by days. Returns the results in reverse chronological order. Can return partial result for the last returned day. Behavior of this method depends on the value of the option "utc_time_offset"
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetChatMessageCalendarContext(ctx context.Context, req *GetChatMessageCalendarRequest) (*MessageCalendar, error) {
+	err := client.checkUsersOnly("getChatMessageCalendar")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getChatMessageCalendar",
 		},
-		Data: map[string]interface{}{
-			"chat_id":         req.ChatId,
-			"filter":          req.Filter,
-			"from_message_id": req.FromMessageId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -2559,17 +3633,38 @@ type GetChatMessageCountRequest struct {
 	ReturnLocal bool `json:"return_local"`
 }
 
+func (req *GetChatMessageCountRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.name("filter")
+	encodeSearchMessagesFilter(encoder, req.Filter)
+	encoder.Bool("return_local", req.ReturnLocal)
+}
+
+func (req *GetChatMessageCountRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns approximate number of messages of the specified type in the chat
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetChatMessageCount(req *GetChatMessageCountRequest) (*Count, error) {
-	result, err := client.Send(Request{
+	return client.GetChatMessageCountContext(context.Background(), req)
+}
+
+// Returns approximate number of messages of the specified type in the chat
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetChatMessageCountContext(ctx context.Context, req *GetChatMessageCountRequest) (*Count, error) {
+	err := client.checkUsersOnly("getChatMessageCount")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getChatMessageCount",
 		},
-		Data: map[string]interface{}{
-			"chat_id":      req.ChatId,
-			"filter":       req.Filter,
-			"return_local": req.ReturnLocal,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -2593,18 +3688,39 @@ type GetChatMessagePositionRequest struct {
 	MessageThreadId int64 `json:"message_thread_id"`
 }
 
+func (req *GetChatMessagePositionRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_id", req.MessageId)
+	encoder.name("filter")
+	encodeSearchMessagesFilter(encoder, req.Filter)
+	encoder.Int64("message_thread_id", req.MessageThreadId)
+}
+
+func (req *GetChatMessagePositionRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns approximate 1-based position of a message among messages, which can be found by the specified filter in the chat. Cannot be used in secret chats
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetChatMessagePosition(req *GetChatMessagePositionRequest) (*Count, error) {
-	result, err := client.Send(Request{
+	return client.GetChatMessagePositionContext(context.Background(), req)
+}
+
+// Returns approximate 1-based position of a message among messages, which can be found by the specified filter in the chat. Cannot be used in secret chats
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetChatMessagePositionContext(ctx context.Context, req *GetChatMessagePositionRequest) (*Count, error) {
+	err := client.checkUsersOnly("getChatMessagePosition")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getChatMessagePosition",
 		},
-		Data: map[string]interface{}{
-			"chat_id":           req.ChatId,
-			"message_id":        req.MessageId,
-			"filter":            req.Filter,
-			"message_thread_id": req.MessageThreadId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -2622,15 +3738,35 @@ type GetChatScheduledMessagesRequest struct {
 	ChatId int64 `json:"chat_id"`
 }
 
+func (req *GetChatScheduledMessagesRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+}
+
+func (req *GetChatScheduledMessagesRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns all scheduled messages in a chat. The messages are returned in a reverse chronological order (i.e., in order of decreasing message_id)
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetChatScheduledMessages(req *GetChatScheduledMessagesRequest) (*Messages, error) {
-	result, err := client.Send(Request{
+	return client.GetChatScheduledMessagesContext(context.Background(), req)
+}
+
+// Returns all scheduled messages in a chat. The messages are returned in a reverse chronological order (i.e., in order of decreasing message_id)
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetChatScheduledMessagesContext(ctx context.Context, req *GetChatScheduledMessagesRequest) (*Messages, error) {
+	err := client.checkUsersOnly("getChatScheduledMessages")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getChatScheduledMessages",
 		},
-		Data: map[string]interface{}{
-			"chat_id": req.ChatId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -2654,18 +3790,38 @@ type GetMessagePublicForwardsRequest struct {
 	Limit int32 `json:"limit"`
 }
 
+func (req *GetMessagePublicForwardsRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_id", req.MessageId)
+	encoder.String("offset", req.Offset)
+	encoder.Int32("limit", req.Limit)
+}
+
+func (req *GetMessagePublicForwardsRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns forwarded copies of a channel message to different public channels. For optimal performance, the number of returned messages is chosen by TDLib
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetMessagePublicForwards(req *GetMessagePublicForwardsRequest) (*FoundMessages, error) {
-	result, err := client.Send(Request{
+	return client.GetMessagePublicForwardsContext(context.Background(), req)
+}
+
+// Returns forwarded copies of a channel message to different public channels. For optimal performance, the number of returned messages is chosen by TDLib
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetMessagePublicForwardsContext(ctx context.Context, req *GetMessagePublicForwardsRequest) (*FoundMessages, error) {
+	err := client.checkUsersOnly("getMessagePublicForwards")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getMessagePublicForwards",
 		},
-		Data: map[string]interface{}{
-			"chat_id":    req.ChatId,
-			"message_id": req.MessageId,
-			"offset":     req.Offset,
-			"limit":      req.Limit,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -2683,15 +3839,35 @@ type GetChatSponsoredMessagesRequest struct {
 	ChatId int64 `json:"chat_id"`
 }
 
+func (req *GetChatSponsoredMessagesRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+}
+
+func (req *GetChatSponsoredMessagesRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns sponsored messages to be shown in a chat; for channel chats only
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetChatSponsoredMessages(req *GetChatSponsoredMessagesRequest) (*SponsoredMessages, error) {
-	result, err := client.Send(Request{
+	return client.GetChatSponsoredMessagesContext(context.Background(), req)
+}
+
+// Returns sponsored messages to be shown in a chat; for channel chats only
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetChatSponsoredMessagesContext(ctx context.Context, req *GetChatSponsoredMessagesRequest) (*SponsoredMessages, error) {
+	err := client.checkUsersOnly("getChatSponsoredMessages")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getChatSponsoredMessages",
 		},
-		Data: map[string]interface{}{
-			"chat_id": req.ChatId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -2711,16 +3887,32 @@ type RemoveNotificationRequest struct {
 	NotificationId int32 `json:"notification_id"`
 }
 
+func (req *RemoveNotificationRequest) encode(encoder *encoder) {
+	encoder.Int32("notification_group_id", req.NotificationGroupId)
+	encoder.Int32("notification_id", req.NotificationId)
+}
+
 // Removes an active notification from notification list. Needs to be called only if the notification is removed by the current user
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) RemoveNotification(req *RemoveNotificationRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.RemoveNotificationContext(context.Background(), req)
+}
+
+// Removes an active notification from notification list. Needs to be called only if the notification is removed by the current user
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) RemoveNotificationContext(ctx context.Context, req *RemoveNotificationRequest) (*Ok, error) {
+	err := client.checkUsersOnly("removeNotification")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "removeNotification",
 		},
-		Data: map[string]interface{}{
-			"notification_group_id": req.NotificationGroupId,
-			"notification_id":       req.NotificationId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -2740,16 +3932,32 @@ type RemoveNotificationGroupRequest struct {
 	MaxNotificationId int32 `json:"max_notification_id"`
 }
 
+func (req *RemoveNotificationGroupRequest) encode(encoder *encoder) {
+	encoder.Int32("notification_group_id", req.NotificationGroupId)
+	encoder.Int32("max_notification_id", req.MaxNotificationId)
+}
+
 // Removes a group of active notifications. Needs to be called only if the notification group is removed by the current user
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) RemoveNotificationGroup(req *RemoveNotificationGroupRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.RemoveNotificationGroupContext(context.Background(), req)
+}
+
+// Removes a group of active notifications. Needs to be called only if the notification group is removed by the current user
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) RemoveNotificationGroupContext(ctx context.Context, req *RemoveNotificationGroupRequest) (*Ok, error) {
+	err := client.checkUsersOnly("removeNotificationGroup")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "removeNotificationGroup",
 		},
-		Data: map[string]interface{}{
-			"notification_group_id": req.NotificationGroupId,
-			"max_notification_id":   req.MaxNotificationId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -2775,19 +3983,30 @@ type GetMessageLinkRequest struct {
 	InMessageThread bool `json:"in_message_thread"`
 }
 
+func (req *GetMessageLinkRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_id", req.MessageId)
+	encoder.Int32("media_timestamp", req.MediaTimestamp)
+	encoder.Bool("for_album", req.ForAlbum)
+	encoder.Bool("in_message_thread", req.InMessageThread)
+}
+
+func (req *GetMessageLinkRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns an HTTPS link to a message in a chat. Available only for already sent messages in supergroups and channels, or if message.can_get_media_timestamp_links and a media timestamp link is generated. This is an offline request
 func (client *Client) GetMessageLink(req *GetMessageLinkRequest) (*MessageLink, error) {
-	result, err := client.Send(Request{
+	return client.GetMessageLinkContext(context.Background(), req)
+}
+
+// Returns an HTTPS link to a message in a chat. Available only for already sent messages in supergroups and channels, or if message.can_get_media_timestamp_links and a media timestamp link is generated. This is an offline request
+func (client *Client) GetMessageLinkContext(ctx context.Context, req *GetMessageLinkRequest) (*MessageLink, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getMessageLink",
 		},
-		Data: map[string]interface{}{
-			"chat_id":           req.ChatId,
-			"message_id":        req.MessageId,
-			"media_timestamp":   req.MediaTimestamp,
-			"for_album":         req.ForAlbum,
-			"in_message_thread": req.InMessageThread,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -2809,17 +4028,37 @@ type GetMessageEmbeddingCodeRequest struct {
 	ForAlbum bool `json:"for_album"`
 }
 
+func (req *GetMessageEmbeddingCodeRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_id", req.MessageId)
+	encoder.Bool("for_album", req.ForAlbum)
+}
+
+func (req *GetMessageEmbeddingCodeRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns an HTML code for embedding the message. Available only for messages in supergroups and channels with a username
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetMessageEmbeddingCode(req *GetMessageEmbeddingCodeRequest) (*Text, error) {
-	result, err := client.Send(Request{
+	return client.GetMessageEmbeddingCodeContext(context.Background(), req)
+}
+
+// Returns an HTML code for embedding the message. Available only for messages in supergroups and channels with a username
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetMessageEmbeddingCodeContext(ctx context.Context, req *GetMessageEmbeddingCodeRequest) (*Text, error) {
+	err := client.checkUsersOnly("getMessageEmbeddingCode")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getMessageEmbeddingCode",
 		},
-		Data: map[string]interface{}{
-			"chat_id":    req.ChatId,
-			"message_id": req.MessageId,
-			"for_album":  req.ForAlbum,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -2837,15 +4076,22 @@ type GetMessageLinkInfoRequest struct {
 	Url string `json:"url"`
 }
 
+func (req *GetMessageLinkInfoRequest) encode(encoder *encoder) {
+	encoder.String("url", req.Url)
+}
+
 // Returns information about a public or private message link. Can be called for any internal link of the type internalLinkTypeMessage
 func (client *Client) GetMessageLinkInfo(req *GetMessageLinkInfoRequest) (*MessageLinkInfo, error) {
-	result, err := client.Send(Request{
+	return client.GetMessageLinkInfoContext(context.Background(), req)
+}
+
+// Returns information about a public or private message link. Can be called for any internal link of the type internalLinkTypeMessage
+func (client *Client) GetMessageLinkInfoContext(ctx context.Context, req *GetMessageLinkInfoRequest) (*MessageLinkInfo, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getMessageLinkInfo",
 		},
-		Data: map[string]interface{}{
-			"url": req.Url,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -2865,16 +4111,33 @@ type TranslateTextRequest struct {
 	ToLanguageCode string `json:"to_language_code"`
 }
 
+func (req *TranslateTextRequest) encode(encoder *encoder) {
+	encoder.name("text")
+	encodeFormattedText(encoder, req.Text)
+	encoder.String("to_language_code", req.ToLanguageCode)
+}
+
 // Translates a text to the given language. If the current user is a Telegram Premium user, then text formatting is preserved
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) TranslateText(req *TranslateTextRequest) (*FormattedText, error) {
-	result, err := client.Send(Request{
+	return client.TranslateTextContext(context.Background(), req)
+}
+
+// Translates a text to the given language. If the current user is a Telegram Premium user, then text formatting is preserved
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) TranslateTextContext(ctx context.Context, req *TranslateTextRequest) (*FormattedText, error) {
+	err := client.checkUsersOnly("translateText")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "translateText",
 		},
-		Data: map[string]interface{}{
-			"text":             req.Text,
-			"to_language_code": req.ToLanguageCode,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -2896,17 +4159,37 @@ type TranslateMessageTextRequest struct {
 	ToLanguageCode string `json:"to_language_code"`
 }
 
+func (req *TranslateMessageTextRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_id", req.MessageId)
+	encoder.String("to_language_code", req.ToLanguageCode)
+}
+
+func (req *TranslateMessageTextRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Extracts text or caption of the given message and translates it to the given language. If the current user is a Telegram Premium user, then text formatting is preserved
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) TranslateMessageText(req *TranslateMessageTextRequest) (*FormattedText, error) {
-	result, err := client.Send(Request{
+	return client.TranslateMessageTextContext(context.Background(), req)
+}
+
+// Extracts text or caption of the given message and translates it to the given language. If the current user is a Telegram Premium user, then text formatting is preserved
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) TranslateMessageTextContext(ctx context.Context, req *TranslateMessageTextRequest) (*FormattedText, error) {
+	err := client.checkUsersOnly("translateMessageText")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "translateMessageText",
 		},
-		Data: map[string]interface{}{
-			"chat_id":          req.ChatId,
-			"message_id":       req.MessageId,
-			"to_language_code": req.ToLanguageCode,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -2926,16 +4209,36 @@ type RecognizeSpeechRequest struct {
 	MessageId int64 `json:"message_id"`
 }
 
+func (req *RecognizeSpeechRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_id", req.MessageId)
+}
+
+func (req *RecognizeSpeechRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Recognizes speech in a video note or a voice note message. The message must be successfully sent and must not be scheduled. May return an error with a message "MSG_VOICE_TOO_LONG" if media duration is too big to be recognized
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) RecognizeSpeech(req *RecognizeSpeechRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.RecognizeSpeechContext(context.Background(), req)
+}
+
+// Recognizes speech in a video note or a voice note message. The message must be successfully sent and must not be scheduled. May return an error with a message "MSG_VOICE_TOO_LONG" if media duration is too big to be recognized
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) RecognizeSpeechContext(ctx context.Context, req *RecognizeSpeechRequest) (*Ok, error) {
+	err := client.checkUsersOnly("recognizeSpeech")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "recognizeSpeech",
 		},
-		Data: map[string]interface{}{
-			"chat_id":    req.ChatId,
-			"message_id": req.MessageId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -2957,17 +4260,37 @@ type RateSpeechRecognitionRequest struct {
 	IsGood bool `json:"is_good"`
 }
 
+func (req *RateSpeechRecognitionRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_id", req.MessageId)
+	encoder.Bool("is_good", req.IsGood)
+}
+
+func (req *RateSpeechRecognitionRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Rates recognized speech in a video note or a voice note message
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) RateSpeechRecognition(req *RateSpeechRecognitionRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.RateSpeechRecognitionContext(context.Background(), req)
+}
+
+// Rates recognized speech in a video note or a voice note message
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) RateSpeechRecognitionContext(ctx context.Context, req *RateSpeechRecognitionRequest) (*Ok, error) {
+	err := client.checkUsersOnly("rateSpeechRecognition")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "rateSpeechRecognition",
 		},
-		Data: map[string]interface{}{
-			"chat_id":    req.ChatId,
-			"message_id": req.MessageId,
-			"is_good":    req.IsGood,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -2985,15 +4308,35 @@ type GetChatAvailableMessageSendersRequest struct {
 	ChatId int64 `json:"chat_id"`
 }
 
+func (req *GetChatAvailableMessageSendersRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+}
+
+func (req *GetChatAvailableMessageSendersRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns list of message sender identifiers, which can be used to send messages in a chat
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetChatAvailableMessageSenders(req *GetChatAvailableMessageSendersRequest) (*ChatMessageSenders, error) {
-	result, err := client.Send(Request{
+	return client.GetChatAvailableMessageSendersContext(context.Background(), req)
+}
+
+// Returns list of message sender identifiers, which can be used to send messages in a chat
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetChatAvailableMessageSendersContext(ctx context.Context, req *GetChatAvailableMessageSendersRequest) (*ChatMessageSenders, error) {
+	err := client.checkUsersOnly("getChatAvailableMessageSenders")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getChatAvailableMessageSenders",
 		},
-		Data: map[string]interface{}{
-			"chat_id": req.ChatId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -3013,16 +4356,37 @@ type SetChatMessageSenderRequest struct {
 	MessageSenderId MessageSender `json:"message_sender_id"`
 }
 
+func (req *SetChatMessageSenderRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.name("message_sender_id")
+	encodeMessageSender(encoder, req.MessageSenderId)
+}
+
+func (req *SetChatMessageSenderRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Selects a message sender to send messages in a chat
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SetChatMessageSender(req *SetChatMessageSenderRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetChatMessageSenderContext(context.Background(), req)
+}
+
+// Selects a message sender to send messages in a chat
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SetChatMessageSenderContext(ctx context.Context, req *SetChatMessageSenderRequest) (*Ok, error) {
+	err := client.checkUsersOnly("setChatMessageSender")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setChatMessageSender",
 		},
-		Data: map[string]interface{}{
-			"chat_id":           req.ChatId,
-			"message_sender_id": req.MessageSenderId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -3050,20 +4414,34 @@ type SendMessageRequest struct {
 	InputMessageContent InputMessageContent `json:"input_message_content"`
 }
 
+func (req *SendMessageRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_thread_id", req.MessageThreadId)
+	encoder.Int64("reply_to_message_id", req.ReplyToMessageId)
+	encoder.name("options")
+	encodeMessageSendOptions(encoder, req.Options)
+	encoder.name("reply_markup")
+	encodeReplyMarkup(encoder, req.ReplyMarkup)
+	encoder.name("input_message_content")
+	encodeInputMessageContent(encoder, req.InputMessageContent)
+}
+
+func (req *SendMessageRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Sends a message. Returns the sent message
 func (client *Client) SendMessage(req *SendMessageRequest) (*Message, error) {
-	result, err := client.Send(Request{
+	return client.SendMessageContext(context.Background(), req)
+}
+
+// Sends a message. Returns the sent message
+func (client *Client) SendMessageContext(ctx context.Context, req *SendMessageRequest) (*Message, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "sendMessage",
 		},
-		Data: map[string]interface{}{
-			"chat_id":               req.ChatId,
-			"message_thread_id":     req.MessageThreadId,
-			"reply_to_message_id":   req.ReplyToMessageId,
-			"options":               req.Options,
-			"reply_markup":          req.ReplyMarkup,
-			"input_message_content": req.InputMessageContent,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -3091,20 +4469,33 @@ type SendMessageAlbumRequest struct {
 	OnlyPreview bool `json:"only_preview"`
 }
 
+func (req *SendMessageAlbumRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_thread_id", req.MessageThreadId)
+	encoder.Int64("reply_to_message_id", req.ReplyToMessageId)
+	encoder.name("options")
+	encodeMessageSendOptions(encoder, req.Options)
+	encoder.name("input_message_contents")
+	encodeListOfInputMessageContent(encoder, req.InputMessageContents)
+	encoder.Bool("only_preview", req.OnlyPreview)
+}
+
+func (req *SendMessageAlbumRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Sends 2-10 messages grouped together into an album. Currently, only audio, document, photo and video messages can be grouped into an album. Documents and audio files can be only grouped in an album with messages of the same type. Returns sent messages
 func (client *Client) SendMessageAlbum(req *SendMessageAlbumRequest) (*Messages, error) {
-	result, err := client.Send(Request{
+	return client.SendMessageAlbumContext(context.Background(), req)
+}
+
+// Sends 2-10 messages grouped together into an album. Currently, only audio, document, photo and video messages can be grouped into an album. Documents and audio files can be only grouped in an album with messages of the same type. Returns sent messages
+func (client *Client) SendMessageAlbumContext(ctx context.Context, req *SendMessageAlbumRequest) (*Messages, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "sendMessageAlbum",
 		},
-		Data: map[string]interface{}{
-			"chat_id":                req.ChatId,
-			"message_thread_id":      req.MessageThreadId,
-			"reply_to_message_id":    req.ReplyToMessageId,
-			"options":                req.Options,
-			"input_message_contents": req.InputMessageContents,
-			"only_preview":           req.OnlyPreview,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -3126,17 +4517,37 @@ type SendBotStartMessageRequest struct {
 	Parameter string `json:"parameter"`
 }
 
+func (req *SendBotStartMessageRequest) encode(encoder *encoder) {
+	encoder.Int64("bot_user_id", req.BotUserId)
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.String("parameter", req.Parameter)
+}
+
+func (req *SendBotStartMessageRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Invites a bot to a chat (if it is not yet a member) and sends it the /start command. Bots can't be invited to a private chat other than the chat with the bot. Bots can't be invited to channels (although they can be added as admins) and secret chats. Returns the sent message
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SendBotStartMessage(req *SendBotStartMessageRequest) (*Message, error) {
-	result, err := client.Send(Request{
+	return client.SendBotStartMessageContext(context.Background(), req)
+}
+
+// Invites a bot to a chat (if it is not yet a member) and sends it the /start command. Bots can't be invited to a private chat other than the chat with the bot. Bots can't be invited to channels (although they can be added as admins) and secret chats. Returns the sent message
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SendBotStartMessageContext(ctx context.Context, req *SendBotStartMessageRequest) (*Message, error) {
+	err := client.checkUsersOnly("sendBotStartMessage")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "sendBotStartMessage",
 		},
-		Data: map[string]interface{}{
-			"bot_user_id": req.BotUserId,
-			"chat_id":     req.ChatId,
-			"parameter":   req.Parameter,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -3166,21 +4577,42 @@ type SendInlineQueryResultMessageRequest struct {
 	HideViaBot bool `json:"hide_via_bot"`
 }
 
+func (req *SendInlineQueryResultMessageRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_thread_id", req.MessageThreadId)
+	encoder.Int64("reply_to_message_id", req.ReplyToMessageId)
+	encoder.name("options")
+	encodeMessageSendOptions(encoder, req.Options)
+	encoder.JsonInt64("query_id", req.QueryId)
+	encoder.String("result_id", req.ResultId)
+	encoder.Bool("hide_via_bot", req.HideViaBot)
+}
+
+func (req *SendInlineQueryResultMessageRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Sends the result of an inline query as a message. Returns the sent message. Always clears a chat draft message
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SendInlineQueryResultMessage(req *SendInlineQueryResultMessageRequest) (*Message, error) {
-	result, err := client.Send(Request{
+	return client.SendInlineQueryResultMessageContext(context.Background(), req)
+}
+
+// Sends the result of an inline query as a message. Returns the sent message. Always clears a chat draft message
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SendInlineQueryResultMessageContext(ctx context.Context, req *SendInlineQueryResultMessageRequest) (*Message, error) {
+	err := client.checkUsersOnly("sendInlineQueryResultMessage")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "sendInlineQueryResultMessage",
 		},
-		Data: map[string]interface{}{
-			"chat_id":             req.ChatId,
-			"message_thread_id":   req.MessageThreadId,
-			"reply_to_message_id": req.ReplyToMessageId,
-			"options":             req.Options,
-			"query_id":            req.QueryId,
-			"result_id":           req.ResultId,
-			"hide_via_bot":        req.HideViaBot,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -3212,22 +4644,34 @@ type ForwardMessagesRequest struct {
 	OnlyPreview bool `json:"only_preview"`
 }
 
+func (req *ForwardMessagesRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_thread_id", req.MessageThreadId)
+	encoder.Int64("from_chat_id", req.FromChatId)
+	encoder.Int64s("message_ids", req.MessageIds)
+	encoder.name("options")
+	encodeMessageSendOptions(encoder, req.Options)
+	encoder.Bool("send_copy", req.SendCopy)
+	encoder.Bool("remove_caption", req.RemoveCaption)
+	encoder.Bool("only_preview", req.OnlyPreview)
+}
+
+func (req *ForwardMessagesRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Forwards previously sent messages. Returns the forwarded messages in the same order as the message identifiers passed in message_ids. If a message can't be forwarded, null will be returned instead of the message
 func (client *Client) ForwardMessages(req *ForwardMessagesRequest) (*Messages, error) {
-	result, err := client.Send(Request{
+	return client.ForwardMessagesContext(context.Background(), req)
+}
+
+// Forwards previously sent messages. Returns the forwarded messages in the same order as the message identifiers passed in message_ids. If a message can't be forwarded, null will be returned instead of the message
+func (client *Client) ForwardMessagesContext(ctx context.Context, req *ForwardMessagesRequest) (*Messages, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "forwardMessages",
 		},
-		Data: map[string]interface{}{
-			"chat_id":           req.ChatId,
-			"message_thread_id": req.MessageThreadId,
-			"from_chat_id":      req.FromChatId,
-			"message_ids":       req.MessageIds,
-			"options":           req.Options,
-			"send_copy":         req.SendCopy,
-			"remove_caption":    req.RemoveCaption,
-			"only_preview":      req.OnlyPreview,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -3247,16 +4691,27 @@ type ResendMessagesRequest struct {
 	MessageIds []int64 `json:"message_ids"`
 }
 
+func (req *ResendMessagesRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64s("message_ids", req.MessageIds)
+}
+
+func (req *ResendMessagesRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Resends messages which failed to send. Can be called only for messages for which messageSendingStateFailed.can_retry is true and after specified in messageSendingStateFailed.retry_after time passed. If a message is re-sent, the corresponding failed to send message is deleted. Returns the sent messages in the same order as the message identifiers passed in message_ids. If a message can't be re-sent, null will be returned instead of the message
 func (client *Client) ResendMessages(req *ResendMessagesRequest) (*Messages, error) {
-	result, err := client.Send(Request{
+	return client.ResendMessagesContext(context.Background(), req)
+}
+
+// Resends messages which failed to send. Can be called only for messages for which messageSendingStateFailed.can_retry is true and after specified in messageSendingStateFailed.retry_after time passed. If a message is re-sent, the corresponding failed to send message is deleted. Returns the sent messages in the same order as the message identifiers passed in message_ids. If a message can't be re-sent, null will be returned instead of the message
+func (client *Client) ResendMessagesContext(ctx context.Context, req *ResendMessagesRequest) (*Messages, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "resendMessages",
 		},
-		Data: map[string]interface{}{
-			"chat_id":     req.ChatId,
-			"message_ids": req.MessageIds,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -3274,15 +4729,35 @@ type SendChatScreenshotTakenNotificationRequest struct {
 	ChatId int64 `json:"chat_id"`
 }
 
+func (req *SendChatScreenshotTakenNotificationRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+}
+
+func (req *SendChatScreenshotTakenNotificationRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Sends a notification about a screenshot taken in a chat. Supported only in private and secret chats
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SendChatScreenshotTakenNotification(req *SendChatScreenshotTakenNotificationRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SendChatScreenshotTakenNotificationContext(context.Background(), req)
+}
+
+// Sends a notification about a screenshot taken in a chat. Supported only in private and secret chats
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SendChatScreenshotTakenNotificationContext(ctx context.Context, req *SendChatScreenshotTakenNotificationRequest) (*Ok, error) {
+	err := client.checkUsersOnly("sendChatScreenshotTakenNotification")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "sendChatScreenshotTakenNotification",
 		},
-		Data: map[string]interface{}{
-			"chat_id": req.ChatId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -3308,19 +4783,41 @@ type AddLocalMessageRequest struct {
 	InputMessageContent InputMessageContent `json:"input_message_content"`
 }
 
+func (req *AddLocalMessageRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.name("sender_id")
+	encodeMessageSender(encoder, req.SenderId)
+	encoder.Int64("reply_to_message_id", req.ReplyToMessageId)
+	encoder.Bool("disable_notification", req.DisableNotification)
+	encoder.name("input_message_content")
+	encodeInputMessageContent(encoder, req.InputMessageContent)
+}
+
+func (req *AddLocalMessageRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Adds a local message to a chat. The message is persistent across application restarts only if the message database is used. Returns the added message
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) AddLocalMessage(req *AddLocalMessageRequest) (*Message, error) {
-	result, err := client.Send(Request{
+	return client.AddLocalMessageContext(context.Background(), req)
+}
+
+// Adds a local message to a chat. The message is persistent across application restarts only if the message database is used. Returns the added message
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) AddLocalMessageContext(ctx context.Context, req *AddLocalMessageRequest) (*Message, error) {
+	err := client.checkUsersOnly("addLocalMessage")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "addLocalMessage",
 		},
-		Data: map[string]interface{}{
-			"chat_id":               req.ChatId,
-			"sender_id":             req.SenderId,
-			"reply_to_message_id":   req.ReplyToMessageId,
-			"disable_notification":  req.DisableNotification,
-			"input_message_content": req.InputMessageContent,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -3342,17 +4839,28 @@ type DeleteMessagesRequest struct {
 	Revoke bool `json:"revoke"`
 }
 
+func (req *DeleteMessagesRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64s("message_ids", req.MessageIds)
+	encoder.Bool("revoke", req.Revoke)
+}
+
+func (req *DeleteMessagesRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Deletes messages
 func (client *Client) DeleteMessages(req *DeleteMessagesRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.DeleteMessagesContext(context.Background(), req)
+}
+
+// Deletes messages
+func (client *Client) DeleteMessagesContext(ctx context.Context, req *DeleteMessagesRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "deleteMessages",
 		},
-		Data: map[string]interface{}{
-			"chat_id":     req.ChatId,
-			"message_ids": req.MessageIds,
-			"revoke":      req.Revoke,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -3372,16 +4880,37 @@ type DeleteChatMessagesBySenderRequest struct {
 	SenderId MessageSender `json:"sender_id"`
 }
 
+func (req *DeleteChatMessagesBySenderRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.name("sender_id")
+	encodeMessageSender(encoder, req.SenderId)
+}
+
+func (req *DeleteChatMessagesBySenderRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Deletes all messages sent by the specified message sender in a chat. Supported only for supergroups; requires can_delete_messages administrator privileges
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) DeleteChatMessagesBySender(req *DeleteChatMessagesBySenderRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.DeleteChatMessagesBySenderContext(context.Background(), req)
+}
+
+// Deletes all messages sent by the specified message sender in a chat. Supported only for supergroups; requires can_delete_messages administrator privileges
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) DeleteChatMessagesBySenderContext(ctx context.Context, req *DeleteChatMessagesBySenderRequest) (*Ok, error) {
+	err := client.checkUsersOnly("deleteChatMessagesBySender")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "deleteChatMessagesBySender",
 		},
-		Data: map[string]interface{}{
-			"chat_id":   req.ChatId,
-			"sender_id": req.SenderId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -3405,18 +4934,38 @@ type DeleteChatMessagesByDateRequest struct {
 	Revoke bool `json:"revoke"`
 }
 
+func (req *DeleteChatMessagesByDateRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int32("min_date", req.MinDate)
+	encoder.Int32("max_date", req.MaxDate)
+	encoder.Bool("revoke", req.Revoke)
+}
+
+func (req *DeleteChatMessagesByDateRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Deletes all messages between the specified dates in a chat. Supported only for private chats and basic groups. Messages sent in the last 30 seconds will not be deleted
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) DeleteChatMessagesByDate(req *DeleteChatMessagesByDateRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.DeleteChatMessagesByDateContext(context.Background(), req)
+}
+
+// Deletes all messages between the specified dates in a chat. Supported only for private chats and basic groups. Messages sent in the last 30 seconds will not be deleted
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) DeleteChatMessagesByDateContext(ctx context.Context, req *DeleteChatMessagesByDateRequest) (*Ok, error) {
+	err := client.checkUsersOnly("deleteChatMessagesByDate")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "deleteChatMessagesByDate",
 		},
-		Data: map[string]interface{}{
-			"chat_id":  req.ChatId,
-			"min_date": req.MinDate,
-			"max_date": req.MaxDate,
-			"revoke":   req.Revoke,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -3440,18 +4989,31 @@ type EditMessageTextRequest struct {
 	InputMessageContent InputMessageContent `json:"input_message_content"`
 }
 
+func (req *EditMessageTextRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_id", req.MessageId)
+	encoder.name("reply_markup")
+	encodeReplyMarkup(encoder, req.ReplyMarkup)
+	encoder.name("input_message_content")
+	encodeInputMessageContent(encoder, req.InputMessageContent)
+}
+
+func (req *EditMessageTextRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Edits the text of a message (or a text of a game message). Returns the edited message after the edit is completed on the server side
 func (client *Client) EditMessageText(req *EditMessageTextRequest) (*Message, error) {
-	result, err := client.Send(Request{
+	return client.EditMessageTextContext(context.Background(), req)
+}
+
+// Edits the text of a message (or a text of a game message). Returns the edited message after the edit is completed on the server side
+func (client *Client) EditMessageTextContext(ctx context.Context, req *EditMessageTextRequest) (*Message, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "editMessageText",
 		},
-		Data: map[string]interface{}{
-			"chat_id":               req.ChatId,
-			"message_id":            req.MessageId,
-			"reply_markup":          req.ReplyMarkup,
-			"input_message_content": req.InputMessageContent,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -3479,20 +5041,33 @@ type EditMessageLiveLocationRequest struct {
 	ProximityAlertRadius int32 `json:"proximity_alert_radius"`
 }
 
+func (req *EditMessageLiveLocationRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_id", req.MessageId)
+	encoder.name("reply_markup")
+	encodeReplyMarkup(encoder, req.ReplyMarkup)
+	encoder.name("location")
+	encodeLocation(encoder, req.Location)
+	encoder.Int32("heading", req.Heading)
+	encoder.Int32("proximity_alert_radius", req.ProximityAlertRadius)
+}
+
+func (req *EditMessageLiveLocationRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Edits the message content of a live location. Messages can be edited for a limited period of time specified in the live location. Returns the edited message after the edit is completed on the server side
 func (client *Client) EditMessageLiveLocation(req *EditMessageLiveLocationRequest) (*Message, error) {
-	result, err := client.Send(Request{
+	return client.EditMessageLiveLocationContext(context.Background(), req)
+}
+
+// Edits the message content of a live location. Messages can be edited for a limited period of time specified in the live location. Returns the edited message after the edit is completed on the server side
+func (client *Client) EditMessageLiveLocationContext(ctx context.Context, req *EditMessageLiveLocationRequest) (*Message, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "editMessageLiveLocation",
 		},
-		Data: map[string]interface{}{
-			"chat_id":                req.ChatId,
-			"message_id":             req.MessageId,
-			"reply_markup":           req.ReplyMarkup,
-			"location":               req.Location,
-			"heading":                req.Heading,
-			"proximity_alert_radius": req.ProximityAlertRadius,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -3516,18 +5091,31 @@ type EditMessageMediaRequest struct {
 	InputMessageContent InputMessageContent `json:"input_message_content"`
 }
 
+func (req *EditMessageMediaRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_id", req.MessageId)
+	encoder.name("reply_markup")
+	encodeReplyMarkup(encoder, req.ReplyMarkup)
+	encoder.name("input_message_content")
+	encodeInputMessageContent(encoder, req.InputMessageContent)
+}
+
+func (req *EditMessageMediaRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Edits the content of a message with an animation, an audio, a document, a photo or a video, including message caption. If only the caption needs to be edited, use editMessageCaption instead. The media can't be edited if the message was set to self-destruct or to a self-destructing media. The type of message content in an album can't be changed with exception of replacing a photo with a video or vice versa. Returns the edited message after the edit is completed on the server side
 func (client *Client) EditMessageMedia(req *EditMessageMediaRequest) (*Message, error) {
-	result, err := client.Send(Request{
+	return client.EditMessageMediaContext(context.Background(), req)
+}
+
+// Edits the content of a message with an animation, an audio, a document, a photo or a video, including message caption. If only the caption needs to be edited, use editMessageCaption instead. The media can't be edited if the message was set to self-destruct or to a self-destructing media. The type of message content in an album can't be changed with exception of replacing a photo with a video or vice versa. Returns the edited message after the edit is completed on the server side
+func (client *Client) EditMessageMediaContext(ctx context.Context, req *EditMessageMediaRequest) (*Message, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "editMessageMedia",
 		},
-		Data: map[string]interface{}{
-			"chat_id":               req.ChatId,
-			"message_id":            req.MessageId,
-			"reply_markup":          req.ReplyMarkup,
-			"input_message_content": req.InputMessageContent,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -3551,18 +5139,31 @@ type EditMessageCaptionRequest struct {
 	Caption *FormattedText `json:"caption"`
 }
 
+func (req *EditMessageCaptionRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_id", req.MessageId)
+	encoder.name("reply_markup")
+	encodeReplyMarkup(encoder, req.ReplyMarkup)
+	encoder.name("caption")
+	encodeFormattedText(encoder, req.Caption)
+}
+
+func (req *EditMessageCaptionRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Edits the message content caption. Returns the edited message after the edit is completed on the server side
 func (client *Client) EditMessageCaption(req *EditMessageCaptionRequest) (*Message, error) {
-	result, err := client.Send(Request{
+	return client.EditMessageCaptionContext(context.Background(), req)
+}
+
+// Edits the message content caption. Returns the edited message after the edit is completed on the server side
+func (client *Client) EditMessageCaptionContext(ctx context.Context, req *EditMessageCaptionRequest) (*Message, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "editMessageCaption",
 		},
-		Data: map[string]interface{}{
-			"chat_id":      req.ChatId,
-			"message_id":   req.MessageId,
-			"reply_markup": req.ReplyMarkup,
-			"caption":      req.Caption,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -3584,17 +5185,38 @@ type EditMessageReplyMarkupRequest struct {
 	ReplyMarkup ReplyMarkup `json:"reply_markup"`
 }
 
+func (req *EditMessageReplyMarkupRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_id", req.MessageId)
+	encoder.name("reply_markup")
+	encodeReplyMarkup(encoder, req.ReplyMarkup)
+}
+
+func (req *EditMessageReplyMarkupRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Edits the message reply markup; for bots only. Returns the edited message after the edit is completed on the server side
+//
+// Available only to bots, users get FunctionTypeError
 func (client *Client) EditMessageReplyMarkup(req *EditMessageReplyMarkupRequest) (*Message, error) {
-	result, err := client.Send(Request{
+	return client.EditMessageReplyMarkupContext(context.Background(), req)
+}
+
+// Edits the message reply markup; for bots only. Returns the edited message after the edit is completed on the server side
+//
+// Available only to bots, users get FunctionTypeError
+func (client *Client) EditMessageReplyMarkupContext(ctx context.Context, req *EditMessageReplyMarkupRequest) (*Message, error) {
+	err := client.checkBotsOnly("editMessageReplyMarkup")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "editMessageReplyMarkup",
 		},
-		Data: map[string]interface{}{
-			"chat_id":      req.ChatId,
-			"message_id":   req.MessageId,
-			"reply_markup": req.ReplyMarkup,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -3616,17 +5238,35 @@ type EditInlineMessageTextRequest struct {
 	InputMessageContent InputMessageContent `json:"input_message_content"`
 }
 
+func (req *EditInlineMessageTextRequest) encode(encoder *encoder) {
+	encoder.String("inline_message_id", req.InlineMessageId)
+	encoder.name("reply_markup")
+	encodeReplyMarkup(encoder, req.ReplyMarkup)
+	encoder.name("input_message_content")
+	encodeInputMessageContent(encoder, req.InputMessageContent)
+}
+
 // Edits the text of an inline text or game message sent via a bot; for bots only
+//
+// Available only to bots, users get FunctionTypeError
 func (client *Client) EditInlineMessageText(req *EditInlineMessageTextRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.EditInlineMessageTextContext(context.Background(), req)
+}
+
+// Edits the text of an inline text or game message sent via a bot; for bots only
+//
+// Available only to bots, users get FunctionTypeError
+func (client *Client) EditInlineMessageTextContext(ctx context.Context, req *EditInlineMessageTextRequest) (*Ok, error) {
+	err := client.checkBotsOnly("editInlineMessageText")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "editInlineMessageText",
 		},
-		Data: map[string]interface{}{
-			"inline_message_id":     req.InlineMessageId,
-			"reply_markup":          req.ReplyMarkup,
-			"input_message_content": req.InputMessageContent,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -3652,19 +5292,37 @@ type EditInlineMessageLiveLocationRequest struct {
 	ProximityAlertRadius int32 `json:"proximity_alert_radius"`
 }
 
+func (req *EditInlineMessageLiveLocationRequest) encode(encoder *encoder) {
+	encoder.String("inline_message_id", req.InlineMessageId)
+	encoder.name("reply_markup")
+	encodeReplyMarkup(encoder, req.ReplyMarkup)
+	encoder.name("location")
+	encodeLocation(encoder, req.Location)
+	encoder.Int32("heading", req.Heading)
+	encoder.Int32("proximity_alert_radius", req.ProximityAlertRadius)
+}
+
 // Edits the content of a live location in an inline message sent via a bot; for bots only
+//
+// Available only to bots, users get FunctionTypeError
 func (client *Client) EditInlineMessageLiveLocation(req *EditInlineMessageLiveLocationRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.EditInlineMessageLiveLocationContext(context.Background(), req)
+}
+
+// Edits the content of a live location in an inline message sent via a bot; for bots only
+//
+// Available only to bots, users get FunctionTypeError
+func (client *Client) EditInlineMessageLiveLocationContext(ctx context.Context, req *EditInlineMessageLiveLocationRequest) (*Ok, error) {
+	err := client.checkBotsOnly("editInlineMessageLiveLocation")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "editInlineMessageLiveLocation",
 		},
-		Data: map[string]interface{}{
-			"inline_message_id":      req.InlineMessageId,
-			"reply_markup":           req.ReplyMarkup,
-			"location":               req.Location,
-			"heading":                req.Heading,
-			"proximity_alert_radius": req.ProximityAlertRadius,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -3686,17 +5344,35 @@ type EditInlineMessageMediaRequest struct {
 	InputMessageContent InputMessageContent `json:"input_message_content"`
 }
 
+func (req *EditInlineMessageMediaRequest) encode(encoder *encoder) {
+	encoder.String("inline_message_id", req.InlineMessageId)
+	encoder.name("reply_markup")
+	encodeReplyMarkup(encoder, req.ReplyMarkup)
+	encoder.name("input_message_content")
+	encodeInputMessageContent(encoder, req.InputMessageContent)
+}
+
 // Edits the content of a message with an animation, an audio, a document, a photo or a video in an inline message sent via a bot; for bots only
+//
+// Available only to bots, users get FunctionTypeError
 func (client *Client) EditInlineMessageMedia(req *EditInlineMessageMediaRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.EditInlineMessageMediaContext(context.Background(), req)
+}
+
+// Edits the content of a message with an animation, an audio, a document, a photo or a video in an inline message sent via a bot; for bots only
+//
+// Available only to bots, users get FunctionTypeError
+func (client *Client) EditInlineMessageMediaContext(ctx context.Context, req *EditInlineMessageMediaRequest) (*Ok, error) {
+	err := client.checkBotsOnly("editInlineMessageMedia")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "editInlineMessageMedia",
 		},
-		Data: map[string]interface{}{
-			"inline_message_id":     req.InlineMessageId,
-			"reply_markup":          req.ReplyMarkup,
-			"input_message_content": req.InputMessageContent,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -3718,17 +5394,35 @@ type EditInlineMessageCaptionRequest struct {
 	Caption *FormattedText `json:"caption"`
 }
 
+func (req *EditInlineMessageCaptionRequest) encode(encoder *encoder) {
+	encoder.String("inline_message_id", req.InlineMessageId)
+	encoder.name("reply_markup")
+	encodeReplyMarkup(encoder, req.ReplyMarkup)
+	encoder.name("caption")
+	encodeFormattedText(encoder, req.Caption)
+}
+
 // Edits the caption of an inline message sent via a bot; for bots only
+//
+// Available only to bots, users get FunctionTypeError
 func (client *Client) EditInlineMessageCaption(req *EditInlineMessageCaptionRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.EditInlineMessageCaptionContext(context.Background(), req)
+}
+
+// Edits the caption of an inline message sent via a bot; for bots only
+//
+// Available only to bots, users get FunctionTypeError
+func (client *Client) EditInlineMessageCaptionContext(ctx context.Context, req *EditInlineMessageCaptionRequest) (*Ok, error) {
+	err := client.checkBotsOnly("editInlineMessageCaption")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "editInlineMessageCaption",
 		},
-		Data: map[string]interface{}{
-			"inline_message_id": req.InlineMessageId,
-			"reply_markup":      req.ReplyMarkup,
-			"caption":           req.Caption,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -3748,16 +5442,33 @@ type EditInlineMessageReplyMarkupRequest struct {
 	ReplyMarkup ReplyMarkup `json:"reply_markup"`
 }
 
+func (req *EditInlineMessageReplyMarkupRequest) encode(encoder *encoder) {
+	encoder.String("inline_message_id", req.InlineMessageId)
+	encoder.name("reply_markup")
+	encodeReplyMarkup(encoder, req.ReplyMarkup)
+}
+
 // Edits the reply markup of an inline message sent via a bot; for bots only
+//
+// Available only to bots, users get FunctionTypeError
 func (client *Client) EditInlineMessageReplyMarkup(req *EditInlineMessageReplyMarkupRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.EditInlineMessageReplyMarkupContext(context.Background(), req)
+}
+
+// Edits the reply markup of an inline message sent via a bot; for bots only
+//
+// Available only to bots, users get FunctionTypeError
+func (client *Client) EditInlineMessageReplyMarkupContext(ctx context.Context, req *EditInlineMessageReplyMarkupRequest) (*Ok, error) {
+	err := client.checkBotsOnly("editInlineMessageReplyMarkup")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "editInlineMessageReplyMarkup",
 		},
-		Data: map[string]interface{}{
-			"inline_message_id": req.InlineMessageId,
-			"reply_markup":      req.ReplyMarkup,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -3779,17 +5490,38 @@ type EditMessageSchedulingStateRequest struct {
 	SchedulingState MessageSchedulingState `json:"scheduling_state"`
 }
 
+func (req *EditMessageSchedulingStateRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_id", req.MessageId)
+	encoder.name("scheduling_state")
+	encodeMessageSchedulingState(encoder, req.SchedulingState)
+}
+
+func (req *EditMessageSchedulingStateRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Edits the time when a scheduled message will be sent. Scheduling state of all messages in the same album or forwarded together with the message will be also changed
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) EditMessageSchedulingState(req *EditMessageSchedulingStateRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.EditMessageSchedulingStateContext(context.Background(), req)
+}
+
+// Edits the time when a scheduled message will be sent. Scheduling state of all messages in the same album or forwarded together with the message will be also changed
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) EditMessageSchedulingStateContext(ctx context.Context, req *EditMessageSchedulingStateRequest) (*Ok, error) {
+	err := client.checkUsersOnly("editMessageSchedulingState")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "editMessageSchedulingState",
 		},
-		Data: map[string]interface{}{
-			"chat_id":          req.ChatId,
-			"message_id":       req.MessageId,
-			"scheduling_state": req.SchedulingState,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -3804,11 +5536,15 @@ func (client *Client) EditMessageSchedulingState(req *EditMessageSchedulingState
 
 // Returns list of custom emojis, which can be used as forum topic icon by all users
 func (client *Client) GetForumTopicDefaultIcons() (*Stickers, error) {
-	result, err := client.Send(Request{
+	return client.GetForumTopicDefaultIconsContext(context.Background())
+}
+
+// Returns list of custom emojis, which can be used as forum topic icon by all users
+func (client *Client) GetForumTopicDefaultIconsContext(ctx context.Context) (*Stickers, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getForumTopicDefaultIcons",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -3830,17 +5566,29 @@ type CreateForumTopicRequest struct {
 	Icon *ForumTopicIcon `json:"icon"`
 }
 
+func (req *CreateForumTopicRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.String("name", req.Name)
+	encoder.name("icon")
+	encodeForumTopicIcon(encoder, req.Icon)
+}
+
+func (req *CreateForumTopicRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Creates a topic in a forum supergroup chat; requires can_manage_topics rights in the supergroup
 func (client *Client) CreateForumTopic(req *CreateForumTopicRequest) (*ForumTopicInfo, error) {
-	result, err := client.Send(Request{
+	return client.CreateForumTopicContext(context.Background(), req)
+}
+
+// Creates a topic in a forum supergroup chat; requires can_manage_topics rights in the supergroup
+func (client *Client) CreateForumTopicContext(ctx context.Context, req *CreateForumTopicRequest) (*ForumTopicInfo, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "createForumTopic",
 		},
-		Data: map[string]interface{}{
-			"chat_id": req.ChatId,
-			"name":    req.Name,
-			"icon":    req.Icon,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -3866,19 +5614,30 @@ type EditForumTopicRequest struct {
 	IconCustomEmojiId JsonInt64 `json:"icon_custom_emoji_id"`
 }
 
+func (req *EditForumTopicRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_thread_id", req.MessageThreadId)
+	encoder.String("name", req.Name)
+	encoder.Bool("edit_icon_custom_emoji", req.EditIconCustomEmoji)
+	encoder.JsonInt64("icon_custom_emoji_id", req.IconCustomEmojiId)
+}
+
+func (req *EditForumTopicRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Edits title and icon of a topic in a forum supergroup chat; requires can_manage_topics administrator right in the supergroup unless the user is creator of the topic
 func (client *Client) EditForumTopic(req *EditForumTopicRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.EditForumTopicContext(context.Background(), req)
+}
+
+// Edits title and icon of a topic in a forum supergroup chat; requires can_manage_topics administrator right in the supergroup unless the user is creator of the topic
+func (client *Client) EditForumTopicContext(ctx context.Context, req *EditForumTopicRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "editForumTopic",
 		},
-		Data: map[string]interface{}{
-			"chat_id":                req.ChatId,
-			"message_thread_id":      req.MessageThreadId,
-			"name":                   req.Name,
-			"edit_icon_custom_emoji": req.EditIconCustomEmoji,
-			"icon_custom_emoji_id":   req.IconCustomEmojiId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -3898,16 +5657,27 @@ type GetForumTopicRequest struct {
 	MessageThreadId int64 `json:"message_thread_id"`
 }
 
+func (req *GetForumTopicRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_thread_id", req.MessageThreadId)
+}
+
+func (req *GetForumTopicRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns information about a forum topic
 func (client *Client) GetForumTopic(req *GetForumTopicRequest) (*ForumTopic, error) {
-	result, err := client.Send(Request{
+	return client.GetForumTopicContext(context.Background(), req)
+}
+
+// Returns information about a forum topic
+func (client *Client) GetForumTopicContext(ctx context.Context, req *GetForumTopicRequest) (*ForumTopic, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getForumTopic",
 		},
-		Data: map[string]interface{}{
-			"chat_id":           req.ChatId,
-			"message_thread_id": req.MessageThreadId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -3927,16 +5697,27 @@ type GetForumTopicLinkRequest struct {
 	MessageThreadId int64 `json:"message_thread_id"`
 }
 
+func (req *GetForumTopicLinkRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_thread_id", req.MessageThreadId)
+}
+
+func (req *GetForumTopicLinkRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns an HTTPS link to a topic in a forum chat. This is an offline request
 func (client *Client) GetForumTopicLink(req *GetForumTopicLinkRequest) (*MessageLink, error) {
-	result, err := client.Send(Request{
+	return client.GetForumTopicLinkContext(context.Background(), req)
+}
+
+// Returns an HTTPS link to a topic in a forum chat. This is an offline request
+func (client *Client) GetForumTopicLinkContext(ctx context.Context, req *GetForumTopicLinkRequest) (*MessageLink, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getForumTopicLink",
 		},
-		Data: map[string]interface{}{
-			"chat_id":           req.ChatId,
-			"message_thread_id": req.MessageThreadId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -3964,20 +5745,40 @@ type GetForumTopicsRequest struct {
 	Limit int32 `json:"limit"`
 }
 
+func (req *GetForumTopicsRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.String("query", req.Query)
+	encoder.Int32("offset_date", req.OffsetDate)
+	encoder.Int64("offset_message_id", req.OffsetMessageId)
+	encoder.Int64("offset_message_thread_id", req.OffsetMessageThreadId)
+	encoder.Int32("limit", req.Limit)
+}
+
+func (req *GetForumTopicsRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns found forum topics in a forum chat. This is a temporary method for getting information about topic list from the server
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetForumTopics(req *GetForumTopicsRequest) (*ForumTopics, error) {
-	result, err := client.Send(Request{
+	return client.GetForumTopicsContext(context.Background(), req)
+}
+
+// Returns found forum topics in a forum chat. This is a temporary method for getting information about topic list from the server
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetForumTopicsContext(ctx context.Context, req *GetForumTopicsRequest) (*ForumTopics, error) {
+	err := client.checkUsersOnly("getForumTopics")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getForumTopics",
 		},
-		Data: map[string]interface{}{
-			"chat_id":                  req.ChatId,
-			"query":                    req.Query,
-			"offset_date":              req.OffsetDate,
-			"offset_message_id":        req.OffsetMessageId,
-			"offset_message_thread_id": req.OffsetMessageThreadId,
-			"limit":                    req.Limit,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -3999,17 +5800,38 @@ type SetForumTopicNotificationSettingsRequest struct {
 	NotificationSettings *ChatNotificationSettings `json:"notification_settings"`
 }
 
+func (req *SetForumTopicNotificationSettingsRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_thread_id", req.MessageThreadId)
+	encoder.name("notification_settings")
+	encodeChatNotificationSettings(encoder, req.NotificationSettings)
+}
+
+func (req *SetForumTopicNotificationSettingsRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Changes the notification settings of a forum topic
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SetForumTopicNotificationSettings(req *SetForumTopicNotificationSettingsRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetForumTopicNotificationSettingsContext(context.Background(), req)
+}
+
+// Changes the notification settings of a forum topic
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SetForumTopicNotificationSettingsContext(ctx context.Context, req *SetForumTopicNotificationSettingsRequest) (*Ok, error) {
+	err := client.checkUsersOnly("setForumTopicNotificationSettings")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setForumTopicNotificationSettings",
 		},
-		Data: map[string]interface{}{
-			"chat_id":               req.ChatId,
-			"message_thread_id":     req.MessageThreadId,
-			"notification_settings": req.NotificationSettings,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -4031,17 +5853,28 @@ type ToggleForumTopicIsClosedRequest struct {
 	IsClosed bool `json:"is_closed"`
 }
 
+func (req *ToggleForumTopicIsClosedRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_thread_id", req.MessageThreadId)
+	encoder.Bool("is_closed", req.IsClosed)
+}
+
+func (req *ToggleForumTopicIsClosedRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Toggles whether a topic is closed in a forum supergroup chat; requires can_manage_topics administrator right in the supergroup unless the user is creator of the topic
 func (client *Client) ToggleForumTopicIsClosed(req *ToggleForumTopicIsClosedRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ToggleForumTopicIsClosedContext(context.Background(), req)
+}
+
+// Toggles whether a topic is closed in a forum supergroup chat; requires can_manage_topics administrator right in the supergroup unless the user is creator of the topic
+func (client *Client) ToggleForumTopicIsClosedContext(ctx context.Context, req *ToggleForumTopicIsClosedRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "toggleForumTopicIsClosed",
 		},
-		Data: map[string]interface{}{
-			"chat_id":           req.ChatId,
-			"message_thread_id": req.MessageThreadId,
-			"is_closed":         req.IsClosed,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -4061,16 +5894,27 @@ type ToggleGeneralForumTopicIsHiddenRequest struct {
 	IsHidden bool `json:"is_hidden"`
 }
 
+func (req *ToggleGeneralForumTopicIsHiddenRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Bool("is_hidden", req.IsHidden)
+}
+
+func (req *ToggleGeneralForumTopicIsHiddenRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Toggles whether a General topic is hidden in a forum supergroup chat; requires can_manage_topics administrator right in the supergroup
 func (client *Client) ToggleGeneralForumTopicIsHidden(req *ToggleGeneralForumTopicIsHiddenRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ToggleGeneralForumTopicIsHiddenContext(context.Background(), req)
+}
+
+// Toggles whether a General topic is hidden in a forum supergroup chat; requires can_manage_topics administrator right in the supergroup
+func (client *Client) ToggleGeneralForumTopicIsHiddenContext(ctx context.Context, req *ToggleGeneralForumTopicIsHiddenRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "toggleGeneralForumTopicIsHidden",
 		},
-		Data: map[string]interface{}{
-			"chat_id":   req.ChatId,
-			"is_hidden": req.IsHidden,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -4092,17 +5936,37 @@ type ToggleForumTopicIsPinnedRequest struct {
 	IsPinned bool `json:"is_pinned"`
 }
 
+func (req *ToggleForumTopicIsPinnedRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_thread_id", req.MessageThreadId)
+	encoder.Bool("is_pinned", req.IsPinned)
+}
+
+func (req *ToggleForumTopicIsPinnedRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Changes the pinned state of a forum topic; requires can_manage_topics administrator right in the supergroup. There can be up to getOption("pinned_forum_topic_count_max") pinned forum topics
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ToggleForumTopicIsPinned(req *ToggleForumTopicIsPinnedRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ToggleForumTopicIsPinnedContext(context.Background(), req)
+}
+
+// Changes the pinned state of a forum topic; requires can_manage_topics administrator right in the supergroup. There can be up to getOption("pinned_forum_topic_count_max") pinned forum topics
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ToggleForumTopicIsPinnedContext(ctx context.Context, req *ToggleForumTopicIsPinnedRequest) (*Ok, error) {
+	err := client.checkUsersOnly("toggleForumTopicIsPinned")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "toggleForumTopicIsPinned",
 		},
-		Data: map[string]interface{}{
-			"chat_id":           req.ChatId,
-			"message_thread_id": req.MessageThreadId,
-			"is_pinned":         req.IsPinned,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -4122,16 +5986,36 @@ type SetPinnedForumTopicsRequest struct {
 	MessageThreadIds []int64 `json:"message_thread_ids"`
 }
 
+func (req *SetPinnedForumTopicsRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64s("message_thread_ids", req.MessageThreadIds)
+}
+
+func (req *SetPinnedForumTopicsRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Changes the order of pinned forum topics
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SetPinnedForumTopics(req *SetPinnedForumTopicsRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetPinnedForumTopicsContext(context.Background(), req)
+}
+
+// Changes the order of pinned forum topics
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SetPinnedForumTopicsContext(ctx context.Context, req *SetPinnedForumTopicsRequest) (*Ok, error) {
+	err := client.checkUsersOnly("setPinnedForumTopics")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setPinnedForumTopics",
 		},
-		Data: map[string]interface{}{
-			"chat_id":            req.ChatId,
-			"message_thread_ids": req.MessageThreadIds,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -4151,16 +6035,27 @@ type DeleteForumTopicRequest struct {
 	MessageThreadId int64 `json:"message_thread_id"`
 }
 
+func (req *DeleteForumTopicRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_thread_id", req.MessageThreadId)
+}
+
+func (req *DeleteForumTopicRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Deletes all messages in a forum topic; requires can_delete_messages administrator right in the supergroup unless the user is creator of the topic, the topic has no messages from other users and has at most 11 messages
 func (client *Client) DeleteForumTopic(req *DeleteForumTopicRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.DeleteForumTopicContext(context.Background(), req)
+}
+
+// Deletes all messages in a forum topic; requires can_delete_messages administrator right in the supergroup unless the user is creator of the topic, the topic has no messages from other users and has at most 11 messages
+func (client *Client) DeleteForumTopicContext(ctx context.Context, req *DeleteForumTopicRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "deleteForumTopic",
 		},
-		Data: map[string]interface{}{
-			"chat_id":           req.ChatId,
-			"message_thread_id": req.MessageThreadId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -4178,15 +6073,31 @@ type GetEmojiReactionRequest struct {
 	Emoji string `json:"emoji"`
 }
 
+func (req *GetEmojiReactionRequest) encode(encoder *encoder) {
+	encoder.String("emoji", req.Emoji)
+}
+
 // Returns information about a emoji reaction. Returns a 404 error if the reaction is not found
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetEmojiReaction(req *GetEmojiReactionRequest) (*EmojiReaction, error) {
-	result, err := client.Send(Request{
+	return client.GetEmojiReactionContext(context.Background(), req)
+}
+
+// Returns information about a emoji reaction. Returns a 404 error if the reaction is not found
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetEmojiReactionContext(ctx context.Context, req *GetEmojiReactionRequest) (*EmojiReaction, error) {
+	err := client.checkUsersOnly("getEmojiReaction")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getEmojiReaction",
 		},
-		Data: map[string]interface{}{
-			"emoji": req.Emoji,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -4200,14 +6111,27 @@ func (client *Client) GetEmojiReaction(req *GetEmojiReactionRequest) (*EmojiReac
 }
 
 // Returns TGS stickers with generic animations for custom emoji reactions
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetCustomEmojiReactionAnimations() (*Stickers, error) {
-	result, err := client.Send(Request{
-		meta: meta{
-			Type: "getCustomEmojiReactionAnimations",
-		},
-		Data: map[string]interface{}{},
-	})
-	if err != nil {
+	return client.GetCustomEmojiReactionAnimationsContext(context.Background())
+}
+
+// Returns TGS stickers with generic animations for custom emoji reactions
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetCustomEmojiReactionAnimationsContext(ctx context.Context) (*Stickers, error) {
+	err := client.checkUsersOnly("getCustomEmojiReactionAnimations")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
+		meta: meta{
+			Type: "getCustomEmojiReactionAnimations",
+		},
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -4227,17 +6151,37 @@ type GetMessageAvailableReactionsRequest struct {
 	RowSize int32 `json:"row_size"`
 }
 
+func (req *GetMessageAvailableReactionsRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_id", req.MessageId)
+	encoder.Int32("row_size", req.RowSize)
+}
+
+func (req *GetMessageAvailableReactionsRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns reactions, which can be added to a message. The list can change after updateActiveEmojiReactions, updateChatAvailableReactions for the chat, or updateMessageInteractionInfo for the message
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetMessageAvailableReactions(req *GetMessageAvailableReactionsRequest) (*AvailableReactions, error) {
-	result, err := client.Send(Request{
+	return client.GetMessageAvailableReactionsContext(context.Background(), req)
+}
+
+// Returns reactions, which can be added to a message. The list can change after updateActiveEmojiReactions, updateChatAvailableReactions for the chat, or updateMessageInteractionInfo for the message
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetMessageAvailableReactionsContext(ctx context.Context, req *GetMessageAvailableReactionsRequest) (*AvailableReactions, error) {
+	err := client.checkUsersOnly("getMessageAvailableReactions")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getMessageAvailableReactions",
 		},
-		Data: map[string]interface{}{
-			"chat_id":    req.ChatId,
-			"message_id": req.MessageId,
-			"row_size":   req.RowSize,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -4251,12 +6195,25 @@ func (client *Client) GetMessageAvailableReactions(req *GetMessageAvailableReact
 }
 
 // Clears the list of recently used reactions
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ClearRecentReactions() (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ClearRecentReactionsContext(context.Background())
+}
+
+// Clears the list of recently used reactions
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ClearRecentReactionsContext(ctx context.Context) (*Ok, error) {
+	err := client.checkUsersOnly("clearRecentReactions")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "clearRecentReactions",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -4282,19 +6239,40 @@ type AddMessageReactionRequest struct {
 	UpdateRecentReactions bool `json:"update_recent_reactions"`
 }
 
+func (req *AddMessageReactionRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_id", req.MessageId)
+	encoder.name("reaction_type")
+	encodeReactionType(encoder, req.ReactionType)
+	encoder.Bool("is_big", req.IsBig)
+	encoder.Bool("update_recent_reactions", req.UpdateRecentReactions)
+}
+
+func (req *AddMessageReactionRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Adds a reaction to a message. Use getMessageAvailableReactions to receive the list of available reactions for the message
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) AddMessageReaction(req *AddMessageReactionRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.AddMessageReactionContext(context.Background(), req)
+}
+
+// Adds a reaction to a message. Use getMessageAvailableReactions to receive the list of available reactions for the message
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) AddMessageReactionContext(ctx context.Context, req *AddMessageReactionRequest) (*Ok, error) {
+	err := client.checkUsersOnly("addMessageReaction")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "addMessageReaction",
 		},
-		Data: map[string]interface{}{
-			"chat_id":                 req.ChatId,
-			"message_id":              req.MessageId,
-			"reaction_type":           req.ReactionType,
-			"is_big":                  req.IsBig,
-			"update_recent_reactions": req.UpdateRecentReactions,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -4316,17 +6294,38 @@ type RemoveMessageReactionRequest struct {
 	ReactionType ReactionType `json:"reaction_type"`
 }
 
+func (req *RemoveMessageReactionRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_id", req.MessageId)
+	encoder.name("reaction_type")
+	encodeReactionType(encoder, req.ReactionType)
+}
+
+func (req *RemoveMessageReactionRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Removes a reaction from a message. A chosen reaction can always be removed
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) RemoveMessageReaction(req *RemoveMessageReactionRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.RemoveMessageReactionContext(context.Background(), req)
+}
+
+// Removes a reaction from a message. A chosen reaction can always be removed
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) RemoveMessageReactionContext(ctx context.Context, req *RemoveMessageReactionRequest) (*Ok, error) {
+	err := client.checkUsersOnly("removeMessageReaction")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "removeMessageReaction",
 		},
-		Data: map[string]interface{}{
-			"chat_id":       req.ChatId,
-			"message_id":    req.MessageId,
-			"reaction_type": req.ReactionType,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -4352,19 +6351,40 @@ type GetMessageAddedReactionsRequest struct {
 	Limit int32 `json:"limit"`
 }
 
+func (req *GetMessageAddedReactionsRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_id", req.MessageId)
+	encoder.name("reaction_type")
+	encodeReactionType(encoder, req.ReactionType)
+	encoder.String("offset", req.Offset)
+	encoder.Int32("limit", req.Limit)
+}
+
+func (req *GetMessageAddedReactionsRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns reactions added for a message, along with their sender
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetMessageAddedReactions(req *GetMessageAddedReactionsRequest) (*AddedReactions, error) {
-	result, err := client.Send(Request{
+	return client.GetMessageAddedReactionsContext(context.Background(), req)
+}
+
+// Returns reactions added for a message, along with their sender
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetMessageAddedReactionsContext(ctx context.Context, req *GetMessageAddedReactionsRequest) (*AddedReactions, error) {
+	err := client.checkUsersOnly("getMessageAddedReactions")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getMessageAddedReactions",
 		},
-		Data: map[string]interface{}{
-			"chat_id":       req.ChatId,
-			"message_id":    req.MessageId,
-			"reaction_type": req.ReactionType,
-			"offset":        req.Offset,
-			"limit":         req.Limit,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -4382,15 +6402,32 @@ type SetDefaultReactionTypeRequest struct {
 	ReactionType ReactionType `json:"reaction_type"`
 }
 
+func (req *SetDefaultReactionTypeRequest) encode(encoder *encoder) {
+	encoder.name("reaction_type")
+	encodeReactionType(encoder, req.ReactionType)
+}
+
 // Changes type of default reaction for the current user
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SetDefaultReactionType(req *SetDefaultReactionTypeRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetDefaultReactionTypeContext(context.Background(), req)
+}
+
+// Changes type of default reaction for the current user
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SetDefaultReactionTypeContext(ctx context.Context, req *SetDefaultReactionTypeRequest) (*Ok, error) {
+	err := client.checkUsersOnly("setDefaultReactionType")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setDefaultReactionType",
 		},
-		Data: map[string]interface{}{
-			"reaction_type": req.ReactionType,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -4408,15 +6445,17 @@ type GetTextEntitiesRequest struct {
 	Text string `json:"text"`
 }
 
+func (req *GetTextEntitiesRequest) encode(encoder *encoder) {
+	encoder.String("text", req.Text)
+}
+
 // Returns all entities (mentions, hashtags, cashtags, bot commands, bank card numbers, URLs, and email addresses) found in the text. Can be called synchronously
 func GetTextEntities(req *GetTextEntitiesRequest) (*TextEntities, error) {
 	result, err := Execute(Request{
 		meta: meta{
 			Type: "getTextEntities",
 		},
-		Data: map[string]interface{}{
-			"text": req.Text,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -4432,7 +6471,21 @@ func GetTextEntities(req *GetTextEntitiesRequest) (*TextEntities, error) {
 // deprecated
 // Returns all entities (mentions, hashtags, cashtags, bot commands, bank card numbers, URLs, and email addresses) found in the text. Can be called synchronously
 func (client *Client) GetTextEntities(req *GetTextEntitiesRequest) (*TextEntities, error) {
-	return GetTextEntities(req)
+	result, err := client.Execute(Request{
+		meta: meta{
+			Type: "getTextEntities",
+		},
+		Data: req,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Type == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return UnmarshalTextEntities(result.Data)
 }
 
 type ParseTextEntitiesRequest struct {
@@ -4442,16 +6495,19 @@ type ParseTextEntitiesRequest struct {
 	ParseMode TextParseMode `json:"parse_mode"`
 }
 
+func (req *ParseTextEntitiesRequest) encode(encoder *encoder) {
+	encoder.String("text", req.Text)
+	encoder.name("parse_mode")
+	encodeTextParseMode(encoder, req.ParseMode)
+}
+
 // Parses Bold, Italic, Underline, Strikethrough, Spoiler, CustomEmoji, Code, Pre, PreCode, TextUrl and MentionName entities from a marked-up text. Can be called synchronously
 func ParseTextEntities(req *ParseTextEntitiesRequest) (*FormattedText, error) {
 	result, err := Execute(Request{
 		meta: meta{
 			Type: "parseTextEntities",
 		},
-		Data: map[string]interface{}{
-			"text":       req.Text,
-			"parse_mode": req.ParseMode,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -4467,7 +6523,21 @@ func ParseTextEntities(req *ParseTextEntitiesRequest) (*FormattedText, error) {
 // deprecated
 // Parses Bold, Italic, Underline, Strikethrough, Spoiler, CustomEmoji, Code, Pre, PreCode, TextUrl and MentionName entities from a marked-up text. Can be called synchronously
 func (client *Client) ParseTextEntities(req *ParseTextEntitiesRequest) (*FormattedText, error) {
-	return ParseTextEntities(req)
+	result, err := client.Execute(Request{
+		meta: meta{
+			Type: "parseTextEntities",
+		},
+		Data: req,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Type == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return UnmarshalFormattedText(result.Data)
 }
 
 type ParseMarkdownRequest struct {
@@ -4475,15 +6545,18 @@ type ParseMarkdownRequest struct {
 	Text *FormattedText `json:"text"`
 }
 
+func (req *ParseMarkdownRequest) encode(encoder *encoder) {
+	encoder.name("text")
+	encodeFormattedText(encoder, req.Text)
+}
+
 // Parses Markdown entities in a human-friendly format, ignoring markup errors. Can be called synchronously
 func ParseMarkdown(req *ParseMarkdownRequest) (*FormattedText, error) {
 	result, err := Execute(Request{
 		meta: meta{
 			Type: "parseMarkdown",
 		},
-		Data: map[string]interface{}{
-			"text": req.Text,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -4499,7 +6572,21 @@ func ParseMarkdown(req *ParseMarkdownRequest) (*FormattedText, error) {
 // deprecated
 // Parses Markdown entities in a human-friendly format, ignoring markup errors. Can be called synchronously
 func (client *Client) ParseMarkdown(req *ParseMarkdownRequest) (*FormattedText, error) {
-	return ParseMarkdown(req)
+	result, err := client.Execute(Request{
+		meta: meta{
+			Type: "parseMarkdown",
+		},
+		Data: req,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Type == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return UnmarshalFormattedText(result.Data)
 }
 
 type GetMarkdownTextRequest struct {
@@ -4507,15 +6594,18 @@ type GetMarkdownTextRequest struct {
 	Text *FormattedText `json:"text"`
 }
 
+func (req *GetMarkdownTextRequest) encode(encoder *encoder) {
+	encoder.name("text")
+	encodeFormattedText(encoder, req.Text)
+}
+
 // Replaces text entities with Markdown formatting in a human-friendly format. Entities that can't be represented in Markdown unambiguously are kept as is. Can be called synchronously
 func GetMarkdownText(req *GetMarkdownTextRequest) (*FormattedText, error) {
 	result, err := Execute(Request{
 		meta: meta{
 			Type: "getMarkdownText",
 		},
-		Data: map[string]interface{}{
-			"text": req.Text,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -4531,7 +6621,21 @@ func GetMarkdownText(req *GetMarkdownTextRequest) (*FormattedText, error) {
 // deprecated
 // Replaces text entities with Markdown formatting in a human-friendly format. Entities that can't be represented in Markdown unambiguously are kept as is. Can be called synchronously
 func (client *Client) GetMarkdownText(req *GetMarkdownTextRequest) (*FormattedText, error) {
-	return GetMarkdownText(req)
+	result, err := client.Execute(Request{
+		meta: meta{
+			Type: "getMarkdownText",
+		},
+		Data: req,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Type == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return UnmarshalFormattedText(result.Data)
 }
 
 type GetFileMimeTypeRequest struct {
@@ -4539,15 +6643,17 @@ type GetFileMimeTypeRequest struct {
 	FileName string `json:"file_name"`
 }
 
+func (req *GetFileMimeTypeRequest) encode(encoder *encoder) {
+	encoder.String("file_name", req.FileName)
+}
+
 // Returns the MIME type of a file, guessed by its extension. Returns an empty string on failure. Can be called synchronously
 func GetFileMimeType(req *GetFileMimeTypeRequest) (*Text, error) {
 	result, err := Execute(Request{
 		meta: meta{
 			Type: "getFileMimeType",
 		},
-		Data: map[string]interface{}{
-			"file_name": req.FileName,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -4563,7 +6669,21 @@ func GetFileMimeType(req *GetFileMimeTypeRequest) (*Text, error) {
 // deprecated
 // Returns the MIME type of a file, guessed by its extension. Returns an empty string on failure. Can be called synchronously
 func (client *Client) GetFileMimeType(req *GetFileMimeTypeRequest) (*Text, error) {
-	return GetFileMimeType(req)
+	result, err := client.Execute(Request{
+		meta: meta{
+			Type: "getFileMimeType",
+		},
+		Data: req,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Type == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return UnmarshalText(result.Data)
 }
 
 type GetFileExtensionRequest struct {
@@ -4571,15 +6691,17 @@ type GetFileExtensionRequest struct {
 	MimeType string `json:"mime_type"`
 }
 
+func (req *GetFileExtensionRequest) encode(encoder *encoder) {
+	encoder.String("mime_type", req.MimeType)
+}
+
 // Returns the extension of a file, guessed by its MIME type. Returns an empty string on failure. Can be called synchronously
 func GetFileExtension(req *GetFileExtensionRequest) (*Text, error) {
 	result, err := Execute(Request{
 		meta: meta{
 			Type: "getFileExtension",
 		},
-		Data: map[string]interface{}{
-			"mime_type": req.MimeType,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -4595,7 +6717,21 @@ func GetFileExtension(req *GetFileExtensionRequest) (*Text, error) {
 // deprecated
 // Returns the extension of a file, guessed by its MIME type. Returns an empty string on failure. Can be called synchronously
 func (client *Client) GetFileExtension(req *GetFileExtensionRequest) (*Text, error) {
-	return GetFileExtension(req)
+	result, err := client.Execute(Request{
+		meta: meta{
+			Type: "getFileExtension",
+		},
+		Data: req,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Type == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return UnmarshalText(result.Data)
 }
 
 type CleanFileNameRequest struct {
@@ -4603,15 +6739,17 @@ type CleanFileNameRequest struct {
 	FileName string `json:"file_name"`
 }
 
+func (req *CleanFileNameRequest) encode(encoder *encoder) {
+	encoder.String("file_name", req.FileName)
+}
+
 // Removes potentially dangerous characters from the name of a file. The encoding of the file name is supposed to be UTF-8. Returns an empty string on failure. Can be called synchronously
 func CleanFileName(req *CleanFileNameRequest) (*Text, error) {
 	result, err := Execute(Request{
 		meta: meta{
 			Type: "cleanFileName",
 		},
-		Data: map[string]interface{}{
-			"file_name": req.FileName,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -4627,7 +6765,21 @@ func CleanFileName(req *CleanFileNameRequest) (*Text, error) {
 // deprecated
 // Removes potentially dangerous characters from the name of a file. The encoding of the file name is supposed to be UTF-8. Returns an empty string on failure. Can be called synchronously
 func (client *Client) CleanFileName(req *CleanFileNameRequest) (*Text, error) {
-	return CleanFileName(req)
+	result, err := client.Execute(Request{
+		meta: meta{
+			Type: "cleanFileName",
+		},
+		Data: req,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Type == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return UnmarshalText(result.Data)
 }
 
 type GetLanguagePackStringRequest struct {
@@ -4641,18 +6793,20 @@ type GetLanguagePackStringRequest struct {
 	Key string `json:"key"`
 }
 
+func (req *GetLanguagePackStringRequest) encode(encoder *encoder) {
+	encoder.String("language_pack_database_path", req.LanguagePackDatabasePath)
+	encoder.String("localization_target", req.LocalizationTarget)
+	encoder.String("language_pack_id", req.LanguagePackId)
+	encoder.String("key", req.Key)
+}
+
 // Returns a string stored in the local database from the specified localization target and language pack by its key. Returns a 404 error if the string is not found. Can be called synchronously
 func GetLanguagePackString(req *GetLanguagePackStringRequest) (LanguagePackStringValue, error) {
 	result, err := Execute(Request{
 		meta: meta{
 			Type: "getLanguagePackString",
 		},
-		Data: map[string]interface{}{
-			"language_pack_database_path": req.LanguagePackDatabasePath,
-			"localization_target":         req.LocalizationTarget,
-			"language_pack_id":            req.LanguagePackId,
-			"key":                         req.Key,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -4662,25 +6816,27 @@ func GetLanguagePackString(req *GetLanguagePackStringRequest) (LanguagePackStrin
 		return nil, buildResponseError(result.Data)
 	}
 
-	switch result.Type {
-	case TypeLanguagePackStringValueOrdinary:
-		return UnmarshalLanguagePackStringValueOrdinary(result.Data)
-
-	case TypeLanguagePackStringValuePluralized:
-		return UnmarshalLanguagePackStringValuePluralized(result.Data)
-
-	case TypeLanguagePackStringValueDeleted:
-		return UnmarshalLanguagePackStringValueDeleted(result.Data)
-
-	default:
-		return nil, errors.New("invalid type")
-	}
+	return UnmarshalLanguagePackStringValue(result.Data)
 }
 
 // deprecated
 // Returns a string stored in the local database from the specified localization target and language pack by its key. Returns a 404 error if the string is not found. Can be called synchronously
 func (client *Client) GetLanguagePackString(req *GetLanguagePackStringRequest) (LanguagePackStringValue, error) {
-	return GetLanguagePackString(req)
+	result, err := client.Execute(Request{
+		meta: meta{
+			Type: "getLanguagePackString",
+		},
+		Data: req,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Type == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return UnmarshalLanguagePackStringValue(result.Data)
 }
 
 type GetJsonValueRequest struct {
@@ -4688,15 +6844,17 @@ type GetJsonValueRequest struct {
 	Json string `json:"json"`
 }
 
+func (req *GetJsonValueRequest) encode(encoder *encoder) {
+	encoder.String("json", req.Json)
+}
+
 // Converts a JSON-serialized string to corresponding JsonValue object. Can be called synchronously
 func GetJsonValue(req *GetJsonValueRequest) (JsonValue, error) {
 	result, err := Execute(Request{
 		meta: meta{
 			Type: "getJsonValue",
 		},
-		Data: map[string]interface{}{
-			"json": req.Json,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -4706,34 +6864,27 @@ func GetJsonValue(req *GetJsonValueRequest) (JsonValue, error) {
 		return nil, buildResponseError(result.Data)
 	}
 
-	switch result.Type {
-	case TypeJsonValueNull:
-		return UnmarshalJsonValueNull(result.Data)
-
-	case TypeJsonValueBoolean:
-		return UnmarshalJsonValueBoolean(result.Data)
-
-	case TypeJsonValueNumber:
-		return UnmarshalJsonValueNumber(result.Data)
-
-	case TypeJsonValueString:
-		return UnmarshalJsonValueString(result.Data)
-
-	case TypeJsonValueArray:
-		return UnmarshalJsonValueArray(result.Data)
-
-	case TypeJsonValueObject:
-		return UnmarshalJsonValueObject(result.Data)
-
-	default:
-		return nil, errors.New("invalid type")
-	}
+	return UnmarshalJsonValue(result.Data)
 }
 
 // deprecated
 // Converts a JSON-serialized string to corresponding JsonValue object. Can be called synchronously
 func (client *Client) GetJsonValue(req *GetJsonValueRequest) (JsonValue, error) {
-	return GetJsonValue(req)
+	result, err := client.Execute(Request{
+		meta: meta{
+			Type: "getJsonValue",
+		},
+		Data: req,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Type == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return UnmarshalJsonValue(result.Data)
 }
 
 type GetJsonStringRequest struct {
@@ -4741,15 +6892,18 @@ type GetJsonStringRequest struct {
 	JsonValue JsonValue `json:"json_value"`
 }
 
+func (req *GetJsonStringRequest) encode(encoder *encoder) {
+	encoder.name("json_value")
+	encodeJsonValue(encoder, req.JsonValue)
+}
+
 // Converts a JsonValue object to corresponding JSON-serialized string. Can be called synchronously
 func GetJsonString(req *GetJsonStringRequest) (*Text, error) {
 	result, err := Execute(Request{
 		meta: meta{
 			Type: "getJsonString",
 		},
-		Data: map[string]interface{}{
-			"json_value": req.JsonValue,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -4765,7 +6919,21 @@ func GetJsonString(req *GetJsonStringRequest) (*Text, error) {
 // deprecated
 // Converts a JsonValue object to corresponding JSON-serialized string. Can be called synchronously
 func (client *Client) GetJsonString(req *GetJsonStringRequest) (*Text, error) {
-	return GetJsonString(req)
+	result, err := client.Execute(Request{
+		meta: meta{
+			Type: "getJsonString",
+		},
+		Data: req,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Type == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return UnmarshalText(result.Data)
 }
 
 type GetThemeParametersJsonStringRequest struct {
@@ -4773,15 +6941,18 @@ type GetThemeParametersJsonStringRequest struct {
 	Theme *ThemeParameters `json:"theme"`
 }
 
+func (req *GetThemeParametersJsonStringRequest) encode(encoder *encoder) {
+	encoder.name("theme")
+	encodeThemeParameters(encoder, req.Theme)
+}
+
 // Converts a themeParameters object to corresponding JSON-serialized string. Can be called synchronously
 func GetThemeParametersJsonString(req *GetThemeParametersJsonStringRequest) (*Text, error) {
 	result, err := Execute(Request{
 		meta: meta{
 			Type: "getThemeParametersJsonString",
 		},
-		Data: map[string]interface{}{
-			"theme": req.Theme,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -4797,29 +6968,63 @@ func GetThemeParametersJsonString(req *GetThemeParametersJsonStringRequest) (*Te
 // deprecated
 // Converts a themeParameters object to corresponding JSON-serialized string. Can be called synchronously
 func (client *Client) GetThemeParametersJsonString(req *GetThemeParametersJsonStringRequest) (*Text, error) {
-	return GetThemeParametersJsonString(req)
-}
-
-type SetPollAnswerRequest struct {
-	// Identifier of the chat to which the poll belongs
-	ChatId int64 `json:"chat_id"`
-	// Identifier of the message containing the poll
-	MessageId int64 `json:"message_id"`
+	result, err := client.Execute(Request{
+		meta: meta{
+			Type: "getThemeParametersJsonString",
+		},
+		Data: req,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Type == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return UnmarshalText(result.Data)
+}
+
+type SetPollAnswerRequest struct {
+	// Identifier of the chat to which the poll belongs
+	ChatId int64 `json:"chat_id"`
+	// Identifier of the message containing the poll
+	MessageId int64 `json:"message_id"`
 	// 0-based identifiers of answer options, chosen by the user. User can choose more than 1 answer option only is the poll allows multiple answers
 	OptionIds []int32 `json:"option_ids"`
 }
 
+func (req *SetPollAnswerRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_id", req.MessageId)
+	encoder.Int32s("option_ids", req.OptionIds)
+}
+
+func (req *SetPollAnswerRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Changes the user answer to a poll. A poll in quiz mode can be answered only once
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SetPollAnswer(req *SetPollAnswerRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetPollAnswerContext(context.Background(), req)
+}
+
+// Changes the user answer to a poll. A poll in quiz mode can be answered only once
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SetPollAnswerContext(ctx context.Context, req *SetPollAnswerRequest) (*Ok, error) {
+	err := client.checkUsersOnly("setPollAnswer")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setPollAnswer",
 		},
-		Data: map[string]interface{}{
-			"chat_id":    req.ChatId,
-			"message_id": req.MessageId,
-			"option_ids": req.OptionIds,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -4845,19 +7050,39 @@ type GetPollVotersRequest struct {
 	Limit int32 `json:"limit"`
 }
 
+func (req *GetPollVotersRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_id", req.MessageId)
+	encoder.Int32("option_id", req.OptionId)
+	encoder.Int32("offset", req.Offset)
+	encoder.Int32("limit", req.Limit)
+}
+
+func (req *GetPollVotersRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns users voted for the specified option in a non-anonymous polls. For optimal performance, the number of returned users is chosen by TDLib
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetPollVoters(req *GetPollVotersRequest) (*Users, error) {
-	result, err := client.Send(Request{
+	return client.GetPollVotersContext(context.Background(), req)
+}
+
+// Returns users voted for the specified option in a non-anonymous polls. For optimal performance, the number of returned users is chosen by TDLib
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetPollVotersContext(ctx context.Context, req *GetPollVotersRequest) (*Users, error) {
+	err := client.checkUsersOnly("getPollVoters")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getPollVoters",
 		},
-		Data: map[string]interface{}{
-			"chat_id":    req.ChatId,
-			"message_id": req.MessageId,
-			"option_id":  req.OptionId,
-			"offset":     req.Offset,
-			"limit":      req.Limit,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -4879,17 +7104,29 @@ type StopPollRequest struct {
 	ReplyMarkup ReplyMarkup `json:"reply_markup"`
 }
 
+func (req *StopPollRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_id", req.MessageId)
+	encoder.name("reply_markup")
+	encodeReplyMarkup(encoder, req.ReplyMarkup)
+}
+
+func (req *StopPollRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Stops a poll. A poll in a message can be stopped when the message has can_be_edited flag set
 func (client *Client) StopPoll(req *StopPollRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.StopPollContext(context.Background(), req)
+}
+
+// Stops a poll. A poll in a message can be stopped when the message has can_be_edited flag set
+func (client *Client) StopPollContext(ctx context.Context, req *StopPollRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "stopPoll",
 		},
-		Data: map[string]interface{}{
-			"chat_id":      req.ChatId,
-			"message_id":   req.MessageId,
-			"reply_markup": req.ReplyMarkup,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -4907,15 +7144,32 @@ type HideSuggestedActionRequest struct {
 	Action SuggestedAction `json:"action"`
 }
 
+func (req *HideSuggestedActionRequest) encode(encoder *encoder) {
+	encoder.name("action")
+	encodeSuggestedAction(encoder, req.Action)
+}
+
 // Hides a suggested action
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) HideSuggestedAction(req *HideSuggestedActionRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.HideSuggestedActionContext(context.Background(), req)
+}
+
+// Hides a suggested action
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) HideSuggestedActionContext(ctx context.Context, req *HideSuggestedActionRequest) (*Ok, error) {
+	err := client.checkUsersOnly("hideSuggestedAction")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "hideSuggestedAction",
 		},
-		Data: map[string]interface{}{
-			"action": req.Action,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -4937,17 +7191,37 @@ type GetLoginUrlInfoRequest struct {
 	ButtonId int64 `json:"button_id"`
 }
 
+func (req *GetLoginUrlInfoRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_id", req.MessageId)
+	encoder.Int64("button_id", req.ButtonId)
+}
+
+func (req *GetLoginUrlInfoRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns information about a button of type inlineKeyboardButtonTypeLoginUrl. The method needs to be called when the user presses the button
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetLoginUrlInfo(req *GetLoginUrlInfoRequest) (LoginUrlInfo, error) {
-	result, err := client.Send(Request{
+	return client.GetLoginUrlInfoContext(context.Background(), req)
+}
+
+// Returns information about a button of type inlineKeyboardButtonTypeLoginUrl. The method needs to be called when the user presses the button
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetLoginUrlInfoContext(ctx context.Context, req *GetLoginUrlInfoRequest) (LoginUrlInfo, error) {
+	err := client.checkUsersOnly("getLoginUrlInfo")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getLoginUrlInfo",
 		},
-		Data: map[string]interface{}{
-			"chat_id":    req.ChatId,
-			"message_id": req.MessageId,
-			"button_id":  req.ButtonId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -4957,16 +7231,7 @@ func (client *Client) GetLoginUrlInfo(req *GetLoginUrlInfoRequest) (LoginUrlInfo
 		return nil, buildResponseError(result.Data)
 	}
 
-	switch result.Type {
-	case TypeLoginUrlInfoOpen:
-		return UnmarshalLoginUrlInfoOpen(result.Data)
-
-	case TypeLoginUrlInfoRequestConfirmation:
-		return UnmarshalLoginUrlInfoRequestConfirmation(result.Data)
-
-	default:
-		return nil, errors.New("invalid type")
-	}
+	return UnmarshalLoginUrlInfo(result.Data)
 }
 
 type GetLoginUrlRequest struct {
@@ -4980,18 +7245,38 @@ type GetLoginUrlRequest struct {
 	AllowWriteAccess bool `json:"allow_write_access"`
 }
 
+func (req *GetLoginUrlRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_id", req.MessageId)
+	encoder.Int64("button_id", req.ButtonId)
+	encoder.Bool("allow_write_access", req.AllowWriteAccess)
+}
+
+func (req *GetLoginUrlRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns an HTTP URL which can be used to automatically authorize the user on a website after clicking an inline button of type inlineKeyboardButtonTypeLoginUrl. Use the method getLoginUrlInfo to find whether a prior user confirmation is needed. If an error is returned, then the button must be handled as an ordinary URL button
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetLoginUrl(req *GetLoginUrlRequest) (*HttpUrl, error) {
-	result, err := client.Send(Request{
+	return client.GetLoginUrlContext(context.Background(), req)
+}
+
+// Returns an HTTP URL which can be used to automatically authorize the user on a website after clicking an inline button of type inlineKeyboardButtonTypeLoginUrl. Use the method getLoginUrlInfo to find whether a prior user confirmation is needed. If an error is returned, then the button must be handled as an ordinary URL button
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetLoginUrlContext(ctx context.Context, req *GetLoginUrlRequest) (*HttpUrl, error) {
+	err := client.checkUsersOnly("getLoginUrl")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getLoginUrl",
 		},
-		Data: map[string]interface{}{
-			"chat_id":            req.ChatId,
-			"message_id":         req.MessageId,
-			"button_id":          req.ButtonId,
-			"allow_write_access": req.AllowWriteAccess,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -5017,19 +7302,39 @@ type ShareUserWithBotRequest struct {
 	OnlyCheck bool `json:"only_check"`
 }
 
+func (req *ShareUserWithBotRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_id", req.MessageId)
+	encoder.Int32("button_id", req.ButtonId)
+	encoder.Int64("shared_user_id", req.SharedUserId)
+	encoder.Bool("only_check", req.OnlyCheck)
+}
+
+func (req *ShareUserWithBotRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Shares a user after pressing a keyboardButtonTypeRequestUser button with the bot
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ShareUserWithBot(req *ShareUserWithBotRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ShareUserWithBotContext(context.Background(), req)
+}
+
+// Shares a user after pressing a keyboardButtonTypeRequestUser button with the bot
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ShareUserWithBotContext(ctx context.Context, req *ShareUserWithBotRequest) (*Ok, error) {
+	err := client.checkUsersOnly("shareUserWithBot")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "shareUserWithBot",
 		},
-		Data: map[string]interface{}{
-			"chat_id":        req.ChatId,
-			"message_id":     req.MessageId,
-			"button_id":      req.ButtonId,
-			"shared_user_id": req.SharedUserId,
-			"only_check":     req.OnlyCheck,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -5055,19 +7360,39 @@ type ShareChatWithBotRequest struct {
 	OnlyCheck bool `json:"only_check"`
 }
 
+func (req *ShareChatWithBotRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_id", req.MessageId)
+	encoder.Int32("button_id", req.ButtonId)
+	encoder.Int64("shared_chat_id", req.SharedChatId)
+	encoder.Bool("only_check", req.OnlyCheck)
+}
+
+func (req *ShareChatWithBotRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Shares a chat after pressing a keyboardButtonTypeRequestChat button with the bot
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ShareChatWithBot(req *ShareChatWithBotRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ShareChatWithBotContext(context.Background(), req)
+}
+
+// Shares a chat after pressing a keyboardButtonTypeRequestChat button with the bot
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ShareChatWithBotContext(ctx context.Context, req *ShareChatWithBotRequest) (*Ok, error) {
+	err := client.checkUsersOnly("shareChatWithBot")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "shareChatWithBot",
 		},
-		Data: map[string]interface{}{
-			"chat_id":        req.ChatId,
-			"message_id":     req.MessageId,
-			"button_id":      req.ButtonId,
-			"shared_chat_id": req.SharedChatId,
-			"only_check":     req.OnlyCheck,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -5093,19 +7418,40 @@ type GetInlineQueryResultsRequest struct {
 	Offset string `json:"offset"`
 }
 
+func (req *GetInlineQueryResultsRequest) encode(encoder *encoder) {
+	encoder.Int64("bot_user_id", req.BotUserId)
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.name("user_location")
+	encodeLocation(encoder, req.UserLocation)
+	encoder.String("query", req.Query)
+	encoder.String("offset", req.Offset)
+}
+
+func (req *GetInlineQueryResultsRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Sends an inline query to a bot and returns its results. Returns an error with code 502 if the bot fails to answer the query before the query timeout expires
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetInlineQueryResults(req *GetInlineQueryResultsRequest) (*InlineQueryResults, error) {
-	result, err := client.Send(Request{
+	return client.GetInlineQueryResultsContext(context.Background(), req)
+}
+
+// Sends an inline query to a bot and returns its results. Returns an error with code 502 if the bot fails to answer the query before the query timeout expires
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetInlineQueryResultsContext(ctx context.Context, req *GetInlineQueryResultsRequest) (*InlineQueryResults, error) {
+	err := client.checkUsersOnly("getInlineQueryResults")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getInlineQueryResults",
 		},
-		Data: map[string]interface{}{
-			"bot_user_id":   req.BotUserId,
-			"chat_id":       req.ChatId,
-			"user_location": req.UserLocation,
-			"query":         req.Query,
-			"offset":        req.Offset,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -5133,20 +7479,38 @@ type AnswerInlineQueryRequest struct {
 	NextOffset string `json:"next_offset"`
 }
 
+func (req *AnswerInlineQueryRequest) encode(encoder *encoder) {
+	encoder.JsonInt64("inline_query_id", req.InlineQueryId)
+	encoder.Bool("is_personal", req.IsPersonal)
+	encoder.name("button")
+	encodeInlineQueryResultsButton(encoder, req.Button)
+	encoder.name("results")
+	encodeListOfInputInlineQueryResult(encoder, req.Results)
+	encoder.Int32("cache_time", req.CacheTime)
+	encoder.String("next_offset", req.NextOffset)
+}
+
 // Sets the result of an inline query; for bots only
+//
+// Available only to bots, users get FunctionTypeError
 func (client *Client) AnswerInlineQuery(req *AnswerInlineQueryRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.AnswerInlineQueryContext(context.Background(), req)
+}
+
+// Sets the result of an inline query; for bots only
+//
+// Available only to bots, users get FunctionTypeError
+func (client *Client) AnswerInlineQueryContext(ctx context.Context, req *AnswerInlineQueryRequest) (*Ok, error) {
+	err := client.checkBotsOnly("answerInlineQuery")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "answerInlineQuery",
 		},
-		Data: map[string]interface{}{
-			"inline_query_id": req.InlineQueryId,
-			"is_personal":     req.IsPersonal,
-			"button":          req.Button,
-			"results":         req.Results,
-			"cache_time":      req.CacheTime,
-			"next_offset":     req.NextOffset,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -5166,16 +7530,32 @@ type SearchWebAppRequest struct {
 	WebAppShortName string `json:"web_app_short_name"`
 }
 
+func (req *SearchWebAppRequest) encode(encoder *encoder) {
+	encoder.Int64("bot_user_id", req.BotUserId)
+	encoder.String("web_app_short_name", req.WebAppShortName)
+}
+
 // Returns information about a Web App by its short name. Returns a 404 error if the Web App is not found
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SearchWebApp(req *SearchWebAppRequest) (*FoundWebApp, error) {
-	result, err := client.Send(Request{
+	return client.SearchWebAppContext(context.Background(), req)
+}
+
+// Returns information about a Web App by its short name. Returns a 404 error if the Web App is not found
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SearchWebAppContext(ctx context.Context, req *SearchWebAppRequest) (*FoundWebApp, error) {
+	err := client.checkUsersOnly("searchWebApp")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "searchWebApp",
 		},
-		Data: map[string]interface{}{
-			"bot_user_id":        req.BotUserId,
-			"web_app_short_name": req.WebAppShortName,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -5205,21 +7585,42 @@ type GetWebAppLinkUrlRequest struct {
 	AllowWriteAccess bool `json:"allow_write_access"`
 }
 
+func (req *GetWebAppLinkUrlRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("bot_user_id", req.BotUserId)
+	encoder.String("web_app_short_name", req.WebAppShortName)
+	encoder.String("start_parameter", req.StartParameter)
+	encoder.name("theme")
+	encodeThemeParameters(encoder, req.Theme)
+	encoder.String("application_name", req.ApplicationName)
+	encoder.Bool("allow_write_access", req.AllowWriteAccess)
+}
+
+func (req *GetWebAppLinkUrlRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns an HTTPS URL of a Web App to open after a link of the type internalLinkTypeWebApp is clicked
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetWebAppLinkUrl(req *GetWebAppLinkUrlRequest) (*HttpUrl, error) {
-	result, err := client.Send(Request{
+	return client.GetWebAppLinkUrlContext(context.Background(), req)
+}
+
+// Returns an HTTPS URL of a Web App to open after a link of the type internalLinkTypeWebApp is clicked
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetWebAppLinkUrlContext(ctx context.Context, req *GetWebAppLinkUrlRequest) (*HttpUrl, error) {
+	err := client.checkUsersOnly("getWebAppLinkUrl")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getWebAppLinkUrl",
 		},
-		Data: map[string]interface{}{
-			"chat_id":            req.ChatId,
-			"bot_user_id":        req.BotUserId,
-			"web_app_short_name": req.WebAppShortName,
-			"start_parameter":    req.StartParameter,
-			"theme":              req.Theme,
-			"application_name":   req.ApplicationName,
-			"allow_write_access": req.AllowWriteAccess,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -5243,18 +7644,35 @@ type GetWebAppUrlRequest struct {
 	ApplicationName string `json:"application_name"`
 }
 
+func (req *GetWebAppUrlRequest) encode(encoder *encoder) {
+	encoder.Int64("bot_user_id", req.BotUserId)
+	encoder.String("url", req.Url)
+	encoder.name("theme")
+	encodeThemeParameters(encoder, req.Theme)
+	encoder.String("application_name", req.ApplicationName)
+}
+
 // Returns an HTTPS URL of a Web App to open after keyboardButtonTypeWebApp or inlineQueryResultsButtonTypeWebApp button is pressed
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetWebAppUrl(req *GetWebAppUrlRequest) (*HttpUrl, error) {
-	result, err := client.Send(Request{
+	return client.GetWebAppUrlContext(context.Background(), req)
+}
+
+// Returns an HTTPS URL of a Web App to open after keyboardButtonTypeWebApp or inlineQueryResultsButtonTypeWebApp button is pressed
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetWebAppUrlContext(ctx context.Context, req *GetWebAppUrlRequest) (*HttpUrl, error) {
+	err := client.checkUsersOnly("getWebAppUrl")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getWebAppUrl",
 		},
-		Data: map[string]interface{}{
-			"bot_user_id":      req.BotUserId,
-			"url":              req.Url,
-			"theme":            req.Theme,
-			"application_name": req.ApplicationName,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -5276,17 +7694,33 @@ type SendWebAppDataRequest struct {
 	Data string `json:"data"`
 }
 
+func (req *SendWebAppDataRequest) encode(encoder *encoder) {
+	encoder.Int64("bot_user_id", req.BotUserId)
+	encoder.String("button_text", req.ButtonText)
+	encoder.String("data", req.Data)
+}
+
 // Sends data received from a keyboardButtonTypeWebApp Web App to a bot
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SendWebAppData(req *SendWebAppDataRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SendWebAppDataContext(context.Background(), req)
+}
+
+// Sends data received from a keyboardButtonTypeWebApp Web App to a bot
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SendWebAppDataContext(ctx context.Context, req *SendWebAppDataRequest) (*Ok, error) {
+	err := client.checkUsersOnly("sendWebAppData")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "sendWebAppData",
 		},
-		Data: map[string]interface{}{
-			"bot_user_id": req.BotUserId,
-			"button_text": req.ButtonText,
-			"data":        req.Data,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -5316,21 +7750,42 @@ type OpenWebAppRequest struct {
 	ReplyToMessageId int64 `json:"reply_to_message_id"`
 }
 
+func (req *OpenWebAppRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("bot_user_id", req.BotUserId)
+	encoder.String("url", req.Url)
+	encoder.name("theme")
+	encodeThemeParameters(encoder, req.Theme)
+	encoder.String("application_name", req.ApplicationName)
+	encoder.Int64("message_thread_id", req.MessageThreadId)
+	encoder.Int64("reply_to_message_id", req.ReplyToMessageId)
+}
+
+func (req *OpenWebAppRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Informs TDLib that a Web App is being opened from attachment menu, a botMenuButton button, an internalLinkTypeAttachmentMenuBot link, or an inlineKeyboardButtonTypeWebApp button. For each bot, a confirmation alert about data sent to the bot must be shown once
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) OpenWebApp(req *OpenWebAppRequest) (*WebAppInfo, error) {
-	result, err := client.Send(Request{
+	return client.OpenWebAppContext(context.Background(), req)
+}
+
+// Informs TDLib that a Web App is being opened from attachment menu, a botMenuButton button, an internalLinkTypeAttachmentMenuBot link, or an inlineKeyboardButtonTypeWebApp button. For each bot, a confirmation alert about data sent to the bot must be shown once
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) OpenWebAppContext(ctx context.Context, req *OpenWebAppRequest) (*WebAppInfo, error) {
+	err := client.checkUsersOnly("openWebApp")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "openWebApp",
 		},
-		Data: map[string]interface{}{
-			"chat_id":             req.ChatId,
-			"bot_user_id":         req.BotUserId,
-			"url":                 req.Url,
-			"theme":               req.Theme,
-			"application_name":    req.ApplicationName,
-			"message_thread_id":   req.MessageThreadId,
-			"reply_to_message_id": req.ReplyToMessageId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -5348,15 +7803,31 @@ type CloseWebAppRequest struct {
 	WebAppLaunchId JsonInt64 `json:"web_app_launch_id"`
 }
 
+func (req *CloseWebAppRequest) encode(encoder *encoder) {
+	encoder.JsonInt64("web_app_launch_id", req.WebAppLaunchId)
+}
+
 // Informs TDLib that a previously opened Web App was closed
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) CloseWebApp(req *CloseWebAppRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.CloseWebAppContext(context.Background(), req)
+}
+
+// Informs TDLib that a previously opened Web App was closed
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) CloseWebAppContext(ctx context.Context, req *CloseWebAppRequest) (*Ok, error) {
+	err := client.checkUsersOnly("closeWebApp")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "closeWebApp",
 		},
-		Data: map[string]interface{}{
-			"web_app_launch_id": req.WebAppLaunchId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -5376,16 +7847,33 @@ type AnswerWebAppQueryRequest struct {
 	Result InputInlineQueryResult `json:"result"`
 }
 
+func (req *AnswerWebAppQueryRequest) encode(encoder *encoder) {
+	encoder.String("web_app_query_id", req.WebAppQueryId)
+	encoder.name("result")
+	encodeInputInlineQueryResult(encoder, req.Result)
+}
+
 // Sets the result of interaction with a Web App and sends corresponding message on behalf of the user to the chat from which the query originated; for bots only
+//
+// Available only to bots, users get FunctionTypeError
 func (client *Client) AnswerWebAppQuery(req *AnswerWebAppQueryRequest) (*SentWebAppMessage, error) {
-	result, err := client.Send(Request{
+	return client.AnswerWebAppQueryContext(context.Background(), req)
+}
+
+// Sets the result of interaction with a Web App and sends corresponding message on behalf of the user to the chat from which the query originated; for bots only
+//
+// Available only to bots, users get FunctionTypeError
+func (client *Client) AnswerWebAppQueryContext(ctx context.Context, req *AnswerWebAppQueryRequest) (*SentWebAppMessage, error) {
+	err := client.checkBotsOnly("answerWebAppQuery")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "answerWebAppQuery",
 		},
-		Data: map[string]interface{}{
-			"web_app_query_id": req.WebAppQueryId,
-			"result":           req.Result,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -5407,17 +7895,38 @@ type GetCallbackQueryAnswerRequest struct {
 	Payload CallbackQueryPayload `json:"payload"`
 }
 
+func (req *GetCallbackQueryAnswerRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_id", req.MessageId)
+	encoder.name("payload")
+	encodeCallbackQueryPayload(encoder, req.Payload)
+}
+
+func (req *GetCallbackQueryAnswerRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Sends a callback query to a bot and returns an answer. Returns an error with code 502 if the bot fails to answer the query before the query timeout expires
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetCallbackQueryAnswer(req *GetCallbackQueryAnswerRequest) (*CallbackQueryAnswer, error) {
-	result, err := client.Send(Request{
+	return client.GetCallbackQueryAnswerContext(context.Background(), req)
+}
+
+// Sends a callback query to a bot and returns an answer. Returns an error with code 502 if the bot fails to answer the query before the query timeout expires
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetCallbackQueryAnswerContext(ctx context.Context, req *GetCallbackQueryAnswerRequest) (*CallbackQueryAnswer, error) {
+	err := client.checkUsersOnly("getCallbackQueryAnswer")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getCallbackQueryAnswer",
 		},
-		Data: map[string]interface{}{
-			"chat_id":    req.ChatId,
-			"message_id": req.MessageId,
-			"payload":    req.Payload,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -5443,19 +7952,35 @@ type AnswerCallbackQueryRequest struct {
 	CacheTime int32 `json:"cache_time"`
 }
 
+func (req *AnswerCallbackQueryRequest) encode(encoder *encoder) {
+	encoder.JsonInt64("callback_query_id", req.CallbackQueryId)
+	encoder.String("text", req.Text)
+	encoder.Bool("show_alert", req.ShowAlert)
+	encoder.String("url", req.Url)
+	encoder.Int32("cache_time", req.CacheTime)
+}
+
 // Sets the result of a callback query; for bots only
+//
+// Available only to bots, users get FunctionTypeError
 func (client *Client) AnswerCallbackQuery(req *AnswerCallbackQueryRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.AnswerCallbackQueryContext(context.Background(), req)
+}
+
+// Sets the result of a callback query; for bots only
+//
+// Available only to bots, users get FunctionTypeError
+func (client *Client) AnswerCallbackQueryContext(ctx context.Context, req *AnswerCallbackQueryRequest) (*Ok, error) {
+	err := client.checkBotsOnly("answerCallbackQuery")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "answerCallbackQuery",
 		},
-		Data: map[string]interface{}{
-			"callback_query_id": req.CallbackQueryId,
-			"text":              req.Text,
-			"show_alert":        req.ShowAlert,
-			"url":               req.Url,
-			"cache_time":        req.CacheTime,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -5477,17 +8002,34 @@ type AnswerShippingQueryRequest struct {
 	ErrorMessage string `json:"error_message"`
 }
 
+func (req *AnswerShippingQueryRequest) encode(encoder *encoder) {
+	encoder.JsonInt64("shipping_query_id", req.ShippingQueryId)
+	encoder.name("shipping_options")
+	encodeListOfShippingOption(encoder, req.ShippingOptions)
+	encoder.String("error_message", req.ErrorMessage)
+}
+
 // Sets the result of a shipping query; for bots only
+//
+// Available only to bots, users get FunctionTypeError
 func (client *Client) AnswerShippingQuery(req *AnswerShippingQueryRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.AnswerShippingQueryContext(context.Background(), req)
+}
+
+// Sets the result of a shipping query; for bots only
+//
+// Available only to bots, users get FunctionTypeError
+func (client *Client) AnswerShippingQueryContext(ctx context.Context, req *AnswerShippingQueryRequest) (*Ok, error) {
+	err := client.checkBotsOnly("answerShippingQuery")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "answerShippingQuery",
 		},
-		Data: map[string]interface{}{
-			"shipping_query_id": req.ShippingQueryId,
-			"shipping_options":  req.ShippingOptions,
-			"error_message":     req.ErrorMessage,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -5507,16 +8049,32 @@ type AnswerPreCheckoutQueryRequest struct {
 	ErrorMessage string `json:"error_message"`
 }
 
+func (req *AnswerPreCheckoutQueryRequest) encode(encoder *encoder) {
+	encoder.JsonInt64("pre_checkout_query_id", req.PreCheckoutQueryId)
+	encoder.String("error_message", req.ErrorMessage)
+}
+
 // Sets the result of a pre-checkout query; for bots only
+//
+// Available only to bots, users get FunctionTypeError
 func (client *Client) AnswerPreCheckoutQuery(req *AnswerPreCheckoutQueryRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.AnswerPreCheckoutQueryContext(context.Background(), req)
+}
+
+// Sets the result of a pre-checkout query; for bots only
+//
+// Available only to bots, users get FunctionTypeError
+func (client *Client) AnswerPreCheckoutQueryContext(ctx context.Context, req *AnswerPreCheckoutQueryRequest) (*Ok, error) {
+	err := client.checkBotsOnly("answerPreCheckoutQuery")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "answerPreCheckoutQuery",
 		},
-		Data: map[string]interface{}{
-			"pre_checkout_query_id": req.PreCheckoutQueryId,
-			"error_message":         req.ErrorMessage,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -5544,20 +8102,40 @@ type SetGameScoreRequest struct {
 	Force bool `json:"force"`
 }
 
+func (req *SetGameScoreRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_id", req.MessageId)
+	encoder.Bool("edit_message", req.EditMessage)
+	encoder.Int64("user_id", req.UserId)
+	encoder.Int32("score", req.Score)
+	encoder.Bool("force", req.Force)
+}
+
+func (req *SetGameScoreRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Updates the game score of the specified user in the game; for bots only
+//
+// Available only to bots, users get FunctionTypeError
 func (client *Client) SetGameScore(req *SetGameScoreRequest) (*Message, error) {
-	result, err := client.Send(Request{
-		meta: meta{
+	return client.SetGameScoreContext(context.Background(), req)
+}
+
+// Updates the game score of the specified user in the game; for bots only
+//
+// Available only to bots, users get FunctionTypeError
+func (client *Client) SetGameScoreContext(ctx context.Context, req *SetGameScoreRequest) (*Message, error) {
+	err := client.checkBotsOnly("setGameScore")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
+		meta: meta{
 			Type: "setGameScore",
 		},
-		Data: map[string]interface{}{
-			"chat_id":      req.ChatId,
-			"message_id":   req.MessageId,
-			"edit_message": req.EditMessage,
-			"user_id":      req.UserId,
-			"score":        req.Score,
-			"force":        req.Force,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -5583,19 +8161,35 @@ type SetInlineGameScoreRequest struct {
 	Force bool `json:"force"`
 }
 
+func (req *SetInlineGameScoreRequest) encode(encoder *encoder) {
+	encoder.String("inline_message_id", req.InlineMessageId)
+	encoder.Bool("edit_message", req.EditMessage)
+	encoder.Int64("user_id", req.UserId)
+	encoder.Int32("score", req.Score)
+	encoder.Bool("force", req.Force)
+}
+
 // Updates the game score of the specified user in a game; for bots only
+//
+// Available only to bots, users get FunctionTypeError
 func (client *Client) SetInlineGameScore(req *SetInlineGameScoreRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetInlineGameScoreContext(context.Background(), req)
+}
+
+// Updates the game score of the specified user in a game; for bots only
+//
+// Available only to bots, users get FunctionTypeError
+func (client *Client) SetInlineGameScoreContext(ctx context.Context, req *SetInlineGameScoreRequest) (*Ok, error) {
+	err := client.checkBotsOnly("setInlineGameScore")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setInlineGameScore",
 		},
-		Data: map[string]interface{}{
-			"inline_message_id": req.InlineMessageId,
-			"edit_message":      req.EditMessage,
-			"user_id":           req.UserId,
-			"score":             req.Score,
-			"force":             req.Force,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -5617,17 +8211,37 @@ type GetGameHighScoresRequest struct {
 	UserId int64 `json:"user_id"`
 }
 
+func (req *GetGameHighScoresRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_id", req.MessageId)
+	encoder.Int64("user_id", req.UserId)
+}
+
+func (req *GetGameHighScoresRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns the high scores for a game and some part of the high score table in the range of the specified user; for bots only
+//
+// Available only to bots, users get FunctionTypeError
 func (client *Client) GetGameHighScores(req *GetGameHighScoresRequest) (*GameHighScores, error) {
-	result, err := client.Send(Request{
+	return client.GetGameHighScoresContext(context.Background(), req)
+}
+
+// Returns the high scores for a game and some part of the high score table in the range of the specified user; for bots only
+//
+// Available only to bots, users get FunctionTypeError
+func (client *Client) GetGameHighScoresContext(ctx context.Context, req *GetGameHighScoresRequest) (*GameHighScores, error) {
+	err := client.checkBotsOnly("getGameHighScores")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getGameHighScores",
 		},
-		Data: map[string]interface{}{
-			"chat_id":    req.ChatId,
-			"message_id": req.MessageId,
-			"user_id":    req.UserId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -5647,16 +8261,32 @@ type GetInlineGameHighScoresRequest struct {
 	UserId int64 `json:"user_id"`
 }
 
+func (req *GetInlineGameHighScoresRequest) encode(encoder *encoder) {
+	encoder.String("inline_message_id", req.InlineMessageId)
+	encoder.Int64("user_id", req.UserId)
+}
+
 // Returns game high scores and some part of the high score table in the range of the specified user; for bots only
+//
+// Available only to bots, users get FunctionTypeError
 func (client *Client) GetInlineGameHighScores(req *GetInlineGameHighScoresRequest) (*GameHighScores, error) {
-	result, err := client.Send(Request{
+	return client.GetInlineGameHighScoresContext(context.Background(), req)
+}
+
+// Returns game high scores and some part of the high score table in the range of the specified user; for bots only
+//
+// Available only to bots, users get FunctionTypeError
+func (client *Client) GetInlineGameHighScoresContext(ctx context.Context, req *GetInlineGameHighScoresRequest) (*GameHighScores, error) {
+	err := client.checkBotsOnly("getInlineGameHighScores")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getInlineGameHighScores",
 		},
-		Data: map[string]interface{}{
-			"inline_message_id": req.InlineMessageId,
-			"user_id":           req.UserId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -5676,16 +8306,36 @@ type DeleteChatReplyMarkupRequest struct {
 	MessageId int64 `json:"message_id"`
 }
 
+func (req *DeleteChatReplyMarkupRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_id", req.MessageId)
+}
+
+func (req *DeleteChatReplyMarkupRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Deletes the default reply markup from a chat. Must be called after a one-time keyboard or a replyMarkupForceReply reply markup has been used. An updateChatReplyMarkup update will be sent if the reply markup is changed
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) DeleteChatReplyMarkup(req *DeleteChatReplyMarkupRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.DeleteChatReplyMarkupContext(context.Background(), req)
+}
+
+// Deletes the default reply markup from a chat. Must be called after a one-time keyboard or a replyMarkupForceReply reply markup has been used. An updateChatReplyMarkup update will be sent if the reply markup is changed
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) DeleteChatReplyMarkupContext(ctx context.Context, req *DeleteChatReplyMarkupRequest) (*Ok, error) {
+	err := client.checkUsersOnly("deleteChatReplyMarkup")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "deleteChatReplyMarkup",
 		},
-		Data: map[string]interface{}{
-			"chat_id":    req.ChatId,
-			"message_id": req.MessageId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -5707,17 +8357,29 @@ type SendChatActionRequest struct {
 	Action ChatAction `json:"action"`
 }
 
+func (req *SendChatActionRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_thread_id", req.MessageThreadId)
+	encoder.name("action")
+	encodeChatAction(encoder, req.Action)
+}
+
+func (req *SendChatActionRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Sends a notification about user activity in a chat
 func (client *Client) SendChatAction(req *SendChatActionRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SendChatActionContext(context.Background(), req)
+}
+
+// Sends a notification about user activity in a chat
+func (client *Client) SendChatActionContext(ctx context.Context, req *SendChatActionRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "sendChatAction",
 		},
-		Data: map[string]interface{}{
-			"chat_id":           req.ChatId,
-			"message_thread_id": req.MessageThreadId,
-			"action":            req.Action,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -5735,15 +8397,35 @@ type OpenChatRequest struct {
 	ChatId int64 `json:"chat_id"`
 }
 
+func (req *OpenChatRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+}
+
+func (req *OpenChatRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Informs TDLib that the chat is opened by the user. Many useful activities depend on the chat being opened or closed (e.g., in supergroups and channels all updates are received only for opened chats)
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) OpenChat(req *OpenChatRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.OpenChatContext(context.Background(), req)
+}
+
+// Informs TDLib that the chat is opened by the user. Many useful activities depend on the chat being opened or closed (e.g., in supergroups and channels all updates are received only for opened chats)
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) OpenChatContext(ctx context.Context, req *OpenChatRequest) (*Ok, error) {
+	err := client.checkUsersOnly("openChat")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "openChat",
 		},
-		Data: map[string]interface{}{
-			"chat_id": req.ChatId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -5761,15 +8443,35 @@ type CloseChatRequest struct {
 	ChatId int64 `json:"chat_id"`
 }
 
+func (req *CloseChatRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+}
+
+func (req *CloseChatRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Informs TDLib that the chat is closed by the user. Many useful activities depend on the chat being opened or closed
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) CloseChat(req *CloseChatRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.CloseChatContext(context.Background(), req)
+}
+
+// Informs TDLib that the chat is closed by the user. Many useful activities depend on the chat being opened or closed
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) CloseChatContext(ctx context.Context, req *CloseChatRequest) (*Ok, error) {
+	err := client.checkUsersOnly("closeChat")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "closeChat",
 		},
-		Data: map[string]interface{}{
-			"chat_id": req.ChatId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -5793,18 +8495,39 @@ type ViewMessagesRequest struct {
 	ForceRead bool `json:"force_read"`
 }
 
+func (req *ViewMessagesRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64s("message_ids", req.MessageIds)
+	encoder.name("source")
+	encodeMessageSource(encoder, req.Source)
+	encoder.Bool("force_read", req.ForceRead)
+}
+
+func (req *ViewMessagesRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Informs TDLib that messages are being viewed by the user. Sponsored messages must be marked as viewed only when the entire text of the message is shown on the screen (excluding the button). Many useful activities depend on whether the messages are currently being viewed or not (e.g., marking messages as read, incrementing a view counter, updating a view counter, removing deleted messages in supergroups and channels)
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ViewMessages(req *ViewMessagesRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ViewMessagesContext(context.Background(), req)
+}
+
+// Informs TDLib that messages are being viewed by the user. Sponsored messages must be marked as viewed only when the entire text of the message is shown on the screen (excluding the button). Many useful activities depend on whether the messages are currently being viewed or not (e.g., marking messages as read, incrementing a view counter, updating a view counter, removing deleted messages in supergroups and channels)
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ViewMessagesContext(ctx context.Context, req *ViewMessagesRequest) (*Ok, error) {
+	err := client.checkUsersOnly("viewMessages")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "viewMessages",
 		},
-		Data: map[string]interface{}{
-			"chat_id":     req.ChatId,
-			"message_ids": req.MessageIds,
-			"source":      req.Source,
-			"force_read":  req.ForceRead,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -5824,16 +8547,36 @@ type OpenMessageContentRequest struct {
 	MessageId int64 `json:"message_id"`
 }
 
+func (req *OpenMessageContentRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_id", req.MessageId)
+}
+
+func (req *OpenMessageContentRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Informs TDLib that the message content has been opened (e.g., the user has opened a photo, video, document, location or venue, or has listened to an audio file or voice note message). An updateMessageContentOpened update will be generated if something has changed
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) OpenMessageContent(req *OpenMessageContentRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.OpenMessageContentContext(context.Background(), req)
+}
+
+// Informs TDLib that the message content has been opened (e.g., the user has opened a photo, video, document, location or venue, or has listened to an audio file or voice note message). An updateMessageContentOpened update will be generated if something has changed
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) OpenMessageContentContext(ctx context.Context, req *OpenMessageContentRequest) (*Ok, error) {
+	err := client.checkUsersOnly("openMessageContent")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "openMessageContent",
 		},
-		Data: map[string]interface{}{
-			"chat_id":    req.ChatId,
-			"message_id": req.MessageId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -5853,16 +8596,36 @@ type ClickAnimatedEmojiMessageRequest struct {
 	MessageId int64 `json:"message_id"`
 }
 
+func (req *ClickAnimatedEmojiMessageRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_id", req.MessageId)
+}
+
+func (req *ClickAnimatedEmojiMessageRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Informs TDLib that a message with an animated emoji was clicked by the user. Returns a big animated sticker to be played or a 404 error if usual animation needs to be played
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ClickAnimatedEmojiMessage(req *ClickAnimatedEmojiMessageRequest) (*Sticker, error) {
-	result, err := client.Send(Request{
+	return client.ClickAnimatedEmojiMessageContext(context.Background(), req)
+}
+
+// Informs TDLib that a message with an animated emoji was clicked by the user. Returns a big animated sticker to be played or a 404 error if usual animation needs to be played
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ClickAnimatedEmojiMessageContext(ctx context.Context, req *ClickAnimatedEmojiMessageRequest) (*Sticker, error) {
+	err := client.checkUsersOnly("clickAnimatedEmojiMessage")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "clickAnimatedEmojiMessage",
 		},
-		Data: map[string]interface{}{
-			"chat_id":    req.ChatId,
-			"message_id": req.MessageId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -5882,16 +8645,24 @@ type GetInternalLinkRequest struct {
 	IsHttp bool `json:"is_http"`
 }
 
+func (req *GetInternalLinkRequest) encode(encoder *encoder) {
+	encoder.name("type")
+	encodeInternalLinkType(encoder, req.Type)
+	encoder.Bool("is_http", req.IsHttp)
+}
+
 // Returns an HTTPS or a tg: link with the given type. Can be called before authorization
 func (client *Client) GetInternalLink(req *GetInternalLinkRequest) (*HttpUrl, error) {
-	result, err := client.Send(Request{
+	return client.GetInternalLinkContext(context.Background(), req)
+}
+
+// Returns an HTTPS or a tg: link with the given type. Can be called before authorization
+func (client *Client) GetInternalLinkContext(ctx context.Context, req *GetInternalLinkRequest) (*HttpUrl, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getInternalLink",
 		},
-		Data: map[string]interface{}{
-			"type":    req.Type,
-			"is_http": req.IsHttp,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -5909,15 +8680,22 @@ type GetInternalLinkTypeRequest struct {
 	Link string `json:"link"`
 }
 
+func (req *GetInternalLinkTypeRequest) encode(encoder *encoder) {
+	encoder.String("link", req.Link)
+}
+
 // Returns information about the type of an internal link. Returns a 404 error if the link is not internal. Can be called before authorization
 func (client *Client) GetInternalLinkType(req *GetInternalLinkTypeRequest) (InternalLinkType, error) {
-	result, err := client.Send(Request{
+	return client.GetInternalLinkTypeContext(context.Background(), req)
+}
+
+// Returns information about the type of an internal link. Returns a 404 error if the link is not internal. Can be called before authorization
+func (client *Client) GetInternalLinkTypeContext(ctx context.Context, req *GetInternalLinkTypeRequest) (InternalLinkType, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getInternalLinkType",
 		},
-		Data: map[string]interface{}{
-			"link": req.Link,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -5927,124 +8705,7 @@ func (client *Client) GetInternalLinkType(req *GetInternalLinkTypeRequest) (Inte
 		return nil, buildResponseError(result.Data)
 	}
 
-	switch result.Type {
-	case TypeInternalLinkTypeActiveSessions:
-		return UnmarshalInternalLinkTypeActiveSessions(result.Data)
-
-	case TypeInternalLinkTypeAttachmentMenuBot:
-		return UnmarshalInternalLinkTypeAttachmentMenuBot(result.Data)
-
-	case TypeInternalLinkTypeAuthenticationCode:
-		return UnmarshalInternalLinkTypeAuthenticationCode(result.Data)
-
-	case TypeInternalLinkTypeBackground:
-		return UnmarshalInternalLinkTypeBackground(result.Data)
-
-	case TypeInternalLinkTypeBotAddToChannel:
-		return UnmarshalInternalLinkTypeBotAddToChannel(result.Data)
-
-	case TypeInternalLinkTypeBotStart:
-		return UnmarshalInternalLinkTypeBotStart(result.Data)
-
-	case TypeInternalLinkTypeBotStartInGroup:
-		return UnmarshalInternalLinkTypeBotStartInGroup(result.Data)
-
-	case TypeInternalLinkTypeChangePhoneNumber:
-		return UnmarshalInternalLinkTypeChangePhoneNumber(result.Data)
-
-	case TypeInternalLinkTypeChatFolderInvite:
-		return UnmarshalInternalLinkTypeChatFolderInvite(result.Data)
-
-	case TypeInternalLinkTypeChatFolderSettings:
-		return UnmarshalInternalLinkTypeChatFolderSettings(result.Data)
-
-	case TypeInternalLinkTypeChatInvite:
-		return UnmarshalInternalLinkTypeChatInvite(result.Data)
-
-	case TypeInternalLinkTypeDefaultMessageAutoDeleteTimerSettings:
-		return UnmarshalInternalLinkTypeDefaultMessageAutoDeleteTimerSettings(result.Data)
-
-	case TypeInternalLinkTypeEditProfileSettings:
-		return UnmarshalInternalLinkTypeEditProfileSettings(result.Data)
-
-	case TypeInternalLinkTypeGame:
-		return UnmarshalInternalLinkTypeGame(result.Data)
-
-	case TypeInternalLinkTypeInstantView:
-		return UnmarshalInternalLinkTypeInstantView(result.Data)
-
-	case TypeInternalLinkTypeInvoice:
-		return UnmarshalInternalLinkTypeInvoice(result.Data)
-
-	case TypeInternalLinkTypeLanguagePack:
-		return UnmarshalInternalLinkTypeLanguagePack(result.Data)
-
-	case TypeInternalLinkTypeLanguageSettings:
-		return UnmarshalInternalLinkTypeLanguageSettings(result.Data)
-
-	case TypeInternalLinkTypeMessage:
-		return UnmarshalInternalLinkTypeMessage(result.Data)
-
-	case TypeInternalLinkTypeMessageDraft:
-		return UnmarshalInternalLinkTypeMessageDraft(result.Data)
-
-	case TypeInternalLinkTypePassportDataRequest:
-		return UnmarshalInternalLinkTypePassportDataRequest(result.Data)
-
-	case TypeInternalLinkTypePhoneNumberConfirmation:
-		return UnmarshalInternalLinkTypePhoneNumberConfirmation(result.Data)
-
-	case TypeInternalLinkTypePremiumFeatures:
-		return UnmarshalInternalLinkTypePremiumFeatures(result.Data)
-
-	case TypeInternalLinkTypePrivacyAndSecuritySettings:
-		return UnmarshalInternalLinkTypePrivacyAndSecuritySettings(result.Data)
-
-	case TypeInternalLinkTypeProxy:
-		return UnmarshalInternalLinkTypeProxy(result.Data)
-
-	case TypeInternalLinkTypePublicChat:
-		return UnmarshalInternalLinkTypePublicChat(result.Data)
-
-	case TypeInternalLinkTypeQrCodeAuthentication:
-		return UnmarshalInternalLinkTypeQrCodeAuthentication(result.Data)
-
-	case TypeInternalLinkTypeRestorePurchases:
-		return UnmarshalInternalLinkTypeRestorePurchases(result.Data)
-
-	case TypeInternalLinkTypeSettings:
-		return UnmarshalInternalLinkTypeSettings(result.Data)
-
-	case TypeInternalLinkTypeStickerSet:
-		return UnmarshalInternalLinkTypeStickerSet(result.Data)
-
-	case TypeInternalLinkTypeTheme:
-		return UnmarshalInternalLinkTypeTheme(result.Data)
-
-	case TypeInternalLinkTypeThemeSettings:
-		return UnmarshalInternalLinkTypeThemeSettings(result.Data)
-
-	case TypeInternalLinkTypeUnknownDeepLink:
-		return UnmarshalInternalLinkTypeUnknownDeepLink(result.Data)
-
-	case TypeInternalLinkTypeUnsupportedProxy:
-		return UnmarshalInternalLinkTypeUnsupportedProxy(result.Data)
-
-	case TypeInternalLinkTypeUserPhoneNumber:
-		return UnmarshalInternalLinkTypeUserPhoneNumber(result.Data)
-
-	case TypeInternalLinkTypeUserToken:
-		return UnmarshalInternalLinkTypeUserToken(result.Data)
-
-	case TypeInternalLinkTypeVideoChat:
-		return UnmarshalInternalLinkTypeVideoChat(result.Data)
-
-	case TypeInternalLinkTypeWebApp:
-		return UnmarshalInternalLinkTypeWebApp(result.Data)
-
-	default:
-		return nil, errors.New("invalid type")
-	}
+	return UnmarshalInternalLinkType(result.Data)
 }
 
 type GetExternalLinkInfoRequest struct {
@@ -6052,15 +8713,31 @@ type GetExternalLinkInfoRequest struct {
 	Link string `json:"link"`
 }
 
+func (req *GetExternalLinkInfoRequest) encode(encoder *encoder) {
+	encoder.String("link", req.Link)
+}
+
 // Returns information about an action to be done when the current user clicks an external link. Don't use this method for links from secret chats if web page preview is disabled in secret chats
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetExternalLinkInfo(req *GetExternalLinkInfoRequest) (LoginUrlInfo, error) {
-	result, err := client.Send(Request{
+	return client.GetExternalLinkInfoContext(context.Background(), req)
+}
+
+// Returns information about an action to be done when the current user clicks an external link. Don't use this method for links from secret chats if web page preview is disabled in secret chats
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetExternalLinkInfoContext(ctx context.Context, req *GetExternalLinkInfoRequest) (LoginUrlInfo, error) {
+	err := client.checkUsersOnly("getExternalLinkInfo")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getExternalLinkInfo",
 		},
-		Data: map[string]interface{}{
-			"link": req.Link,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -6070,16 +8747,7 @@ func (client *Client) GetExternalLinkInfo(req *GetExternalLinkInfoRequest) (Logi
 		return nil, buildResponseError(result.Data)
 	}
 
-	switch result.Type {
-	case TypeLoginUrlInfoOpen:
-		return UnmarshalLoginUrlInfoOpen(result.Data)
-
-	case TypeLoginUrlInfoRequestConfirmation:
-		return UnmarshalLoginUrlInfoRequestConfirmation(result.Data)
-
-	default:
-		return nil, errors.New("invalid type")
-	}
+	return UnmarshalLoginUrlInfo(result.Data)
 }
 
 type GetExternalLinkRequest struct {
@@ -6089,16 +8757,32 @@ type GetExternalLinkRequest struct {
 	AllowWriteAccess bool `json:"allow_write_access"`
 }
 
+func (req *GetExternalLinkRequest) encode(encoder *encoder) {
+	encoder.String("link", req.Link)
+	encoder.Bool("allow_write_access", req.AllowWriteAccess)
+}
+
 // Returns an HTTP URL which can be used to automatically authorize the current user on a website after clicking an HTTP link. Use the method getExternalLinkInfo to find whether a prior user confirmation is needed
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetExternalLink(req *GetExternalLinkRequest) (*HttpUrl, error) {
-	result, err := client.Send(Request{
+	return client.GetExternalLinkContext(context.Background(), req)
+}
+
+// Returns an HTTP URL which can be used to automatically authorize the current user on a website after clicking an HTTP link. Use the method getExternalLinkInfo to find whether a prior user confirmation is needed
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetExternalLinkContext(ctx context.Context, req *GetExternalLinkRequest) (*HttpUrl, error) {
+	err := client.checkUsersOnly("getExternalLink")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getExternalLink",
 		},
-		Data: map[string]interface{}{
-			"link":               req.Link,
-			"allow_write_access": req.AllowWriteAccess,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -6116,15 +8800,35 @@ type ReadAllChatMentionsRequest struct {
 	ChatId int64 `json:"chat_id"`
 }
 
+func (req *ReadAllChatMentionsRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+}
+
+func (req *ReadAllChatMentionsRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Marks all mentions in a chat as read
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ReadAllChatMentions(req *ReadAllChatMentionsRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ReadAllChatMentionsContext(context.Background(), req)
+}
+
+// Marks all mentions in a chat as read
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ReadAllChatMentionsContext(ctx context.Context, req *ReadAllChatMentionsRequest) (*Ok, error) {
+	err := client.checkUsersOnly("readAllChatMentions")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "readAllChatMentions",
 		},
-		Data: map[string]interface{}{
-			"chat_id": req.ChatId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -6144,16 +8848,36 @@ type ReadAllMessageThreadMentionsRequest struct {
 	MessageThreadId int64 `json:"message_thread_id"`
 }
 
+func (req *ReadAllMessageThreadMentionsRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_thread_id", req.MessageThreadId)
+}
+
+func (req *ReadAllMessageThreadMentionsRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Marks all mentions in a forum topic as read
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ReadAllMessageThreadMentions(req *ReadAllMessageThreadMentionsRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ReadAllMessageThreadMentionsContext(context.Background(), req)
+}
+
+// Marks all mentions in a forum topic as read
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ReadAllMessageThreadMentionsContext(ctx context.Context, req *ReadAllMessageThreadMentionsRequest) (*Ok, error) {
+	err := client.checkUsersOnly("readAllMessageThreadMentions")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "readAllMessageThreadMentions",
 		},
-		Data: map[string]interface{}{
-			"chat_id":           req.ChatId,
-			"message_thread_id": req.MessageThreadId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -6171,15 +8895,35 @@ type ReadAllChatReactionsRequest struct {
 	ChatId int64 `json:"chat_id"`
 }
 
+func (req *ReadAllChatReactionsRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+}
+
+func (req *ReadAllChatReactionsRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Marks all reactions in a chat or a forum topic as read
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ReadAllChatReactions(req *ReadAllChatReactionsRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ReadAllChatReactionsContext(context.Background(), req)
+}
+
+// Marks all reactions in a chat or a forum topic as read
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ReadAllChatReactionsContext(ctx context.Context, req *ReadAllChatReactionsRequest) (*Ok, error) {
+	err := client.checkUsersOnly("readAllChatReactions")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "readAllChatReactions",
 		},
-		Data: map[string]interface{}{
-			"chat_id": req.ChatId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -6199,16 +8943,36 @@ type ReadAllMessageThreadReactionsRequest struct {
 	MessageThreadId int64 `json:"message_thread_id"`
 }
 
+func (req *ReadAllMessageThreadReactionsRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_thread_id", req.MessageThreadId)
+}
+
+func (req *ReadAllMessageThreadReactionsRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Marks all reactions in a forum topic as read
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ReadAllMessageThreadReactions(req *ReadAllMessageThreadReactionsRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ReadAllMessageThreadReactionsContext(context.Background(), req)
+}
+
+// Marks all reactions in a forum topic as read
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ReadAllMessageThreadReactionsContext(ctx context.Context, req *ReadAllMessageThreadReactionsRequest) (*Ok, error) {
+	err := client.checkUsersOnly("readAllMessageThreadReactions")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "readAllMessageThreadReactions",
 		},
-		Data: map[string]interface{}{
-			"chat_id":           req.ChatId,
-			"message_thread_id": req.MessageThreadId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -6228,16 +8992,23 @@ type CreatePrivateChatRequest struct {
 	Force bool `json:"force"`
 }
 
+func (req *CreatePrivateChatRequest) encode(encoder *encoder) {
+	encoder.Int64("user_id", req.UserId)
+	encoder.Bool("force", req.Force)
+}
+
 // Returns an existing chat corresponding to a given user
 func (client *Client) CreatePrivateChat(req *CreatePrivateChatRequest) (*Chat, error) {
-	result, err := client.Send(Request{
+	return client.CreatePrivateChatContext(context.Background(), req)
+}
+
+// Returns an existing chat corresponding to a given user
+func (client *Client) CreatePrivateChatContext(ctx context.Context, req *CreatePrivateChatRequest) (*Chat, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "createPrivateChat",
 		},
-		Data: map[string]interface{}{
-			"user_id": req.UserId,
-			"force":   req.Force,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -6257,16 +9028,23 @@ type CreateBasicGroupChatRequest struct {
 	Force bool `json:"force"`
 }
 
+func (req *CreateBasicGroupChatRequest) encode(encoder *encoder) {
+	encoder.Int64("basic_group_id", req.BasicGroupId)
+	encoder.Bool("force", req.Force)
+}
+
 // Returns an existing chat corresponding to a known basic group
 func (client *Client) CreateBasicGroupChat(req *CreateBasicGroupChatRequest) (*Chat, error) {
-	result, err := client.Send(Request{
+	return client.CreateBasicGroupChatContext(context.Background(), req)
+}
+
+// Returns an existing chat corresponding to a known basic group
+func (client *Client) CreateBasicGroupChatContext(ctx context.Context, req *CreateBasicGroupChatRequest) (*Chat, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "createBasicGroupChat",
 		},
-		Data: map[string]interface{}{
-			"basic_group_id": req.BasicGroupId,
-			"force":          req.Force,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -6286,16 +9064,23 @@ type CreateSupergroupChatRequest struct {
 	Force bool `json:"force"`
 }
 
-// Returns an existing chat corresponding to a known supergroup or channel
+func (req *CreateSupergroupChatRequest) encode(encoder *encoder) {
+	encoder.Int64("supergroup_id", req.SupergroupId)
+	encoder.Bool("force", req.Force)
+}
+
+// Returns an existing chat corresponding to a known supergroup or channel
 func (client *Client) CreateSupergroupChat(req *CreateSupergroupChatRequest) (*Chat, error) {
-	result, err := client.Send(Request{
+	return client.CreateSupergroupChatContext(context.Background(), req)
+}
+
+// Returns an existing chat corresponding to a known supergroup or channel
+func (client *Client) CreateSupergroupChatContext(ctx context.Context, req *CreateSupergroupChatRequest) (*Chat, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "createSupergroupChat",
 		},
-		Data: map[string]interface{}{
-			"supergroup_id": req.SupergroupId,
-			"force":         req.Force,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -6313,15 +9098,22 @@ type CreateSecretChatRequest struct {
 	SecretChatId int32 `json:"secret_chat_id"`
 }
 
+func (req *CreateSecretChatRequest) encode(encoder *encoder) {
+	encoder.Int32("secret_chat_id", req.SecretChatId)
+}
+
 // Returns an existing chat corresponding to a known secret chat
 func (client *Client) CreateSecretChat(req *CreateSecretChatRequest) (*Chat, error) {
-	result, err := client.Send(Request{
+	return client.CreateSecretChatContext(context.Background(), req)
+}
+
+// Returns an existing chat corresponding to a known secret chat
+func (client *Client) CreateSecretChatContext(ctx context.Context, req *CreateSecretChatRequest) (*Chat, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "createSecretChat",
 		},
-		Data: map[string]interface{}{
-			"secret_chat_id": req.SecretChatId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -6343,17 +9135,33 @@ type CreateNewBasicGroupChatRequest struct {
 	MessageAutoDeleteTime int32 `json:"message_auto_delete_time"`
 }
 
+func (req *CreateNewBasicGroupChatRequest) encode(encoder *encoder) {
+	encoder.Int64s("user_ids", req.UserIds)
+	encoder.String("title", req.Title)
+	encoder.Int32("message_auto_delete_time", req.MessageAutoDeleteTime)
+}
+
 // Creates a new basic group and sends a corresponding messageBasicGroupChatCreate. Returns the newly created chat
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) CreateNewBasicGroupChat(req *CreateNewBasicGroupChatRequest) (*Chat, error) {
-	result, err := client.Send(Request{
+	return client.CreateNewBasicGroupChatContext(context.Background(), req)
+}
+
+// Creates a new basic group and sends a corresponding messageBasicGroupChatCreate. Returns the newly created chat
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) CreateNewBasicGroupChatContext(ctx context.Context, req *CreateNewBasicGroupChatRequest) (*Chat, error) {
+	err := client.checkUsersOnly("createNewBasicGroupChat")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "createNewBasicGroupChat",
 		},
-		Data: map[string]interface{}{
-			"user_ids":                 req.UserIds,
-			"title":                    req.Title,
-			"message_auto_delete_time": req.MessageAutoDeleteTime,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -6383,21 +9191,38 @@ type CreateNewSupergroupChatRequest struct {
 	ForImport bool `json:"for_import"`
 }
 
+func (req *CreateNewSupergroupChatRequest) encode(encoder *encoder) {
+	encoder.String("title", req.Title)
+	encoder.Bool("is_forum", req.IsForum)
+	encoder.Bool("is_channel", req.IsChannel)
+	encoder.String("description", req.Description)
+	encoder.name("location")
+	encodeChatLocation(encoder, req.Location)
+	encoder.Int32("message_auto_delete_time", req.MessageAutoDeleteTime)
+	encoder.Bool("for_import", req.ForImport)
+}
+
 // Creates a new supergroup or channel and sends a corresponding messageSupergroupChatCreate. Returns the newly created chat
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) CreateNewSupergroupChat(req *CreateNewSupergroupChatRequest) (*Chat, error) {
-	result, err := client.Send(Request{
+	return client.CreateNewSupergroupChatContext(context.Background(), req)
+}
+
+// Creates a new supergroup or channel and sends a corresponding messageSupergroupChatCreate. Returns the newly created chat
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) CreateNewSupergroupChatContext(ctx context.Context, req *CreateNewSupergroupChatRequest) (*Chat, error) {
+	err := client.checkUsersOnly("createNewSupergroupChat")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "createNewSupergroupChat",
 		},
-		Data: map[string]interface{}{
-			"title":                    req.Title,
-			"is_forum":                 req.IsForum,
-			"is_channel":               req.IsChannel,
-			"description":              req.Description,
-			"location":                 req.Location,
-			"message_auto_delete_time": req.MessageAutoDeleteTime,
-			"for_import":               req.ForImport,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -6415,15 +9240,22 @@ type CreateNewSecretChatRequest struct {
 	UserId int64 `json:"user_id"`
 }
 
+func (req *CreateNewSecretChatRequest) encode(encoder *encoder) {
+	encoder.Int64("user_id", req.UserId)
+}
+
 // Creates a new secret chat. Returns the newly created chat
 func (client *Client) CreateNewSecretChat(req *CreateNewSecretChatRequest) (*Chat, error) {
-	result, err := client.Send(Request{
+	return client.CreateNewSecretChatContext(context.Background(), req)
+}
+
+// Creates a new secret chat. Returns the newly created chat
+func (client *Client) CreateNewSecretChatContext(ctx context.Context, req *CreateNewSecretChatRequest) (*Chat, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "createNewSecretChat",
 		},
-		Data: map[string]interface{}{
-			"user_id": req.UserId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -6441,15 +9273,35 @@ type UpgradeBasicGroupChatToSupergroupChatRequest struct {
 	ChatId int64 `json:"chat_id"`
 }
 
+func (req *UpgradeBasicGroupChatToSupergroupChatRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+}
+
+func (req *UpgradeBasicGroupChatToSupergroupChatRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Creates a new supergroup from an existing basic group and sends a corresponding messageChatUpgradeTo and messageChatUpgradeFrom; requires creator privileges. Deactivates the original basic group
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) UpgradeBasicGroupChatToSupergroupChat(req *UpgradeBasicGroupChatToSupergroupChatRequest) (*Chat, error) {
-	result, err := client.Send(Request{
+	return client.UpgradeBasicGroupChatToSupergroupChatContext(context.Background(), req)
+}
+
+// Creates a new supergroup from an existing basic group and sends a corresponding messageChatUpgradeTo and messageChatUpgradeFrom; requires creator privileges. Deactivates the original basic group
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) UpgradeBasicGroupChatToSupergroupChatContext(ctx context.Context, req *UpgradeBasicGroupChatToSupergroupChatRequest) (*Chat, error) {
+	err := client.checkUsersOnly("upgradeBasicGroupChatToSupergroupChat")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "upgradeBasicGroupChatToSupergroupChat",
 		},
-		Data: map[string]interface{}{
-			"chat_id": req.ChatId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -6467,15 +9319,35 @@ type GetChatListsToAddChatRequest struct {
 	ChatId int64 `json:"chat_id"`
 }
 
+func (req *GetChatListsToAddChatRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+}
+
+func (req *GetChatListsToAddChatRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns chat lists to which the chat can be added. This is an offline request
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetChatListsToAddChat(req *GetChatListsToAddChatRequest) (*ChatLists, error) {
-	result, err := client.Send(Request{
+	return client.GetChatListsToAddChatContext(context.Background(), req)
+}
+
+// Returns chat lists to which the chat can be added. This is an offline request
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetChatListsToAddChatContext(ctx context.Context, req *GetChatListsToAddChatRequest) (*ChatLists, error) {
+	err := client.checkUsersOnly("getChatListsToAddChat")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getChatListsToAddChat",
 		},
-		Data: map[string]interface{}{
-			"chat_id": req.ChatId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -6495,16 +9367,37 @@ type AddChatToListRequest struct {
 	ChatList ChatList `json:"chat_list"`
 }
 
+func (req *AddChatToListRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.name("chat_list")
+	encodeChatList(encoder, req.ChatList)
+}
+
+func (req *AddChatToListRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Adds a chat to a chat list. A chat can't be simultaneously in Main and Archive chat lists, so it is automatically removed from another one if needed
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) AddChatToList(req *AddChatToListRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.AddChatToListContext(context.Background(), req)
+}
+
+// Adds a chat to a chat list. A chat can't be simultaneously in Main and Archive chat lists, so it is automatically removed from another one if needed
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) AddChatToListContext(ctx context.Context, req *AddChatToListRequest) (*Ok, error) {
+	err := client.checkUsersOnly("addChatToList")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "addChatToList",
 		},
-		Data: map[string]interface{}{
-			"chat_id":   req.ChatId,
-			"chat_list": req.ChatList,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -6522,15 +9415,31 @@ type GetChatFolderRequest struct {
 	ChatFolderId int32 `json:"chat_folder_id"`
 }
 
+func (req *GetChatFolderRequest) encode(encoder *encoder) {
+	encoder.Int32("chat_folder_id", req.ChatFolderId)
+}
+
 // Returns information about a chat folder by its identifier
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetChatFolder(req *GetChatFolderRequest) (*ChatFolder, error) {
-	result, err := client.Send(Request{
+	return client.GetChatFolderContext(context.Background(), req)
+}
+
+// Returns information about a chat folder by its identifier
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetChatFolderContext(ctx context.Context, req *GetChatFolderRequest) (*ChatFolder, error) {
+	err := client.checkUsersOnly("getChatFolder")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getChatFolder",
 		},
-		Data: map[string]interface{}{
-			"chat_folder_id": req.ChatFolderId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -6548,15 +9457,32 @@ type CreateChatFolderRequest struct {
 	Folder *ChatFolder `json:"folder"`
 }
 
+func (req *CreateChatFolderRequest) encode(encoder *encoder) {
+	encoder.name("folder")
+	encodeChatFolder(encoder, req.Folder)
+}
+
 // Creates new chat folder. Returns information about the created chat folder. There can be up to getOption("chat_folder_count_max") chat folders, but the limit can be increased with Telegram Premium
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) CreateChatFolder(req *CreateChatFolderRequest) (*ChatFolderInfo, error) {
-	result, err := client.Send(Request{
+	return client.CreateChatFolderContext(context.Background(), req)
+}
+
+// Creates new chat folder. Returns information about the created chat folder. There can be up to getOption("chat_folder_count_max") chat folders, but the limit can be increased with Telegram Premium
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) CreateChatFolderContext(ctx context.Context, req *CreateChatFolderRequest) (*ChatFolderInfo, error) {
+	err := client.checkUsersOnly("createChatFolder")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "createChatFolder",
 		},
-		Data: map[string]interface{}{
-			"folder": req.Folder,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -6576,16 +9502,33 @@ type EditChatFolderRequest struct {
 	Folder *ChatFolder `json:"folder"`
 }
 
+func (req *EditChatFolderRequest) encode(encoder *encoder) {
+	encoder.Int32("chat_folder_id", req.ChatFolderId)
+	encoder.name("folder")
+	encodeChatFolder(encoder, req.Folder)
+}
+
 // Edits existing chat folder. Returns information about the edited chat folder
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) EditChatFolder(req *EditChatFolderRequest) (*ChatFolderInfo, error) {
-	result, err := client.Send(Request{
+	return client.EditChatFolderContext(context.Background(), req)
+}
+
+// Edits existing chat folder. Returns information about the edited chat folder
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) EditChatFolderContext(ctx context.Context, req *EditChatFolderRequest) (*ChatFolderInfo, error) {
+	err := client.checkUsersOnly("editChatFolder")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "editChatFolder",
 		},
-		Data: map[string]interface{}{
-			"chat_folder_id": req.ChatFolderId,
-			"folder":         req.Folder,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -6605,16 +9548,32 @@ type DeleteChatFolderRequest struct {
 	LeaveChatIds []int64 `json:"leave_chat_ids"`
 }
 
+func (req *DeleteChatFolderRequest) encode(encoder *encoder) {
+	encoder.Int32("chat_folder_id", req.ChatFolderId)
+	encoder.Int64s("leave_chat_ids", req.LeaveChatIds)
+}
+
 // Deletes existing chat folder
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) DeleteChatFolder(req *DeleteChatFolderRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.DeleteChatFolderContext(context.Background(), req)
+}
+
+// Deletes existing chat folder
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) DeleteChatFolderContext(ctx context.Context, req *DeleteChatFolderRequest) (*Ok, error) {
+	err := client.checkUsersOnly("deleteChatFolder")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "deleteChatFolder",
 		},
-		Data: map[string]interface{}{
-			"chat_folder_id": req.ChatFolderId,
-			"leave_chat_ids": req.LeaveChatIds,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -6632,15 +9591,31 @@ type GetChatFolderChatsToLeaveRequest struct {
 	ChatFolderId int32 `json:"chat_folder_id"`
 }
 
+func (req *GetChatFolderChatsToLeaveRequest) encode(encoder *encoder) {
+	encoder.Int32("chat_folder_id", req.ChatFolderId)
+}
+
 // Returns identifiers of pinned or always included chats from a chat folder, which are suggested to be left when the chat folder is deleted
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetChatFolderChatsToLeave(req *GetChatFolderChatsToLeaveRequest) (*Chats, error) {
-	result, err := client.Send(Request{
+	return client.GetChatFolderChatsToLeaveContext(context.Background(), req)
+}
+
+// Returns identifiers of pinned or always included chats from a chat folder, which are suggested to be left when the chat folder is deleted
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetChatFolderChatsToLeaveContext(ctx context.Context, req *GetChatFolderChatsToLeaveRequest) (*Chats, error) {
+	err := client.checkUsersOnly("getChatFolderChatsToLeave")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getChatFolderChatsToLeave",
 		},
-		Data: map[string]interface{}{
-			"chat_folder_id": req.ChatFolderId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -6660,16 +9635,32 @@ type ReorderChatFoldersRequest struct {
 	MainChatListPosition int32 `json:"main_chat_list_position"`
 }
 
+func (req *ReorderChatFoldersRequest) encode(encoder *encoder) {
+	encoder.Int32s("chat_folder_ids", req.ChatFolderIds)
+	encoder.Int32("main_chat_list_position", req.MainChatListPosition)
+}
+
 // Changes the order of chat folders
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ReorderChatFolders(req *ReorderChatFoldersRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ReorderChatFoldersContext(context.Background(), req)
+}
+
+// Changes the order of chat folders
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ReorderChatFoldersContext(ctx context.Context, req *ReorderChatFoldersRequest) (*Ok, error) {
+	err := client.checkUsersOnly("reorderChatFolders")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "reorderChatFolders",
 		},
-		Data: map[string]interface{}{
-			"chat_folder_ids":         req.ChatFolderIds,
-			"main_chat_list_position": req.MainChatListPosition,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -6683,12 +9674,25 @@ func (client *Client) ReorderChatFolders(req *ReorderChatFoldersRequest) (*Ok, e
 }
 
 // Returns recommended chat folders for the current user
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetRecommendedChatFolders() (*RecommendedChatFolders, error) {
-	result, err := client.Send(Request{
+	return client.GetRecommendedChatFoldersContext(context.Background())
+}
+
+// Returns recommended chat folders for the current user
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetRecommendedChatFoldersContext(ctx context.Context) (*RecommendedChatFolders, error) {
+	err := client.checkUsersOnly("getRecommendedChatFolders")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getRecommendedChatFolders",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -6706,15 +9710,18 @@ type GetChatFolderDefaultIconNameRequest struct {
 	Folder *ChatFolder `json:"folder"`
 }
 
+func (req *GetChatFolderDefaultIconNameRequest) encode(encoder *encoder) {
+	encoder.name("folder")
+	encodeChatFolder(encoder, req.Folder)
+}
+
 // Returns default icon name for a folder. Can be called synchronously
 func GetChatFolderDefaultIconName(req *GetChatFolderDefaultIconNameRequest) (*ChatFolderIcon, error) {
 	result, err := Execute(Request{
 		meta: meta{
 			Type: "getChatFolderDefaultIconName",
 		},
-		Data: map[string]interface{}{
-			"folder": req.Folder,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -6730,7 +9737,21 @@ func GetChatFolderDefaultIconName(req *GetChatFolderDefaultIconNameRequest) (*Ch
 // deprecated
 // Returns default icon name for a folder. Can be called synchronously
 func (client *Client) GetChatFolderDefaultIconName(req *GetChatFolderDefaultIconNameRequest) (*ChatFolderIcon, error) {
-	return GetChatFolderDefaultIconName(req)
+	result, err := client.Execute(Request{
+		meta: meta{
+			Type: "getChatFolderDefaultIconName",
+		},
+		Data: req,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Type == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return UnmarshalChatFolderIcon(result.Data)
 }
 
 type GetChatsForChatFolderInviteLinkRequest struct {
@@ -6738,15 +9759,31 @@ type GetChatsForChatFolderInviteLinkRequest struct {
 	ChatFolderId int32 `json:"chat_folder_id"`
 }
 
+func (req *GetChatsForChatFolderInviteLinkRequest) encode(encoder *encoder) {
+	encoder.Int32("chat_folder_id", req.ChatFolderId)
+}
+
 // Returns identifiers of chats from a chat folder, suitable for adding to a chat folder invite link
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetChatsForChatFolderInviteLink(req *GetChatsForChatFolderInviteLinkRequest) (*Chats, error) {
-	result, err := client.Send(Request{
+	return client.GetChatsForChatFolderInviteLinkContext(context.Background(), req)
+}
+
+// Returns identifiers of chats from a chat folder, suitable for adding to a chat folder invite link
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetChatsForChatFolderInviteLinkContext(ctx context.Context, req *GetChatsForChatFolderInviteLinkRequest) (*Chats, error) {
+	err := client.checkUsersOnly("getChatsForChatFolderInviteLink")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getChatsForChatFolderInviteLink",
 		},
-		Data: map[string]interface{}{
-			"chat_folder_id": req.ChatFolderId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -6768,17 +9805,33 @@ type CreateChatFolderInviteLinkRequest struct {
 	ChatIds []int64 `json:"chat_ids"`
 }
 
+func (req *CreateChatFolderInviteLinkRequest) encode(encoder *encoder) {
+	encoder.Int32("chat_folder_id", req.ChatFolderId)
+	encoder.String("name", req.Name)
+	encoder.Int64s("chat_ids", req.ChatIds)
+}
+
 // Creates a new invite link for a chat folder. A link can be created for a chat folder if it has only pinned and included chats
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) CreateChatFolderInviteLink(req *CreateChatFolderInviteLinkRequest) (*ChatFolderInviteLink, error) {
-	result, err := client.Send(Request{
+	return client.CreateChatFolderInviteLinkContext(context.Background(), req)
+}
+
+// Creates a new invite link for a chat folder. A link can be created for a chat folder if it has only pinned and included chats
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) CreateChatFolderInviteLinkContext(ctx context.Context, req *CreateChatFolderInviteLinkRequest) (*ChatFolderInviteLink, error) {
+	err := client.checkUsersOnly("createChatFolderInviteLink")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "createChatFolderInviteLink",
 		},
-		Data: map[string]interface{}{
-			"chat_folder_id": req.ChatFolderId,
-			"name":           req.Name,
-			"chat_ids":       req.ChatIds,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -6796,15 +9849,31 @@ type GetChatFolderInviteLinksRequest struct {
 	ChatFolderId int32 `json:"chat_folder_id"`
 }
 
+func (req *GetChatFolderInviteLinksRequest) encode(encoder *encoder) {
+	encoder.Int32("chat_folder_id", req.ChatFolderId)
+}
+
 // Returns invite links created by the current user for a shareable chat folder
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetChatFolderInviteLinks(req *GetChatFolderInviteLinksRequest) (*ChatFolderInviteLinks, error) {
-	result, err := client.Send(Request{
+	return client.GetChatFolderInviteLinksContext(context.Background(), req)
+}
+
+// Returns invite links created by the current user for a shareable chat folder
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetChatFolderInviteLinksContext(ctx context.Context, req *GetChatFolderInviteLinksRequest) (*ChatFolderInviteLinks, error) {
+	err := client.checkUsersOnly("getChatFolderInviteLinks")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getChatFolderInviteLinks",
 		},
-		Data: map[string]interface{}{
-			"chat_folder_id": req.ChatFolderId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -6828,18 +9897,34 @@ type EditChatFolderInviteLinkRequest struct {
 	ChatIds []int64 `json:"chat_ids"`
 }
 
+func (req *EditChatFolderInviteLinkRequest) encode(encoder *encoder) {
+	encoder.Int32("chat_folder_id", req.ChatFolderId)
+	encoder.String("invite_link", req.InviteLink)
+	encoder.String("name", req.Name)
+	encoder.Int64s("chat_ids", req.ChatIds)
+}
+
 // Edits an invite link for a chat folder
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) EditChatFolderInviteLink(req *EditChatFolderInviteLinkRequest) (*ChatFolderInviteLink, error) {
-	result, err := client.Send(Request{
+	return client.EditChatFolderInviteLinkContext(context.Background(), req)
+}
+
+// Edits an invite link for a chat folder
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) EditChatFolderInviteLinkContext(ctx context.Context, req *EditChatFolderInviteLinkRequest) (*ChatFolderInviteLink, error) {
+	err := client.checkUsersOnly("editChatFolderInviteLink")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "editChatFolderInviteLink",
 		},
-		Data: map[string]interface{}{
-			"chat_folder_id": req.ChatFolderId,
-			"invite_link":    req.InviteLink,
-			"name":           req.Name,
-			"chat_ids":       req.ChatIds,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -6859,16 +9944,32 @@ type DeleteChatFolderInviteLinkRequest struct {
 	InviteLink string `json:"invite_link"`
 }
 
+func (req *DeleteChatFolderInviteLinkRequest) encode(encoder *encoder) {
+	encoder.Int32("chat_folder_id", req.ChatFolderId)
+	encoder.String("invite_link", req.InviteLink)
+}
+
 // Deletes an invite link for a chat folder
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) DeleteChatFolderInviteLink(req *DeleteChatFolderInviteLinkRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.DeleteChatFolderInviteLinkContext(context.Background(), req)
+}
+
+// Deletes an invite link for a chat folder
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) DeleteChatFolderInviteLinkContext(ctx context.Context, req *DeleteChatFolderInviteLinkRequest) (*Ok, error) {
+	err := client.checkUsersOnly("deleteChatFolderInviteLink")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "deleteChatFolderInviteLink",
 		},
-		Data: map[string]interface{}{
-			"chat_folder_id": req.ChatFolderId,
-			"invite_link":    req.InviteLink,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -6886,15 +9987,31 @@ type CheckChatFolderInviteLinkRequest struct {
 	InviteLink string `json:"invite_link"`
 }
 
+func (req *CheckChatFolderInviteLinkRequest) encode(encoder *encoder) {
+	encoder.String("invite_link", req.InviteLink)
+}
+
 // Checks the validity of an invite link for a chat folder and returns information about the corresponding chat folder
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) CheckChatFolderInviteLink(req *CheckChatFolderInviteLinkRequest) (*ChatFolderInviteLinkInfo, error) {
-	result, err := client.Send(Request{
+	return client.CheckChatFolderInviteLinkContext(context.Background(), req)
+}
+
+// Checks the validity of an invite link for a chat folder and returns information about the corresponding chat folder
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) CheckChatFolderInviteLinkContext(ctx context.Context, req *CheckChatFolderInviteLinkRequest) (*ChatFolderInviteLinkInfo, error) {
+	err := client.checkUsersOnly("checkChatFolderInviteLink")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "checkChatFolderInviteLink",
 		},
-		Data: map[string]interface{}{
-			"invite_link": req.InviteLink,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -6914,16 +10031,32 @@ type AddChatFolderByInviteLinkRequest struct {
 	ChatIds []int64 `json:"chat_ids"`
 }
 
+func (req *AddChatFolderByInviteLinkRequest) encode(encoder *encoder) {
+	encoder.String("invite_link", req.InviteLink)
+	encoder.Int64s("chat_ids", req.ChatIds)
+}
+
 // Adds a chat folder by an invite link
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) AddChatFolderByInviteLink(req *AddChatFolderByInviteLinkRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.AddChatFolderByInviteLinkContext(context.Background(), req)
+}
+
+// Adds a chat folder by an invite link
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) AddChatFolderByInviteLinkContext(ctx context.Context, req *AddChatFolderByInviteLinkRequest) (*Ok, error) {
+	err := client.checkUsersOnly("addChatFolderByInviteLink")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "addChatFolderByInviteLink",
 		},
-		Data: map[string]interface{}{
-			"invite_link": req.InviteLink,
-			"chat_ids":    req.ChatIds,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -6941,15 +10074,31 @@ type GetChatFolderNewChatsRequest struct {
 	ChatFolderId int32 `json:"chat_folder_id"`
 }
 
+func (req *GetChatFolderNewChatsRequest) encode(encoder *encoder) {
+	encoder.Int32("chat_folder_id", req.ChatFolderId)
+}
+
 // Returns new chats added to a shareable chat folder by its owner. The method must be called at most once in getOption("chat_folder_new_chats_update_period") for the given chat folder
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetChatFolderNewChats(req *GetChatFolderNewChatsRequest) (*Chats, error) {
-	result, err := client.Send(Request{
+	return client.GetChatFolderNewChatsContext(context.Background(), req)
+}
+
+// Returns new chats added to a shareable chat folder by its owner. The method must be called at most once in getOption("chat_folder_new_chats_update_period") for the given chat folder
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetChatFolderNewChatsContext(ctx context.Context, req *GetChatFolderNewChatsRequest) (*Chats, error) {
+	err := client.checkUsersOnly("getChatFolderNewChats")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getChatFolderNewChats",
 		},
-		Data: map[string]interface{}{
-			"chat_folder_id": req.ChatFolderId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -6969,16 +10118,32 @@ type ProcessChatFolderNewChatsRequest struct {
 	AddedChatIds []int64 `json:"added_chat_ids"`
 }
 
+func (req *ProcessChatFolderNewChatsRequest) encode(encoder *encoder) {
+	encoder.Int32("chat_folder_id", req.ChatFolderId)
+	encoder.Int64s("added_chat_ids", req.AddedChatIds)
+}
+
 // Process new chats added to a shareable chat folder by its owner
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ProcessChatFolderNewChats(req *ProcessChatFolderNewChatsRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ProcessChatFolderNewChatsContext(context.Background(), req)
+}
+
+// Process new chats added to a shareable chat folder by its owner
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ProcessChatFolderNewChatsContext(ctx context.Context, req *ProcessChatFolderNewChatsRequest) (*Ok, error) {
+	err := client.checkUsersOnly("processChatFolderNewChats")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "processChatFolderNewChats",
 		},
-		Data: map[string]interface{}{
-			"chat_folder_id": req.ChatFolderId,
-			"added_chat_ids": req.AddedChatIds,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -6998,16 +10163,27 @@ type SetChatTitleRequest struct {
 	Title string `json:"title"`
 }
 
+func (req *SetChatTitleRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.String("title", req.Title)
+}
+
+func (req *SetChatTitleRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Changes the chat title. Supported only for basic groups, supergroups and channels. Requires can_change_info administrator right
 func (client *Client) SetChatTitle(req *SetChatTitleRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetChatTitleContext(context.Background(), req)
+}
+
+// Changes the chat title. Supported only for basic groups, supergroups and channels. Requires can_change_info administrator right
+func (client *Client) SetChatTitleContext(ctx context.Context, req *SetChatTitleRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setChatTitle",
 		},
-		Data: map[string]interface{}{
-			"chat_id": req.ChatId,
-			"title":   req.Title,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -7027,16 +10203,28 @@ type SetChatPhotoRequest struct {
 	Photo InputChatPhoto `json:"photo"`
 }
 
+func (req *SetChatPhotoRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.name("photo")
+	encodeInputChatPhoto(encoder, req.Photo)
+}
+
+func (req *SetChatPhotoRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Changes the photo of a chat. Supported only for basic groups, supergroups and channels. Requires can_change_info administrator right
 func (client *Client) SetChatPhoto(req *SetChatPhotoRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetChatPhotoContext(context.Background(), req)
+}
+
+// Changes the photo of a chat. Supported only for basic groups, supergroups and channels. Requires can_change_info administrator right
+func (client *Client) SetChatPhotoContext(ctx context.Context, req *SetChatPhotoRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setChatPhoto",
 		},
-		Data: map[string]interface{}{
-			"chat_id": req.ChatId,
-			"photo":   req.Photo,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -7056,16 +10244,27 @@ type SetChatMessageAutoDeleteTimeRequest struct {
 	MessageAutoDeleteTime int32 `json:"message_auto_delete_time"`
 }
 
+func (req *SetChatMessageAutoDeleteTimeRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int32("message_auto_delete_time", req.MessageAutoDeleteTime)
+}
+
+func (req *SetChatMessageAutoDeleteTimeRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Changes the message auto-delete or self-destruct (for secret chats) time in a chat. Requires change_info administrator right in basic groups, supergroups and channels Message auto-delete time can't be changed in a chat with the current user (Saved Messages) and the chat 777000 (Telegram).
 func (client *Client) SetChatMessageAutoDeleteTime(req *SetChatMessageAutoDeleteTimeRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetChatMessageAutoDeleteTimeContext(context.Background(), req)
+}
+
+// Changes the message auto-delete or self-destruct (for secret chats) time in a chat. Requires change_info administrator right in basic groups, supergroups and channels Message auto-delete time can't be changed in a chat with the current user (Saved Messages) and the chat 777000 (Telegram).
+func (client *Client) SetChatMessageAutoDeleteTimeContext(ctx context.Context, req *SetChatMessageAutoDeleteTimeRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setChatMessageAutoDeleteTime",
 		},
-		Data: map[string]interface{}{
-			"chat_id":                  req.ChatId,
-			"message_auto_delete_time": req.MessageAutoDeleteTime,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -7085,16 +10284,28 @@ type SetChatPermissionsRequest struct {
 	Permissions *ChatPermissions `json:"permissions"`
 }
 
+func (req *SetChatPermissionsRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.name("permissions")
+	encodeChatPermissions(encoder, req.Permissions)
+}
+
+func (req *SetChatPermissionsRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Changes the chat members permissions. Supported only for basic groups and supergroups. Requires can_restrict_members administrator right
 func (client *Client) SetChatPermissions(req *SetChatPermissionsRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetChatPermissionsContext(context.Background(), req)
+}
+
+// Changes the chat members permissions. Supported only for basic groups and supergroups. Requires can_restrict_members administrator right
+func (client *Client) SetChatPermissionsContext(ctx context.Context, req *SetChatPermissionsRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setChatPermissions",
 		},
-		Data: map[string]interface{}{
-			"chat_id":     req.ChatId,
-			"permissions": req.Permissions,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -7118,18 +10329,40 @@ type SetChatBackgroundRequest struct {
 	DarkThemeDimming int32 `json:"dark_theme_dimming"`
 }
 
+func (req *SetChatBackgroundRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.name("background")
+	encodeInputBackground(encoder, req.Background)
+	encoder.name("type")
+	encodeBackgroundType(encoder, req.Type)
+	encoder.Int32("dark_theme_dimming", req.DarkThemeDimming)
+}
+
+func (req *SetChatBackgroundRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Changes the background in a specific chat. Supported only in private and secret chats with non-deleted users
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SetChatBackground(req *SetChatBackgroundRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetChatBackgroundContext(context.Background(), req)
+}
+
+// Changes the background in a specific chat. Supported only in private and secret chats with non-deleted users
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SetChatBackgroundContext(ctx context.Context, req *SetChatBackgroundRequest) (*Ok, error) {
+	err := client.checkUsersOnly("setChatBackground")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setChatBackground",
 		},
-		Data: map[string]interface{}{
-			"chat_id":            req.ChatId,
-			"background":         req.Background,
-			"type":               req.Type,
-			"dark_theme_dimming": req.DarkThemeDimming,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -7149,16 +10382,36 @@ type SetChatThemeRequest struct {
 	ThemeName string `json:"theme_name"`
 }
 
+func (req *SetChatThemeRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.String("theme_name", req.ThemeName)
+}
+
+func (req *SetChatThemeRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Changes the chat theme. Supported only in private and secret chats
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SetChatTheme(req *SetChatThemeRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetChatThemeContext(context.Background(), req)
+}
+
+// Changes the chat theme. Supported only in private and secret chats
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SetChatThemeContext(ctx context.Context, req *SetChatThemeRequest) (*Ok, error) {
+	err := client.checkUsersOnly("setChatTheme")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setChatTheme",
 		},
-		Data: map[string]interface{}{
-			"chat_id":    req.ChatId,
-			"theme_name": req.ThemeName,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -7180,17 +10433,38 @@ type SetChatDraftMessageRequest struct {
 	DraftMessage *DraftMessage `json:"draft_message"`
 }
 
+func (req *SetChatDraftMessageRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_thread_id", req.MessageThreadId)
+	encoder.name("draft_message")
+	encodeDraftMessage(encoder, req.DraftMessage)
+}
+
+func (req *SetChatDraftMessageRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Changes the draft message in a chat
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SetChatDraftMessage(req *SetChatDraftMessageRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetChatDraftMessageContext(context.Background(), req)
+}
+
+// Changes the draft message in a chat
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SetChatDraftMessageContext(ctx context.Context, req *SetChatDraftMessageRequest) (*Ok, error) {
+	err := client.checkUsersOnly("setChatDraftMessage")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setChatDraftMessage",
 		},
-		Data: map[string]interface{}{
-			"chat_id":           req.ChatId,
-			"message_thread_id": req.MessageThreadId,
-			"draft_message":     req.DraftMessage,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -7210,16 +10484,37 @@ type SetChatNotificationSettingsRequest struct {
 	NotificationSettings *ChatNotificationSettings `json:"notification_settings"`
 }
 
+func (req *SetChatNotificationSettingsRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.name("notification_settings")
+	encodeChatNotificationSettings(encoder, req.NotificationSettings)
+}
+
+func (req *SetChatNotificationSettingsRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Changes the notification settings of a chat. Notification settings of a chat with the current user (Saved Messages) can't be changed
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SetChatNotificationSettings(req *SetChatNotificationSettingsRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetChatNotificationSettingsContext(context.Background(), req)
+}
+
+// Changes the notification settings of a chat. Notification settings of a chat with the current user (Saved Messages) can't be changed
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SetChatNotificationSettingsContext(ctx context.Context, req *SetChatNotificationSettingsRequest) (*Ok, error) {
+	err := client.checkUsersOnly("setChatNotificationSettings")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setChatNotificationSettings",
 		},
-		Data: map[string]interface{}{
-			"chat_id":               req.ChatId,
-			"notification_settings": req.NotificationSettings,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -7239,16 +10534,36 @@ type ToggleChatHasProtectedContentRequest struct {
 	HasProtectedContent bool `json:"has_protected_content"`
 }
 
+func (req *ToggleChatHasProtectedContentRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Bool("has_protected_content", req.HasProtectedContent)
+}
+
+func (req *ToggleChatHasProtectedContentRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Changes the ability of users to save, forward, or copy chat content. Supported only for basic groups, supergroups and channels. Requires owner privileges
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ToggleChatHasProtectedContent(req *ToggleChatHasProtectedContentRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ToggleChatHasProtectedContentContext(context.Background(), req)
+}
+
+// Changes the ability of users to save, forward, or copy chat content. Supported only for basic groups, supergroups and channels. Requires owner privileges
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ToggleChatHasProtectedContentContext(ctx context.Context, req *ToggleChatHasProtectedContentRequest) (*Ok, error) {
+	err := client.checkUsersOnly("toggleChatHasProtectedContent")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "toggleChatHasProtectedContent",
 		},
-		Data: map[string]interface{}{
-			"chat_id":               req.ChatId,
-			"has_protected_content": req.HasProtectedContent,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -7268,16 +10583,36 @@ type ToggleChatIsTranslatableRequest struct {
 	IsTranslatable bool `json:"is_translatable"`
 }
 
+func (req *ToggleChatIsTranslatableRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Bool("is_translatable", req.IsTranslatable)
+}
+
+func (req *ToggleChatIsTranslatableRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Changes the translatable state of a chat; for Telegram Premium users only
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ToggleChatIsTranslatable(req *ToggleChatIsTranslatableRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ToggleChatIsTranslatableContext(context.Background(), req)
+}
+
+// Changes the translatable state of a chat; for Telegram Premium users only
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ToggleChatIsTranslatableContext(ctx context.Context, req *ToggleChatIsTranslatableRequest) (*Ok, error) {
+	err := client.checkUsersOnly("toggleChatIsTranslatable")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "toggleChatIsTranslatable",
 		},
-		Data: map[string]interface{}{
-			"chat_id":         req.ChatId,
-			"is_translatable": req.IsTranslatable,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -7297,16 +10632,36 @@ type ToggleChatIsMarkedAsUnreadRequest struct {
 	IsMarkedAsUnread bool `json:"is_marked_as_unread"`
 }
 
+func (req *ToggleChatIsMarkedAsUnreadRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Bool("is_marked_as_unread", req.IsMarkedAsUnread)
+}
+
+func (req *ToggleChatIsMarkedAsUnreadRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Changes the marked as unread state of a chat
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ToggleChatIsMarkedAsUnread(req *ToggleChatIsMarkedAsUnreadRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ToggleChatIsMarkedAsUnreadContext(context.Background(), req)
+}
+
+// Changes the marked as unread state of a chat
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ToggleChatIsMarkedAsUnreadContext(ctx context.Context, req *ToggleChatIsMarkedAsUnreadRequest) (*Ok, error) {
+	err := client.checkUsersOnly("toggleChatIsMarkedAsUnread")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "toggleChatIsMarkedAsUnread",
 		},
-		Data: map[string]interface{}{
-			"chat_id":             req.ChatId,
-			"is_marked_as_unread": req.IsMarkedAsUnread,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -7326,16 +10681,36 @@ type ToggleChatDefaultDisableNotificationRequest struct {
 	DefaultDisableNotification bool `json:"default_disable_notification"`
 }
 
+func (req *ToggleChatDefaultDisableNotificationRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Bool("default_disable_notification", req.DefaultDisableNotification)
+}
+
+func (req *ToggleChatDefaultDisableNotificationRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Changes the value of the default disable_notification parameter, used when a message is sent to a chat
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ToggleChatDefaultDisableNotification(req *ToggleChatDefaultDisableNotificationRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ToggleChatDefaultDisableNotificationContext(context.Background(), req)
+}
+
+// Changes the value of the default disable_notification parameter, used when a message is sent to a chat
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ToggleChatDefaultDisableNotificationContext(ctx context.Context, req *ToggleChatDefaultDisableNotificationRequest) (*Ok, error) {
+	err := client.checkUsersOnly("toggleChatDefaultDisableNotification")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "toggleChatDefaultDisableNotification",
 		},
-		Data: map[string]interface{}{
-			"chat_id":                      req.ChatId,
-			"default_disable_notification": req.DefaultDisableNotification,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -7355,16 +10730,28 @@ type SetChatAvailableReactionsRequest struct {
 	AvailableReactions ChatAvailableReactions `json:"available_reactions"`
 }
 
+func (req *SetChatAvailableReactionsRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.name("available_reactions")
+	encodeChatAvailableReactions(encoder, req.AvailableReactions)
+}
+
+func (req *SetChatAvailableReactionsRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Changes reactions, available in a chat. Available for basic groups, supergroups, and channels. Requires can_change_info administrator right
 func (client *Client) SetChatAvailableReactions(req *SetChatAvailableReactionsRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetChatAvailableReactionsContext(context.Background(), req)
+}
+
+// Changes reactions, available in a chat. Available for basic groups, supergroups, and channels. Requires can_change_info administrator right
+func (client *Client) SetChatAvailableReactionsContext(ctx context.Context, req *SetChatAvailableReactionsRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setChatAvailableReactions",
 		},
-		Data: map[string]interface{}{
-			"chat_id":             req.ChatId,
-			"available_reactions": req.AvailableReactions,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -7384,16 +10771,27 @@ type SetChatClientDataRequest struct {
 	ClientData string `json:"client_data"`
 }
 
+func (req *SetChatClientDataRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.String("client_data", req.ClientData)
+}
+
+func (req *SetChatClientDataRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Changes application-specific data associated with a chat
 func (client *Client) SetChatClientData(req *SetChatClientDataRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetChatClientDataContext(context.Background(), req)
+}
+
+// Changes application-specific data associated with a chat
+func (client *Client) SetChatClientDataContext(ctx context.Context, req *SetChatClientDataRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setChatClientData",
 		},
-		Data: map[string]interface{}{
-			"chat_id":     req.ChatId,
-			"client_data": req.ClientData,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -7413,16 +10811,27 @@ type SetChatDescriptionRequest struct {
 	Description string `json:"description"`
 }
 
+func (req *SetChatDescriptionRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.String("description", req.Description)
+}
+
+func (req *SetChatDescriptionRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Changes information about a chat. Available for basic groups, supergroups, and channels. Requires can_change_info administrator right
 func (client *Client) SetChatDescription(req *SetChatDescriptionRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetChatDescriptionContext(context.Background(), req)
+}
+
+// Changes information about a chat. Available for basic groups, supergroups, and channels. Requires can_change_info administrator right
+func (client *Client) SetChatDescriptionContext(ctx context.Context, req *SetChatDescriptionRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setChatDescription",
 		},
-		Data: map[string]interface{}{
-			"chat_id":     req.ChatId,
-			"description": req.Description,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -7442,16 +10851,36 @@ type SetChatDiscussionGroupRequest struct {
 	DiscussionChatId int64 `json:"discussion_chat_id"`
 }
 
+func (req *SetChatDiscussionGroupRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("discussion_chat_id", req.DiscussionChatId)
+}
+
+func (req *SetChatDiscussionGroupRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Changes the discussion group of a channel chat; requires can_change_info administrator right in the channel if it is specified
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SetChatDiscussionGroup(req *SetChatDiscussionGroupRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetChatDiscussionGroupContext(context.Background(), req)
+}
+
+// Changes the discussion group of a channel chat; requires can_change_info administrator right in the channel if it is specified
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SetChatDiscussionGroupContext(ctx context.Context, req *SetChatDiscussionGroupRequest) (*Ok, error) {
+	err := client.checkUsersOnly("setChatDiscussionGroup")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setChatDiscussionGroup",
 		},
-		Data: map[string]interface{}{
-			"chat_id":            req.ChatId,
-			"discussion_chat_id": req.DiscussionChatId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -7471,16 +10900,37 @@ type SetChatLocationRequest struct {
 	Location *ChatLocation `json:"location"`
 }
 
+func (req *SetChatLocationRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.name("location")
+	encodeChatLocation(encoder, req.Location)
+}
+
+func (req *SetChatLocationRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Changes the location of a chat. Available only for some location-based supergroups, use supergroupFullInfo.can_set_location to check whether the method is allowed to use
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SetChatLocation(req *SetChatLocationRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetChatLocationContext(context.Background(), req)
+}
+
+// Changes the location of a chat. Available only for some location-based supergroups, use supergroupFullInfo.can_set_location to check whether the method is allowed to use
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SetChatLocationContext(ctx context.Context, req *SetChatLocationRequest) (*Ok, error) {
+	err := client.checkUsersOnly("setChatLocation")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setChatLocation",
 		},
-		Data: map[string]interface{}{
-			"chat_id":  req.ChatId,
-			"location": req.Location,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -7500,16 +10950,36 @@ type SetChatSlowModeDelayRequest struct {
 	SlowModeDelay int32 `json:"slow_mode_delay"`
 }
 
+func (req *SetChatSlowModeDelayRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int32("slow_mode_delay", req.SlowModeDelay)
+}
+
+func (req *SetChatSlowModeDelayRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Changes the slow mode delay of a chat. Available only for supergroups; requires can_restrict_members rights
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SetChatSlowModeDelay(req *SetChatSlowModeDelayRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetChatSlowModeDelayContext(context.Background(), req)
+}
+
+// Changes the slow mode delay of a chat. Available only for supergroups; requires can_restrict_members rights
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SetChatSlowModeDelayContext(ctx context.Context, req *SetChatSlowModeDelayRequest) (*Ok, error) {
+	err := client.checkUsersOnly("setChatSlowModeDelay")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setChatSlowModeDelay",
 		},
-		Data: map[string]interface{}{
-			"chat_id":         req.ChatId,
-			"slow_mode_delay": req.SlowModeDelay,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -7533,18 +11003,29 @@ type PinChatMessageRequest struct {
 	OnlyForSelf bool `json:"only_for_self"`
 }
 
+func (req *PinChatMessageRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_id", req.MessageId)
+	encoder.Bool("disable_notification", req.DisableNotification)
+	encoder.Bool("only_for_self", req.OnlyForSelf)
+}
+
+func (req *PinChatMessageRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Pins a message in a chat; requires can_pin_messages rights or can_edit_messages rights in the channel
 func (client *Client) PinChatMessage(req *PinChatMessageRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.PinChatMessageContext(context.Background(), req)
+}
+
+// Pins a message in a chat; requires can_pin_messages rights or can_edit_messages rights in the channel
+func (client *Client) PinChatMessageContext(ctx context.Context, req *PinChatMessageRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "pinChatMessage",
 		},
-		Data: map[string]interface{}{
-			"chat_id":              req.ChatId,
-			"message_id":           req.MessageId,
-			"disable_notification": req.DisableNotification,
-			"only_for_self":        req.OnlyForSelf,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -7564,16 +11045,27 @@ type UnpinChatMessageRequest struct {
 	MessageId int64 `json:"message_id"`
 }
 
+func (req *UnpinChatMessageRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_id", req.MessageId)
+}
+
+func (req *UnpinChatMessageRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Removes a pinned message from a chat; requires can_pin_messages rights in the group or can_edit_messages rights in the channel
 func (client *Client) UnpinChatMessage(req *UnpinChatMessageRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.UnpinChatMessageContext(context.Background(), req)
+}
+
+// Removes a pinned message from a chat; requires can_pin_messages rights in the group or can_edit_messages rights in the channel
+func (client *Client) UnpinChatMessageContext(ctx context.Context, req *UnpinChatMessageRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "unpinChatMessage",
 		},
-		Data: map[string]interface{}{
-			"chat_id":    req.ChatId,
-			"message_id": req.MessageId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -7591,15 +11083,26 @@ type UnpinAllChatMessagesRequest struct {
 	ChatId int64 `json:"chat_id"`
 }
 
+func (req *UnpinAllChatMessagesRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+}
+
+func (req *UnpinAllChatMessagesRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Removes all pinned messages from a chat; requires can_pin_messages rights in the group or can_edit_messages rights in the channel
 func (client *Client) UnpinAllChatMessages(req *UnpinAllChatMessagesRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.UnpinAllChatMessagesContext(context.Background(), req)
+}
+
+// Removes all pinned messages from a chat; requires can_pin_messages rights in the group or can_edit_messages rights in the channel
+func (client *Client) UnpinAllChatMessagesContext(ctx context.Context, req *UnpinAllChatMessagesRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "unpinAllChatMessages",
 		},
-		Data: map[string]interface{}{
-			"chat_id": req.ChatId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -7619,16 +11122,27 @@ type UnpinAllMessageThreadMessagesRequest struct {
 	MessageThreadId int64 `json:"message_thread_id"`
 }
 
+func (req *UnpinAllMessageThreadMessagesRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_thread_id", req.MessageThreadId)
+}
+
+func (req *UnpinAllMessageThreadMessagesRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Removes all pinned messages from a forum topic; requires can_pin_messages rights in the supergroup
 func (client *Client) UnpinAllMessageThreadMessages(req *UnpinAllMessageThreadMessagesRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.UnpinAllMessageThreadMessagesContext(context.Background(), req)
+}
+
+// Removes all pinned messages from a forum topic; requires can_pin_messages rights in the supergroup
+func (client *Client) UnpinAllMessageThreadMessagesContext(ctx context.Context, req *UnpinAllMessageThreadMessagesRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "unpinAllMessageThreadMessages",
 		},
-		Data: map[string]interface{}{
-			"chat_id":           req.ChatId,
-			"message_thread_id": req.MessageThreadId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -7646,15 +11160,35 @@ type JoinChatRequest struct {
 	ChatId int64 `json:"chat_id"`
 }
 
+func (req *JoinChatRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+}
+
+func (req *JoinChatRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Adds the current user as a new member to a chat. Private and secret chats can't be joined using this method. May return an error with a message "INVITE_REQUEST_SENT" if only a join request was created
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) JoinChat(req *JoinChatRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.JoinChatContext(context.Background(), req)
+}
+
+// Adds the current user as a new member to a chat. Private and secret chats can't be joined using this method. May return an error with a message "INVITE_REQUEST_SENT" if only a join request was created
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) JoinChatContext(ctx context.Context, req *JoinChatRequest) (*Ok, error) {
+	err := client.checkUsersOnly("joinChat")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "joinChat",
 		},
-		Data: map[string]interface{}{
-			"chat_id": req.ChatId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -7672,15 +11206,26 @@ type LeaveChatRequest struct {
 	ChatId int64 `json:"chat_id"`
 }
 
+func (req *LeaveChatRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+}
+
+func (req *LeaveChatRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Removes the current user from chat members. Private and secret chats can't be left using this method
 func (client *Client) LeaveChat(req *LeaveChatRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.LeaveChatContext(context.Background(), req)
+}
+
+// Removes the current user from chat members. Private and secret chats can't be left using this method
+func (client *Client) LeaveChatContext(ctx context.Context, req *LeaveChatRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "leaveChat",
 		},
-		Data: map[string]interface{}{
-			"chat_id": req.ChatId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -7702,17 +11247,37 @@ type AddChatMemberRequest struct {
 	ForwardLimit int32 `json:"forward_limit"`
 }
 
+func (req *AddChatMemberRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("user_id", req.UserId)
+	encoder.Int32("forward_limit", req.ForwardLimit)
+}
+
+func (req *AddChatMemberRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Adds a new member to a chat. Members can't be added to private or secret chats
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) AddChatMember(req *AddChatMemberRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.AddChatMemberContext(context.Background(), req)
+}
+
+// Adds a new member to a chat. Members can't be added to private or secret chats
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) AddChatMemberContext(ctx context.Context, req *AddChatMemberRequest) (*Ok, error) {
+	err := client.checkUsersOnly("addChatMember")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "addChatMember",
 		},
-		Data: map[string]interface{}{
-			"chat_id":       req.ChatId,
-			"user_id":       req.UserId,
-			"forward_limit": req.ForwardLimit,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -7732,16 +11297,36 @@ type AddChatMembersRequest struct {
 	UserIds []int64 `json:"user_ids"`
 }
 
+func (req *AddChatMembersRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64s("user_ids", req.UserIds)
+}
+
+func (req *AddChatMembersRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Adds multiple new members to a chat. Currently, this method is only available for supergroups and channels. This method can't be used to join a chat. Members can't be added to a channel if it has more than 200 members
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) AddChatMembers(req *AddChatMembersRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.AddChatMembersContext(context.Background(), req)
+}
+
+// Adds multiple new members to a chat. Currently, this method is only available for supergroups and channels. This method can't be used to join a chat. Members can't be added to a channel if it has more than 200 members
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) AddChatMembersContext(ctx context.Context, req *AddChatMembersRequest) (*Ok, error) {
+	err := client.checkUsersOnly("addChatMembers")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "addChatMembers",
 		},
-		Data: map[string]interface{}{
-			"chat_id":  req.ChatId,
-			"user_ids": req.UserIds,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -7763,17 +11348,30 @@ type SetChatMemberStatusRequest struct {
 	Status ChatMemberStatus `json:"status"`
 }
 
+func (req *SetChatMemberStatusRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.name("member_id")
+	encodeMessageSender(encoder, req.MemberId)
+	encoder.name("status")
+	encodeChatMemberStatus(encoder, req.Status)
+}
+
+func (req *SetChatMemberStatusRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Changes the status of a chat member, needs appropriate privileges. This function is currently not suitable for transferring chat ownership; use transferChatOwnership instead. Use addChatMember or banChatMember if some additional parameters needs to be passed
 func (client *Client) SetChatMemberStatus(req *SetChatMemberStatusRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetChatMemberStatusContext(context.Background(), req)
+}
+
+// Changes the status of a chat member, needs appropriate privileges. This function is currently not suitable for transferring chat ownership; use transferChatOwnership instead. Use addChatMember or banChatMember if some additional parameters needs to be passed
+func (client *Client) SetChatMemberStatusContext(ctx context.Context, req *SetChatMemberStatusRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setChatMemberStatus",
 		},
-		Data: map[string]interface{}{
-			"chat_id":   req.ChatId,
-			"member_id": req.MemberId,
-			"status":    req.Status,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -7797,18 +11395,30 @@ type BanChatMemberRequest struct {
 	RevokeMessages bool `json:"revoke_messages"`
 }
 
+func (req *BanChatMemberRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.name("member_id")
+	encodeMessageSender(encoder, req.MemberId)
+	encoder.Int32("banned_until_date", req.BannedUntilDate)
+	encoder.Bool("revoke_messages", req.RevokeMessages)
+}
+
+func (req *BanChatMemberRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Bans a member in a chat. Members can't be banned in private or secret chats. In supergroups and channels, the user will not be able to return to the group on their own using invite links, etc., unless unbanned first
 func (client *Client) BanChatMember(req *BanChatMemberRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.BanChatMemberContext(context.Background(), req)
+}
+
+// Bans a member in a chat. Members can't be banned in private or secret chats. In supergroups and channels, the user will not be able to return to the group on their own using invite links, etc., unless unbanned first
+func (client *Client) BanChatMemberContext(ctx context.Context, req *BanChatMemberRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "banChatMember",
 		},
-		Data: map[string]interface{}{
-			"chat_id":           req.ChatId,
-			"member_id":         req.MemberId,
-			"banned_until_date": req.BannedUntilDate,
-			"revoke_messages":   req.RevokeMessages,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -7822,12 +11432,25 @@ func (client *Client) BanChatMember(req *BanChatMemberRequest) (*Ok, error) {
 }
 
 // Checks whether the current session can be used to transfer a chat ownership to another user
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) CanTransferOwnership() (CanTransferOwnershipResult, error) {
-	result, err := client.Send(Request{
+	return client.CanTransferOwnershipContext(context.Background())
+}
+
+// Checks whether the current session can be used to transfer a chat ownership to another user
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) CanTransferOwnershipContext(ctx context.Context) (CanTransferOwnershipResult, error) {
+	err := client.checkUsersOnly("canTransferOwnership")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "canTransferOwnership",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -7837,22 +11460,7 @@ func (client *Client) CanTransferOwnership() (CanTransferOwnershipResult, error)
 		return nil, buildResponseError(result.Data)
 	}
 
-	switch result.Type {
-	case TypeCanTransferOwnershipResultOk:
-		return UnmarshalCanTransferOwnershipResultOk(result.Data)
-
-	case TypeCanTransferOwnershipResultPasswordNeeded:
-		return UnmarshalCanTransferOwnershipResultPasswordNeeded(result.Data)
-
-	case TypeCanTransferOwnershipResultPasswordTooFresh:
-		return UnmarshalCanTransferOwnershipResultPasswordTooFresh(result.Data)
-
-	case TypeCanTransferOwnershipResultSessionTooFresh:
-		return UnmarshalCanTransferOwnershipResultSessionTooFresh(result.Data)
-
-	default:
-		return nil, errors.New("invalid type")
-	}
+	return UnmarshalCanTransferOwnershipResult(result.Data)
 }
 
 type TransferChatOwnershipRequest struct {
@@ -7864,17 +11472,37 @@ type TransferChatOwnershipRequest struct {
 	Password string `json:"password"`
 }
 
+func (req *TransferChatOwnershipRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("user_id", req.UserId)
+	encoder.String("password", req.Password)
+}
+
+func (req *TransferChatOwnershipRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Changes the owner of a chat. The current user must be a current owner of the chat. Use the method canTransferOwnership to check whether the ownership can be transferred from the current session. Available only for supergroups and channel chats
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) TransferChatOwnership(req *TransferChatOwnershipRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.TransferChatOwnershipContext(context.Background(), req)
+}
+
+// Changes the owner of a chat. The current user must be a current owner of the chat. Use the method canTransferOwnership to check whether the ownership can be transferred from the current session. Available only for supergroups and channel chats
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) TransferChatOwnershipContext(ctx context.Context, req *TransferChatOwnershipRequest) (*Ok, error) {
+	err := client.checkUsersOnly("transferChatOwnership")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "transferChatOwnership",
 		},
-		Data: map[string]interface{}{
-			"chat_id":  req.ChatId,
-			"user_id":  req.UserId,
-			"password": req.Password,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -7894,16 +11522,28 @@ type GetChatMemberRequest struct {
 	MemberId MessageSender `json:"member_id"`
 }
 
+func (req *GetChatMemberRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.name("member_id")
+	encodeMessageSender(encoder, req.MemberId)
+}
+
+func (req *GetChatMemberRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns information about a single member of a chat
 func (client *Client) GetChatMember(req *GetChatMemberRequest) (*ChatMember, error) {
-	result, err := client.Send(Request{
+	return client.GetChatMemberContext(context.Background(), req)
+}
+
+// Returns information about a single member of a chat
+func (client *Client) GetChatMemberContext(ctx context.Context, req *GetChatMemberRequest) (*ChatMember, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getChatMember",
 		},
-		Data: map[string]interface{}{
-			"chat_id":   req.ChatId,
-			"member_id": req.MemberId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -7927,18 +11567,30 @@ type SearchChatMembersRequest struct {
 	Filter ChatMembersFilter `json:"filter"`
 }
 
+func (req *SearchChatMembersRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.String("query", req.Query)
+	encoder.Int32("limit", req.Limit)
+	encoder.name("filter")
+	encodeChatMembersFilter(encoder, req.Filter)
+}
+
+func (req *SearchChatMembersRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Searches for a specified query in the first name, last name and usernames of the members of a specified chat. Requires administrator rights in channels
 func (client *Client) SearchChatMembers(req *SearchChatMembersRequest) (*ChatMembers, error) {
-	result, err := client.Send(Request{
+	return client.SearchChatMembersContext(context.Background(), req)
+}
+
+// Searches for a specified query in the first name, last name and usernames of the members of a specified chat. Requires administrator rights in channels
+func (client *Client) SearchChatMembersContext(ctx context.Context, req *SearchChatMembersRequest) (*ChatMembers, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "searchChatMembers",
 		},
-		Data: map[string]interface{}{
-			"chat_id": req.ChatId,
-			"query":   req.Query,
-			"limit":   req.Limit,
-			"filter":  req.Filter,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -7956,15 +11608,26 @@ type GetChatAdministratorsRequest struct {
 	ChatId int64 `json:"chat_id"`
 }
 
+func (req *GetChatAdministratorsRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+}
+
+func (req *GetChatAdministratorsRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns a list of administrators of the chat with their custom titles
 func (client *Client) GetChatAdministrators(req *GetChatAdministratorsRequest) (*ChatAdministrators, error) {
-	result, err := client.Send(Request{
+	return client.GetChatAdministratorsContext(context.Background(), req)
+}
+
+// Returns a list of administrators of the chat with their custom titles
+func (client *Client) GetChatAdministratorsContext(ctx context.Context, req *GetChatAdministratorsRequest) (*ChatAdministrators, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getChatAdministrators",
 		},
-		Data: map[string]interface{}{
-			"chat_id": req.ChatId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -7982,15 +11645,31 @@ type ClearAllDraftMessagesRequest struct {
 	ExcludeSecretChats bool `json:"exclude_secret_chats"`
 }
 
+func (req *ClearAllDraftMessagesRequest) encode(encoder *encoder) {
+	encoder.Bool("exclude_secret_chats", req.ExcludeSecretChats)
+}
+
 // Clears message drafts in all chats
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ClearAllDraftMessages(req *ClearAllDraftMessagesRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ClearAllDraftMessagesContext(context.Background(), req)
+}
+
+// Clears message drafts in all chats
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ClearAllDraftMessagesContext(ctx context.Context, req *ClearAllDraftMessagesRequest) (*Ok, error) {
+	err := client.checkUsersOnly("clearAllDraftMessages")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "clearAllDraftMessages",
 		},
-		Data: map[string]interface{}{
-			"exclude_secret_chats": req.ExcludeSecretChats,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -8008,15 +11687,31 @@ type GetSavedNotificationSoundRequest struct {
 	NotificationSoundId JsonInt64 `json:"notification_sound_id"`
 }
 
+func (req *GetSavedNotificationSoundRequest) encode(encoder *encoder) {
+	encoder.JsonInt64("notification_sound_id", req.NotificationSoundId)
+}
+
 // Returns saved notification sound by its identifier. Returns a 404 error if there is no saved notification sound with the specified identifier
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetSavedNotificationSound(req *GetSavedNotificationSoundRequest) (*NotificationSounds, error) {
-	result, err := client.Send(Request{
+	return client.GetSavedNotificationSoundContext(context.Background(), req)
+}
+
+// Returns saved notification sound by its identifier. Returns a 404 error if there is no saved notification sound with the specified identifier
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetSavedNotificationSoundContext(ctx context.Context, req *GetSavedNotificationSoundRequest) (*NotificationSounds, error) {
+	err := client.checkUsersOnly("getSavedNotificationSound")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getSavedNotificationSound",
 		},
-		Data: map[string]interface{}{
-			"notification_sound_id": req.NotificationSoundId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -8030,12 +11725,25 @@ func (client *Client) GetSavedNotificationSound(req *GetSavedNotificationSoundRe
 }
 
 // Returns list of saved notification sounds. If a sound isn't in the list, then default sound needs to be used
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetSavedNotificationSounds() (*NotificationSounds, error) {
-	result, err := client.Send(Request{
+	return client.GetSavedNotificationSoundsContext(context.Background())
+}
+
+// Returns list of saved notification sounds. If a sound isn't in the list, then default sound needs to be used
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetSavedNotificationSoundsContext(ctx context.Context) (*NotificationSounds, error) {
+	err := client.checkUsersOnly("getSavedNotificationSounds")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getSavedNotificationSounds",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -8053,15 +11761,32 @@ type AddSavedNotificationSoundRequest struct {
 	Sound InputFile `json:"sound"`
 }
 
+func (req *AddSavedNotificationSoundRequest) encode(encoder *encoder) {
+	encoder.name("sound")
+	encodeInputFile(encoder, req.Sound)
+}
+
 // Adds a new notification sound to the list of saved notification sounds. The new notification sound is added to the top of the list. If it is already in the list, its position isn't changed
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) AddSavedNotificationSound(req *AddSavedNotificationSoundRequest) (*NotificationSound, error) {
-	result, err := client.Send(Request{
+	return client.AddSavedNotificationSoundContext(context.Background(), req)
+}
+
+// Adds a new notification sound to the list of saved notification sounds. The new notification sound is added to the top of the list. If it is already in the list, its position isn't changed
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) AddSavedNotificationSoundContext(ctx context.Context, req *AddSavedNotificationSoundRequest) (*NotificationSound, error) {
+	err := client.checkUsersOnly("addSavedNotificationSound")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "addSavedNotificationSound",
 		},
-		Data: map[string]interface{}{
-			"sound": req.Sound,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -8079,15 +11804,31 @@ type RemoveSavedNotificationSoundRequest struct {
 	NotificationSoundId JsonInt64 `json:"notification_sound_id"`
 }
 
+func (req *RemoveSavedNotificationSoundRequest) encode(encoder *encoder) {
+	encoder.JsonInt64("notification_sound_id", req.NotificationSoundId)
+}
+
 // Removes a notification sound from the list of saved notification sounds
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) RemoveSavedNotificationSound(req *RemoveSavedNotificationSoundRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.RemoveSavedNotificationSoundContext(context.Background(), req)
+}
+
+// Removes a notification sound from the list of saved notification sounds
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) RemoveSavedNotificationSoundContext(ctx context.Context, req *RemoveSavedNotificationSoundRequest) (*Ok, error) {
+	err := client.checkUsersOnly("removeSavedNotificationSound")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "removeSavedNotificationSound",
 		},
-		Data: map[string]interface{}{
-			"notification_sound_id": req.NotificationSoundId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -8107,16 +11848,33 @@ type GetChatNotificationSettingsExceptionsRequest struct {
 	CompareSound bool `json:"compare_sound"`
 }
 
+func (req *GetChatNotificationSettingsExceptionsRequest) encode(encoder *encoder) {
+	encoder.name("scope")
+	encodeNotificationSettingsScope(encoder, req.Scope)
+	encoder.Bool("compare_sound", req.CompareSound)
+}
+
 // Returns list of chats with non-default notification settings
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetChatNotificationSettingsExceptions(req *GetChatNotificationSettingsExceptionsRequest) (*Chats, error) {
-	result, err := client.Send(Request{
+	return client.GetChatNotificationSettingsExceptionsContext(context.Background(), req)
+}
+
+// Returns list of chats with non-default notification settings
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetChatNotificationSettingsExceptionsContext(ctx context.Context, req *GetChatNotificationSettingsExceptionsRequest) (*Chats, error) {
+	err := client.checkUsersOnly("getChatNotificationSettingsExceptions")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getChatNotificationSettingsExceptions",
 		},
-		Data: map[string]interface{}{
-			"scope":         req.Scope,
-			"compare_sound": req.CompareSound,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -8134,15 +11892,32 @@ type GetScopeNotificationSettingsRequest struct {
 	Scope NotificationSettingsScope `json:"scope"`
 }
 
+func (req *GetScopeNotificationSettingsRequest) encode(encoder *encoder) {
+	encoder.name("scope")
+	encodeNotificationSettingsScope(encoder, req.Scope)
+}
+
 // Returns the notification settings for chats of a given type
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetScopeNotificationSettings(req *GetScopeNotificationSettingsRequest) (*ScopeNotificationSettings, error) {
-	result, err := client.Send(Request{
+	return client.GetScopeNotificationSettingsContext(context.Background(), req)
+}
+
+// Returns the notification settings for chats of a given type
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetScopeNotificationSettingsContext(ctx context.Context, req *GetScopeNotificationSettingsRequest) (*ScopeNotificationSettings, error) {
+	err := client.checkUsersOnly("getScopeNotificationSettings")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getScopeNotificationSettings",
 		},
-		Data: map[string]interface{}{
-			"scope": req.Scope,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -8162,16 +11937,34 @@ type SetScopeNotificationSettingsRequest struct {
 	NotificationSettings *ScopeNotificationSettings `json:"notification_settings"`
 }
 
+func (req *SetScopeNotificationSettingsRequest) encode(encoder *encoder) {
+	encoder.name("scope")
+	encodeNotificationSettingsScope(encoder, req.Scope)
+	encoder.name("notification_settings")
+	encodeScopeNotificationSettings(encoder, req.NotificationSettings)
+}
+
 // Changes notification settings for chats of a given type
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SetScopeNotificationSettings(req *SetScopeNotificationSettingsRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetScopeNotificationSettingsContext(context.Background(), req)
+}
+
+// Changes notification settings for chats of a given type
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SetScopeNotificationSettingsContext(ctx context.Context, req *SetScopeNotificationSettingsRequest) (*Ok, error) {
+	err := client.checkUsersOnly("setScopeNotificationSettings")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setScopeNotificationSettings",
 		},
-		Data: map[string]interface{}{
-			"scope":                 req.Scope,
-			"notification_settings": req.NotificationSettings,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -8185,12 +11978,25 @@ func (client *Client) SetScopeNotificationSettings(req *SetScopeNotificationSett
 }
 
 // Resets all notification settings to their default values. By default, all chats are unmuted and message previews are shown
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ResetAllNotificationSettings() (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ResetAllNotificationSettingsContext(context.Background())
+}
+
+// Resets all notification settings to their default values. By default, all chats are unmuted and message previews are shown
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ResetAllNotificationSettingsContext(ctx context.Context) (*Ok, error) {
+	err := client.checkUsersOnly("resetAllNotificationSettings")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "resetAllNotificationSettings",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -8212,17 +12018,38 @@ type ToggleChatIsPinnedRequest struct {
 	IsPinned bool `json:"is_pinned"`
 }
 
+func (req *ToggleChatIsPinnedRequest) encode(encoder *encoder) {
+	encoder.name("chat_list")
+	encodeChatList(encoder, req.ChatList)
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Bool("is_pinned", req.IsPinned)
+}
+
+func (req *ToggleChatIsPinnedRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Changes the pinned state of a chat. There can be up to getOption("pinned_chat_count_max")/getOption("pinned_archived_chat_count_max") pinned non-secret chats and the same number of secret chats in the main/archive chat list. The limit can be increased with Telegram Premium
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ToggleChatIsPinned(req *ToggleChatIsPinnedRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ToggleChatIsPinnedContext(context.Background(), req)
+}
+
+// Changes the pinned state of a chat. There can be up to getOption("pinned_chat_count_max")/getOption("pinned_archived_chat_count_max") pinned non-secret chats and the same number of secret chats in the main/archive chat list. The limit can be increased with Telegram Premium
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ToggleChatIsPinnedContext(ctx context.Context, req *ToggleChatIsPinnedRequest) (*Ok, error) {
+	err := client.checkUsersOnly("toggleChatIsPinned")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "toggleChatIsPinned",
 		},
-		Data: map[string]interface{}{
-			"chat_list": req.ChatList,
-			"chat_id":   req.ChatId,
-			"is_pinned": req.IsPinned,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -8242,16 +12069,33 @@ type SetPinnedChatsRequest struct {
 	ChatIds []int64 `json:"chat_ids"`
 }
 
+func (req *SetPinnedChatsRequest) encode(encoder *encoder) {
+	encoder.name("chat_list")
+	encodeChatList(encoder, req.ChatList)
+	encoder.Int64s("chat_ids", req.ChatIds)
+}
+
 // Changes the order of pinned chats
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SetPinnedChats(req *SetPinnedChatsRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetPinnedChatsContext(context.Background(), req)
+}
+
+// Changes the order of pinned chats
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SetPinnedChatsContext(ctx context.Context, req *SetPinnedChatsRequest) (*Ok, error) {
+	err := client.checkUsersOnly("setPinnedChats")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setPinnedChats",
 		},
-		Data: map[string]interface{}{
-			"chat_list": req.ChatList,
-			"chat_ids":  req.ChatIds,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -8269,15 +12113,32 @@ type ReadChatListRequest struct {
 	ChatList ChatList `json:"chat_list"`
 }
 
+func (req *ReadChatListRequest) encode(encoder *encoder) {
+	encoder.name("chat_list")
+	encodeChatList(encoder, req.ChatList)
+}
+
 // Traverse all chats in a chat list and marks all messages in the chats as read
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ReadChatList(req *ReadChatListRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ReadChatListContext(context.Background(), req)
+}
+
+// Traverse all chats in a chat list and marks all messages in the chats as read
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ReadChatListContext(ctx context.Context, req *ReadChatListRequest) (*Ok, error) {
+	err := client.checkUsersOnly("readChatList")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "readChatList",
 		},
-		Data: map[string]interface{}{
-			"chat_list": req.ChatList,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -8295,15 +12156,31 @@ type GetAttachmentMenuBotRequest struct {
 	BotUserId int64 `json:"bot_user_id"`
 }
 
+func (req *GetAttachmentMenuBotRequest) encode(encoder *encoder) {
+	encoder.Int64("bot_user_id", req.BotUserId)
+}
+
 // Returns information about a bot that can be added to attachment menu
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetAttachmentMenuBot(req *GetAttachmentMenuBotRequest) (*AttachmentMenuBot, error) {
-	result, err := client.Send(Request{
+	return client.GetAttachmentMenuBotContext(context.Background(), req)
+}
+
+// Returns information about a bot that can be added to attachment menu
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetAttachmentMenuBotContext(ctx context.Context, req *GetAttachmentMenuBotRequest) (*AttachmentMenuBot, error) {
+	err := client.checkUsersOnly("getAttachmentMenuBot")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getAttachmentMenuBot",
 		},
-		Data: map[string]interface{}{
-			"bot_user_id": req.BotUserId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -8325,17 +12202,33 @@ type ToggleBotIsAddedToAttachmentMenuRequest struct {
 	AllowWriteAccess bool `json:"allow_write_access"`
 }
 
+func (req *ToggleBotIsAddedToAttachmentMenuRequest) encode(encoder *encoder) {
+	encoder.Int64("bot_user_id", req.BotUserId)
+	encoder.Bool("is_added", req.IsAdded)
+	encoder.Bool("allow_write_access", req.AllowWriteAccess)
+}
+
 // Adds or removes a bot to attachment menu. Bot can be added to attachment menu, only if userTypeBot.can_be_added_to_attachment_menu == true
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ToggleBotIsAddedToAttachmentMenu(req *ToggleBotIsAddedToAttachmentMenuRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ToggleBotIsAddedToAttachmentMenuContext(context.Background(), req)
+}
+
+// Adds or removes a bot to attachment menu. Bot can be added to attachment menu, only if userTypeBot.can_be_added_to_attachment_menu == true
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ToggleBotIsAddedToAttachmentMenuContext(ctx context.Context, req *ToggleBotIsAddedToAttachmentMenuRequest) (*Ok, error) {
+	err := client.checkUsersOnly("toggleBotIsAddedToAttachmentMenu")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "toggleBotIsAddedToAttachmentMenu",
 		},
-		Data: map[string]interface{}{
-			"bot_user_id":        req.BotUserId,
-			"is_added":           req.IsAdded,
-			"allow_write_access": req.AllowWriteAccess,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -8349,12 +12242,25 @@ func (client *Client) ToggleBotIsAddedToAttachmentMenu(req *ToggleBotIsAddedToAt
 }
 
 // Returns up to 8 emoji statuses, which must be shown right after the default Premium Badge in the emoji status list
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetThemedEmojiStatuses() (*EmojiStatuses, error) {
-	result, err := client.Send(Request{
+	return client.GetThemedEmojiStatusesContext(context.Background())
+}
+
+// Returns up to 8 emoji statuses, which must be shown right after the default Premium Badge in the emoji status list
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetThemedEmojiStatusesContext(ctx context.Context) (*EmojiStatuses, error) {
+	err := client.checkUsersOnly("getThemedEmojiStatuses")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getThemedEmojiStatuses",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -8368,12 +12274,25 @@ func (client *Client) GetThemedEmojiStatuses() (*EmojiStatuses, error) {
 }
 
 // Returns recent emoji statuses
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetRecentEmojiStatuses() (*EmojiStatuses, error) {
-	result, err := client.Send(Request{
+	return client.GetRecentEmojiStatusesContext(context.Background())
+}
+
+// Returns recent emoji statuses
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetRecentEmojiStatusesContext(ctx context.Context) (*EmojiStatuses, error) {
+	err := client.checkUsersOnly("getRecentEmojiStatuses")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getRecentEmojiStatuses",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -8387,12 +12306,25 @@ func (client *Client) GetRecentEmojiStatuses() (*EmojiStatuses, error) {
 }
 
 // Returns default emoji statuses
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetDefaultEmojiStatuses() (*EmojiStatuses, error) {
-	result, err := client.Send(Request{
+	return client.GetDefaultEmojiStatusesContext(context.Background())
+}
+
+// Returns default emoji statuses
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetDefaultEmojiStatusesContext(ctx context.Context) (*EmojiStatuses, error) {
+	err := client.checkUsersOnly("getDefaultEmojiStatuses")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getDefaultEmojiStatuses",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -8406,12 +12338,25 @@ func (client *Client) GetDefaultEmojiStatuses() (*EmojiStatuses, error) {
 }
 
 // Clears the list of recently used emoji statuses
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ClearRecentEmojiStatuses() (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ClearRecentEmojiStatusesContext(context.Background())
+}
+
+// Clears the list of recently used emoji statuses
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ClearRecentEmojiStatusesContext(ctx context.Context) (*Ok, error) {
+	err := client.checkUsersOnly("clearRecentEmojiStatuses")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "clearRecentEmojiStatuses",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -8437,19 +12382,26 @@ type DownloadFileRequest struct {
 	Synchronous bool `json:"synchronous"`
 }
 
+func (req *DownloadFileRequest) encode(encoder *encoder) {
+	encoder.Int32("file_id", req.FileId)
+	encoder.Int32("priority", req.Priority)
+	encoder.Int64("offset", req.Offset)
+	encoder.Int64("limit", req.Limit)
+	encoder.Bool("synchronous", req.Synchronous)
+}
+
 // Downloads a file from the cloud. Download progress and completion of the download will be notified through updateFile updates
 func (client *Client) DownloadFile(req *DownloadFileRequest) (*File, error) {
-	result, err := client.Send(Request{
+	return client.DownloadFileContext(context.Background(), req)
+}
+
+// Downloads a file from the cloud. Download progress and completion of the download will be notified through updateFile updates
+func (client *Client) DownloadFileContext(ctx context.Context, req *DownloadFileRequest) (*File, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "downloadFile",
 		},
-		Data: map[string]interface{}{
-			"file_id":     req.FileId,
-			"priority":    req.Priority,
-			"offset":      req.Offset,
-			"limit":       req.Limit,
-			"synchronous": req.Synchronous,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -8469,16 +12421,23 @@ type GetFileDownloadedPrefixSizeRequest struct {
 	Offset int64 `json:"offset"`
 }
 
+func (req *GetFileDownloadedPrefixSizeRequest) encode(encoder *encoder) {
+	encoder.Int32("file_id", req.FileId)
+	encoder.Int64("offset", req.Offset)
+}
+
 // Returns file downloaded prefix size from a given offset, in bytes
 func (client *Client) GetFileDownloadedPrefixSize(req *GetFileDownloadedPrefixSizeRequest) (*FileDownloadedPrefixSize, error) {
-	result, err := client.Send(Request{
+	return client.GetFileDownloadedPrefixSizeContext(context.Background(), req)
+}
+
+// Returns file downloaded prefix size from a given offset, in bytes
+func (client *Client) GetFileDownloadedPrefixSizeContext(ctx context.Context, req *GetFileDownloadedPrefixSizeRequest) (*FileDownloadedPrefixSize, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getFileDownloadedPrefixSize",
 		},
-		Data: map[string]interface{}{
-			"file_id": req.FileId,
-			"offset":  req.Offset,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -8498,16 +12457,23 @@ type CancelDownloadFileRequest struct {
 	OnlyIfPending bool `json:"only_if_pending"`
 }
 
+func (req *CancelDownloadFileRequest) encode(encoder *encoder) {
+	encoder.Int32("file_id", req.FileId)
+	encoder.Bool("only_if_pending", req.OnlyIfPending)
+}
+
 // Stops the downloading of a file. If a file has already been downloaded, does nothing
 func (client *Client) CancelDownloadFile(req *CancelDownloadFileRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.CancelDownloadFileContext(context.Background(), req)
+}
+
+// Stops the downloading of a file. If a file has already been downloaded, does nothing
+func (client *Client) CancelDownloadFileContext(ctx context.Context, req *CancelDownloadFileRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "cancelDownloadFile",
 		},
-		Data: map[string]interface{}{
-			"file_id":         req.FileId,
-			"only_if_pending": req.OnlyIfPending,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -8527,16 +12493,23 @@ type GetSuggestedFileNameRequest struct {
 	Directory string `json:"directory"`
 }
 
+func (req *GetSuggestedFileNameRequest) encode(encoder *encoder) {
+	encoder.Int32("file_id", req.FileId)
+	encoder.String("directory", req.Directory)
+}
+
 // Returns suggested name for saving a file in a given directory
 func (client *Client) GetSuggestedFileName(req *GetSuggestedFileNameRequest) (*Text, error) {
-	result, err := client.Send(Request{
+	return client.GetSuggestedFileNameContext(context.Background(), req)
+}
+
+// Returns suggested name for saving a file in a given directory
+func (client *Client) GetSuggestedFileNameContext(ctx context.Context, req *GetSuggestedFileNameRequest) (*Text, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getSuggestedFileName",
 		},
-		Data: map[string]interface{}{
-			"file_id":   req.FileId,
-			"directory": req.Directory,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -8558,17 +12531,26 @@ type PreliminaryUploadFileRequest struct {
 	Priority int32 `json:"priority"`
 }
 
+func (req *PreliminaryUploadFileRequest) encode(encoder *encoder) {
+	encoder.name("file")
+	encodeInputFile(encoder, req.File)
+	encoder.name("file_type")
+	encodeFileType(encoder, req.FileType)
+	encoder.Int32("priority", req.Priority)
+}
+
 // Preliminary uploads a file to the cloud before sending it in a message, which can be useful for uploading of being recorded voice and video notes. Updates updateFile will be used to notify about upload progress and successful completion of the upload. The file will not have a persistent remote identifier until it will be sent in a message
 func (client *Client) PreliminaryUploadFile(req *PreliminaryUploadFileRequest) (*File, error) {
-	result, err := client.Send(Request{
-		meta: meta{
-			Type: "preliminaryUploadFile",
-		},
-		Data: map[string]interface{}{
-			"file":      req.File,
-			"file_type": req.FileType,
-			"priority":  req.Priority,
+	return client.PreliminaryUploadFileContext(context.Background(), req)
+}
+
+// Preliminary uploads a file to the cloud before sending it in a message, which can be useful for uploading of being recorded voice and video notes. Updates updateFile will be used to notify about upload progress and successful completion of the upload. The file will not have a persistent remote identifier until it will be sent in a message
+func (client *Client) PreliminaryUploadFileContext(ctx context.Context, req *PreliminaryUploadFileRequest) (*File, error) {
+	result, err := client.SendContext(ctx, Request{
+		meta: meta{
+			Type: "preliminaryUploadFile",
 		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -8586,15 +12568,22 @@ type CancelPreliminaryUploadFileRequest struct {
 	FileId int32 `json:"file_id"`
 }
 
+func (req *CancelPreliminaryUploadFileRequest) encode(encoder *encoder) {
+	encoder.Int32("file_id", req.FileId)
+}
+
 // Stops the preliminary uploading of a file. Supported only for files uploaded by using preliminaryUploadFile. For other files the behavior is undefined
 func (client *Client) CancelPreliminaryUploadFile(req *CancelPreliminaryUploadFileRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.CancelPreliminaryUploadFileContext(context.Background(), req)
+}
+
+// Stops the preliminary uploading of a file. Supported only for files uploaded by using preliminaryUploadFile. For other files the behavior is undefined
+func (client *Client) CancelPreliminaryUploadFileContext(ctx context.Context, req *CancelPreliminaryUploadFileRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "cancelPreliminaryUploadFile",
 		},
-		Data: map[string]interface{}{
-			"file_id": req.FileId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -8616,17 +12605,24 @@ type WriteGeneratedFilePartRequest struct {
 	Data []byte `json:"data"`
 }
 
+func (req *WriteGeneratedFilePartRequest) encode(encoder *encoder) {
+	encoder.JsonInt64("generation_id", req.GenerationId)
+	encoder.Int64("offset", req.Offset)
+	encoder.Bytes("data", req.Data)
+}
+
 // Writes a part of a generated file. This method is intended to be used only if the application has no direct access to TDLib's file system, because it is usually slower than a direct write to the destination file
 func (client *Client) WriteGeneratedFilePart(req *WriteGeneratedFilePartRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.WriteGeneratedFilePartContext(context.Background(), req)
+}
+
+// Writes a part of a generated file. This method is intended to be used only if the application has no direct access to TDLib's file system, because it is usually slower than a direct write to the destination file
+func (client *Client) WriteGeneratedFilePartContext(ctx context.Context, req *WriteGeneratedFilePartRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "writeGeneratedFilePart",
 		},
-		Data: map[string]interface{}{
-			"generation_id": req.GenerationId,
-			"offset":        req.Offset,
-			"data":          req.Data,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -8648,17 +12644,24 @@ type SetFileGenerationProgressRequest struct {
 	LocalPrefixSize int64 `json:"local_prefix_size"`
 }
 
+func (req *SetFileGenerationProgressRequest) encode(encoder *encoder) {
+	encoder.JsonInt64("generation_id", req.GenerationId)
+	encoder.Int64("expected_size", req.ExpectedSize)
+	encoder.Int64("local_prefix_size", req.LocalPrefixSize)
+}
+
 // Informs TDLib on a file generation progress
 func (client *Client) SetFileGenerationProgress(req *SetFileGenerationProgressRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetFileGenerationProgressContext(context.Background(), req)
+}
+
+// Informs TDLib on a file generation progress
+func (client *Client) SetFileGenerationProgressContext(ctx context.Context, req *SetFileGenerationProgressRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setFileGenerationProgress",
 		},
-		Data: map[string]interface{}{
-			"generation_id":     req.GenerationId,
-			"expected_size":     req.ExpectedSize,
-			"local_prefix_size": req.LocalPrefixSize,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -8678,16 +12681,24 @@ type FinishFileGenerationRequest struct {
 	Error *Error `json:"error"`
 }
 
+func (req *FinishFileGenerationRequest) encode(encoder *encoder) {
+	encoder.JsonInt64("generation_id", req.GenerationId)
+	encoder.name("error")
+	encodeError(encoder, req.Error)
+}
+
 // Finishes the file generation
 func (client *Client) FinishFileGeneration(req *FinishFileGenerationRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.FinishFileGenerationContext(context.Background(), req)
+}
+
+// Finishes the file generation
+func (client *Client) FinishFileGenerationContext(ctx context.Context, req *FinishFileGenerationRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "finishFileGeneration",
 		},
-		Data: map[string]interface{}{
-			"generation_id": req.GenerationId,
-			"error":         req.Error,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -8709,17 +12720,24 @@ type ReadFilePartRequest struct {
 	Count int64 `json:"count"`
 }
 
+func (req *ReadFilePartRequest) encode(encoder *encoder) {
+	encoder.Int32("file_id", req.FileId)
+	encoder.Int64("offset", req.Offset)
+	encoder.Int64("count", req.Count)
+}
+
 // Reads a part of a file from the TDLib file cache and returns read bytes. This method is intended to be used only if the application has no direct access to TDLib's file system, because it is usually slower than a direct read from the file
 func (client *Client) ReadFilePart(req *ReadFilePartRequest) (*FilePart, error) {
-	result, err := client.Send(Request{
+	return client.ReadFilePartContext(context.Background(), req)
+}
+
+// Reads a part of a file from the TDLib file cache and returns read bytes. This method is intended to be used only if the application has no direct access to TDLib's file system, because it is usually slower than a direct read from the file
+func (client *Client) ReadFilePartContext(ctx context.Context, req *ReadFilePartRequest) (*FilePart, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "readFilePart",
 		},
-		Data: map[string]interface{}{
-			"file_id": req.FileId,
-			"offset":  req.Offset,
-			"count":   req.Count,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -8737,15 +12755,22 @@ type DeleteFileRequest struct {
 	FileId int32 `json:"file_id"`
 }
 
+func (req *DeleteFileRequest) encode(encoder *encoder) {
+	encoder.Int32("file_id", req.FileId)
+}
+
 // Deletes a file from the TDLib file cache
 func (client *Client) DeleteFile(req *DeleteFileRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.DeleteFileContext(context.Background(), req)
+}
+
+// Deletes a file from the TDLib file cache
+func (client *Client) DeleteFileContext(ctx context.Context, req *DeleteFileRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "deleteFile",
 		},
-		Data: map[string]interface{}{
-			"file_id": req.FileId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -8769,18 +12794,29 @@ type AddFileToDownloadsRequest struct {
 	Priority int32 `json:"priority"`
 }
 
+func (req *AddFileToDownloadsRequest) encode(encoder *encoder) {
+	encoder.Int32("file_id", req.FileId)
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_id", req.MessageId)
+	encoder.Int32("priority", req.Priority)
+}
+
+func (req *AddFileToDownloadsRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Adds a file from a message to the list of file downloads. Download progress and completion of the download will be notified through updateFile updates. If message database is used, the list of file downloads is persistent across application restarts. The downloading is independent from download using downloadFile, i.e. it continues if downloadFile is canceled or is used to download a part of the file
 func (client *Client) AddFileToDownloads(req *AddFileToDownloadsRequest) (*File, error) {
-	result, err := client.Send(Request{
+	return client.AddFileToDownloadsContext(context.Background(), req)
+}
+
+// Adds a file from a message to the list of file downloads. Download progress and completion of the download will be notified through updateFile updates. If message database is used, the list of file downloads is persistent across application restarts. The downloading is independent from download using downloadFile, i.e. it continues if downloadFile is canceled or is used to download a part of the file
+func (client *Client) AddFileToDownloadsContext(ctx context.Context, req *AddFileToDownloadsRequest) (*File, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "addFileToDownloads",
 		},
-		Data: map[string]interface{}{
-			"file_id":    req.FileId,
-			"chat_id":    req.ChatId,
-			"message_id": req.MessageId,
-			"priority":   req.Priority,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -8800,16 +12836,23 @@ type ToggleDownloadIsPausedRequest struct {
 	IsPaused bool `json:"is_paused"`
 }
 
+func (req *ToggleDownloadIsPausedRequest) encode(encoder *encoder) {
+	encoder.Int32("file_id", req.FileId)
+	encoder.Bool("is_paused", req.IsPaused)
+}
+
 // Changes pause state of a file in the file download list
 func (client *Client) ToggleDownloadIsPaused(req *ToggleDownloadIsPausedRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ToggleDownloadIsPausedContext(context.Background(), req)
+}
+
+// Changes pause state of a file in the file download list
+func (client *Client) ToggleDownloadIsPausedContext(ctx context.Context, req *ToggleDownloadIsPausedRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "toggleDownloadIsPaused",
 		},
-		Data: map[string]interface{}{
-			"file_id":   req.FileId,
-			"is_paused": req.IsPaused,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -8827,15 +12870,22 @@ type ToggleAllDownloadsArePausedRequest struct {
 	ArePaused bool `json:"are_paused"`
 }
 
+func (req *ToggleAllDownloadsArePausedRequest) encode(encoder *encoder) {
+	encoder.Bool("are_paused", req.ArePaused)
+}
+
 // Changes pause state of all files in the file download list
 func (client *Client) ToggleAllDownloadsArePaused(req *ToggleAllDownloadsArePausedRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ToggleAllDownloadsArePausedContext(context.Background(), req)
+}
+
+// Changes pause state of all files in the file download list
+func (client *Client) ToggleAllDownloadsArePausedContext(ctx context.Context, req *ToggleAllDownloadsArePausedRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "toggleAllDownloadsArePaused",
 		},
-		Data: map[string]interface{}{
-			"are_paused": req.ArePaused,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -8855,16 +12905,23 @@ type RemoveFileFromDownloadsRequest struct {
 	DeleteFromCache bool `json:"delete_from_cache"`
 }
 
+func (req *RemoveFileFromDownloadsRequest) encode(encoder *encoder) {
+	encoder.Int32("file_id", req.FileId)
+	encoder.Bool("delete_from_cache", req.DeleteFromCache)
+}
+
 // Removes a file from the file download list
 func (client *Client) RemoveFileFromDownloads(req *RemoveFileFromDownloadsRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.RemoveFileFromDownloadsContext(context.Background(), req)
+}
+
+// Removes a file from the file download list
+func (client *Client) RemoveFileFromDownloadsContext(ctx context.Context, req *RemoveFileFromDownloadsRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "removeFileFromDownloads",
 		},
-		Data: map[string]interface{}{
-			"file_id":           req.FileId,
-			"delete_from_cache": req.DeleteFromCache,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -8886,17 +12943,24 @@ type RemoveAllFilesFromDownloadsRequest struct {
 	DeleteFromCache bool `json:"delete_from_cache"`
 }
 
+func (req *RemoveAllFilesFromDownloadsRequest) encode(encoder *encoder) {
+	encoder.Bool("only_active", req.OnlyActive)
+	encoder.Bool("only_completed", req.OnlyCompleted)
+	encoder.Bool("delete_from_cache", req.DeleteFromCache)
+}
+
 // Removes all files from the file download list
 func (client *Client) RemoveAllFilesFromDownloads(req *RemoveAllFilesFromDownloadsRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.RemoveAllFilesFromDownloadsContext(context.Background(), req)
+}
+
+// Removes all files from the file download list
+func (client *Client) RemoveAllFilesFromDownloadsContext(ctx context.Context, req *RemoveAllFilesFromDownloadsRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "removeAllFilesFromDownloads",
 		},
-		Data: map[string]interface{}{
-			"only_active":       req.OnlyActive,
-			"only_completed":    req.OnlyCompleted,
-			"delete_from_cache": req.DeleteFromCache,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -8922,19 +12986,26 @@ type SearchFileDownloadsRequest struct {
 	Limit int32 `json:"limit"`
 }
 
+func (req *SearchFileDownloadsRequest) encode(encoder *encoder) {
+	encoder.String("query", req.Query)
+	encoder.Bool("only_active", req.OnlyActive)
+	encoder.Bool("only_completed", req.OnlyCompleted)
+	encoder.String("offset", req.Offset)
+	encoder.Int32("limit", req.Limit)
+}
+
 // Searches for files in the file download list or recently downloaded files from the list
 func (client *Client) SearchFileDownloads(req *SearchFileDownloadsRequest) (*FoundFileDownloads, error) {
-	result, err := client.Send(Request{
+	return client.SearchFileDownloadsContext(context.Background(), req)
+}
+
+// Searches for files in the file download list or recently downloaded files from the list
+func (client *Client) SearchFileDownloadsContext(ctx context.Context, req *SearchFileDownloadsRequest) (*FoundFileDownloads, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "searchFileDownloads",
 		},
-		Data: map[string]interface{}{
-			"query":          req.Query,
-			"only_active":    req.OnlyActive,
-			"only_completed": req.OnlyCompleted,
-			"offset":         req.Offset,
-			"limit":          req.Limit,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -8952,15 +13023,31 @@ type GetMessageFileTypeRequest struct {
 	MessageFileHead string `json:"message_file_head"`
 }
 
+func (req *GetMessageFileTypeRequest) encode(encoder *encoder) {
+	encoder.String("message_file_head", req.MessageFileHead)
+}
+
 // Returns information about a file with messages exported from another application
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetMessageFileType(req *GetMessageFileTypeRequest) (MessageFileType, error) {
-	result, err := client.Send(Request{
+	return client.GetMessageFileTypeContext(context.Background(), req)
+}
+
+// Returns information about a file with messages exported from another application
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetMessageFileTypeContext(ctx context.Context, req *GetMessageFileTypeRequest) (MessageFileType, error) {
+	err := client.checkUsersOnly("getMessageFileType")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getMessageFileType",
 		},
-		Data: map[string]interface{}{
-			"message_file_head": req.MessageFileHead,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -8970,19 +13057,7 @@ func (client *Client) GetMessageFileType(req *GetMessageFileTypeRequest) (Messag
 		return nil, buildResponseError(result.Data)
 	}
 
-	switch result.Type {
-	case TypeMessageFileTypePrivate:
-		return UnmarshalMessageFileTypePrivate(result.Data)
-
-	case TypeMessageFileTypeGroup:
-		return UnmarshalMessageFileTypeGroup(result.Data)
-
-	case TypeMessageFileTypeUnknown:
-		return UnmarshalMessageFileTypeUnknown(result.Data)
-
-	default:
-		return nil, errors.New("invalid type")
-	}
+	return UnmarshalMessageFileType(result.Data)
 }
 
 type GetMessageImportConfirmationTextRequest struct {
@@ -8990,15 +13065,35 @@ type GetMessageImportConfirmationTextRequest struct {
 	ChatId int64 `json:"chat_id"`
 }
 
+func (req *GetMessageImportConfirmationTextRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+}
+
+func (req *GetMessageImportConfirmationTextRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns a confirmation text to be shown to the user before starting message import
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetMessageImportConfirmationText(req *GetMessageImportConfirmationTextRequest) (*Text, error) {
-	result, err := client.Send(Request{
+	return client.GetMessageImportConfirmationTextContext(context.Background(), req)
+}
+
+// Returns a confirmation text to be shown to the user before starting message import
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetMessageImportConfirmationTextContext(ctx context.Context, req *GetMessageImportConfirmationTextRequest) (*Text, error) {
+	err := client.checkUsersOnly("getMessageImportConfirmationText")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getMessageImportConfirmationText",
 		},
-		Data: map[string]interface{}{
-			"chat_id": req.ChatId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -9020,17 +13115,39 @@ type ImportMessagesRequest struct {
 	AttachedFiles []InputFile `json:"attached_files"`
 }
 
+func (req *ImportMessagesRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.name("message_file")
+	encodeInputFile(encoder, req.MessageFile)
+	encoder.name("attached_files")
+	encodeListOfInputFile(encoder, req.AttachedFiles)
+}
+
+func (req *ImportMessagesRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Imports messages exported from another app
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ImportMessages(req *ImportMessagesRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ImportMessagesContext(context.Background(), req)
+}
+
+// Imports messages exported from another app
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ImportMessagesContext(ctx context.Context, req *ImportMessagesRequest) (*Ok, error) {
+	err := client.checkUsersOnly("importMessages")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "importMessages",
 		},
-		Data: map[string]interface{}{
-			"chat_id":        req.ChatId,
-			"message_file":   req.MessageFile,
-			"attached_files": req.AttachedFiles,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -9048,15 +13165,26 @@ type ReplacePrimaryChatInviteLinkRequest struct {
 	ChatId int64 `json:"chat_id"`
 }
 
+func (req *ReplacePrimaryChatInviteLinkRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+}
+
+func (req *ReplacePrimaryChatInviteLinkRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Replaces current primary invite link for a chat with a new primary invite link. Available for basic groups, supergroups, and channels. Requires administrator privileges and can_invite_users right
 func (client *Client) ReplacePrimaryChatInviteLink(req *ReplacePrimaryChatInviteLinkRequest) (*ChatInviteLink, error) {
-	result, err := client.Send(Request{
+	return client.ReplacePrimaryChatInviteLinkContext(context.Background(), req)
+}
+
+// Replaces current primary invite link for a chat with a new primary invite link. Available for basic groups, supergroups, and channels. Requires administrator privileges and can_invite_users right
+func (client *Client) ReplacePrimaryChatInviteLinkContext(ctx context.Context, req *ReplacePrimaryChatInviteLinkRequest) (*ChatInviteLink, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "replacePrimaryChatInviteLink",
 		},
-		Data: map[string]interface{}{
-			"chat_id": req.ChatId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -9082,19 +13210,30 @@ type CreateChatInviteLinkRequest struct {
 	CreatesJoinRequest bool `json:"creates_join_request"`
 }
 
+func (req *CreateChatInviteLinkRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.String("name", req.Name)
+	encoder.Int32("expiration_date", req.ExpirationDate)
+	encoder.Int32("member_limit", req.MemberLimit)
+	encoder.Bool("creates_join_request", req.CreatesJoinRequest)
+}
+
+func (req *CreateChatInviteLinkRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Creates a new invite link for a chat. Available for basic groups, supergroups, and channels. Requires administrator privileges and can_invite_users right in the chat
 func (client *Client) CreateChatInviteLink(req *CreateChatInviteLinkRequest) (*ChatInviteLink, error) {
-	result, err := client.Send(Request{
+	return client.CreateChatInviteLinkContext(context.Background(), req)
+}
+
+// Creates a new invite link for a chat. Available for basic groups, supergroups, and channels. Requires administrator privileges and can_invite_users right in the chat
+func (client *Client) CreateChatInviteLinkContext(ctx context.Context, req *CreateChatInviteLinkRequest) (*ChatInviteLink, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "createChatInviteLink",
 		},
-		Data: map[string]interface{}{
-			"chat_id":              req.ChatId,
-			"name":                 req.Name,
-			"expiration_date":      req.ExpirationDate,
-			"member_limit":         req.MemberLimit,
-			"creates_join_request": req.CreatesJoinRequest,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -9122,20 +13261,31 @@ type EditChatInviteLinkRequest struct {
 	CreatesJoinRequest bool `json:"creates_join_request"`
 }
 
+func (req *EditChatInviteLinkRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.String("invite_link", req.InviteLink)
+	encoder.String("name", req.Name)
+	encoder.Int32("expiration_date", req.ExpirationDate)
+	encoder.Int32("member_limit", req.MemberLimit)
+	encoder.Bool("creates_join_request", req.CreatesJoinRequest)
+}
+
+func (req *EditChatInviteLinkRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Edits a non-primary invite link for a chat. Available for basic groups, supergroups, and channels. Requires administrator privileges and can_invite_users right in the chat for own links and owner privileges for other links
 func (client *Client) EditChatInviteLink(req *EditChatInviteLinkRequest) (*ChatInviteLink, error) {
-	result, err := client.Send(Request{
+	return client.EditChatInviteLinkContext(context.Background(), req)
+}
+
+// Edits a non-primary invite link for a chat. Available for basic groups, supergroups, and channels. Requires administrator privileges and can_invite_users right in the chat for own links and owner privileges for other links
+func (client *Client) EditChatInviteLinkContext(ctx context.Context, req *EditChatInviteLinkRequest) (*ChatInviteLink, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "editChatInviteLink",
 		},
-		Data: map[string]interface{}{
-			"chat_id":              req.ChatId,
-			"invite_link":          req.InviteLink,
-			"name":                 req.Name,
-			"expiration_date":      req.ExpirationDate,
-			"member_limit":         req.MemberLimit,
-			"creates_join_request": req.CreatesJoinRequest,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -9155,16 +13305,36 @@ type GetChatInviteLinkRequest struct {
 	InviteLink string `json:"invite_link"`
 }
 
+func (req *GetChatInviteLinkRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.String("invite_link", req.InviteLink)
+}
+
+func (req *GetChatInviteLinkRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns information about an invite link. Requires administrator privileges and can_invite_users right in the chat to get own links and owner privileges to get other links
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetChatInviteLink(req *GetChatInviteLinkRequest) (*ChatInviteLink, error) {
-	result, err := client.Send(Request{
+	return client.GetChatInviteLinkContext(context.Background(), req)
+}
+
+// Returns information about an invite link. Requires administrator privileges and can_invite_users right in the chat to get own links and owner privileges to get other links
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetChatInviteLinkContext(ctx context.Context, req *GetChatInviteLinkRequest) (*ChatInviteLink, error) {
+	err := client.checkUsersOnly("getChatInviteLink")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getChatInviteLink",
 		},
-		Data: map[string]interface{}{
-			"chat_id":     req.ChatId,
-			"invite_link": req.InviteLink,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -9182,15 +13352,35 @@ type GetChatInviteLinkCountsRequest struct {
 	ChatId int64 `json:"chat_id"`
 }
 
+func (req *GetChatInviteLinkCountsRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+}
+
+func (req *GetChatInviteLinkCountsRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns list of chat administrators with number of their invite links. Requires owner privileges in the chat
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetChatInviteLinkCounts(req *GetChatInviteLinkCountsRequest) (*ChatInviteLinkCounts, error) {
-	result, err := client.Send(Request{
+	return client.GetChatInviteLinkCountsContext(context.Background(), req)
+}
+
+// Returns list of chat administrators with number of their invite links. Requires owner privileges in the chat
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetChatInviteLinkCountsContext(ctx context.Context, req *GetChatInviteLinkCountsRequest) (*ChatInviteLinkCounts, error) {
+	err := client.checkUsersOnly("getChatInviteLinkCounts")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getChatInviteLinkCounts",
 		},
-		Data: map[string]interface{}{
-			"chat_id": req.ChatId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -9218,20 +13408,40 @@ type GetChatInviteLinksRequest struct {
 	Limit int32 `json:"limit"`
 }
 
+func (req *GetChatInviteLinksRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("creator_user_id", req.CreatorUserId)
+	encoder.Bool("is_revoked", req.IsRevoked)
+	encoder.Int32("offset_date", req.OffsetDate)
+	encoder.String("offset_invite_link", req.OffsetInviteLink)
+	encoder.Int32("limit", req.Limit)
+}
+
+func (req *GetChatInviteLinksRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns invite links for a chat created by specified administrator. Requires administrator privileges and can_invite_users right in the chat to get own links and owner privileges to get other links
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetChatInviteLinks(req *GetChatInviteLinksRequest) (*ChatInviteLinks, error) {
-	result, err := client.Send(Request{
+	return client.GetChatInviteLinksContext(context.Background(), req)
+}
+
+// Returns invite links for a chat created by specified administrator. Requires administrator privileges and can_invite_users right in the chat to get own links and owner privileges to get other links
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetChatInviteLinksContext(ctx context.Context, req *GetChatInviteLinksRequest) (*ChatInviteLinks, error) {
+	err := client.checkUsersOnly("getChatInviteLinks")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getChatInviteLinks",
 		},
-		Data: map[string]interface{}{
-			"chat_id":            req.ChatId,
-			"creator_user_id":    req.CreatorUserId,
-			"is_revoked":         req.IsRevoked,
-			"offset_date":        req.OffsetDate,
-			"offset_invite_link": req.OffsetInviteLink,
-			"limit":              req.Limit,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -9255,18 +13465,39 @@ type GetChatInviteLinkMembersRequest struct {
 	Limit int32 `json:"limit"`
 }
 
+func (req *GetChatInviteLinkMembersRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.String("invite_link", req.InviteLink)
+	encoder.name("offset_member")
+	encodeChatInviteLinkMember(encoder, req.OffsetMember)
+	encoder.Int32("limit", req.Limit)
+}
+
+func (req *GetChatInviteLinkMembersRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns chat members joined a chat via an invite link. Requires administrator privileges and can_invite_users right in the chat for own links and owner privileges for other links
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetChatInviteLinkMembers(req *GetChatInviteLinkMembersRequest) (*ChatInviteLinkMembers, error) {
-	result, err := client.Send(Request{
+	return client.GetChatInviteLinkMembersContext(context.Background(), req)
+}
+
+// Returns chat members joined a chat via an invite link. Requires administrator privileges and can_invite_users right in the chat for own links and owner privileges for other links
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetChatInviteLinkMembersContext(ctx context.Context, req *GetChatInviteLinkMembersRequest) (*ChatInviteLinkMembers, error) {
+	err := client.checkUsersOnly("getChatInviteLinkMembers")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getChatInviteLinkMembers",
 		},
-		Data: map[string]interface{}{
-			"chat_id":       req.ChatId,
-			"invite_link":   req.InviteLink,
-			"offset_member": req.OffsetMember,
-			"limit":         req.Limit,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -9286,16 +13517,27 @@ type RevokeChatInviteLinkRequest struct {
 	InviteLink string `json:"invite_link"`
 }
 
+func (req *RevokeChatInviteLinkRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.String("invite_link", req.InviteLink)
+}
+
+func (req *RevokeChatInviteLinkRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Revokes invite link for a chat. Available for basic groups, supergroups, and channels. Requires administrator privileges and can_invite_users right in the chat for own links and owner privileges for other links. If a primary link is revoked, then additionally to the revoked link returns new primary link
 func (client *Client) RevokeChatInviteLink(req *RevokeChatInviteLinkRequest) (*ChatInviteLinks, error) {
-	result, err := client.Send(Request{
+	return client.RevokeChatInviteLinkContext(context.Background(), req)
+}
+
+// Revokes invite link for a chat. Available for basic groups, supergroups, and channels. Requires administrator privileges and can_invite_users right in the chat for own links and owner privileges for other links. If a primary link is revoked, then additionally to the revoked link returns new primary link
+func (client *Client) RevokeChatInviteLinkContext(ctx context.Context, req *RevokeChatInviteLinkRequest) (*ChatInviteLinks, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "revokeChatInviteLink",
 		},
-		Data: map[string]interface{}{
-			"chat_id":     req.ChatId,
-			"invite_link": req.InviteLink,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -9315,16 +13557,36 @@ type DeleteRevokedChatInviteLinkRequest struct {
 	InviteLink string `json:"invite_link"`
 }
 
+func (req *DeleteRevokedChatInviteLinkRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.String("invite_link", req.InviteLink)
+}
+
+func (req *DeleteRevokedChatInviteLinkRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Deletes revoked chat invite links. Requires administrator privileges and can_invite_users right in the chat for own links and owner privileges for other links
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) DeleteRevokedChatInviteLink(req *DeleteRevokedChatInviteLinkRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.DeleteRevokedChatInviteLinkContext(context.Background(), req)
+}
+
+// Deletes revoked chat invite links. Requires administrator privileges and can_invite_users right in the chat for own links and owner privileges for other links
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) DeleteRevokedChatInviteLinkContext(ctx context.Context, req *DeleteRevokedChatInviteLinkRequest) (*Ok, error) {
+	err := client.checkUsersOnly("deleteRevokedChatInviteLink")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "deleteRevokedChatInviteLink",
 		},
-		Data: map[string]interface{}{
-			"chat_id":     req.ChatId,
-			"invite_link": req.InviteLink,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -9344,16 +13606,36 @@ type DeleteAllRevokedChatInviteLinksRequest struct {
 	CreatorUserId int64 `json:"creator_user_id"`
 }
 
+func (req *DeleteAllRevokedChatInviteLinksRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("creator_user_id", req.CreatorUserId)
+}
+
+func (req *DeleteAllRevokedChatInviteLinksRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Deletes all revoked chat invite links created by a given chat administrator. Requires administrator privileges and can_invite_users right in the chat for own links and owner privileges for other links
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) DeleteAllRevokedChatInviteLinks(req *DeleteAllRevokedChatInviteLinksRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.DeleteAllRevokedChatInviteLinksContext(context.Background(), req)
+}
+
+// Deletes all revoked chat invite links created by a given chat administrator. Requires administrator privileges and can_invite_users right in the chat for own links and owner privileges for other links
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) DeleteAllRevokedChatInviteLinksContext(ctx context.Context, req *DeleteAllRevokedChatInviteLinksRequest) (*Ok, error) {
+	err := client.checkUsersOnly("deleteAllRevokedChatInviteLinks")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "deleteAllRevokedChatInviteLinks",
 		},
-		Data: map[string]interface{}{
-			"chat_id":         req.ChatId,
-			"creator_user_id": req.CreatorUserId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -9371,15 +13653,31 @@ type CheckChatInviteLinkRequest struct {
 	InviteLink string `json:"invite_link"`
 }
 
+func (req *CheckChatInviteLinkRequest) encode(encoder *encoder) {
+	encoder.String("invite_link", req.InviteLink)
+}
+
 // Checks the validity of an invite link for a chat and returns information about the corresponding chat
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) CheckChatInviteLink(req *CheckChatInviteLinkRequest) (*ChatInviteLinkInfo, error) {
-	result, err := client.Send(Request{
+	return client.CheckChatInviteLinkContext(context.Background(), req)
+}
+
+// Checks the validity of an invite link for a chat and returns information about the corresponding chat
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) CheckChatInviteLinkContext(ctx context.Context, req *CheckChatInviteLinkRequest) (*ChatInviteLinkInfo, error) {
+	err := client.checkUsersOnly("checkChatInviteLink")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "checkChatInviteLink",
 		},
-		Data: map[string]interface{}{
-			"invite_link": req.InviteLink,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -9397,15 +13695,31 @@ type JoinChatByInviteLinkRequest struct {
 	InviteLink string `json:"invite_link"`
 }
 
+func (req *JoinChatByInviteLinkRequest) encode(encoder *encoder) {
+	encoder.String("invite_link", req.InviteLink)
+}
+
 // Uses an invite link to add the current user to the chat if possible. May return an error with a message "INVITE_REQUEST_SENT" if only a join request was created
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) JoinChatByInviteLink(req *JoinChatByInviteLinkRequest) (*Chat, error) {
-	result, err := client.Send(Request{
+	return client.JoinChatByInviteLinkContext(context.Background(), req)
+}
+
+// Uses an invite link to add the current user to the chat if possible. May return an error with a message "INVITE_REQUEST_SENT" if only a join request was created
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) JoinChatByInviteLinkContext(ctx context.Context, req *JoinChatByInviteLinkRequest) (*Chat, error) {
+	err := client.checkUsersOnly("joinChatByInviteLink")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "joinChatByInviteLink",
 		},
-		Data: map[string]interface{}{
-			"invite_link": req.InviteLink,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -9431,19 +13745,40 @@ type GetChatJoinRequestsRequest struct {
 	Limit int32 `json:"limit"`
 }
 
+func (req *GetChatJoinRequestsRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.String("invite_link", req.InviteLink)
+	encoder.String("query", req.Query)
+	encoder.name("offset_request")
+	encodeChatJoinRequest(encoder, req.OffsetRequest)
+	encoder.Int32("limit", req.Limit)
+}
+
+func (req *GetChatJoinRequestsRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns pending join requests in a chat
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetChatJoinRequests(req *GetChatJoinRequestsRequest) (*ChatJoinRequests, error) {
-	result, err := client.Send(Request{
+	return client.GetChatJoinRequestsContext(context.Background(), req)
+}
+
+// Returns pending join requests in a chat
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetChatJoinRequestsContext(ctx context.Context, req *GetChatJoinRequestsRequest) (*ChatJoinRequests, error) {
+	err := client.checkUsersOnly("getChatJoinRequests")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getChatJoinRequests",
 		},
-		Data: map[string]interface{}{
-			"chat_id":        req.ChatId,
-			"invite_link":    req.InviteLink,
-			"query":          req.Query,
-			"offset_request": req.OffsetRequest,
-			"limit":          req.Limit,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -9465,17 +13800,28 @@ type ProcessChatJoinRequestRequest struct {
 	Approve bool `json:"approve"`
 }
 
-// Handles a pending join request in a chat
-func (client *Client) ProcessChatJoinRequest(req *ProcessChatJoinRequestRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+func (req *ProcessChatJoinRequestRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("user_id", req.UserId)
+	encoder.Bool("approve", req.Approve)
+}
+
+func (req *ProcessChatJoinRequestRequest) chatId() int64 {
+	return req.ChatId
+}
+
+// Handles a pending join request in a chat
+func (client *Client) ProcessChatJoinRequest(req *ProcessChatJoinRequestRequest) (*Ok, error) {
+	return client.ProcessChatJoinRequestContext(context.Background(), req)
+}
+
+// Handles a pending join request in a chat
+func (client *Client) ProcessChatJoinRequestContext(ctx context.Context, req *ProcessChatJoinRequestRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "processChatJoinRequest",
 		},
-		Data: map[string]interface{}{
-			"chat_id": req.ChatId,
-			"user_id": req.UserId,
-			"approve": req.Approve,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -9497,17 +13843,37 @@ type ProcessChatJoinRequestsRequest struct {
 	Approve bool `json:"approve"`
 }
 
+func (req *ProcessChatJoinRequestsRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.String("invite_link", req.InviteLink)
+	encoder.Bool("approve", req.Approve)
+}
+
+func (req *ProcessChatJoinRequestsRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Handles all pending join requests for a given link in a chat
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ProcessChatJoinRequests(req *ProcessChatJoinRequestsRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ProcessChatJoinRequestsContext(context.Background(), req)
+}
+
+// Handles all pending join requests for a given link in a chat
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ProcessChatJoinRequestsContext(ctx context.Context, req *ProcessChatJoinRequestsRequest) (*Ok, error) {
+	err := client.checkUsersOnly("processChatJoinRequests")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "processChatJoinRequests",
 		},
-		Data: map[string]interface{}{
-			"chat_id":     req.ChatId,
-			"invite_link": req.InviteLink,
-			"approve":     req.Approve,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -9529,17 +13895,34 @@ type CreateCallRequest struct {
 	IsVideo bool `json:"is_video"`
 }
 
+func (req *CreateCallRequest) encode(encoder *encoder) {
+	encoder.Int64("user_id", req.UserId)
+	encoder.name("protocol")
+	encodeCallProtocol(encoder, req.Protocol)
+	encoder.Bool("is_video", req.IsVideo)
+}
+
 // Creates a new call
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) CreateCall(req *CreateCallRequest) (*CallId, error) {
-	result, err := client.Send(Request{
+	return client.CreateCallContext(context.Background(), req)
+}
+
+// Creates a new call
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) CreateCallContext(ctx context.Context, req *CreateCallRequest) (*CallId, error) {
+	err := client.checkUsersOnly("createCall")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "createCall",
 		},
-		Data: map[string]interface{}{
-			"user_id":  req.UserId,
-			"protocol": req.Protocol,
-			"is_video": req.IsVideo,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -9559,16 +13942,33 @@ type AcceptCallRequest struct {
 	Protocol *CallProtocol `json:"protocol"`
 }
 
+func (req *AcceptCallRequest) encode(encoder *encoder) {
+	encoder.Int32("call_id", req.CallId)
+	encoder.name("protocol")
+	encodeCallProtocol(encoder, req.Protocol)
+}
+
 // Accepts an incoming call
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) AcceptCall(req *AcceptCallRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.AcceptCallContext(context.Background(), req)
+}
+
+// Accepts an incoming call
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) AcceptCallContext(ctx context.Context, req *AcceptCallRequest) (*Ok, error) {
+	err := client.checkUsersOnly("acceptCall")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "acceptCall",
 		},
-		Data: map[string]interface{}{
-			"call_id":  req.CallId,
-			"protocol": req.Protocol,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -9588,16 +13988,32 @@ type SendCallSignalingDataRequest struct {
 	Data []byte `json:"data"`
 }
 
+func (req *SendCallSignalingDataRequest) encode(encoder *encoder) {
+	encoder.Int32("call_id", req.CallId)
+	encoder.Bytes("data", req.Data)
+}
+
 // Sends call signaling data
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SendCallSignalingData(req *SendCallSignalingDataRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SendCallSignalingDataContext(context.Background(), req)
+}
+
+// Sends call signaling data
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SendCallSignalingDataContext(ctx context.Context, req *SendCallSignalingDataRequest) (*Ok, error) {
+	err := client.checkUsersOnly("sendCallSignalingData")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "sendCallSignalingData",
 		},
-		Data: map[string]interface{}{
-			"call_id": req.CallId,
-			"data":    req.Data,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -9623,19 +14039,35 @@ type DiscardCallRequest struct {
 	ConnectionId JsonInt64 `json:"connection_id"`
 }
 
+func (req *DiscardCallRequest) encode(encoder *encoder) {
+	encoder.Int32("call_id", req.CallId)
+	encoder.Bool("is_disconnected", req.IsDisconnected)
+	encoder.Int32("duration", req.Duration)
+	encoder.Bool("is_video", req.IsVideo)
+	encoder.JsonInt64("connection_id", req.ConnectionId)
+}
+
 // Discards a call
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) DiscardCall(req *DiscardCallRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.DiscardCallContext(context.Background(), req)
+}
+
+// Discards a call
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) DiscardCallContext(ctx context.Context, req *DiscardCallRequest) (*Ok, error) {
+	err := client.checkUsersOnly("discardCall")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "discardCall",
 		},
-		Data: map[string]interface{}{
-			"call_id":         req.CallId,
-			"is_disconnected": req.IsDisconnected,
-			"duration":        req.Duration,
-			"is_video":        req.IsVideo,
-			"connection_id":   req.ConnectionId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -9659,18 +14091,35 @@ type SendCallRatingRequest struct {
 	Problems []CallProblem `json:"problems"`
 }
 
+func (req *SendCallRatingRequest) encode(encoder *encoder) {
+	encoder.Int32("call_id", req.CallId)
+	encoder.Int32("rating", req.Rating)
+	encoder.String("comment", req.Comment)
+	encoder.name("problems")
+	encodeListOfCallProblem(encoder, req.Problems)
+}
+
 // Sends a call rating
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SendCallRating(req *SendCallRatingRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SendCallRatingContext(context.Background(), req)
+}
+
+// Sends a call rating
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SendCallRatingContext(ctx context.Context, req *SendCallRatingRequest) (*Ok, error) {
+	err := client.checkUsersOnly("sendCallRating")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "sendCallRating",
 		},
-		Data: map[string]interface{}{
-			"call_id":  req.CallId,
-			"rating":   req.Rating,
-			"comment":  req.Comment,
-			"problems": req.Problems,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -9690,16 +14139,32 @@ type SendCallDebugInformationRequest struct {
 	DebugInformation string `json:"debug_information"`
 }
 
+func (req *SendCallDebugInformationRequest) encode(encoder *encoder) {
+	encoder.Int32("call_id", req.CallId)
+	encoder.String("debug_information", req.DebugInformation)
+}
+
 // Sends debug information for a call to Telegram servers
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SendCallDebugInformation(req *SendCallDebugInformationRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SendCallDebugInformationContext(context.Background(), req)
+}
+
+// Sends debug information for a call to Telegram servers
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SendCallDebugInformationContext(ctx context.Context, req *SendCallDebugInformationRequest) (*Ok, error) {
+	err := client.checkUsersOnly("sendCallDebugInformation")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "sendCallDebugInformation",
 		},
-		Data: map[string]interface{}{
-			"call_id":           req.CallId,
-			"debug_information": req.DebugInformation,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -9719,16 +14184,33 @@ type SendCallLogRequest struct {
 	LogFile InputFile `json:"log_file"`
 }
 
+func (req *SendCallLogRequest) encode(encoder *encoder) {
+	encoder.Int32("call_id", req.CallId)
+	encoder.name("log_file")
+	encodeInputFile(encoder, req.LogFile)
+}
+
 // Sends log file for a call to Telegram servers
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SendCallLog(req *SendCallLogRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SendCallLogContext(context.Background(), req)
+}
+
+// Sends log file for a call to Telegram servers
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SendCallLogContext(ctx context.Context, req *SendCallLogRequest) (*Ok, error) {
+	err := client.checkUsersOnly("sendCallLog")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "sendCallLog",
 		},
-		Data: map[string]interface{}{
-			"call_id":  req.CallId,
-			"log_file": req.LogFile,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -9746,15 +14228,35 @@ type GetVideoChatAvailableParticipantsRequest struct {
 	ChatId int64 `json:"chat_id"`
 }
 
+func (req *GetVideoChatAvailableParticipantsRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+}
+
+func (req *GetVideoChatAvailableParticipantsRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns list of participant identifiers, on whose behalf a video chat in the chat can be joined
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetVideoChatAvailableParticipants(req *GetVideoChatAvailableParticipantsRequest) (*MessageSenders, error) {
-	result, err := client.Send(Request{
+	return client.GetVideoChatAvailableParticipantsContext(context.Background(), req)
+}
+
+// Returns list of participant identifiers, on whose behalf a video chat in the chat can be joined
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetVideoChatAvailableParticipantsContext(ctx context.Context, req *GetVideoChatAvailableParticipantsRequest) (*MessageSenders, error) {
+	err := client.checkUsersOnly("getVideoChatAvailableParticipants")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getVideoChatAvailableParticipants",
 		},
-		Data: map[string]interface{}{
-			"chat_id": req.ChatId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -9774,16 +14276,37 @@ type SetVideoChatDefaultParticipantRequest struct {
 	DefaultParticipantId MessageSender `json:"default_participant_id"`
 }
 
+func (req *SetVideoChatDefaultParticipantRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.name("default_participant_id")
+	encodeMessageSender(encoder, req.DefaultParticipantId)
+}
+
+func (req *SetVideoChatDefaultParticipantRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Changes default participant identifier, on whose behalf a video chat in the chat will be joined
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SetVideoChatDefaultParticipant(req *SetVideoChatDefaultParticipantRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetVideoChatDefaultParticipantContext(context.Background(), req)
+}
+
+// Changes default participant identifier, on whose behalf a video chat in the chat will be joined
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SetVideoChatDefaultParticipantContext(ctx context.Context, req *SetVideoChatDefaultParticipantRequest) (*Ok, error) {
+	err := client.checkUsersOnly("setVideoChatDefaultParticipant")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setVideoChatDefaultParticipant",
 		},
-		Data: map[string]interface{}{
-			"chat_id":                req.ChatId,
-			"default_participant_id": req.DefaultParticipantId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -9807,18 +14330,38 @@ type CreateVideoChatRequest struct {
 	IsRtmpStream bool `json:"is_rtmp_stream"`
 }
 
+func (req *CreateVideoChatRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.String("title", req.Title)
+	encoder.Int32("start_date", req.StartDate)
+	encoder.Bool("is_rtmp_stream", req.IsRtmpStream)
+}
+
+func (req *CreateVideoChatRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Creates a video chat (a group call bound to a chat). Available only for basic groups, supergroups and channels; requires can_manage_video_chats rights
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) CreateVideoChat(req *CreateVideoChatRequest) (*GroupCallId, error) {
-	result, err := client.Send(Request{
+	return client.CreateVideoChatContext(context.Background(), req)
+}
+
+// Creates a video chat (a group call bound to a chat). Available only for basic groups, supergroups and channels; requires can_manage_video_chats rights
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) CreateVideoChatContext(ctx context.Context, req *CreateVideoChatRequest) (*GroupCallId, error) {
+	err := client.checkUsersOnly("createVideoChat")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "createVideoChat",
 		},
-		Data: map[string]interface{}{
-			"chat_id":        req.ChatId,
-			"title":          req.Title,
-			"start_date":     req.StartDate,
-			"is_rtmp_stream": req.IsRtmpStream,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -9836,15 +14379,35 @@ type GetVideoChatRtmpUrlRequest struct {
 	ChatId int64 `json:"chat_id"`
 }
 
+func (req *GetVideoChatRtmpUrlRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+}
+
+func (req *GetVideoChatRtmpUrlRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns RTMP URL for streaming to the chat; requires creator privileges
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetVideoChatRtmpUrl(req *GetVideoChatRtmpUrlRequest) (*RtmpUrl, error) {
-	result, err := client.Send(Request{
+	return client.GetVideoChatRtmpUrlContext(context.Background(), req)
+}
+
+// Returns RTMP URL for streaming to the chat; requires creator privileges
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetVideoChatRtmpUrlContext(ctx context.Context, req *GetVideoChatRtmpUrlRequest) (*RtmpUrl, error) {
+	err := client.checkUsersOnly("getVideoChatRtmpUrl")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getVideoChatRtmpUrl",
 		},
-		Data: map[string]interface{}{
-			"chat_id": req.ChatId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -9862,15 +14425,35 @@ type ReplaceVideoChatRtmpUrlRequest struct {
 	ChatId int64 `json:"chat_id"`
 }
 
+func (req *ReplaceVideoChatRtmpUrlRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+}
+
+func (req *ReplaceVideoChatRtmpUrlRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Replaces the current RTMP URL for streaming to the chat; requires creator privileges
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ReplaceVideoChatRtmpUrl(req *ReplaceVideoChatRtmpUrlRequest) (*RtmpUrl, error) {
-	result, err := client.Send(Request{
+	return client.ReplaceVideoChatRtmpUrlContext(context.Background(), req)
+}
+
+// Replaces the current RTMP URL for streaming to the chat; requires creator privileges
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ReplaceVideoChatRtmpUrlContext(ctx context.Context, req *ReplaceVideoChatRtmpUrlRequest) (*RtmpUrl, error) {
+	err := client.checkUsersOnly("replaceVideoChatRtmpUrl")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "replaceVideoChatRtmpUrl",
 		},
-		Data: map[string]interface{}{
-			"chat_id": req.ChatId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -9888,15 +14471,31 @@ type GetGroupCallRequest struct {
 	GroupCallId int32 `json:"group_call_id"`
 }
 
+func (req *GetGroupCallRequest) encode(encoder *encoder) {
+	encoder.Int32("group_call_id", req.GroupCallId)
+}
+
 // Returns information about a group call
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetGroupCall(req *GetGroupCallRequest) (*GroupCall, error) {
-	result, err := client.Send(Request{
+	return client.GetGroupCallContext(context.Background(), req)
+}
+
+// Returns information about a group call
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetGroupCallContext(ctx context.Context, req *GetGroupCallRequest) (*GroupCall, error) {
+	err := client.checkUsersOnly("getGroupCall")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getGroupCall",
 		},
-		Data: map[string]interface{}{
-			"group_call_id": req.GroupCallId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -9914,15 +14513,31 @@ type StartScheduledGroupCallRequest struct {
 	GroupCallId int32 `json:"group_call_id"`
 }
 
+func (req *StartScheduledGroupCallRequest) encode(encoder *encoder) {
+	encoder.Int32("group_call_id", req.GroupCallId)
+}
+
 // Starts a scheduled group call
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) StartScheduledGroupCall(req *StartScheduledGroupCallRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.StartScheduledGroupCallContext(context.Background(), req)
+}
+
+// Starts a scheduled group call
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) StartScheduledGroupCallContext(ctx context.Context, req *StartScheduledGroupCallRequest) (*Ok, error) {
+	err := client.checkUsersOnly("startScheduledGroupCall")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "startScheduledGroupCall",
 		},
-		Data: map[string]interface{}{
-			"group_call_id": req.GroupCallId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -9942,16 +14557,32 @@ type ToggleGroupCallEnabledStartNotificationRequest struct {
 	EnabledStartNotification bool `json:"enabled_start_notification"`
 }
 
+func (req *ToggleGroupCallEnabledStartNotificationRequest) encode(encoder *encoder) {
+	encoder.Int32("group_call_id", req.GroupCallId)
+	encoder.Bool("enabled_start_notification", req.EnabledStartNotification)
+}
+
 // Toggles whether the current user will receive a notification when the group call will start; scheduled group calls only
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ToggleGroupCallEnabledStartNotification(req *ToggleGroupCallEnabledStartNotificationRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ToggleGroupCallEnabledStartNotificationContext(context.Background(), req)
+}
+
+// Toggles whether the current user will receive a notification when the group call will start; scheduled group calls only
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ToggleGroupCallEnabledStartNotificationContext(ctx context.Context, req *ToggleGroupCallEnabledStartNotificationRequest) (*Ok, error) {
+	err := client.checkUsersOnly("toggleGroupCallEnabledStartNotification")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "toggleGroupCallEnabledStartNotification",
 		},
-		Data: map[string]interface{}{
-			"group_call_id":              req.GroupCallId,
-			"enabled_start_notification": req.EnabledStartNotification,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -9981,21 +14612,38 @@ type JoinGroupCallRequest struct {
 	InviteHash string `json:"invite_hash"`
 }
 
+func (req *JoinGroupCallRequest) encode(encoder *encoder) {
+	encoder.Int32("group_call_id", req.GroupCallId)
+	encoder.name("participant_id")
+	encodeMessageSender(encoder, req.ParticipantId)
+	encoder.Int32("audio_source_id", req.AudioSourceId)
+	encoder.String("payload", req.Payload)
+	encoder.Bool("is_muted", req.IsMuted)
+	encoder.Bool("is_my_video_enabled", req.IsMyVideoEnabled)
+	encoder.String("invite_hash", req.InviteHash)
+}
+
 // Joins an active group call. Returns join response payload for tgcalls
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) JoinGroupCall(req *JoinGroupCallRequest) (*Text, error) {
-	result, err := client.Send(Request{
+	return client.JoinGroupCallContext(context.Background(), req)
+}
+
+// Joins an active group call. Returns join response payload for tgcalls
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) JoinGroupCallContext(ctx context.Context, req *JoinGroupCallRequest) (*Text, error) {
+	err := client.checkUsersOnly("joinGroupCall")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "joinGroupCall",
 		},
-		Data: map[string]interface{}{
-			"group_call_id":       req.GroupCallId,
-			"participant_id":      req.ParticipantId,
-			"audio_source_id":     req.AudioSourceId,
-			"payload":             req.Payload,
-			"is_muted":            req.IsMuted,
-			"is_my_video_enabled": req.IsMyVideoEnabled,
-			"invite_hash":         req.InviteHash,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -10017,17 +14665,33 @@ type StartGroupCallScreenSharingRequest struct {
 	Payload string `json:"payload"`
 }
 
+func (req *StartGroupCallScreenSharingRequest) encode(encoder *encoder) {
+	encoder.Int32("group_call_id", req.GroupCallId)
+	encoder.Int32("audio_source_id", req.AudioSourceId)
+	encoder.String("payload", req.Payload)
+}
+
 // Starts screen sharing in a joined group call. Returns join response payload for tgcalls
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) StartGroupCallScreenSharing(req *StartGroupCallScreenSharingRequest) (*Text, error) {
-	result, err := client.Send(Request{
+	return client.StartGroupCallScreenSharingContext(context.Background(), req)
+}
+
+// Starts screen sharing in a joined group call. Returns join response payload for tgcalls
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) StartGroupCallScreenSharingContext(ctx context.Context, req *StartGroupCallScreenSharingRequest) (*Text, error) {
+	err := client.checkUsersOnly("startGroupCallScreenSharing")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "startGroupCallScreenSharing",
 		},
-		Data: map[string]interface{}{
-			"group_call_id":   req.GroupCallId,
-			"audio_source_id": req.AudioSourceId,
-			"payload":         req.Payload,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -10047,16 +14711,32 @@ type ToggleGroupCallScreenSharingIsPausedRequest struct {
 	IsPaused bool `json:"is_paused"`
 }
 
+func (req *ToggleGroupCallScreenSharingIsPausedRequest) encode(encoder *encoder) {
+	encoder.Int32("group_call_id", req.GroupCallId)
+	encoder.Bool("is_paused", req.IsPaused)
+}
+
 // Pauses or unpauses screen sharing in a joined group call
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ToggleGroupCallScreenSharingIsPaused(req *ToggleGroupCallScreenSharingIsPausedRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ToggleGroupCallScreenSharingIsPausedContext(context.Background(), req)
+}
+
+// Pauses or unpauses screen sharing in a joined group call
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ToggleGroupCallScreenSharingIsPausedContext(ctx context.Context, req *ToggleGroupCallScreenSharingIsPausedRequest) (*Ok, error) {
+	err := client.checkUsersOnly("toggleGroupCallScreenSharingIsPaused")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "toggleGroupCallScreenSharingIsPaused",
 		},
-		Data: map[string]interface{}{
-			"group_call_id": req.GroupCallId,
-			"is_paused":     req.IsPaused,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -10074,15 +14754,31 @@ type EndGroupCallScreenSharingRequest struct {
 	GroupCallId int32 `json:"group_call_id"`
 }
 
+func (req *EndGroupCallScreenSharingRequest) encode(encoder *encoder) {
+	encoder.Int32("group_call_id", req.GroupCallId)
+}
+
 // Ends screen sharing in a joined group call
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) EndGroupCallScreenSharing(req *EndGroupCallScreenSharingRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.EndGroupCallScreenSharingContext(context.Background(), req)
+}
+
+// Ends screen sharing in a joined group call
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) EndGroupCallScreenSharingContext(ctx context.Context, req *EndGroupCallScreenSharingRequest) (*Ok, error) {
+	err := client.checkUsersOnly("endGroupCallScreenSharing")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "endGroupCallScreenSharing",
 		},
-		Data: map[string]interface{}{
-			"group_call_id": req.GroupCallId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -10102,16 +14798,32 @@ type SetGroupCallTitleRequest struct {
 	Title string `json:"title"`
 }
 
+func (req *SetGroupCallTitleRequest) encode(encoder *encoder) {
+	encoder.Int32("group_call_id", req.GroupCallId)
+	encoder.String("title", req.Title)
+}
+
 // Sets group call title. Requires groupCall.can_be_managed group call flag
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SetGroupCallTitle(req *SetGroupCallTitleRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetGroupCallTitleContext(context.Background(), req)
+}
+
+// Sets group call title. Requires groupCall.can_be_managed group call flag
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SetGroupCallTitleContext(ctx context.Context, req *SetGroupCallTitleRequest) (*Ok, error) {
+	err := client.checkUsersOnly("setGroupCallTitle")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setGroupCallTitle",
 		},
-		Data: map[string]interface{}{
-			"group_call_id": req.GroupCallId,
-			"title":         req.Title,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -10131,16 +14843,32 @@ type ToggleGroupCallMuteNewParticipantsRequest struct {
 	MuteNewParticipants bool `json:"mute_new_participants"`
 }
 
+func (req *ToggleGroupCallMuteNewParticipantsRequest) encode(encoder *encoder) {
+	encoder.Int32("group_call_id", req.GroupCallId)
+	encoder.Bool("mute_new_participants", req.MuteNewParticipants)
+}
+
 // Toggles whether new participants of a group call can be unmuted only by administrators of the group call. Requires groupCall.can_toggle_mute_new_participants group call flag
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ToggleGroupCallMuteNewParticipants(req *ToggleGroupCallMuteNewParticipantsRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ToggleGroupCallMuteNewParticipantsContext(context.Background(), req)
+}
+
+// Toggles whether new participants of a group call can be unmuted only by administrators of the group call. Requires groupCall.can_toggle_mute_new_participants group call flag
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ToggleGroupCallMuteNewParticipantsContext(ctx context.Context, req *ToggleGroupCallMuteNewParticipantsRequest) (*Ok, error) {
+	err := client.checkUsersOnly("toggleGroupCallMuteNewParticipants")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "toggleGroupCallMuteNewParticipants",
 		},
-		Data: map[string]interface{}{
-			"group_call_id":         req.GroupCallId,
-			"mute_new_participants": req.MuteNewParticipants,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -10160,16 +14888,32 @@ type InviteGroupCallParticipantsRequest struct {
 	UserIds []int64 `json:"user_ids"`
 }
 
+func (req *InviteGroupCallParticipantsRequest) encode(encoder *encoder) {
+	encoder.Int32("group_call_id", req.GroupCallId)
+	encoder.Int64s("user_ids", req.UserIds)
+}
+
 // Invites users to an active group call. Sends a service message of type messageInviteToGroupCall for video chats
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) InviteGroupCallParticipants(req *InviteGroupCallParticipantsRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.InviteGroupCallParticipantsContext(context.Background(), req)
+}
+
+// Invites users to an active group call. Sends a service message of type messageInviteToGroupCall for video chats
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) InviteGroupCallParticipantsContext(ctx context.Context, req *InviteGroupCallParticipantsRequest) (*Ok, error) {
+	err := client.checkUsersOnly("inviteGroupCallParticipants")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "inviteGroupCallParticipants",
 		},
-		Data: map[string]interface{}{
-			"group_call_id": req.GroupCallId,
-			"user_ids":      req.UserIds,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -10189,16 +14933,32 @@ type GetGroupCallInviteLinkRequest struct {
 	CanSelfUnmute bool `json:"can_self_unmute"`
 }
 
+func (req *GetGroupCallInviteLinkRequest) encode(encoder *encoder) {
+	encoder.Int32("group_call_id", req.GroupCallId)
+	encoder.Bool("can_self_unmute", req.CanSelfUnmute)
+}
+
 // Returns invite link to a video chat in a public chat
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetGroupCallInviteLink(req *GetGroupCallInviteLinkRequest) (*HttpUrl, error) {
-	result, err := client.Send(Request{
-		meta: meta{
-			Type: "getGroupCallInviteLink",
-		},
-		Data: map[string]interface{}{
-			"group_call_id":   req.GroupCallId,
-			"can_self_unmute": req.CanSelfUnmute,
+	return client.GetGroupCallInviteLinkContext(context.Background(), req)
+}
+
+// Returns invite link to a video chat in a public chat
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetGroupCallInviteLinkContext(ctx context.Context, req *GetGroupCallInviteLinkRequest) (*HttpUrl, error) {
+	err := client.checkUsersOnly("getGroupCallInviteLink")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
+		meta: meta{
+			Type: "getGroupCallInviteLink",
 		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -10216,15 +14976,31 @@ type RevokeGroupCallInviteLinkRequest struct {
 	GroupCallId int32 `json:"group_call_id"`
 }
 
+func (req *RevokeGroupCallInviteLinkRequest) encode(encoder *encoder) {
+	encoder.Int32("group_call_id", req.GroupCallId)
+}
+
 // Revokes invite link for a group call. Requires groupCall.can_be_managed group call flag
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) RevokeGroupCallInviteLink(req *RevokeGroupCallInviteLinkRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.RevokeGroupCallInviteLinkContext(context.Background(), req)
+}
+
+// Revokes invite link for a group call. Requires groupCall.can_be_managed group call flag
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) RevokeGroupCallInviteLinkContext(ctx context.Context, req *RevokeGroupCallInviteLinkRequest) (*Ok, error) {
+	err := client.checkUsersOnly("revokeGroupCallInviteLink")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "revokeGroupCallInviteLink",
 		},
-		Data: map[string]interface{}{
-			"group_call_id": req.GroupCallId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -10248,18 +15024,34 @@ type StartGroupCallRecordingRequest struct {
 	UsePortraitOrientation bool `json:"use_portrait_orientation"`
 }
 
+func (req *StartGroupCallRecordingRequest) encode(encoder *encoder) {
+	encoder.Int32("group_call_id", req.GroupCallId)
+	encoder.String("title", req.Title)
+	encoder.Bool("record_video", req.RecordVideo)
+	encoder.Bool("use_portrait_orientation", req.UsePortraitOrientation)
+}
+
 // Starts recording of an active group call. Requires groupCall.can_be_managed group call flag
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) StartGroupCallRecording(req *StartGroupCallRecordingRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.StartGroupCallRecordingContext(context.Background(), req)
+}
+
+// Starts recording of an active group call. Requires groupCall.can_be_managed group call flag
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) StartGroupCallRecordingContext(ctx context.Context, req *StartGroupCallRecordingRequest) (*Ok, error) {
+	err := client.checkUsersOnly("startGroupCallRecording")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "startGroupCallRecording",
 		},
-		Data: map[string]interface{}{
-			"group_call_id":            req.GroupCallId,
-			"title":                    req.Title,
-			"record_video":             req.RecordVideo,
-			"use_portrait_orientation": req.UsePortraitOrientation,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -10277,15 +15069,31 @@ type EndGroupCallRecordingRequest struct {
 	GroupCallId int32 `json:"group_call_id"`
 }
 
+func (req *EndGroupCallRecordingRequest) encode(encoder *encoder) {
+	encoder.Int32("group_call_id", req.GroupCallId)
+}
+
 // Ends recording of an active group call. Requires groupCall.can_be_managed group call flag
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) EndGroupCallRecording(req *EndGroupCallRecordingRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.EndGroupCallRecordingContext(context.Background(), req)
+}
+
+// Ends recording of an active group call. Requires groupCall.can_be_managed group call flag
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) EndGroupCallRecordingContext(ctx context.Context, req *EndGroupCallRecordingRequest) (*Ok, error) {
+	err := client.checkUsersOnly("endGroupCallRecording")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "endGroupCallRecording",
 		},
-		Data: map[string]interface{}{
-			"group_call_id": req.GroupCallId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -10305,16 +15113,32 @@ type ToggleGroupCallIsMyVideoPausedRequest struct {
 	IsMyVideoPaused bool `json:"is_my_video_paused"`
 }
 
+func (req *ToggleGroupCallIsMyVideoPausedRequest) encode(encoder *encoder) {
+	encoder.Int32("group_call_id", req.GroupCallId)
+	encoder.Bool("is_my_video_paused", req.IsMyVideoPaused)
+}
+
 // Toggles whether current user's video is paused
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ToggleGroupCallIsMyVideoPaused(req *ToggleGroupCallIsMyVideoPausedRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ToggleGroupCallIsMyVideoPausedContext(context.Background(), req)
+}
+
+// Toggles whether current user's video is paused
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ToggleGroupCallIsMyVideoPausedContext(ctx context.Context, req *ToggleGroupCallIsMyVideoPausedRequest) (*Ok, error) {
+	err := client.checkUsersOnly("toggleGroupCallIsMyVideoPaused")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "toggleGroupCallIsMyVideoPaused",
 		},
-		Data: map[string]interface{}{
-			"group_call_id":      req.GroupCallId,
-			"is_my_video_paused": req.IsMyVideoPaused,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -10334,16 +15158,32 @@ type ToggleGroupCallIsMyVideoEnabledRequest struct {
 	IsMyVideoEnabled bool `json:"is_my_video_enabled"`
 }
 
+func (req *ToggleGroupCallIsMyVideoEnabledRequest) encode(encoder *encoder) {
+	encoder.Int32("group_call_id", req.GroupCallId)
+	encoder.Bool("is_my_video_enabled", req.IsMyVideoEnabled)
+}
+
 // Toggles whether current user's video is enabled
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ToggleGroupCallIsMyVideoEnabled(req *ToggleGroupCallIsMyVideoEnabledRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ToggleGroupCallIsMyVideoEnabledContext(context.Background(), req)
+}
+
+// Toggles whether current user's video is enabled
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ToggleGroupCallIsMyVideoEnabledContext(ctx context.Context, req *ToggleGroupCallIsMyVideoEnabledRequest) (*Ok, error) {
+	err := client.checkUsersOnly("toggleGroupCallIsMyVideoEnabled")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "toggleGroupCallIsMyVideoEnabled",
 		},
-		Data: map[string]interface{}{
-			"group_call_id":       req.GroupCallId,
-			"is_my_video_enabled": req.IsMyVideoEnabled,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -10365,17 +15205,33 @@ type SetGroupCallParticipantIsSpeakingRequest struct {
 	IsSpeaking bool `json:"is_speaking"`
 }
 
+func (req *SetGroupCallParticipantIsSpeakingRequest) encode(encoder *encoder) {
+	encoder.Int32("group_call_id", req.GroupCallId)
+	encoder.Int32("audio_source", req.AudioSource)
+	encoder.Bool("is_speaking", req.IsSpeaking)
+}
+
 // Informs TDLib that speaking state of a participant of an active group has changed
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SetGroupCallParticipantIsSpeaking(req *SetGroupCallParticipantIsSpeakingRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetGroupCallParticipantIsSpeakingContext(context.Background(), req)
+}
+
+// Informs TDLib that speaking state of a participant of an active group has changed
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SetGroupCallParticipantIsSpeakingContext(ctx context.Context, req *SetGroupCallParticipantIsSpeakingRequest) (*Ok, error) {
+	err := client.checkUsersOnly("setGroupCallParticipantIsSpeaking")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setGroupCallParticipantIsSpeaking",
 		},
-		Data: map[string]interface{}{
-			"group_call_id": req.GroupCallId,
-			"audio_source":  req.AudioSource,
-			"is_speaking":   req.IsSpeaking,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -10397,17 +15253,34 @@ type ToggleGroupCallParticipantIsMutedRequest struct {
 	IsMuted bool `json:"is_muted"`
 }
 
+func (req *ToggleGroupCallParticipantIsMutedRequest) encode(encoder *encoder) {
+	encoder.Int32("group_call_id", req.GroupCallId)
+	encoder.name("participant_id")
+	encodeMessageSender(encoder, req.ParticipantId)
+	encoder.Bool("is_muted", req.IsMuted)
+}
+
 // Toggles whether a participant of an active group call is muted, unmuted, or allowed to unmute themselves
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ToggleGroupCallParticipantIsMuted(req *ToggleGroupCallParticipantIsMutedRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ToggleGroupCallParticipantIsMutedContext(context.Background(), req)
+}
+
+// Toggles whether a participant of an active group call is muted, unmuted, or allowed to unmute themselves
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ToggleGroupCallParticipantIsMutedContext(ctx context.Context, req *ToggleGroupCallParticipantIsMutedRequest) (*Ok, error) {
+	err := client.checkUsersOnly("toggleGroupCallParticipantIsMuted")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "toggleGroupCallParticipantIsMuted",
 		},
-		Data: map[string]interface{}{
-			"group_call_id":  req.GroupCallId,
-			"participant_id": req.ParticipantId,
-			"is_muted":       req.IsMuted,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -10429,17 +15302,34 @@ type SetGroupCallParticipantVolumeLevelRequest struct {
 	VolumeLevel int32 `json:"volume_level"`
 }
 
+func (req *SetGroupCallParticipantVolumeLevelRequest) encode(encoder *encoder) {
+	encoder.Int32("group_call_id", req.GroupCallId)
+	encoder.name("participant_id")
+	encodeMessageSender(encoder, req.ParticipantId)
+	encoder.Int32("volume_level", req.VolumeLevel)
+}
+
 // Changes volume level of a participant of an active group call. If the current user can manage the group call, then the participant's volume level will be changed for all users with the default volume level
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SetGroupCallParticipantVolumeLevel(req *SetGroupCallParticipantVolumeLevelRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetGroupCallParticipantVolumeLevelContext(context.Background(), req)
+}
+
+// Changes volume level of a participant of an active group call. If the current user can manage the group call, then the participant's volume level will be changed for all users with the default volume level
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SetGroupCallParticipantVolumeLevelContext(ctx context.Context, req *SetGroupCallParticipantVolumeLevelRequest) (*Ok, error) {
+	err := client.checkUsersOnly("setGroupCallParticipantVolumeLevel")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setGroupCallParticipantVolumeLevel",
 		},
-		Data: map[string]interface{}{
-			"group_call_id":  req.GroupCallId,
-			"participant_id": req.ParticipantId,
-			"volume_level":   req.VolumeLevel,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -10461,17 +15351,34 @@ type ToggleGroupCallParticipantIsHandRaisedRequest struct {
 	IsHandRaised bool `json:"is_hand_raised"`
 }
 
+func (req *ToggleGroupCallParticipantIsHandRaisedRequest) encode(encoder *encoder) {
+	encoder.Int32("group_call_id", req.GroupCallId)
+	encoder.name("participant_id")
+	encodeMessageSender(encoder, req.ParticipantId)
+	encoder.Bool("is_hand_raised", req.IsHandRaised)
+}
+
 // Toggles whether a group call participant hand is rased
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ToggleGroupCallParticipantIsHandRaised(req *ToggleGroupCallParticipantIsHandRaisedRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ToggleGroupCallParticipantIsHandRaisedContext(context.Background(), req)
+}
+
+// Toggles whether a group call participant hand is rased
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ToggleGroupCallParticipantIsHandRaisedContext(ctx context.Context, req *ToggleGroupCallParticipantIsHandRaisedRequest) (*Ok, error) {
+	err := client.checkUsersOnly("toggleGroupCallParticipantIsHandRaised")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "toggleGroupCallParticipantIsHandRaised",
 		},
-		Data: map[string]interface{}{
-			"group_call_id":  req.GroupCallId,
-			"participant_id": req.ParticipantId,
-			"is_hand_raised": req.IsHandRaised,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -10491,16 +15398,32 @@ type LoadGroupCallParticipantsRequest struct {
 	Limit int32 `json:"limit"`
 }
 
+func (req *LoadGroupCallParticipantsRequest) encode(encoder *encoder) {
+	encoder.Int32("group_call_id", req.GroupCallId)
+	encoder.Int32("limit", req.Limit)
+}
+
 // Loads more participants of a group call. The loaded participants will be received through updates. Use the field groupCall.loaded_all_participants to check whether all participants have already been loaded
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) LoadGroupCallParticipants(req *LoadGroupCallParticipantsRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.LoadGroupCallParticipantsContext(context.Background(), req)
+}
+
+// Loads more participants of a group call. The loaded participants will be received through updates. Use the field groupCall.loaded_all_participants to check whether all participants have already been loaded
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) LoadGroupCallParticipantsContext(ctx context.Context, req *LoadGroupCallParticipantsRequest) (*Ok, error) {
+	err := client.checkUsersOnly("loadGroupCallParticipants")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "loadGroupCallParticipants",
 		},
-		Data: map[string]interface{}{
-			"group_call_id": req.GroupCallId,
-			"limit":         req.Limit,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -10518,15 +15441,31 @@ type LeaveGroupCallRequest struct {
 	GroupCallId int32 `json:"group_call_id"`
 }
 
+func (req *LeaveGroupCallRequest) encode(encoder *encoder) {
+	encoder.Int32("group_call_id", req.GroupCallId)
+}
+
 // Leaves a group call
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) LeaveGroupCall(req *LeaveGroupCallRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.LeaveGroupCallContext(context.Background(), req)
+}
+
+// Leaves a group call
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) LeaveGroupCallContext(ctx context.Context, req *LeaveGroupCallRequest) (*Ok, error) {
+	err := client.checkUsersOnly("leaveGroupCall")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "leaveGroupCall",
 		},
-		Data: map[string]interface{}{
-			"group_call_id": req.GroupCallId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -10544,15 +15483,31 @@ type EndGroupCallRequest struct {
 	GroupCallId int32 `json:"group_call_id"`
 }
 
+func (req *EndGroupCallRequest) encode(encoder *encoder) {
+	encoder.Int32("group_call_id", req.GroupCallId)
+}
+
 // Ends a group call. Requires groupCall.can_be_managed
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) EndGroupCall(req *EndGroupCallRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.EndGroupCallContext(context.Background(), req)
+}
+
+// Ends a group call. Requires groupCall.can_be_managed
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) EndGroupCallContext(ctx context.Context, req *EndGroupCallRequest) (*Ok, error) {
+	err := client.checkUsersOnly("endGroupCall")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "endGroupCall",
 		},
-		Data: map[string]interface{}{
-			"group_call_id": req.GroupCallId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -10570,15 +15525,31 @@ type GetGroupCallStreamsRequest struct {
 	GroupCallId int32 `json:"group_call_id"`
 }
 
+func (req *GetGroupCallStreamsRequest) encode(encoder *encoder) {
+	encoder.Int32("group_call_id", req.GroupCallId)
+}
+
 // Returns information about available group call streams
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetGroupCallStreams(req *GetGroupCallStreamsRequest) (*GroupCallStreams, error) {
-	result, err := client.Send(Request{
+	return client.GetGroupCallStreamsContext(context.Background(), req)
+}
+
+// Returns information about available group call streams
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetGroupCallStreamsContext(ctx context.Context, req *GetGroupCallStreamsRequest) (*GroupCallStreams, error) {
+	err := client.checkUsersOnly("getGroupCallStreams")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getGroupCallStreams",
 		},
-		Data: map[string]interface{}{
-			"group_call_id": req.GroupCallId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -10604,19 +15575,36 @@ type GetGroupCallStreamSegmentRequest struct {
 	VideoQuality GroupCallVideoQuality `json:"video_quality"`
 }
 
+func (req *GetGroupCallStreamSegmentRequest) encode(encoder *encoder) {
+	encoder.Int32("group_call_id", req.GroupCallId)
+	encoder.Int64("time_offset", req.TimeOffset)
+	encoder.Int32("scale", req.Scale)
+	encoder.Int32("channel_id", req.ChannelId)
+	encoder.name("video_quality")
+	encodeGroupCallVideoQuality(encoder, req.VideoQuality)
+}
+
 // Returns a file with a segment of a group call stream in a modified OGG format for audio or MPEG-4 format for video
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetGroupCallStreamSegment(req *GetGroupCallStreamSegmentRequest) (*FilePart, error) {
-	result, err := client.Send(Request{
+	return client.GetGroupCallStreamSegmentContext(context.Background(), req)
+}
+
+// Returns a file with a segment of a group call stream in a modified OGG format for audio or MPEG-4 format for video
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetGroupCallStreamSegmentContext(ctx context.Context, req *GetGroupCallStreamSegmentRequest) (*FilePart, error) {
+	err := client.checkUsersOnly("getGroupCallStreamSegment")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getGroupCallStreamSegment",
 		},
-		Data: map[string]interface{}{
-			"group_call_id": req.GroupCallId,
-			"time_offset":   req.TimeOffset,
-			"scale":         req.Scale,
-			"channel_id":    req.ChannelId,
-			"video_quality": req.VideoQuality,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -10636,16 +15624,33 @@ type ToggleMessageSenderIsBlockedRequest struct {
 	IsBlocked bool `json:"is_blocked"`
 }
 
+func (req *ToggleMessageSenderIsBlockedRequest) encode(encoder *encoder) {
+	encoder.name("sender_id")
+	encodeMessageSender(encoder, req.SenderId)
+	encoder.Bool("is_blocked", req.IsBlocked)
+}
+
 // Changes the block state of a message sender. Currently, only users and supergroup chats can be blocked
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ToggleMessageSenderIsBlocked(req *ToggleMessageSenderIsBlockedRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ToggleMessageSenderIsBlockedContext(context.Background(), req)
+}
+
+// Changes the block state of a message sender. Currently, only users and supergroup chats can be blocked
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ToggleMessageSenderIsBlockedContext(ctx context.Context, req *ToggleMessageSenderIsBlockedRequest) (*Ok, error) {
+	err := client.checkUsersOnly("toggleMessageSenderIsBlocked")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "toggleMessageSenderIsBlocked",
 		},
-		Data: map[string]interface{}{
-			"sender_id":  req.SenderId,
-			"is_blocked": req.IsBlocked,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -10669,18 +15674,34 @@ type BlockMessageSenderFromRepliesRequest struct {
 	ReportSpam bool `json:"report_spam"`
 }
 
+func (req *BlockMessageSenderFromRepliesRequest) encode(encoder *encoder) {
+	encoder.Int64("message_id", req.MessageId)
+	encoder.Bool("delete_message", req.DeleteMessage)
+	encoder.Bool("delete_all_messages", req.DeleteAllMessages)
+	encoder.Bool("report_spam", req.ReportSpam)
+}
+
 // Blocks an original sender of a message in the Replies chat
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) BlockMessageSenderFromReplies(req *BlockMessageSenderFromRepliesRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.BlockMessageSenderFromRepliesContext(context.Background(), req)
+}
+
+// Blocks an original sender of a message in the Replies chat
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) BlockMessageSenderFromRepliesContext(ctx context.Context, req *BlockMessageSenderFromRepliesRequest) (*Ok, error) {
+	err := client.checkUsersOnly("blockMessageSenderFromReplies")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "blockMessageSenderFromReplies",
 		},
-		Data: map[string]interface{}{
-			"message_id":          req.MessageId,
-			"delete_message":      req.DeleteMessage,
-			"delete_all_messages": req.DeleteAllMessages,
-			"report_spam":         req.ReportSpam,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -10700,16 +15721,32 @@ type GetBlockedMessageSendersRequest struct {
 	Limit int32 `json:"limit"`
 }
 
+func (req *GetBlockedMessageSendersRequest) encode(encoder *encoder) {
+	encoder.Int32("offset", req.Offset)
+	encoder.Int32("limit", req.Limit)
+}
+
 // Returns users and chats that were blocked by the current user
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetBlockedMessageSenders(req *GetBlockedMessageSendersRequest) (*MessageSenders, error) {
-	result, err := client.Send(Request{
+	return client.GetBlockedMessageSendersContext(context.Background(), req)
+}
+
+// Returns users and chats that were blocked by the current user
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetBlockedMessageSendersContext(ctx context.Context, req *GetBlockedMessageSendersRequest) (*MessageSenders, error) {
+	err := client.checkUsersOnly("getBlockedMessageSenders")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getBlockedMessageSenders",
 		},
-		Data: map[string]interface{}{
-			"offset": req.Offset,
-			"limit":  req.Limit,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -10729,16 +15766,33 @@ type AddContactRequest struct {
 	SharePhoneNumber bool `json:"share_phone_number"`
 }
 
+func (req *AddContactRequest) encode(encoder *encoder) {
+	encoder.name("contact")
+	encodeContact(encoder, req.Contact)
+	encoder.Bool("share_phone_number", req.SharePhoneNumber)
+}
+
 // Adds a user to the contact list or edits an existing contact by their user identifier
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) AddContact(req *AddContactRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.AddContactContext(context.Background(), req)
+}
+
+// Adds a user to the contact list or edits an existing contact by their user identifier
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) AddContactContext(ctx context.Context, req *AddContactRequest) (*Ok, error) {
+	err := client.checkUsersOnly("addContact")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "addContact",
 		},
-		Data: map[string]interface{}{
-			"contact":            req.Contact,
-			"share_phone_number": req.SharePhoneNumber,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -10756,15 +15810,32 @@ type ImportContactsRequest struct {
 	Contacts []*Contact `json:"contacts"`
 }
 
+func (req *ImportContactsRequest) encode(encoder *encoder) {
+	encoder.name("contacts")
+	encodeListOfContact(encoder, req.Contacts)
+}
+
 // Adds new contacts or edits existing contacts by their phone numbers; contacts' user identifiers are ignored
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ImportContacts(req *ImportContactsRequest) (*ImportedContacts, error) {
-	result, err := client.Send(Request{
+	return client.ImportContactsContext(context.Background(), req)
+}
+
+// Adds new contacts or edits existing contacts by their phone numbers; contacts' user identifiers are ignored
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ImportContactsContext(ctx context.Context, req *ImportContactsRequest) (*ImportedContacts, error) {
+	err := client.checkUsersOnly("importContacts")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "importContacts",
 		},
-		Data: map[string]interface{}{
-			"contacts": req.Contacts,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -10778,12 +15849,25 @@ func (client *Client) ImportContacts(req *ImportContactsRequest) (*ImportedConta
 }
 
 // Returns all user contacts
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetContacts() (*Users, error) {
-	result, err := client.Send(Request{
+	return client.GetContactsContext(context.Background())
+}
+
+// Returns all user contacts
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetContactsContext(ctx context.Context) (*Users, error) {
+	err := client.checkUsersOnly("getContacts")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getContacts",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -10803,16 +15887,32 @@ type SearchContactsRequest struct {
 	Limit int32 `json:"limit"`
 }
 
+func (req *SearchContactsRequest) encode(encoder *encoder) {
+	encoder.String("query", req.Query)
+	encoder.Int32("limit", req.Limit)
+}
+
 // Searches for the specified query in the first names, last names and usernames of the known user contacts
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SearchContacts(req *SearchContactsRequest) (*Users, error) {
-	result, err := client.Send(Request{
+	return client.SearchContactsContext(context.Background(), req)
+}
+
+// Searches for the specified query in the first names, last names and usernames of the known user contacts
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SearchContactsContext(ctx context.Context, req *SearchContactsRequest) (*Users, error) {
+	err := client.checkUsersOnly("searchContacts")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "searchContacts",
 		},
-		Data: map[string]interface{}{
-			"query": req.Query,
-			"limit": req.Limit,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -10830,15 +15930,31 @@ type RemoveContactsRequest struct {
 	UserIds []int64 `json:"user_ids"`
 }
 
+func (req *RemoveContactsRequest) encode(encoder *encoder) {
+	encoder.Int64s("user_ids", req.UserIds)
+}
+
 // Removes users from the contact list
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) RemoveContacts(req *RemoveContactsRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.RemoveContactsContext(context.Background(), req)
+}
+
+// Removes users from the contact list
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) RemoveContactsContext(ctx context.Context, req *RemoveContactsRequest) (*Ok, error) {
+	err := client.checkUsersOnly("removeContacts")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "removeContacts",
 		},
-		Data: map[string]interface{}{
-			"user_ids": req.UserIds,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -10852,12 +15968,25 @@ func (client *Client) RemoveContacts(req *RemoveContactsRequest) (*Ok, error) {
 }
 
 // Returns the total number of imported contacts
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetImportedContactCount() (*Count, error) {
-	result, err := client.Send(Request{
+	return client.GetImportedContactCountContext(context.Background())
+}
+
+// Returns the total number of imported contacts
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetImportedContactCountContext(ctx context.Context) (*Count, error) {
+	err := client.checkUsersOnly("getImportedContactCount")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getImportedContactCount",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -10875,15 +16004,32 @@ type ChangeImportedContactsRequest struct {
 	Contacts []*Contact `json:"contacts"`
 }
 
+func (req *ChangeImportedContactsRequest) encode(encoder *encoder) {
+	encoder.name("contacts")
+	encodeListOfContact(encoder, req.Contacts)
+}
+
 // Changes imported contacts using the list of contacts saved on the device. Imports newly added contacts and, if at least the file database is enabled, deletes recently deleted contacts. Query result depends on the result of the previous query, so only one query is possible at the same time
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ChangeImportedContacts(req *ChangeImportedContactsRequest) (*ImportedContacts, error) {
-	result, err := client.Send(Request{
+	return client.ChangeImportedContactsContext(context.Background(), req)
+}
+
+// Changes imported contacts using the list of contacts saved on the device. Imports newly added contacts and, if at least the file database is enabled, deletes recently deleted contacts. Query result depends on the result of the previous query, so only one query is possible at the same time
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ChangeImportedContactsContext(ctx context.Context, req *ChangeImportedContactsRequest) (*ImportedContacts, error) {
+	err := client.checkUsersOnly("changeImportedContacts")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "changeImportedContacts",
 		},
-		Data: map[string]interface{}{
-			"contacts": req.Contacts,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -10897,12 +16043,25 @@ func (client *Client) ChangeImportedContacts(req *ChangeImportedContactsRequest)
 }
 
 // Clears all imported contacts, contact list remains unchanged
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ClearImportedContacts() (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ClearImportedContactsContext(context.Background())
+}
+
+// Clears all imported contacts, contact list remains unchanged
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ClearImportedContactsContext(ctx context.Context) (*Ok, error) {
+	err := client.checkUsersOnly("clearImportedContacts")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "clearImportedContacts",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -10922,16 +16081,33 @@ type SetUserPersonalProfilePhotoRequest struct {
 	Photo InputChatPhoto `json:"photo"`
 }
 
+func (req *SetUserPersonalProfilePhotoRequest) encode(encoder *encoder) {
+	encoder.Int64("user_id", req.UserId)
+	encoder.name("photo")
+	encodeInputChatPhoto(encoder, req.Photo)
+}
+
 // Changes a personal profile photo of a contact user
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SetUserPersonalProfilePhoto(req *SetUserPersonalProfilePhotoRequest) (*Ok, error) {
-	result, err := client.Send(Request{
-		meta: meta{
-			Type: "setUserPersonalProfilePhoto",
-		},
-		Data: map[string]interface{}{
-			"user_id": req.UserId,
-			"photo":   req.Photo,
+	return client.SetUserPersonalProfilePhotoContext(context.Background(), req)
+}
+
+// Changes a personal profile photo of a contact user
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SetUserPersonalProfilePhotoContext(ctx context.Context, req *SetUserPersonalProfilePhotoRequest) (*Ok, error) {
+	err := client.checkUsersOnly("setUserPersonalProfilePhoto")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
+		meta: meta{
+			Type: "setUserPersonalProfilePhoto",
 		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -10951,16 +16127,33 @@ type SuggestUserProfilePhotoRequest struct {
 	Photo InputChatPhoto `json:"photo"`
 }
 
+func (req *SuggestUserProfilePhotoRequest) encode(encoder *encoder) {
+	encoder.Int64("user_id", req.UserId)
+	encoder.name("photo")
+	encodeInputChatPhoto(encoder, req.Photo)
+}
+
 // Suggests a profile photo to another regular user with common messages
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SuggestUserProfilePhoto(req *SuggestUserProfilePhotoRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SuggestUserProfilePhotoContext(context.Background(), req)
+}
+
+// Suggests a profile photo to another regular user with common messages
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SuggestUserProfilePhotoContext(ctx context.Context, req *SuggestUserProfilePhotoRequest) (*Ok, error) {
+	err := client.checkUsersOnly("suggestUserProfilePhoto")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "suggestUserProfilePhoto",
 		},
-		Data: map[string]interface{}{
-			"user_id": req.UserId,
-			"photo":   req.Photo,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -10978,15 +16171,31 @@ type SearchUserByPhoneNumberRequest struct {
 	PhoneNumber string `json:"phone_number"`
 }
 
+func (req *SearchUserByPhoneNumberRequest) encode(encoder *encoder) {
+	encoder.String("phone_number", req.PhoneNumber)
+}
+
 // Searches a user by their phone number. Returns a 404 error if the user can't be found
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SearchUserByPhoneNumber(req *SearchUserByPhoneNumberRequest) (*User, error) {
-	result, err := client.Send(Request{
+	return client.SearchUserByPhoneNumberContext(context.Background(), req)
+}
+
+// Searches a user by their phone number. Returns a 404 error if the user can't be found
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SearchUserByPhoneNumberContext(ctx context.Context, req *SearchUserByPhoneNumberRequest) (*User, error) {
+	err := client.checkUsersOnly("searchUserByPhoneNumber")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "searchUserByPhoneNumber",
 		},
-		Data: map[string]interface{}{
-			"phone_number": req.PhoneNumber,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -11004,15 +16213,31 @@ type SharePhoneNumberRequest struct {
 	UserId int64 `json:"user_id"`
 }
 
+func (req *SharePhoneNumberRequest) encode(encoder *encoder) {
+	encoder.Int64("user_id", req.UserId)
+}
+
 // Shares the phone number of the current user with a mutual contact. Supposed to be called when the user clicks on chatActionBarSharePhoneNumber
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SharePhoneNumber(req *SharePhoneNumberRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SharePhoneNumberContext(context.Background(), req)
+}
+
+// Shares the phone number of the current user with a mutual contact. Supposed to be called when the user clicks on chatActionBarSharePhoneNumber
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SharePhoneNumberContext(ctx context.Context, req *SharePhoneNumberRequest) (*Ok, error) {
+	err := client.checkUsersOnly("sharePhoneNumber")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "sharePhoneNumber",
 		},
-		Data: map[string]interface{}{
-			"user_id": req.UserId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -11034,17 +16259,24 @@ type GetUserProfilePhotosRequest struct {
 	Limit int32 `json:"limit"`
 }
 
+func (req *GetUserProfilePhotosRequest) encode(encoder *encoder) {
+	encoder.Int64("user_id", req.UserId)
+	encoder.Int32("offset", req.Offset)
+	encoder.Int32("limit", req.Limit)
+}
+
 // Returns the profile photos of a user. Personal and public photo aren't returned
 func (client *Client) GetUserProfilePhotos(req *GetUserProfilePhotosRequest) (*ChatPhotos, error) {
-	result, err := client.Send(Request{
+	return client.GetUserProfilePhotosContext(context.Background(), req)
+}
+
+// Returns the profile photos of a user. Personal and public photo aren't returned
+func (client *Client) GetUserProfilePhotosContext(ctx context.Context, req *GetUserProfilePhotosRequest) (*ChatPhotos, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getUserProfilePhotos",
 		},
-		Data: map[string]interface{}{
-			"user_id": req.UserId,
-			"offset":  req.Offset,
-			"limit":   req.Limit,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -11068,18 +16300,39 @@ type GetStickersRequest struct {
 	ChatId int64 `json:"chat_id"`
 }
 
+func (req *GetStickersRequest) encode(encoder *encoder) {
+	encoder.name("sticker_type")
+	encodeStickerType(encoder, req.StickerType)
+	encoder.String("query", req.Query)
+	encoder.Int32("limit", req.Limit)
+	encoder.Int64("chat_id", req.ChatId)
+}
+
+func (req *GetStickersRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns stickers from the installed sticker sets that correspond to any of the given emoji or can be found by sticker-specific keywords. If the query is non-empty, then favorite, recently used or trending stickers may also be returned
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetStickers(req *GetStickersRequest) (*Stickers, error) {
-	result, err := client.Send(Request{
+	return client.GetStickersContext(context.Background(), req)
+}
+
+// Returns stickers from the installed sticker sets that correspond to any of the given emoji or can be found by sticker-specific keywords. If the query is non-empty, then favorite, recently used or trending stickers may also be returned
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetStickersContext(ctx context.Context, req *GetStickersRequest) (*Stickers, error) {
+	err := client.checkUsersOnly("getStickers")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getStickers",
 		},
-		Data: map[string]interface{}{
-			"sticker_type": req.StickerType,
-			"query":        req.Query,
-			"limit":        req.Limit,
-			"chat_id":      req.ChatId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -11101,17 +16354,34 @@ type SearchStickersRequest struct {
 	Limit int32 `json:"limit"`
 }
 
+func (req *SearchStickersRequest) encode(encoder *encoder) {
+	encoder.name("sticker_type")
+	encodeStickerType(encoder, req.StickerType)
+	encoder.String("emojis", req.Emojis)
+	encoder.Int32("limit", req.Limit)
+}
+
 // Searches for stickers from public sticker sets that correspond to any of the given emoji
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SearchStickers(req *SearchStickersRequest) (*Stickers, error) {
-	result, err := client.Send(Request{
+	return client.SearchStickersContext(context.Background(), req)
+}
+
+// Searches for stickers from public sticker sets that correspond to any of the given emoji
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SearchStickersContext(ctx context.Context, req *SearchStickersRequest) (*Stickers, error) {
+	err := client.checkUsersOnly("searchStickers")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "searchStickers",
 		},
-		Data: map[string]interface{}{
-			"sticker_type": req.StickerType,
-			"emojis":       req.Emojis,
-			"limit":        req.Limit,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -11129,15 +16399,31 @@ type GetPremiumStickersRequest struct {
 	Limit int32 `json:"limit"`
 }
 
+func (req *GetPremiumStickersRequest) encode(encoder *encoder) {
+	encoder.Int32("limit", req.Limit)
+}
+
 // Returns premium stickers from regular sticker sets
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetPremiumStickers(req *GetPremiumStickersRequest) (*Stickers, error) {
-	result, err := client.Send(Request{
+	return client.GetPremiumStickersContext(context.Background(), req)
+}
+
+// Returns premium stickers from regular sticker sets
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetPremiumStickersContext(ctx context.Context, req *GetPremiumStickersRequest) (*Stickers, error) {
+	err := client.checkUsersOnly("getPremiumStickers")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getPremiumStickers",
 		},
-		Data: map[string]interface{}{
-			"limit": req.Limit,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -11155,15 +16441,32 @@ type GetInstalledStickerSetsRequest struct {
 	StickerType StickerType `json:"sticker_type"`
 }
 
+func (req *GetInstalledStickerSetsRequest) encode(encoder *encoder) {
+	encoder.name("sticker_type")
+	encodeStickerType(encoder, req.StickerType)
+}
+
 // Returns a list of installed sticker sets
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetInstalledStickerSets(req *GetInstalledStickerSetsRequest) (*StickerSets, error) {
-	result, err := client.Send(Request{
+	return client.GetInstalledStickerSetsContext(context.Background(), req)
+}
+
+// Returns a list of installed sticker sets
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetInstalledStickerSetsContext(ctx context.Context, req *GetInstalledStickerSetsRequest) (*StickerSets, error) {
+	err := client.checkUsersOnly("getInstalledStickerSets")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getInstalledStickerSets",
 		},
-		Data: map[string]interface{}{
-			"sticker_type": req.StickerType,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -11185,17 +16488,34 @@ type GetArchivedStickerSetsRequest struct {
 	Limit int32 `json:"limit"`
 }
 
+func (req *GetArchivedStickerSetsRequest) encode(encoder *encoder) {
+	encoder.name("sticker_type")
+	encodeStickerType(encoder, req.StickerType)
+	encoder.JsonInt64("offset_sticker_set_id", req.OffsetStickerSetId)
+	encoder.Int32("limit", req.Limit)
+}
+
 // Returns a list of archived sticker sets
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetArchivedStickerSets(req *GetArchivedStickerSetsRequest) (*StickerSets, error) {
-	result, err := client.Send(Request{
+	return client.GetArchivedStickerSetsContext(context.Background(), req)
+}
+
+// Returns a list of archived sticker sets
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetArchivedStickerSetsContext(ctx context.Context, req *GetArchivedStickerSetsRequest) (*StickerSets, error) {
+	err := client.checkUsersOnly("getArchivedStickerSets")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getArchivedStickerSets",
 		},
-		Data: map[string]interface{}{
-			"sticker_type":          req.StickerType,
-			"offset_sticker_set_id": req.OffsetStickerSetId,
-			"limit":                 req.Limit,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -11217,17 +16537,34 @@ type GetTrendingStickerSetsRequest struct {
 	Limit int32 `json:"limit"`
 }
 
+func (req *GetTrendingStickerSetsRequest) encode(encoder *encoder) {
+	encoder.name("sticker_type")
+	encodeStickerType(encoder, req.StickerType)
+	encoder.Int32("offset", req.Offset)
+	encoder.Int32("limit", req.Limit)
+}
+
 // Returns a list of trending sticker sets. For optimal performance, the number of returned sticker sets is chosen by TDLib
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetTrendingStickerSets(req *GetTrendingStickerSetsRequest) (*TrendingStickerSets, error) {
-	result, err := client.Send(Request{
+	return client.GetTrendingStickerSetsContext(context.Background(), req)
+}
+
+// Returns a list of trending sticker sets. For optimal performance, the number of returned sticker sets is chosen by TDLib
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetTrendingStickerSetsContext(ctx context.Context, req *GetTrendingStickerSetsRequest) (*TrendingStickerSets, error) {
+	err := client.checkUsersOnly("getTrendingStickerSets")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getTrendingStickerSets",
 		},
-		Data: map[string]interface{}{
-			"sticker_type": req.StickerType,
-			"offset":       req.Offset,
-			"limit":        req.Limit,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -11245,15 +16582,31 @@ type GetAttachedStickerSetsRequest struct {
 	FileId int32 `json:"file_id"`
 }
 
+func (req *GetAttachedStickerSetsRequest) encode(encoder *encoder) {
+	encoder.Int32("file_id", req.FileId)
+}
+
 // Returns a list of sticker sets attached to a file, including regular, mask, and emoji sticker sets. Currently, only animations, photos, and videos can have attached sticker sets
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetAttachedStickerSets(req *GetAttachedStickerSetsRequest) (*StickerSets, error) {
-	result, err := client.Send(Request{
+	return client.GetAttachedStickerSetsContext(context.Background(), req)
+}
+
+// Returns a list of sticker sets attached to a file, including regular, mask, and emoji sticker sets. Currently, only animations, photos, and videos can have attached sticker sets
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetAttachedStickerSetsContext(ctx context.Context, req *GetAttachedStickerSetsRequest) (*StickerSets, error) {
+	err := client.checkUsersOnly("getAttachedStickerSets")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getAttachedStickerSets",
 		},
-		Data: map[string]interface{}{
-			"file_id": req.FileId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -11271,15 +16624,22 @@ type GetStickerSetRequest struct {
 	SetId JsonInt64 `json:"set_id"`
 }
 
+func (req *GetStickerSetRequest) encode(encoder *encoder) {
+	encoder.JsonInt64("set_id", req.SetId)
+}
+
 // Returns information about a sticker set by its identifier
 func (client *Client) GetStickerSet(req *GetStickerSetRequest) (*StickerSet, error) {
-	result, err := client.Send(Request{
+	return client.GetStickerSetContext(context.Background(), req)
+}
+
+// Returns information about a sticker set by its identifier
+func (client *Client) GetStickerSetContext(ctx context.Context, req *GetStickerSetRequest) (*StickerSet, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getStickerSet",
 		},
-		Data: map[string]interface{}{
-			"set_id": req.SetId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -11297,15 +16657,22 @@ type SearchStickerSetRequest struct {
 	Name string `json:"name"`
 }
 
+func (req *SearchStickerSetRequest) encode(encoder *encoder) {
+	encoder.String("name", req.Name)
+}
+
 // Searches for a sticker set by its name
 func (client *Client) SearchStickerSet(req *SearchStickerSetRequest) (*StickerSet, error) {
-	result, err := client.Send(Request{
+	return client.SearchStickerSetContext(context.Background(), req)
+}
+
+// Searches for a sticker set by its name
+func (client *Client) SearchStickerSetContext(ctx context.Context, req *SearchStickerSetRequest) (*StickerSet, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "searchStickerSet",
 		},
-		Data: map[string]interface{}{
-			"name": req.Name,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -11327,17 +16694,25 @@ type SearchInstalledStickerSetsRequest struct {
 	Limit int32 `json:"limit"`
 }
 
+func (req *SearchInstalledStickerSetsRequest) encode(encoder *encoder) {
+	encoder.name("sticker_type")
+	encodeStickerType(encoder, req.StickerType)
+	encoder.String("query", req.Query)
+	encoder.Int32("limit", req.Limit)
+}
+
 // Searches for installed sticker sets by looking for specified query in their title and name
 func (client *Client) SearchInstalledStickerSets(req *SearchInstalledStickerSetsRequest) (*StickerSets, error) {
-	result, err := client.Send(Request{
+	return client.SearchInstalledStickerSetsContext(context.Background(), req)
+}
+
+// Searches for installed sticker sets by looking for specified query in their title and name
+func (client *Client) SearchInstalledStickerSetsContext(ctx context.Context, req *SearchInstalledStickerSetsRequest) (*StickerSets, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "searchInstalledStickerSets",
 		},
-		Data: map[string]interface{}{
-			"sticker_type": req.StickerType,
-			"query":        req.Query,
-			"limit":        req.Limit,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -11355,15 +16730,22 @@ type SearchStickerSetsRequest struct {
 	Query string `json:"query"`
 }
 
+func (req *SearchStickerSetsRequest) encode(encoder *encoder) {
+	encoder.String("query", req.Query)
+}
+
 // Searches for ordinary sticker sets by looking for specified query in their title and name. Excludes installed sticker sets from the results
 func (client *Client) SearchStickerSets(req *SearchStickerSetsRequest) (*StickerSets, error) {
-	result, err := client.Send(Request{
+	return client.SearchStickerSetsContext(context.Background(), req)
+}
+
+// Searches for ordinary sticker sets by looking for specified query in their title and name. Excludes installed sticker sets from the results
+func (client *Client) SearchStickerSetsContext(ctx context.Context, req *SearchStickerSetsRequest) (*StickerSets, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "searchStickerSets",
 		},
-		Data: map[string]interface{}{
-			"query": req.Query,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -11385,17 +16767,33 @@ type ChangeStickerSetRequest struct {
 	IsArchived bool `json:"is_archived"`
 }
 
+func (req *ChangeStickerSetRequest) encode(encoder *encoder) {
+	encoder.JsonInt64("set_id", req.SetId)
+	encoder.Bool("is_installed", req.IsInstalled)
+	encoder.Bool("is_archived", req.IsArchived)
+}
+
 // Installs/uninstalls or activates/archives a sticker set
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ChangeStickerSet(req *ChangeStickerSetRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ChangeStickerSetContext(context.Background(), req)
+}
+
+// Installs/uninstalls or activates/archives a sticker set
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ChangeStickerSetContext(ctx context.Context, req *ChangeStickerSetRequest) (*Ok, error) {
+	err := client.checkUsersOnly("changeStickerSet")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "changeStickerSet",
 		},
-		Data: map[string]interface{}{
-			"set_id":       req.SetId,
-			"is_installed": req.IsInstalled,
-			"is_archived":  req.IsArchived,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -11413,15 +16811,32 @@ type ViewTrendingStickerSetsRequest struct {
 	StickerSetIds []JsonInt64 `json:"sticker_set_ids"`
 }
 
+func (req *ViewTrendingStickerSetsRequest) encode(encoder *encoder) {
+	encoder.name("sticker_set_ids")
+	encodeListOfJsonInt64(encoder, req.StickerSetIds)
+}
+
 // Informs the server that some trending sticker sets have been viewed by the user
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ViewTrendingStickerSets(req *ViewTrendingStickerSetsRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ViewTrendingStickerSetsContext(context.Background(), req)
+}
+
+// Informs the server that some trending sticker sets have been viewed by the user
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ViewTrendingStickerSetsContext(ctx context.Context, req *ViewTrendingStickerSetsRequest) (*Ok, error) {
+	err := client.checkUsersOnly("viewTrendingStickerSets")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "viewTrendingStickerSets",
 		},
-		Data: map[string]interface{}{
-			"sticker_set_ids": req.StickerSetIds,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -11441,16 +16856,34 @@ type ReorderInstalledStickerSetsRequest struct {
 	StickerSetIds []JsonInt64 `json:"sticker_set_ids"`
 }
 
+func (req *ReorderInstalledStickerSetsRequest) encode(encoder *encoder) {
+	encoder.name("sticker_type")
+	encodeStickerType(encoder, req.StickerType)
+	encoder.name("sticker_set_ids")
+	encodeListOfJsonInt64(encoder, req.StickerSetIds)
+}
+
 // Changes the order of installed sticker sets
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ReorderInstalledStickerSets(req *ReorderInstalledStickerSetsRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ReorderInstalledStickerSetsContext(context.Background(), req)
+}
+
+// Changes the order of installed sticker sets
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ReorderInstalledStickerSetsContext(ctx context.Context, req *ReorderInstalledStickerSetsRequest) (*Ok, error) {
+	err := client.checkUsersOnly("reorderInstalledStickerSets")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "reorderInstalledStickerSets",
 		},
-		Data: map[string]interface{}{
-			"sticker_type":    req.StickerType,
-			"sticker_set_ids": req.StickerSetIds,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -11468,15 +16901,31 @@ type GetRecentStickersRequest struct {
 	IsAttached bool `json:"is_attached"`
 }
 
+func (req *GetRecentStickersRequest) encode(encoder *encoder) {
+	encoder.Bool("is_attached", req.IsAttached)
+}
+
 // Returns a list of recently used stickers
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetRecentStickers(req *GetRecentStickersRequest) (*Stickers, error) {
-	result, err := client.Send(Request{
+	return client.GetRecentStickersContext(context.Background(), req)
+}
+
+// Returns a list of recently used stickers
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetRecentStickersContext(ctx context.Context, req *GetRecentStickersRequest) (*Stickers, error) {
+	err := client.checkUsersOnly("getRecentStickers")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getRecentStickers",
 		},
-		Data: map[string]interface{}{
-			"is_attached": req.IsAttached,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -11496,16 +16945,33 @@ type AddRecentStickerRequest struct {
 	Sticker InputFile `json:"sticker"`
 }
 
+func (req *AddRecentStickerRequest) encode(encoder *encoder) {
+	encoder.Bool("is_attached", req.IsAttached)
+	encoder.name("sticker")
+	encodeInputFile(encoder, req.Sticker)
+}
+
 // Manually adds a new sticker to the list of recently used stickers. The new sticker is added to the top of the list. If the sticker was already in the list, it is removed from the list first. Only stickers belonging to a sticker set can be added to this list. Emoji stickers can't be added to recent stickers
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) AddRecentSticker(req *AddRecentStickerRequest) (*Stickers, error) {
-	result, err := client.Send(Request{
+	return client.AddRecentStickerContext(context.Background(), req)
+}
+
+// Manually adds a new sticker to the list of recently used stickers. The new sticker is added to the top of the list. If the sticker was already in the list, it is removed from the list first. Only stickers belonging to a sticker set can be added to this list. Emoji stickers can't be added to recent stickers
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) AddRecentStickerContext(ctx context.Context, req *AddRecentStickerRequest) (*Stickers, error) {
+	err := client.checkUsersOnly("addRecentSticker")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "addRecentSticker",
 		},
-		Data: map[string]interface{}{
-			"is_attached": req.IsAttached,
-			"sticker":     req.Sticker,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -11525,16 +16991,33 @@ type RemoveRecentStickerRequest struct {
 	Sticker InputFile `json:"sticker"`
 }
 
+func (req *RemoveRecentStickerRequest) encode(encoder *encoder) {
+	encoder.Bool("is_attached", req.IsAttached)
+	encoder.name("sticker")
+	encodeInputFile(encoder, req.Sticker)
+}
+
 // Removes a sticker from the list of recently used stickers
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) RemoveRecentSticker(req *RemoveRecentStickerRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.RemoveRecentStickerContext(context.Background(), req)
+}
+
+// Removes a sticker from the list of recently used stickers
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) RemoveRecentStickerContext(ctx context.Context, req *RemoveRecentStickerRequest) (*Ok, error) {
+	err := client.checkUsersOnly("removeRecentSticker")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "removeRecentSticker",
 		},
-		Data: map[string]interface{}{
-			"is_attached": req.IsAttached,
-			"sticker":     req.Sticker,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -11552,15 +17035,31 @@ type ClearRecentStickersRequest struct {
 	IsAttached bool `json:"is_attached"`
 }
 
+func (req *ClearRecentStickersRequest) encode(encoder *encoder) {
+	encoder.Bool("is_attached", req.IsAttached)
+}
+
 // Clears the list of recently used stickers
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ClearRecentStickers(req *ClearRecentStickersRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ClearRecentStickersContext(context.Background(), req)
+}
+
+// Clears the list of recently used stickers
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ClearRecentStickersContext(ctx context.Context, req *ClearRecentStickersRequest) (*Ok, error) {
+	err := client.checkUsersOnly("clearRecentStickers")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "clearRecentStickers",
 		},
-		Data: map[string]interface{}{
-			"is_attached": req.IsAttached,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -11574,12 +17073,25 @@ func (client *Client) ClearRecentStickers(req *ClearRecentStickersRequest) (*Ok,
 }
 
 // Returns favorite stickers
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetFavoriteStickers() (*Stickers, error) {
-	result, err := client.Send(Request{
+	return client.GetFavoriteStickersContext(context.Background())
+}
+
+// Returns favorite stickers
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetFavoriteStickersContext(ctx context.Context) (*Stickers, error) {
+	err := client.checkUsersOnly("getFavoriteStickers")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getFavoriteStickers",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -11597,15 +17109,32 @@ type AddFavoriteStickerRequest struct {
 	Sticker InputFile `json:"sticker"`
 }
 
+func (req *AddFavoriteStickerRequest) encode(encoder *encoder) {
+	encoder.name("sticker")
+	encodeInputFile(encoder, req.Sticker)
+}
+
 // Adds a new sticker to the list of favorite stickers. The new sticker is added to the top of the list. If the sticker was already in the list, it is removed from the list first. Only stickers belonging to a sticker set can be added to this list. Emoji stickers can't be added to favorite stickers
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) AddFavoriteSticker(req *AddFavoriteStickerRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.AddFavoriteStickerContext(context.Background(), req)
+}
+
+// Adds a new sticker to the list of favorite stickers. The new sticker is added to the top of the list. If the sticker was already in the list, it is removed from the list first. Only stickers belonging to a sticker set can be added to this list. Emoji stickers can't be added to favorite stickers
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) AddFavoriteStickerContext(ctx context.Context, req *AddFavoriteStickerRequest) (*Ok, error) {
+	err := client.checkUsersOnly("addFavoriteSticker")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "addFavoriteSticker",
 		},
-		Data: map[string]interface{}{
-			"sticker": req.Sticker,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -11623,15 +17152,32 @@ type RemoveFavoriteStickerRequest struct {
 	Sticker InputFile `json:"sticker"`
 }
 
+func (req *RemoveFavoriteStickerRequest) encode(encoder *encoder) {
+	encoder.name("sticker")
+	encodeInputFile(encoder, req.Sticker)
+}
+
 // Removes a sticker from the list of favorite stickers
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) RemoveFavoriteSticker(req *RemoveFavoriteStickerRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.RemoveFavoriteStickerContext(context.Background(), req)
+}
+
+// Removes a sticker from the list of favorite stickers
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) RemoveFavoriteStickerContext(ctx context.Context, req *RemoveFavoriteStickerRequest) (*Ok, error) {
+	err := client.checkUsersOnly("removeFavoriteSticker")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "removeFavoriteSticker",
 		},
-		Data: map[string]interface{}{
-			"sticker": req.Sticker,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -11649,15 +17195,32 @@ type GetStickerEmojisRequest struct {
 	Sticker InputFile `json:"sticker"`
 }
 
+func (req *GetStickerEmojisRequest) encode(encoder *encoder) {
+	encoder.name("sticker")
+	encodeInputFile(encoder, req.Sticker)
+}
+
 // Returns emoji corresponding to a sticker. The list is only for informational purposes, because a sticker is always sent with a fixed emoji from the corresponding Sticker object
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetStickerEmojis(req *GetStickerEmojisRequest) (*Emojis, error) {
-	result, err := client.Send(Request{
+	return client.GetStickerEmojisContext(context.Background(), req)
+}
+
+// Returns emoji corresponding to a sticker. The list is only for informational purposes, because a sticker is always sent with a fixed emoji from the corresponding Sticker object
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetStickerEmojisContext(ctx context.Context, req *GetStickerEmojisRequest) (*Emojis, error) {
+	err := client.checkUsersOnly("getStickerEmojis")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getStickerEmojis",
 		},
-		Data: map[string]interface{}{
-			"sticker": req.Sticker,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -11679,19 +17242,35 @@ type SearchEmojisRequest struct {
 	InputLanguageCodes []string `json:"input_language_codes"`
 }
 
+func (req *SearchEmojisRequest) encode(encoder *encoder) {
+	encoder.String("text", req.Text)
+	encoder.Bool("exact_match", req.ExactMatch)
+	encoder.Strings("input_language_codes", req.InputLanguageCodes)
+}
+
 // Searches for emojis by keywords. Supported only if the file database is enabled
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SearchEmojis(req *SearchEmojisRequest) (*Emojis, error) {
-	result, err := client.Send(Request{
-		meta: meta{
-			Type: "searchEmojis",
-		},
-		Data: map[string]interface{}{
-			"text":                 req.Text,
-			"exact_match":          req.ExactMatch,
-			"input_language_codes": req.InputLanguageCodes,
-		},
-	})
-	if err != nil {
+	return client.SearchEmojisContext(context.Background(), req)
+}
+
+// Searches for emojis by keywords. Supported only if the file database is enabled
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SearchEmojisContext(ctx context.Context, req *SearchEmojisRequest) (*Emojis, error) {
+	err := client.checkUsersOnly("searchEmojis")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
+		meta: meta{
+			Type: "searchEmojis",
+		},
+		Data: req,
+	})
+	if err != nil {
 		return nil, err
 	}
 
@@ -11707,15 +17286,32 @@ type GetEmojiCategoriesRequest struct {
 	Type EmojiCategoryType `json:"type"`
 }
 
+func (req *GetEmojiCategoriesRequest) encode(encoder *encoder) {
+	encoder.name("type")
+	encodeEmojiCategoryType(encoder, req.Type)
+}
+
 // Returns available emojis categories
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetEmojiCategories(req *GetEmojiCategoriesRequest) (*EmojiCategories, error) {
-	result, err := client.Send(Request{
+	return client.GetEmojiCategoriesContext(context.Background(), req)
+}
+
+// Returns available emojis categories
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetEmojiCategoriesContext(ctx context.Context, req *GetEmojiCategoriesRequest) (*EmojiCategories, error) {
+	err := client.checkUsersOnly("getEmojiCategories")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getEmojiCategories",
 		},
-		Data: map[string]interface{}{
-			"type": req.Type,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -11733,15 +17329,31 @@ type GetAnimatedEmojiRequest struct {
 	Emoji string `json:"emoji"`
 }
 
+func (req *GetAnimatedEmojiRequest) encode(encoder *encoder) {
+	encoder.String("emoji", req.Emoji)
+}
+
 // Returns an animated emoji corresponding to a given emoji. Returns a 404 error if the emoji has no animated emoji
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetAnimatedEmoji(req *GetAnimatedEmojiRequest) (*AnimatedEmoji, error) {
-	result, err := client.Send(Request{
+	return client.GetAnimatedEmojiContext(context.Background(), req)
+}
+
+// Returns an animated emoji corresponding to a given emoji. Returns a 404 error if the emoji has no animated emoji
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetAnimatedEmojiContext(ctx context.Context, req *GetAnimatedEmojiRequest) (*AnimatedEmoji, error) {
+	err := client.checkUsersOnly("getAnimatedEmoji")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getAnimatedEmoji",
 		},
-		Data: map[string]interface{}{
-			"emoji": req.Emoji,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -11759,15 +17371,31 @@ type GetEmojiSuggestionsUrlRequest struct {
 	LanguageCode string `json:"language_code"`
 }
 
+func (req *GetEmojiSuggestionsUrlRequest) encode(encoder *encoder) {
+	encoder.String("language_code", req.LanguageCode)
+}
+
 // Returns an HTTP URL which can be used to automatically log in to the translation platform and suggest new emoji replacements. The URL will be valid for 30 seconds after generation
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetEmojiSuggestionsUrl(req *GetEmojiSuggestionsUrlRequest) (*HttpUrl, error) {
-	result, err := client.Send(Request{
+	return client.GetEmojiSuggestionsUrlContext(context.Background(), req)
+}
+
+// Returns an HTTP URL which can be used to automatically log in to the translation platform and suggest new emoji replacements. The URL will be valid for 30 seconds after generation
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetEmojiSuggestionsUrlContext(ctx context.Context, req *GetEmojiSuggestionsUrlRequest) (*HttpUrl, error) {
+	err := client.checkUsersOnly("getEmojiSuggestionsUrl")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getEmojiSuggestionsUrl",
 		},
-		Data: map[string]interface{}{
-			"language_code": req.LanguageCode,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -11785,15 +17413,23 @@ type GetCustomEmojiStickersRequest struct {
 	CustomEmojiIds []JsonInt64 `json:"custom_emoji_ids"`
 }
 
+func (req *GetCustomEmojiStickersRequest) encode(encoder *encoder) {
+	encoder.name("custom_emoji_ids")
+	encodeListOfJsonInt64(encoder, req.CustomEmojiIds)
+}
+
 // Returns list of custom emoji stickers by their identifiers. Stickers are returned in arbitrary order. Only found stickers are returned
 func (client *Client) GetCustomEmojiStickers(req *GetCustomEmojiStickersRequest) (*Stickers, error) {
-	result, err := client.Send(Request{
+	return client.GetCustomEmojiStickersContext(context.Background(), req)
+}
+
+// Returns list of custom emoji stickers by their identifiers. Stickers are returned in arbitrary order. Only found stickers are returned
+func (client *Client) GetCustomEmojiStickersContext(ctx context.Context, req *GetCustomEmojiStickersRequest) (*Stickers, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getCustomEmojiStickers",
 		},
-		Data: map[string]interface{}{
-			"custom_emoji_ids": req.CustomEmojiIds,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -11808,11 +17444,15 @@ func (client *Client) GetCustomEmojiStickers(req *GetCustomEmojiStickersRequest)
 
 // Returns default list of custom emoji stickers for placing on a chat photo
 func (client *Client) GetDefaultChatPhotoCustomEmojiStickers() (*Stickers, error) {
-	result, err := client.Send(Request{
+	return client.GetDefaultChatPhotoCustomEmojiStickersContext(context.Background())
+}
+
+// Returns default list of custom emoji stickers for placing on a chat photo
+func (client *Client) GetDefaultChatPhotoCustomEmojiStickersContext(ctx context.Context) (*Stickers, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getDefaultChatPhotoCustomEmojiStickers",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -11827,11 +17467,15 @@ func (client *Client) GetDefaultChatPhotoCustomEmojiStickers() (*Stickers, error
 
 // Returns default list of custom emoji stickers for placing on a profile photo
 func (client *Client) GetDefaultProfilePhotoCustomEmojiStickers() (*Stickers, error) {
-	result, err := client.Send(Request{
+	return client.GetDefaultProfilePhotoCustomEmojiStickersContext(context.Background())
+}
+
+// Returns default list of custom emoji stickers for placing on a profile photo
+func (client *Client) GetDefaultProfilePhotoCustomEmojiStickersContext(ctx context.Context) (*Stickers, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getDefaultProfilePhotoCustomEmojiStickers",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -11845,12 +17489,25 @@ func (client *Client) GetDefaultProfilePhotoCustomEmojiStickers() (*Stickers, er
 }
 
 // Returns saved animations
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetSavedAnimations() (*Animations, error) {
-	result, err := client.Send(Request{
+	return client.GetSavedAnimationsContext(context.Background())
+}
+
+// Returns saved animations
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetSavedAnimationsContext(ctx context.Context) (*Animations, error) {
+	err := client.checkUsersOnly("getSavedAnimations")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getSavedAnimations",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -11868,15 +17525,32 @@ type AddSavedAnimationRequest struct {
 	Animation InputFile `json:"animation"`
 }
 
+func (req *AddSavedAnimationRequest) encode(encoder *encoder) {
+	encoder.name("animation")
+	encodeInputFile(encoder, req.Animation)
+}
+
 // Manually adds a new animation to the list of saved animations. The new animation is added to the beginning of the list. If the animation was already in the list, it is removed first. Only non-secret video animations with MIME type "video/mp4" can be added to the list
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) AddSavedAnimation(req *AddSavedAnimationRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.AddSavedAnimationContext(context.Background(), req)
+}
+
+// Manually adds a new animation to the list of saved animations. The new animation is added to the beginning of the list. If the animation was already in the list, it is removed first. Only non-secret video animations with MIME type "video/mp4" can be added to the list
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) AddSavedAnimationContext(ctx context.Context, req *AddSavedAnimationRequest) (*Ok, error) {
+	err := client.checkUsersOnly("addSavedAnimation")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "addSavedAnimation",
 		},
-		Data: map[string]interface{}{
-			"animation": req.Animation,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -11894,15 +17568,32 @@ type RemoveSavedAnimationRequest struct {
 	Animation InputFile `json:"animation"`
 }
 
+func (req *RemoveSavedAnimationRequest) encode(encoder *encoder) {
+	encoder.name("animation")
+	encodeInputFile(encoder, req.Animation)
+}
+
 // Removes an animation from the list of saved animations
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) RemoveSavedAnimation(req *RemoveSavedAnimationRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.RemoveSavedAnimationContext(context.Background(), req)
+}
+
+// Removes an animation from the list of saved animations
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) RemoveSavedAnimationContext(ctx context.Context, req *RemoveSavedAnimationRequest) (*Ok, error) {
+	err := client.checkUsersOnly("removeSavedAnimation")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "removeSavedAnimation",
 		},
-		Data: map[string]interface{}{
-			"animation": req.Animation,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -11916,12 +17607,25 @@ func (client *Client) RemoveSavedAnimation(req *RemoveSavedAnimationRequest) (*O
 }
 
 // Returns up to 20 recently used inline bots in the order of their last usage
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetRecentInlineBots() (*Users, error) {
-	result, err := client.Send(Request{
+	return client.GetRecentInlineBotsContext(context.Background())
+}
+
+// Returns up to 20 recently used inline bots in the order of their last usage
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetRecentInlineBotsContext(ctx context.Context) (*Users, error) {
+	err := client.checkUsersOnly("getRecentInlineBots")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getRecentInlineBots",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -11941,16 +17645,32 @@ type SearchHashtagsRequest struct {
 	Limit int32 `json:"limit"`
 }
 
+func (req *SearchHashtagsRequest) encode(encoder *encoder) {
+	encoder.String("prefix", req.Prefix)
+	encoder.Int32("limit", req.Limit)
+}
+
 // Searches for recently used hashtags by their prefix
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SearchHashtags(req *SearchHashtagsRequest) (*Hashtags, error) {
-	result, err := client.Send(Request{
+	return client.SearchHashtagsContext(context.Background(), req)
+}
+
+// Searches for recently used hashtags by their prefix
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SearchHashtagsContext(ctx context.Context, req *SearchHashtagsRequest) (*Hashtags, error) {
+	err := client.checkUsersOnly("searchHashtags")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "searchHashtags",
 		},
-		Data: map[string]interface{}{
-			"prefix": req.Prefix,
-			"limit":  req.Limit,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -11968,15 +17688,31 @@ type RemoveRecentHashtagRequest struct {
 	Hashtag string `json:"hashtag"`
 }
 
+func (req *RemoveRecentHashtagRequest) encode(encoder *encoder) {
+	encoder.String("hashtag", req.Hashtag)
+}
+
 // Removes a hashtag from the list of recently used hashtags
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) RemoveRecentHashtag(req *RemoveRecentHashtagRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.RemoveRecentHashtagContext(context.Background(), req)
+}
+
+// Removes a hashtag from the list of recently used hashtags
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) RemoveRecentHashtagContext(ctx context.Context, req *RemoveRecentHashtagRequest) (*Ok, error) {
+	err := client.checkUsersOnly("removeRecentHashtag")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "removeRecentHashtag",
 		},
-		Data: map[string]interface{}{
-			"hashtag": req.Hashtag,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -11994,15 +17730,32 @@ type GetWebPagePreviewRequest struct {
 	Text *FormattedText `json:"text"`
 }
 
+func (req *GetWebPagePreviewRequest) encode(encoder *encoder) {
+	encoder.name("text")
+	encodeFormattedText(encoder, req.Text)
+}
+
 // Returns a web page preview by the text of the message. Do not call this function too often. Returns a 404 error if the web page has no preview
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetWebPagePreview(req *GetWebPagePreviewRequest) (*WebPage, error) {
-	result, err := client.Send(Request{
+	return client.GetWebPagePreviewContext(context.Background(), req)
+}
+
+// Returns a web page preview by the text of the message. Do not call this function too often. Returns a 404 error if the web page has no preview
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetWebPagePreviewContext(ctx context.Context, req *GetWebPagePreviewRequest) (*WebPage, error) {
+	err := client.checkUsersOnly("getWebPagePreview")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getWebPagePreview",
 		},
-		Data: map[string]interface{}{
-			"text": req.Text,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -12022,16 +17775,32 @@ type GetWebPageInstantViewRequest struct {
 	ForceFull bool `json:"force_full"`
 }
 
+func (req *GetWebPageInstantViewRequest) encode(encoder *encoder) {
+	encoder.String("url", req.Url)
+	encoder.Bool("force_full", req.ForceFull)
+}
+
 // Returns an instant view version of a web page if available. Returns a 404 error if the web page has no instant view page
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetWebPageInstantView(req *GetWebPageInstantViewRequest) (*WebPageInstantView, error) {
-	result, err := client.Send(Request{
+	return client.GetWebPageInstantViewContext(context.Background(), req)
+}
+
+// Returns an instant view version of a web page if available. Returns a 404 error if the web page has no instant view page
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetWebPageInstantViewContext(ctx context.Context, req *GetWebPageInstantViewRequest) (*WebPageInstantView, error) {
+	err := client.checkUsersOnly("getWebPageInstantView")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getWebPageInstantView",
 		},
-		Data: map[string]interface{}{
-			"url":        req.Url,
-			"force_full": req.ForceFull,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -12051,16 +17820,33 @@ type SetProfilePhotoRequest struct {
 	IsPublic bool `json:"is_public"`
 }
 
+func (req *SetProfilePhotoRequest) encode(encoder *encoder) {
+	encoder.name("photo")
+	encodeInputChatPhoto(encoder, req.Photo)
+	encoder.Bool("is_public", req.IsPublic)
+}
+
 // Changes a profile photo for the current user
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SetProfilePhoto(req *SetProfilePhotoRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetProfilePhotoContext(context.Background(), req)
+}
+
+// Changes a profile photo for the current user
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SetProfilePhotoContext(ctx context.Context, req *SetProfilePhotoRequest) (*Ok, error) {
+	err := client.checkUsersOnly("setProfilePhoto")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setProfilePhoto",
 		},
-		Data: map[string]interface{}{
-			"photo":     req.Photo,
-			"is_public": req.IsPublic,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -12078,15 +17864,31 @@ type DeleteProfilePhotoRequest struct {
 	ProfilePhotoId JsonInt64 `json:"profile_photo_id"`
 }
 
+func (req *DeleteProfilePhotoRequest) encode(encoder *encoder) {
+	encoder.JsonInt64("profile_photo_id", req.ProfilePhotoId)
+}
+
 // Deletes a profile photo
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) DeleteProfilePhoto(req *DeleteProfilePhotoRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.DeleteProfilePhotoContext(context.Background(), req)
+}
+
+// Deletes a profile photo
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) DeleteProfilePhotoContext(ctx context.Context, req *DeleteProfilePhotoRequest) (*Ok, error) {
+	err := client.checkUsersOnly("deleteProfilePhoto")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "deleteProfilePhoto",
 		},
-		Data: map[string]interface{}{
-			"profile_photo_id": req.ProfilePhotoId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -12106,16 +17908,32 @@ type SetNameRequest struct {
 	LastName string `json:"last_name"`
 }
 
+func (req *SetNameRequest) encode(encoder *encoder) {
+	encoder.String("first_name", req.FirstName)
+	encoder.String("last_name", req.LastName)
+}
+
 // Changes the first and last name of the current user
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SetName(req *SetNameRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetNameContext(context.Background(), req)
+}
+
+// Changes the first and last name of the current user
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SetNameContext(ctx context.Context, req *SetNameRequest) (*Ok, error) {
+	err := client.checkUsersOnly("setName")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setName",
 		},
-		Data: map[string]interface{}{
-			"first_name": req.FirstName,
-			"last_name":  req.LastName,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -12133,15 +17951,31 @@ type SetBioRequest struct {
 	Bio string `json:"bio"`
 }
 
+func (req *SetBioRequest) encode(encoder *encoder) {
+	encoder.String("bio", req.Bio)
+}
+
 // Changes the bio of the current user
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SetBio(req *SetBioRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetBioContext(context.Background(), req)
+}
+
+// Changes the bio of the current user
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SetBioContext(ctx context.Context, req *SetBioRequest) (*Ok, error) {
+	err := client.checkUsersOnly("setBio")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setBio",
 		},
-		Data: map[string]interface{}{
-			"bio": req.Bio,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -12159,15 +17993,31 @@ type SetUsernameRequest struct {
 	Username string `json:"username"`
 }
 
+func (req *SetUsernameRequest) encode(encoder *encoder) {
+	encoder.String("username", req.Username)
+}
+
 // Changes the editable username of the current user
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SetUsername(req *SetUsernameRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetUsernameContext(context.Background(), req)
+}
+
+// Changes the editable username of the current user
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SetUsernameContext(ctx context.Context, req *SetUsernameRequest) (*Ok, error) {
+	err := client.checkUsersOnly("setUsername")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setUsername",
 		},
-		Data: map[string]interface{}{
-			"username": req.Username,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -12187,16 +18037,32 @@ type ToggleUsernameIsActiveRequest struct {
 	IsActive bool `json:"is_active"`
 }
 
+func (req *ToggleUsernameIsActiveRequest) encode(encoder *encoder) {
+	encoder.String("username", req.Username)
+	encoder.Bool("is_active", req.IsActive)
+}
+
 // Changes active state for a username of the current user. The editable username can't be disabled. May return an error with a message "USERNAMES_ACTIVE_TOO_MUCH" if the maximum number of active usernames has been reached
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ToggleUsernameIsActive(req *ToggleUsernameIsActiveRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ToggleUsernameIsActiveContext(context.Background(), req)
+}
+
+// Changes active state for a username of the current user. The editable username can't be disabled. May return an error with a message "USERNAMES_ACTIVE_TOO_MUCH" if the maximum number of active usernames has been reached
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ToggleUsernameIsActiveContext(ctx context.Context, req *ToggleUsernameIsActiveRequest) (*Ok, error) {
+	err := client.checkUsersOnly("toggleUsernameIsActive")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "toggleUsernameIsActive",
 		},
-		Data: map[string]interface{}{
-			"username":  req.Username,
-			"is_active": req.IsActive,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -12214,15 +18080,31 @@ type ReorderActiveUsernamesRequest struct {
 	Usernames []string `json:"usernames"`
 }
 
+func (req *ReorderActiveUsernamesRequest) encode(encoder *encoder) {
+	encoder.Strings("usernames", req.Usernames)
+}
+
 // Changes order of active usernames of the current user
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ReorderActiveUsernames(req *ReorderActiveUsernamesRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ReorderActiveUsernamesContext(context.Background(), req)
+}
+
+// Changes order of active usernames of the current user
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ReorderActiveUsernamesContext(ctx context.Context, req *ReorderActiveUsernamesRequest) (*Ok, error) {
+	err := client.checkUsersOnly("reorderActiveUsernames")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "reorderActiveUsernames",
 		},
-		Data: map[string]interface{}{
-			"usernames": req.Usernames,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -12242,16 +18124,33 @@ type SetEmojiStatusRequest struct {
 	Duration int32 `json:"duration"`
 }
 
+func (req *SetEmojiStatusRequest) encode(encoder *encoder) {
+	encoder.name("emoji_status")
+	encodeEmojiStatus(encoder, req.EmojiStatus)
+	encoder.Int32("duration", req.Duration)
+}
+
 // Changes the emoji status of the current user; for Telegram Premium users only
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SetEmojiStatus(req *SetEmojiStatusRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetEmojiStatusContext(context.Background(), req)
+}
+
+// Changes the emoji status of the current user; for Telegram Premium users only
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SetEmojiStatusContext(ctx context.Context, req *SetEmojiStatusRequest) (*Ok, error) {
+	err := client.checkUsersOnly("setEmojiStatus")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setEmojiStatus",
 		},
-		Data: map[string]interface{}{
-			"emoji_status": req.EmojiStatus,
-			"duration":     req.Duration,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -12269,15 +18168,32 @@ type SetLocationRequest struct {
 	Location *Location `json:"location"`
 }
 
+func (req *SetLocationRequest) encode(encoder *encoder) {
+	encoder.name("location")
+	encodeLocation(encoder, req.Location)
+}
+
 // Changes the location of the current user. Needs to be called if getOption("is_location_visible") is true and location changes for more than 1 kilometer
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SetLocation(req *SetLocationRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetLocationContext(context.Background(), req)
+}
+
+// Changes the location of the current user. Needs to be called if getOption("is_location_visible") is true and location changes for more than 1 kilometer
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SetLocationContext(ctx context.Context, req *SetLocationRequest) (*Ok, error) {
+	err := client.checkUsersOnly("setLocation")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setLocation",
 		},
-		Data: map[string]interface{}{
-			"location": req.Location,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -12297,16 +18213,33 @@ type ChangePhoneNumberRequest struct {
 	Settings *PhoneNumberAuthenticationSettings `json:"settings"`
 }
 
+func (req *ChangePhoneNumberRequest) encode(encoder *encoder) {
+	encoder.String("phone_number", req.PhoneNumber)
+	encoder.name("settings")
+	encodePhoneNumberAuthenticationSettings(encoder, req.Settings)
+}
+
 // Changes the phone number of the user and sends an authentication code to the user's new phone number. On success, returns information about the sent code
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ChangePhoneNumber(req *ChangePhoneNumberRequest) (*AuthenticationCodeInfo, error) {
-	result, err := client.Send(Request{
+	return client.ChangePhoneNumberContext(context.Background(), req)
+}
+
+// Changes the phone number of the user and sends an authentication code to the user's new phone number. On success, returns information about the sent code
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ChangePhoneNumberContext(ctx context.Context, req *ChangePhoneNumberRequest) (*AuthenticationCodeInfo, error) {
+	err := client.checkUsersOnly("changePhoneNumber")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "changePhoneNumber",
 		},
-		Data: map[string]interface{}{
-			"phone_number": req.PhoneNumber,
-			"settings":     req.Settings,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -12320,12 +18253,25 @@ func (client *Client) ChangePhoneNumber(req *ChangePhoneNumberRequest) (*Authent
 }
 
 // Resends the authentication code sent to confirm a new phone number for the current user. Works only if the previously received authenticationCodeInfo next_code_type was not null and the server-specified timeout has passed
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ResendChangePhoneNumberCode() (*AuthenticationCodeInfo, error) {
-	result, err := client.Send(Request{
+	return client.ResendChangePhoneNumberCodeContext(context.Background())
+}
+
+// Resends the authentication code sent to confirm a new phone number for the current user. Works only if the previously received authenticationCodeInfo next_code_type was not null and the server-specified timeout has passed
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ResendChangePhoneNumberCodeContext(ctx context.Context) (*AuthenticationCodeInfo, error) {
+	err := client.checkUsersOnly("resendChangePhoneNumberCode")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "resendChangePhoneNumberCode",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -12343,15 +18289,31 @@ type CheckChangePhoneNumberCodeRequest struct {
 	Code string `json:"code"`
 }
 
+func (req *CheckChangePhoneNumberCodeRequest) encode(encoder *encoder) {
+	encoder.String("code", req.Code)
+}
+
 // Checks the authentication code sent to confirm a new phone number of the user
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) CheckChangePhoneNumberCode(req *CheckChangePhoneNumberCodeRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.CheckChangePhoneNumberCodeContext(context.Background(), req)
+}
+
+// Checks the authentication code sent to confirm a new phone number of the user
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) CheckChangePhoneNumberCodeContext(ctx context.Context, req *CheckChangePhoneNumberCodeRequest) (*Ok, error) {
+	err := client.checkUsersOnly("checkChangePhoneNumberCode")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "checkChangePhoneNumberCode",
 		},
-		Data: map[string]interface{}{
-			"code": req.Code,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -12365,12 +18327,25 @@ func (client *Client) CheckChangePhoneNumberCode(req *CheckChangePhoneNumberCode
 }
 
 // Returns an HTTPS link, which can be used to get information about the current user
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetUserLink() (*UserLink, error) {
-	result, err := client.Send(Request{
+	return client.GetUserLinkContext(context.Background())
+}
+
+// Returns an HTTPS link, which can be used to get information about the current user
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetUserLinkContext(ctx context.Context) (*UserLink, error) {
+	err := client.checkUsersOnly("getUserLink")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getUserLink",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -12388,15 +18363,31 @@ type SearchUserByTokenRequest struct {
 	Token string `json:"token"`
 }
 
+func (req *SearchUserByTokenRequest) encode(encoder *encoder) {
+	encoder.String("token", req.Token)
+}
+
 // Searches a user by a token from the user's link
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SearchUserByToken(req *SearchUserByTokenRequest) (*User, error) {
-	result, err := client.Send(Request{
-		meta: meta{
-			Type: "searchUserByToken",
-		},
-		Data: map[string]interface{}{
-			"token": req.Token,
+	return client.SearchUserByTokenContext(context.Background(), req)
+}
+
+// Searches a user by a token from the user's link
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SearchUserByTokenContext(ctx context.Context, req *SearchUserByTokenRequest) (*User, error) {
+	err := client.checkUsersOnly("searchUserByToken")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
+		meta: meta{
+			Type: "searchUserByToken",
 		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -12418,17 +18409,35 @@ type SetCommandsRequest struct {
 	Commands []*BotCommand `json:"commands"`
 }
 
+func (req *SetCommandsRequest) encode(encoder *encoder) {
+	encoder.name("scope")
+	encodeBotCommandScope(encoder, req.Scope)
+	encoder.String("language_code", req.LanguageCode)
+	encoder.name("commands")
+	encodeListOfBotCommand(encoder, req.Commands)
+}
+
 // Sets the list of commands supported by the bot for the given user scope and language; for bots only
+//
+// Available only to bots, users get FunctionTypeError
 func (client *Client) SetCommands(req *SetCommandsRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetCommandsContext(context.Background(), req)
+}
+
+// Sets the list of commands supported by the bot for the given user scope and language; for bots only
+//
+// Available only to bots, users get FunctionTypeError
+func (client *Client) SetCommandsContext(ctx context.Context, req *SetCommandsRequest) (*Ok, error) {
+	err := client.checkBotsOnly("setCommands")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setCommands",
 		},
-		Data: map[string]interface{}{
-			"scope":         req.Scope,
-			"language_code": req.LanguageCode,
-			"commands":      req.Commands,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -12448,16 +18457,33 @@ type DeleteCommandsRequest struct {
 	LanguageCode string `json:"language_code"`
 }
 
+func (req *DeleteCommandsRequest) encode(encoder *encoder) {
+	encoder.name("scope")
+	encodeBotCommandScope(encoder, req.Scope)
+	encoder.String("language_code", req.LanguageCode)
+}
+
 // Deletes commands supported by the bot for the given user scope and language; for bots only
+//
+// Available only to bots, users get FunctionTypeError
 func (client *Client) DeleteCommands(req *DeleteCommandsRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.DeleteCommandsContext(context.Background(), req)
+}
+
+// Deletes commands supported by the bot for the given user scope and language; for bots only
+//
+// Available only to bots, users get FunctionTypeError
+func (client *Client) DeleteCommandsContext(ctx context.Context, req *DeleteCommandsRequest) (*Ok, error) {
+	err := client.checkBotsOnly("deleteCommands")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "deleteCommands",
 		},
-		Data: map[string]interface{}{
-			"scope":         req.Scope,
-			"language_code": req.LanguageCode,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -12477,16 +18503,33 @@ type GetCommandsRequest struct {
 	LanguageCode string `json:"language_code"`
 }
 
+func (req *GetCommandsRequest) encode(encoder *encoder) {
+	encoder.name("scope")
+	encodeBotCommandScope(encoder, req.Scope)
+	encoder.String("language_code", req.LanguageCode)
+}
+
 // Returns list of commands supported by the bot for the given user scope and language; for bots only
+//
+// Available only to bots, users get FunctionTypeError
 func (client *Client) GetCommands(req *GetCommandsRequest) (*BotCommands, error) {
-	result, err := client.Send(Request{
+	return client.GetCommandsContext(context.Background(), req)
+}
+
+// Returns list of commands supported by the bot for the given user scope and language; for bots only
+//
+// Available only to bots, users get FunctionTypeError
+func (client *Client) GetCommandsContext(ctx context.Context, req *GetCommandsRequest) (*BotCommands, error) {
+	err := client.checkBotsOnly("getCommands")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getCommands",
 		},
-		Data: map[string]interface{}{
-			"scope":         req.Scope,
-			"language_code": req.LanguageCode,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -12506,16 +18549,33 @@ type SetMenuButtonRequest struct {
 	MenuButton *BotMenuButton `json:"menu_button"`
 }
 
+func (req *SetMenuButtonRequest) encode(encoder *encoder) {
+	encoder.Int64("user_id", req.UserId)
+	encoder.name("menu_button")
+	encodeBotMenuButton(encoder, req.MenuButton)
+}
+
 // Sets menu button for the given user or for all users; for bots only
+//
+// Available only to bots, users get FunctionTypeError
 func (client *Client) SetMenuButton(req *SetMenuButtonRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetMenuButtonContext(context.Background(), req)
+}
+
+// Sets menu button for the given user or for all users; for bots only
+//
+// Available only to bots, users get FunctionTypeError
+func (client *Client) SetMenuButtonContext(ctx context.Context, req *SetMenuButtonRequest) (*Ok, error) {
+	err := client.checkBotsOnly("setMenuButton")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setMenuButton",
 		},
-		Data: map[string]interface{}{
-			"user_id":     req.UserId,
-			"menu_button": req.MenuButton,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -12533,15 +18593,31 @@ type GetMenuButtonRequest struct {
 	UserId int64 `json:"user_id"`
 }
 
+func (req *GetMenuButtonRequest) encode(encoder *encoder) {
+	encoder.Int64("user_id", req.UserId)
+}
+
 // Returns menu button set by the bot for the given user; for bots only
+//
+// Available only to bots, users get FunctionTypeError
 func (client *Client) GetMenuButton(req *GetMenuButtonRequest) (*BotMenuButton, error) {
-	result, err := client.Send(Request{
+	return client.GetMenuButtonContext(context.Background(), req)
+}
+
+// Returns menu button set by the bot for the given user; for bots only
+//
+// Available only to bots, users get FunctionTypeError
+func (client *Client) GetMenuButtonContext(ctx context.Context, req *GetMenuButtonRequest) (*BotMenuButton, error) {
+	err := client.checkBotsOnly("getMenuButton")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getMenuButton",
 		},
-		Data: map[string]interface{}{
-			"user_id": req.UserId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -12559,15 +18635,32 @@ type SetDefaultGroupAdministratorRightsRequest struct {
 	DefaultGroupAdministratorRights *ChatAdministratorRights `json:"default_group_administrator_rights"`
 }
 
+func (req *SetDefaultGroupAdministratorRightsRequest) encode(encoder *encoder) {
+	encoder.name("default_group_administrator_rights")
+	encodeChatAdministratorRights(encoder, req.DefaultGroupAdministratorRights)
+}
+
 // Sets default administrator rights for adding the bot to basic group and supergroup chats; for bots only
+//
+// Available only to bots, users get FunctionTypeError
 func (client *Client) SetDefaultGroupAdministratorRights(req *SetDefaultGroupAdministratorRightsRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetDefaultGroupAdministratorRightsContext(context.Background(), req)
+}
+
+// Sets default administrator rights for adding the bot to basic group and supergroup chats; for bots only
+//
+// Available only to bots, users get FunctionTypeError
+func (client *Client) SetDefaultGroupAdministratorRightsContext(ctx context.Context, req *SetDefaultGroupAdministratorRightsRequest) (*Ok, error) {
+	err := client.checkBotsOnly("setDefaultGroupAdministratorRights")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setDefaultGroupAdministratorRights",
 		},
-		Data: map[string]interface{}{
-			"default_group_administrator_rights": req.DefaultGroupAdministratorRights,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -12585,15 +18678,32 @@ type SetDefaultChannelAdministratorRightsRequest struct {
 	DefaultChannelAdministratorRights *ChatAdministratorRights `json:"default_channel_administrator_rights"`
 }
 
+func (req *SetDefaultChannelAdministratorRightsRequest) encode(encoder *encoder) {
+	encoder.name("default_channel_administrator_rights")
+	encodeChatAdministratorRights(encoder, req.DefaultChannelAdministratorRights)
+}
+
 // Sets default administrator rights for adding the bot to channel chats; for bots only
+//
+// Available only to bots, users get FunctionTypeError
 func (client *Client) SetDefaultChannelAdministratorRights(req *SetDefaultChannelAdministratorRightsRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetDefaultChannelAdministratorRightsContext(context.Background(), req)
+}
+
+// Sets default administrator rights for adding the bot to channel chats; for bots only
+//
+// Available only to bots, users get FunctionTypeError
+func (client *Client) SetDefaultChannelAdministratorRightsContext(ctx context.Context, req *SetDefaultChannelAdministratorRightsRequest) (*Ok, error) {
+	err := client.checkBotsOnly("setDefaultChannelAdministratorRights")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setDefaultChannelAdministratorRights",
 		},
-		Data: map[string]interface{}{
-			"default_channel_administrator_rights": req.DefaultChannelAdministratorRights,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -12615,17 +18725,24 @@ type SetBotNameRequest struct {
 	Name string `json:"name"`
 }
 
+func (req *SetBotNameRequest) encode(encoder *encoder) {
+	encoder.Int64("bot_user_id", req.BotUserId)
+	encoder.String("language_code", req.LanguageCode)
+	encoder.String("name", req.Name)
+}
+
 // Sets the name of a bot. Can be called only if userTypeBot.can_be_edited == true
 func (client *Client) SetBotName(req *SetBotNameRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetBotNameContext(context.Background(), req)
+}
+
+// Sets the name of a bot. Can be called only if userTypeBot.can_be_edited == true
+func (client *Client) SetBotNameContext(ctx context.Context, req *SetBotNameRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setBotName",
 		},
-		Data: map[string]interface{}{
-			"bot_user_id":   req.BotUserId,
-			"language_code": req.LanguageCode,
-			"name":          req.Name,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -12645,16 +18762,23 @@ type GetBotNameRequest struct {
 	LanguageCode string `json:"language_code"`
 }
 
+func (req *GetBotNameRequest) encode(encoder *encoder) {
+	encoder.Int64("bot_user_id", req.BotUserId)
+	encoder.String("language_code", req.LanguageCode)
+}
+
 // Returns the name of a bot in the given language. Can be called only if userTypeBot.can_be_edited == true
 func (client *Client) GetBotName(req *GetBotNameRequest) (*Text, error) {
-	result, err := client.Send(Request{
+	return client.GetBotNameContext(context.Background(), req)
+}
+
+// Returns the name of a bot in the given language. Can be called only if userTypeBot.can_be_edited == true
+func (client *Client) GetBotNameContext(ctx context.Context, req *GetBotNameRequest) (*Text, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getBotName",
 		},
-		Data: map[string]interface{}{
-			"bot_user_id":   req.BotUserId,
-			"language_code": req.LanguageCode,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -12674,16 +18798,24 @@ type SetBotProfilePhotoRequest struct {
 	Photo InputChatPhoto `json:"photo"`
 }
 
+func (req *SetBotProfilePhotoRequest) encode(encoder *encoder) {
+	encoder.Int64("bot_user_id", req.BotUserId)
+	encoder.name("photo")
+	encodeInputChatPhoto(encoder, req.Photo)
+}
+
 // Changes a profile photo for a bot
 func (client *Client) SetBotProfilePhoto(req *SetBotProfilePhotoRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetBotProfilePhotoContext(context.Background(), req)
+}
+
+// Changes a profile photo for a bot
+func (client *Client) SetBotProfilePhotoContext(ctx context.Context, req *SetBotProfilePhotoRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setBotProfilePhoto",
 		},
-		Data: map[string]interface{}{
-			"bot_user_id": req.BotUserId,
-			"photo":       req.Photo,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -12705,17 +18837,33 @@ type ToggleBotUsernameIsActiveRequest struct {
 	IsActive bool `json:"is_active"`
 }
 
+func (req *ToggleBotUsernameIsActiveRequest) encode(encoder *encoder) {
+	encoder.Int64("bot_user_id", req.BotUserId)
+	encoder.String("username", req.Username)
+	encoder.Bool("is_active", req.IsActive)
+}
+
 // Changes active state for a username of a bot. The editable username can't be disabled. May return an error with a message "USERNAMES_ACTIVE_TOO_MUCH" if the maximum number of active usernames has been reached. Can be called only if userTypeBot.can_be_edited == true
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ToggleBotUsernameIsActive(req *ToggleBotUsernameIsActiveRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ToggleBotUsernameIsActiveContext(context.Background(), req)
+}
+
+// Changes active state for a username of a bot. The editable username can't be disabled. May return an error with a message "USERNAMES_ACTIVE_TOO_MUCH" if the maximum number of active usernames has been reached. Can be called only if userTypeBot.can_be_edited == true
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ToggleBotUsernameIsActiveContext(ctx context.Context, req *ToggleBotUsernameIsActiveRequest) (*Ok, error) {
+	err := client.checkUsersOnly("toggleBotUsernameIsActive")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "toggleBotUsernameIsActive",
 		},
-		Data: map[string]interface{}{
-			"bot_user_id": req.BotUserId,
-			"username":    req.Username,
-			"is_active":   req.IsActive,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -12735,16 +18883,32 @@ type ReorderActiveBotUsernamesRequest struct {
 	Usernames []string `json:"usernames"`
 }
 
+func (req *ReorderActiveBotUsernamesRequest) encode(encoder *encoder) {
+	encoder.Int64("bot_user_id", req.BotUserId)
+	encoder.Strings("usernames", req.Usernames)
+}
+
 // Changes order of active usernames of a bot. Can be called only if userTypeBot.can_be_edited == true
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ReorderActiveBotUsernames(req *ReorderActiveBotUsernamesRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ReorderActiveBotUsernamesContext(context.Background(), req)
+}
+
+// Changes order of active usernames of a bot. Can be called only if userTypeBot.can_be_edited == true
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ReorderActiveBotUsernamesContext(ctx context.Context, req *ReorderActiveBotUsernamesRequest) (*Ok, error) {
+	err := client.checkUsersOnly("reorderActiveBotUsernames")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "reorderActiveBotUsernames",
 		},
-		Data: map[string]interface{}{
-			"bot_user_id": req.BotUserId,
-			"usernames":   req.Usernames,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -12766,17 +18930,24 @@ type SetBotInfoDescriptionRequest struct {
 	Description string `json:"description"`
 }
 
+func (req *SetBotInfoDescriptionRequest) encode(encoder *encoder) {
+	encoder.Int64("bot_user_id", req.BotUserId)
+	encoder.String("language_code", req.LanguageCode)
+	encoder.String("description", req.Description)
+}
+
 // Sets the text shown in the chat with a bot if the chat is empty. Can be called only if userTypeBot.can_be_edited == true
 func (client *Client) SetBotInfoDescription(req *SetBotInfoDescriptionRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetBotInfoDescriptionContext(context.Background(), req)
+}
+
+// Sets the text shown in the chat with a bot if the chat is empty. Can be called only if userTypeBot.can_be_edited == true
+func (client *Client) SetBotInfoDescriptionContext(ctx context.Context, req *SetBotInfoDescriptionRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setBotInfoDescription",
 		},
-		Data: map[string]interface{}{
-			"bot_user_id":   req.BotUserId,
-			"language_code": req.LanguageCode,
-			"description":   req.Description,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -12796,16 +18967,23 @@ type GetBotInfoDescriptionRequest struct {
 	LanguageCode string `json:"language_code"`
 }
 
+func (req *GetBotInfoDescriptionRequest) encode(encoder *encoder) {
+	encoder.Int64("bot_user_id", req.BotUserId)
+	encoder.String("language_code", req.LanguageCode)
+}
+
 // Returns the text shown in the chat with a bot if the chat is empty in the given language. Can be called only if userTypeBot.can_be_edited == true
 func (client *Client) GetBotInfoDescription(req *GetBotInfoDescriptionRequest) (*Text, error) {
-	result, err := client.Send(Request{
+	return client.GetBotInfoDescriptionContext(context.Background(), req)
+}
+
+// Returns the text shown in the chat with a bot if the chat is empty in the given language. Can be called only if userTypeBot.can_be_edited == true
+func (client *Client) GetBotInfoDescriptionContext(ctx context.Context, req *GetBotInfoDescriptionRequest) (*Text, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getBotInfoDescription",
 		},
-		Data: map[string]interface{}{
-			"bot_user_id":   req.BotUserId,
-			"language_code": req.LanguageCode,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -12827,17 +19005,24 @@ type SetBotInfoShortDescriptionRequest struct {
 	ShortDescription string `json:"short_description"`
 }
 
+func (req *SetBotInfoShortDescriptionRequest) encode(encoder *encoder) {
+	encoder.Int64("bot_user_id", req.BotUserId)
+	encoder.String("language_code", req.LanguageCode)
+	encoder.String("short_description", req.ShortDescription)
+}
+
 // Sets the text shown on a bot's profile page and sent together with the link when users share the bot. Can be called only if userTypeBot.can_be_edited == true
 func (client *Client) SetBotInfoShortDescription(req *SetBotInfoShortDescriptionRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetBotInfoShortDescriptionContext(context.Background(), req)
+}
+
+// Sets the text shown on a bot's profile page and sent together with the link when users share the bot. Can be called only if userTypeBot.can_be_edited == true
+func (client *Client) SetBotInfoShortDescriptionContext(ctx context.Context, req *SetBotInfoShortDescriptionRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setBotInfoShortDescription",
 		},
-		Data: map[string]interface{}{
-			"bot_user_id":       req.BotUserId,
-			"language_code":     req.LanguageCode,
-			"short_description": req.ShortDescription,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -12857,16 +19042,23 @@ type GetBotInfoShortDescriptionRequest struct {
 	LanguageCode string `json:"language_code"`
 }
 
+func (req *GetBotInfoShortDescriptionRequest) encode(encoder *encoder) {
+	encoder.Int64("bot_user_id", req.BotUserId)
+	encoder.String("language_code", req.LanguageCode)
+}
+
 // Returns the text shown on a bot's profile page and sent together with the link when users share the bot in the given language. Can be called only if userTypeBot.can_be_edited == true
 func (client *Client) GetBotInfoShortDescription(req *GetBotInfoShortDescriptionRequest) (*Text, error) {
-	result, err := client.Send(Request{
+	return client.GetBotInfoShortDescriptionContext(context.Background(), req)
+}
+
+// Returns the text shown on a bot's profile page and sent together with the link when users share the bot in the given language. Can be called only if userTypeBot.can_be_edited == true
+func (client *Client) GetBotInfoShortDescriptionContext(ctx context.Context, req *GetBotInfoShortDescriptionRequest) (*Text, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getBotInfoShortDescription",
 		},
-		Data: map[string]interface{}{
-			"bot_user_id":   req.BotUserId,
-			"language_code": req.LanguageCode,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -12880,12 +19072,25 @@ func (client *Client) GetBotInfoShortDescription(req *GetBotInfoShortDescription
 }
 
 // Returns all active sessions of the current user
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetActiveSessions() (*Sessions, error) {
-	result, err := client.Send(Request{
+	return client.GetActiveSessionsContext(context.Background())
+}
+
+// Returns all active sessions of the current user
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetActiveSessionsContext(ctx context.Context) (*Sessions, error) {
+	err := client.checkUsersOnly("getActiveSessions")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getActiveSessions",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -12903,15 +19108,31 @@ type TerminateSessionRequest struct {
 	SessionId JsonInt64 `json:"session_id"`
 }
 
+func (req *TerminateSessionRequest) encode(encoder *encoder) {
+	encoder.JsonInt64("session_id", req.SessionId)
+}
+
 // Terminates a session of the current user
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) TerminateSession(req *TerminateSessionRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.TerminateSessionContext(context.Background(), req)
+}
+
+// Terminates a session of the current user
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) TerminateSessionContext(ctx context.Context, req *TerminateSessionRequest) (*Ok, error) {
+	err := client.checkUsersOnly("terminateSession")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "terminateSession",
 		},
-		Data: map[string]interface{}{
-			"session_id": req.SessionId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -12925,12 +19146,25 @@ func (client *Client) TerminateSession(req *TerminateSessionRequest) (*Ok, error
 }
 
 // Terminates all other sessions of the current user
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) TerminateAllOtherSessions() (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.TerminateAllOtherSessionsContext(context.Background())
+}
+
+// Terminates all other sessions of the current user
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) TerminateAllOtherSessionsContext(ctx context.Context) (*Ok, error) {
+	err := client.checkUsersOnly("terminateAllOtherSessions")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "terminateAllOtherSessions",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -12950,16 +19184,32 @@ type ToggleSessionCanAcceptCallsRequest struct {
 	CanAcceptCalls bool `json:"can_accept_calls"`
 }
 
+func (req *ToggleSessionCanAcceptCallsRequest) encode(encoder *encoder) {
+	encoder.JsonInt64("session_id", req.SessionId)
+	encoder.Bool("can_accept_calls", req.CanAcceptCalls)
+}
+
 // Toggles whether a session can accept incoming calls
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ToggleSessionCanAcceptCalls(req *ToggleSessionCanAcceptCallsRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ToggleSessionCanAcceptCallsContext(context.Background(), req)
+}
+
+// Toggles whether a session can accept incoming calls
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ToggleSessionCanAcceptCallsContext(ctx context.Context, req *ToggleSessionCanAcceptCallsRequest) (*Ok, error) {
+	err := client.checkUsersOnly("toggleSessionCanAcceptCalls")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "toggleSessionCanAcceptCalls",
 		},
-		Data: map[string]interface{}{
-			"session_id":       req.SessionId,
-			"can_accept_calls": req.CanAcceptCalls,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -12979,16 +19229,32 @@ type ToggleSessionCanAcceptSecretChatsRequest struct {
 	CanAcceptSecretChats bool `json:"can_accept_secret_chats"`
 }
 
+func (req *ToggleSessionCanAcceptSecretChatsRequest) encode(encoder *encoder) {
+	encoder.JsonInt64("session_id", req.SessionId)
+	encoder.Bool("can_accept_secret_chats", req.CanAcceptSecretChats)
+}
+
 // Toggles whether a session can accept incoming secret chats
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ToggleSessionCanAcceptSecretChats(req *ToggleSessionCanAcceptSecretChatsRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ToggleSessionCanAcceptSecretChatsContext(context.Background(), req)
+}
+
+// Toggles whether a session can accept incoming secret chats
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ToggleSessionCanAcceptSecretChatsContext(ctx context.Context, req *ToggleSessionCanAcceptSecretChatsRequest) (*Ok, error) {
+	err := client.checkUsersOnly("toggleSessionCanAcceptSecretChats")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "toggleSessionCanAcceptSecretChats",
 		},
-		Data: map[string]interface{}{
-			"session_id":              req.SessionId,
-			"can_accept_secret_chats": req.CanAcceptSecretChats,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -13006,15 +19272,31 @@ type SetInactiveSessionTtlRequest struct {
 	InactiveSessionTtlDays int32 `json:"inactive_session_ttl_days"`
 }
 
+func (req *SetInactiveSessionTtlRequest) encode(encoder *encoder) {
+	encoder.Int32("inactive_session_ttl_days", req.InactiveSessionTtlDays)
+}
+
 // Changes the period of inactivity after which sessions will automatically be terminated
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SetInactiveSessionTtl(req *SetInactiveSessionTtlRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetInactiveSessionTtlContext(context.Background(), req)
+}
+
+// Changes the period of inactivity after which sessions will automatically be terminated
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SetInactiveSessionTtlContext(ctx context.Context, req *SetInactiveSessionTtlRequest) (*Ok, error) {
+	err := client.checkUsersOnly("setInactiveSessionTtl")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setInactiveSessionTtl",
 		},
-		Data: map[string]interface{}{
-			"inactive_session_ttl_days": req.InactiveSessionTtlDays,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -13028,12 +19310,25 @@ func (client *Client) SetInactiveSessionTtl(req *SetInactiveSessionTtlRequest) (
 }
 
 // Returns all website where the current user used Telegram to log in
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetConnectedWebsites() (*ConnectedWebsites, error) {
-	result, err := client.Send(Request{
+	return client.GetConnectedWebsitesContext(context.Background())
+}
+
+// Returns all website where the current user used Telegram to log in
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetConnectedWebsitesContext(ctx context.Context) (*ConnectedWebsites, error) {
+	err := client.checkUsersOnly("getConnectedWebsites")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getConnectedWebsites",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -13051,15 +19346,31 @@ type DisconnectWebsiteRequest struct {
 	WebsiteId JsonInt64 `json:"website_id"`
 }
 
+func (req *DisconnectWebsiteRequest) encode(encoder *encoder) {
+	encoder.JsonInt64("website_id", req.WebsiteId)
+}
+
 // Disconnects website from the current user's Telegram account
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) DisconnectWebsite(req *DisconnectWebsiteRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.DisconnectWebsiteContext(context.Background(), req)
+}
+
+// Disconnects website from the current user's Telegram account
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) DisconnectWebsiteContext(ctx context.Context, req *DisconnectWebsiteRequest) (*Ok, error) {
+	err := client.checkUsersOnly("disconnectWebsite")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "disconnectWebsite",
 		},
-		Data: map[string]interface{}{
-			"website_id": req.WebsiteId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -13073,12 +19384,25 @@ func (client *Client) DisconnectWebsite(req *DisconnectWebsiteRequest) (*Ok, err
 }
 
 // Disconnects all websites from the current user's Telegram account
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) DisconnectAllWebsites() (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.DisconnectAllWebsitesContext(context.Background())
+}
+
+// Disconnects all websites from the current user's Telegram account
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) DisconnectAllWebsitesContext(ctx context.Context) (*Ok, error) {
+	err := client.checkUsersOnly("disconnectAllWebsites")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "disconnectAllWebsites",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -13098,16 +19422,32 @@ type SetSupergroupUsernameRequest struct {
 	Username string `json:"username"`
 }
 
+func (req *SetSupergroupUsernameRequest) encode(encoder *encoder) {
+	encoder.Int64("supergroup_id", req.SupergroupId)
+	encoder.String("username", req.Username)
+}
+
 // Changes the editable username of a supergroup or channel, requires owner privileges in the supergroup or channel
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SetSupergroupUsername(req *SetSupergroupUsernameRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetSupergroupUsernameContext(context.Background(), req)
+}
+
+// Changes the editable username of a supergroup or channel, requires owner privileges in the supergroup or channel
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SetSupergroupUsernameContext(ctx context.Context, req *SetSupergroupUsernameRequest) (*Ok, error) {
+	err := client.checkUsersOnly("setSupergroupUsername")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setSupergroupUsername",
 		},
-		Data: map[string]interface{}{
-			"supergroup_id": req.SupergroupId,
-			"username":      req.Username,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -13129,17 +19469,33 @@ type ToggleSupergroupUsernameIsActiveRequest struct {
 	IsActive bool `json:"is_active"`
 }
 
+func (req *ToggleSupergroupUsernameIsActiveRequest) encode(encoder *encoder) {
+	encoder.Int64("supergroup_id", req.SupergroupId)
+	encoder.String("username", req.Username)
+	encoder.Bool("is_active", req.IsActive)
+}
+
 // Changes active state for a username of a supergroup or channel, requires owner privileges in the supergroup or channel. The editable username can't be disabled. May return an error with a message "USERNAMES_ACTIVE_TOO_MUCH" if the maximum number of active usernames has been reached
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ToggleSupergroupUsernameIsActive(req *ToggleSupergroupUsernameIsActiveRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ToggleSupergroupUsernameIsActiveContext(context.Background(), req)
+}
+
+// Changes active state for a username of a supergroup or channel, requires owner privileges in the supergroup or channel. The editable username can't be disabled. May return an error with a message "USERNAMES_ACTIVE_TOO_MUCH" if the maximum number of active usernames has been reached
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ToggleSupergroupUsernameIsActiveContext(ctx context.Context, req *ToggleSupergroupUsernameIsActiveRequest) (*Ok, error) {
+	err := client.checkUsersOnly("toggleSupergroupUsernameIsActive")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "toggleSupergroupUsernameIsActive",
 		},
-		Data: map[string]interface{}{
-			"supergroup_id": req.SupergroupId,
-			"username":      req.Username,
-			"is_active":     req.IsActive,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -13157,15 +19513,31 @@ type DisableAllSupergroupUsernamesRequest struct {
 	SupergroupId int64 `json:"supergroup_id"`
 }
 
+func (req *DisableAllSupergroupUsernamesRequest) encode(encoder *encoder) {
+	encoder.Int64("supergroup_id", req.SupergroupId)
+}
+
 // Disables all active non-editable usernames of a supergroup or channel, requires owner privileges in the supergroup or channel
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) DisableAllSupergroupUsernames(req *DisableAllSupergroupUsernamesRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.DisableAllSupergroupUsernamesContext(context.Background(), req)
+}
+
+// Disables all active non-editable usernames of a supergroup or channel, requires owner privileges in the supergroup or channel
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) DisableAllSupergroupUsernamesContext(ctx context.Context, req *DisableAllSupergroupUsernamesRequest) (*Ok, error) {
+	err := client.checkUsersOnly("disableAllSupergroupUsernames")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "disableAllSupergroupUsernames",
 		},
-		Data: map[string]interface{}{
-			"supergroup_id": req.SupergroupId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -13185,16 +19557,32 @@ type ReorderSupergroupActiveUsernamesRequest struct {
 	Usernames []string `json:"usernames"`
 }
 
+func (req *ReorderSupergroupActiveUsernamesRequest) encode(encoder *encoder) {
+	encoder.Int64("supergroup_id", req.SupergroupId)
+	encoder.Strings("usernames", req.Usernames)
+}
+
 // Changes order of active usernames of a supergroup or channel, requires owner privileges in the supergroup or channel
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ReorderSupergroupActiveUsernames(req *ReorderSupergroupActiveUsernamesRequest) (*Ok, error) {
-	result, err := client.Send(Request{
-		meta: meta{
-			Type: "reorderSupergroupActiveUsernames",
-		},
-		Data: map[string]interface{}{
-			"supergroup_id": req.SupergroupId,
-			"usernames":     req.Usernames,
-		},
+	return client.ReorderSupergroupActiveUsernamesContext(context.Background(), req)
+}
+
+// Changes order of active usernames of a supergroup or channel, requires owner privileges in the supergroup or channel
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ReorderSupergroupActiveUsernamesContext(ctx context.Context, req *ReorderSupergroupActiveUsernamesRequest) (*Ok, error) {
+	err := client.checkUsersOnly("reorderSupergroupActiveUsernames")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
+		meta: meta{
+			Type: "reorderSupergroupActiveUsernames",
+		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -13214,16 +19602,23 @@ type SetSupergroupStickerSetRequest struct {
 	StickerSetId JsonInt64 `json:"sticker_set_id"`
 }
 
+func (req *SetSupergroupStickerSetRequest) encode(encoder *encoder) {
+	encoder.Int64("supergroup_id", req.SupergroupId)
+	encoder.JsonInt64("sticker_set_id", req.StickerSetId)
+}
+
 // Changes the sticker set of a supergroup; requires can_change_info administrator right
 func (client *Client) SetSupergroupStickerSet(req *SetSupergroupStickerSetRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetSupergroupStickerSetContext(context.Background(), req)
+}
+
+// Changes the sticker set of a supergroup; requires can_change_info administrator right
+func (client *Client) SetSupergroupStickerSetContext(ctx context.Context, req *SetSupergroupStickerSetRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setSupergroupStickerSet",
 		},
-		Data: map[string]interface{}{
-			"supergroup_id":  req.SupergroupId,
-			"sticker_set_id": req.StickerSetId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -13243,16 +19638,32 @@ type ToggleSupergroupSignMessagesRequest struct {
 	SignMessages bool `json:"sign_messages"`
 }
 
+func (req *ToggleSupergroupSignMessagesRequest) encode(encoder *encoder) {
+	encoder.Int64("supergroup_id", req.SupergroupId)
+	encoder.Bool("sign_messages", req.SignMessages)
+}
+
 // Toggles whether sender signature is added to sent messages in a channel; requires can_change_info administrator right
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ToggleSupergroupSignMessages(req *ToggleSupergroupSignMessagesRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ToggleSupergroupSignMessagesContext(context.Background(), req)
+}
+
+// Toggles whether sender signature is added to sent messages in a channel; requires can_change_info administrator right
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ToggleSupergroupSignMessagesContext(ctx context.Context, req *ToggleSupergroupSignMessagesRequest) (*Ok, error) {
+	err := client.checkUsersOnly("toggleSupergroupSignMessages")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "toggleSupergroupSignMessages",
 		},
-		Data: map[string]interface{}{
-			"supergroup_id": req.SupergroupId,
-			"sign_messages": req.SignMessages,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -13272,16 +19683,32 @@ type ToggleSupergroupJoinToSendMessagesRequest struct {
 	JoinToSendMessages bool `json:"join_to_send_messages"`
 }
 
+func (req *ToggleSupergroupJoinToSendMessagesRequest) encode(encoder *encoder) {
+	encoder.Int64("supergroup_id", req.SupergroupId)
+	encoder.Bool("join_to_send_messages", req.JoinToSendMessages)
+}
+
 // Toggles whether joining is mandatory to send messages to a discussion supergroup; requires can_restrict_members administrator right
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ToggleSupergroupJoinToSendMessages(req *ToggleSupergroupJoinToSendMessagesRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ToggleSupergroupJoinToSendMessagesContext(context.Background(), req)
+}
+
+// Toggles whether joining is mandatory to send messages to a discussion supergroup; requires can_restrict_members administrator right
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ToggleSupergroupJoinToSendMessagesContext(ctx context.Context, req *ToggleSupergroupJoinToSendMessagesRequest) (*Ok, error) {
+	err := client.checkUsersOnly("toggleSupergroupJoinToSendMessages")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "toggleSupergroupJoinToSendMessages",
 		},
-		Data: map[string]interface{}{
-			"supergroup_id":         req.SupergroupId,
-			"join_to_send_messages": req.JoinToSendMessages,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -13301,16 +19728,32 @@ type ToggleSupergroupJoinByRequestRequest struct {
 	JoinByRequest bool `json:"join_by_request"`
 }
 
+func (req *ToggleSupergroupJoinByRequestRequest) encode(encoder *encoder) {
+	encoder.Int64("supergroup_id", req.SupergroupId)
+	encoder.Bool("join_by_request", req.JoinByRequest)
+}
+
 // Toggles whether all users directly joining the supergroup need to be approved by supergroup administrators; requires can_restrict_members administrator right
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ToggleSupergroupJoinByRequest(req *ToggleSupergroupJoinByRequestRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ToggleSupergroupJoinByRequestContext(context.Background(), req)
+}
+
+// Toggles whether all users directly joining the supergroup need to be approved by supergroup administrators; requires can_restrict_members administrator right
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ToggleSupergroupJoinByRequestContext(ctx context.Context, req *ToggleSupergroupJoinByRequestRequest) (*Ok, error) {
+	err := client.checkUsersOnly("toggleSupergroupJoinByRequest")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "toggleSupergroupJoinByRequest",
 		},
-		Data: map[string]interface{}{
-			"supergroup_id":   req.SupergroupId,
-			"join_by_request": req.JoinByRequest,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -13330,16 +19773,32 @@ type ToggleSupergroupIsAllHistoryAvailableRequest struct {
 	IsAllHistoryAvailable bool `json:"is_all_history_available"`
 }
 
+func (req *ToggleSupergroupIsAllHistoryAvailableRequest) encode(encoder *encoder) {
+	encoder.Int64("supergroup_id", req.SupergroupId)
+	encoder.Bool("is_all_history_available", req.IsAllHistoryAvailable)
+}
+
 // Toggles whether the message history of a supergroup is available to new members; requires can_change_info administrator right
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ToggleSupergroupIsAllHistoryAvailable(req *ToggleSupergroupIsAllHistoryAvailableRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ToggleSupergroupIsAllHistoryAvailableContext(context.Background(), req)
+}
+
+// Toggles whether the message history of a supergroup is available to new members; requires can_change_info administrator right
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ToggleSupergroupIsAllHistoryAvailableContext(ctx context.Context, req *ToggleSupergroupIsAllHistoryAvailableRequest) (*Ok, error) {
+	err := client.checkUsersOnly("toggleSupergroupIsAllHistoryAvailable")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "toggleSupergroupIsAllHistoryAvailable",
 		},
-		Data: map[string]interface{}{
-			"supergroup_id":            req.SupergroupId,
-			"is_all_history_available": req.IsAllHistoryAvailable,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -13359,16 +19818,32 @@ type ToggleSupergroupHasHiddenMembersRequest struct {
 	HasHiddenMembers bool `json:"has_hidden_members"`
 }
 
+func (req *ToggleSupergroupHasHiddenMembersRequest) encode(encoder *encoder) {
+	encoder.Int64("supergroup_id", req.SupergroupId)
+	encoder.Bool("has_hidden_members", req.HasHiddenMembers)
+}
+
 // Toggles whether non-administrators can receive only administrators and bots using getSupergroupMembers or searchChatMembers. Can be called only if supergroupFullInfo.can_hide_members == true
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ToggleSupergroupHasHiddenMembers(req *ToggleSupergroupHasHiddenMembersRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ToggleSupergroupHasHiddenMembersContext(context.Background(), req)
+}
+
+// Toggles whether non-administrators can receive only administrators and bots using getSupergroupMembers or searchChatMembers. Can be called only if supergroupFullInfo.can_hide_members == true
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ToggleSupergroupHasHiddenMembersContext(ctx context.Context, req *ToggleSupergroupHasHiddenMembersRequest) (*Ok, error) {
+	err := client.checkUsersOnly("toggleSupergroupHasHiddenMembers")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "toggleSupergroupHasHiddenMembers",
 		},
-		Data: map[string]interface{}{
-			"supergroup_id":      req.SupergroupId,
-			"has_hidden_members": req.HasHiddenMembers,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -13388,16 +19863,32 @@ type ToggleSupergroupHasAggressiveAntiSpamEnabledRequest struct {
 	HasAggressiveAntiSpamEnabled bool `json:"has_aggressive_anti_spam_enabled"`
 }
 
+func (req *ToggleSupergroupHasAggressiveAntiSpamEnabledRequest) encode(encoder *encoder) {
+	encoder.Int64("supergroup_id", req.SupergroupId)
+	encoder.Bool("has_aggressive_anti_spam_enabled", req.HasAggressiveAntiSpamEnabled)
+}
+
 // Toggles whether aggressive anti-spam checks are enabled in the supergroup. Can be called only if supergroupFullInfo.can_toggle_aggressive_anti_spam == true
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ToggleSupergroupHasAggressiveAntiSpamEnabled(req *ToggleSupergroupHasAggressiveAntiSpamEnabledRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ToggleSupergroupHasAggressiveAntiSpamEnabledContext(context.Background(), req)
+}
+
+// Toggles whether aggressive anti-spam checks are enabled in the supergroup. Can be called only if supergroupFullInfo.can_toggle_aggressive_anti_spam == true
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ToggleSupergroupHasAggressiveAntiSpamEnabledContext(ctx context.Context, req *ToggleSupergroupHasAggressiveAntiSpamEnabledRequest) (*Ok, error) {
+	err := client.checkUsersOnly("toggleSupergroupHasAggressiveAntiSpamEnabled")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "toggleSupergroupHasAggressiveAntiSpamEnabled",
 		},
-		Data: map[string]interface{}{
-			"supergroup_id":                    req.SupergroupId,
-			"has_aggressive_anti_spam_enabled": req.HasAggressiveAntiSpamEnabled,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -13417,16 +19908,32 @@ type ToggleSupergroupIsForumRequest struct {
 	IsForum bool `json:"is_forum"`
 }
 
+func (req *ToggleSupergroupIsForumRequest) encode(encoder *encoder) {
+	encoder.Int64("supergroup_id", req.SupergroupId)
+	encoder.Bool("is_forum", req.IsForum)
+}
+
 // Toggles whether the supergroup is a forum; requires owner privileges in the supergroup. Discussion supergroups can't be converted to forums
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ToggleSupergroupIsForum(req *ToggleSupergroupIsForumRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ToggleSupergroupIsForumContext(context.Background(), req)
+}
+
+// Toggles whether the supergroup is a forum; requires owner privileges in the supergroup. Discussion supergroups can't be converted to forums
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ToggleSupergroupIsForumContext(ctx context.Context, req *ToggleSupergroupIsForumRequest) (*Ok, error) {
+	err := client.checkUsersOnly("toggleSupergroupIsForum")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "toggleSupergroupIsForum",
 		},
-		Data: map[string]interface{}{
-			"supergroup_id": req.SupergroupId,
-			"is_forum":      req.IsForum,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -13444,15 +19951,31 @@ type ToggleSupergroupIsBroadcastGroupRequest struct {
 	SupergroupId int64 `json:"supergroup_id"`
 }
 
+func (req *ToggleSupergroupIsBroadcastGroupRequest) encode(encoder *encoder) {
+	encoder.Int64("supergroup_id", req.SupergroupId)
+}
+
 // Upgrades supergroup to a broadcast group; requires owner privileges in the supergroup
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ToggleSupergroupIsBroadcastGroup(req *ToggleSupergroupIsBroadcastGroupRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ToggleSupergroupIsBroadcastGroupContext(context.Background(), req)
+}
+
+// Upgrades supergroup to a broadcast group; requires owner privileges in the supergroup
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ToggleSupergroupIsBroadcastGroupContext(ctx context.Context, req *ToggleSupergroupIsBroadcastGroupRequest) (*Ok, error) {
+	err := client.checkUsersOnly("toggleSupergroupIsBroadcastGroup")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "toggleSupergroupIsBroadcastGroup",
 		},
-		Data: map[string]interface{}{
-			"supergroup_id": req.SupergroupId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -13472,16 +19995,32 @@ type ReportSupergroupSpamRequest struct {
 	MessageIds []int64 `json:"message_ids"`
 }
 
+func (req *ReportSupergroupSpamRequest) encode(encoder *encoder) {
+	encoder.Int64("supergroup_id", req.SupergroupId)
+	encoder.Int64s("message_ids", req.MessageIds)
+}
+
 // Reports messages in a supergroup as spam; requires administrator rights in the supergroup
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ReportSupergroupSpam(req *ReportSupergroupSpamRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ReportSupergroupSpamContext(context.Background(), req)
+}
+
+// Reports messages in a supergroup as spam; requires administrator rights in the supergroup
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ReportSupergroupSpamContext(ctx context.Context, req *ReportSupergroupSpamRequest) (*Ok, error) {
+	err := client.checkUsersOnly("reportSupergroupSpam")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "reportSupergroupSpam",
 		},
-		Data: map[string]interface{}{
-			"supergroup_id": req.SupergroupId,
-			"message_ids":   req.MessageIds,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -13501,16 +20040,32 @@ type ReportSupergroupAntiSpamFalsePositiveRequest struct {
 	MessageId int64 `json:"message_id"`
 }
 
+func (req *ReportSupergroupAntiSpamFalsePositiveRequest) encode(encoder *encoder) {
+	encoder.Int64("supergroup_id", req.SupergroupId)
+	encoder.Int64("message_id", req.MessageId)
+}
+
 // Reports a false deletion of a message by aggressive anti-spam checks; requires administrator rights in the supergroup. Can be called only for messages from chatEventMessageDeleted with can_report_anti_spam_false_positive == true
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ReportSupergroupAntiSpamFalsePositive(req *ReportSupergroupAntiSpamFalsePositiveRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ReportSupergroupAntiSpamFalsePositiveContext(context.Background(), req)
+}
+
+// Reports a false deletion of a message by aggressive anti-spam checks; requires administrator rights in the supergroup. Can be called only for messages from chatEventMessageDeleted with can_report_anti_spam_false_positive == true
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ReportSupergroupAntiSpamFalsePositiveContext(ctx context.Context, req *ReportSupergroupAntiSpamFalsePositiveRequest) (*Ok, error) {
+	err := client.checkUsersOnly("reportSupergroupAntiSpamFalsePositive")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "reportSupergroupAntiSpamFalsePositive",
 		},
-		Data: map[string]interface{}{
-			"supergroup_id": req.SupergroupId,
-			"message_id":    req.MessageId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -13534,18 +20089,26 @@ type GetSupergroupMembersRequest struct {
 	Limit int32 `json:"limit"`
 }
 
+func (req *GetSupergroupMembersRequest) encode(encoder *encoder) {
+	encoder.Int64("supergroup_id", req.SupergroupId)
+	encoder.name("filter")
+	encodeSupergroupMembersFilter(encoder, req.Filter)
+	encoder.Int32("offset", req.Offset)
+	encoder.Int32("limit", req.Limit)
+}
+
 // Returns information about members or banned users in a supergroup or channel. Can be used only if supergroupFullInfo.can_get_members == true; additionally, administrator privileges may be required for some filters
 func (client *Client) GetSupergroupMembers(req *GetSupergroupMembersRequest) (*ChatMembers, error) {
-	result, err := client.Send(Request{
+	return client.GetSupergroupMembersContext(context.Background(), req)
+}
+
+// Returns information about members or banned users in a supergroup or channel. Can be used only if supergroupFullInfo.can_get_members == true; additionally, administrator privileges may be required for some filters
+func (client *Client) GetSupergroupMembersContext(ctx context.Context, req *GetSupergroupMembersRequest) (*ChatMembers, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getSupergroupMembers",
 		},
-		Data: map[string]interface{}{
-			"supergroup_id": req.SupergroupId,
-			"filter":        req.Filter,
-			"offset":        req.Offset,
-			"limit":         req.Limit,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -13563,15 +20126,22 @@ type CloseSecretChatRequest struct {
 	SecretChatId int32 `json:"secret_chat_id"`
 }
 
+func (req *CloseSecretChatRequest) encode(encoder *encoder) {
+	encoder.Int32("secret_chat_id", req.SecretChatId)
+}
+
 // Closes a secret chat, effectively transferring its state to secretChatStateClosed
 func (client *Client) CloseSecretChat(req *CloseSecretChatRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.CloseSecretChatContext(context.Background(), req)
+}
+
+// Closes a secret chat, effectively transferring its state to secretChatStateClosed
+func (client *Client) CloseSecretChatContext(ctx context.Context, req *CloseSecretChatRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "closeSecretChat",
 		},
-		Data: map[string]interface{}{
-			"secret_chat_id": req.SecretChatId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -13599,20 +20169,41 @@ type GetChatEventLogRequest struct {
 	UserIds []int64 `json:"user_ids"`
 }
 
+func (req *GetChatEventLogRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.String("query", req.Query)
+	encoder.JsonInt64("from_event_id", req.FromEventId)
+	encoder.Int32("limit", req.Limit)
+	encoder.name("filters")
+	encodeChatEventLogFilters(encoder, req.Filters)
+	encoder.Int64s("user_ids", req.UserIds)
+}
+
+func (req *GetChatEventLogRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns a list of service actions taken by chat members and administrators in the last 48 hours. Available only for supergroups and channels. Requires administrator rights. Returns results in reverse chronological order (i.e., in order of decreasing event_id)
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetChatEventLog(req *GetChatEventLogRequest) (*ChatEvents, error) {
-	result, err := client.Send(Request{
+	return client.GetChatEventLogContext(context.Background(), req)
+}
+
+// Returns a list of service actions taken by chat members and administrators in the last 48 hours. Available only for supergroups and channels. Requires administrator rights. Returns results in reverse chronological order (i.e., in order of decreasing event_id)
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetChatEventLogContext(ctx context.Context, req *GetChatEventLogRequest) (*ChatEvents, error) {
+	err := client.checkUsersOnly("getChatEventLog")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getChatEventLog",
 		},
-		Data: map[string]interface{}{
-			"chat_id":       req.ChatId,
-			"query":         req.Query,
-			"from_event_id": req.FromEventId,
-			"limit":         req.Limit,
-			"filters":       req.Filters,
-			"user_ids":      req.UserIds,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -13632,16 +20223,34 @@ type GetPaymentFormRequest struct {
 	Theme *ThemeParameters `json:"theme"`
 }
 
+func (req *GetPaymentFormRequest) encode(encoder *encoder) {
+	encoder.name("input_invoice")
+	encodeInputInvoice(encoder, req.InputInvoice)
+	encoder.name("theme")
+	encodeThemeParameters(encoder, req.Theme)
+}
+
 // Returns an invoice payment form. This method must be called when the user presses inlineKeyboardButtonBuy
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetPaymentForm(req *GetPaymentFormRequest) (*PaymentForm, error) {
-	result, err := client.Send(Request{
+	return client.GetPaymentFormContext(context.Background(), req)
+}
+
+// Returns an invoice payment form. This method must be called when the user presses inlineKeyboardButtonBuy
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetPaymentFormContext(ctx context.Context, req *GetPaymentFormRequest) (*PaymentForm, error) {
+	err := client.checkUsersOnly("getPaymentForm")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getPaymentForm",
 		},
-		Data: map[string]interface{}{
-			"input_invoice": req.InputInvoice,
-			"theme":         req.Theme,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -13663,17 +20272,35 @@ type ValidateOrderInfoRequest struct {
 	AllowSave bool `json:"allow_save"`
 }
 
+func (req *ValidateOrderInfoRequest) encode(encoder *encoder) {
+	encoder.name("input_invoice")
+	encodeInputInvoice(encoder, req.InputInvoice)
+	encoder.name("order_info")
+	encodeOrderInfo(encoder, req.OrderInfo)
+	encoder.Bool("allow_save", req.AllowSave)
+}
+
 // Validates the order information provided by a user and returns the available shipping options for a flexible invoice
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ValidateOrderInfo(req *ValidateOrderInfoRequest) (*ValidatedOrderInfo, error) {
-	result, err := client.Send(Request{
+	return client.ValidateOrderInfoContext(context.Background(), req)
+}
+
+// Validates the order information provided by a user and returns the available shipping options for a flexible invoice
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ValidateOrderInfoContext(ctx context.Context, req *ValidateOrderInfoRequest) (*ValidatedOrderInfo, error) {
+	err := client.checkUsersOnly("validateOrderInfo")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "validateOrderInfo",
 		},
-		Data: map[string]interface{}{
-			"input_invoice": req.InputInvoice,
-			"order_info":    req.OrderInfo,
-			"allow_save":    req.AllowSave,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -13701,20 +20328,38 @@ type SendPaymentFormRequest struct {
 	TipAmount int64 `json:"tip_amount"`
 }
 
+func (req *SendPaymentFormRequest) encode(encoder *encoder) {
+	encoder.name("input_invoice")
+	encodeInputInvoice(encoder, req.InputInvoice)
+	encoder.JsonInt64("payment_form_id", req.PaymentFormId)
+	encoder.String("order_info_id", req.OrderInfoId)
+	encoder.String("shipping_option_id", req.ShippingOptionId)
+	encoder.name("credentials")
+	encodeInputCredentials(encoder, req.Credentials)
+	encoder.Int64("tip_amount", req.TipAmount)
+}
+
 // Sends a filled-out payment form to the bot for final verification
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SendPaymentForm(req *SendPaymentFormRequest) (*PaymentResult, error) {
-	result, err := client.Send(Request{
+	return client.SendPaymentFormContext(context.Background(), req)
+}
+
+// Sends a filled-out payment form to the bot for final verification
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SendPaymentFormContext(ctx context.Context, req *SendPaymentFormRequest) (*PaymentResult, error) {
+	err := client.checkUsersOnly("sendPaymentForm")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "sendPaymentForm",
 		},
-		Data: map[string]interface{}{
-			"input_invoice":      req.InputInvoice,
-			"payment_form_id":    req.PaymentFormId,
-			"order_info_id":      req.OrderInfoId,
-			"shipping_option_id": req.ShippingOptionId,
-			"credentials":        req.Credentials,
-			"tip_amount":         req.TipAmount,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -13734,16 +20379,36 @@ type GetPaymentReceiptRequest struct {
 	MessageId int64 `json:"message_id"`
 }
 
+func (req *GetPaymentReceiptRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_id", req.MessageId)
+}
+
+func (req *GetPaymentReceiptRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns information about a successful payment
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetPaymentReceipt(req *GetPaymentReceiptRequest) (*PaymentReceipt, error) {
-	result, err := client.Send(Request{
+	return client.GetPaymentReceiptContext(context.Background(), req)
+}
+
+// Returns information about a successful payment
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetPaymentReceiptContext(ctx context.Context, req *GetPaymentReceiptRequest) (*PaymentReceipt, error) {
+	err := client.checkUsersOnly("getPaymentReceipt")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getPaymentReceipt",
 		},
-		Data: map[string]interface{}{
-			"chat_id":    req.ChatId,
-			"message_id": req.MessageId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -13757,12 +20422,25 @@ func (client *Client) GetPaymentReceipt(req *GetPaymentReceiptRequest) (*Payment
 }
 
 // Returns saved order information. Returns a 404 error if there is no saved order information
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetSavedOrderInfo() (*OrderInfo, error) {
-	result, err := client.Send(Request{
+	return client.GetSavedOrderInfoContext(context.Background())
+}
+
+// Returns saved order information. Returns a 404 error if there is no saved order information
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetSavedOrderInfoContext(ctx context.Context) (*OrderInfo, error) {
+	err := client.checkUsersOnly("getSavedOrderInfo")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getSavedOrderInfo",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -13776,12 +20454,25 @@ func (client *Client) GetSavedOrderInfo() (*OrderInfo, error) {
 }
 
 // Deletes saved order information
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) DeleteSavedOrderInfo() (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.DeleteSavedOrderInfoContext(context.Background())
+}
+
+// Deletes saved order information
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) DeleteSavedOrderInfoContext(ctx context.Context) (*Ok, error) {
+	err := client.checkUsersOnly("deleteSavedOrderInfo")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "deleteSavedOrderInfo",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -13795,12 +20486,25 @@ func (client *Client) DeleteSavedOrderInfo() (*Ok, error) {
 }
 
 // Deletes saved credentials for all payment provider bots
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) DeleteSavedCredentials() (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.DeleteSavedCredentialsContext(context.Background())
+}
+
+// Deletes saved credentials for all payment provider bots
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) DeleteSavedCredentialsContext(ctx context.Context) (*Ok, error) {
+	err := client.checkUsersOnly("deleteSavedCredentials")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "deleteSavedCredentials",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -13818,15 +20522,32 @@ type CreateInvoiceLinkRequest struct {
 	Invoice InputMessageContent `json:"invoice"`
 }
 
+func (req *CreateInvoiceLinkRequest) encode(encoder *encoder) {
+	encoder.name("invoice")
+	encodeInputMessageContent(encoder, req.Invoice)
+}
+
 // Creates a link for the given invoice; for bots only
+//
+// Available only to bots, users get FunctionTypeError
 func (client *Client) CreateInvoiceLink(req *CreateInvoiceLinkRequest) (*HttpUrl, error) {
-	result, err := client.Send(Request{
+	return client.CreateInvoiceLinkContext(context.Background(), req)
+}
+
+// Creates a link for the given invoice; for bots only
+//
+// Available only to bots, users get FunctionTypeError
+func (client *Client) CreateInvoiceLinkContext(ctx context.Context, req *CreateInvoiceLinkRequest) (*HttpUrl, error) {
+	err := client.checkBotsOnly("createInvoiceLink")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "createInvoiceLink",
 		},
-		Data: map[string]interface{}{
-			"invoice": req.Invoice,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -13840,12 +20561,25 @@ func (client *Client) CreateInvoiceLink(req *CreateInvoiceLinkRequest) (*HttpUrl
 }
 
 // Returns a user that can be contacted to get support
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetSupportUser() (*User, error) {
-	result, err := client.Send(Request{
+	return client.GetSupportUserContext(context.Background())
+}
+
+// Returns a user that can be contacted to get support
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetSupportUserContext(ctx context.Context) (*User, error) {
+	err := client.checkUsersOnly("getSupportUser")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getSupportUser",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -13863,15 +20597,31 @@ type GetBackgroundsRequest struct {
 	ForDarkTheme bool `json:"for_dark_theme"`
 }
 
+func (req *GetBackgroundsRequest) encode(encoder *encoder) {
+	encoder.Bool("for_dark_theme", req.ForDarkTheme)
+}
+
 // Returns backgrounds installed by the user
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetBackgrounds(req *GetBackgroundsRequest) (*Backgrounds, error) {
-	result, err := client.Send(Request{
+	return client.GetBackgroundsContext(context.Background(), req)
+}
+
+// Returns backgrounds installed by the user
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetBackgroundsContext(ctx context.Context, req *GetBackgroundsRequest) (*Backgrounds, error) {
+	err := client.checkUsersOnly("getBackgrounds")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getBackgrounds",
 		},
-		Data: map[string]interface{}{
-			"for_dark_theme": req.ForDarkTheme,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -13891,16 +20641,33 @@ type GetBackgroundUrlRequest struct {
 	Type BackgroundType `json:"type"`
 }
 
+func (req *GetBackgroundUrlRequest) encode(encoder *encoder) {
+	encoder.String("name", req.Name)
+	encoder.name("type")
+	encodeBackgroundType(encoder, req.Type)
+}
+
 // Constructs a persistent HTTP URL for a background
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetBackgroundUrl(req *GetBackgroundUrlRequest) (*HttpUrl, error) {
-	result, err := client.Send(Request{
+	return client.GetBackgroundUrlContext(context.Background(), req)
+}
+
+// Constructs a persistent HTTP URL for a background
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetBackgroundUrlContext(ctx context.Context, req *GetBackgroundUrlRequest) (*HttpUrl, error) {
+	err := client.checkUsersOnly("getBackgroundUrl")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getBackgroundUrl",
 		},
-		Data: map[string]interface{}{
-			"name": req.Name,
-			"type": req.Type,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -13918,15 +20685,31 @@ type SearchBackgroundRequest struct {
 	Name string `json:"name"`
 }
 
+func (req *SearchBackgroundRequest) encode(encoder *encoder) {
+	encoder.String("name", req.Name)
+}
+
 // Searches for a background by its name
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SearchBackground(req *SearchBackgroundRequest) (*Background, error) {
-	result, err := client.Send(Request{
+	return client.SearchBackgroundContext(context.Background(), req)
+}
+
+// Searches for a background by its name
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SearchBackgroundContext(ctx context.Context, req *SearchBackgroundRequest) (*Background, error) {
+	err := client.checkUsersOnly("searchBackground")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "searchBackground",
 		},
-		Data: map[string]interface{}{
-			"name": req.Name,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -13948,17 +20731,35 @@ type SetBackgroundRequest struct {
 	ForDarkTheme bool `json:"for_dark_theme"`
 }
 
+func (req *SetBackgroundRequest) encode(encoder *encoder) {
+	encoder.name("background")
+	encodeInputBackground(encoder, req.Background)
+	encoder.name("type")
+	encodeBackgroundType(encoder, req.Type)
+	encoder.Bool("for_dark_theme", req.ForDarkTheme)
+}
+
 // Changes the background selected by the user; adds background to the list of installed backgrounds
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SetBackground(req *SetBackgroundRequest) (*Background, error) {
-	result, err := client.Send(Request{
-		meta: meta{
-			Type: "setBackground",
-		},
-		Data: map[string]interface{}{
-			"background":     req.Background,
-			"type":           req.Type,
-			"for_dark_theme": req.ForDarkTheme,
-		},
+	return client.SetBackgroundContext(context.Background(), req)
+}
+
+// Changes the background selected by the user; adds background to the list of installed backgrounds
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SetBackgroundContext(ctx context.Context, req *SetBackgroundRequest) (*Background, error) {
+	err := client.checkUsersOnly("setBackground")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
+		meta: meta{
+			Type: "setBackground",
+		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -13976,15 +20777,31 @@ type RemoveBackgroundRequest struct {
 	BackgroundId JsonInt64 `json:"background_id"`
 }
 
+func (req *RemoveBackgroundRequest) encode(encoder *encoder) {
+	encoder.JsonInt64("background_id", req.BackgroundId)
+}
+
 // Removes background from the list of installed backgrounds
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) RemoveBackground(req *RemoveBackgroundRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.RemoveBackgroundContext(context.Background(), req)
+}
+
+// Removes background from the list of installed backgrounds
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) RemoveBackgroundContext(ctx context.Context, req *RemoveBackgroundRequest) (*Ok, error) {
+	err := client.checkUsersOnly("removeBackground")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "removeBackground",
 		},
-		Data: map[string]interface{}{
-			"background_id": req.BackgroundId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -13998,12 +20815,25 @@ func (client *Client) RemoveBackground(req *RemoveBackgroundRequest) (*Ok, error
 }
 
 // Resets list of installed backgrounds to its default value
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ResetBackgrounds() (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ResetBackgroundsContext(context.Background())
+}
+
+// Resets list of installed backgrounds to its default value
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ResetBackgroundsContext(ctx context.Context) (*Ok, error) {
+	err := client.checkUsersOnly("resetBackgrounds")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "resetBackgrounds",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -14021,15 +20851,31 @@ type GetLocalizationTargetInfoRequest struct {
 	OnlyLocal bool `json:"only_local"`
 }
 
+func (req *GetLocalizationTargetInfoRequest) encode(encoder *encoder) {
+	encoder.Bool("only_local", req.OnlyLocal)
+}
+
 // Returns information about the current localization target. This is an offline request if only_local is true. Can be called before authorization
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetLocalizationTargetInfo(req *GetLocalizationTargetInfoRequest) (*LocalizationTargetInfo, error) {
-	result, err := client.Send(Request{
+	return client.GetLocalizationTargetInfoContext(context.Background(), req)
+}
+
+// Returns information about the current localization target. This is an offline request if only_local is true. Can be called before authorization
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetLocalizationTargetInfoContext(ctx context.Context, req *GetLocalizationTargetInfoRequest) (*LocalizationTargetInfo, error) {
+	err := client.checkUsersOnly("getLocalizationTargetInfo")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getLocalizationTargetInfo",
 		},
-		Data: map[string]interface{}{
-			"only_local": req.OnlyLocal,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -14047,15 +20893,31 @@ type GetLanguagePackInfoRequest struct {
 	LanguagePackId string `json:"language_pack_id"`
 }
 
+func (req *GetLanguagePackInfoRequest) encode(encoder *encoder) {
+	encoder.String("language_pack_id", req.LanguagePackId)
+}
+
 // Returns information about a language pack. Returned language pack identifier may be different from a provided one. Can be called before authorization
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetLanguagePackInfo(req *GetLanguagePackInfoRequest) (*LanguagePackInfo, error) {
-	result, err := client.Send(Request{
+	return client.GetLanguagePackInfoContext(context.Background(), req)
+}
+
+// Returns information about a language pack. Returned language pack identifier may be different from a provided one. Can be called before authorization
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetLanguagePackInfoContext(ctx context.Context, req *GetLanguagePackInfoRequest) (*LanguagePackInfo, error) {
+	err := client.checkUsersOnly("getLanguagePackInfo")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getLanguagePackInfo",
 		},
-		Data: map[string]interface{}{
-			"language_pack_id": req.LanguagePackId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -14075,16 +20937,32 @@ type GetLanguagePackStringsRequest struct {
 	Keys []string `json:"keys"`
 }
 
+func (req *GetLanguagePackStringsRequest) encode(encoder *encoder) {
+	encoder.String("language_pack_id", req.LanguagePackId)
+	encoder.Strings("keys", req.Keys)
+}
+
 // Returns strings from a language pack in the current localization target by their keys. Can be called before authorization
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetLanguagePackStrings(req *GetLanguagePackStringsRequest) (*LanguagePackStrings, error) {
-	result, err := client.Send(Request{
+	return client.GetLanguagePackStringsContext(context.Background(), req)
+}
+
+// Returns strings from a language pack in the current localization target by their keys. Can be called before authorization
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetLanguagePackStringsContext(ctx context.Context, req *GetLanguagePackStringsRequest) (*LanguagePackStrings, error) {
+	err := client.checkUsersOnly("getLanguagePackStrings")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getLanguagePackStrings",
 		},
-		Data: map[string]interface{}{
-			"language_pack_id": req.LanguagePackId,
-			"keys":             req.Keys,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -14102,15 +20980,31 @@ type SynchronizeLanguagePackRequest struct {
 	LanguagePackId string `json:"language_pack_id"`
 }
 
+func (req *SynchronizeLanguagePackRequest) encode(encoder *encoder) {
+	encoder.String("language_pack_id", req.LanguagePackId)
+}
+
 // Fetches the latest versions of all strings from a language pack in the current localization target from the server. This method doesn't need to be called explicitly for the current used/base language packs. Can be called before authorization
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SynchronizeLanguagePack(req *SynchronizeLanguagePackRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SynchronizeLanguagePackContext(context.Background(), req)
+}
+
+// Fetches the latest versions of all strings from a language pack in the current localization target from the server. This method doesn't need to be called explicitly for the current used/base language packs. Can be called before authorization
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SynchronizeLanguagePackContext(ctx context.Context, req *SynchronizeLanguagePackRequest) (*Ok, error) {
+	err := client.checkUsersOnly("synchronizeLanguagePack")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "synchronizeLanguagePack",
 		},
-		Data: map[string]interface{}{
-			"language_pack_id": req.LanguagePackId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -14128,15 +21022,31 @@ type AddCustomServerLanguagePackRequest struct {
 	LanguagePackId string `json:"language_pack_id"`
 }
 
+func (req *AddCustomServerLanguagePackRequest) encode(encoder *encoder) {
+	encoder.String("language_pack_id", req.LanguagePackId)
+}
+
 // Adds a custom server language pack to the list of installed language packs in current localization target. Can be called before authorization
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) AddCustomServerLanguagePack(req *AddCustomServerLanguagePackRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.AddCustomServerLanguagePackContext(context.Background(), req)
+}
+
+// Adds a custom server language pack to the list of installed language packs in current localization target. Can be called before authorization
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) AddCustomServerLanguagePackContext(ctx context.Context, req *AddCustomServerLanguagePackRequest) (*Ok, error) {
+	err := client.checkUsersOnly("addCustomServerLanguagePack")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "addCustomServerLanguagePack",
 		},
-		Data: map[string]interface{}{
-			"language_pack_id": req.LanguagePackId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -14156,16 +21066,34 @@ type SetCustomLanguagePackRequest struct {
 	Strings []*LanguagePackString `json:"strings"`
 }
 
+func (req *SetCustomLanguagePackRequest) encode(encoder *encoder) {
+	encoder.name("info")
+	encodeLanguagePackInfo(encoder, req.Info)
+	encoder.name("strings")
+	encodeListOfLanguagePackString(encoder, req.Strings)
+}
+
 // Adds or changes a custom local language pack to the current localization target
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SetCustomLanguagePack(req *SetCustomLanguagePackRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetCustomLanguagePackContext(context.Background(), req)
+}
+
+// Adds or changes a custom local language pack to the current localization target
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SetCustomLanguagePackContext(ctx context.Context, req *SetCustomLanguagePackRequest) (*Ok, error) {
+	err := client.checkUsersOnly("setCustomLanguagePack")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setCustomLanguagePack",
 		},
-		Data: map[string]interface{}{
-			"info":    req.Info,
-			"strings": req.Strings,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -14183,15 +21111,32 @@ type EditCustomLanguagePackInfoRequest struct {
 	Info *LanguagePackInfo `json:"info"`
 }
 
+func (req *EditCustomLanguagePackInfoRequest) encode(encoder *encoder) {
+	encoder.name("info")
+	encodeLanguagePackInfo(encoder, req.Info)
+}
+
 // Edits information about a custom local language pack in the current localization target. Can be called before authorization
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) EditCustomLanguagePackInfo(req *EditCustomLanguagePackInfoRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.EditCustomLanguagePackInfoContext(context.Background(), req)
+}
+
+// Edits information about a custom local language pack in the current localization target. Can be called before authorization
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) EditCustomLanguagePackInfoContext(ctx context.Context, req *EditCustomLanguagePackInfoRequest) (*Ok, error) {
+	err := client.checkUsersOnly("editCustomLanguagePackInfo")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "editCustomLanguagePackInfo",
 		},
-		Data: map[string]interface{}{
-			"info": req.Info,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -14211,16 +21156,33 @@ type SetCustomLanguagePackStringRequest struct {
 	NewString *LanguagePackString `json:"new_string"`
 }
 
+func (req *SetCustomLanguagePackStringRequest) encode(encoder *encoder) {
+	encoder.String("language_pack_id", req.LanguagePackId)
+	encoder.name("new_string")
+	encodeLanguagePackString(encoder, req.NewString)
+}
+
 // Adds, edits or deletes a string in a custom local language pack. Can be called before authorization
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SetCustomLanguagePackString(req *SetCustomLanguagePackStringRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetCustomLanguagePackStringContext(context.Background(), req)
+}
+
+// Adds, edits or deletes a string in a custom local language pack. Can be called before authorization
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SetCustomLanguagePackStringContext(ctx context.Context, req *SetCustomLanguagePackStringRequest) (*Ok, error) {
+	err := client.checkUsersOnly("setCustomLanguagePackString")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setCustomLanguagePackString",
 		},
-		Data: map[string]interface{}{
-			"language_pack_id": req.LanguagePackId,
-			"new_string":       req.NewString,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -14238,15 +21200,31 @@ type DeleteLanguagePackRequest struct {
 	LanguagePackId string `json:"language_pack_id"`
 }
 
+func (req *DeleteLanguagePackRequest) encode(encoder *encoder) {
+	encoder.String("language_pack_id", req.LanguagePackId)
+}
+
 // Deletes all information about a language pack in the current localization target. The language pack which is currently in use (including base language pack) or is being synchronized can't be deleted. Can be called before authorization
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) DeleteLanguagePack(req *DeleteLanguagePackRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.DeleteLanguagePackContext(context.Background(), req)
+}
+
+// Deletes all information about a language pack in the current localization target. The language pack which is currently in use (including base language pack) or is being synchronized can't be deleted. Can be called before authorization
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) DeleteLanguagePackContext(ctx context.Context, req *DeleteLanguagePackRequest) (*Ok, error) {
+	err := client.checkUsersOnly("deleteLanguagePack")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "deleteLanguagePack",
 		},
-		Data: map[string]interface{}{
-			"language_pack_id": req.LanguagePackId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -14266,16 +21244,33 @@ type RegisterDeviceRequest struct {
 	OtherUserIds []int64 `json:"other_user_ids"`
 }
 
+func (req *RegisterDeviceRequest) encode(encoder *encoder) {
+	encoder.name("device_token")
+	encodeDeviceToken(encoder, req.DeviceToken)
+	encoder.Int64s("other_user_ids", req.OtherUserIds)
+}
+
 // Registers the currently used device for receiving push notifications. Returns a globally unique identifier of the push notification subscription
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) RegisterDevice(req *RegisterDeviceRequest) (*PushReceiverId, error) {
-	result, err := client.Send(Request{
+	return client.RegisterDeviceContext(context.Background(), req)
+}
+
+// Registers the currently used device for receiving push notifications. Returns a globally unique identifier of the push notification subscription
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) RegisterDeviceContext(ctx context.Context, req *RegisterDeviceRequest) (*PushReceiverId, error) {
+	err := client.checkUsersOnly("registerDevice")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "registerDevice",
 		},
-		Data: map[string]interface{}{
-			"device_token":   req.DeviceToken,
-			"other_user_ids": req.OtherUserIds,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -14293,15 +21288,31 @@ type ProcessPushNotificationRequest struct {
 	Payload string `json:"payload"`
 }
 
+func (req *ProcessPushNotificationRequest) encode(encoder *encoder) {
+	encoder.String("payload", req.Payload)
+}
+
 // Handles a push notification. Returns error with code 406 if the push notification is not supported and connection to the server is required to fetch new data. Can be called before authorization
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ProcessPushNotification(req *ProcessPushNotificationRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ProcessPushNotificationContext(context.Background(), req)
+}
+
+// Handles a push notification. Returns error with code 406 if the push notification is not supported and connection to the server is required to fetch new data. Can be called before authorization
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ProcessPushNotificationContext(ctx context.Context, req *ProcessPushNotificationRequest) (*Ok, error) {
+	err := client.checkUsersOnly("processPushNotification")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "processPushNotification",
 		},
-		Data: map[string]interface{}{
-			"payload": req.Payload,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -14319,15 +21330,17 @@ type GetPushReceiverIdRequest struct {
 	Payload string `json:"payload"`
 }
 
+func (req *GetPushReceiverIdRequest) encode(encoder *encoder) {
+	encoder.String("payload", req.Payload)
+}
+
 // Returns a globally unique push notification subscription identifier for identification of an account, which has received a push notification. Can be called synchronously
 func GetPushReceiverId(req *GetPushReceiverIdRequest) (*PushReceiverId, error) {
 	result, err := Execute(Request{
 		meta: meta{
 			Type: "getPushReceiverId",
 		},
-		Data: map[string]interface{}{
-			"payload": req.Payload,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -14343,7 +21356,21 @@ func GetPushReceiverId(req *GetPushReceiverIdRequest) (*PushReceiverId, error) {
 // deprecated
 // Returns a globally unique push notification subscription identifier for identification of an account, which has received a push notification. Can be called synchronously
 func (client *Client) GetPushReceiverId(req *GetPushReceiverIdRequest) (*PushReceiverId, error) {
-	return GetPushReceiverId(req)
+	result, err := client.Execute(Request{
+		meta: meta{
+			Type: "getPushReceiverId",
+		},
+		Data: req,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Type == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return UnmarshalPushReceiverId(result.Data)
 }
 
 type GetRecentlyVisitedTMeUrlsRequest struct {
@@ -14351,15 +21378,31 @@ type GetRecentlyVisitedTMeUrlsRequest struct {
 	Referrer string `json:"referrer"`
 }
 
+func (req *GetRecentlyVisitedTMeUrlsRequest) encode(encoder *encoder) {
+	encoder.String("referrer", req.Referrer)
+}
+
 // Returns t.me URLs recently visited by a newly registered user
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetRecentlyVisitedTMeUrls(req *GetRecentlyVisitedTMeUrlsRequest) (*TMeUrls, error) {
-	result, err := client.Send(Request{
+	return client.GetRecentlyVisitedTMeUrlsContext(context.Background(), req)
+}
+
+// Returns t.me URLs recently visited by a newly registered user
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetRecentlyVisitedTMeUrlsContext(ctx context.Context, req *GetRecentlyVisitedTMeUrlsRequest) (*TMeUrls, error) {
+	err := client.checkUsersOnly("getRecentlyVisitedTMeUrls")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getRecentlyVisitedTMeUrls",
 		},
-		Data: map[string]interface{}{
-			"referrer": req.Referrer,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -14379,16 +21422,34 @@ type SetUserPrivacySettingRulesRequest struct {
 	Rules *UserPrivacySettingRules `json:"rules"`
 }
 
+func (req *SetUserPrivacySettingRulesRequest) encode(encoder *encoder) {
+	encoder.name("setting")
+	encodeUserPrivacySetting(encoder, req.Setting)
+	encoder.name("rules")
+	encodeUserPrivacySettingRules(encoder, req.Rules)
+}
+
 // Changes user privacy settings
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SetUserPrivacySettingRules(req *SetUserPrivacySettingRulesRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetUserPrivacySettingRulesContext(context.Background(), req)
+}
+
+// Changes user privacy settings
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SetUserPrivacySettingRulesContext(ctx context.Context, req *SetUserPrivacySettingRulesRequest) (*Ok, error) {
+	err := client.checkUsersOnly("setUserPrivacySettingRules")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setUserPrivacySettingRules",
 		},
-		Data: map[string]interface{}{
-			"setting": req.Setting,
-			"rules":   req.Rules,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -14406,15 +21467,32 @@ type GetUserPrivacySettingRulesRequest struct {
 	Setting UserPrivacySetting `json:"setting"`
 }
 
+func (req *GetUserPrivacySettingRulesRequest) encode(encoder *encoder) {
+	encoder.name("setting")
+	encodeUserPrivacySetting(encoder, req.Setting)
+}
+
 // Returns the current privacy settings
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetUserPrivacySettingRules(req *GetUserPrivacySettingRulesRequest) (*UserPrivacySettingRules, error) {
-	result, err := client.Send(Request{
+	return client.GetUserPrivacySettingRulesContext(context.Background(), req)
+}
+
+// Returns the current privacy settings
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetUserPrivacySettingRulesContext(ctx context.Context, req *GetUserPrivacySettingRulesRequest) (*UserPrivacySettingRules, error) {
+	err := client.checkUsersOnly("getUserPrivacySettingRules")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getUserPrivacySettingRules",
 		},
-		Data: map[string]interface{}{
-			"setting": req.Setting,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -14432,15 +21510,17 @@ type GetOptionRequest struct {
 	Name string `json:"name"`
 }
 
+func (req *GetOptionRequest) encode(encoder *encoder) {
+	encoder.String("name", req.Name)
+}
+
 // Returns the value of an option by its name. (Check the list of available options on https://core.telegram.org/tdlib/options.) Can be called before authorization. Can be called synchronously for options "version" and "commit_hash"
 func GetOption(req *GetOptionRequest) (OptionValue, error) {
 	result, err := Execute(Request{
 		meta: meta{
 			Type: "getOption",
 		},
-		Data: map[string]interface{}{
-			"name": req.Name,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -14450,28 +21530,27 @@ func GetOption(req *GetOptionRequest) (OptionValue, error) {
 		return nil, buildResponseError(result.Data)
 	}
 
-	switch result.Type {
-	case TypeOptionValueBoolean:
-		return UnmarshalOptionValueBoolean(result.Data)
-
-	case TypeOptionValueEmpty:
-		return UnmarshalOptionValueEmpty(result.Data)
-
-	case TypeOptionValueInteger:
-		return UnmarshalOptionValueInteger(result.Data)
-
-	case TypeOptionValueString:
-		return UnmarshalOptionValueString(result.Data)
-
-	default:
-		return nil, errors.New("invalid type")
-	}
+	return UnmarshalOptionValue(result.Data)
 }
 
 // deprecated
 // Returns the value of an option by its name. (Check the list of available options on https://core.telegram.org/tdlib/options.) Can be called before authorization. Can be called synchronously for options "version" and "commit_hash"
 func (client *Client) GetOption(req *GetOptionRequest) (OptionValue, error) {
-	return GetOption(req)
+	result, err := client.Execute(Request{
+		meta: meta{
+			Type: "getOption",
+		},
+		Data: req,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Type == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return UnmarshalOptionValue(result.Data)
 }
 
 type SetOptionRequest struct {
@@ -14481,16 +21560,24 @@ type SetOptionRequest struct {
 	Value OptionValue `json:"value"`
 }
 
+func (req *SetOptionRequest) encode(encoder *encoder) {
+	encoder.String("name", req.Name)
+	encoder.name("value")
+	encodeOptionValue(encoder, req.Value)
+}
+
 // Sets the value of an option. (Check the list of available options on https://core.telegram.org/tdlib/options.) Only writable options can be set. Can be called before authorization
 func (client *Client) SetOption(req *SetOptionRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetOptionContext(context.Background(), req)
+}
+
+// Sets the value of an option. (Check the list of available options on https://core.telegram.org/tdlib/options.) Only writable options can be set. Can be called before authorization
+func (client *Client) SetOptionContext(ctx context.Context, req *SetOptionRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setOption",
 		},
-		Data: map[string]interface{}{
-			"name":  req.Name,
-			"value": req.Value,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -14508,15 +21595,32 @@ type SetAccountTtlRequest struct {
 	Ttl *AccountTtl `json:"ttl"`
 }
 
+func (req *SetAccountTtlRequest) encode(encoder *encoder) {
+	encoder.name("ttl")
+	encodeAccountTtl(encoder, req.Ttl)
+}
+
 // Changes the period of inactivity after which the account of the current user will automatically be deleted
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SetAccountTtl(req *SetAccountTtlRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetAccountTtlContext(context.Background(), req)
+}
+
+// Changes the period of inactivity after which the account of the current user will automatically be deleted
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SetAccountTtlContext(ctx context.Context, req *SetAccountTtlRequest) (*Ok, error) {
+	err := client.checkUsersOnly("setAccountTtl")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setAccountTtl",
 		},
-		Data: map[string]interface{}{
-			"ttl": req.Ttl,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -14530,12 +21634,25 @@ func (client *Client) SetAccountTtl(req *SetAccountTtlRequest) (*Ok, error) {
 }
 
 // Returns the period of inactivity after which the account of the current user will automatically be deleted
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetAccountTtl() (*AccountTtl, error) {
-	result, err := client.Send(Request{
+	return client.GetAccountTtlContext(context.Background())
+}
+
+// Returns the period of inactivity after which the account of the current user will automatically be deleted
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetAccountTtlContext(ctx context.Context) (*AccountTtl, error) {
+	err := client.checkUsersOnly("getAccountTtl")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getAccountTtl",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -14555,16 +21672,32 @@ type DeleteAccountRequest struct {
 	Password string `json:"password"`
 }
 
+func (req *DeleteAccountRequest) encode(encoder *encoder) {
+	encoder.String("reason", req.Reason)
+	encoder.String("password", req.Password)
+}
+
 // Deletes the account of the current user, deleting all information associated with the user from the server. The phone number of the account can be used to create a new account. Can be called before authorization when the current authorization state is authorizationStateWaitPassword
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) DeleteAccount(req *DeleteAccountRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.DeleteAccountContext(context.Background(), req)
+}
+
+// Deletes the account of the current user, deleting all information associated with the user from the server. The phone number of the account can be used to create a new account. Can be called before authorization when the current authorization state is authorizationStateWaitPassword
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) DeleteAccountContext(ctx context.Context, req *DeleteAccountRequest) (*Ok, error) {
+	err := client.checkUsersOnly("deleteAccount")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "deleteAccount",
 		},
-		Data: map[string]interface{}{
-			"reason":   req.Reason,
-			"password": req.Password,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -14582,15 +21715,32 @@ type SetDefaultMessageAutoDeleteTimeRequest struct {
 	MessageAutoDeleteTime *MessageAutoDeleteTime `json:"message_auto_delete_time"`
 }
 
+func (req *SetDefaultMessageAutoDeleteTimeRequest) encode(encoder *encoder) {
+	encoder.name("message_auto_delete_time")
+	encodeMessageAutoDeleteTime(encoder, req.MessageAutoDeleteTime)
+}
+
 // Changes the default message auto-delete time for new chats
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SetDefaultMessageAutoDeleteTime(req *SetDefaultMessageAutoDeleteTimeRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetDefaultMessageAutoDeleteTimeContext(context.Background(), req)
+}
+
+// Changes the default message auto-delete time for new chats
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SetDefaultMessageAutoDeleteTimeContext(ctx context.Context, req *SetDefaultMessageAutoDeleteTimeRequest) (*Ok, error) {
+	err := client.checkUsersOnly("setDefaultMessageAutoDeleteTime")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setDefaultMessageAutoDeleteTime",
 		},
-		Data: map[string]interface{}{
-			"message_auto_delete_time": req.MessageAutoDeleteTime,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -14604,12 +21754,25 @@ func (client *Client) SetDefaultMessageAutoDeleteTime(req *SetDefaultMessageAuto
 }
 
 // Returns default message auto-delete time setting for new chats
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetDefaultMessageAutoDeleteTime() (*MessageAutoDeleteTime, error) {
-	result, err := client.Send(Request{
+	return client.GetDefaultMessageAutoDeleteTimeContext(context.Background())
+}
+
+// Returns default message auto-delete time setting for new chats
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetDefaultMessageAutoDeleteTimeContext(ctx context.Context) (*MessageAutoDeleteTime, error) {
+	err := client.checkUsersOnly("getDefaultMessageAutoDeleteTime")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getDefaultMessageAutoDeleteTime",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -14627,15 +21790,35 @@ type RemoveChatActionBarRequest struct {
 	ChatId int64 `json:"chat_id"`
 }
 
-// Removes a chat action bar without any other action
-func (client *Client) RemoveChatActionBar(req *RemoveChatActionBarRequest) (*Ok, error) {
-	result, err := client.Send(Request{
-		meta: meta{
+func (req *RemoveChatActionBarRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+}
+
+func (req *RemoveChatActionBarRequest) chatId() int64 {
+	return req.ChatId
+}
+
+// Removes a chat action bar without any other action
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) RemoveChatActionBar(req *RemoveChatActionBarRequest) (*Ok, error) {
+	return client.RemoveChatActionBarContext(context.Background(), req)
+}
+
+// Removes a chat action bar without any other action
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) RemoveChatActionBarContext(ctx context.Context, req *RemoveChatActionBarRequest) (*Ok, error) {
+	err := client.checkUsersOnly("removeChatActionBar")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
+		meta: meta{
 			Type: "removeChatActionBar",
 		},
-		Data: map[string]interface{}{
-			"chat_id": req.ChatId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -14659,18 +21842,39 @@ type ReportChatRequest struct {
 	Text string `json:"text"`
 }
 
+func (req *ReportChatRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64s("message_ids", req.MessageIds)
+	encoder.name("reason")
+	encodeChatReportReason(encoder, req.Reason)
+	encoder.String("text", req.Text)
+}
+
+func (req *ReportChatRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Reports a chat to the Telegram moderators. A chat can be reported only from the chat action bar, or if chat.can_be_reported
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ReportChat(req *ReportChatRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ReportChatContext(context.Background(), req)
+}
+
+// Reports a chat to the Telegram moderators. A chat can be reported only from the chat action bar, or if chat.can_be_reported
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ReportChatContext(ctx context.Context, req *ReportChatRequest) (*Ok, error) {
+	err := client.checkUsersOnly("reportChat")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "reportChat",
 		},
-		Data: map[string]interface{}{
-			"chat_id":     req.ChatId,
-			"message_ids": req.MessageIds,
-			"reason":      req.Reason,
-			"text":        req.Text,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -14694,18 +21898,39 @@ type ReportChatPhotoRequest struct {
 	Text string `json:"text"`
 }
 
+func (req *ReportChatPhotoRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int32("file_id", req.FileId)
+	encoder.name("reason")
+	encodeChatReportReason(encoder, req.Reason)
+	encoder.String("text", req.Text)
+}
+
+func (req *ReportChatPhotoRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Reports a chat photo to the Telegram moderators. A chat photo can be reported only if chat.can_be_reported
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ReportChatPhoto(req *ReportChatPhotoRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ReportChatPhotoContext(context.Background(), req)
+}
+
+// Reports a chat photo to the Telegram moderators. A chat photo can be reported only if chat.can_be_reported
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ReportChatPhotoContext(ctx context.Context, req *ReportChatPhotoRequest) (*Ok, error) {
+	err := client.checkUsersOnly("reportChatPhoto")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "reportChatPhoto",
 		},
-		Data: map[string]interface{}{
-			"chat_id": req.ChatId,
-			"file_id": req.FileId,
-			"reason":  req.Reason,
-			"text":    req.Text,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -14727,17 +21952,38 @@ type ReportMessageReactionsRequest struct {
 	SenderId MessageSender `json:"sender_id"`
 }
 
+func (req *ReportMessageReactionsRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_id", req.MessageId)
+	encoder.name("sender_id")
+	encodeMessageSender(encoder, req.SenderId)
+}
+
+func (req *ReportMessageReactionsRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Reports reactions set on a message to the Telegram moderators. Reactions on a message can be reported only if message.can_report_reactions
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ReportMessageReactions(req *ReportMessageReactionsRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ReportMessageReactionsContext(context.Background(), req)
+}
+
+// Reports reactions set on a message to the Telegram moderators. Reactions on a message can be reported only if message.can_report_reactions
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ReportMessageReactionsContext(ctx context.Context, req *ReportMessageReactionsRequest) (*Ok, error) {
+	err := client.checkUsersOnly("reportMessageReactions")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "reportMessageReactions",
 		},
-		Data: map[string]interface{}{
-			"chat_id":    req.ChatId,
-			"message_id": req.MessageId,
-			"sender_id":  req.SenderId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -14757,16 +22003,36 @@ type GetChatStatisticsRequest struct {
 	IsDark bool `json:"is_dark"`
 }
 
+func (req *GetChatStatisticsRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Bool("is_dark", req.IsDark)
+}
+
+func (req *GetChatStatisticsRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns detailed statistics about a chat. Currently, this method can be used only for supergroups and channels. Can be used only if supergroupFullInfo.can_get_statistics == true
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetChatStatistics(req *GetChatStatisticsRequest) (ChatStatistics, error) {
-	result, err := client.Send(Request{
+	return client.GetChatStatisticsContext(context.Background(), req)
+}
+
+// Returns detailed statistics about a chat. Currently, this method can be used only for supergroups and channels. Can be used only if supergroupFullInfo.can_get_statistics == true
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetChatStatisticsContext(ctx context.Context, req *GetChatStatisticsRequest) (ChatStatistics, error) {
+	err := client.checkUsersOnly("getChatStatistics")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getChatStatistics",
 		},
-		Data: map[string]interface{}{
-			"chat_id": req.ChatId,
-			"is_dark": req.IsDark,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -14776,16 +22042,7 @@ func (client *Client) GetChatStatistics(req *GetChatStatisticsRequest) (ChatStat
 		return nil, buildResponseError(result.Data)
 	}
 
-	switch result.Type {
-	case TypeChatStatisticsSupergroup:
-		return UnmarshalChatStatisticsSupergroup(result.Data)
-
-	case TypeChatStatisticsChannel:
-		return UnmarshalChatStatisticsChannel(result.Data)
-
-	default:
-		return nil, errors.New("invalid type")
-	}
+	return UnmarshalChatStatistics(result.Data)
 }
 
 type GetMessageStatisticsRequest struct {
@@ -14797,17 +22054,37 @@ type GetMessageStatisticsRequest struct {
 	IsDark bool `json:"is_dark"`
 }
 
+func (req *GetMessageStatisticsRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.Int64("message_id", req.MessageId)
+	encoder.Bool("is_dark", req.IsDark)
+}
+
+func (req *GetMessageStatisticsRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns detailed statistics about a message. Can be used only if message.can_get_statistics == true
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetMessageStatistics(req *GetMessageStatisticsRequest) (*MessageStatistics, error) {
-	result, err := client.Send(Request{
+	return client.GetMessageStatisticsContext(context.Background(), req)
+}
+
+// Returns detailed statistics about a message. Can be used only if message.can_get_statistics == true
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetMessageStatisticsContext(ctx context.Context, req *GetMessageStatisticsRequest) (*MessageStatistics, error) {
+	err := client.checkUsersOnly("getMessageStatistics")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getMessageStatistics",
 		},
-		Data: map[string]interface{}{
-			"chat_id":    req.ChatId,
-			"message_id": req.MessageId,
-			"is_dark":    req.IsDark,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -14829,17 +22106,37 @@ type GetStatisticalGraphRequest struct {
 	X int64 `json:"x"`
 }
 
+func (req *GetStatisticalGraphRequest) encode(encoder *encoder) {
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.String("token", req.Token)
+	encoder.Int64("x", req.X)
+}
+
+func (req *GetStatisticalGraphRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Loads an asynchronous or a zoomed in statistical graph
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetStatisticalGraph(req *GetStatisticalGraphRequest) (StatisticalGraph, error) {
-	result, err := client.Send(Request{
+	return client.GetStatisticalGraphContext(context.Background(), req)
+}
+
+// Loads an asynchronous or a zoomed in statistical graph
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetStatisticalGraphContext(ctx context.Context, req *GetStatisticalGraphRequest) (StatisticalGraph, error) {
+	err := client.checkUsersOnly("getStatisticalGraph")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getStatisticalGraph",
 		},
-		Data: map[string]interface{}{
-			"chat_id": req.ChatId,
-			"token":   req.Token,
-			"x":       req.X,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -14849,19 +22146,7 @@ func (client *Client) GetStatisticalGraph(req *GetStatisticalGraphRequest) (Stat
 		return nil, buildResponseError(result.Data)
 	}
 
-	switch result.Type {
-	case TypeStatisticalGraphData:
-		return UnmarshalStatisticalGraphData(result.Data)
-
-	case TypeStatisticalGraphAsync:
-		return UnmarshalStatisticalGraphAsync(result.Data)
-
-	case TypeStatisticalGraphError:
-		return UnmarshalStatisticalGraphError(result.Data)
-
-	default:
-		return nil, errors.New("invalid type")
-	}
+	return UnmarshalStatisticalGraph(result.Data)
 }
 
 type GetStorageStatisticsRequest struct {
@@ -14869,15 +22154,22 @@ type GetStorageStatisticsRequest struct {
 	ChatLimit int32 `json:"chat_limit"`
 }
 
+func (req *GetStorageStatisticsRequest) encode(encoder *encoder) {
+	encoder.Int32("chat_limit", req.ChatLimit)
+}
+
 // Returns storage usage statistics. Can be called before authorization
 func (client *Client) GetStorageStatistics(req *GetStorageStatisticsRequest) (*StorageStatistics, error) {
-	result, err := client.Send(Request{
+	return client.GetStorageStatisticsContext(context.Background(), req)
+}
+
+// Returns storage usage statistics. Can be called before authorization
+func (client *Client) GetStorageStatisticsContext(ctx context.Context, req *GetStorageStatisticsRequest) (*StorageStatistics, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getStorageStatistics",
 		},
-		Data: map[string]interface{}{
-			"chat_limit": req.ChatLimit,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -14891,12 +22183,25 @@ func (client *Client) GetStorageStatistics(req *GetStorageStatisticsRequest) (*S
 }
 
 // Quickly returns approximate storage usage statistics. Can be called before authorization
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetStorageStatisticsFast() (*StorageStatisticsFast, error) {
-	result, err := client.Send(Request{
+	return client.GetStorageStatisticsFastContext(context.Background())
+}
+
+// Quickly returns approximate storage usage statistics. Can be called before authorization
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetStorageStatisticsFastContext(ctx context.Context) (*StorageStatisticsFast, error) {
+	err := client.checkUsersOnly("getStorageStatisticsFast")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getStorageStatisticsFast",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -14911,11 +22216,15 @@ func (client *Client) GetStorageStatisticsFast() (*StorageStatisticsFast, error)
 
 // Returns database statistics
 func (client *Client) GetDatabaseStatistics() (*DatabaseStatistics, error) {
-	result, err := client.Send(Request{
+	return client.GetDatabaseStatisticsContext(context.Background())
+}
+
+// Returns database statistics
+func (client *Client) GetDatabaseStatisticsContext(ctx context.Context) (*DatabaseStatistics, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getDatabaseStatistics",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -14933,15 +22242,22 @@ type GetMemoryStatisticsRequest struct {
 	Full bool `json:"full"`
 }
 
+func (req *GetMemoryStatisticsRequest) encode(encoder *encoder) {
+	encoder.Bool("full", req.Full)
+}
+
 // Returns memory statistics
 func (client *Client) GetMemoryStatistics(req *GetMemoryStatisticsRequest) (*MemoryStatistics, error) {
-	result, err := client.Send(Request{
+	return client.GetMemoryStatisticsContext(context.Background(), req)
+}
+
+// Returns memory statistics
+func (client *Client) GetMemoryStatisticsContext(ctx context.Context, req *GetMemoryStatisticsRequest) (*MemoryStatistics, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getMemoryStatistics",
 		},
-		Data: map[string]interface{}{
-			"full": req.Full,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -14975,23 +22291,31 @@ type OptimizeStorageRequest struct {
 	ChatLimit int32 `json:"chat_limit"`
 }
 
+func (req *OptimizeStorageRequest) encode(encoder *encoder) {
+	encoder.Int64("size", req.Size)
+	encoder.Int32("ttl", req.Ttl)
+	encoder.Int32("count", req.Count)
+	encoder.Int32("immunity_delay", req.ImmunityDelay)
+	encoder.name("file_types")
+	encodeListOfFileType(encoder, req.FileTypes)
+	encoder.Int64s("chat_ids", req.ChatIds)
+	encoder.Int64s("exclude_chat_ids", req.ExcludeChatIds)
+	encoder.Bool("return_deleted_file_statistics", req.ReturnDeletedFileStatistics)
+	encoder.Int32("chat_limit", req.ChatLimit)
+}
+
 // Optimizes storage usage, i.e. deletes some files and returns new storage usage statistics. Secret thumbnails can't be deleted
 func (client *Client) OptimizeStorage(req *OptimizeStorageRequest) (*StorageStatistics, error) {
-	result, err := client.Send(Request{
+	return client.OptimizeStorageContext(context.Background(), req)
+}
+
+// Optimizes storage usage, i.e. deletes some files and returns new storage usage statistics. Secret thumbnails can't be deleted
+func (client *Client) OptimizeStorageContext(ctx context.Context, req *OptimizeStorageRequest) (*StorageStatistics, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "optimizeStorage",
 		},
-		Data: map[string]interface{}{
-			"size":                           req.Size,
-			"ttl":                            req.Ttl,
-			"count":                          req.Count,
-			"immunity_delay":                 req.ImmunityDelay,
-			"file_types":                     req.FileTypes,
-			"chat_ids":                       req.ChatIds,
-			"exclude_chat_ids":               req.ExcludeChatIds,
-			"return_deleted_file_statistics": req.ReturnDeletedFileStatistics,
-			"chat_limit":                     req.ChatLimit,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -15009,15 +22333,23 @@ type SetNetworkTypeRequest struct {
 	Type NetworkType `json:"type"`
 }
 
+func (req *SetNetworkTypeRequest) encode(encoder *encoder) {
+	encoder.name("type")
+	encodeNetworkType(encoder, req.Type)
+}
+
 // Sets the current network type. Can be called before authorization. Calling this method forces all network connections to reopen, mitigating the delay in switching between different networks, so it must be called whenever the network is changed, even if the network type remains the same. Network type is used to check whether the library can use the network at all and also for collecting detailed network data usage statistics
 func (client *Client) SetNetworkType(req *SetNetworkTypeRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetNetworkTypeContext(context.Background(), req)
+}
+
+// Sets the current network type. Can be called before authorization. Calling this method forces all network connections to reopen, mitigating the delay in switching between different networks, so it must be called whenever the network is changed, even if the network type remains the same. Network type is used to check whether the library can use the network at all and also for collecting detailed network data usage statistics
+func (client *Client) SetNetworkTypeContext(ctx context.Context, req *SetNetworkTypeRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setNetworkType",
 		},
-		Data: map[string]interface{}{
-			"type": req.Type,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -15035,15 +22367,22 @@ type GetNetworkStatisticsRequest struct {
 	OnlyCurrent bool `json:"only_current"`
 }
 
+func (req *GetNetworkStatisticsRequest) encode(encoder *encoder) {
+	encoder.Bool("only_current", req.OnlyCurrent)
+}
+
 // Returns network data usage statistics. Can be called before authorization
 func (client *Client) GetNetworkStatistics(req *GetNetworkStatisticsRequest) (*NetworkStatistics, error) {
-	result, err := client.Send(Request{
+	return client.GetNetworkStatisticsContext(context.Background(), req)
+}
+
+// Returns network data usage statistics. Can be called before authorization
+func (client *Client) GetNetworkStatisticsContext(ctx context.Context, req *GetNetworkStatisticsRequest) (*NetworkStatistics, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getNetworkStatistics",
 		},
-		Data: map[string]interface{}{
-			"only_current": req.OnlyCurrent,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -15061,15 +22400,23 @@ type AddNetworkStatisticsRequest struct {
 	Entry NetworkStatisticsEntry `json:"entry"`
 }
 
+func (req *AddNetworkStatisticsRequest) encode(encoder *encoder) {
+	encoder.name("entry")
+	encodeNetworkStatisticsEntry(encoder, req.Entry)
+}
+
 // Adds the specified data to data usage statistics. Can be called before authorization
 func (client *Client) AddNetworkStatistics(req *AddNetworkStatisticsRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.AddNetworkStatisticsContext(context.Background(), req)
+}
+
+// Adds the specified data to data usage statistics. Can be called before authorization
+func (client *Client) AddNetworkStatisticsContext(ctx context.Context, req *AddNetworkStatisticsRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "addNetworkStatistics",
 		},
-		Data: map[string]interface{}{
-			"entry": req.Entry,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -15084,11 +22431,15 @@ func (client *Client) AddNetworkStatistics(req *AddNetworkStatisticsRequest) (*O
 
 // Resets all network data usage statistics to zero. Can be called before authorization
 func (client *Client) ResetNetworkStatistics() (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ResetNetworkStatisticsContext(context.Background())
+}
+
+// Resets all network data usage statistics to zero. Can be called before authorization
+func (client *Client) ResetNetworkStatisticsContext(ctx context.Context) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "resetNetworkStatistics",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -15102,12 +22453,25 @@ func (client *Client) ResetNetworkStatistics() (*Ok, error) {
 }
 
 // Returns auto-download settings presets for the current user
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetAutoDownloadSettingsPresets() (*AutoDownloadSettingsPresets, error) {
-	result, err := client.Send(Request{
+	return client.GetAutoDownloadSettingsPresetsContext(context.Background())
+}
+
+// Returns auto-download settings presets for the current user
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetAutoDownloadSettingsPresetsContext(ctx context.Context) (*AutoDownloadSettingsPresets, error) {
+	err := client.checkUsersOnly("getAutoDownloadSettingsPresets")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getAutoDownloadSettingsPresets",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -15127,16 +22491,34 @@ type SetAutoDownloadSettingsRequest struct {
 	Type NetworkType `json:"type"`
 }
 
+func (req *SetAutoDownloadSettingsRequest) encode(encoder *encoder) {
+	encoder.name("settings")
+	encodeAutoDownloadSettings(encoder, req.Settings)
+	encoder.name("type")
+	encodeNetworkType(encoder, req.Type)
+}
+
 // Sets auto-download settings
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SetAutoDownloadSettings(req *SetAutoDownloadSettingsRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetAutoDownloadSettingsContext(context.Background(), req)
+}
+
+// Sets auto-download settings
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SetAutoDownloadSettingsContext(ctx context.Context, req *SetAutoDownloadSettingsRequest) (*Ok, error) {
+	err := client.checkUsersOnly("setAutoDownloadSettings")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setAutoDownloadSettings",
 		},
-		Data: map[string]interface{}{
-			"settings": req.Settings,
-			"type":     req.Type,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -15150,12 +22532,25 @@ func (client *Client) SetAutoDownloadSettings(req *SetAutoDownloadSettingsReques
 }
 
 // Returns autosave settings for the current user
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetAutosaveSettings() (*AutosaveSettings, error) {
-	result, err := client.Send(Request{
+	return client.GetAutosaveSettingsContext(context.Background())
+}
+
+// Returns autosave settings for the current user
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetAutosaveSettingsContext(ctx context.Context) (*AutosaveSettings, error) {
+	err := client.checkUsersOnly("getAutosaveSettings")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getAutosaveSettings",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -15175,16 +22570,34 @@ type SetAutosaveSettingsRequest struct {
 	Settings *ScopeAutosaveSettings `json:"settings"`
 }
 
+func (req *SetAutosaveSettingsRequest) encode(encoder *encoder) {
+	encoder.name("scope")
+	encodeAutosaveSettingsScope(encoder, req.Scope)
+	encoder.name("settings")
+	encodeScopeAutosaveSettings(encoder, req.Settings)
+}
+
 // Sets autosave settings for the given scope. The method is guaranteed to work only after at least one call to getAutosaveSettings
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SetAutosaveSettings(req *SetAutosaveSettingsRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetAutosaveSettingsContext(context.Background(), req)
+}
+
+// Sets autosave settings for the given scope. The method is guaranteed to work only after at least one call to getAutosaveSettings
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SetAutosaveSettingsContext(ctx context.Context, req *SetAutosaveSettingsRequest) (*Ok, error) {
+	err := client.checkUsersOnly("setAutosaveSettings")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setAutosaveSettings",
 		},
-		Data: map[string]interface{}{
-			"scope":    req.Scope,
-			"settings": req.Settings,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -15198,12 +22611,25 @@ func (client *Client) SetAutosaveSettings(req *SetAutosaveSettingsRequest) (*Ok,
 }
 
 // Clears the list of all autosave settings exceptions. The method is guaranteed to work only after at least one call to getAutosaveSettings
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ClearAutosaveSettingsExceptions() (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ClearAutosaveSettingsExceptionsContext(context.Background())
+}
+
+// Clears the list of all autosave settings exceptions. The method is guaranteed to work only after at least one call to getAutosaveSettings
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ClearAutosaveSettingsExceptionsContext(ctx context.Context) (*Ok, error) {
+	err := client.checkUsersOnly("clearAutosaveSettingsExceptions")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "clearAutosaveSettingsExceptions",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -15221,15 +22647,31 @@ type GetBankCardInfoRequest struct {
 	BankCardNumber string `json:"bank_card_number"`
 }
 
+func (req *GetBankCardInfoRequest) encode(encoder *encoder) {
+	encoder.String("bank_card_number", req.BankCardNumber)
+}
+
 // Returns information about a bank card
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetBankCardInfo(req *GetBankCardInfoRequest) (*BankCardInfo, error) {
-	result, err := client.Send(Request{
+	return client.GetBankCardInfoContext(context.Background(), req)
+}
+
+// Returns information about a bank card
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetBankCardInfoContext(ctx context.Context, req *GetBankCardInfoRequest) (*BankCardInfo, error) {
+	err := client.checkUsersOnly("getBankCardInfo")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getBankCardInfo",
 		},
-		Data: map[string]interface{}{
-			"bank_card_number": req.BankCardNumber,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -15249,16 +22691,33 @@ type GetPassportElementRequest struct {
 	Password string `json:"password"`
 }
 
+func (req *GetPassportElementRequest) encode(encoder *encoder) {
+	encoder.name("type")
+	encodePassportElementType(encoder, req.Type)
+	encoder.String("password", req.Password)
+}
+
 // Returns one of the available Telegram Passport elements
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetPassportElement(req *GetPassportElementRequest) (PassportElement, error) {
-	result, err := client.Send(Request{
+	return client.GetPassportElementContext(context.Background(), req)
+}
+
+// Returns one of the available Telegram Passport elements
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetPassportElementContext(ctx context.Context, req *GetPassportElementRequest) (PassportElement, error) {
+	err := client.checkUsersOnly("getPassportElement")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getPassportElement",
 		},
-		Data: map[string]interface{}{
-			"type":     req.Type,
-			"password": req.Password,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -15268,49 +22727,7 @@ func (client *Client) GetPassportElement(req *GetPassportElementRequest) (Passpo
 		return nil, buildResponseError(result.Data)
 	}
 
-	switch result.Type {
-	case TypePassportElementPersonalDetails:
-		return UnmarshalPassportElementPersonalDetails(result.Data)
-
-	case TypePassportElementPassport:
-		return UnmarshalPassportElementPassport(result.Data)
-
-	case TypePassportElementDriverLicense:
-		return UnmarshalPassportElementDriverLicense(result.Data)
-
-	case TypePassportElementIdentityCard:
-		return UnmarshalPassportElementIdentityCard(result.Data)
-
-	case TypePassportElementInternalPassport:
-		return UnmarshalPassportElementInternalPassport(result.Data)
-
-	case TypePassportElementAddress:
-		return UnmarshalPassportElementAddress(result.Data)
-
-	case TypePassportElementUtilityBill:
-		return UnmarshalPassportElementUtilityBill(result.Data)
-
-	case TypePassportElementBankStatement:
-		return UnmarshalPassportElementBankStatement(result.Data)
-
-	case TypePassportElementRentalAgreement:
-		return UnmarshalPassportElementRentalAgreement(result.Data)
-
-	case TypePassportElementPassportRegistration:
-		return UnmarshalPassportElementPassportRegistration(result.Data)
-
-	case TypePassportElementTemporaryRegistration:
-		return UnmarshalPassportElementTemporaryRegistration(result.Data)
-
-	case TypePassportElementPhoneNumber:
-		return UnmarshalPassportElementPhoneNumber(result.Data)
-
-	case TypePassportElementEmailAddress:
-		return UnmarshalPassportElementEmailAddress(result.Data)
-
-	default:
-		return nil, errors.New("invalid type")
-	}
+	return UnmarshalPassportElement(result.Data)
 }
 
 type GetAllPassportElementsRequest struct {
@@ -15318,15 +22735,31 @@ type GetAllPassportElementsRequest struct {
 	Password string `json:"password"`
 }
 
+func (req *GetAllPassportElementsRequest) encode(encoder *encoder) {
+	encoder.String("password", req.Password)
+}
+
 // Returns all available Telegram Passport elements
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetAllPassportElements(req *GetAllPassportElementsRequest) (*PassportElements, error) {
-	result, err := client.Send(Request{
+	return client.GetAllPassportElementsContext(context.Background(), req)
+}
+
+// Returns all available Telegram Passport elements
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetAllPassportElementsContext(ctx context.Context, req *GetAllPassportElementsRequest) (*PassportElements, error) {
+	err := client.checkUsersOnly("getAllPassportElements")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getAllPassportElements",
 		},
-		Data: map[string]interface{}{
-			"password": req.Password,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -15346,16 +22779,33 @@ type SetPassportElementRequest struct {
 	Password string `json:"password"`
 }
 
+func (req *SetPassportElementRequest) encode(encoder *encoder) {
+	encoder.name("element")
+	encodeInputPassportElement(encoder, req.Element)
+	encoder.String("password", req.Password)
+}
+
 // Adds an element to the user's Telegram Passport. May return an error with a message "PHONE_VERIFICATION_NEEDED" or "EMAIL_VERIFICATION_NEEDED" if the chosen phone number or the chosen email address must be verified first
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SetPassportElement(req *SetPassportElementRequest) (PassportElement, error) {
-	result, err := client.Send(Request{
+	return client.SetPassportElementContext(context.Background(), req)
+}
+
+// Adds an element to the user's Telegram Passport. May return an error with a message "PHONE_VERIFICATION_NEEDED" or "EMAIL_VERIFICATION_NEEDED" if the chosen phone number or the chosen email address must be verified first
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SetPassportElementContext(ctx context.Context, req *SetPassportElementRequest) (PassportElement, error) {
+	err := client.checkUsersOnly("setPassportElement")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setPassportElement",
 		},
-		Data: map[string]interface{}{
-			"element":  req.Element,
-			"password": req.Password,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -15365,49 +22815,7 @@ func (client *Client) SetPassportElement(req *SetPassportElementRequest) (Passpo
 		return nil, buildResponseError(result.Data)
 	}
 
-	switch result.Type {
-	case TypePassportElementPersonalDetails:
-		return UnmarshalPassportElementPersonalDetails(result.Data)
-
-	case TypePassportElementPassport:
-		return UnmarshalPassportElementPassport(result.Data)
-
-	case TypePassportElementDriverLicense:
-		return UnmarshalPassportElementDriverLicense(result.Data)
-
-	case TypePassportElementIdentityCard:
-		return UnmarshalPassportElementIdentityCard(result.Data)
-
-	case TypePassportElementInternalPassport:
-		return UnmarshalPassportElementInternalPassport(result.Data)
-
-	case TypePassportElementAddress:
-		return UnmarshalPassportElementAddress(result.Data)
-
-	case TypePassportElementUtilityBill:
-		return UnmarshalPassportElementUtilityBill(result.Data)
-
-	case TypePassportElementBankStatement:
-		return UnmarshalPassportElementBankStatement(result.Data)
-
-	case TypePassportElementRentalAgreement:
-		return UnmarshalPassportElementRentalAgreement(result.Data)
-
-	case TypePassportElementPassportRegistration:
-		return UnmarshalPassportElementPassportRegistration(result.Data)
-
-	case TypePassportElementTemporaryRegistration:
-		return UnmarshalPassportElementTemporaryRegistration(result.Data)
-
-	case TypePassportElementPhoneNumber:
-		return UnmarshalPassportElementPhoneNumber(result.Data)
-
-	case TypePassportElementEmailAddress:
-		return UnmarshalPassportElementEmailAddress(result.Data)
-
-	default:
-		return nil, errors.New("invalid type")
-	}
+	return UnmarshalPassportElement(result.Data)
 }
 
 type DeletePassportElementRequest struct {
@@ -15415,15 +22823,32 @@ type DeletePassportElementRequest struct {
 	Type PassportElementType `json:"type"`
 }
 
+func (req *DeletePassportElementRequest) encode(encoder *encoder) {
+	encoder.name("type")
+	encodePassportElementType(encoder, req.Type)
+}
+
 // Deletes a Telegram Passport element
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) DeletePassportElement(req *DeletePassportElementRequest) (*Ok, error) {
-	result, err := client.Send(Request{
-		meta: meta{
-			Type: "deletePassportElement",
-		},
-		Data: map[string]interface{}{
-			"type": req.Type,
+	return client.DeletePassportElementContext(context.Background(), req)
+}
+
+// Deletes a Telegram Passport element
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) DeletePassportElementContext(ctx context.Context, req *DeletePassportElementRequest) (*Ok, error) {
+	err := client.checkUsersOnly("deletePassportElement")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
+		meta: meta{
+			Type: "deletePassportElement",
 		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -15443,16 +22868,33 @@ type SetPassportElementErrorsRequest struct {
 	Errors []*InputPassportElementError `json:"errors"`
 }
 
+func (req *SetPassportElementErrorsRequest) encode(encoder *encoder) {
+	encoder.Int64("user_id", req.UserId)
+	encoder.name("errors")
+	encodeListOfInputPassportElementError(encoder, req.Errors)
+}
+
 // Informs the user that some of the elements in their Telegram Passport contain errors; for bots only. The user will not be able to resend the elements, until the errors are fixed
+//
+// Available only to bots, users get FunctionTypeError
 func (client *Client) SetPassportElementErrors(req *SetPassportElementErrorsRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetPassportElementErrorsContext(context.Background(), req)
+}
+
+// Informs the user that some of the elements in their Telegram Passport contain errors; for bots only. The user will not be able to resend the elements, until the errors are fixed
+//
+// Available only to bots, users get FunctionTypeError
+func (client *Client) SetPassportElementErrorsContext(ctx context.Context, req *SetPassportElementErrorsRequest) (*Ok, error) {
+	err := client.checkBotsOnly("setPassportElementErrors")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setPassportElementErrors",
 		},
-		Data: map[string]interface{}{
-			"user_id": req.UserId,
-			"errors":  req.Errors,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -15470,15 +22912,31 @@ type GetPreferredCountryLanguageRequest struct {
 	CountryCode string `json:"country_code"`
 }
 
+func (req *GetPreferredCountryLanguageRequest) encode(encoder *encoder) {
+	encoder.String("country_code", req.CountryCode)
+}
+
 // Returns an IETF language tag of the language preferred in the country, which must be used to fill native fields in Telegram Passport personal details. Returns a 404 error if unknown
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetPreferredCountryLanguage(req *GetPreferredCountryLanguageRequest) (*Text, error) {
-	result, err := client.Send(Request{
+	return client.GetPreferredCountryLanguageContext(context.Background(), req)
+}
+
+// Returns an IETF language tag of the language preferred in the country, which must be used to fill native fields in Telegram Passport personal details. Returns a 404 error if unknown
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetPreferredCountryLanguageContext(ctx context.Context, req *GetPreferredCountryLanguageRequest) (*Text, error) {
+	err := client.checkUsersOnly("getPreferredCountryLanguage")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getPreferredCountryLanguage",
 		},
-		Data: map[string]interface{}{
-			"country_code": req.CountryCode,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -15498,16 +22956,33 @@ type SendPhoneNumberVerificationCodeRequest struct {
 	Settings *PhoneNumberAuthenticationSettings `json:"settings"`
 }
 
+func (req *SendPhoneNumberVerificationCodeRequest) encode(encoder *encoder) {
+	encoder.String("phone_number", req.PhoneNumber)
+	encoder.name("settings")
+	encodePhoneNumberAuthenticationSettings(encoder, req.Settings)
+}
+
 // Sends a code to verify a phone number to be added to a user's Telegram Passport
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SendPhoneNumberVerificationCode(req *SendPhoneNumberVerificationCodeRequest) (*AuthenticationCodeInfo, error) {
-	result, err := client.Send(Request{
+	return client.SendPhoneNumberVerificationCodeContext(context.Background(), req)
+}
+
+// Sends a code to verify a phone number to be added to a user's Telegram Passport
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SendPhoneNumberVerificationCodeContext(ctx context.Context, req *SendPhoneNumberVerificationCodeRequest) (*AuthenticationCodeInfo, error) {
+	err := client.checkUsersOnly("sendPhoneNumberVerificationCode")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "sendPhoneNumberVerificationCode",
 		},
-		Data: map[string]interface{}{
-			"phone_number": req.PhoneNumber,
-			"settings":     req.Settings,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -15521,12 +22996,25 @@ func (client *Client) SendPhoneNumberVerificationCode(req *SendPhoneNumberVerifi
 }
 
 // Resends the code to verify a phone number to be added to a user's Telegram Passport
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ResendPhoneNumberVerificationCode() (*AuthenticationCodeInfo, error) {
-	result, err := client.Send(Request{
+	return client.ResendPhoneNumberVerificationCodeContext(context.Background())
+}
+
+// Resends the code to verify a phone number to be added to a user's Telegram Passport
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ResendPhoneNumberVerificationCodeContext(ctx context.Context) (*AuthenticationCodeInfo, error) {
+	err := client.checkUsersOnly("resendPhoneNumberVerificationCode")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "resendPhoneNumberVerificationCode",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -15544,15 +23032,31 @@ type CheckPhoneNumberVerificationCodeRequest struct {
 	Code string `json:"code"`
 }
 
+func (req *CheckPhoneNumberVerificationCodeRequest) encode(encoder *encoder) {
+	encoder.String("code", req.Code)
+}
+
 // Checks the phone number verification code for Telegram Passport
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) CheckPhoneNumberVerificationCode(req *CheckPhoneNumberVerificationCodeRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.CheckPhoneNumberVerificationCodeContext(context.Background(), req)
+}
+
+// Checks the phone number verification code for Telegram Passport
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) CheckPhoneNumberVerificationCodeContext(ctx context.Context, req *CheckPhoneNumberVerificationCodeRequest) (*Ok, error) {
+	err := client.checkUsersOnly("checkPhoneNumberVerificationCode")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "checkPhoneNumberVerificationCode",
 		},
-		Data: map[string]interface{}{
-			"code": req.Code,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -15570,15 +23074,31 @@ type SendEmailAddressVerificationCodeRequest struct {
 	EmailAddress string `json:"email_address"`
 }
 
+func (req *SendEmailAddressVerificationCodeRequest) encode(encoder *encoder) {
+	encoder.String("email_address", req.EmailAddress)
+}
+
 // Sends a code to verify an email address to be added to a user's Telegram Passport
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SendEmailAddressVerificationCode(req *SendEmailAddressVerificationCodeRequest) (*EmailAddressAuthenticationCodeInfo, error) {
-	result, err := client.Send(Request{
+	return client.SendEmailAddressVerificationCodeContext(context.Background(), req)
+}
+
+// Sends a code to verify an email address to be added to a user's Telegram Passport
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SendEmailAddressVerificationCodeContext(ctx context.Context, req *SendEmailAddressVerificationCodeRequest) (*EmailAddressAuthenticationCodeInfo, error) {
+	err := client.checkUsersOnly("sendEmailAddressVerificationCode")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "sendEmailAddressVerificationCode",
 		},
-		Data: map[string]interface{}{
-			"email_address": req.EmailAddress,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -15592,12 +23112,25 @@ func (client *Client) SendEmailAddressVerificationCode(req *SendEmailAddressVeri
 }
 
 // Resends the code to verify an email address to be added to a user's Telegram Passport
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ResendEmailAddressVerificationCode() (*EmailAddressAuthenticationCodeInfo, error) {
-	result, err := client.Send(Request{
+	return client.ResendEmailAddressVerificationCodeContext(context.Background())
+}
+
+// Resends the code to verify an email address to be added to a user's Telegram Passport
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ResendEmailAddressVerificationCodeContext(ctx context.Context) (*EmailAddressAuthenticationCodeInfo, error) {
+	err := client.checkUsersOnly("resendEmailAddressVerificationCode")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "resendEmailAddressVerificationCode",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -15615,15 +23148,31 @@ type CheckEmailAddressVerificationCodeRequest struct {
 	Code string `json:"code"`
 }
 
+func (req *CheckEmailAddressVerificationCodeRequest) encode(encoder *encoder) {
+	encoder.String("code", req.Code)
+}
+
 // Checks the email address verification code for Telegram Passport
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) CheckEmailAddressVerificationCode(req *CheckEmailAddressVerificationCodeRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.CheckEmailAddressVerificationCodeContext(context.Background(), req)
+}
+
+// Checks the email address verification code for Telegram Passport
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) CheckEmailAddressVerificationCodeContext(ctx context.Context, req *CheckEmailAddressVerificationCodeRequest) (*Ok, error) {
+	err := client.checkUsersOnly("checkEmailAddressVerificationCode")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "checkEmailAddressVerificationCode",
 		},
-		Data: map[string]interface{}{
-			"code": req.Code,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -15647,18 +23196,34 @@ type GetPassportAuthorizationFormRequest struct {
 	Nonce string `json:"nonce"`
 }
 
+func (req *GetPassportAuthorizationFormRequest) encode(encoder *encoder) {
+	encoder.Int64("bot_user_id", req.BotUserId)
+	encoder.String("scope", req.Scope)
+	encoder.String("public_key", req.PublicKey)
+	encoder.String("nonce", req.Nonce)
+}
+
 // Returns a Telegram Passport authorization form for sharing data with a service
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetPassportAuthorizationForm(req *GetPassportAuthorizationFormRequest) (*PassportAuthorizationForm, error) {
-	result, err := client.Send(Request{
+	return client.GetPassportAuthorizationFormContext(context.Background(), req)
+}
+
+// Returns a Telegram Passport authorization form for sharing data with a service
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetPassportAuthorizationFormContext(ctx context.Context, req *GetPassportAuthorizationFormRequest) (*PassportAuthorizationForm, error) {
+	err := client.checkUsersOnly("getPassportAuthorizationForm")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getPassportAuthorizationForm",
 		},
-		Data: map[string]interface{}{
-			"bot_user_id": req.BotUserId,
-			"scope":       req.Scope,
-			"public_key":  req.PublicKey,
-			"nonce":       req.Nonce,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -15678,16 +23243,32 @@ type GetPassportAuthorizationFormAvailableElementsRequest struct {
 	Password string `json:"password"`
 }
 
+func (req *GetPassportAuthorizationFormAvailableElementsRequest) encode(encoder *encoder) {
+	encoder.Int32("authorization_form_id", req.AuthorizationFormId)
+	encoder.String("password", req.Password)
+}
+
 // Returns already available Telegram Passport elements suitable for completing a Telegram Passport authorization form. Result can be received only once for each authorization form
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetPassportAuthorizationFormAvailableElements(req *GetPassportAuthorizationFormAvailableElementsRequest) (*PassportElementsWithErrors, error) {
-	result, err := client.Send(Request{
+	return client.GetPassportAuthorizationFormAvailableElementsContext(context.Background(), req)
+}
+
+// Returns already available Telegram Passport elements suitable for completing a Telegram Passport authorization form. Result can be received only once for each authorization form
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetPassportAuthorizationFormAvailableElementsContext(ctx context.Context, req *GetPassportAuthorizationFormAvailableElementsRequest) (*PassportElementsWithErrors, error) {
+	err := client.checkUsersOnly("getPassportAuthorizationFormAvailableElements")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getPassportAuthorizationFormAvailableElements",
 		},
-		Data: map[string]interface{}{
-			"authorization_form_id": req.AuthorizationFormId,
-			"password":              req.Password,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -15707,16 +23288,33 @@ type SendPassportAuthorizationFormRequest struct {
 	Types []PassportElementType `json:"types"`
 }
 
+func (req *SendPassportAuthorizationFormRequest) encode(encoder *encoder) {
+	encoder.Int32("authorization_form_id", req.AuthorizationFormId)
+	encoder.name("types")
+	encodeListOfPassportElementType(encoder, req.Types)
+}
+
 // Sends a Telegram Passport authorization form, effectively sharing data with the service. This method must be called after getPassportAuthorizationFormAvailableElements if some previously available elements are going to be reused
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SendPassportAuthorizationForm(req *SendPassportAuthorizationFormRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SendPassportAuthorizationFormContext(context.Background(), req)
+}
+
+// Sends a Telegram Passport authorization form, effectively sharing data with the service. This method must be called after getPassportAuthorizationFormAvailableElements if some previously available elements are going to be reused
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SendPassportAuthorizationFormContext(ctx context.Context, req *SendPassportAuthorizationFormRequest) (*Ok, error) {
+	err := client.checkUsersOnly("sendPassportAuthorizationForm")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "sendPassportAuthorizationForm",
 		},
-		Data: map[string]interface{}{
-			"authorization_form_id": req.AuthorizationFormId,
-			"types":                 req.Types,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -15738,17 +23336,34 @@ type SendPhoneNumberConfirmationCodeRequest struct {
 	Settings *PhoneNumberAuthenticationSettings `json:"settings"`
 }
 
+func (req *SendPhoneNumberConfirmationCodeRequest) encode(encoder *encoder) {
+	encoder.String("hash", req.Hash)
+	encoder.String("phone_number", req.PhoneNumber)
+	encoder.name("settings")
+	encodePhoneNumberAuthenticationSettings(encoder, req.Settings)
+}
+
 // Sends phone number confirmation code to handle links of the type internalLinkTypePhoneNumberConfirmation
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SendPhoneNumberConfirmationCode(req *SendPhoneNumberConfirmationCodeRequest) (*AuthenticationCodeInfo, error) {
-	result, err := client.Send(Request{
+	return client.SendPhoneNumberConfirmationCodeContext(context.Background(), req)
+}
+
+// Sends phone number confirmation code to handle links of the type internalLinkTypePhoneNumberConfirmation
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SendPhoneNumberConfirmationCodeContext(ctx context.Context, req *SendPhoneNumberConfirmationCodeRequest) (*AuthenticationCodeInfo, error) {
+	err := client.checkUsersOnly("sendPhoneNumberConfirmationCode")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "sendPhoneNumberConfirmationCode",
 		},
-		Data: map[string]interface{}{
-			"hash":         req.Hash,
-			"phone_number": req.PhoneNumber,
-			"settings":     req.Settings,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -15762,12 +23377,25 @@ func (client *Client) SendPhoneNumberConfirmationCode(req *SendPhoneNumberConfir
 }
 
 // Resends phone number confirmation code
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ResendPhoneNumberConfirmationCode() (*AuthenticationCodeInfo, error) {
-	result, err := client.Send(Request{
+	return client.ResendPhoneNumberConfirmationCodeContext(context.Background())
+}
+
+// Resends phone number confirmation code
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ResendPhoneNumberConfirmationCodeContext(ctx context.Context) (*AuthenticationCodeInfo, error) {
+	err := client.checkUsersOnly("resendPhoneNumberConfirmationCode")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "resendPhoneNumberConfirmationCode",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -15785,15 +23413,31 @@ type CheckPhoneNumberConfirmationCodeRequest struct {
 	Code string `json:"code"`
 }
 
+func (req *CheckPhoneNumberConfirmationCodeRequest) encode(encoder *encoder) {
+	encoder.String("code", req.Code)
+}
+
 // Checks phone number confirmation code
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) CheckPhoneNumberConfirmationCode(req *CheckPhoneNumberConfirmationCodeRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.CheckPhoneNumberConfirmationCodeContext(context.Background(), req)
+}
+
+// Checks phone number confirmation code
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) CheckPhoneNumberConfirmationCodeContext(ctx context.Context, req *CheckPhoneNumberConfirmationCodeRequest) (*Ok, error) {
+	err := client.checkUsersOnly("checkPhoneNumberConfirmationCode")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "checkPhoneNumberConfirmationCode",
 		},
-		Data: map[string]interface{}{
-			"code": req.Code,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -15813,16 +23457,32 @@ type SetBotUpdatesStatusRequest struct {
 	ErrorMessage string `json:"error_message"`
 }
 
+func (req *SetBotUpdatesStatusRequest) encode(encoder *encoder) {
+	encoder.Int32("pending_update_count", req.PendingUpdateCount)
+	encoder.String("error_message", req.ErrorMessage)
+}
+
 // Informs the server about the number of pending bot updates if they haven't been processed for a long time; for bots only
+//
+// Available only to bots, users get FunctionTypeError
 func (client *Client) SetBotUpdatesStatus(req *SetBotUpdatesStatusRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetBotUpdatesStatusContext(context.Background(), req)
+}
+
+// Informs the server about the number of pending bot updates if they haven't been processed for a long time; for bots only
+//
+// Available only to bots, users get FunctionTypeError
+func (client *Client) SetBotUpdatesStatusContext(ctx context.Context, req *SetBotUpdatesStatusRequest) (*Ok, error) {
+	err := client.checkBotsOnly("setBotUpdatesStatus")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setBotUpdatesStatus",
 		},
-		Data: map[string]interface{}{
-			"pending_update_count": req.PendingUpdateCount,
-			"error_message":        req.ErrorMessage,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -15844,17 +23504,26 @@ type UploadStickerFileRequest struct {
 	Sticker InputFile `json:"sticker"`
 }
 
+func (req *UploadStickerFileRequest) encode(encoder *encoder) {
+	encoder.Int64("user_id", req.UserId)
+	encoder.name("sticker_format")
+	encodeStickerFormat(encoder, req.StickerFormat)
+	encoder.name("sticker")
+	encodeInputFile(encoder, req.Sticker)
+}
+
 // Uploads a file with a sticker; returns the uploaded file
 func (client *Client) UploadStickerFile(req *UploadStickerFileRequest) (*File, error) {
-	result, err := client.Send(Request{
+	return client.UploadStickerFileContext(context.Background(), req)
+}
+
+// Uploads a file with a sticker; returns the uploaded file
+func (client *Client) UploadStickerFileContext(ctx context.Context, req *UploadStickerFileRequest) (*File, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "uploadStickerFile",
 		},
-		Data: map[string]interface{}{
-			"user_id":        req.UserId,
-			"sticker_format": req.StickerFormat,
-			"sticker":        req.Sticker,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -15872,15 +23541,22 @@ type GetSuggestedStickerSetNameRequest struct {
 	Title string `json:"title"`
 }
 
+func (req *GetSuggestedStickerSetNameRequest) encode(encoder *encoder) {
+	encoder.String("title", req.Title)
+}
+
 // Returns a suggested name for a new sticker set with a given title
 func (client *Client) GetSuggestedStickerSetName(req *GetSuggestedStickerSetNameRequest) (*Text, error) {
-	result, err := client.Send(Request{
+	return client.GetSuggestedStickerSetNameContext(context.Background(), req)
+}
+
+// Returns a suggested name for a new sticker set with a given title
+func (client *Client) GetSuggestedStickerSetNameContext(ctx context.Context, req *GetSuggestedStickerSetNameRequest) (*Text, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getSuggestedStickerSetName",
 		},
-		Data: map[string]interface{}{
-			"title": req.Title,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -15898,15 +23574,22 @@ type CheckStickerSetNameRequest struct {
 	Name string `json:"name"`
 }
 
+func (req *CheckStickerSetNameRequest) encode(encoder *encoder) {
+	encoder.String("name", req.Name)
+}
+
 // Checks whether a name can be used for a new sticker set
 func (client *Client) CheckStickerSetName(req *CheckStickerSetNameRequest) (CheckStickerSetNameResult, error) {
-	result, err := client.Send(Request{
+	return client.CheckStickerSetNameContext(context.Background(), req)
+}
+
+// Checks whether a name can be used for a new sticker set
+func (client *Client) CheckStickerSetNameContext(ctx context.Context, req *CheckStickerSetNameRequest) (CheckStickerSetNameResult, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "checkStickerSetName",
 		},
-		Data: map[string]interface{}{
-			"name": req.Name,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -15916,19 +23599,7 @@ func (client *Client) CheckStickerSetName(req *CheckStickerSetNameRequest) (Chec
 		return nil, buildResponseError(result.Data)
 	}
 
-	switch result.Type {
-	case TypeCheckStickerSetNameResultOk:
-		return UnmarshalCheckStickerSetNameResultOk(result.Data)
-
-	case TypeCheckStickerSetNameResultNameInvalid:
-		return UnmarshalCheckStickerSetNameResultNameInvalid(result.Data)
-
-	case TypeCheckStickerSetNameResultNameOccupied:
-		return UnmarshalCheckStickerSetNameResultNameOccupied(result.Data)
-
-	default:
-		return nil, errors.New("invalid type")
-	}
+	return UnmarshalCheckStickerSetNameResult(result.Data)
 }
 
 type CreateNewStickerSetRequest struct {
@@ -15950,22 +23621,32 @@ type CreateNewStickerSetRequest struct {
 	Source string `json:"source"`
 }
 
+func (req *CreateNewStickerSetRequest) encode(encoder *encoder) {
+	encoder.Int64("user_id", req.UserId)
+	encoder.String("title", req.Title)
+	encoder.String("name", req.Name)
+	encoder.name("sticker_format")
+	encodeStickerFormat(encoder, req.StickerFormat)
+	encoder.name("sticker_type")
+	encodeStickerType(encoder, req.StickerType)
+	encoder.Bool("needs_repainting", req.NeedsRepainting)
+	encoder.name("stickers")
+	encodeListOfInputSticker(encoder, req.Stickers)
+	encoder.String("source", req.Source)
+}
+
 // Creates a new sticker set. Returns the newly created sticker set
 func (client *Client) CreateNewStickerSet(req *CreateNewStickerSetRequest) (*StickerSet, error) {
-	result, err := client.Send(Request{
+	return client.CreateNewStickerSetContext(context.Background(), req)
+}
+
+// Creates a new sticker set. Returns the newly created sticker set
+func (client *Client) CreateNewStickerSetContext(ctx context.Context, req *CreateNewStickerSetRequest) (*StickerSet, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "createNewStickerSet",
 		},
-		Data: map[string]interface{}{
-			"user_id":          req.UserId,
-			"title":            req.Title,
-			"name":             req.Name,
-			"sticker_format":   req.StickerFormat,
-			"sticker_type":     req.StickerType,
-			"needs_repainting": req.NeedsRepainting,
-			"stickers":         req.Stickers,
-			"source":           req.Source,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -15987,17 +23668,34 @@ type AddStickerToSetRequest struct {
 	Sticker *InputSticker `json:"sticker"`
 }
 
+func (req *AddStickerToSetRequest) encode(encoder *encoder) {
+	encoder.Int64("user_id", req.UserId)
+	encoder.String("name", req.Name)
+	encoder.name("sticker")
+	encodeInputSticker(encoder, req.Sticker)
+}
+
 // Adds a new sticker to a set; for bots only
+//
+// Available only to bots, users get FunctionTypeError
 func (client *Client) AddStickerToSet(req *AddStickerToSetRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.AddStickerToSetContext(context.Background(), req)
+}
+
+// Adds a new sticker to a set; for bots only
+//
+// Available only to bots, users get FunctionTypeError
+func (client *Client) AddStickerToSetContext(ctx context.Context, req *AddStickerToSetRequest) (*Ok, error) {
+	err := client.checkBotsOnly("addStickerToSet")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "addStickerToSet",
 		},
-		Data: map[string]interface{}{
-			"user_id": req.UserId,
-			"name":    req.Name,
-			"sticker": req.Sticker,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -16019,17 +23717,34 @@ type SetStickerSetThumbnailRequest struct {
 	Thumbnail InputFile `json:"thumbnail"`
 }
 
+func (req *SetStickerSetThumbnailRequest) encode(encoder *encoder) {
+	encoder.Int64("user_id", req.UserId)
+	encoder.String("name", req.Name)
+	encoder.name("thumbnail")
+	encodeInputFile(encoder, req.Thumbnail)
+}
+
 // Sets a sticker set thumbnail; for bots only
+//
+// Available only to bots, users get FunctionTypeError
 func (client *Client) SetStickerSetThumbnail(req *SetStickerSetThumbnailRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetStickerSetThumbnailContext(context.Background(), req)
+}
+
+// Sets a sticker set thumbnail; for bots only
+//
+// Available only to bots, users get FunctionTypeError
+func (client *Client) SetStickerSetThumbnailContext(ctx context.Context, req *SetStickerSetThumbnailRequest) (*Ok, error) {
+	err := client.checkBotsOnly("setStickerSetThumbnail")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setStickerSetThumbnail",
 		},
-		Data: map[string]interface{}{
-			"user_id":   req.UserId,
-			"name":      req.Name,
-			"thumbnail": req.Thumbnail,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -16049,16 +23764,32 @@ type SetCustomEmojiStickerSetThumbnailRequest struct {
 	CustomEmojiId JsonInt64 `json:"custom_emoji_id"`
 }
 
+func (req *SetCustomEmojiStickerSetThumbnailRequest) encode(encoder *encoder) {
+	encoder.String("name", req.Name)
+	encoder.JsonInt64("custom_emoji_id", req.CustomEmojiId)
+}
+
 // Sets a custom emoji sticker set thumbnail; for bots only
+//
+// Available only to bots, users get FunctionTypeError
 func (client *Client) SetCustomEmojiStickerSetThumbnail(req *SetCustomEmojiStickerSetThumbnailRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetCustomEmojiStickerSetThumbnailContext(context.Background(), req)
+}
+
+// Sets a custom emoji sticker set thumbnail; for bots only
+//
+// Available only to bots, users get FunctionTypeError
+func (client *Client) SetCustomEmojiStickerSetThumbnailContext(ctx context.Context, req *SetCustomEmojiStickerSetThumbnailRequest) (*Ok, error) {
+	err := client.checkBotsOnly("setCustomEmojiStickerSetThumbnail")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setCustomEmojiStickerSetThumbnail",
 		},
-		Data: map[string]interface{}{
-			"name":            req.Name,
-			"custom_emoji_id": req.CustomEmojiId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -16078,16 +23809,32 @@ type SetStickerSetTitleRequest struct {
 	Title string `json:"title"`
 }
 
+func (req *SetStickerSetTitleRequest) encode(encoder *encoder) {
+	encoder.String("name", req.Name)
+	encoder.String("title", req.Title)
+}
+
 // Sets a sticker set title; for bots only
+//
+// Available only to bots, users get FunctionTypeError
 func (client *Client) SetStickerSetTitle(req *SetStickerSetTitleRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetStickerSetTitleContext(context.Background(), req)
+}
+
+// Sets a sticker set title; for bots only
+//
+// Available only to bots, users get FunctionTypeError
+func (client *Client) SetStickerSetTitleContext(ctx context.Context, req *SetStickerSetTitleRequest) (*Ok, error) {
+	err := client.checkBotsOnly("setStickerSetTitle")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setStickerSetTitle",
 		},
-		Data: map[string]interface{}{
-			"name":  req.Name,
-			"title": req.Title,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -16105,15 +23852,31 @@ type DeleteStickerSetRequest struct {
 	Name string `json:"name"`
 }
 
+func (req *DeleteStickerSetRequest) encode(encoder *encoder) {
+	encoder.String("name", req.Name)
+}
+
 // Deleted a sticker set; for bots only
+//
+// Available only to bots, users get FunctionTypeError
 func (client *Client) DeleteStickerSet(req *DeleteStickerSetRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.DeleteStickerSetContext(context.Background(), req)
+}
+
+// Deleted a sticker set; for bots only
+//
+// Available only to bots, users get FunctionTypeError
+func (client *Client) DeleteStickerSetContext(ctx context.Context, req *DeleteStickerSetRequest) (*Ok, error) {
+	err := client.checkBotsOnly("deleteStickerSet")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "deleteStickerSet",
 		},
-		Data: map[string]interface{}{
-			"name": req.Name,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -16133,16 +23896,33 @@ type SetStickerPositionInSetRequest struct {
 	Position int32 `json:"position"`
 }
 
+func (req *SetStickerPositionInSetRequest) encode(encoder *encoder) {
+	encoder.name("sticker")
+	encodeInputFile(encoder, req.Sticker)
+	encoder.Int32("position", req.Position)
+}
+
 // Changes the position of a sticker in the set to which it belongs; for bots only. The sticker set must have been created by the bot
+//
+// Available only to bots, users get FunctionTypeError
 func (client *Client) SetStickerPositionInSet(req *SetStickerPositionInSetRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetStickerPositionInSetContext(context.Background(), req)
+}
+
+// Changes the position of a sticker in the set to which it belongs; for bots only. The sticker set must have been created by the bot
+//
+// Available only to bots, users get FunctionTypeError
+func (client *Client) SetStickerPositionInSetContext(ctx context.Context, req *SetStickerPositionInSetRequest) (*Ok, error) {
+	err := client.checkBotsOnly("setStickerPositionInSet")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setStickerPositionInSet",
 		},
-		Data: map[string]interface{}{
-			"sticker":  req.Sticker,
-			"position": req.Position,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -16160,15 +23940,32 @@ type RemoveStickerFromSetRequest struct {
 	Sticker InputFile `json:"sticker"`
 }
 
+func (req *RemoveStickerFromSetRequest) encode(encoder *encoder) {
+	encoder.name("sticker")
+	encodeInputFile(encoder, req.Sticker)
+}
+
 // Removes a sticker from the set to which it belongs; for bots only. The sticker set must have been created by the bot
+//
+// Available only to bots, users get FunctionTypeError
 func (client *Client) RemoveStickerFromSet(req *RemoveStickerFromSetRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.RemoveStickerFromSetContext(context.Background(), req)
+}
+
+// Removes a sticker from the set to which it belongs; for bots only. The sticker set must have been created by the bot
+//
+// Available only to bots, users get FunctionTypeError
+func (client *Client) RemoveStickerFromSetContext(ctx context.Context, req *RemoveStickerFromSetRequest) (*Ok, error) {
+	err := client.checkBotsOnly("removeStickerFromSet")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "removeStickerFromSet",
 		},
-		Data: map[string]interface{}{
-			"sticker": req.Sticker,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -16188,17 +23985,34 @@ type SetStickerEmojisRequest struct {
 	Emojis string `json:"emojis"`
 }
 
+func (req *SetStickerEmojisRequest) encode(encoder *encoder) {
+	encoder.name("sticker")
+	encodeInputFile(encoder, req.Sticker)
+	encoder.String("emojis", req.Emojis)
+}
+
 // Changes the list of emoji corresponding to a sticker; for bots only. The sticker must belong to a regular or custom emoji sticker set created by the bot
+//
+// Available only to bots, users get FunctionTypeError
 func (client *Client) SetStickerEmojis(req *SetStickerEmojisRequest) (*Ok, error) {
-	result, err := client.Send(Request{
-		meta: meta{
-			Type: "setStickerEmojis",
-		},
-		Data: map[string]interface{}{
-			"sticker": req.Sticker,
-			"emojis":  req.Emojis,
-		},
-	})
+	return client.SetStickerEmojisContext(context.Background(), req)
+}
+
+// Changes the list of emoji corresponding to a sticker; for bots only. The sticker must belong to a regular or custom emoji sticker set created by the bot
+//
+// Available only to bots, users get FunctionTypeError
+func (client *Client) SetStickerEmojisContext(ctx context.Context, req *SetStickerEmojisRequest) (*Ok, error) {
+	err := client.checkBotsOnly("setStickerEmojis")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
+		meta: meta{
+			Type: "setStickerEmojis",
+		},
+		Data: req,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -16217,16 +24031,33 @@ type SetStickerKeywordsRequest struct {
 	Keywords []string `json:"keywords"`
 }
 
+func (req *SetStickerKeywordsRequest) encode(encoder *encoder) {
+	encoder.name("sticker")
+	encodeInputFile(encoder, req.Sticker)
+	encoder.Strings("keywords", req.Keywords)
+}
+
 // Changes the list of keywords of a sticker; for bots only. The sticker must belong to a regular or custom emoji sticker set created by the bot
+//
+// Available only to bots, users get FunctionTypeError
 func (client *Client) SetStickerKeywords(req *SetStickerKeywordsRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetStickerKeywordsContext(context.Background(), req)
+}
+
+// Changes the list of keywords of a sticker; for bots only. The sticker must belong to a regular or custom emoji sticker set created by the bot
+//
+// Available only to bots, users get FunctionTypeError
+func (client *Client) SetStickerKeywordsContext(ctx context.Context, req *SetStickerKeywordsRequest) (*Ok, error) {
+	err := client.checkBotsOnly("setStickerKeywords")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setStickerKeywords",
 		},
-		Data: map[string]interface{}{
-			"sticker":  req.Sticker,
-			"keywords": req.Keywords,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -16246,16 +24077,34 @@ type SetStickerMaskPositionRequest struct {
 	MaskPosition *MaskPosition `json:"mask_position"`
 }
 
+func (req *SetStickerMaskPositionRequest) encode(encoder *encoder) {
+	encoder.name("sticker")
+	encodeInputFile(encoder, req.Sticker)
+	encoder.name("mask_position")
+	encodeMaskPosition(encoder, req.MaskPosition)
+}
+
 // Changes the mask position of a mask sticker; for bots only. The sticker must belong to a mask sticker set created by the bot
+//
+// Available only to bots, users get FunctionTypeError
 func (client *Client) SetStickerMaskPosition(req *SetStickerMaskPositionRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetStickerMaskPositionContext(context.Background(), req)
+}
+
+// Changes the mask position of a mask sticker; for bots only. The sticker must belong to a mask sticker set created by the bot
+//
+// Available only to bots, users get FunctionTypeError
+func (client *Client) SetStickerMaskPositionContext(ctx context.Context, req *SetStickerMaskPositionRequest) (*Ok, error) {
+	err := client.checkBotsOnly("setStickerMaskPosition")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setStickerMaskPosition",
 		},
-		Data: map[string]interface{}{
-			"sticker":       req.Sticker,
-			"mask_position": req.MaskPosition,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -16283,20 +24132,32 @@ type GetMapThumbnailFileRequest struct {
 	ChatId int64 `json:"chat_id"`
 }
 
+func (req *GetMapThumbnailFileRequest) encode(encoder *encoder) {
+	encoder.name("location")
+	encodeLocation(encoder, req.Location)
+	encoder.Int32("zoom", req.Zoom)
+	encoder.Int32("width", req.Width)
+	encoder.Int32("height", req.Height)
+	encoder.Int32("scale", req.Scale)
+	encoder.Int64("chat_id", req.ChatId)
+}
+
+func (req *GetMapThumbnailFileRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Returns information about a file with a map thumbnail in PNG format. Only map thumbnail files with size less than 1MB can be downloaded
 func (client *Client) GetMapThumbnailFile(req *GetMapThumbnailFileRequest) (*File, error) {
-	result, err := client.Send(Request{
+	return client.GetMapThumbnailFileContext(context.Background(), req)
+}
+
+// Returns information about a file with a map thumbnail in PNG format. Only map thumbnail files with size less than 1MB can be downloaded
+func (client *Client) GetMapThumbnailFileContext(ctx context.Context, req *GetMapThumbnailFileRequest) (*File, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getMapThumbnailFile",
 		},
-		Data: map[string]interface{}{
-			"location": req.Location,
-			"zoom":     req.Zoom,
-			"width":    req.Width,
-			"height":   req.Height,
-			"scale":    req.Scale,
-			"chat_id":  req.ChatId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -16314,15 +24175,32 @@ type GetPremiumLimitRequest struct {
 	LimitType PremiumLimitType `json:"limit_type"`
 }
 
+func (req *GetPremiumLimitRequest) encode(encoder *encoder) {
+	encoder.name("limit_type")
+	encodePremiumLimitType(encoder, req.LimitType)
+}
+
 // Returns information about a limit, increased for Premium users. Returns a 404 error if the limit is unknown
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetPremiumLimit(req *GetPremiumLimitRequest) (*PremiumLimit, error) {
-	result, err := client.Send(Request{
+	return client.GetPremiumLimitContext(context.Background(), req)
+}
+
+// Returns information about a limit, increased for Premium users. Returns a 404 error if the limit is unknown
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetPremiumLimitContext(ctx context.Context, req *GetPremiumLimitRequest) (*PremiumLimit, error) {
+	err := client.checkUsersOnly("getPremiumLimit")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getPremiumLimit",
 		},
-		Data: map[string]interface{}{
-			"limit_type": req.LimitType,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -16340,15 +24218,32 @@ type GetPremiumFeaturesRequest struct {
 	Source PremiumSource `json:"source"`
 }
 
+func (req *GetPremiumFeaturesRequest) encode(encoder *encoder) {
+	encoder.name("source")
+	encodePremiumSource(encoder, req.Source)
+}
+
 // Returns information about features, available to Premium users
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetPremiumFeatures(req *GetPremiumFeaturesRequest) (*PremiumFeatures, error) {
-	result, err := client.Send(Request{
+	return client.GetPremiumFeaturesContext(context.Background(), req)
+}
+
+// Returns information about features, available to Premium users
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetPremiumFeaturesContext(ctx context.Context, req *GetPremiumFeaturesRequest) (*PremiumFeatures, error) {
+	err := client.checkUsersOnly("getPremiumFeatures")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getPremiumFeatures",
 		},
-		Data: map[string]interface{}{
-			"source": req.Source,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -16362,12 +24257,25 @@ func (client *Client) GetPremiumFeatures(req *GetPremiumFeaturesRequest) (*Premi
 }
 
 // Returns examples of premium stickers for demonstration purposes
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetPremiumStickerExamples() (*Stickers, error) {
-	result, err := client.Send(Request{
+	return client.GetPremiumStickerExamplesContext(context.Background())
+}
+
+// Returns examples of premium stickers for demonstration purposes
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetPremiumStickerExamplesContext(ctx context.Context) (*Stickers, error) {
+	err := client.checkUsersOnly("getPremiumStickerExamples")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getPremiumStickerExamples",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -16385,15 +24293,32 @@ type ViewPremiumFeatureRequest struct {
 	Feature PremiumFeature `json:"feature"`
 }
 
+func (req *ViewPremiumFeatureRequest) encode(encoder *encoder) {
+	encoder.name("feature")
+	encodePremiumFeature(encoder, req.Feature)
+}
+
 // Informs TDLib that the user viewed detailed information about a Premium feature on the Premium features screen
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ViewPremiumFeature(req *ViewPremiumFeatureRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ViewPremiumFeatureContext(context.Background(), req)
+}
+
+// Informs TDLib that the user viewed detailed information about a Premium feature on the Premium features screen
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ViewPremiumFeatureContext(ctx context.Context, req *ViewPremiumFeatureRequest) (*Ok, error) {
+	err := client.checkUsersOnly("viewPremiumFeature")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "viewPremiumFeature",
 		},
-		Data: map[string]interface{}{
-			"feature": req.Feature,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -16407,12 +24332,25 @@ func (client *Client) ViewPremiumFeature(req *ViewPremiumFeatureRequest) (*Ok, e
 }
 
 // Informs TDLib that the user clicked Premium subscription button on the Premium features screen
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) ClickPremiumSubscriptionButton() (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.ClickPremiumSubscriptionButtonContext(context.Background())
+}
+
+// Informs TDLib that the user clicked Premium subscription button on the Premium features screen
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) ClickPremiumSubscriptionButtonContext(ctx context.Context) (*Ok, error) {
+	err := client.checkUsersOnly("clickPremiumSubscriptionButton")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "clickPremiumSubscriptionButton",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -16426,12 +24364,25 @@ func (client *Client) ClickPremiumSubscriptionButton() (*Ok, error) {
 }
 
 // Returns state of Telegram Premium subscription and promotion videos for Premium features
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetPremiumState() (*PremiumState, error) {
-	result, err := client.Send(Request{
+	return client.GetPremiumStateContext(context.Background())
+}
+
+// Returns state of Telegram Premium subscription and promotion videos for Premium features
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetPremiumStateContext(ctx context.Context) (*PremiumState, error) {
+	err := client.checkUsersOnly("getPremiumState")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getPremiumState",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -16449,15 +24400,32 @@ type CanPurchasePremiumRequest struct {
 	Purpose StorePaymentPurpose `json:"purpose"`
 }
 
+func (req *CanPurchasePremiumRequest) encode(encoder *encoder) {
+	encoder.name("purpose")
+	encodeStorePaymentPurpose(encoder, req.Purpose)
+}
+
 // Checks whether Telegram Premium purchase is possible. Must be called before in-store Premium purchase
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) CanPurchasePremium(req *CanPurchasePremiumRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.CanPurchasePremiumContext(context.Background(), req)
+}
+
+// Checks whether Telegram Premium purchase is possible. Must be called before in-store Premium purchase
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) CanPurchasePremiumContext(ctx context.Context, req *CanPurchasePremiumRequest) (*Ok, error) {
+	err := client.checkUsersOnly("canPurchasePremium")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "canPurchasePremium",
 		},
-		Data: map[string]interface{}{
-			"purpose": req.Purpose,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -16477,16 +24445,33 @@ type AssignAppStoreTransactionRequest struct {
 	Purpose StorePaymentPurpose `json:"purpose"`
 }
 
+func (req *AssignAppStoreTransactionRequest) encode(encoder *encoder) {
+	encoder.Bytes("receipt", req.Receipt)
+	encoder.name("purpose")
+	encodeStorePaymentPurpose(encoder, req.Purpose)
+}
+
 // Informs server about a purchase through App Store. For official applications only
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) AssignAppStoreTransaction(req *AssignAppStoreTransactionRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.AssignAppStoreTransactionContext(context.Background(), req)
+}
+
+// Informs server about a purchase through App Store. For official applications only
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) AssignAppStoreTransactionContext(ctx context.Context, req *AssignAppStoreTransactionRequest) (*Ok, error) {
+	err := client.checkUsersOnly("assignAppStoreTransaction")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "assignAppStoreTransaction",
 		},
-		Data: map[string]interface{}{
-			"receipt": req.Receipt,
-			"purpose": req.Purpose,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -16510,18 +24495,35 @@ type AssignGooglePlayTransactionRequest struct {
 	Purpose StorePaymentPurpose `json:"purpose"`
 }
 
+func (req *AssignGooglePlayTransactionRequest) encode(encoder *encoder) {
+	encoder.String("package_name", req.PackageName)
+	encoder.String("store_product_id", req.StoreProductId)
+	encoder.String("purchase_token", req.PurchaseToken)
+	encoder.name("purpose")
+	encodeStorePaymentPurpose(encoder, req.Purpose)
+}
+
 // Informs server about a purchase through Google Play. For official applications only
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) AssignGooglePlayTransaction(req *AssignGooglePlayTransactionRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.AssignGooglePlayTransactionContext(context.Background(), req)
+}
+
+// Informs server about a purchase through Google Play. For official applications only
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) AssignGooglePlayTransactionContext(ctx context.Context, req *AssignGooglePlayTransactionRequest) (*Ok, error) {
+	err := client.checkUsersOnly("assignGooglePlayTransaction")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "assignGooglePlayTransaction",
 		},
-		Data: map[string]interface{}{
-			"package_name":     req.PackageName,
-			"store_product_id": req.StoreProductId,
-			"purchase_token":   req.PurchaseToken,
-			"purpose":          req.Purpose,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -16539,15 +24541,31 @@ type AcceptTermsOfServiceRequest struct {
 	TermsOfServiceId string `json:"terms_of_service_id"`
 }
 
+func (req *AcceptTermsOfServiceRequest) encode(encoder *encoder) {
+	encoder.String("terms_of_service_id", req.TermsOfServiceId)
+}
+
 // Accepts Telegram terms of services
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) AcceptTermsOfService(req *AcceptTermsOfServiceRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.AcceptTermsOfServiceContext(context.Background(), req)
+}
+
+// Accepts Telegram terms of services
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) AcceptTermsOfServiceContext(ctx context.Context, req *AcceptTermsOfServiceRequest) (*Ok, error) {
+	err := client.checkUsersOnly("acceptTermsOfService")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "acceptTermsOfService",
 		},
-		Data: map[string]interface{}{
-			"terms_of_service_id": req.TermsOfServiceId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -16567,16 +24585,32 @@ type SendCustomRequestRequest struct {
 	Parameters string `json:"parameters"`
 }
 
+func (req *SendCustomRequestRequest) encode(encoder *encoder) {
+	encoder.String("method", req.Method)
+	encoder.String("parameters", req.Parameters)
+}
+
 // Sends a custom request; for bots only
+//
+// Available only to bots, users get FunctionTypeError
 func (client *Client) SendCustomRequest(req *SendCustomRequestRequest) (*CustomRequestResult, error) {
-	result, err := client.Send(Request{
+	return client.SendCustomRequestContext(context.Background(), req)
+}
+
+// Sends a custom request; for bots only
+//
+// Available only to bots, users get FunctionTypeError
+func (client *Client) SendCustomRequestContext(ctx context.Context, req *SendCustomRequestRequest) (*CustomRequestResult, error) {
+	err := client.checkBotsOnly("sendCustomRequest")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "sendCustomRequest",
 		},
-		Data: map[string]interface{}{
-			"method":     req.Method,
-			"parameters": req.Parameters,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -16596,16 +24630,32 @@ type AnswerCustomQueryRequest struct {
 	Data string `json:"data"`
 }
 
+func (req *AnswerCustomQueryRequest) encode(encoder *encoder) {
+	encoder.JsonInt64("custom_query_id", req.CustomQueryId)
+	encoder.String("data", req.Data)
+}
+
 // Answers a custom query; for bots only
+//
+// Available only to bots, users get FunctionTypeError
 func (client *Client) AnswerCustomQuery(req *AnswerCustomQueryRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.AnswerCustomQueryContext(context.Background(), req)
+}
+
+// Answers a custom query; for bots only
+//
+// Available only to bots, users get FunctionTypeError
+func (client *Client) AnswerCustomQueryContext(ctx context.Context, req *AnswerCustomQueryRequest) (*Ok, error) {
+	err := client.checkBotsOnly("answerCustomQuery")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "answerCustomQuery",
 		},
-		Data: map[string]interface{}{
-			"custom_query_id": req.CustomQueryId,
-			"data":            req.Data,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -16623,15 +24673,22 @@ type SetAlarmRequest struct {
 	Seconds float64 `json:"seconds"`
 }
 
+func (req *SetAlarmRequest) encode(encoder *encoder) {
+	encoder.Float64("seconds", req.Seconds)
+}
+
 // Succeeds after a specified amount of time has passed. Can be called before initialization
 func (client *Client) SetAlarm(req *SetAlarmRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SetAlarmContext(context.Background(), req)
+}
+
+// Succeeds after a specified amount of time has passed. Can be called before initialization
+func (client *Client) SetAlarmContext(ctx context.Context, req *SetAlarmRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setAlarm",
 		},
-		Data: map[string]interface{}{
-			"seconds": req.Seconds,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -16646,11 +24703,15 @@ func (client *Client) SetAlarm(req *SetAlarmRequest) (*Ok, error) {
 
 // Returns information about existing countries. Can be called before authorization
 func (client *Client) GetCountries() (*Countries, error) {
-	result, err := client.Send(Request{
+	return client.GetCountriesContext(context.Background())
+}
+
+// Returns information about existing countries. Can be called before authorization
+func (client *Client) GetCountriesContext(ctx context.Context) (*Countries, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getCountries",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -16665,11 +24726,15 @@ func (client *Client) GetCountries() (*Countries, error) {
 
 // Uses the current IP address to find the current country. Returns two-letter ISO 3166-1 alpha-2 country code. Can be called before authorization
 func (client *Client) GetCountryCode() (*Text, error) {
-	result, err := client.Send(Request{
+	return client.GetCountryCodeContext(context.Background())
+}
+
+// Uses the current IP address to find the current country. Returns two-letter ISO 3166-1 alpha-2 country code. Can be called before authorization
+func (client *Client) GetCountryCodeContext(ctx context.Context) (*Text, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getCountryCode",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -16687,15 +24752,22 @@ type GetPhoneNumberInfoRequest struct {
 	PhoneNumberPrefix string `json:"phone_number_prefix"`
 }
 
+func (req *GetPhoneNumberInfoRequest) encode(encoder *encoder) {
+	encoder.String("phone_number_prefix", req.PhoneNumberPrefix)
+}
+
 // Returns information about a phone number by its prefix. Can be called before authorization
 func (client *Client) GetPhoneNumberInfo(req *GetPhoneNumberInfoRequest) (*PhoneNumberInfo, error) {
-	result, err := client.Send(Request{
+	return client.GetPhoneNumberInfoContext(context.Background(), req)
+}
+
+// Returns information about a phone number by its prefix. Can be called before authorization
+func (client *Client) GetPhoneNumberInfoContext(ctx context.Context, req *GetPhoneNumberInfoRequest) (*PhoneNumberInfo, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getPhoneNumberInfo",
 		},
-		Data: map[string]interface{}{
-			"phone_number_prefix": req.PhoneNumberPrefix,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -16715,16 +24787,18 @@ type GetPhoneNumberInfoSyncRequest struct {
 	PhoneNumberPrefix string `json:"phone_number_prefix"`
 }
 
+func (req *GetPhoneNumberInfoSyncRequest) encode(encoder *encoder) {
+	encoder.String("language_code", req.LanguageCode)
+	encoder.String("phone_number_prefix", req.PhoneNumberPrefix)
+}
+
 // Returns information about a phone number by its prefix synchronously. getCountries must be called at least once after changing localization to the specified language if properly localized country information is expected. Can be called synchronously
 func GetPhoneNumberInfoSync(req *GetPhoneNumberInfoSyncRequest) (*PhoneNumberInfo, error) {
 	result, err := Execute(Request{
 		meta: meta{
 			Type: "getPhoneNumberInfoSync",
 		},
-		Data: map[string]interface{}{
-			"language_code":       req.LanguageCode,
-			"phone_number_prefix": req.PhoneNumberPrefix,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -16740,7 +24814,21 @@ func GetPhoneNumberInfoSync(req *GetPhoneNumberInfoSyncRequest) (*PhoneNumberInf
 // deprecated
 // Returns information about a phone number by its prefix synchronously. getCountries must be called at least once after changing localization to the specified language if properly localized country information is expected. Can be called synchronously
 func (client *Client) GetPhoneNumberInfoSync(req *GetPhoneNumberInfoSyncRequest) (*PhoneNumberInfo, error) {
-	return GetPhoneNumberInfoSync(req)
+	result, err := client.Execute(Request{
+		meta: meta{
+			Type: "getPhoneNumberInfoSync",
+		},
+		Data: req,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Type == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return UnmarshalPhoneNumberInfo(result.Data)
 }
 
 type GetDeepLinkInfoRequest struct {
@@ -16748,15 +24836,22 @@ type GetDeepLinkInfoRequest struct {
 	Link string `json:"link"`
 }
 
+func (req *GetDeepLinkInfoRequest) encode(encoder *encoder) {
+	encoder.String("link", req.Link)
+}
+
 // Returns information about a tg:// deep link. Use "tg://need_update_for_some_feature" or "tg:some_unsupported_feature" for testing. Returns a 404 error for unknown links. Can be called before authorization
 func (client *Client) GetDeepLinkInfo(req *GetDeepLinkInfoRequest) (*DeepLinkInfo, error) {
-	result, err := client.Send(Request{
+	return client.GetDeepLinkInfoContext(context.Background(), req)
+}
+
+// Returns information about a tg:// deep link. Use "tg://need_update_for_some_feature" or "tg:some_unsupported_feature" for testing. Returns a 404 error for unknown links. Can be called before authorization
+func (client *Client) GetDeepLinkInfoContext(ctx context.Context, req *GetDeepLinkInfoRequest) (*DeepLinkInfo, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getDeepLinkInfo",
 		},
-		Data: map[string]interface{}{
-			"link": req.Link,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -16770,12 +24865,25 @@ func (client *Client) GetDeepLinkInfo(req *GetDeepLinkInfoRequest) (*DeepLinkInf
 }
 
 // Returns application config, provided by the server. Can be called before authorization
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetApplicationConfig() (JsonValue, error) {
-	result, err := client.Send(Request{
+	return client.GetApplicationConfigContext(context.Background())
+}
+
+// Returns application config, provided by the server. Can be called before authorization
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetApplicationConfigContext(ctx context.Context) (JsonValue, error) {
+	err := client.checkUsersOnly("getApplicationConfig")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getApplicationConfig",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -16785,28 +24893,7 @@ func (client *Client) GetApplicationConfig() (JsonValue, error) {
 		return nil, buildResponseError(result.Data)
 	}
 
-	switch result.Type {
-	case TypeJsonValueNull:
-		return UnmarshalJsonValueNull(result.Data)
-
-	case TypeJsonValueBoolean:
-		return UnmarshalJsonValueBoolean(result.Data)
-
-	case TypeJsonValueNumber:
-		return UnmarshalJsonValueNumber(result.Data)
-
-	case TypeJsonValueString:
-		return UnmarshalJsonValueString(result.Data)
-
-	case TypeJsonValueArray:
-		return UnmarshalJsonValueArray(result.Data)
-
-	case TypeJsonValueObject:
-		return UnmarshalJsonValueObject(result.Data)
-
-	default:
-		return nil, errors.New("invalid type")
-	}
+	return UnmarshalJsonValue(result.Data)
 }
 
 type AddApplicationChangelogRequest struct {
@@ -16814,15 +24901,31 @@ type AddApplicationChangelogRequest struct {
 	PreviousApplicationVersion string `json:"previous_application_version"`
 }
 
+func (req *AddApplicationChangelogRequest) encode(encoder *encoder) {
+	encoder.String("previous_application_version", req.PreviousApplicationVersion)
+}
+
 // Adds server-provided application changelog as messages to the chat 777000 (Telegram); for official applications only. Returns a 404 error if nothing changed
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) AddApplicationChangelog(req *AddApplicationChangelogRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.AddApplicationChangelogContext(context.Background(), req)
+}
+
+// Adds server-provided application changelog as messages to the chat 777000 (Telegram); for official applications only. Returns a 404 error if nothing changed
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) AddApplicationChangelogContext(ctx context.Context, req *AddApplicationChangelogRequest) (*Ok, error) {
+	err := client.checkUsersOnly("addApplicationChangelog")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "addApplicationChangelog",
 		},
-		Data: map[string]interface{}{
-			"previous_application_version": req.PreviousApplicationVersion,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -16844,17 +24947,38 @@ type SaveApplicationLogEventRequest struct {
 	Data JsonValue `json:"data"`
 }
 
+func (req *SaveApplicationLogEventRequest) encode(encoder *encoder) {
+	encoder.String("type", req.Type)
+	encoder.Int64("chat_id", req.ChatId)
+	encoder.name("data")
+	encodeJsonValue(encoder, req.Data)
+}
+
+func (req *SaveApplicationLogEventRequest) chatId() int64 {
+	return req.ChatId
+}
+
 // Saves application log event on the server. Can be called before authorization
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SaveApplicationLogEvent(req *SaveApplicationLogEventRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.SaveApplicationLogEventContext(context.Background(), req)
+}
+
+// Saves application log event on the server. Can be called before authorization
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SaveApplicationLogEventContext(ctx context.Context, req *SaveApplicationLogEventRequest) (*Ok, error) {
+	err := client.checkUsersOnly("saveApplicationLogEvent")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "saveApplicationLogEvent",
 		},
-		Data: map[string]interface{}{
-			"type":    req.Type,
-			"chat_id": req.ChatId,
-			"data":    req.Data,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -16868,12 +24992,25 @@ func (client *Client) SaveApplicationLogEvent(req *SaveApplicationLogEventReques
 }
 
 // Returns the link for downloading official Telegram application to be used when the current user invites friends to Telegram
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetApplicationDownloadLink() (*HttpUrl, error) {
-	result, err := client.Send(Request{
+	return client.GetApplicationDownloadLinkContext(context.Background())
+}
+
+// Returns the link for downloading official Telegram application to be used when the current user invites friends to Telegram
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetApplicationDownloadLinkContext(ctx context.Context) (*HttpUrl, error) {
+	err := client.checkUsersOnly("getApplicationDownloadLink")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getApplicationDownloadLink",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -16897,18 +25034,26 @@ type AddProxyRequest struct {
 	Type ProxyType `json:"type"`
 }
 
+func (req *AddProxyRequest) encode(encoder *encoder) {
+	encoder.String("server", req.Server)
+	encoder.Int32("port", req.Port)
+	encoder.Bool("enable", req.Enable)
+	encoder.name("type")
+	encodeProxyType(encoder, req.Type)
+}
+
 // Adds a proxy server for network requests. Can be called before authorization
 func (client *Client) AddProxy(req *AddProxyRequest) (*Proxy, error) {
-	result, err := client.Send(Request{
+	return client.AddProxyContext(context.Background(), req)
+}
+
+// Adds a proxy server for network requests. Can be called before authorization
+func (client *Client) AddProxyContext(ctx context.Context, req *AddProxyRequest) (*Proxy, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "addProxy",
 		},
-		Data: map[string]interface{}{
-			"server": req.Server,
-			"port":   req.Port,
-			"enable": req.Enable,
-			"type":   req.Type,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -16934,19 +25079,27 @@ type EditProxyRequest struct {
 	Type ProxyType `json:"type"`
 }
 
+func (req *EditProxyRequest) encode(encoder *encoder) {
+	encoder.Int32("proxy_id", req.ProxyId)
+	encoder.String("server", req.Server)
+	encoder.Int32("port", req.Port)
+	encoder.Bool("enable", req.Enable)
+	encoder.name("type")
+	encodeProxyType(encoder, req.Type)
+}
+
 // Edits an existing proxy server for network requests. Can be called before authorization
 func (client *Client) EditProxy(req *EditProxyRequest) (*Proxy, error) {
-	result, err := client.Send(Request{
+	return client.EditProxyContext(context.Background(), req)
+}
+
+// Edits an existing proxy server for network requests. Can be called before authorization
+func (client *Client) EditProxyContext(ctx context.Context, req *EditProxyRequest) (*Proxy, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "editProxy",
 		},
-		Data: map[string]interface{}{
-			"proxy_id": req.ProxyId,
-			"server":   req.Server,
-			"port":     req.Port,
-			"enable":   req.Enable,
-			"type":     req.Type,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -16964,15 +25117,22 @@ type EnableProxyRequest struct {
 	ProxyId int32 `json:"proxy_id"`
 }
 
+func (req *EnableProxyRequest) encode(encoder *encoder) {
+	encoder.Int32("proxy_id", req.ProxyId)
+}
+
 // Enables a proxy. Only one proxy can be enabled at a time. Can be called before authorization
 func (client *Client) EnableProxy(req *EnableProxyRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.EnableProxyContext(context.Background(), req)
+}
+
+// Enables a proxy. Only one proxy can be enabled at a time. Can be called before authorization
+func (client *Client) EnableProxyContext(ctx context.Context, req *EnableProxyRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "enableProxy",
 		},
-		Data: map[string]interface{}{
-			"proxy_id": req.ProxyId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -16987,11 +25147,15 @@ func (client *Client) EnableProxy(req *EnableProxyRequest) (*Ok, error) {
 
 // Disables the currently enabled proxy. Can be called before authorization
 func (client *Client) DisableProxy() (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.DisableProxyContext(context.Background())
+}
+
+// Disables the currently enabled proxy. Can be called before authorization
+func (client *Client) DisableProxyContext(ctx context.Context) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "disableProxy",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -17009,15 +25173,22 @@ type RemoveProxyRequest struct {
 	ProxyId int32 `json:"proxy_id"`
 }
 
+func (req *RemoveProxyRequest) encode(encoder *encoder) {
+	encoder.Int32("proxy_id", req.ProxyId)
+}
+
 // Removes a proxy server. Can be called before authorization
 func (client *Client) RemoveProxy(req *RemoveProxyRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.RemoveProxyContext(context.Background(), req)
+}
+
+// Removes a proxy server. Can be called before authorization
+func (client *Client) RemoveProxyContext(ctx context.Context, req *RemoveProxyRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "removeProxy",
 		},
-		Data: map[string]interface{}{
-			"proxy_id": req.ProxyId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -17032,11 +25203,15 @@ func (client *Client) RemoveProxy(req *RemoveProxyRequest) (*Ok, error) {
 
 // Returns list of proxies that are currently set up. Can be called before authorization
 func (client *Client) GetProxies() (*Proxies, error) {
-	result, err := client.Send(Request{
+	return client.GetProxiesContext(context.Background())
+}
+
+// Returns list of proxies that are currently set up. Can be called before authorization
+func (client *Client) GetProxiesContext(ctx context.Context) (*Proxies, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getProxies",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -17054,15 +25229,22 @@ type GetProxyLinkRequest struct {
 	ProxyId int32 `json:"proxy_id"`
 }
 
+func (req *GetProxyLinkRequest) encode(encoder *encoder) {
+	encoder.Int32("proxy_id", req.ProxyId)
+}
+
 // Returns an HTTPS link, which can be used to add a proxy. Available only for SOCKS5 and MTProto proxies. Can be called before authorization
 func (client *Client) GetProxyLink(req *GetProxyLinkRequest) (*HttpUrl, error) {
-	result, err := client.Send(Request{
+	return client.GetProxyLinkContext(context.Background(), req)
+}
+
+// Returns an HTTPS link, which can be used to add a proxy. Available only for SOCKS5 and MTProto proxies. Can be called before authorization
+func (client *Client) GetProxyLinkContext(ctx context.Context, req *GetProxyLinkRequest) (*HttpUrl, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getProxyLink",
 		},
-		Data: map[string]interface{}{
-			"proxy_id": req.ProxyId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -17080,15 +25262,22 @@ type PingProxyRequest struct {
 	ProxyId int32 `json:"proxy_id"`
 }
 
+func (req *PingProxyRequest) encode(encoder *encoder) {
+	encoder.Int32("proxy_id", req.ProxyId)
+}
+
 // Computes time needed to receive a response from a Telegram server through a proxy. Can be called before authorization
 func (client *Client) PingProxy(req *PingProxyRequest) (*Seconds, error) {
-	result, err := client.Send(Request{
+	return client.PingProxyContext(context.Background(), req)
+}
+
+// Computes time needed to receive a response from a Telegram server through a proxy. Can be called before authorization
+func (client *Client) PingProxyContext(ctx context.Context, req *PingProxyRequest) (*Seconds, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "pingProxy",
 		},
-		Data: map[string]interface{}{
-			"proxy_id": req.ProxyId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -17106,15 +25295,18 @@ type SetLogStreamRequest struct {
 	LogStream LogStream `json:"log_stream"`
 }
 
+func (req *SetLogStreamRequest) encode(encoder *encoder) {
+	encoder.name("log_stream")
+	encodeLogStream(encoder, req.LogStream)
+}
+
 // Sets new log stream for internal logging of TDLib. Can be called synchronously
 func SetLogStream(req *SetLogStreamRequest) (*Ok, error) {
 	result, err := Execute(Request{
 		meta: meta{
 			Type: "setLogStream",
 		},
-		Data: map[string]interface{}{
-			"log_stream": req.LogStream,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -17130,7 +25322,21 @@ func SetLogStream(req *SetLogStreamRequest) (*Ok, error) {
 // deprecated
 // Sets new log stream for internal logging of TDLib. Can be called synchronously
 func (client *Client) SetLogStream(req *SetLogStreamRequest) (*Ok, error) {
-	return SetLogStream(req)
+	result, err := client.Execute(Request{
+		meta: meta{
+			Type: "setLogStream",
+		},
+		Data: req,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Type == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return UnmarshalOk(result.Data)
 }
 
 // Returns information about currently used log stream for internal logging of TDLib. Can be called synchronously
@@ -17139,7 +25345,6 @@ func GetLogStream() (LogStream, error) {
 		meta: meta{
 			Type: "getLogStream",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -17149,25 +25354,26 @@ func GetLogStream() (LogStream, error) {
 		return nil, buildResponseError(result.Data)
 	}
 
-	switch result.Type {
-	case TypeLogStreamDefault:
-		return UnmarshalLogStreamDefault(result.Data)
-
-	case TypeLogStreamFile:
-		return UnmarshalLogStreamFile(result.Data)
-
-	case TypeLogStreamEmpty:
-		return UnmarshalLogStreamEmpty(result.Data)
-
-	default:
-		return nil, errors.New("invalid type")
-	}
+	return UnmarshalLogStream(result.Data)
 }
 
 // deprecated
 // Returns information about currently used log stream for internal logging of TDLib. Can be called synchronously
 func (client *Client) GetLogStream() (LogStream, error) {
-	return GetLogStream()
+	result, err := client.Execute(Request{
+		meta: meta{
+			Type: "getLogStream",
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Type == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return UnmarshalLogStream(result.Data)
 }
 
 type SetLogVerbosityLevelRequest struct {
@@ -17175,15 +25381,17 @@ type SetLogVerbosityLevelRequest struct {
 	NewVerbosityLevel int32 `json:"new_verbosity_level"`
 }
 
+func (req *SetLogVerbosityLevelRequest) encode(encoder *encoder) {
+	encoder.Int32("new_verbosity_level", req.NewVerbosityLevel)
+}
+
 // Sets the verbosity level of the internal logging of TDLib. Can be called synchronously
 func SetLogVerbosityLevel(req *SetLogVerbosityLevelRequest) (*Ok, error) {
 	result, err := Execute(Request{
 		meta: meta{
 			Type: "setLogVerbosityLevel",
 		},
-		Data: map[string]interface{}{
-			"new_verbosity_level": req.NewVerbosityLevel,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -17199,7 +25407,21 @@ func SetLogVerbosityLevel(req *SetLogVerbosityLevelRequest) (*Ok, error) {
 // deprecated
 // Sets the verbosity level of the internal logging of TDLib. Can be called synchronously
 func (client *Client) SetLogVerbosityLevel(req *SetLogVerbosityLevelRequest) (*Ok, error) {
-	return SetLogVerbosityLevel(req)
+	result, err := client.Execute(Request{
+		meta: meta{
+			Type: "setLogVerbosityLevel",
+		},
+		Data: req,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Type == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return UnmarshalOk(result.Data)
 }
 
 // Returns current verbosity level of the internal logging of TDLib. Can be called synchronously
@@ -17208,7 +25430,6 @@ func GetLogVerbosityLevel() (*LogVerbosityLevel, error) {
 		meta: meta{
 			Type: "getLogVerbosityLevel",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -17224,7 +25445,20 @@ func GetLogVerbosityLevel() (*LogVerbosityLevel, error) {
 // deprecated
 // Returns current verbosity level of the internal logging of TDLib. Can be called synchronously
 func (client *Client) GetLogVerbosityLevel() (*LogVerbosityLevel, error) {
-	return GetLogVerbosityLevel()
+	result, err := client.Execute(Request{
+		meta: meta{
+			Type: "getLogVerbosityLevel",
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Type == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return UnmarshalLogVerbosityLevel(result.Data)
 }
 
 // Returns list of available TDLib internal log tags, for example, ["actor", "binlog", "connections", "notifications", "proxy"]. Can be called synchronously
@@ -17233,7 +25467,6 @@ func GetLogTags() (*LogTags, error) {
 		meta: meta{
 			Type: "getLogTags",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -17249,7 +25482,20 @@ func GetLogTags() (*LogTags, error) {
 // deprecated
 // Returns list of available TDLib internal log tags, for example, ["actor", "binlog", "connections", "notifications", "proxy"]. Can be called synchronously
 func (client *Client) GetLogTags() (*LogTags, error) {
-	return GetLogTags()
+	result, err := client.Execute(Request{
+		meta: meta{
+			Type: "getLogTags",
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Type == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return UnmarshalLogTags(result.Data)
 }
 
 type SetLogTagVerbosityLevelRequest struct {
@@ -17259,16 +25505,18 @@ type SetLogTagVerbosityLevelRequest struct {
 	NewVerbosityLevel int32 `json:"new_verbosity_level"`
 }
 
+func (req *SetLogTagVerbosityLevelRequest) encode(encoder *encoder) {
+	encoder.String("tag", req.Tag)
+	encoder.Int32("new_verbosity_level", req.NewVerbosityLevel)
+}
+
 // Sets the verbosity level for a specified TDLib internal log tag. Can be called synchronously
 func SetLogTagVerbosityLevel(req *SetLogTagVerbosityLevelRequest) (*Ok, error) {
 	result, err := Execute(Request{
 		meta: meta{
 			Type: "setLogTagVerbosityLevel",
 		},
-		Data: map[string]interface{}{
-			"tag":                 req.Tag,
-			"new_verbosity_level": req.NewVerbosityLevel,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -17284,7 +25532,21 @@ func SetLogTagVerbosityLevel(req *SetLogTagVerbosityLevelRequest) (*Ok, error) {
 // deprecated
 // Sets the verbosity level for a specified TDLib internal log tag. Can be called synchronously
 func (client *Client) SetLogTagVerbosityLevel(req *SetLogTagVerbosityLevelRequest) (*Ok, error) {
-	return SetLogTagVerbosityLevel(req)
+	result, err := client.Execute(Request{
+		meta: meta{
+			Type: "setLogTagVerbosityLevel",
+		},
+		Data: req,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Type == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return UnmarshalOk(result.Data)
 }
 
 type GetLogTagVerbosityLevelRequest struct {
@@ -17292,15 +25554,17 @@ type GetLogTagVerbosityLevelRequest struct {
 	Tag string `json:"tag"`
 }
 
+func (req *GetLogTagVerbosityLevelRequest) encode(encoder *encoder) {
+	encoder.String("tag", req.Tag)
+}
+
 // Returns current verbosity level for a specified TDLib internal log tag. Can be called synchronously
 func GetLogTagVerbosityLevel(req *GetLogTagVerbosityLevelRequest) (*LogVerbosityLevel, error) {
 	result, err := Execute(Request{
 		meta: meta{
 			Type: "getLogTagVerbosityLevel",
 		},
-		Data: map[string]interface{}{
-			"tag": req.Tag,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -17316,7 +25580,21 @@ func GetLogTagVerbosityLevel(req *GetLogTagVerbosityLevelRequest) (*LogVerbosity
 // deprecated
 // Returns current verbosity level for a specified TDLib internal log tag. Can be called synchronously
 func (client *Client) GetLogTagVerbosityLevel(req *GetLogTagVerbosityLevelRequest) (*LogVerbosityLevel, error) {
-	return GetLogTagVerbosityLevel(req)
+	result, err := client.Execute(Request{
+		meta: meta{
+			Type: "getLogTagVerbosityLevel",
+		},
+		Data: req,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Type == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return UnmarshalLogVerbosityLevel(result.Data)
 }
 
 type AddLogMessageRequest struct {
@@ -17326,16 +25604,18 @@ type AddLogMessageRequest struct {
 	Text string `json:"text"`
 }
 
+func (req *AddLogMessageRequest) encode(encoder *encoder) {
+	encoder.Int32("verbosity_level", req.VerbosityLevel)
+	encoder.String("text", req.Text)
+}
+
 // Adds a message to TDLib internal log. Can be called synchronously
 func AddLogMessage(req *AddLogMessageRequest) (*Ok, error) {
 	result, err := Execute(Request{
 		meta: meta{
 			Type: "addLogMessage",
 		},
-		Data: map[string]interface{}{
-			"verbosity_level": req.VerbosityLevel,
-			"text":            req.Text,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -17351,7 +25631,21 @@ func AddLogMessage(req *AddLogMessageRequest) (*Ok, error) {
 // deprecated
 // Adds a message to TDLib internal log. Can be called synchronously
 func (client *Client) AddLogMessage(req *AddLogMessageRequest) (*Ok, error) {
-	return AddLogMessage(req)
+	result, err := client.Execute(Request{
+		meta: meta{
+			Type: "addLogMessage",
+		},
+		Data: req,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Type == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return UnmarshalOk(result.Data)
 }
 
 type GetUserSupportInfoRequest struct {
@@ -17359,15 +25653,31 @@ type GetUserSupportInfoRequest struct {
 	UserId int64 `json:"user_id"`
 }
 
+func (req *GetUserSupportInfoRequest) encode(encoder *encoder) {
+	encoder.Int64("user_id", req.UserId)
+}
+
 // Returns support information for the given user; for Telegram support only
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetUserSupportInfo(req *GetUserSupportInfoRequest) (*UserSupportInfo, error) {
-	result, err := client.Send(Request{
+	return client.GetUserSupportInfoContext(context.Background(), req)
+}
+
+// Returns support information for the given user; for Telegram support only
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetUserSupportInfoContext(ctx context.Context, req *GetUserSupportInfoRequest) (*UserSupportInfo, error) {
+	err := client.checkUsersOnly("getUserSupportInfo")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getUserSupportInfo",
 		},
-		Data: map[string]interface{}{
-			"user_id": req.UserId,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -17387,16 +25697,33 @@ type SetUserSupportInfoRequest struct {
 	Message *FormattedText `json:"message"`
 }
 
+func (req *SetUserSupportInfoRequest) encode(encoder *encoder) {
+	encoder.Int64("user_id", req.UserId)
+	encoder.name("message")
+	encodeFormattedText(encoder, req.Message)
+}
+
 // Sets support information for the given user; for Telegram support only
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) SetUserSupportInfo(req *SetUserSupportInfoRequest) (*UserSupportInfo, error) {
-	result, err := client.Send(Request{
+	return client.SetUserSupportInfoContext(context.Background(), req)
+}
+
+// Sets support information for the given user; for Telegram support only
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) SetUserSupportInfoContext(ctx context.Context, req *SetUserSupportInfoRequest) (*UserSupportInfo, error) {
+	err := client.checkUsersOnly("setUserSupportInfo")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "setUserSupportInfo",
 		},
-		Data: map[string]interface{}{
-			"user_id": req.UserId,
-			"message": req.Message,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -17410,12 +25737,25 @@ func (client *Client) SetUserSupportInfo(req *SetUserSupportInfoRequest) (*UserS
 }
 
 // Returns localized name of the Telegram support user; for Telegram support only
+//
+// Available only to users, bots get FunctionTypeError
 func (client *Client) GetSupportName() (*Text, error) {
-	result, err := client.Send(Request{
+	return client.GetSupportNameContext(context.Background())
+}
+
+// Returns localized name of the Telegram support user; for Telegram support only
+//
+// Available only to users, bots get FunctionTypeError
+func (client *Client) GetSupportNameContext(ctx context.Context) (*Text, error) {
+	err := client.checkUsersOnly("getSupportName")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "getSupportName",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -17430,11 +25770,15 @@ func (client *Client) GetSupportName() (*Text, error) {
 
 // Does nothing; for testing only. This is an offline method. Can be called before authorization
 func (client *Client) TestCallEmpty() (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.TestCallEmptyContext(context.Background())
+}
+
+// Does nothing; for testing only. This is an offline method. Can be called before authorization
+func (client *Client) TestCallEmptyContext(ctx context.Context) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "testCallEmpty",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -17452,15 +25796,22 @@ type TestCallStringRequest struct {
 	X string `json:"x"`
 }
 
+func (req *TestCallStringRequest) encode(encoder *encoder) {
+	encoder.String("x", req.X)
+}
+
 // Returns the received string; for testing only. This is an offline method. Can be called before authorization
 func (client *Client) TestCallString(req *TestCallStringRequest) (*TestString, error) {
-	result, err := client.Send(Request{
+	return client.TestCallStringContext(context.Background(), req)
+}
+
+// Returns the received string; for testing only. This is an offline method. Can be called before authorization
+func (client *Client) TestCallStringContext(ctx context.Context, req *TestCallStringRequest) (*TestString, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "testCallString",
 		},
-		Data: map[string]interface{}{
-			"x": req.X,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -17478,15 +25829,22 @@ type TestCallBytesRequest struct {
 	X []byte `json:"x"`
 }
 
+func (req *TestCallBytesRequest) encode(encoder *encoder) {
+	encoder.Bytes("x", req.X)
+}
+
 // Returns the received bytes; for testing only. This is an offline method. Can be called before authorization
 func (client *Client) TestCallBytes(req *TestCallBytesRequest) (*TestBytes, error) {
-	result, err := client.Send(Request{
+	return client.TestCallBytesContext(context.Background(), req)
+}
+
+// Returns the received bytes; for testing only. This is an offline method. Can be called before authorization
+func (client *Client) TestCallBytesContext(ctx context.Context, req *TestCallBytesRequest) (*TestBytes, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "testCallBytes",
 		},
-		Data: map[string]interface{}{
-			"x": req.X,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -17504,15 +25862,22 @@ type TestCallVectorIntRequest struct {
 	X []int32 `json:"x"`
 }
 
+func (req *TestCallVectorIntRequest) encode(encoder *encoder) {
+	encoder.Int32s("x", req.X)
+}
+
 // Returns the received vector of numbers; for testing only. This is an offline method. Can be called before authorization
 func (client *Client) TestCallVectorInt(req *TestCallVectorIntRequest) (*TestVectorInt, error) {
-	result, err := client.Send(Request{
+	return client.TestCallVectorIntContext(context.Background(), req)
+}
+
+// Returns the received vector of numbers; for testing only. This is an offline method. Can be called before authorization
+func (client *Client) TestCallVectorIntContext(ctx context.Context, req *TestCallVectorIntRequest) (*TestVectorInt, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "testCallVectorInt",
 		},
-		Data: map[string]interface{}{
-			"x": req.X,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -17530,15 +25895,23 @@ type TestCallVectorIntObjectRequest struct {
 	X []*TestInt `json:"x"`
 }
 
+func (req *TestCallVectorIntObjectRequest) encode(encoder *encoder) {
+	encoder.name("x")
+	encodeListOfTestInt(encoder, req.X)
+}
+
 // Returns the received vector of objects containing a number; for testing only. This is an offline method. Can be called before authorization
 func (client *Client) TestCallVectorIntObject(req *TestCallVectorIntObjectRequest) (*TestVectorIntObject, error) {
-	result, err := client.Send(Request{
+	return client.TestCallVectorIntObjectContext(context.Background(), req)
+}
+
+// Returns the received vector of objects containing a number; for testing only. This is an offline method. Can be called before authorization
+func (client *Client) TestCallVectorIntObjectContext(ctx context.Context, req *TestCallVectorIntObjectRequest) (*TestVectorIntObject, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "testCallVectorIntObject",
 		},
-		Data: map[string]interface{}{
-			"x": req.X,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -17556,15 +25929,22 @@ type TestCallVectorStringRequest struct {
 	X []string `json:"x"`
 }
 
+func (req *TestCallVectorStringRequest) encode(encoder *encoder) {
+	encoder.Strings("x", req.X)
+}
+
 // Returns the received vector of strings; for testing only. This is an offline method. Can be called before authorization
 func (client *Client) TestCallVectorString(req *TestCallVectorStringRequest) (*TestVectorString, error) {
-	result, err := client.Send(Request{
+	return client.TestCallVectorStringContext(context.Background(), req)
+}
+
+// Returns the received vector of strings; for testing only. This is an offline method. Can be called before authorization
+func (client *Client) TestCallVectorStringContext(ctx context.Context, req *TestCallVectorStringRequest) (*TestVectorString, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "testCallVectorString",
 		},
-		Data: map[string]interface{}{
-			"x": req.X,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -17582,15 +25962,23 @@ type TestCallVectorStringObjectRequest struct {
 	X []*TestString `json:"x"`
 }
 
+func (req *TestCallVectorStringObjectRequest) encode(encoder *encoder) {
+	encoder.name("x")
+	encodeListOfTestString(encoder, req.X)
+}
+
 // Returns the received vector of objects containing a string; for testing only. This is an offline method. Can be called before authorization
 func (client *Client) TestCallVectorStringObject(req *TestCallVectorStringObjectRequest) (*TestVectorStringObject, error) {
-	result, err := client.Send(Request{
+	return client.TestCallVectorStringObjectContext(context.Background(), req)
+}
+
+// Returns the received vector of objects containing a string; for testing only. This is an offline method. Can be called before authorization
+func (client *Client) TestCallVectorStringObjectContext(ctx context.Context, req *TestCallVectorStringObjectRequest) (*TestVectorStringObject, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "testCallVectorStringObject",
 		},
-		Data: map[string]interface{}{
-			"x": req.X,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -17608,15 +25996,22 @@ type TestSquareIntRequest struct {
 	X int32 `json:"x"`
 }
 
+func (req *TestSquareIntRequest) encode(encoder *encoder) {
+	encoder.Int32("x", req.X)
+}
+
 // Returns the squared received number; for testing only. This is an offline method. Can be called before authorization
 func (client *Client) TestSquareInt(req *TestSquareIntRequest) (*TestInt, error) {
-	result, err := client.Send(Request{
+	return client.TestSquareIntContext(context.Background(), req)
+}
+
+// Returns the squared received number; for testing only. This is an offline method. Can be called before authorization
+func (client *Client) TestSquareIntContext(ctx context.Context, req *TestSquareIntRequest) (*TestInt, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "testSquareInt",
 		},
-		Data: map[string]interface{}{
-			"x": req.X,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -17631,11 +26026,15 @@ func (client *Client) TestSquareInt(req *TestSquareIntRequest) (*TestInt, error)
 
 // Sends a simple network request to the Telegram servers; for testing only. Can be called before authorization
 func (client *Client) TestNetwork() (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.TestNetworkContext(context.Background())
+}
+
+// Sends a simple network request to the Telegram servers; for testing only. Can be called before authorization
+func (client *Client) TestNetworkContext(ctx context.Context) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "testNetwork",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -17661,19 +26060,27 @@ type TestProxyRequest struct {
 	Timeout float64 `json:"timeout"`
 }
 
+func (req *TestProxyRequest) encode(encoder *encoder) {
+	encoder.String("server", req.Server)
+	encoder.Int32("port", req.Port)
+	encoder.name("type")
+	encodeProxyType(encoder, req.Type)
+	encoder.Int32("dc_id", req.DcId)
+	encoder.Float64("timeout", req.Timeout)
+}
+
 // Sends a simple network request to the Telegram servers via proxy; for testing only. Can be called before authorization
 func (client *Client) TestProxy(req *TestProxyRequest) (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.TestProxyContext(context.Background(), req)
+}
+
+// Sends a simple network request to the Telegram servers via proxy; for testing only. Can be called before authorization
+func (client *Client) TestProxyContext(ctx context.Context, req *TestProxyRequest) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "testProxy",
 		},
-		Data: map[string]interface{}{
-			"server":  req.Server,
-			"port":    req.Port,
-			"type":    req.Type,
-			"dc_id":   req.DcId,
-			"timeout": req.Timeout,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -17688,11 +26095,15 @@ func (client *Client) TestProxy(req *TestProxyRequest) (*Ok, error) {
 
 // Forces an updates.getDifference call to the Telegram servers; for testing only
 func (client *Client) TestGetDifference() (*Ok, error) {
-	result, err := client.Send(Request{
+	return client.TestGetDifferenceContext(context.Background())
+}
+
+// Forces an updates.getDifference call to the Telegram servers; for testing only
+func (client *Client) TestGetDifferenceContext(ctx context.Context) (*Ok, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "testGetDifference",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -17707,11 +26118,15 @@ func (client *Client) TestGetDifference() (*Ok, error) {
 
 // Does nothing and ensures that the Update object is used; for testing only. This is an offline method. Can be called before authorization
 func (client *Client) TestUseUpdate() (Update, error) {
-	result, err := client.Send(Request{
+	return client.TestUseUpdateContext(context.Background())
+}
+
+// Does nothing and ensures that the Update object is used; for testing only. This is an offline method. Can be called before authorization
+func (client *Client) TestUseUpdateContext(ctx context.Context) (Update, error) {
+	result, err := client.SendContext(ctx, Request{
 		meta: meta{
 			Type: "testUseUpdate",
 		},
-		Data: map[string]interface{}{},
 	})
 	if err != nil {
 		return nil, err
@@ -17721,340 +26136,7 @@ func (client *Client) TestUseUpdate() (Update, error) {
 		return nil, buildResponseError(result.Data)
 	}
 
-	switch result.Type {
-	case TypeUpdateAuthorizationState:
-		return UnmarshalUpdateAuthorizationState(result.Data)
-
-	case TypeUpdateNewMessage:
-		return UnmarshalUpdateNewMessage(result.Data)
-
-	case TypeUpdateMessageSendAcknowledged:
-		return UnmarshalUpdateMessageSendAcknowledged(result.Data)
-
-	case TypeUpdateMessageSendSucceeded:
-		return UnmarshalUpdateMessageSendSucceeded(result.Data)
-
-	case TypeUpdateMessageSendFailed:
-		return UnmarshalUpdateMessageSendFailed(result.Data)
-
-	case TypeUpdateMessageContent:
-		return UnmarshalUpdateMessageContent(result.Data)
-
-	case TypeUpdateMessageEdited:
-		return UnmarshalUpdateMessageEdited(result.Data)
-
-	case TypeUpdateMessageIsPinned:
-		return UnmarshalUpdateMessageIsPinned(result.Data)
-
-	case TypeUpdateMessageInteractionInfo:
-		return UnmarshalUpdateMessageInteractionInfo(result.Data)
-
-	case TypeUpdateMessageContentOpened:
-		return UnmarshalUpdateMessageContentOpened(result.Data)
-
-	case TypeUpdateMessageMentionRead:
-		return UnmarshalUpdateMessageMentionRead(result.Data)
-
-	case TypeUpdateMessageUnreadReactions:
-		return UnmarshalUpdateMessageUnreadReactions(result.Data)
-
-	case TypeUpdateMessageLiveLocationViewed:
-		return UnmarshalUpdateMessageLiveLocationViewed(result.Data)
-
-	case TypeUpdateNewChat:
-		return UnmarshalUpdateNewChat(result.Data)
-
-	case TypeUpdateChatTitle:
-		return UnmarshalUpdateChatTitle(result.Data)
-
-	case TypeUpdateChatPhoto:
-		return UnmarshalUpdateChatPhoto(result.Data)
-
-	case TypeUpdateChatPermissions:
-		return UnmarshalUpdateChatPermissions(result.Data)
-
-	case TypeUpdateChatLastMessage:
-		return UnmarshalUpdateChatLastMessage(result.Data)
-
-	case TypeUpdateChatPosition:
-		return UnmarshalUpdateChatPosition(result.Data)
-
-	case TypeUpdateChatReadInbox:
-		return UnmarshalUpdateChatReadInbox(result.Data)
-
-	case TypeUpdateChatReadOutbox:
-		return UnmarshalUpdateChatReadOutbox(result.Data)
-
-	case TypeUpdateChatActionBar:
-		return UnmarshalUpdateChatActionBar(result.Data)
-
-	case TypeUpdateChatAvailableReactions:
-		return UnmarshalUpdateChatAvailableReactions(result.Data)
-
-	case TypeUpdateChatDraftMessage:
-		return UnmarshalUpdateChatDraftMessage(result.Data)
-
-	case TypeUpdateChatMessageSender:
-		return UnmarshalUpdateChatMessageSender(result.Data)
-
-	case TypeUpdateChatMessageAutoDeleteTime:
-		return UnmarshalUpdateChatMessageAutoDeleteTime(result.Data)
-
-	case TypeUpdateChatNotificationSettings:
-		return UnmarshalUpdateChatNotificationSettings(result.Data)
-
-	case TypeUpdateChatPendingJoinRequests:
-		return UnmarshalUpdateChatPendingJoinRequests(result.Data)
-
-	case TypeUpdateChatReplyMarkup:
-		return UnmarshalUpdateChatReplyMarkup(result.Data)
-
-	case TypeUpdateChatBackground:
-		return UnmarshalUpdateChatBackground(result.Data)
-
-	case TypeUpdateChatTheme:
-		return UnmarshalUpdateChatTheme(result.Data)
-
-	case TypeUpdateChatUnreadMentionCount:
-		return UnmarshalUpdateChatUnreadMentionCount(result.Data)
-
-	case TypeUpdateChatUnreadReactionCount:
-		return UnmarshalUpdateChatUnreadReactionCount(result.Data)
-
-	case TypeUpdateChatVideoChat:
-		return UnmarshalUpdateChatVideoChat(result.Data)
-
-	case TypeUpdateChatDefaultDisableNotification:
-		return UnmarshalUpdateChatDefaultDisableNotification(result.Data)
-
-	case TypeUpdateChatHasProtectedContent:
-		return UnmarshalUpdateChatHasProtectedContent(result.Data)
-
-	case TypeUpdateChatIsTranslatable:
-		return UnmarshalUpdateChatIsTranslatable(result.Data)
-
-	case TypeUpdateChatIsMarkedAsUnread:
-		return UnmarshalUpdateChatIsMarkedAsUnread(result.Data)
-
-	case TypeUpdateChatIsBlocked:
-		return UnmarshalUpdateChatIsBlocked(result.Data)
-
-	case TypeUpdateChatHasScheduledMessages:
-		return UnmarshalUpdateChatHasScheduledMessages(result.Data)
-
-	case TypeUpdateChatFolders:
-		return UnmarshalUpdateChatFolders(result.Data)
-
-	case TypeUpdateChatOnlineMemberCount:
-		return UnmarshalUpdateChatOnlineMemberCount(result.Data)
-
-	case TypeUpdateForumTopicInfo:
-		return UnmarshalUpdateForumTopicInfo(result.Data)
-
-	case TypeUpdateScopeNotificationSettings:
-		return UnmarshalUpdateScopeNotificationSettings(result.Data)
-
-	case TypeUpdateNotification:
-		return UnmarshalUpdateNotification(result.Data)
-
-	case TypeUpdateNotificationGroup:
-		return UnmarshalUpdateNotificationGroup(result.Data)
-
-	case TypeUpdateActiveNotifications:
-		return UnmarshalUpdateActiveNotifications(result.Data)
-
-	case TypeUpdateHavePendingNotifications:
-		return UnmarshalUpdateHavePendingNotifications(result.Data)
-
-	case TypeUpdateDeleteMessages:
-		return UnmarshalUpdateDeleteMessages(result.Data)
-
-	case TypeUpdateChatAction:
-		return UnmarshalUpdateChatAction(result.Data)
-
-	case TypeUpdateUserStatus:
-		return UnmarshalUpdateUserStatus(result.Data)
-
-	case TypeUpdateUser:
-		return UnmarshalUpdateUser(result.Data)
-
-	case TypeUpdateAccessHash:
-		return UnmarshalUpdateAccessHash(result.Data)
-
-	case TypeUpdateBasicGroup:
-		return UnmarshalUpdateBasicGroup(result.Data)
-
-	case TypeUpdateSupergroup:
-		return UnmarshalUpdateSupergroup(result.Data)
-
-	case TypeUpdateSecretChat:
-		return UnmarshalUpdateSecretChat(result.Data)
-
-	case TypeUpdateUserFullInfo:
-		return UnmarshalUpdateUserFullInfo(result.Data)
-
-	case TypeUpdateBasicGroupFullInfo:
-		return UnmarshalUpdateBasicGroupFullInfo(result.Data)
-
-	case TypeUpdateSupergroupFullInfo:
-		return UnmarshalUpdateSupergroupFullInfo(result.Data)
-
-	case TypeUpdateServiceNotification:
-		return UnmarshalUpdateServiceNotification(result.Data)
-
-	case TypeUpdateFile:
-		return UnmarshalUpdateFile(result.Data)
-
-	case TypeUpdateFileGenerationStart:
-		return UnmarshalUpdateFileGenerationStart(result.Data)
-
-	case TypeUpdateFileGenerationStop:
-		return UnmarshalUpdateFileGenerationStop(result.Data)
-
-	case TypeUpdateFileDownloads:
-		return UnmarshalUpdateFileDownloads(result.Data)
-
-	case TypeUpdateFileAddedToDownloads:
-		return UnmarshalUpdateFileAddedToDownloads(result.Data)
-
-	case TypeUpdateFileDownload:
-		return UnmarshalUpdateFileDownload(result.Data)
-
-	case TypeUpdateFileRemovedFromDownloads:
-		return UnmarshalUpdateFileRemovedFromDownloads(result.Data)
-
-	case TypeUpdateCall:
-		return UnmarshalUpdateCall(result.Data)
-
-	case TypeUpdateGroupCall:
-		return UnmarshalUpdateGroupCall(result.Data)
-
-	case TypeUpdateGroupCallParticipant:
-		return UnmarshalUpdateGroupCallParticipant(result.Data)
-
-	case TypeUpdateNewCallSignalingData:
-		return UnmarshalUpdateNewCallSignalingData(result.Data)
-
-	case TypeUpdateUserPrivacySettingRules:
-		return UnmarshalUpdateUserPrivacySettingRules(result.Data)
-
-	case TypeUpdateUnreadMessageCount:
-		return UnmarshalUpdateUnreadMessageCount(result.Data)
-
-	case TypeUpdateUnreadChatCount:
-		return UnmarshalUpdateUnreadChatCount(result.Data)
-
-	case TypeUpdateOption:
-		return UnmarshalUpdateOption(result.Data)
-
-	case TypeUpdateStickerSet:
-		return UnmarshalUpdateStickerSet(result.Data)
-
-	case TypeUpdateInstalledStickerSets:
-		return UnmarshalUpdateInstalledStickerSets(result.Data)
-
-	case TypeUpdateTrendingStickerSets:
-		return UnmarshalUpdateTrendingStickerSets(result.Data)
-
-	case TypeUpdateRecentStickers:
-		return UnmarshalUpdateRecentStickers(result.Data)
-
-	case TypeUpdateFavoriteStickers:
-		return UnmarshalUpdateFavoriteStickers(result.Data)
-
-	case TypeUpdateSavedAnimations:
-		return UnmarshalUpdateSavedAnimations(result.Data)
-
-	case TypeUpdateSavedNotificationSounds:
-		return UnmarshalUpdateSavedNotificationSounds(result.Data)
-
-	case TypeUpdateSelectedBackground:
-		return UnmarshalUpdateSelectedBackground(result.Data)
-
-	case TypeUpdateChatThemes:
-		return UnmarshalUpdateChatThemes(result.Data)
-
-	case TypeUpdateLanguagePackStrings:
-		return UnmarshalUpdateLanguagePackStrings(result.Data)
-
-	case TypeUpdateConnectionState:
-		return UnmarshalUpdateConnectionState(result.Data)
-
-	case TypeUpdateTermsOfService:
-		return UnmarshalUpdateTermsOfService(result.Data)
-
-	case TypeUpdateUsersNearby:
-		return UnmarshalUpdateUsersNearby(result.Data)
-
-	case TypeUpdateAttachmentMenuBots:
-		return UnmarshalUpdateAttachmentMenuBots(result.Data)
-
-	case TypeUpdateWebAppMessageSent:
-		return UnmarshalUpdateWebAppMessageSent(result.Data)
-
-	case TypeUpdateActiveEmojiReactions:
-		return UnmarshalUpdateActiveEmojiReactions(result.Data)
-
-	case TypeUpdateDefaultReactionType:
-		return UnmarshalUpdateDefaultReactionType(result.Data)
-
-	case TypeUpdateDiceEmojis:
-		return UnmarshalUpdateDiceEmojis(result.Data)
-
-	case TypeUpdateAnimatedEmojiMessageClicked:
-		return UnmarshalUpdateAnimatedEmojiMessageClicked(result.Data)
-
-	case TypeUpdateAnimationSearchParameters:
-		return UnmarshalUpdateAnimationSearchParameters(result.Data)
-
-	case TypeUpdateSuggestedActions:
-		return UnmarshalUpdateSuggestedActions(result.Data)
-
-	case TypeUpdateAddChatMembersPrivacyForbidden:
-		return UnmarshalUpdateAddChatMembersPrivacyForbidden(result.Data)
-
-	case TypeUpdateAutosaveSettings:
-		return UnmarshalUpdateAutosaveSettings(result.Data)
-
-	case TypeUpdateNewInlineQuery:
-		return UnmarshalUpdateNewInlineQuery(result.Data)
-
-	case TypeUpdateNewChosenInlineResult:
-		return UnmarshalUpdateNewChosenInlineResult(result.Data)
-
-	case TypeUpdateNewCallbackQuery:
-		return UnmarshalUpdateNewCallbackQuery(result.Data)
-
-	case TypeUpdateNewInlineCallbackQuery:
-		return UnmarshalUpdateNewInlineCallbackQuery(result.Data)
-
-	case TypeUpdateNewShippingQuery:
-		return UnmarshalUpdateNewShippingQuery(result.Data)
-
-	case TypeUpdateNewPreCheckoutQuery:
-		return UnmarshalUpdateNewPreCheckoutQuery(result.Data)
-
-	case TypeUpdateNewCustomEvent:
-		return UnmarshalUpdateNewCustomEvent(result.Data)
-
-	case TypeUpdateNewCustomQuery:
-		return UnmarshalUpdateNewCustomQuery(result.Data)
-
-	case TypeUpdatePoll:
-		return UnmarshalUpdatePoll(result.Data)
-
-	case TypeUpdatePollAnswer:
-		return UnmarshalUpdatePollAnswer(result.Data)
-
-	case TypeUpdateChatMember:
-		return UnmarshalUpdateChatMember(result.Data)
-
-	case TypeUpdateNewChatJoinRequest:
-		return UnmarshalUpdateNewChatJoinRequest(result.Data)
-
-	default:
-		return nil, errors.New("invalid type")
-	}
+	return UnmarshalUpdate(result.Data)
 }
 
 type TestReturnErrorRequest struct {
@@ -18062,15 +26144,18 @@ type TestReturnErrorRequest struct {
 	Error *Error `json:"error"`
 }
 
+func (req *TestReturnErrorRequest) encode(encoder *encoder) {
+	encoder.name("error")
+	encodeError(encoder, req.Error)
+}
+
 // Returns the specified error and ensures that the Error object is used; for testing only. Can be called synchronously
 func TestReturnError(req *TestReturnErrorRequest) (*Error, error) {
 	result, err := Execute(Request{
 		meta: meta{
 			Type: "testReturnError",
 		},
-		Data: map[string]interface{}{
-			"error": req.Error,
-		},
+		Data: req,
 	})
 	if err != nil {
 		return nil, err
@@ -18086,5 +26171,19 @@ func TestReturnError(req *TestReturnErrorRequest) (*Error, error) {
 // deprecated
 // Returns the specified error and ensures that the Error object is used; for testing only. Can be called synchronously
 func (client *Client) TestReturnError(req *TestReturnErrorRequest) (*Error, error) {
-	return TestReturnError(req)
+	result, err := client.Execute(Request{
+		meta: meta{
+			Type: "testReturnError",
+		},
+		Data: req,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Type == "error" {
+		return nil, buildResponseError(result.Data)
+	}
+
+	return UnmarshalError(result.Data)
 }
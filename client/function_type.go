@@ -0,0 +1,109 @@
+package client
+
+import (
+	"errors"
+	"sync/atomic"
+)
+
+var (
+	// Returned by the methods available only to users when the client is authorized as a bot
+	ErrUsersOnly = errors.New("method is available only to users")
+	// Returned by the methods available only to bots when the client is authorized as a user
+	ErrBotsOnly = errors.New("method is available only to bots")
+)
+
+// FunctionTypeError is returned without sending the request when the method isn't available to the authorized account
+type FunctionTypeError struct {
+	// TDLib method, for example getChatStatistics
+	Method string
+	// ErrUsersOnly or ErrBotsOnly
+	Err error
+}
+
+func (functionTypeError FunctionTypeError) Error() string {
+	return functionTypeError.Method + ": " + functionTypeError.Err.Error()
+}
+
+func (functionTypeError FunctionTypeError) Unwrap() error {
+	return functionTypeError.Err
+}
+
+const (
+	sessionTypeUnknown int32 = iota
+	sessionTypeUser
+	sessionTypeBot
+)
+
+// checkUsersOnly fails if the client is authorized as a bot
+func (client *Client) checkUsersOnly(method string) error {
+	if atomic.LoadInt32(&client.sessionType) == sessionTypeBot {
+		return FunctionTypeError{
+			Method: method,
+			Err:    ErrUsersOnly,
+		}
+	}
+
+	return nil
+}
+
+// checkBotsOnly fails if the client is authorized as a user
+func (client *Client) checkBotsOnly(method string) error {
+	if atomic.LoadInt32(&client.sessionType) == sessionTypeUser {
+		return FunctionTypeError{
+			Method: method,
+			Err:    ErrBotsOnly,
+		}
+	}
+
+	return nil
+}
+
+// watchSessionType takes the type of the account from updateUser of my_id option, TDLib sends both after the authorization,
+// so no request is needed. The type is reset when the client leaves authorizationStateReady; while it is unknown,
+// TDLib checks the methods itself
+func (client *Client) watchSessionType(response *Response) {
+	switch response.Type {
+	case TypeUpdateAuthorizationState:
+		update, err := UnmarshalUpdateAuthorizationState(response.Data)
+		if err != nil || update.AuthorizationState == nil {
+			return
+		}
+
+		isReady := update.AuthorizationState.AuthorizationStateType() == TypeAuthorizationStateReady
+		if client.isReady && !isReady {
+			client.myId = 0
+			atomic.StoreInt32(&client.sessionType, sessionTypeUnknown)
+		}
+		client.isReady = isReady
+
+	case TypeUpdateOption:
+		update, err := UnmarshalUpdateOption(response.Data)
+		if err != nil || update.Name != "my_id" {
+			return
+		}
+
+		client.myId = 0
+
+		value, ok := update.Value.(*OptionValueInteger)
+		if ok {
+			client.myId = int64(value.Value)
+		}
+
+	case TypeUpdateUser:
+		if client.myId == 0 || atomic.LoadInt32(&client.sessionType) != sessionTypeUnknown {
+			return
+		}
+
+		update, err := UnmarshalUpdateUser(response.Data)
+		if err != nil || update.User == nil || update.User.Id != client.myId || update.User.Type == nil {
+			return
+		}
+
+		sessionType := sessionTypeUser
+		if update.User.Type.UserTypeType() == TypeUserTypeBot {
+			sessionType = sessionTypeBot
+		}
+
+		atomic.StoreInt32(&client.sessionType, sessionType)
+	}
+}
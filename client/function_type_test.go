@@ -0,0 +1,97 @@
+package client_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/megaplan/go-tdlib/client"
+	"github.com/megaplan/go-tdlib/client/tdtest"
+)
+
+// sendAccount sends the updates TDLib sends about the authorized account and waits until the client receives them
+func sendAccount(t *testing.T, server *tdtest.Server, tdlibClient *client.Client, updates ...client.Type) {
+	clientId := server.ClientIds()[0]
+
+	for _, update := range updates {
+		err := server.SendUpdate(clientId, update)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the response is received after the updates
+	_, err := tdlibClient.GetAuthorizationState()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFunctionTypeOfBot(t *testing.T) {
+	server := tdtest.NewServer()
+	server.Handle("getPasswordState", tdtest.Result(&client.PasswordState{}))
+
+	tdlibClient := newTestClient(t, server)
+
+	sendAccount(t, server, tdlibClient,
+		&client.UpdateOption{Name: "my_id", Value: &client.OptionValueInteger{Value: 1}},
+		// the bot type of another user doesn't matter
+		&client.UpdateUser{User: &client.User{Id: 2, Type: &client.UserTypeBot{}}},
+		&client.UpdateUser{User: &client.User{Id: 1, Type: &client.UserTypeBot{}}},
+	)
+
+	for i := 0; i < 2; i++ {
+		_, err := tdlibClient.GetPasswordState()
+		if !errors.Is(err, client.ErrUsersOnly) {
+			t.Fatalf("unexpected error %v", err)
+		}
+	}
+
+	if len(server.Requests("getPasswordState")) != 0 {
+		t.Fatal("the method is sent")
+	}
+
+	if len(server.Requests("getMe")) != 0 {
+		t.Fatalf("getMe is sent %d times", len(server.Requests("getMe")))
+	}
+
+	// the type is reset when the client leaves the ready state
+	sendAccount(t, server, tdlibClient, &client.UpdateAuthorizationState{AuthorizationState: &client.AuthorizationStateLoggingOut{}})
+
+	_, err := tdlibClient.GetPasswordState()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFunctionTypeOfUser(t *testing.T) {
+	server := tdtest.NewServer()
+	tdlibClient := newTestClient(t, server)
+
+	sendAccount(t, server, tdlibClient,
+		&client.UpdateUser{User: &client.User{Id: 2, Type: &client.UserTypeRegular{}}},
+		&client.UpdateOption{Name: "my_id", Value: &client.OptionValueInteger{Value: 2}},
+		&client.UpdateUser{User: &client.User{Id: 2, Type: &client.UserTypeRegular{}}},
+	)
+
+	_, err := tdlibClient.SetBotUpdatesStatus(&client.SetBotUpdatesStatusRequest{})
+	if !errors.Is(err, client.ErrBotsOnly) {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+func TestFunctionTypeUnknown(t *testing.T) {
+	server := tdtest.NewServer()
+	server.Handle("getPasswordState", tdtest.Result(&client.PasswordState{}))
+
+	// the account isn't known, so TDLib checks the method itself
+	tdlibClient := newTestClient(t, server)
+
+	_, err := tdlibClient.GetPasswordState()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(server.Requests("getMe")) != 0 {
+		t.Fatalf("getMe is sent %d times", len(server.Requests("getMe")))
+	}
+}
@@ -0,0 +1,32 @@
+package client
+
+import (
+	"context"
+)
+
+// Handler sends the request and returns the response
+type Handler func(ctx context.Context, req Request) (*Response, error)
+
+// Interceptor is called for every request sent or executed by the client.
+// It may change the request before passing it to next handler, change the response or return without calling next handler
+type Interceptor func(ctx context.Context, req Request, next Handler) (*Response, error)
+
+// WithInterceptor adds interceptors to the chain. The first added interceptor is called first
+func WithInterceptor(interceptors ...Interceptor) Option {
+	return func(client *Client) {
+		client.interceptors = append(client.interceptors, interceptors...)
+	}
+}
+
+func chainInterceptors(interceptors []Interceptor, handler Handler) Handler {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor := interceptors[i]
+		next := handler
+
+		handler = func(ctx context.Context, req Request) (*Response, error) {
+			return interceptor(ctx, req, next)
+		}
+	}
+
+	return handler
+}
@@ -0,0 +1,172 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/megaplan/go-tdlib/client"
+	"github.com/megaplan/go-tdlib/client/tdtest"
+)
+
+var errBlocked = errors.New("the request is blocked")
+
+// callRecorder records the calls of the interceptors
+type callRecorder struct {
+	mu    sync.Mutex
+	calls []string
+}
+
+func (recorder *callRecorder) interceptor(name string) client.Interceptor {
+	return func(ctx context.Context, req client.Request, next client.Handler) (*client.Response, error) {
+		if req.Type == "getAuthorizationState" {
+			return next(ctx, req)
+		}
+
+		recorder.add(name + " " + req.Type)
+
+		response, err := next(ctx, req)
+		if err == nil {
+			recorder.add(name + " " + response.Type)
+		}
+
+		return response, err
+	}
+}
+
+func (recorder *callRecorder) add(call string) {
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+
+	recorder.calls = append(recorder.calls, call)
+}
+
+func (recorder *callRecorder) take() []string {
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+
+	calls := recorder.calls
+	recorder.calls = nil
+
+	return calls
+}
+
+func equalCalls(calls []string, expected ...string) bool {
+	if len(calls) != len(expected) {
+		return false
+	}
+
+	for i := range calls {
+		if calls[i] != expected[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func newInterceptedClient(t *testing.T, server *tdtest.Server, interceptors ...client.Interceptor) *client.Client {
+	tdlibClient, err := client.NewClient(
+		client.SessionAuthorizer(&client.TdlibParameters{}),
+		client.WithTransport(server),
+		client.WithInterceptor(interceptors...),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(server.Close)
+	t.Cleanup(tdlibClient.Shutdown)
+
+	return tdlibClient
+}
+
+func TestInterceptorChain(t *testing.T) {
+	server := tdtest.NewServer()
+	server.Handle("getChat", func(req *tdtest.Request) client.Type {
+		var getChatRequest client.GetChatRequest
+
+		err := req.Decode(&getChatRequest)
+		if err != nil {
+			return &client.Error{Code: 400, Message: err.Error()}
+		}
+
+		return &client.Chat{Id: getChatRequest.ChatId}
+	})
+
+	recorder := &callRecorder{}
+
+	// the interceptor may change the request
+	rewrite := func(ctx context.Context, req client.Request, next client.Handler) (*client.Response, error) {
+		getChatRequest, ok := req.Data.(*client.GetChatRequest)
+		if ok {
+			req.Data = &client.GetChatRequest{ChatId: getChatRequest.ChatId + 1}
+		}
+
+		return next(ctx, req)
+	}
+
+	tdlibClient := newInterceptedClient(t, server, recorder.interceptor("first"), rewrite, recorder.interceptor("second"))
+	recorder.take()
+
+	chat, err := tdlibClient.GetChat(&client.GetChatRequest{ChatId: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if chat.Id != 2 {
+		t.Fatalf("the request isn't changed by the interceptor, chat %d", chat.Id)
+	}
+
+	calls := recorder.take()
+	if !equalCalls(calls, "first getChat", "second getChat", "second chat", "first chat") {
+		t.Fatalf("unexpected calls %v", calls)
+	}
+}
+
+func TestInterceptorShortCircuit(t *testing.T) {
+	server := tdtest.NewServer()
+	server.Handle("getChat", tdtest.Result(&client.Chat{Id: 1}))
+
+	block := func(ctx context.Context, req client.Request, next client.Handler) (*client.Response, error) {
+		if req.Type == "getChat" {
+			return nil, errBlocked
+		}
+
+		return next(ctx, req)
+	}
+
+	tdlibClient := newInterceptedClient(t, server, block)
+
+	_, err := tdlibClient.GetChat(&client.GetChatRequest{ChatId: 1})
+	if err != errBlocked {
+		t.Fatalf("expected the error of the interceptor, got %v", err)
+	}
+
+	if len(server.Requests("getChat")) != 0 {
+		t.Fatal("the blocked request is sent")
+	}
+}
+
+func TestInterceptorExecute(t *testing.T) {
+	server := tdtest.NewServer()
+	server.Handle("getFileMimeType", tdtest.Result(&client.Text{Text: "image/png"}))
+
+	recorder := &callRecorder{}
+	tdlibClient := newInterceptedClient(t, server, recorder.interceptor("first"))
+	recorder.take()
+
+	text, err := tdlibClient.GetFileMimeType(&client.GetFileMimeTypeRequest{FileName: "image.png"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if text.Text != "image/png" {
+		t.Fatalf("unexpected text %q", text.Text)
+	}
+
+	calls := recorder.take()
+	if !equalCalls(calls, "first getFileMimeType", "first text") {
+		t.Fatalf("unexpected calls %v", calls)
+	}
+}
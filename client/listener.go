@@ -1,9 +1,14 @@
 package client
 
 import (
+	"context"
+	"errors"
 	"sync"
+	"sync/atomic"
 )
 
+var ErrListenerOverflow = errors.New("listener buffer overflow")
+
 func newListenerStore() *listenerStore {
 	return &listenerStore{
 		listeners: []*Listener{},
@@ -12,16 +17,37 @@ func newListenerStore() *listenerStore {
 
 type listenerStore struct {
 	sync.Mutex
+	closed    bool
 	listeners []*Listener
 }
 
+// Add registers the listener. The listener is closed at once if the store is already closed
 func (store *listenerStore) Add(listener *Listener) {
 	store.Lock()
 	defer store.Unlock()
 
+	if store.closed {
+		listener.Close()
+		return
+	}
+
 	store.listeners = append(store.listeners, listener)
 }
 
+// Close closes all listeners, so range loops over their updates are finished
+func (store *listenerStore) Close() {
+	store.Lock()
+	defer store.Unlock()
+
+	store.closed = true
+
+	for _, listener := range store.listeners {
+		listener.Close()
+	}
+
+	store.listeners = []*Listener{}
+}
+
 func (store *listenerStore) Listeners() []*Listener {
 	store.Lock()
 	defer store.Unlock()
@@ -44,17 +70,134 @@ func (store *listenerStore) gc() {
 	}
 }
 
+// ListenerFilter reports whether the listener receives updates of the type
+type ListenerFilter func(typ string) bool
+
+// TypeFilter accepts updates of the types only, for example TypeUpdateNewMessage
+func TypeFilter(types ...string) ListenerFilter {
+	accepted := map[string]bool{}
+	for _, typ := range types {
+		accepted[typ] = true
+	}
+
+	return func(typ string) bool {
+		return accepted[typ]
+	}
+}
+
+// OverflowPolicy defines what the client does with an update when the listener buffer is full
+type OverflowPolicy int
+
+const (
+	// The client waits until the listener receives the update. A slow listener delays all updates and responses of the client
+	OverflowBlock OverflowPolicy = iota
+	// The oldest update in the buffer is dropped
+	OverflowDropOldest
+	// The new update is dropped
+	OverflowDropNewest
+	// The listener is closed with ErrListenerOverflow
+	OverflowDisconnect
+)
+
+type ListenerOption func(*Listener)
+
+// WithFilter makes the listener receive only the updates accepted by the filter. All filters must accept the update
+func WithFilter(filter ListenerFilter) ListenerOption {
+	return func(listener *Listener) {
+		listener.filters = append(listener.filters, filter)
+	}
+}
+
+// WithBufferSize sets the size of the Updates channel, 1000 by default.
+// A negative size is taken as 0. The size is at least 1 unless the overflow policy is OverflowBlock, so an update can be kept
+func WithBufferSize(size int) ListenerOption {
+	return func(listener *Listener) {
+		listener.bufferSize = size
+	}
+}
+
+// WithOverflowPolicy sets the policy used when the Updates channel is full, OverflowBlock by default
+func WithOverflowPolicy(policy OverflowPolicy) ListenerOption {
+	return func(listener *Listener) {
+		listener.overflowPolicy = policy
+	}
+}
+
 type Listener struct {
 	mu       sync.Mutex
 	isActive bool
-	Updates  chan Type
+	err      error
+	// closed before Updates, so a blocked send is interrupted
+	done chan struct{}
+	// done of the client, so a stalled listener doesn't keep the client receiver after the shutdown
+	clientDone <-chan struct{}
+	// held while the update is sent, so Updates isn't closed during the send
+	sendMu         sync.Mutex
+	filters        []ListenerFilter
+	bufferSize     int
+	overflowPolicy OverflowPolicy
+	dropped        uint64
+	Updates        chan Type
+}
+
+func newListener(options ...ListenerOption) *Listener {
+	listener := &Listener{
+		isActive:       true,
+		done:           make(chan struct{}),
+		bufferSize:     1000,
+		overflowPolicy: OverflowBlock,
+	}
+
+	for _, option := range options {
+		option(listener)
+	}
+
+	if listener.bufferSize < 0 {
+		listener.bufferSize = 0
+	}
+
+	// an unbuffered channel can't keep an update, so a dropping policy would drop every update the listener doesn't wait for
+	if listener.bufferSize < 1 && listener.overflowPolicy != OverflowBlock {
+		listener.bufferSize = 1
+	}
+
+	listener.Updates = make(chan Type, listener.bufferSize)
+
+	return listener
+}
+
+// closeOnDone closes the listener when ctx is done
+func (listener *Listener) closeOnDone(ctx context.Context) {
+	go func() {
+		select {
+		case <-ctx.Done():
+			listener.Close()
+
+		case <-listener.done:
+		}
+	}()
 }
 
+// Close unregisters the listener and closes Updates. May be called multiple times from any goroutine
 func (listener *Listener) Close() {
+	listener.close(nil)
+}
+
+func (listener *Listener) close(err error) {
 	listener.mu.Lock()
-	defer listener.mu.Unlock()
+	if !listener.isActive {
+		listener.mu.Unlock()
+		return
+	}
 
 	listener.isActive = false
+	listener.err = err
+	close(listener.done)
+	listener.mu.Unlock()
+
+	listener.sendMu.Lock()
+	defer listener.sendMu.Unlock()
+
 	close(listener.Updates)
 }
 
@@ -64,3 +207,88 @@ func (listener *Listener) IsActive() bool {
 
 	return listener.isActive
 }
+
+// Err returns the reason the listener was closed by the client, for example ErrListenerOverflow
+func (listener *Listener) Err() error {
+	listener.mu.Lock()
+	defer listener.mu.Unlock()
+
+	return listener.err
+}
+
+// Dropped returns the number of updates dropped because of the buffer overflow
+func (listener *Listener) Dropped() uint64 {
+	return atomic.LoadUint64(&listener.dropped)
+}
+
+// Accepts reports whether the listener receives updates of the type
+func (listener *Listener) Accepts(typ string) bool {
+	for _, filter := range listener.filters {
+		if !filter(typ) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// deliver passes the update to the Updates channel according to the overflow policy
+func (listener *Listener) deliver(update Type) {
+	if !listener.send(update) {
+		atomic.AddUint64(&listener.dropped, 1)
+
+		if listener.overflowPolicy == OverflowDisconnect {
+			listener.close(ErrListenerOverflow)
+		}
+	}
+}
+
+// send returns false if the update is dropped because of the overflow
+func (listener *Listener) send(update Type) bool {
+	listener.sendMu.Lock()
+	defer listener.sendMu.Unlock()
+
+	select {
+	case <-listener.done:
+		return true
+	default:
+	}
+
+	switch listener.overflowPolicy {
+	case OverflowDropOldest:
+		for {
+			select {
+			case listener.Updates <- update:
+				return true
+			case <-listener.done:
+				return true
+			default:
+			}
+
+			select {
+			case <-listener.Updates:
+				atomic.AddUint64(&listener.dropped, 1)
+			default:
+			}
+		}
+
+	case OverflowDropNewest, OverflowDisconnect:
+		select {
+		case listener.Updates <- update:
+			return true
+		case <-listener.done:
+			return true
+		default:
+			return false
+		}
+
+	default:
+		select {
+		case listener.Updates <- update:
+		case <-listener.done:
+		case <-listener.clientDone:
+		}
+
+		return true
+	}
+}
@@ -0,0 +1,280 @@
+package client_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/megaplan/go-tdlib/client"
+	"github.com/megaplan/go-tdlib/client/tdtest"
+)
+
+func newTestClient(t *testing.T, server *tdtest.Server) *client.Client {
+	tdlibClient, err := client.NewClient(client.SessionAuthorizer(&client.TdlibParameters{}), client.WithTransport(server))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(server.Close)
+	t.Cleanup(tdlibClient.Shutdown)
+
+	return tdlibClient
+}
+
+// sendUpdates sends chat updates with the identifiers and waits until the client receives them
+func sendUpdates(t *testing.T, server *tdtest.Server, tdlibClient *client.Client, chatIds ...int64) {
+	clientIds := server.ClientIds()
+
+	for _, chatId := range chatIds {
+		err := server.SendUpdate(clientIds[len(clientIds)-1], &client.UpdateNewChat{Chat: &client.Chat{Id: chatId}})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the response is received after the updates
+	_, err := tdlibClient.GetAuthorizationState()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func receivedChatIds(listener *client.Listener) []int64 {
+	chatIds := []int64{}
+	for len(listener.Updates) > 0 {
+		chatIds = append(chatIds, (<-listener.Updates).(*client.UpdateNewChat).Chat.Id)
+	}
+
+	return chatIds
+}
+
+func equalChatIds(chatIds []int64, expected ...int64) bool {
+	if len(chatIds) != len(expected) {
+		return false
+	}
+
+	for i := range chatIds {
+		if chatIds[i] != expected[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func TestListenerFilter(t *testing.T) {
+	server := tdtest.NewServer()
+	tdlibClient := newTestClient(t, server)
+
+	listener := tdlibClient.GetListener(client.WithFilter(client.TypeFilter(client.TypeUpdateNewChat)))
+	defer listener.Close()
+
+	err := server.SendUpdate(server.ClientIds()[0], &client.UpdateChatTitle{ChatId: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sendUpdates(t, server, tdlibClient, 2)
+
+	chatIds := receivedChatIds(listener)
+	if !equalChatIds(chatIds, 2) {
+		t.Fatalf("unexpected updates %v", chatIds)
+	}
+}
+
+func TestListenerOwnObject(t *testing.T) {
+	server := tdtest.NewServer()
+	tdlibClient := newTestClient(t, server)
+
+	first := tdlibClient.GetListener(client.WithFilter(client.TypeFilter(client.TypeUpdateNewChat)))
+	defer first.Close()
+
+	second := tdlibClient.GetListener(client.WithFilter(client.TypeFilter(client.TypeUpdateNewChat)))
+	defer second.Close()
+
+	sendUpdates(t, server, tdlibClient, 1)
+
+	// a listener may change the object without a race with the others
+	firstUpdate := (<-first.Updates).(*client.UpdateNewChat)
+	secondUpdate := (<-second.Updates).(*client.UpdateNewChat)
+
+	if firstUpdate == secondUpdate || firstUpdate.Chat == secondUpdate.Chat {
+		t.Fatal("listeners share the object")
+	}
+
+	if firstUpdate.Chat.Id != 1 || secondUpdate.Chat.Id != 1 {
+		t.Fatalf("unexpected updates %#v %#v", firstUpdate.Chat, secondUpdate.Chat)
+	}
+}
+
+func TestListenerOverflow(t *testing.T) {
+	tests := []struct {
+		policy  client.OverflowPolicy
+		chatIds []int64
+		dropped uint64
+		err     error
+	}{
+		{client.OverflowDropNewest, []int64{1, 2}, 3, nil},
+		{client.OverflowDropOldest, []int64{4, 5}, 3, nil},
+		{client.OverflowDisconnect, []int64{1, 2}, 1, client.ErrListenerOverflow},
+	}
+
+	for _, test := range tests {
+		server := tdtest.NewServer()
+		tdlibClient := newTestClient(t, server)
+
+		listener := tdlibClient.GetListener(
+			client.WithFilter(client.TypeFilter(client.TypeUpdateNewChat)),
+			client.WithBufferSize(2),
+			client.WithOverflowPolicy(test.policy),
+		)
+
+		sendUpdates(t, server, tdlibClient, 1, 2, 3, 4, 5)
+
+		if listener.Dropped() != test.dropped {
+			t.Errorf("policy %d: expected %d dropped updates, got %d", test.policy, test.dropped, listener.Dropped())
+		}
+
+		if listener.Err() != test.err {
+			t.Errorf("policy %d: expected error %v, got %v", test.policy, test.err, listener.Err())
+		}
+
+		chatIds := receivedChatIds(listener)
+		if !equalChatIds(chatIds, test.chatIds...) {
+			t.Errorf("policy %d: unexpected updates %v", test.policy, chatIds)
+		}
+
+		listener.Close()
+	}
+}
+
+func TestListenerBufferSize(t *testing.T) {
+	tests := []struct {
+		size    int
+		policy  client.OverflowPolicy
+		chatIds []int64
+		dropped uint64
+	}{
+		{0, client.OverflowDropOldest, []int64{3}, 2},
+		{-1, client.OverflowDropOldest, []int64{3}, 2},
+		{-1, client.OverflowDropNewest, []int64{1}, 2},
+	}
+
+	for _, test := range tests {
+		server := tdtest.NewServer()
+		tdlibClient := newTestClient(t, server)
+
+		listener := tdlibClient.GetListener(
+			client.WithFilter(client.TypeFilter(client.TypeUpdateNewChat)),
+			client.WithBufferSize(test.size),
+			client.WithOverflowPolicy(test.policy),
+		)
+
+		if cap(listener.Updates) != 1 {
+			t.Errorf("size %d: expected the buffer of 1 update, got %d", test.size, cap(listener.Updates))
+		}
+
+		sendUpdates(t, server, tdlibClient, 1, 2, 3)
+
+		if listener.Dropped() != test.dropped {
+			t.Errorf("size %d: expected %d dropped updates, got %d", test.size, test.dropped, listener.Dropped())
+		}
+
+		chatIds := receivedChatIds(listener)
+		if !equalChatIds(chatIds, test.chatIds...) {
+			t.Errorf("size %d: unexpected updates %v", test.size, chatIds)
+		}
+
+		listener.Close()
+	}
+
+	// a negative size of the blocking listener is taken as 0 instead of a panic
+	server := tdtest.NewServer()
+	tdlibClient := newTestClient(t, server)
+
+	listener := tdlibClient.GetListener(client.WithBufferSize(-1))
+	defer listener.Close()
+
+	if cap(listener.Updates) != 0 {
+		t.Fatalf("expected the unbuffered channel, got %d", cap(listener.Updates))
+	}
+}
+
+func TestListenerCloseDuringSend(t *testing.T) {
+	server := tdtest.NewServer()
+	tdlibClient := newTestClient(t, server)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		listener := tdlibClient.GetListener(client.WithBufferSize(1))
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			<-listener.Updates
+			listener.Close()
+			listener.Close()
+
+			// the channel is closed, the rest of the buffer is drained
+			for range listener.Updates {
+			}
+		}()
+	}
+
+	// blocking listeners are closed while the client waits to send to them
+	sendUpdates(t, server, tdlibClient, 1, 2, 3)
+
+	wg.Wait()
+}
+
+func TestListenerShutdown(t *testing.T) {
+	server := tdtest.NewServer()
+	tdlibClient := newTestClient(t, server)
+
+	stalled := tdlibClient.GetListener(client.WithBufferSize(1))
+	listener := tdlibClient.GetListener(client.WithFilter(client.TypeFilter(client.TypeUpdateAuthorizationState)))
+
+	// the second update blocks the client receiver, nobody reads the stalled listener
+	for _, chatId := range []int64{1, 2} {
+		err := server.SendUpdate(server.ClientIds()[0], &client.UpdateNewChat{Chat: &client.Chat{Id: chatId}})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for len(stalled.Updates) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	tdlibClient.Shutdown()
+
+	done := make(chan struct{})
+	go func() {
+		for range listener.Updates {
+		}
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("listeners aren't closed on shutdown")
+	}
+
+	if stalled.IsActive() {
+		t.Fatal("the stalled listener isn't closed")
+	}
+
+	// a listener of the client which is shut down is closed at once
+	late := tdlibClient.GetListener()
+	if _, ok := <-late.Updates; ok || late.IsActive() {
+		t.Fatal("the listener isn't closed")
+	}
+
+	_, err := tdlibClient.GetMe()
+	if !errors.Is(err, client.ErrClientClosed) {
+		t.Fatalf("expected ErrClientClosed, got %v", err)
+	}
+}
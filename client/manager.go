@@ -0,0 +1,397 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+var (
+	ErrAccountExists   = errors.New("account already exists")
+	ErrAccountNotFound = errors.New("account not found")
+)
+
+// AuthorizerFactory returns a new authorization state handler of the account for every start of its client.
+// Database and files directories of the parameters are set to the directories of the account
+type AuthorizerFactory func(parameters *TdlibParameters) AuthorizationStateHandler
+
+type ManagerConfig struct {
+	// Directory containing the directories of all accounts
+	Root string
+	// TDLib parameters of all accounts
+	Parameters TdlibParameters
+	// Options of all clients
+	Options []Option
+	// Delay before the restart of the client closed by TDLib, 5 seconds by default
+	RestartDelay time.Duration
+}
+
+// AccountHealth is the state of the account client
+type AccountHealth struct {
+	Key string
+	// Type of the last authorization state, for example TypeAuthorizationStateReady
+	State string
+	// Number of restarts of the client after authorizationStateClosed
+	Restarts int
+	// The authorization error of the current client; reset when the client is ready
+	Err error
+	// The client is closed and isn't restarted anymore because of the authorization error
+	Stopped   bool
+	UpdatedAt time.Time
+}
+
+// Ready reports whether the client can send requests
+func (health AccountHealth) Ready() bool {
+	return health.State == TypeAuthorizationStateReady
+}
+
+// Manager runs clients of multiple accounts in one process. Every account has its own database directory.
+// The client is restarted when TDLib closes it unless the authorization failed with a non-retryable error, for example ErrNeedsLogin
+type Manager struct {
+	mu       sync.Mutex
+	config   ManagerConfig
+	accounts map[string]*account
+}
+
+func NewManager(config ManagerConfig) *Manager {
+	if config.RestartDelay == 0 {
+		config.RestartDelay = 5 * time.Second
+	}
+
+	return &Manager{
+		config:   config,
+		accounts: map[string]*account{},
+	}
+}
+
+// Add starts the client of the account. The client is authorized in background, see Health
+func (manager *Manager) Add(key string, authorizerFactory AuthorizerFactory, options ...Option) (*Client, error) {
+	manager.mu.Lock()
+
+	_, ok := manager.accounts[key]
+	if ok {
+		manager.mu.Unlock()
+		return nil, ErrAccountExists
+	}
+
+	account := &account{
+		key:               key,
+		authorizerFactory: authorizerFactory,
+		options:           append(append([]Option{}, manager.config.Options...), options...),
+		done:              make(chan struct{}),
+	}
+	account.health.Key = key
+	account.beginStart()
+
+	manager.accounts[key] = account
+	manager.mu.Unlock()
+
+	return manager.start(account), nil
+}
+
+// Get returns the current client of the account
+func (manager *Manager) Get(key string) (*Client, bool) {
+	account, ok := manager.account(key)
+	if !ok {
+		return nil, false
+	}
+
+	return account.getClient(), true
+}
+
+// Keys returns sorted keys of all accounts
+func (manager *Manager) Keys() []string {
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+
+	keys := make([]string, 0, len(manager.accounts))
+	for key := range manager.accounts {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// Health returns the state of the account client
+func (manager *Manager) Health(key string) (AccountHealth, bool) {
+	account, ok := manager.account(key)
+	if !ok {
+		return AccountHealth{}, false
+	}
+
+	return account.getHealth(), true
+}
+
+// HealthAll returns the states of all account clients sorted by key
+func (manager *Manager) HealthAll() []AccountHealth {
+	healths := []AccountHealth{}
+	for _, key := range manager.Keys() {
+		health, ok := manager.Health(key)
+		if ok {
+			healths = append(healths, health)
+		}
+	}
+
+	return healths
+}
+
+// Remove closes the client of the account and forgets the account. The database directory is kept
+func (manager *Manager) Remove(ctx context.Context, key string) error {
+	manager.mu.Lock()
+	account, ok := manager.accounts[key]
+	delete(manager.accounts, key)
+
+	if ok {
+		// closed under the lock, so the account isn't restarted after the removal
+		close(account.done)
+	}
+	manager.mu.Unlock()
+
+	if !ok {
+		return ErrAccountNotFound
+	}
+
+	err := account.getClient().CloseAndWait(ctx)
+	if errors.Is(err, ErrClientClosed) {
+		// the client is waiting for the restart
+		return nil
+	}
+
+	return err
+}
+
+// Close removes all accounts
+func (manager *Manager) Close(ctx context.Context) error {
+	var errs []error
+
+	for _, key := range manager.Keys() {
+		err := manager.Remove(ctx, key)
+		if err != nil && !errors.Is(err, ErrAccountNotFound) {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// DatabaseDirectory returns the database directory of the account
+func (manager *Manager) DatabaseDirectory(key string) string {
+	return filepath.Join(manager.config.Root, key, "database")
+}
+
+// FilesDirectory returns the files directory of the account
+func (manager *Manager) FilesDirectory(key string) string {
+	return filepath.Join(manager.config.Root, key, "files")
+}
+
+func (manager *Manager) account(key string) (*account, bool) {
+	manager.mu.Lock()
+	defer manager.mu.Unlock()
+
+	account, ok := manager.accounts[key]
+
+	return account, ok
+}
+
+// start starts a new client of the account. The client is built without the manager locked, because the options
+// may send requests synchronously. account.beginStart must be called under the lock before
+func (manager *Manager) start(account *account) *Client {
+	parameters := manager.config.Parameters
+	parameters.DatabaseDirectory = manager.DatabaseDirectory(account.key)
+	parameters.FilesDirectory = manager.FilesDirectory(account.key)
+
+	authorizer := &accountAuthorizer{
+		AuthorizationStateHandler: account.authorizerFactory(&parameters),
+		account:                   account,
+	}
+
+	// the listener must be added before the first request is sent
+	var listener *Listener
+	watch := func(client *Client) {
+		listener = client.GetListener(WithFilter(TypeFilter(TypeUpdateAuthorizationState)))
+	}
+
+	options := append(append([]Option{}, account.options...), watch)
+
+	client := NewClientAsync(authorizer, options...)
+	account.setClient(client)
+
+	go manager.watch(account, client, listener, authorizer)
+
+	return client
+}
+
+// restart starts a new client unless the account is removed
+func (manager *Manager) restart(account *account) {
+	manager.mu.Lock()
+
+	select {
+	case <-account.done:
+		manager.mu.Unlock()
+		return
+	default:
+	}
+
+	account.restarted()
+	account.beginStart()
+	manager.mu.Unlock()
+
+	manager.start(account)
+}
+
+// watch tracks the authorization state of the client and restarts the client when it is closed
+func (manager *Manager) watch(account *account, client *Client, listener *Listener, authorizer *accountAuthorizer) {
+	closed := false
+	for update := range listener.Updates {
+		state := update.(*UpdateAuthorizationState).AuthorizationState.AuthorizationStateType()
+		account.setState(state)
+
+		if state == TypeAuthorizationStateClosed {
+			closed = true
+			break
+		}
+	}
+
+	listener.Close()
+
+	if !closed {
+		return
+	}
+
+	client.Shutdown()
+
+	err := authorizer.handleError()
+	if err != nil && !IsRetryableAuthorizationError(err) {
+		account.stopped()
+		return
+	}
+
+	select {
+	case <-time.After(manager.config.RestartDelay):
+	case <-account.done:
+		return
+	}
+
+	manager.restart(account)
+}
+
+type account struct {
+	mu                sync.Mutex
+	key               string
+	authorizerFactory AuthorizerFactory
+	options           []Option
+	client            *Client
+	health            AccountHealth
+	// closed when the account is removed
+	done chan struct{}
+	// closed when the client being started is set
+	started chan struct{}
+}
+
+// getClient returns the current client, waiting for the client being started
+func (account *account) getClient() *Client {
+	account.mu.Lock()
+	started := account.started
+	account.mu.Unlock()
+
+	<-started
+
+	account.mu.Lock()
+	defer account.mu.Unlock()
+
+	return account.client
+}
+
+// beginStart makes getClient wait for the next client
+func (account *account) beginStart() {
+	account.mu.Lock()
+	defer account.mu.Unlock()
+
+	account.started = make(chan struct{})
+}
+
+func (account *account) setClient(client *Client) {
+	account.mu.Lock()
+	defer account.mu.Unlock()
+
+	account.client = client
+	close(account.started)
+}
+
+func (account *account) getHealth() AccountHealth {
+	account.mu.Lock()
+	defer account.mu.Unlock()
+
+	return account.health
+}
+
+func (account *account) setState(state string) {
+	account.mu.Lock()
+	defer account.mu.Unlock()
+
+	account.health.State = state
+	if state == TypeAuthorizationStateReady {
+		account.health.Err = nil
+	}
+	account.health.UpdatedAt = time.Now()
+}
+
+func (account *account) setError(err error) {
+	account.mu.Lock()
+	defer account.mu.Unlock()
+
+	account.health.Err = err
+	account.health.UpdatedAt = time.Now()
+}
+
+func (account *account) stopped() {
+	account.mu.Lock()
+	defer account.mu.Unlock()
+
+	account.health.Stopped = true
+	account.health.UpdatedAt = time.Now()
+}
+
+func (account *account) restarted() {
+	account.mu.Lock()
+	defer account.mu.Unlock()
+
+	account.health.Restarts++
+	account.health.UpdatedAt = time.Now()
+}
+
+// accountAuthorizer records authorization errors in the account health
+type accountAuthorizer struct {
+	AuthorizationStateHandler
+	account *account
+	mu      sync.Mutex
+	// the error the client is closed with, it is recorded before authorizationStateClosed is received
+	err error
+}
+
+func (authorizer *accountAuthorizer) Handle(client *Client, state AuthorizationState) error {
+	err := authorizer.AuthorizationStateHandler.Handle(client, state)
+	if err != nil {
+		authorizer.mu.Lock()
+		authorizer.err = err
+		authorizer.mu.Unlock()
+	}
+
+	return err
+}
+
+func (authorizer *accountAuthorizer) handleError() error {
+	authorizer.mu.Lock()
+	defer authorizer.mu.Unlock()
+
+	return authorizer.err
+}
+
+func (authorizer *accountAuthorizer) Error(err error) {
+	authorizer.account.setError(err)
+	authorizer.AuthorizationStateHandler.Error(err)
+}
@@ -0,0 +1,211 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/megaplan/go-tdlib/client"
+	"github.com/megaplan/go-tdlib/client/tdtest"
+)
+
+func newTestManager(t *testing.T, server *tdtest.Server, restartDelay time.Duration) *client.Manager {
+	// TDLib answers close with authorizationStateClosed
+	server.Handle("close", func(req *tdtest.Request) client.Type {
+		server.SendUpdate(req.ClientId, &client.UpdateAuthorizationState{
+			AuthorizationState: &client.AuthorizationStateClosed{},
+		})
+
+		return &client.Ok{}
+	})
+
+	manager := client.NewManager(client.ManagerConfig{
+		Root:         t.TempDir(),
+		Options:      []client.Option{client.WithTransport(server)},
+		RestartDelay: restartDelay,
+	})
+
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		manager.Close(ctx)
+	})
+
+	return manager
+}
+
+func sessionAuthorizer(parameters *client.TdlibParameters) client.AuthorizationStateHandler {
+	return client.SessionAuthorizer(parameters)
+}
+
+// waitHealth waits until the health of the account matches the condition
+func waitHealth(t *testing.T, manager *client.Manager, key string, condition func(health client.AccountHealth) bool) client.AccountHealth {
+	deadline := time.Now().Add(time.Second)
+	for {
+		health, ok := manager.Health(key)
+		if !ok {
+			t.Fatalf("account %s not found", key)
+		}
+
+		if condition(health) {
+			return health
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatalf("unexpected health %+v", health)
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestManagerRestart(t *testing.T) {
+	server := tdtest.NewServer()
+	manager := newTestManager(t, server, 10*time.Millisecond)
+
+	tdlibClient, err := manager.Add("account", sessionAuthorizer)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	waitHealth(t, manager, "account", client.AccountHealth.Ready)
+
+	// TDLib closes the instance, for example after the session is terminated
+	err = server.SendUpdate(server.ClientIds()[0], &client.UpdateAuthorizationState{
+		AuthorizationState: &client.AuthorizationStateClosed{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	health := waitHealth(t, manager, "account", func(health client.AccountHealth) bool {
+		return health.Restarts == 1 && health.Ready()
+	})
+	if health.Err != nil || health.Stopped {
+		t.Fatalf("unexpected health %+v", health)
+	}
+
+	restartedClient, ok := manager.Get("account")
+	if !ok || restartedClient == tdlibClient {
+		t.Fatal("the client isn't restarted")
+	}
+
+	_, err = tdlibClient.GetMe()
+	if !errors.Is(err, client.ErrClientClosed) {
+		t.Fatalf("expected ErrClientClosed from the closed client, got %v", err)
+	}
+
+	_, err = restartedClient.GetAuthorizationState()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestManagerStopsOnNonRetryableError(t *testing.T) {
+	server := tdtest.NewServer()
+	server.SetAuthorizationState(&client.AuthorizationStateWaitPhoneNumber{})
+	manager := newTestManager(t, server, 10*time.Millisecond)
+
+	_, err := manager.Add("account", sessionAuthorizer)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	health := waitHealth(t, manager, "account", func(health client.AccountHealth) bool {
+		return health.Stopped && health.Err != nil
+	})
+	if !errors.Is(health.Err, client.ErrNeedsLogin) || health.State != client.TypeAuthorizationStateClosed {
+		t.Fatalf("unexpected health %+v", health)
+	}
+
+	time.Sleep(50 * time.Millisecond)
+
+	if len(server.ClientIds()) != 1 {
+		t.Fatalf("the client is restarted after the non-retryable error: %d clients", len(server.ClientIds()))
+	}
+}
+
+func TestManagerRemoveBeforeRestart(t *testing.T) {
+	server := tdtest.NewServer()
+	manager := newTestManager(t, server, 50*time.Millisecond)
+
+	_, err := manager.Add("account", sessionAuthorizer)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	waitHealth(t, manager, "account", client.AccountHealth.Ready)
+
+	err = server.SendUpdate(server.ClientIds()[0], &client.UpdateAuthorizationState{
+		AuthorizationState: &client.AuthorizationStateClosed{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	waitHealth(t, manager, "account", func(health client.AccountHealth) bool {
+		return health.State == client.TypeAuthorizationStateClosed
+	})
+
+	err = manager.Remove(context.Background(), "account")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	time.Sleep(100 * time.Millisecond)
+
+	if len(server.ClientIds()) != 1 {
+		t.Fatalf("the removed account is restarted: %d clients", len(server.ClientIds()))
+	}
+
+	if len(manager.Keys()) != 0 {
+		t.Fatalf("unexpected accounts %v", manager.Keys())
+	}
+}
+
+func TestManagerStartWithoutLock(t *testing.T) {
+	server := tdtest.NewServer()
+	manager := newTestManager(t, server, 10*time.Millisecond)
+
+	release := make(chan struct{})
+	server.Handle("addProxy", func(req *tdtest.Request) client.Type {
+		<-release
+		return &client.Proxy{}
+	})
+
+	added := make(chan error, 1)
+	go func() {
+		_, err := manager.Add("slow", sessionAuthorizer, client.WithProxy(&client.AddProxyRequest{Server: "proxy", Port: 1080}))
+		added <- err
+	}()
+
+	deadline := time.Now().Add(time.Second)
+	for len(server.Requests("addProxy")) == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("proxy isn't added")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	// the start of the slow account doesn't block the other accounts
+	_, err := manager.Add("fast", sessionAuthorizer)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	waitHealth(t, manager, "fast", client.AccountHealth.Ready)
+
+	close(release)
+
+	err = <-added
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tdlibClient, ok := manager.Get("slow")
+	if !ok || tdlibClient == nil {
+		t.Fatal("client of the slow account isn't set")
+	}
+}
@@ -0,0 +1,30652 @@
+// AUTOGENERATED
+
+package client
+
+func encodeAuthenticationCodeType(encoder *encoder, value AuthenticationCodeType) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *AuthenticationCodeTypeTelegramMessage:
+		encodeAuthenticationCodeTypeTelegramMessage(encoder, value)
+
+	case *AuthenticationCodeTypeSms:
+		encodeAuthenticationCodeTypeSms(encoder, value)
+
+	case *AuthenticationCodeTypeCall:
+		encodeAuthenticationCodeTypeCall(encoder, value)
+
+	case *AuthenticationCodeTypeFlashCall:
+		encodeAuthenticationCodeTypeFlashCall(encoder, value)
+
+	case *AuthenticationCodeTypeMissedCall:
+		encodeAuthenticationCodeTypeMissedCall(encoder, value)
+
+	case *AuthenticationCodeTypeFragment:
+		encodeAuthenticationCodeTypeFragment(encoder, value)
+
+	case *AuthenticationCodeTypeFirebaseAndroid:
+		encodeAuthenticationCodeTypeFirebaseAndroid(encoder, value)
+
+	case *AuthenticationCodeTypeFirebaseIos:
+		encodeAuthenticationCodeTypeFirebaseIos(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeEmailAddressAuthentication(encoder *encoder, value EmailAddressAuthentication) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *EmailAddressAuthenticationCode:
+		encodeEmailAddressAuthenticationCode(encoder, value)
+
+	case *EmailAddressAuthenticationAppleId:
+		encodeEmailAddressAuthenticationAppleId(encoder, value)
+
+	case *EmailAddressAuthenticationGoogleId:
+		encodeEmailAddressAuthenticationGoogleId(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeEmailAddressResetState(encoder *encoder, value EmailAddressResetState) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *EmailAddressResetStateAvailable:
+		encodeEmailAddressResetStateAvailable(encoder, value)
+
+	case *EmailAddressResetStatePending:
+		encodeEmailAddressResetStatePending(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeAuthorizationState(encoder *encoder, value AuthorizationState) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *AuthorizationStateWaitTdlibParameters:
+		encodeAuthorizationStateWaitTdlibParameters(encoder, value)
+
+	case *AuthorizationStateWaitPhoneNumber:
+		encodeAuthorizationStateWaitPhoneNumber(encoder, value)
+
+	case *AuthorizationStateWaitEmailAddress:
+		encodeAuthorizationStateWaitEmailAddress(encoder, value)
+
+	case *AuthorizationStateWaitEmailCode:
+		encodeAuthorizationStateWaitEmailCode(encoder, value)
+
+	case *AuthorizationStateWaitCode:
+		encodeAuthorizationStateWaitCode(encoder, value)
+
+	case *AuthorizationStateWaitOtherDeviceConfirmation:
+		encodeAuthorizationStateWaitOtherDeviceConfirmation(encoder, value)
+
+	case *AuthorizationStateWaitRegistration:
+		encodeAuthorizationStateWaitRegistration(encoder, value)
+
+	case *AuthorizationStateWaitPassword:
+		encodeAuthorizationStateWaitPassword(encoder, value)
+
+	case *AuthorizationStateReady:
+		encodeAuthorizationStateReady(encoder, value)
+
+	case *AuthorizationStateLoggingOut:
+		encodeAuthorizationStateLoggingOut(encoder, value)
+
+	case *AuthorizationStateClosing:
+		encodeAuthorizationStateClosing(encoder, value)
+
+	case *AuthorizationStateClosed:
+		encodeAuthorizationStateClosed(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeInputFile(encoder *encoder, value InputFile) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *InputFileId:
+		encodeInputFileId(encoder, value)
+
+	case *InputFileRemote:
+		encodeInputFileRemote(encoder, value)
+
+	case *InputFileLocal:
+		encodeInputFileLocal(encoder, value)
+
+	case *InputFileGenerated:
+		encodeInputFileGenerated(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeThumbnailFormat(encoder *encoder, value ThumbnailFormat) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *ThumbnailFormatJpeg:
+		encodeThumbnailFormatJpeg(encoder, value)
+
+	case *ThumbnailFormatGif:
+		encodeThumbnailFormatGif(encoder, value)
+
+	case *ThumbnailFormatMpeg4:
+		encodeThumbnailFormatMpeg4(encoder, value)
+
+	case *ThumbnailFormatPng:
+		encodeThumbnailFormatPng(encoder, value)
+
+	case *ThumbnailFormatTgs:
+		encodeThumbnailFormatTgs(encoder, value)
+
+	case *ThumbnailFormatWebm:
+		encodeThumbnailFormatWebm(encoder, value)
+
+	case *ThumbnailFormatWebp:
+		encodeThumbnailFormatWebp(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeMaskPoint(encoder *encoder, value MaskPoint) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *MaskPointForehead:
+		encodeMaskPointForehead(encoder, value)
+
+	case *MaskPointEyes:
+		encodeMaskPointEyes(encoder, value)
+
+	case *MaskPointMouth:
+		encodeMaskPointMouth(encoder, value)
+
+	case *MaskPointChin:
+		encodeMaskPointChin(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeStickerFormat(encoder *encoder, value StickerFormat) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *StickerFormatWebp:
+		encodeStickerFormatWebp(encoder, value)
+
+	case *StickerFormatTgs:
+		encodeStickerFormatTgs(encoder, value)
+
+	case *StickerFormatWebm:
+		encodeStickerFormatWebm(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeStickerType(encoder *encoder, value StickerType) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *StickerTypeRegular:
+		encodeStickerTypeRegular(encoder, value)
+
+	case *StickerTypeMask:
+		encodeStickerTypeMask(encoder, value)
+
+	case *StickerTypeCustomEmoji:
+		encodeStickerTypeCustomEmoji(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeStickerFullType(encoder *encoder, value StickerFullType) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *StickerFullTypeRegular:
+		encodeStickerFullTypeRegular(encoder, value)
+
+	case *StickerFullTypeMask:
+		encodeStickerFullTypeMask(encoder, value)
+
+	case *StickerFullTypeCustomEmoji:
+		encodeStickerFullTypeCustomEmoji(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodePollType(encoder *encoder, value PollType) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *PollTypeRegular:
+		encodePollTypeRegular(encoder, value)
+
+	case *PollTypeQuiz:
+		encodePollTypeQuiz(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeUserType(encoder *encoder, value UserType) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *UserTypeRegular:
+		encodeUserTypeRegular(encoder, value)
+
+	case *UserTypeDeleted:
+		encodeUserTypeDeleted(encoder, value)
+
+	case *UserTypeBot:
+		encodeUserTypeBot(encoder, value)
+
+	case *UserTypeUnknown:
+		encodeUserTypeUnknown(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeAccessHashType(encoder *encoder, value AccessHashType) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *AccessHashTypeUser:
+		encodeAccessHashTypeUser(encoder, value)
+
+	case *AccessHashTypeChannel:
+		encodeAccessHashTypeChannel(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeChatPhotoStickerType(encoder *encoder, value ChatPhotoStickerType) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *ChatPhotoStickerTypeRegularOrMask:
+		encodeChatPhotoStickerTypeRegularOrMask(encoder, value)
+
+	case *ChatPhotoStickerTypeCustomEmoji:
+		encodeChatPhotoStickerTypeCustomEmoji(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeInputChatPhoto(encoder *encoder, value InputChatPhoto) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *InputChatPhotoPrevious:
+		encodeInputChatPhotoPrevious(encoder, value)
+
+	case *InputChatPhotoStatic:
+		encodeInputChatPhotoStatic(encoder, value)
+
+	case *InputChatPhotoAnimation:
+		encodeInputChatPhotoAnimation(encoder, value)
+
+	case *InputChatPhotoSticker:
+		encodeInputChatPhotoSticker(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeChatMemberStatus(encoder *encoder, value ChatMemberStatus) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *ChatMemberStatusCreator:
+		encodeChatMemberStatusCreator(encoder, value)
+
+	case *ChatMemberStatusAdministrator:
+		encodeChatMemberStatusAdministrator(encoder, value)
+
+	case *ChatMemberStatusMember:
+		encodeChatMemberStatusMember(encoder, value)
+
+	case *ChatMemberStatusRestricted:
+		encodeChatMemberStatusRestricted(encoder, value)
+
+	case *ChatMemberStatusLeft:
+		encodeChatMemberStatusLeft(encoder, value)
+
+	case *ChatMemberStatusBanned:
+		encodeChatMemberStatusBanned(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeChatMembersFilter(encoder *encoder, value ChatMembersFilter) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *ChatMembersFilterContacts:
+		encodeChatMembersFilterContacts(encoder, value)
+
+	case *ChatMembersFilterAdministrators:
+		encodeChatMembersFilterAdministrators(encoder, value)
+
+	case *ChatMembersFilterMembers:
+		encodeChatMembersFilterMembers(encoder, value)
+
+	case *ChatMembersFilterMention:
+		encodeChatMembersFilterMention(encoder, value)
+
+	case *ChatMembersFilterRestricted:
+		encodeChatMembersFilterRestricted(encoder, value)
+
+	case *ChatMembersFilterBanned:
+		encodeChatMembersFilterBanned(encoder, value)
+
+	case *ChatMembersFilterBots:
+		encodeChatMembersFilterBots(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeSupergroupMembersFilter(encoder *encoder, value SupergroupMembersFilter) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *SupergroupMembersFilterRecent:
+		encodeSupergroupMembersFilterRecent(encoder, value)
+
+	case *SupergroupMembersFilterContacts:
+		encodeSupergroupMembersFilterContacts(encoder, value)
+
+	case *SupergroupMembersFilterAdministrators:
+		encodeSupergroupMembersFilterAdministrators(encoder, value)
+
+	case *SupergroupMembersFilterSearch:
+		encodeSupergroupMembersFilterSearch(encoder, value)
+
+	case *SupergroupMembersFilterRestricted:
+		encodeSupergroupMembersFilterRestricted(encoder, value)
+
+	case *SupergroupMembersFilterBanned:
+		encodeSupergroupMembersFilterBanned(encoder, value)
+
+	case *SupergroupMembersFilterMention:
+		encodeSupergroupMembersFilterMention(encoder, value)
+
+	case *SupergroupMembersFilterBots:
+		encodeSupergroupMembersFilterBots(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeSecretChatState(encoder *encoder, value SecretChatState) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *SecretChatStatePending:
+		encodeSecretChatStatePending(encoder, value)
+
+	case *SecretChatStateReady:
+		encodeSecretChatStateReady(encoder, value)
+
+	case *SecretChatStateClosed:
+		encodeSecretChatStateClosed(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeMessageSender(encoder *encoder, value MessageSender) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *MessageSenderUser:
+		encodeMessageSenderUser(encoder, value)
+
+	case *MessageSenderChat:
+		encodeMessageSenderChat(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeMessageForwardOrigin(encoder *encoder, value MessageForwardOrigin) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *MessageForwardOriginUser:
+		encodeMessageForwardOriginUser(encoder, value)
+
+	case *MessageForwardOriginChat:
+		encodeMessageForwardOriginChat(encoder, value)
+
+	case *MessageForwardOriginHiddenUser:
+		encodeMessageForwardOriginHiddenUser(encoder, value)
+
+	case *MessageForwardOriginChannel:
+		encodeMessageForwardOriginChannel(encoder, value)
+
+	case *MessageForwardOriginMessageImport:
+		encodeMessageForwardOriginMessageImport(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeReactionType(encoder *encoder, value ReactionType) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *ReactionTypeEmoji:
+		encodeReactionTypeEmoji(encoder, value)
+
+	case *ReactionTypeCustomEmoji:
+		encodeReactionTypeCustomEmoji(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeMessageSendingState(encoder *encoder, value MessageSendingState) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *MessageSendingStatePending:
+		encodeMessageSendingStatePending(encoder, value)
+
+	case *MessageSendingStateFailed:
+		encodeMessageSendingStateFailed(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeMessageSource(encoder *encoder, value MessageSource) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *MessageSourceChatHistory:
+		encodeMessageSourceChatHistory(encoder, value)
+
+	case *MessageSourceMessageThreadHistory:
+		encodeMessageSourceMessageThreadHistory(encoder, value)
+
+	case *MessageSourceForumTopicHistory:
+		encodeMessageSourceForumTopicHistory(encoder, value)
+
+	case *MessageSourceHistoryPreview:
+		encodeMessageSourceHistoryPreview(encoder, value)
+
+	case *MessageSourceChatList:
+		encodeMessageSourceChatList(encoder, value)
+
+	case *MessageSourceSearch:
+		encodeMessageSourceSearch(encoder, value)
+
+	case *MessageSourceChatEventLog:
+		encodeMessageSourceChatEventLog(encoder, value)
+
+	case *MessageSourceNotification:
+		encodeMessageSourceNotification(encoder, value)
+
+	case *MessageSourceOther:
+		encodeMessageSourceOther(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeNotificationSettingsScope(encoder *encoder, value NotificationSettingsScope) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *NotificationSettingsScopePrivateChats:
+		encodeNotificationSettingsScopePrivateChats(encoder, value)
+
+	case *NotificationSettingsScopeGroupChats:
+		encodeNotificationSettingsScopeGroupChats(encoder, value)
+
+	case *NotificationSettingsScopeChannelChats:
+		encodeNotificationSettingsScopeChannelChats(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeChatType(encoder *encoder, value ChatType) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *ChatTypePrivate:
+		encodeChatTypePrivate(encoder, value)
+
+	case *ChatTypeBasicGroup:
+		encodeChatTypeBasicGroup(encoder, value)
+
+	case *ChatTypeSupergroup:
+		encodeChatTypeSupergroup(encoder, value)
+
+	case *ChatTypeSecret:
+		encodeChatTypeSecret(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeChatList(encoder *encoder, value ChatList) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *ChatListMain:
+		encodeChatListMain(encoder, value)
+
+	case *ChatListArchive:
+		encodeChatListArchive(encoder, value)
+
+	case *ChatListFolder:
+		encodeChatListFolder(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeChatSource(encoder *encoder, value ChatSource) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *ChatSourceMtprotoProxy:
+		encodeChatSourceMtprotoProxy(encoder, value)
+
+	case *ChatSourcePublicServiceAnnouncement:
+		encodeChatSourcePublicServiceAnnouncement(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeChatAvailableReactions(encoder *encoder, value ChatAvailableReactions) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *ChatAvailableReactionsAll:
+		encodeChatAvailableReactionsAll(encoder, value)
+
+	case *ChatAvailableReactionsSome:
+		encodeChatAvailableReactionsSome(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodePublicChatType(encoder *encoder, value PublicChatType) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *PublicChatTypeHasUsername:
+		encodePublicChatTypeHasUsername(encoder, value)
+
+	case *PublicChatTypeIsLocationBased:
+		encodePublicChatTypeIsLocationBased(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeChatActionBar(encoder *encoder, value ChatActionBar) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *ChatActionBarReportSpam:
+		encodeChatActionBarReportSpam(encoder, value)
+
+	case *ChatActionBarReportUnrelatedLocation:
+		encodeChatActionBarReportUnrelatedLocation(encoder, value)
+
+	case *ChatActionBarInviteMembers:
+		encodeChatActionBarInviteMembers(encoder, value)
+
+	case *ChatActionBarReportAddBlock:
+		encodeChatActionBarReportAddBlock(encoder, value)
+
+	case *ChatActionBarAddContact:
+		encodeChatActionBarAddContact(encoder, value)
+
+	case *ChatActionBarSharePhoneNumber:
+		encodeChatActionBarSharePhoneNumber(encoder, value)
+
+	case *ChatActionBarJoinRequest:
+		encodeChatActionBarJoinRequest(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeKeyboardButtonType(encoder *encoder, value KeyboardButtonType) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *KeyboardButtonTypeText:
+		encodeKeyboardButtonTypeText(encoder, value)
+
+	case *KeyboardButtonTypeRequestPhoneNumber:
+		encodeKeyboardButtonTypeRequestPhoneNumber(encoder, value)
+
+	case *KeyboardButtonTypeRequestLocation:
+		encodeKeyboardButtonTypeRequestLocation(encoder, value)
+
+	case *KeyboardButtonTypeRequestPoll:
+		encodeKeyboardButtonTypeRequestPoll(encoder, value)
+
+	case *KeyboardButtonTypeRequestUser:
+		encodeKeyboardButtonTypeRequestUser(encoder, value)
+
+	case *KeyboardButtonTypeRequestChat:
+		encodeKeyboardButtonTypeRequestChat(encoder, value)
+
+	case *KeyboardButtonTypeWebApp:
+		encodeKeyboardButtonTypeWebApp(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeInlineKeyboardButtonType(encoder *encoder, value InlineKeyboardButtonType) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *InlineKeyboardButtonTypeUrl:
+		encodeInlineKeyboardButtonTypeUrl(encoder, value)
+
+	case *InlineKeyboardButtonTypeLoginUrl:
+		encodeInlineKeyboardButtonTypeLoginUrl(encoder, value)
+
+	case *InlineKeyboardButtonTypeWebApp:
+		encodeInlineKeyboardButtonTypeWebApp(encoder, value)
+
+	case *InlineKeyboardButtonTypeCallback:
+		encodeInlineKeyboardButtonTypeCallback(encoder, value)
+
+	case *InlineKeyboardButtonTypeCallbackWithPassword:
+		encodeInlineKeyboardButtonTypeCallbackWithPassword(encoder, value)
+
+	case *InlineKeyboardButtonTypeCallbackGame:
+		encodeInlineKeyboardButtonTypeCallbackGame(encoder, value)
+
+	case *InlineKeyboardButtonTypeSwitchInline:
+		encodeInlineKeyboardButtonTypeSwitchInline(encoder, value)
+
+	case *InlineKeyboardButtonTypeBuy:
+		encodeInlineKeyboardButtonTypeBuy(encoder, value)
+
+	case *InlineKeyboardButtonTypeUser:
+		encodeInlineKeyboardButtonTypeUser(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeReplyMarkup(encoder *encoder, value ReplyMarkup) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *ReplyMarkupRemoveKeyboard:
+		encodeReplyMarkupRemoveKeyboard(encoder, value)
+
+	case *ReplyMarkupForceReply:
+		encodeReplyMarkupForceReply(encoder, value)
+
+	case *ReplyMarkupShowKeyboard:
+		encodeReplyMarkupShowKeyboard(encoder, value)
+
+	case *ReplyMarkupInlineKeyboard:
+		encodeReplyMarkupInlineKeyboard(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeLoginUrlInfo(encoder *encoder, value LoginUrlInfo) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *LoginUrlInfoOpen:
+		encodeLoginUrlInfoOpen(encoder, value)
+
+	case *LoginUrlInfoRequestConfirmation:
+		encodeLoginUrlInfoRequestConfirmation(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeRichText(encoder *encoder, value RichText) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *RichTextPlain:
+		encodeRichTextPlain(encoder, value)
+
+	case *RichTextBold:
+		encodeRichTextBold(encoder, value)
+
+	case *RichTextItalic:
+		encodeRichTextItalic(encoder, value)
+
+	case *RichTextUnderline:
+		encodeRichTextUnderline(encoder, value)
+
+	case *RichTextStrikethrough:
+		encodeRichTextStrikethrough(encoder, value)
+
+	case *RichTextFixed:
+		encodeRichTextFixed(encoder, value)
+
+	case *RichTextUrl:
+		encodeRichTextUrl(encoder, value)
+
+	case *RichTextEmailAddress:
+		encodeRichTextEmailAddress(encoder, value)
+
+	case *RichTextSubscript:
+		encodeRichTextSubscript(encoder, value)
+
+	case *RichTextSuperscript:
+		encodeRichTextSuperscript(encoder, value)
+
+	case *RichTextMarked:
+		encodeRichTextMarked(encoder, value)
+
+	case *RichTextPhoneNumber:
+		encodeRichTextPhoneNumber(encoder, value)
+
+	case *RichTextIcon:
+		encodeRichTextIcon(encoder, value)
+
+	case *RichTextReference:
+		encodeRichTextReference(encoder, value)
+
+	case *RichTextAnchor:
+		encodeRichTextAnchor(encoder, value)
+
+	case *RichTextAnchorLink:
+		encodeRichTextAnchorLink(encoder, value)
+
+	case *RichTexts:
+		encodeRichTexts(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodePageBlockHorizontalAlignment(encoder *encoder, value PageBlockHorizontalAlignment) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *PageBlockHorizontalAlignmentLeft:
+		encodePageBlockHorizontalAlignmentLeft(encoder, value)
+
+	case *PageBlockHorizontalAlignmentCenter:
+		encodePageBlockHorizontalAlignmentCenter(encoder, value)
+
+	case *PageBlockHorizontalAlignmentRight:
+		encodePageBlockHorizontalAlignmentRight(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodePageBlockVerticalAlignment(encoder *encoder, value PageBlockVerticalAlignment) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *PageBlockVerticalAlignmentTop:
+		encodePageBlockVerticalAlignmentTop(encoder, value)
+
+	case *PageBlockVerticalAlignmentMiddle:
+		encodePageBlockVerticalAlignmentMiddle(encoder, value)
+
+	case *PageBlockVerticalAlignmentBottom:
+		encodePageBlockVerticalAlignmentBottom(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodePageBlock(encoder *encoder, value PageBlock) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *PageBlockTitle:
+		encodePageBlockTitle(encoder, value)
+
+	case *PageBlockSubtitle:
+		encodePageBlockSubtitle(encoder, value)
+
+	case *PageBlockAuthorDate:
+		encodePageBlockAuthorDate(encoder, value)
+
+	case *PageBlockHeader:
+		encodePageBlockHeader(encoder, value)
+
+	case *PageBlockSubheader:
+		encodePageBlockSubheader(encoder, value)
+
+	case *PageBlockKicker:
+		encodePageBlockKicker(encoder, value)
+
+	case *PageBlockParagraph:
+		encodePageBlockParagraph(encoder, value)
+
+	case *PageBlockPreformatted:
+		encodePageBlockPreformatted(encoder, value)
+
+	case *PageBlockFooter:
+		encodePageBlockFooter(encoder, value)
+
+	case *PageBlockDivider:
+		encodePageBlockDivider(encoder, value)
+
+	case *PageBlockAnchor:
+		encodePageBlockAnchor(encoder, value)
+
+	case *PageBlockList:
+		encodePageBlockList(encoder, value)
+
+	case *PageBlockBlockQuote:
+		encodePageBlockBlockQuote(encoder, value)
+
+	case *PageBlockPullQuote:
+		encodePageBlockPullQuote(encoder, value)
+
+	case *PageBlockAnimation:
+		encodePageBlockAnimation(encoder, value)
+
+	case *PageBlockAudio:
+		encodePageBlockAudio(encoder, value)
+
+	case *PageBlockPhoto:
+		encodePageBlockPhoto(encoder, value)
+
+	case *PageBlockVideo:
+		encodePageBlockVideo(encoder, value)
+
+	case *PageBlockVoiceNote:
+		encodePageBlockVoiceNote(encoder, value)
+
+	case *PageBlockCover:
+		encodePageBlockCover(encoder, value)
+
+	case *PageBlockEmbedded:
+		encodePageBlockEmbedded(encoder, value)
+
+	case *PageBlockEmbeddedPost:
+		encodePageBlockEmbeddedPost(encoder, value)
+
+	case *PageBlockCollage:
+		encodePageBlockCollage(encoder, value)
+
+	case *PageBlockSlideshow:
+		encodePageBlockSlideshow(encoder, value)
+
+	case *PageBlockChatLink:
+		encodePageBlockChatLink(encoder, value)
+
+	case *PageBlockTable:
+		encodePageBlockTable(encoder, value)
+
+	case *PageBlockDetails:
+		encodePageBlockDetails(encoder, value)
+
+	case *PageBlockRelatedArticles:
+		encodePageBlockRelatedArticles(encoder, value)
+
+	case *PageBlockMap:
+		encodePageBlockMap(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeInputCredentials(encoder *encoder, value InputCredentials) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *InputCredentialsSaved:
+		encodeInputCredentialsSaved(encoder, value)
+
+	case *InputCredentialsNew:
+		encodeInputCredentialsNew(encoder, value)
+
+	case *InputCredentialsApplePay:
+		encodeInputCredentialsApplePay(encoder, value)
+
+	case *InputCredentialsGooglePay:
+		encodeInputCredentialsGooglePay(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodePaymentProvider(encoder *encoder, value PaymentProvider) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *PaymentProviderSmartGlocal:
+		encodePaymentProviderSmartGlocal(encoder, value)
+
+	case *PaymentProviderStripe:
+		encodePaymentProviderStripe(encoder, value)
+
+	case *PaymentProviderOther:
+		encodePaymentProviderOther(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeInputInvoice(encoder *encoder, value InputInvoice) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *InputInvoiceMessage:
+		encodeInputInvoiceMessage(encoder, value)
+
+	case *InputInvoiceName:
+		encodeInputInvoiceName(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeMessageExtendedMedia(encoder *encoder, value MessageExtendedMedia) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *MessageExtendedMediaPreview:
+		encodeMessageExtendedMediaPreview(encoder, value)
+
+	case *MessageExtendedMediaPhoto:
+		encodeMessageExtendedMediaPhoto(encoder, value)
+
+	case *MessageExtendedMediaVideo:
+		encodeMessageExtendedMediaVideo(encoder, value)
+
+	case *MessageExtendedMediaUnsupported:
+		encodeMessageExtendedMediaUnsupported(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodePassportElementType(encoder *encoder, value PassportElementType) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *PassportElementTypePersonalDetails:
+		encodePassportElementTypePersonalDetails(encoder, value)
+
+	case *PassportElementTypePassport:
+		encodePassportElementTypePassport(encoder, value)
+
+	case *PassportElementTypeDriverLicense:
+		encodePassportElementTypeDriverLicense(encoder, value)
+
+	case *PassportElementTypeIdentityCard:
+		encodePassportElementTypeIdentityCard(encoder, value)
+
+	case *PassportElementTypeInternalPassport:
+		encodePassportElementTypeInternalPassport(encoder, value)
+
+	case *PassportElementTypeAddress:
+		encodePassportElementTypeAddress(encoder, value)
+
+	case *PassportElementTypeUtilityBill:
+		encodePassportElementTypeUtilityBill(encoder, value)
+
+	case *PassportElementTypeBankStatement:
+		encodePassportElementTypeBankStatement(encoder, value)
+
+	case *PassportElementTypeRentalAgreement:
+		encodePassportElementTypeRentalAgreement(encoder, value)
+
+	case *PassportElementTypePassportRegistration:
+		encodePassportElementTypePassportRegistration(encoder, value)
+
+	case *PassportElementTypeTemporaryRegistration:
+		encodePassportElementTypeTemporaryRegistration(encoder, value)
+
+	case *PassportElementTypePhoneNumber:
+		encodePassportElementTypePhoneNumber(encoder, value)
+
+	case *PassportElementTypeEmailAddress:
+		encodePassportElementTypeEmailAddress(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodePassportElement(encoder *encoder, value PassportElement) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *PassportElementPersonalDetails:
+		encodePassportElementPersonalDetails(encoder, value)
+
+	case *PassportElementPassport:
+		encodePassportElementPassport(encoder, value)
+
+	case *PassportElementDriverLicense:
+		encodePassportElementDriverLicense(encoder, value)
+
+	case *PassportElementIdentityCard:
+		encodePassportElementIdentityCard(encoder, value)
+
+	case *PassportElementInternalPassport:
+		encodePassportElementInternalPassport(encoder, value)
+
+	case *PassportElementAddress:
+		encodePassportElementAddress(encoder, value)
+
+	case *PassportElementUtilityBill:
+		encodePassportElementUtilityBill(encoder, value)
+
+	case *PassportElementBankStatement:
+		encodePassportElementBankStatement(encoder, value)
+
+	case *PassportElementRentalAgreement:
+		encodePassportElementRentalAgreement(encoder, value)
+
+	case *PassportElementPassportRegistration:
+		encodePassportElementPassportRegistration(encoder, value)
+
+	case *PassportElementTemporaryRegistration:
+		encodePassportElementTemporaryRegistration(encoder, value)
+
+	case *PassportElementPhoneNumber:
+		encodePassportElementPhoneNumber(encoder, value)
+
+	case *PassportElementEmailAddress:
+		encodePassportElementEmailAddress(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeInputPassportElement(encoder *encoder, value InputPassportElement) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *InputPassportElementPersonalDetails:
+		encodeInputPassportElementPersonalDetails(encoder, value)
+
+	case *InputPassportElementPassport:
+		encodeInputPassportElementPassport(encoder, value)
+
+	case *InputPassportElementDriverLicense:
+		encodeInputPassportElementDriverLicense(encoder, value)
+
+	case *InputPassportElementIdentityCard:
+		encodeInputPassportElementIdentityCard(encoder, value)
+
+	case *InputPassportElementInternalPassport:
+		encodeInputPassportElementInternalPassport(encoder, value)
+
+	case *InputPassportElementAddress:
+		encodeInputPassportElementAddress(encoder, value)
+
+	case *InputPassportElementUtilityBill:
+		encodeInputPassportElementUtilityBill(encoder, value)
+
+	case *InputPassportElementBankStatement:
+		encodeInputPassportElementBankStatement(encoder, value)
+
+	case *InputPassportElementRentalAgreement:
+		encodeInputPassportElementRentalAgreement(encoder, value)
+
+	case *InputPassportElementPassportRegistration:
+		encodeInputPassportElementPassportRegistration(encoder, value)
+
+	case *InputPassportElementTemporaryRegistration:
+		encodeInputPassportElementTemporaryRegistration(encoder, value)
+
+	case *InputPassportElementPhoneNumber:
+		encodeInputPassportElementPhoneNumber(encoder, value)
+
+	case *InputPassportElementEmailAddress:
+		encodeInputPassportElementEmailAddress(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodePassportElementErrorSource(encoder *encoder, value PassportElementErrorSource) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *PassportElementErrorSourceUnspecified:
+		encodePassportElementErrorSourceUnspecified(encoder, value)
+
+	case *PassportElementErrorSourceDataField:
+		encodePassportElementErrorSourceDataField(encoder, value)
+
+	case *PassportElementErrorSourceFrontSide:
+		encodePassportElementErrorSourceFrontSide(encoder, value)
+
+	case *PassportElementErrorSourceReverseSide:
+		encodePassportElementErrorSourceReverseSide(encoder, value)
+
+	case *PassportElementErrorSourceSelfie:
+		encodePassportElementErrorSourceSelfie(encoder, value)
+
+	case *PassportElementErrorSourceTranslationFile:
+		encodePassportElementErrorSourceTranslationFile(encoder, value)
+
+	case *PassportElementErrorSourceTranslationFiles:
+		encodePassportElementErrorSourceTranslationFiles(encoder, value)
+
+	case *PassportElementErrorSourceFile:
+		encodePassportElementErrorSourceFile(encoder, value)
+
+	case *PassportElementErrorSourceFiles:
+		encodePassportElementErrorSourceFiles(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeInputPassportElementErrorSource(encoder *encoder, value InputPassportElementErrorSource) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *InputPassportElementErrorSourceUnspecified:
+		encodeInputPassportElementErrorSourceUnspecified(encoder, value)
+
+	case *InputPassportElementErrorSourceDataField:
+		encodeInputPassportElementErrorSourceDataField(encoder, value)
+
+	case *InputPassportElementErrorSourceFrontSide:
+		encodeInputPassportElementErrorSourceFrontSide(encoder, value)
+
+	case *InputPassportElementErrorSourceReverseSide:
+		encodeInputPassportElementErrorSourceReverseSide(encoder, value)
+
+	case *InputPassportElementErrorSourceSelfie:
+		encodeInputPassportElementErrorSourceSelfie(encoder, value)
+
+	case *InputPassportElementErrorSourceTranslationFile:
+		encodeInputPassportElementErrorSourceTranslationFile(encoder, value)
+
+	case *InputPassportElementErrorSourceTranslationFiles:
+		encodeInputPassportElementErrorSourceTranslationFiles(encoder, value)
+
+	case *InputPassportElementErrorSourceFile:
+		encodeInputPassportElementErrorSourceFile(encoder, value)
+
+	case *InputPassportElementErrorSourceFiles:
+		encodeInputPassportElementErrorSourceFiles(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeMessageContent(encoder *encoder, value MessageContent) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *MessageText:
+		encodeMessageText(encoder, value)
+
+	case *MessageAnimation:
+		encodeMessageAnimation(encoder, value)
+
+	case *MessageAudio:
+		encodeMessageAudio(encoder, value)
+
+	case *MessageDocument:
+		encodeMessageDocument(encoder, value)
+
+	case *MessagePhoto:
+		encodeMessagePhoto(encoder, value)
+
+	case *MessageExpiredPhoto:
+		encodeMessageExpiredPhoto(encoder, value)
+
+	case *MessageSticker:
+		encodeMessageSticker(encoder, value)
+
+	case *MessageVideo:
+		encodeMessageVideo(encoder, value)
+
+	case *MessageExpiredVideo:
+		encodeMessageExpiredVideo(encoder, value)
+
+	case *MessageVideoNote:
+		encodeMessageVideoNote(encoder, value)
+
+	case *MessageVoiceNote:
+		encodeMessageVoiceNote(encoder, value)
+
+	case *MessageLocation:
+		encodeMessageLocation(encoder, value)
+
+	case *MessageVenue:
+		encodeMessageVenue(encoder, value)
+
+	case *MessageContact:
+		encodeMessageContact(encoder, value)
+
+	case *MessageAnimatedEmoji:
+		encodeMessageAnimatedEmoji(encoder, value)
+
+	case *MessageDice:
+		encodeMessageDice(encoder, value)
+
+	case *MessageGame:
+		encodeMessageGame(encoder, value)
+
+	case *MessagePoll:
+		encodeMessagePoll(encoder, value)
+
+	case *MessageInvoice:
+		encodeMessageInvoice(encoder, value)
+
+	case *MessageCall:
+		encodeMessageCall(encoder, value)
+
+	case *MessageVideoChatScheduled:
+		encodeMessageVideoChatScheduled(encoder, value)
+
+	case *MessageVideoChatStarted:
+		encodeMessageVideoChatStarted(encoder, value)
+
+	case *MessageVideoChatEnded:
+		encodeMessageVideoChatEnded(encoder, value)
+
+	case *MessageInviteVideoChatParticipants:
+		encodeMessageInviteVideoChatParticipants(encoder, value)
+
+	case *MessageBasicGroupChatCreate:
+		encodeMessageBasicGroupChatCreate(encoder, value)
+
+	case *MessageSupergroupChatCreate:
+		encodeMessageSupergroupChatCreate(encoder, value)
+
+	case *MessageChatChangeTitle:
+		encodeMessageChatChangeTitle(encoder, value)
+
+	case *MessageChatChangePhoto:
+		encodeMessageChatChangePhoto(encoder, value)
+
+	case *MessageChatDeletePhoto:
+		encodeMessageChatDeletePhoto(encoder, value)
+
+	case *MessageChatAddMembers:
+		encodeMessageChatAddMembers(encoder, value)
+
+	case *MessageChatJoinByLink:
+		encodeMessageChatJoinByLink(encoder, value)
+
+	case *MessageChatJoinByRequest:
+		encodeMessageChatJoinByRequest(encoder, value)
+
+	case *MessageChatDeleteMember:
+		encodeMessageChatDeleteMember(encoder, value)
+
+	case *MessageChatUpgradeTo:
+		encodeMessageChatUpgradeTo(encoder, value)
+
+	case *MessageChatUpgradeFrom:
+		encodeMessageChatUpgradeFrom(encoder, value)
+
+	case *MessagePinMessage:
+		encodeMessagePinMessage(encoder, value)
+
+	case *MessageScreenshotTaken:
+		encodeMessageScreenshotTaken(encoder, value)
+
+	case *MessageChatSetBackground:
+		encodeMessageChatSetBackground(encoder, value)
+
+	case *MessageChatSetTheme:
+		encodeMessageChatSetTheme(encoder, value)
+
+	case *MessageChatSetMessageAutoDeleteTime:
+		encodeMessageChatSetMessageAutoDeleteTime(encoder, value)
+
+	case *MessageForumTopicCreated:
+		encodeMessageForumTopicCreated(encoder, value)
+
+	case *MessageForumTopicEdited:
+		encodeMessageForumTopicEdited(encoder, value)
+
+	case *MessageForumTopicIsClosedToggled:
+		encodeMessageForumTopicIsClosedToggled(encoder, value)
+
+	case *MessageForumTopicIsHiddenToggled:
+		encodeMessageForumTopicIsHiddenToggled(encoder, value)
+
+	case *MessageSuggestProfilePhoto:
+		encodeMessageSuggestProfilePhoto(encoder, value)
+
+	case *MessageCustomServiceAction:
+		encodeMessageCustomServiceAction(encoder, value)
+
+	case *MessageGameScore:
+		encodeMessageGameScore(encoder, value)
+
+	case *MessagePaymentSuccessful:
+		encodeMessagePaymentSuccessful(encoder, value)
+
+	case *MessagePaymentSuccessfulBot:
+		encodeMessagePaymentSuccessfulBot(encoder, value)
+
+	case *MessageGiftedPremium:
+		encodeMessageGiftedPremium(encoder, value)
+
+	case *MessageContactRegistered:
+		encodeMessageContactRegistered(encoder, value)
+
+	case *MessageUserShared:
+		encodeMessageUserShared(encoder, value)
+
+	case *MessageChatShared:
+		encodeMessageChatShared(encoder, value)
+
+	case *MessageWebsiteConnected:
+		encodeMessageWebsiteConnected(encoder, value)
+
+	case *MessageBotWriteAccessAllowed:
+		encodeMessageBotWriteAccessAllowed(encoder, value)
+
+	case *MessageWebAppDataSent:
+		encodeMessageWebAppDataSent(encoder, value)
+
+	case *MessageWebAppDataReceived:
+		encodeMessageWebAppDataReceived(encoder, value)
+
+	case *MessagePassportDataSent:
+		encodeMessagePassportDataSent(encoder, value)
+
+	case *MessagePassportDataReceived:
+		encodeMessagePassportDataReceived(encoder, value)
+
+	case *MessageProximityAlertTriggered:
+		encodeMessageProximityAlertTriggered(encoder, value)
+
+	case *MessageUnsupported:
+		encodeMessageUnsupported(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeTextEntityType(encoder *encoder, value TextEntityType) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *TextEntityTypeMention:
+		encodeTextEntityTypeMention(encoder, value)
+
+	case *TextEntityTypeHashtag:
+		encodeTextEntityTypeHashtag(encoder, value)
+
+	case *TextEntityTypeCashtag:
+		encodeTextEntityTypeCashtag(encoder, value)
+
+	case *TextEntityTypeBotCommand:
+		encodeTextEntityTypeBotCommand(encoder, value)
+
+	case *TextEntityTypeUrl:
+		encodeTextEntityTypeUrl(encoder, value)
+
+	case *TextEntityTypeEmailAddress:
+		encodeTextEntityTypeEmailAddress(encoder, value)
+
+	case *TextEntityTypePhoneNumber:
+		encodeTextEntityTypePhoneNumber(encoder, value)
+
+	case *TextEntityTypeBankCardNumber:
+		encodeTextEntityTypeBankCardNumber(encoder, value)
+
+	case *TextEntityTypeBold:
+		encodeTextEntityTypeBold(encoder, value)
+
+	case *TextEntityTypeItalic:
+		encodeTextEntityTypeItalic(encoder, value)
+
+	case *TextEntityTypeUnderline:
+		encodeTextEntityTypeUnderline(encoder, value)
+
+	case *TextEntityTypeStrikethrough:
+		encodeTextEntityTypeStrikethrough(encoder, value)
+
+	case *TextEntityTypeSpoiler:
+		encodeTextEntityTypeSpoiler(encoder, value)
+
+	case *TextEntityTypeCode:
+		encodeTextEntityTypeCode(encoder, value)
+
+	case *TextEntityTypePre:
+		encodeTextEntityTypePre(encoder, value)
+
+	case *TextEntityTypePreCode:
+		encodeTextEntityTypePreCode(encoder, value)
+
+	case *TextEntityTypeTextUrl:
+		encodeTextEntityTypeTextUrl(encoder, value)
+
+	case *TextEntityTypeMentionName:
+		encodeTextEntityTypeMentionName(encoder, value)
+
+	case *TextEntityTypeCustomEmoji:
+		encodeTextEntityTypeCustomEmoji(encoder, value)
+
+	case *TextEntityTypeMediaTimestamp:
+		encodeTextEntityTypeMediaTimestamp(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeMessageSchedulingState(encoder *encoder, value MessageSchedulingState) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *MessageSchedulingStateSendAtDate:
+		encodeMessageSchedulingStateSendAtDate(encoder, value)
+
+	case *MessageSchedulingStateSendWhenOnline:
+		encodeMessageSchedulingStateSendWhenOnline(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeInputMessageContent(encoder *encoder, value InputMessageContent) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *InputMessageText:
+		encodeInputMessageText(encoder, value)
+
+	case *InputMessageAnimation:
+		encodeInputMessageAnimation(encoder, value)
+
+	case *InputMessageAudio:
+		encodeInputMessageAudio(encoder, value)
+
+	case *InputMessageDocument:
+		encodeInputMessageDocument(encoder, value)
+
+	case *InputMessagePhoto:
+		encodeInputMessagePhoto(encoder, value)
+
+	case *InputMessageSticker:
+		encodeInputMessageSticker(encoder, value)
+
+	case *InputMessageVideo:
+		encodeInputMessageVideo(encoder, value)
+
+	case *InputMessageVideoNote:
+		encodeInputMessageVideoNote(encoder, value)
+
+	case *InputMessageVoiceNote:
+		encodeInputMessageVoiceNote(encoder, value)
+
+	case *InputMessageLocation:
+		encodeInputMessageLocation(encoder, value)
+
+	case *InputMessageVenue:
+		encodeInputMessageVenue(encoder, value)
+
+	case *InputMessageContact:
+		encodeInputMessageContact(encoder, value)
+
+	case *InputMessageDice:
+		encodeInputMessageDice(encoder, value)
+
+	case *InputMessageGame:
+		encodeInputMessageGame(encoder, value)
+
+	case *InputMessageInvoice:
+		encodeInputMessageInvoice(encoder, value)
+
+	case *InputMessagePoll:
+		encodeInputMessagePoll(encoder, value)
+
+	case *InputMessageForwarded:
+		encodeInputMessageForwarded(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeSearchMessagesFilter(encoder *encoder, value SearchMessagesFilter) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *SearchMessagesFilterEmpty:
+		encodeSearchMessagesFilterEmpty(encoder, value)
+
+	case *SearchMessagesFilterAnimation:
+		encodeSearchMessagesFilterAnimation(encoder, value)
+
+	case *SearchMessagesFilterAudio:
+		encodeSearchMessagesFilterAudio(encoder, value)
+
+	case *SearchMessagesFilterDocument:
+		encodeSearchMessagesFilterDocument(encoder, value)
+
+	case *SearchMessagesFilterPhoto:
+		encodeSearchMessagesFilterPhoto(encoder, value)
+
+	case *SearchMessagesFilterVideo:
+		encodeSearchMessagesFilterVideo(encoder, value)
+
+	case *SearchMessagesFilterVoiceNote:
+		encodeSearchMessagesFilterVoiceNote(encoder, value)
+
+	case *SearchMessagesFilterPhotoAndVideo:
+		encodeSearchMessagesFilterPhotoAndVideo(encoder, value)
+
+	case *SearchMessagesFilterUrl:
+		encodeSearchMessagesFilterUrl(encoder, value)
+
+	case *SearchMessagesFilterChatPhoto:
+		encodeSearchMessagesFilterChatPhoto(encoder, value)
+
+	case *SearchMessagesFilterVideoNote:
+		encodeSearchMessagesFilterVideoNote(encoder, value)
+
+	case *SearchMessagesFilterVoiceAndVideoNote:
+		encodeSearchMessagesFilterVoiceAndVideoNote(encoder, value)
+
+	case *SearchMessagesFilterMention:
+		encodeSearchMessagesFilterMention(encoder, value)
+
+	case *SearchMessagesFilterUnreadMention:
+		encodeSearchMessagesFilterUnreadMention(encoder, value)
+
+	case *SearchMessagesFilterUnreadReaction:
+		encodeSearchMessagesFilterUnreadReaction(encoder, value)
+
+	case *SearchMessagesFilterFailedToSend:
+		encodeSearchMessagesFilterFailedToSend(encoder, value)
+
+	case *SearchMessagesFilterPinned:
+		encodeSearchMessagesFilterPinned(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeChatAction(encoder *encoder, value ChatAction) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *ChatActionTyping:
+		encodeChatActionTyping(encoder, value)
+
+	case *ChatActionRecordingVideo:
+		encodeChatActionRecordingVideo(encoder, value)
+
+	case *ChatActionUploadingVideo:
+		encodeChatActionUploadingVideo(encoder, value)
+
+	case *ChatActionRecordingVoiceNote:
+		encodeChatActionRecordingVoiceNote(encoder, value)
+
+	case *ChatActionUploadingVoiceNote:
+		encodeChatActionUploadingVoiceNote(encoder, value)
+
+	case *ChatActionUploadingPhoto:
+		encodeChatActionUploadingPhoto(encoder, value)
+
+	case *ChatActionUploadingDocument:
+		encodeChatActionUploadingDocument(encoder, value)
+
+	case *ChatActionChoosingSticker:
+		encodeChatActionChoosingSticker(encoder, value)
+
+	case *ChatActionChoosingLocation:
+		encodeChatActionChoosingLocation(encoder, value)
+
+	case *ChatActionChoosingContact:
+		encodeChatActionChoosingContact(encoder, value)
+
+	case *ChatActionStartPlayingGame:
+		encodeChatActionStartPlayingGame(encoder, value)
+
+	case *ChatActionRecordingVideoNote:
+		encodeChatActionRecordingVideoNote(encoder, value)
+
+	case *ChatActionUploadingVideoNote:
+		encodeChatActionUploadingVideoNote(encoder, value)
+
+	case *ChatActionWatchingAnimations:
+		encodeChatActionWatchingAnimations(encoder, value)
+
+	case *ChatActionCancel:
+		encodeChatActionCancel(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeUserStatus(encoder *encoder, value UserStatus) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *UserStatusEmpty:
+		encodeUserStatusEmpty(encoder, value)
+
+	case *UserStatusOnline:
+		encodeUserStatusOnline(encoder, value)
+
+	case *UserStatusOffline:
+		encodeUserStatusOffline(encoder, value)
+
+	case *UserStatusRecently:
+		encodeUserStatusRecently(encoder, value)
+
+	case *UserStatusLastWeek:
+		encodeUserStatusLastWeek(encoder, value)
+
+	case *UserStatusLastMonth:
+		encodeUserStatusLastMonth(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeEmojiCategoryType(encoder *encoder, value EmojiCategoryType) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *EmojiCategoryTypeDefault:
+		encodeEmojiCategoryTypeDefault(encoder, value)
+
+	case *EmojiCategoryTypeEmojiStatus:
+		encodeEmojiCategoryTypeEmojiStatus(encoder, value)
+
+	case *EmojiCategoryTypeChatPhoto:
+		encodeEmojiCategoryTypeChatPhoto(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeCallDiscardReason(encoder *encoder, value CallDiscardReason) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *CallDiscardReasonEmpty:
+		encodeCallDiscardReasonEmpty(encoder, value)
+
+	case *CallDiscardReasonMissed:
+		encodeCallDiscardReasonMissed(encoder, value)
+
+	case *CallDiscardReasonDeclined:
+		encodeCallDiscardReasonDeclined(encoder, value)
+
+	case *CallDiscardReasonDisconnected:
+		encodeCallDiscardReasonDisconnected(encoder, value)
+
+	case *CallDiscardReasonHungUp:
+		encodeCallDiscardReasonHungUp(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeCallServerType(encoder *encoder, value CallServerType) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *CallServerTypeTelegramReflector:
+		encodeCallServerTypeTelegramReflector(encoder, value)
+
+	case *CallServerTypeWebrtc:
+		encodeCallServerTypeWebrtc(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeCallState(encoder *encoder, value CallState) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *CallStatePending:
+		encodeCallStatePending(encoder, value)
+
+	case *CallStateExchangingKeys:
+		encodeCallStateExchangingKeys(encoder, value)
+
+	case *CallStateReady:
+		encodeCallStateReady(encoder, value)
+
+	case *CallStateHangingUp:
+		encodeCallStateHangingUp(encoder, value)
+
+	case *CallStateDiscarded:
+		encodeCallStateDiscarded(encoder, value)
+
+	case *CallStateError:
+		encodeCallStateError(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeGroupCallVideoQuality(encoder *encoder, value GroupCallVideoQuality) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *GroupCallVideoQualityThumbnail:
+		encodeGroupCallVideoQualityThumbnail(encoder, value)
+
+	case *GroupCallVideoQualityMedium:
+		encodeGroupCallVideoQualityMedium(encoder, value)
+
+	case *GroupCallVideoQualityFull:
+		encodeGroupCallVideoQualityFull(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeCallProblem(encoder *encoder, value CallProblem) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *CallProblemEcho:
+		encodeCallProblemEcho(encoder, value)
+
+	case *CallProblemNoise:
+		encodeCallProblemNoise(encoder, value)
+
+	case *CallProblemInterruptions:
+		encodeCallProblemInterruptions(encoder, value)
+
+	case *CallProblemDistortedSpeech:
+		encodeCallProblemDistortedSpeech(encoder, value)
+
+	case *CallProblemSilentLocal:
+		encodeCallProblemSilentLocal(encoder, value)
+
+	case *CallProblemSilentRemote:
+		encodeCallProblemSilentRemote(encoder, value)
+
+	case *CallProblemDropped:
+		encodeCallProblemDropped(encoder, value)
+
+	case *CallProblemDistortedVideo:
+		encodeCallProblemDistortedVideo(encoder, value)
+
+	case *CallProblemPixelatedVideo:
+		encodeCallProblemPixelatedVideo(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeFirebaseAuthenticationSettings(encoder *encoder, value FirebaseAuthenticationSettings) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *FirebaseAuthenticationSettingsAndroid:
+		encodeFirebaseAuthenticationSettingsAndroid(encoder, value)
+
+	case *FirebaseAuthenticationSettingsIos:
+		encodeFirebaseAuthenticationSettingsIos(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeDiceStickers(encoder *encoder, value DiceStickers) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *DiceStickersRegular:
+		encodeDiceStickersRegular(encoder, value)
+
+	case *DiceStickersSlotMachine:
+		encodeDiceStickersSlotMachine(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeSpeechRecognitionResult(encoder *encoder, value SpeechRecognitionResult) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *SpeechRecognitionResultPending:
+		encodeSpeechRecognitionResultPending(encoder, value)
+
+	case *SpeechRecognitionResultText:
+		encodeSpeechRecognitionResultText(encoder, value)
+
+	case *SpeechRecognitionResultError:
+		encodeSpeechRecognitionResultError(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeInputInlineQueryResult(encoder *encoder, value InputInlineQueryResult) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *InputInlineQueryResultAnimation:
+		encodeInputInlineQueryResultAnimation(encoder, value)
+
+	case *InputInlineQueryResultArticle:
+		encodeInputInlineQueryResultArticle(encoder, value)
+
+	case *InputInlineQueryResultAudio:
+		encodeInputInlineQueryResultAudio(encoder, value)
+
+	case *InputInlineQueryResultContact:
+		encodeInputInlineQueryResultContact(encoder, value)
+
+	case *InputInlineQueryResultDocument:
+		encodeInputInlineQueryResultDocument(encoder, value)
+
+	case *InputInlineQueryResultGame:
+		encodeInputInlineQueryResultGame(encoder, value)
+
+	case *InputInlineQueryResultLocation:
+		encodeInputInlineQueryResultLocation(encoder, value)
+
+	case *InputInlineQueryResultPhoto:
+		encodeInputInlineQueryResultPhoto(encoder, value)
+
+	case *InputInlineQueryResultSticker:
+		encodeInputInlineQueryResultSticker(encoder, value)
+
+	case *InputInlineQueryResultVenue:
+		encodeInputInlineQueryResultVenue(encoder, value)
+
+	case *InputInlineQueryResultVideo:
+		encodeInputInlineQueryResultVideo(encoder, value)
+
+	case *InputInlineQueryResultVoiceNote:
+		encodeInputInlineQueryResultVoiceNote(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeInlineQueryResult(encoder *encoder, value InlineQueryResult) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *InlineQueryResultArticle:
+		encodeInlineQueryResultArticle(encoder, value)
+
+	case *InlineQueryResultContact:
+		encodeInlineQueryResultContact(encoder, value)
+
+	case *InlineQueryResultLocation:
+		encodeInlineQueryResultLocation(encoder, value)
+
+	case *InlineQueryResultVenue:
+		encodeInlineQueryResultVenue(encoder, value)
+
+	case *InlineQueryResultGame:
+		encodeInlineQueryResultGame(encoder, value)
+
+	case *InlineQueryResultAnimation:
+		encodeInlineQueryResultAnimation(encoder, value)
+
+	case *InlineQueryResultAudio:
+		encodeInlineQueryResultAudio(encoder, value)
+
+	case *InlineQueryResultDocument:
+		encodeInlineQueryResultDocument(encoder, value)
+
+	case *InlineQueryResultPhoto:
+		encodeInlineQueryResultPhoto(encoder, value)
+
+	case *InlineQueryResultSticker:
+		encodeInlineQueryResultSticker(encoder, value)
+
+	case *InlineQueryResultVideo:
+		encodeInlineQueryResultVideo(encoder, value)
+
+	case *InlineQueryResultVoiceNote:
+		encodeInlineQueryResultVoiceNote(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeInlineQueryResultsButtonType(encoder *encoder, value InlineQueryResultsButtonType) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *InlineQueryResultsButtonTypeStartBot:
+		encodeInlineQueryResultsButtonTypeStartBot(encoder, value)
+
+	case *InlineQueryResultsButtonTypeWebApp:
+		encodeInlineQueryResultsButtonTypeWebApp(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeCallbackQueryPayload(encoder *encoder, value CallbackQueryPayload) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *CallbackQueryPayloadData:
+		encodeCallbackQueryPayloadData(encoder, value)
+
+	case *CallbackQueryPayloadDataWithPassword:
+		encodeCallbackQueryPayloadDataWithPassword(encoder, value)
+
+	case *CallbackQueryPayloadGame:
+		encodeCallbackQueryPayloadGame(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeChatEventAction(encoder *encoder, value ChatEventAction) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *ChatEventMessageEdited:
+		encodeChatEventMessageEdited(encoder, value)
+
+	case *ChatEventMessageDeleted:
+		encodeChatEventMessageDeleted(encoder, value)
+
+	case *ChatEventMessagePinned:
+		encodeChatEventMessagePinned(encoder, value)
+
+	case *ChatEventMessageUnpinned:
+		encodeChatEventMessageUnpinned(encoder, value)
+
+	case *ChatEventPollStopped:
+		encodeChatEventPollStopped(encoder, value)
+
+	case *ChatEventMemberJoined:
+		encodeChatEventMemberJoined(encoder, value)
+
+	case *ChatEventMemberJoinedByInviteLink:
+		encodeChatEventMemberJoinedByInviteLink(encoder, value)
+
+	case *ChatEventMemberJoinedByRequest:
+		encodeChatEventMemberJoinedByRequest(encoder, value)
+
+	case *ChatEventMemberInvited:
+		encodeChatEventMemberInvited(encoder, value)
+
+	case *ChatEventMemberLeft:
+		encodeChatEventMemberLeft(encoder, value)
+
+	case *ChatEventMemberPromoted:
+		encodeChatEventMemberPromoted(encoder, value)
+
+	case *ChatEventMemberRestricted:
+		encodeChatEventMemberRestricted(encoder, value)
+
+	case *ChatEventAvailableReactionsChanged:
+		encodeChatEventAvailableReactionsChanged(encoder, value)
+
+	case *ChatEventDescriptionChanged:
+		encodeChatEventDescriptionChanged(encoder, value)
+
+	case *ChatEventLinkedChatChanged:
+		encodeChatEventLinkedChatChanged(encoder, value)
+
+	case *ChatEventLocationChanged:
+		encodeChatEventLocationChanged(encoder, value)
+
+	case *ChatEventMessageAutoDeleteTimeChanged:
+		encodeChatEventMessageAutoDeleteTimeChanged(encoder, value)
+
+	case *ChatEventPermissionsChanged:
+		encodeChatEventPermissionsChanged(encoder, value)
+
+	case *ChatEventPhotoChanged:
+		encodeChatEventPhotoChanged(encoder, value)
+
+	case *ChatEventSlowModeDelayChanged:
+		encodeChatEventSlowModeDelayChanged(encoder, value)
+
+	case *ChatEventStickerSetChanged:
+		encodeChatEventStickerSetChanged(encoder, value)
+
+	case *ChatEventTitleChanged:
+		encodeChatEventTitleChanged(encoder, value)
+
+	case *ChatEventUsernameChanged:
+		encodeChatEventUsernameChanged(encoder, value)
+
+	case *ChatEventActiveUsernamesChanged:
+		encodeChatEventActiveUsernamesChanged(encoder, value)
+
+	case *ChatEventHasProtectedContentToggled:
+		encodeChatEventHasProtectedContentToggled(encoder, value)
+
+	case *ChatEventInvitesToggled:
+		encodeChatEventInvitesToggled(encoder, value)
+
+	case *ChatEventIsAllHistoryAvailableToggled:
+		encodeChatEventIsAllHistoryAvailableToggled(encoder, value)
+
+	case *ChatEventHasAggressiveAntiSpamEnabledToggled:
+		encodeChatEventHasAggressiveAntiSpamEnabledToggled(encoder, value)
+
+	case *ChatEventSignMessagesToggled:
+		encodeChatEventSignMessagesToggled(encoder, value)
+
+	case *ChatEventInviteLinkEdited:
+		encodeChatEventInviteLinkEdited(encoder, value)
+
+	case *ChatEventInviteLinkRevoked:
+		encodeChatEventInviteLinkRevoked(encoder, value)
+
+	case *ChatEventInviteLinkDeleted:
+		encodeChatEventInviteLinkDeleted(encoder, value)
+
+	case *ChatEventVideoChatCreated:
+		encodeChatEventVideoChatCreated(encoder, value)
+
+	case *ChatEventVideoChatEnded:
+		encodeChatEventVideoChatEnded(encoder, value)
+
+	case *ChatEventVideoChatMuteNewParticipantsToggled:
+		encodeChatEventVideoChatMuteNewParticipantsToggled(encoder, value)
+
+	case *ChatEventVideoChatParticipantIsMutedToggled:
+		encodeChatEventVideoChatParticipantIsMutedToggled(encoder, value)
+
+	case *ChatEventVideoChatParticipantVolumeLevelChanged:
+		encodeChatEventVideoChatParticipantVolumeLevelChanged(encoder, value)
+
+	case *ChatEventIsForumToggled:
+		encodeChatEventIsForumToggled(encoder, value)
+
+	case *ChatEventForumTopicCreated:
+		encodeChatEventForumTopicCreated(encoder, value)
+
+	case *ChatEventForumTopicEdited:
+		encodeChatEventForumTopicEdited(encoder, value)
+
+	case *ChatEventForumTopicToggleIsClosed:
+		encodeChatEventForumTopicToggleIsClosed(encoder, value)
+
+	case *ChatEventForumTopicToggleIsHidden:
+		encodeChatEventForumTopicToggleIsHidden(encoder, value)
+
+	case *ChatEventForumTopicDeleted:
+		encodeChatEventForumTopicDeleted(encoder, value)
+
+	case *ChatEventForumTopicPinned:
+		encodeChatEventForumTopicPinned(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeLanguagePackStringValue(encoder *encoder, value LanguagePackStringValue) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *LanguagePackStringValueOrdinary:
+		encodeLanguagePackStringValueOrdinary(encoder, value)
+
+	case *LanguagePackStringValuePluralized:
+		encodeLanguagePackStringValuePluralized(encoder, value)
+
+	case *LanguagePackStringValueDeleted:
+		encodeLanguagePackStringValueDeleted(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodePremiumLimitType(encoder *encoder, value PremiumLimitType) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *PremiumLimitTypeSupergroupCount:
+		encodePremiumLimitTypeSupergroupCount(encoder, value)
+
+	case *PremiumLimitTypePinnedChatCount:
+		encodePremiumLimitTypePinnedChatCount(encoder, value)
+
+	case *PremiumLimitTypeCreatedPublicChatCount:
+		encodePremiumLimitTypeCreatedPublicChatCount(encoder, value)
+
+	case *PremiumLimitTypeSavedAnimationCount:
+		encodePremiumLimitTypeSavedAnimationCount(encoder, value)
+
+	case *PremiumLimitTypeFavoriteStickerCount:
+		encodePremiumLimitTypeFavoriteStickerCount(encoder, value)
+
+	case *PremiumLimitTypeChatFolderCount:
+		encodePremiumLimitTypeChatFolderCount(encoder, value)
+
+	case *PremiumLimitTypeChatFolderChosenChatCount:
+		encodePremiumLimitTypeChatFolderChosenChatCount(encoder, value)
+
+	case *PremiumLimitTypePinnedArchivedChatCount:
+		encodePremiumLimitTypePinnedArchivedChatCount(encoder, value)
+
+	case *PremiumLimitTypeCaptionLength:
+		encodePremiumLimitTypeCaptionLength(encoder, value)
+
+	case *PremiumLimitTypeBioLength:
+		encodePremiumLimitTypeBioLength(encoder, value)
+
+	case *PremiumLimitTypeChatFolderInviteLinkCount:
+		encodePremiumLimitTypeChatFolderInviteLinkCount(encoder, value)
+
+	case *PremiumLimitTypeShareableChatFolderCount:
+		encodePremiumLimitTypeShareableChatFolderCount(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodePremiumFeature(encoder *encoder, value PremiumFeature) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *PremiumFeatureIncreasedLimits:
+		encodePremiumFeatureIncreasedLimits(encoder, value)
+
+	case *PremiumFeatureIncreasedUploadFileSize:
+		encodePremiumFeatureIncreasedUploadFileSize(encoder, value)
+
+	case *PremiumFeatureImprovedDownloadSpeed:
+		encodePremiumFeatureImprovedDownloadSpeed(encoder, value)
+
+	case *PremiumFeatureVoiceRecognition:
+		encodePremiumFeatureVoiceRecognition(encoder, value)
+
+	case *PremiumFeatureDisabledAds:
+		encodePremiumFeatureDisabledAds(encoder, value)
+
+	case *PremiumFeatureUniqueReactions:
+		encodePremiumFeatureUniqueReactions(encoder, value)
+
+	case *PremiumFeatureUniqueStickers:
+		encodePremiumFeatureUniqueStickers(encoder, value)
+
+	case *PremiumFeatureCustomEmoji:
+		encodePremiumFeatureCustomEmoji(encoder, value)
+
+	case *PremiumFeatureAdvancedChatManagement:
+		encodePremiumFeatureAdvancedChatManagement(encoder, value)
+
+	case *PremiumFeatureProfileBadge:
+		encodePremiumFeatureProfileBadge(encoder, value)
+
+	case *PremiumFeatureEmojiStatus:
+		encodePremiumFeatureEmojiStatus(encoder, value)
+
+	case *PremiumFeatureAnimatedProfilePhoto:
+		encodePremiumFeatureAnimatedProfilePhoto(encoder, value)
+
+	case *PremiumFeatureForumTopicIcon:
+		encodePremiumFeatureForumTopicIcon(encoder, value)
+
+	case *PremiumFeatureAppIcons:
+		encodePremiumFeatureAppIcons(encoder, value)
+
+	case *PremiumFeatureRealTimeChatTranslation:
+		encodePremiumFeatureRealTimeChatTranslation(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodePremiumSource(encoder *encoder, value PremiumSource) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *PremiumSourceLimitExceeded:
+		encodePremiumSourceLimitExceeded(encoder, value)
+
+	case *PremiumSourceFeature:
+		encodePremiumSourceFeature(encoder, value)
+
+	case *PremiumSourceLink:
+		encodePremiumSourceLink(encoder, value)
+
+	case *PremiumSourceSettings:
+		encodePremiumSourceSettings(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeStorePaymentPurpose(encoder *encoder, value StorePaymentPurpose) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *StorePaymentPurposePremiumSubscription:
+		encodeStorePaymentPurposePremiumSubscription(encoder, value)
+
+	case *StorePaymentPurposeGiftedPremium:
+		encodeStorePaymentPurposeGiftedPremium(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeDeviceToken(encoder *encoder, value DeviceToken) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *DeviceTokenFirebaseCloudMessaging:
+		encodeDeviceTokenFirebaseCloudMessaging(encoder, value)
+
+	case *DeviceTokenApplePush:
+		encodeDeviceTokenApplePush(encoder, value)
+
+	case *DeviceTokenApplePushVoIP:
+		encodeDeviceTokenApplePushVoIP(encoder, value)
+
+	case *DeviceTokenWindowsPush:
+		encodeDeviceTokenWindowsPush(encoder, value)
+
+	case *DeviceTokenMicrosoftPush:
+		encodeDeviceTokenMicrosoftPush(encoder, value)
+
+	case *DeviceTokenMicrosoftPushVoIP:
+		encodeDeviceTokenMicrosoftPushVoIP(encoder, value)
+
+	case *DeviceTokenWebPush:
+		encodeDeviceTokenWebPush(encoder, value)
+
+	case *DeviceTokenSimplePush:
+		encodeDeviceTokenSimplePush(encoder, value)
+
+	case *DeviceTokenUbuntuPush:
+		encodeDeviceTokenUbuntuPush(encoder, value)
+
+	case *DeviceTokenBlackBerryPush:
+		encodeDeviceTokenBlackBerryPush(encoder, value)
+
+	case *DeviceTokenTizenPush:
+		encodeDeviceTokenTizenPush(encoder, value)
+
+	case *DeviceTokenHuaweiPush:
+		encodeDeviceTokenHuaweiPush(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeBackgroundFill(encoder *encoder, value BackgroundFill) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *BackgroundFillSolid:
+		encodeBackgroundFillSolid(encoder, value)
+
+	case *BackgroundFillGradient:
+		encodeBackgroundFillGradient(encoder, value)
+
+	case *BackgroundFillFreeformGradient:
+		encodeBackgroundFillFreeformGradient(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeBackgroundType(encoder *encoder, value BackgroundType) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *BackgroundTypeWallpaper:
+		encodeBackgroundTypeWallpaper(encoder, value)
+
+	case *BackgroundTypePattern:
+		encodeBackgroundTypePattern(encoder, value)
+
+	case *BackgroundTypeFill:
+		encodeBackgroundTypeFill(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeInputBackground(encoder *encoder, value InputBackground) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *InputBackgroundLocal:
+		encodeInputBackgroundLocal(encoder, value)
+
+	case *InputBackgroundRemote:
+		encodeInputBackgroundRemote(encoder, value)
+
+	case *InputBackgroundPrevious:
+		encodeInputBackgroundPrevious(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeCanTransferOwnershipResult(encoder *encoder, value CanTransferOwnershipResult) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *CanTransferOwnershipResultOk:
+		encodeCanTransferOwnershipResultOk(encoder, value)
+
+	case *CanTransferOwnershipResultPasswordNeeded:
+		encodeCanTransferOwnershipResultPasswordNeeded(encoder, value)
+
+	case *CanTransferOwnershipResultPasswordTooFresh:
+		encodeCanTransferOwnershipResultPasswordTooFresh(encoder, value)
+
+	case *CanTransferOwnershipResultSessionTooFresh:
+		encodeCanTransferOwnershipResultSessionTooFresh(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeCheckChatUsernameResult(encoder *encoder, value CheckChatUsernameResult) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *CheckChatUsernameResultOk:
+		encodeCheckChatUsernameResultOk(encoder, value)
+
+	case *CheckChatUsernameResultUsernameInvalid:
+		encodeCheckChatUsernameResultUsernameInvalid(encoder, value)
+
+	case *CheckChatUsernameResultUsernameOccupied:
+		encodeCheckChatUsernameResultUsernameOccupied(encoder, value)
+
+	case *CheckChatUsernameResultUsernamePurchasable:
+		encodeCheckChatUsernameResultUsernamePurchasable(encoder, value)
+
+	case *CheckChatUsernameResultPublicChatsTooMany:
+		encodeCheckChatUsernameResultPublicChatsTooMany(encoder, value)
+
+	case *CheckChatUsernameResultPublicGroupsUnavailable:
+		encodeCheckChatUsernameResultPublicGroupsUnavailable(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeCheckStickerSetNameResult(encoder *encoder, value CheckStickerSetNameResult) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *CheckStickerSetNameResultOk:
+		encodeCheckStickerSetNameResultOk(encoder, value)
+
+	case *CheckStickerSetNameResultNameInvalid:
+		encodeCheckStickerSetNameResultNameInvalid(encoder, value)
+
+	case *CheckStickerSetNameResultNameOccupied:
+		encodeCheckStickerSetNameResultNameOccupied(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeResetPasswordResult(encoder *encoder, value ResetPasswordResult) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *ResetPasswordResultOk:
+		encodeResetPasswordResultOk(encoder, value)
+
+	case *ResetPasswordResultPending:
+		encodeResetPasswordResultPending(encoder, value)
+
+	case *ResetPasswordResultDeclined:
+		encodeResetPasswordResultDeclined(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeMessageFileType(encoder *encoder, value MessageFileType) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *MessageFileTypePrivate:
+		encodeMessageFileTypePrivate(encoder, value)
+
+	case *MessageFileTypeGroup:
+		encodeMessageFileTypeGroup(encoder, value)
+
+	case *MessageFileTypeUnknown:
+		encodeMessageFileTypeUnknown(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodePushMessageContent(encoder *encoder, value PushMessageContent) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *PushMessageContentHidden:
+		encodePushMessageContentHidden(encoder, value)
+
+	case *PushMessageContentAnimation:
+		encodePushMessageContentAnimation(encoder, value)
+
+	case *PushMessageContentAudio:
+		encodePushMessageContentAudio(encoder, value)
+
+	case *PushMessageContentContact:
+		encodePushMessageContentContact(encoder, value)
+
+	case *PushMessageContentContactRegistered:
+		encodePushMessageContentContactRegistered(encoder, value)
+
+	case *PushMessageContentDocument:
+		encodePushMessageContentDocument(encoder, value)
+
+	case *PushMessageContentGame:
+		encodePushMessageContentGame(encoder, value)
+
+	case *PushMessageContentGameScore:
+		encodePushMessageContentGameScore(encoder, value)
+
+	case *PushMessageContentInvoice:
+		encodePushMessageContentInvoice(encoder, value)
+
+	case *PushMessageContentLocation:
+		encodePushMessageContentLocation(encoder, value)
+
+	case *PushMessageContentPhoto:
+		encodePushMessageContentPhoto(encoder, value)
+
+	case *PushMessageContentPoll:
+		encodePushMessageContentPoll(encoder, value)
+
+	case *PushMessageContentScreenshotTaken:
+		encodePushMessageContentScreenshotTaken(encoder, value)
+
+	case *PushMessageContentSticker:
+		encodePushMessageContentSticker(encoder, value)
+
+	case *PushMessageContentText:
+		encodePushMessageContentText(encoder, value)
+
+	case *PushMessageContentVideo:
+		encodePushMessageContentVideo(encoder, value)
+
+	case *PushMessageContentVideoNote:
+		encodePushMessageContentVideoNote(encoder, value)
+
+	case *PushMessageContentVoiceNote:
+		encodePushMessageContentVoiceNote(encoder, value)
+
+	case *PushMessageContentBasicGroupChatCreate:
+		encodePushMessageContentBasicGroupChatCreate(encoder, value)
+
+	case *PushMessageContentChatAddMembers:
+		encodePushMessageContentChatAddMembers(encoder, value)
+
+	case *PushMessageContentChatChangePhoto:
+		encodePushMessageContentChatChangePhoto(encoder, value)
+
+	case *PushMessageContentChatChangeTitle:
+		encodePushMessageContentChatChangeTitle(encoder, value)
+
+	case *PushMessageContentChatSetBackground:
+		encodePushMessageContentChatSetBackground(encoder, value)
+
+	case *PushMessageContentChatSetTheme:
+		encodePushMessageContentChatSetTheme(encoder, value)
+
+	case *PushMessageContentChatDeleteMember:
+		encodePushMessageContentChatDeleteMember(encoder, value)
+
+	case *PushMessageContentChatJoinByLink:
+		encodePushMessageContentChatJoinByLink(encoder, value)
+
+	case *PushMessageContentChatJoinByRequest:
+		encodePushMessageContentChatJoinByRequest(encoder, value)
+
+	case *PushMessageContentRecurringPayment:
+		encodePushMessageContentRecurringPayment(encoder, value)
+
+	case *PushMessageContentSuggestProfilePhoto:
+		encodePushMessageContentSuggestProfilePhoto(encoder, value)
+
+	case *PushMessageContentMessageForwards:
+		encodePushMessageContentMessageForwards(encoder, value)
+
+	case *PushMessageContentMediaAlbum:
+		encodePushMessageContentMediaAlbum(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeNotificationType(encoder *encoder, value NotificationType) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *NotificationTypeNewMessage:
+		encodeNotificationTypeNewMessage(encoder, value)
+
+	case *NotificationTypeNewSecretChat:
+		encodeNotificationTypeNewSecretChat(encoder, value)
+
+	case *NotificationTypeNewCall:
+		encodeNotificationTypeNewCall(encoder, value)
+
+	case *NotificationTypeNewPushMessage:
+		encodeNotificationTypeNewPushMessage(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeNotificationGroupType(encoder *encoder, value NotificationGroupType) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *NotificationGroupTypeMessages:
+		encodeNotificationGroupTypeMessages(encoder, value)
+
+	case *NotificationGroupTypeMentions:
+		encodeNotificationGroupTypeMentions(encoder, value)
+
+	case *NotificationGroupTypeSecretChat:
+		encodeNotificationGroupTypeSecretChat(encoder, value)
+
+	case *NotificationGroupTypeCalls:
+		encodeNotificationGroupTypeCalls(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeOptionValue(encoder *encoder, value OptionValue) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *OptionValueBoolean:
+		encodeOptionValueBoolean(encoder, value)
+
+	case *OptionValueEmpty:
+		encodeOptionValueEmpty(encoder, value)
+
+	case *OptionValueInteger:
+		encodeOptionValueInteger(encoder, value)
+
+	case *OptionValueString:
+		encodeOptionValueString(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeJsonValue(encoder *encoder, value JsonValue) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *JsonValueNull:
+		encodeJsonValueNull(encoder, value)
+
+	case *JsonValueBoolean:
+		encodeJsonValueBoolean(encoder, value)
+
+	case *JsonValueNumber:
+		encodeJsonValueNumber(encoder, value)
+
+	case *JsonValueString:
+		encodeJsonValueString(encoder, value)
+
+	case *JsonValueArray:
+		encodeJsonValueArray(encoder, value)
+
+	case *JsonValueObject:
+		encodeJsonValueObject(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeUserPrivacySettingRule(encoder *encoder, value UserPrivacySettingRule) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *UserPrivacySettingRuleAllowAll:
+		encodeUserPrivacySettingRuleAllowAll(encoder, value)
+
+	case *UserPrivacySettingRuleAllowContacts:
+		encodeUserPrivacySettingRuleAllowContacts(encoder, value)
+
+	case *UserPrivacySettingRuleAllowUsers:
+		encodeUserPrivacySettingRuleAllowUsers(encoder, value)
+
+	case *UserPrivacySettingRuleAllowChatMembers:
+		encodeUserPrivacySettingRuleAllowChatMembers(encoder, value)
+
+	case *UserPrivacySettingRuleRestrictAll:
+		encodeUserPrivacySettingRuleRestrictAll(encoder, value)
+
+	case *UserPrivacySettingRuleRestrictContacts:
+		encodeUserPrivacySettingRuleRestrictContacts(encoder, value)
+
+	case *UserPrivacySettingRuleRestrictUsers:
+		encodeUserPrivacySettingRuleRestrictUsers(encoder, value)
+
+	case *UserPrivacySettingRuleRestrictChatMembers:
+		encodeUserPrivacySettingRuleRestrictChatMembers(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeUserPrivacySetting(encoder *encoder, value UserPrivacySetting) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *UserPrivacySettingShowStatus:
+		encodeUserPrivacySettingShowStatus(encoder, value)
+
+	case *UserPrivacySettingShowProfilePhoto:
+		encodeUserPrivacySettingShowProfilePhoto(encoder, value)
+
+	case *UserPrivacySettingShowLinkInForwardedMessages:
+		encodeUserPrivacySettingShowLinkInForwardedMessages(encoder, value)
+
+	case *UserPrivacySettingShowPhoneNumber:
+		encodeUserPrivacySettingShowPhoneNumber(encoder, value)
+
+	case *UserPrivacySettingAllowChatInvites:
+		encodeUserPrivacySettingAllowChatInvites(encoder, value)
+
+	case *UserPrivacySettingAllowCalls:
+		encodeUserPrivacySettingAllowCalls(encoder, value)
+
+	case *UserPrivacySettingAllowPeerToPeerCalls:
+		encodeUserPrivacySettingAllowPeerToPeerCalls(encoder, value)
+
+	case *UserPrivacySettingAllowFindingByPhoneNumber:
+		encodeUserPrivacySettingAllowFindingByPhoneNumber(encoder, value)
+
+	case *UserPrivacySettingAllowPrivateVoiceAndVideoNoteMessages:
+		encodeUserPrivacySettingAllowPrivateVoiceAndVideoNoteMessages(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeSessionType(encoder *encoder, value SessionType) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *SessionTypeAndroid:
+		encodeSessionTypeAndroid(encoder, value)
+
+	case *SessionTypeApple:
+		encodeSessionTypeApple(encoder, value)
+
+	case *SessionTypeBrave:
+		encodeSessionTypeBrave(encoder, value)
+
+	case *SessionTypeChrome:
+		encodeSessionTypeChrome(encoder, value)
+
+	case *SessionTypeEdge:
+		encodeSessionTypeEdge(encoder, value)
+
+	case *SessionTypeFirefox:
+		encodeSessionTypeFirefox(encoder, value)
+
+	case *SessionTypeIpad:
+		encodeSessionTypeIpad(encoder, value)
+
+	case *SessionTypeIphone:
+		encodeSessionTypeIphone(encoder, value)
+
+	case *SessionTypeLinux:
+		encodeSessionTypeLinux(encoder, value)
+
+	case *SessionTypeMac:
+		encodeSessionTypeMac(encoder, value)
+
+	case *SessionTypeOpera:
+		encodeSessionTypeOpera(encoder, value)
+
+	case *SessionTypeSafari:
+		encodeSessionTypeSafari(encoder, value)
+
+	case *SessionTypeUbuntu:
+		encodeSessionTypeUbuntu(encoder, value)
+
+	case *SessionTypeUnknown:
+		encodeSessionTypeUnknown(encoder, value)
+
+	case *SessionTypeVivaldi:
+		encodeSessionTypeVivaldi(encoder, value)
+
+	case *SessionTypeWindows:
+		encodeSessionTypeWindows(encoder, value)
+
+	case *SessionTypeXbox:
+		encodeSessionTypeXbox(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeChatReportReason(encoder *encoder, value ChatReportReason) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *ChatReportReasonSpam:
+		encodeChatReportReasonSpam(encoder, value)
+
+	case *ChatReportReasonViolence:
+		encodeChatReportReasonViolence(encoder, value)
+
+	case *ChatReportReasonPornography:
+		encodeChatReportReasonPornography(encoder, value)
+
+	case *ChatReportReasonChildAbuse:
+		encodeChatReportReasonChildAbuse(encoder, value)
+
+	case *ChatReportReasonCopyright:
+		encodeChatReportReasonCopyright(encoder, value)
+
+	case *ChatReportReasonUnrelatedLocation:
+		encodeChatReportReasonUnrelatedLocation(encoder, value)
+
+	case *ChatReportReasonFake:
+		encodeChatReportReasonFake(encoder, value)
+
+	case *ChatReportReasonIllegalDrugs:
+		encodeChatReportReasonIllegalDrugs(encoder, value)
+
+	case *ChatReportReasonPersonalDetails:
+		encodeChatReportReasonPersonalDetails(encoder, value)
+
+	case *ChatReportReasonCustom:
+		encodeChatReportReasonCustom(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeTargetChat(encoder *encoder, value TargetChat) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *TargetChatCurrent:
+		encodeTargetChatCurrent(encoder, value)
+
+	case *TargetChatChosen:
+		encodeTargetChatChosen(encoder, value)
+
+	case *TargetChatInternalLink:
+		encodeTargetChatInternalLink(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeInternalLinkType(encoder *encoder, value InternalLinkType) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *InternalLinkTypeActiveSessions:
+		encodeInternalLinkTypeActiveSessions(encoder, value)
+
+	case *InternalLinkTypeAttachmentMenuBot:
+		encodeInternalLinkTypeAttachmentMenuBot(encoder, value)
+
+	case *InternalLinkTypeAuthenticationCode:
+		encodeInternalLinkTypeAuthenticationCode(encoder, value)
+
+	case *InternalLinkTypeBackground:
+		encodeInternalLinkTypeBackground(encoder, value)
+
+	case *InternalLinkTypeBotAddToChannel:
+		encodeInternalLinkTypeBotAddToChannel(encoder, value)
+
+	case *InternalLinkTypeBotStart:
+		encodeInternalLinkTypeBotStart(encoder, value)
+
+	case *InternalLinkTypeBotStartInGroup:
+		encodeInternalLinkTypeBotStartInGroup(encoder, value)
+
+	case *InternalLinkTypeChangePhoneNumber:
+		encodeInternalLinkTypeChangePhoneNumber(encoder, value)
+
+	case *InternalLinkTypeChatFolderInvite:
+		encodeInternalLinkTypeChatFolderInvite(encoder, value)
+
+	case *InternalLinkTypeChatFolderSettings:
+		encodeInternalLinkTypeChatFolderSettings(encoder, value)
+
+	case *InternalLinkTypeChatInvite:
+		encodeInternalLinkTypeChatInvite(encoder, value)
+
+	case *InternalLinkTypeDefaultMessageAutoDeleteTimerSettings:
+		encodeInternalLinkTypeDefaultMessageAutoDeleteTimerSettings(encoder, value)
+
+	case *InternalLinkTypeEditProfileSettings:
+		encodeInternalLinkTypeEditProfileSettings(encoder, value)
+
+	case *InternalLinkTypeGame:
+		encodeInternalLinkTypeGame(encoder, value)
+
+	case *InternalLinkTypeInstantView:
+		encodeInternalLinkTypeInstantView(encoder, value)
+
+	case *InternalLinkTypeInvoice:
+		encodeInternalLinkTypeInvoice(encoder, value)
+
+	case *InternalLinkTypeLanguagePack:
+		encodeInternalLinkTypeLanguagePack(encoder, value)
+
+	case *InternalLinkTypeLanguageSettings:
+		encodeInternalLinkTypeLanguageSettings(encoder, value)
+
+	case *InternalLinkTypeMessage:
+		encodeInternalLinkTypeMessage(encoder, value)
+
+	case *InternalLinkTypeMessageDraft:
+		encodeInternalLinkTypeMessageDraft(encoder, value)
+
+	case *InternalLinkTypePassportDataRequest:
+		encodeInternalLinkTypePassportDataRequest(encoder, value)
+
+	case *InternalLinkTypePhoneNumberConfirmation:
+		encodeInternalLinkTypePhoneNumberConfirmation(encoder, value)
+
+	case *InternalLinkTypePremiumFeatures:
+		encodeInternalLinkTypePremiumFeatures(encoder, value)
+
+	case *InternalLinkTypePrivacyAndSecuritySettings:
+		encodeInternalLinkTypePrivacyAndSecuritySettings(encoder, value)
+
+	case *InternalLinkTypeProxy:
+		encodeInternalLinkTypeProxy(encoder, value)
+
+	case *InternalLinkTypePublicChat:
+		encodeInternalLinkTypePublicChat(encoder, value)
+
+	case *InternalLinkTypeQrCodeAuthentication:
+		encodeInternalLinkTypeQrCodeAuthentication(encoder, value)
+
+	case *InternalLinkTypeRestorePurchases:
+		encodeInternalLinkTypeRestorePurchases(encoder, value)
+
+	case *InternalLinkTypeSettings:
+		encodeInternalLinkTypeSettings(encoder, value)
+
+	case *InternalLinkTypeStickerSet:
+		encodeInternalLinkTypeStickerSet(encoder, value)
+
+	case *InternalLinkTypeTheme:
+		encodeInternalLinkTypeTheme(encoder, value)
+
+	case *InternalLinkTypeThemeSettings:
+		encodeInternalLinkTypeThemeSettings(encoder, value)
+
+	case *InternalLinkTypeUnknownDeepLink:
+		encodeInternalLinkTypeUnknownDeepLink(encoder, value)
+
+	case *InternalLinkTypeUnsupportedProxy:
+		encodeInternalLinkTypeUnsupportedProxy(encoder, value)
+
+	case *InternalLinkTypeUserPhoneNumber:
+		encodeInternalLinkTypeUserPhoneNumber(encoder, value)
+
+	case *InternalLinkTypeUserToken:
+		encodeInternalLinkTypeUserToken(encoder, value)
+
+	case *InternalLinkTypeVideoChat:
+		encodeInternalLinkTypeVideoChat(encoder, value)
+
+	case *InternalLinkTypeWebApp:
+		encodeInternalLinkTypeWebApp(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeFileType(encoder *encoder, value FileType) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *FileTypeNone:
+		encodeFileTypeNone(encoder, value)
+
+	case *FileTypeAnimation:
+		encodeFileTypeAnimation(encoder, value)
+
+	case *FileTypeAudio:
+		encodeFileTypeAudio(encoder, value)
+
+	case *FileTypeDocument:
+		encodeFileTypeDocument(encoder, value)
+
+	case *FileTypeNotificationSound:
+		encodeFileTypeNotificationSound(encoder, value)
+
+	case *FileTypePhoto:
+		encodeFileTypePhoto(encoder, value)
+
+	case *FileTypeProfilePhoto:
+		encodeFileTypeProfilePhoto(encoder, value)
+
+	case *FileTypeSecret:
+		encodeFileTypeSecret(encoder, value)
+
+	case *FileTypeSecretThumbnail:
+		encodeFileTypeSecretThumbnail(encoder, value)
+
+	case *FileTypeSecure:
+		encodeFileTypeSecure(encoder, value)
+
+	case *FileTypeSticker:
+		encodeFileTypeSticker(encoder, value)
+
+	case *FileTypeThumbnail:
+		encodeFileTypeThumbnail(encoder, value)
+
+	case *FileTypeUnknown:
+		encodeFileTypeUnknown(encoder, value)
+
+	case *FileTypeVideo:
+		encodeFileTypeVideo(encoder, value)
+
+	case *FileTypeVideoNote:
+		encodeFileTypeVideoNote(encoder, value)
+
+	case *FileTypeVoiceNote:
+		encodeFileTypeVoiceNote(encoder, value)
+
+	case *FileTypeWallpaper:
+		encodeFileTypeWallpaper(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeNetworkType(encoder *encoder, value NetworkType) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *NetworkTypeNone:
+		encodeNetworkTypeNone(encoder, value)
+
+	case *NetworkTypeMobile:
+		encodeNetworkTypeMobile(encoder, value)
+
+	case *NetworkTypeMobileRoaming:
+		encodeNetworkTypeMobileRoaming(encoder, value)
+
+	case *NetworkTypeWiFi:
+		encodeNetworkTypeWiFi(encoder, value)
+
+	case *NetworkTypeOther:
+		encodeNetworkTypeOther(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeNetworkStatisticsEntry(encoder *encoder, value NetworkStatisticsEntry) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *NetworkStatisticsEntryFile:
+		encodeNetworkStatisticsEntryFile(encoder, value)
+
+	case *NetworkStatisticsEntryCall:
+		encodeNetworkStatisticsEntryCall(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeAutosaveSettingsScope(encoder *encoder, value AutosaveSettingsScope) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *AutosaveSettingsScopePrivateChats:
+		encodeAutosaveSettingsScopePrivateChats(encoder, value)
+
+	case *AutosaveSettingsScopeGroupChats:
+		encodeAutosaveSettingsScopeGroupChats(encoder, value)
+
+	case *AutosaveSettingsScopeChannelChats:
+		encodeAutosaveSettingsScopeChannelChats(encoder, value)
+
+	case *AutosaveSettingsScopeChat:
+		encodeAutosaveSettingsScopeChat(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeConnectionState(encoder *encoder, value ConnectionState) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *ConnectionStateWaitingForNetwork:
+		encodeConnectionStateWaitingForNetwork(encoder, value)
+
+	case *ConnectionStateConnectingToProxy:
+		encodeConnectionStateConnectingToProxy(encoder, value)
+
+	case *ConnectionStateConnecting:
+		encodeConnectionStateConnecting(encoder, value)
+
+	case *ConnectionStateUpdating:
+		encodeConnectionStateUpdating(encoder, value)
+
+	case *ConnectionStateReady:
+		encodeConnectionStateReady(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeTopChatCategory(encoder *encoder, value TopChatCategory) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *TopChatCategoryUsers:
+		encodeTopChatCategoryUsers(encoder, value)
+
+	case *TopChatCategoryBots:
+		encodeTopChatCategoryBots(encoder, value)
+
+	case *TopChatCategoryGroups:
+		encodeTopChatCategoryGroups(encoder, value)
+
+	case *TopChatCategoryChannels:
+		encodeTopChatCategoryChannels(encoder, value)
+
+	case *TopChatCategoryInlineBots:
+		encodeTopChatCategoryInlineBots(encoder, value)
+
+	case *TopChatCategoryCalls:
+		encodeTopChatCategoryCalls(encoder, value)
+
+	case *TopChatCategoryForwardChats:
+		encodeTopChatCategoryForwardChats(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeTMeUrlType(encoder *encoder, value TMeUrlType) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *TMeUrlTypeUser:
+		encodeTMeUrlTypeUser(encoder, value)
+
+	case *TMeUrlTypeSupergroup:
+		encodeTMeUrlTypeSupergroup(encoder, value)
+
+	case *TMeUrlTypeChatInvite:
+		encodeTMeUrlTypeChatInvite(encoder, value)
+
+	case *TMeUrlTypeStickerSet:
+		encodeTMeUrlTypeStickerSet(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeSuggestedAction(encoder *encoder, value SuggestedAction) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *SuggestedActionEnableArchiveAndMuteNewChats:
+		encodeSuggestedActionEnableArchiveAndMuteNewChats(encoder, value)
+
+	case *SuggestedActionCheckPassword:
+		encodeSuggestedActionCheckPassword(encoder, value)
+
+	case *SuggestedActionCheckPhoneNumber:
+		encodeSuggestedActionCheckPhoneNumber(encoder, value)
+
+	case *SuggestedActionViewChecksHint:
+		encodeSuggestedActionViewChecksHint(encoder, value)
+
+	case *SuggestedActionConvertToBroadcastGroup:
+		encodeSuggestedActionConvertToBroadcastGroup(encoder, value)
+
+	case *SuggestedActionSetPassword:
+		encodeSuggestedActionSetPassword(encoder, value)
+
+	case *SuggestedActionUpgradePremium:
+		encodeSuggestedActionUpgradePremium(encoder, value)
+
+	case *SuggestedActionSubscribeToAnnualPremium:
+		encodeSuggestedActionSubscribeToAnnualPremium(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeTextParseMode(encoder *encoder, value TextParseMode) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *TextParseModeMarkdown:
+		encodeTextParseModeMarkdown(encoder, value)
+
+	case *TextParseModeHTML:
+		encodeTextParseModeHTML(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeProxyType(encoder *encoder, value ProxyType) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *ProxyTypeSocks5:
+		encodeProxyTypeSocks5(encoder, value)
+
+	case *ProxyTypeHttp:
+		encodeProxyTypeHttp(encoder, value)
+
+	case *ProxyTypeMtproto:
+		encodeProxyTypeMtproto(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeStatisticalGraph(encoder *encoder, value StatisticalGraph) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *StatisticalGraphData:
+		encodeStatisticalGraphData(encoder, value)
+
+	case *StatisticalGraphAsync:
+		encodeStatisticalGraphAsync(encoder, value)
+
+	case *StatisticalGraphError:
+		encodeStatisticalGraphError(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeChatStatistics(encoder *encoder, value ChatStatistics) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *ChatStatisticsSupergroup:
+		encodeChatStatisticsSupergroup(encoder, value)
+
+	case *ChatStatisticsChannel:
+		encodeChatStatisticsChannel(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeVectorPathCommand(encoder *encoder, value VectorPathCommand) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *VectorPathCommandLine:
+		encodeVectorPathCommandLine(encoder, value)
+
+	case *VectorPathCommandCubicBezierCurve:
+		encodeVectorPathCommandCubicBezierCurve(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeBotCommandScope(encoder *encoder, value BotCommandScope) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *BotCommandScopeDefault:
+		encodeBotCommandScopeDefault(encoder, value)
+
+	case *BotCommandScopeAllPrivateChats:
+		encodeBotCommandScopeAllPrivateChats(encoder, value)
+
+	case *BotCommandScopeAllGroupChats:
+		encodeBotCommandScopeAllGroupChats(encoder, value)
+
+	case *BotCommandScopeAllChatAdministrators:
+		encodeBotCommandScopeAllChatAdministrators(encoder, value)
+
+	case *BotCommandScopeChat:
+		encodeBotCommandScopeChat(encoder, value)
+
+	case *BotCommandScopeChatAdministrators:
+		encodeBotCommandScopeChatAdministrators(encoder, value)
+
+	case *BotCommandScopeChatMember:
+		encodeBotCommandScopeChatMember(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeUpdate(encoder *encoder, value Update) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *UpdateAuthorizationState:
+		encodeUpdateAuthorizationState(encoder, value)
+
+	case *UpdateNewMessage:
+		encodeUpdateNewMessage(encoder, value)
+
+	case *UpdateMessageSendAcknowledged:
+		encodeUpdateMessageSendAcknowledged(encoder, value)
+
+	case *UpdateMessageSendSucceeded:
+		encodeUpdateMessageSendSucceeded(encoder, value)
+
+	case *UpdateMessageSendFailed:
+		encodeUpdateMessageSendFailed(encoder, value)
+
+	case *UpdateMessageContent:
+		encodeUpdateMessageContent(encoder, value)
+
+	case *UpdateMessageEdited:
+		encodeUpdateMessageEdited(encoder, value)
+
+	case *UpdateMessageIsPinned:
+		encodeUpdateMessageIsPinned(encoder, value)
+
+	case *UpdateMessageInteractionInfo:
+		encodeUpdateMessageInteractionInfo(encoder, value)
+
+	case *UpdateMessageContentOpened:
+		encodeUpdateMessageContentOpened(encoder, value)
+
+	case *UpdateMessageMentionRead:
+		encodeUpdateMessageMentionRead(encoder, value)
+
+	case *UpdateMessageUnreadReactions:
+		encodeUpdateMessageUnreadReactions(encoder, value)
+
+	case *UpdateMessageLiveLocationViewed:
+		encodeUpdateMessageLiveLocationViewed(encoder, value)
+
+	case *UpdateNewChat:
+		encodeUpdateNewChat(encoder, value)
+
+	case *UpdateChatTitle:
+		encodeUpdateChatTitle(encoder, value)
+
+	case *UpdateChatPhoto:
+		encodeUpdateChatPhoto(encoder, value)
+
+	case *UpdateChatPermissions:
+		encodeUpdateChatPermissions(encoder, value)
+
+	case *UpdateChatLastMessage:
+		encodeUpdateChatLastMessage(encoder, value)
+
+	case *UpdateChatPosition:
+		encodeUpdateChatPosition(encoder, value)
+
+	case *UpdateChatReadInbox:
+		encodeUpdateChatReadInbox(encoder, value)
+
+	case *UpdateChatReadOutbox:
+		encodeUpdateChatReadOutbox(encoder, value)
+
+	case *UpdateChatActionBar:
+		encodeUpdateChatActionBar(encoder, value)
+
+	case *UpdateChatAvailableReactions:
+		encodeUpdateChatAvailableReactions(encoder, value)
+
+	case *UpdateChatDraftMessage:
+		encodeUpdateChatDraftMessage(encoder, value)
+
+	case *UpdateChatMessageSender:
+		encodeUpdateChatMessageSender(encoder, value)
+
+	case *UpdateChatMessageAutoDeleteTime:
+		encodeUpdateChatMessageAutoDeleteTime(encoder, value)
+
+	case *UpdateChatNotificationSettings:
+		encodeUpdateChatNotificationSettings(encoder, value)
+
+	case *UpdateChatPendingJoinRequests:
+		encodeUpdateChatPendingJoinRequests(encoder, value)
+
+	case *UpdateChatReplyMarkup:
+		encodeUpdateChatReplyMarkup(encoder, value)
+
+	case *UpdateChatBackground:
+		encodeUpdateChatBackground(encoder, value)
+
+	case *UpdateChatTheme:
+		encodeUpdateChatTheme(encoder, value)
+
+	case *UpdateChatUnreadMentionCount:
+		encodeUpdateChatUnreadMentionCount(encoder, value)
+
+	case *UpdateChatUnreadReactionCount:
+		encodeUpdateChatUnreadReactionCount(encoder, value)
+
+	case *UpdateChatVideoChat:
+		encodeUpdateChatVideoChat(encoder, value)
+
+	case *UpdateChatDefaultDisableNotification:
+		encodeUpdateChatDefaultDisableNotification(encoder, value)
+
+	case *UpdateChatHasProtectedContent:
+		encodeUpdateChatHasProtectedContent(encoder, value)
+
+	case *UpdateChatIsTranslatable:
+		encodeUpdateChatIsTranslatable(encoder, value)
+
+	case *UpdateChatIsMarkedAsUnread:
+		encodeUpdateChatIsMarkedAsUnread(encoder, value)
+
+	case *UpdateChatIsBlocked:
+		encodeUpdateChatIsBlocked(encoder, value)
+
+	case *UpdateChatHasScheduledMessages:
+		encodeUpdateChatHasScheduledMessages(encoder, value)
+
+	case *UpdateChatFolders:
+		encodeUpdateChatFolders(encoder, value)
+
+	case *UpdateChatOnlineMemberCount:
+		encodeUpdateChatOnlineMemberCount(encoder, value)
+
+	case *UpdateForumTopicInfo:
+		encodeUpdateForumTopicInfo(encoder, value)
+
+	case *UpdateScopeNotificationSettings:
+		encodeUpdateScopeNotificationSettings(encoder, value)
+
+	case *UpdateNotification:
+		encodeUpdateNotification(encoder, value)
+
+	case *UpdateNotificationGroup:
+		encodeUpdateNotificationGroup(encoder, value)
+
+	case *UpdateActiveNotifications:
+		encodeUpdateActiveNotifications(encoder, value)
+
+	case *UpdateHavePendingNotifications:
+		encodeUpdateHavePendingNotifications(encoder, value)
+
+	case *UpdateDeleteMessages:
+		encodeUpdateDeleteMessages(encoder, value)
+
+	case *UpdateChatAction:
+		encodeUpdateChatAction(encoder, value)
+
+	case *UpdateUserStatus:
+		encodeUpdateUserStatus(encoder, value)
+
+	case *UpdateUser:
+		encodeUpdateUser(encoder, value)
+
+	case *UpdateAccessHash:
+		encodeUpdateAccessHash(encoder, value)
+
+	case *UpdateBasicGroup:
+		encodeUpdateBasicGroup(encoder, value)
+
+	case *UpdateSupergroup:
+		encodeUpdateSupergroup(encoder, value)
+
+	case *UpdateSecretChat:
+		encodeUpdateSecretChat(encoder, value)
+
+	case *UpdateUserFullInfo:
+		encodeUpdateUserFullInfo(encoder, value)
+
+	case *UpdateBasicGroupFullInfo:
+		encodeUpdateBasicGroupFullInfo(encoder, value)
+
+	case *UpdateSupergroupFullInfo:
+		encodeUpdateSupergroupFullInfo(encoder, value)
+
+	case *UpdateServiceNotification:
+		encodeUpdateServiceNotification(encoder, value)
+
+	case *UpdateFile:
+		encodeUpdateFile(encoder, value)
+
+	case *UpdateFileGenerationStart:
+		encodeUpdateFileGenerationStart(encoder, value)
+
+	case *UpdateFileGenerationStop:
+		encodeUpdateFileGenerationStop(encoder, value)
+
+	case *UpdateFileDownloads:
+		encodeUpdateFileDownloads(encoder, value)
+
+	case *UpdateFileAddedToDownloads:
+		encodeUpdateFileAddedToDownloads(encoder, value)
+
+	case *UpdateFileDownload:
+		encodeUpdateFileDownload(encoder, value)
+
+	case *UpdateFileRemovedFromDownloads:
+		encodeUpdateFileRemovedFromDownloads(encoder, value)
+
+	case *UpdateCall:
+		encodeUpdateCall(encoder, value)
+
+	case *UpdateGroupCall:
+		encodeUpdateGroupCall(encoder, value)
+
+	case *UpdateGroupCallParticipant:
+		encodeUpdateGroupCallParticipant(encoder, value)
+
+	case *UpdateNewCallSignalingData:
+		encodeUpdateNewCallSignalingData(encoder, value)
+
+	case *UpdateUserPrivacySettingRules:
+		encodeUpdateUserPrivacySettingRules(encoder, value)
+
+	case *UpdateUnreadMessageCount:
+		encodeUpdateUnreadMessageCount(encoder, value)
+
+	case *UpdateUnreadChatCount:
+		encodeUpdateUnreadChatCount(encoder, value)
+
+	case *UpdateOption:
+		encodeUpdateOption(encoder, value)
+
+	case *UpdateStickerSet:
+		encodeUpdateStickerSet(encoder, value)
+
+	case *UpdateInstalledStickerSets:
+		encodeUpdateInstalledStickerSets(encoder, value)
+
+	case *UpdateTrendingStickerSets:
+		encodeUpdateTrendingStickerSets(encoder, value)
+
+	case *UpdateRecentStickers:
+		encodeUpdateRecentStickers(encoder, value)
+
+	case *UpdateFavoriteStickers:
+		encodeUpdateFavoriteStickers(encoder, value)
+
+	case *UpdateSavedAnimations:
+		encodeUpdateSavedAnimations(encoder, value)
+
+	case *UpdateSavedNotificationSounds:
+		encodeUpdateSavedNotificationSounds(encoder, value)
+
+	case *UpdateSelectedBackground:
+		encodeUpdateSelectedBackground(encoder, value)
+
+	case *UpdateChatThemes:
+		encodeUpdateChatThemes(encoder, value)
+
+	case *UpdateLanguagePackStrings:
+		encodeUpdateLanguagePackStrings(encoder, value)
+
+	case *UpdateConnectionState:
+		encodeUpdateConnectionState(encoder, value)
+
+	case *UpdateTermsOfService:
+		encodeUpdateTermsOfService(encoder, value)
+
+	case *UpdateUsersNearby:
+		encodeUpdateUsersNearby(encoder, value)
+
+	case *UpdateAttachmentMenuBots:
+		encodeUpdateAttachmentMenuBots(encoder, value)
+
+	case *UpdateWebAppMessageSent:
+		encodeUpdateWebAppMessageSent(encoder, value)
+
+	case *UpdateActiveEmojiReactions:
+		encodeUpdateActiveEmojiReactions(encoder, value)
+
+	case *UpdateDefaultReactionType:
+		encodeUpdateDefaultReactionType(encoder, value)
+
+	case *UpdateDiceEmojis:
+		encodeUpdateDiceEmojis(encoder, value)
+
+	case *UpdateAnimatedEmojiMessageClicked:
+		encodeUpdateAnimatedEmojiMessageClicked(encoder, value)
+
+	case *UpdateAnimationSearchParameters:
+		encodeUpdateAnimationSearchParameters(encoder, value)
+
+	case *UpdateSuggestedActions:
+		encodeUpdateSuggestedActions(encoder, value)
+
+	case *UpdateAddChatMembersPrivacyForbidden:
+		encodeUpdateAddChatMembersPrivacyForbidden(encoder, value)
+
+	case *UpdateAutosaveSettings:
+		encodeUpdateAutosaveSettings(encoder, value)
+
+	case *UpdateNewInlineQuery:
+		encodeUpdateNewInlineQuery(encoder, value)
+
+	case *UpdateNewChosenInlineResult:
+		encodeUpdateNewChosenInlineResult(encoder, value)
+
+	case *UpdateNewCallbackQuery:
+		encodeUpdateNewCallbackQuery(encoder, value)
+
+	case *UpdateNewInlineCallbackQuery:
+		encodeUpdateNewInlineCallbackQuery(encoder, value)
+
+	case *UpdateNewShippingQuery:
+		encodeUpdateNewShippingQuery(encoder, value)
+
+	case *UpdateNewPreCheckoutQuery:
+		encodeUpdateNewPreCheckoutQuery(encoder, value)
+
+	case *UpdateNewCustomEvent:
+		encodeUpdateNewCustomEvent(encoder, value)
+
+	case *UpdateNewCustomQuery:
+		encodeUpdateNewCustomQuery(encoder, value)
+
+	case *UpdatePoll:
+		encodeUpdatePoll(encoder, value)
+
+	case *UpdatePollAnswer:
+		encodeUpdatePollAnswer(encoder, value)
+
+	case *UpdateChatMember:
+		encodeUpdateChatMember(encoder, value)
+
+	case *UpdateNewChatJoinRequest:
+		encodeUpdateNewChatJoinRequest(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeLogStream(encoder *encoder, value LogStream) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *LogStreamDefault:
+		encodeLogStreamDefault(encoder, value)
+
+	case *LogStreamFile:
+		encodeLogStreamFile(encoder, value)
+
+	case *LogStreamEmpty:
+		encodeLogStreamEmpty(encoder, value)
+
+	default:
+		// the type missing in the schema keeps its raw JSON
+		encoder.marshaler(value)
+	}
+}
+
+func encodeError(encoder *encoder, value *Error) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (error *Error) encode(encoder *encoder) {
+	encoder.meta(TypeError, error.meta)
+	encoder.Int32("code", error.Code)
+	encoder.String("message", error.Message)
+}
+
+func encodeOk(encoder *encoder, value *Ok) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (ok *Ok) encode(encoder *encoder) {
+	encoder.meta(TypeOk, ok.meta)
+}
+
+func encodeAuthenticationCodeTypeTelegramMessage(encoder *encoder, value *AuthenticationCodeTypeTelegramMessage) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (authenticationCodeTypeTelegramMessage *AuthenticationCodeTypeTelegramMessage) encode(encoder *encoder) {
+	encoder.meta(TypeAuthenticationCodeTypeTelegramMessage, authenticationCodeTypeTelegramMessage.meta)
+	encoder.Int32("length", authenticationCodeTypeTelegramMessage.Length)
+}
+
+func encodeAuthenticationCodeTypeSms(encoder *encoder, value *AuthenticationCodeTypeSms) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (authenticationCodeTypeSms *AuthenticationCodeTypeSms) encode(encoder *encoder) {
+	encoder.meta(TypeAuthenticationCodeTypeSms, authenticationCodeTypeSms.meta)
+	encoder.Int32("length", authenticationCodeTypeSms.Length)
+}
+
+func encodeAuthenticationCodeTypeCall(encoder *encoder, value *AuthenticationCodeTypeCall) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (authenticationCodeTypeCall *AuthenticationCodeTypeCall) encode(encoder *encoder) {
+	encoder.meta(TypeAuthenticationCodeTypeCall, authenticationCodeTypeCall.meta)
+	encoder.Int32("length", authenticationCodeTypeCall.Length)
+}
+
+func encodeAuthenticationCodeTypeFlashCall(encoder *encoder, value *AuthenticationCodeTypeFlashCall) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (authenticationCodeTypeFlashCall *AuthenticationCodeTypeFlashCall) encode(encoder *encoder) {
+	encoder.meta(TypeAuthenticationCodeTypeFlashCall, authenticationCodeTypeFlashCall.meta)
+	encoder.String("pattern", authenticationCodeTypeFlashCall.Pattern)
+}
+
+func encodeAuthenticationCodeTypeMissedCall(encoder *encoder, value *AuthenticationCodeTypeMissedCall) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (authenticationCodeTypeMissedCall *AuthenticationCodeTypeMissedCall) encode(encoder *encoder) {
+	encoder.meta(TypeAuthenticationCodeTypeMissedCall, authenticationCodeTypeMissedCall.meta)
+	encoder.String("phone_number_prefix", authenticationCodeTypeMissedCall.PhoneNumberPrefix)
+	encoder.Int32("length", authenticationCodeTypeMissedCall.Length)
+}
+
+func encodeAuthenticationCodeTypeFragment(encoder *encoder, value *AuthenticationCodeTypeFragment) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (authenticationCodeTypeFragment *AuthenticationCodeTypeFragment) encode(encoder *encoder) {
+	encoder.meta(TypeAuthenticationCodeTypeFragment, authenticationCodeTypeFragment.meta)
+	encoder.String("url", authenticationCodeTypeFragment.Url)
+	encoder.Int32("length", authenticationCodeTypeFragment.Length)
+}
+
+func encodeAuthenticationCodeTypeFirebaseAndroid(encoder *encoder, value *AuthenticationCodeTypeFirebaseAndroid) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (authenticationCodeTypeFirebaseAndroid *AuthenticationCodeTypeFirebaseAndroid) encode(encoder *encoder) {
+	encoder.meta(TypeAuthenticationCodeTypeFirebaseAndroid, authenticationCodeTypeFirebaseAndroid.meta)
+	encoder.Bytes("nonce", authenticationCodeTypeFirebaseAndroid.Nonce)
+	encoder.Int32("length", authenticationCodeTypeFirebaseAndroid.Length)
+}
+
+func encodeAuthenticationCodeTypeFirebaseIos(encoder *encoder, value *AuthenticationCodeTypeFirebaseIos) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (authenticationCodeTypeFirebaseIos *AuthenticationCodeTypeFirebaseIos) encode(encoder *encoder) {
+	encoder.meta(TypeAuthenticationCodeTypeFirebaseIos, authenticationCodeTypeFirebaseIos.meta)
+	encoder.String("receipt", authenticationCodeTypeFirebaseIos.Receipt)
+	encoder.Int32("push_timeout", authenticationCodeTypeFirebaseIos.PushTimeout)
+	encoder.Int32("length", authenticationCodeTypeFirebaseIos.Length)
+}
+
+func encodeAuthenticationCodeInfo(encoder *encoder, value *AuthenticationCodeInfo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (authenticationCodeInfo *AuthenticationCodeInfo) encode(encoder *encoder) {
+	encoder.meta(TypeAuthenticationCodeInfo, authenticationCodeInfo.meta)
+	encoder.String("phone_number", authenticationCodeInfo.PhoneNumber)
+	encoder.name("type")
+	encodeAuthenticationCodeType(encoder, authenticationCodeInfo.Type)
+	encoder.name("next_type")
+	encodeAuthenticationCodeType(encoder, authenticationCodeInfo.NextType)
+	encoder.Int32("timeout", authenticationCodeInfo.Timeout)
+}
+
+func encodeEmailAddressAuthenticationCodeInfo(encoder *encoder, value *EmailAddressAuthenticationCodeInfo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (emailAddressAuthenticationCodeInfo *EmailAddressAuthenticationCodeInfo) encode(encoder *encoder) {
+	encoder.meta(TypeEmailAddressAuthenticationCodeInfo, emailAddressAuthenticationCodeInfo.meta)
+	encoder.String("email_address_pattern", emailAddressAuthenticationCodeInfo.EmailAddressPattern)
+	encoder.Int32("length", emailAddressAuthenticationCodeInfo.Length)
+}
+
+func encodeEmailAddressAuthenticationCode(encoder *encoder, value *EmailAddressAuthenticationCode) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (emailAddressAuthenticationCode *EmailAddressAuthenticationCode) encode(encoder *encoder) {
+	encoder.meta(TypeEmailAddressAuthenticationCode, emailAddressAuthenticationCode.meta)
+	encoder.String("code", emailAddressAuthenticationCode.Code)
+}
+
+func encodeEmailAddressAuthenticationAppleId(encoder *encoder, value *EmailAddressAuthenticationAppleId) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (emailAddressAuthenticationAppleId *EmailAddressAuthenticationAppleId) encode(encoder *encoder) {
+	encoder.meta(TypeEmailAddressAuthenticationAppleId, emailAddressAuthenticationAppleId.meta)
+	encoder.String("token", emailAddressAuthenticationAppleId.Token)
+}
+
+func encodeEmailAddressAuthenticationGoogleId(encoder *encoder, value *EmailAddressAuthenticationGoogleId) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (emailAddressAuthenticationGoogleId *EmailAddressAuthenticationGoogleId) encode(encoder *encoder) {
+	encoder.meta(TypeEmailAddressAuthenticationGoogleId, emailAddressAuthenticationGoogleId.meta)
+	encoder.String("token", emailAddressAuthenticationGoogleId.Token)
+}
+
+func encodeEmailAddressResetStateAvailable(encoder *encoder, value *EmailAddressResetStateAvailable) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (emailAddressResetStateAvailable *EmailAddressResetStateAvailable) encode(encoder *encoder) {
+	encoder.meta(TypeEmailAddressResetStateAvailable, emailAddressResetStateAvailable.meta)
+	encoder.Int32("wait_period", emailAddressResetStateAvailable.WaitPeriod)
+}
+
+func encodeEmailAddressResetStatePending(encoder *encoder, value *EmailAddressResetStatePending) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (emailAddressResetStatePending *EmailAddressResetStatePending) encode(encoder *encoder) {
+	encoder.meta(TypeEmailAddressResetStatePending, emailAddressResetStatePending.meta)
+	encoder.Int32("reset_in", emailAddressResetStatePending.ResetIn)
+}
+
+func encodeTextEntity(encoder *encoder, value *TextEntity) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (textEntity *TextEntity) encode(encoder *encoder) {
+	encoder.meta(TypeTextEntity, textEntity.meta)
+	encoder.Int32("offset", textEntity.Offset)
+	encoder.Int32("length", textEntity.Length)
+	encoder.name("type")
+	encodeTextEntityType(encoder, textEntity.Type)
+}
+
+func encodeTextEntities(encoder *encoder, value *TextEntities) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (textEntities *TextEntities) encode(encoder *encoder) {
+	encoder.meta(TypeTextEntities, textEntities.meta)
+	encoder.name("entities")
+	encodeListOfTextEntity(encoder, textEntities.Entities)
+}
+
+func encodeFormattedText(encoder *encoder, value *FormattedText) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (formattedText *FormattedText) encode(encoder *encoder) {
+	encoder.meta(TypeFormattedText, formattedText.meta)
+	encoder.String("text", formattedText.Text)
+	encoder.name("entities")
+	encodeListOfTextEntity(encoder, formattedText.Entities)
+}
+
+func encodeTermsOfService(encoder *encoder, value *TermsOfService) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (termsOfService *TermsOfService) encode(encoder *encoder) {
+	encoder.meta(TypeTermsOfService, termsOfService.meta)
+	encoder.name("text")
+	encodeFormattedText(encoder, termsOfService.Text)
+	encoder.Int32("min_user_age", termsOfService.MinUserAge)
+	encoder.Bool("show_popup", termsOfService.ShowPopup)
+}
+
+func encodeAuthorizationStateWaitTdlibParameters(encoder *encoder, value *AuthorizationStateWaitTdlibParameters) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (authorizationStateWaitTdlibParameters *AuthorizationStateWaitTdlibParameters) encode(encoder *encoder) {
+	encoder.meta(TypeAuthorizationStateWaitTdlibParameters, authorizationStateWaitTdlibParameters.meta)
+}
+
+func encodeAuthorizationStateWaitPhoneNumber(encoder *encoder, value *AuthorizationStateWaitPhoneNumber) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (authorizationStateWaitPhoneNumber *AuthorizationStateWaitPhoneNumber) encode(encoder *encoder) {
+	encoder.meta(TypeAuthorizationStateWaitPhoneNumber, authorizationStateWaitPhoneNumber.meta)
+}
+
+func encodeAuthorizationStateWaitEmailAddress(encoder *encoder, value *AuthorizationStateWaitEmailAddress) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (authorizationStateWaitEmailAddress *AuthorizationStateWaitEmailAddress) encode(encoder *encoder) {
+	encoder.meta(TypeAuthorizationStateWaitEmailAddress, authorizationStateWaitEmailAddress.meta)
+	encoder.Bool("allow_apple_id", authorizationStateWaitEmailAddress.AllowAppleId)
+	encoder.Bool("allow_google_id", authorizationStateWaitEmailAddress.AllowGoogleId)
+}
+
+func encodeAuthorizationStateWaitEmailCode(encoder *encoder, value *AuthorizationStateWaitEmailCode) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (authorizationStateWaitEmailCode *AuthorizationStateWaitEmailCode) encode(encoder *encoder) {
+	encoder.meta(TypeAuthorizationStateWaitEmailCode, authorizationStateWaitEmailCode.meta)
+	encoder.Bool("allow_apple_id", authorizationStateWaitEmailCode.AllowAppleId)
+	encoder.Bool("allow_google_id", authorizationStateWaitEmailCode.AllowGoogleId)
+	encoder.name("code_info")
+	encodeEmailAddressAuthenticationCodeInfo(encoder, authorizationStateWaitEmailCode.CodeInfo)
+	encoder.name("email_address_reset_state")
+	encodeEmailAddressResetState(encoder, authorizationStateWaitEmailCode.EmailAddressResetState)
+}
+
+func encodeAuthorizationStateWaitCode(encoder *encoder, value *AuthorizationStateWaitCode) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (authorizationStateWaitCode *AuthorizationStateWaitCode) encode(encoder *encoder) {
+	encoder.meta(TypeAuthorizationStateWaitCode, authorizationStateWaitCode.meta)
+	encoder.name("code_info")
+	encodeAuthenticationCodeInfo(encoder, authorizationStateWaitCode.CodeInfo)
+}
+
+func encodeAuthorizationStateWaitOtherDeviceConfirmation(encoder *encoder, value *AuthorizationStateWaitOtherDeviceConfirmation) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (authorizationStateWaitOtherDeviceConfirmation *AuthorizationStateWaitOtherDeviceConfirmation) encode(encoder *encoder) {
+	encoder.meta(TypeAuthorizationStateWaitOtherDeviceConfirmation, authorizationStateWaitOtherDeviceConfirmation.meta)
+	encoder.String("link", authorizationStateWaitOtherDeviceConfirmation.Link)
+}
+
+func encodeAuthorizationStateWaitRegistration(encoder *encoder, value *AuthorizationStateWaitRegistration) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (authorizationStateWaitRegistration *AuthorizationStateWaitRegistration) encode(encoder *encoder) {
+	encoder.meta(TypeAuthorizationStateWaitRegistration, authorizationStateWaitRegistration.meta)
+	encoder.name("terms_of_service")
+	encodeTermsOfService(encoder, authorizationStateWaitRegistration.TermsOfService)
+}
+
+func encodeAuthorizationStateWaitPassword(encoder *encoder, value *AuthorizationStateWaitPassword) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (authorizationStateWaitPassword *AuthorizationStateWaitPassword) encode(encoder *encoder) {
+	encoder.meta(TypeAuthorizationStateWaitPassword, authorizationStateWaitPassword.meta)
+	encoder.String("password_hint", authorizationStateWaitPassword.PasswordHint)
+	encoder.Bool("has_recovery_email_address", authorizationStateWaitPassword.HasRecoveryEmailAddress)
+	encoder.Bool("has_passport_data", authorizationStateWaitPassword.HasPassportData)
+	encoder.String("recovery_email_address_pattern", authorizationStateWaitPassword.RecoveryEmailAddressPattern)
+}
+
+func encodeAuthorizationStateReady(encoder *encoder, value *AuthorizationStateReady) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (authorizationStateReady *AuthorizationStateReady) encode(encoder *encoder) {
+	encoder.meta(TypeAuthorizationStateReady, authorizationStateReady.meta)
+}
+
+func encodeAuthorizationStateLoggingOut(encoder *encoder, value *AuthorizationStateLoggingOut) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (authorizationStateLoggingOut *AuthorizationStateLoggingOut) encode(encoder *encoder) {
+	encoder.meta(TypeAuthorizationStateLoggingOut, authorizationStateLoggingOut.meta)
+}
+
+func encodeAuthorizationStateClosing(encoder *encoder, value *AuthorizationStateClosing) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (authorizationStateClosing *AuthorizationStateClosing) encode(encoder *encoder) {
+	encoder.meta(TypeAuthorizationStateClosing, authorizationStateClosing.meta)
+}
+
+func encodeAuthorizationStateClosed(encoder *encoder, value *AuthorizationStateClosed) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (authorizationStateClosed *AuthorizationStateClosed) encode(encoder *encoder) {
+	encoder.meta(TypeAuthorizationStateClosed, authorizationStateClosed.meta)
+}
+
+func encodePasswordState(encoder *encoder, value *PasswordState) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (passwordState *PasswordState) encode(encoder *encoder) {
+	encoder.meta(TypePasswordState, passwordState.meta)
+	encoder.Bool("has_password", passwordState.HasPassword)
+	encoder.String("password_hint", passwordState.PasswordHint)
+	encoder.Bool("has_recovery_email_address", passwordState.HasRecoveryEmailAddress)
+	encoder.Bool("has_passport_data", passwordState.HasPassportData)
+	encoder.name("recovery_email_address_code_info")
+	encodeEmailAddressAuthenticationCodeInfo(encoder, passwordState.RecoveryEmailAddressCodeInfo)
+	encoder.String("login_email_address_pattern", passwordState.LoginEmailAddressPattern)
+	encoder.Int32("pending_reset_date", passwordState.PendingResetDate)
+}
+
+func encodeRecoveryEmailAddress(encoder *encoder, value *RecoveryEmailAddress) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (recoveryEmailAddress *RecoveryEmailAddress) encode(encoder *encoder) {
+	encoder.meta(TypeRecoveryEmailAddress, recoveryEmailAddress.meta)
+	encoder.String("recovery_email_address", recoveryEmailAddress.RecoveryEmailAddress)
+}
+
+func encodeTemporaryPasswordState(encoder *encoder, value *TemporaryPasswordState) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (temporaryPasswordState *TemporaryPasswordState) encode(encoder *encoder) {
+	encoder.meta(TypeTemporaryPasswordState, temporaryPasswordState.meta)
+	encoder.Bool("has_password", temporaryPasswordState.HasPassword)
+	encoder.Int32("valid_for", temporaryPasswordState.ValidFor)
+}
+
+func encodeLocalFile(encoder *encoder, value *LocalFile) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (localFile *LocalFile) encode(encoder *encoder) {
+	encoder.meta(TypeLocalFile, localFile.meta)
+	encoder.String("path", localFile.Path)
+	encoder.Bool("can_be_downloaded", localFile.CanBeDownloaded)
+	encoder.Bool("can_be_deleted", localFile.CanBeDeleted)
+	encoder.Bool("is_downloading_active", localFile.IsDownloadingActive)
+	encoder.Bool("is_downloading_completed", localFile.IsDownloadingCompleted)
+	encoder.Int64("download_offset", localFile.DownloadOffset)
+	encoder.Int64("downloaded_prefix_size", localFile.DownloadedPrefixSize)
+	encoder.Int64("downloaded_size", localFile.DownloadedSize)
+}
+
+func encodeRemoteFile(encoder *encoder, value *RemoteFile) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (remoteFile *RemoteFile) encode(encoder *encoder) {
+	encoder.meta(TypeRemoteFile, remoteFile.meta)
+	encoder.String("id", remoteFile.Id)
+	encoder.String("unique_id", remoteFile.UniqueId)
+	encoder.Bool("is_uploading_active", remoteFile.IsUploadingActive)
+	encoder.Bool("is_uploading_completed", remoteFile.IsUploadingCompleted)
+	encoder.Int64("uploaded_size", remoteFile.UploadedSize)
+}
+
+func encodeFile(encoder *encoder, value *File) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (file *File) encode(encoder *encoder) {
+	encoder.meta(TypeFile, file.meta)
+	encoder.Int32("id", file.Id)
+	encoder.Int64("size", file.Size)
+	encoder.Int64("expected_size", file.ExpectedSize)
+	encoder.name("local")
+	encodeLocalFile(encoder, file.Local)
+	encoder.name("remote")
+	encodeRemoteFile(encoder, file.Remote)
+}
+
+func encodeInputFileId(encoder *encoder, value *InputFileId) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputFileId *InputFileId) encode(encoder *encoder) {
+	encoder.meta(TypeInputFileId, inputFileId.meta)
+	encoder.Int32("id", inputFileId.Id)
+}
+
+func encodeInputFileRemote(encoder *encoder, value *InputFileRemote) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputFileRemote *InputFileRemote) encode(encoder *encoder) {
+	encoder.meta(TypeInputFileRemote, inputFileRemote.meta)
+	encoder.String("id", inputFileRemote.Id)
+}
+
+func encodeInputFileLocal(encoder *encoder, value *InputFileLocal) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputFileLocal *InputFileLocal) encode(encoder *encoder) {
+	encoder.meta(TypeInputFileLocal, inputFileLocal.meta)
+	encoder.String("path", inputFileLocal.Path)
+}
+
+func encodeInputFileGenerated(encoder *encoder, value *InputFileGenerated) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputFileGenerated *InputFileGenerated) encode(encoder *encoder) {
+	encoder.meta(TypeInputFileGenerated, inputFileGenerated.meta)
+	encoder.String("original_path", inputFileGenerated.OriginalPath)
+	encoder.String("conversion", inputFileGenerated.Conversion)
+	encoder.Int64("expected_size", inputFileGenerated.ExpectedSize)
+}
+
+func encodePhotoSize(encoder *encoder, value *PhotoSize) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (photoSize *PhotoSize) encode(encoder *encoder) {
+	encoder.meta(TypePhotoSize, photoSize.meta)
+	encoder.String("type", photoSize.Type)
+	encoder.name("photo")
+	encodeFile(encoder, photoSize.Photo)
+	encoder.Int32("width", photoSize.Width)
+	encoder.Int32("height", photoSize.Height)
+	encoder.Int32s("progressive_sizes", photoSize.ProgressiveSizes)
+}
+
+func encodeMinithumbnail(encoder *encoder, value *Minithumbnail) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (minithumbnail *Minithumbnail) encode(encoder *encoder) {
+	encoder.meta(TypeMinithumbnail, minithumbnail.meta)
+	encoder.Int32("width", minithumbnail.Width)
+	encoder.Int32("height", minithumbnail.Height)
+	encoder.Bytes("data", minithumbnail.Data)
+}
+
+func encodeThumbnailFormatJpeg(encoder *encoder, value *ThumbnailFormatJpeg) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (thumbnailFormatJpeg *ThumbnailFormatJpeg) encode(encoder *encoder) {
+	encoder.meta(TypeThumbnailFormatJpeg, thumbnailFormatJpeg.meta)
+}
+
+func encodeThumbnailFormatGif(encoder *encoder, value *ThumbnailFormatGif) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (thumbnailFormatGif *ThumbnailFormatGif) encode(encoder *encoder) {
+	encoder.meta(TypeThumbnailFormatGif, thumbnailFormatGif.meta)
+}
+
+func encodeThumbnailFormatMpeg4(encoder *encoder, value *ThumbnailFormatMpeg4) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (thumbnailFormatMpeg4 *ThumbnailFormatMpeg4) encode(encoder *encoder) {
+	encoder.meta(TypeThumbnailFormatMpeg4, thumbnailFormatMpeg4.meta)
+}
+
+func encodeThumbnailFormatPng(encoder *encoder, value *ThumbnailFormatPng) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (thumbnailFormatPng *ThumbnailFormatPng) encode(encoder *encoder) {
+	encoder.meta(TypeThumbnailFormatPng, thumbnailFormatPng.meta)
+}
+
+func encodeThumbnailFormatTgs(encoder *encoder, value *ThumbnailFormatTgs) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (thumbnailFormatTgs *ThumbnailFormatTgs) encode(encoder *encoder) {
+	encoder.meta(TypeThumbnailFormatTgs, thumbnailFormatTgs.meta)
+}
+
+func encodeThumbnailFormatWebm(encoder *encoder, value *ThumbnailFormatWebm) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (thumbnailFormatWebm *ThumbnailFormatWebm) encode(encoder *encoder) {
+	encoder.meta(TypeThumbnailFormatWebm, thumbnailFormatWebm.meta)
+}
+
+func encodeThumbnailFormatWebp(encoder *encoder, value *ThumbnailFormatWebp) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (thumbnailFormatWebp *ThumbnailFormatWebp) encode(encoder *encoder) {
+	encoder.meta(TypeThumbnailFormatWebp, thumbnailFormatWebp.meta)
+}
+
+func encodeThumbnail(encoder *encoder, value *Thumbnail) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (thumbnail *Thumbnail) encode(encoder *encoder) {
+	encoder.meta(TypeThumbnail, thumbnail.meta)
+	encoder.name("format")
+	encodeThumbnailFormat(encoder, thumbnail.Format)
+	encoder.Int32("width", thumbnail.Width)
+	encoder.Int32("height", thumbnail.Height)
+	encoder.name("file")
+	encodeFile(encoder, thumbnail.File)
+}
+
+func encodeMaskPointForehead(encoder *encoder, value *MaskPointForehead) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (maskPointForehead *MaskPointForehead) encode(encoder *encoder) {
+	encoder.meta(TypeMaskPointForehead, maskPointForehead.meta)
+}
+
+func encodeMaskPointEyes(encoder *encoder, value *MaskPointEyes) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (maskPointEyes *MaskPointEyes) encode(encoder *encoder) {
+	encoder.meta(TypeMaskPointEyes, maskPointEyes.meta)
+}
+
+func encodeMaskPointMouth(encoder *encoder, value *MaskPointMouth) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (maskPointMouth *MaskPointMouth) encode(encoder *encoder) {
+	encoder.meta(TypeMaskPointMouth, maskPointMouth.meta)
+}
+
+func encodeMaskPointChin(encoder *encoder, value *MaskPointChin) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (maskPointChin *MaskPointChin) encode(encoder *encoder) {
+	encoder.meta(TypeMaskPointChin, maskPointChin.meta)
+}
+
+func encodeMaskPosition(encoder *encoder, value *MaskPosition) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (maskPosition *MaskPosition) encode(encoder *encoder) {
+	encoder.meta(TypeMaskPosition, maskPosition.meta)
+	encoder.name("point")
+	encodeMaskPoint(encoder, maskPosition.Point)
+	encoder.Float64("x_shift", maskPosition.XShift)
+	encoder.Float64("y_shift", maskPosition.YShift)
+	encoder.Float64("scale", maskPosition.Scale)
+}
+
+func encodeStickerFormatWebp(encoder *encoder, value *StickerFormatWebp) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (stickerFormatWebp *StickerFormatWebp) encode(encoder *encoder) {
+	encoder.meta(TypeStickerFormatWebp, stickerFormatWebp.meta)
+}
+
+func encodeStickerFormatTgs(encoder *encoder, value *StickerFormatTgs) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (stickerFormatTgs *StickerFormatTgs) encode(encoder *encoder) {
+	encoder.meta(TypeStickerFormatTgs, stickerFormatTgs.meta)
+}
+
+func encodeStickerFormatWebm(encoder *encoder, value *StickerFormatWebm) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (stickerFormatWebm *StickerFormatWebm) encode(encoder *encoder) {
+	encoder.meta(TypeStickerFormatWebm, stickerFormatWebm.meta)
+}
+
+func encodeStickerTypeRegular(encoder *encoder, value *StickerTypeRegular) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (stickerTypeRegular *StickerTypeRegular) encode(encoder *encoder) {
+	encoder.meta(TypeStickerTypeRegular, stickerTypeRegular.meta)
+}
+
+func encodeStickerTypeMask(encoder *encoder, value *StickerTypeMask) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (stickerTypeMask *StickerTypeMask) encode(encoder *encoder) {
+	encoder.meta(TypeStickerTypeMask, stickerTypeMask.meta)
+}
+
+func encodeStickerTypeCustomEmoji(encoder *encoder, value *StickerTypeCustomEmoji) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (stickerTypeCustomEmoji *StickerTypeCustomEmoji) encode(encoder *encoder) {
+	encoder.meta(TypeStickerTypeCustomEmoji, stickerTypeCustomEmoji.meta)
+}
+
+func encodeStickerFullTypeRegular(encoder *encoder, value *StickerFullTypeRegular) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (stickerFullTypeRegular *StickerFullTypeRegular) encode(encoder *encoder) {
+	encoder.meta(TypeStickerFullTypeRegular, stickerFullTypeRegular.meta)
+	encoder.name("premium_animation")
+	encodeFile(encoder, stickerFullTypeRegular.PremiumAnimation)
+}
+
+func encodeStickerFullTypeMask(encoder *encoder, value *StickerFullTypeMask) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (stickerFullTypeMask *StickerFullTypeMask) encode(encoder *encoder) {
+	encoder.meta(TypeStickerFullTypeMask, stickerFullTypeMask.meta)
+	encoder.name("mask_position")
+	encodeMaskPosition(encoder, stickerFullTypeMask.MaskPosition)
+}
+
+func encodeStickerFullTypeCustomEmoji(encoder *encoder, value *StickerFullTypeCustomEmoji) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (stickerFullTypeCustomEmoji *StickerFullTypeCustomEmoji) encode(encoder *encoder) {
+	encoder.meta(TypeStickerFullTypeCustomEmoji, stickerFullTypeCustomEmoji.meta)
+	encoder.JsonInt64("custom_emoji_id", stickerFullTypeCustomEmoji.CustomEmojiId)
+	encoder.Bool("needs_repainting", stickerFullTypeCustomEmoji.NeedsRepainting)
+}
+
+func encodeClosedVectorPath(encoder *encoder, value *ClosedVectorPath) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (closedVectorPath *ClosedVectorPath) encode(encoder *encoder) {
+	encoder.meta(TypeClosedVectorPath, closedVectorPath.meta)
+	encoder.name("commands")
+	encodeListOfVectorPathCommand(encoder, closedVectorPath.Commands)
+}
+
+func encodePollOption(encoder *encoder, value *PollOption) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pollOption *PollOption) encode(encoder *encoder) {
+	encoder.meta(TypePollOption, pollOption.meta)
+	encoder.String("text", pollOption.Text)
+	encoder.Int32("voter_count", pollOption.VoterCount)
+	encoder.Int32("vote_percentage", pollOption.VotePercentage)
+	encoder.Bool("is_chosen", pollOption.IsChosen)
+	encoder.Bool("is_being_chosen", pollOption.IsBeingChosen)
+}
+
+func encodePollTypeRegular(encoder *encoder, value *PollTypeRegular) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pollTypeRegular *PollTypeRegular) encode(encoder *encoder) {
+	encoder.meta(TypePollTypeRegular, pollTypeRegular.meta)
+	encoder.Bool("allow_multiple_answers", pollTypeRegular.AllowMultipleAnswers)
+}
+
+func encodePollTypeQuiz(encoder *encoder, value *PollTypeQuiz) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pollTypeQuiz *PollTypeQuiz) encode(encoder *encoder) {
+	encoder.meta(TypePollTypeQuiz, pollTypeQuiz.meta)
+	encoder.Int32("correct_option_id", pollTypeQuiz.CorrectOptionId)
+	encoder.name("explanation")
+	encodeFormattedText(encoder, pollTypeQuiz.Explanation)
+}
+
+func encodeAnimation(encoder *encoder, value *Animation) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (animation *Animation) encode(encoder *encoder) {
+	encoder.meta(TypeAnimation, animation.meta)
+	encoder.Int32("duration", animation.Duration)
+	encoder.Int32("width", animation.Width)
+	encoder.Int32("height", animation.Height)
+	encoder.String("file_name", animation.FileName)
+	encoder.String("mime_type", animation.MimeType)
+	encoder.Bool("has_stickers", animation.HasStickers)
+	encoder.name("minithumbnail")
+	encodeMinithumbnail(encoder, animation.Minithumbnail)
+	encoder.name("thumbnail")
+	encodeThumbnail(encoder, animation.Thumbnail)
+	encoder.name("animation")
+	encodeFile(encoder, animation.Animation)
+}
+
+func encodeAudio(encoder *encoder, value *Audio) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (audio *Audio) encode(encoder *encoder) {
+	encoder.meta(TypeAudio, audio.meta)
+	encoder.Int32("duration", audio.Duration)
+	encoder.String("title", audio.Title)
+	encoder.String("performer", audio.Performer)
+	encoder.String("file_name", audio.FileName)
+	encoder.String("mime_type", audio.MimeType)
+	encoder.name("album_cover_minithumbnail")
+	encodeMinithumbnail(encoder, audio.AlbumCoverMinithumbnail)
+	encoder.name("album_cover_thumbnail")
+	encodeThumbnail(encoder, audio.AlbumCoverThumbnail)
+	encoder.name("external_album_covers")
+	encodeListOfThumbnail(encoder, audio.ExternalAlbumCovers)
+	encoder.name("audio")
+	encodeFile(encoder, audio.Audio)
+}
+
+func encodeDocument(encoder *encoder, value *Document) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (document *Document) encode(encoder *encoder) {
+	encoder.meta(TypeDocument, document.meta)
+	encoder.String("file_name", document.FileName)
+	encoder.String("mime_type", document.MimeType)
+	encoder.name("minithumbnail")
+	encodeMinithumbnail(encoder, document.Minithumbnail)
+	encoder.name("thumbnail")
+	encodeThumbnail(encoder, document.Thumbnail)
+	encoder.name("document")
+	encodeFile(encoder, document.Document)
+}
+
+func encodePhoto(encoder *encoder, value *Photo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (photo *Photo) encode(encoder *encoder) {
+	encoder.meta(TypePhoto, photo.meta)
+	encoder.Bool("has_stickers", photo.HasStickers)
+	encoder.name("minithumbnail")
+	encodeMinithumbnail(encoder, photo.Minithumbnail)
+	encoder.name("sizes")
+	encodeListOfPhotoSize(encoder, photo.Sizes)
+}
+
+func encodeSticker(encoder *encoder, value *Sticker) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (sticker *Sticker) encode(encoder *encoder) {
+	encoder.meta(TypeSticker, sticker.meta)
+	encoder.JsonInt64("id", sticker.Id)
+	encoder.JsonInt64("set_id", sticker.SetId)
+	encoder.Int32("width", sticker.Width)
+	encoder.Int32("height", sticker.Height)
+	encoder.String("emoji", sticker.Emoji)
+	encoder.name("format")
+	encodeStickerFormat(encoder, sticker.Format)
+	encoder.name("full_type")
+	encodeStickerFullType(encoder, sticker.FullType)
+	encoder.name("outline")
+	encodeListOfClosedVectorPath(encoder, sticker.Outline)
+	encoder.name("thumbnail")
+	encodeThumbnail(encoder, sticker.Thumbnail)
+	encoder.name("sticker")
+	encodeFile(encoder, sticker.Sticker)
+}
+
+func encodeVideo(encoder *encoder, value *Video) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (video *Video) encode(encoder *encoder) {
+	encoder.meta(TypeVideo, video.meta)
+	encoder.Int32("duration", video.Duration)
+	encoder.Int32("width", video.Width)
+	encoder.Int32("height", video.Height)
+	encoder.String("file_name", video.FileName)
+	encoder.String("mime_type", video.MimeType)
+	encoder.Bool("has_stickers", video.HasStickers)
+	encoder.Bool("supports_streaming", video.SupportsStreaming)
+	encoder.name("minithumbnail")
+	encodeMinithumbnail(encoder, video.Minithumbnail)
+	encoder.name("thumbnail")
+	encodeThumbnail(encoder, video.Thumbnail)
+	encoder.name("video")
+	encodeFile(encoder, video.Video)
+}
+
+func encodeVideoNote(encoder *encoder, value *VideoNote) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (videoNote *VideoNote) encode(encoder *encoder) {
+	encoder.meta(TypeVideoNote, videoNote.meta)
+	encoder.Int32("duration", videoNote.Duration)
+	encoder.Bytes("waveform", videoNote.Waveform)
+	encoder.Int32("length", videoNote.Length)
+	encoder.name("minithumbnail")
+	encodeMinithumbnail(encoder, videoNote.Minithumbnail)
+	encoder.name("thumbnail")
+	encodeThumbnail(encoder, videoNote.Thumbnail)
+	encoder.name("speech_recognition_result")
+	encodeSpeechRecognitionResult(encoder, videoNote.SpeechRecognitionResult)
+	encoder.name("video")
+	encodeFile(encoder, videoNote.Video)
+}
+
+func encodeVoiceNote(encoder *encoder, value *VoiceNote) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (voiceNote *VoiceNote) encode(encoder *encoder) {
+	encoder.meta(TypeVoiceNote, voiceNote.meta)
+	encoder.Int32("duration", voiceNote.Duration)
+	encoder.Bytes("waveform", voiceNote.Waveform)
+	encoder.String("mime_type", voiceNote.MimeType)
+	encoder.name("speech_recognition_result")
+	encodeSpeechRecognitionResult(encoder, voiceNote.SpeechRecognitionResult)
+	encoder.name("voice")
+	encodeFile(encoder, voiceNote.Voice)
+}
+
+func encodeAnimatedEmoji(encoder *encoder, value *AnimatedEmoji) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (animatedEmoji *AnimatedEmoji) encode(encoder *encoder) {
+	encoder.meta(TypeAnimatedEmoji, animatedEmoji.meta)
+	encoder.name("sticker")
+	encodeSticker(encoder, animatedEmoji.Sticker)
+	encoder.Int32("sticker_width", animatedEmoji.StickerWidth)
+	encoder.Int32("sticker_height", animatedEmoji.StickerHeight)
+	encoder.Int32("fitzpatrick_type", animatedEmoji.FitzpatrickType)
+	encoder.name("sound")
+	encodeFile(encoder, animatedEmoji.Sound)
+}
+
+func encodeContact(encoder *encoder, value *Contact) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (contact *Contact) encode(encoder *encoder) {
+	encoder.meta(TypeContact, contact.meta)
+	encoder.String("phone_number", contact.PhoneNumber)
+	encoder.String("first_name", contact.FirstName)
+	encoder.String("last_name", contact.LastName)
+	encoder.String("vcard", contact.Vcard)
+	encoder.Int64("user_id", contact.UserId)
+}
+
+func encodeLocation(encoder *encoder, value *Location) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (location *Location) encode(encoder *encoder) {
+	encoder.meta(TypeLocation, location.meta)
+	encoder.Float64("latitude", location.Latitude)
+	encoder.Float64("longitude", location.Longitude)
+	encoder.Float64("horizontal_accuracy", location.HorizontalAccuracy)
+}
+
+func encodeVenue(encoder *encoder, value *Venue) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (venue *Venue) encode(encoder *encoder) {
+	encoder.meta(TypeVenue, venue.meta)
+	encoder.name("location")
+	encodeLocation(encoder, venue.Location)
+	encoder.String("title", venue.Title)
+	encoder.String("address", venue.Address)
+	encoder.String("provider", venue.Provider)
+	encoder.String("id", venue.Id)
+	encoder.String("type", venue.Type)
+}
+
+func encodeGame(encoder *encoder, value *Game) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (game *Game) encode(encoder *encoder) {
+	encoder.meta(TypeGame, game.meta)
+	encoder.JsonInt64("id", game.Id)
+	encoder.String("short_name", game.ShortName)
+	encoder.String("title", game.Title)
+	encoder.name("text")
+	encodeFormattedText(encoder, game.Text)
+	encoder.String("description", game.Description)
+	encoder.name("photo")
+	encodePhoto(encoder, game.Photo)
+	encoder.name("animation")
+	encodeAnimation(encoder, game.Animation)
+}
+
+func encodeWebApp(encoder *encoder, value *WebApp) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (webApp *WebApp) encode(encoder *encoder) {
+	encoder.meta(TypeWebApp, webApp.meta)
+	encoder.String("short_name", webApp.ShortName)
+	encoder.String("title", webApp.Title)
+	encoder.String("description", webApp.Description)
+	encoder.name("photo")
+	encodePhoto(encoder, webApp.Photo)
+	encoder.name("animation")
+	encodeAnimation(encoder, webApp.Animation)
+}
+
+func encodePoll(encoder *encoder, value *Poll) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (poll *Poll) encode(encoder *encoder) {
+	encoder.meta(TypePoll, poll.meta)
+	encoder.JsonInt64("id", poll.Id)
+	encoder.String("question", poll.Question)
+	encoder.name("options")
+	encodeListOfPollOption(encoder, poll.Options)
+	encoder.Int32("total_voter_count", poll.TotalVoterCount)
+	encoder.Int64s("recent_voter_user_ids", poll.RecentVoterUserIds)
+	encoder.Bool("is_anonymous", poll.IsAnonymous)
+	encoder.name("type")
+	encodePollType(encoder, poll.Type)
+	encoder.Int32("open_period", poll.OpenPeriod)
+	encoder.Int32("close_date", poll.CloseDate)
+	encoder.Bool("is_closed", poll.IsClosed)
+}
+
+func encodeBackground(encoder *encoder, value *Background) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (background *Background) encode(encoder *encoder) {
+	encoder.meta(TypeBackground, background.meta)
+	encoder.JsonInt64("id", background.Id)
+	encoder.Bool("is_default", background.IsDefault)
+	encoder.Bool("is_dark", background.IsDark)
+	encoder.String("name", background.Name)
+	encoder.name("document")
+	encodeDocument(encoder, background.Document)
+	encoder.name("type")
+	encodeBackgroundType(encoder, background.Type)
+}
+
+func encodeBackgrounds(encoder *encoder, value *Backgrounds) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (backgrounds *Backgrounds) encode(encoder *encoder) {
+	encoder.meta(TypeBackgrounds, backgrounds.meta)
+	encoder.name("backgrounds")
+	encodeListOfBackground(encoder, backgrounds.Backgrounds)
+}
+
+func encodeChatBackground(encoder *encoder, value *ChatBackground) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatBackground *ChatBackground) encode(encoder *encoder) {
+	encoder.meta(TypeChatBackground, chatBackground.meta)
+	encoder.name("background")
+	encodeBackground(encoder, chatBackground.Background)
+	encoder.Int32("dark_theme_dimming", chatBackground.DarkThemeDimming)
+}
+
+func encodeProfilePhoto(encoder *encoder, value *ProfilePhoto) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (profilePhoto *ProfilePhoto) encode(encoder *encoder) {
+	encoder.meta(TypeProfilePhoto, profilePhoto.meta)
+	encoder.JsonInt64("id", profilePhoto.Id)
+	encoder.name("small")
+	encodeFile(encoder, profilePhoto.Small)
+	encoder.name("big")
+	encodeFile(encoder, profilePhoto.Big)
+	encoder.name("minithumbnail")
+	encodeMinithumbnail(encoder, profilePhoto.Minithumbnail)
+	encoder.Bool("has_animation", profilePhoto.HasAnimation)
+	encoder.Bool("is_personal", profilePhoto.IsPersonal)
+}
+
+func encodeChatPhotoInfo(encoder *encoder, value *ChatPhotoInfo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatPhotoInfo *ChatPhotoInfo) encode(encoder *encoder) {
+	encoder.meta(TypeChatPhotoInfo, chatPhotoInfo.meta)
+	encoder.name("small")
+	encodeFile(encoder, chatPhotoInfo.Small)
+	encoder.name("big")
+	encodeFile(encoder, chatPhotoInfo.Big)
+	encoder.name("minithumbnail")
+	encodeMinithumbnail(encoder, chatPhotoInfo.Minithumbnail)
+	encoder.Bool("has_animation", chatPhotoInfo.HasAnimation)
+	encoder.Bool("is_personal", chatPhotoInfo.IsPersonal)
+}
+
+func encodeUserTypeRegular(encoder *encoder, value *UserTypeRegular) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (userTypeRegular *UserTypeRegular) encode(encoder *encoder) {
+	encoder.meta(TypeUserTypeRegular, userTypeRegular.meta)
+}
+
+func encodeUserTypeDeleted(encoder *encoder, value *UserTypeDeleted) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (userTypeDeleted *UserTypeDeleted) encode(encoder *encoder) {
+	encoder.meta(TypeUserTypeDeleted, userTypeDeleted.meta)
+}
+
+func encodeUserTypeBot(encoder *encoder, value *UserTypeBot) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (userTypeBot *UserTypeBot) encode(encoder *encoder) {
+	encoder.meta(TypeUserTypeBot, userTypeBot.meta)
+	encoder.Bool("can_be_edited", userTypeBot.CanBeEdited)
+	encoder.Bool("can_join_groups", userTypeBot.CanJoinGroups)
+	encoder.Bool("can_read_all_group_messages", userTypeBot.CanReadAllGroupMessages)
+	encoder.Bool("is_inline", userTypeBot.IsInline)
+	encoder.String("inline_query_placeholder", userTypeBot.InlineQueryPlaceholder)
+	encoder.Bool("need_location", userTypeBot.NeedLocation)
+	encoder.Bool("can_be_added_to_attachment_menu", userTypeBot.CanBeAddedToAttachmentMenu)
+}
+
+func encodeUserTypeUnknown(encoder *encoder, value *UserTypeUnknown) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (userTypeUnknown *UserTypeUnknown) encode(encoder *encoder) {
+	encoder.meta(TypeUserTypeUnknown, userTypeUnknown.meta)
+}
+
+func encodeAccessHashTypeUser(encoder *encoder, value *AccessHashTypeUser) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (accessHashTypeUser *AccessHashTypeUser) encode(encoder *encoder) {
+	encoder.meta(TypeAccessHashTypeUser, accessHashTypeUser.meta)
+}
+
+func encodeAccessHashTypeChannel(encoder *encoder, value *AccessHashTypeChannel) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (accessHashTypeChannel *AccessHashTypeChannel) encode(encoder *encoder) {
+	encoder.meta(TypeAccessHashTypeChannel, accessHashTypeChannel.meta)
+}
+
+func encodeAccessHash(encoder *encoder, value *AccessHash) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (accessHash *AccessHash) encode(encoder *encoder) {
+	encoder.meta(TypeAccessHash, accessHash.meta)
+	encoder.Int64("chat_id", accessHash.ChatId)
+	encoder.name("type")
+	encodeAccessHashType(encoder, accessHash.Type)
+	encoder.JsonInt64("access_hash", accessHash.AccessHash)
+}
+
+func encodeBotCommand(encoder *encoder, value *BotCommand) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (botCommand *BotCommand) encode(encoder *encoder) {
+	encoder.meta(TypeBotCommand, botCommand.meta)
+	encoder.String("command", botCommand.Command)
+	encoder.String("description", botCommand.Description)
+}
+
+func encodeBotCommands(encoder *encoder, value *BotCommands) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (botCommands *BotCommands) encode(encoder *encoder) {
+	encoder.meta(TypeBotCommands, botCommands.meta)
+	encoder.Int64("bot_user_id", botCommands.BotUserId)
+	encoder.name("commands")
+	encodeListOfBotCommand(encoder, botCommands.Commands)
+}
+
+func encodeBotMenuButton(encoder *encoder, value *BotMenuButton) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (botMenuButton *BotMenuButton) encode(encoder *encoder) {
+	encoder.meta(TypeBotMenuButton, botMenuButton.meta)
+	encoder.String("text", botMenuButton.Text)
+	encoder.String("url", botMenuButton.Url)
+}
+
+func encodeChatLocation(encoder *encoder, value *ChatLocation) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatLocation *ChatLocation) encode(encoder *encoder) {
+	encoder.meta(TypeChatLocation, chatLocation.meta)
+	encoder.name("location")
+	encodeLocation(encoder, chatLocation.Location)
+	encoder.String("address", chatLocation.Address)
+}
+
+func encodeChatPhotoStickerTypeRegularOrMask(encoder *encoder, value *ChatPhotoStickerTypeRegularOrMask) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatPhotoStickerTypeRegularOrMask *ChatPhotoStickerTypeRegularOrMask) encode(encoder *encoder) {
+	encoder.meta(TypeChatPhotoStickerTypeRegularOrMask, chatPhotoStickerTypeRegularOrMask.meta)
+	encoder.JsonInt64("sticker_set_id", chatPhotoStickerTypeRegularOrMask.StickerSetId)
+	encoder.JsonInt64("sticker_id", chatPhotoStickerTypeRegularOrMask.StickerId)
+}
+
+func encodeChatPhotoStickerTypeCustomEmoji(encoder *encoder, value *ChatPhotoStickerTypeCustomEmoji) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatPhotoStickerTypeCustomEmoji *ChatPhotoStickerTypeCustomEmoji) encode(encoder *encoder) {
+	encoder.meta(TypeChatPhotoStickerTypeCustomEmoji, chatPhotoStickerTypeCustomEmoji.meta)
+	encoder.JsonInt64("custom_emoji_id", chatPhotoStickerTypeCustomEmoji.CustomEmojiId)
+}
+
+func encodeChatPhotoSticker(encoder *encoder, value *ChatPhotoSticker) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatPhotoSticker *ChatPhotoSticker) encode(encoder *encoder) {
+	encoder.meta(TypeChatPhotoSticker, chatPhotoSticker.meta)
+	encoder.name("type")
+	encodeChatPhotoStickerType(encoder, chatPhotoSticker.Type)
+	encoder.name("background_fill")
+	encodeBackgroundFill(encoder, chatPhotoSticker.BackgroundFill)
+}
+
+func encodeAnimatedChatPhoto(encoder *encoder, value *AnimatedChatPhoto) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (animatedChatPhoto *AnimatedChatPhoto) encode(encoder *encoder) {
+	encoder.meta(TypeAnimatedChatPhoto, animatedChatPhoto.meta)
+	encoder.Int32("length", animatedChatPhoto.Length)
+	encoder.name("file")
+	encodeFile(encoder, animatedChatPhoto.File)
+	encoder.Float64("main_frame_timestamp", animatedChatPhoto.MainFrameTimestamp)
+}
+
+func encodeChatPhoto(encoder *encoder, value *ChatPhoto) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatPhoto *ChatPhoto) encode(encoder *encoder) {
+	encoder.meta(TypeChatPhoto, chatPhoto.meta)
+	encoder.JsonInt64("id", chatPhoto.Id)
+	encoder.Int32("added_date", chatPhoto.AddedDate)
+	encoder.name("minithumbnail")
+	encodeMinithumbnail(encoder, chatPhoto.Minithumbnail)
+	encoder.name("sizes")
+	encodeListOfPhotoSize(encoder, chatPhoto.Sizes)
+	encoder.name("animation")
+	encodeAnimatedChatPhoto(encoder, chatPhoto.Animation)
+	encoder.name("small_animation")
+	encodeAnimatedChatPhoto(encoder, chatPhoto.SmallAnimation)
+	encoder.name("sticker")
+	encodeChatPhotoSticker(encoder, chatPhoto.Sticker)
+}
+
+func encodeChatPhotos(encoder *encoder, value *ChatPhotos) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatPhotos *ChatPhotos) encode(encoder *encoder) {
+	encoder.meta(TypeChatPhotos, chatPhotos.meta)
+	encoder.Int32("total_count", chatPhotos.TotalCount)
+	encoder.name("photos")
+	encodeListOfChatPhoto(encoder, chatPhotos.Photos)
+}
+
+func encodeInputChatPhotoPrevious(encoder *encoder, value *InputChatPhotoPrevious) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputChatPhotoPrevious *InputChatPhotoPrevious) encode(encoder *encoder) {
+	encoder.meta(TypeInputChatPhotoPrevious, inputChatPhotoPrevious.meta)
+	encoder.JsonInt64("chat_photo_id", inputChatPhotoPrevious.ChatPhotoId)
+}
+
+func encodeInputChatPhotoStatic(encoder *encoder, value *InputChatPhotoStatic) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputChatPhotoStatic *InputChatPhotoStatic) encode(encoder *encoder) {
+	encoder.meta(TypeInputChatPhotoStatic, inputChatPhotoStatic.meta)
+	encoder.name("photo")
+	encodeInputFile(encoder, inputChatPhotoStatic.Photo)
+}
+
+func encodeInputChatPhotoAnimation(encoder *encoder, value *InputChatPhotoAnimation) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputChatPhotoAnimation *InputChatPhotoAnimation) encode(encoder *encoder) {
+	encoder.meta(TypeInputChatPhotoAnimation, inputChatPhotoAnimation.meta)
+	encoder.name("animation")
+	encodeInputFile(encoder, inputChatPhotoAnimation.Animation)
+	encoder.Float64("main_frame_timestamp", inputChatPhotoAnimation.MainFrameTimestamp)
+}
+
+func encodeInputChatPhotoSticker(encoder *encoder, value *InputChatPhotoSticker) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputChatPhotoSticker *InputChatPhotoSticker) encode(encoder *encoder) {
+	encoder.meta(TypeInputChatPhotoSticker, inputChatPhotoSticker.meta)
+	encoder.name("sticker")
+	encodeChatPhotoSticker(encoder, inputChatPhotoSticker.Sticker)
+}
+
+func encodeChatPermissions(encoder *encoder, value *ChatPermissions) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatPermissions *ChatPermissions) encode(encoder *encoder) {
+	encoder.meta(TypeChatPermissions, chatPermissions.meta)
+	encoder.Bool("can_send_basic_messages", chatPermissions.CanSendBasicMessages)
+	encoder.Bool("can_send_audios", chatPermissions.CanSendAudios)
+	encoder.Bool("can_send_documents", chatPermissions.CanSendDocuments)
+	encoder.Bool("can_send_photos", chatPermissions.CanSendPhotos)
+	encoder.Bool("can_send_videos", chatPermissions.CanSendVideos)
+	encoder.Bool("can_send_video_notes", chatPermissions.CanSendVideoNotes)
+	encoder.Bool("can_send_voice_notes", chatPermissions.CanSendVoiceNotes)
+	encoder.Bool("can_send_polls", chatPermissions.CanSendPolls)
+	encoder.Bool("can_send_other_messages", chatPermissions.CanSendOtherMessages)
+	encoder.Bool("can_add_web_page_previews", chatPermissions.CanAddWebPagePreviews)
+	encoder.Bool("can_change_info", chatPermissions.CanChangeInfo)
+	encoder.Bool("can_invite_users", chatPermissions.CanInviteUsers)
+	encoder.Bool("can_pin_messages", chatPermissions.CanPinMessages)
+	encoder.Bool("can_manage_topics", chatPermissions.CanManageTopics)
+}
+
+func encodeChatAdministratorRights(encoder *encoder, value *ChatAdministratorRights) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatAdministratorRights *ChatAdministratorRights) encode(encoder *encoder) {
+	encoder.meta(TypeChatAdministratorRights, chatAdministratorRights.meta)
+	encoder.Bool("can_manage_chat", chatAdministratorRights.CanManageChat)
+	encoder.Bool("can_change_info", chatAdministratorRights.CanChangeInfo)
+	encoder.Bool("can_post_messages", chatAdministratorRights.CanPostMessages)
+	encoder.Bool("can_edit_messages", chatAdministratorRights.CanEditMessages)
+	encoder.Bool("can_delete_messages", chatAdministratorRights.CanDeleteMessages)
+	encoder.Bool("can_invite_users", chatAdministratorRights.CanInviteUsers)
+	encoder.Bool("can_restrict_members", chatAdministratorRights.CanRestrictMembers)
+	encoder.Bool("can_pin_messages", chatAdministratorRights.CanPinMessages)
+	encoder.Bool("can_manage_topics", chatAdministratorRights.CanManageTopics)
+	encoder.Bool("can_promote_members", chatAdministratorRights.CanPromoteMembers)
+	encoder.Bool("can_manage_video_chats", chatAdministratorRights.CanManageVideoChats)
+	encoder.Bool("is_anonymous", chatAdministratorRights.IsAnonymous)
+}
+
+func encodePremiumPaymentOption(encoder *encoder, value *PremiumPaymentOption) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (premiumPaymentOption *PremiumPaymentOption) encode(encoder *encoder) {
+	encoder.meta(TypePremiumPaymentOption, premiumPaymentOption.meta)
+	encoder.String("currency", premiumPaymentOption.Currency)
+	encoder.Int64("amount", premiumPaymentOption.Amount)
+	encoder.Int32("discount_percentage", premiumPaymentOption.DiscountPercentage)
+	encoder.Int32("month_count", premiumPaymentOption.MonthCount)
+	encoder.String("store_product_id", premiumPaymentOption.StoreProductId)
+	encoder.name("payment_link")
+	encodeInternalLinkType(encoder, premiumPaymentOption.PaymentLink)
+}
+
+func encodePremiumStatePaymentOption(encoder *encoder, value *PremiumStatePaymentOption) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (premiumStatePaymentOption *PremiumStatePaymentOption) encode(encoder *encoder) {
+	encoder.meta(TypePremiumStatePaymentOption, premiumStatePaymentOption.meta)
+	encoder.name("payment_option")
+	encodePremiumPaymentOption(encoder, premiumStatePaymentOption.PaymentOption)
+	encoder.Bool("is_current", premiumStatePaymentOption.IsCurrent)
+	encoder.Bool("is_upgrade", premiumStatePaymentOption.IsUpgrade)
+	encoder.String("last_transaction_id", premiumStatePaymentOption.LastTransactionId)
+}
+
+func encodeEmojiStatus(encoder *encoder, value *EmojiStatus) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (emojiStatus *EmojiStatus) encode(encoder *encoder) {
+	encoder.meta(TypeEmojiStatus, emojiStatus.meta)
+	encoder.JsonInt64("custom_emoji_id", emojiStatus.CustomEmojiId)
+}
+
+func encodeEmojiStatuses(encoder *encoder, value *EmojiStatuses) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (emojiStatuses *EmojiStatuses) encode(encoder *encoder) {
+	encoder.meta(TypeEmojiStatuses, emojiStatuses.meta)
+	encoder.name("emoji_statuses")
+	encodeListOfEmojiStatus(encoder, emojiStatuses.EmojiStatuses)
+}
+
+func encodeUsernames(encoder *encoder, value *Usernames) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (usernames *Usernames) encode(encoder *encoder) {
+	encoder.meta(TypeUsernames, usernames.meta)
+	encoder.Strings("active_usernames", usernames.ActiveUsernames)
+	encoder.Strings("disabled_usernames", usernames.DisabledUsernames)
+	encoder.String("editable_username", usernames.EditableUsername)
+}
+
+func encodeUser(encoder *encoder, value *User) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (user *User) encode(encoder *encoder) {
+	encoder.meta(TypeUser, user.meta)
+	encoder.Int64("id", user.Id)
+	encoder.String("first_name", user.FirstName)
+	encoder.String("last_name", user.LastName)
+	encoder.name("usernames")
+	encodeUsernames(encoder, user.Usernames)
+	encoder.String("phone_number", user.PhoneNumber)
+	encoder.name("status")
+	encodeUserStatus(encoder, user.Status)
+	encoder.name("profile_photo")
+	encodeProfilePhoto(encoder, user.ProfilePhoto)
+	encoder.name("emoji_status")
+	encodeEmojiStatus(encoder, user.EmojiStatus)
+	encoder.Bool("is_contact", user.IsContact)
+	encoder.Bool("is_mutual_contact", user.IsMutualContact)
+	encoder.Bool("is_verified", user.IsVerified)
+	encoder.Bool("is_premium", user.IsPremium)
+	encoder.Bool("is_support", user.IsSupport)
+	encoder.String("restriction_reason", user.RestrictionReason)
+	encoder.Bool("is_scam", user.IsScam)
+	encoder.Bool("is_fake", user.IsFake)
+	encoder.Bool("have_access", user.HaveAccess)
+	encoder.name("type")
+	encodeUserType(encoder, user.Type)
+	encoder.String("language_code", user.LanguageCode)
+	encoder.Bool("added_to_attachment_menu", user.AddedToAttachmentMenu)
+}
+
+func encodeBotInfo(encoder *encoder, value *BotInfo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (botInfo *BotInfo) encode(encoder *encoder) {
+	encoder.meta(TypeBotInfo, botInfo.meta)
+	encoder.String("short_description", botInfo.ShortDescription)
+	encoder.String("description", botInfo.Description)
+	encoder.name("photo")
+	encodePhoto(encoder, botInfo.Photo)
+	encoder.name("animation")
+	encodeAnimation(encoder, botInfo.Animation)
+	encoder.name("menu_button")
+	encodeBotMenuButton(encoder, botInfo.MenuButton)
+	encoder.name("commands")
+	encodeListOfBotCommand(encoder, botInfo.Commands)
+	encoder.name("default_group_administrator_rights")
+	encodeChatAdministratorRights(encoder, botInfo.DefaultGroupAdministratorRights)
+	encoder.name("default_channel_administrator_rights")
+	encodeChatAdministratorRights(encoder, botInfo.DefaultChannelAdministratorRights)
+	encoder.name("edit_commands_link")
+	encodeInternalLinkType(encoder, botInfo.EditCommandsLink)
+	encoder.name("edit_description_link")
+	encodeInternalLinkType(encoder, botInfo.EditDescriptionLink)
+	encoder.name("edit_description_media_link")
+	encodeInternalLinkType(encoder, botInfo.EditDescriptionMediaLink)
+	encoder.name("edit_settings_link")
+	encodeInternalLinkType(encoder, botInfo.EditSettingsLink)
+}
+
+func encodeUserFullInfo(encoder *encoder, value *UserFullInfo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (userFullInfo *UserFullInfo) encode(encoder *encoder) {
+	encoder.meta(TypeUserFullInfo, userFullInfo.meta)
+	encoder.name("personal_photo")
+	encodeChatPhoto(encoder, userFullInfo.PersonalPhoto)
+	encoder.name("photo")
+	encodeChatPhoto(encoder, userFullInfo.Photo)
+	encoder.name("public_photo")
+	encodeChatPhoto(encoder, userFullInfo.PublicPhoto)
+	encoder.Bool("is_blocked", userFullInfo.IsBlocked)
+	encoder.Bool("can_be_called", userFullInfo.CanBeCalled)
+	encoder.Bool("supports_video_calls", userFullInfo.SupportsVideoCalls)
+	encoder.Bool("has_private_calls", userFullInfo.HasPrivateCalls)
+	encoder.Bool("has_private_forwards", userFullInfo.HasPrivateForwards)
+	encoder.Bool("has_restricted_voice_and_video_note_messages", userFullInfo.HasRestrictedVoiceAndVideoNoteMessages)
+	encoder.Bool("need_phone_number_privacy_exception", userFullInfo.NeedPhoneNumberPrivacyException)
+	encoder.name("bio")
+	encodeFormattedText(encoder, userFullInfo.Bio)
+	encoder.name("premium_gift_options")
+	encodeListOfPremiumPaymentOption(encoder, userFullInfo.PremiumGiftOptions)
+	encoder.Int32("group_in_common_count", userFullInfo.GroupInCommonCount)
+	encoder.name("bot_info")
+	encodeBotInfo(encoder, userFullInfo.BotInfo)
+}
+
+func encodeUsers(encoder *encoder, value *Users) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (users *Users) encode(encoder *encoder) {
+	encoder.meta(TypeUsers, users.meta)
+	encoder.Int32("total_count", users.TotalCount)
+	encoder.Int64s("user_ids", users.UserIds)
+}
+
+func encodeChatAdministrator(encoder *encoder, value *ChatAdministrator) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatAdministrator *ChatAdministrator) encode(encoder *encoder) {
+	encoder.meta(TypeChatAdministrator, chatAdministrator.meta)
+	encoder.Int64("user_id", chatAdministrator.UserId)
+	encoder.String("custom_title", chatAdministrator.CustomTitle)
+	encoder.Bool("is_owner", chatAdministrator.IsOwner)
+}
+
+func encodeChatAdministrators(encoder *encoder, value *ChatAdministrators) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatAdministrators *ChatAdministrators) encode(encoder *encoder) {
+	encoder.meta(TypeChatAdministrators, chatAdministrators.meta)
+	encoder.name("administrators")
+	encodeListOfChatAdministrator(encoder, chatAdministrators.Administrators)
+}
+
+func encodeChatMemberStatusCreator(encoder *encoder, value *ChatMemberStatusCreator) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatMemberStatusCreator *ChatMemberStatusCreator) encode(encoder *encoder) {
+	encoder.meta(TypeChatMemberStatusCreator, chatMemberStatusCreator.meta)
+	encoder.String("custom_title", chatMemberStatusCreator.CustomTitle)
+	encoder.Bool("is_anonymous", chatMemberStatusCreator.IsAnonymous)
+	encoder.Bool("is_member", chatMemberStatusCreator.IsMember)
+}
+
+func encodeChatMemberStatusAdministrator(encoder *encoder, value *ChatMemberStatusAdministrator) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatMemberStatusAdministrator *ChatMemberStatusAdministrator) encode(encoder *encoder) {
+	encoder.meta(TypeChatMemberStatusAdministrator, chatMemberStatusAdministrator.meta)
+	encoder.String("custom_title", chatMemberStatusAdministrator.CustomTitle)
+	encoder.Bool("can_be_edited", chatMemberStatusAdministrator.CanBeEdited)
+	encoder.name("rights")
+	encodeChatAdministratorRights(encoder, chatMemberStatusAdministrator.Rights)
+}
+
+func encodeChatMemberStatusMember(encoder *encoder, value *ChatMemberStatusMember) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatMemberStatusMember *ChatMemberStatusMember) encode(encoder *encoder) {
+	encoder.meta(TypeChatMemberStatusMember, chatMemberStatusMember.meta)
+}
+
+func encodeChatMemberStatusRestricted(encoder *encoder, value *ChatMemberStatusRestricted) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatMemberStatusRestricted *ChatMemberStatusRestricted) encode(encoder *encoder) {
+	encoder.meta(TypeChatMemberStatusRestricted, chatMemberStatusRestricted.meta)
+	encoder.Bool("is_member", chatMemberStatusRestricted.IsMember)
+	encoder.Int32("restricted_until_date", chatMemberStatusRestricted.RestrictedUntilDate)
+	encoder.name("permissions")
+	encodeChatPermissions(encoder, chatMemberStatusRestricted.Permissions)
+}
+
+func encodeChatMemberStatusLeft(encoder *encoder, value *ChatMemberStatusLeft) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatMemberStatusLeft *ChatMemberStatusLeft) encode(encoder *encoder) {
+	encoder.meta(TypeChatMemberStatusLeft, chatMemberStatusLeft.meta)
+}
+
+func encodeChatMemberStatusBanned(encoder *encoder, value *ChatMemberStatusBanned) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatMemberStatusBanned *ChatMemberStatusBanned) encode(encoder *encoder) {
+	encoder.meta(TypeChatMemberStatusBanned, chatMemberStatusBanned.meta)
+	encoder.Int32("banned_until_date", chatMemberStatusBanned.BannedUntilDate)
+}
+
+func encodeChatMember(encoder *encoder, value *ChatMember) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatMember *ChatMember) encode(encoder *encoder) {
+	encoder.meta(TypeChatMember, chatMember.meta)
+	encoder.name("member_id")
+	encodeMessageSender(encoder, chatMember.MemberId)
+	encoder.Int64("inviter_user_id", chatMember.InviterUserId)
+	encoder.Int32("joined_chat_date", chatMember.JoinedChatDate)
+	encoder.name("status")
+	encodeChatMemberStatus(encoder, chatMember.Status)
+}
+
+func encodeChatMembers(encoder *encoder, value *ChatMembers) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatMembers *ChatMembers) encode(encoder *encoder) {
+	encoder.meta(TypeChatMembers, chatMembers.meta)
+	encoder.Int32("total_count", chatMembers.TotalCount)
+	encoder.name("members")
+	encodeListOfChatMember(encoder, chatMembers.Members)
+}
+
+func encodeChatMembersFilterContacts(encoder *encoder, value *ChatMembersFilterContacts) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatMembersFilterContacts *ChatMembersFilterContacts) encode(encoder *encoder) {
+	encoder.meta(TypeChatMembersFilterContacts, chatMembersFilterContacts.meta)
+}
+
+func encodeChatMembersFilterAdministrators(encoder *encoder, value *ChatMembersFilterAdministrators) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatMembersFilterAdministrators *ChatMembersFilterAdministrators) encode(encoder *encoder) {
+	encoder.meta(TypeChatMembersFilterAdministrators, chatMembersFilterAdministrators.meta)
+}
+
+func encodeChatMembersFilterMembers(encoder *encoder, value *ChatMembersFilterMembers) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatMembersFilterMembers *ChatMembersFilterMembers) encode(encoder *encoder) {
+	encoder.meta(TypeChatMembersFilterMembers, chatMembersFilterMembers.meta)
+}
+
+func encodeChatMembersFilterMention(encoder *encoder, value *ChatMembersFilterMention) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatMembersFilterMention *ChatMembersFilterMention) encode(encoder *encoder) {
+	encoder.meta(TypeChatMembersFilterMention, chatMembersFilterMention.meta)
+	encoder.Int64("message_thread_id", chatMembersFilterMention.MessageThreadId)
+}
+
+func encodeChatMembersFilterRestricted(encoder *encoder, value *ChatMembersFilterRestricted) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatMembersFilterRestricted *ChatMembersFilterRestricted) encode(encoder *encoder) {
+	encoder.meta(TypeChatMembersFilterRestricted, chatMembersFilterRestricted.meta)
+}
+
+func encodeChatMembersFilterBanned(encoder *encoder, value *ChatMembersFilterBanned) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatMembersFilterBanned *ChatMembersFilterBanned) encode(encoder *encoder) {
+	encoder.meta(TypeChatMembersFilterBanned, chatMembersFilterBanned.meta)
+}
+
+func encodeChatMembersFilterBots(encoder *encoder, value *ChatMembersFilterBots) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatMembersFilterBots *ChatMembersFilterBots) encode(encoder *encoder) {
+	encoder.meta(TypeChatMembersFilterBots, chatMembersFilterBots.meta)
+}
+
+func encodeSupergroupMembersFilterRecent(encoder *encoder, value *SupergroupMembersFilterRecent) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (supergroupMembersFilterRecent *SupergroupMembersFilterRecent) encode(encoder *encoder) {
+	encoder.meta(TypeSupergroupMembersFilterRecent, supergroupMembersFilterRecent.meta)
+}
+
+func encodeSupergroupMembersFilterContacts(encoder *encoder, value *SupergroupMembersFilterContacts) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (supergroupMembersFilterContacts *SupergroupMembersFilterContacts) encode(encoder *encoder) {
+	encoder.meta(TypeSupergroupMembersFilterContacts, supergroupMembersFilterContacts.meta)
+	encoder.String("query", supergroupMembersFilterContacts.Query)
+}
+
+func encodeSupergroupMembersFilterAdministrators(encoder *encoder, value *SupergroupMembersFilterAdministrators) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (supergroupMembersFilterAdministrators *SupergroupMembersFilterAdministrators) encode(encoder *encoder) {
+	encoder.meta(TypeSupergroupMembersFilterAdministrators, supergroupMembersFilterAdministrators.meta)
+}
+
+func encodeSupergroupMembersFilterSearch(encoder *encoder, value *SupergroupMembersFilterSearch) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (supergroupMembersFilterSearch *SupergroupMembersFilterSearch) encode(encoder *encoder) {
+	encoder.meta(TypeSupergroupMembersFilterSearch, supergroupMembersFilterSearch.meta)
+	encoder.String("query", supergroupMembersFilterSearch.Query)
+}
+
+func encodeSupergroupMembersFilterRestricted(encoder *encoder, value *SupergroupMembersFilterRestricted) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (supergroupMembersFilterRestricted *SupergroupMembersFilterRestricted) encode(encoder *encoder) {
+	encoder.meta(TypeSupergroupMembersFilterRestricted, supergroupMembersFilterRestricted.meta)
+	encoder.String("query", supergroupMembersFilterRestricted.Query)
+}
+
+func encodeSupergroupMembersFilterBanned(encoder *encoder, value *SupergroupMembersFilterBanned) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (supergroupMembersFilterBanned *SupergroupMembersFilterBanned) encode(encoder *encoder) {
+	encoder.meta(TypeSupergroupMembersFilterBanned, supergroupMembersFilterBanned.meta)
+	encoder.String("query", supergroupMembersFilterBanned.Query)
+}
+
+func encodeSupergroupMembersFilterMention(encoder *encoder, value *SupergroupMembersFilterMention) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (supergroupMembersFilterMention *SupergroupMembersFilterMention) encode(encoder *encoder) {
+	encoder.meta(TypeSupergroupMembersFilterMention, supergroupMembersFilterMention.meta)
+	encoder.String("query", supergroupMembersFilterMention.Query)
+	encoder.Int64("message_thread_id", supergroupMembersFilterMention.MessageThreadId)
+}
+
+func encodeSupergroupMembersFilterBots(encoder *encoder, value *SupergroupMembersFilterBots) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (supergroupMembersFilterBots *SupergroupMembersFilterBots) encode(encoder *encoder) {
+	encoder.meta(TypeSupergroupMembersFilterBots, supergroupMembersFilterBots.meta)
+}
+
+func encodeChatInviteLink(encoder *encoder, value *ChatInviteLink) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatInviteLink *ChatInviteLink) encode(encoder *encoder) {
+	encoder.meta(TypeChatInviteLink, chatInviteLink.meta)
+	encoder.String("invite_link", chatInviteLink.InviteLink)
+	encoder.String("name", chatInviteLink.Name)
+	encoder.Int64("creator_user_id", chatInviteLink.CreatorUserId)
+	encoder.Int32("date", chatInviteLink.Date)
+	encoder.Int32("edit_date", chatInviteLink.EditDate)
+	encoder.Int32("expiration_date", chatInviteLink.ExpirationDate)
+	encoder.Int32("member_limit", chatInviteLink.MemberLimit)
+	encoder.Int32("member_count", chatInviteLink.MemberCount)
+	encoder.Int32("pending_join_request_count", chatInviteLink.PendingJoinRequestCount)
+	encoder.Bool("creates_join_request", chatInviteLink.CreatesJoinRequest)
+	encoder.Bool("is_primary", chatInviteLink.IsPrimary)
+	encoder.Bool("is_revoked", chatInviteLink.IsRevoked)
+}
+
+func encodeChatInviteLinks(encoder *encoder, value *ChatInviteLinks) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatInviteLinks *ChatInviteLinks) encode(encoder *encoder) {
+	encoder.meta(TypeChatInviteLinks, chatInviteLinks.meta)
+	encoder.Int32("total_count", chatInviteLinks.TotalCount)
+	encoder.name("invite_links")
+	encodeListOfChatInviteLink(encoder, chatInviteLinks.InviteLinks)
+}
+
+func encodeChatInviteLinkCount(encoder *encoder, value *ChatInviteLinkCount) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatInviteLinkCount *ChatInviteLinkCount) encode(encoder *encoder) {
+	encoder.meta(TypeChatInviteLinkCount, chatInviteLinkCount.meta)
+	encoder.Int64("user_id", chatInviteLinkCount.UserId)
+	encoder.Int32("invite_link_count", chatInviteLinkCount.InviteLinkCount)
+	encoder.Int32("revoked_invite_link_count", chatInviteLinkCount.RevokedInviteLinkCount)
+}
+
+func encodeChatInviteLinkCounts(encoder *encoder, value *ChatInviteLinkCounts) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatInviteLinkCounts *ChatInviteLinkCounts) encode(encoder *encoder) {
+	encoder.meta(TypeChatInviteLinkCounts, chatInviteLinkCounts.meta)
+	encoder.name("invite_link_counts")
+	encodeListOfChatInviteLinkCount(encoder, chatInviteLinkCounts.InviteLinkCounts)
+}
+
+func encodeChatInviteLinkMember(encoder *encoder, value *ChatInviteLinkMember) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatInviteLinkMember *ChatInviteLinkMember) encode(encoder *encoder) {
+	encoder.meta(TypeChatInviteLinkMember, chatInviteLinkMember.meta)
+	encoder.Int64("user_id", chatInviteLinkMember.UserId)
+	encoder.Int32("joined_chat_date", chatInviteLinkMember.JoinedChatDate)
+	encoder.Bool("via_chat_folder_invite_link", chatInviteLinkMember.ViaChatFolderInviteLink)
+	encoder.Int64("approver_user_id", chatInviteLinkMember.ApproverUserId)
+}
+
+func encodeChatInviteLinkMembers(encoder *encoder, value *ChatInviteLinkMembers) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatInviteLinkMembers *ChatInviteLinkMembers) encode(encoder *encoder) {
+	encoder.meta(TypeChatInviteLinkMembers, chatInviteLinkMembers.meta)
+	encoder.Int32("total_count", chatInviteLinkMembers.TotalCount)
+	encoder.name("members")
+	encodeListOfChatInviteLinkMember(encoder, chatInviteLinkMembers.Members)
+}
+
+func encodeChatInviteLinkInfo(encoder *encoder, value *ChatInviteLinkInfo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatInviteLinkInfo *ChatInviteLinkInfo) encode(encoder *encoder) {
+	encoder.meta(TypeChatInviteLinkInfo, chatInviteLinkInfo.meta)
+	encoder.Int64("chat_id", chatInviteLinkInfo.ChatId)
+	encoder.Int32("accessible_for", chatInviteLinkInfo.AccessibleFor)
+	encoder.name("type")
+	encodeChatType(encoder, chatInviteLinkInfo.Type)
+	encoder.String("title", chatInviteLinkInfo.Title)
+	encoder.name("photo")
+	encodeChatPhotoInfo(encoder, chatInviteLinkInfo.Photo)
+	encoder.String("description", chatInviteLinkInfo.Description)
+	encoder.Int32("member_count", chatInviteLinkInfo.MemberCount)
+	encoder.Int64s("member_user_ids", chatInviteLinkInfo.MemberUserIds)
+	encoder.Bool("creates_join_request", chatInviteLinkInfo.CreatesJoinRequest)
+	encoder.Bool("is_public", chatInviteLinkInfo.IsPublic)
+}
+
+func encodeChatJoinRequest(encoder *encoder, value *ChatJoinRequest) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatJoinRequest *ChatJoinRequest) encode(encoder *encoder) {
+	encoder.meta(TypeChatJoinRequest, chatJoinRequest.meta)
+	encoder.Int64("user_id", chatJoinRequest.UserId)
+	encoder.Int32("date", chatJoinRequest.Date)
+	encoder.String("bio", chatJoinRequest.Bio)
+}
+
+func encodeChatJoinRequests(encoder *encoder, value *ChatJoinRequests) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatJoinRequests *ChatJoinRequests) encode(encoder *encoder) {
+	encoder.meta(TypeChatJoinRequests, chatJoinRequests.meta)
+	encoder.Int32("total_count", chatJoinRequests.TotalCount)
+	encoder.name("requests")
+	encodeListOfChatJoinRequest(encoder, chatJoinRequests.Requests)
+}
+
+func encodeChatJoinRequestsInfo(encoder *encoder, value *ChatJoinRequestsInfo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatJoinRequestsInfo *ChatJoinRequestsInfo) encode(encoder *encoder) {
+	encoder.meta(TypeChatJoinRequestsInfo, chatJoinRequestsInfo.meta)
+	encoder.Int32("total_count", chatJoinRequestsInfo.TotalCount)
+	encoder.Int64s("user_ids", chatJoinRequestsInfo.UserIds)
+}
+
+func encodeBasicGroup(encoder *encoder, value *BasicGroup) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (basicGroup *BasicGroup) encode(encoder *encoder) {
+	encoder.meta(TypeBasicGroup, basicGroup.meta)
+	encoder.Int64("id", basicGroup.Id)
+	encoder.Int32("member_count", basicGroup.MemberCount)
+	encoder.name("status")
+	encodeChatMemberStatus(encoder, basicGroup.Status)
+	encoder.Bool("is_active", basicGroup.IsActive)
+	encoder.Int64("upgraded_to_supergroup_id", basicGroup.UpgradedToSupergroupId)
+}
+
+func encodeBasicGroupFullInfo(encoder *encoder, value *BasicGroupFullInfo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (basicGroupFullInfo *BasicGroupFullInfo) encode(encoder *encoder) {
+	encoder.meta(TypeBasicGroupFullInfo, basicGroupFullInfo.meta)
+	encoder.name("photo")
+	encodeChatPhoto(encoder, basicGroupFullInfo.Photo)
+	encoder.String("description", basicGroupFullInfo.Description)
+	encoder.Int64("creator_user_id", basicGroupFullInfo.CreatorUserId)
+	encoder.name("members")
+	encodeListOfChatMember(encoder, basicGroupFullInfo.Members)
+	encoder.Bool("can_hide_members", basicGroupFullInfo.CanHideMembers)
+	encoder.Bool("can_toggle_aggressive_anti_spam", basicGroupFullInfo.CanToggleAggressiveAntiSpam)
+	encoder.name("invite_link")
+	encodeChatInviteLink(encoder, basicGroupFullInfo.InviteLink)
+	encoder.name("bot_commands")
+	encodeListOfBotCommands(encoder, basicGroupFullInfo.BotCommands)
+}
+
+func encodeSupergroup(encoder *encoder, value *Supergroup) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (supergroup *Supergroup) encode(encoder *encoder) {
+	encoder.meta(TypeSupergroup, supergroup.meta)
+	encoder.Int64("id", supergroup.Id)
+	encoder.name("usernames")
+	encodeUsernames(encoder, supergroup.Usernames)
+	encoder.Int32("date", supergroup.Date)
+	encoder.name("status")
+	encodeChatMemberStatus(encoder, supergroup.Status)
+	encoder.Int32("member_count", supergroup.MemberCount)
+	encoder.Bool("has_linked_chat", supergroup.HasLinkedChat)
+	encoder.Bool("has_location", supergroup.HasLocation)
+	encoder.Bool("sign_messages", supergroup.SignMessages)
+	encoder.Bool("join_to_send_messages", supergroup.JoinToSendMessages)
+	encoder.Bool("join_by_request", supergroup.JoinByRequest)
+	encoder.Bool("is_slow_mode_enabled", supergroup.IsSlowModeEnabled)
+	encoder.Bool("is_channel", supergroup.IsChannel)
+	encoder.Bool("is_broadcast_group", supergroup.IsBroadcastGroup)
+	encoder.Bool("is_forum", supergroup.IsForum)
+	encoder.Bool("is_verified", supergroup.IsVerified)
+	encoder.String("restriction_reason", supergroup.RestrictionReason)
+	encoder.Bool("is_scam", supergroup.IsScam)
+	encoder.Bool("is_fake", supergroup.IsFake)
+}
+
+func encodeSupergroupFullInfo(encoder *encoder, value *SupergroupFullInfo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (supergroupFullInfo *SupergroupFullInfo) encode(encoder *encoder) {
+	encoder.meta(TypeSupergroupFullInfo, supergroupFullInfo.meta)
+	encoder.name("photo")
+	encodeChatPhoto(encoder, supergroupFullInfo.Photo)
+	encoder.String("description", supergroupFullInfo.Description)
+	encoder.Int32("member_count", supergroupFullInfo.MemberCount)
+	encoder.Int32("administrator_count", supergroupFullInfo.AdministratorCount)
+	encoder.Int32("restricted_count", supergroupFullInfo.RestrictedCount)
+	encoder.Int32("banned_count", supergroupFullInfo.BannedCount)
+	encoder.Int64("linked_chat_id", supergroupFullInfo.LinkedChatId)
+	encoder.Int32("slow_mode_delay", supergroupFullInfo.SlowModeDelay)
+	encoder.Float64("slow_mode_delay_expires_in", supergroupFullInfo.SlowModeDelayExpiresIn)
+	encoder.Bool("can_get_members", supergroupFullInfo.CanGetMembers)
+	encoder.Bool("has_hidden_members", supergroupFullInfo.HasHiddenMembers)
+	encoder.Bool("can_hide_members", supergroupFullInfo.CanHideMembers)
+	encoder.Bool("can_set_username", supergroupFullInfo.CanSetUsername)
+	encoder.Bool("can_set_sticker_set", supergroupFullInfo.CanSetStickerSet)
+	encoder.Bool("can_set_location", supergroupFullInfo.CanSetLocation)
+	encoder.Bool("can_get_statistics", supergroupFullInfo.CanGetStatistics)
+	encoder.Bool("can_toggle_aggressive_anti_spam", supergroupFullInfo.CanToggleAggressiveAntiSpam)
+	encoder.Bool("is_all_history_available", supergroupFullInfo.IsAllHistoryAvailable)
+	encoder.Bool("has_aggressive_anti_spam_enabled", supergroupFullInfo.HasAggressiveAntiSpamEnabled)
+	encoder.JsonInt64("sticker_set_id", supergroupFullInfo.StickerSetId)
+	encoder.name("location")
+	encodeChatLocation(encoder, supergroupFullInfo.Location)
+	encoder.name("invite_link")
+	encodeChatInviteLink(encoder, supergroupFullInfo.InviteLink)
+	encoder.name("bot_commands")
+	encodeListOfBotCommands(encoder, supergroupFullInfo.BotCommands)
+	encoder.Int64("upgraded_from_basic_group_id", supergroupFullInfo.UpgradedFromBasicGroupId)
+	encoder.Int64("upgraded_from_max_message_id", supergroupFullInfo.UpgradedFromMaxMessageId)
+}
+
+func encodeSecretChatStatePending(encoder *encoder, value *SecretChatStatePending) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (secretChatStatePending *SecretChatStatePending) encode(encoder *encoder) {
+	encoder.meta(TypeSecretChatStatePending, secretChatStatePending.meta)
+}
+
+func encodeSecretChatStateReady(encoder *encoder, value *SecretChatStateReady) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (secretChatStateReady *SecretChatStateReady) encode(encoder *encoder) {
+	encoder.meta(TypeSecretChatStateReady, secretChatStateReady.meta)
+}
+
+func encodeSecretChatStateClosed(encoder *encoder, value *SecretChatStateClosed) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (secretChatStateClosed *SecretChatStateClosed) encode(encoder *encoder) {
+	encoder.meta(TypeSecretChatStateClosed, secretChatStateClosed.meta)
+}
+
+func encodeSecretChat(encoder *encoder, value *SecretChat) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (secretChat *SecretChat) encode(encoder *encoder) {
+	encoder.meta(TypeSecretChat, secretChat.meta)
+	encoder.Int32("id", secretChat.Id)
+	encoder.Int64("user_id", secretChat.UserId)
+	encoder.name("state")
+	encodeSecretChatState(encoder, secretChat.State)
+	encoder.Bool("is_outbound", secretChat.IsOutbound)
+	encoder.Bytes("key_hash", secretChat.KeyHash)
+	encoder.Int32("layer", secretChat.Layer)
+}
+
+func encodeMessageSenderUser(encoder *encoder, value *MessageSenderUser) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageSenderUser *MessageSenderUser) encode(encoder *encoder) {
+	encoder.meta(TypeMessageSenderUser, messageSenderUser.meta)
+	encoder.Int64("user_id", messageSenderUser.UserId)
+}
+
+func encodeMessageSenderChat(encoder *encoder, value *MessageSenderChat) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageSenderChat *MessageSenderChat) encode(encoder *encoder) {
+	encoder.meta(TypeMessageSenderChat, messageSenderChat.meta)
+	encoder.Int64("chat_id", messageSenderChat.ChatId)
+}
+
+func encodeMessageSenders(encoder *encoder, value *MessageSenders) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageSenders *MessageSenders) encode(encoder *encoder) {
+	encoder.meta(TypeMessageSenders, messageSenders.meta)
+	encoder.Int32("total_count", messageSenders.TotalCount)
+	encoder.name("senders")
+	encodeListOfMessageSender(encoder, messageSenders.Senders)
+}
+
+func encodeChatMessageSender(encoder *encoder, value *ChatMessageSender) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatMessageSender *ChatMessageSender) encode(encoder *encoder) {
+	encoder.meta(TypeChatMessageSender, chatMessageSender.meta)
+	encoder.name("sender")
+	encodeMessageSender(encoder, chatMessageSender.Sender)
+	encoder.Bool("needs_premium", chatMessageSender.NeedsPremium)
+}
+
+func encodeChatMessageSenders(encoder *encoder, value *ChatMessageSenders) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatMessageSenders *ChatMessageSenders) encode(encoder *encoder) {
+	encoder.meta(TypeChatMessageSenders, chatMessageSenders.meta)
+	encoder.name("senders")
+	encodeListOfChatMessageSender(encoder, chatMessageSenders.Senders)
+}
+
+func encodeMessageViewer(encoder *encoder, value *MessageViewer) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageViewer *MessageViewer) encode(encoder *encoder) {
+	encoder.meta(TypeMessageViewer, messageViewer.meta)
+	encoder.Int64("user_id", messageViewer.UserId)
+	encoder.Int32("view_date", messageViewer.ViewDate)
+}
+
+func encodeMessageViewers(encoder *encoder, value *MessageViewers) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageViewers *MessageViewers) encode(encoder *encoder) {
+	encoder.meta(TypeMessageViewers, messageViewers.meta)
+	encoder.name("viewers")
+	encodeListOfMessageViewer(encoder, messageViewers.Viewers)
+}
+
+func encodeMessageForwardOriginUser(encoder *encoder, value *MessageForwardOriginUser) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageForwardOriginUser *MessageForwardOriginUser) encode(encoder *encoder) {
+	encoder.meta(TypeMessageForwardOriginUser, messageForwardOriginUser.meta)
+	encoder.Int64("sender_user_id", messageForwardOriginUser.SenderUserId)
+}
+
+func encodeMessageForwardOriginChat(encoder *encoder, value *MessageForwardOriginChat) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageForwardOriginChat *MessageForwardOriginChat) encode(encoder *encoder) {
+	encoder.meta(TypeMessageForwardOriginChat, messageForwardOriginChat.meta)
+	encoder.Int64("sender_chat_id", messageForwardOriginChat.SenderChatId)
+	encoder.String("author_signature", messageForwardOriginChat.AuthorSignature)
+}
+
+func encodeMessageForwardOriginHiddenUser(encoder *encoder, value *MessageForwardOriginHiddenUser) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageForwardOriginHiddenUser *MessageForwardOriginHiddenUser) encode(encoder *encoder) {
+	encoder.meta(TypeMessageForwardOriginHiddenUser, messageForwardOriginHiddenUser.meta)
+	encoder.String("sender_name", messageForwardOriginHiddenUser.SenderName)
+}
+
+func encodeMessageForwardOriginChannel(encoder *encoder, value *MessageForwardOriginChannel) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageForwardOriginChannel *MessageForwardOriginChannel) encode(encoder *encoder) {
+	encoder.meta(TypeMessageForwardOriginChannel, messageForwardOriginChannel.meta)
+	encoder.Int64("chat_id", messageForwardOriginChannel.ChatId)
+	encoder.Int64("message_id", messageForwardOriginChannel.MessageId)
+	encoder.String("author_signature", messageForwardOriginChannel.AuthorSignature)
+}
+
+func encodeMessageForwardOriginMessageImport(encoder *encoder, value *MessageForwardOriginMessageImport) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageForwardOriginMessageImport *MessageForwardOriginMessageImport) encode(encoder *encoder) {
+	encoder.meta(TypeMessageForwardOriginMessageImport, messageForwardOriginMessageImport.meta)
+	encoder.String("sender_name", messageForwardOriginMessageImport.SenderName)
+}
+
+func encodeReactionTypeEmoji(encoder *encoder, value *ReactionTypeEmoji) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (reactionTypeEmoji *ReactionTypeEmoji) encode(encoder *encoder) {
+	encoder.meta(TypeReactionTypeEmoji, reactionTypeEmoji.meta)
+	encoder.String("emoji", reactionTypeEmoji.Emoji)
+}
+
+func encodeReactionTypeCustomEmoji(encoder *encoder, value *ReactionTypeCustomEmoji) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (reactionTypeCustomEmoji *ReactionTypeCustomEmoji) encode(encoder *encoder) {
+	encoder.meta(TypeReactionTypeCustomEmoji, reactionTypeCustomEmoji.meta)
+	encoder.JsonInt64("custom_emoji_id", reactionTypeCustomEmoji.CustomEmojiId)
+}
+
+func encodeMessageForwardInfo(encoder *encoder, value *MessageForwardInfo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageForwardInfo *MessageForwardInfo) encode(encoder *encoder) {
+	encoder.meta(TypeMessageForwardInfo, messageForwardInfo.meta)
+	encoder.name("origin")
+	encodeMessageForwardOrigin(encoder, messageForwardInfo.Origin)
+	encoder.Int32("date", messageForwardInfo.Date)
+	encoder.String("public_service_announcement_type", messageForwardInfo.PublicServiceAnnouncementType)
+	encoder.Int64("from_chat_id", messageForwardInfo.FromChatId)
+	encoder.Int64("from_message_id", messageForwardInfo.FromMessageId)
+}
+
+func encodeMessageReplyInfo(encoder *encoder, value *MessageReplyInfo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageReplyInfo *MessageReplyInfo) encode(encoder *encoder) {
+	encoder.meta(TypeMessageReplyInfo, messageReplyInfo.meta)
+	encoder.Int32("reply_count", messageReplyInfo.ReplyCount)
+	encoder.name("recent_replier_ids")
+	encodeListOfMessageSender(encoder, messageReplyInfo.RecentReplierIds)
+	encoder.Int64("last_read_inbox_message_id", messageReplyInfo.LastReadInboxMessageId)
+	encoder.Int64("last_read_outbox_message_id", messageReplyInfo.LastReadOutboxMessageId)
+	encoder.Int64("last_message_id", messageReplyInfo.LastMessageId)
+}
+
+func encodeMessageReaction(encoder *encoder, value *MessageReaction) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageReaction *MessageReaction) encode(encoder *encoder) {
+	encoder.meta(TypeMessageReaction, messageReaction.meta)
+	encoder.name("type")
+	encodeReactionType(encoder, messageReaction.Type)
+	encoder.Int32("total_count", messageReaction.TotalCount)
+	encoder.Bool("is_chosen", messageReaction.IsChosen)
+	encoder.name("recent_sender_ids")
+	encodeListOfMessageSender(encoder, messageReaction.RecentSenderIds)
+}
+
+func encodeMessageInteractionInfo(encoder *encoder, value *MessageInteractionInfo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageInteractionInfo *MessageInteractionInfo) encode(encoder *encoder) {
+	encoder.meta(TypeMessageInteractionInfo, messageInteractionInfo.meta)
+	encoder.Int32("view_count", messageInteractionInfo.ViewCount)
+	encoder.Int32("forward_count", messageInteractionInfo.ForwardCount)
+	encoder.name("reply_info")
+	encodeMessageReplyInfo(encoder, messageInteractionInfo.ReplyInfo)
+	encoder.name("reactions")
+	encodeListOfMessageReaction(encoder, messageInteractionInfo.Reactions)
+}
+
+func encodeUnreadReaction(encoder *encoder, value *UnreadReaction) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (unreadReaction *UnreadReaction) encode(encoder *encoder) {
+	encoder.meta(TypeUnreadReaction, unreadReaction.meta)
+	encoder.name("type")
+	encodeReactionType(encoder, unreadReaction.Type)
+	encoder.name("sender_id")
+	encodeMessageSender(encoder, unreadReaction.SenderId)
+	encoder.Bool("is_big", unreadReaction.IsBig)
+}
+
+func encodeMessageSendingStatePending(encoder *encoder, value *MessageSendingStatePending) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageSendingStatePending *MessageSendingStatePending) encode(encoder *encoder) {
+	encoder.meta(TypeMessageSendingStatePending, messageSendingStatePending.meta)
+	encoder.Int32("sending_id", messageSendingStatePending.SendingId)
+}
+
+func encodeMessageSendingStateFailed(encoder *encoder, value *MessageSendingStateFailed) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageSendingStateFailed *MessageSendingStateFailed) encode(encoder *encoder) {
+	encoder.meta(TypeMessageSendingStateFailed, messageSendingStateFailed.meta)
+	encoder.Int32("error_code", messageSendingStateFailed.ErrorCode)
+	encoder.String("error_message", messageSendingStateFailed.ErrorMessage)
+	encoder.Bool("can_retry", messageSendingStateFailed.CanRetry)
+	encoder.Bool("need_another_sender", messageSendingStateFailed.NeedAnotherSender)
+	encoder.Float64("retry_after", messageSendingStateFailed.RetryAfter)
+}
+
+func encodeMessage(encoder *encoder, value *Message) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (message *Message) encode(encoder *encoder) {
+	encoder.meta(TypeMessage, message.meta)
+	encoder.Int64("id", message.Id)
+	encoder.name("sender_id")
+	encodeMessageSender(encoder, message.SenderId)
+	encoder.Int64("chat_id", message.ChatId)
+	encoder.name("sending_state")
+	encodeMessageSendingState(encoder, message.SendingState)
+	encoder.name("scheduling_state")
+	encodeMessageSchedulingState(encoder, message.SchedulingState)
+	encoder.Bool("is_outgoing", message.IsOutgoing)
+	encoder.Bool("is_pinned", message.IsPinned)
+	encoder.Bool("can_be_edited", message.CanBeEdited)
+	encoder.Bool("can_be_forwarded", message.CanBeForwarded)
+	encoder.Bool("can_be_saved", message.CanBeSaved)
+	encoder.Bool("can_be_deleted_only_for_self", message.CanBeDeletedOnlyForSelf)
+	encoder.Bool("can_be_deleted_for_all_users", message.CanBeDeletedForAllUsers)
+	encoder.Bool("can_get_added_reactions", message.CanGetAddedReactions)
+	encoder.Bool("can_get_statistics", message.CanGetStatistics)
+	encoder.Bool("can_get_message_thread", message.CanGetMessageThread)
+	encoder.Bool("can_get_viewers", message.CanGetViewers)
+	encoder.Bool("can_get_media_timestamp_links", message.CanGetMediaTimestampLinks)
+	encoder.Bool("can_report_reactions", message.CanReportReactions)
+	encoder.Bool("has_timestamped_media", message.HasTimestampedMedia)
+	encoder.Bool("is_channel_post", message.IsChannelPost)
+	encoder.Bool("is_topic_message", message.IsTopicMessage)
+	encoder.Bool("contains_unread_mention", message.ContainsUnreadMention)
+	encoder.Int32("date", message.Date)
+	encoder.Int32("edit_date", message.EditDate)
+	encoder.name("forward_info")
+	encodeMessageForwardInfo(encoder, message.ForwardInfo)
+	encoder.name("interaction_info")
+	encodeMessageInteractionInfo(encoder, message.InteractionInfo)
+	encoder.name("unread_reactions")
+	encodeListOfUnreadReaction(encoder, message.UnreadReactions)
+	encoder.Int64("reply_in_chat_id", message.ReplyInChatId)
+	encoder.Int64("reply_to_message_id", message.ReplyToMessageId)
+	encoder.Int64("message_thread_id", message.MessageThreadId)
+	encoder.Int32("self_destruct_time", message.SelfDestructTime)
+	encoder.Float64("self_destruct_in", message.SelfDestructIn)
+	encoder.Float64("auto_delete_in", message.AutoDeleteIn)
+	encoder.Int64("via_bot_user_id", message.ViaBotUserId)
+	encoder.String("author_signature", message.AuthorSignature)
+	encoder.JsonInt64("media_album_id", message.MediaAlbumId)
+	encoder.String("restriction_reason", message.RestrictionReason)
+	encoder.name("content")
+	encodeMessageContent(encoder, message.Content)
+	encoder.name("reply_markup")
+	encodeReplyMarkup(encoder, message.ReplyMarkup)
+}
+
+func encodeMessages(encoder *encoder, value *Messages) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messages *Messages) encode(encoder *encoder) {
+	encoder.meta(TypeMessages, messages.meta)
+	encoder.Int32("total_count", messages.TotalCount)
+	encoder.name("messages")
+	encodeListOfMessage(encoder, messages.Messages)
+}
+
+func encodeFoundMessages(encoder *encoder, value *FoundMessages) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (foundMessages *FoundMessages) encode(encoder *encoder) {
+	encoder.meta(TypeFoundMessages, foundMessages.meta)
+	encoder.Int32("total_count", foundMessages.TotalCount)
+	encoder.name("messages")
+	encodeListOfMessage(encoder, foundMessages.Messages)
+	encoder.String("next_offset", foundMessages.NextOffset)
+}
+
+func encodeFoundChatMessages(encoder *encoder, value *FoundChatMessages) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (foundChatMessages *FoundChatMessages) encode(encoder *encoder) {
+	encoder.meta(TypeFoundChatMessages, foundChatMessages.meta)
+	encoder.Int32("total_count", foundChatMessages.TotalCount)
+	encoder.name("messages")
+	encodeListOfMessage(encoder, foundChatMessages.Messages)
+	encoder.Int64("next_from_message_id", foundChatMessages.NextFromMessageId)
+}
+
+func encodeMessagePosition(encoder *encoder, value *MessagePosition) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messagePosition *MessagePosition) encode(encoder *encoder) {
+	encoder.meta(TypeMessagePosition, messagePosition.meta)
+	encoder.Int32("position", messagePosition.Position)
+	encoder.Int64("message_id", messagePosition.MessageId)
+	encoder.Int32("date", messagePosition.Date)
+}
+
+func encodeMessagePositions(encoder *encoder, value *MessagePositions) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messagePositions *MessagePositions) encode(encoder *encoder) {
+	encoder.meta(TypeMessagePositions, messagePositions.meta)
+	encoder.Int32("total_count", messagePositions.TotalCount)
+	encoder.name("positions")
+	encodeListOfMessagePosition(encoder, messagePositions.Positions)
+}
+
+func encodeMessageCalendarDay(encoder *encoder, value *MessageCalendarDay) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageCalendarDay *MessageCalendarDay) encode(encoder *encoder) {
+	encoder.meta(TypeMessageCalendarDay, messageCalendarDay.meta)
+	encoder.Int32("total_count", messageCalendarDay.TotalCount)
+	encoder.name("message")
+	encodeMessage(encoder, messageCalendarDay.Message)
+}
+
+func encodeMessageCalendar(encoder *encoder, value *MessageCalendar) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageCalendar *MessageCalendar) encode(encoder *encoder) {
+	encoder.meta(TypeMessageCalendar, messageCalendar.meta)
+	encoder.Int32("total_count", messageCalendar.TotalCount)
+	encoder.name("days")
+	encodeListOfMessageCalendarDay(encoder, messageCalendar.Days)
+}
+
+func encodeMessageSourceChatHistory(encoder *encoder, value *MessageSourceChatHistory) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageSourceChatHistory *MessageSourceChatHistory) encode(encoder *encoder) {
+	encoder.meta(TypeMessageSourceChatHistory, messageSourceChatHistory.meta)
+}
+
+func encodeMessageSourceMessageThreadHistory(encoder *encoder, value *MessageSourceMessageThreadHistory) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageSourceMessageThreadHistory *MessageSourceMessageThreadHistory) encode(encoder *encoder) {
+	encoder.meta(TypeMessageSourceMessageThreadHistory, messageSourceMessageThreadHistory.meta)
+}
+
+func encodeMessageSourceForumTopicHistory(encoder *encoder, value *MessageSourceForumTopicHistory) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageSourceForumTopicHistory *MessageSourceForumTopicHistory) encode(encoder *encoder) {
+	encoder.meta(TypeMessageSourceForumTopicHistory, messageSourceForumTopicHistory.meta)
+}
+
+func encodeMessageSourceHistoryPreview(encoder *encoder, value *MessageSourceHistoryPreview) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageSourceHistoryPreview *MessageSourceHistoryPreview) encode(encoder *encoder) {
+	encoder.meta(TypeMessageSourceHistoryPreview, messageSourceHistoryPreview.meta)
+}
+
+func encodeMessageSourceChatList(encoder *encoder, value *MessageSourceChatList) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageSourceChatList *MessageSourceChatList) encode(encoder *encoder) {
+	encoder.meta(TypeMessageSourceChatList, messageSourceChatList.meta)
+}
+
+func encodeMessageSourceSearch(encoder *encoder, value *MessageSourceSearch) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageSourceSearch *MessageSourceSearch) encode(encoder *encoder) {
+	encoder.meta(TypeMessageSourceSearch, messageSourceSearch.meta)
+}
+
+func encodeMessageSourceChatEventLog(encoder *encoder, value *MessageSourceChatEventLog) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageSourceChatEventLog *MessageSourceChatEventLog) encode(encoder *encoder) {
+	encoder.meta(TypeMessageSourceChatEventLog, messageSourceChatEventLog.meta)
+}
+
+func encodeMessageSourceNotification(encoder *encoder, value *MessageSourceNotification) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageSourceNotification *MessageSourceNotification) encode(encoder *encoder) {
+	encoder.meta(TypeMessageSourceNotification, messageSourceNotification.meta)
+}
+
+func encodeMessageSourceOther(encoder *encoder, value *MessageSourceOther) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageSourceOther *MessageSourceOther) encode(encoder *encoder) {
+	encoder.meta(TypeMessageSourceOther, messageSourceOther.meta)
+}
+
+func encodeSponsoredMessage(encoder *encoder, value *SponsoredMessage) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (sponsoredMessage *SponsoredMessage) encode(encoder *encoder) {
+	encoder.meta(TypeSponsoredMessage, sponsoredMessage.meta)
+	encoder.Int64("message_id", sponsoredMessage.MessageId)
+	encoder.Bool("is_recommended", sponsoredMessage.IsRecommended)
+	encoder.Int64("sponsor_chat_id", sponsoredMessage.SponsorChatId)
+	encoder.name("sponsor_chat_info")
+	encodeChatInviteLinkInfo(encoder, sponsoredMessage.SponsorChatInfo)
+	encoder.Bool("show_chat_photo", sponsoredMessage.ShowChatPhoto)
+	encoder.name("link")
+	encodeInternalLinkType(encoder, sponsoredMessage.Link)
+	encoder.name("content")
+	encodeMessageContent(encoder, sponsoredMessage.Content)
+	encoder.String("sponsor_info", sponsoredMessage.SponsorInfo)
+	encoder.String("additional_info", sponsoredMessage.AdditionalInfo)
+}
+
+func encodeSponsoredMessages(encoder *encoder, value *SponsoredMessages) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (sponsoredMessages *SponsoredMessages) encode(encoder *encoder) {
+	encoder.meta(TypeSponsoredMessages, sponsoredMessages.meta)
+	encoder.name("messages")
+	encodeListOfSponsoredMessage(encoder, sponsoredMessages.Messages)
+	encoder.Int32("messages_between", sponsoredMessages.MessagesBetween)
+}
+
+func encodeFileDownload(encoder *encoder, value *FileDownload) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (fileDownload *FileDownload) encode(encoder *encoder) {
+	encoder.meta(TypeFileDownload, fileDownload.meta)
+	encoder.Int32("file_id", fileDownload.FileId)
+	encoder.name("message")
+	encodeMessage(encoder, fileDownload.Message)
+	encoder.Int32("add_date", fileDownload.AddDate)
+	encoder.Int32("complete_date", fileDownload.CompleteDate)
+	encoder.Bool("is_paused", fileDownload.IsPaused)
+}
+
+func encodeDownloadedFileCounts(encoder *encoder, value *DownloadedFileCounts) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (downloadedFileCounts *DownloadedFileCounts) encode(encoder *encoder) {
+	encoder.meta(TypeDownloadedFileCounts, downloadedFileCounts.meta)
+	encoder.Int32("active_count", downloadedFileCounts.ActiveCount)
+	encoder.Int32("paused_count", downloadedFileCounts.PausedCount)
+	encoder.Int32("completed_count", downloadedFileCounts.CompletedCount)
+}
+
+func encodeFoundFileDownloads(encoder *encoder, value *FoundFileDownloads) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (foundFileDownloads *FoundFileDownloads) encode(encoder *encoder) {
+	encoder.meta(TypeFoundFileDownloads, foundFileDownloads.meta)
+	encoder.name("total_counts")
+	encodeDownloadedFileCounts(encoder, foundFileDownloads.TotalCounts)
+	encoder.name("files")
+	encodeListOfFileDownload(encoder, foundFileDownloads.Files)
+	encoder.String("next_offset", foundFileDownloads.NextOffset)
+}
+
+func encodeNotificationSettingsScopePrivateChats(encoder *encoder, value *NotificationSettingsScopePrivateChats) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (notificationSettingsScopePrivateChats *NotificationSettingsScopePrivateChats) encode(encoder *encoder) {
+	encoder.meta(TypeNotificationSettingsScopePrivateChats, notificationSettingsScopePrivateChats.meta)
+}
+
+func encodeNotificationSettingsScopeGroupChats(encoder *encoder, value *NotificationSettingsScopeGroupChats) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (notificationSettingsScopeGroupChats *NotificationSettingsScopeGroupChats) encode(encoder *encoder) {
+	encoder.meta(TypeNotificationSettingsScopeGroupChats, notificationSettingsScopeGroupChats.meta)
+}
+
+func encodeNotificationSettingsScopeChannelChats(encoder *encoder, value *NotificationSettingsScopeChannelChats) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (notificationSettingsScopeChannelChats *NotificationSettingsScopeChannelChats) encode(encoder *encoder) {
+	encoder.meta(TypeNotificationSettingsScopeChannelChats, notificationSettingsScopeChannelChats.meta)
+}
+
+func encodeChatNotificationSettings(encoder *encoder, value *ChatNotificationSettings) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatNotificationSettings *ChatNotificationSettings) encode(encoder *encoder) {
+	encoder.meta(TypeChatNotificationSettings, chatNotificationSettings.meta)
+	encoder.Bool("use_default_mute_for", chatNotificationSettings.UseDefaultMuteFor)
+	encoder.Int32("mute_for", chatNotificationSettings.MuteFor)
+	encoder.Bool("use_default_sound", chatNotificationSettings.UseDefaultSound)
+	encoder.JsonInt64("sound_id", chatNotificationSettings.SoundId)
+	encoder.Bool("use_default_show_preview", chatNotificationSettings.UseDefaultShowPreview)
+	encoder.Bool("show_preview", chatNotificationSettings.ShowPreview)
+	encoder.Bool("use_default_disable_pinned_message_notifications", chatNotificationSettings.UseDefaultDisablePinnedMessageNotifications)
+	encoder.Bool("disable_pinned_message_notifications", chatNotificationSettings.DisablePinnedMessageNotifications)
+	encoder.Bool("use_default_disable_mention_notifications", chatNotificationSettings.UseDefaultDisableMentionNotifications)
+	encoder.Bool("disable_mention_notifications", chatNotificationSettings.DisableMentionNotifications)
+}
+
+func encodeScopeNotificationSettings(encoder *encoder, value *ScopeNotificationSettings) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (scopeNotificationSettings *ScopeNotificationSettings) encode(encoder *encoder) {
+	encoder.meta(TypeScopeNotificationSettings, scopeNotificationSettings.meta)
+	encoder.Int32("mute_for", scopeNotificationSettings.MuteFor)
+	encoder.JsonInt64("sound_id", scopeNotificationSettings.SoundId)
+	encoder.Bool("show_preview", scopeNotificationSettings.ShowPreview)
+	encoder.Bool("disable_pinned_message_notifications", scopeNotificationSettings.DisablePinnedMessageNotifications)
+	encoder.Bool("disable_mention_notifications", scopeNotificationSettings.DisableMentionNotifications)
+}
+
+func encodeDraftMessage(encoder *encoder, value *DraftMessage) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (draftMessage *DraftMessage) encode(encoder *encoder) {
+	encoder.meta(TypeDraftMessage, draftMessage.meta)
+	encoder.Int64("reply_to_message_id", draftMessage.ReplyToMessageId)
+	encoder.Int32("date", draftMessage.Date)
+	encoder.name("input_message_text")
+	encodeInputMessageContent(encoder, draftMessage.InputMessageText)
+}
+
+func encodeChatTypePrivate(encoder *encoder, value *ChatTypePrivate) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatTypePrivate *ChatTypePrivate) encode(encoder *encoder) {
+	encoder.meta(TypeChatTypePrivate, chatTypePrivate.meta)
+	encoder.Int64("user_id", chatTypePrivate.UserId)
+}
+
+func encodeChatTypeBasicGroup(encoder *encoder, value *ChatTypeBasicGroup) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatTypeBasicGroup *ChatTypeBasicGroup) encode(encoder *encoder) {
+	encoder.meta(TypeChatTypeBasicGroup, chatTypeBasicGroup.meta)
+	encoder.Int64("basic_group_id", chatTypeBasicGroup.BasicGroupId)
+}
+
+func encodeChatTypeSupergroup(encoder *encoder, value *ChatTypeSupergroup) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatTypeSupergroup *ChatTypeSupergroup) encode(encoder *encoder) {
+	encoder.meta(TypeChatTypeSupergroup, chatTypeSupergroup.meta)
+	encoder.Int64("supergroup_id", chatTypeSupergroup.SupergroupId)
+	encoder.Bool("is_channel", chatTypeSupergroup.IsChannel)
+}
+
+func encodeChatTypeSecret(encoder *encoder, value *ChatTypeSecret) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatTypeSecret *ChatTypeSecret) encode(encoder *encoder) {
+	encoder.meta(TypeChatTypeSecret, chatTypeSecret.meta)
+	encoder.Int32("secret_chat_id", chatTypeSecret.SecretChatId)
+	encoder.Int64("user_id", chatTypeSecret.UserId)
+}
+
+func encodeChatFolderIcon(encoder *encoder, value *ChatFolderIcon) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatFolderIcon *ChatFolderIcon) encode(encoder *encoder) {
+	encoder.meta(TypeChatFolderIcon, chatFolderIcon.meta)
+	encoder.String("name", chatFolderIcon.Name)
+}
+
+func encodeChatFolder(encoder *encoder, value *ChatFolder) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatFolder *ChatFolder) encode(encoder *encoder) {
+	encoder.meta(TypeChatFolder, chatFolder.meta)
+	encoder.String("title", chatFolder.Title)
+	encoder.name("icon")
+	encodeChatFolderIcon(encoder, chatFolder.Icon)
+	encoder.Bool("is_shareable", chatFolder.IsShareable)
+	encoder.Int64s("pinned_chat_ids", chatFolder.PinnedChatIds)
+	encoder.Int64s("included_chat_ids", chatFolder.IncludedChatIds)
+	encoder.Int64s("excluded_chat_ids", chatFolder.ExcludedChatIds)
+	encoder.Bool("exclude_muted", chatFolder.ExcludeMuted)
+	encoder.Bool("exclude_read", chatFolder.ExcludeRead)
+	encoder.Bool("exclude_archived", chatFolder.ExcludeArchived)
+	encoder.Bool("include_contacts", chatFolder.IncludeContacts)
+	encoder.Bool("include_non_contacts", chatFolder.IncludeNonContacts)
+	encoder.Bool("include_bots", chatFolder.IncludeBots)
+	encoder.Bool("include_groups", chatFolder.IncludeGroups)
+	encoder.Bool("include_channels", chatFolder.IncludeChannels)
+}
+
+func encodeChatFolderInfo(encoder *encoder, value *ChatFolderInfo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatFolderInfo *ChatFolderInfo) encode(encoder *encoder) {
+	encoder.meta(TypeChatFolderInfo, chatFolderInfo.meta)
+	encoder.Int32("id", chatFolderInfo.Id)
+	encoder.String("title", chatFolderInfo.Title)
+	encoder.name("icon")
+	encodeChatFolderIcon(encoder, chatFolderInfo.Icon)
+	encoder.Bool("has_my_invite_links", chatFolderInfo.HasMyInviteLinks)
+}
+
+func encodeChatFolderInviteLink(encoder *encoder, value *ChatFolderInviteLink) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatFolderInviteLink *ChatFolderInviteLink) encode(encoder *encoder) {
+	encoder.meta(TypeChatFolderInviteLink, chatFolderInviteLink.meta)
+	encoder.String("invite_link", chatFolderInviteLink.InviteLink)
+	encoder.String("name", chatFolderInviteLink.Name)
+	encoder.Int64s("chat_ids", chatFolderInviteLink.ChatIds)
+}
+
+func encodeChatFolderInviteLinks(encoder *encoder, value *ChatFolderInviteLinks) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatFolderInviteLinks *ChatFolderInviteLinks) encode(encoder *encoder) {
+	encoder.meta(TypeChatFolderInviteLinks, chatFolderInviteLinks.meta)
+	encoder.name("invite_links")
+	encodeListOfChatFolderInviteLink(encoder, chatFolderInviteLinks.InviteLinks)
+}
+
+func encodeChatFolderInviteLinkInfo(encoder *encoder, value *ChatFolderInviteLinkInfo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatFolderInviteLinkInfo *ChatFolderInviteLinkInfo) encode(encoder *encoder) {
+	encoder.meta(TypeChatFolderInviteLinkInfo, chatFolderInviteLinkInfo.meta)
+	encoder.name("chat_folder_info")
+	encodeChatFolderInfo(encoder, chatFolderInviteLinkInfo.ChatFolderInfo)
+	encoder.Int64s("missing_chat_ids", chatFolderInviteLinkInfo.MissingChatIds)
+	encoder.Int64s("added_chat_ids", chatFolderInviteLinkInfo.AddedChatIds)
+}
+
+func encodeRecommendedChatFolder(encoder *encoder, value *RecommendedChatFolder) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (recommendedChatFolder *RecommendedChatFolder) encode(encoder *encoder) {
+	encoder.meta(TypeRecommendedChatFolder, recommendedChatFolder.meta)
+	encoder.name("folder")
+	encodeChatFolder(encoder, recommendedChatFolder.Folder)
+	encoder.String("description", recommendedChatFolder.Description)
+}
+
+func encodeRecommendedChatFolders(encoder *encoder, value *RecommendedChatFolders) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (recommendedChatFolders *RecommendedChatFolders) encode(encoder *encoder) {
+	encoder.meta(TypeRecommendedChatFolders, recommendedChatFolders.meta)
+	encoder.name("chat_folders")
+	encodeListOfRecommendedChatFolder(encoder, recommendedChatFolders.ChatFolders)
+}
+
+func encodeChatListMain(encoder *encoder, value *ChatListMain) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatListMain *ChatListMain) encode(encoder *encoder) {
+	encoder.meta(TypeChatListMain, chatListMain.meta)
+}
+
+func encodeChatListArchive(encoder *encoder, value *ChatListArchive) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatListArchive *ChatListArchive) encode(encoder *encoder) {
+	encoder.meta(TypeChatListArchive, chatListArchive.meta)
+}
+
+func encodeChatListFolder(encoder *encoder, value *ChatListFolder) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatListFolder *ChatListFolder) encode(encoder *encoder) {
+	encoder.meta(TypeChatListFolder, chatListFolder.meta)
+	encoder.Int32("chat_folder_id", chatListFolder.ChatFolderId)
+}
+
+func encodeChatLists(encoder *encoder, value *ChatLists) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatLists *ChatLists) encode(encoder *encoder) {
+	encoder.meta(TypeChatLists, chatLists.meta)
+	encoder.name("chat_lists")
+	encodeListOfChatList(encoder, chatLists.ChatLists)
+}
+
+func encodeChatSourceMtprotoProxy(encoder *encoder, value *ChatSourceMtprotoProxy) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatSourceMtprotoProxy *ChatSourceMtprotoProxy) encode(encoder *encoder) {
+	encoder.meta(TypeChatSourceMtprotoProxy, chatSourceMtprotoProxy.meta)
+}
+
+func encodeChatSourcePublicServiceAnnouncement(encoder *encoder, value *ChatSourcePublicServiceAnnouncement) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatSourcePublicServiceAnnouncement *ChatSourcePublicServiceAnnouncement) encode(encoder *encoder) {
+	encoder.meta(TypeChatSourcePublicServiceAnnouncement, chatSourcePublicServiceAnnouncement.meta)
+	encoder.String("type", chatSourcePublicServiceAnnouncement.Type)
+	encoder.String("text", chatSourcePublicServiceAnnouncement.Text)
+}
+
+func encodeChatPosition(encoder *encoder, value *ChatPosition) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatPosition *ChatPosition) encode(encoder *encoder) {
+	encoder.meta(TypeChatPosition, chatPosition.meta)
+	encoder.name("list")
+	encodeChatList(encoder, chatPosition.List)
+	encoder.JsonInt64("order", chatPosition.Order)
+	encoder.Bool("is_pinned", chatPosition.IsPinned)
+	encoder.name("source")
+	encodeChatSource(encoder, chatPosition.Source)
+}
+
+func encodeChatAvailableReactionsAll(encoder *encoder, value *ChatAvailableReactionsAll) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatAvailableReactionsAll *ChatAvailableReactionsAll) encode(encoder *encoder) {
+	encoder.meta(TypeChatAvailableReactionsAll, chatAvailableReactionsAll.meta)
+}
+
+func encodeChatAvailableReactionsSome(encoder *encoder, value *ChatAvailableReactionsSome) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatAvailableReactionsSome *ChatAvailableReactionsSome) encode(encoder *encoder) {
+	encoder.meta(TypeChatAvailableReactionsSome, chatAvailableReactionsSome.meta)
+	encoder.name("reactions")
+	encodeListOfReactionType(encoder, chatAvailableReactionsSome.Reactions)
+}
+
+func encodeVideoChat(encoder *encoder, value *VideoChat) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (videoChat *VideoChat) encode(encoder *encoder) {
+	encoder.meta(TypeVideoChat, videoChat.meta)
+	encoder.Int32("group_call_id", videoChat.GroupCallId)
+	encoder.Bool("has_participants", videoChat.HasParticipants)
+	encoder.name("default_participant_id")
+	encodeMessageSender(encoder, videoChat.DefaultParticipantId)
+}
+
+func encodeChat(encoder *encoder, value *Chat) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chat *Chat) encode(encoder *encoder) {
+	encoder.meta(TypeChat, chat.meta)
+	encoder.Int64("id", chat.Id)
+	encoder.name("type")
+	encodeChatType(encoder, chat.Type)
+	encoder.String("title", chat.Title)
+	encoder.name("photo")
+	encodeChatPhotoInfo(encoder, chat.Photo)
+	encoder.name("permissions")
+	encodeChatPermissions(encoder, chat.Permissions)
+	encoder.name("last_message")
+	encodeMessage(encoder, chat.LastMessage)
+	encoder.name("positions")
+	encodeListOfChatPosition(encoder, chat.Positions)
+	encoder.name("message_sender_id")
+	encodeMessageSender(encoder, chat.MessageSenderId)
+	encoder.Bool("has_protected_content", chat.HasProtectedContent)
+	encoder.Bool("is_translatable", chat.IsTranslatable)
+	encoder.Bool("is_marked_as_unread", chat.IsMarkedAsUnread)
+	encoder.Bool("is_blocked", chat.IsBlocked)
+	encoder.Bool("has_scheduled_messages", chat.HasScheduledMessages)
+	encoder.Bool("can_be_deleted_only_for_self", chat.CanBeDeletedOnlyForSelf)
+	encoder.Bool("can_be_deleted_for_all_users", chat.CanBeDeletedForAllUsers)
+	encoder.Bool("can_be_reported", chat.CanBeReported)
+	encoder.Bool("default_disable_notification", chat.DefaultDisableNotification)
+	encoder.Int32("unread_count", chat.UnreadCount)
+	encoder.Int64("last_read_inbox_message_id", chat.LastReadInboxMessageId)
+	encoder.Int64("last_read_outbox_message_id", chat.LastReadOutboxMessageId)
+	encoder.Int32("unread_mention_count", chat.UnreadMentionCount)
+	encoder.Int32("unread_reaction_count", chat.UnreadReactionCount)
+	encoder.name("notification_settings")
+	encodeChatNotificationSettings(encoder, chat.NotificationSettings)
+	encoder.name("available_reactions")
+	encodeChatAvailableReactions(encoder, chat.AvailableReactions)
+	encoder.Int32("message_auto_delete_time", chat.MessageAutoDeleteTime)
+	encoder.name("background")
+	encodeChatBackground(encoder, chat.Background)
+	encoder.String("theme_name", chat.ThemeName)
+	encoder.name("action_bar")
+	encodeChatActionBar(encoder, chat.ActionBar)
+	encoder.name("video_chat")
+	encodeVideoChat(encoder, chat.VideoChat)
+	encoder.name("pending_join_requests")
+	encodeChatJoinRequestsInfo(encoder, chat.PendingJoinRequests)
+	encoder.Int64("reply_markup_message_id", chat.ReplyMarkupMessageId)
+	encoder.name("draft_message")
+	encodeDraftMessage(encoder, chat.DraftMessage)
+	encoder.String("client_data", chat.ClientData)
+}
+
+func encodeChats(encoder *encoder, value *Chats) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chats *Chats) encode(encoder *encoder) {
+	encoder.meta(TypeChats, chats.meta)
+	encoder.Int32("total_count", chats.TotalCount)
+	encoder.Int64s("chat_ids", chats.ChatIds)
+}
+
+func encodeChatNearby(encoder *encoder, value *ChatNearby) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatNearby *ChatNearby) encode(encoder *encoder) {
+	encoder.meta(TypeChatNearby, chatNearby.meta)
+	encoder.Int64("chat_id", chatNearby.ChatId)
+	encoder.Int32("distance", chatNearby.Distance)
+}
+
+func encodeChatsNearby(encoder *encoder, value *ChatsNearby) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatsNearby *ChatsNearby) encode(encoder *encoder) {
+	encoder.meta(TypeChatsNearby, chatsNearby.meta)
+	encoder.name("users_nearby")
+	encodeListOfChatNearby(encoder, chatsNearby.UsersNearby)
+	encoder.name("supergroups_nearby")
+	encodeListOfChatNearby(encoder, chatsNearby.SupergroupsNearby)
+}
+
+func encodePublicChatTypeHasUsername(encoder *encoder, value *PublicChatTypeHasUsername) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (publicChatTypeHasUsername *PublicChatTypeHasUsername) encode(encoder *encoder) {
+	encoder.meta(TypePublicChatTypeHasUsername, publicChatTypeHasUsername.meta)
+}
+
+func encodePublicChatTypeIsLocationBased(encoder *encoder, value *PublicChatTypeIsLocationBased) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (publicChatTypeIsLocationBased *PublicChatTypeIsLocationBased) encode(encoder *encoder) {
+	encoder.meta(TypePublicChatTypeIsLocationBased, publicChatTypeIsLocationBased.meta)
+}
+
+func encodeChatActionBarReportSpam(encoder *encoder, value *ChatActionBarReportSpam) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatActionBarReportSpam *ChatActionBarReportSpam) encode(encoder *encoder) {
+	encoder.meta(TypeChatActionBarReportSpam, chatActionBarReportSpam.meta)
+	encoder.Bool("can_unarchive", chatActionBarReportSpam.CanUnarchive)
+}
+
+func encodeChatActionBarReportUnrelatedLocation(encoder *encoder, value *ChatActionBarReportUnrelatedLocation) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatActionBarReportUnrelatedLocation *ChatActionBarReportUnrelatedLocation) encode(encoder *encoder) {
+	encoder.meta(TypeChatActionBarReportUnrelatedLocation, chatActionBarReportUnrelatedLocation.meta)
+}
+
+func encodeChatActionBarInviteMembers(encoder *encoder, value *ChatActionBarInviteMembers) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatActionBarInviteMembers *ChatActionBarInviteMembers) encode(encoder *encoder) {
+	encoder.meta(TypeChatActionBarInviteMembers, chatActionBarInviteMembers.meta)
+}
+
+func encodeChatActionBarReportAddBlock(encoder *encoder, value *ChatActionBarReportAddBlock) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatActionBarReportAddBlock *ChatActionBarReportAddBlock) encode(encoder *encoder) {
+	encoder.meta(TypeChatActionBarReportAddBlock, chatActionBarReportAddBlock.meta)
+	encoder.Bool("can_unarchive", chatActionBarReportAddBlock.CanUnarchive)
+	encoder.Int32("distance", chatActionBarReportAddBlock.Distance)
+}
+
+func encodeChatActionBarAddContact(encoder *encoder, value *ChatActionBarAddContact) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatActionBarAddContact *ChatActionBarAddContact) encode(encoder *encoder) {
+	encoder.meta(TypeChatActionBarAddContact, chatActionBarAddContact.meta)
+}
+
+func encodeChatActionBarSharePhoneNumber(encoder *encoder, value *ChatActionBarSharePhoneNumber) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatActionBarSharePhoneNumber *ChatActionBarSharePhoneNumber) encode(encoder *encoder) {
+	encoder.meta(TypeChatActionBarSharePhoneNumber, chatActionBarSharePhoneNumber.meta)
+}
+
+func encodeChatActionBarJoinRequest(encoder *encoder, value *ChatActionBarJoinRequest) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatActionBarJoinRequest *ChatActionBarJoinRequest) encode(encoder *encoder) {
+	encoder.meta(TypeChatActionBarJoinRequest, chatActionBarJoinRequest.meta)
+	encoder.String("title", chatActionBarJoinRequest.Title)
+	encoder.Bool("is_channel", chatActionBarJoinRequest.IsChannel)
+	encoder.Int32("request_date", chatActionBarJoinRequest.RequestDate)
+}
+
+func encodeKeyboardButtonTypeText(encoder *encoder, value *KeyboardButtonTypeText) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (keyboardButtonTypeText *KeyboardButtonTypeText) encode(encoder *encoder) {
+	encoder.meta(TypeKeyboardButtonTypeText, keyboardButtonTypeText.meta)
+}
+
+func encodeKeyboardButtonTypeRequestPhoneNumber(encoder *encoder, value *KeyboardButtonTypeRequestPhoneNumber) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (keyboardButtonTypeRequestPhoneNumber *KeyboardButtonTypeRequestPhoneNumber) encode(encoder *encoder) {
+	encoder.meta(TypeKeyboardButtonTypeRequestPhoneNumber, keyboardButtonTypeRequestPhoneNumber.meta)
+}
+
+func encodeKeyboardButtonTypeRequestLocation(encoder *encoder, value *KeyboardButtonTypeRequestLocation) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (keyboardButtonTypeRequestLocation *KeyboardButtonTypeRequestLocation) encode(encoder *encoder) {
+	encoder.meta(TypeKeyboardButtonTypeRequestLocation, keyboardButtonTypeRequestLocation.meta)
+}
+
+func encodeKeyboardButtonTypeRequestPoll(encoder *encoder, value *KeyboardButtonTypeRequestPoll) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (keyboardButtonTypeRequestPoll *KeyboardButtonTypeRequestPoll) encode(encoder *encoder) {
+	encoder.meta(TypeKeyboardButtonTypeRequestPoll, keyboardButtonTypeRequestPoll.meta)
+	encoder.Bool("force_regular", keyboardButtonTypeRequestPoll.ForceRegular)
+	encoder.Bool("force_quiz", keyboardButtonTypeRequestPoll.ForceQuiz)
+}
+
+func encodeKeyboardButtonTypeRequestUser(encoder *encoder, value *KeyboardButtonTypeRequestUser) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (keyboardButtonTypeRequestUser *KeyboardButtonTypeRequestUser) encode(encoder *encoder) {
+	encoder.meta(TypeKeyboardButtonTypeRequestUser, keyboardButtonTypeRequestUser.meta)
+	encoder.Int32("id", keyboardButtonTypeRequestUser.Id)
+	encoder.Bool("restrict_user_is_bot", keyboardButtonTypeRequestUser.RestrictUserIsBot)
+	encoder.Bool("user_is_bot", keyboardButtonTypeRequestUser.UserIsBot)
+	encoder.Bool("restrict_user_is_premium", keyboardButtonTypeRequestUser.RestrictUserIsPremium)
+	encoder.Bool("user_is_premium", keyboardButtonTypeRequestUser.UserIsPremium)
+}
+
+func encodeKeyboardButtonTypeRequestChat(encoder *encoder, value *KeyboardButtonTypeRequestChat) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (keyboardButtonTypeRequestChat *KeyboardButtonTypeRequestChat) encode(encoder *encoder) {
+	encoder.meta(TypeKeyboardButtonTypeRequestChat, keyboardButtonTypeRequestChat.meta)
+	encoder.Int32("id", keyboardButtonTypeRequestChat.Id)
+	encoder.Bool("chat_is_channel", keyboardButtonTypeRequestChat.ChatIsChannel)
+	encoder.Bool("restrict_chat_is_forum", keyboardButtonTypeRequestChat.RestrictChatIsForum)
+	encoder.Bool("chat_is_forum", keyboardButtonTypeRequestChat.ChatIsForum)
+	encoder.Bool("restrict_chat_has_username", keyboardButtonTypeRequestChat.RestrictChatHasUsername)
+	encoder.Bool("chat_has_username", keyboardButtonTypeRequestChat.ChatHasUsername)
+	encoder.Bool("chat_is_created", keyboardButtonTypeRequestChat.ChatIsCreated)
+	encoder.name("user_administrator_rights")
+	encodeChatAdministratorRights(encoder, keyboardButtonTypeRequestChat.UserAdministratorRights)
+	encoder.name("bot_administrator_rights")
+	encodeChatAdministratorRights(encoder, keyboardButtonTypeRequestChat.BotAdministratorRights)
+	encoder.Bool("bot_is_member", keyboardButtonTypeRequestChat.BotIsMember)
+}
+
+func encodeKeyboardButtonTypeWebApp(encoder *encoder, value *KeyboardButtonTypeWebApp) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (keyboardButtonTypeWebApp *KeyboardButtonTypeWebApp) encode(encoder *encoder) {
+	encoder.meta(TypeKeyboardButtonTypeWebApp, keyboardButtonTypeWebApp.meta)
+	encoder.String("url", keyboardButtonTypeWebApp.Url)
+}
+
+func encodeKeyboardButton(encoder *encoder, value *KeyboardButton) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (keyboardButton *KeyboardButton) encode(encoder *encoder) {
+	encoder.meta(TypeKeyboardButton, keyboardButton.meta)
+	encoder.String("text", keyboardButton.Text)
+	encoder.name("type")
+	encodeKeyboardButtonType(encoder, keyboardButton.Type)
+}
+
+func encodeInlineKeyboardButtonTypeUrl(encoder *encoder, value *InlineKeyboardButtonTypeUrl) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inlineKeyboardButtonTypeUrl *InlineKeyboardButtonTypeUrl) encode(encoder *encoder) {
+	encoder.meta(TypeInlineKeyboardButtonTypeUrl, inlineKeyboardButtonTypeUrl.meta)
+	encoder.String("url", inlineKeyboardButtonTypeUrl.Url)
+}
+
+func encodeInlineKeyboardButtonTypeLoginUrl(encoder *encoder, value *InlineKeyboardButtonTypeLoginUrl) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inlineKeyboardButtonTypeLoginUrl *InlineKeyboardButtonTypeLoginUrl) encode(encoder *encoder) {
+	encoder.meta(TypeInlineKeyboardButtonTypeLoginUrl, inlineKeyboardButtonTypeLoginUrl.meta)
+	encoder.String("url", inlineKeyboardButtonTypeLoginUrl.Url)
+	encoder.Int64("id", inlineKeyboardButtonTypeLoginUrl.Id)
+	encoder.String("forward_text", inlineKeyboardButtonTypeLoginUrl.ForwardText)
+}
+
+func encodeInlineKeyboardButtonTypeWebApp(encoder *encoder, value *InlineKeyboardButtonTypeWebApp) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inlineKeyboardButtonTypeWebApp *InlineKeyboardButtonTypeWebApp) encode(encoder *encoder) {
+	encoder.meta(TypeInlineKeyboardButtonTypeWebApp, inlineKeyboardButtonTypeWebApp.meta)
+	encoder.String("url", inlineKeyboardButtonTypeWebApp.Url)
+}
+
+func encodeInlineKeyboardButtonTypeCallback(encoder *encoder, value *InlineKeyboardButtonTypeCallback) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inlineKeyboardButtonTypeCallback *InlineKeyboardButtonTypeCallback) encode(encoder *encoder) {
+	encoder.meta(TypeInlineKeyboardButtonTypeCallback, inlineKeyboardButtonTypeCallback.meta)
+	encoder.Bytes("data", inlineKeyboardButtonTypeCallback.Data)
+}
+
+func encodeInlineKeyboardButtonTypeCallbackWithPassword(encoder *encoder, value *InlineKeyboardButtonTypeCallbackWithPassword) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inlineKeyboardButtonTypeCallbackWithPassword *InlineKeyboardButtonTypeCallbackWithPassword) encode(encoder *encoder) {
+	encoder.meta(TypeInlineKeyboardButtonTypeCallbackWithPassword, inlineKeyboardButtonTypeCallbackWithPassword.meta)
+	encoder.Bytes("data", inlineKeyboardButtonTypeCallbackWithPassword.Data)
+}
+
+func encodeInlineKeyboardButtonTypeCallbackGame(encoder *encoder, value *InlineKeyboardButtonTypeCallbackGame) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inlineKeyboardButtonTypeCallbackGame *InlineKeyboardButtonTypeCallbackGame) encode(encoder *encoder) {
+	encoder.meta(TypeInlineKeyboardButtonTypeCallbackGame, inlineKeyboardButtonTypeCallbackGame.meta)
+}
+
+func encodeInlineKeyboardButtonTypeSwitchInline(encoder *encoder, value *InlineKeyboardButtonTypeSwitchInline) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inlineKeyboardButtonTypeSwitchInline *InlineKeyboardButtonTypeSwitchInline) encode(encoder *encoder) {
+	encoder.meta(TypeInlineKeyboardButtonTypeSwitchInline, inlineKeyboardButtonTypeSwitchInline.meta)
+	encoder.String("query", inlineKeyboardButtonTypeSwitchInline.Query)
+	encoder.name("target_chat")
+	encodeTargetChat(encoder, inlineKeyboardButtonTypeSwitchInline.TargetChat)
+}
+
+func encodeInlineKeyboardButtonTypeBuy(encoder *encoder, value *InlineKeyboardButtonTypeBuy) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inlineKeyboardButtonTypeBuy *InlineKeyboardButtonTypeBuy) encode(encoder *encoder) {
+	encoder.meta(TypeInlineKeyboardButtonTypeBuy, inlineKeyboardButtonTypeBuy.meta)
+}
+
+func encodeInlineKeyboardButtonTypeUser(encoder *encoder, value *InlineKeyboardButtonTypeUser) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inlineKeyboardButtonTypeUser *InlineKeyboardButtonTypeUser) encode(encoder *encoder) {
+	encoder.meta(TypeInlineKeyboardButtonTypeUser, inlineKeyboardButtonTypeUser.meta)
+	encoder.Int64("user_id", inlineKeyboardButtonTypeUser.UserId)
+}
+
+func encodeInlineKeyboardButton(encoder *encoder, value *InlineKeyboardButton) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inlineKeyboardButton *InlineKeyboardButton) encode(encoder *encoder) {
+	encoder.meta(TypeInlineKeyboardButton, inlineKeyboardButton.meta)
+	encoder.String("text", inlineKeyboardButton.Text)
+	encoder.name("type")
+	encodeInlineKeyboardButtonType(encoder, inlineKeyboardButton.Type)
+}
+
+func encodeReplyMarkupRemoveKeyboard(encoder *encoder, value *ReplyMarkupRemoveKeyboard) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (replyMarkupRemoveKeyboard *ReplyMarkupRemoveKeyboard) encode(encoder *encoder) {
+	encoder.meta(TypeReplyMarkupRemoveKeyboard, replyMarkupRemoveKeyboard.meta)
+	encoder.Bool("is_personal", replyMarkupRemoveKeyboard.IsPersonal)
+}
+
+func encodeReplyMarkupForceReply(encoder *encoder, value *ReplyMarkupForceReply) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (replyMarkupForceReply *ReplyMarkupForceReply) encode(encoder *encoder) {
+	encoder.meta(TypeReplyMarkupForceReply, replyMarkupForceReply.meta)
+	encoder.Bool("is_personal", replyMarkupForceReply.IsPersonal)
+	encoder.String("input_field_placeholder", replyMarkupForceReply.InputFieldPlaceholder)
+}
+
+func encodeReplyMarkupShowKeyboard(encoder *encoder, value *ReplyMarkupShowKeyboard) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (replyMarkupShowKeyboard *ReplyMarkupShowKeyboard) encode(encoder *encoder) {
+	encoder.meta(TypeReplyMarkupShowKeyboard, replyMarkupShowKeyboard.meta)
+	encoder.name("rows")
+	encodeListOfListOfKeyboardButton(encoder, replyMarkupShowKeyboard.Rows)
+	encoder.Bool("is_persistent", replyMarkupShowKeyboard.IsPersistent)
+	encoder.Bool("resize_keyboard", replyMarkupShowKeyboard.ResizeKeyboard)
+	encoder.Bool("one_time", replyMarkupShowKeyboard.OneTime)
+	encoder.Bool("is_personal", replyMarkupShowKeyboard.IsPersonal)
+	encoder.String("input_field_placeholder", replyMarkupShowKeyboard.InputFieldPlaceholder)
+}
+
+func encodeReplyMarkupInlineKeyboard(encoder *encoder, value *ReplyMarkupInlineKeyboard) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (replyMarkupInlineKeyboard *ReplyMarkupInlineKeyboard) encode(encoder *encoder) {
+	encoder.meta(TypeReplyMarkupInlineKeyboard, replyMarkupInlineKeyboard.meta)
+	encoder.name("rows")
+	encodeListOfListOfInlineKeyboardButton(encoder, replyMarkupInlineKeyboard.Rows)
+}
+
+func encodeLoginUrlInfoOpen(encoder *encoder, value *LoginUrlInfoOpen) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (loginUrlInfoOpen *LoginUrlInfoOpen) encode(encoder *encoder) {
+	encoder.meta(TypeLoginUrlInfoOpen, loginUrlInfoOpen.meta)
+	encoder.String("url", loginUrlInfoOpen.Url)
+	encoder.Bool("skip_confirmation", loginUrlInfoOpen.SkipConfirmation)
+}
+
+func encodeLoginUrlInfoRequestConfirmation(encoder *encoder, value *LoginUrlInfoRequestConfirmation) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (loginUrlInfoRequestConfirmation *LoginUrlInfoRequestConfirmation) encode(encoder *encoder) {
+	encoder.meta(TypeLoginUrlInfoRequestConfirmation, loginUrlInfoRequestConfirmation.meta)
+	encoder.String("url", loginUrlInfoRequestConfirmation.Url)
+	encoder.String("domain", loginUrlInfoRequestConfirmation.Domain)
+	encoder.Int64("bot_user_id", loginUrlInfoRequestConfirmation.BotUserId)
+	encoder.Bool("request_write_access", loginUrlInfoRequestConfirmation.RequestWriteAccess)
+}
+
+func encodeFoundWebApp(encoder *encoder, value *FoundWebApp) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (foundWebApp *FoundWebApp) encode(encoder *encoder) {
+	encoder.meta(TypeFoundWebApp, foundWebApp.meta)
+	encoder.name("web_app")
+	encodeWebApp(encoder, foundWebApp.WebApp)
+	encoder.Bool("request_write_access", foundWebApp.RequestWriteAccess)
+	encoder.Bool("skip_confirmation", foundWebApp.SkipConfirmation)
+}
+
+func encodeWebAppInfo(encoder *encoder, value *WebAppInfo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (webAppInfo *WebAppInfo) encode(encoder *encoder) {
+	encoder.meta(TypeWebAppInfo, webAppInfo.meta)
+	encoder.JsonInt64("launch_id", webAppInfo.LaunchId)
+	encoder.String("url", webAppInfo.Url)
+}
+
+func encodeMessageThreadInfo(encoder *encoder, value *MessageThreadInfo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageThreadInfo *MessageThreadInfo) encode(encoder *encoder) {
+	encoder.meta(TypeMessageThreadInfo, messageThreadInfo.meta)
+	encoder.Int64("chat_id", messageThreadInfo.ChatId)
+	encoder.Int64("message_thread_id", messageThreadInfo.MessageThreadId)
+	encoder.name("reply_info")
+	encodeMessageReplyInfo(encoder, messageThreadInfo.ReplyInfo)
+	encoder.Int32("unread_message_count", messageThreadInfo.UnreadMessageCount)
+	encoder.name("messages")
+	encodeListOfMessage(encoder, messageThreadInfo.Messages)
+	encoder.name("draft_message")
+	encodeDraftMessage(encoder, messageThreadInfo.DraftMessage)
+}
+
+func encodeForumTopicIcon(encoder *encoder, value *ForumTopicIcon) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (forumTopicIcon *ForumTopicIcon) encode(encoder *encoder) {
+	encoder.meta(TypeForumTopicIcon, forumTopicIcon.meta)
+	encoder.Int32("color", forumTopicIcon.Color)
+	encoder.JsonInt64("custom_emoji_id", forumTopicIcon.CustomEmojiId)
+}
+
+func encodeForumTopicInfo(encoder *encoder, value *ForumTopicInfo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (forumTopicInfo *ForumTopicInfo) encode(encoder *encoder) {
+	encoder.meta(TypeForumTopicInfo, forumTopicInfo.meta)
+	encoder.Int64("message_thread_id", forumTopicInfo.MessageThreadId)
+	encoder.String("name", forumTopicInfo.Name)
+	encoder.name("icon")
+	encodeForumTopicIcon(encoder, forumTopicInfo.Icon)
+	encoder.Int32("creation_date", forumTopicInfo.CreationDate)
+	encoder.name("creator_id")
+	encodeMessageSender(encoder, forumTopicInfo.CreatorId)
+	encoder.Bool("is_general", forumTopicInfo.IsGeneral)
+	encoder.Bool("is_outgoing", forumTopicInfo.IsOutgoing)
+	encoder.Bool("is_closed", forumTopicInfo.IsClosed)
+	encoder.Bool("is_hidden", forumTopicInfo.IsHidden)
+}
+
+func encodeForumTopic(encoder *encoder, value *ForumTopic) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (forumTopic *ForumTopic) encode(encoder *encoder) {
+	encoder.meta(TypeForumTopic, forumTopic.meta)
+	encoder.name("info")
+	encodeForumTopicInfo(encoder, forumTopic.Info)
+	encoder.name("last_message")
+	encodeMessage(encoder, forumTopic.LastMessage)
+	encoder.Bool("is_pinned", forumTopic.IsPinned)
+	encoder.Int32("unread_count", forumTopic.UnreadCount)
+	encoder.Int64("last_read_inbox_message_id", forumTopic.LastReadInboxMessageId)
+	encoder.Int64("last_read_outbox_message_id", forumTopic.LastReadOutboxMessageId)
+	encoder.Int32("unread_mention_count", forumTopic.UnreadMentionCount)
+	encoder.Int32("unread_reaction_count", forumTopic.UnreadReactionCount)
+	encoder.name("notification_settings")
+	encodeChatNotificationSettings(encoder, forumTopic.NotificationSettings)
+	encoder.name("draft_message")
+	encodeDraftMessage(encoder, forumTopic.DraftMessage)
+}
+
+func encodeForumTopics(encoder *encoder, value *ForumTopics) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (forumTopics *ForumTopics) encode(encoder *encoder) {
+	encoder.meta(TypeForumTopics, forumTopics.meta)
+	encoder.Int32("total_count", forumTopics.TotalCount)
+	encoder.name("topics")
+	encodeListOfForumTopic(encoder, forumTopics.Topics)
+	encoder.Int32("next_offset_date", forumTopics.NextOffsetDate)
+	encoder.Int64("next_offset_message_id", forumTopics.NextOffsetMessageId)
+	encoder.Int64("next_offset_message_thread_id", forumTopics.NextOffsetMessageThreadId)
+}
+
+func encodeRichTextPlain(encoder *encoder, value *RichTextPlain) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (richTextPlain *RichTextPlain) encode(encoder *encoder) {
+	encoder.meta(TypeRichTextPlain, richTextPlain.meta)
+	encoder.String("text", richTextPlain.Text)
+}
+
+func encodeRichTextBold(encoder *encoder, value *RichTextBold) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (richTextBold *RichTextBold) encode(encoder *encoder) {
+	encoder.meta(TypeRichTextBold, richTextBold.meta)
+	encoder.name("text")
+	encodeRichText(encoder, richTextBold.Text)
+}
+
+func encodeRichTextItalic(encoder *encoder, value *RichTextItalic) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (richTextItalic *RichTextItalic) encode(encoder *encoder) {
+	encoder.meta(TypeRichTextItalic, richTextItalic.meta)
+	encoder.name("text")
+	encodeRichText(encoder, richTextItalic.Text)
+}
+
+func encodeRichTextUnderline(encoder *encoder, value *RichTextUnderline) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (richTextUnderline *RichTextUnderline) encode(encoder *encoder) {
+	encoder.meta(TypeRichTextUnderline, richTextUnderline.meta)
+	encoder.name("text")
+	encodeRichText(encoder, richTextUnderline.Text)
+}
+
+func encodeRichTextStrikethrough(encoder *encoder, value *RichTextStrikethrough) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (richTextStrikethrough *RichTextStrikethrough) encode(encoder *encoder) {
+	encoder.meta(TypeRichTextStrikethrough, richTextStrikethrough.meta)
+	encoder.name("text")
+	encodeRichText(encoder, richTextStrikethrough.Text)
+}
+
+func encodeRichTextFixed(encoder *encoder, value *RichTextFixed) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (richTextFixed *RichTextFixed) encode(encoder *encoder) {
+	encoder.meta(TypeRichTextFixed, richTextFixed.meta)
+	encoder.name("text")
+	encodeRichText(encoder, richTextFixed.Text)
+}
+
+func encodeRichTextUrl(encoder *encoder, value *RichTextUrl) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (richTextUrl *RichTextUrl) encode(encoder *encoder) {
+	encoder.meta(TypeRichTextUrl, richTextUrl.meta)
+	encoder.name("text")
+	encodeRichText(encoder, richTextUrl.Text)
+	encoder.String("url", richTextUrl.Url)
+	encoder.Bool("is_cached", richTextUrl.IsCached)
+}
+
+func encodeRichTextEmailAddress(encoder *encoder, value *RichTextEmailAddress) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (richTextEmailAddress *RichTextEmailAddress) encode(encoder *encoder) {
+	encoder.meta(TypeRichTextEmailAddress, richTextEmailAddress.meta)
+	encoder.name("text")
+	encodeRichText(encoder, richTextEmailAddress.Text)
+	encoder.String("email_address", richTextEmailAddress.EmailAddress)
+}
+
+func encodeRichTextSubscript(encoder *encoder, value *RichTextSubscript) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (richTextSubscript *RichTextSubscript) encode(encoder *encoder) {
+	encoder.meta(TypeRichTextSubscript, richTextSubscript.meta)
+	encoder.name("text")
+	encodeRichText(encoder, richTextSubscript.Text)
+}
+
+func encodeRichTextSuperscript(encoder *encoder, value *RichTextSuperscript) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (richTextSuperscript *RichTextSuperscript) encode(encoder *encoder) {
+	encoder.meta(TypeRichTextSuperscript, richTextSuperscript.meta)
+	encoder.name("text")
+	encodeRichText(encoder, richTextSuperscript.Text)
+}
+
+func encodeRichTextMarked(encoder *encoder, value *RichTextMarked) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (richTextMarked *RichTextMarked) encode(encoder *encoder) {
+	encoder.meta(TypeRichTextMarked, richTextMarked.meta)
+	encoder.name("text")
+	encodeRichText(encoder, richTextMarked.Text)
+}
+
+func encodeRichTextPhoneNumber(encoder *encoder, value *RichTextPhoneNumber) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (richTextPhoneNumber *RichTextPhoneNumber) encode(encoder *encoder) {
+	encoder.meta(TypeRichTextPhoneNumber, richTextPhoneNumber.meta)
+	encoder.name("text")
+	encodeRichText(encoder, richTextPhoneNumber.Text)
+	encoder.String("phone_number", richTextPhoneNumber.PhoneNumber)
+}
+
+func encodeRichTextIcon(encoder *encoder, value *RichTextIcon) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (richTextIcon *RichTextIcon) encode(encoder *encoder) {
+	encoder.meta(TypeRichTextIcon, richTextIcon.meta)
+	encoder.name("document")
+	encodeDocument(encoder, richTextIcon.Document)
+	encoder.Int32("width", richTextIcon.Width)
+	encoder.Int32("height", richTextIcon.Height)
+}
+
+func encodeRichTextReference(encoder *encoder, value *RichTextReference) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (richTextReference *RichTextReference) encode(encoder *encoder) {
+	encoder.meta(TypeRichTextReference, richTextReference.meta)
+	encoder.name("text")
+	encodeRichText(encoder, richTextReference.Text)
+	encoder.String("anchor_name", richTextReference.AnchorName)
+	encoder.String("url", richTextReference.Url)
+}
+
+func encodeRichTextAnchor(encoder *encoder, value *RichTextAnchor) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (richTextAnchor *RichTextAnchor) encode(encoder *encoder) {
+	encoder.meta(TypeRichTextAnchor, richTextAnchor.meta)
+	encoder.String("name", richTextAnchor.Name)
+}
+
+func encodeRichTextAnchorLink(encoder *encoder, value *RichTextAnchorLink) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (richTextAnchorLink *RichTextAnchorLink) encode(encoder *encoder) {
+	encoder.meta(TypeRichTextAnchorLink, richTextAnchorLink.meta)
+	encoder.name("text")
+	encodeRichText(encoder, richTextAnchorLink.Text)
+	encoder.String("anchor_name", richTextAnchorLink.AnchorName)
+	encoder.String("url", richTextAnchorLink.Url)
+}
+
+func encodeRichTexts(encoder *encoder, value *RichTexts) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (richTexts *RichTexts) encode(encoder *encoder) {
+	encoder.meta(TypeRichTexts, richTexts.meta)
+	encoder.name("texts")
+	encodeListOfRichText(encoder, richTexts.Texts)
+}
+
+func encodePageBlockCaption(encoder *encoder, value *PageBlockCaption) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pageBlockCaption *PageBlockCaption) encode(encoder *encoder) {
+	encoder.meta(TypePageBlockCaption, pageBlockCaption.meta)
+	encoder.name("text")
+	encodeRichText(encoder, pageBlockCaption.Text)
+	encoder.name("credit")
+	encodeRichText(encoder, pageBlockCaption.Credit)
+}
+
+func encodePageBlockListItem(encoder *encoder, value *PageBlockListItem) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pageBlockListItem *PageBlockListItem) encode(encoder *encoder) {
+	encoder.meta(TypePageBlockListItem, pageBlockListItem.meta)
+	encoder.String("label", pageBlockListItem.Label)
+	encoder.name("page_blocks")
+	encodeListOfPageBlock(encoder, pageBlockListItem.PageBlocks)
+}
+
+func encodePageBlockHorizontalAlignmentLeft(encoder *encoder, value *PageBlockHorizontalAlignmentLeft) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pageBlockHorizontalAlignmentLeft *PageBlockHorizontalAlignmentLeft) encode(encoder *encoder) {
+	encoder.meta(TypePageBlockHorizontalAlignmentLeft, pageBlockHorizontalAlignmentLeft.meta)
+}
+
+func encodePageBlockHorizontalAlignmentCenter(encoder *encoder, value *PageBlockHorizontalAlignmentCenter) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pageBlockHorizontalAlignmentCenter *PageBlockHorizontalAlignmentCenter) encode(encoder *encoder) {
+	encoder.meta(TypePageBlockHorizontalAlignmentCenter, pageBlockHorizontalAlignmentCenter.meta)
+}
+
+func encodePageBlockHorizontalAlignmentRight(encoder *encoder, value *PageBlockHorizontalAlignmentRight) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pageBlockHorizontalAlignmentRight *PageBlockHorizontalAlignmentRight) encode(encoder *encoder) {
+	encoder.meta(TypePageBlockHorizontalAlignmentRight, pageBlockHorizontalAlignmentRight.meta)
+}
+
+func encodePageBlockVerticalAlignmentTop(encoder *encoder, value *PageBlockVerticalAlignmentTop) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pageBlockVerticalAlignmentTop *PageBlockVerticalAlignmentTop) encode(encoder *encoder) {
+	encoder.meta(TypePageBlockVerticalAlignmentTop, pageBlockVerticalAlignmentTop.meta)
+}
+
+func encodePageBlockVerticalAlignmentMiddle(encoder *encoder, value *PageBlockVerticalAlignmentMiddle) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pageBlockVerticalAlignmentMiddle *PageBlockVerticalAlignmentMiddle) encode(encoder *encoder) {
+	encoder.meta(TypePageBlockVerticalAlignmentMiddle, pageBlockVerticalAlignmentMiddle.meta)
+}
+
+func encodePageBlockVerticalAlignmentBottom(encoder *encoder, value *PageBlockVerticalAlignmentBottom) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pageBlockVerticalAlignmentBottom *PageBlockVerticalAlignmentBottom) encode(encoder *encoder) {
+	encoder.meta(TypePageBlockVerticalAlignmentBottom, pageBlockVerticalAlignmentBottom.meta)
+}
+
+func encodePageBlockTableCell(encoder *encoder, value *PageBlockTableCell) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pageBlockTableCell *PageBlockTableCell) encode(encoder *encoder) {
+	encoder.meta(TypePageBlockTableCell, pageBlockTableCell.meta)
+	encoder.name("text")
+	encodeRichText(encoder, pageBlockTableCell.Text)
+	encoder.Bool("is_header", pageBlockTableCell.IsHeader)
+	encoder.Int32("colspan", pageBlockTableCell.Colspan)
+	encoder.Int32("rowspan", pageBlockTableCell.Rowspan)
+	encoder.name("align")
+	encodePageBlockHorizontalAlignment(encoder, pageBlockTableCell.Align)
+	encoder.name("valign")
+	encodePageBlockVerticalAlignment(encoder, pageBlockTableCell.Valign)
+}
+
+func encodePageBlockRelatedArticle(encoder *encoder, value *PageBlockRelatedArticle) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pageBlockRelatedArticle *PageBlockRelatedArticle) encode(encoder *encoder) {
+	encoder.meta(TypePageBlockRelatedArticle, pageBlockRelatedArticle.meta)
+	encoder.String("url", pageBlockRelatedArticle.Url)
+	encoder.String("title", pageBlockRelatedArticle.Title)
+	encoder.String("description", pageBlockRelatedArticle.Description)
+	encoder.name("photo")
+	encodePhoto(encoder, pageBlockRelatedArticle.Photo)
+	encoder.String("author", pageBlockRelatedArticle.Author)
+	encoder.Int32("publish_date", pageBlockRelatedArticle.PublishDate)
+}
+
+func encodePageBlockTitle(encoder *encoder, value *PageBlockTitle) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pageBlockTitle *PageBlockTitle) encode(encoder *encoder) {
+	encoder.meta(TypePageBlockTitle, pageBlockTitle.meta)
+	encoder.name("title")
+	encodeRichText(encoder, pageBlockTitle.Title)
+}
+
+func encodePageBlockSubtitle(encoder *encoder, value *PageBlockSubtitle) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pageBlockSubtitle *PageBlockSubtitle) encode(encoder *encoder) {
+	encoder.meta(TypePageBlockSubtitle, pageBlockSubtitle.meta)
+	encoder.name("subtitle")
+	encodeRichText(encoder, pageBlockSubtitle.Subtitle)
+}
+
+func encodePageBlockAuthorDate(encoder *encoder, value *PageBlockAuthorDate) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pageBlockAuthorDate *PageBlockAuthorDate) encode(encoder *encoder) {
+	encoder.meta(TypePageBlockAuthorDate, pageBlockAuthorDate.meta)
+	encoder.name("author")
+	encodeRichText(encoder, pageBlockAuthorDate.Author)
+	encoder.Int32("publish_date", pageBlockAuthorDate.PublishDate)
+}
+
+func encodePageBlockHeader(encoder *encoder, value *PageBlockHeader) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pageBlockHeader *PageBlockHeader) encode(encoder *encoder) {
+	encoder.meta(TypePageBlockHeader, pageBlockHeader.meta)
+	encoder.name("header")
+	encodeRichText(encoder, pageBlockHeader.Header)
+}
+
+func encodePageBlockSubheader(encoder *encoder, value *PageBlockSubheader) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pageBlockSubheader *PageBlockSubheader) encode(encoder *encoder) {
+	encoder.meta(TypePageBlockSubheader, pageBlockSubheader.meta)
+	encoder.name("subheader")
+	encodeRichText(encoder, pageBlockSubheader.Subheader)
+}
+
+func encodePageBlockKicker(encoder *encoder, value *PageBlockKicker) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pageBlockKicker *PageBlockKicker) encode(encoder *encoder) {
+	encoder.meta(TypePageBlockKicker, pageBlockKicker.meta)
+	encoder.name("kicker")
+	encodeRichText(encoder, pageBlockKicker.Kicker)
+}
+
+func encodePageBlockParagraph(encoder *encoder, value *PageBlockParagraph) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pageBlockParagraph *PageBlockParagraph) encode(encoder *encoder) {
+	encoder.meta(TypePageBlockParagraph, pageBlockParagraph.meta)
+	encoder.name("text")
+	encodeRichText(encoder, pageBlockParagraph.Text)
+}
+
+func encodePageBlockPreformatted(encoder *encoder, value *PageBlockPreformatted) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pageBlockPreformatted *PageBlockPreformatted) encode(encoder *encoder) {
+	encoder.meta(TypePageBlockPreformatted, pageBlockPreformatted.meta)
+	encoder.name("text")
+	encodeRichText(encoder, pageBlockPreformatted.Text)
+	encoder.String("language", pageBlockPreformatted.Language)
+}
+
+func encodePageBlockFooter(encoder *encoder, value *PageBlockFooter) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pageBlockFooter *PageBlockFooter) encode(encoder *encoder) {
+	encoder.meta(TypePageBlockFooter, pageBlockFooter.meta)
+	encoder.name("footer")
+	encodeRichText(encoder, pageBlockFooter.Footer)
+}
+
+func encodePageBlockDivider(encoder *encoder, value *PageBlockDivider) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pageBlockDivider *PageBlockDivider) encode(encoder *encoder) {
+	encoder.meta(TypePageBlockDivider, pageBlockDivider.meta)
+}
+
+func encodePageBlockAnchor(encoder *encoder, value *PageBlockAnchor) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pageBlockAnchor *PageBlockAnchor) encode(encoder *encoder) {
+	encoder.meta(TypePageBlockAnchor, pageBlockAnchor.meta)
+	encoder.String("name", pageBlockAnchor.Name)
+}
+
+func encodePageBlockList(encoder *encoder, value *PageBlockList) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pageBlockList *PageBlockList) encode(encoder *encoder) {
+	encoder.meta(TypePageBlockList, pageBlockList.meta)
+	encoder.name("items")
+	encodeListOfPageBlockListItem(encoder, pageBlockList.Items)
+}
+
+func encodePageBlockBlockQuote(encoder *encoder, value *PageBlockBlockQuote) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pageBlockBlockQuote *PageBlockBlockQuote) encode(encoder *encoder) {
+	encoder.meta(TypePageBlockBlockQuote, pageBlockBlockQuote.meta)
+	encoder.name("text")
+	encodeRichText(encoder, pageBlockBlockQuote.Text)
+	encoder.name("credit")
+	encodeRichText(encoder, pageBlockBlockQuote.Credit)
+}
+
+func encodePageBlockPullQuote(encoder *encoder, value *PageBlockPullQuote) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pageBlockPullQuote *PageBlockPullQuote) encode(encoder *encoder) {
+	encoder.meta(TypePageBlockPullQuote, pageBlockPullQuote.meta)
+	encoder.name("text")
+	encodeRichText(encoder, pageBlockPullQuote.Text)
+	encoder.name("credit")
+	encodeRichText(encoder, pageBlockPullQuote.Credit)
+}
+
+func encodePageBlockAnimation(encoder *encoder, value *PageBlockAnimation) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pageBlockAnimation *PageBlockAnimation) encode(encoder *encoder) {
+	encoder.meta(TypePageBlockAnimation, pageBlockAnimation.meta)
+	encoder.name("animation")
+	encodeAnimation(encoder, pageBlockAnimation.Animation)
+	encoder.name("caption")
+	encodePageBlockCaption(encoder, pageBlockAnimation.Caption)
+	encoder.Bool("need_autoplay", pageBlockAnimation.NeedAutoplay)
+}
+
+func encodePageBlockAudio(encoder *encoder, value *PageBlockAudio) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pageBlockAudio *PageBlockAudio) encode(encoder *encoder) {
+	encoder.meta(TypePageBlockAudio, pageBlockAudio.meta)
+	encoder.name("audio")
+	encodeAudio(encoder, pageBlockAudio.Audio)
+	encoder.name("caption")
+	encodePageBlockCaption(encoder, pageBlockAudio.Caption)
+}
+
+func encodePageBlockPhoto(encoder *encoder, value *PageBlockPhoto) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pageBlockPhoto *PageBlockPhoto) encode(encoder *encoder) {
+	encoder.meta(TypePageBlockPhoto, pageBlockPhoto.meta)
+	encoder.name("photo")
+	encodePhoto(encoder, pageBlockPhoto.Photo)
+	encoder.name("caption")
+	encodePageBlockCaption(encoder, pageBlockPhoto.Caption)
+	encoder.String("url", pageBlockPhoto.Url)
+}
+
+func encodePageBlockVideo(encoder *encoder, value *PageBlockVideo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pageBlockVideo *PageBlockVideo) encode(encoder *encoder) {
+	encoder.meta(TypePageBlockVideo, pageBlockVideo.meta)
+	encoder.name("video")
+	encodeVideo(encoder, pageBlockVideo.Video)
+	encoder.name("caption")
+	encodePageBlockCaption(encoder, pageBlockVideo.Caption)
+	encoder.Bool("need_autoplay", pageBlockVideo.NeedAutoplay)
+	encoder.Bool("is_looped", pageBlockVideo.IsLooped)
+}
+
+func encodePageBlockVoiceNote(encoder *encoder, value *PageBlockVoiceNote) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pageBlockVoiceNote *PageBlockVoiceNote) encode(encoder *encoder) {
+	encoder.meta(TypePageBlockVoiceNote, pageBlockVoiceNote.meta)
+	encoder.name("voice_note")
+	encodeVoiceNote(encoder, pageBlockVoiceNote.VoiceNote)
+	encoder.name("caption")
+	encodePageBlockCaption(encoder, pageBlockVoiceNote.Caption)
+}
+
+func encodePageBlockCover(encoder *encoder, value *PageBlockCover) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pageBlockCover *PageBlockCover) encode(encoder *encoder) {
+	encoder.meta(TypePageBlockCover, pageBlockCover.meta)
+	encoder.name("cover")
+	encodePageBlock(encoder, pageBlockCover.Cover)
+}
+
+func encodePageBlockEmbedded(encoder *encoder, value *PageBlockEmbedded) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pageBlockEmbedded *PageBlockEmbedded) encode(encoder *encoder) {
+	encoder.meta(TypePageBlockEmbedded, pageBlockEmbedded.meta)
+	encoder.String("url", pageBlockEmbedded.Url)
+	encoder.String("html", pageBlockEmbedded.Html)
+	encoder.name("poster_photo")
+	encodePhoto(encoder, pageBlockEmbedded.PosterPhoto)
+	encoder.Int32("width", pageBlockEmbedded.Width)
+	encoder.Int32("height", pageBlockEmbedded.Height)
+	encoder.name("caption")
+	encodePageBlockCaption(encoder, pageBlockEmbedded.Caption)
+	encoder.Bool("is_full_width", pageBlockEmbedded.IsFullWidth)
+	encoder.Bool("allow_scrolling", pageBlockEmbedded.AllowScrolling)
+}
+
+func encodePageBlockEmbeddedPost(encoder *encoder, value *PageBlockEmbeddedPost) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pageBlockEmbeddedPost *PageBlockEmbeddedPost) encode(encoder *encoder) {
+	encoder.meta(TypePageBlockEmbeddedPost, pageBlockEmbeddedPost.meta)
+	encoder.String("url", pageBlockEmbeddedPost.Url)
+	encoder.String("author", pageBlockEmbeddedPost.Author)
+	encoder.name("author_photo")
+	encodePhoto(encoder, pageBlockEmbeddedPost.AuthorPhoto)
+	encoder.Int32("date", pageBlockEmbeddedPost.Date)
+	encoder.name("page_blocks")
+	encodeListOfPageBlock(encoder, pageBlockEmbeddedPost.PageBlocks)
+	encoder.name("caption")
+	encodePageBlockCaption(encoder, pageBlockEmbeddedPost.Caption)
+}
+
+func encodePageBlockCollage(encoder *encoder, value *PageBlockCollage) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pageBlockCollage *PageBlockCollage) encode(encoder *encoder) {
+	encoder.meta(TypePageBlockCollage, pageBlockCollage.meta)
+	encoder.name("page_blocks")
+	encodeListOfPageBlock(encoder, pageBlockCollage.PageBlocks)
+	encoder.name("caption")
+	encodePageBlockCaption(encoder, pageBlockCollage.Caption)
+}
+
+func encodePageBlockSlideshow(encoder *encoder, value *PageBlockSlideshow) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pageBlockSlideshow *PageBlockSlideshow) encode(encoder *encoder) {
+	encoder.meta(TypePageBlockSlideshow, pageBlockSlideshow.meta)
+	encoder.name("page_blocks")
+	encodeListOfPageBlock(encoder, pageBlockSlideshow.PageBlocks)
+	encoder.name("caption")
+	encodePageBlockCaption(encoder, pageBlockSlideshow.Caption)
+}
+
+func encodePageBlockChatLink(encoder *encoder, value *PageBlockChatLink) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pageBlockChatLink *PageBlockChatLink) encode(encoder *encoder) {
+	encoder.meta(TypePageBlockChatLink, pageBlockChatLink.meta)
+	encoder.String("title", pageBlockChatLink.Title)
+	encoder.name("photo")
+	encodeChatPhotoInfo(encoder, pageBlockChatLink.Photo)
+	encoder.String("username", pageBlockChatLink.Username)
+}
+
+func encodePageBlockTable(encoder *encoder, value *PageBlockTable) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pageBlockTable *PageBlockTable) encode(encoder *encoder) {
+	encoder.meta(TypePageBlockTable, pageBlockTable.meta)
+	encoder.name("caption")
+	encodeRichText(encoder, pageBlockTable.Caption)
+	encoder.name("cells")
+	encodeListOfListOfPageBlockTableCell(encoder, pageBlockTable.Cells)
+	encoder.Bool("is_bordered", pageBlockTable.IsBordered)
+	encoder.Bool("is_striped", pageBlockTable.IsStriped)
+}
+
+func encodePageBlockDetails(encoder *encoder, value *PageBlockDetails) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pageBlockDetails *PageBlockDetails) encode(encoder *encoder) {
+	encoder.meta(TypePageBlockDetails, pageBlockDetails.meta)
+	encoder.name("header")
+	encodeRichText(encoder, pageBlockDetails.Header)
+	encoder.name("page_blocks")
+	encodeListOfPageBlock(encoder, pageBlockDetails.PageBlocks)
+	encoder.Bool("is_open", pageBlockDetails.IsOpen)
+}
+
+func encodePageBlockRelatedArticles(encoder *encoder, value *PageBlockRelatedArticles) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pageBlockRelatedArticles *PageBlockRelatedArticles) encode(encoder *encoder) {
+	encoder.meta(TypePageBlockRelatedArticles, pageBlockRelatedArticles.meta)
+	encoder.name("header")
+	encodeRichText(encoder, pageBlockRelatedArticles.Header)
+	encoder.name("articles")
+	encodeListOfPageBlockRelatedArticle(encoder, pageBlockRelatedArticles.Articles)
+}
+
+func encodePageBlockMap(encoder *encoder, value *PageBlockMap) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pageBlockMap *PageBlockMap) encode(encoder *encoder) {
+	encoder.meta(TypePageBlockMap, pageBlockMap.meta)
+	encoder.name("location")
+	encodeLocation(encoder, pageBlockMap.Location)
+	encoder.Int32("zoom", pageBlockMap.Zoom)
+	encoder.Int32("width", pageBlockMap.Width)
+	encoder.Int32("height", pageBlockMap.Height)
+	encoder.name("caption")
+	encodePageBlockCaption(encoder, pageBlockMap.Caption)
+}
+
+func encodeWebPageInstantView(encoder *encoder, value *WebPageInstantView) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (webPageInstantView *WebPageInstantView) encode(encoder *encoder) {
+	encoder.meta(TypeWebPageInstantView, webPageInstantView.meta)
+	encoder.name("page_blocks")
+	encodeListOfPageBlock(encoder, webPageInstantView.PageBlocks)
+	encoder.Int32("view_count", webPageInstantView.ViewCount)
+	encoder.Int32("version", webPageInstantView.Version)
+	encoder.Bool("is_rtl", webPageInstantView.IsRtl)
+	encoder.Bool("is_full", webPageInstantView.IsFull)
+	encoder.name("feedback_link")
+	encodeInternalLinkType(encoder, webPageInstantView.FeedbackLink)
+}
+
+func encodeWebPage(encoder *encoder, value *WebPage) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (webPage *WebPage) encode(encoder *encoder) {
+	encoder.meta(TypeWebPage, webPage.meta)
+	encoder.String("url", webPage.Url)
+	encoder.String("display_url", webPage.DisplayUrl)
+	encoder.String("type", webPage.Type)
+	encoder.String("site_name", webPage.SiteName)
+	encoder.String("title", webPage.Title)
+	encoder.name("description")
+	encodeFormattedText(encoder, webPage.Description)
+	encoder.name("photo")
+	encodePhoto(encoder, webPage.Photo)
+	encoder.String("embed_url", webPage.EmbedUrl)
+	encoder.String("embed_type", webPage.EmbedType)
+	encoder.Int32("embed_width", webPage.EmbedWidth)
+	encoder.Int32("embed_height", webPage.EmbedHeight)
+	encoder.Int32("duration", webPage.Duration)
+	encoder.String("author", webPage.Author)
+	encoder.name("animation")
+	encodeAnimation(encoder, webPage.Animation)
+	encoder.name("audio")
+	encodeAudio(encoder, webPage.Audio)
+	encoder.name("document")
+	encodeDocument(encoder, webPage.Document)
+	encoder.name("sticker")
+	encodeSticker(encoder, webPage.Sticker)
+	encoder.name("video")
+	encodeVideo(encoder, webPage.Video)
+	encoder.name("video_note")
+	encodeVideoNote(encoder, webPage.VideoNote)
+	encoder.name("voice_note")
+	encodeVoiceNote(encoder, webPage.VoiceNote)
+	encoder.Int32("instant_view_version", webPage.InstantViewVersion)
+}
+
+func encodeCountryInfo(encoder *encoder, value *CountryInfo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (countryInfo *CountryInfo) encode(encoder *encoder) {
+	encoder.meta(TypeCountryInfo, countryInfo.meta)
+	encoder.String("country_code", countryInfo.CountryCode)
+	encoder.String("name", countryInfo.Name)
+	encoder.String("english_name", countryInfo.EnglishName)
+	encoder.Bool("is_hidden", countryInfo.IsHidden)
+	encoder.Strings("calling_codes", countryInfo.CallingCodes)
+}
+
+func encodeCountries(encoder *encoder, value *Countries) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (countries *Countries) encode(encoder *encoder) {
+	encoder.meta(TypeCountries, countries.meta)
+	encoder.name("countries")
+	encodeListOfCountryInfo(encoder, countries.Countries)
+}
+
+func encodePhoneNumberInfo(encoder *encoder, value *PhoneNumberInfo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (phoneNumberInfo *PhoneNumberInfo) encode(encoder *encoder) {
+	encoder.meta(TypePhoneNumberInfo, phoneNumberInfo.meta)
+	encoder.name("country")
+	encodeCountryInfo(encoder, phoneNumberInfo.Country)
+	encoder.String("country_calling_code", phoneNumberInfo.CountryCallingCode)
+	encoder.String("formatted_phone_number", phoneNumberInfo.FormattedPhoneNumber)
+	encoder.Bool("is_anonymous", phoneNumberInfo.IsAnonymous)
+}
+
+func encodeBankCardActionOpenUrl(encoder *encoder, value *BankCardActionOpenUrl) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (bankCardActionOpenUrl *BankCardActionOpenUrl) encode(encoder *encoder) {
+	encoder.meta(TypeBankCardActionOpenUrl, bankCardActionOpenUrl.meta)
+	encoder.String("text", bankCardActionOpenUrl.Text)
+	encoder.String("url", bankCardActionOpenUrl.Url)
+}
+
+func encodeBankCardInfo(encoder *encoder, value *BankCardInfo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (bankCardInfo *BankCardInfo) encode(encoder *encoder) {
+	encoder.meta(TypeBankCardInfo, bankCardInfo.meta)
+	encoder.String("title", bankCardInfo.Title)
+	encoder.name("actions")
+	encodeListOfBankCardActionOpenUrl(encoder, bankCardInfo.Actions)
+}
+
+func encodeAddress(encoder *encoder, value *Address) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (address *Address) encode(encoder *encoder) {
+	encoder.meta(TypeAddress, address.meta)
+	encoder.String("country_code", address.CountryCode)
+	encoder.String("state", address.State)
+	encoder.String("city", address.City)
+	encoder.String("street_line1", address.StreetLine1)
+	encoder.String("street_line2", address.StreetLine2)
+	encoder.String("postal_code", address.PostalCode)
+}
+
+func encodeThemeParameters(encoder *encoder, value *ThemeParameters) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (themeParameters *ThemeParameters) encode(encoder *encoder) {
+	encoder.meta(TypeThemeParameters, themeParameters.meta)
+	encoder.Int32("background_color", themeParameters.BackgroundColor)
+	encoder.Int32("secondary_background_color", themeParameters.SecondaryBackgroundColor)
+	encoder.Int32("text_color", themeParameters.TextColor)
+	encoder.Int32("hint_color", themeParameters.HintColor)
+	encoder.Int32("link_color", themeParameters.LinkColor)
+	encoder.Int32("button_color", themeParameters.ButtonColor)
+	encoder.Int32("button_text_color", themeParameters.ButtonTextColor)
+}
+
+func encodeLabeledPricePart(encoder *encoder, value *LabeledPricePart) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (labeledPricePart *LabeledPricePart) encode(encoder *encoder) {
+	encoder.meta(TypeLabeledPricePart, labeledPricePart.meta)
+	encoder.String("label", labeledPricePart.Label)
+	encoder.Int64("amount", labeledPricePart.Amount)
+}
+
+func encodeInvoice(encoder *encoder, value *Invoice) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (invoice *Invoice) encode(encoder *encoder) {
+	encoder.meta(TypeInvoice, invoice.meta)
+	encoder.String("currency", invoice.Currency)
+	encoder.name("price_parts")
+	encodeListOfLabeledPricePart(encoder, invoice.PriceParts)
+	encoder.Int64("max_tip_amount", invoice.MaxTipAmount)
+	encoder.Int64s("suggested_tip_amounts", invoice.SuggestedTipAmounts)
+	encoder.String("recurring_payment_terms_of_service_url", invoice.RecurringPaymentTermsOfServiceUrl)
+	encoder.Bool("is_test", invoice.IsTest)
+	encoder.Bool("need_name", invoice.NeedName)
+	encoder.Bool("need_phone_number", invoice.NeedPhoneNumber)
+	encoder.Bool("need_email_address", invoice.NeedEmailAddress)
+	encoder.Bool("need_shipping_address", invoice.NeedShippingAddress)
+	encoder.Bool("send_phone_number_to_provider", invoice.SendPhoneNumberToProvider)
+	encoder.Bool("send_email_address_to_provider", invoice.SendEmailAddressToProvider)
+	encoder.Bool("is_flexible", invoice.IsFlexible)
+}
+
+func encodeOrderInfo(encoder *encoder, value *OrderInfo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (orderInfo *OrderInfo) encode(encoder *encoder) {
+	encoder.meta(TypeOrderInfo, orderInfo.meta)
+	encoder.String("name", orderInfo.Name)
+	encoder.String("phone_number", orderInfo.PhoneNumber)
+	encoder.String("email_address", orderInfo.EmailAddress)
+	encoder.name("shipping_address")
+	encodeAddress(encoder, orderInfo.ShippingAddress)
+}
+
+func encodeShippingOption(encoder *encoder, value *ShippingOption) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (shippingOption *ShippingOption) encode(encoder *encoder) {
+	encoder.meta(TypeShippingOption, shippingOption.meta)
+	encoder.String("id", shippingOption.Id)
+	encoder.String("title", shippingOption.Title)
+	encoder.name("price_parts")
+	encodeListOfLabeledPricePart(encoder, shippingOption.PriceParts)
+}
+
+func encodeSavedCredentials(encoder *encoder, value *SavedCredentials) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (savedCredentials *SavedCredentials) encode(encoder *encoder) {
+	encoder.meta(TypeSavedCredentials, savedCredentials.meta)
+	encoder.String("id", savedCredentials.Id)
+	encoder.String("title", savedCredentials.Title)
+}
+
+func encodeInputCredentialsSaved(encoder *encoder, value *InputCredentialsSaved) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputCredentialsSaved *InputCredentialsSaved) encode(encoder *encoder) {
+	encoder.meta(TypeInputCredentialsSaved, inputCredentialsSaved.meta)
+	encoder.String("saved_credentials_id", inputCredentialsSaved.SavedCredentialsId)
+}
+
+func encodeInputCredentialsNew(encoder *encoder, value *InputCredentialsNew) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputCredentialsNew *InputCredentialsNew) encode(encoder *encoder) {
+	encoder.meta(TypeInputCredentialsNew, inputCredentialsNew.meta)
+	encoder.String("data", inputCredentialsNew.Data)
+	encoder.Bool("allow_save", inputCredentialsNew.AllowSave)
+}
+
+func encodeInputCredentialsApplePay(encoder *encoder, value *InputCredentialsApplePay) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputCredentialsApplePay *InputCredentialsApplePay) encode(encoder *encoder) {
+	encoder.meta(TypeInputCredentialsApplePay, inputCredentialsApplePay.meta)
+	encoder.String("data", inputCredentialsApplePay.Data)
+}
+
+func encodeInputCredentialsGooglePay(encoder *encoder, value *InputCredentialsGooglePay) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputCredentialsGooglePay *InputCredentialsGooglePay) encode(encoder *encoder) {
+	encoder.meta(TypeInputCredentialsGooglePay, inputCredentialsGooglePay.meta)
+	encoder.String("data", inputCredentialsGooglePay.Data)
+}
+
+func encodePaymentProviderSmartGlocal(encoder *encoder, value *PaymentProviderSmartGlocal) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (paymentProviderSmartGlocal *PaymentProviderSmartGlocal) encode(encoder *encoder) {
+	encoder.meta(TypePaymentProviderSmartGlocal, paymentProviderSmartGlocal.meta)
+	encoder.String("public_token", paymentProviderSmartGlocal.PublicToken)
+}
+
+func encodePaymentProviderStripe(encoder *encoder, value *PaymentProviderStripe) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (paymentProviderStripe *PaymentProviderStripe) encode(encoder *encoder) {
+	encoder.meta(TypePaymentProviderStripe, paymentProviderStripe.meta)
+	encoder.String("publishable_key", paymentProviderStripe.PublishableKey)
+	encoder.Bool("need_country", paymentProviderStripe.NeedCountry)
+	encoder.Bool("need_postal_code", paymentProviderStripe.NeedPostalCode)
+	encoder.Bool("need_cardholder_name", paymentProviderStripe.NeedCardholderName)
+}
+
+func encodePaymentProviderOther(encoder *encoder, value *PaymentProviderOther) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (paymentProviderOther *PaymentProviderOther) encode(encoder *encoder) {
+	encoder.meta(TypePaymentProviderOther, paymentProviderOther.meta)
+	encoder.String("url", paymentProviderOther.Url)
+}
+
+func encodePaymentOption(encoder *encoder, value *PaymentOption) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (paymentOption *PaymentOption) encode(encoder *encoder) {
+	encoder.meta(TypePaymentOption, paymentOption.meta)
+	encoder.String("title", paymentOption.Title)
+	encoder.String("url", paymentOption.Url)
+}
+
+func encodePaymentForm(encoder *encoder, value *PaymentForm) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (paymentForm *PaymentForm) encode(encoder *encoder) {
+	encoder.meta(TypePaymentForm, paymentForm.meta)
+	encoder.JsonInt64("id", paymentForm.Id)
+	encoder.name("invoice")
+	encodeInvoice(encoder, paymentForm.Invoice)
+	encoder.Int64("seller_bot_user_id", paymentForm.SellerBotUserId)
+	encoder.Int64("payment_provider_user_id", paymentForm.PaymentProviderUserId)
+	encoder.name("payment_provider")
+	encodePaymentProvider(encoder, paymentForm.PaymentProvider)
+	encoder.name("additional_payment_options")
+	encodeListOfPaymentOption(encoder, paymentForm.AdditionalPaymentOptions)
+	encoder.name("saved_order_info")
+	encodeOrderInfo(encoder, paymentForm.SavedOrderInfo)
+	encoder.name("saved_credentials")
+	encodeListOfSavedCredentials(encoder, paymentForm.SavedCredentials)
+	encoder.Bool("can_save_credentials", paymentForm.CanSaveCredentials)
+	encoder.Bool("need_password", paymentForm.NeedPassword)
+	encoder.String("product_title", paymentForm.ProductTitle)
+	encoder.name("product_description")
+	encodeFormattedText(encoder, paymentForm.ProductDescription)
+	encoder.name("product_photo")
+	encodePhoto(encoder, paymentForm.ProductPhoto)
+}
+
+func encodeValidatedOrderInfo(encoder *encoder, value *ValidatedOrderInfo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (validatedOrderInfo *ValidatedOrderInfo) encode(encoder *encoder) {
+	encoder.meta(TypeValidatedOrderInfo, validatedOrderInfo.meta)
+	encoder.String("order_info_id", validatedOrderInfo.OrderInfoId)
+	encoder.name("shipping_options")
+	encodeListOfShippingOption(encoder, validatedOrderInfo.ShippingOptions)
+}
+
+func encodePaymentResult(encoder *encoder, value *PaymentResult) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (paymentResult *PaymentResult) encode(encoder *encoder) {
+	encoder.meta(TypePaymentResult, paymentResult.meta)
+	encoder.Bool("success", paymentResult.Success)
+	encoder.String("verification_url", paymentResult.VerificationUrl)
+}
+
+func encodePaymentReceipt(encoder *encoder, value *PaymentReceipt) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (paymentReceipt *PaymentReceipt) encode(encoder *encoder) {
+	encoder.meta(TypePaymentReceipt, paymentReceipt.meta)
+	encoder.String("title", paymentReceipt.Title)
+	encoder.name("description")
+	encodeFormattedText(encoder, paymentReceipt.Description)
+	encoder.name("photo")
+	encodePhoto(encoder, paymentReceipt.Photo)
+	encoder.Int32("date", paymentReceipt.Date)
+	encoder.Int64("seller_bot_user_id", paymentReceipt.SellerBotUserId)
+	encoder.Int64("payment_provider_user_id", paymentReceipt.PaymentProviderUserId)
+	encoder.name("invoice")
+	encodeInvoice(encoder, paymentReceipt.Invoice)
+	encoder.name("order_info")
+	encodeOrderInfo(encoder, paymentReceipt.OrderInfo)
+	encoder.name("shipping_option")
+	encodeShippingOption(encoder, paymentReceipt.ShippingOption)
+	encoder.String("credentials_title", paymentReceipt.CredentialsTitle)
+	encoder.Int64("tip_amount", paymentReceipt.TipAmount)
+}
+
+func encodeInputInvoiceMessage(encoder *encoder, value *InputInvoiceMessage) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputInvoiceMessage *InputInvoiceMessage) encode(encoder *encoder) {
+	encoder.meta(TypeInputInvoiceMessage, inputInvoiceMessage.meta)
+	encoder.Int64("chat_id", inputInvoiceMessage.ChatId)
+	encoder.Int64("message_id", inputInvoiceMessage.MessageId)
+}
+
+func encodeInputInvoiceName(encoder *encoder, value *InputInvoiceName) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputInvoiceName *InputInvoiceName) encode(encoder *encoder) {
+	encoder.meta(TypeInputInvoiceName, inputInvoiceName.meta)
+	encoder.String("name", inputInvoiceName.Name)
+}
+
+func encodeMessageExtendedMediaPreview(encoder *encoder, value *MessageExtendedMediaPreview) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageExtendedMediaPreview *MessageExtendedMediaPreview) encode(encoder *encoder) {
+	encoder.meta(TypeMessageExtendedMediaPreview, messageExtendedMediaPreview.meta)
+	encoder.Int32("width", messageExtendedMediaPreview.Width)
+	encoder.Int32("height", messageExtendedMediaPreview.Height)
+	encoder.Int32("duration", messageExtendedMediaPreview.Duration)
+	encoder.name("minithumbnail")
+	encodeMinithumbnail(encoder, messageExtendedMediaPreview.Minithumbnail)
+	encoder.name("caption")
+	encodeFormattedText(encoder, messageExtendedMediaPreview.Caption)
+}
+
+func encodeMessageExtendedMediaPhoto(encoder *encoder, value *MessageExtendedMediaPhoto) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageExtendedMediaPhoto *MessageExtendedMediaPhoto) encode(encoder *encoder) {
+	encoder.meta(TypeMessageExtendedMediaPhoto, messageExtendedMediaPhoto.meta)
+	encoder.name("photo")
+	encodePhoto(encoder, messageExtendedMediaPhoto.Photo)
+	encoder.name("caption")
+	encodeFormattedText(encoder, messageExtendedMediaPhoto.Caption)
+}
+
+func encodeMessageExtendedMediaVideo(encoder *encoder, value *MessageExtendedMediaVideo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageExtendedMediaVideo *MessageExtendedMediaVideo) encode(encoder *encoder) {
+	encoder.meta(TypeMessageExtendedMediaVideo, messageExtendedMediaVideo.meta)
+	encoder.name("video")
+	encodeVideo(encoder, messageExtendedMediaVideo.Video)
+	encoder.name("caption")
+	encodeFormattedText(encoder, messageExtendedMediaVideo.Caption)
+}
+
+func encodeMessageExtendedMediaUnsupported(encoder *encoder, value *MessageExtendedMediaUnsupported) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageExtendedMediaUnsupported *MessageExtendedMediaUnsupported) encode(encoder *encoder) {
+	encoder.meta(TypeMessageExtendedMediaUnsupported, messageExtendedMediaUnsupported.meta)
+	encoder.name("caption")
+	encodeFormattedText(encoder, messageExtendedMediaUnsupported.Caption)
+}
+
+func encodeDatedFile(encoder *encoder, value *DatedFile) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (datedFile *DatedFile) encode(encoder *encoder) {
+	encoder.meta(TypeDatedFile, datedFile.meta)
+	encoder.name("file")
+	encodeFile(encoder, datedFile.File)
+	encoder.Int32("date", datedFile.Date)
+}
+
+func encodePassportElementTypePersonalDetails(encoder *encoder, value *PassportElementTypePersonalDetails) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (passportElementTypePersonalDetails *PassportElementTypePersonalDetails) encode(encoder *encoder) {
+	encoder.meta(TypePassportElementTypePersonalDetails, passportElementTypePersonalDetails.meta)
+}
+
+func encodePassportElementTypePassport(encoder *encoder, value *PassportElementTypePassport) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (passportElementTypePassport *PassportElementTypePassport) encode(encoder *encoder) {
+	encoder.meta(TypePassportElementTypePassport, passportElementTypePassport.meta)
+}
+
+func encodePassportElementTypeDriverLicense(encoder *encoder, value *PassportElementTypeDriverLicense) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (passportElementTypeDriverLicense *PassportElementTypeDriverLicense) encode(encoder *encoder) {
+	encoder.meta(TypePassportElementTypeDriverLicense, passportElementTypeDriverLicense.meta)
+}
+
+func encodePassportElementTypeIdentityCard(encoder *encoder, value *PassportElementTypeIdentityCard) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (passportElementTypeIdentityCard *PassportElementTypeIdentityCard) encode(encoder *encoder) {
+	encoder.meta(TypePassportElementTypeIdentityCard, passportElementTypeIdentityCard.meta)
+}
+
+func encodePassportElementTypeInternalPassport(encoder *encoder, value *PassportElementTypeInternalPassport) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (passportElementTypeInternalPassport *PassportElementTypeInternalPassport) encode(encoder *encoder) {
+	encoder.meta(TypePassportElementTypeInternalPassport, passportElementTypeInternalPassport.meta)
+}
+
+func encodePassportElementTypeAddress(encoder *encoder, value *PassportElementTypeAddress) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (passportElementTypeAddress *PassportElementTypeAddress) encode(encoder *encoder) {
+	encoder.meta(TypePassportElementTypeAddress, passportElementTypeAddress.meta)
+}
+
+func encodePassportElementTypeUtilityBill(encoder *encoder, value *PassportElementTypeUtilityBill) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (passportElementTypeUtilityBill *PassportElementTypeUtilityBill) encode(encoder *encoder) {
+	encoder.meta(TypePassportElementTypeUtilityBill, passportElementTypeUtilityBill.meta)
+}
+
+func encodePassportElementTypeBankStatement(encoder *encoder, value *PassportElementTypeBankStatement) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (passportElementTypeBankStatement *PassportElementTypeBankStatement) encode(encoder *encoder) {
+	encoder.meta(TypePassportElementTypeBankStatement, passportElementTypeBankStatement.meta)
+}
+
+func encodePassportElementTypeRentalAgreement(encoder *encoder, value *PassportElementTypeRentalAgreement) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (passportElementTypeRentalAgreement *PassportElementTypeRentalAgreement) encode(encoder *encoder) {
+	encoder.meta(TypePassportElementTypeRentalAgreement, passportElementTypeRentalAgreement.meta)
+}
+
+func encodePassportElementTypePassportRegistration(encoder *encoder, value *PassportElementTypePassportRegistration) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (passportElementTypePassportRegistration *PassportElementTypePassportRegistration) encode(encoder *encoder) {
+	encoder.meta(TypePassportElementTypePassportRegistration, passportElementTypePassportRegistration.meta)
+}
+
+func encodePassportElementTypeTemporaryRegistration(encoder *encoder, value *PassportElementTypeTemporaryRegistration) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (passportElementTypeTemporaryRegistration *PassportElementTypeTemporaryRegistration) encode(encoder *encoder) {
+	encoder.meta(TypePassportElementTypeTemporaryRegistration, passportElementTypeTemporaryRegistration.meta)
+}
+
+func encodePassportElementTypePhoneNumber(encoder *encoder, value *PassportElementTypePhoneNumber) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (passportElementTypePhoneNumber *PassportElementTypePhoneNumber) encode(encoder *encoder) {
+	encoder.meta(TypePassportElementTypePhoneNumber, passportElementTypePhoneNumber.meta)
+}
+
+func encodePassportElementTypeEmailAddress(encoder *encoder, value *PassportElementTypeEmailAddress) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (passportElementTypeEmailAddress *PassportElementTypeEmailAddress) encode(encoder *encoder) {
+	encoder.meta(TypePassportElementTypeEmailAddress, passportElementTypeEmailAddress.meta)
+}
+
+func encodeDate(encoder *encoder, value *Date) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (date *Date) encode(encoder *encoder) {
+	encoder.meta(TypeDate, date.meta)
+	encoder.Int32("day", date.Day)
+	encoder.Int32("month", date.Month)
+	encoder.Int32("year", date.Year)
+}
+
+func encodePersonalDetails(encoder *encoder, value *PersonalDetails) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (personalDetails *PersonalDetails) encode(encoder *encoder) {
+	encoder.meta(TypePersonalDetails, personalDetails.meta)
+	encoder.String("first_name", personalDetails.FirstName)
+	encoder.String("middle_name", personalDetails.MiddleName)
+	encoder.String("last_name", personalDetails.LastName)
+	encoder.String("native_first_name", personalDetails.NativeFirstName)
+	encoder.String("native_middle_name", personalDetails.NativeMiddleName)
+	encoder.String("native_last_name", personalDetails.NativeLastName)
+	encoder.name("birthdate")
+	encodeDate(encoder, personalDetails.Birthdate)
+	encoder.String("gender", personalDetails.Gender)
+	encoder.String("country_code", personalDetails.CountryCode)
+	encoder.String("residence_country_code", personalDetails.ResidenceCountryCode)
+}
+
+func encodeIdentityDocument(encoder *encoder, value *IdentityDocument) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (identityDocument *IdentityDocument) encode(encoder *encoder) {
+	encoder.meta(TypeIdentityDocument, identityDocument.meta)
+	encoder.String("number", identityDocument.Number)
+	encoder.name("expiry_date")
+	encodeDate(encoder, identityDocument.ExpiryDate)
+	encoder.name("front_side")
+	encodeDatedFile(encoder, identityDocument.FrontSide)
+	encoder.name("reverse_side")
+	encodeDatedFile(encoder, identityDocument.ReverseSide)
+	encoder.name("selfie")
+	encodeDatedFile(encoder, identityDocument.Selfie)
+	encoder.name("translation")
+	encodeListOfDatedFile(encoder, identityDocument.Translation)
+}
+
+func encodeInputIdentityDocument(encoder *encoder, value *InputIdentityDocument) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputIdentityDocument *InputIdentityDocument) encode(encoder *encoder) {
+	encoder.meta(TypeInputIdentityDocument, inputIdentityDocument.meta)
+	encoder.String("number", inputIdentityDocument.Number)
+	encoder.name("expiry_date")
+	encodeDate(encoder, inputIdentityDocument.ExpiryDate)
+	encoder.name("front_side")
+	encodeInputFile(encoder, inputIdentityDocument.FrontSide)
+	encoder.name("reverse_side")
+	encodeInputFile(encoder, inputIdentityDocument.ReverseSide)
+	encoder.name("selfie")
+	encodeInputFile(encoder, inputIdentityDocument.Selfie)
+	encoder.name("translation")
+	encodeListOfInputFile(encoder, inputIdentityDocument.Translation)
+}
+
+func encodePersonalDocument(encoder *encoder, value *PersonalDocument) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (personalDocument *PersonalDocument) encode(encoder *encoder) {
+	encoder.meta(TypePersonalDocument, personalDocument.meta)
+	encoder.name("files")
+	encodeListOfDatedFile(encoder, personalDocument.Files)
+	encoder.name("translation")
+	encodeListOfDatedFile(encoder, personalDocument.Translation)
+}
+
+func encodeInputPersonalDocument(encoder *encoder, value *InputPersonalDocument) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputPersonalDocument *InputPersonalDocument) encode(encoder *encoder) {
+	encoder.meta(TypeInputPersonalDocument, inputPersonalDocument.meta)
+	encoder.name("files")
+	encodeListOfInputFile(encoder, inputPersonalDocument.Files)
+	encoder.name("translation")
+	encodeListOfInputFile(encoder, inputPersonalDocument.Translation)
+}
+
+func encodePassportElementPersonalDetails(encoder *encoder, value *PassportElementPersonalDetails) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (passportElementPersonalDetails *PassportElementPersonalDetails) encode(encoder *encoder) {
+	encoder.meta(TypePassportElementPersonalDetails, passportElementPersonalDetails.meta)
+	encoder.name("personal_details")
+	encodePersonalDetails(encoder, passportElementPersonalDetails.PersonalDetails)
+}
+
+func encodePassportElementPassport(encoder *encoder, value *PassportElementPassport) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (passportElementPassport *PassportElementPassport) encode(encoder *encoder) {
+	encoder.meta(TypePassportElementPassport, passportElementPassport.meta)
+	encoder.name("passport")
+	encodeIdentityDocument(encoder, passportElementPassport.Passport)
+}
+
+func encodePassportElementDriverLicense(encoder *encoder, value *PassportElementDriverLicense) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (passportElementDriverLicense *PassportElementDriverLicense) encode(encoder *encoder) {
+	encoder.meta(TypePassportElementDriverLicense, passportElementDriverLicense.meta)
+	encoder.name("driver_license")
+	encodeIdentityDocument(encoder, passportElementDriverLicense.DriverLicense)
+}
+
+func encodePassportElementIdentityCard(encoder *encoder, value *PassportElementIdentityCard) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (passportElementIdentityCard *PassportElementIdentityCard) encode(encoder *encoder) {
+	encoder.meta(TypePassportElementIdentityCard, passportElementIdentityCard.meta)
+	encoder.name("identity_card")
+	encodeIdentityDocument(encoder, passportElementIdentityCard.IdentityCard)
+}
+
+func encodePassportElementInternalPassport(encoder *encoder, value *PassportElementInternalPassport) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (passportElementInternalPassport *PassportElementInternalPassport) encode(encoder *encoder) {
+	encoder.meta(TypePassportElementInternalPassport, passportElementInternalPassport.meta)
+	encoder.name("internal_passport")
+	encodeIdentityDocument(encoder, passportElementInternalPassport.InternalPassport)
+}
+
+func encodePassportElementAddress(encoder *encoder, value *PassportElementAddress) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (passportElementAddress *PassportElementAddress) encode(encoder *encoder) {
+	encoder.meta(TypePassportElementAddress, passportElementAddress.meta)
+	encoder.name("address")
+	encodeAddress(encoder, passportElementAddress.Address)
+}
+
+func encodePassportElementUtilityBill(encoder *encoder, value *PassportElementUtilityBill) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (passportElementUtilityBill *PassportElementUtilityBill) encode(encoder *encoder) {
+	encoder.meta(TypePassportElementUtilityBill, passportElementUtilityBill.meta)
+	encoder.name("utility_bill")
+	encodePersonalDocument(encoder, passportElementUtilityBill.UtilityBill)
+}
+
+func encodePassportElementBankStatement(encoder *encoder, value *PassportElementBankStatement) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (passportElementBankStatement *PassportElementBankStatement) encode(encoder *encoder) {
+	encoder.meta(TypePassportElementBankStatement, passportElementBankStatement.meta)
+	encoder.name("bank_statement")
+	encodePersonalDocument(encoder, passportElementBankStatement.BankStatement)
+}
+
+func encodePassportElementRentalAgreement(encoder *encoder, value *PassportElementRentalAgreement) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (passportElementRentalAgreement *PassportElementRentalAgreement) encode(encoder *encoder) {
+	encoder.meta(TypePassportElementRentalAgreement, passportElementRentalAgreement.meta)
+	encoder.name("rental_agreement")
+	encodePersonalDocument(encoder, passportElementRentalAgreement.RentalAgreement)
+}
+
+func encodePassportElementPassportRegistration(encoder *encoder, value *PassportElementPassportRegistration) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (passportElementPassportRegistration *PassportElementPassportRegistration) encode(encoder *encoder) {
+	encoder.meta(TypePassportElementPassportRegistration, passportElementPassportRegistration.meta)
+	encoder.name("passport_registration")
+	encodePersonalDocument(encoder, passportElementPassportRegistration.PassportRegistration)
+}
+
+func encodePassportElementTemporaryRegistration(encoder *encoder, value *PassportElementTemporaryRegistration) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (passportElementTemporaryRegistration *PassportElementTemporaryRegistration) encode(encoder *encoder) {
+	encoder.meta(TypePassportElementTemporaryRegistration, passportElementTemporaryRegistration.meta)
+	encoder.name("temporary_registration")
+	encodePersonalDocument(encoder, passportElementTemporaryRegistration.TemporaryRegistration)
+}
+
+func encodePassportElementPhoneNumber(encoder *encoder, value *PassportElementPhoneNumber) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (passportElementPhoneNumber *PassportElementPhoneNumber) encode(encoder *encoder) {
+	encoder.meta(TypePassportElementPhoneNumber, passportElementPhoneNumber.meta)
+	encoder.String("phone_number", passportElementPhoneNumber.PhoneNumber)
+}
+
+func encodePassportElementEmailAddress(encoder *encoder, value *PassportElementEmailAddress) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (passportElementEmailAddress *PassportElementEmailAddress) encode(encoder *encoder) {
+	encoder.meta(TypePassportElementEmailAddress, passportElementEmailAddress.meta)
+	encoder.String("email_address", passportElementEmailAddress.EmailAddress)
+}
+
+func encodeInputPassportElementPersonalDetails(encoder *encoder, value *InputPassportElementPersonalDetails) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputPassportElementPersonalDetails *InputPassportElementPersonalDetails) encode(encoder *encoder) {
+	encoder.meta(TypeInputPassportElementPersonalDetails, inputPassportElementPersonalDetails.meta)
+	encoder.name("personal_details")
+	encodePersonalDetails(encoder, inputPassportElementPersonalDetails.PersonalDetails)
+}
+
+func encodeInputPassportElementPassport(encoder *encoder, value *InputPassportElementPassport) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputPassportElementPassport *InputPassportElementPassport) encode(encoder *encoder) {
+	encoder.meta(TypeInputPassportElementPassport, inputPassportElementPassport.meta)
+	encoder.name("passport")
+	encodeInputIdentityDocument(encoder, inputPassportElementPassport.Passport)
+}
+
+func encodeInputPassportElementDriverLicense(encoder *encoder, value *InputPassportElementDriverLicense) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputPassportElementDriverLicense *InputPassportElementDriverLicense) encode(encoder *encoder) {
+	encoder.meta(TypeInputPassportElementDriverLicense, inputPassportElementDriverLicense.meta)
+	encoder.name("driver_license")
+	encodeInputIdentityDocument(encoder, inputPassportElementDriverLicense.DriverLicense)
+}
+
+func encodeInputPassportElementIdentityCard(encoder *encoder, value *InputPassportElementIdentityCard) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputPassportElementIdentityCard *InputPassportElementIdentityCard) encode(encoder *encoder) {
+	encoder.meta(TypeInputPassportElementIdentityCard, inputPassportElementIdentityCard.meta)
+	encoder.name("identity_card")
+	encodeInputIdentityDocument(encoder, inputPassportElementIdentityCard.IdentityCard)
+}
+
+func encodeInputPassportElementInternalPassport(encoder *encoder, value *InputPassportElementInternalPassport) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputPassportElementInternalPassport *InputPassportElementInternalPassport) encode(encoder *encoder) {
+	encoder.meta(TypeInputPassportElementInternalPassport, inputPassportElementInternalPassport.meta)
+	encoder.name("internal_passport")
+	encodeInputIdentityDocument(encoder, inputPassportElementInternalPassport.InternalPassport)
+}
+
+func encodeInputPassportElementAddress(encoder *encoder, value *InputPassportElementAddress) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputPassportElementAddress *InputPassportElementAddress) encode(encoder *encoder) {
+	encoder.meta(TypeInputPassportElementAddress, inputPassportElementAddress.meta)
+	encoder.name("address")
+	encodeAddress(encoder, inputPassportElementAddress.Address)
+}
+
+func encodeInputPassportElementUtilityBill(encoder *encoder, value *InputPassportElementUtilityBill) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputPassportElementUtilityBill *InputPassportElementUtilityBill) encode(encoder *encoder) {
+	encoder.meta(TypeInputPassportElementUtilityBill, inputPassportElementUtilityBill.meta)
+	encoder.name("utility_bill")
+	encodeInputPersonalDocument(encoder, inputPassportElementUtilityBill.UtilityBill)
+}
+
+func encodeInputPassportElementBankStatement(encoder *encoder, value *InputPassportElementBankStatement) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputPassportElementBankStatement *InputPassportElementBankStatement) encode(encoder *encoder) {
+	encoder.meta(TypeInputPassportElementBankStatement, inputPassportElementBankStatement.meta)
+	encoder.name("bank_statement")
+	encodeInputPersonalDocument(encoder, inputPassportElementBankStatement.BankStatement)
+}
+
+func encodeInputPassportElementRentalAgreement(encoder *encoder, value *InputPassportElementRentalAgreement) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputPassportElementRentalAgreement *InputPassportElementRentalAgreement) encode(encoder *encoder) {
+	encoder.meta(TypeInputPassportElementRentalAgreement, inputPassportElementRentalAgreement.meta)
+	encoder.name("rental_agreement")
+	encodeInputPersonalDocument(encoder, inputPassportElementRentalAgreement.RentalAgreement)
+}
+
+func encodeInputPassportElementPassportRegistration(encoder *encoder, value *InputPassportElementPassportRegistration) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputPassportElementPassportRegistration *InputPassportElementPassportRegistration) encode(encoder *encoder) {
+	encoder.meta(TypeInputPassportElementPassportRegistration, inputPassportElementPassportRegistration.meta)
+	encoder.name("passport_registration")
+	encodeInputPersonalDocument(encoder, inputPassportElementPassportRegistration.PassportRegistration)
+}
+
+func encodeInputPassportElementTemporaryRegistration(encoder *encoder, value *InputPassportElementTemporaryRegistration) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputPassportElementTemporaryRegistration *InputPassportElementTemporaryRegistration) encode(encoder *encoder) {
+	encoder.meta(TypeInputPassportElementTemporaryRegistration, inputPassportElementTemporaryRegistration.meta)
+	encoder.name("temporary_registration")
+	encodeInputPersonalDocument(encoder, inputPassportElementTemporaryRegistration.TemporaryRegistration)
+}
+
+func encodeInputPassportElementPhoneNumber(encoder *encoder, value *InputPassportElementPhoneNumber) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputPassportElementPhoneNumber *InputPassportElementPhoneNumber) encode(encoder *encoder) {
+	encoder.meta(TypeInputPassportElementPhoneNumber, inputPassportElementPhoneNumber.meta)
+	encoder.String("phone_number", inputPassportElementPhoneNumber.PhoneNumber)
+}
+
+func encodeInputPassportElementEmailAddress(encoder *encoder, value *InputPassportElementEmailAddress) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputPassportElementEmailAddress *InputPassportElementEmailAddress) encode(encoder *encoder) {
+	encoder.meta(TypeInputPassportElementEmailAddress, inputPassportElementEmailAddress.meta)
+	encoder.String("email_address", inputPassportElementEmailAddress.EmailAddress)
+}
+
+func encodePassportElements(encoder *encoder, value *PassportElements) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (passportElements *PassportElements) encode(encoder *encoder) {
+	encoder.meta(TypePassportElements, passportElements.meta)
+	encoder.name("elements")
+	encodeListOfPassportElement(encoder, passportElements.Elements)
+}
+
+func encodePassportElementErrorSourceUnspecified(encoder *encoder, value *PassportElementErrorSourceUnspecified) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (passportElementErrorSourceUnspecified *PassportElementErrorSourceUnspecified) encode(encoder *encoder) {
+	encoder.meta(TypePassportElementErrorSourceUnspecified, passportElementErrorSourceUnspecified.meta)
+}
+
+func encodePassportElementErrorSourceDataField(encoder *encoder, value *PassportElementErrorSourceDataField) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (passportElementErrorSourceDataField *PassportElementErrorSourceDataField) encode(encoder *encoder) {
+	encoder.meta(TypePassportElementErrorSourceDataField, passportElementErrorSourceDataField.meta)
+	encoder.String("field_name", passportElementErrorSourceDataField.FieldName)
+}
+
+func encodePassportElementErrorSourceFrontSide(encoder *encoder, value *PassportElementErrorSourceFrontSide) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (passportElementErrorSourceFrontSide *PassportElementErrorSourceFrontSide) encode(encoder *encoder) {
+	encoder.meta(TypePassportElementErrorSourceFrontSide, passportElementErrorSourceFrontSide.meta)
+}
+
+func encodePassportElementErrorSourceReverseSide(encoder *encoder, value *PassportElementErrorSourceReverseSide) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (passportElementErrorSourceReverseSide *PassportElementErrorSourceReverseSide) encode(encoder *encoder) {
+	encoder.meta(TypePassportElementErrorSourceReverseSide, passportElementErrorSourceReverseSide.meta)
+}
+
+func encodePassportElementErrorSourceSelfie(encoder *encoder, value *PassportElementErrorSourceSelfie) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (passportElementErrorSourceSelfie *PassportElementErrorSourceSelfie) encode(encoder *encoder) {
+	encoder.meta(TypePassportElementErrorSourceSelfie, passportElementErrorSourceSelfie.meta)
+}
+
+func encodePassportElementErrorSourceTranslationFile(encoder *encoder, value *PassportElementErrorSourceTranslationFile) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (passportElementErrorSourceTranslationFile *PassportElementErrorSourceTranslationFile) encode(encoder *encoder) {
+	encoder.meta(TypePassportElementErrorSourceTranslationFile, passportElementErrorSourceTranslationFile.meta)
+	encoder.Int32("file_index", passportElementErrorSourceTranslationFile.FileIndex)
+}
+
+func encodePassportElementErrorSourceTranslationFiles(encoder *encoder, value *PassportElementErrorSourceTranslationFiles) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (passportElementErrorSourceTranslationFiles *PassportElementErrorSourceTranslationFiles) encode(encoder *encoder) {
+	encoder.meta(TypePassportElementErrorSourceTranslationFiles, passportElementErrorSourceTranslationFiles.meta)
+}
+
+func encodePassportElementErrorSourceFile(encoder *encoder, value *PassportElementErrorSourceFile) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (passportElementErrorSourceFile *PassportElementErrorSourceFile) encode(encoder *encoder) {
+	encoder.meta(TypePassportElementErrorSourceFile, passportElementErrorSourceFile.meta)
+	encoder.Int32("file_index", passportElementErrorSourceFile.FileIndex)
+}
+
+func encodePassportElementErrorSourceFiles(encoder *encoder, value *PassportElementErrorSourceFiles) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (passportElementErrorSourceFiles *PassportElementErrorSourceFiles) encode(encoder *encoder) {
+	encoder.meta(TypePassportElementErrorSourceFiles, passportElementErrorSourceFiles.meta)
+}
+
+func encodePassportElementError(encoder *encoder, value *PassportElementError) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (passportElementError *PassportElementError) encode(encoder *encoder) {
+	encoder.meta(TypePassportElementError, passportElementError.meta)
+	encoder.name("type")
+	encodePassportElementType(encoder, passportElementError.Type)
+	encoder.String("message", passportElementError.Message)
+	encoder.name("source")
+	encodePassportElementErrorSource(encoder, passportElementError.Source)
+}
+
+func encodePassportSuitableElement(encoder *encoder, value *PassportSuitableElement) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (passportSuitableElement *PassportSuitableElement) encode(encoder *encoder) {
+	encoder.meta(TypePassportSuitableElement, passportSuitableElement.meta)
+	encoder.name("type")
+	encodePassportElementType(encoder, passportSuitableElement.Type)
+	encoder.Bool("is_selfie_required", passportSuitableElement.IsSelfieRequired)
+	encoder.Bool("is_translation_required", passportSuitableElement.IsTranslationRequired)
+	encoder.Bool("is_native_name_required", passportSuitableElement.IsNativeNameRequired)
+}
+
+func encodePassportRequiredElement(encoder *encoder, value *PassportRequiredElement) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (passportRequiredElement *PassportRequiredElement) encode(encoder *encoder) {
+	encoder.meta(TypePassportRequiredElement, passportRequiredElement.meta)
+	encoder.name("suitable_elements")
+	encodeListOfPassportSuitableElement(encoder, passportRequiredElement.SuitableElements)
+}
+
+func encodePassportAuthorizationForm(encoder *encoder, value *PassportAuthorizationForm) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (passportAuthorizationForm *PassportAuthorizationForm) encode(encoder *encoder) {
+	encoder.meta(TypePassportAuthorizationForm, passportAuthorizationForm.meta)
+	encoder.Int32("id", passportAuthorizationForm.Id)
+	encoder.name("required_elements")
+	encodeListOfPassportRequiredElement(encoder, passportAuthorizationForm.RequiredElements)
+	encoder.String("privacy_policy_url", passportAuthorizationForm.PrivacyPolicyUrl)
+}
+
+func encodePassportElementsWithErrors(encoder *encoder, value *PassportElementsWithErrors) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (passportElementsWithErrors *PassportElementsWithErrors) encode(encoder *encoder) {
+	encoder.meta(TypePassportElementsWithErrors, passportElementsWithErrors.meta)
+	encoder.name("elements")
+	encodeListOfPassportElement(encoder, passportElementsWithErrors.Elements)
+	encoder.name("errors")
+	encodeListOfPassportElementError(encoder, passportElementsWithErrors.Errors)
+}
+
+func encodeEncryptedCredentials(encoder *encoder, value *EncryptedCredentials) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (encryptedCredentials *EncryptedCredentials) encode(encoder *encoder) {
+	encoder.meta(TypeEncryptedCredentials, encryptedCredentials.meta)
+	encoder.Bytes("data", encryptedCredentials.Data)
+	encoder.Bytes("hash", encryptedCredentials.Hash)
+	encoder.Bytes("secret", encryptedCredentials.Secret)
+}
+
+func encodeEncryptedPassportElement(encoder *encoder, value *EncryptedPassportElement) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (encryptedPassportElement *EncryptedPassportElement) encode(encoder *encoder) {
+	encoder.meta(TypeEncryptedPassportElement, encryptedPassportElement.meta)
+	encoder.name("type")
+	encodePassportElementType(encoder, encryptedPassportElement.Type)
+	encoder.Bytes("data", encryptedPassportElement.Data)
+	encoder.name("front_side")
+	encodeDatedFile(encoder, encryptedPassportElement.FrontSide)
+	encoder.name("reverse_side")
+	encodeDatedFile(encoder, encryptedPassportElement.ReverseSide)
+	encoder.name("selfie")
+	encodeDatedFile(encoder, encryptedPassportElement.Selfie)
+	encoder.name("translation")
+	encodeListOfDatedFile(encoder, encryptedPassportElement.Translation)
+	encoder.name("files")
+	encodeListOfDatedFile(encoder, encryptedPassportElement.Files)
+	encoder.String("value", encryptedPassportElement.Value)
+	encoder.String("hash", encryptedPassportElement.Hash)
+}
+
+func encodeInputPassportElementErrorSourceUnspecified(encoder *encoder, value *InputPassportElementErrorSourceUnspecified) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputPassportElementErrorSourceUnspecified *InputPassportElementErrorSourceUnspecified) encode(encoder *encoder) {
+	encoder.meta(TypeInputPassportElementErrorSourceUnspecified, inputPassportElementErrorSourceUnspecified.meta)
+	encoder.Bytes("element_hash", inputPassportElementErrorSourceUnspecified.ElementHash)
+}
+
+func encodeInputPassportElementErrorSourceDataField(encoder *encoder, value *InputPassportElementErrorSourceDataField) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputPassportElementErrorSourceDataField *InputPassportElementErrorSourceDataField) encode(encoder *encoder) {
+	encoder.meta(TypeInputPassportElementErrorSourceDataField, inputPassportElementErrorSourceDataField.meta)
+	encoder.String("field_name", inputPassportElementErrorSourceDataField.FieldName)
+	encoder.Bytes("data_hash", inputPassportElementErrorSourceDataField.DataHash)
+}
+
+func encodeInputPassportElementErrorSourceFrontSide(encoder *encoder, value *InputPassportElementErrorSourceFrontSide) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputPassportElementErrorSourceFrontSide *InputPassportElementErrorSourceFrontSide) encode(encoder *encoder) {
+	encoder.meta(TypeInputPassportElementErrorSourceFrontSide, inputPassportElementErrorSourceFrontSide.meta)
+	encoder.Bytes("file_hash", inputPassportElementErrorSourceFrontSide.FileHash)
+}
+
+func encodeInputPassportElementErrorSourceReverseSide(encoder *encoder, value *InputPassportElementErrorSourceReverseSide) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputPassportElementErrorSourceReverseSide *InputPassportElementErrorSourceReverseSide) encode(encoder *encoder) {
+	encoder.meta(TypeInputPassportElementErrorSourceReverseSide, inputPassportElementErrorSourceReverseSide.meta)
+	encoder.Bytes("file_hash", inputPassportElementErrorSourceReverseSide.FileHash)
+}
+
+func encodeInputPassportElementErrorSourceSelfie(encoder *encoder, value *InputPassportElementErrorSourceSelfie) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputPassportElementErrorSourceSelfie *InputPassportElementErrorSourceSelfie) encode(encoder *encoder) {
+	encoder.meta(TypeInputPassportElementErrorSourceSelfie, inputPassportElementErrorSourceSelfie.meta)
+	encoder.Bytes("file_hash", inputPassportElementErrorSourceSelfie.FileHash)
+}
+
+func encodeInputPassportElementErrorSourceTranslationFile(encoder *encoder, value *InputPassportElementErrorSourceTranslationFile) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputPassportElementErrorSourceTranslationFile *InputPassportElementErrorSourceTranslationFile) encode(encoder *encoder) {
+	encoder.meta(TypeInputPassportElementErrorSourceTranslationFile, inputPassportElementErrorSourceTranslationFile.meta)
+	encoder.Bytes("file_hash", inputPassportElementErrorSourceTranslationFile.FileHash)
+}
+
+func encodeInputPassportElementErrorSourceTranslationFiles(encoder *encoder, value *InputPassportElementErrorSourceTranslationFiles) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputPassportElementErrorSourceTranslationFiles *InputPassportElementErrorSourceTranslationFiles) encode(encoder *encoder) {
+	encoder.meta(TypeInputPassportElementErrorSourceTranslationFiles, inputPassportElementErrorSourceTranslationFiles.meta)
+	encoder.name("file_hashes")
+	encodeListOfBytes(encoder, inputPassportElementErrorSourceTranslationFiles.FileHashes)
+}
+
+func encodeInputPassportElementErrorSourceFile(encoder *encoder, value *InputPassportElementErrorSourceFile) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputPassportElementErrorSourceFile *InputPassportElementErrorSourceFile) encode(encoder *encoder) {
+	encoder.meta(TypeInputPassportElementErrorSourceFile, inputPassportElementErrorSourceFile.meta)
+	encoder.Bytes("file_hash", inputPassportElementErrorSourceFile.FileHash)
+}
+
+func encodeInputPassportElementErrorSourceFiles(encoder *encoder, value *InputPassportElementErrorSourceFiles) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputPassportElementErrorSourceFiles *InputPassportElementErrorSourceFiles) encode(encoder *encoder) {
+	encoder.meta(TypeInputPassportElementErrorSourceFiles, inputPassportElementErrorSourceFiles.meta)
+	encoder.name("file_hashes")
+	encodeListOfBytes(encoder, inputPassportElementErrorSourceFiles.FileHashes)
+}
+
+func encodeInputPassportElementError(encoder *encoder, value *InputPassportElementError) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputPassportElementError *InputPassportElementError) encode(encoder *encoder) {
+	encoder.meta(TypeInputPassportElementError, inputPassportElementError.meta)
+	encoder.name("type")
+	encodePassportElementType(encoder, inputPassportElementError.Type)
+	encoder.String("message", inputPassportElementError.Message)
+	encoder.name("source")
+	encodeInputPassportElementErrorSource(encoder, inputPassportElementError.Source)
+}
+
+func encodeMessageText(encoder *encoder, value *MessageText) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageText *MessageText) encode(encoder *encoder) {
+	encoder.meta(TypeMessageText, messageText.meta)
+	encoder.name("text")
+	encodeFormattedText(encoder, messageText.Text)
+	encoder.name("web_page")
+	encodeWebPage(encoder, messageText.WebPage)
+}
+
+func encodeMessageAnimation(encoder *encoder, value *MessageAnimation) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageAnimation *MessageAnimation) encode(encoder *encoder) {
+	encoder.meta(TypeMessageAnimation, messageAnimation.meta)
+	encoder.name("animation")
+	encodeAnimation(encoder, messageAnimation.Animation)
+	encoder.name("caption")
+	encodeFormattedText(encoder, messageAnimation.Caption)
+	encoder.Bool("has_spoiler", messageAnimation.HasSpoiler)
+	encoder.Bool("is_secret", messageAnimation.IsSecret)
+}
+
+func encodeMessageAudio(encoder *encoder, value *MessageAudio) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageAudio *MessageAudio) encode(encoder *encoder) {
+	encoder.meta(TypeMessageAudio, messageAudio.meta)
+	encoder.name("audio")
+	encodeAudio(encoder, messageAudio.Audio)
+	encoder.name("caption")
+	encodeFormattedText(encoder, messageAudio.Caption)
+}
+
+func encodeMessageDocument(encoder *encoder, value *MessageDocument) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageDocument *MessageDocument) encode(encoder *encoder) {
+	encoder.meta(TypeMessageDocument, messageDocument.meta)
+	encoder.name("document")
+	encodeDocument(encoder, messageDocument.Document)
+	encoder.name("caption")
+	encodeFormattedText(encoder, messageDocument.Caption)
+}
+
+func encodeMessagePhoto(encoder *encoder, value *MessagePhoto) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messagePhoto *MessagePhoto) encode(encoder *encoder) {
+	encoder.meta(TypeMessagePhoto, messagePhoto.meta)
+	encoder.name("photo")
+	encodePhoto(encoder, messagePhoto.Photo)
+	encoder.name("caption")
+	encodeFormattedText(encoder, messagePhoto.Caption)
+	encoder.Bool("has_spoiler", messagePhoto.HasSpoiler)
+	encoder.Bool("is_secret", messagePhoto.IsSecret)
+}
+
+func encodeMessageExpiredPhoto(encoder *encoder, value *MessageExpiredPhoto) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageExpiredPhoto *MessageExpiredPhoto) encode(encoder *encoder) {
+	encoder.meta(TypeMessageExpiredPhoto, messageExpiredPhoto.meta)
+}
+
+func encodeMessageSticker(encoder *encoder, value *MessageSticker) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageSticker *MessageSticker) encode(encoder *encoder) {
+	encoder.meta(TypeMessageSticker, messageSticker.meta)
+	encoder.name("sticker")
+	encodeSticker(encoder, messageSticker.Sticker)
+	encoder.Bool("is_premium", messageSticker.IsPremium)
+}
+
+func encodeMessageVideo(encoder *encoder, value *MessageVideo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageVideo *MessageVideo) encode(encoder *encoder) {
+	encoder.meta(TypeMessageVideo, messageVideo.meta)
+	encoder.name("video")
+	encodeVideo(encoder, messageVideo.Video)
+	encoder.name("caption")
+	encodeFormattedText(encoder, messageVideo.Caption)
+	encoder.Bool("has_spoiler", messageVideo.HasSpoiler)
+	encoder.Bool("is_secret", messageVideo.IsSecret)
+}
+
+func encodeMessageExpiredVideo(encoder *encoder, value *MessageExpiredVideo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageExpiredVideo *MessageExpiredVideo) encode(encoder *encoder) {
+	encoder.meta(TypeMessageExpiredVideo, messageExpiredVideo.meta)
+}
+
+func encodeMessageVideoNote(encoder *encoder, value *MessageVideoNote) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageVideoNote *MessageVideoNote) encode(encoder *encoder) {
+	encoder.meta(TypeMessageVideoNote, messageVideoNote.meta)
+	encoder.name("video_note")
+	encodeVideoNote(encoder, messageVideoNote.VideoNote)
+	encoder.Bool("is_viewed", messageVideoNote.IsViewed)
+	encoder.Bool("is_secret", messageVideoNote.IsSecret)
+}
+
+func encodeMessageVoiceNote(encoder *encoder, value *MessageVoiceNote) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageVoiceNote *MessageVoiceNote) encode(encoder *encoder) {
+	encoder.meta(TypeMessageVoiceNote, messageVoiceNote.meta)
+	encoder.name("voice_note")
+	encodeVoiceNote(encoder, messageVoiceNote.VoiceNote)
+	encoder.name("caption")
+	encodeFormattedText(encoder, messageVoiceNote.Caption)
+	encoder.Bool("is_listened", messageVoiceNote.IsListened)
+}
+
+func encodeMessageLocation(encoder *encoder, value *MessageLocation) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageLocation *MessageLocation) encode(encoder *encoder) {
+	encoder.meta(TypeMessageLocation, messageLocation.meta)
+	encoder.name("location")
+	encodeLocation(encoder, messageLocation.Location)
+	encoder.Int32("live_period", messageLocation.LivePeriod)
+	encoder.Int32("expires_in", messageLocation.ExpiresIn)
+	encoder.Int32("heading", messageLocation.Heading)
+	encoder.Int32("proximity_alert_radius", messageLocation.ProximityAlertRadius)
+}
+
+func encodeMessageVenue(encoder *encoder, value *MessageVenue) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageVenue *MessageVenue) encode(encoder *encoder) {
+	encoder.meta(TypeMessageVenue, messageVenue.meta)
+	encoder.name("venue")
+	encodeVenue(encoder, messageVenue.Venue)
+}
+
+func encodeMessageContact(encoder *encoder, value *MessageContact) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageContact *MessageContact) encode(encoder *encoder) {
+	encoder.meta(TypeMessageContact, messageContact.meta)
+	encoder.name("contact")
+	encodeContact(encoder, messageContact.Contact)
+}
+
+func encodeMessageAnimatedEmoji(encoder *encoder, value *MessageAnimatedEmoji) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageAnimatedEmoji *MessageAnimatedEmoji) encode(encoder *encoder) {
+	encoder.meta(TypeMessageAnimatedEmoji, messageAnimatedEmoji.meta)
+	encoder.name("animated_emoji")
+	encodeAnimatedEmoji(encoder, messageAnimatedEmoji.AnimatedEmoji)
+	encoder.String("emoji", messageAnimatedEmoji.Emoji)
+}
+
+func encodeMessageDice(encoder *encoder, value *MessageDice) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageDice *MessageDice) encode(encoder *encoder) {
+	encoder.meta(TypeMessageDice, messageDice.meta)
+	encoder.name("initial_state")
+	encodeDiceStickers(encoder, messageDice.InitialState)
+	encoder.name("final_state")
+	encodeDiceStickers(encoder, messageDice.FinalState)
+	encoder.String("emoji", messageDice.Emoji)
+	encoder.Int32("value", messageDice.Value)
+	encoder.Int32("success_animation_frame_number", messageDice.SuccessAnimationFrameNumber)
+}
+
+func encodeMessageGame(encoder *encoder, value *MessageGame) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageGame *MessageGame) encode(encoder *encoder) {
+	encoder.meta(TypeMessageGame, messageGame.meta)
+	encoder.name("game")
+	encodeGame(encoder, messageGame.Game)
+}
+
+func encodeMessagePoll(encoder *encoder, value *MessagePoll) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messagePoll *MessagePoll) encode(encoder *encoder) {
+	encoder.meta(TypeMessagePoll, messagePoll.meta)
+	encoder.name("poll")
+	encodePoll(encoder, messagePoll.Poll)
+}
+
+func encodeMessageInvoice(encoder *encoder, value *MessageInvoice) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageInvoice *MessageInvoice) encode(encoder *encoder) {
+	encoder.meta(TypeMessageInvoice, messageInvoice.meta)
+	encoder.String("title", messageInvoice.Title)
+	encoder.name("description")
+	encodeFormattedText(encoder, messageInvoice.Description)
+	encoder.name("photo")
+	encodePhoto(encoder, messageInvoice.Photo)
+	encoder.String("currency", messageInvoice.Currency)
+	encoder.Int64("total_amount", messageInvoice.TotalAmount)
+	encoder.String("start_parameter", messageInvoice.StartParameter)
+	encoder.Bool("is_test", messageInvoice.IsTest)
+	encoder.Bool("need_shipping_address", messageInvoice.NeedShippingAddress)
+	encoder.Int64("receipt_message_id", messageInvoice.ReceiptMessageId)
+	encoder.name("extended_media")
+	encodeMessageExtendedMedia(encoder, messageInvoice.ExtendedMedia)
+}
+
+func encodeMessageCall(encoder *encoder, value *MessageCall) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageCall *MessageCall) encode(encoder *encoder) {
+	encoder.meta(TypeMessageCall, messageCall.meta)
+	encoder.Bool("is_video", messageCall.IsVideo)
+	encoder.name("discard_reason")
+	encodeCallDiscardReason(encoder, messageCall.DiscardReason)
+	encoder.Int32("duration", messageCall.Duration)
+}
+
+func encodeMessageVideoChatScheduled(encoder *encoder, value *MessageVideoChatScheduled) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageVideoChatScheduled *MessageVideoChatScheduled) encode(encoder *encoder) {
+	encoder.meta(TypeMessageVideoChatScheduled, messageVideoChatScheduled.meta)
+	encoder.Int32("group_call_id", messageVideoChatScheduled.GroupCallId)
+	encoder.Int32("start_date", messageVideoChatScheduled.StartDate)
+}
+
+func encodeMessageVideoChatStarted(encoder *encoder, value *MessageVideoChatStarted) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageVideoChatStarted *MessageVideoChatStarted) encode(encoder *encoder) {
+	encoder.meta(TypeMessageVideoChatStarted, messageVideoChatStarted.meta)
+	encoder.Int32("group_call_id", messageVideoChatStarted.GroupCallId)
+}
+
+func encodeMessageVideoChatEnded(encoder *encoder, value *MessageVideoChatEnded) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageVideoChatEnded *MessageVideoChatEnded) encode(encoder *encoder) {
+	encoder.meta(TypeMessageVideoChatEnded, messageVideoChatEnded.meta)
+	encoder.Int32("duration", messageVideoChatEnded.Duration)
+}
+
+func encodeMessageInviteVideoChatParticipants(encoder *encoder, value *MessageInviteVideoChatParticipants) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageInviteVideoChatParticipants *MessageInviteVideoChatParticipants) encode(encoder *encoder) {
+	encoder.meta(TypeMessageInviteVideoChatParticipants, messageInviteVideoChatParticipants.meta)
+	encoder.Int32("group_call_id", messageInviteVideoChatParticipants.GroupCallId)
+	encoder.Int64s("user_ids", messageInviteVideoChatParticipants.UserIds)
+}
+
+func encodeMessageBasicGroupChatCreate(encoder *encoder, value *MessageBasicGroupChatCreate) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageBasicGroupChatCreate *MessageBasicGroupChatCreate) encode(encoder *encoder) {
+	encoder.meta(TypeMessageBasicGroupChatCreate, messageBasicGroupChatCreate.meta)
+	encoder.String("title", messageBasicGroupChatCreate.Title)
+	encoder.Int64s("member_user_ids", messageBasicGroupChatCreate.MemberUserIds)
+}
+
+func encodeMessageSupergroupChatCreate(encoder *encoder, value *MessageSupergroupChatCreate) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageSupergroupChatCreate *MessageSupergroupChatCreate) encode(encoder *encoder) {
+	encoder.meta(TypeMessageSupergroupChatCreate, messageSupergroupChatCreate.meta)
+	encoder.String("title", messageSupergroupChatCreate.Title)
+}
+
+func encodeMessageChatChangeTitle(encoder *encoder, value *MessageChatChangeTitle) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageChatChangeTitle *MessageChatChangeTitle) encode(encoder *encoder) {
+	encoder.meta(TypeMessageChatChangeTitle, messageChatChangeTitle.meta)
+	encoder.String("title", messageChatChangeTitle.Title)
+}
+
+func encodeMessageChatChangePhoto(encoder *encoder, value *MessageChatChangePhoto) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageChatChangePhoto *MessageChatChangePhoto) encode(encoder *encoder) {
+	encoder.meta(TypeMessageChatChangePhoto, messageChatChangePhoto.meta)
+	encoder.name("photo")
+	encodeChatPhoto(encoder, messageChatChangePhoto.Photo)
+}
+
+func encodeMessageChatDeletePhoto(encoder *encoder, value *MessageChatDeletePhoto) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageChatDeletePhoto *MessageChatDeletePhoto) encode(encoder *encoder) {
+	encoder.meta(TypeMessageChatDeletePhoto, messageChatDeletePhoto.meta)
+}
+
+func encodeMessageChatAddMembers(encoder *encoder, value *MessageChatAddMembers) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageChatAddMembers *MessageChatAddMembers) encode(encoder *encoder) {
+	encoder.meta(TypeMessageChatAddMembers, messageChatAddMembers.meta)
+	encoder.Int64s("member_user_ids", messageChatAddMembers.MemberUserIds)
+}
+
+func encodeMessageChatJoinByLink(encoder *encoder, value *MessageChatJoinByLink) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageChatJoinByLink *MessageChatJoinByLink) encode(encoder *encoder) {
+	encoder.meta(TypeMessageChatJoinByLink, messageChatJoinByLink.meta)
+}
+
+func encodeMessageChatJoinByRequest(encoder *encoder, value *MessageChatJoinByRequest) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageChatJoinByRequest *MessageChatJoinByRequest) encode(encoder *encoder) {
+	encoder.meta(TypeMessageChatJoinByRequest, messageChatJoinByRequest.meta)
+}
+
+func encodeMessageChatDeleteMember(encoder *encoder, value *MessageChatDeleteMember) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageChatDeleteMember *MessageChatDeleteMember) encode(encoder *encoder) {
+	encoder.meta(TypeMessageChatDeleteMember, messageChatDeleteMember.meta)
+	encoder.Int64("user_id", messageChatDeleteMember.UserId)
+}
+
+func encodeMessageChatUpgradeTo(encoder *encoder, value *MessageChatUpgradeTo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageChatUpgradeTo *MessageChatUpgradeTo) encode(encoder *encoder) {
+	encoder.meta(TypeMessageChatUpgradeTo, messageChatUpgradeTo.meta)
+	encoder.Int64("supergroup_id", messageChatUpgradeTo.SupergroupId)
+}
+
+func encodeMessageChatUpgradeFrom(encoder *encoder, value *MessageChatUpgradeFrom) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageChatUpgradeFrom *MessageChatUpgradeFrom) encode(encoder *encoder) {
+	encoder.meta(TypeMessageChatUpgradeFrom, messageChatUpgradeFrom.meta)
+	encoder.String("title", messageChatUpgradeFrom.Title)
+	encoder.Int64("basic_group_id", messageChatUpgradeFrom.BasicGroupId)
+}
+
+func encodeMessagePinMessage(encoder *encoder, value *MessagePinMessage) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messagePinMessage *MessagePinMessage) encode(encoder *encoder) {
+	encoder.meta(TypeMessagePinMessage, messagePinMessage.meta)
+	encoder.Int64("message_id", messagePinMessage.MessageId)
+}
+
+func encodeMessageScreenshotTaken(encoder *encoder, value *MessageScreenshotTaken) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageScreenshotTaken *MessageScreenshotTaken) encode(encoder *encoder) {
+	encoder.meta(TypeMessageScreenshotTaken, messageScreenshotTaken.meta)
+}
+
+func encodeMessageChatSetBackground(encoder *encoder, value *MessageChatSetBackground) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageChatSetBackground *MessageChatSetBackground) encode(encoder *encoder) {
+	encoder.meta(TypeMessageChatSetBackground, messageChatSetBackground.meta)
+	encoder.Int64("old_background_message_id", messageChatSetBackground.OldBackgroundMessageId)
+	encoder.name("background")
+	encodeChatBackground(encoder, messageChatSetBackground.Background)
+}
+
+func encodeMessageChatSetTheme(encoder *encoder, value *MessageChatSetTheme) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageChatSetTheme *MessageChatSetTheme) encode(encoder *encoder) {
+	encoder.meta(TypeMessageChatSetTheme, messageChatSetTheme.meta)
+	encoder.String("theme_name", messageChatSetTheme.ThemeName)
+}
+
+func encodeMessageChatSetMessageAutoDeleteTime(encoder *encoder, value *MessageChatSetMessageAutoDeleteTime) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageChatSetMessageAutoDeleteTime *MessageChatSetMessageAutoDeleteTime) encode(encoder *encoder) {
+	encoder.meta(TypeMessageChatSetMessageAutoDeleteTime, messageChatSetMessageAutoDeleteTime.meta)
+	encoder.Int32("message_auto_delete_time", messageChatSetMessageAutoDeleteTime.MessageAutoDeleteTime)
+	encoder.Int64("from_user_id", messageChatSetMessageAutoDeleteTime.FromUserId)
+}
+
+func encodeMessageForumTopicCreated(encoder *encoder, value *MessageForumTopicCreated) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageForumTopicCreated *MessageForumTopicCreated) encode(encoder *encoder) {
+	encoder.meta(TypeMessageForumTopicCreated, messageForumTopicCreated.meta)
+	encoder.String("name", messageForumTopicCreated.Name)
+	encoder.name("icon")
+	encodeForumTopicIcon(encoder, messageForumTopicCreated.Icon)
+}
+
+func encodeMessageForumTopicEdited(encoder *encoder, value *MessageForumTopicEdited) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageForumTopicEdited *MessageForumTopicEdited) encode(encoder *encoder) {
+	encoder.meta(TypeMessageForumTopicEdited, messageForumTopicEdited.meta)
+	encoder.String("name", messageForumTopicEdited.Name)
+	encoder.Bool("edit_icon_custom_emoji_id", messageForumTopicEdited.EditIconCustomEmojiId)
+	encoder.JsonInt64("icon_custom_emoji_id", messageForumTopicEdited.IconCustomEmojiId)
+}
+
+func encodeMessageForumTopicIsClosedToggled(encoder *encoder, value *MessageForumTopicIsClosedToggled) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageForumTopicIsClosedToggled *MessageForumTopicIsClosedToggled) encode(encoder *encoder) {
+	encoder.meta(TypeMessageForumTopicIsClosedToggled, messageForumTopicIsClosedToggled.meta)
+	encoder.Bool("is_closed", messageForumTopicIsClosedToggled.IsClosed)
+}
+
+func encodeMessageForumTopicIsHiddenToggled(encoder *encoder, value *MessageForumTopicIsHiddenToggled) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageForumTopicIsHiddenToggled *MessageForumTopicIsHiddenToggled) encode(encoder *encoder) {
+	encoder.meta(TypeMessageForumTopicIsHiddenToggled, messageForumTopicIsHiddenToggled.meta)
+	encoder.Bool("is_hidden", messageForumTopicIsHiddenToggled.IsHidden)
+}
+
+func encodeMessageSuggestProfilePhoto(encoder *encoder, value *MessageSuggestProfilePhoto) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageSuggestProfilePhoto *MessageSuggestProfilePhoto) encode(encoder *encoder) {
+	encoder.meta(TypeMessageSuggestProfilePhoto, messageSuggestProfilePhoto.meta)
+	encoder.name("photo")
+	encodeChatPhoto(encoder, messageSuggestProfilePhoto.Photo)
+}
+
+func encodeMessageCustomServiceAction(encoder *encoder, value *MessageCustomServiceAction) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageCustomServiceAction *MessageCustomServiceAction) encode(encoder *encoder) {
+	encoder.meta(TypeMessageCustomServiceAction, messageCustomServiceAction.meta)
+	encoder.String("text", messageCustomServiceAction.Text)
+}
+
+func encodeMessageGameScore(encoder *encoder, value *MessageGameScore) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageGameScore *MessageGameScore) encode(encoder *encoder) {
+	encoder.meta(TypeMessageGameScore, messageGameScore.meta)
+	encoder.Int64("game_message_id", messageGameScore.GameMessageId)
+	encoder.JsonInt64("game_id", messageGameScore.GameId)
+	encoder.Int32("score", messageGameScore.Score)
+}
+
+func encodeMessagePaymentSuccessful(encoder *encoder, value *MessagePaymentSuccessful) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messagePaymentSuccessful *MessagePaymentSuccessful) encode(encoder *encoder) {
+	encoder.meta(TypeMessagePaymentSuccessful, messagePaymentSuccessful.meta)
+	encoder.Int64("invoice_chat_id", messagePaymentSuccessful.InvoiceChatId)
+	encoder.Int64("invoice_message_id", messagePaymentSuccessful.InvoiceMessageId)
+	encoder.String("currency", messagePaymentSuccessful.Currency)
+	encoder.Int64("total_amount", messagePaymentSuccessful.TotalAmount)
+	encoder.Bool("is_recurring", messagePaymentSuccessful.IsRecurring)
+	encoder.Bool("is_first_recurring", messagePaymentSuccessful.IsFirstRecurring)
+	encoder.String("invoice_name", messagePaymentSuccessful.InvoiceName)
+}
+
+func encodeMessagePaymentSuccessfulBot(encoder *encoder, value *MessagePaymentSuccessfulBot) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messagePaymentSuccessfulBot *MessagePaymentSuccessfulBot) encode(encoder *encoder) {
+	encoder.meta(TypeMessagePaymentSuccessfulBot, messagePaymentSuccessfulBot.meta)
+	encoder.String("currency", messagePaymentSuccessfulBot.Currency)
+	encoder.Int64("total_amount", messagePaymentSuccessfulBot.TotalAmount)
+	encoder.Bool("is_recurring", messagePaymentSuccessfulBot.IsRecurring)
+	encoder.Bool("is_first_recurring", messagePaymentSuccessfulBot.IsFirstRecurring)
+	encoder.Bytes("invoice_payload", messagePaymentSuccessfulBot.InvoicePayload)
+	encoder.String("shipping_option_id", messagePaymentSuccessfulBot.ShippingOptionId)
+	encoder.name("order_info")
+	encodeOrderInfo(encoder, messagePaymentSuccessfulBot.OrderInfo)
+	encoder.String("telegram_payment_charge_id", messagePaymentSuccessfulBot.TelegramPaymentChargeId)
+	encoder.String("provider_payment_charge_id", messagePaymentSuccessfulBot.ProviderPaymentChargeId)
+}
+
+func encodeMessageGiftedPremium(encoder *encoder, value *MessageGiftedPremium) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageGiftedPremium *MessageGiftedPremium) encode(encoder *encoder) {
+	encoder.meta(TypeMessageGiftedPremium, messageGiftedPremium.meta)
+	encoder.Int64("gifter_user_id", messageGiftedPremium.GifterUserId)
+	encoder.String("currency", messageGiftedPremium.Currency)
+	encoder.Int64("amount", messageGiftedPremium.Amount)
+	encoder.String("cryptocurrency", messageGiftedPremium.Cryptocurrency)
+	encoder.JsonInt64("cryptocurrency_amount", messageGiftedPremium.CryptocurrencyAmount)
+	encoder.Int32("month_count", messageGiftedPremium.MonthCount)
+	encoder.name("sticker")
+	encodeSticker(encoder, messageGiftedPremium.Sticker)
+}
+
+func encodeMessageContactRegistered(encoder *encoder, value *MessageContactRegistered) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageContactRegistered *MessageContactRegistered) encode(encoder *encoder) {
+	encoder.meta(TypeMessageContactRegistered, messageContactRegistered.meta)
+}
+
+func encodeMessageUserShared(encoder *encoder, value *MessageUserShared) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageUserShared *MessageUserShared) encode(encoder *encoder) {
+	encoder.meta(TypeMessageUserShared, messageUserShared.meta)
+	encoder.Int64("user_id", messageUserShared.UserId)
+	encoder.Int32("button_id", messageUserShared.ButtonId)
+}
+
+func encodeMessageChatShared(encoder *encoder, value *MessageChatShared) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageChatShared *MessageChatShared) encode(encoder *encoder) {
+	encoder.meta(TypeMessageChatShared, messageChatShared.meta)
+	encoder.Int64("chat_id", messageChatShared.ChatId)
+	encoder.Int32("button_id", messageChatShared.ButtonId)
+}
+
+func encodeMessageWebsiteConnected(encoder *encoder, value *MessageWebsiteConnected) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageWebsiteConnected *MessageWebsiteConnected) encode(encoder *encoder) {
+	encoder.meta(TypeMessageWebsiteConnected, messageWebsiteConnected.meta)
+	encoder.String("domain_name", messageWebsiteConnected.DomainName)
+}
+
+func encodeMessageBotWriteAccessAllowed(encoder *encoder, value *MessageBotWriteAccessAllowed) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageBotWriteAccessAllowed *MessageBotWriteAccessAllowed) encode(encoder *encoder) {
+	encoder.meta(TypeMessageBotWriteAccessAllowed, messageBotWriteAccessAllowed.meta)
+	encoder.name("web_app")
+	encodeWebApp(encoder, messageBotWriteAccessAllowed.WebApp)
+}
+
+func encodeMessageWebAppDataSent(encoder *encoder, value *MessageWebAppDataSent) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageWebAppDataSent *MessageWebAppDataSent) encode(encoder *encoder) {
+	encoder.meta(TypeMessageWebAppDataSent, messageWebAppDataSent.meta)
+	encoder.String("button_text", messageWebAppDataSent.ButtonText)
+}
+
+func encodeMessageWebAppDataReceived(encoder *encoder, value *MessageWebAppDataReceived) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageWebAppDataReceived *MessageWebAppDataReceived) encode(encoder *encoder) {
+	encoder.meta(TypeMessageWebAppDataReceived, messageWebAppDataReceived.meta)
+	encoder.String("button_text", messageWebAppDataReceived.ButtonText)
+	encoder.String("data", messageWebAppDataReceived.Data)
+}
+
+func encodeMessagePassportDataSent(encoder *encoder, value *MessagePassportDataSent) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messagePassportDataSent *MessagePassportDataSent) encode(encoder *encoder) {
+	encoder.meta(TypeMessagePassportDataSent, messagePassportDataSent.meta)
+	encoder.name("types")
+	encodeListOfPassportElementType(encoder, messagePassportDataSent.Types)
+}
+
+func encodeMessagePassportDataReceived(encoder *encoder, value *MessagePassportDataReceived) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messagePassportDataReceived *MessagePassportDataReceived) encode(encoder *encoder) {
+	encoder.meta(TypeMessagePassportDataReceived, messagePassportDataReceived.meta)
+	encoder.name("elements")
+	encodeListOfEncryptedPassportElement(encoder, messagePassportDataReceived.Elements)
+	encoder.name("credentials")
+	encodeEncryptedCredentials(encoder, messagePassportDataReceived.Credentials)
+}
+
+func encodeMessageProximityAlertTriggered(encoder *encoder, value *MessageProximityAlertTriggered) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageProximityAlertTriggered *MessageProximityAlertTriggered) encode(encoder *encoder) {
+	encoder.meta(TypeMessageProximityAlertTriggered, messageProximityAlertTriggered.meta)
+	encoder.name("traveler_id")
+	encodeMessageSender(encoder, messageProximityAlertTriggered.TravelerId)
+	encoder.name("watcher_id")
+	encodeMessageSender(encoder, messageProximityAlertTriggered.WatcherId)
+	encoder.Int32("distance", messageProximityAlertTriggered.Distance)
+}
+
+func encodeMessageUnsupported(encoder *encoder, value *MessageUnsupported) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageUnsupported *MessageUnsupported) encode(encoder *encoder) {
+	encoder.meta(TypeMessageUnsupported, messageUnsupported.meta)
+}
+
+func encodeTextEntityTypeMention(encoder *encoder, value *TextEntityTypeMention) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (textEntityTypeMention *TextEntityTypeMention) encode(encoder *encoder) {
+	encoder.meta(TypeTextEntityTypeMention, textEntityTypeMention.meta)
+}
+
+func encodeTextEntityTypeHashtag(encoder *encoder, value *TextEntityTypeHashtag) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (textEntityTypeHashtag *TextEntityTypeHashtag) encode(encoder *encoder) {
+	encoder.meta(TypeTextEntityTypeHashtag, textEntityTypeHashtag.meta)
+}
+
+func encodeTextEntityTypeCashtag(encoder *encoder, value *TextEntityTypeCashtag) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (textEntityTypeCashtag *TextEntityTypeCashtag) encode(encoder *encoder) {
+	encoder.meta(TypeTextEntityTypeCashtag, textEntityTypeCashtag.meta)
+}
+
+func encodeTextEntityTypeBotCommand(encoder *encoder, value *TextEntityTypeBotCommand) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (textEntityTypeBotCommand *TextEntityTypeBotCommand) encode(encoder *encoder) {
+	encoder.meta(TypeTextEntityTypeBotCommand, textEntityTypeBotCommand.meta)
+}
+
+func encodeTextEntityTypeUrl(encoder *encoder, value *TextEntityTypeUrl) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (textEntityTypeUrl *TextEntityTypeUrl) encode(encoder *encoder) {
+	encoder.meta(TypeTextEntityTypeUrl, textEntityTypeUrl.meta)
+}
+
+func encodeTextEntityTypeEmailAddress(encoder *encoder, value *TextEntityTypeEmailAddress) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (textEntityTypeEmailAddress *TextEntityTypeEmailAddress) encode(encoder *encoder) {
+	encoder.meta(TypeTextEntityTypeEmailAddress, textEntityTypeEmailAddress.meta)
+}
+
+func encodeTextEntityTypePhoneNumber(encoder *encoder, value *TextEntityTypePhoneNumber) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (textEntityTypePhoneNumber *TextEntityTypePhoneNumber) encode(encoder *encoder) {
+	encoder.meta(TypeTextEntityTypePhoneNumber, textEntityTypePhoneNumber.meta)
+}
+
+func encodeTextEntityTypeBankCardNumber(encoder *encoder, value *TextEntityTypeBankCardNumber) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (textEntityTypeBankCardNumber *TextEntityTypeBankCardNumber) encode(encoder *encoder) {
+	encoder.meta(TypeTextEntityTypeBankCardNumber, textEntityTypeBankCardNumber.meta)
+}
+
+func encodeTextEntityTypeBold(encoder *encoder, value *TextEntityTypeBold) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (textEntityTypeBold *TextEntityTypeBold) encode(encoder *encoder) {
+	encoder.meta(TypeTextEntityTypeBold, textEntityTypeBold.meta)
+}
+
+func encodeTextEntityTypeItalic(encoder *encoder, value *TextEntityTypeItalic) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (textEntityTypeItalic *TextEntityTypeItalic) encode(encoder *encoder) {
+	encoder.meta(TypeTextEntityTypeItalic, textEntityTypeItalic.meta)
+}
+
+func encodeTextEntityTypeUnderline(encoder *encoder, value *TextEntityTypeUnderline) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (textEntityTypeUnderline *TextEntityTypeUnderline) encode(encoder *encoder) {
+	encoder.meta(TypeTextEntityTypeUnderline, textEntityTypeUnderline.meta)
+}
+
+func encodeTextEntityTypeStrikethrough(encoder *encoder, value *TextEntityTypeStrikethrough) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (textEntityTypeStrikethrough *TextEntityTypeStrikethrough) encode(encoder *encoder) {
+	encoder.meta(TypeTextEntityTypeStrikethrough, textEntityTypeStrikethrough.meta)
+}
+
+func encodeTextEntityTypeSpoiler(encoder *encoder, value *TextEntityTypeSpoiler) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (textEntityTypeSpoiler *TextEntityTypeSpoiler) encode(encoder *encoder) {
+	encoder.meta(TypeTextEntityTypeSpoiler, textEntityTypeSpoiler.meta)
+}
+
+func encodeTextEntityTypeCode(encoder *encoder, value *TextEntityTypeCode) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (textEntityTypeCode *TextEntityTypeCode) encode(encoder *encoder) {
+	encoder.meta(TypeTextEntityTypeCode, textEntityTypeCode.meta)
+}
+
+func encodeTextEntityTypePre(encoder *encoder, value *TextEntityTypePre) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (textEntityTypePre *TextEntityTypePre) encode(encoder *encoder) {
+	encoder.meta(TypeTextEntityTypePre, textEntityTypePre.meta)
+}
+
+func encodeTextEntityTypePreCode(encoder *encoder, value *TextEntityTypePreCode) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (textEntityTypePreCode *TextEntityTypePreCode) encode(encoder *encoder) {
+	encoder.meta(TypeTextEntityTypePreCode, textEntityTypePreCode.meta)
+	encoder.String("language", textEntityTypePreCode.Language)
+}
+
+func encodeTextEntityTypeTextUrl(encoder *encoder, value *TextEntityTypeTextUrl) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (textEntityTypeTextUrl *TextEntityTypeTextUrl) encode(encoder *encoder) {
+	encoder.meta(TypeTextEntityTypeTextUrl, textEntityTypeTextUrl.meta)
+	encoder.String("url", textEntityTypeTextUrl.Url)
+}
+
+func encodeTextEntityTypeMentionName(encoder *encoder, value *TextEntityTypeMentionName) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (textEntityTypeMentionName *TextEntityTypeMentionName) encode(encoder *encoder) {
+	encoder.meta(TypeTextEntityTypeMentionName, textEntityTypeMentionName.meta)
+	encoder.Int64("user_id", textEntityTypeMentionName.UserId)
+}
+
+func encodeTextEntityTypeCustomEmoji(encoder *encoder, value *TextEntityTypeCustomEmoji) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (textEntityTypeCustomEmoji *TextEntityTypeCustomEmoji) encode(encoder *encoder) {
+	encoder.meta(TypeTextEntityTypeCustomEmoji, textEntityTypeCustomEmoji.meta)
+	encoder.JsonInt64("custom_emoji_id", textEntityTypeCustomEmoji.CustomEmojiId)
+}
+
+func encodeTextEntityTypeMediaTimestamp(encoder *encoder, value *TextEntityTypeMediaTimestamp) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (textEntityTypeMediaTimestamp *TextEntityTypeMediaTimestamp) encode(encoder *encoder) {
+	encoder.meta(TypeTextEntityTypeMediaTimestamp, textEntityTypeMediaTimestamp.meta)
+	encoder.Int32("media_timestamp", textEntityTypeMediaTimestamp.MediaTimestamp)
+}
+
+func encodeInputThumbnail(encoder *encoder, value *InputThumbnail) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputThumbnail *InputThumbnail) encode(encoder *encoder) {
+	encoder.meta(TypeInputThumbnail, inputThumbnail.meta)
+	encoder.name("thumbnail")
+	encodeInputFile(encoder, inputThumbnail.Thumbnail)
+	encoder.Int32("width", inputThumbnail.Width)
+	encoder.Int32("height", inputThumbnail.Height)
+}
+
+func encodeMessageSchedulingStateSendAtDate(encoder *encoder, value *MessageSchedulingStateSendAtDate) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageSchedulingStateSendAtDate *MessageSchedulingStateSendAtDate) encode(encoder *encoder) {
+	encoder.meta(TypeMessageSchedulingStateSendAtDate, messageSchedulingStateSendAtDate.meta)
+	encoder.Int32("send_date", messageSchedulingStateSendAtDate.SendDate)
+}
+
+func encodeMessageSchedulingStateSendWhenOnline(encoder *encoder, value *MessageSchedulingStateSendWhenOnline) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageSchedulingStateSendWhenOnline *MessageSchedulingStateSendWhenOnline) encode(encoder *encoder) {
+	encoder.meta(TypeMessageSchedulingStateSendWhenOnline, messageSchedulingStateSendWhenOnline.meta)
+}
+
+func encodeMessageSendOptions(encoder *encoder, value *MessageSendOptions) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageSendOptions *MessageSendOptions) encode(encoder *encoder) {
+	encoder.meta(TypeMessageSendOptions, messageSendOptions.meta)
+	encoder.Bool("disable_notification", messageSendOptions.DisableNotification)
+	encoder.Bool("from_background", messageSendOptions.FromBackground)
+	encoder.Bool("protect_content", messageSendOptions.ProtectContent)
+	encoder.Bool("update_order_of_installed_sticker_sets", messageSendOptions.UpdateOrderOfInstalledStickerSets)
+	encoder.name("scheduling_state")
+	encodeMessageSchedulingState(encoder, messageSendOptions.SchedulingState)
+	encoder.Int32("sending_id", messageSendOptions.SendingId)
+}
+
+func encodeMessageCopyOptions(encoder *encoder, value *MessageCopyOptions) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageCopyOptions *MessageCopyOptions) encode(encoder *encoder) {
+	encoder.meta(TypeMessageCopyOptions, messageCopyOptions.meta)
+	encoder.Bool("send_copy", messageCopyOptions.SendCopy)
+	encoder.Bool("replace_caption", messageCopyOptions.ReplaceCaption)
+	encoder.name("new_caption")
+	encodeFormattedText(encoder, messageCopyOptions.NewCaption)
+}
+
+func encodeInputMessageText(encoder *encoder, value *InputMessageText) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputMessageText *InputMessageText) encode(encoder *encoder) {
+	encoder.meta(TypeInputMessageText, inputMessageText.meta)
+	encoder.name("text")
+	encodeFormattedText(encoder, inputMessageText.Text)
+	encoder.Bool("disable_web_page_preview", inputMessageText.DisableWebPagePreview)
+	encoder.Bool("clear_draft", inputMessageText.ClearDraft)
+}
+
+func encodeInputMessageAnimation(encoder *encoder, value *InputMessageAnimation) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputMessageAnimation *InputMessageAnimation) encode(encoder *encoder) {
+	encoder.meta(TypeInputMessageAnimation, inputMessageAnimation.meta)
+	encoder.name("animation")
+	encodeInputFile(encoder, inputMessageAnimation.Animation)
+	encoder.name("thumbnail")
+	encodeInputThumbnail(encoder, inputMessageAnimation.Thumbnail)
+	encoder.Int32s("added_sticker_file_ids", inputMessageAnimation.AddedStickerFileIds)
+	encoder.Int32("duration", inputMessageAnimation.Duration)
+	encoder.Int32("width", inputMessageAnimation.Width)
+	encoder.Int32("height", inputMessageAnimation.Height)
+	encoder.name("caption")
+	encodeFormattedText(encoder, inputMessageAnimation.Caption)
+	encoder.Bool("has_spoiler", inputMessageAnimation.HasSpoiler)
+}
+
+func encodeInputMessageAudio(encoder *encoder, value *InputMessageAudio) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputMessageAudio *InputMessageAudio) encode(encoder *encoder) {
+	encoder.meta(TypeInputMessageAudio, inputMessageAudio.meta)
+	encoder.name("audio")
+	encodeInputFile(encoder, inputMessageAudio.Audio)
+	encoder.name("album_cover_thumbnail")
+	encodeInputThumbnail(encoder, inputMessageAudio.AlbumCoverThumbnail)
+	encoder.Int32("duration", inputMessageAudio.Duration)
+	encoder.String("title", inputMessageAudio.Title)
+	encoder.String("performer", inputMessageAudio.Performer)
+	encoder.name("caption")
+	encodeFormattedText(encoder, inputMessageAudio.Caption)
+}
+
+func encodeInputMessageDocument(encoder *encoder, value *InputMessageDocument) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputMessageDocument *InputMessageDocument) encode(encoder *encoder) {
+	encoder.meta(TypeInputMessageDocument, inputMessageDocument.meta)
+	encoder.name("document")
+	encodeInputFile(encoder, inputMessageDocument.Document)
+	encoder.name("thumbnail")
+	encodeInputThumbnail(encoder, inputMessageDocument.Thumbnail)
+	encoder.Bool("disable_content_type_detection", inputMessageDocument.DisableContentTypeDetection)
+	encoder.name("caption")
+	encodeFormattedText(encoder, inputMessageDocument.Caption)
+}
+
+func encodeInputMessagePhoto(encoder *encoder, value *InputMessagePhoto) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputMessagePhoto *InputMessagePhoto) encode(encoder *encoder) {
+	encoder.meta(TypeInputMessagePhoto, inputMessagePhoto.meta)
+	encoder.name("photo")
+	encodeInputFile(encoder, inputMessagePhoto.Photo)
+	encoder.name("thumbnail")
+	encodeInputThumbnail(encoder, inputMessagePhoto.Thumbnail)
+	encoder.Int32s("added_sticker_file_ids", inputMessagePhoto.AddedStickerFileIds)
+	encoder.Int32("width", inputMessagePhoto.Width)
+	encoder.Int32("height", inputMessagePhoto.Height)
+	encoder.name("caption")
+	encodeFormattedText(encoder, inputMessagePhoto.Caption)
+	encoder.Int32("self_destruct_time", inputMessagePhoto.SelfDestructTime)
+	encoder.Bool("has_spoiler", inputMessagePhoto.HasSpoiler)
+}
+
+func encodeInputMessageSticker(encoder *encoder, value *InputMessageSticker) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputMessageSticker *InputMessageSticker) encode(encoder *encoder) {
+	encoder.meta(TypeInputMessageSticker, inputMessageSticker.meta)
+	encoder.name("sticker")
+	encodeInputFile(encoder, inputMessageSticker.Sticker)
+	encoder.name("thumbnail")
+	encodeInputThumbnail(encoder, inputMessageSticker.Thumbnail)
+	encoder.Int32("width", inputMessageSticker.Width)
+	encoder.Int32("height", inputMessageSticker.Height)
+	encoder.String("emoji", inputMessageSticker.Emoji)
+}
+
+func encodeInputMessageVideo(encoder *encoder, value *InputMessageVideo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputMessageVideo *InputMessageVideo) encode(encoder *encoder) {
+	encoder.meta(TypeInputMessageVideo, inputMessageVideo.meta)
+	encoder.name("video")
+	encodeInputFile(encoder, inputMessageVideo.Video)
+	encoder.name("thumbnail")
+	encodeInputThumbnail(encoder, inputMessageVideo.Thumbnail)
+	encoder.Int32s("added_sticker_file_ids", inputMessageVideo.AddedStickerFileIds)
+	encoder.Int32("duration", inputMessageVideo.Duration)
+	encoder.Int32("width", inputMessageVideo.Width)
+	encoder.Int32("height", inputMessageVideo.Height)
+	encoder.Bool("supports_streaming", inputMessageVideo.SupportsStreaming)
+	encoder.name("caption")
+	encodeFormattedText(encoder, inputMessageVideo.Caption)
+	encoder.Int32("self_destruct_time", inputMessageVideo.SelfDestructTime)
+	encoder.Bool("has_spoiler", inputMessageVideo.HasSpoiler)
+}
+
+func encodeInputMessageVideoNote(encoder *encoder, value *InputMessageVideoNote) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputMessageVideoNote *InputMessageVideoNote) encode(encoder *encoder) {
+	encoder.meta(TypeInputMessageVideoNote, inputMessageVideoNote.meta)
+	encoder.name("video_note")
+	encodeInputFile(encoder, inputMessageVideoNote.VideoNote)
+	encoder.name("thumbnail")
+	encodeInputThumbnail(encoder, inputMessageVideoNote.Thumbnail)
+	encoder.Int32("duration", inputMessageVideoNote.Duration)
+	encoder.Int32("length", inputMessageVideoNote.Length)
+}
+
+func encodeInputMessageVoiceNote(encoder *encoder, value *InputMessageVoiceNote) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputMessageVoiceNote *InputMessageVoiceNote) encode(encoder *encoder) {
+	encoder.meta(TypeInputMessageVoiceNote, inputMessageVoiceNote.meta)
+	encoder.name("voice_note")
+	encodeInputFile(encoder, inputMessageVoiceNote.VoiceNote)
+	encoder.Int32("duration", inputMessageVoiceNote.Duration)
+	encoder.Bytes("waveform", inputMessageVoiceNote.Waveform)
+	encoder.name("caption")
+	encodeFormattedText(encoder, inputMessageVoiceNote.Caption)
+}
+
+func encodeInputMessageLocation(encoder *encoder, value *InputMessageLocation) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputMessageLocation *InputMessageLocation) encode(encoder *encoder) {
+	encoder.meta(TypeInputMessageLocation, inputMessageLocation.meta)
+	encoder.name("location")
+	encodeLocation(encoder, inputMessageLocation.Location)
+	encoder.Int32("live_period", inputMessageLocation.LivePeriod)
+	encoder.Int32("heading", inputMessageLocation.Heading)
+	encoder.Int32("proximity_alert_radius", inputMessageLocation.ProximityAlertRadius)
+}
+
+func encodeInputMessageVenue(encoder *encoder, value *InputMessageVenue) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputMessageVenue *InputMessageVenue) encode(encoder *encoder) {
+	encoder.meta(TypeInputMessageVenue, inputMessageVenue.meta)
+	encoder.name("venue")
+	encodeVenue(encoder, inputMessageVenue.Venue)
+}
+
+func encodeInputMessageContact(encoder *encoder, value *InputMessageContact) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputMessageContact *InputMessageContact) encode(encoder *encoder) {
+	encoder.meta(TypeInputMessageContact, inputMessageContact.meta)
+	encoder.name("contact")
+	encodeContact(encoder, inputMessageContact.Contact)
+}
+
+func encodeInputMessageDice(encoder *encoder, value *InputMessageDice) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputMessageDice *InputMessageDice) encode(encoder *encoder) {
+	encoder.meta(TypeInputMessageDice, inputMessageDice.meta)
+	encoder.String("emoji", inputMessageDice.Emoji)
+	encoder.Bool("clear_draft", inputMessageDice.ClearDraft)
+}
+
+func encodeInputMessageGame(encoder *encoder, value *InputMessageGame) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputMessageGame *InputMessageGame) encode(encoder *encoder) {
+	encoder.meta(TypeInputMessageGame, inputMessageGame.meta)
+	encoder.Int64("bot_user_id", inputMessageGame.BotUserId)
+	encoder.String("game_short_name", inputMessageGame.GameShortName)
+}
+
+func encodeInputMessageInvoice(encoder *encoder, value *InputMessageInvoice) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputMessageInvoice *InputMessageInvoice) encode(encoder *encoder) {
+	encoder.meta(TypeInputMessageInvoice, inputMessageInvoice.meta)
+	encoder.name("invoice")
+	encodeInvoice(encoder, inputMessageInvoice.Invoice)
+	encoder.String("title", inputMessageInvoice.Title)
+	encoder.String("description", inputMessageInvoice.Description)
+	encoder.String("photo_url", inputMessageInvoice.PhotoUrl)
+	encoder.Int32("photo_size", inputMessageInvoice.PhotoSize)
+	encoder.Int32("photo_width", inputMessageInvoice.PhotoWidth)
+	encoder.Int32("photo_height", inputMessageInvoice.PhotoHeight)
+	encoder.Bytes("payload", inputMessageInvoice.Payload)
+	encoder.String("provider_token", inputMessageInvoice.ProviderToken)
+	encoder.String("provider_data", inputMessageInvoice.ProviderData)
+	encoder.String("start_parameter", inputMessageInvoice.StartParameter)
+	encoder.name("extended_media_content")
+	encodeInputMessageContent(encoder, inputMessageInvoice.ExtendedMediaContent)
+}
+
+func encodeInputMessagePoll(encoder *encoder, value *InputMessagePoll) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputMessagePoll *InputMessagePoll) encode(encoder *encoder) {
+	encoder.meta(TypeInputMessagePoll, inputMessagePoll.meta)
+	encoder.String("question", inputMessagePoll.Question)
+	encoder.Strings("options", inputMessagePoll.Options)
+	encoder.Bool("is_anonymous", inputMessagePoll.IsAnonymous)
+	encoder.name("type")
+	encodePollType(encoder, inputMessagePoll.Type)
+	encoder.Int32("open_period", inputMessagePoll.OpenPeriod)
+	encoder.Int32("close_date", inputMessagePoll.CloseDate)
+	encoder.Bool("is_closed", inputMessagePoll.IsClosed)
+}
+
+func encodeInputMessageForwarded(encoder *encoder, value *InputMessageForwarded) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputMessageForwarded *InputMessageForwarded) encode(encoder *encoder) {
+	encoder.meta(TypeInputMessageForwarded, inputMessageForwarded.meta)
+	encoder.Int64("from_chat_id", inputMessageForwarded.FromChatId)
+	encoder.Int64("message_id", inputMessageForwarded.MessageId)
+	encoder.Bool("in_game_share", inputMessageForwarded.InGameShare)
+	encoder.name("copy_options")
+	encodeMessageCopyOptions(encoder, inputMessageForwarded.CopyOptions)
+}
+
+func encodeSearchMessagesFilterEmpty(encoder *encoder, value *SearchMessagesFilterEmpty) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (searchMessagesFilterEmpty *SearchMessagesFilterEmpty) encode(encoder *encoder) {
+	encoder.meta(TypeSearchMessagesFilterEmpty, searchMessagesFilterEmpty.meta)
+}
+
+func encodeSearchMessagesFilterAnimation(encoder *encoder, value *SearchMessagesFilterAnimation) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (searchMessagesFilterAnimation *SearchMessagesFilterAnimation) encode(encoder *encoder) {
+	encoder.meta(TypeSearchMessagesFilterAnimation, searchMessagesFilterAnimation.meta)
+}
+
+func encodeSearchMessagesFilterAudio(encoder *encoder, value *SearchMessagesFilterAudio) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (searchMessagesFilterAudio *SearchMessagesFilterAudio) encode(encoder *encoder) {
+	encoder.meta(TypeSearchMessagesFilterAudio, searchMessagesFilterAudio.meta)
+}
+
+func encodeSearchMessagesFilterDocument(encoder *encoder, value *SearchMessagesFilterDocument) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (searchMessagesFilterDocument *SearchMessagesFilterDocument) encode(encoder *encoder) {
+	encoder.meta(TypeSearchMessagesFilterDocument, searchMessagesFilterDocument.meta)
+}
+
+func encodeSearchMessagesFilterPhoto(encoder *encoder, value *SearchMessagesFilterPhoto) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (searchMessagesFilterPhoto *SearchMessagesFilterPhoto) encode(encoder *encoder) {
+	encoder.meta(TypeSearchMessagesFilterPhoto, searchMessagesFilterPhoto.meta)
+}
+
+func encodeSearchMessagesFilterVideo(encoder *encoder, value *SearchMessagesFilterVideo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (searchMessagesFilterVideo *SearchMessagesFilterVideo) encode(encoder *encoder) {
+	encoder.meta(TypeSearchMessagesFilterVideo, searchMessagesFilterVideo.meta)
+}
+
+func encodeSearchMessagesFilterVoiceNote(encoder *encoder, value *SearchMessagesFilterVoiceNote) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (searchMessagesFilterVoiceNote *SearchMessagesFilterVoiceNote) encode(encoder *encoder) {
+	encoder.meta(TypeSearchMessagesFilterVoiceNote, searchMessagesFilterVoiceNote.meta)
+}
+
+func encodeSearchMessagesFilterPhotoAndVideo(encoder *encoder, value *SearchMessagesFilterPhotoAndVideo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (searchMessagesFilterPhotoAndVideo *SearchMessagesFilterPhotoAndVideo) encode(encoder *encoder) {
+	encoder.meta(TypeSearchMessagesFilterPhotoAndVideo, searchMessagesFilterPhotoAndVideo.meta)
+}
+
+func encodeSearchMessagesFilterUrl(encoder *encoder, value *SearchMessagesFilterUrl) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (searchMessagesFilterUrl *SearchMessagesFilterUrl) encode(encoder *encoder) {
+	encoder.meta(TypeSearchMessagesFilterUrl, searchMessagesFilterUrl.meta)
+}
+
+func encodeSearchMessagesFilterChatPhoto(encoder *encoder, value *SearchMessagesFilterChatPhoto) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (searchMessagesFilterChatPhoto *SearchMessagesFilterChatPhoto) encode(encoder *encoder) {
+	encoder.meta(TypeSearchMessagesFilterChatPhoto, searchMessagesFilterChatPhoto.meta)
+}
+
+func encodeSearchMessagesFilterVideoNote(encoder *encoder, value *SearchMessagesFilterVideoNote) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (searchMessagesFilterVideoNote *SearchMessagesFilterVideoNote) encode(encoder *encoder) {
+	encoder.meta(TypeSearchMessagesFilterVideoNote, searchMessagesFilterVideoNote.meta)
+}
+
+func encodeSearchMessagesFilterVoiceAndVideoNote(encoder *encoder, value *SearchMessagesFilterVoiceAndVideoNote) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (searchMessagesFilterVoiceAndVideoNote *SearchMessagesFilterVoiceAndVideoNote) encode(encoder *encoder) {
+	encoder.meta(TypeSearchMessagesFilterVoiceAndVideoNote, searchMessagesFilterVoiceAndVideoNote.meta)
+}
+
+func encodeSearchMessagesFilterMention(encoder *encoder, value *SearchMessagesFilterMention) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (searchMessagesFilterMention *SearchMessagesFilterMention) encode(encoder *encoder) {
+	encoder.meta(TypeSearchMessagesFilterMention, searchMessagesFilterMention.meta)
+}
+
+func encodeSearchMessagesFilterUnreadMention(encoder *encoder, value *SearchMessagesFilterUnreadMention) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (searchMessagesFilterUnreadMention *SearchMessagesFilterUnreadMention) encode(encoder *encoder) {
+	encoder.meta(TypeSearchMessagesFilterUnreadMention, searchMessagesFilterUnreadMention.meta)
+}
+
+func encodeSearchMessagesFilterUnreadReaction(encoder *encoder, value *SearchMessagesFilterUnreadReaction) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (searchMessagesFilterUnreadReaction *SearchMessagesFilterUnreadReaction) encode(encoder *encoder) {
+	encoder.meta(TypeSearchMessagesFilterUnreadReaction, searchMessagesFilterUnreadReaction.meta)
+}
+
+func encodeSearchMessagesFilterFailedToSend(encoder *encoder, value *SearchMessagesFilterFailedToSend) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (searchMessagesFilterFailedToSend *SearchMessagesFilterFailedToSend) encode(encoder *encoder) {
+	encoder.meta(TypeSearchMessagesFilterFailedToSend, searchMessagesFilterFailedToSend.meta)
+}
+
+func encodeSearchMessagesFilterPinned(encoder *encoder, value *SearchMessagesFilterPinned) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (searchMessagesFilterPinned *SearchMessagesFilterPinned) encode(encoder *encoder) {
+	encoder.meta(TypeSearchMessagesFilterPinned, searchMessagesFilterPinned.meta)
+}
+
+func encodeChatActionTyping(encoder *encoder, value *ChatActionTyping) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatActionTyping *ChatActionTyping) encode(encoder *encoder) {
+	encoder.meta(TypeChatActionTyping, chatActionTyping.meta)
+}
+
+func encodeChatActionRecordingVideo(encoder *encoder, value *ChatActionRecordingVideo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatActionRecordingVideo *ChatActionRecordingVideo) encode(encoder *encoder) {
+	encoder.meta(TypeChatActionRecordingVideo, chatActionRecordingVideo.meta)
+}
+
+func encodeChatActionUploadingVideo(encoder *encoder, value *ChatActionUploadingVideo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatActionUploadingVideo *ChatActionUploadingVideo) encode(encoder *encoder) {
+	encoder.meta(TypeChatActionUploadingVideo, chatActionUploadingVideo.meta)
+	encoder.Int32("progress", chatActionUploadingVideo.Progress)
+}
+
+func encodeChatActionRecordingVoiceNote(encoder *encoder, value *ChatActionRecordingVoiceNote) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatActionRecordingVoiceNote *ChatActionRecordingVoiceNote) encode(encoder *encoder) {
+	encoder.meta(TypeChatActionRecordingVoiceNote, chatActionRecordingVoiceNote.meta)
+}
+
+func encodeChatActionUploadingVoiceNote(encoder *encoder, value *ChatActionUploadingVoiceNote) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatActionUploadingVoiceNote *ChatActionUploadingVoiceNote) encode(encoder *encoder) {
+	encoder.meta(TypeChatActionUploadingVoiceNote, chatActionUploadingVoiceNote.meta)
+	encoder.Int32("progress", chatActionUploadingVoiceNote.Progress)
+}
+
+func encodeChatActionUploadingPhoto(encoder *encoder, value *ChatActionUploadingPhoto) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatActionUploadingPhoto *ChatActionUploadingPhoto) encode(encoder *encoder) {
+	encoder.meta(TypeChatActionUploadingPhoto, chatActionUploadingPhoto.meta)
+	encoder.Int32("progress", chatActionUploadingPhoto.Progress)
+}
+
+func encodeChatActionUploadingDocument(encoder *encoder, value *ChatActionUploadingDocument) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatActionUploadingDocument *ChatActionUploadingDocument) encode(encoder *encoder) {
+	encoder.meta(TypeChatActionUploadingDocument, chatActionUploadingDocument.meta)
+	encoder.Int32("progress", chatActionUploadingDocument.Progress)
+}
+
+func encodeChatActionChoosingSticker(encoder *encoder, value *ChatActionChoosingSticker) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatActionChoosingSticker *ChatActionChoosingSticker) encode(encoder *encoder) {
+	encoder.meta(TypeChatActionChoosingSticker, chatActionChoosingSticker.meta)
+}
+
+func encodeChatActionChoosingLocation(encoder *encoder, value *ChatActionChoosingLocation) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatActionChoosingLocation *ChatActionChoosingLocation) encode(encoder *encoder) {
+	encoder.meta(TypeChatActionChoosingLocation, chatActionChoosingLocation.meta)
+}
+
+func encodeChatActionChoosingContact(encoder *encoder, value *ChatActionChoosingContact) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatActionChoosingContact *ChatActionChoosingContact) encode(encoder *encoder) {
+	encoder.meta(TypeChatActionChoosingContact, chatActionChoosingContact.meta)
+}
+
+func encodeChatActionStartPlayingGame(encoder *encoder, value *ChatActionStartPlayingGame) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatActionStartPlayingGame *ChatActionStartPlayingGame) encode(encoder *encoder) {
+	encoder.meta(TypeChatActionStartPlayingGame, chatActionStartPlayingGame.meta)
+}
+
+func encodeChatActionRecordingVideoNote(encoder *encoder, value *ChatActionRecordingVideoNote) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatActionRecordingVideoNote *ChatActionRecordingVideoNote) encode(encoder *encoder) {
+	encoder.meta(TypeChatActionRecordingVideoNote, chatActionRecordingVideoNote.meta)
+}
+
+func encodeChatActionUploadingVideoNote(encoder *encoder, value *ChatActionUploadingVideoNote) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatActionUploadingVideoNote *ChatActionUploadingVideoNote) encode(encoder *encoder) {
+	encoder.meta(TypeChatActionUploadingVideoNote, chatActionUploadingVideoNote.meta)
+	encoder.Int32("progress", chatActionUploadingVideoNote.Progress)
+}
+
+func encodeChatActionWatchingAnimations(encoder *encoder, value *ChatActionWatchingAnimations) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatActionWatchingAnimations *ChatActionWatchingAnimations) encode(encoder *encoder) {
+	encoder.meta(TypeChatActionWatchingAnimations, chatActionWatchingAnimations.meta)
+	encoder.String("emoji", chatActionWatchingAnimations.Emoji)
+}
+
+func encodeChatActionCancel(encoder *encoder, value *ChatActionCancel) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatActionCancel *ChatActionCancel) encode(encoder *encoder) {
+	encoder.meta(TypeChatActionCancel, chatActionCancel.meta)
+}
+
+func encodeUserStatusEmpty(encoder *encoder, value *UserStatusEmpty) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (userStatusEmpty *UserStatusEmpty) encode(encoder *encoder) {
+	encoder.meta(TypeUserStatusEmpty, userStatusEmpty.meta)
+}
+
+func encodeUserStatusOnline(encoder *encoder, value *UserStatusOnline) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (userStatusOnline *UserStatusOnline) encode(encoder *encoder) {
+	encoder.meta(TypeUserStatusOnline, userStatusOnline.meta)
+	encoder.Int32("expires", userStatusOnline.Expires)
+}
+
+func encodeUserStatusOffline(encoder *encoder, value *UserStatusOffline) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (userStatusOffline *UserStatusOffline) encode(encoder *encoder) {
+	encoder.meta(TypeUserStatusOffline, userStatusOffline.meta)
+	encoder.Int32("was_online", userStatusOffline.WasOnline)
+}
+
+func encodeUserStatusRecently(encoder *encoder, value *UserStatusRecently) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (userStatusRecently *UserStatusRecently) encode(encoder *encoder) {
+	encoder.meta(TypeUserStatusRecently, userStatusRecently.meta)
+}
+
+func encodeUserStatusLastWeek(encoder *encoder, value *UserStatusLastWeek) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (userStatusLastWeek *UserStatusLastWeek) encode(encoder *encoder) {
+	encoder.meta(TypeUserStatusLastWeek, userStatusLastWeek.meta)
+}
+
+func encodeUserStatusLastMonth(encoder *encoder, value *UserStatusLastMonth) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (userStatusLastMonth *UserStatusLastMonth) encode(encoder *encoder) {
+	encoder.meta(TypeUserStatusLastMonth, userStatusLastMonth.meta)
+}
+
+func encodeStickers(encoder *encoder, value *Stickers) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (stickers *Stickers) encode(encoder *encoder) {
+	encoder.meta(TypeStickers, stickers.meta)
+	encoder.name("stickers")
+	encodeListOfSticker(encoder, stickers.Stickers)
+}
+
+func encodeEmojis(encoder *encoder, value *Emojis) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (emojis *Emojis) encode(encoder *encoder) {
+	encoder.meta(TypeEmojis, emojis.meta)
+	encoder.Strings("emojis", emojis.Emojis)
+}
+
+func encodeStickerSet(encoder *encoder, value *StickerSet) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (stickerSet *StickerSet) encode(encoder *encoder) {
+	encoder.meta(TypeStickerSet, stickerSet.meta)
+	encoder.JsonInt64("id", stickerSet.Id)
+	encoder.String("title", stickerSet.Title)
+	encoder.String("name", stickerSet.Name)
+	encoder.name("thumbnail")
+	encodeThumbnail(encoder, stickerSet.Thumbnail)
+	encoder.name("thumbnail_outline")
+	encodeListOfClosedVectorPath(encoder, stickerSet.ThumbnailOutline)
+	encoder.Bool("is_installed", stickerSet.IsInstalled)
+	encoder.Bool("is_archived", stickerSet.IsArchived)
+	encoder.Bool("is_official", stickerSet.IsOfficial)
+	encoder.name("sticker_format")
+	encodeStickerFormat(encoder, stickerSet.StickerFormat)
+	encoder.name("sticker_type")
+	encodeStickerType(encoder, stickerSet.StickerType)
+	encoder.Bool("is_viewed", stickerSet.IsViewed)
+	encoder.name("stickers")
+	encodeListOfSticker(encoder, stickerSet.Stickers)
+	encoder.name("emojis")
+	encodeListOfEmojis(encoder, stickerSet.Emojis)
+}
+
+func encodeStickerSetInfo(encoder *encoder, value *StickerSetInfo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (stickerSetInfo *StickerSetInfo) encode(encoder *encoder) {
+	encoder.meta(TypeStickerSetInfo, stickerSetInfo.meta)
+	encoder.JsonInt64("id", stickerSetInfo.Id)
+	encoder.String("title", stickerSetInfo.Title)
+	encoder.String("name", stickerSetInfo.Name)
+	encoder.name("thumbnail")
+	encodeThumbnail(encoder, stickerSetInfo.Thumbnail)
+	encoder.name("thumbnail_outline")
+	encodeListOfClosedVectorPath(encoder, stickerSetInfo.ThumbnailOutline)
+	encoder.Bool("is_installed", stickerSetInfo.IsInstalled)
+	encoder.Bool("is_archived", stickerSetInfo.IsArchived)
+	encoder.Bool("is_official", stickerSetInfo.IsOfficial)
+	encoder.name("sticker_format")
+	encodeStickerFormat(encoder, stickerSetInfo.StickerFormat)
+	encoder.name("sticker_type")
+	encodeStickerType(encoder, stickerSetInfo.StickerType)
+	encoder.Bool("is_viewed", stickerSetInfo.IsViewed)
+	encoder.Int32("size", stickerSetInfo.Size)
+	encoder.name("covers")
+	encodeListOfSticker(encoder, stickerSetInfo.Covers)
+}
+
+func encodeStickerSets(encoder *encoder, value *StickerSets) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (stickerSets *StickerSets) encode(encoder *encoder) {
+	encoder.meta(TypeStickerSets, stickerSets.meta)
+	encoder.Int32("total_count", stickerSets.TotalCount)
+	encoder.name("sets")
+	encodeListOfStickerSetInfo(encoder, stickerSets.Sets)
+}
+
+func encodeTrendingStickerSets(encoder *encoder, value *TrendingStickerSets) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (trendingStickerSets *TrendingStickerSets) encode(encoder *encoder) {
+	encoder.meta(TypeTrendingStickerSets, trendingStickerSets.meta)
+	encoder.Int32("total_count", trendingStickerSets.TotalCount)
+	encoder.name("sets")
+	encodeListOfStickerSetInfo(encoder, trendingStickerSets.Sets)
+	encoder.Bool("is_premium", trendingStickerSets.IsPremium)
+}
+
+func encodeEmojiCategory(encoder *encoder, value *EmojiCategory) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (emojiCategory *EmojiCategory) encode(encoder *encoder) {
+	encoder.meta(TypeEmojiCategory, emojiCategory.meta)
+	encoder.String("name", emojiCategory.Name)
+	encoder.name("icon")
+	encodeSticker(encoder, emojiCategory.Icon)
+	encoder.Strings("emojis", emojiCategory.Emojis)
+}
+
+func encodeEmojiCategories(encoder *encoder, value *EmojiCategories) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (emojiCategories *EmojiCategories) encode(encoder *encoder) {
+	encoder.meta(TypeEmojiCategories, emojiCategories.meta)
+	encoder.name("categories")
+	encodeListOfEmojiCategory(encoder, emojiCategories.Categories)
+}
+
+func encodeEmojiCategoryTypeDefault(encoder *encoder, value *EmojiCategoryTypeDefault) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (emojiCategoryTypeDefault *EmojiCategoryTypeDefault) encode(encoder *encoder) {
+	encoder.meta(TypeEmojiCategoryTypeDefault, emojiCategoryTypeDefault.meta)
+}
+
+func encodeEmojiCategoryTypeEmojiStatus(encoder *encoder, value *EmojiCategoryTypeEmojiStatus) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (emojiCategoryTypeEmojiStatus *EmojiCategoryTypeEmojiStatus) encode(encoder *encoder) {
+	encoder.meta(TypeEmojiCategoryTypeEmojiStatus, emojiCategoryTypeEmojiStatus.meta)
+}
+
+func encodeEmojiCategoryTypeChatPhoto(encoder *encoder, value *EmojiCategoryTypeChatPhoto) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (emojiCategoryTypeChatPhoto *EmojiCategoryTypeChatPhoto) encode(encoder *encoder) {
+	encoder.meta(TypeEmojiCategoryTypeChatPhoto, emojiCategoryTypeChatPhoto.meta)
+}
+
+func encodeCallDiscardReasonEmpty(encoder *encoder, value *CallDiscardReasonEmpty) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (callDiscardReasonEmpty *CallDiscardReasonEmpty) encode(encoder *encoder) {
+	encoder.meta(TypeCallDiscardReasonEmpty, callDiscardReasonEmpty.meta)
+}
+
+func encodeCallDiscardReasonMissed(encoder *encoder, value *CallDiscardReasonMissed) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (callDiscardReasonMissed *CallDiscardReasonMissed) encode(encoder *encoder) {
+	encoder.meta(TypeCallDiscardReasonMissed, callDiscardReasonMissed.meta)
+}
+
+func encodeCallDiscardReasonDeclined(encoder *encoder, value *CallDiscardReasonDeclined) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (callDiscardReasonDeclined *CallDiscardReasonDeclined) encode(encoder *encoder) {
+	encoder.meta(TypeCallDiscardReasonDeclined, callDiscardReasonDeclined.meta)
+}
+
+func encodeCallDiscardReasonDisconnected(encoder *encoder, value *CallDiscardReasonDisconnected) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (callDiscardReasonDisconnected *CallDiscardReasonDisconnected) encode(encoder *encoder) {
+	encoder.meta(TypeCallDiscardReasonDisconnected, callDiscardReasonDisconnected.meta)
+}
+
+func encodeCallDiscardReasonHungUp(encoder *encoder, value *CallDiscardReasonHungUp) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (callDiscardReasonHungUp *CallDiscardReasonHungUp) encode(encoder *encoder) {
+	encoder.meta(TypeCallDiscardReasonHungUp, callDiscardReasonHungUp.meta)
+}
+
+func encodeCallProtocol(encoder *encoder, value *CallProtocol) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (callProtocol *CallProtocol) encode(encoder *encoder) {
+	encoder.meta(TypeCallProtocol, callProtocol.meta)
+	encoder.Bool("udp_p2p", callProtocol.UdpP2p)
+	encoder.Bool("udp_reflector", callProtocol.UdpReflector)
+	encoder.Int32("min_layer", callProtocol.MinLayer)
+	encoder.Int32("max_layer", callProtocol.MaxLayer)
+	encoder.Strings("library_versions", callProtocol.LibraryVersions)
+}
+
+func encodeCallServerTypeTelegramReflector(encoder *encoder, value *CallServerTypeTelegramReflector) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (callServerTypeTelegramReflector *CallServerTypeTelegramReflector) encode(encoder *encoder) {
+	encoder.meta(TypeCallServerTypeTelegramReflector, callServerTypeTelegramReflector.meta)
+	encoder.Bytes("peer_tag", callServerTypeTelegramReflector.PeerTag)
+	encoder.Bool("is_tcp", callServerTypeTelegramReflector.IsTcp)
+}
+
+func encodeCallServerTypeWebrtc(encoder *encoder, value *CallServerTypeWebrtc) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (callServerTypeWebrtc *CallServerTypeWebrtc) encode(encoder *encoder) {
+	encoder.meta(TypeCallServerTypeWebrtc, callServerTypeWebrtc.meta)
+	encoder.String("username", callServerTypeWebrtc.Username)
+	encoder.String("password", callServerTypeWebrtc.Password)
+	encoder.Bool("supports_turn", callServerTypeWebrtc.SupportsTurn)
+	encoder.Bool("supports_stun", callServerTypeWebrtc.SupportsStun)
+}
+
+func encodeCallServer(encoder *encoder, value *CallServer) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (callServer *CallServer) encode(encoder *encoder) {
+	encoder.meta(TypeCallServer, callServer.meta)
+	encoder.JsonInt64("id", callServer.Id)
+	encoder.String("ip_address", callServer.IpAddress)
+	encoder.String("ipv6_address", callServer.Ipv6Address)
+	encoder.Int32("port", callServer.Port)
+	encoder.name("type")
+	encodeCallServerType(encoder, callServer.Type)
+}
+
+func encodeCallId(encoder *encoder, value *CallId) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (callId *CallId) encode(encoder *encoder) {
+	encoder.meta(TypeCallId, callId.meta)
+	encoder.Int32("id", callId.Id)
+}
+
+func encodeGroupCallId(encoder *encoder, value *GroupCallId) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (groupCallId *GroupCallId) encode(encoder *encoder) {
+	encoder.meta(TypeGroupCallId, groupCallId.meta)
+	encoder.Int32("id", groupCallId.Id)
+}
+
+func encodeCallStatePending(encoder *encoder, value *CallStatePending) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (callStatePending *CallStatePending) encode(encoder *encoder) {
+	encoder.meta(TypeCallStatePending, callStatePending.meta)
+	encoder.Bool("is_created", callStatePending.IsCreated)
+	encoder.Bool("is_received", callStatePending.IsReceived)
+}
+
+func encodeCallStateExchangingKeys(encoder *encoder, value *CallStateExchangingKeys) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (callStateExchangingKeys *CallStateExchangingKeys) encode(encoder *encoder) {
+	encoder.meta(TypeCallStateExchangingKeys, callStateExchangingKeys.meta)
+}
+
+func encodeCallStateReady(encoder *encoder, value *CallStateReady) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (callStateReady *CallStateReady) encode(encoder *encoder) {
+	encoder.meta(TypeCallStateReady, callStateReady.meta)
+	encoder.name("protocol")
+	encodeCallProtocol(encoder, callStateReady.Protocol)
+	encoder.name("servers")
+	encodeListOfCallServer(encoder, callStateReady.Servers)
+	encoder.String("config", callStateReady.Config)
+	encoder.Bytes("encryption_key", callStateReady.EncryptionKey)
+	encoder.Strings("emojis", callStateReady.Emojis)
+	encoder.Bool("allow_p2p", callStateReady.AllowP2p)
+}
+
+func encodeCallStateHangingUp(encoder *encoder, value *CallStateHangingUp) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (callStateHangingUp *CallStateHangingUp) encode(encoder *encoder) {
+	encoder.meta(TypeCallStateHangingUp, callStateHangingUp.meta)
+}
+
+func encodeCallStateDiscarded(encoder *encoder, value *CallStateDiscarded) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (callStateDiscarded *CallStateDiscarded) encode(encoder *encoder) {
+	encoder.meta(TypeCallStateDiscarded, callStateDiscarded.meta)
+	encoder.name("reason")
+	encodeCallDiscardReason(encoder, callStateDiscarded.Reason)
+	encoder.Bool("need_rating", callStateDiscarded.NeedRating)
+	encoder.Bool("need_debug_information", callStateDiscarded.NeedDebugInformation)
+	encoder.Bool("need_log", callStateDiscarded.NeedLog)
+}
+
+func encodeCallStateError(encoder *encoder, value *CallStateError) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (callStateError *CallStateError) encode(encoder *encoder) {
+	encoder.meta(TypeCallStateError, callStateError.meta)
+	encoder.name("error")
+	encodeError(encoder, callStateError.Error)
+}
+
+func encodeGroupCallVideoQualityThumbnail(encoder *encoder, value *GroupCallVideoQualityThumbnail) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (groupCallVideoQualityThumbnail *GroupCallVideoQualityThumbnail) encode(encoder *encoder) {
+	encoder.meta(TypeGroupCallVideoQualityThumbnail, groupCallVideoQualityThumbnail.meta)
+}
+
+func encodeGroupCallVideoQualityMedium(encoder *encoder, value *GroupCallVideoQualityMedium) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (groupCallVideoQualityMedium *GroupCallVideoQualityMedium) encode(encoder *encoder) {
+	encoder.meta(TypeGroupCallVideoQualityMedium, groupCallVideoQualityMedium.meta)
+}
+
+func encodeGroupCallVideoQualityFull(encoder *encoder, value *GroupCallVideoQualityFull) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (groupCallVideoQualityFull *GroupCallVideoQualityFull) encode(encoder *encoder) {
+	encoder.meta(TypeGroupCallVideoQualityFull, groupCallVideoQualityFull.meta)
+}
+
+func encodeGroupCallStream(encoder *encoder, value *GroupCallStream) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (groupCallStream *GroupCallStream) encode(encoder *encoder) {
+	encoder.meta(TypeGroupCallStream, groupCallStream.meta)
+	encoder.Int32("channel_id", groupCallStream.ChannelId)
+	encoder.Int32("scale", groupCallStream.Scale)
+	encoder.Int64("time_offset", groupCallStream.TimeOffset)
+}
+
+func encodeGroupCallStreams(encoder *encoder, value *GroupCallStreams) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (groupCallStreams *GroupCallStreams) encode(encoder *encoder) {
+	encoder.meta(TypeGroupCallStreams, groupCallStreams.meta)
+	encoder.name("streams")
+	encodeListOfGroupCallStream(encoder, groupCallStreams.Streams)
+}
+
+func encodeRtmpUrl(encoder *encoder, value *RtmpUrl) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (rtmpUrl *RtmpUrl) encode(encoder *encoder) {
+	encoder.meta(TypeRtmpUrl, rtmpUrl.meta)
+	encoder.String("url", rtmpUrl.Url)
+	encoder.String("stream_key", rtmpUrl.StreamKey)
+}
+
+func encodeGroupCallRecentSpeaker(encoder *encoder, value *GroupCallRecentSpeaker) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (groupCallRecentSpeaker *GroupCallRecentSpeaker) encode(encoder *encoder) {
+	encoder.meta(TypeGroupCallRecentSpeaker, groupCallRecentSpeaker.meta)
+	encoder.name("participant_id")
+	encodeMessageSender(encoder, groupCallRecentSpeaker.ParticipantId)
+	encoder.Bool("is_speaking", groupCallRecentSpeaker.IsSpeaking)
+}
+
+func encodeGroupCall(encoder *encoder, value *GroupCall) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (groupCall *GroupCall) encode(encoder *encoder) {
+	encoder.meta(TypeGroupCall, groupCall.meta)
+	encoder.Int32("id", groupCall.Id)
+	encoder.String("title", groupCall.Title)
+	encoder.Int32("scheduled_start_date", groupCall.ScheduledStartDate)
+	encoder.Bool("enabled_start_notification", groupCall.EnabledStartNotification)
+	encoder.Bool("is_active", groupCall.IsActive)
+	encoder.Bool("is_rtmp_stream", groupCall.IsRtmpStream)
+	encoder.Bool("is_joined", groupCall.IsJoined)
+	encoder.Bool("need_rejoin", groupCall.NeedRejoin)
+	encoder.Bool("can_be_managed", groupCall.CanBeManaged)
+	encoder.Int32("participant_count", groupCall.ParticipantCount)
+	encoder.Bool("has_hidden_listeners", groupCall.HasHiddenListeners)
+	encoder.Bool("loaded_all_participants", groupCall.LoadedAllParticipants)
+	encoder.name("recent_speakers")
+	encodeListOfGroupCallRecentSpeaker(encoder, groupCall.RecentSpeakers)
+	encoder.Bool("is_my_video_enabled", groupCall.IsMyVideoEnabled)
+	encoder.Bool("is_my_video_paused", groupCall.IsMyVideoPaused)
+	encoder.Bool("can_enable_video", groupCall.CanEnableVideo)
+	encoder.Bool("mute_new_participants", groupCall.MuteNewParticipants)
+	encoder.Bool("can_toggle_mute_new_participants", groupCall.CanToggleMuteNewParticipants)
+	encoder.Int32("record_duration", groupCall.RecordDuration)
+	encoder.Bool("is_video_recorded", groupCall.IsVideoRecorded)
+	encoder.Int32("duration", groupCall.Duration)
+}
+
+func encodeGroupCallVideoSourceGroup(encoder *encoder, value *GroupCallVideoSourceGroup) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (groupCallVideoSourceGroup *GroupCallVideoSourceGroup) encode(encoder *encoder) {
+	encoder.meta(TypeGroupCallVideoSourceGroup, groupCallVideoSourceGroup.meta)
+	encoder.String("semantics", groupCallVideoSourceGroup.Semantics)
+	encoder.Int32s("source_ids", groupCallVideoSourceGroup.SourceIds)
+}
+
+func encodeGroupCallParticipantVideoInfo(encoder *encoder, value *GroupCallParticipantVideoInfo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (groupCallParticipantVideoInfo *GroupCallParticipantVideoInfo) encode(encoder *encoder) {
+	encoder.meta(TypeGroupCallParticipantVideoInfo, groupCallParticipantVideoInfo.meta)
+	encoder.name("source_groups")
+	encodeListOfGroupCallVideoSourceGroup(encoder, groupCallParticipantVideoInfo.SourceGroups)
+	encoder.String("endpoint_id", groupCallParticipantVideoInfo.EndpointId)
+	encoder.Bool("is_paused", groupCallParticipantVideoInfo.IsPaused)
+}
+
+func encodeGroupCallParticipant(encoder *encoder, value *GroupCallParticipant) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (groupCallParticipant *GroupCallParticipant) encode(encoder *encoder) {
+	encoder.meta(TypeGroupCallParticipant, groupCallParticipant.meta)
+	encoder.name("participant_id")
+	encodeMessageSender(encoder, groupCallParticipant.ParticipantId)
+	encoder.Int32("audio_source_id", groupCallParticipant.AudioSourceId)
+	encoder.Int32("screen_sharing_audio_source_id", groupCallParticipant.ScreenSharingAudioSourceId)
+	encoder.name("video_info")
+	encodeGroupCallParticipantVideoInfo(encoder, groupCallParticipant.VideoInfo)
+	encoder.name("screen_sharing_video_info")
+	encodeGroupCallParticipantVideoInfo(encoder, groupCallParticipant.ScreenSharingVideoInfo)
+	encoder.String("bio", groupCallParticipant.Bio)
+	encoder.Bool("is_current_user", groupCallParticipant.IsCurrentUser)
+	encoder.Bool("is_speaking", groupCallParticipant.IsSpeaking)
+	encoder.Bool("is_hand_raised", groupCallParticipant.IsHandRaised)
+	encoder.Bool("can_be_muted_for_all_users", groupCallParticipant.CanBeMutedForAllUsers)
+	encoder.Bool("can_be_unmuted_for_all_users", groupCallParticipant.CanBeUnmutedForAllUsers)
+	encoder.Bool("can_be_muted_for_current_user", groupCallParticipant.CanBeMutedForCurrentUser)
+	encoder.Bool("can_be_unmuted_for_current_user", groupCallParticipant.CanBeUnmutedForCurrentUser)
+	encoder.Bool("is_muted_for_all_users", groupCallParticipant.IsMutedForAllUsers)
+	encoder.Bool("is_muted_for_current_user", groupCallParticipant.IsMutedForCurrentUser)
+	encoder.Bool("can_unmute_self", groupCallParticipant.CanUnmuteSelf)
+	encoder.Int32("volume_level", groupCallParticipant.VolumeLevel)
+	encoder.String("order", groupCallParticipant.Order)
+}
+
+func encodeCallProblemEcho(encoder *encoder, value *CallProblemEcho) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (callProblemEcho *CallProblemEcho) encode(encoder *encoder) {
+	encoder.meta(TypeCallProblemEcho, callProblemEcho.meta)
+}
+
+func encodeCallProblemNoise(encoder *encoder, value *CallProblemNoise) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (callProblemNoise *CallProblemNoise) encode(encoder *encoder) {
+	encoder.meta(TypeCallProblemNoise, callProblemNoise.meta)
+}
+
+func encodeCallProblemInterruptions(encoder *encoder, value *CallProblemInterruptions) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (callProblemInterruptions *CallProblemInterruptions) encode(encoder *encoder) {
+	encoder.meta(TypeCallProblemInterruptions, callProblemInterruptions.meta)
+}
+
+func encodeCallProblemDistortedSpeech(encoder *encoder, value *CallProblemDistortedSpeech) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (callProblemDistortedSpeech *CallProblemDistortedSpeech) encode(encoder *encoder) {
+	encoder.meta(TypeCallProblemDistortedSpeech, callProblemDistortedSpeech.meta)
+}
+
+func encodeCallProblemSilentLocal(encoder *encoder, value *CallProblemSilentLocal) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (callProblemSilentLocal *CallProblemSilentLocal) encode(encoder *encoder) {
+	encoder.meta(TypeCallProblemSilentLocal, callProblemSilentLocal.meta)
+}
+
+func encodeCallProblemSilentRemote(encoder *encoder, value *CallProblemSilentRemote) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (callProblemSilentRemote *CallProblemSilentRemote) encode(encoder *encoder) {
+	encoder.meta(TypeCallProblemSilentRemote, callProblemSilentRemote.meta)
+}
+
+func encodeCallProblemDropped(encoder *encoder, value *CallProblemDropped) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (callProblemDropped *CallProblemDropped) encode(encoder *encoder) {
+	encoder.meta(TypeCallProblemDropped, callProblemDropped.meta)
+}
+
+func encodeCallProblemDistortedVideo(encoder *encoder, value *CallProblemDistortedVideo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (callProblemDistortedVideo *CallProblemDistortedVideo) encode(encoder *encoder) {
+	encoder.meta(TypeCallProblemDistortedVideo, callProblemDistortedVideo.meta)
+}
+
+func encodeCallProblemPixelatedVideo(encoder *encoder, value *CallProblemPixelatedVideo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (callProblemPixelatedVideo *CallProblemPixelatedVideo) encode(encoder *encoder) {
+	encoder.meta(TypeCallProblemPixelatedVideo, callProblemPixelatedVideo.meta)
+}
+
+func encodeCall(encoder *encoder, value *Call) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (call *Call) encode(encoder *encoder) {
+	encoder.meta(TypeCall, call.meta)
+	encoder.Int32("id", call.Id)
+	encoder.Int64("user_id", call.UserId)
+	encoder.Bool("is_outgoing", call.IsOutgoing)
+	encoder.Bool("is_video", call.IsVideo)
+	encoder.name("state")
+	encodeCallState(encoder, call.State)
+}
+
+func encodeFirebaseAuthenticationSettingsAndroid(encoder *encoder, value *FirebaseAuthenticationSettingsAndroid) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (firebaseAuthenticationSettingsAndroid *FirebaseAuthenticationSettingsAndroid) encode(encoder *encoder) {
+	encoder.meta(TypeFirebaseAuthenticationSettingsAndroid, firebaseAuthenticationSettingsAndroid.meta)
+}
+
+func encodeFirebaseAuthenticationSettingsIos(encoder *encoder, value *FirebaseAuthenticationSettingsIos) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (firebaseAuthenticationSettingsIos *FirebaseAuthenticationSettingsIos) encode(encoder *encoder) {
+	encoder.meta(TypeFirebaseAuthenticationSettingsIos, firebaseAuthenticationSettingsIos.meta)
+	encoder.String("device_token", firebaseAuthenticationSettingsIos.DeviceToken)
+	encoder.Bool("is_app_sandbox", firebaseAuthenticationSettingsIos.IsAppSandbox)
+}
+
+func encodePhoneNumberAuthenticationSettings(encoder *encoder, value *PhoneNumberAuthenticationSettings) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (phoneNumberAuthenticationSettings *PhoneNumberAuthenticationSettings) encode(encoder *encoder) {
+	encoder.meta(TypePhoneNumberAuthenticationSettings, phoneNumberAuthenticationSettings.meta)
+	encoder.Bool("allow_flash_call", phoneNumberAuthenticationSettings.AllowFlashCall)
+	encoder.Bool("allow_missed_call", phoneNumberAuthenticationSettings.AllowMissedCall)
+	encoder.Bool("is_current_phone_number", phoneNumberAuthenticationSettings.IsCurrentPhoneNumber)
+	encoder.Bool("allow_sms_retriever_api", phoneNumberAuthenticationSettings.AllowSmsRetrieverApi)
+	encoder.name("firebase_authentication_settings")
+	encodeFirebaseAuthenticationSettings(encoder, phoneNumberAuthenticationSettings.FirebaseAuthenticationSettings)
+	encoder.Strings("authentication_tokens", phoneNumberAuthenticationSettings.AuthenticationTokens)
+}
+
+func encodeAddedReaction(encoder *encoder, value *AddedReaction) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (addedReaction *AddedReaction) encode(encoder *encoder) {
+	encoder.meta(TypeAddedReaction, addedReaction.meta)
+	encoder.name("type")
+	encodeReactionType(encoder, addedReaction.Type)
+	encoder.name("sender_id")
+	encodeMessageSender(encoder, addedReaction.SenderId)
+	encoder.Int32("date", addedReaction.Date)
+}
+
+func encodeAddedReactions(encoder *encoder, value *AddedReactions) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (addedReactions *AddedReactions) encode(encoder *encoder) {
+	encoder.meta(TypeAddedReactions, addedReactions.meta)
+	encoder.Int32("total_count", addedReactions.TotalCount)
+	encoder.name("reactions")
+	encodeListOfAddedReaction(encoder, addedReactions.Reactions)
+	encoder.String("next_offset", addedReactions.NextOffset)
+}
+
+func encodeAvailableReaction(encoder *encoder, value *AvailableReaction) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (availableReaction *AvailableReaction) encode(encoder *encoder) {
+	encoder.meta(TypeAvailableReaction, availableReaction.meta)
+	encoder.name("type")
+	encodeReactionType(encoder, availableReaction.Type)
+	encoder.Bool("needs_premium", availableReaction.NeedsPremium)
+}
+
+func encodeAvailableReactions(encoder *encoder, value *AvailableReactions) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (availableReactions *AvailableReactions) encode(encoder *encoder) {
+	encoder.meta(TypeAvailableReactions, availableReactions.meta)
+	encoder.name("top_reactions")
+	encodeListOfAvailableReaction(encoder, availableReactions.TopReactions)
+	encoder.name("recent_reactions")
+	encodeListOfAvailableReaction(encoder, availableReactions.RecentReactions)
+	encoder.name("popular_reactions")
+	encodeListOfAvailableReaction(encoder, availableReactions.PopularReactions)
+	encoder.Bool("allow_custom_emoji", availableReactions.AllowCustomEmoji)
+}
+
+func encodeEmojiReaction(encoder *encoder, value *EmojiReaction) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (emojiReaction *EmojiReaction) encode(encoder *encoder) {
+	encoder.meta(TypeEmojiReaction, emojiReaction.meta)
+	encoder.String("emoji", emojiReaction.Emoji)
+	encoder.String("title", emojiReaction.Title)
+	encoder.Bool("is_active", emojiReaction.IsActive)
+	encoder.name("static_icon")
+	encodeSticker(encoder, emojiReaction.StaticIcon)
+	encoder.name("appear_animation")
+	encodeSticker(encoder, emojiReaction.AppearAnimation)
+	encoder.name("select_animation")
+	encodeSticker(encoder, emojiReaction.SelectAnimation)
+	encoder.name("activate_animation")
+	encodeSticker(encoder, emojiReaction.ActivateAnimation)
+	encoder.name("effect_animation")
+	encodeSticker(encoder, emojiReaction.EffectAnimation)
+	encoder.name("around_animation")
+	encodeSticker(encoder, emojiReaction.AroundAnimation)
+	encoder.name("center_animation")
+	encodeSticker(encoder, emojiReaction.CenterAnimation)
+}
+
+func encodeAnimations(encoder *encoder, value *Animations) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (animations *Animations) encode(encoder *encoder) {
+	encoder.meta(TypeAnimations, animations.meta)
+	encoder.name("animations")
+	encodeListOfAnimation(encoder, animations.Animations)
+}
+
+func encodeDiceStickersRegular(encoder *encoder, value *DiceStickersRegular) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (diceStickersRegular *DiceStickersRegular) encode(encoder *encoder) {
+	encoder.meta(TypeDiceStickersRegular, diceStickersRegular.meta)
+	encoder.name("sticker")
+	encodeSticker(encoder, diceStickersRegular.Sticker)
+}
+
+func encodeDiceStickersSlotMachine(encoder *encoder, value *DiceStickersSlotMachine) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (diceStickersSlotMachine *DiceStickersSlotMachine) encode(encoder *encoder) {
+	encoder.meta(TypeDiceStickersSlotMachine, diceStickersSlotMachine.meta)
+	encoder.name("background")
+	encodeSticker(encoder, diceStickersSlotMachine.Background)
+	encoder.name("lever")
+	encodeSticker(encoder, diceStickersSlotMachine.Lever)
+	encoder.name("left_reel")
+	encodeSticker(encoder, diceStickersSlotMachine.LeftReel)
+	encoder.name("center_reel")
+	encodeSticker(encoder, diceStickersSlotMachine.CenterReel)
+	encoder.name("right_reel")
+	encodeSticker(encoder, diceStickersSlotMachine.RightReel)
+}
+
+func encodeImportedContacts(encoder *encoder, value *ImportedContacts) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (importedContacts *ImportedContacts) encode(encoder *encoder) {
+	encoder.meta(TypeImportedContacts, importedContacts.meta)
+	encoder.Int64s("user_ids", importedContacts.UserIds)
+	encoder.Int32s("importer_count", importedContacts.ImporterCount)
+}
+
+func encodeSpeechRecognitionResultPending(encoder *encoder, value *SpeechRecognitionResultPending) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (speechRecognitionResultPending *SpeechRecognitionResultPending) encode(encoder *encoder) {
+	encoder.meta(TypeSpeechRecognitionResultPending, speechRecognitionResultPending.meta)
+	encoder.String("partial_text", speechRecognitionResultPending.PartialText)
+}
+
+func encodeSpeechRecognitionResultText(encoder *encoder, value *SpeechRecognitionResultText) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (speechRecognitionResultText *SpeechRecognitionResultText) encode(encoder *encoder) {
+	encoder.meta(TypeSpeechRecognitionResultText, speechRecognitionResultText.meta)
+	encoder.String("text", speechRecognitionResultText.Text)
+}
+
+func encodeSpeechRecognitionResultError(encoder *encoder, value *SpeechRecognitionResultError) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (speechRecognitionResultError *SpeechRecognitionResultError) encode(encoder *encoder) {
+	encoder.meta(TypeSpeechRecognitionResultError, speechRecognitionResultError.meta)
+	encoder.name("error")
+	encodeError(encoder, speechRecognitionResultError.Error)
+}
+
+func encodeAttachmentMenuBotColor(encoder *encoder, value *AttachmentMenuBotColor) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (attachmentMenuBotColor *AttachmentMenuBotColor) encode(encoder *encoder) {
+	encoder.meta(TypeAttachmentMenuBotColor, attachmentMenuBotColor.meta)
+	encoder.Int32("light_color", attachmentMenuBotColor.LightColor)
+	encoder.Int32("dark_color", attachmentMenuBotColor.DarkColor)
+}
+
+func encodeAttachmentMenuBot(encoder *encoder, value *AttachmentMenuBot) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (attachmentMenuBot *AttachmentMenuBot) encode(encoder *encoder) {
+	encoder.meta(TypeAttachmentMenuBot, attachmentMenuBot.meta)
+	encoder.Int64("bot_user_id", attachmentMenuBot.BotUserId)
+	encoder.Bool("supports_self_chat", attachmentMenuBot.SupportsSelfChat)
+	encoder.Bool("supports_user_chats", attachmentMenuBot.SupportsUserChats)
+	encoder.Bool("supports_bot_chats", attachmentMenuBot.SupportsBotChats)
+	encoder.Bool("supports_group_chats", attachmentMenuBot.SupportsGroupChats)
+	encoder.Bool("supports_channel_chats", attachmentMenuBot.SupportsChannelChats)
+	encoder.Bool("supports_settings", attachmentMenuBot.SupportsSettings)
+	encoder.Bool("request_write_access", attachmentMenuBot.RequestWriteAccess)
+	encoder.String("name", attachmentMenuBot.Name)
+	encoder.name("name_color")
+	encodeAttachmentMenuBotColor(encoder, attachmentMenuBot.NameColor)
+	encoder.name("default_icon")
+	encodeFile(encoder, attachmentMenuBot.DefaultIcon)
+	encoder.name("ios_static_icon")
+	encodeFile(encoder, attachmentMenuBot.IosStaticIcon)
+	encoder.name("ios_animated_icon")
+	encodeFile(encoder, attachmentMenuBot.IosAnimatedIcon)
+	encoder.name("android_icon")
+	encodeFile(encoder, attachmentMenuBot.AndroidIcon)
+	encoder.name("macos_icon")
+	encodeFile(encoder, attachmentMenuBot.MacosIcon)
+	encoder.name("icon_color")
+	encodeAttachmentMenuBotColor(encoder, attachmentMenuBot.IconColor)
+	encoder.name("web_app_placeholder")
+	encodeFile(encoder, attachmentMenuBot.WebAppPlaceholder)
+}
+
+func encodeSentWebAppMessage(encoder *encoder, value *SentWebAppMessage) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (sentWebAppMessage *SentWebAppMessage) encode(encoder *encoder) {
+	encoder.meta(TypeSentWebAppMessage, sentWebAppMessage.meta)
+	encoder.String("inline_message_id", sentWebAppMessage.InlineMessageId)
+}
+
+func encodeHttpUrl(encoder *encoder, value *HttpUrl) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (httpUrl *HttpUrl) encode(encoder *encoder) {
+	encoder.meta(TypeHttpUrl, httpUrl.meta)
+	encoder.String("url", httpUrl.Url)
+}
+
+func encodeUserLink(encoder *encoder, value *UserLink) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (userLink *UserLink) encode(encoder *encoder) {
+	encoder.meta(TypeUserLink, userLink.meta)
+	encoder.String("url", userLink.Url)
+	encoder.Int32("expires_in", userLink.ExpiresIn)
+}
+
+func encodeInputInlineQueryResultAnimation(encoder *encoder, value *InputInlineQueryResultAnimation) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputInlineQueryResultAnimation *InputInlineQueryResultAnimation) encode(encoder *encoder) {
+	encoder.meta(TypeInputInlineQueryResultAnimation, inputInlineQueryResultAnimation.meta)
+	encoder.String("id", inputInlineQueryResultAnimation.Id)
+	encoder.String("title", inputInlineQueryResultAnimation.Title)
+	encoder.String("thumbnail_url", inputInlineQueryResultAnimation.ThumbnailUrl)
+	encoder.String("thumbnail_mime_type", inputInlineQueryResultAnimation.ThumbnailMimeType)
+	encoder.String("video_url", inputInlineQueryResultAnimation.VideoUrl)
+	encoder.String("video_mime_type", inputInlineQueryResultAnimation.VideoMimeType)
+	encoder.Int32("video_duration", inputInlineQueryResultAnimation.VideoDuration)
+	encoder.Int32("video_width", inputInlineQueryResultAnimation.VideoWidth)
+	encoder.Int32("video_height", inputInlineQueryResultAnimation.VideoHeight)
+	encoder.name("reply_markup")
+	encodeReplyMarkup(encoder, inputInlineQueryResultAnimation.ReplyMarkup)
+	encoder.name("input_message_content")
+	encodeInputMessageContent(encoder, inputInlineQueryResultAnimation.InputMessageContent)
+}
+
+func encodeInputInlineQueryResultArticle(encoder *encoder, value *InputInlineQueryResultArticle) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputInlineQueryResultArticle *InputInlineQueryResultArticle) encode(encoder *encoder) {
+	encoder.meta(TypeInputInlineQueryResultArticle, inputInlineQueryResultArticle.meta)
+	encoder.String("id", inputInlineQueryResultArticle.Id)
+	encoder.String("url", inputInlineQueryResultArticle.Url)
+	encoder.Bool("hide_url", inputInlineQueryResultArticle.HideUrl)
+	encoder.String("title", inputInlineQueryResultArticle.Title)
+	encoder.String("description", inputInlineQueryResultArticle.Description)
+	encoder.String("thumbnail_url", inputInlineQueryResultArticle.ThumbnailUrl)
+	encoder.Int32("thumbnail_width", inputInlineQueryResultArticle.ThumbnailWidth)
+	encoder.Int32("thumbnail_height", inputInlineQueryResultArticle.ThumbnailHeight)
+	encoder.name("reply_markup")
+	encodeReplyMarkup(encoder, inputInlineQueryResultArticle.ReplyMarkup)
+	encoder.name("input_message_content")
+	encodeInputMessageContent(encoder, inputInlineQueryResultArticle.InputMessageContent)
+}
+
+func encodeInputInlineQueryResultAudio(encoder *encoder, value *InputInlineQueryResultAudio) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputInlineQueryResultAudio *InputInlineQueryResultAudio) encode(encoder *encoder) {
+	encoder.meta(TypeInputInlineQueryResultAudio, inputInlineQueryResultAudio.meta)
+	encoder.String("id", inputInlineQueryResultAudio.Id)
+	encoder.String("title", inputInlineQueryResultAudio.Title)
+	encoder.String("performer", inputInlineQueryResultAudio.Performer)
+	encoder.String("audio_url", inputInlineQueryResultAudio.AudioUrl)
+	encoder.Int32("audio_duration", inputInlineQueryResultAudio.AudioDuration)
+	encoder.name("reply_markup")
+	encodeReplyMarkup(encoder, inputInlineQueryResultAudio.ReplyMarkup)
+	encoder.name("input_message_content")
+	encodeInputMessageContent(encoder, inputInlineQueryResultAudio.InputMessageContent)
+}
+
+func encodeInputInlineQueryResultContact(encoder *encoder, value *InputInlineQueryResultContact) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputInlineQueryResultContact *InputInlineQueryResultContact) encode(encoder *encoder) {
+	encoder.meta(TypeInputInlineQueryResultContact, inputInlineQueryResultContact.meta)
+	encoder.String("id", inputInlineQueryResultContact.Id)
+	encoder.name("contact")
+	encodeContact(encoder, inputInlineQueryResultContact.Contact)
+	encoder.String("thumbnail_url", inputInlineQueryResultContact.ThumbnailUrl)
+	encoder.Int32("thumbnail_width", inputInlineQueryResultContact.ThumbnailWidth)
+	encoder.Int32("thumbnail_height", inputInlineQueryResultContact.ThumbnailHeight)
+	encoder.name("reply_markup")
+	encodeReplyMarkup(encoder, inputInlineQueryResultContact.ReplyMarkup)
+	encoder.name("input_message_content")
+	encodeInputMessageContent(encoder, inputInlineQueryResultContact.InputMessageContent)
+}
+
+func encodeInputInlineQueryResultDocument(encoder *encoder, value *InputInlineQueryResultDocument) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputInlineQueryResultDocument *InputInlineQueryResultDocument) encode(encoder *encoder) {
+	encoder.meta(TypeInputInlineQueryResultDocument, inputInlineQueryResultDocument.meta)
+	encoder.String("id", inputInlineQueryResultDocument.Id)
+	encoder.String("title", inputInlineQueryResultDocument.Title)
+	encoder.String("description", inputInlineQueryResultDocument.Description)
+	encoder.String("document_url", inputInlineQueryResultDocument.DocumentUrl)
+	encoder.String("mime_type", inputInlineQueryResultDocument.MimeType)
+	encoder.String("thumbnail_url", inputInlineQueryResultDocument.ThumbnailUrl)
+	encoder.Int32("thumbnail_width", inputInlineQueryResultDocument.ThumbnailWidth)
+	encoder.Int32("thumbnail_height", inputInlineQueryResultDocument.ThumbnailHeight)
+	encoder.name("reply_markup")
+	encodeReplyMarkup(encoder, inputInlineQueryResultDocument.ReplyMarkup)
+	encoder.name("input_message_content")
+	encodeInputMessageContent(encoder, inputInlineQueryResultDocument.InputMessageContent)
+}
+
+func encodeInputInlineQueryResultGame(encoder *encoder, value *InputInlineQueryResultGame) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputInlineQueryResultGame *InputInlineQueryResultGame) encode(encoder *encoder) {
+	encoder.meta(TypeInputInlineQueryResultGame, inputInlineQueryResultGame.meta)
+	encoder.String("id", inputInlineQueryResultGame.Id)
+	encoder.String("game_short_name", inputInlineQueryResultGame.GameShortName)
+	encoder.name("reply_markup")
+	encodeReplyMarkup(encoder, inputInlineQueryResultGame.ReplyMarkup)
+}
+
+func encodeInputInlineQueryResultLocation(encoder *encoder, value *InputInlineQueryResultLocation) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputInlineQueryResultLocation *InputInlineQueryResultLocation) encode(encoder *encoder) {
+	encoder.meta(TypeInputInlineQueryResultLocation, inputInlineQueryResultLocation.meta)
+	encoder.String("id", inputInlineQueryResultLocation.Id)
+	encoder.name("location")
+	encodeLocation(encoder, inputInlineQueryResultLocation.Location)
+	encoder.Int32("live_period", inputInlineQueryResultLocation.LivePeriod)
+	encoder.String("title", inputInlineQueryResultLocation.Title)
+	encoder.String("thumbnail_url", inputInlineQueryResultLocation.ThumbnailUrl)
+	encoder.Int32("thumbnail_width", inputInlineQueryResultLocation.ThumbnailWidth)
+	encoder.Int32("thumbnail_height", inputInlineQueryResultLocation.ThumbnailHeight)
+	encoder.name("reply_markup")
+	encodeReplyMarkup(encoder, inputInlineQueryResultLocation.ReplyMarkup)
+	encoder.name("input_message_content")
+	encodeInputMessageContent(encoder, inputInlineQueryResultLocation.InputMessageContent)
+}
+
+func encodeInputInlineQueryResultPhoto(encoder *encoder, value *InputInlineQueryResultPhoto) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputInlineQueryResultPhoto *InputInlineQueryResultPhoto) encode(encoder *encoder) {
+	encoder.meta(TypeInputInlineQueryResultPhoto, inputInlineQueryResultPhoto.meta)
+	encoder.String("id", inputInlineQueryResultPhoto.Id)
+	encoder.String("title", inputInlineQueryResultPhoto.Title)
+	encoder.String("description", inputInlineQueryResultPhoto.Description)
+	encoder.String("thumbnail_url", inputInlineQueryResultPhoto.ThumbnailUrl)
+	encoder.String("photo_url", inputInlineQueryResultPhoto.PhotoUrl)
+	encoder.Int32("photo_width", inputInlineQueryResultPhoto.PhotoWidth)
+	encoder.Int32("photo_height", inputInlineQueryResultPhoto.PhotoHeight)
+	encoder.name("reply_markup")
+	encodeReplyMarkup(encoder, inputInlineQueryResultPhoto.ReplyMarkup)
+	encoder.name("input_message_content")
+	encodeInputMessageContent(encoder, inputInlineQueryResultPhoto.InputMessageContent)
+}
+
+func encodeInputInlineQueryResultSticker(encoder *encoder, value *InputInlineQueryResultSticker) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputInlineQueryResultSticker *InputInlineQueryResultSticker) encode(encoder *encoder) {
+	encoder.meta(TypeInputInlineQueryResultSticker, inputInlineQueryResultSticker.meta)
+	encoder.String("id", inputInlineQueryResultSticker.Id)
+	encoder.String("thumbnail_url", inputInlineQueryResultSticker.ThumbnailUrl)
+	encoder.String("sticker_url", inputInlineQueryResultSticker.StickerUrl)
+	encoder.Int32("sticker_width", inputInlineQueryResultSticker.StickerWidth)
+	encoder.Int32("sticker_height", inputInlineQueryResultSticker.StickerHeight)
+	encoder.name("reply_markup")
+	encodeReplyMarkup(encoder, inputInlineQueryResultSticker.ReplyMarkup)
+	encoder.name("input_message_content")
+	encodeInputMessageContent(encoder, inputInlineQueryResultSticker.InputMessageContent)
+}
+
+func encodeInputInlineQueryResultVenue(encoder *encoder, value *InputInlineQueryResultVenue) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputInlineQueryResultVenue *InputInlineQueryResultVenue) encode(encoder *encoder) {
+	encoder.meta(TypeInputInlineQueryResultVenue, inputInlineQueryResultVenue.meta)
+	encoder.String("id", inputInlineQueryResultVenue.Id)
+	encoder.name("venue")
+	encodeVenue(encoder, inputInlineQueryResultVenue.Venue)
+	encoder.String("thumbnail_url", inputInlineQueryResultVenue.ThumbnailUrl)
+	encoder.Int32("thumbnail_width", inputInlineQueryResultVenue.ThumbnailWidth)
+	encoder.Int32("thumbnail_height", inputInlineQueryResultVenue.ThumbnailHeight)
+	encoder.name("reply_markup")
+	encodeReplyMarkup(encoder, inputInlineQueryResultVenue.ReplyMarkup)
+	encoder.name("input_message_content")
+	encodeInputMessageContent(encoder, inputInlineQueryResultVenue.InputMessageContent)
+}
+
+func encodeInputInlineQueryResultVideo(encoder *encoder, value *InputInlineQueryResultVideo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputInlineQueryResultVideo *InputInlineQueryResultVideo) encode(encoder *encoder) {
+	encoder.meta(TypeInputInlineQueryResultVideo, inputInlineQueryResultVideo.meta)
+	encoder.String("id", inputInlineQueryResultVideo.Id)
+	encoder.String("title", inputInlineQueryResultVideo.Title)
+	encoder.String("description", inputInlineQueryResultVideo.Description)
+	encoder.String("thumbnail_url", inputInlineQueryResultVideo.ThumbnailUrl)
+	encoder.String("video_url", inputInlineQueryResultVideo.VideoUrl)
+	encoder.String("mime_type", inputInlineQueryResultVideo.MimeType)
+	encoder.Int32("video_width", inputInlineQueryResultVideo.VideoWidth)
+	encoder.Int32("video_height", inputInlineQueryResultVideo.VideoHeight)
+	encoder.Int32("video_duration", inputInlineQueryResultVideo.VideoDuration)
+	encoder.name("reply_markup")
+	encodeReplyMarkup(encoder, inputInlineQueryResultVideo.ReplyMarkup)
+	encoder.name("input_message_content")
+	encodeInputMessageContent(encoder, inputInlineQueryResultVideo.InputMessageContent)
+}
+
+func encodeInputInlineQueryResultVoiceNote(encoder *encoder, value *InputInlineQueryResultVoiceNote) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputInlineQueryResultVoiceNote *InputInlineQueryResultVoiceNote) encode(encoder *encoder) {
+	encoder.meta(TypeInputInlineQueryResultVoiceNote, inputInlineQueryResultVoiceNote.meta)
+	encoder.String("id", inputInlineQueryResultVoiceNote.Id)
+	encoder.String("title", inputInlineQueryResultVoiceNote.Title)
+	encoder.String("voice_note_url", inputInlineQueryResultVoiceNote.VoiceNoteUrl)
+	encoder.Int32("voice_note_duration", inputInlineQueryResultVoiceNote.VoiceNoteDuration)
+	encoder.name("reply_markup")
+	encodeReplyMarkup(encoder, inputInlineQueryResultVoiceNote.ReplyMarkup)
+	encoder.name("input_message_content")
+	encodeInputMessageContent(encoder, inputInlineQueryResultVoiceNote.InputMessageContent)
+}
+
+func encodeInlineQueryResultArticle(encoder *encoder, value *InlineQueryResultArticle) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inlineQueryResultArticle *InlineQueryResultArticle) encode(encoder *encoder) {
+	encoder.meta(TypeInlineQueryResultArticle, inlineQueryResultArticle.meta)
+	encoder.String("id", inlineQueryResultArticle.Id)
+	encoder.String("url", inlineQueryResultArticle.Url)
+	encoder.Bool("hide_url", inlineQueryResultArticle.HideUrl)
+	encoder.String("title", inlineQueryResultArticle.Title)
+	encoder.String("description", inlineQueryResultArticle.Description)
+	encoder.name("thumbnail")
+	encodeThumbnail(encoder, inlineQueryResultArticle.Thumbnail)
+}
+
+func encodeInlineQueryResultContact(encoder *encoder, value *InlineQueryResultContact) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inlineQueryResultContact *InlineQueryResultContact) encode(encoder *encoder) {
+	encoder.meta(TypeInlineQueryResultContact, inlineQueryResultContact.meta)
+	encoder.String("id", inlineQueryResultContact.Id)
+	encoder.name("contact")
+	encodeContact(encoder, inlineQueryResultContact.Contact)
+	encoder.name("thumbnail")
+	encodeThumbnail(encoder, inlineQueryResultContact.Thumbnail)
+}
+
+func encodeInlineQueryResultLocation(encoder *encoder, value *InlineQueryResultLocation) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inlineQueryResultLocation *InlineQueryResultLocation) encode(encoder *encoder) {
+	encoder.meta(TypeInlineQueryResultLocation, inlineQueryResultLocation.meta)
+	encoder.String("id", inlineQueryResultLocation.Id)
+	encoder.name("location")
+	encodeLocation(encoder, inlineQueryResultLocation.Location)
+	encoder.String("title", inlineQueryResultLocation.Title)
+	encoder.name("thumbnail")
+	encodeThumbnail(encoder, inlineQueryResultLocation.Thumbnail)
+}
+
+func encodeInlineQueryResultVenue(encoder *encoder, value *InlineQueryResultVenue) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inlineQueryResultVenue *InlineQueryResultVenue) encode(encoder *encoder) {
+	encoder.meta(TypeInlineQueryResultVenue, inlineQueryResultVenue.meta)
+	encoder.String("id", inlineQueryResultVenue.Id)
+	encoder.name("venue")
+	encodeVenue(encoder, inlineQueryResultVenue.Venue)
+	encoder.name("thumbnail")
+	encodeThumbnail(encoder, inlineQueryResultVenue.Thumbnail)
+}
+
+func encodeInlineQueryResultGame(encoder *encoder, value *InlineQueryResultGame) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inlineQueryResultGame *InlineQueryResultGame) encode(encoder *encoder) {
+	encoder.meta(TypeInlineQueryResultGame, inlineQueryResultGame.meta)
+	encoder.String("id", inlineQueryResultGame.Id)
+	encoder.name("game")
+	encodeGame(encoder, inlineQueryResultGame.Game)
+}
+
+func encodeInlineQueryResultAnimation(encoder *encoder, value *InlineQueryResultAnimation) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inlineQueryResultAnimation *InlineQueryResultAnimation) encode(encoder *encoder) {
+	encoder.meta(TypeInlineQueryResultAnimation, inlineQueryResultAnimation.meta)
+	encoder.String("id", inlineQueryResultAnimation.Id)
+	encoder.name("animation")
+	encodeAnimation(encoder, inlineQueryResultAnimation.Animation)
+	encoder.String("title", inlineQueryResultAnimation.Title)
+}
+
+func encodeInlineQueryResultAudio(encoder *encoder, value *InlineQueryResultAudio) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inlineQueryResultAudio *InlineQueryResultAudio) encode(encoder *encoder) {
+	encoder.meta(TypeInlineQueryResultAudio, inlineQueryResultAudio.meta)
+	encoder.String("id", inlineQueryResultAudio.Id)
+	encoder.name("audio")
+	encodeAudio(encoder, inlineQueryResultAudio.Audio)
+}
+
+func encodeInlineQueryResultDocument(encoder *encoder, value *InlineQueryResultDocument) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inlineQueryResultDocument *InlineQueryResultDocument) encode(encoder *encoder) {
+	encoder.meta(TypeInlineQueryResultDocument, inlineQueryResultDocument.meta)
+	encoder.String("id", inlineQueryResultDocument.Id)
+	encoder.name("document")
+	encodeDocument(encoder, inlineQueryResultDocument.Document)
+	encoder.String("title", inlineQueryResultDocument.Title)
+	encoder.String("description", inlineQueryResultDocument.Description)
+}
+
+func encodeInlineQueryResultPhoto(encoder *encoder, value *InlineQueryResultPhoto) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inlineQueryResultPhoto *InlineQueryResultPhoto) encode(encoder *encoder) {
+	encoder.meta(TypeInlineQueryResultPhoto, inlineQueryResultPhoto.meta)
+	encoder.String("id", inlineQueryResultPhoto.Id)
+	encoder.name("photo")
+	encodePhoto(encoder, inlineQueryResultPhoto.Photo)
+	encoder.String("title", inlineQueryResultPhoto.Title)
+	encoder.String("description", inlineQueryResultPhoto.Description)
+}
+
+func encodeInlineQueryResultSticker(encoder *encoder, value *InlineQueryResultSticker) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inlineQueryResultSticker *InlineQueryResultSticker) encode(encoder *encoder) {
+	encoder.meta(TypeInlineQueryResultSticker, inlineQueryResultSticker.meta)
+	encoder.String("id", inlineQueryResultSticker.Id)
+	encoder.name("sticker")
+	encodeSticker(encoder, inlineQueryResultSticker.Sticker)
+}
+
+func encodeInlineQueryResultVideo(encoder *encoder, value *InlineQueryResultVideo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inlineQueryResultVideo *InlineQueryResultVideo) encode(encoder *encoder) {
+	encoder.meta(TypeInlineQueryResultVideo, inlineQueryResultVideo.meta)
+	encoder.String("id", inlineQueryResultVideo.Id)
+	encoder.name("video")
+	encodeVideo(encoder, inlineQueryResultVideo.Video)
+	encoder.String("title", inlineQueryResultVideo.Title)
+	encoder.String("description", inlineQueryResultVideo.Description)
+}
+
+func encodeInlineQueryResultVoiceNote(encoder *encoder, value *InlineQueryResultVoiceNote) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inlineQueryResultVoiceNote *InlineQueryResultVoiceNote) encode(encoder *encoder) {
+	encoder.meta(TypeInlineQueryResultVoiceNote, inlineQueryResultVoiceNote.meta)
+	encoder.String("id", inlineQueryResultVoiceNote.Id)
+	encoder.name("voice_note")
+	encodeVoiceNote(encoder, inlineQueryResultVoiceNote.VoiceNote)
+	encoder.String("title", inlineQueryResultVoiceNote.Title)
+}
+
+func encodeInlineQueryResultsButtonTypeStartBot(encoder *encoder, value *InlineQueryResultsButtonTypeStartBot) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inlineQueryResultsButtonTypeStartBot *InlineQueryResultsButtonTypeStartBot) encode(encoder *encoder) {
+	encoder.meta(TypeInlineQueryResultsButtonTypeStartBot, inlineQueryResultsButtonTypeStartBot.meta)
+	encoder.String("parameter", inlineQueryResultsButtonTypeStartBot.Parameter)
+}
+
+func encodeInlineQueryResultsButtonTypeWebApp(encoder *encoder, value *InlineQueryResultsButtonTypeWebApp) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inlineQueryResultsButtonTypeWebApp *InlineQueryResultsButtonTypeWebApp) encode(encoder *encoder) {
+	encoder.meta(TypeInlineQueryResultsButtonTypeWebApp, inlineQueryResultsButtonTypeWebApp.meta)
+	encoder.String("url", inlineQueryResultsButtonTypeWebApp.Url)
+}
+
+func encodeInlineQueryResultsButton(encoder *encoder, value *InlineQueryResultsButton) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inlineQueryResultsButton *InlineQueryResultsButton) encode(encoder *encoder) {
+	encoder.meta(TypeInlineQueryResultsButton, inlineQueryResultsButton.meta)
+	encoder.String("text", inlineQueryResultsButton.Text)
+	encoder.name("type")
+	encodeInlineQueryResultsButtonType(encoder, inlineQueryResultsButton.Type)
+}
+
+func encodeInlineQueryResults(encoder *encoder, value *InlineQueryResults) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inlineQueryResults *InlineQueryResults) encode(encoder *encoder) {
+	encoder.meta(TypeInlineQueryResults, inlineQueryResults.meta)
+	encoder.JsonInt64("inline_query_id", inlineQueryResults.InlineQueryId)
+	encoder.name("button")
+	encodeInlineQueryResultsButton(encoder, inlineQueryResults.Button)
+	encoder.name("results")
+	encodeListOfInlineQueryResult(encoder, inlineQueryResults.Results)
+	encoder.String("next_offset", inlineQueryResults.NextOffset)
+}
+
+func encodeCallbackQueryPayloadData(encoder *encoder, value *CallbackQueryPayloadData) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (callbackQueryPayloadData *CallbackQueryPayloadData) encode(encoder *encoder) {
+	encoder.meta(TypeCallbackQueryPayloadData, callbackQueryPayloadData.meta)
+	encoder.Bytes("data", callbackQueryPayloadData.Data)
+}
+
+func encodeCallbackQueryPayloadDataWithPassword(encoder *encoder, value *CallbackQueryPayloadDataWithPassword) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (callbackQueryPayloadDataWithPassword *CallbackQueryPayloadDataWithPassword) encode(encoder *encoder) {
+	encoder.meta(TypeCallbackQueryPayloadDataWithPassword, callbackQueryPayloadDataWithPassword.meta)
+	encoder.String("password", callbackQueryPayloadDataWithPassword.Password)
+	encoder.Bytes("data", callbackQueryPayloadDataWithPassword.Data)
+}
+
+func encodeCallbackQueryPayloadGame(encoder *encoder, value *CallbackQueryPayloadGame) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (callbackQueryPayloadGame *CallbackQueryPayloadGame) encode(encoder *encoder) {
+	encoder.meta(TypeCallbackQueryPayloadGame, callbackQueryPayloadGame.meta)
+	encoder.String("game_short_name", callbackQueryPayloadGame.GameShortName)
+}
+
+func encodeCallbackQueryAnswer(encoder *encoder, value *CallbackQueryAnswer) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (callbackQueryAnswer *CallbackQueryAnswer) encode(encoder *encoder) {
+	encoder.meta(TypeCallbackQueryAnswer, callbackQueryAnswer.meta)
+	encoder.String("text", callbackQueryAnswer.Text)
+	encoder.Bool("show_alert", callbackQueryAnswer.ShowAlert)
+	encoder.String("url", callbackQueryAnswer.Url)
+}
+
+func encodeCustomRequestResult(encoder *encoder, value *CustomRequestResult) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (customRequestResult *CustomRequestResult) encode(encoder *encoder) {
+	encoder.meta(TypeCustomRequestResult, customRequestResult.meta)
+	encoder.String("result", customRequestResult.Result)
+}
+
+func encodeGameHighScore(encoder *encoder, value *GameHighScore) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (gameHighScore *GameHighScore) encode(encoder *encoder) {
+	encoder.meta(TypeGameHighScore, gameHighScore.meta)
+	encoder.Int32("position", gameHighScore.Position)
+	encoder.Int64("user_id", gameHighScore.UserId)
+	encoder.Int32("score", gameHighScore.Score)
+}
+
+func encodeGameHighScores(encoder *encoder, value *GameHighScores) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (gameHighScores *GameHighScores) encode(encoder *encoder) {
+	encoder.meta(TypeGameHighScores, gameHighScores.meta)
+	encoder.name("scores")
+	encodeListOfGameHighScore(encoder, gameHighScores.Scores)
+}
+
+func encodeChatEventMessageEdited(encoder *encoder, value *ChatEventMessageEdited) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatEventMessageEdited *ChatEventMessageEdited) encode(encoder *encoder) {
+	encoder.meta(TypeChatEventMessageEdited, chatEventMessageEdited.meta)
+	encoder.name("old_message")
+	encodeMessage(encoder, chatEventMessageEdited.OldMessage)
+	encoder.name("new_message")
+	encodeMessage(encoder, chatEventMessageEdited.NewMessage)
+}
+
+func encodeChatEventMessageDeleted(encoder *encoder, value *ChatEventMessageDeleted) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatEventMessageDeleted *ChatEventMessageDeleted) encode(encoder *encoder) {
+	encoder.meta(TypeChatEventMessageDeleted, chatEventMessageDeleted.meta)
+	encoder.name("message")
+	encodeMessage(encoder, chatEventMessageDeleted.Message)
+	encoder.Bool("can_report_anti_spam_false_positive", chatEventMessageDeleted.CanReportAntiSpamFalsePositive)
+}
+
+func encodeChatEventMessagePinned(encoder *encoder, value *ChatEventMessagePinned) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatEventMessagePinned *ChatEventMessagePinned) encode(encoder *encoder) {
+	encoder.meta(TypeChatEventMessagePinned, chatEventMessagePinned.meta)
+	encoder.name("message")
+	encodeMessage(encoder, chatEventMessagePinned.Message)
+}
+
+func encodeChatEventMessageUnpinned(encoder *encoder, value *ChatEventMessageUnpinned) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatEventMessageUnpinned *ChatEventMessageUnpinned) encode(encoder *encoder) {
+	encoder.meta(TypeChatEventMessageUnpinned, chatEventMessageUnpinned.meta)
+	encoder.name("message")
+	encodeMessage(encoder, chatEventMessageUnpinned.Message)
+}
+
+func encodeChatEventPollStopped(encoder *encoder, value *ChatEventPollStopped) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatEventPollStopped *ChatEventPollStopped) encode(encoder *encoder) {
+	encoder.meta(TypeChatEventPollStopped, chatEventPollStopped.meta)
+	encoder.name("message")
+	encodeMessage(encoder, chatEventPollStopped.Message)
+}
+
+func encodeChatEventMemberJoined(encoder *encoder, value *ChatEventMemberJoined) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatEventMemberJoined *ChatEventMemberJoined) encode(encoder *encoder) {
+	encoder.meta(TypeChatEventMemberJoined, chatEventMemberJoined.meta)
+}
+
+func encodeChatEventMemberJoinedByInviteLink(encoder *encoder, value *ChatEventMemberJoinedByInviteLink) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatEventMemberJoinedByInviteLink *ChatEventMemberJoinedByInviteLink) encode(encoder *encoder) {
+	encoder.meta(TypeChatEventMemberJoinedByInviteLink, chatEventMemberJoinedByInviteLink.meta)
+	encoder.name("invite_link")
+	encodeChatInviteLink(encoder, chatEventMemberJoinedByInviteLink.InviteLink)
+	encoder.Bool("via_chat_folder_invite_link", chatEventMemberJoinedByInviteLink.ViaChatFolderInviteLink)
+}
+
+func encodeChatEventMemberJoinedByRequest(encoder *encoder, value *ChatEventMemberJoinedByRequest) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatEventMemberJoinedByRequest *ChatEventMemberJoinedByRequest) encode(encoder *encoder) {
+	encoder.meta(TypeChatEventMemberJoinedByRequest, chatEventMemberJoinedByRequest.meta)
+	encoder.Int64("approver_user_id", chatEventMemberJoinedByRequest.ApproverUserId)
+	encoder.name("invite_link")
+	encodeChatInviteLink(encoder, chatEventMemberJoinedByRequest.InviteLink)
+}
+
+func encodeChatEventMemberInvited(encoder *encoder, value *ChatEventMemberInvited) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatEventMemberInvited *ChatEventMemberInvited) encode(encoder *encoder) {
+	encoder.meta(TypeChatEventMemberInvited, chatEventMemberInvited.meta)
+	encoder.Int64("user_id", chatEventMemberInvited.UserId)
+	encoder.name("status")
+	encodeChatMemberStatus(encoder, chatEventMemberInvited.Status)
+}
+
+func encodeChatEventMemberLeft(encoder *encoder, value *ChatEventMemberLeft) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatEventMemberLeft *ChatEventMemberLeft) encode(encoder *encoder) {
+	encoder.meta(TypeChatEventMemberLeft, chatEventMemberLeft.meta)
+}
+
+func encodeChatEventMemberPromoted(encoder *encoder, value *ChatEventMemberPromoted) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatEventMemberPromoted *ChatEventMemberPromoted) encode(encoder *encoder) {
+	encoder.meta(TypeChatEventMemberPromoted, chatEventMemberPromoted.meta)
+	encoder.Int64("user_id", chatEventMemberPromoted.UserId)
+	encoder.name("old_status")
+	encodeChatMemberStatus(encoder, chatEventMemberPromoted.OldStatus)
+	encoder.name("new_status")
+	encodeChatMemberStatus(encoder, chatEventMemberPromoted.NewStatus)
+}
+
+func encodeChatEventMemberRestricted(encoder *encoder, value *ChatEventMemberRestricted) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatEventMemberRestricted *ChatEventMemberRestricted) encode(encoder *encoder) {
+	encoder.meta(TypeChatEventMemberRestricted, chatEventMemberRestricted.meta)
+	encoder.name("member_id")
+	encodeMessageSender(encoder, chatEventMemberRestricted.MemberId)
+	encoder.name("old_status")
+	encodeChatMemberStatus(encoder, chatEventMemberRestricted.OldStatus)
+	encoder.name("new_status")
+	encodeChatMemberStatus(encoder, chatEventMemberRestricted.NewStatus)
+}
+
+func encodeChatEventAvailableReactionsChanged(encoder *encoder, value *ChatEventAvailableReactionsChanged) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatEventAvailableReactionsChanged *ChatEventAvailableReactionsChanged) encode(encoder *encoder) {
+	encoder.meta(TypeChatEventAvailableReactionsChanged, chatEventAvailableReactionsChanged.meta)
+	encoder.name("old_available_reactions")
+	encodeChatAvailableReactions(encoder, chatEventAvailableReactionsChanged.OldAvailableReactions)
+	encoder.name("new_available_reactions")
+	encodeChatAvailableReactions(encoder, chatEventAvailableReactionsChanged.NewAvailableReactions)
+}
+
+func encodeChatEventDescriptionChanged(encoder *encoder, value *ChatEventDescriptionChanged) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatEventDescriptionChanged *ChatEventDescriptionChanged) encode(encoder *encoder) {
+	encoder.meta(TypeChatEventDescriptionChanged, chatEventDescriptionChanged.meta)
+	encoder.String("old_description", chatEventDescriptionChanged.OldDescription)
+	encoder.String("new_description", chatEventDescriptionChanged.NewDescription)
+}
+
+func encodeChatEventLinkedChatChanged(encoder *encoder, value *ChatEventLinkedChatChanged) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatEventLinkedChatChanged *ChatEventLinkedChatChanged) encode(encoder *encoder) {
+	encoder.meta(TypeChatEventLinkedChatChanged, chatEventLinkedChatChanged.meta)
+	encoder.Int64("old_linked_chat_id", chatEventLinkedChatChanged.OldLinkedChatId)
+	encoder.Int64("new_linked_chat_id", chatEventLinkedChatChanged.NewLinkedChatId)
+}
+
+func encodeChatEventLocationChanged(encoder *encoder, value *ChatEventLocationChanged) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatEventLocationChanged *ChatEventLocationChanged) encode(encoder *encoder) {
+	encoder.meta(TypeChatEventLocationChanged, chatEventLocationChanged.meta)
+	encoder.name("old_location")
+	encodeChatLocation(encoder, chatEventLocationChanged.OldLocation)
+	encoder.name("new_location")
+	encodeChatLocation(encoder, chatEventLocationChanged.NewLocation)
+}
+
+func encodeChatEventMessageAutoDeleteTimeChanged(encoder *encoder, value *ChatEventMessageAutoDeleteTimeChanged) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatEventMessageAutoDeleteTimeChanged *ChatEventMessageAutoDeleteTimeChanged) encode(encoder *encoder) {
+	encoder.meta(TypeChatEventMessageAutoDeleteTimeChanged, chatEventMessageAutoDeleteTimeChanged.meta)
+	encoder.Int32("old_message_auto_delete_time", chatEventMessageAutoDeleteTimeChanged.OldMessageAutoDeleteTime)
+	encoder.Int32("new_message_auto_delete_time", chatEventMessageAutoDeleteTimeChanged.NewMessageAutoDeleteTime)
+}
+
+func encodeChatEventPermissionsChanged(encoder *encoder, value *ChatEventPermissionsChanged) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatEventPermissionsChanged *ChatEventPermissionsChanged) encode(encoder *encoder) {
+	encoder.meta(TypeChatEventPermissionsChanged, chatEventPermissionsChanged.meta)
+	encoder.name("old_permissions")
+	encodeChatPermissions(encoder, chatEventPermissionsChanged.OldPermissions)
+	encoder.name("new_permissions")
+	encodeChatPermissions(encoder, chatEventPermissionsChanged.NewPermissions)
+}
+
+func encodeChatEventPhotoChanged(encoder *encoder, value *ChatEventPhotoChanged) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatEventPhotoChanged *ChatEventPhotoChanged) encode(encoder *encoder) {
+	encoder.meta(TypeChatEventPhotoChanged, chatEventPhotoChanged.meta)
+	encoder.name("old_photo")
+	encodeChatPhoto(encoder, chatEventPhotoChanged.OldPhoto)
+	encoder.name("new_photo")
+	encodeChatPhoto(encoder, chatEventPhotoChanged.NewPhoto)
+}
+
+func encodeChatEventSlowModeDelayChanged(encoder *encoder, value *ChatEventSlowModeDelayChanged) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatEventSlowModeDelayChanged *ChatEventSlowModeDelayChanged) encode(encoder *encoder) {
+	encoder.meta(TypeChatEventSlowModeDelayChanged, chatEventSlowModeDelayChanged.meta)
+	encoder.Int32("old_slow_mode_delay", chatEventSlowModeDelayChanged.OldSlowModeDelay)
+	encoder.Int32("new_slow_mode_delay", chatEventSlowModeDelayChanged.NewSlowModeDelay)
+}
+
+func encodeChatEventStickerSetChanged(encoder *encoder, value *ChatEventStickerSetChanged) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatEventStickerSetChanged *ChatEventStickerSetChanged) encode(encoder *encoder) {
+	encoder.meta(TypeChatEventStickerSetChanged, chatEventStickerSetChanged.meta)
+	encoder.JsonInt64("old_sticker_set_id", chatEventStickerSetChanged.OldStickerSetId)
+	encoder.JsonInt64("new_sticker_set_id", chatEventStickerSetChanged.NewStickerSetId)
+}
+
+func encodeChatEventTitleChanged(encoder *encoder, value *ChatEventTitleChanged) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatEventTitleChanged *ChatEventTitleChanged) encode(encoder *encoder) {
+	encoder.meta(TypeChatEventTitleChanged, chatEventTitleChanged.meta)
+	encoder.String("old_title", chatEventTitleChanged.OldTitle)
+	encoder.String("new_title", chatEventTitleChanged.NewTitle)
+}
+
+func encodeChatEventUsernameChanged(encoder *encoder, value *ChatEventUsernameChanged) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatEventUsernameChanged *ChatEventUsernameChanged) encode(encoder *encoder) {
+	encoder.meta(TypeChatEventUsernameChanged, chatEventUsernameChanged.meta)
+	encoder.String("old_username", chatEventUsernameChanged.OldUsername)
+	encoder.String("new_username", chatEventUsernameChanged.NewUsername)
+}
+
+func encodeChatEventActiveUsernamesChanged(encoder *encoder, value *ChatEventActiveUsernamesChanged) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatEventActiveUsernamesChanged *ChatEventActiveUsernamesChanged) encode(encoder *encoder) {
+	encoder.meta(TypeChatEventActiveUsernamesChanged, chatEventActiveUsernamesChanged.meta)
+	encoder.Strings("old_usernames", chatEventActiveUsernamesChanged.OldUsernames)
+	encoder.Strings("new_usernames", chatEventActiveUsernamesChanged.NewUsernames)
+}
+
+func encodeChatEventHasProtectedContentToggled(encoder *encoder, value *ChatEventHasProtectedContentToggled) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatEventHasProtectedContentToggled *ChatEventHasProtectedContentToggled) encode(encoder *encoder) {
+	encoder.meta(TypeChatEventHasProtectedContentToggled, chatEventHasProtectedContentToggled.meta)
+	encoder.Bool("has_protected_content", chatEventHasProtectedContentToggled.HasProtectedContent)
+}
+
+func encodeChatEventInvitesToggled(encoder *encoder, value *ChatEventInvitesToggled) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatEventInvitesToggled *ChatEventInvitesToggled) encode(encoder *encoder) {
+	encoder.meta(TypeChatEventInvitesToggled, chatEventInvitesToggled.meta)
+	encoder.Bool("can_invite_users", chatEventInvitesToggled.CanInviteUsers)
+}
+
+func encodeChatEventIsAllHistoryAvailableToggled(encoder *encoder, value *ChatEventIsAllHistoryAvailableToggled) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatEventIsAllHistoryAvailableToggled *ChatEventIsAllHistoryAvailableToggled) encode(encoder *encoder) {
+	encoder.meta(TypeChatEventIsAllHistoryAvailableToggled, chatEventIsAllHistoryAvailableToggled.meta)
+	encoder.Bool("is_all_history_available", chatEventIsAllHistoryAvailableToggled.IsAllHistoryAvailable)
+}
+
+func encodeChatEventHasAggressiveAntiSpamEnabledToggled(encoder *encoder, value *ChatEventHasAggressiveAntiSpamEnabledToggled) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatEventHasAggressiveAntiSpamEnabledToggled *ChatEventHasAggressiveAntiSpamEnabledToggled) encode(encoder *encoder) {
+	encoder.meta(TypeChatEventHasAggressiveAntiSpamEnabledToggled, chatEventHasAggressiveAntiSpamEnabledToggled.meta)
+	encoder.Bool("has_aggressive_anti_spam_enabled", chatEventHasAggressiveAntiSpamEnabledToggled.HasAggressiveAntiSpamEnabled)
+}
+
+func encodeChatEventSignMessagesToggled(encoder *encoder, value *ChatEventSignMessagesToggled) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatEventSignMessagesToggled *ChatEventSignMessagesToggled) encode(encoder *encoder) {
+	encoder.meta(TypeChatEventSignMessagesToggled, chatEventSignMessagesToggled.meta)
+	encoder.Bool("sign_messages", chatEventSignMessagesToggled.SignMessages)
+}
+
+func encodeChatEventInviteLinkEdited(encoder *encoder, value *ChatEventInviteLinkEdited) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatEventInviteLinkEdited *ChatEventInviteLinkEdited) encode(encoder *encoder) {
+	encoder.meta(TypeChatEventInviteLinkEdited, chatEventInviteLinkEdited.meta)
+	encoder.name("old_invite_link")
+	encodeChatInviteLink(encoder, chatEventInviteLinkEdited.OldInviteLink)
+	encoder.name("new_invite_link")
+	encodeChatInviteLink(encoder, chatEventInviteLinkEdited.NewInviteLink)
+}
+
+func encodeChatEventInviteLinkRevoked(encoder *encoder, value *ChatEventInviteLinkRevoked) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatEventInviteLinkRevoked *ChatEventInviteLinkRevoked) encode(encoder *encoder) {
+	encoder.meta(TypeChatEventInviteLinkRevoked, chatEventInviteLinkRevoked.meta)
+	encoder.name("invite_link")
+	encodeChatInviteLink(encoder, chatEventInviteLinkRevoked.InviteLink)
+}
+
+func encodeChatEventInviteLinkDeleted(encoder *encoder, value *ChatEventInviteLinkDeleted) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatEventInviteLinkDeleted *ChatEventInviteLinkDeleted) encode(encoder *encoder) {
+	encoder.meta(TypeChatEventInviteLinkDeleted, chatEventInviteLinkDeleted.meta)
+	encoder.name("invite_link")
+	encodeChatInviteLink(encoder, chatEventInviteLinkDeleted.InviteLink)
+}
+
+func encodeChatEventVideoChatCreated(encoder *encoder, value *ChatEventVideoChatCreated) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatEventVideoChatCreated *ChatEventVideoChatCreated) encode(encoder *encoder) {
+	encoder.meta(TypeChatEventVideoChatCreated, chatEventVideoChatCreated.meta)
+	encoder.Int32("group_call_id", chatEventVideoChatCreated.GroupCallId)
+}
+
+func encodeChatEventVideoChatEnded(encoder *encoder, value *ChatEventVideoChatEnded) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatEventVideoChatEnded *ChatEventVideoChatEnded) encode(encoder *encoder) {
+	encoder.meta(TypeChatEventVideoChatEnded, chatEventVideoChatEnded.meta)
+	encoder.Int32("group_call_id", chatEventVideoChatEnded.GroupCallId)
+}
+
+func encodeChatEventVideoChatMuteNewParticipantsToggled(encoder *encoder, value *ChatEventVideoChatMuteNewParticipantsToggled) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatEventVideoChatMuteNewParticipantsToggled *ChatEventVideoChatMuteNewParticipantsToggled) encode(encoder *encoder) {
+	encoder.meta(TypeChatEventVideoChatMuteNewParticipantsToggled, chatEventVideoChatMuteNewParticipantsToggled.meta)
+	encoder.Bool("mute_new_participants", chatEventVideoChatMuteNewParticipantsToggled.MuteNewParticipants)
+}
+
+func encodeChatEventVideoChatParticipantIsMutedToggled(encoder *encoder, value *ChatEventVideoChatParticipantIsMutedToggled) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatEventVideoChatParticipantIsMutedToggled *ChatEventVideoChatParticipantIsMutedToggled) encode(encoder *encoder) {
+	encoder.meta(TypeChatEventVideoChatParticipantIsMutedToggled, chatEventVideoChatParticipantIsMutedToggled.meta)
+	encoder.name("participant_id")
+	encodeMessageSender(encoder, chatEventVideoChatParticipantIsMutedToggled.ParticipantId)
+	encoder.Bool("is_muted", chatEventVideoChatParticipantIsMutedToggled.IsMuted)
+}
+
+func encodeChatEventVideoChatParticipantVolumeLevelChanged(encoder *encoder, value *ChatEventVideoChatParticipantVolumeLevelChanged) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatEventVideoChatParticipantVolumeLevelChanged *ChatEventVideoChatParticipantVolumeLevelChanged) encode(encoder *encoder) {
+	encoder.meta(TypeChatEventVideoChatParticipantVolumeLevelChanged, chatEventVideoChatParticipantVolumeLevelChanged.meta)
+	encoder.name("participant_id")
+	encodeMessageSender(encoder, chatEventVideoChatParticipantVolumeLevelChanged.ParticipantId)
+	encoder.Int32("volume_level", chatEventVideoChatParticipantVolumeLevelChanged.VolumeLevel)
+}
+
+func encodeChatEventIsForumToggled(encoder *encoder, value *ChatEventIsForumToggled) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatEventIsForumToggled *ChatEventIsForumToggled) encode(encoder *encoder) {
+	encoder.meta(TypeChatEventIsForumToggled, chatEventIsForumToggled.meta)
+	encoder.Bool("is_forum", chatEventIsForumToggled.IsForum)
+}
+
+func encodeChatEventForumTopicCreated(encoder *encoder, value *ChatEventForumTopicCreated) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatEventForumTopicCreated *ChatEventForumTopicCreated) encode(encoder *encoder) {
+	encoder.meta(TypeChatEventForumTopicCreated, chatEventForumTopicCreated.meta)
+	encoder.name("topic_info")
+	encodeForumTopicInfo(encoder, chatEventForumTopicCreated.TopicInfo)
+}
+
+func encodeChatEventForumTopicEdited(encoder *encoder, value *ChatEventForumTopicEdited) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatEventForumTopicEdited *ChatEventForumTopicEdited) encode(encoder *encoder) {
+	encoder.meta(TypeChatEventForumTopicEdited, chatEventForumTopicEdited.meta)
+	encoder.name("old_topic_info")
+	encodeForumTopicInfo(encoder, chatEventForumTopicEdited.OldTopicInfo)
+	encoder.name("new_topic_info")
+	encodeForumTopicInfo(encoder, chatEventForumTopicEdited.NewTopicInfo)
+}
+
+func encodeChatEventForumTopicToggleIsClosed(encoder *encoder, value *ChatEventForumTopicToggleIsClosed) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatEventForumTopicToggleIsClosed *ChatEventForumTopicToggleIsClosed) encode(encoder *encoder) {
+	encoder.meta(TypeChatEventForumTopicToggleIsClosed, chatEventForumTopicToggleIsClosed.meta)
+	encoder.name("topic_info")
+	encodeForumTopicInfo(encoder, chatEventForumTopicToggleIsClosed.TopicInfo)
+}
+
+func encodeChatEventForumTopicToggleIsHidden(encoder *encoder, value *ChatEventForumTopicToggleIsHidden) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatEventForumTopicToggleIsHidden *ChatEventForumTopicToggleIsHidden) encode(encoder *encoder) {
+	encoder.meta(TypeChatEventForumTopicToggleIsHidden, chatEventForumTopicToggleIsHidden.meta)
+	encoder.name("topic_info")
+	encodeForumTopicInfo(encoder, chatEventForumTopicToggleIsHidden.TopicInfo)
+}
+
+func encodeChatEventForumTopicDeleted(encoder *encoder, value *ChatEventForumTopicDeleted) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatEventForumTopicDeleted *ChatEventForumTopicDeleted) encode(encoder *encoder) {
+	encoder.meta(TypeChatEventForumTopicDeleted, chatEventForumTopicDeleted.meta)
+	encoder.name("topic_info")
+	encodeForumTopicInfo(encoder, chatEventForumTopicDeleted.TopicInfo)
+}
+
+func encodeChatEventForumTopicPinned(encoder *encoder, value *ChatEventForumTopicPinned) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatEventForumTopicPinned *ChatEventForumTopicPinned) encode(encoder *encoder) {
+	encoder.meta(TypeChatEventForumTopicPinned, chatEventForumTopicPinned.meta)
+	encoder.name("old_topic_info")
+	encodeForumTopicInfo(encoder, chatEventForumTopicPinned.OldTopicInfo)
+	encoder.name("new_topic_info")
+	encodeForumTopicInfo(encoder, chatEventForumTopicPinned.NewTopicInfo)
+}
+
+func encodeChatEvent(encoder *encoder, value *ChatEvent) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatEvent *ChatEvent) encode(encoder *encoder) {
+	encoder.meta(TypeChatEvent, chatEvent.meta)
+	encoder.JsonInt64("id", chatEvent.Id)
+	encoder.Int32("date", chatEvent.Date)
+	encoder.name("member_id")
+	encodeMessageSender(encoder, chatEvent.MemberId)
+	encoder.name("action")
+	encodeChatEventAction(encoder, chatEvent.Action)
+}
+
+func encodeChatEvents(encoder *encoder, value *ChatEvents) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatEvents *ChatEvents) encode(encoder *encoder) {
+	encoder.meta(TypeChatEvents, chatEvents.meta)
+	encoder.name("events")
+	encodeListOfChatEvent(encoder, chatEvents.Events)
+}
+
+func encodeChatEventLogFilters(encoder *encoder, value *ChatEventLogFilters) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatEventLogFilters *ChatEventLogFilters) encode(encoder *encoder) {
+	encoder.meta(TypeChatEventLogFilters, chatEventLogFilters.meta)
+	encoder.Bool("message_edits", chatEventLogFilters.MessageEdits)
+	encoder.Bool("message_deletions", chatEventLogFilters.MessageDeletions)
+	encoder.Bool("message_pins", chatEventLogFilters.MessagePins)
+	encoder.Bool("member_joins", chatEventLogFilters.MemberJoins)
+	encoder.Bool("member_leaves", chatEventLogFilters.MemberLeaves)
+	encoder.Bool("member_invites", chatEventLogFilters.MemberInvites)
+	encoder.Bool("member_promotions", chatEventLogFilters.MemberPromotions)
+	encoder.Bool("member_restrictions", chatEventLogFilters.MemberRestrictions)
+	encoder.Bool("info_changes", chatEventLogFilters.InfoChanges)
+	encoder.Bool("setting_changes", chatEventLogFilters.SettingChanges)
+	encoder.Bool("invite_link_changes", chatEventLogFilters.InviteLinkChanges)
+	encoder.Bool("video_chat_changes", chatEventLogFilters.VideoChatChanges)
+	encoder.Bool("forum_changes", chatEventLogFilters.ForumChanges)
+}
+
+func encodeLanguagePackStringValueOrdinary(encoder *encoder, value *LanguagePackStringValueOrdinary) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (languagePackStringValueOrdinary *LanguagePackStringValueOrdinary) encode(encoder *encoder) {
+	encoder.meta(TypeLanguagePackStringValueOrdinary, languagePackStringValueOrdinary.meta)
+	encoder.String("value", languagePackStringValueOrdinary.Value)
+}
+
+func encodeLanguagePackStringValuePluralized(encoder *encoder, value *LanguagePackStringValuePluralized) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (languagePackStringValuePluralized *LanguagePackStringValuePluralized) encode(encoder *encoder) {
+	encoder.meta(TypeLanguagePackStringValuePluralized, languagePackStringValuePluralized.meta)
+	encoder.String("zero_value", languagePackStringValuePluralized.ZeroValue)
+	encoder.String("one_value", languagePackStringValuePluralized.OneValue)
+	encoder.String("two_value", languagePackStringValuePluralized.TwoValue)
+	encoder.String("few_value", languagePackStringValuePluralized.FewValue)
+	encoder.String("many_value", languagePackStringValuePluralized.ManyValue)
+	encoder.String("other_value", languagePackStringValuePluralized.OtherValue)
+}
+
+func encodeLanguagePackStringValueDeleted(encoder *encoder, value *LanguagePackStringValueDeleted) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (languagePackStringValueDeleted *LanguagePackStringValueDeleted) encode(encoder *encoder) {
+	encoder.meta(TypeLanguagePackStringValueDeleted, languagePackStringValueDeleted.meta)
+}
+
+func encodeLanguagePackString(encoder *encoder, value *LanguagePackString) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (languagePackString *LanguagePackString) encode(encoder *encoder) {
+	encoder.meta(TypeLanguagePackString, languagePackString.meta)
+	encoder.String("key", languagePackString.Key)
+	encoder.name("value")
+	encodeLanguagePackStringValue(encoder, languagePackString.Value)
+}
+
+func encodeLanguagePackStrings(encoder *encoder, value *LanguagePackStrings) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (languagePackStrings *LanguagePackStrings) encode(encoder *encoder) {
+	encoder.meta(TypeLanguagePackStrings, languagePackStrings.meta)
+	encoder.name("strings")
+	encodeListOfLanguagePackString(encoder, languagePackStrings.Strings)
+}
+
+func encodeLanguagePackInfo(encoder *encoder, value *LanguagePackInfo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (languagePackInfo *LanguagePackInfo) encode(encoder *encoder) {
+	encoder.meta(TypeLanguagePackInfo, languagePackInfo.meta)
+	encoder.String("id", languagePackInfo.Id)
+	encoder.String("base_language_pack_id", languagePackInfo.BaseLanguagePackId)
+	encoder.String("name", languagePackInfo.Name)
+	encoder.String("native_name", languagePackInfo.NativeName)
+	encoder.String("plural_code", languagePackInfo.PluralCode)
+	encoder.Bool("is_official", languagePackInfo.IsOfficial)
+	encoder.Bool("is_rtl", languagePackInfo.IsRtl)
+	encoder.Bool("is_beta", languagePackInfo.IsBeta)
+	encoder.Bool("is_installed", languagePackInfo.IsInstalled)
+	encoder.Int32("total_string_count", languagePackInfo.TotalStringCount)
+	encoder.Int32("translated_string_count", languagePackInfo.TranslatedStringCount)
+	encoder.Int32("local_string_count", languagePackInfo.LocalStringCount)
+	encoder.String("translation_url", languagePackInfo.TranslationUrl)
+}
+
+func encodeLocalizationTargetInfo(encoder *encoder, value *LocalizationTargetInfo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (localizationTargetInfo *LocalizationTargetInfo) encode(encoder *encoder) {
+	encoder.meta(TypeLocalizationTargetInfo, localizationTargetInfo.meta)
+	encoder.name("language_packs")
+	encodeListOfLanguagePackInfo(encoder, localizationTargetInfo.LanguagePacks)
+}
+
+func encodePremiumLimitTypeSupergroupCount(encoder *encoder, value *PremiumLimitTypeSupergroupCount) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (premiumLimitTypeSupergroupCount *PremiumLimitTypeSupergroupCount) encode(encoder *encoder) {
+	encoder.meta(TypePremiumLimitTypeSupergroupCount, premiumLimitTypeSupergroupCount.meta)
+}
+
+func encodePremiumLimitTypePinnedChatCount(encoder *encoder, value *PremiumLimitTypePinnedChatCount) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (premiumLimitTypePinnedChatCount *PremiumLimitTypePinnedChatCount) encode(encoder *encoder) {
+	encoder.meta(TypePremiumLimitTypePinnedChatCount, premiumLimitTypePinnedChatCount.meta)
+}
+
+func encodePremiumLimitTypeCreatedPublicChatCount(encoder *encoder, value *PremiumLimitTypeCreatedPublicChatCount) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (premiumLimitTypeCreatedPublicChatCount *PremiumLimitTypeCreatedPublicChatCount) encode(encoder *encoder) {
+	encoder.meta(TypePremiumLimitTypeCreatedPublicChatCount, premiumLimitTypeCreatedPublicChatCount.meta)
+}
+
+func encodePremiumLimitTypeSavedAnimationCount(encoder *encoder, value *PremiumLimitTypeSavedAnimationCount) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (premiumLimitTypeSavedAnimationCount *PremiumLimitTypeSavedAnimationCount) encode(encoder *encoder) {
+	encoder.meta(TypePremiumLimitTypeSavedAnimationCount, premiumLimitTypeSavedAnimationCount.meta)
+}
+
+func encodePremiumLimitTypeFavoriteStickerCount(encoder *encoder, value *PremiumLimitTypeFavoriteStickerCount) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (premiumLimitTypeFavoriteStickerCount *PremiumLimitTypeFavoriteStickerCount) encode(encoder *encoder) {
+	encoder.meta(TypePremiumLimitTypeFavoriteStickerCount, premiumLimitTypeFavoriteStickerCount.meta)
+}
+
+func encodePremiumLimitTypeChatFolderCount(encoder *encoder, value *PremiumLimitTypeChatFolderCount) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (premiumLimitTypeChatFolderCount *PremiumLimitTypeChatFolderCount) encode(encoder *encoder) {
+	encoder.meta(TypePremiumLimitTypeChatFolderCount, premiumLimitTypeChatFolderCount.meta)
+}
+
+func encodePremiumLimitTypeChatFolderChosenChatCount(encoder *encoder, value *PremiumLimitTypeChatFolderChosenChatCount) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (premiumLimitTypeChatFolderChosenChatCount *PremiumLimitTypeChatFolderChosenChatCount) encode(encoder *encoder) {
+	encoder.meta(TypePremiumLimitTypeChatFolderChosenChatCount, premiumLimitTypeChatFolderChosenChatCount.meta)
+}
+
+func encodePremiumLimitTypePinnedArchivedChatCount(encoder *encoder, value *PremiumLimitTypePinnedArchivedChatCount) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (premiumLimitTypePinnedArchivedChatCount *PremiumLimitTypePinnedArchivedChatCount) encode(encoder *encoder) {
+	encoder.meta(TypePremiumLimitTypePinnedArchivedChatCount, premiumLimitTypePinnedArchivedChatCount.meta)
+}
+
+func encodePremiumLimitTypeCaptionLength(encoder *encoder, value *PremiumLimitTypeCaptionLength) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (premiumLimitTypeCaptionLength *PremiumLimitTypeCaptionLength) encode(encoder *encoder) {
+	encoder.meta(TypePremiumLimitTypeCaptionLength, premiumLimitTypeCaptionLength.meta)
+}
+
+func encodePremiumLimitTypeBioLength(encoder *encoder, value *PremiumLimitTypeBioLength) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (premiumLimitTypeBioLength *PremiumLimitTypeBioLength) encode(encoder *encoder) {
+	encoder.meta(TypePremiumLimitTypeBioLength, premiumLimitTypeBioLength.meta)
+}
+
+func encodePremiumLimitTypeChatFolderInviteLinkCount(encoder *encoder, value *PremiumLimitTypeChatFolderInviteLinkCount) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (premiumLimitTypeChatFolderInviteLinkCount *PremiumLimitTypeChatFolderInviteLinkCount) encode(encoder *encoder) {
+	encoder.meta(TypePremiumLimitTypeChatFolderInviteLinkCount, premiumLimitTypeChatFolderInviteLinkCount.meta)
+}
+
+func encodePremiumLimitTypeShareableChatFolderCount(encoder *encoder, value *PremiumLimitTypeShareableChatFolderCount) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (premiumLimitTypeShareableChatFolderCount *PremiumLimitTypeShareableChatFolderCount) encode(encoder *encoder) {
+	encoder.meta(TypePremiumLimitTypeShareableChatFolderCount, premiumLimitTypeShareableChatFolderCount.meta)
+}
+
+func encodePremiumFeatureIncreasedLimits(encoder *encoder, value *PremiumFeatureIncreasedLimits) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (premiumFeatureIncreasedLimits *PremiumFeatureIncreasedLimits) encode(encoder *encoder) {
+	encoder.meta(TypePremiumFeatureIncreasedLimits, premiumFeatureIncreasedLimits.meta)
+}
+
+func encodePremiumFeatureIncreasedUploadFileSize(encoder *encoder, value *PremiumFeatureIncreasedUploadFileSize) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (premiumFeatureIncreasedUploadFileSize *PremiumFeatureIncreasedUploadFileSize) encode(encoder *encoder) {
+	encoder.meta(TypePremiumFeatureIncreasedUploadFileSize, premiumFeatureIncreasedUploadFileSize.meta)
+}
+
+func encodePremiumFeatureImprovedDownloadSpeed(encoder *encoder, value *PremiumFeatureImprovedDownloadSpeed) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (premiumFeatureImprovedDownloadSpeed *PremiumFeatureImprovedDownloadSpeed) encode(encoder *encoder) {
+	encoder.meta(TypePremiumFeatureImprovedDownloadSpeed, premiumFeatureImprovedDownloadSpeed.meta)
+}
+
+func encodePremiumFeatureVoiceRecognition(encoder *encoder, value *PremiumFeatureVoiceRecognition) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (premiumFeatureVoiceRecognition *PremiumFeatureVoiceRecognition) encode(encoder *encoder) {
+	encoder.meta(TypePremiumFeatureVoiceRecognition, premiumFeatureVoiceRecognition.meta)
+}
+
+func encodePremiumFeatureDisabledAds(encoder *encoder, value *PremiumFeatureDisabledAds) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (premiumFeatureDisabledAds *PremiumFeatureDisabledAds) encode(encoder *encoder) {
+	encoder.meta(TypePremiumFeatureDisabledAds, premiumFeatureDisabledAds.meta)
+}
+
+func encodePremiumFeatureUniqueReactions(encoder *encoder, value *PremiumFeatureUniqueReactions) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (premiumFeatureUniqueReactions *PremiumFeatureUniqueReactions) encode(encoder *encoder) {
+	encoder.meta(TypePremiumFeatureUniqueReactions, premiumFeatureUniqueReactions.meta)
+}
+
+func encodePremiumFeatureUniqueStickers(encoder *encoder, value *PremiumFeatureUniqueStickers) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (premiumFeatureUniqueStickers *PremiumFeatureUniqueStickers) encode(encoder *encoder) {
+	encoder.meta(TypePremiumFeatureUniqueStickers, premiumFeatureUniqueStickers.meta)
+}
+
+func encodePremiumFeatureCustomEmoji(encoder *encoder, value *PremiumFeatureCustomEmoji) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (premiumFeatureCustomEmoji *PremiumFeatureCustomEmoji) encode(encoder *encoder) {
+	encoder.meta(TypePremiumFeatureCustomEmoji, premiumFeatureCustomEmoji.meta)
+}
+
+func encodePremiumFeatureAdvancedChatManagement(encoder *encoder, value *PremiumFeatureAdvancedChatManagement) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (premiumFeatureAdvancedChatManagement *PremiumFeatureAdvancedChatManagement) encode(encoder *encoder) {
+	encoder.meta(TypePremiumFeatureAdvancedChatManagement, premiumFeatureAdvancedChatManagement.meta)
+}
+
+func encodePremiumFeatureProfileBadge(encoder *encoder, value *PremiumFeatureProfileBadge) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (premiumFeatureProfileBadge *PremiumFeatureProfileBadge) encode(encoder *encoder) {
+	encoder.meta(TypePremiumFeatureProfileBadge, premiumFeatureProfileBadge.meta)
+}
+
+func encodePremiumFeatureEmojiStatus(encoder *encoder, value *PremiumFeatureEmojiStatus) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (premiumFeatureEmojiStatus *PremiumFeatureEmojiStatus) encode(encoder *encoder) {
+	encoder.meta(TypePremiumFeatureEmojiStatus, premiumFeatureEmojiStatus.meta)
+}
+
+func encodePremiumFeatureAnimatedProfilePhoto(encoder *encoder, value *PremiumFeatureAnimatedProfilePhoto) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (premiumFeatureAnimatedProfilePhoto *PremiumFeatureAnimatedProfilePhoto) encode(encoder *encoder) {
+	encoder.meta(TypePremiumFeatureAnimatedProfilePhoto, premiumFeatureAnimatedProfilePhoto.meta)
+}
+
+func encodePremiumFeatureForumTopicIcon(encoder *encoder, value *PremiumFeatureForumTopicIcon) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (premiumFeatureForumTopicIcon *PremiumFeatureForumTopicIcon) encode(encoder *encoder) {
+	encoder.meta(TypePremiumFeatureForumTopicIcon, premiumFeatureForumTopicIcon.meta)
+}
+
+func encodePremiumFeatureAppIcons(encoder *encoder, value *PremiumFeatureAppIcons) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (premiumFeatureAppIcons *PremiumFeatureAppIcons) encode(encoder *encoder) {
+	encoder.meta(TypePremiumFeatureAppIcons, premiumFeatureAppIcons.meta)
+}
+
+func encodePremiumFeatureRealTimeChatTranslation(encoder *encoder, value *PremiumFeatureRealTimeChatTranslation) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (premiumFeatureRealTimeChatTranslation *PremiumFeatureRealTimeChatTranslation) encode(encoder *encoder) {
+	encoder.meta(TypePremiumFeatureRealTimeChatTranslation, premiumFeatureRealTimeChatTranslation.meta)
+}
+
+func encodePremiumLimit(encoder *encoder, value *PremiumLimit) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (premiumLimit *PremiumLimit) encode(encoder *encoder) {
+	encoder.meta(TypePremiumLimit, premiumLimit.meta)
+	encoder.name("type")
+	encodePremiumLimitType(encoder, premiumLimit.Type)
+	encoder.Int32("default_value", premiumLimit.DefaultValue)
+	encoder.Int32("premium_value", premiumLimit.PremiumValue)
+}
+
+func encodePremiumFeatures(encoder *encoder, value *PremiumFeatures) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (premiumFeatures *PremiumFeatures) encode(encoder *encoder) {
+	encoder.meta(TypePremiumFeatures, premiumFeatures.meta)
+	encoder.name("features")
+	encodeListOfPremiumFeature(encoder, premiumFeatures.Features)
+	encoder.name("limits")
+	encodeListOfPremiumLimit(encoder, premiumFeatures.Limits)
+	encoder.name("payment_link")
+	encodeInternalLinkType(encoder, premiumFeatures.PaymentLink)
+}
+
+func encodePremiumSourceLimitExceeded(encoder *encoder, value *PremiumSourceLimitExceeded) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (premiumSourceLimitExceeded *PremiumSourceLimitExceeded) encode(encoder *encoder) {
+	encoder.meta(TypePremiumSourceLimitExceeded, premiumSourceLimitExceeded.meta)
+	encoder.name("limit_type")
+	encodePremiumLimitType(encoder, premiumSourceLimitExceeded.LimitType)
+}
+
+func encodePremiumSourceFeature(encoder *encoder, value *PremiumSourceFeature) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (premiumSourceFeature *PremiumSourceFeature) encode(encoder *encoder) {
+	encoder.meta(TypePremiumSourceFeature, premiumSourceFeature.meta)
+	encoder.name("feature")
+	encodePremiumFeature(encoder, premiumSourceFeature.Feature)
+}
+
+func encodePremiumSourceLink(encoder *encoder, value *PremiumSourceLink) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (premiumSourceLink *PremiumSourceLink) encode(encoder *encoder) {
+	encoder.meta(TypePremiumSourceLink, premiumSourceLink.meta)
+	encoder.String("referrer", premiumSourceLink.Referrer)
+}
+
+func encodePremiumSourceSettings(encoder *encoder, value *PremiumSourceSettings) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (premiumSourceSettings *PremiumSourceSettings) encode(encoder *encoder) {
+	encoder.meta(TypePremiumSourceSettings, premiumSourceSettings.meta)
+}
+
+func encodePremiumFeaturePromotionAnimation(encoder *encoder, value *PremiumFeaturePromotionAnimation) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (premiumFeaturePromotionAnimation *PremiumFeaturePromotionAnimation) encode(encoder *encoder) {
+	encoder.meta(TypePremiumFeaturePromotionAnimation, premiumFeaturePromotionAnimation.meta)
+	encoder.name("feature")
+	encodePremiumFeature(encoder, premiumFeaturePromotionAnimation.Feature)
+	encoder.name("animation")
+	encodeAnimation(encoder, premiumFeaturePromotionAnimation.Animation)
+}
+
+func encodePremiumState(encoder *encoder, value *PremiumState) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (premiumState *PremiumState) encode(encoder *encoder) {
+	encoder.meta(TypePremiumState, premiumState.meta)
+	encoder.name("state")
+	encodeFormattedText(encoder, premiumState.State)
+	encoder.name("payment_options")
+	encodeListOfPremiumStatePaymentOption(encoder, premiumState.PaymentOptions)
+	encoder.name("animations")
+	encodeListOfPremiumFeaturePromotionAnimation(encoder, premiumState.Animations)
+}
+
+func encodeStorePaymentPurposePremiumSubscription(encoder *encoder, value *StorePaymentPurposePremiumSubscription) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (storePaymentPurposePremiumSubscription *StorePaymentPurposePremiumSubscription) encode(encoder *encoder) {
+	encoder.meta(TypeStorePaymentPurposePremiumSubscription, storePaymentPurposePremiumSubscription.meta)
+	encoder.Bool("is_restore", storePaymentPurposePremiumSubscription.IsRestore)
+	encoder.Bool("is_upgrade", storePaymentPurposePremiumSubscription.IsUpgrade)
+}
+
+func encodeStorePaymentPurposeGiftedPremium(encoder *encoder, value *StorePaymentPurposeGiftedPremium) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (storePaymentPurposeGiftedPremium *StorePaymentPurposeGiftedPremium) encode(encoder *encoder) {
+	encoder.meta(TypeStorePaymentPurposeGiftedPremium, storePaymentPurposeGiftedPremium.meta)
+	encoder.Int64("user_id", storePaymentPurposeGiftedPremium.UserId)
+	encoder.String("currency", storePaymentPurposeGiftedPremium.Currency)
+	encoder.Int64("amount", storePaymentPurposeGiftedPremium.Amount)
+}
+
+func encodeDeviceTokenFirebaseCloudMessaging(encoder *encoder, value *DeviceTokenFirebaseCloudMessaging) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (deviceTokenFirebaseCloudMessaging *DeviceTokenFirebaseCloudMessaging) encode(encoder *encoder) {
+	encoder.meta(TypeDeviceTokenFirebaseCloudMessaging, deviceTokenFirebaseCloudMessaging.meta)
+	encoder.String("token", deviceTokenFirebaseCloudMessaging.Token)
+	encoder.Bool("encrypt", deviceTokenFirebaseCloudMessaging.Encrypt)
+}
+
+func encodeDeviceTokenApplePush(encoder *encoder, value *DeviceTokenApplePush) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (deviceTokenApplePush *DeviceTokenApplePush) encode(encoder *encoder) {
+	encoder.meta(TypeDeviceTokenApplePush, deviceTokenApplePush.meta)
+	encoder.String("device_token", deviceTokenApplePush.DeviceToken)
+	encoder.Bool("is_app_sandbox", deviceTokenApplePush.IsAppSandbox)
+}
+
+func encodeDeviceTokenApplePushVoIP(encoder *encoder, value *DeviceTokenApplePushVoIP) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (deviceTokenApplePushVoIP *DeviceTokenApplePushVoIP) encode(encoder *encoder) {
+	encoder.meta(TypeDeviceTokenApplePushVoIP, deviceTokenApplePushVoIP.meta)
+	encoder.String("device_token", deviceTokenApplePushVoIP.DeviceToken)
+	encoder.Bool("is_app_sandbox", deviceTokenApplePushVoIP.IsAppSandbox)
+	encoder.Bool("encrypt", deviceTokenApplePushVoIP.Encrypt)
+}
+
+func encodeDeviceTokenWindowsPush(encoder *encoder, value *DeviceTokenWindowsPush) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (deviceTokenWindowsPush *DeviceTokenWindowsPush) encode(encoder *encoder) {
+	encoder.meta(TypeDeviceTokenWindowsPush, deviceTokenWindowsPush.meta)
+	encoder.String("access_token", deviceTokenWindowsPush.AccessToken)
+}
+
+func encodeDeviceTokenMicrosoftPush(encoder *encoder, value *DeviceTokenMicrosoftPush) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (deviceTokenMicrosoftPush *DeviceTokenMicrosoftPush) encode(encoder *encoder) {
+	encoder.meta(TypeDeviceTokenMicrosoftPush, deviceTokenMicrosoftPush.meta)
+	encoder.String("channel_uri", deviceTokenMicrosoftPush.ChannelUri)
+}
+
+func encodeDeviceTokenMicrosoftPushVoIP(encoder *encoder, value *DeviceTokenMicrosoftPushVoIP) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (deviceTokenMicrosoftPushVoIP *DeviceTokenMicrosoftPushVoIP) encode(encoder *encoder) {
+	encoder.meta(TypeDeviceTokenMicrosoftPushVoIP, deviceTokenMicrosoftPushVoIP.meta)
+	encoder.String("channel_uri", deviceTokenMicrosoftPushVoIP.ChannelUri)
+}
+
+func encodeDeviceTokenWebPush(encoder *encoder, value *DeviceTokenWebPush) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (deviceTokenWebPush *DeviceTokenWebPush) encode(encoder *encoder) {
+	encoder.meta(TypeDeviceTokenWebPush, deviceTokenWebPush.meta)
+	encoder.String("endpoint", deviceTokenWebPush.Endpoint)
+	encoder.String("p256dh_base64url", deviceTokenWebPush.P256dhBase64url)
+	encoder.String("auth_base64url", deviceTokenWebPush.AuthBase64url)
+}
+
+func encodeDeviceTokenSimplePush(encoder *encoder, value *DeviceTokenSimplePush) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (deviceTokenSimplePush *DeviceTokenSimplePush) encode(encoder *encoder) {
+	encoder.meta(TypeDeviceTokenSimplePush, deviceTokenSimplePush.meta)
+	encoder.String("endpoint", deviceTokenSimplePush.Endpoint)
+}
+
+func encodeDeviceTokenUbuntuPush(encoder *encoder, value *DeviceTokenUbuntuPush) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (deviceTokenUbuntuPush *DeviceTokenUbuntuPush) encode(encoder *encoder) {
+	encoder.meta(TypeDeviceTokenUbuntuPush, deviceTokenUbuntuPush.meta)
+	encoder.String("token", deviceTokenUbuntuPush.Token)
+}
+
+func encodeDeviceTokenBlackBerryPush(encoder *encoder, value *DeviceTokenBlackBerryPush) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (deviceTokenBlackBerryPush *DeviceTokenBlackBerryPush) encode(encoder *encoder) {
+	encoder.meta(TypeDeviceTokenBlackBerryPush, deviceTokenBlackBerryPush.meta)
+	encoder.String("token", deviceTokenBlackBerryPush.Token)
+}
+
+func encodeDeviceTokenTizenPush(encoder *encoder, value *DeviceTokenTizenPush) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (deviceTokenTizenPush *DeviceTokenTizenPush) encode(encoder *encoder) {
+	encoder.meta(TypeDeviceTokenTizenPush, deviceTokenTizenPush.meta)
+	encoder.String("reg_id", deviceTokenTizenPush.RegId)
+}
+
+func encodeDeviceTokenHuaweiPush(encoder *encoder, value *DeviceTokenHuaweiPush) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (deviceTokenHuaweiPush *DeviceTokenHuaweiPush) encode(encoder *encoder) {
+	encoder.meta(TypeDeviceTokenHuaweiPush, deviceTokenHuaweiPush.meta)
+	encoder.String("token", deviceTokenHuaweiPush.Token)
+	encoder.Bool("encrypt", deviceTokenHuaweiPush.Encrypt)
+}
+
+func encodePushReceiverId(encoder *encoder, value *PushReceiverId) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pushReceiverId *PushReceiverId) encode(encoder *encoder) {
+	encoder.meta(TypePushReceiverId, pushReceiverId.meta)
+	encoder.JsonInt64("id", pushReceiverId.Id)
+}
+
+func encodeBackgroundFillSolid(encoder *encoder, value *BackgroundFillSolid) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (backgroundFillSolid *BackgroundFillSolid) encode(encoder *encoder) {
+	encoder.meta(TypeBackgroundFillSolid, backgroundFillSolid.meta)
+	encoder.Int32("color", backgroundFillSolid.Color)
+}
+
+func encodeBackgroundFillGradient(encoder *encoder, value *BackgroundFillGradient) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (backgroundFillGradient *BackgroundFillGradient) encode(encoder *encoder) {
+	encoder.meta(TypeBackgroundFillGradient, backgroundFillGradient.meta)
+	encoder.Int32("top_color", backgroundFillGradient.TopColor)
+	encoder.Int32("bottom_color", backgroundFillGradient.BottomColor)
+	encoder.Int32("rotation_angle", backgroundFillGradient.RotationAngle)
+}
+
+func encodeBackgroundFillFreeformGradient(encoder *encoder, value *BackgroundFillFreeformGradient) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (backgroundFillFreeformGradient *BackgroundFillFreeformGradient) encode(encoder *encoder) {
+	encoder.meta(TypeBackgroundFillFreeformGradient, backgroundFillFreeformGradient.meta)
+	encoder.Int32s("colors", backgroundFillFreeformGradient.Colors)
+}
+
+func encodeBackgroundTypeWallpaper(encoder *encoder, value *BackgroundTypeWallpaper) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (backgroundTypeWallpaper *BackgroundTypeWallpaper) encode(encoder *encoder) {
+	encoder.meta(TypeBackgroundTypeWallpaper, backgroundTypeWallpaper.meta)
+	encoder.Bool("is_blurred", backgroundTypeWallpaper.IsBlurred)
+	encoder.Bool("is_moving", backgroundTypeWallpaper.IsMoving)
+}
+
+func encodeBackgroundTypePattern(encoder *encoder, value *BackgroundTypePattern) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (backgroundTypePattern *BackgroundTypePattern) encode(encoder *encoder) {
+	encoder.meta(TypeBackgroundTypePattern, backgroundTypePattern.meta)
+	encoder.name("fill")
+	encodeBackgroundFill(encoder, backgroundTypePattern.Fill)
+	encoder.Int32("intensity", backgroundTypePattern.Intensity)
+	encoder.Bool("is_inverted", backgroundTypePattern.IsInverted)
+	encoder.Bool("is_moving", backgroundTypePattern.IsMoving)
+}
+
+func encodeBackgroundTypeFill(encoder *encoder, value *BackgroundTypeFill) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (backgroundTypeFill *BackgroundTypeFill) encode(encoder *encoder) {
+	encoder.meta(TypeBackgroundTypeFill, backgroundTypeFill.meta)
+	encoder.name("fill")
+	encodeBackgroundFill(encoder, backgroundTypeFill.Fill)
+}
+
+func encodeInputBackgroundLocal(encoder *encoder, value *InputBackgroundLocal) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputBackgroundLocal *InputBackgroundLocal) encode(encoder *encoder) {
+	encoder.meta(TypeInputBackgroundLocal, inputBackgroundLocal.meta)
+	encoder.name("background")
+	encodeInputFile(encoder, inputBackgroundLocal.Background)
+}
+
+func encodeInputBackgroundRemote(encoder *encoder, value *InputBackgroundRemote) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputBackgroundRemote *InputBackgroundRemote) encode(encoder *encoder) {
+	encoder.meta(TypeInputBackgroundRemote, inputBackgroundRemote.meta)
+	encoder.JsonInt64("background_id", inputBackgroundRemote.BackgroundId)
+}
+
+func encodeInputBackgroundPrevious(encoder *encoder, value *InputBackgroundPrevious) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputBackgroundPrevious *InputBackgroundPrevious) encode(encoder *encoder) {
+	encoder.meta(TypeInputBackgroundPrevious, inputBackgroundPrevious.meta)
+	encoder.Int64("message_id", inputBackgroundPrevious.MessageId)
+}
+
+func encodeThemeSettings(encoder *encoder, value *ThemeSettings) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (themeSettings *ThemeSettings) encode(encoder *encoder) {
+	encoder.meta(TypeThemeSettings, themeSettings.meta)
+	encoder.Int32("accent_color", themeSettings.AccentColor)
+	encoder.name("background")
+	encodeBackground(encoder, themeSettings.Background)
+	encoder.name("outgoing_message_fill")
+	encodeBackgroundFill(encoder, themeSettings.OutgoingMessageFill)
+	encoder.Bool("animate_outgoing_message_fill", themeSettings.AnimateOutgoingMessageFill)
+	encoder.Int32("outgoing_message_accent_color", themeSettings.OutgoingMessageAccentColor)
+}
+
+func encodeChatTheme(encoder *encoder, value *ChatTheme) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatTheme *ChatTheme) encode(encoder *encoder) {
+	encoder.meta(TypeChatTheme, chatTheme.meta)
+	encoder.String("name", chatTheme.Name)
+	encoder.name("light_settings")
+	encodeThemeSettings(encoder, chatTheme.LightSettings)
+	encoder.name("dark_settings")
+	encodeThemeSettings(encoder, chatTheme.DarkSettings)
+}
+
+func encodeHashtags(encoder *encoder, value *Hashtags) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (hashtags *Hashtags) encode(encoder *encoder) {
+	encoder.meta(TypeHashtags, hashtags.meta)
+	encoder.Strings("hashtags", hashtags.Hashtags)
+}
+
+func encodeCanTransferOwnershipResultOk(encoder *encoder, value *CanTransferOwnershipResultOk) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (canTransferOwnershipResultOk *CanTransferOwnershipResultOk) encode(encoder *encoder) {
+	encoder.meta(TypeCanTransferOwnershipResultOk, canTransferOwnershipResultOk.meta)
+}
+
+func encodeCanTransferOwnershipResultPasswordNeeded(encoder *encoder, value *CanTransferOwnershipResultPasswordNeeded) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (canTransferOwnershipResultPasswordNeeded *CanTransferOwnershipResultPasswordNeeded) encode(encoder *encoder) {
+	encoder.meta(TypeCanTransferOwnershipResultPasswordNeeded, canTransferOwnershipResultPasswordNeeded.meta)
+}
+
+func encodeCanTransferOwnershipResultPasswordTooFresh(encoder *encoder, value *CanTransferOwnershipResultPasswordTooFresh) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (canTransferOwnershipResultPasswordTooFresh *CanTransferOwnershipResultPasswordTooFresh) encode(encoder *encoder) {
+	encoder.meta(TypeCanTransferOwnershipResultPasswordTooFresh, canTransferOwnershipResultPasswordTooFresh.meta)
+	encoder.Int32("retry_after", canTransferOwnershipResultPasswordTooFresh.RetryAfter)
+}
+
+func encodeCanTransferOwnershipResultSessionTooFresh(encoder *encoder, value *CanTransferOwnershipResultSessionTooFresh) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (canTransferOwnershipResultSessionTooFresh *CanTransferOwnershipResultSessionTooFresh) encode(encoder *encoder) {
+	encoder.meta(TypeCanTransferOwnershipResultSessionTooFresh, canTransferOwnershipResultSessionTooFresh.meta)
+	encoder.Int32("retry_after", canTransferOwnershipResultSessionTooFresh.RetryAfter)
+}
+
+func encodeCheckChatUsernameResultOk(encoder *encoder, value *CheckChatUsernameResultOk) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (checkChatUsernameResultOk *CheckChatUsernameResultOk) encode(encoder *encoder) {
+	encoder.meta(TypeCheckChatUsernameResultOk, checkChatUsernameResultOk.meta)
+}
+
+func encodeCheckChatUsernameResultUsernameInvalid(encoder *encoder, value *CheckChatUsernameResultUsernameInvalid) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (checkChatUsernameResultUsernameInvalid *CheckChatUsernameResultUsernameInvalid) encode(encoder *encoder) {
+	encoder.meta(TypeCheckChatUsernameResultUsernameInvalid, checkChatUsernameResultUsernameInvalid.meta)
+}
+
+func encodeCheckChatUsernameResultUsernameOccupied(encoder *encoder, value *CheckChatUsernameResultUsernameOccupied) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (checkChatUsernameResultUsernameOccupied *CheckChatUsernameResultUsernameOccupied) encode(encoder *encoder) {
+	encoder.meta(TypeCheckChatUsernameResultUsernameOccupied, checkChatUsernameResultUsernameOccupied.meta)
+}
+
+func encodeCheckChatUsernameResultUsernamePurchasable(encoder *encoder, value *CheckChatUsernameResultUsernamePurchasable) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (checkChatUsernameResultUsernamePurchasable *CheckChatUsernameResultUsernamePurchasable) encode(encoder *encoder) {
+	encoder.meta(TypeCheckChatUsernameResultUsernamePurchasable, checkChatUsernameResultUsernamePurchasable.meta)
+}
+
+func encodeCheckChatUsernameResultPublicChatsTooMany(encoder *encoder, value *CheckChatUsernameResultPublicChatsTooMany) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (checkChatUsernameResultPublicChatsTooMany *CheckChatUsernameResultPublicChatsTooMany) encode(encoder *encoder) {
+	encoder.meta(TypeCheckChatUsernameResultPublicChatsTooMany, checkChatUsernameResultPublicChatsTooMany.meta)
+}
+
+func encodeCheckChatUsernameResultPublicGroupsUnavailable(encoder *encoder, value *CheckChatUsernameResultPublicGroupsUnavailable) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (checkChatUsernameResultPublicGroupsUnavailable *CheckChatUsernameResultPublicGroupsUnavailable) encode(encoder *encoder) {
+	encoder.meta(TypeCheckChatUsernameResultPublicGroupsUnavailable, checkChatUsernameResultPublicGroupsUnavailable.meta)
+}
+
+func encodeCheckStickerSetNameResultOk(encoder *encoder, value *CheckStickerSetNameResultOk) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (checkStickerSetNameResultOk *CheckStickerSetNameResultOk) encode(encoder *encoder) {
+	encoder.meta(TypeCheckStickerSetNameResultOk, checkStickerSetNameResultOk.meta)
+}
+
+func encodeCheckStickerSetNameResultNameInvalid(encoder *encoder, value *CheckStickerSetNameResultNameInvalid) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (checkStickerSetNameResultNameInvalid *CheckStickerSetNameResultNameInvalid) encode(encoder *encoder) {
+	encoder.meta(TypeCheckStickerSetNameResultNameInvalid, checkStickerSetNameResultNameInvalid.meta)
+}
+
+func encodeCheckStickerSetNameResultNameOccupied(encoder *encoder, value *CheckStickerSetNameResultNameOccupied) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (checkStickerSetNameResultNameOccupied *CheckStickerSetNameResultNameOccupied) encode(encoder *encoder) {
+	encoder.meta(TypeCheckStickerSetNameResultNameOccupied, checkStickerSetNameResultNameOccupied.meta)
+}
+
+func encodeResetPasswordResultOk(encoder *encoder, value *ResetPasswordResultOk) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (resetPasswordResultOk *ResetPasswordResultOk) encode(encoder *encoder) {
+	encoder.meta(TypeResetPasswordResultOk, resetPasswordResultOk.meta)
+}
+
+func encodeResetPasswordResultPending(encoder *encoder, value *ResetPasswordResultPending) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (resetPasswordResultPending *ResetPasswordResultPending) encode(encoder *encoder) {
+	encoder.meta(TypeResetPasswordResultPending, resetPasswordResultPending.meta)
+	encoder.Int32("pending_reset_date", resetPasswordResultPending.PendingResetDate)
+}
+
+func encodeResetPasswordResultDeclined(encoder *encoder, value *ResetPasswordResultDeclined) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (resetPasswordResultDeclined *ResetPasswordResultDeclined) encode(encoder *encoder) {
+	encoder.meta(TypeResetPasswordResultDeclined, resetPasswordResultDeclined.meta)
+	encoder.Int32("retry_date", resetPasswordResultDeclined.RetryDate)
+}
+
+func encodeMessageFileTypePrivate(encoder *encoder, value *MessageFileTypePrivate) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageFileTypePrivate *MessageFileTypePrivate) encode(encoder *encoder) {
+	encoder.meta(TypeMessageFileTypePrivate, messageFileTypePrivate.meta)
+	encoder.String("name", messageFileTypePrivate.Name)
+}
+
+func encodeMessageFileTypeGroup(encoder *encoder, value *MessageFileTypeGroup) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageFileTypeGroup *MessageFileTypeGroup) encode(encoder *encoder) {
+	encoder.meta(TypeMessageFileTypeGroup, messageFileTypeGroup.meta)
+	encoder.String("title", messageFileTypeGroup.Title)
+}
+
+func encodeMessageFileTypeUnknown(encoder *encoder, value *MessageFileTypeUnknown) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageFileTypeUnknown *MessageFileTypeUnknown) encode(encoder *encoder) {
+	encoder.meta(TypeMessageFileTypeUnknown, messageFileTypeUnknown.meta)
+}
+
+func encodePushMessageContentHidden(encoder *encoder, value *PushMessageContentHidden) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pushMessageContentHidden *PushMessageContentHidden) encode(encoder *encoder) {
+	encoder.meta(TypePushMessageContentHidden, pushMessageContentHidden.meta)
+	encoder.Bool("is_pinned", pushMessageContentHidden.IsPinned)
+}
+
+func encodePushMessageContentAnimation(encoder *encoder, value *PushMessageContentAnimation) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pushMessageContentAnimation *PushMessageContentAnimation) encode(encoder *encoder) {
+	encoder.meta(TypePushMessageContentAnimation, pushMessageContentAnimation.meta)
+	encoder.name("animation")
+	encodeAnimation(encoder, pushMessageContentAnimation.Animation)
+	encoder.String("caption", pushMessageContentAnimation.Caption)
+	encoder.Bool("is_pinned", pushMessageContentAnimation.IsPinned)
+}
+
+func encodePushMessageContentAudio(encoder *encoder, value *PushMessageContentAudio) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pushMessageContentAudio *PushMessageContentAudio) encode(encoder *encoder) {
+	encoder.meta(TypePushMessageContentAudio, pushMessageContentAudio.meta)
+	encoder.name("audio")
+	encodeAudio(encoder, pushMessageContentAudio.Audio)
+	encoder.Bool("is_pinned", pushMessageContentAudio.IsPinned)
+}
+
+func encodePushMessageContentContact(encoder *encoder, value *PushMessageContentContact) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pushMessageContentContact *PushMessageContentContact) encode(encoder *encoder) {
+	encoder.meta(TypePushMessageContentContact, pushMessageContentContact.meta)
+	encoder.String("name", pushMessageContentContact.Name)
+	encoder.Bool("is_pinned", pushMessageContentContact.IsPinned)
+}
+
+func encodePushMessageContentContactRegistered(encoder *encoder, value *PushMessageContentContactRegistered) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pushMessageContentContactRegistered *PushMessageContentContactRegistered) encode(encoder *encoder) {
+	encoder.meta(TypePushMessageContentContactRegistered, pushMessageContentContactRegistered.meta)
+}
+
+func encodePushMessageContentDocument(encoder *encoder, value *PushMessageContentDocument) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pushMessageContentDocument *PushMessageContentDocument) encode(encoder *encoder) {
+	encoder.meta(TypePushMessageContentDocument, pushMessageContentDocument.meta)
+	encoder.name("document")
+	encodeDocument(encoder, pushMessageContentDocument.Document)
+	encoder.Bool("is_pinned", pushMessageContentDocument.IsPinned)
+}
+
+func encodePushMessageContentGame(encoder *encoder, value *PushMessageContentGame) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pushMessageContentGame *PushMessageContentGame) encode(encoder *encoder) {
+	encoder.meta(TypePushMessageContentGame, pushMessageContentGame.meta)
+	encoder.String("title", pushMessageContentGame.Title)
+	encoder.Bool("is_pinned", pushMessageContentGame.IsPinned)
+}
+
+func encodePushMessageContentGameScore(encoder *encoder, value *PushMessageContentGameScore) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pushMessageContentGameScore *PushMessageContentGameScore) encode(encoder *encoder) {
+	encoder.meta(TypePushMessageContentGameScore, pushMessageContentGameScore.meta)
+	encoder.String("title", pushMessageContentGameScore.Title)
+	encoder.Int32("score", pushMessageContentGameScore.Score)
+	encoder.Bool("is_pinned", pushMessageContentGameScore.IsPinned)
+}
+
+func encodePushMessageContentInvoice(encoder *encoder, value *PushMessageContentInvoice) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pushMessageContentInvoice *PushMessageContentInvoice) encode(encoder *encoder) {
+	encoder.meta(TypePushMessageContentInvoice, pushMessageContentInvoice.meta)
+	encoder.String("price", pushMessageContentInvoice.Price)
+	encoder.Bool("is_pinned", pushMessageContentInvoice.IsPinned)
+}
+
+func encodePushMessageContentLocation(encoder *encoder, value *PushMessageContentLocation) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pushMessageContentLocation *PushMessageContentLocation) encode(encoder *encoder) {
+	encoder.meta(TypePushMessageContentLocation, pushMessageContentLocation.meta)
+	encoder.Bool("is_live", pushMessageContentLocation.IsLive)
+	encoder.Bool("is_pinned", pushMessageContentLocation.IsPinned)
+}
+
+func encodePushMessageContentPhoto(encoder *encoder, value *PushMessageContentPhoto) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pushMessageContentPhoto *PushMessageContentPhoto) encode(encoder *encoder) {
+	encoder.meta(TypePushMessageContentPhoto, pushMessageContentPhoto.meta)
+	encoder.name("photo")
+	encodePhoto(encoder, pushMessageContentPhoto.Photo)
+	encoder.String("caption", pushMessageContentPhoto.Caption)
+	encoder.Bool("is_secret", pushMessageContentPhoto.IsSecret)
+	encoder.Bool("is_pinned", pushMessageContentPhoto.IsPinned)
+}
+
+func encodePushMessageContentPoll(encoder *encoder, value *PushMessageContentPoll) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pushMessageContentPoll *PushMessageContentPoll) encode(encoder *encoder) {
+	encoder.meta(TypePushMessageContentPoll, pushMessageContentPoll.meta)
+	encoder.String("question", pushMessageContentPoll.Question)
+	encoder.Bool("is_regular", pushMessageContentPoll.IsRegular)
+	encoder.Bool("is_pinned", pushMessageContentPoll.IsPinned)
+}
+
+func encodePushMessageContentScreenshotTaken(encoder *encoder, value *PushMessageContentScreenshotTaken) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pushMessageContentScreenshotTaken *PushMessageContentScreenshotTaken) encode(encoder *encoder) {
+	encoder.meta(TypePushMessageContentScreenshotTaken, pushMessageContentScreenshotTaken.meta)
+}
+
+func encodePushMessageContentSticker(encoder *encoder, value *PushMessageContentSticker) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pushMessageContentSticker *PushMessageContentSticker) encode(encoder *encoder) {
+	encoder.meta(TypePushMessageContentSticker, pushMessageContentSticker.meta)
+	encoder.name("sticker")
+	encodeSticker(encoder, pushMessageContentSticker.Sticker)
+	encoder.String("emoji", pushMessageContentSticker.Emoji)
+	encoder.Bool("is_pinned", pushMessageContentSticker.IsPinned)
+}
+
+func encodePushMessageContentText(encoder *encoder, value *PushMessageContentText) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pushMessageContentText *PushMessageContentText) encode(encoder *encoder) {
+	encoder.meta(TypePushMessageContentText, pushMessageContentText.meta)
+	encoder.String("text", pushMessageContentText.Text)
+	encoder.Bool("is_pinned", pushMessageContentText.IsPinned)
+}
+
+func encodePushMessageContentVideo(encoder *encoder, value *PushMessageContentVideo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pushMessageContentVideo *PushMessageContentVideo) encode(encoder *encoder) {
+	encoder.meta(TypePushMessageContentVideo, pushMessageContentVideo.meta)
+	encoder.name("video")
+	encodeVideo(encoder, pushMessageContentVideo.Video)
+	encoder.String("caption", pushMessageContentVideo.Caption)
+	encoder.Bool("is_secret", pushMessageContentVideo.IsSecret)
+	encoder.Bool("is_pinned", pushMessageContentVideo.IsPinned)
+}
+
+func encodePushMessageContentVideoNote(encoder *encoder, value *PushMessageContentVideoNote) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pushMessageContentVideoNote *PushMessageContentVideoNote) encode(encoder *encoder) {
+	encoder.meta(TypePushMessageContentVideoNote, pushMessageContentVideoNote.meta)
+	encoder.name("video_note")
+	encodeVideoNote(encoder, pushMessageContentVideoNote.VideoNote)
+	encoder.Bool("is_pinned", pushMessageContentVideoNote.IsPinned)
+}
+
+func encodePushMessageContentVoiceNote(encoder *encoder, value *PushMessageContentVoiceNote) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pushMessageContentVoiceNote *PushMessageContentVoiceNote) encode(encoder *encoder) {
+	encoder.meta(TypePushMessageContentVoiceNote, pushMessageContentVoiceNote.meta)
+	encoder.name("voice_note")
+	encodeVoiceNote(encoder, pushMessageContentVoiceNote.VoiceNote)
+	encoder.Bool("is_pinned", pushMessageContentVoiceNote.IsPinned)
+}
+
+func encodePushMessageContentBasicGroupChatCreate(encoder *encoder, value *PushMessageContentBasicGroupChatCreate) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pushMessageContentBasicGroupChatCreate *PushMessageContentBasicGroupChatCreate) encode(encoder *encoder) {
+	encoder.meta(TypePushMessageContentBasicGroupChatCreate, pushMessageContentBasicGroupChatCreate.meta)
+}
+
+func encodePushMessageContentChatAddMembers(encoder *encoder, value *PushMessageContentChatAddMembers) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pushMessageContentChatAddMembers *PushMessageContentChatAddMembers) encode(encoder *encoder) {
+	encoder.meta(TypePushMessageContentChatAddMembers, pushMessageContentChatAddMembers.meta)
+	encoder.String("member_name", pushMessageContentChatAddMembers.MemberName)
+	encoder.Bool("is_current_user", pushMessageContentChatAddMembers.IsCurrentUser)
+	encoder.Bool("is_returned", pushMessageContentChatAddMembers.IsReturned)
+}
+
+func encodePushMessageContentChatChangePhoto(encoder *encoder, value *PushMessageContentChatChangePhoto) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pushMessageContentChatChangePhoto *PushMessageContentChatChangePhoto) encode(encoder *encoder) {
+	encoder.meta(TypePushMessageContentChatChangePhoto, pushMessageContentChatChangePhoto.meta)
+}
+
+func encodePushMessageContentChatChangeTitle(encoder *encoder, value *PushMessageContentChatChangeTitle) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pushMessageContentChatChangeTitle *PushMessageContentChatChangeTitle) encode(encoder *encoder) {
+	encoder.meta(TypePushMessageContentChatChangeTitle, pushMessageContentChatChangeTitle.meta)
+	encoder.String("title", pushMessageContentChatChangeTitle.Title)
+}
+
+func encodePushMessageContentChatSetBackground(encoder *encoder, value *PushMessageContentChatSetBackground) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pushMessageContentChatSetBackground *PushMessageContentChatSetBackground) encode(encoder *encoder) {
+	encoder.meta(TypePushMessageContentChatSetBackground, pushMessageContentChatSetBackground.meta)
+	encoder.Bool("is_same", pushMessageContentChatSetBackground.IsSame)
+}
+
+func encodePushMessageContentChatSetTheme(encoder *encoder, value *PushMessageContentChatSetTheme) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pushMessageContentChatSetTheme *PushMessageContentChatSetTheme) encode(encoder *encoder) {
+	encoder.meta(TypePushMessageContentChatSetTheme, pushMessageContentChatSetTheme.meta)
+	encoder.String("theme_name", pushMessageContentChatSetTheme.ThemeName)
+}
+
+func encodePushMessageContentChatDeleteMember(encoder *encoder, value *PushMessageContentChatDeleteMember) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pushMessageContentChatDeleteMember *PushMessageContentChatDeleteMember) encode(encoder *encoder) {
+	encoder.meta(TypePushMessageContentChatDeleteMember, pushMessageContentChatDeleteMember.meta)
+	encoder.String("member_name", pushMessageContentChatDeleteMember.MemberName)
+	encoder.Bool("is_current_user", pushMessageContentChatDeleteMember.IsCurrentUser)
+	encoder.Bool("is_left", pushMessageContentChatDeleteMember.IsLeft)
+}
+
+func encodePushMessageContentChatJoinByLink(encoder *encoder, value *PushMessageContentChatJoinByLink) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pushMessageContentChatJoinByLink *PushMessageContentChatJoinByLink) encode(encoder *encoder) {
+	encoder.meta(TypePushMessageContentChatJoinByLink, pushMessageContentChatJoinByLink.meta)
+}
+
+func encodePushMessageContentChatJoinByRequest(encoder *encoder, value *PushMessageContentChatJoinByRequest) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pushMessageContentChatJoinByRequest *PushMessageContentChatJoinByRequest) encode(encoder *encoder) {
+	encoder.meta(TypePushMessageContentChatJoinByRequest, pushMessageContentChatJoinByRequest.meta)
+}
+
+func encodePushMessageContentRecurringPayment(encoder *encoder, value *PushMessageContentRecurringPayment) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pushMessageContentRecurringPayment *PushMessageContentRecurringPayment) encode(encoder *encoder) {
+	encoder.meta(TypePushMessageContentRecurringPayment, pushMessageContentRecurringPayment.meta)
+	encoder.String("amount", pushMessageContentRecurringPayment.Amount)
+}
+
+func encodePushMessageContentSuggestProfilePhoto(encoder *encoder, value *PushMessageContentSuggestProfilePhoto) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pushMessageContentSuggestProfilePhoto *PushMessageContentSuggestProfilePhoto) encode(encoder *encoder) {
+	encoder.meta(TypePushMessageContentSuggestProfilePhoto, pushMessageContentSuggestProfilePhoto.meta)
+}
+
+func encodePushMessageContentMessageForwards(encoder *encoder, value *PushMessageContentMessageForwards) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pushMessageContentMessageForwards *PushMessageContentMessageForwards) encode(encoder *encoder) {
+	encoder.meta(TypePushMessageContentMessageForwards, pushMessageContentMessageForwards.meta)
+	encoder.Int32("total_count", pushMessageContentMessageForwards.TotalCount)
+}
+
+func encodePushMessageContentMediaAlbum(encoder *encoder, value *PushMessageContentMediaAlbum) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (pushMessageContentMediaAlbum *PushMessageContentMediaAlbum) encode(encoder *encoder) {
+	encoder.meta(TypePushMessageContentMediaAlbum, pushMessageContentMediaAlbum.meta)
+	encoder.Int32("total_count", pushMessageContentMediaAlbum.TotalCount)
+	encoder.Bool("has_photos", pushMessageContentMediaAlbum.HasPhotos)
+	encoder.Bool("has_videos", pushMessageContentMediaAlbum.HasVideos)
+	encoder.Bool("has_audios", pushMessageContentMediaAlbum.HasAudios)
+	encoder.Bool("has_documents", pushMessageContentMediaAlbum.HasDocuments)
+}
+
+func encodeNotificationTypeNewMessage(encoder *encoder, value *NotificationTypeNewMessage) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (notificationTypeNewMessage *NotificationTypeNewMessage) encode(encoder *encoder) {
+	encoder.meta(TypeNotificationTypeNewMessage, notificationTypeNewMessage.meta)
+	encoder.name("message")
+	encodeMessage(encoder, notificationTypeNewMessage.Message)
+	encoder.Bool("show_preview", notificationTypeNewMessage.ShowPreview)
+}
+
+func encodeNotificationTypeNewSecretChat(encoder *encoder, value *NotificationTypeNewSecretChat) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (notificationTypeNewSecretChat *NotificationTypeNewSecretChat) encode(encoder *encoder) {
+	encoder.meta(TypeNotificationTypeNewSecretChat, notificationTypeNewSecretChat.meta)
+}
+
+func encodeNotificationTypeNewCall(encoder *encoder, value *NotificationTypeNewCall) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (notificationTypeNewCall *NotificationTypeNewCall) encode(encoder *encoder) {
+	encoder.meta(TypeNotificationTypeNewCall, notificationTypeNewCall.meta)
+	encoder.Int32("call_id", notificationTypeNewCall.CallId)
+}
+
+func encodeNotificationTypeNewPushMessage(encoder *encoder, value *NotificationTypeNewPushMessage) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (notificationTypeNewPushMessage *NotificationTypeNewPushMessage) encode(encoder *encoder) {
+	encoder.meta(TypeNotificationTypeNewPushMessage, notificationTypeNewPushMessage.meta)
+	encoder.Int64("message_id", notificationTypeNewPushMessage.MessageId)
+	encoder.name("sender_id")
+	encodeMessageSender(encoder, notificationTypeNewPushMessage.SenderId)
+	encoder.String("sender_name", notificationTypeNewPushMessage.SenderName)
+	encoder.Bool("is_outgoing", notificationTypeNewPushMessage.IsOutgoing)
+	encoder.name("content")
+	encodePushMessageContent(encoder, notificationTypeNewPushMessage.Content)
+}
+
+func encodeNotificationGroupTypeMessages(encoder *encoder, value *NotificationGroupTypeMessages) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (notificationGroupTypeMessages *NotificationGroupTypeMessages) encode(encoder *encoder) {
+	encoder.meta(TypeNotificationGroupTypeMessages, notificationGroupTypeMessages.meta)
+}
+
+func encodeNotificationGroupTypeMentions(encoder *encoder, value *NotificationGroupTypeMentions) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (notificationGroupTypeMentions *NotificationGroupTypeMentions) encode(encoder *encoder) {
+	encoder.meta(TypeNotificationGroupTypeMentions, notificationGroupTypeMentions.meta)
+}
+
+func encodeNotificationGroupTypeSecretChat(encoder *encoder, value *NotificationGroupTypeSecretChat) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (notificationGroupTypeSecretChat *NotificationGroupTypeSecretChat) encode(encoder *encoder) {
+	encoder.meta(TypeNotificationGroupTypeSecretChat, notificationGroupTypeSecretChat.meta)
+}
+
+func encodeNotificationGroupTypeCalls(encoder *encoder, value *NotificationGroupTypeCalls) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (notificationGroupTypeCalls *NotificationGroupTypeCalls) encode(encoder *encoder) {
+	encoder.meta(TypeNotificationGroupTypeCalls, notificationGroupTypeCalls.meta)
+}
+
+func encodeNotificationSound(encoder *encoder, value *NotificationSound) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (notificationSound *NotificationSound) encode(encoder *encoder) {
+	encoder.meta(TypeNotificationSound, notificationSound.meta)
+	encoder.JsonInt64("id", notificationSound.Id)
+	encoder.Int32("duration", notificationSound.Duration)
+	encoder.Int32("date", notificationSound.Date)
+	encoder.String("title", notificationSound.Title)
+	encoder.String("data", notificationSound.Data)
+	encoder.name("sound")
+	encodeFile(encoder, notificationSound.Sound)
+}
+
+func encodeNotificationSounds(encoder *encoder, value *NotificationSounds) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (notificationSounds *NotificationSounds) encode(encoder *encoder) {
+	encoder.meta(TypeNotificationSounds, notificationSounds.meta)
+	encoder.name("notification_sounds")
+	encodeListOfNotificationSound(encoder, notificationSounds.NotificationSounds)
+}
+
+func encodeNotification(encoder *encoder, value *Notification) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (notification *Notification) encode(encoder *encoder) {
+	encoder.meta(TypeNotification, notification.meta)
+	encoder.Int32("id", notification.Id)
+	encoder.Int32("date", notification.Date)
+	encoder.Bool("is_silent", notification.IsSilent)
+	encoder.name("type")
+	encodeNotificationType(encoder, notification.Type)
+}
+
+func encodeNotificationGroup(encoder *encoder, value *NotificationGroup) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (notificationGroup *NotificationGroup) encode(encoder *encoder) {
+	encoder.meta(TypeNotificationGroup, notificationGroup.meta)
+	encoder.Int32("id", notificationGroup.Id)
+	encoder.name("type")
+	encodeNotificationGroupType(encoder, notificationGroup.Type)
+	encoder.Int64("chat_id", notificationGroup.ChatId)
+	encoder.Int32("total_count", notificationGroup.TotalCount)
+	encoder.name("notifications")
+	encodeListOfNotification(encoder, notificationGroup.Notifications)
+}
+
+func encodeOptionValueBoolean(encoder *encoder, value *OptionValueBoolean) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (optionValueBoolean *OptionValueBoolean) encode(encoder *encoder) {
+	encoder.meta(TypeOptionValueBoolean, optionValueBoolean.meta)
+	encoder.Bool("value", optionValueBoolean.Value)
+}
+
+func encodeOptionValueEmpty(encoder *encoder, value *OptionValueEmpty) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (optionValueEmpty *OptionValueEmpty) encode(encoder *encoder) {
+	encoder.meta(TypeOptionValueEmpty, optionValueEmpty.meta)
+}
+
+func encodeOptionValueInteger(encoder *encoder, value *OptionValueInteger) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (optionValueInteger *OptionValueInteger) encode(encoder *encoder) {
+	encoder.meta(TypeOptionValueInteger, optionValueInteger.meta)
+	encoder.JsonInt64("value", optionValueInteger.Value)
+}
+
+func encodeOptionValueString(encoder *encoder, value *OptionValueString) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (optionValueString *OptionValueString) encode(encoder *encoder) {
+	encoder.meta(TypeOptionValueString, optionValueString.meta)
+	encoder.String("value", optionValueString.Value)
+}
+
+func encodeJsonObjectMember(encoder *encoder, value *JsonObjectMember) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (jsonObjectMember *JsonObjectMember) encode(encoder *encoder) {
+	encoder.meta(TypeJsonObjectMember, jsonObjectMember.meta)
+	encoder.String("key", jsonObjectMember.Key)
+	encoder.name("value")
+	encodeJsonValue(encoder, jsonObjectMember.Value)
+}
+
+func encodeJsonValueNull(encoder *encoder, value *JsonValueNull) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (jsonValueNull *JsonValueNull) encode(encoder *encoder) {
+	encoder.meta(TypeJsonValueNull, jsonValueNull.meta)
+}
+
+func encodeJsonValueBoolean(encoder *encoder, value *JsonValueBoolean) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (jsonValueBoolean *JsonValueBoolean) encode(encoder *encoder) {
+	encoder.meta(TypeJsonValueBoolean, jsonValueBoolean.meta)
+	encoder.Bool("value", jsonValueBoolean.Value)
+}
+
+func encodeJsonValueNumber(encoder *encoder, value *JsonValueNumber) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (jsonValueNumber *JsonValueNumber) encode(encoder *encoder) {
+	encoder.meta(TypeJsonValueNumber, jsonValueNumber.meta)
+	encoder.Float64("value", jsonValueNumber.Value)
+}
+
+func encodeJsonValueString(encoder *encoder, value *JsonValueString) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (jsonValueString *JsonValueString) encode(encoder *encoder) {
+	encoder.meta(TypeJsonValueString, jsonValueString.meta)
+	encoder.String("value", jsonValueString.Value)
+}
+
+func encodeJsonValueArray(encoder *encoder, value *JsonValueArray) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (jsonValueArray *JsonValueArray) encode(encoder *encoder) {
+	encoder.meta(TypeJsonValueArray, jsonValueArray.meta)
+	encoder.name("values")
+	encodeListOfJsonValue(encoder, jsonValueArray.Values)
+}
+
+func encodeJsonValueObject(encoder *encoder, value *JsonValueObject) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (jsonValueObject *JsonValueObject) encode(encoder *encoder) {
+	encoder.meta(TypeJsonValueObject, jsonValueObject.meta)
+	encoder.name("members")
+	encodeListOfJsonObjectMember(encoder, jsonValueObject.Members)
+}
+
+func encodeUserPrivacySettingRuleAllowAll(encoder *encoder, value *UserPrivacySettingRuleAllowAll) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (userPrivacySettingRuleAllowAll *UserPrivacySettingRuleAllowAll) encode(encoder *encoder) {
+	encoder.meta(TypeUserPrivacySettingRuleAllowAll, userPrivacySettingRuleAllowAll.meta)
+}
+
+func encodeUserPrivacySettingRuleAllowContacts(encoder *encoder, value *UserPrivacySettingRuleAllowContacts) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (userPrivacySettingRuleAllowContacts *UserPrivacySettingRuleAllowContacts) encode(encoder *encoder) {
+	encoder.meta(TypeUserPrivacySettingRuleAllowContacts, userPrivacySettingRuleAllowContacts.meta)
+}
+
+func encodeUserPrivacySettingRuleAllowUsers(encoder *encoder, value *UserPrivacySettingRuleAllowUsers) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (userPrivacySettingRuleAllowUsers *UserPrivacySettingRuleAllowUsers) encode(encoder *encoder) {
+	encoder.meta(TypeUserPrivacySettingRuleAllowUsers, userPrivacySettingRuleAllowUsers.meta)
+	encoder.Int64s("user_ids", userPrivacySettingRuleAllowUsers.UserIds)
+}
+
+func encodeUserPrivacySettingRuleAllowChatMembers(encoder *encoder, value *UserPrivacySettingRuleAllowChatMembers) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (userPrivacySettingRuleAllowChatMembers *UserPrivacySettingRuleAllowChatMembers) encode(encoder *encoder) {
+	encoder.meta(TypeUserPrivacySettingRuleAllowChatMembers, userPrivacySettingRuleAllowChatMembers.meta)
+	encoder.Int64s("chat_ids", userPrivacySettingRuleAllowChatMembers.ChatIds)
+}
+
+func encodeUserPrivacySettingRuleRestrictAll(encoder *encoder, value *UserPrivacySettingRuleRestrictAll) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (userPrivacySettingRuleRestrictAll *UserPrivacySettingRuleRestrictAll) encode(encoder *encoder) {
+	encoder.meta(TypeUserPrivacySettingRuleRestrictAll, userPrivacySettingRuleRestrictAll.meta)
+}
+
+func encodeUserPrivacySettingRuleRestrictContacts(encoder *encoder, value *UserPrivacySettingRuleRestrictContacts) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (userPrivacySettingRuleRestrictContacts *UserPrivacySettingRuleRestrictContacts) encode(encoder *encoder) {
+	encoder.meta(TypeUserPrivacySettingRuleRestrictContacts, userPrivacySettingRuleRestrictContacts.meta)
+}
+
+func encodeUserPrivacySettingRuleRestrictUsers(encoder *encoder, value *UserPrivacySettingRuleRestrictUsers) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (userPrivacySettingRuleRestrictUsers *UserPrivacySettingRuleRestrictUsers) encode(encoder *encoder) {
+	encoder.meta(TypeUserPrivacySettingRuleRestrictUsers, userPrivacySettingRuleRestrictUsers.meta)
+	encoder.Int64s("user_ids", userPrivacySettingRuleRestrictUsers.UserIds)
+}
+
+func encodeUserPrivacySettingRuleRestrictChatMembers(encoder *encoder, value *UserPrivacySettingRuleRestrictChatMembers) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (userPrivacySettingRuleRestrictChatMembers *UserPrivacySettingRuleRestrictChatMembers) encode(encoder *encoder) {
+	encoder.meta(TypeUserPrivacySettingRuleRestrictChatMembers, userPrivacySettingRuleRestrictChatMembers.meta)
+	encoder.Int64s("chat_ids", userPrivacySettingRuleRestrictChatMembers.ChatIds)
+}
+
+func encodeUserPrivacySettingRules(encoder *encoder, value *UserPrivacySettingRules) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (userPrivacySettingRules *UserPrivacySettingRules) encode(encoder *encoder) {
+	encoder.meta(TypeUserPrivacySettingRules, userPrivacySettingRules.meta)
+	encoder.name("rules")
+	encodeListOfUserPrivacySettingRule(encoder, userPrivacySettingRules.Rules)
+}
+
+func encodeUserPrivacySettingShowStatus(encoder *encoder, value *UserPrivacySettingShowStatus) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (userPrivacySettingShowStatus *UserPrivacySettingShowStatus) encode(encoder *encoder) {
+	encoder.meta(TypeUserPrivacySettingShowStatus, userPrivacySettingShowStatus.meta)
+}
+
+func encodeUserPrivacySettingShowProfilePhoto(encoder *encoder, value *UserPrivacySettingShowProfilePhoto) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (userPrivacySettingShowProfilePhoto *UserPrivacySettingShowProfilePhoto) encode(encoder *encoder) {
+	encoder.meta(TypeUserPrivacySettingShowProfilePhoto, userPrivacySettingShowProfilePhoto.meta)
+}
+
+func encodeUserPrivacySettingShowLinkInForwardedMessages(encoder *encoder, value *UserPrivacySettingShowLinkInForwardedMessages) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (userPrivacySettingShowLinkInForwardedMessages *UserPrivacySettingShowLinkInForwardedMessages) encode(encoder *encoder) {
+	encoder.meta(TypeUserPrivacySettingShowLinkInForwardedMessages, userPrivacySettingShowLinkInForwardedMessages.meta)
+}
+
+func encodeUserPrivacySettingShowPhoneNumber(encoder *encoder, value *UserPrivacySettingShowPhoneNumber) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (userPrivacySettingShowPhoneNumber *UserPrivacySettingShowPhoneNumber) encode(encoder *encoder) {
+	encoder.meta(TypeUserPrivacySettingShowPhoneNumber, userPrivacySettingShowPhoneNumber.meta)
+}
+
+func encodeUserPrivacySettingAllowChatInvites(encoder *encoder, value *UserPrivacySettingAllowChatInvites) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (userPrivacySettingAllowChatInvites *UserPrivacySettingAllowChatInvites) encode(encoder *encoder) {
+	encoder.meta(TypeUserPrivacySettingAllowChatInvites, userPrivacySettingAllowChatInvites.meta)
+}
+
+func encodeUserPrivacySettingAllowCalls(encoder *encoder, value *UserPrivacySettingAllowCalls) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (userPrivacySettingAllowCalls *UserPrivacySettingAllowCalls) encode(encoder *encoder) {
+	encoder.meta(TypeUserPrivacySettingAllowCalls, userPrivacySettingAllowCalls.meta)
+}
+
+func encodeUserPrivacySettingAllowPeerToPeerCalls(encoder *encoder, value *UserPrivacySettingAllowPeerToPeerCalls) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (userPrivacySettingAllowPeerToPeerCalls *UserPrivacySettingAllowPeerToPeerCalls) encode(encoder *encoder) {
+	encoder.meta(TypeUserPrivacySettingAllowPeerToPeerCalls, userPrivacySettingAllowPeerToPeerCalls.meta)
+}
+
+func encodeUserPrivacySettingAllowFindingByPhoneNumber(encoder *encoder, value *UserPrivacySettingAllowFindingByPhoneNumber) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (userPrivacySettingAllowFindingByPhoneNumber *UserPrivacySettingAllowFindingByPhoneNumber) encode(encoder *encoder) {
+	encoder.meta(TypeUserPrivacySettingAllowFindingByPhoneNumber, userPrivacySettingAllowFindingByPhoneNumber.meta)
+}
+
+func encodeUserPrivacySettingAllowPrivateVoiceAndVideoNoteMessages(encoder *encoder, value *UserPrivacySettingAllowPrivateVoiceAndVideoNoteMessages) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (userPrivacySettingAllowPrivateVoiceAndVideoNoteMessages *UserPrivacySettingAllowPrivateVoiceAndVideoNoteMessages) encode(encoder *encoder) {
+	encoder.meta(TypeUserPrivacySettingAllowPrivateVoiceAndVideoNoteMessages, userPrivacySettingAllowPrivateVoiceAndVideoNoteMessages.meta)
+}
+
+func encodeAccountTtl(encoder *encoder, value *AccountTtl) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (accountTtl *AccountTtl) encode(encoder *encoder) {
+	encoder.meta(TypeAccountTtl, accountTtl.meta)
+	encoder.Int32("days", accountTtl.Days)
+}
+
+func encodeMessageAutoDeleteTime(encoder *encoder, value *MessageAutoDeleteTime) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageAutoDeleteTime *MessageAutoDeleteTime) encode(encoder *encoder) {
+	encoder.meta(TypeMessageAutoDeleteTime, messageAutoDeleteTime.meta)
+	encoder.Int32("time", messageAutoDeleteTime.Time)
+}
+
+func encodeSessionTypeAndroid(encoder *encoder, value *SessionTypeAndroid) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (sessionTypeAndroid *SessionTypeAndroid) encode(encoder *encoder) {
+	encoder.meta(TypeSessionTypeAndroid, sessionTypeAndroid.meta)
+}
+
+func encodeSessionTypeApple(encoder *encoder, value *SessionTypeApple) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (sessionTypeApple *SessionTypeApple) encode(encoder *encoder) {
+	encoder.meta(TypeSessionTypeApple, sessionTypeApple.meta)
+}
+
+func encodeSessionTypeBrave(encoder *encoder, value *SessionTypeBrave) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (sessionTypeBrave *SessionTypeBrave) encode(encoder *encoder) {
+	encoder.meta(TypeSessionTypeBrave, sessionTypeBrave.meta)
+}
+
+func encodeSessionTypeChrome(encoder *encoder, value *SessionTypeChrome) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (sessionTypeChrome *SessionTypeChrome) encode(encoder *encoder) {
+	encoder.meta(TypeSessionTypeChrome, sessionTypeChrome.meta)
+}
+
+func encodeSessionTypeEdge(encoder *encoder, value *SessionTypeEdge) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (sessionTypeEdge *SessionTypeEdge) encode(encoder *encoder) {
+	encoder.meta(TypeSessionTypeEdge, sessionTypeEdge.meta)
+}
+
+func encodeSessionTypeFirefox(encoder *encoder, value *SessionTypeFirefox) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (sessionTypeFirefox *SessionTypeFirefox) encode(encoder *encoder) {
+	encoder.meta(TypeSessionTypeFirefox, sessionTypeFirefox.meta)
+}
+
+func encodeSessionTypeIpad(encoder *encoder, value *SessionTypeIpad) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (sessionTypeIpad *SessionTypeIpad) encode(encoder *encoder) {
+	encoder.meta(TypeSessionTypeIpad, sessionTypeIpad.meta)
+}
+
+func encodeSessionTypeIphone(encoder *encoder, value *SessionTypeIphone) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (sessionTypeIphone *SessionTypeIphone) encode(encoder *encoder) {
+	encoder.meta(TypeSessionTypeIphone, sessionTypeIphone.meta)
+}
+
+func encodeSessionTypeLinux(encoder *encoder, value *SessionTypeLinux) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (sessionTypeLinux *SessionTypeLinux) encode(encoder *encoder) {
+	encoder.meta(TypeSessionTypeLinux, sessionTypeLinux.meta)
+}
+
+func encodeSessionTypeMac(encoder *encoder, value *SessionTypeMac) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (sessionTypeMac *SessionTypeMac) encode(encoder *encoder) {
+	encoder.meta(TypeSessionTypeMac, sessionTypeMac.meta)
+}
+
+func encodeSessionTypeOpera(encoder *encoder, value *SessionTypeOpera) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (sessionTypeOpera *SessionTypeOpera) encode(encoder *encoder) {
+	encoder.meta(TypeSessionTypeOpera, sessionTypeOpera.meta)
+}
+
+func encodeSessionTypeSafari(encoder *encoder, value *SessionTypeSafari) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (sessionTypeSafari *SessionTypeSafari) encode(encoder *encoder) {
+	encoder.meta(TypeSessionTypeSafari, sessionTypeSafari.meta)
+}
+
+func encodeSessionTypeUbuntu(encoder *encoder, value *SessionTypeUbuntu) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (sessionTypeUbuntu *SessionTypeUbuntu) encode(encoder *encoder) {
+	encoder.meta(TypeSessionTypeUbuntu, sessionTypeUbuntu.meta)
+}
+
+func encodeSessionTypeUnknown(encoder *encoder, value *SessionTypeUnknown) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (sessionTypeUnknown *SessionTypeUnknown) encode(encoder *encoder) {
+	encoder.meta(TypeSessionTypeUnknown, sessionTypeUnknown.meta)
+}
+
+func encodeSessionTypeVivaldi(encoder *encoder, value *SessionTypeVivaldi) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (sessionTypeVivaldi *SessionTypeVivaldi) encode(encoder *encoder) {
+	encoder.meta(TypeSessionTypeVivaldi, sessionTypeVivaldi.meta)
+}
+
+func encodeSessionTypeWindows(encoder *encoder, value *SessionTypeWindows) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (sessionTypeWindows *SessionTypeWindows) encode(encoder *encoder) {
+	encoder.meta(TypeSessionTypeWindows, sessionTypeWindows.meta)
+}
+
+func encodeSessionTypeXbox(encoder *encoder, value *SessionTypeXbox) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (sessionTypeXbox *SessionTypeXbox) encode(encoder *encoder) {
+	encoder.meta(TypeSessionTypeXbox, sessionTypeXbox.meta)
+}
+
+func encodeSession(encoder *encoder, value *Session) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (session *Session) encode(encoder *encoder) {
+	encoder.meta(TypeSession, session.meta)
+	encoder.JsonInt64("id", session.Id)
+	encoder.Bool("is_current", session.IsCurrent)
+	encoder.Bool("is_password_pending", session.IsPasswordPending)
+	encoder.Bool("can_accept_secret_chats", session.CanAcceptSecretChats)
+	encoder.Bool("can_accept_calls", session.CanAcceptCalls)
+	encoder.name("type")
+	encodeSessionType(encoder, session.Type)
+	encoder.Int32("api_id", session.ApiId)
+	encoder.String("application_name", session.ApplicationName)
+	encoder.String("application_version", session.ApplicationVersion)
+	encoder.Bool("is_official_application", session.IsOfficialApplication)
+	encoder.String("device_model", session.DeviceModel)
+	encoder.String("platform", session.Platform)
+	encoder.String("system_version", session.SystemVersion)
+	encoder.Int32("log_in_date", session.LogInDate)
+	encoder.Int32("last_active_date", session.LastActiveDate)
+	encoder.String("ip", session.Ip)
+	encoder.String("country", session.Country)
+	encoder.String("region", session.Region)
+}
+
+func encodeSessions(encoder *encoder, value *Sessions) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (sessions *Sessions) encode(encoder *encoder) {
+	encoder.meta(TypeSessions, sessions.meta)
+	encoder.name("sessions")
+	encodeListOfSession(encoder, sessions.Sessions)
+	encoder.Int32("inactive_session_ttl_days", sessions.InactiveSessionTtlDays)
+}
+
+func encodeConnectedWebsite(encoder *encoder, value *ConnectedWebsite) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (connectedWebsite *ConnectedWebsite) encode(encoder *encoder) {
+	encoder.meta(TypeConnectedWebsite, connectedWebsite.meta)
+	encoder.JsonInt64("id", connectedWebsite.Id)
+	encoder.String("domain_name", connectedWebsite.DomainName)
+	encoder.Int64("bot_user_id", connectedWebsite.BotUserId)
+	encoder.String("browser", connectedWebsite.Browser)
+	encoder.String("platform", connectedWebsite.Platform)
+	encoder.Int32("log_in_date", connectedWebsite.LogInDate)
+	encoder.Int32("last_active_date", connectedWebsite.LastActiveDate)
+	encoder.String("ip", connectedWebsite.Ip)
+	encoder.String("location", connectedWebsite.Location)
+}
+
+func encodeConnectedWebsites(encoder *encoder, value *ConnectedWebsites) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (connectedWebsites *ConnectedWebsites) encode(encoder *encoder) {
+	encoder.meta(TypeConnectedWebsites, connectedWebsites.meta)
+	encoder.name("websites")
+	encodeListOfConnectedWebsite(encoder, connectedWebsites.Websites)
+}
+
+func encodeChatReportReasonSpam(encoder *encoder, value *ChatReportReasonSpam) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatReportReasonSpam *ChatReportReasonSpam) encode(encoder *encoder) {
+	encoder.meta(TypeChatReportReasonSpam, chatReportReasonSpam.meta)
+}
+
+func encodeChatReportReasonViolence(encoder *encoder, value *ChatReportReasonViolence) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatReportReasonViolence *ChatReportReasonViolence) encode(encoder *encoder) {
+	encoder.meta(TypeChatReportReasonViolence, chatReportReasonViolence.meta)
+}
+
+func encodeChatReportReasonPornography(encoder *encoder, value *ChatReportReasonPornography) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatReportReasonPornography *ChatReportReasonPornography) encode(encoder *encoder) {
+	encoder.meta(TypeChatReportReasonPornography, chatReportReasonPornography.meta)
+}
+
+func encodeChatReportReasonChildAbuse(encoder *encoder, value *ChatReportReasonChildAbuse) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatReportReasonChildAbuse *ChatReportReasonChildAbuse) encode(encoder *encoder) {
+	encoder.meta(TypeChatReportReasonChildAbuse, chatReportReasonChildAbuse.meta)
+}
+
+func encodeChatReportReasonCopyright(encoder *encoder, value *ChatReportReasonCopyright) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatReportReasonCopyright *ChatReportReasonCopyright) encode(encoder *encoder) {
+	encoder.meta(TypeChatReportReasonCopyright, chatReportReasonCopyright.meta)
+}
+
+func encodeChatReportReasonUnrelatedLocation(encoder *encoder, value *ChatReportReasonUnrelatedLocation) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatReportReasonUnrelatedLocation *ChatReportReasonUnrelatedLocation) encode(encoder *encoder) {
+	encoder.meta(TypeChatReportReasonUnrelatedLocation, chatReportReasonUnrelatedLocation.meta)
+}
+
+func encodeChatReportReasonFake(encoder *encoder, value *ChatReportReasonFake) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatReportReasonFake *ChatReportReasonFake) encode(encoder *encoder) {
+	encoder.meta(TypeChatReportReasonFake, chatReportReasonFake.meta)
+}
+
+func encodeChatReportReasonIllegalDrugs(encoder *encoder, value *ChatReportReasonIllegalDrugs) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatReportReasonIllegalDrugs *ChatReportReasonIllegalDrugs) encode(encoder *encoder) {
+	encoder.meta(TypeChatReportReasonIllegalDrugs, chatReportReasonIllegalDrugs.meta)
+}
+
+func encodeChatReportReasonPersonalDetails(encoder *encoder, value *ChatReportReasonPersonalDetails) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatReportReasonPersonalDetails *ChatReportReasonPersonalDetails) encode(encoder *encoder) {
+	encoder.meta(TypeChatReportReasonPersonalDetails, chatReportReasonPersonalDetails.meta)
+}
+
+func encodeChatReportReasonCustom(encoder *encoder, value *ChatReportReasonCustom) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatReportReasonCustom *ChatReportReasonCustom) encode(encoder *encoder) {
+	encoder.meta(TypeChatReportReasonCustom, chatReportReasonCustom.meta)
+}
+
+func encodeTargetChatCurrent(encoder *encoder, value *TargetChatCurrent) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (targetChatCurrent *TargetChatCurrent) encode(encoder *encoder) {
+	encoder.meta(TypeTargetChatCurrent, targetChatCurrent.meta)
+}
+
+func encodeTargetChatChosen(encoder *encoder, value *TargetChatChosen) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (targetChatChosen *TargetChatChosen) encode(encoder *encoder) {
+	encoder.meta(TypeTargetChatChosen, targetChatChosen.meta)
+	encoder.Bool("allow_user_chats", targetChatChosen.AllowUserChats)
+	encoder.Bool("allow_bot_chats", targetChatChosen.AllowBotChats)
+	encoder.Bool("allow_group_chats", targetChatChosen.AllowGroupChats)
+	encoder.Bool("allow_channel_chats", targetChatChosen.AllowChannelChats)
+}
+
+func encodeTargetChatInternalLink(encoder *encoder, value *TargetChatInternalLink) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (targetChatInternalLink *TargetChatInternalLink) encode(encoder *encoder) {
+	encoder.meta(TypeTargetChatInternalLink, targetChatInternalLink.meta)
+	encoder.name("link")
+	encodeInternalLinkType(encoder, targetChatInternalLink.Link)
+}
+
+func encodeInternalLinkTypeActiveSessions(encoder *encoder, value *InternalLinkTypeActiveSessions) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (internalLinkTypeActiveSessions *InternalLinkTypeActiveSessions) encode(encoder *encoder) {
+	encoder.meta(TypeInternalLinkTypeActiveSessions, internalLinkTypeActiveSessions.meta)
+}
+
+func encodeInternalLinkTypeAttachmentMenuBot(encoder *encoder, value *InternalLinkTypeAttachmentMenuBot) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (internalLinkTypeAttachmentMenuBot *InternalLinkTypeAttachmentMenuBot) encode(encoder *encoder) {
+	encoder.meta(TypeInternalLinkTypeAttachmentMenuBot, internalLinkTypeAttachmentMenuBot.meta)
+	encoder.name("target_chat")
+	encodeTargetChat(encoder, internalLinkTypeAttachmentMenuBot.TargetChat)
+	encoder.String("bot_username", internalLinkTypeAttachmentMenuBot.BotUsername)
+	encoder.String("url", internalLinkTypeAttachmentMenuBot.Url)
+}
+
+func encodeInternalLinkTypeAuthenticationCode(encoder *encoder, value *InternalLinkTypeAuthenticationCode) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (internalLinkTypeAuthenticationCode *InternalLinkTypeAuthenticationCode) encode(encoder *encoder) {
+	encoder.meta(TypeInternalLinkTypeAuthenticationCode, internalLinkTypeAuthenticationCode.meta)
+	encoder.String("code", internalLinkTypeAuthenticationCode.Code)
+}
+
+func encodeInternalLinkTypeBackground(encoder *encoder, value *InternalLinkTypeBackground) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (internalLinkTypeBackground *InternalLinkTypeBackground) encode(encoder *encoder) {
+	encoder.meta(TypeInternalLinkTypeBackground, internalLinkTypeBackground.meta)
+	encoder.String("background_name", internalLinkTypeBackground.BackgroundName)
+}
+
+func encodeInternalLinkTypeBotAddToChannel(encoder *encoder, value *InternalLinkTypeBotAddToChannel) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (internalLinkTypeBotAddToChannel *InternalLinkTypeBotAddToChannel) encode(encoder *encoder) {
+	encoder.meta(TypeInternalLinkTypeBotAddToChannel, internalLinkTypeBotAddToChannel.meta)
+	encoder.String("bot_username", internalLinkTypeBotAddToChannel.BotUsername)
+	encoder.name("administrator_rights")
+	encodeChatAdministratorRights(encoder, internalLinkTypeBotAddToChannel.AdministratorRights)
+}
+
+func encodeInternalLinkTypeBotStart(encoder *encoder, value *InternalLinkTypeBotStart) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (internalLinkTypeBotStart *InternalLinkTypeBotStart) encode(encoder *encoder) {
+	encoder.meta(TypeInternalLinkTypeBotStart, internalLinkTypeBotStart.meta)
+	encoder.String("bot_username", internalLinkTypeBotStart.BotUsername)
+	encoder.String("start_parameter", internalLinkTypeBotStart.StartParameter)
+	encoder.Bool("autostart", internalLinkTypeBotStart.Autostart)
+}
+
+func encodeInternalLinkTypeBotStartInGroup(encoder *encoder, value *InternalLinkTypeBotStartInGroup) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (internalLinkTypeBotStartInGroup *InternalLinkTypeBotStartInGroup) encode(encoder *encoder) {
+	encoder.meta(TypeInternalLinkTypeBotStartInGroup, internalLinkTypeBotStartInGroup.meta)
+	encoder.String("bot_username", internalLinkTypeBotStartInGroup.BotUsername)
+	encoder.String("start_parameter", internalLinkTypeBotStartInGroup.StartParameter)
+	encoder.name("administrator_rights")
+	encodeChatAdministratorRights(encoder, internalLinkTypeBotStartInGroup.AdministratorRights)
+}
+
+func encodeInternalLinkTypeChangePhoneNumber(encoder *encoder, value *InternalLinkTypeChangePhoneNumber) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (internalLinkTypeChangePhoneNumber *InternalLinkTypeChangePhoneNumber) encode(encoder *encoder) {
+	encoder.meta(TypeInternalLinkTypeChangePhoneNumber, internalLinkTypeChangePhoneNumber.meta)
+}
+
+func encodeInternalLinkTypeChatFolderInvite(encoder *encoder, value *InternalLinkTypeChatFolderInvite) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (internalLinkTypeChatFolderInvite *InternalLinkTypeChatFolderInvite) encode(encoder *encoder) {
+	encoder.meta(TypeInternalLinkTypeChatFolderInvite, internalLinkTypeChatFolderInvite.meta)
+	encoder.String("invite_link", internalLinkTypeChatFolderInvite.InviteLink)
+}
+
+func encodeInternalLinkTypeChatFolderSettings(encoder *encoder, value *InternalLinkTypeChatFolderSettings) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (internalLinkTypeChatFolderSettings *InternalLinkTypeChatFolderSettings) encode(encoder *encoder) {
+	encoder.meta(TypeInternalLinkTypeChatFolderSettings, internalLinkTypeChatFolderSettings.meta)
+}
+
+func encodeInternalLinkTypeChatInvite(encoder *encoder, value *InternalLinkTypeChatInvite) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (internalLinkTypeChatInvite *InternalLinkTypeChatInvite) encode(encoder *encoder) {
+	encoder.meta(TypeInternalLinkTypeChatInvite, internalLinkTypeChatInvite.meta)
+	encoder.String("invite_link", internalLinkTypeChatInvite.InviteLink)
+}
+
+func encodeInternalLinkTypeDefaultMessageAutoDeleteTimerSettings(encoder *encoder, value *InternalLinkTypeDefaultMessageAutoDeleteTimerSettings) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (internalLinkTypeDefaultMessageAutoDeleteTimerSettings *InternalLinkTypeDefaultMessageAutoDeleteTimerSettings) encode(encoder *encoder) {
+	encoder.meta(TypeInternalLinkTypeDefaultMessageAutoDeleteTimerSettings, internalLinkTypeDefaultMessageAutoDeleteTimerSettings.meta)
+}
+
+func encodeInternalLinkTypeEditProfileSettings(encoder *encoder, value *InternalLinkTypeEditProfileSettings) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (internalLinkTypeEditProfileSettings *InternalLinkTypeEditProfileSettings) encode(encoder *encoder) {
+	encoder.meta(TypeInternalLinkTypeEditProfileSettings, internalLinkTypeEditProfileSettings.meta)
+}
+
+func encodeInternalLinkTypeGame(encoder *encoder, value *InternalLinkTypeGame) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (internalLinkTypeGame *InternalLinkTypeGame) encode(encoder *encoder) {
+	encoder.meta(TypeInternalLinkTypeGame, internalLinkTypeGame.meta)
+	encoder.String("bot_username", internalLinkTypeGame.BotUsername)
+	encoder.String("game_short_name", internalLinkTypeGame.GameShortName)
+}
+
+func encodeInternalLinkTypeInstantView(encoder *encoder, value *InternalLinkTypeInstantView) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (internalLinkTypeInstantView *InternalLinkTypeInstantView) encode(encoder *encoder) {
+	encoder.meta(TypeInternalLinkTypeInstantView, internalLinkTypeInstantView.meta)
+	encoder.String("url", internalLinkTypeInstantView.Url)
+	encoder.String("fallback_url", internalLinkTypeInstantView.FallbackUrl)
+}
+
+func encodeInternalLinkTypeInvoice(encoder *encoder, value *InternalLinkTypeInvoice) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (internalLinkTypeInvoice *InternalLinkTypeInvoice) encode(encoder *encoder) {
+	encoder.meta(TypeInternalLinkTypeInvoice, internalLinkTypeInvoice.meta)
+	encoder.String("invoice_name", internalLinkTypeInvoice.InvoiceName)
+}
+
+func encodeInternalLinkTypeLanguagePack(encoder *encoder, value *InternalLinkTypeLanguagePack) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (internalLinkTypeLanguagePack *InternalLinkTypeLanguagePack) encode(encoder *encoder) {
+	encoder.meta(TypeInternalLinkTypeLanguagePack, internalLinkTypeLanguagePack.meta)
+	encoder.String("language_pack_id", internalLinkTypeLanguagePack.LanguagePackId)
+}
+
+func encodeInternalLinkTypeLanguageSettings(encoder *encoder, value *InternalLinkTypeLanguageSettings) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (internalLinkTypeLanguageSettings *InternalLinkTypeLanguageSettings) encode(encoder *encoder) {
+	encoder.meta(TypeInternalLinkTypeLanguageSettings, internalLinkTypeLanguageSettings.meta)
+}
+
+func encodeInternalLinkTypeMessage(encoder *encoder, value *InternalLinkTypeMessage) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (internalLinkTypeMessage *InternalLinkTypeMessage) encode(encoder *encoder) {
+	encoder.meta(TypeInternalLinkTypeMessage, internalLinkTypeMessage.meta)
+	encoder.String("url", internalLinkTypeMessage.Url)
+}
+
+func encodeInternalLinkTypeMessageDraft(encoder *encoder, value *InternalLinkTypeMessageDraft) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (internalLinkTypeMessageDraft *InternalLinkTypeMessageDraft) encode(encoder *encoder) {
+	encoder.meta(TypeInternalLinkTypeMessageDraft, internalLinkTypeMessageDraft.meta)
+	encoder.name("text")
+	encodeFormattedText(encoder, internalLinkTypeMessageDraft.Text)
+	encoder.Bool("contains_link", internalLinkTypeMessageDraft.ContainsLink)
+}
+
+func encodeInternalLinkTypePassportDataRequest(encoder *encoder, value *InternalLinkTypePassportDataRequest) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (internalLinkTypePassportDataRequest *InternalLinkTypePassportDataRequest) encode(encoder *encoder) {
+	encoder.meta(TypeInternalLinkTypePassportDataRequest, internalLinkTypePassportDataRequest.meta)
+	encoder.Int64("bot_user_id", internalLinkTypePassportDataRequest.BotUserId)
+	encoder.String("scope", internalLinkTypePassportDataRequest.Scope)
+	encoder.String("public_key", internalLinkTypePassportDataRequest.PublicKey)
+	encoder.String("nonce", internalLinkTypePassportDataRequest.Nonce)
+	encoder.String("callback_url", internalLinkTypePassportDataRequest.CallbackUrl)
+}
+
+func encodeInternalLinkTypePhoneNumberConfirmation(encoder *encoder, value *InternalLinkTypePhoneNumberConfirmation) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (internalLinkTypePhoneNumberConfirmation *InternalLinkTypePhoneNumberConfirmation) encode(encoder *encoder) {
+	encoder.meta(TypeInternalLinkTypePhoneNumberConfirmation, internalLinkTypePhoneNumberConfirmation.meta)
+	encoder.String("hash", internalLinkTypePhoneNumberConfirmation.Hash)
+	encoder.String("phone_number", internalLinkTypePhoneNumberConfirmation.PhoneNumber)
+}
+
+func encodeInternalLinkTypePremiumFeatures(encoder *encoder, value *InternalLinkTypePremiumFeatures) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (internalLinkTypePremiumFeatures *InternalLinkTypePremiumFeatures) encode(encoder *encoder) {
+	encoder.meta(TypeInternalLinkTypePremiumFeatures, internalLinkTypePremiumFeatures.meta)
+	encoder.String("referrer", internalLinkTypePremiumFeatures.Referrer)
+}
+
+func encodeInternalLinkTypePrivacyAndSecuritySettings(encoder *encoder, value *InternalLinkTypePrivacyAndSecuritySettings) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (internalLinkTypePrivacyAndSecuritySettings *InternalLinkTypePrivacyAndSecuritySettings) encode(encoder *encoder) {
+	encoder.meta(TypeInternalLinkTypePrivacyAndSecuritySettings, internalLinkTypePrivacyAndSecuritySettings.meta)
+}
+
+func encodeInternalLinkTypeProxy(encoder *encoder, value *InternalLinkTypeProxy) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (internalLinkTypeProxy *InternalLinkTypeProxy) encode(encoder *encoder) {
+	encoder.meta(TypeInternalLinkTypeProxy, internalLinkTypeProxy.meta)
+	encoder.String("server", internalLinkTypeProxy.Server)
+	encoder.Int32("port", internalLinkTypeProxy.Port)
+	encoder.name("type")
+	encodeProxyType(encoder, internalLinkTypeProxy.Type)
+}
+
+func encodeInternalLinkTypePublicChat(encoder *encoder, value *InternalLinkTypePublicChat) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (internalLinkTypePublicChat *InternalLinkTypePublicChat) encode(encoder *encoder) {
+	encoder.meta(TypeInternalLinkTypePublicChat, internalLinkTypePublicChat.meta)
+	encoder.String("chat_username", internalLinkTypePublicChat.ChatUsername)
+}
+
+func encodeInternalLinkTypeQrCodeAuthentication(encoder *encoder, value *InternalLinkTypeQrCodeAuthentication) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (internalLinkTypeQrCodeAuthentication *InternalLinkTypeQrCodeAuthentication) encode(encoder *encoder) {
+	encoder.meta(TypeInternalLinkTypeQrCodeAuthentication, internalLinkTypeQrCodeAuthentication.meta)
+}
+
+func encodeInternalLinkTypeRestorePurchases(encoder *encoder, value *InternalLinkTypeRestorePurchases) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (internalLinkTypeRestorePurchases *InternalLinkTypeRestorePurchases) encode(encoder *encoder) {
+	encoder.meta(TypeInternalLinkTypeRestorePurchases, internalLinkTypeRestorePurchases.meta)
+}
+
+func encodeInternalLinkTypeSettings(encoder *encoder, value *InternalLinkTypeSettings) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (internalLinkTypeSettings *InternalLinkTypeSettings) encode(encoder *encoder) {
+	encoder.meta(TypeInternalLinkTypeSettings, internalLinkTypeSettings.meta)
+}
+
+func encodeInternalLinkTypeStickerSet(encoder *encoder, value *InternalLinkTypeStickerSet) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (internalLinkTypeStickerSet *InternalLinkTypeStickerSet) encode(encoder *encoder) {
+	encoder.meta(TypeInternalLinkTypeStickerSet, internalLinkTypeStickerSet.meta)
+	encoder.String("sticker_set_name", internalLinkTypeStickerSet.StickerSetName)
+	encoder.Bool("expect_custom_emoji", internalLinkTypeStickerSet.ExpectCustomEmoji)
+}
+
+func encodeInternalLinkTypeTheme(encoder *encoder, value *InternalLinkTypeTheme) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (internalLinkTypeTheme *InternalLinkTypeTheme) encode(encoder *encoder) {
+	encoder.meta(TypeInternalLinkTypeTheme, internalLinkTypeTheme.meta)
+	encoder.String("theme_name", internalLinkTypeTheme.ThemeName)
+}
+
+func encodeInternalLinkTypeThemeSettings(encoder *encoder, value *InternalLinkTypeThemeSettings) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (internalLinkTypeThemeSettings *InternalLinkTypeThemeSettings) encode(encoder *encoder) {
+	encoder.meta(TypeInternalLinkTypeThemeSettings, internalLinkTypeThemeSettings.meta)
+}
+
+func encodeInternalLinkTypeUnknownDeepLink(encoder *encoder, value *InternalLinkTypeUnknownDeepLink) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (internalLinkTypeUnknownDeepLink *InternalLinkTypeUnknownDeepLink) encode(encoder *encoder) {
+	encoder.meta(TypeInternalLinkTypeUnknownDeepLink, internalLinkTypeUnknownDeepLink.meta)
+	encoder.String("link", internalLinkTypeUnknownDeepLink.Link)
+}
+
+func encodeInternalLinkTypeUnsupportedProxy(encoder *encoder, value *InternalLinkTypeUnsupportedProxy) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (internalLinkTypeUnsupportedProxy *InternalLinkTypeUnsupportedProxy) encode(encoder *encoder) {
+	encoder.meta(TypeInternalLinkTypeUnsupportedProxy, internalLinkTypeUnsupportedProxy.meta)
+}
+
+func encodeInternalLinkTypeUserPhoneNumber(encoder *encoder, value *InternalLinkTypeUserPhoneNumber) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (internalLinkTypeUserPhoneNumber *InternalLinkTypeUserPhoneNumber) encode(encoder *encoder) {
+	encoder.meta(TypeInternalLinkTypeUserPhoneNumber, internalLinkTypeUserPhoneNumber.meta)
+	encoder.String("phone_number", internalLinkTypeUserPhoneNumber.PhoneNumber)
+}
+
+func encodeInternalLinkTypeUserToken(encoder *encoder, value *InternalLinkTypeUserToken) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (internalLinkTypeUserToken *InternalLinkTypeUserToken) encode(encoder *encoder) {
+	encoder.meta(TypeInternalLinkTypeUserToken, internalLinkTypeUserToken.meta)
+	encoder.String("token", internalLinkTypeUserToken.Token)
+}
+
+func encodeInternalLinkTypeVideoChat(encoder *encoder, value *InternalLinkTypeVideoChat) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (internalLinkTypeVideoChat *InternalLinkTypeVideoChat) encode(encoder *encoder) {
+	encoder.meta(TypeInternalLinkTypeVideoChat, internalLinkTypeVideoChat.meta)
+	encoder.String("chat_username", internalLinkTypeVideoChat.ChatUsername)
+	encoder.String("invite_hash", internalLinkTypeVideoChat.InviteHash)
+	encoder.Bool("is_live_stream", internalLinkTypeVideoChat.IsLiveStream)
+}
+
+func encodeInternalLinkTypeWebApp(encoder *encoder, value *InternalLinkTypeWebApp) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (internalLinkTypeWebApp *InternalLinkTypeWebApp) encode(encoder *encoder) {
+	encoder.meta(TypeInternalLinkTypeWebApp, internalLinkTypeWebApp.meta)
+	encoder.String("bot_username", internalLinkTypeWebApp.BotUsername)
+	encoder.String("web_app_short_name", internalLinkTypeWebApp.WebAppShortName)
+	encoder.String("start_parameter", internalLinkTypeWebApp.StartParameter)
+}
+
+func encodeMessageLink(encoder *encoder, value *MessageLink) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageLink *MessageLink) encode(encoder *encoder) {
+	encoder.meta(TypeMessageLink, messageLink.meta)
+	encoder.String("link", messageLink.Link)
+	encoder.Bool("is_public", messageLink.IsPublic)
+}
+
+func encodeMessageLinkInfo(encoder *encoder, value *MessageLinkInfo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageLinkInfo *MessageLinkInfo) encode(encoder *encoder) {
+	encoder.meta(TypeMessageLinkInfo, messageLinkInfo.meta)
+	encoder.Bool("is_public", messageLinkInfo.IsPublic)
+	encoder.Int64("chat_id", messageLinkInfo.ChatId)
+	encoder.Int64("message_thread_id", messageLinkInfo.MessageThreadId)
+	encoder.name("message")
+	encodeMessage(encoder, messageLinkInfo.Message)
+	encoder.Int32("media_timestamp", messageLinkInfo.MediaTimestamp)
+	encoder.Bool("for_album", messageLinkInfo.ForAlbum)
+}
+
+func encodeFilePart(encoder *encoder, value *FilePart) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (filePart *FilePart) encode(encoder *encoder) {
+	encoder.meta(TypeFilePart, filePart.meta)
+	encoder.Bytes("data", filePart.Data)
+}
+
+func encodeFileTypeNone(encoder *encoder, value *FileTypeNone) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (fileTypeNone *FileTypeNone) encode(encoder *encoder) {
+	encoder.meta(TypeFileTypeNone, fileTypeNone.meta)
+}
+
+func encodeFileTypeAnimation(encoder *encoder, value *FileTypeAnimation) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (fileTypeAnimation *FileTypeAnimation) encode(encoder *encoder) {
+	encoder.meta(TypeFileTypeAnimation, fileTypeAnimation.meta)
+}
+
+func encodeFileTypeAudio(encoder *encoder, value *FileTypeAudio) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (fileTypeAudio *FileTypeAudio) encode(encoder *encoder) {
+	encoder.meta(TypeFileTypeAudio, fileTypeAudio.meta)
+}
+
+func encodeFileTypeDocument(encoder *encoder, value *FileTypeDocument) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (fileTypeDocument *FileTypeDocument) encode(encoder *encoder) {
+	encoder.meta(TypeFileTypeDocument, fileTypeDocument.meta)
+}
+
+func encodeFileTypeNotificationSound(encoder *encoder, value *FileTypeNotificationSound) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (fileTypeNotificationSound *FileTypeNotificationSound) encode(encoder *encoder) {
+	encoder.meta(TypeFileTypeNotificationSound, fileTypeNotificationSound.meta)
+}
+
+func encodeFileTypePhoto(encoder *encoder, value *FileTypePhoto) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (fileTypePhoto *FileTypePhoto) encode(encoder *encoder) {
+	encoder.meta(TypeFileTypePhoto, fileTypePhoto.meta)
+}
+
+func encodeFileTypeProfilePhoto(encoder *encoder, value *FileTypeProfilePhoto) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (fileTypeProfilePhoto *FileTypeProfilePhoto) encode(encoder *encoder) {
+	encoder.meta(TypeFileTypeProfilePhoto, fileTypeProfilePhoto.meta)
+}
+
+func encodeFileTypeSecret(encoder *encoder, value *FileTypeSecret) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (fileTypeSecret *FileTypeSecret) encode(encoder *encoder) {
+	encoder.meta(TypeFileTypeSecret, fileTypeSecret.meta)
+}
+
+func encodeFileTypeSecretThumbnail(encoder *encoder, value *FileTypeSecretThumbnail) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (fileTypeSecretThumbnail *FileTypeSecretThumbnail) encode(encoder *encoder) {
+	encoder.meta(TypeFileTypeSecretThumbnail, fileTypeSecretThumbnail.meta)
+}
+
+func encodeFileTypeSecure(encoder *encoder, value *FileTypeSecure) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (fileTypeSecure *FileTypeSecure) encode(encoder *encoder) {
+	encoder.meta(TypeFileTypeSecure, fileTypeSecure.meta)
+}
+
+func encodeFileTypeSticker(encoder *encoder, value *FileTypeSticker) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (fileTypeSticker *FileTypeSticker) encode(encoder *encoder) {
+	encoder.meta(TypeFileTypeSticker, fileTypeSticker.meta)
+}
+
+func encodeFileTypeThumbnail(encoder *encoder, value *FileTypeThumbnail) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (fileTypeThumbnail *FileTypeThumbnail) encode(encoder *encoder) {
+	encoder.meta(TypeFileTypeThumbnail, fileTypeThumbnail.meta)
+}
+
+func encodeFileTypeUnknown(encoder *encoder, value *FileTypeUnknown) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (fileTypeUnknown *FileTypeUnknown) encode(encoder *encoder) {
+	encoder.meta(TypeFileTypeUnknown, fileTypeUnknown.meta)
+}
+
+func encodeFileTypeVideo(encoder *encoder, value *FileTypeVideo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (fileTypeVideo *FileTypeVideo) encode(encoder *encoder) {
+	encoder.meta(TypeFileTypeVideo, fileTypeVideo.meta)
+}
+
+func encodeFileTypeVideoNote(encoder *encoder, value *FileTypeVideoNote) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (fileTypeVideoNote *FileTypeVideoNote) encode(encoder *encoder) {
+	encoder.meta(TypeFileTypeVideoNote, fileTypeVideoNote.meta)
+}
+
+func encodeFileTypeVoiceNote(encoder *encoder, value *FileTypeVoiceNote) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (fileTypeVoiceNote *FileTypeVoiceNote) encode(encoder *encoder) {
+	encoder.meta(TypeFileTypeVoiceNote, fileTypeVoiceNote.meta)
+}
+
+func encodeFileTypeWallpaper(encoder *encoder, value *FileTypeWallpaper) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (fileTypeWallpaper *FileTypeWallpaper) encode(encoder *encoder) {
+	encoder.meta(TypeFileTypeWallpaper, fileTypeWallpaper.meta)
+}
+
+func encodeStorageStatisticsByFileType(encoder *encoder, value *StorageStatisticsByFileType) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (storageStatisticsByFileType *StorageStatisticsByFileType) encode(encoder *encoder) {
+	encoder.meta(TypeStorageStatisticsByFileType, storageStatisticsByFileType.meta)
+	encoder.name("file_type")
+	encodeFileType(encoder, storageStatisticsByFileType.FileType)
+	encoder.Int64("size", storageStatisticsByFileType.Size)
+	encoder.Int32("count", storageStatisticsByFileType.Count)
+}
+
+func encodeStorageStatisticsByChat(encoder *encoder, value *StorageStatisticsByChat) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (storageStatisticsByChat *StorageStatisticsByChat) encode(encoder *encoder) {
+	encoder.meta(TypeStorageStatisticsByChat, storageStatisticsByChat.meta)
+	encoder.Int64("chat_id", storageStatisticsByChat.ChatId)
+	encoder.Int64("size", storageStatisticsByChat.Size)
+	encoder.Int32("count", storageStatisticsByChat.Count)
+	encoder.name("by_file_type")
+	encodeListOfStorageStatisticsByFileType(encoder, storageStatisticsByChat.ByFileType)
+}
+
+func encodeStorageStatistics(encoder *encoder, value *StorageStatistics) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (storageStatistics *StorageStatistics) encode(encoder *encoder) {
+	encoder.meta(TypeStorageStatistics, storageStatistics.meta)
+	encoder.Int64("size", storageStatistics.Size)
+	encoder.Int32("count", storageStatistics.Count)
+	encoder.name("by_chat")
+	encodeListOfStorageStatisticsByChat(encoder, storageStatistics.ByChat)
+}
+
+func encodeStorageStatisticsFast(encoder *encoder, value *StorageStatisticsFast) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (storageStatisticsFast *StorageStatisticsFast) encode(encoder *encoder) {
+	encoder.meta(TypeStorageStatisticsFast, storageStatisticsFast.meta)
+	encoder.Int64("files_size", storageStatisticsFast.FilesSize)
+	encoder.Int32("file_count", storageStatisticsFast.FileCount)
+	encoder.Int64("database_size", storageStatisticsFast.DatabaseSize)
+	encoder.Int64("language_pack_database_size", storageStatisticsFast.LanguagePackDatabaseSize)
+	encoder.Int64("log_size", storageStatisticsFast.LogSize)
+}
+
+func encodeDatabaseStatistics(encoder *encoder, value *DatabaseStatistics) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (databaseStatistics *DatabaseStatistics) encode(encoder *encoder) {
+	encoder.meta(TypeDatabaseStatistics, databaseStatistics.meta)
+	encoder.String("statistics", databaseStatistics.Statistics)
+}
+
+func encodeMemoryStatistics(encoder *encoder, value *MemoryStatistics) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (memoryStatistics *MemoryStatistics) encode(encoder *encoder) {
+	encoder.meta(TypeMemoryStatistics, memoryStatistics.meta)
+	encoder.String("statistics", memoryStatistics.Statistics)
+}
+
+func encodeNetworkTypeNone(encoder *encoder, value *NetworkTypeNone) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (networkTypeNone *NetworkTypeNone) encode(encoder *encoder) {
+	encoder.meta(TypeNetworkTypeNone, networkTypeNone.meta)
+}
+
+func encodeNetworkTypeMobile(encoder *encoder, value *NetworkTypeMobile) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (networkTypeMobile *NetworkTypeMobile) encode(encoder *encoder) {
+	encoder.meta(TypeNetworkTypeMobile, networkTypeMobile.meta)
+}
+
+func encodeNetworkTypeMobileRoaming(encoder *encoder, value *NetworkTypeMobileRoaming) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (networkTypeMobileRoaming *NetworkTypeMobileRoaming) encode(encoder *encoder) {
+	encoder.meta(TypeNetworkTypeMobileRoaming, networkTypeMobileRoaming.meta)
+}
+
+func encodeNetworkTypeWiFi(encoder *encoder, value *NetworkTypeWiFi) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (networkTypeWiFi *NetworkTypeWiFi) encode(encoder *encoder) {
+	encoder.meta(TypeNetworkTypeWiFi, networkTypeWiFi.meta)
+}
+
+func encodeNetworkTypeOther(encoder *encoder, value *NetworkTypeOther) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (networkTypeOther *NetworkTypeOther) encode(encoder *encoder) {
+	encoder.meta(TypeNetworkTypeOther, networkTypeOther.meta)
+}
+
+func encodeNetworkStatisticsEntryFile(encoder *encoder, value *NetworkStatisticsEntryFile) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (networkStatisticsEntryFile *NetworkStatisticsEntryFile) encode(encoder *encoder) {
+	encoder.meta(TypeNetworkStatisticsEntryFile, networkStatisticsEntryFile.meta)
+	encoder.name("file_type")
+	encodeFileType(encoder, networkStatisticsEntryFile.FileType)
+	encoder.name("network_type")
+	encodeNetworkType(encoder, networkStatisticsEntryFile.NetworkType)
+	encoder.Int64("sent_bytes", networkStatisticsEntryFile.SentBytes)
+	encoder.Int64("received_bytes", networkStatisticsEntryFile.ReceivedBytes)
+}
+
+func encodeNetworkStatisticsEntryCall(encoder *encoder, value *NetworkStatisticsEntryCall) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (networkStatisticsEntryCall *NetworkStatisticsEntryCall) encode(encoder *encoder) {
+	encoder.meta(TypeNetworkStatisticsEntryCall, networkStatisticsEntryCall.meta)
+	encoder.name("network_type")
+	encodeNetworkType(encoder, networkStatisticsEntryCall.NetworkType)
+	encoder.Int64("sent_bytes", networkStatisticsEntryCall.SentBytes)
+	encoder.Int64("received_bytes", networkStatisticsEntryCall.ReceivedBytes)
+	encoder.Float64("duration", networkStatisticsEntryCall.Duration)
+}
+
+func encodeNetworkStatistics(encoder *encoder, value *NetworkStatistics) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (networkStatistics *NetworkStatistics) encode(encoder *encoder) {
+	encoder.meta(TypeNetworkStatistics, networkStatistics.meta)
+	encoder.Int32("since_date", networkStatistics.SinceDate)
+	encoder.name("entries")
+	encodeListOfNetworkStatisticsEntry(encoder, networkStatistics.Entries)
+}
+
+func encodeAutoDownloadSettings(encoder *encoder, value *AutoDownloadSettings) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (autoDownloadSettings *AutoDownloadSettings) encode(encoder *encoder) {
+	encoder.meta(TypeAutoDownloadSettings, autoDownloadSettings.meta)
+	encoder.Bool("is_auto_download_enabled", autoDownloadSettings.IsAutoDownloadEnabled)
+	encoder.Int32("max_photo_file_size", autoDownloadSettings.MaxPhotoFileSize)
+	encoder.Int64("max_video_file_size", autoDownloadSettings.MaxVideoFileSize)
+	encoder.Int64("max_other_file_size", autoDownloadSettings.MaxOtherFileSize)
+	encoder.Int32("video_upload_bitrate", autoDownloadSettings.VideoUploadBitrate)
+	encoder.Bool("preload_large_videos", autoDownloadSettings.PreloadLargeVideos)
+	encoder.Bool("preload_next_audio", autoDownloadSettings.PreloadNextAudio)
+	encoder.Bool("use_less_data_for_calls", autoDownloadSettings.UseLessDataForCalls)
+}
+
+func encodeAutoDownloadSettingsPresets(encoder *encoder, value *AutoDownloadSettingsPresets) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (autoDownloadSettingsPresets *AutoDownloadSettingsPresets) encode(encoder *encoder) {
+	encoder.meta(TypeAutoDownloadSettingsPresets, autoDownloadSettingsPresets.meta)
+	encoder.name("low")
+	encodeAutoDownloadSettings(encoder, autoDownloadSettingsPresets.Low)
+	encoder.name("medium")
+	encodeAutoDownloadSettings(encoder, autoDownloadSettingsPresets.Medium)
+	encoder.name("high")
+	encodeAutoDownloadSettings(encoder, autoDownloadSettingsPresets.High)
+}
+
+func encodeAutosaveSettingsScopePrivateChats(encoder *encoder, value *AutosaveSettingsScopePrivateChats) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (autosaveSettingsScopePrivateChats *AutosaveSettingsScopePrivateChats) encode(encoder *encoder) {
+	encoder.meta(TypeAutosaveSettingsScopePrivateChats, autosaveSettingsScopePrivateChats.meta)
+}
+
+func encodeAutosaveSettingsScopeGroupChats(encoder *encoder, value *AutosaveSettingsScopeGroupChats) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (autosaveSettingsScopeGroupChats *AutosaveSettingsScopeGroupChats) encode(encoder *encoder) {
+	encoder.meta(TypeAutosaveSettingsScopeGroupChats, autosaveSettingsScopeGroupChats.meta)
+}
+
+func encodeAutosaveSettingsScopeChannelChats(encoder *encoder, value *AutosaveSettingsScopeChannelChats) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (autosaveSettingsScopeChannelChats *AutosaveSettingsScopeChannelChats) encode(encoder *encoder) {
+	encoder.meta(TypeAutosaveSettingsScopeChannelChats, autosaveSettingsScopeChannelChats.meta)
+}
+
+func encodeAutosaveSettingsScopeChat(encoder *encoder, value *AutosaveSettingsScopeChat) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (autosaveSettingsScopeChat *AutosaveSettingsScopeChat) encode(encoder *encoder) {
+	encoder.meta(TypeAutosaveSettingsScopeChat, autosaveSettingsScopeChat.meta)
+	encoder.Int64("chat_id", autosaveSettingsScopeChat.ChatId)
+}
+
+func encodeScopeAutosaveSettings(encoder *encoder, value *ScopeAutosaveSettings) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (scopeAutosaveSettings *ScopeAutosaveSettings) encode(encoder *encoder) {
+	encoder.meta(TypeScopeAutosaveSettings, scopeAutosaveSettings.meta)
+	encoder.Bool("autosave_photos", scopeAutosaveSettings.AutosavePhotos)
+	encoder.Bool("autosave_videos", scopeAutosaveSettings.AutosaveVideos)
+	encoder.Int64("max_video_file_size", scopeAutosaveSettings.MaxVideoFileSize)
+}
+
+func encodeAutosaveSettingsException(encoder *encoder, value *AutosaveSettingsException) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (autosaveSettingsException *AutosaveSettingsException) encode(encoder *encoder) {
+	encoder.meta(TypeAutosaveSettingsException, autosaveSettingsException.meta)
+	encoder.Int64("chat_id", autosaveSettingsException.ChatId)
+	encoder.name("settings")
+	encodeScopeAutosaveSettings(encoder, autosaveSettingsException.Settings)
+}
+
+func encodeAutosaveSettings(encoder *encoder, value *AutosaveSettings) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (autosaveSettings *AutosaveSettings) encode(encoder *encoder) {
+	encoder.meta(TypeAutosaveSettings, autosaveSettings.meta)
+	encoder.name("private_chat_settings")
+	encodeScopeAutosaveSettings(encoder, autosaveSettings.PrivateChatSettings)
+	encoder.name("group_settings")
+	encodeScopeAutosaveSettings(encoder, autosaveSettings.GroupSettings)
+	encoder.name("channel_settings")
+	encodeScopeAutosaveSettings(encoder, autosaveSettings.ChannelSettings)
+	encoder.name("exceptions")
+	encodeListOfAutosaveSettingsException(encoder, autosaveSettings.Exceptions)
+}
+
+func encodeConnectionStateWaitingForNetwork(encoder *encoder, value *ConnectionStateWaitingForNetwork) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (connectionStateWaitingForNetwork *ConnectionStateWaitingForNetwork) encode(encoder *encoder) {
+	encoder.meta(TypeConnectionStateWaitingForNetwork, connectionStateWaitingForNetwork.meta)
+}
+
+func encodeConnectionStateConnectingToProxy(encoder *encoder, value *ConnectionStateConnectingToProxy) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (connectionStateConnectingToProxy *ConnectionStateConnectingToProxy) encode(encoder *encoder) {
+	encoder.meta(TypeConnectionStateConnectingToProxy, connectionStateConnectingToProxy.meta)
+}
+
+func encodeConnectionStateConnecting(encoder *encoder, value *ConnectionStateConnecting) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (connectionStateConnecting *ConnectionStateConnecting) encode(encoder *encoder) {
+	encoder.meta(TypeConnectionStateConnecting, connectionStateConnecting.meta)
+}
+
+func encodeConnectionStateUpdating(encoder *encoder, value *ConnectionStateUpdating) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (connectionStateUpdating *ConnectionStateUpdating) encode(encoder *encoder) {
+	encoder.meta(TypeConnectionStateUpdating, connectionStateUpdating.meta)
+}
+
+func encodeConnectionStateReady(encoder *encoder, value *ConnectionStateReady) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (connectionStateReady *ConnectionStateReady) encode(encoder *encoder) {
+	encoder.meta(TypeConnectionStateReady, connectionStateReady.meta)
+}
+
+func encodeTopChatCategoryUsers(encoder *encoder, value *TopChatCategoryUsers) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (topChatCategoryUsers *TopChatCategoryUsers) encode(encoder *encoder) {
+	encoder.meta(TypeTopChatCategoryUsers, topChatCategoryUsers.meta)
+}
+
+func encodeTopChatCategoryBots(encoder *encoder, value *TopChatCategoryBots) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (topChatCategoryBots *TopChatCategoryBots) encode(encoder *encoder) {
+	encoder.meta(TypeTopChatCategoryBots, topChatCategoryBots.meta)
+}
+
+func encodeTopChatCategoryGroups(encoder *encoder, value *TopChatCategoryGroups) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (topChatCategoryGroups *TopChatCategoryGroups) encode(encoder *encoder) {
+	encoder.meta(TypeTopChatCategoryGroups, topChatCategoryGroups.meta)
+}
+
+func encodeTopChatCategoryChannels(encoder *encoder, value *TopChatCategoryChannels) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (topChatCategoryChannels *TopChatCategoryChannels) encode(encoder *encoder) {
+	encoder.meta(TypeTopChatCategoryChannels, topChatCategoryChannels.meta)
+}
+
+func encodeTopChatCategoryInlineBots(encoder *encoder, value *TopChatCategoryInlineBots) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (topChatCategoryInlineBots *TopChatCategoryInlineBots) encode(encoder *encoder) {
+	encoder.meta(TypeTopChatCategoryInlineBots, topChatCategoryInlineBots.meta)
+}
+
+func encodeTopChatCategoryCalls(encoder *encoder, value *TopChatCategoryCalls) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (topChatCategoryCalls *TopChatCategoryCalls) encode(encoder *encoder) {
+	encoder.meta(TypeTopChatCategoryCalls, topChatCategoryCalls.meta)
+}
+
+func encodeTopChatCategoryForwardChats(encoder *encoder, value *TopChatCategoryForwardChats) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (topChatCategoryForwardChats *TopChatCategoryForwardChats) encode(encoder *encoder) {
+	encoder.meta(TypeTopChatCategoryForwardChats, topChatCategoryForwardChats.meta)
+}
+
+func encodeTMeUrlTypeUser(encoder *encoder, value *TMeUrlTypeUser) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (tMeUrlTypeUser *TMeUrlTypeUser) encode(encoder *encoder) {
+	encoder.meta(TypeTMeUrlTypeUser, tMeUrlTypeUser.meta)
+	encoder.Int64("user_id", tMeUrlTypeUser.UserId)
+}
+
+func encodeTMeUrlTypeSupergroup(encoder *encoder, value *TMeUrlTypeSupergroup) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (tMeUrlTypeSupergroup *TMeUrlTypeSupergroup) encode(encoder *encoder) {
+	encoder.meta(TypeTMeUrlTypeSupergroup, tMeUrlTypeSupergroup.meta)
+	encoder.Int64("supergroup_id", tMeUrlTypeSupergroup.SupergroupId)
+}
+
+func encodeTMeUrlTypeChatInvite(encoder *encoder, value *TMeUrlTypeChatInvite) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (tMeUrlTypeChatInvite *TMeUrlTypeChatInvite) encode(encoder *encoder) {
+	encoder.meta(TypeTMeUrlTypeChatInvite, tMeUrlTypeChatInvite.meta)
+	encoder.name("info")
+	encodeChatInviteLinkInfo(encoder, tMeUrlTypeChatInvite.Info)
+}
+
+func encodeTMeUrlTypeStickerSet(encoder *encoder, value *TMeUrlTypeStickerSet) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (tMeUrlTypeStickerSet *TMeUrlTypeStickerSet) encode(encoder *encoder) {
+	encoder.meta(TypeTMeUrlTypeStickerSet, tMeUrlTypeStickerSet.meta)
+	encoder.JsonInt64("sticker_set_id", tMeUrlTypeStickerSet.StickerSetId)
+}
+
+func encodeTMeUrl(encoder *encoder, value *TMeUrl) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (tMeUrl *TMeUrl) encode(encoder *encoder) {
+	encoder.meta(TypeTMeUrl, tMeUrl.meta)
+	encoder.String("url", tMeUrl.Url)
+	encoder.name("type")
+	encodeTMeUrlType(encoder, tMeUrl.Type)
+}
+
+func encodeTMeUrls(encoder *encoder, value *TMeUrls) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (tMeUrls *TMeUrls) encode(encoder *encoder) {
+	encoder.meta(TypeTMeUrls, tMeUrls.meta)
+	encoder.name("urls")
+	encodeListOfTMeUrl(encoder, tMeUrls.Urls)
+}
+
+func encodeSuggestedActionEnableArchiveAndMuteNewChats(encoder *encoder, value *SuggestedActionEnableArchiveAndMuteNewChats) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (suggestedActionEnableArchiveAndMuteNewChats *SuggestedActionEnableArchiveAndMuteNewChats) encode(encoder *encoder) {
+	encoder.meta(TypeSuggestedActionEnableArchiveAndMuteNewChats, suggestedActionEnableArchiveAndMuteNewChats.meta)
+}
+
+func encodeSuggestedActionCheckPassword(encoder *encoder, value *SuggestedActionCheckPassword) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (suggestedActionCheckPassword *SuggestedActionCheckPassword) encode(encoder *encoder) {
+	encoder.meta(TypeSuggestedActionCheckPassword, suggestedActionCheckPassword.meta)
+}
+
+func encodeSuggestedActionCheckPhoneNumber(encoder *encoder, value *SuggestedActionCheckPhoneNumber) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (suggestedActionCheckPhoneNumber *SuggestedActionCheckPhoneNumber) encode(encoder *encoder) {
+	encoder.meta(TypeSuggestedActionCheckPhoneNumber, suggestedActionCheckPhoneNumber.meta)
+}
+
+func encodeSuggestedActionViewChecksHint(encoder *encoder, value *SuggestedActionViewChecksHint) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (suggestedActionViewChecksHint *SuggestedActionViewChecksHint) encode(encoder *encoder) {
+	encoder.meta(TypeSuggestedActionViewChecksHint, suggestedActionViewChecksHint.meta)
+}
+
+func encodeSuggestedActionConvertToBroadcastGroup(encoder *encoder, value *SuggestedActionConvertToBroadcastGroup) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (suggestedActionConvertToBroadcastGroup *SuggestedActionConvertToBroadcastGroup) encode(encoder *encoder) {
+	encoder.meta(TypeSuggestedActionConvertToBroadcastGroup, suggestedActionConvertToBroadcastGroup.meta)
+	encoder.Int64("supergroup_id", suggestedActionConvertToBroadcastGroup.SupergroupId)
+}
+
+func encodeSuggestedActionSetPassword(encoder *encoder, value *SuggestedActionSetPassword) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (suggestedActionSetPassword *SuggestedActionSetPassword) encode(encoder *encoder) {
+	encoder.meta(TypeSuggestedActionSetPassword, suggestedActionSetPassword.meta)
+	encoder.Int32("authorization_delay", suggestedActionSetPassword.AuthorizationDelay)
+}
+
+func encodeSuggestedActionUpgradePremium(encoder *encoder, value *SuggestedActionUpgradePremium) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (suggestedActionUpgradePremium *SuggestedActionUpgradePremium) encode(encoder *encoder) {
+	encoder.meta(TypeSuggestedActionUpgradePremium, suggestedActionUpgradePremium.meta)
+}
+
+func encodeSuggestedActionSubscribeToAnnualPremium(encoder *encoder, value *SuggestedActionSubscribeToAnnualPremium) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (suggestedActionSubscribeToAnnualPremium *SuggestedActionSubscribeToAnnualPremium) encode(encoder *encoder) {
+	encoder.meta(TypeSuggestedActionSubscribeToAnnualPremium, suggestedActionSubscribeToAnnualPremium.meta)
+}
+
+func encodeCount(encoder *encoder, value *Count) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (count *Count) encode(encoder *encoder) {
+	encoder.meta(TypeCount, count.meta)
+	encoder.Int32("count", count.Count)
+}
+
+func encodeText(encoder *encoder, value *Text) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (text *Text) encode(encoder *encoder) {
+	encoder.meta(TypeText, text.meta)
+	encoder.String("text", text.Text)
+}
+
+func encodeSeconds(encoder *encoder, value *Seconds) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (seconds *Seconds) encode(encoder *encoder) {
+	encoder.meta(TypeSeconds, seconds.meta)
+	encoder.Float64("seconds", seconds.Seconds)
+}
+
+func encodeFileDownloadedPrefixSize(encoder *encoder, value *FileDownloadedPrefixSize) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (fileDownloadedPrefixSize *FileDownloadedPrefixSize) encode(encoder *encoder) {
+	encoder.meta(TypeFileDownloadedPrefixSize, fileDownloadedPrefixSize.meta)
+	encoder.Int64("size", fileDownloadedPrefixSize.Size)
+}
+
+func encodeDeepLinkInfo(encoder *encoder, value *DeepLinkInfo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (deepLinkInfo *DeepLinkInfo) encode(encoder *encoder) {
+	encoder.meta(TypeDeepLinkInfo, deepLinkInfo.meta)
+	encoder.name("text")
+	encodeFormattedText(encoder, deepLinkInfo.Text)
+	encoder.Bool("need_update_application", deepLinkInfo.NeedUpdateApplication)
+}
+
+func encodeTextParseModeMarkdown(encoder *encoder, value *TextParseModeMarkdown) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (textParseModeMarkdown *TextParseModeMarkdown) encode(encoder *encoder) {
+	encoder.meta(TypeTextParseModeMarkdown, textParseModeMarkdown.meta)
+	encoder.Int32("version", textParseModeMarkdown.Version)
+}
+
+func encodeTextParseModeHTML(encoder *encoder, value *TextParseModeHTML) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (textParseModeHTML *TextParseModeHTML) encode(encoder *encoder) {
+	encoder.meta(TypeTextParseModeHTML, textParseModeHTML.meta)
+}
+
+func encodeProxyTypeSocks5(encoder *encoder, value *ProxyTypeSocks5) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (proxyTypeSocks5 *ProxyTypeSocks5) encode(encoder *encoder) {
+	encoder.meta(TypeProxyTypeSocks5, proxyTypeSocks5.meta)
+	encoder.String("username", proxyTypeSocks5.Username)
+	encoder.String("password", proxyTypeSocks5.Password)
+}
+
+func encodeProxyTypeHttp(encoder *encoder, value *ProxyTypeHttp) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (proxyTypeHttp *ProxyTypeHttp) encode(encoder *encoder) {
+	encoder.meta(TypeProxyTypeHttp, proxyTypeHttp.meta)
+	encoder.String("username", proxyTypeHttp.Username)
+	encoder.String("password", proxyTypeHttp.Password)
+	encoder.Bool("http_only", proxyTypeHttp.HttpOnly)
+}
+
+func encodeProxyTypeMtproto(encoder *encoder, value *ProxyTypeMtproto) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (proxyTypeMtproto *ProxyTypeMtproto) encode(encoder *encoder) {
+	encoder.meta(TypeProxyTypeMtproto, proxyTypeMtproto.meta)
+	encoder.String("secret", proxyTypeMtproto.Secret)
+}
+
+func encodeProxy(encoder *encoder, value *Proxy) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (proxy *Proxy) encode(encoder *encoder) {
+	encoder.meta(TypeProxy, proxy.meta)
+	encoder.Int32("id", proxy.Id)
+	encoder.String("server", proxy.Server)
+	encoder.Int32("port", proxy.Port)
+	encoder.Int32("last_used_date", proxy.LastUsedDate)
+	encoder.Bool("is_enabled", proxy.IsEnabled)
+	encoder.name("type")
+	encodeProxyType(encoder, proxy.Type)
+}
+
+func encodeProxies(encoder *encoder, value *Proxies) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (proxies *Proxies) encode(encoder *encoder) {
+	encoder.meta(TypeProxies, proxies.meta)
+	encoder.name("proxies")
+	encodeListOfProxy(encoder, proxies.Proxies)
+}
+
+func encodeInputSticker(encoder *encoder, value *InputSticker) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (inputSticker *InputSticker) encode(encoder *encoder) {
+	encoder.meta(TypeInputSticker, inputSticker.meta)
+	encoder.name("sticker")
+	encodeInputFile(encoder, inputSticker.Sticker)
+	encoder.String("emojis", inputSticker.Emojis)
+	encoder.name("mask_position")
+	encodeMaskPosition(encoder, inputSticker.MaskPosition)
+	encoder.Strings("keywords", inputSticker.Keywords)
+}
+
+func encodeDateRange(encoder *encoder, value *DateRange) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (dateRange *DateRange) encode(encoder *encoder) {
+	encoder.meta(TypeDateRange, dateRange.meta)
+	encoder.Int32("start_date", dateRange.StartDate)
+	encoder.Int32("end_date", dateRange.EndDate)
+}
+
+func encodeStatisticalValue(encoder *encoder, value *StatisticalValue) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (statisticalValue *StatisticalValue) encode(encoder *encoder) {
+	encoder.meta(TypeStatisticalValue, statisticalValue.meta)
+	encoder.Float64("value", statisticalValue.Value)
+	encoder.Float64("previous_value", statisticalValue.PreviousValue)
+	encoder.Float64("growth_rate_percentage", statisticalValue.GrowthRatePercentage)
+}
+
+func encodeStatisticalGraphData(encoder *encoder, value *StatisticalGraphData) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (statisticalGraphData *StatisticalGraphData) encode(encoder *encoder) {
+	encoder.meta(TypeStatisticalGraphData, statisticalGraphData.meta)
+	encoder.String("json_data", statisticalGraphData.JsonData)
+	encoder.String("zoom_token", statisticalGraphData.ZoomToken)
+}
+
+func encodeStatisticalGraphAsync(encoder *encoder, value *StatisticalGraphAsync) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (statisticalGraphAsync *StatisticalGraphAsync) encode(encoder *encoder) {
+	encoder.meta(TypeStatisticalGraphAsync, statisticalGraphAsync.meta)
+	encoder.String("token", statisticalGraphAsync.Token)
+}
+
+func encodeStatisticalGraphError(encoder *encoder, value *StatisticalGraphError) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (statisticalGraphError *StatisticalGraphError) encode(encoder *encoder) {
+	encoder.meta(TypeStatisticalGraphError, statisticalGraphError.meta)
+	encoder.String("error_message", statisticalGraphError.ErrorMessage)
+}
+
+func encodeChatStatisticsMessageInteractionInfo(encoder *encoder, value *ChatStatisticsMessageInteractionInfo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatStatisticsMessageInteractionInfo *ChatStatisticsMessageInteractionInfo) encode(encoder *encoder) {
+	encoder.meta(TypeChatStatisticsMessageInteractionInfo, chatStatisticsMessageInteractionInfo.meta)
+	encoder.Int64("message_id", chatStatisticsMessageInteractionInfo.MessageId)
+	encoder.Int32("view_count", chatStatisticsMessageInteractionInfo.ViewCount)
+	encoder.Int32("forward_count", chatStatisticsMessageInteractionInfo.ForwardCount)
+}
+
+func encodeChatStatisticsMessageSenderInfo(encoder *encoder, value *ChatStatisticsMessageSenderInfo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatStatisticsMessageSenderInfo *ChatStatisticsMessageSenderInfo) encode(encoder *encoder) {
+	encoder.meta(TypeChatStatisticsMessageSenderInfo, chatStatisticsMessageSenderInfo.meta)
+	encoder.Int64("user_id", chatStatisticsMessageSenderInfo.UserId)
+	encoder.Int32("sent_message_count", chatStatisticsMessageSenderInfo.SentMessageCount)
+	encoder.Int32("average_character_count", chatStatisticsMessageSenderInfo.AverageCharacterCount)
+}
+
+func encodeChatStatisticsAdministratorActionsInfo(encoder *encoder, value *ChatStatisticsAdministratorActionsInfo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatStatisticsAdministratorActionsInfo *ChatStatisticsAdministratorActionsInfo) encode(encoder *encoder) {
+	encoder.meta(TypeChatStatisticsAdministratorActionsInfo, chatStatisticsAdministratorActionsInfo.meta)
+	encoder.Int64("user_id", chatStatisticsAdministratorActionsInfo.UserId)
+	encoder.Int32("deleted_message_count", chatStatisticsAdministratorActionsInfo.DeletedMessageCount)
+	encoder.Int32("banned_user_count", chatStatisticsAdministratorActionsInfo.BannedUserCount)
+	encoder.Int32("restricted_user_count", chatStatisticsAdministratorActionsInfo.RestrictedUserCount)
+}
+
+func encodeChatStatisticsInviterInfo(encoder *encoder, value *ChatStatisticsInviterInfo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatStatisticsInviterInfo *ChatStatisticsInviterInfo) encode(encoder *encoder) {
+	encoder.meta(TypeChatStatisticsInviterInfo, chatStatisticsInviterInfo.meta)
+	encoder.Int64("user_id", chatStatisticsInviterInfo.UserId)
+	encoder.Int32("added_member_count", chatStatisticsInviterInfo.AddedMemberCount)
+}
+
+func encodeChatStatisticsSupergroup(encoder *encoder, value *ChatStatisticsSupergroup) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatStatisticsSupergroup *ChatStatisticsSupergroup) encode(encoder *encoder) {
+	encoder.meta(TypeChatStatisticsSupergroup, chatStatisticsSupergroup.meta)
+	encoder.name("period")
+	encodeDateRange(encoder, chatStatisticsSupergroup.Period)
+	encoder.name("member_count")
+	encodeStatisticalValue(encoder, chatStatisticsSupergroup.MemberCount)
+	encoder.name("message_count")
+	encodeStatisticalValue(encoder, chatStatisticsSupergroup.MessageCount)
+	encoder.name("viewer_count")
+	encodeStatisticalValue(encoder, chatStatisticsSupergroup.ViewerCount)
+	encoder.name("sender_count")
+	encodeStatisticalValue(encoder, chatStatisticsSupergroup.SenderCount)
+	encoder.name("member_count_graph")
+	encodeStatisticalGraph(encoder, chatStatisticsSupergroup.MemberCountGraph)
+	encoder.name("join_graph")
+	encodeStatisticalGraph(encoder, chatStatisticsSupergroup.JoinGraph)
+	encoder.name("join_by_source_graph")
+	encodeStatisticalGraph(encoder, chatStatisticsSupergroup.JoinBySourceGraph)
+	encoder.name("language_graph")
+	encodeStatisticalGraph(encoder, chatStatisticsSupergroup.LanguageGraph)
+	encoder.name("message_content_graph")
+	encodeStatisticalGraph(encoder, chatStatisticsSupergroup.MessageContentGraph)
+	encoder.name("action_graph")
+	encodeStatisticalGraph(encoder, chatStatisticsSupergroup.ActionGraph)
+	encoder.name("day_graph")
+	encodeStatisticalGraph(encoder, chatStatisticsSupergroup.DayGraph)
+	encoder.name("week_graph")
+	encodeStatisticalGraph(encoder, chatStatisticsSupergroup.WeekGraph)
+	encoder.name("top_senders")
+	encodeListOfChatStatisticsMessageSenderInfo(encoder, chatStatisticsSupergroup.TopSenders)
+	encoder.name("top_administrators")
+	encodeListOfChatStatisticsAdministratorActionsInfo(encoder, chatStatisticsSupergroup.TopAdministrators)
+	encoder.name("top_inviters")
+	encodeListOfChatStatisticsInviterInfo(encoder, chatStatisticsSupergroup.TopInviters)
+}
+
+func encodeChatStatisticsChannel(encoder *encoder, value *ChatStatisticsChannel) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (chatStatisticsChannel *ChatStatisticsChannel) encode(encoder *encoder) {
+	encoder.meta(TypeChatStatisticsChannel, chatStatisticsChannel.meta)
+	encoder.name("period")
+	encodeDateRange(encoder, chatStatisticsChannel.Period)
+	encoder.name("member_count")
+	encodeStatisticalValue(encoder, chatStatisticsChannel.MemberCount)
+	encoder.name("mean_view_count")
+	encodeStatisticalValue(encoder, chatStatisticsChannel.MeanViewCount)
+	encoder.name("mean_share_count")
+	encodeStatisticalValue(encoder, chatStatisticsChannel.MeanShareCount)
+	encoder.Float64("enabled_notifications_percentage", chatStatisticsChannel.EnabledNotificationsPercentage)
+	encoder.name("member_count_graph")
+	encodeStatisticalGraph(encoder, chatStatisticsChannel.MemberCountGraph)
+	encoder.name("join_graph")
+	encodeStatisticalGraph(encoder, chatStatisticsChannel.JoinGraph)
+	encoder.name("mute_graph")
+	encodeStatisticalGraph(encoder, chatStatisticsChannel.MuteGraph)
+	encoder.name("view_count_by_hour_graph")
+	encodeStatisticalGraph(encoder, chatStatisticsChannel.ViewCountByHourGraph)
+	encoder.name("view_count_by_source_graph")
+	encodeStatisticalGraph(encoder, chatStatisticsChannel.ViewCountBySourceGraph)
+	encoder.name("join_by_source_graph")
+	encodeStatisticalGraph(encoder, chatStatisticsChannel.JoinBySourceGraph)
+	encoder.name("language_graph")
+	encodeStatisticalGraph(encoder, chatStatisticsChannel.LanguageGraph)
+	encoder.name("message_interaction_graph")
+	encodeStatisticalGraph(encoder, chatStatisticsChannel.MessageInteractionGraph)
+	encoder.name("instant_view_interaction_graph")
+	encodeStatisticalGraph(encoder, chatStatisticsChannel.InstantViewInteractionGraph)
+	encoder.name("recent_message_interactions")
+	encodeListOfChatStatisticsMessageInteractionInfo(encoder, chatStatisticsChannel.RecentMessageInteractions)
+}
+
+func encodeMessageStatistics(encoder *encoder, value *MessageStatistics) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (messageStatistics *MessageStatistics) encode(encoder *encoder) {
+	encoder.meta(TypeMessageStatistics, messageStatistics.meta)
+	encoder.name("message_interaction_graph")
+	encodeStatisticalGraph(encoder, messageStatistics.MessageInteractionGraph)
+}
+
+func encodePoint(encoder *encoder, value *Point) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (point *Point) encode(encoder *encoder) {
+	encoder.meta(TypePoint, point.meta)
+	encoder.Float64("x", point.X)
+	encoder.Float64("y", point.Y)
+}
+
+func encodeVectorPathCommandLine(encoder *encoder, value *VectorPathCommandLine) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (vectorPathCommandLine *VectorPathCommandLine) encode(encoder *encoder) {
+	encoder.meta(TypeVectorPathCommandLine, vectorPathCommandLine.meta)
+	encoder.name("end_point")
+	encodePoint(encoder, vectorPathCommandLine.EndPoint)
+}
+
+func encodeVectorPathCommandCubicBezierCurve(encoder *encoder, value *VectorPathCommandCubicBezierCurve) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (vectorPathCommandCubicBezierCurve *VectorPathCommandCubicBezierCurve) encode(encoder *encoder) {
+	encoder.meta(TypeVectorPathCommandCubicBezierCurve, vectorPathCommandCubicBezierCurve.meta)
+	encoder.name("start_control_point")
+	encodePoint(encoder, vectorPathCommandCubicBezierCurve.StartControlPoint)
+	encoder.name("end_control_point")
+	encodePoint(encoder, vectorPathCommandCubicBezierCurve.EndControlPoint)
+	encoder.name("end_point")
+	encodePoint(encoder, vectorPathCommandCubicBezierCurve.EndPoint)
+}
+
+func encodeBotCommandScopeDefault(encoder *encoder, value *BotCommandScopeDefault) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (botCommandScopeDefault *BotCommandScopeDefault) encode(encoder *encoder) {
+	encoder.meta(TypeBotCommandScopeDefault, botCommandScopeDefault.meta)
+}
+
+func encodeBotCommandScopeAllPrivateChats(encoder *encoder, value *BotCommandScopeAllPrivateChats) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (botCommandScopeAllPrivateChats *BotCommandScopeAllPrivateChats) encode(encoder *encoder) {
+	encoder.meta(TypeBotCommandScopeAllPrivateChats, botCommandScopeAllPrivateChats.meta)
+}
+
+func encodeBotCommandScopeAllGroupChats(encoder *encoder, value *BotCommandScopeAllGroupChats) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (botCommandScopeAllGroupChats *BotCommandScopeAllGroupChats) encode(encoder *encoder) {
+	encoder.meta(TypeBotCommandScopeAllGroupChats, botCommandScopeAllGroupChats.meta)
+}
+
+func encodeBotCommandScopeAllChatAdministrators(encoder *encoder, value *BotCommandScopeAllChatAdministrators) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (botCommandScopeAllChatAdministrators *BotCommandScopeAllChatAdministrators) encode(encoder *encoder) {
+	encoder.meta(TypeBotCommandScopeAllChatAdministrators, botCommandScopeAllChatAdministrators.meta)
+}
+
+func encodeBotCommandScopeChat(encoder *encoder, value *BotCommandScopeChat) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (botCommandScopeChat *BotCommandScopeChat) encode(encoder *encoder) {
+	encoder.meta(TypeBotCommandScopeChat, botCommandScopeChat.meta)
+	encoder.Int64("chat_id", botCommandScopeChat.ChatId)
+}
+
+func encodeBotCommandScopeChatAdministrators(encoder *encoder, value *BotCommandScopeChatAdministrators) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (botCommandScopeChatAdministrators *BotCommandScopeChatAdministrators) encode(encoder *encoder) {
+	encoder.meta(TypeBotCommandScopeChatAdministrators, botCommandScopeChatAdministrators.meta)
+	encoder.Int64("chat_id", botCommandScopeChatAdministrators.ChatId)
+}
+
+func encodeBotCommandScopeChatMember(encoder *encoder, value *BotCommandScopeChatMember) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (botCommandScopeChatMember *BotCommandScopeChatMember) encode(encoder *encoder) {
+	encoder.meta(TypeBotCommandScopeChatMember, botCommandScopeChatMember.meta)
+	encoder.Int64("chat_id", botCommandScopeChatMember.ChatId)
+	encoder.Int64("user_id", botCommandScopeChatMember.UserId)
+}
+
+func encodeUpdateAuthorizationState(encoder *encoder, value *UpdateAuthorizationState) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateAuthorizationState *UpdateAuthorizationState) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateAuthorizationState, updateAuthorizationState.meta)
+	encoder.name("authorization_state")
+	encodeAuthorizationState(encoder, updateAuthorizationState.AuthorizationState)
+}
+
+func encodeUpdateNewMessage(encoder *encoder, value *UpdateNewMessage) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateNewMessage *UpdateNewMessage) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateNewMessage, updateNewMessage.meta)
+	encoder.name("message")
+	encodeMessage(encoder, updateNewMessage.Message)
+}
+
+func encodeUpdateMessageSendAcknowledged(encoder *encoder, value *UpdateMessageSendAcknowledged) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateMessageSendAcknowledged *UpdateMessageSendAcknowledged) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateMessageSendAcknowledged, updateMessageSendAcknowledged.meta)
+	encoder.Int64("chat_id", updateMessageSendAcknowledged.ChatId)
+	encoder.Int64("message_id", updateMessageSendAcknowledged.MessageId)
+}
+
+func encodeUpdateMessageSendSucceeded(encoder *encoder, value *UpdateMessageSendSucceeded) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateMessageSendSucceeded *UpdateMessageSendSucceeded) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateMessageSendSucceeded, updateMessageSendSucceeded.meta)
+	encoder.name("message")
+	encodeMessage(encoder, updateMessageSendSucceeded.Message)
+	encoder.Int64("old_message_id", updateMessageSendSucceeded.OldMessageId)
+}
+
+func encodeUpdateMessageSendFailed(encoder *encoder, value *UpdateMessageSendFailed) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateMessageSendFailed *UpdateMessageSendFailed) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateMessageSendFailed, updateMessageSendFailed.meta)
+	encoder.name("message")
+	encodeMessage(encoder, updateMessageSendFailed.Message)
+	encoder.Int64("old_message_id", updateMessageSendFailed.OldMessageId)
+	encoder.Int32("error_code", updateMessageSendFailed.ErrorCode)
+	encoder.String("error_message", updateMessageSendFailed.ErrorMessage)
+}
+
+func encodeUpdateMessageContent(encoder *encoder, value *UpdateMessageContent) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateMessageContent *UpdateMessageContent) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateMessageContent, updateMessageContent.meta)
+	encoder.Int64("chat_id", updateMessageContent.ChatId)
+	encoder.Int64("message_id", updateMessageContent.MessageId)
+	encoder.name("new_content")
+	encodeMessageContent(encoder, updateMessageContent.NewContent)
+}
+
+func encodeUpdateMessageEdited(encoder *encoder, value *UpdateMessageEdited) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateMessageEdited *UpdateMessageEdited) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateMessageEdited, updateMessageEdited.meta)
+	encoder.Int64("chat_id", updateMessageEdited.ChatId)
+	encoder.Int64("message_id", updateMessageEdited.MessageId)
+	encoder.Int32("edit_date", updateMessageEdited.EditDate)
+	encoder.name("reply_markup")
+	encodeReplyMarkup(encoder, updateMessageEdited.ReplyMarkup)
+}
+
+func encodeUpdateMessageIsPinned(encoder *encoder, value *UpdateMessageIsPinned) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateMessageIsPinned *UpdateMessageIsPinned) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateMessageIsPinned, updateMessageIsPinned.meta)
+	encoder.Int64("chat_id", updateMessageIsPinned.ChatId)
+	encoder.Int64("message_id", updateMessageIsPinned.MessageId)
+	encoder.Bool("is_pinned", updateMessageIsPinned.IsPinned)
+}
+
+func encodeUpdateMessageInteractionInfo(encoder *encoder, value *UpdateMessageInteractionInfo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateMessageInteractionInfo *UpdateMessageInteractionInfo) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateMessageInteractionInfo, updateMessageInteractionInfo.meta)
+	encoder.Int64("chat_id", updateMessageInteractionInfo.ChatId)
+	encoder.Int64("message_id", updateMessageInteractionInfo.MessageId)
+	encoder.name("interaction_info")
+	encodeMessageInteractionInfo(encoder, updateMessageInteractionInfo.InteractionInfo)
+}
+
+func encodeUpdateMessageContentOpened(encoder *encoder, value *UpdateMessageContentOpened) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateMessageContentOpened *UpdateMessageContentOpened) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateMessageContentOpened, updateMessageContentOpened.meta)
+	encoder.Int64("chat_id", updateMessageContentOpened.ChatId)
+	encoder.Int64("message_id", updateMessageContentOpened.MessageId)
+}
+
+func encodeUpdateMessageMentionRead(encoder *encoder, value *UpdateMessageMentionRead) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateMessageMentionRead *UpdateMessageMentionRead) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateMessageMentionRead, updateMessageMentionRead.meta)
+	encoder.Int64("chat_id", updateMessageMentionRead.ChatId)
+	encoder.Int64("message_id", updateMessageMentionRead.MessageId)
+	encoder.Int32("unread_mention_count", updateMessageMentionRead.UnreadMentionCount)
+}
+
+func encodeUpdateMessageUnreadReactions(encoder *encoder, value *UpdateMessageUnreadReactions) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateMessageUnreadReactions *UpdateMessageUnreadReactions) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateMessageUnreadReactions, updateMessageUnreadReactions.meta)
+	encoder.Int64("chat_id", updateMessageUnreadReactions.ChatId)
+	encoder.Int64("message_id", updateMessageUnreadReactions.MessageId)
+	encoder.name("unread_reactions")
+	encodeListOfUnreadReaction(encoder, updateMessageUnreadReactions.UnreadReactions)
+	encoder.Int32("unread_reaction_count", updateMessageUnreadReactions.UnreadReactionCount)
+}
+
+func encodeUpdateMessageLiveLocationViewed(encoder *encoder, value *UpdateMessageLiveLocationViewed) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateMessageLiveLocationViewed *UpdateMessageLiveLocationViewed) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateMessageLiveLocationViewed, updateMessageLiveLocationViewed.meta)
+	encoder.Int64("chat_id", updateMessageLiveLocationViewed.ChatId)
+	encoder.Int64("message_id", updateMessageLiveLocationViewed.MessageId)
+}
+
+func encodeUpdateNewChat(encoder *encoder, value *UpdateNewChat) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateNewChat *UpdateNewChat) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateNewChat, updateNewChat.meta)
+	encoder.name("chat")
+	encodeChat(encoder, updateNewChat.Chat)
+}
+
+func encodeUpdateChatTitle(encoder *encoder, value *UpdateChatTitle) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateChatTitle *UpdateChatTitle) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateChatTitle, updateChatTitle.meta)
+	encoder.Int64("chat_id", updateChatTitle.ChatId)
+	encoder.String("title", updateChatTitle.Title)
+}
+
+func encodeUpdateChatPhoto(encoder *encoder, value *UpdateChatPhoto) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateChatPhoto *UpdateChatPhoto) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateChatPhoto, updateChatPhoto.meta)
+	encoder.Int64("chat_id", updateChatPhoto.ChatId)
+	encoder.name("photo")
+	encodeChatPhotoInfo(encoder, updateChatPhoto.Photo)
+}
+
+func encodeUpdateChatPermissions(encoder *encoder, value *UpdateChatPermissions) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateChatPermissions *UpdateChatPermissions) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateChatPermissions, updateChatPermissions.meta)
+	encoder.Int64("chat_id", updateChatPermissions.ChatId)
+	encoder.name("permissions")
+	encodeChatPermissions(encoder, updateChatPermissions.Permissions)
+}
+
+func encodeUpdateChatLastMessage(encoder *encoder, value *UpdateChatLastMessage) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateChatLastMessage *UpdateChatLastMessage) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateChatLastMessage, updateChatLastMessage.meta)
+	encoder.Int64("chat_id", updateChatLastMessage.ChatId)
+	encoder.name("last_message")
+	encodeMessage(encoder, updateChatLastMessage.LastMessage)
+	encoder.name("positions")
+	encodeListOfChatPosition(encoder, updateChatLastMessage.Positions)
+}
+
+func encodeUpdateChatPosition(encoder *encoder, value *UpdateChatPosition) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateChatPosition *UpdateChatPosition) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateChatPosition, updateChatPosition.meta)
+	encoder.Int64("chat_id", updateChatPosition.ChatId)
+	encoder.name("position")
+	encodeChatPosition(encoder, updateChatPosition.Position)
+}
+
+func encodeUpdateChatReadInbox(encoder *encoder, value *UpdateChatReadInbox) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateChatReadInbox *UpdateChatReadInbox) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateChatReadInbox, updateChatReadInbox.meta)
+	encoder.Int64("chat_id", updateChatReadInbox.ChatId)
+	encoder.Int64("last_read_inbox_message_id", updateChatReadInbox.LastReadInboxMessageId)
+	encoder.Int32("unread_count", updateChatReadInbox.UnreadCount)
+}
+
+func encodeUpdateChatReadOutbox(encoder *encoder, value *UpdateChatReadOutbox) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateChatReadOutbox *UpdateChatReadOutbox) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateChatReadOutbox, updateChatReadOutbox.meta)
+	encoder.Int64("chat_id", updateChatReadOutbox.ChatId)
+	encoder.Int64("last_read_outbox_message_id", updateChatReadOutbox.LastReadOutboxMessageId)
+}
+
+func encodeUpdateChatActionBar(encoder *encoder, value *UpdateChatActionBar) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateChatActionBar *UpdateChatActionBar) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateChatActionBar, updateChatActionBar.meta)
+	encoder.Int64("chat_id", updateChatActionBar.ChatId)
+	encoder.name("action_bar")
+	encodeChatActionBar(encoder, updateChatActionBar.ActionBar)
+}
+
+func encodeUpdateChatAvailableReactions(encoder *encoder, value *UpdateChatAvailableReactions) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateChatAvailableReactions *UpdateChatAvailableReactions) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateChatAvailableReactions, updateChatAvailableReactions.meta)
+	encoder.Int64("chat_id", updateChatAvailableReactions.ChatId)
+	encoder.name("available_reactions")
+	encodeChatAvailableReactions(encoder, updateChatAvailableReactions.AvailableReactions)
+}
+
+func encodeUpdateChatDraftMessage(encoder *encoder, value *UpdateChatDraftMessage) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateChatDraftMessage *UpdateChatDraftMessage) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateChatDraftMessage, updateChatDraftMessage.meta)
+	encoder.Int64("chat_id", updateChatDraftMessage.ChatId)
+	encoder.name("draft_message")
+	encodeDraftMessage(encoder, updateChatDraftMessage.DraftMessage)
+	encoder.name("positions")
+	encodeListOfChatPosition(encoder, updateChatDraftMessage.Positions)
+}
+
+func encodeUpdateChatMessageSender(encoder *encoder, value *UpdateChatMessageSender) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateChatMessageSender *UpdateChatMessageSender) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateChatMessageSender, updateChatMessageSender.meta)
+	encoder.Int64("chat_id", updateChatMessageSender.ChatId)
+	encoder.name("message_sender_id")
+	encodeMessageSender(encoder, updateChatMessageSender.MessageSenderId)
+}
+
+func encodeUpdateChatMessageAutoDeleteTime(encoder *encoder, value *UpdateChatMessageAutoDeleteTime) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateChatMessageAutoDeleteTime *UpdateChatMessageAutoDeleteTime) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateChatMessageAutoDeleteTime, updateChatMessageAutoDeleteTime.meta)
+	encoder.Int64("chat_id", updateChatMessageAutoDeleteTime.ChatId)
+	encoder.Int32("message_auto_delete_time", updateChatMessageAutoDeleteTime.MessageAutoDeleteTime)
+}
+
+func encodeUpdateChatNotificationSettings(encoder *encoder, value *UpdateChatNotificationSettings) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateChatNotificationSettings *UpdateChatNotificationSettings) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateChatNotificationSettings, updateChatNotificationSettings.meta)
+	encoder.Int64("chat_id", updateChatNotificationSettings.ChatId)
+	encoder.name("notification_settings")
+	encodeChatNotificationSettings(encoder, updateChatNotificationSettings.NotificationSettings)
+}
+
+func encodeUpdateChatPendingJoinRequests(encoder *encoder, value *UpdateChatPendingJoinRequests) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateChatPendingJoinRequests *UpdateChatPendingJoinRequests) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateChatPendingJoinRequests, updateChatPendingJoinRequests.meta)
+	encoder.Int64("chat_id", updateChatPendingJoinRequests.ChatId)
+	encoder.name("pending_join_requests")
+	encodeChatJoinRequestsInfo(encoder, updateChatPendingJoinRequests.PendingJoinRequests)
+}
+
+func encodeUpdateChatReplyMarkup(encoder *encoder, value *UpdateChatReplyMarkup) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateChatReplyMarkup *UpdateChatReplyMarkup) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateChatReplyMarkup, updateChatReplyMarkup.meta)
+	encoder.Int64("chat_id", updateChatReplyMarkup.ChatId)
+	encoder.Int64("reply_markup_message_id", updateChatReplyMarkup.ReplyMarkupMessageId)
+}
+
+func encodeUpdateChatBackground(encoder *encoder, value *UpdateChatBackground) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateChatBackground *UpdateChatBackground) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateChatBackground, updateChatBackground.meta)
+	encoder.Int64("chat_id", updateChatBackground.ChatId)
+	encoder.name("background")
+	encodeChatBackground(encoder, updateChatBackground.Background)
+}
+
+func encodeUpdateChatTheme(encoder *encoder, value *UpdateChatTheme) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateChatTheme *UpdateChatTheme) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateChatTheme, updateChatTheme.meta)
+	encoder.Int64("chat_id", updateChatTheme.ChatId)
+	encoder.String("theme_name", updateChatTheme.ThemeName)
+}
+
+func encodeUpdateChatUnreadMentionCount(encoder *encoder, value *UpdateChatUnreadMentionCount) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateChatUnreadMentionCount *UpdateChatUnreadMentionCount) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateChatUnreadMentionCount, updateChatUnreadMentionCount.meta)
+	encoder.Int64("chat_id", updateChatUnreadMentionCount.ChatId)
+	encoder.Int32("unread_mention_count", updateChatUnreadMentionCount.UnreadMentionCount)
+}
+
+func encodeUpdateChatUnreadReactionCount(encoder *encoder, value *UpdateChatUnreadReactionCount) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateChatUnreadReactionCount *UpdateChatUnreadReactionCount) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateChatUnreadReactionCount, updateChatUnreadReactionCount.meta)
+	encoder.Int64("chat_id", updateChatUnreadReactionCount.ChatId)
+	encoder.Int32("unread_reaction_count", updateChatUnreadReactionCount.UnreadReactionCount)
+}
+
+func encodeUpdateChatVideoChat(encoder *encoder, value *UpdateChatVideoChat) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateChatVideoChat *UpdateChatVideoChat) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateChatVideoChat, updateChatVideoChat.meta)
+	encoder.Int64("chat_id", updateChatVideoChat.ChatId)
+	encoder.name("video_chat")
+	encodeVideoChat(encoder, updateChatVideoChat.VideoChat)
+}
+
+func encodeUpdateChatDefaultDisableNotification(encoder *encoder, value *UpdateChatDefaultDisableNotification) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateChatDefaultDisableNotification *UpdateChatDefaultDisableNotification) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateChatDefaultDisableNotification, updateChatDefaultDisableNotification.meta)
+	encoder.Int64("chat_id", updateChatDefaultDisableNotification.ChatId)
+	encoder.Bool("default_disable_notification", updateChatDefaultDisableNotification.DefaultDisableNotification)
+}
+
+func encodeUpdateChatHasProtectedContent(encoder *encoder, value *UpdateChatHasProtectedContent) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateChatHasProtectedContent *UpdateChatHasProtectedContent) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateChatHasProtectedContent, updateChatHasProtectedContent.meta)
+	encoder.Int64("chat_id", updateChatHasProtectedContent.ChatId)
+	encoder.Bool("has_protected_content", updateChatHasProtectedContent.HasProtectedContent)
+}
+
+func encodeUpdateChatIsTranslatable(encoder *encoder, value *UpdateChatIsTranslatable) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateChatIsTranslatable *UpdateChatIsTranslatable) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateChatIsTranslatable, updateChatIsTranslatable.meta)
+	encoder.Int64("chat_id", updateChatIsTranslatable.ChatId)
+	encoder.Bool("is_translatable", updateChatIsTranslatable.IsTranslatable)
+}
+
+func encodeUpdateChatIsMarkedAsUnread(encoder *encoder, value *UpdateChatIsMarkedAsUnread) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateChatIsMarkedAsUnread *UpdateChatIsMarkedAsUnread) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateChatIsMarkedAsUnread, updateChatIsMarkedAsUnread.meta)
+	encoder.Int64("chat_id", updateChatIsMarkedAsUnread.ChatId)
+	encoder.Bool("is_marked_as_unread", updateChatIsMarkedAsUnread.IsMarkedAsUnread)
+}
+
+func encodeUpdateChatIsBlocked(encoder *encoder, value *UpdateChatIsBlocked) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateChatIsBlocked *UpdateChatIsBlocked) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateChatIsBlocked, updateChatIsBlocked.meta)
+	encoder.Int64("chat_id", updateChatIsBlocked.ChatId)
+	encoder.Bool("is_blocked", updateChatIsBlocked.IsBlocked)
+}
+
+func encodeUpdateChatHasScheduledMessages(encoder *encoder, value *UpdateChatHasScheduledMessages) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateChatHasScheduledMessages *UpdateChatHasScheduledMessages) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateChatHasScheduledMessages, updateChatHasScheduledMessages.meta)
+	encoder.Int64("chat_id", updateChatHasScheduledMessages.ChatId)
+	encoder.Bool("has_scheduled_messages", updateChatHasScheduledMessages.HasScheduledMessages)
+}
+
+func encodeUpdateChatFolders(encoder *encoder, value *UpdateChatFolders) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateChatFolders *UpdateChatFolders) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateChatFolders, updateChatFolders.meta)
+	encoder.name("chat_folders")
+	encodeListOfChatFolderInfo(encoder, updateChatFolders.ChatFolders)
+	encoder.Int32("main_chat_list_position", updateChatFolders.MainChatListPosition)
+}
+
+func encodeUpdateChatOnlineMemberCount(encoder *encoder, value *UpdateChatOnlineMemberCount) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateChatOnlineMemberCount *UpdateChatOnlineMemberCount) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateChatOnlineMemberCount, updateChatOnlineMemberCount.meta)
+	encoder.Int64("chat_id", updateChatOnlineMemberCount.ChatId)
+	encoder.Int32("online_member_count", updateChatOnlineMemberCount.OnlineMemberCount)
+}
+
+func encodeUpdateForumTopicInfo(encoder *encoder, value *UpdateForumTopicInfo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateForumTopicInfo *UpdateForumTopicInfo) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateForumTopicInfo, updateForumTopicInfo.meta)
+	encoder.Int64("chat_id", updateForumTopicInfo.ChatId)
+	encoder.name("info")
+	encodeForumTopicInfo(encoder, updateForumTopicInfo.Info)
+}
+
+func encodeUpdateScopeNotificationSettings(encoder *encoder, value *UpdateScopeNotificationSettings) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateScopeNotificationSettings *UpdateScopeNotificationSettings) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateScopeNotificationSettings, updateScopeNotificationSettings.meta)
+	encoder.name("scope")
+	encodeNotificationSettingsScope(encoder, updateScopeNotificationSettings.Scope)
+	encoder.name("notification_settings")
+	encodeScopeNotificationSettings(encoder, updateScopeNotificationSettings.NotificationSettings)
+}
+
+func encodeUpdateNotification(encoder *encoder, value *UpdateNotification) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateNotification *UpdateNotification) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateNotification, updateNotification.meta)
+	encoder.Int32("notification_group_id", updateNotification.NotificationGroupId)
+	encoder.name("notification")
+	encodeNotification(encoder, updateNotification.Notification)
+}
+
+func encodeUpdateNotificationGroup(encoder *encoder, value *UpdateNotificationGroup) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateNotificationGroup *UpdateNotificationGroup) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateNotificationGroup, updateNotificationGroup.meta)
+	encoder.Int32("notification_group_id", updateNotificationGroup.NotificationGroupId)
+	encoder.name("type")
+	encodeNotificationGroupType(encoder, updateNotificationGroup.Type)
+	encoder.Int64("chat_id", updateNotificationGroup.ChatId)
+	encoder.Int64("notification_settings_chat_id", updateNotificationGroup.NotificationSettingsChatId)
+	encoder.JsonInt64("notification_sound_id", updateNotificationGroup.NotificationSoundId)
+	encoder.Int32("total_count", updateNotificationGroup.TotalCount)
+	encoder.name("added_notifications")
+	encodeListOfNotification(encoder, updateNotificationGroup.AddedNotifications)
+	encoder.Int32s("removed_notification_ids", updateNotificationGroup.RemovedNotificationIds)
+}
+
+func encodeUpdateActiveNotifications(encoder *encoder, value *UpdateActiveNotifications) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateActiveNotifications *UpdateActiveNotifications) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateActiveNotifications, updateActiveNotifications.meta)
+	encoder.name("groups")
+	encodeListOfNotificationGroup(encoder, updateActiveNotifications.Groups)
+}
+
+func encodeUpdateHavePendingNotifications(encoder *encoder, value *UpdateHavePendingNotifications) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateHavePendingNotifications *UpdateHavePendingNotifications) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateHavePendingNotifications, updateHavePendingNotifications.meta)
+	encoder.Bool("have_delayed_notifications", updateHavePendingNotifications.HaveDelayedNotifications)
+	encoder.Bool("have_unreceived_notifications", updateHavePendingNotifications.HaveUnreceivedNotifications)
+}
+
+func encodeUpdateDeleteMessages(encoder *encoder, value *UpdateDeleteMessages) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateDeleteMessages *UpdateDeleteMessages) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateDeleteMessages, updateDeleteMessages.meta)
+	encoder.Int64("chat_id", updateDeleteMessages.ChatId)
+	encoder.Int64s("message_ids", updateDeleteMessages.MessageIds)
+	encoder.Bool("is_permanent", updateDeleteMessages.IsPermanent)
+	encoder.Bool("from_cache", updateDeleteMessages.FromCache)
+}
+
+func encodeUpdateChatAction(encoder *encoder, value *UpdateChatAction) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateChatAction *UpdateChatAction) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateChatAction, updateChatAction.meta)
+	encoder.Int64("chat_id", updateChatAction.ChatId)
+	encoder.Int64("message_thread_id", updateChatAction.MessageThreadId)
+	encoder.name("sender_id")
+	encodeMessageSender(encoder, updateChatAction.SenderId)
+	encoder.name("action")
+	encodeChatAction(encoder, updateChatAction.Action)
+}
+
+func encodeUpdateUserStatus(encoder *encoder, value *UpdateUserStatus) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateUserStatus *UpdateUserStatus) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateUserStatus, updateUserStatus.meta)
+	encoder.Int64("user_id", updateUserStatus.UserId)
+	encoder.name("status")
+	encodeUserStatus(encoder, updateUserStatus.Status)
+}
+
+func encodeUpdateUser(encoder *encoder, value *UpdateUser) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateUser *UpdateUser) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateUser, updateUser.meta)
+	encoder.name("user")
+	encodeUser(encoder, updateUser.User)
+}
+
+func encodeUpdateAccessHash(encoder *encoder, value *UpdateAccessHash) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateAccessHash *UpdateAccessHash) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateAccessHash, updateAccessHash.meta)
+	encoder.name("access_hash")
+	encodeAccessHash(encoder, updateAccessHash.AccessHash)
+}
+
+func encodeUpdateBasicGroup(encoder *encoder, value *UpdateBasicGroup) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateBasicGroup *UpdateBasicGroup) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateBasicGroup, updateBasicGroup.meta)
+	encoder.name("basic_group")
+	encodeBasicGroup(encoder, updateBasicGroup.BasicGroup)
+}
+
+func encodeUpdateSupergroup(encoder *encoder, value *UpdateSupergroup) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateSupergroup *UpdateSupergroup) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateSupergroup, updateSupergroup.meta)
+	encoder.name("supergroup")
+	encodeSupergroup(encoder, updateSupergroup.Supergroup)
+}
+
+func encodeUpdateSecretChat(encoder *encoder, value *UpdateSecretChat) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateSecretChat *UpdateSecretChat) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateSecretChat, updateSecretChat.meta)
+	encoder.name("secret_chat")
+	encodeSecretChat(encoder, updateSecretChat.SecretChat)
+}
+
+func encodeUpdateUserFullInfo(encoder *encoder, value *UpdateUserFullInfo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateUserFullInfo *UpdateUserFullInfo) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateUserFullInfo, updateUserFullInfo.meta)
+	encoder.Int64("user_id", updateUserFullInfo.UserId)
+	encoder.name("user_full_info")
+	encodeUserFullInfo(encoder, updateUserFullInfo.UserFullInfo)
+}
+
+func encodeUpdateBasicGroupFullInfo(encoder *encoder, value *UpdateBasicGroupFullInfo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateBasicGroupFullInfo *UpdateBasicGroupFullInfo) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateBasicGroupFullInfo, updateBasicGroupFullInfo.meta)
+	encoder.Int64("basic_group_id", updateBasicGroupFullInfo.BasicGroupId)
+	encoder.name("basic_group_full_info")
+	encodeBasicGroupFullInfo(encoder, updateBasicGroupFullInfo.BasicGroupFullInfo)
+}
+
+func encodeUpdateSupergroupFullInfo(encoder *encoder, value *UpdateSupergroupFullInfo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateSupergroupFullInfo *UpdateSupergroupFullInfo) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateSupergroupFullInfo, updateSupergroupFullInfo.meta)
+	encoder.Int64("supergroup_id", updateSupergroupFullInfo.SupergroupId)
+	encoder.name("supergroup_full_info")
+	encodeSupergroupFullInfo(encoder, updateSupergroupFullInfo.SupergroupFullInfo)
+}
+
+func encodeUpdateServiceNotification(encoder *encoder, value *UpdateServiceNotification) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateServiceNotification *UpdateServiceNotification) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateServiceNotification, updateServiceNotification.meta)
+	encoder.String("type", updateServiceNotification.Type)
+	encoder.name("content")
+	encodeMessageContent(encoder, updateServiceNotification.Content)
+}
+
+func encodeUpdateFile(encoder *encoder, value *UpdateFile) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateFile *UpdateFile) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateFile, updateFile.meta)
+	encoder.name("file")
+	encodeFile(encoder, updateFile.File)
+}
+
+func encodeUpdateFileGenerationStart(encoder *encoder, value *UpdateFileGenerationStart) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateFileGenerationStart *UpdateFileGenerationStart) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateFileGenerationStart, updateFileGenerationStart.meta)
+	encoder.JsonInt64("generation_id", updateFileGenerationStart.GenerationId)
+	encoder.String("original_path", updateFileGenerationStart.OriginalPath)
+	encoder.String("destination_path", updateFileGenerationStart.DestinationPath)
+	encoder.String("conversion", updateFileGenerationStart.Conversion)
+}
+
+func encodeUpdateFileGenerationStop(encoder *encoder, value *UpdateFileGenerationStop) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateFileGenerationStop *UpdateFileGenerationStop) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateFileGenerationStop, updateFileGenerationStop.meta)
+	encoder.JsonInt64("generation_id", updateFileGenerationStop.GenerationId)
+}
+
+func encodeUpdateFileDownloads(encoder *encoder, value *UpdateFileDownloads) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateFileDownloads *UpdateFileDownloads) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateFileDownloads, updateFileDownloads.meta)
+	encoder.Int64("total_size", updateFileDownloads.TotalSize)
+	encoder.Int32("total_count", updateFileDownloads.TotalCount)
+	encoder.Int64("downloaded_size", updateFileDownloads.DownloadedSize)
+}
+
+func encodeUpdateFileAddedToDownloads(encoder *encoder, value *UpdateFileAddedToDownloads) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateFileAddedToDownloads *UpdateFileAddedToDownloads) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateFileAddedToDownloads, updateFileAddedToDownloads.meta)
+	encoder.name("file_download")
+	encodeFileDownload(encoder, updateFileAddedToDownloads.FileDownload)
+	encoder.name("counts")
+	encodeDownloadedFileCounts(encoder, updateFileAddedToDownloads.Counts)
+}
+
+func encodeUpdateFileDownload(encoder *encoder, value *UpdateFileDownload) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateFileDownload *UpdateFileDownload) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateFileDownload, updateFileDownload.meta)
+	encoder.Int32("file_id", updateFileDownload.FileId)
+	encoder.Int32("complete_date", updateFileDownload.CompleteDate)
+	encoder.Bool("is_paused", updateFileDownload.IsPaused)
+	encoder.name("counts")
+	encodeDownloadedFileCounts(encoder, updateFileDownload.Counts)
+}
+
+func encodeUpdateFileRemovedFromDownloads(encoder *encoder, value *UpdateFileRemovedFromDownloads) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateFileRemovedFromDownloads *UpdateFileRemovedFromDownloads) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateFileRemovedFromDownloads, updateFileRemovedFromDownloads.meta)
+	encoder.Int32("file_id", updateFileRemovedFromDownloads.FileId)
+	encoder.name("counts")
+	encodeDownloadedFileCounts(encoder, updateFileRemovedFromDownloads.Counts)
+}
+
+func encodeUpdateCall(encoder *encoder, value *UpdateCall) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateCall *UpdateCall) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateCall, updateCall.meta)
+	encoder.name("call")
+	encodeCall(encoder, updateCall.Call)
+}
+
+func encodeUpdateGroupCall(encoder *encoder, value *UpdateGroupCall) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateGroupCall *UpdateGroupCall) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateGroupCall, updateGroupCall.meta)
+	encoder.name("group_call")
+	encodeGroupCall(encoder, updateGroupCall.GroupCall)
+}
+
+func encodeUpdateGroupCallParticipant(encoder *encoder, value *UpdateGroupCallParticipant) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateGroupCallParticipant *UpdateGroupCallParticipant) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateGroupCallParticipant, updateGroupCallParticipant.meta)
+	encoder.Int32("group_call_id", updateGroupCallParticipant.GroupCallId)
+	encoder.name("participant")
+	encodeGroupCallParticipant(encoder, updateGroupCallParticipant.Participant)
+}
+
+func encodeUpdateNewCallSignalingData(encoder *encoder, value *UpdateNewCallSignalingData) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateNewCallSignalingData *UpdateNewCallSignalingData) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateNewCallSignalingData, updateNewCallSignalingData.meta)
+	encoder.Int32("call_id", updateNewCallSignalingData.CallId)
+	encoder.Bytes("data", updateNewCallSignalingData.Data)
+}
+
+func encodeUpdateUserPrivacySettingRules(encoder *encoder, value *UpdateUserPrivacySettingRules) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateUserPrivacySettingRules *UpdateUserPrivacySettingRules) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateUserPrivacySettingRules, updateUserPrivacySettingRules.meta)
+	encoder.name("setting")
+	encodeUserPrivacySetting(encoder, updateUserPrivacySettingRules.Setting)
+	encoder.name("rules")
+	encodeUserPrivacySettingRules(encoder, updateUserPrivacySettingRules.Rules)
+}
+
+func encodeUpdateUnreadMessageCount(encoder *encoder, value *UpdateUnreadMessageCount) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateUnreadMessageCount *UpdateUnreadMessageCount) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateUnreadMessageCount, updateUnreadMessageCount.meta)
+	encoder.name("chat_list")
+	encodeChatList(encoder, updateUnreadMessageCount.ChatList)
+	encoder.Int32("unread_count", updateUnreadMessageCount.UnreadCount)
+	encoder.Int32("unread_unmuted_count", updateUnreadMessageCount.UnreadUnmutedCount)
+}
+
+func encodeUpdateUnreadChatCount(encoder *encoder, value *UpdateUnreadChatCount) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateUnreadChatCount *UpdateUnreadChatCount) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateUnreadChatCount, updateUnreadChatCount.meta)
+	encoder.name("chat_list")
+	encodeChatList(encoder, updateUnreadChatCount.ChatList)
+	encoder.Int32("total_count", updateUnreadChatCount.TotalCount)
+	encoder.Int32("unread_count", updateUnreadChatCount.UnreadCount)
+	encoder.Int32("unread_unmuted_count", updateUnreadChatCount.UnreadUnmutedCount)
+	encoder.Int32("marked_as_unread_count", updateUnreadChatCount.MarkedAsUnreadCount)
+	encoder.Int32("marked_as_unread_unmuted_count", updateUnreadChatCount.MarkedAsUnreadUnmutedCount)
+}
+
+func encodeUpdateOption(encoder *encoder, value *UpdateOption) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateOption *UpdateOption) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateOption, updateOption.meta)
+	encoder.String("name", updateOption.Name)
+	encoder.name("value")
+	encodeOptionValue(encoder, updateOption.Value)
+}
+
+func encodeUpdateStickerSet(encoder *encoder, value *UpdateStickerSet) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateStickerSet *UpdateStickerSet) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateStickerSet, updateStickerSet.meta)
+	encoder.name("sticker_set")
+	encodeStickerSet(encoder, updateStickerSet.StickerSet)
+}
+
+func encodeUpdateInstalledStickerSets(encoder *encoder, value *UpdateInstalledStickerSets) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateInstalledStickerSets *UpdateInstalledStickerSets) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateInstalledStickerSets, updateInstalledStickerSets.meta)
+	encoder.name("sticker_type")
+	encodeStickerType(encoder, updateInstalledStickerSets.StickerType)
+	encoder.name("sticker_set_ids")
+	encodeListOfJsonInt64(encoder, updateInstalledStickerSets.StickerSetIds)
+}
+
+func encodeUpdateTrendingStickerSets(encoder *encoder, value *UpdateTrendingStickerSets) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateTrendingStickerSets *UpdateTrendingStickerSets) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateTrendingStickerSets, updateTrendingStickerSets.meta)
+	encoder.name("sticker_type")
+	encodeStickerType(encoder, updateTrendingStickerSets.StickerType)
+	encoder.name("sticker_sets")
+	encodeTrendingStickerSets(encoder, updateTrendingStickerSets.StickerSets)
+}
+
+func encodeUpdateRecentStickers(encoder *encoder, value *UpdateRecentStickers) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateRecentStickers *UpdateRecentStickers) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateRecentStickers, updateRecentStickers.meta)
+	encoder.Bool("is_attached", updateRecentStickers.IsAttached)
+	encoder.Int32s("sticker_ids", updateRecentStickers.StickerIds)
+}
+
+func encodeUpdateFavoriteStickers(encoder *encoder, value *UpdateFavoriteStickers) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateFavoriteStickers *UpdateFavoriteStickers) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateFavoriteStickers, updateFavoriteStickers.meta)
+	encoder.Int32s("sticker_ids", updateFavoriteStickers.StickerIds)
+}
+
+func encodeUpdateSavedAnimations(encoder *encoder, value *UpdateSavedAnimations) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateSavedAnimations *UpdateSavedAnimations) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateSavedAnimations, updateSavedAnimations.meta)
+	encoder.Int32s("animation_ids", updateSavedAnimations.AnimationIds)
+}
+
+func encodeUpdateSavedNotificationSounds(encoder *encoder, value *UpdateSavedNotificationSounds) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateSavedNotificationSounds *UpdateSavedNotificationSounds) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateSavedNotificationSounds, updateSavedNotificationSounds.meta)
+	encoder.name("notification_sound_ids")
+	encodeListOfJsonInt64(encoder, updateSavedNotificationSounds.NotificationSoundIds)
+}
+
+func encodeUpdateSelectedBackground(encoder *encoder, value *UpdateSelectedBackground) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateSelectedBackground *UpdateSelectedBackground) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateSelectedBackground, updateSelectedBackground.meta)
+	encoder.Bool("for_dark_theme", updateSelectedBackground.ForDarkTheme)
+	encoder.name("background")
+	encodeBackground(encoder, updateSelectedBackground.Background)
+}
+
+func encodeUpdateChatThemes(encoder *encoder, value *UpdateChatThemes) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateChatThemes *UpdateChatThemes) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateChatThemes, updateChatThemes.meta)
+	encoder.name("chat_themes")
+	encodeListOfChatTheme(encoder, updateChatThemes.ChatThemes)
+}
+
+func encodeUpdateLanguagePackStrings(encoder *encoder, value *UpdateLanguagePackStrings) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateLanguagePackStrings *UpdateLanguagePackStrings) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateLanguagePackStrings, updateLanguagePackStrings.meta)
+	encoder.String("localization_target", updateLanguagePackStrings.LocalizationTarget)
+	encoder.String("language_pack_id", updateLanguagePackStrings.LanguagePackId)
+	encoder.name("strings")
+	encodeListOfLanguagePackString(encoder, updateLanguagePackStrings.Strings)
+}
+
+func encodeUpdateConnectionState(encoder *encoder, value *UpdateConnectionState) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateConnectionState *UpdateConnectionState) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateConnectionState, updateConnectionState.meta)
+	encoder.name("state")
+	encodeConnectionState(encoder, updateConnectionState.State)
+}
+
+func encodeUpdateTermsOfService(encoder *encoder, value *UpdateTermsOfService) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateTermsOfService *UpdateTermsOfService) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateTermsOfService, updateTermsOfService.meta)
+	encoder.String("terms_of_service_id", updateTermsOfService.TermsOfServiceId)
+	encoder.name("terms_of_service")
+	encodeTermsOfService(encoder, updateTermsOfService.TermsOfService)
+}
+
+func encodeUpdateUsersNearby(encoder *encoder, value *UpdateUsersNearby) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateUsersNearby *UpdateUsersNearby) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateUsersNearby, updateUsersNearby.meta)
+	encoder.name("users_nearby")
+	encodeListOfChatNearby(encoder, updateUsersNearby.UsersNearby)
+}
+
+func encodeUpdateAttachmentMenuBots(encoder *encoder, value *UpdateAttachmentMenuBots) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateAttachmentMenuBots *UpdateAttachmentMenuBots) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateAttachmentMenuBots, updateAttachmentMenuBots.meta)
+	encoder.name("bots")
+	encodeListOfAttachmentMenuBot(encoder, updateAttachmentMenuBots.Bots)
+}
+
+func encodeUpdateWebAppMessageSent(encoder *encoder, value *UpdateWebAppMessageSent) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateWebAppMessageSent *UpdateWebAppMessageSent) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateWebAppMessageSent, updateWebAppMessageSent.meta)
+	encoder.JsonInt64("web_app_launch_id", updateWebAppMessageSent.WebAppLaunchId)
+}
+
+func encodeUpdateActiveEmojiReactions(encoder *encoder, value *UpdateActiveEmojiReactions) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateActiveEmojiReactions *UpdateActiveEmojiReactions) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateActiveEmojiReactions, updateActiveEmojiReactions.meta)
+	encoder.Strings("emojis", updateActiveEmojiReactions.Emojis)
+}
+
+func encodeUpdateDefaultReactionType(encoder *encoder, value *UpdateDefaultReactionType) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateDefaultReactionType *UpdateDefaultReactionType) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateDefaultReactionType, updateDefaultReactionType.meta)
+	encoder.name("reaction_type")
+	encodeReactionType(encoder, updateDefaultReactionType.ReactionType)
+}
+
+func encodeUpdateDiceEmojis(encoder *encoder, value *UpdateDiceEmojis) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateDiceEmojis *UpdateDiceEmojis) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateDiceEmojis, updateDiceEmojis.meta)
+	encoder.Strings("emojis", updateDiceEmojis.Emojis)
+}
+
+func encodeUpdateAnimatedEmojiMessageClicked(encoder *encoder, value *UpdateAnimatedEmojiMessageClicked) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateAnimatedEmojiMessageClicked *UpdateAnimatedEmojiMessageClicked) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateAnimatedEmojiMessageClicked, updateAnimatedEmojiMessageClicked.meta)
+	encoder.Int64("chat_id", updateAnimatedEmojiMessageClicked.ChatId)
+	encoder.Int64("message_id", updateAnimatedEmojiMessageClicked.MessageId)
+	encoder.name("sticker")
+	encodeSticker(encoder, updateAnimatedEmojiMessageClicked.Sticker)
+}
+
+func encodeUpdateAnimationSearchParameters(encoder *encoder, value *UpdateAnimationSearchParameters) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateAnimationSearchParameters *UpdateAnimationSearchParameters) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateAnimationSearchParameters, updateAnimationSearchParameters.meta)
+	encoder.String("provider", updateAnimationSearchParameters.Provider)
+	encoder.Strings("emojis", updateAnimationSearchParameters.Emojis)
+}
+
+func encodeUpdateSuggestedActions(encoder *encoder, value *UpdateSuggestedActions) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateSuggestedActions *UpdateSuggestedActions) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateSuggestedActions, updateSuggestedActions.meta)
+	encoder.name("added_actions")
+	encodeListOfSuggestedAction(encoder, updateSuggestedActions.AddedActions)
+	encoder.name("removed_actions")
+	encodeListOfSuggestedAction(encoder, updateSuggestedActions.RemovedActions)
+}
+
+func encodeUpdateAddChatMembersPrivacyForbidden(encoder *encoder, value *UpdateAddChatMembersPrivacyForbidden) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateAddChatMembersPrivacyForbidden *UpdateAddChatMembersPrivacyForbidden) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateAddChatMembersPrivacyForbidden, updateAddChatMembersPrivacyForbidden.meta)
+	encoder.Int64("chat_id", updateAddChatMembersPrivacyForbidden.ChatId)
+	encoder.Int64s("user_ids", updateAddChatMembersPrivacyForbidden.UserIds)
+}
+
+func encodeUpdateAutosaveSettings(encoder *encoder, value *UpdateAutosaveSettings) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateAutosaveSettings *UpdateAutosaveSettings) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateAutosaveSettings, updateAutosaveSettings.meta)
+	encoder.name("scope")
+	encodeAutosaveSettingsScope(encoder, updateAutosaveSettings.Scope)
+	encoder.name("settings")
+	encodeScopeAutosaveSettings(encoder, updateAutosaveSettings.Settings)
+}
+
+func encodeUpdateNewInlineQuery(encoder *encoder, value *UpdateNewInlineQuery) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateNewInlineQuery *UpdateNewInlineQuery) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateNewInlineQuery, updateNewInlineQuery.meta)
+	encoder.JsonInt64("id", updateNewInlineQuery.Id)
+	encoder.Int64("sender_user_id", updateNewInlineQuery.SenderUserId)
+	encoder.name("user_location")
+	encodeLocation(encoder, updateNewInlineQuery.UserLocation)
+	encoder.name("chat_type")
+	encodeChatType(encoder, updateNewInlineQuery.ChatType)
+	encoder.String("query", updateNewInlineQuery.Query)
+	encoder.String("offset", updateNewInlineQuery.Offset)
+}
+
+func encodeUpdateNewChosenInlineResult(encoder *encoder, value *UpdateNewChosenInlineResult) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateNewChosenInlineResult *UpdateNewChosenInlineResult) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateNewChosenInlineResult, updateNewChosenInlineResult.meta)
+	encoder.Int64("sender_user_id", updateNewChosenInlineResult.SenderUserId)
+	encoder.name("user_location")
+	encodeLocation(encoder, updateNewChosenInlineResult.UserLocation)
+	encoder.String("query", updateNewChosenInlineResult.Query)
+	encoder.String("result_id", updateNewChosenInlineResult.ResultId)
+	encoder.String("inline_message_id", updateNewChosenInlineResult.InlineMessageId)
+}
+
+func encodeUpdateNewCallbackQuery(encoder *encoder, value *UpdateNewCallbackQuery) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateNewCallbackQuery *UpdateNewCallbackQuery) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateNewCallbackQuery, updateNewCallbackQuery.meta)
+	encoder.JsonInt64("id", updateNewCallbackQuery.Id)
+	encoder.Int64("sender_user_id", updateNewCallbackQuery.SenderUserId)
+	encoder.Int64("chat_id", updateNewCallbackQuery.ChatId)
+	encoder.Int64("message_id", updateNewCallbackQuery.MessageId)
+	encoder.JsonInt64("chat_instance", updateNewCallbackQuery.ChatInstance)
+	encoder.name("payload")
+	encodeCallbackQueryPayload(encoder, updateNewCallbackQuery.Payload)
+}
+
+func encodeUpdateNewInlineCallbackQuery(encoder *encoder, value *UpdateNewInlineCallbackQuery) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateNewInlineCallbackQuery *UpdateNewInlineCallbackQuery) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateNewInlineCallbackQuery, updateNewInlineCallbackQuery.meta)
+	encoder.JsonInt64("id", updateNewInlineCallbackQuery.Id)
+	encoder.Int64("sender_user_id", updateNewInlineCallbackQuery.SenderUserId)
+	encoder.String("inline_message_id", updateNewInlineCallbackQuery.InlineMessageId)
+	encoder.JsonInt64("chat_instance", updateNewInlineCallbackQuery.ChatInstance)
+	encoder.name("payload")
+	encodeCallbackQueryPayload(encoder, updateNewInlineCallbackQuery.Payload)
+}
+
+func encodeUpdateNewShippingQuery(encoder *encoder, value *UpdateNewShippingQuery) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateNewShippingQuery *UpdateNewShippingQuery) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateNewShippingQuery, updateNewShippingQuery.meta)
+	encoder.JsonInt64("id", updateNewShippingQuery.Id)
+	encoder.Int64("sender_user_id", updateNewShippingQuery.SenderUserId)
+	encoder.String("invoice_payload", updateNewShippingQuery.InvoicePayload)
+	encoder.name("shipping_address")
+	encodeAddress(encoder, updateNewShippingQuery.ShippingAddress)
+}
+
+func encodeUpdateNewPreCheckoutQuery(encoder *encoder, value *UpdateNewPreCheckoutQuery) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateNewPreCheckoutQuery *UpdateNewPreCheckoutQuery) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateNewPreCheckoutQuery, updateNewPreCheckoutQuery.meta)
+	encoder.JsonInt64("id", updateNewPreCheckoutQuery.Id)
+	encoder.Int64("sender_user_id", updateNewPreCheckoutQuery.SenderUserId)
+	encoder.String("currency", updateNewPreCheckoutQuery.Currency)
+	encoder.Int64("total_amount", updateNewPreCheckoutQuery.TotalAmount)
+	encoder.Bytes("invoice_payload", updateNewPreCheckoutQuery.InvoicePayload)
+	encoder.String("shipping_option_id", updateNewPreCheckoutQuery.ShippingOptionId)
+	encoder.name("order_info")
+	encodeOrderInfo(encoder, updateNewPreCheckoutQuery.OrderInfo)
+}
+
+func encodeUpdateNewCustomEvent(encoder *encoder, value *UpdateNewCustomEvent) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateNewCustomEvent *UpdateNewCustomEvent) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateNewCustomEvent, updateNewCustomEvent.meta)
+	encoder.String("event", updateNewCustomEvent.Event)
+}
+
+func encodeUpdateNewCustomQuery(encoder *encoder, value *UpdateNewCustomQuery) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateNewCustomQuery *UpdateNewCustomQuery) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateNewCustomQuery, updateNewCustomQuery.meta)
+	encoder.JsonInt64("id", updateNewCustomQuery.Id)
+	encoder.String("data", updateNewCustomQuery.Data)
+	encoder.Int32("timeout", updateNewCustomQuery.Timeout)
+}
+
+func encodeUpdatePoll(encoder *encoder, value *UpdatePoll) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updatePoll *UpdatePoll) encode(encoder *encoder) {
+	encoder.meta(TypeUpdatePoll, updatePoll.meta)
+	encoder.name("poll")
+	encodePoll(encoder, updatePoll.Poll)
+}
+
+func encodeUpdatePollAnswer(encoder *encoder, value *UpdatePollAnswer) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updatePollAnswer *UpdatePollAnswer) encode(encoder *encoder) {
+	encoder.meta(TypeUpdatePollAnswer, updatePollAnswer.meta)
+	encoder.JsonInt64("poll_id", updatePollAnswer.PollId)
+	encoder.Int64("user_id", updatePollAnswer.UserId)
+	encoder.Int32s("option_ids", updatePollAnswer.OptionIds)
+}
+
+func encodeUpdateChatMember(encoder *encoder, value *UpdateChatMember) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateChatMember *UpdateChatMember) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateChatMember, updateChatMember.meta)
+	encoder.Int64("chat_id", updateChatMember.ChatId)
+	encoder.Int64("actor_user_id", updateChatMember.ActorUserId)
+	encoder.Int32("date", updateChatMember.Date)
+	encoder.name("invite_link")
+	encodeChatInviteLink(encoder, updateChatMember.InviteLink)
+	encoder.Bool("via_chat_folder_invite_link", updateChatMember.ViaChatFolderInviteLink)
+	encoder.name("old_chat_member")
+	encodeChatMember(encoder, updateChatMember.OldChatMember)
+	encoder.name("new_chat_member")
+	encodeChatMember(encoder, updateChatMember.NewChatMember)
+}
+
+func encodeUpdateNewChatJoinRequest(encoder *encoder, value *UpdateNewChatJoinRequest) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updateNewChatJoinRequest *UpdateNewChatJoinRequest) encode(encoder *encoder) {
+	encoder.meta(TypeUpdateNewChatJoinRequest, updateNewChatJoinRequest.meta)
+	encoder.Int64("chat_id", updateNewChatJoinRequest.ChatId)
+	encoder.name("request")
+	encodeChatJoinRequest(encoder, updateNewChatJoinRequest.Request)
+	encoder.Int64("user_chat_id", updateNewChatJoinRequest.UserChatId)
+	encoder.name("invite_link")
+	encodeChatInviteLink(encoder, updateNewChatJoinRequest.InviteLink)
+}
+
+func encodeUpdates(encoder *encoder, value *Updates) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (updates *Updates) encode(encoder *encoder) {
+	encoder.meta(TypeUpdates, updates.meta)
+	encoder.name("updates")
+	encodeListOfUpdate(encoder, updates.Updates)
+}
+
+func encodeLogStreamDefault(encoder *encoder, value *LogStreamDefault) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (logStreamDefault *LogStreamDefault) encode(encoder *encoder) {
+	encoder.meta(TypeLogStreamDefault, logStreamDefault.meta)
+}
+
+func encodeLogStreamFile(encoder *encoder, value *LogStreamFile) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (logStreamFile *LogStreamFile) encode(encoder *encoder) {
+	encoder.meta(TypeLogStreamFile, logStreamFile.meta)
+	encoder.String("path", logStreamFile.Path)
+	encoder.Int64("max_file_size", logStreamFile.MaxFileSize)
+	encoder.Bool("redirect_stderr", logStreamFile.RedirectStderr)
+}
+
+func encodeLogStreamEmpty(encoder *encoder, value *LogStreamEmpty) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (logStreamEmpty *LogStreamEmpty) encode(encoder *encoder) {
+	encoder.meta(TypeLogStreamEmpty, logStreamEmpty.meta)
+}
+
+func encodeLogVerbosityLevel(encoder *encoder, value *LogVerbosityLevel) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (logVerbosityLevel *LogVerbosityLevel) encode(encoder *encoder) {
+	encoder.meta(TypeLogVerbosityLevel, logVerbosityLevel.meta)
+	encoder.Int32("verbosity_level", logVerbosityLevel.VerbosityLevel)
+}
+
+func encodeLogTags(encoder *encoder, value *LogTags) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (logTags *LogTags) encode(encoder *encoder) {
+	encoder.meta(TypeLogTags, logTags.meta)
+	encoder.Strings("tags", logTags.Tags)
+}
+
+func encodeUserSupportInfo(encoder *encoder, value *UserSupportInfo) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (userSupportInfo *UserSupportInfo) encode(encoder *encoder) {
+	encoder.meta(TypeUserSupportInfo, userSupportInfo.meta)
+	encoder.name("message")
+	encodeFormattedText(encoder, userSupportInfo.Message)
+	encoder.String("author", userSupportInfo.Author)
+	encoder.Int32("date", userSupportInfo.Date)
+}
+
+func encodeTestInt(encoder *encoder, value *TestInt) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (testInt *TestInt) encode(encoder *encoder) {
+	encoder.meta(TypeTestInt, testInt.meta)
+	encoder.Int32("value", testInt.Value)
+}
+
+func encodeTestString(encoder *encoder, value *TestString) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (testString *TestString) encode(encoder *encoder) {
+	encoder.meta(TypeTestString, testString.meta)
+	encoder.String("value", testString.Value)
+}
+
+func encodeTestBytes(encoder *encoder, value *TestBytes) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (testBytes *TestBytes) encode(encoder *encoder) {
+	encoder.meta(TypeTestBytes, testBytes.meta)
+	encoder.Bytes("value", testBytes.Value)
+}
+
+func encodeTestVectorInt(encoder *encoder, value *TestVectorInt) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (testVectorInt *TestVectorInt) encode(encoder *encoder) {
+	encoder.meta(TypeTestVectorInt, testVectorInt.meta)
+	encoder.Int32s("value", testVectorInt.Value)
+}
+
+func encodeTestVectorIntObject(encoder *encoder, value *TestVectorIntObject) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (testVectorIntObject *TestVectorIntObject) encode(encoder *encoder) {
+	encoder.meta(TypeTestVectorIntObject, testVectorIntObject.meta)
+	encoder.name("value")
+	encodeListOfTestInt(encoder, testVectorIntObject.Value)
+}
+
+func encodeTestVectorString(encoder *encoder, value *TestVectorString) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (testVectorString *TestVectorString) encode(encoder *encoder) {
+	encoder.meta(TypeTestVectorString, testVectorString.meta)
+	encoder.Strings("value", testVectorString.Value)
+}
+
+func encodeTestVectorStringObject(encoder *encoder, value *TestVectorStringObject) {
+	if value == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginObject()
+	value.encode(encoder)
+	encoder.endObject()
+}
+
+func (testVectorStringObject *TestVectorStringObject) encode(encoder *encoder) {
+	encoder.meta(TypeTestVectorStringObject, testVectorStringObject.meta)
+	encoder.name("value")
+	encodeListOfTestString(encoder, testVectorStringObject.Value)
+}
+
+func encodeListOfInt32(encoder *encoder, list []int32) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeInt32(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfInt64(encoder *encoder, list []int64) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeInt64(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfString(encoder *encoder, list []string) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeString(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfTextEntity(encoder *encoder, list []*TextEntity) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeTextEntity(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfVectorPathCommand(encoder *encoder, list []VectorPathCommand) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeVectorPathCommand(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfThumbnail(encoder *encoder, list []*Thumbnail) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeThumbnail(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfPhotoSize(encoder *encoder, list []*PhotoSize) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodePhotoSize(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfClosedVectorPath(encoder *encoder, list []*ClosedVectorPath) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeClosedVectorPath(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfPollOption(encoder *encoder, list []*PollOption) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodePollOption(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfBackground(encoder *encoder, list []*Background) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeBackground(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfBotCommand(encoder *encoder, list []*BotCommand) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeBotCommand(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfChatPhoto(encoder *encoder, list []*ChatPhoto) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeChatPhoto(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfEmojiStatus(encoder *encoder, list []*EmojiStatus) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeEmojiStatus(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfPremiumPaymentOption(encoder *encoder, list []*PremiumPaymentOption) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodePremiumPaymentOption(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfChatAdministrator(encoder *encoder, list []*ChatAdministrator) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeChatAdministrator(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfChatMember(encoder *encoder, list []*ChatMember) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeChatMember(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfChatInviteLink(encoder *encoder, list []*ChatInviteLink) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeChatInviteLink(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfChatInviteLinkCount(encoder *encoder, list []*ChatInviteLinkCount) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeChatInviteLinkCount(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfChatInviteLinkMember(encoder *encoder, list []*ChatInviteLinkMember) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeChatInviteLinkMember(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfChatJoinRequest(encoder *encoder, list []*ChatJoinRequest) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeChatJoinRequest(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfBotCommands(encoder *encoder, list []*BotCommands) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeBotCommands(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfMessageSender(encoder *encoder, list []MessageSender) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeMessageSender(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfChatMessageSender(encoder *encoder, list []*ChatMessageSender) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeChatMessageSender(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfMessageViewer(encoder *encoder, list []*MessageViewer) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeMessageViewer(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfMessageReaction(encoder *encoder, list []*MessageReaction) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeMessageReaction(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfUnreadReaction(encoder *encoder, list []*UnreadReaction) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeUnreadReaction(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfMessage(encoder *encoder, list []*Message) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeMessage(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfMessagePosition(encoder *encoder, list []*MessagePosition) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeMessagePosition(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfMessageCalendarDay(encoder *encoder, list []*MessageCalendarDay) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeMessageCalendarDay(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfSponsoredMessage(encoder *encoder, list []*SponsoredMessage) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeSponsoredMessage(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfFileDownload(encoder *encoder, list []*FileDownload) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeFileDownload(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfChatFolderInviteLink(encoder *encoder, list []*ChatFolderInviteLink) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeChatFolderInviteLink(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfRecommendedChatFolder(encoder *encoder, list []*RecommendedChatFolder) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeRecommendedChatFolder(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfChatList(encoder *encoder, list []ChatList) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeChatList(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfReactionType(encoder *encoder, list []ReactionType) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeReactionType(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfChatPosition(encoder *encoder, list []*ChatPosition) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeChatPosition(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfChatNearby(encoder *encoder, list []*ChatNearby) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeChatNearby(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfKeyboardButton(encoder *encoder, list []*KeyboardButton) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeKeyboardButton(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfListOfKeyboardButton(encoder *encoder, list [][]*KeyboardButton) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeListOfKeyboardButton(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfInlineKeyboardButton(encoder *encoder, list []*InlineKeyboardButton) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeInlineKeyboardButton(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfListOfInlineKeyboardButton(encoder *encoder, list [][]*InlineKeyboardButton) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeListOfInlineKeyboardButton(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfForumTopic(encoder *encoder, list []*ForumTopic) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeForumTopic(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfRichText(encoder *encoder, list []RichText) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeRichText(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfPageBlock(encoder *encoder, list []PageBlock) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodePageBlock(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfPageBlockListItem(encoder *encoder, list []*PageBlockListItem) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodePageBlockListItem(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfPageBlockTableCell(encoder *encoder, list []*PageBlockTableCell) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodePageBlockTableCell(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfListOfPageBlockTableCell(encoder *encoder, list [][]*PageBlockTableCell) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeListOfPageBlockTableCell(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfPageBlockRelatedArticle(encoder *encoder, list []*PageBlockRelatedArticle) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodePageBlockRelatedArticle(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfCountryInfo(encoder *encoder, list []*CountryInfo) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeCountryInfo(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfBankCardActionOpenUrl(encoder *encoder, list []*BankCardActionOpenUrl) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeBankCardActionOpenUrl(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfLabeledPricePart(encoder *encoder, list []*LabeledPricePart) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeLabeledPricePart(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfPaymentOption(encoder *encoder, list []*PaymentOption) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodePaymentOption(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfSavedCredentials(encoder *encoder, list []*SavedCredentials) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeSavedCredentials(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfShippingOption(encoder *encoder, list []*ShippingOption) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeShippingOption(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfDatedFile(encoder *encoder, list []*DatedFile) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeDatedFile(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfInputFile(encoder *encoder, list []InputFile) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeInputFile(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfPassportElement(encoder *encoder, list []PassportElement) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodePassportElement(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfPassportSuitableElement(encoder *encoder, list []*PassportSuitableElement) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodePassportSuitableElement(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfPassportRequiredElement(encoder *encoder, list []*PassportRequiredElement) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodePassportRequiredElement(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfPassportElementError(encoder *encoder, list []*PassportElementError) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodePassportElementError(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfBytes(encoder *encoder, list [][]byte) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeBytes(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfPassportElementType(encoder *encoder, list []PassportElementType) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodePassportElementType(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfEncryptedPassportElement(encoder *encoder, list []*EncryptedPassportElement) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeEncryptedPassportElement(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfSticker(encoder *encoder, list []*Sticker) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeSticker(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfEmojis(encoder *encoder, list []*Emojis) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeEmojis(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfStickerSetInfo(encoder *encoder, list []*StickerSetInfo) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeStickerSetInfo(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfEmojiCategory(encoder *encoder, list []*EmojiCategory) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeEmojiCategory(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfCallServer(encoder *encoder, list []*CallServer) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeCallServer(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfGroupCallStream(encoder *encoder, list []*GroupCallStream) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeGroupCallStream(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfGroupCallRecentSpeaker(encoder *encoder, list []*GroupCallRecentSpeaker) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeGroupCallRecentSpeaker(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfGroupCallVideoSourceGroup(encoder *encoder, list []*GroupCallVideoSourceGroup) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeGroupCallVideoSourceGroup(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfAddedReaction(encoder *encoder, list []*AddedReaction) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeAddedReaction(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfAvailableReaction(encoder *encoder, list []*AvailableReaction) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeAvailableReaction(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfAnimation(encoder *encoder, list []*Animation) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeAnimation(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfInlineQueryResult(encoder *encoder, list []InlineQueryResult) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeInlineQueryResult(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfGameHighScore(encoder *encoder, list []*GameHighScore) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeGameHighScore(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfChatEvent(encoder *encoder, list []*ChatEvent) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeChatEvent(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfLanguagePackString(encoder *encoder, list []*LanguagePackString) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeLanguagePackString(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfLanguagePackInfo(encoder *encoder, list []*LanguagePackInfo) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeLanguagePackInfo(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfPremiumFeature(encoder *encoder, list []PremiumFeature) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodePremiumFeature(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfPremiumLimit(encoder *encoder, list []*PremiumLimit) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodePremiumLimit(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfPremiumStatePaymentOption(encoder *encoder, list []*PremiumStatePaymentOption) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodePremiumStatePaymentOption(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfPremiumFeaturePromotionAnimation(encoder *encoder, list []*PremiumFeaturePromotionAnimation) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodePremiumFeaturePromotionAnimation(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfNotificationSound(encoder *encoder, list []*NotificationSound) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeNotificationSound(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfNotification(encoder *encoder, list []*Notification) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeNotification(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfJsonValue(encoder *encoder, list []JsonValue) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeJsonValue(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfJsonObjectMember(encoder *encoder, list []*JsonObjectMember) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeJsonObjectMember(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfUserPrivacySettingRule(encoder *encoder, list []UserPrivacySettingRule) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeUserPrivacySettingRule(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfSession(encoder *encoder, list []*Session) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeSession(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfConnectedWebsite(encoder *encoder, list []*ConnectedWebsite) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeConnectedWebsite(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfStorageStatisticsByFileType(encoder *encoder, list []*StorageStatisticsByFileType) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeStorageStatisticsByFileType(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfStorageStatisticsByChat(encoder *encoder, list []*StorageStatisticsByChat) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeStorageStatisticsByChat(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfNetworkStatisticsEntry(encoder *encoder, list []NetworkStatisticsEntry) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeNetworkStatisticsEntry(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfAutosaveSettingsException(encoder *encoder, list []*AutosaveSettingsException) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeAutosaveSettingsException(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfTMeUrl(encoder *encoder, list []*TMeUrl) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeTMeUrl(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfProxy(encoder *encoder, list []*Proxy) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeProxy(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfChatStatisticsMessageSenderInfo(encoder *encoder, list []*ChatStatisticsMessageSenderInfo) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeChatStatisticsMessageSenderInfo(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfChatStatisticsAdministratorActionsInfo(encoder *encoder, list []*ChatStatisticsAdministratorActionsInfo) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeChatStatisticsAdministratorActionsInfo(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfChatStatisticsInviterInfo(encoder *encoder, list []*ChatStatisticsInviterInfo) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeChatStatisticsInviterInfo(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfChatStatisticsMessageInteractionInfo(encoder *encoder, list []*ChatStatisticsMessageInteractionInfo) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeChatStatisticsMessageInteractionInfo(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfChatFolderInfo(encoder *encoder, list []*ChatFolderInfo) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeChatFolderInfo(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfNotificationGroup(encoder *encoder, list []*NotificationGroup) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeNotificationGroup(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfJsonInt64(encoder *encoder, list []JsonInt64) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeJsonInt64(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfChatTheme(encoder *encoder, list []*ChatTheme) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeChatTheme(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfAttachmentMenuBot(encoder *encoder, list []*AttachmentMenuBot) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeAttachmentMenuBot(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfSuggestedAction(encoder *encoder, list []SuggestedAction) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeSuggestedAction(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfUpdate(encoder *encoder, list []Update) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeUpdate(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfTestInt(encoder *encoder, list []*TestInt) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeTestInt(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfTestString(encoder *encoder, list []*TestString) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeTestString(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfInputMessageContent(encoder *encoder, list []InputMessageContent) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeInputMessageContent(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfInputInlineQueryResult(encoder *encoder, list []InputInlineQueryResult) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeInputInlineQueryResult(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfCallProblem(encoder *encoder, list []CallProblem) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeCallProblem(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfContact(encoder *encoder, list []*Contact) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeContact(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfFileType(encoder *encoder, list []FileType) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeFileType(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfInputPassportElementError(encoder *encoder, list []*InputPassportElementError) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeInputPassportElementError(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeListOfInputSticker(encoder *encoder, list []*InputSticker) {
+	if list == nil {
+		encoder.null()
+		return
+	}
+
+	encoder.beginArray()
+	for i, value := range list {
+		if i > 0 {
+			encoder.comma()
+		}
+		encodeInputSticker(encoder, value)
+	}
+	encoder.endArray()
+}
+
+func encodeType(encoder *encoder, value Type) {
+	switch value := value.(type) {
+	case nil:
+		encoder.null()
+
+	case *Error:
+		encodeError(encoder, value)
+
+	case *Ok:
+		encodeOk(encoder, value)
+
+	case *AuthenticationCodeTypeTelegramMessage:
+		encodeAuthenticationCodeTypeTelegramMessage(encoder, value)
+
+	case *AuthenticationCodeTypeSms:
+		encodeAuthenticationCodeTypeSms(encoder, value)
+
+	case *AuthenticationCodeTypeCall:
+		encodeAuthenticationCodeTypeCall(encoder, value)
+
+	case *AuthenticationCodeTypeFlashCall:
+		encodeAuthenticationCodeTypeFlashCall(encoder, value)
+
+	case *AuthenticationCodeTypeMissedCall:
+		encodeAuthenticationCodeTypeMissedCall(encoder, value)
+
+	case *AuthenticationCodeTypeFragment:
+		encodeAuthenticationCodeTypeFragment(encoder, value)
+
+	case *AuthenticationCodeTypeFirebaseAndroid:
+		encodeAuthenticationCodeTypeFirebaseAndroid(encoder, value)
+
+	case *AuthenticationCodeTypeFirebaseIos:
+		encodeAuthenticationCodeTypeFirebaseIos(encoder, value)
+
+	case *AuthenticationCodeInfo:
+		encodeAuthenticationCodeInfo(encoder, value)
+
+	case *EmailAddressAuthenticationCodeInfo:
+		encodeEmailAddressAuthenticationCodeInfo(encoder, value)
+
+	case *EmailAddressAuthenticationCode:
+		encodeEmailAddressAuthenticationCode(encoder, value)
+
+	case *EmailAddressAuthenticationAppleId:
+		encodeEmailAddressAuthenticationAppleId(encoder, value)
+
+	case *EmailAddressAuthenticationGoogleId:
+		encodeEmailAddressAuthenticationGoogleId(encoder, value)
+
+	case *EmailAddressResetStateAvailable:
+		encodeEmailAddressResetStateAvailable(encoder, value)
+
+	case *EmailAddressResetStatePending:
+		encodeEmailAddressResetStatePending(encoder, value)
+
+	case *TextEntity:
+		encodeTextEntity(encoder, value)
+
+	case *TextEntities:
+		encodeTextEntities(encoder, value)
+
+	case *FormattedText:
+		encodeFormattedText(encoder, value)
+
+	case *TermsOfService:
+		encodeTermsOfService(encoder, value)
+
+	case *AuthorizationStateWaitTdlibParameters:
+		encodeAuthorizationStateWaitTdlibParameters(encoder, value)
+
+	case *AuthorizationStateWaitPhoneNumber:
+		encodeAuthorizationStateWaitPhoneNumber(encoder, value)
+
+	case *AuthorizationStateWaitEmailAddress:
+		encodeAuthorizationStateWaitEmailAddress(encoder, value)
+
+	case *AuthorizationStateWaitEmailCode:
+		encodeAuthorizationStateWaitEmailCode(encoder, value)
+
+	case *AuthorizationStateWaitCode:
+		encodeAuthorizationStateWaitCode(encoder, value)
+
+	case *AuthorizationStateWaitOtherDeviceConfirmation:
+		encodeAuthorizationStateWaitOtherDeviceConfirmation(encoder, value)
+
+	case *AuthorizationStateWaitRegistration:
+		encodeAuthorizationStateWaitRegistration(encoder, value)
+
+	case *AuthorizationStateWaitPassword:
+		encodeAuthorizationStateWaitPassword(encoder, value)
+
+	case *AuthorizationStateReady:
+		encodeAuthorizationStateReady(encoder, value)
+
+	case *AuthorizationStateLoggingOut:
+		encodeAuthorizationStateLoggingOut(encoder, value)
+
+	case *AuthorizationStateClosing:
+		encodeAuthorizationStateClosing(encoder, value)
+
+	case *AuthorizationStateClosed:
+		encodeAuthorizationStateClosed(encoder, value)
+
+	case *PasswordState:
+		encodePasswordState(encoder, value)
+
+	case *RecoveryEmailAddress:
+		encodeRecoveryEmailAddress(encoder, value)
+
+	case *TemporaryPasswordState:
+		encodeTemporaryPasswordState(encoder, value)
+
+	case *LocalFile:
+		encodeLocalFile(encoder, value)
+
+	case *RemoteFile:
+		encodeRemoteFile(encoder, value)
+
+	case *File:
+		encodeFile(encoder, value)
+
+	case *InputFileId:
+		encodeInputFileId(encoder, value)
+
+	case *InputFileRemote:
+		encodeInputFileRemote(encoder, value)
+
+	case *InputFileLocal:
+		encodeInputFileLocal(encoder, value)
+
+	case *InputFileGenerated:
+		encodeInputFileGenerated(encoder, value)
+
+	case *PhotoSize:
+		encodePhotoSize(encoder, value)
+
+	case *Minithumbnail:
+		encodeMinithumbnail(encoder, value)
+
+	case *ThumbnailFormatJpeg:
+		encodeThumbnailFormatJpeg(encoder, value)
+
+	case *ThumbnailFormatGif:
+		encodeThumbnailFormatGif(encoder, value)
+
+	case *ThumbnailFormatMpeg4:
+		encodeThumbnailFormatMpeg4(encoder, value)
+
+	case *ThumbnailFormatPng:
+		encodeThumbnailFormatPng(encoder, value)
+
+	case *ThumbnailFormatTgs:
+		encodeThumbnailFormatTgs(encoder, value)
+
+	case *ThumbnailFormatWebm:
+		encodeThumbnailFormatWebm(encoder, value)
+
+	case *ThumbnailFormatWebp:
+		encodeThumbnailFormatWebp(encoder, value)
+
+	case *Thumbnail:
+		encodeThumbnail(encoder, value)
+
+	case *MaskPointForehead:
+		encodeMaskPointForehead(encoder, value)
+
+	case *MaskPointEyes:
+		encodeMaskPointEyes(encoder, value)
+
+	case *MaskPointMouth:
+		encodeMaskPointMouth(encoder, value)
+
+	case *MaskPointChin:
+		encodeMaskPointChin(encoder, value)
+
+	case *MaskPosition:
+		encodeMaskPosition(encoder, value)
+
+	case *StickerFormatWebp:
+		encodeStickerFormatWebp(encoder, value)
+
+	case *StickerFormatTgs:
+		encodeStickerFormatTgs(encoder, value)
+
+	case *StickerFormatWebm:
+		encodeStickerFormatWebm(encoder, value)
+
+	case *StickerTypeRegular:
+		encodeStickerTypeRegular(encoder, value)
+
+	case *StickerTypeMask:
+		encodeStickerTypeMask(encoder, value)
+
+	case *StickerTypeCustomEmoji:
+		encodeStickerTypeCustomEmoji(encoder, value)
+
+	case *StickerFullTypeRegular:
+		encodeStickerFullTypeRegular(encoder, value)
+
+	case *StickerFullTypeMask:
+		encodeStickerFullTypeMask(encoder, value)
+
+	case *StickerFullTypeCustomEmoji:
+		encodeStickerFullTypeCustomEmoji(encoder, value)
+
+	case *ClosedVectorPath:
+		encodeClosedVectorPath(encoder, value)
+
+	case *PollOption:
+		encodePollOption(encoder, value)
+
+	case *PollTypeRegular:
+		encodePollTypeRegular(encoder, value)
+
+	case *PollTypeQuiz:
+		encodePollTypeQuiz(encoder, value)
+
+	case *Animation:
+		encodeAnimation(encoder, value)
+
+	case *Audio:
+		encodeAudio(encoder, value)
+
+	case *Document:
+		encodeDocument(encoder, value)
+
+	case *Photo:
+		encodePhoto(encoder, value)
+
+	case *Sticker:
+		encodeSticker(encoder, value)
+
+	case *Video:
+		encodeVideo(encoder, value)
+
+	case *VideoNote:
+		encodeVideoNote(encoder, value)
+
+	case *VoiceNote:
+		encodeVoiceNote(encoder, value)
+
+	case *AnimatedEmoji:
+		encodeAnimatedEmoji(encoder, value)
+
+	case *Contact:
+		encodeContact(encoder, value)
+
+	case *Location:
+		encodeLocation(encoder, value)
+
+	case *Venue:
+		encodeVenue(encoder, value)
+
+	case *Game:
+		encodeGame(encoder, value)
+
+	case *WebApp:
+		encodeWebApp(encoder, value)
+
+	case *Poll:
+		encodePoll(encoder, value)
+
+	case *Background:
+		encodeBackground(encoder, value)
+
+	case *Backgrounds:
+		encodeBackgrounds(encoder, value)
+
+	case *ChatBackground:
+		encodeChatBackground(encoder, value)
+
+	case *ProfilePhoto:
+		encodeProfilePhoto(encoder, value)
+
+	case *ChatPhotoInfo:
+		encodeChatPhotoInfo(encoder, value)
+
+	case *UserTypeRegular:
+		encodeUserTypeRegular(encoder, value)
+
+	case *UserTypeDeleted:
+		encodeUserTypeDeleted(encoder, value)
+
+	case *UserTypeBot:
+		encodeUserTypeBot(encoder, value)
+
+	case *UserTypeUnknown:
+		encodeUserTypeUnknown(encoder, value)
+
+	case *AccessHashTypeUser:
+		encodeAccessHashTypeUser(encoder, value)
+
+	case *AccessHashTypeChannel:
+		encodeAccessHashTypeChannel(encoder, value)
+
+	case *AccessHash:
+		encodeAccessHash(encoder, value)
+
+	case *BotCommand:
+		encodeBotCommand(encoder, value)
+
+	case *BotCommands:
+		encodeBotCommands(encoder, value)
+
+	case *BotMenuButton:
+		encodeBotMenuButton(encoder, value)
+
+	case *ChatLocation:
+		encodeChatLocation(encoder, value)
+
+	case *ChatPhotoStickerTypeRegularOrMask:
+		encodeChatPhotoStickerTypeRegularOrMask(encoder, value)
+
+	case *ChatPhotoStickerTypeCustomEmoji:
+		encodeChatPhotoStickerTypeCustomEmoji(encoder, value)
+
+	case *ChatPhotoSticker:
+		encodeChatPhotoSticker(encoder, value)
+
+	case *AnimatedChatPhoto:
+		encodeAnimatedChatPhoto(encoder, value)
+
+	case *ChatPhoto:
+		encodeChatPhoto(encoder, value)
+
+	case *ChatPhotos:
+		encodeChatPhotos(encoder, value)
+
+	case *InputChatPhotoPrevious:
+		encodeInputChatPhotoPrevious(encoder, value)
+
+	case *InputChatPhotoStatic:
+		encodeInputChatPhotoStatic(encoder, value)
+
+	case *InputChatPhotoAnimation:
+		encodeInputChatPhotoAnimation(encoder, value)
+
+	case *InputChatPhotoSticker:
+		encodeInputChatPhotoSticker(encoder, value)
+
+	case *ChatPermissions:
+		encodeChatPermissions(encoder, value)
+
+	case *ChatAdministratorRights:
+		encodeChatAdministratorRights(encoder, value)
+
+	case *PremiumPaymentOption:
+		encodePremiumPaymentOption(encoder, value)
+
+	case *PremiumStatePaymentOption:
+		encodePremiumStatePaymentOption(encoder, value)
+
+	case *EmojiStatus:
+		encodeEmojiStatus(encoder, value)
+
+	case *EmojiStatuses:
+		encodeEmojiStatuses(encoder, value)
+
+	case *Usernames:
+		encodeUsernames(encoder, value)
+
+	case *User:
+		encodeUser(encoder, value)
+
+	case *BotInfo:
+		encodeBotInfo(encoder, value)
+
+	case *UserFullInfo:
+		encodeUserFullInfo(encoder, value)
+
+	case *Users:
+		encodeUsers(encoder, value)
+
+	case *ChatAdministrator:
+		encodeChatAdministrator(encoder, value)
+
+	case *ChatAdministrators:
+		encodeChatAdministrators(encoder, value)
+
+	case *ChatMemberStatusCreator:
+		encodeChatMemberStatusCreator(encoder, value)
+
+	case *ChatMemberStatusAdministrator:
+		encodeChatMemberStatusAdministrator(encoder, value)
+
+	case *ChatMemberStatusMember:
+		encodeChatMemberStatusMember(encoder, value)
+
+	case *ChatMemberStatusRestricted:
+		encodeChatMemberStatusRestricted(encoder, value)
+
+	case *ChatMemberStatusLeft:
+		encodeChatMemberStatusLeft(encoder, value)
+
+	case *ChatMemberStatusBanned:
+		encodeChatMemberStatusBanned(encoder, value)
+
+	case *ChatMember:
+		encodeChatMember(encoder, value)
+
+	case *ChatMembers:
+		encodeChatMembers(encoder, value)
+
+	case *ChatMembersFilterContacts:
+		encodeChatMembersFilterContacts(encoder, value)
+
+	case *ChatMembersFilterAdministrators:
+		encodeChatMembersFilterAdministrators(encoder, value)
+
+	case *ChatMembersFilterMembers:
+		encodeChatMembersFilterMembers(encoder, value)
+
+	case *ChatMembersFilterMention:
+		encodeChatMembersFilterMention(encoder, value)
+
+	case *ChatMembersFilterRestricted:
+		encodeChatMembersFilterRestricted(encoder, value)
+
+	case *ChatMembersFilterBanned:
+		encodeChatMembersFilterBanned(encoder, value)
+
+	case *ChatMembersFilterBots:
+		encodeChatMembersFilterBots(encoder, value)
+
+	case *SupergroupMembersFilterRecent:
+		encodeSupergroupMembersFilterRecent(encoder, value)
+
+	case *SupergroupMembersFilterContacts:
+		encodeSupergroupMembersFilterContacts(encoder, value)
+
+	case *SupergroupMembersFilterAdministrators:
+		encodeSupergroupMembersFilterAdministrators(encoder, value)
+
+	case *SupergroupMembersFilterSearch:
+		encodeSupergroupMembersFilterSearch(encoder, value)
+
+	case *SupergroupMembersFilterRestricted:
+		encodeSupergroupMembersFilterRestricted(encoder, value)
+
+	case *SupergroupMembersFilterBanned:
+		encodeSupergroupMembersFilterBanned(encoder, value)
+
+	case *SupergroupMembersFilterMention:
+		encodeSupergroupMembersFilterMention(encoder, value)
+
+	case *SupergroupMembersFilterBots:
+		encodeSupergroupMembersFilterBots(encoder, value)
+
+	case *ChatInviteLink:
+		encodeChatInviteLink(encoder, value)
+
+	case *ChatInviteLinks:
+		encodeChatInviteLinks(encoder, value)
+
+	case *ChatInviteLinkCount:
+		encodeChatInviteLinkCount(encoder, value)
+
+	case *ChatInviteLinkCounts:
+		encodeChatInviteLinkCounts(encoder, value)
+
+	case *ChatInviteLinkMember:
+		encodeChatInviteLinkMember(encoder, value)
+
+	case *ChatInviteLinkMembers:
+		encodeChatInviteLinkMembers(encoder, value)
+
+	case *ChatInviteLinkInfo:
+		encodeChatInviteLinkInfo(encoder, value)
+
+	case *ChatJoinRequest:
+		encodeChatJoinRequest(encoder, value)
+
+	case *ChatJoinRequests:
+		encodeChatJoinRequests(encoder, value)
+
+	case *ChatJoinRequestsInfo:
+		encodeChatJoinRequestsInfo(encoder, value)
+
+	case *BasicGroup:
+		encodeBasicGroup(encoder, value)
+
+	case *BasicGroupFullInfo:
+		encodeBasicGroupFullInfo(encoder, value)
+
+	case *Supergroup:
+		encodeSupergroup(encoder, value)
+
+	case *SupergroupFullInfo:
+		encodeSupergroupFullInfo(encoder, value)
+
+	case *SecretChatStatePending:
+		encodeSecretChatStatePending(encoder, value)
+
+	case *SecretChatStateReady:
+		encodeSecretChatStateReady(encoder, value)
+
+	case *SecretChatStateClosed:
+		encodeSecretChatStateClosed(encoder, value)
+
+	case *SecretChat:
+		encodeSecretChat(encoder, value)
+
+	case *MessageSenderUser:
+		encodeMessageSenderUser(encoder, value)
+
+	case *MessageSenderChat:
+		encodeMessageSenderChat(encoder, value)
+
+	case *MessageSenders:
+		encodeMessageSenders(encoder, value)
+
+	case *ChatMessageSender:
+		encodeChatMessageSender(encoder, value)
+
+	case *ChatMessageSenders:
+		encodeChatMessageSenders(encoder, value)
+
+	case *MessageViewer:
+		encodeMessageViewer(encoder, value)
+
+	case *MessageViewers:
+		encodeMessageViewers(encoder, value)
+
+	case *MessageForwardOriginUser:
+		encodeMessageForwardOriginUser(encoder, value)
+
+	case *MessageForwardOriginChat:
+		encodeMessageForwardOriginChat(encoder, value)
+
+	case *MessageForwardOriginHiddenUser:
+		encodeMessageForwardOriginHiddenUser(encoder, value)
+
+	case *MessageForwardOriginChannel:
+		encodeMessageForwardOriginChannel(encoder, value)
+
+	case *MessageForwardOriginMessageImport:
+		encodeMessageForwardOriginMessageImport(encoder, value)
+
+	case *ReactionTypeEmoji:
+		encodeReactionTypeEmoji(encoder, value)
+
+	case *ReactionTypeCustomEmoji:
+		encodeReactionTypeCustomEmoji(encoder, value)
+
+	case *MessageForwardInfo:
+		encodeMessageForwardInfo(encoder, value)
+
+	case *MessageReplyInfo:
+		encodeMessageReplyInfo(encoder, value)
+
+	case *MessageReaction:
+		encodeMessageReaction(encoder, value)
+
+	case *MessageInteractionInfo:
+		encodeMessageInteractionInfo(encoder, value)
+
+	case *UnreadReaction:
+		encodeUnreadReaction(encoder, value)
+
+	case *MessageSendingStatePending:
+		encodeMessageSendingStatePending(encoder, value)
+
+	case *MessageSendingStateFailed:
+		encodeMessageSendingStateFailed(encoder, value)
+
+	case *Message:
+		encodeMessage(encoder, value)
+
+	case *Messages:
+		encodeMessages(encoder, value)
+
+	case *FoundMessages:
+		encodeFoundMessages(encoder, value)
+
+	case *FoundChatMessages:
+		encodeFoundChatMessages(encoder, value)
+
+	case *MessagePosition:
+		encodeMessagePosition(encoder, value)
+
+	case *MessagePositions:
+		encodeMessagePositions(encoder, value)
+
+	case *MessageCalendarDay:
+		encodeMessageCalendarDay(encoder, value)
+
+	case *MessageCalendar:
+		encodeMessageCalendar(encoder, value)
+
+	case *MessageSourceChatHistory:
+		encodeMessageSourceChatHistory(encoder, value)
+
+	case *MessageSourceMessageThreadHistory:
+		encodeMessageSourceMessageThreadHistory(encoder, value)
+
+	case *MessageSourceForumTopicHistory:
+		encodeMessageSourceForumTopicHistory(encoder, value)
+
+	case *MessageSourceHistoryPreview:
+		encodeMessageSourceHistoryPreview(encoder, value)
+
+	case *MessageSourceChatList:
+		encodeMessageSourceChatList(encoder, value)
+
+	case *MessageSourceSearch:
+		encodeMessageSourceSearch(encoder, value)
+
+	case *MessageSourceChatEventLog:
+		encodeMessageSourceChatEventLog(encoder, value)
+
+	case *MessageSourceNotification:
+		encodeMessageSourceNotification(encoder, value)
+
+	case *MessageSourceOther:
+		encodeMessageSourceOther(encoder, value)
+
+	case *SponsoredMessage:
+		encodeSponsoredMessage(encoder, value)
+
+	case *SponsoredMessages:
+		encodeSponsoredMessages(encoder, value)
+
+	case *FileDownload:
+		encodeFileDownload(encoder, value)
+
+	case *DownloadedFileCounts:
+		encodeDownloadedFileCounts(encoder, value)
+
+	case *FoundFileDownloads:
+		encodeFoundFileDownloads(encoder, value)
+
+	case *NotificationSettingsScopePrivateChats:
+		encodeNotificationSettingsScopePrivateChats(encoder, value)
+
+	case *NotificationSettingsScopeGroupChats:
+		encodeNotificationSettingsScopeGroupChats(encoder, value)
+
+	case *NotificationSettingsScopeChannelChats:
+		encodeNotificationSettingsScopeChannelChats(encoder, value)
+
+	case *ChatNotificationSettings:
+		encodeChatNotificationSettings(encoder, value)
+
+	case *ScopeNotificationSettings:
+		encodeScopeNotificationSettings(encoder, value)
+
+	case *DraftMessage:
+		encodeDraftMessage(encoder, value)
+
+	case *ChatTypePrivate:
+		encodeChatTypePrivate(encoder, value)
+
+	case *ChatTypeBasicGroup:
+		encodeChatTypeBasicGroup(encoder, value)
+
+	case *ChatTypeSupergroup:
+		encodeChatTypeSupergroup(encoder, value)
+
+	case *ChatTypeSecret:
+		encodeChatTypeSecret(encoder, value)
+
+	case *ChatFolderIcon:
+		encodeChatFolderIcon(encoder, value)
+
+	case *ChatFolder:
+		encodeChatFolder(encoder, value)
+
+	case *ChatFolderInfo:
+		encodeChatFolderInfo(encoder, value)
+
+	case *ChatFolderInviteLink:
+		encodeChatFolderInviteLink(encoder, value)
+
+	case *ChatFolderInviteLinks:
+		encodeChatFolderInviteLinks(encoder, value)
+
+	case *ChatFolderInviteLinkInfo:
+		encodeChatFolderInviteLinkInfo(encoder, value)
+
+	case *RecommendedChatFolder:
+		encodeRecommendedChatFolder(encoder, value)
+
+	case *RecommendedChatFolders:
+		encodeRecommendedChatFolders(encoder, value)
+
+	case *ChatListMain:
+		encodeChatListMain(encoder, value)
+
+	case *ChatListArchive:
+		encodeChatListArchive(encoder, value)
+
+	case *ChatListFolder:
+		encodeChatListFolder(encoder, value)
+
+	case *ChatLists:
+		encodeChatLists(encoder, value)
+
+	case *ChatSourceMtprotoProxy:
+		encodeChatSourceMtprotoProxy(encoder, value)
+
+	case *ChatSourcePublicServiceAnnouncement:
+		encodeChatSourcePublicServiceAnnouncement(encoder, value)
+
+	case *ChatPosition:
+		encodeChatPosition(encoder, value)
+
+	case *ChatAvailableReactionsAll:
+		encodeChatAvailableReactionsAll(encoder, value)
+
+	case *ChatAvailableReactionsSome:
+		encodeChatAvailableReactionsSome(encoder, value)
+
+	case *VideoChat:
+		encodeVideoChat(encoder, value)
+
+	case *Chat:
+		encodeChat(encoder, value)
+
+	case *Chats:
+		encodeChats(encoder, value)
+
+	case *ChatNearby:
+		encodeChatNearby(encoder, value)
+
+	case *ChatsNearby:
+		encodeChatsNearby(encoder, value)
+
+	case *PublicChatTypeHasUsername:
+		encodePublicChatTypeHasUsername(encoder, value)
+
+	case *PublicChatTypeIsLocationBased:
+		encodePublicChatTypeIsLocationBased(encoder, value)
+
+	case *ChatActionBarReportSpam:
+		encodeChatActionBarReportSpam(encoder, value)
+
+	case *ChatActionBarReportUnrelatedLocation:
+		encodeChatActionBarReportUnrelatedLocation(encoder, value)
+
+	case *ChatActionBarInviteMembers:
+		encodeChatActionBarInviteMembers(encoder, value)
+
+	case *ChatActionBarReportAddBlock:
+		encodeChatActionBarReportAddBlock(encoder, value)
+
+	case *ChatActionBarAddContact:
+		encodeChatActionBarAddContact(encoder, value)
+
+	case *ChatActionBarSharePhoneNumber:
+		encodeChatActionBarSharePhoneNumber(encoder, value)
+
+	case *ChatActionBarJoinRequest:
+		encodeChatActionBarJoinRequest(encoder, value)
+
+	case *KeyboardButtonTypeText:
+		encodeKeyboardButtonTypeText(encoder, value)
+
+	case *KeyboardButtonTypeRequestPhoneNumber:
+		encodeKeyboardButtonTypeRequestPhoneNumber(encoder, value)
+
+	case *KeyboardButtonTypeRequestLocation:
+		encodeKeyboardButtonTypeRequestLocation(encoder, value)
+
+	case *KeyboardButtonTypeRequestPoll:
+		encodeKeyboardButtonTypeRequestPoll(encoder, value)
+
+	case *KeyboardButtonTypeRequestUser:
+		encodeKeyboardButtonTypeRequestUser(encoder, value)
+
+	case *KeyboardButtonTypeRequestChat:
+		encodeKeyboardButtonTypeRequestChat(encoder, value)
+
+	case *KeyboardButtonTypeWebApp:
+		encodeKeyboardButtonTypeWebApp(encoder, value)
+
+	case *KeyboardButton:
+		encodeKeyboardButton(encoder, value)
+
+	case *InlineKeyboardButtonTypeUrl:
+		encodeInlineKeyboardButtonTypeUrl(encoder, value)
+
+	case *InlineKeyboardButtonTypeLoginUrl:
+		encodeInlineKeyboardButtonTypeLoginUrl(encoder, value)
+
+	case *InlineKeyboardButtonTypeWebApp:
+		encodeInlineKeyboardButtonTypeWebApp(encoder, value)
+
+	case *InlineKeyboardButtonTypeCallback:
+		encodeInlineKeyboardButtonTypeCallback(encoder, value)
+
+	case *InlineKeyboardButtonTypeCallbackWithPassword:
+		encodeInlineKeyboardButtonTypeCallbackWithPassword(encoder, value)
+
+	case *InlineKeyboardButtonTypeCallbackGame:
+		encodeInlineKeyboardButtonTypeCallbackGame(encoder, value)
+
+	case *InlineKeyboardButtonTypeSwitchInline:
+		encodeInlineKeyboardButtonTypeSwitchInline(encoder, value)
+
+	case *InlineKeyboardButtonTypeBuy:
+		encodeInlineKeyboardButtonTypeBuy(encoder, value)
+
+	case *InlineKeyboardButtonTypeUser:
+		encodeInlineKeyboardButtonTypeUser(encoder, value)
+
+	case *InlineKeyboardButton:
+		encodeInlineKeyboardButton(encoder, value)
+
+	case *ReplyMarkupRemoveKeyboard:
+		encodeReplyMarkupRemoveKeyboard(encoder, value)
+
+	case *ReplyMarkupForceReply:
+		encodeReplyMarkupForceReply(encoder, value)
+
+	case *ReplyMarkupShowKeyboard:
+		encodeReplyMarkupShowKeyboard(encoder, value)
+
+	case *ReplyMarkupInlineKeyboard:
+		encodeReplyMarkupInlineKeyboard(encoder, value)
+
+	case *LoginUrlInfoOpen:
+		encodeLoginUrlInfoOpen(encoder, value)
+
+	case *LoginUrlInfoRequestConfirmation:
+		encodeLoginUrlInfoRequestConfirmation(encoder, value)
+
+	case *FoundWebApp:
+		encodeFoundWebApp(encoder, value)
+
+	case *WebAppInfo:
+		encodeWebAppInfo(encoder, value)
+
+	case *MessageThreadInfo:
+		encodeMessageThreadInfo(encoder, value)
+
+	case *ForumTopicIcon:
+		encodeForumTopicIcon(encoder, value)
+
+	case *ForumTopicInfo:
+		encodeForumTopicInfo(encoder, value)
+
+	case *ForumTopic:
+		encodeForumTopic(encoder, value)
+
+	case *ForumTopics:
+		encodeForumTopics(encoder, value)
+
+	case *RichTextPlain:
+		encodeRichTextPlain(encoder, value)
+
+	case *RichTextBold:
+		encodeRichTextBold(encoder, value)
+
+	case *RichTextItalic:
+		encodeRichTextItalic(encoder, value)
+
+	case *RichTextUnderline:
+		encodeRichTextUnderline(encoder, value)
+
+	case *RichTextStrikethrough:
+		encodeRichTextStrikethrough(encoder, value)
+
+	case *RichTextFixed:
+		encodeRichTextFixed(encoder, value)
+
+	case *RichTextUrl:
+		encodeRichTextUrl(encoder, value)
+
+	case *RichTextEmailAddress:
+		encodeRichTextEmailAddress(encoder, value)
+
+	case *RichTextSubscript:
+		encodeRichTextSubscript(encoder, value)
+
+	case *RichTextSuperscript:
+		encodeRichTextSuperscript(encoder, value)
+
+	case *RichTextMarked:
+		encodeRichTextMarked(encoder, value)
+
+	case *RichTextPhoneNumber:
+		encodeRichTextPhoneNumber(encoder, value)
+
+	case *RichTextIcon:
+		encodeRichTextIcon(encoder, value)
+
+	case *RichTextReference:
+		encodeRichTextReference(encoder, value)
+
+	case *RichTextAnchor:
+		encodeRichTextAnchor(encoder, value)
+
+	case *RichTextAnchorLink:
+		encodeRichTextAnchorLink(encoder, value)
+
+	case *RichTexts:
+		encodeRichTexts(encoder, value)
+
+	case *PageBlockCaption:
+		encodePageBlockCaption(encoder, value)
+
+	case *PageBlockListItem:
+		encodePageBlockListItem(encoder, value)
+
+	case *PageBlockHorizontalAlignmentLeft:
+		encodePageBlockHorizontalAlignmentLeft(encoder, value)
+
+	case *PageBlockHorizontalAlignmentCenter:
+		encodePageBlockHorizontalAlignmentCenter(encoder, value)
+
+	case *PageBlockHorizontalAlignmentRight:
+		encodePageBlockHorizontalAlignmentRight(encoder, value)
+
+	case *PageBlockVerticalAlignmentTop:
+		encodePageBlockVerticalAlignmentTop(encoder, value)
+
+	case *PageBlockVerticalAlignmentMiddle:
+		encodePageBlockVerticalAlignmentMiddle(encoder, value)
+
+	case *PageBlockVerticalAlignmentBottom:
+		encodePageBlockVerticalAlignmentBottom(encoder, value)
+
+	case *PageBlockTableCell:
+		encodePageBlockTableCell(encoder, value)
+
+	case *PageBlockRelatedArticle:
+		encodePageBlockRelatedArticle(encoder, value)
+
+	case *PageBlockTitle:
+		encodePageBlockTitle(encoder, value)
+
+	case *PageBlockSubtitle:
+		encodePageBlockSubtitle(encoder, value)
+
+	case *PageBlockAuthorDate:
+		encodePageBlockAuthorDate(encoder, value)
+
+	case *PageBlockHeader:
+		encodePageBlockHeader(encoder, value)
+
+	case *PageBlockSubheader:
+		encodePageBlockSubheader(encoder, value)
+
+	case *PageBlockKicker:
+		encodePageBlockKicker(encoder, value)
+
+	case *PageBlockParagraph:
+		encodePageBlockParagraph(encoder, value)
+
+	case *PageBlockPreformatted:
+		encodePageBlockPreformatted(encoder, value)
+
+	case *PageBlockFooter:
+		encodePageBlockFooter(encoder, value)
+
+	case *PageBlockDivider:
+		encodePageBlockDivider(encoder, value)
+
+	case *PageBlockAnchor:
+		encodePageBlockAnchor(encoder, value)
+
+	case *PageBlockList:
+		encodePageBlockList(encoder, value)
+
+	case *PageBlockBlockQuote:
+		encodePageBlockBlockQuote(encoder, value)
+
+	case *PageBlockPullQuote:
+		encodePageBlockPullQuote(encoder, value)
+
+	case *PageBlockAnimation:
+		encodePageBlockAnimation(encoder, value)
+
+	case *PageBlockAudio:
+		encodePageBlockAudio(encoder, value)
+
+	case *PageBlockPhoto:
+		encodePageBlockPhoto(encoder, value)
+
+	case *PageBlockVideo:
+		encodePageBlockVideo(encoder, value)
+
+	case *PageBlockVoiceNote:
+		encodePageBlockVoiceNote(encoder, value)
+
+	case *PageBlockCover:
+		encodePageBlockCover(encoder, value)
+
+	case *PageBlockEmbedded:
+		encodePageBlockEmbedded(encoder, value)
+
+	case *PageBlockEmbeddedPost:
+		encodePageBlockEmbeddedPost(encoder, value)
+
+	case *PageBlockCollage:
+		encodePageBlockCollage(encoder, value)
+
+	case *PageBlockSlideshow:
+		encodePageBlockSlideshow(encoder, value)
+
+	case *PageBlockChatLink:
+		encodePageBlockChatLink(encoder, value)
+
+	case *PageBlockTable:
+		encodePageBlockTable(encoder, value)
+
+	case *PageBlockDetails:
+		encodePageBlockDetails(encoder, value)
+
+	case *PageBlockRelatedArticles:
+		encodePageBlockRelatedArticles(encoder, value)
+
+	case *PageBlockMap:
+		encodePageBlockMap(encoder, value)
+
+	case *WebPageInstantView:
+		encodeWebPageInstantView(encoder, value)
+
+	case *WebPage:
+		encodeWebPage(encoder, value)
+
+	case *CountryInfo:
+		encodeCountryInfo(encoder, value)
+
+	case *Countries:
+		encodeCountries(encoder, value)
+
+	case *PhoneNumberInfo:
+		encodePhoneNumberInfo(encoder, value)
+
+	case *BankCardActionOpenUrl:
+		encodeBankCardActionOpenUrl(encoder, value)
+
+	case *BankCardInfo:
+		encodeBankCardInfo(encoder, value)
+
+	case *Address:
+		encodeAddress(encoder, value)
+
+	case *ThemeParameters:
+		encodeThemeParameters(encoder, value)
+
+	case *LabeledPricePart:
+		encodeLabeledPricePart(encoder, value)
+
+	case *Invoice:
+		encodeInvoice(encoder, value)
+
+	case *OrderInfo:
+		encodeOrderInfo(encoder, value)
+
+	case *ShippingOption:
+		encodeShippingOption(encoder, value)
+
+	case *SavedCredentials:
+		encodeSavedCredentials(encoder, value)
+
+	case *InputCredentialsSaved:
+		encodeInputCredentialsSaved(encoder, value)
+
+	case *InputCredentialsNew:
+		encodeInputCredentialsNew(encoder, value)
+
+	case *InputCredentialsApplePay:
+		encodeInputCredentialsApplePay(encoder, value)
+
+	case *InputCredentialsGooglePay:
+		encodeInputCredentialsGooglePay(encoder, value)
+
+	case *PaymentProviderSmartGlocal:
+		encodePaymentProviderSmartGlocal(encoder, value)
+
+	case *PaymentProviderStripe:
+		encodePaymentProviderStripe(encoder, value)
+
+	case *PaymentProviderOther:
+		encodePaymentProviderOther(encoder, value)
+
+	case *PaymentOption:
+		encodePaymentOption(encoder, value)
+
+	case *PaymentForm:
+		encodePaymentForm(encoder, value)
+
+	case *ValidatedOrderInfo:
+		encodeValidatedOrderInfo(encoder, value)
+
+	case *PaymentResult:
+		encodePaymentResult(encoder, value)
+
+	case *PaymentReceipt:
+		encodePaymentReceipt(encoder, value)
+
+	case *InputInvoiceMessage:
+		encodeInputInvoiceMessage(encoder, value)
+
+	case *InputInvoiceName:
+		encodeInputInvoiceName(encoder, value)
+
+	case *MessageExtendedMediaPreview:
+		encodeMessageExtendedMediaPreview(encoder, value)
+
+	case *MessageExtendedMediaPhoto:
+		encodeMessageExtendedMediaPhoto(encoder, value)
+
+	case *MessageExtendedMediaVideo:
+		encodeMessageExtendedMediaVideo(encoder, value)
+
+	case *MessageExtendedMediaUnsupported:
+		encodeMessageExtendedMediaUnsupported(encoder, value)
+
+	case *DatedFile:
+		encodeDatedFile(encoder, value)
+
+	case *PassportElementTypePersonalDetails:
+		encodePassportElementTypePersonalDetails(encoder, value)
+
+	case *PassportElementTypePassport:
+		encodePassportElementTypePassport(encoder, value)
+
+	case *PassportElementTypeDriverLicense:
+		encodePassportElementTypeDriverLicense(encoder, value)
+
+	case *PassportElementTypeIdentityCard:
+		encodePassportElementTypeIdentityCard(encoder, value)
+
+	case *PassportElementTypeInternalPassport:
+		encodePassportElementTypeInternalPassport(encoder, value)
+
+	case *PassportElementTypeAddress:
+		encodePassportElementTypeAddress(encoder, value)
+
+	case *PassportElementTypeUtilityBill:
+		encodePassportElementTypeUtilityBill(encoder, value)
+
+	case *PassportElementTypeBankStatement:
+		encodePassportElementTypeBankStatement(encoder, value)
+
+	case *PassportElementTypeRentalAgreement:
+		encodePassportElementTypeRentalAgreement(encoder, value)
+
+	case *PassportElementTypePassportRegistration:
+		encodePassportElementTypePassportRegistration(encoder, value)
+
+	case *PassportElementTypeTemporaryRegistration:
+		encodePassportElementTypeTemporaryRegistration(encoder, value)
+
+	case *PassportElementTypePhoneNumber:
+		encodePassportElementTypePhoneNumber(encoder, value)
+
+	case *PassportElementTypeEmailAddress:
+		encodePassportElementTypeEmailAddress(encoder, value)
+
+	case *Date:
+		encodeDate(encoder, value)
+
+	case *PersonalDetails:
+		encodePersonalDetails(encoder, value)
+
+	case *IdentityDocument:
+		encodeIdentityDocument(encoder, value)
+
+	case *InputIdentityDocument:
+		encodeInputIdentityDocument(encoder, value)
+
+	case *PersonalDocument:
+		encodePersonalDocument(encoder, value)
+
+	case *InputPersonalDocument:
+		encodeInputPersonalDocument(encoder, value)
+
+	case *PassportElementPersonalDetails:
+		encodePassportElementPersonalDetails(encoder, value)
+
+	case *PassportElementPassport:
+		encodePassportElementPassport(encoder, value)
+
+	case *PassportElementDriverLicense:
+		encodePassportElementDriverLicense(encoder, value)
+
+	case *PassportElementIdentityCard:
+		encodePassportElementIdentityCard(encoder, value)
+
+	case *PassportElementInternalPassport:
+		encodePassportElementInternalPassport(encoder, value)
+
+	case *PassportElementAddress:
+		encodePassportElementAddress(encoder, value)
+
+	case *PassportElementUtilityBill:
+		encodePassportElementUtilityBill(encoder, value)
+
+	case *PassportElementBankStatement:
+		encodePassportElementBankStatement(encoder, value)
+
+	case *PassportElementRentalAgreement:
+		encodePassportElementRentalAgreement(encoder, value)
+
+	case *PassportElementPassportRegistration:
+		encodePassportElementPassportRegistration(encoder, value)
+
+	case *PassportElementTemporaryRegistration:
+		encodePassportElementTemporaryRegistration(encoder, value)
+
+	case *PassportElementPhoneNumber:
+		encodePassportElementPhoneNumber(encoder, value)
+
+	case *PassportElementEmailAddress:
+		encodePassportElementEmailAddress(encoder, value)
+
+	case *InputPassportElementPersonalDetails:
+		encodeInputPassportElementPersonalDetails(encoder, value)
+
+	case *InputPassportElementPassport:
+		encodeInputPassportElementPassport(encoder, value)
+
+	case *InputPassportElementDriverLicense:
+		encodeInputPassportElementDriverLicense(encoder, value)
+
+	case *InputPassportElementIdentityCard:
+		encodeInputPassportElementIdentityCard(encoder, value)
+
+	case *InputPassportElementInternalPassport:
+		encodeInputPassportElementInternalPassport(encoder, value)
+
+	case *InputPassportElementAddress:
+		encodeInputPassportElementAddress(encoder, value)
+
+	case *InputPassportElementUtilityBill:
+		encodeInputPassportElementUtilityBill(encoder, value)
+
+	case *InputPassportElementBankStatement:
+		encodeInputPassportElementBankStatement(encoder, value)
+
+	case *InputPassportElementRentalAgreement:
+		encodeInputPassportElementRentalAgreement(encoder, value)
+
+	case *InputPassportElementPassportRegistration:
+		encodeInputPassportElementPassportRegistration(encoder, value)
+
+	case *InputPassportElementTemporaryRegistration:
+		encodeInputPassportElementTemporaryRegistration(encoder, value)
+
+	case *InputPassportElementPhoneNumber:
+		encodeInputPassportElementPhoneNumber(encoder, value)
+
+	case *InputPassportElementEmailAddress:
+		encodeInputPassportElementEmailAddress(encoder, value)
+
+	case *PassportElements:
+		encodePassportElements(encoder, value)
+
+	case *PassportElementErrorSourceUnspecified:
+		encodePassportElementErrorSourceUnspecified(encoder, value)
+
+	case *PassportElementErrorSourceDataField:
+		encodePassportElementErrorSourceDataField(encoder, value)
+
+	case *PassportElementErrorSourceFrontSide:
+		encodePassportElementErrorSourceFrontSide(encoder, value)
+
+	case *PassportElementErrorSourceReverseSide:
+		encodePassportElementErrorSourceReverseSide(encoder, value)
+
+	case *PassportElementErrorSourceSelfie:
+		encodePassportElementErrorSourceSelfie(encoder, value)
+
+	case *PassportElementErrorSourceTranslationFile:
+		encodePassportElementErrorSourceTranslationFile(encoder, value)
+
+	case *PassportElementErrorSourceTranslationFiles:
+		encodePassportElementErrorSourceTranslationFiles(encoder, value)
+
+	case *PassportElementErrorSourceFile:
+		encodePassportElementErrorSourceFile(encoder, value)
+
+	case *PassportElementErrorSourceFiles:
+		encodePassportElementErrorSourceFiles(encoder, value)
+
+	case *PassportElementError:
+		encodePassportElementError(encoder, value)
+
+	case *PassportSuitableElement:
+		encodePassportSuitableElement(encoder, value)
+
+	case *PassportRequiredElement:
+		encodePassportRequiredElement(encoder, value)
+
+	case *PassportAuthorizationForm:
+		encodePassportAuthorizationForm(encoder, value)
+
+	case *PassportElementsWithErrors:
+		encodePassportElementsWithErrors(encoder, value)
+
+	case *EncryptedCredentials:
+		encodeEncryptedCredentials(encoder, value)
+
+	case *EncryptedPassportElement:
+		encodeEncryptedPassportElement(encoder, value)
+
+	case *InputPassportElementErrorSourceUnspecified:
+		encodeInputPassportElementErrorSourceUnspecified(encoder, value)
+
+	case *InputPassportElementErrorSourceDataField:
+		encodeInputPassportElementErrorSourceDataField(encoder, value)
+
+	case *InputPassportElementErrorSourceFrontSide:
+		encodeInputPassportElementErrorSourceFrontSide(encoder, value)
+
+	case *InputPassportElementErrorSourceReverseSide:
+		encodeInputPassportElementErrorSourceReverseSide(encoder, value)
+
+	case *InputPassportElementErrorSourceSelfie:
+		encodeInputPassportElementErrorSourceSelfie(encoder, value)
+
+	case *InputPassportElementErrorSourceTranslationFile:
+		encodeInputPassportElementErrorSourceTranslationFile(encoder, value)
+
+	case *InputPassportElementErrorSourceTranslationFiles:
+		encodeInputPassportElementErrorSourceTranslationFiles(encoder, value)
+
+	case *InputPassportElementErrorSourceFile:
+		encodeInputPassportElementErrorSourceFile(encoder, value)
+
+	case *InputPassportElementErrorSourceFiles:
+		encodeInputPassportElementErrorSourceFiles(encoder, value)
+
+	case *InputPassportElementError:
+		encodeInputPassportElementError(encoder, value)
+
+	case *MessageText:
+		encodeMessageText(encoder, value)
+
+	case *MessageAnimation:
+		encodeMessageAnimation(encoder, value)
+
+	case *MessageAudio:
+		encodeMessageAudio(encoder, value)
+
+	case *MessageDocument:
+		encodeMessageDocument(encoder, value)
+
+	case *MessagePhoto:
+		encodeMessagePhoto(encoder, value)
+
+	case *MessageExpiredPhoto:
+		encodeMessageExpiredPhoto(encoder, value)
+
+	case *MessageSticker:
+		encodeMessageSticker(encoder, value)
+
+	case *MessageVideo:
+		encodeMessageVideo(encoder, value)
+
+	case *MessageExpiredVideo:
+		encodeMessageExpiredVideo(encoder, value)
+
+	case *MessageVideoNote:
+		encodeMessageVideoNote(encoder, value)
+
+	case *MessageVoiceNote:
+		encodeMessageVoiceNote(encoder, value)
+
+	case *MessageLocation:
+		encodeMessageLocation(encoder, value)
+
+	case *MessageVenue:
+		encodeMessageVenue(encoder, value)
+
+	case *MessageContact:
+		encodeMessageContact(encoder, value)
+
+	case *MessageAnimatedEmoji:
+		encodeMessageAnimatedEmoji(encoder, value)
+
+	case *MessageDice:
+		encodeMessageDice(encoder, value)
+
+	case *MessageGame:
+		encodeMessageGame(encoder, value)
+
+	case *MessagePoll:
+		encodeMessagePoll(encoder, value)
+
+	case *MessageInvoice:
+		encodeMessageInvoice(encoder, value)
+
+	case *MessageCall:
+		encodeMessageCall(encoder, value)
+
+	case *MessageVideoChatScheduled:
+		encodeMessageVideoChatScheduled(encoder, value)
+
+	case *MessageVideoChatStarted:
+		encodeMessageVideoChatStarted(encoder, value)
+
+	case *MessageVideoChatEnded:
+		encodeMessageVideoChatEnded(encoder, value)
+
+	case *MessageInviteVideoChatParticipants:
+		encodeMessageInviteVideoChatParticipants(encoder, value)
+
+	case *MessageBasicGroupChatCreate:
+		encodeMessageBasicGroupChatCreate(encoder, value)
+
+	case *MessageSupergroupChatCreate:
+		encodeMessageSupergroupChatCreate(encoder, value)
+
+	case *MessageChatChangeTitle:
+		encodeMessageChatChangeTitle(encoder, value)
+
+	case *MessageChatChangePhoto:
+		encodeMessageChatChangePhoto(encoder, value)
+
+	case *MessageChatDeletePhoto:
+		encodeMessageChatDeletePhoto(encoder, value)
+
+	case *MessageChatAddMembers:
+		encodeMessageChatAddMembers(encoder, value)
+
+	case *MessageChatJoinByLink:
+		encodeMessageChatJoinByLink(encoder, value)
+
+	case *MessageChatJoinByRequest:
+		encodeMessageChatJoinByRequest(encoder, value)
+
+	case *MessageChatDeleteMember:
+		encodeMessageChatDeleteMember(encoder, value)
+
+	case *MessageChatUpgradeTo:
+		encodeMessageChatUpgradeTo(encoder, value)
+
+	case *MessageChatUpgradeFrom:
+		encodeMessageChatUpgradeFrom(encoder, value)
+
+	case *MessagePinMessage:
+		encodeMessagePinMessage(encoder, value)
+
+	case *MessageScreenshotTaken:
+		encodeMessageScreenshotTaken(encoder, value)
+
+	case *MessageChatSetBackground:
+		encodeMessageChatSetBackground(encoder, value)
+
+	case *MessageChatSetTheme:
+		encodeMessageChatSetTheme(encoder, value)
+
+	case *MessageChatSetMessageAutoDeleteTime:
+		encodeMessageChatSetMessageAutoDeleteTime(encoder, value)
+
+	case *MessageForumTopicCreated:
+		encodeMessageForumTopicCreated(encoder, value)
+
+	case *MessageForumTopicEdited:
+		encodeMessageForumTopicEdited(encoder, value)
+
+	case *MessageForumTopicIsClosedToggled:
+		encodeMessageForumTopicIsClosedToggled(encoder, value)
+
+	case *MessageForumTopicIsHiddenToggled:
+		encodeMessageForumTopicIsHiddenToggled(encoder, value)
+
+	case *MessageSuggestProfilePhoto:
+		encodeMessageSuggestProfilePhoto(encoder, value)
+
+	case *MessageCustomServiceAction:
+		encodeMessageCustomServiceAction(encoder, value)
+
+	case *MessageGameScore:
+		encodeMessageGameScore(encoder, value)
+
+	case *MessagePaymentSuccessful:
+		encodeMessagePaymentSuccessful(encoder, value)
+
+	case *MessagePaymentSuccessfulBot:
+		encodeMessagePaymentSuccessfulBot(encoder, value)
+
+	case *MessageGiftedPremium:
+		encodeMessageGiftedPremium(encoder, value)
+
+	case *MessageContactRegistered:
+		encodeMessageContactRegistered(encoder, value)
+
+	case *MessageUserShared:
+		encodeMessageUserShared(encoder, value)
+
+	case *MessageChatShared:
+		encodeMessageChatShared(encoder, value)
+
+	case *MessageWebsiteConnected:
+		encodeMessageWebsiteConnected(encoder, value)
+
+	case *MessageBotWriteAccessAllowed:
+		encodeMessageBotWriteAccessAllowed(encoder, value)
+
+	case *MessageWebAppDataSent:
+		encodeMessageWebAppDataSent(encoder, value)
+
+	case *MessageWebAppDataReceived:
+		encodeMessageWebAppDataReceived(encoder, value)
+
+	case *MessagePassportDataSent:
+		encodeMessagePassportDataSent(encoder, value)
+
+	case *MessagePassportDataReceived:
+		encodeMessagePassportDataReceived(encoder, value)
+
+	case *MessageProximityAlertTriggered:
+		encodeMessageProximityAlertTriggered(encoder, value)
+
+	case *MessageUnsupported:
+		encodeMessageUnsupported(encoder, value)
+
+	case *TextEntityTypeMention:
+		encodeTextEntityTypeMention(encoder, value)
+
+	case *TextEntityTypeHashtag:
+		encodeTextEntityTypeHashtag(encoder, value)
+
+	case *TextEntityTypeCashtag:
+		encodeTextEntityTypeCashtag(encoder, value)
+
+	case *TextEntityTypeBotCommand:
+		encodeTextEntityTypeBotCommand(encoder, value)
+
+	case *TextEntityTypeUrl:
+		encodeTextEntityTypeUrl(encoder, value)
+
+	case *TextEntityTypeEmailAddress:
+		encodeTextEntityTypeEmailAddress(encoder, value)
+
+	case *TextEntityTypePhoneNumber:
+		encodeTextEntityTypePhoneNumber(encoder, value)
+
+	case *TextEntityTypeBankCardNumber:
+		encodeTextEntityTypeBankCardNumber(encoder, value)
+
+	case *TextEntityTypeBold:
+		encodeTextEntityTypeBold(encoder, value)
+
+	case *TextEntityTypeItalic:
+		encodeTextEntityTypeItalic(encoder, value)
+
+	case *TextEntityTypeUnderline:
+		encodeTextEntityTypeUnderline(encoder, value)
+
+	case *TextEntityTypeStrikethrough:
+		encodeTextEntityTypeStrikethrough(encoder, value)
+
+	case *TextEntityTypeSpoiler:
+		encodeTextEntityTypeSpoiler(encoder, value)
+
+	case *TextEntityTypeCode:
+		encodeTextEntityTypeCode(encoder, value)
+
+	case *TextEntityTypePre:
+		encodeTextEntityTypePre(encoder, value)
+
+	case *TextEntityTypePreCode:
+		encodeTextEntityTypePreCode(encoder, value)
+
+	case *TextEntityTypeTextUrl:
+		encodeTextEntityTypeTextUrl(encoder, value)
+
+	case *TextEntityTypeMentionName:
+		encodeTextEntityTypeMentionName(encoder, value)
+
+	case *TextEntityTypeCustomEmoji:
+		encodeTextEntityTypeCustomEmoji(encoder, value)
+
+	case *TextEntityTypeMediaTimestamp:
+		encodeTextEntityTypeMediaTimestamp(encoder, value)
+
+	case *InputThumbnail:
+		encodeInputThumbnail(encoder, value)
+
+	case *MessageSchedulingStateSendAtDate:
+		encodeMessageSchedulingStateSendAtDate(encoder, value)
+
+	case *MessageSchedulingStateSendWhenOnline:
+		encodeMessageSchedulingStateSendWhenOnline(encoder, value)
+
+	case *MessageSendOptions:
+		encodeMessageSendOptions(encoder, value)
+
+	case *MessageCopyOptions:
+		encodeMessageCopyOptions(encoder, value)
+
+	case *InputMessageText:
+		encodeInputMessageText(encoder, value)
+
+	case *InputMessageAnimation:
+		encodeInputMessageAnimation(encoder, value)
+
+	case *InputMessageAudio:
+		encodeInputMessageAudio(encoder, value)
+
+	case *InputMessageDocument:
+		encodeInputMessageDocument(encoder, value)
+
+	case *InputMessagePhoto:
+		encodeInputMessagePhoto(encoder, value)
+
+	case *InputMessageSticker:
+		encodeInputMessageSticker(encoder, value)
+
+	case *InputMessageVideo:
+		encodeInputMessageVideo(encoder, value)
+
+	case *InputMessageVideoNote:
+		encodeInputMessageVideoNote(encoder, value)
+
+	case *InputMessageVoiceNote:
+		encodeInputMessageVoiceNote(encoder, value)
+
+	case *InputMessageLocation:
+		encodeInputMessageLocation(encoder, value)
+
+	case *InputMessageVenue:
+		encodeInputMessageVenue(encoder, value)
+
+	case *InputMessageContact:
+		encodeInputMessageContact(encoder, value)
+
+	case *InputMessageDice:
+		encodeInputMessageDice(encoder, value)
+
+	case *InputMessageGame:
+		encodeInputMessageGame(encoder, value)
+
+	case *InputMessageInvoice:
+		encodeInputMessageInvoice(encoder, value)
+
+	case *InputMessagePoll:
+		encodeInputMessagePoll(encoder, value)
+
+	case *InputMessageForwarded:
+		encodeInputMessageForwarded(encoder, value)
+
+	case *SearchMessagesFilterEmpty:
+		encodeSearchMessagesFilterEmpty(encoder, value)
+
+	case *SearchMessagesFilterAnimation:
+		encodeSearchMessagesFilterAnimation(encoder, value)
+
+	case *SearchMessagesFilterAudio:
+		encodeSearchMessagesFilterAudio(encoder, value)
+
+	case *SearchMessagesFilterDocument:
+		encodeSearchMessagesFilterDocument(encoder, value)
+
+	case *SearchMessagesFilterPhoto:
+		encodeSearchMessagesFilterPhoto(encoder, value)
+
+	case *SearchMessagesFilterVideo:
+		encodeSearchMessagesFilterVideo(encoder, value)
+
+	case *SearchMessagesFilterVoiceNote:
+		encodeSearchMessagesFilterVoiceNote(encoder, value)
+
+	case *SearchMessagesFilterPhotoAndVideo:
+		encodeSearchMessagesFilterPhotoAndVideo(encoder, value)
+
+	case *SearchMessagesFilterUrl:
+		encodeSearchMessagesFilterUrl(encoder, value)
+
+	case *SearchMessagesFilterChatPhoto:
+		encodeSearchMessagesFilterChatPhoto(encoder, value)
+
+	case *SearchMessagesFilterVideoNote:
+		encodeSearchMessagesFilterVideoNote(encoder, value)
+
+	case *SearchMessagesFilterVoiceAndVideoNote:
+		encodeSearchMessagesFilterVoiceAndVideoNote(encoder, value)
+
+	case *SearchMessagesFilterMention:
+		encodeSearchMessagesFilterMention(encoder, value)
+
+	case *SearchMessagesFilterUnreadMention:
+		encodeSearchMessagesFilterUnreadMention(encoder, value)
+
+	case *SearchMessagesFilterUnreadReaction:
+		encodeSearchMessagesFilterUnreadReaction(encoder, value)
+
+	case *SearchMessagesFilterFailedToSend:
+		encodeSearchMessagesFilterFailedToSend(encoder, value)
+
+	case *SearchMessagesFilterPinned:
+		encodeSearchMessagesFilterPinned(encoder, value)
+
+	case *ChatActionTyping:
+		encodeChatActionTyping(encoder, value)
+
+	case *ChatActionRecordingVideo:
+		encodeChatActionRecordingVideo(encoder, value)
+
+	case *ChatActionUploadingVideo:
+		encodeChatActionUploadingVideo(encoder, value)
+
+	case *ChatActionRecordingVoiceNote:
+		encodeChatActionRecordingVoiceNote(encoder, value)
+
+	case *ChatActionUploadingVoiceNote:
+		encodeChatActionUploadingVoiceNote(encoder, value)
+
+	case *ChatActionUploadingPhoto:
+		encodeChatActionUploadingPhoto(encoder, value)
+
+	case *ChatActionUploadingDocument:
+		encodeChatActionUploadingDocument(encoder, value)
+
+	case *ChatActionChoosingSticker:
+		encodeChatActionChoosingSticker(encoder, value)
+
+	case *ChatActionChoosingLocation:
+		encodeChatActionChoosingLocation(encoder, value)
+
+	case *ChatActionChoosingContact:
+		encodeChatActionChoosingContact(encoder, value)
+
+	case *ChatActionStartPlayingGame:
+		encodeChatActionStartPlayingGame(encoder, value)
+
+	case *ChatActionRecordingVideoNote:
+		encodeChatActionRecordingVideoNote(encoder, value)
+
+	case *ChatActionUploadingVideoNote:
+		encodeChatActionUploadingVideoNote(encoder, value)
+
+	case *ChatActionWatchingAnimations:
+		encodeChatActionWatchingAnimations(encoder, value)
+
+	case *ChatActionCancel:
+		encodeChatActionCancel(encoder, value)
+
+	case *UserStatusEmpty:
+		encodeUserStatusEmpty(encoder, value)
+
+	case *UserStatusOnline:
+		encodeUserStatusOnline(encoder, value)
+
+	case *UserStatusOffline:
+		encodeUserStatusOffline(encoder, value)
+
+	case *UserStatusRecently:
+		encodeUserStatusRecently(encoder, value)
+
+	case *UserStatusLastWeek:
+		encodeUserStatusLastWeek(encoder, value)
+
+	case *UserStatusLastMonth:
+		encodeUserStatusLastMonth(encoder, value)
+
+	case *Stickers:
+		encodeStickers(encoder, value)
+
+	case *Emojis:
+		encodeEmojis(encoder, value)
+
+	case *StickerSet:
+		encodeStickerSet(encoder, value)
+
+	case *StickerSetInfo:
+		encodeStickerSetInfo(encoder, value)
+
+	case *StickerSets:
+		encodeStickerSets(encoder, value)
+
+	case *TrendingStickerSets:
+		encodeTrendingStickerSets(encoder, value)
+
+	case *EmojiCategory:
+		encodeEmojiCategory(encoder, value)
+
+	case *EmojiCategories:
+		encodeEmojiCategories(encoder, value)
+
+	case *EmojiCategoryTypeDefault:
+		encodeEmojiCategoryTypeDefault(encoder, value)
+
+	case *EmojiCategoryTypeEmojiStatus:
+		encodeEmojiCategoryTypeEmojiStatus(encoder, value)
+
+	case *EmojiCategoryTypeChatPhoto:
+		encodeEmojiCategoryTypeChatPhoto(encoder, value)
+
+	case *CallDiscardReasonEmpty:
+		encodeCallDiscardReasonEmpty(encoder, value)
+
+	case *CallDiscardReasonMissed:
+		encodeCallDiscardReasonMissed(encoder, value)
+
+	case *CallDiscardReasonDeclined:
+		encodeCallDiscardReasonDeclined(encoder, value)
+
+	case *CallDiscardReasonDisconnected:
+		encodeCallDiscardReasonDisconnected(encoder, value)
+
+	case *CallDiscardReasonHungUp:
+		encodeCallDiscardReasonHungUp(encoder, value)
+
+	case *CallProtocol:
+		encodeCallProtocol(encoder, value)
+
+	case *CallServerTypeTelegramReflector:
+		encodeCallServerTypeTelegramReflector(encoder, value)
+
+	case *CallServerTypeWebrtc:
+		encodeCallServerTypeWebrtc(encoder, value)
+
+	case *CallServer:
+		encodeCallServer(encoder, value)
+
+	case *CallId:
+		encodeCallId(encoder, value)
+
+	case *GroupCallId:
+		encodeGroupCallId(encoder, value)
+
+	case *CallStatePending:
+		encodeCallStatePending(encoder, value)
+
+	case *CallStateExchangingKeys:
+		encodeCallStateExchangingKeys(encoder, value)
+
+	case *CallStateReady:
+		encodeCallStateReady(encoder, value)
+
+	case *CallStateHangingUp:
+		encodeCallStateHangingUp(encoder, value)
+
+	case *CallStateDiscarded:
+		encodeCallStateDiscarded(encoder, value)
+
+	case *CallStateError:
+		encodeCallStateError(encoder, value)
+
+	case *GroupCallVideoQualityThumbnail:
+		encodeGroupCallVideoQualityThumbnail(encoder, value)
+
+	case *GroupCallVideoQualityMedium:
+		encodeGroupCallVideoQualityMedium(encoder, value)
+
+	case *GroupCallVideoQualityFull:
+		encodeGroupCallVideoQualityFull(encoder, value)
+
+	case *GroupCallStream:
+		encodeGroupCallStream(encoder, value)
+
+	case *GroupCallStreams:
+		encodeGroupCallStreams(encoder, value)
+
+	case *RtmpUrl:
+		encodeRtmpUrl(encoder, value)
+
+	case *GroupCallRecentSpeaker:
+		encodeGroupCallRecentSpeaker(encoder, value)
+
+	case *GroupCall:
+		encodeGroupCall(encoder, value)
+
+	case *GroupCallVideoSourceGroup:
+		encodeGroupCallVideoSourceGroup(encoder, value)
+
+	case *GroupCallParticipantVideoInfo:
+		encodeGroupCallParticipantVideoInfo(encoder, value)
+
+	case *GroupCallParticipant:
+		encodeGroupCallParticipant(encoder, value)
+
+	case *CallProblemEcho:
+		encodeCallProblemEcho(encoder, value)
+
+	case *CallProblemNoise:
+		encodeCallProblemNoise(encoder, value)
+
+	case *CallProblemInterruptions:
+		encodeCallProblemInterruptions(encoder, value)
+
+	case *CallProblemDistortedSpeech:
+		encodeCallProblemDistortedSpeech(encoder, value)
+
+	case *CallProblemSilentLocal:
+		encodeCallProblemSilentLocal(encoder, value)
+
+	case *CallProblemSilentRemote:
+		encodeCallProblemSilentRemote(encoder, value)
+
+	case *CallProblemDropped:
+		encodeCallProblemDropped(encoder, value)
+
+	case *CallProblemDistortedVideo:
+		encodeCallProblemDistortedVideo(encoder, value)
+
+	case *CallProblemPixelatedVideo:
+		encodeCallProblemPixelatedVideo(encoder, value)
+
+	case *Call:
+		encodeCall(encoder, value)
+
+	case *FirebaseAuthenticationSettingsAndroid:
+		encodeFirebaseAuthenticationSettingsAndroid(encoder, value)
+
+	case *FirebaseAuthenticationSettingsIos:
+		encodeFirebaseAuthenticationSettingsIos(encoder, value)
+
+	case *PhoneNumberAuthenticationSettings:
+		encodePhoneNumberAuthenticationSettings(encoder, value)
+
+	case *AddedReaction:
+		encodeAddedReaction(encoder, value)
+
+	case *AddedReactions:
+		encodeAddedReactions(encoder, value)
+
+	case *AvailableReaction:
+		encodeAvailableReaction(encoder, value)
+
+	case *AvailableReactions:
+		encodeAvailableReactions(encoder, value)
+
+	case *EmojiReaction:
+		encodeEmojiReaction(encoder, value)
+
+	case *Animations:
+		encodeAnimations(encoder, value)
+
+	case *DiceStickersRegular:
+		encodeDiceStickersRegular(encoder, value)
+
+	case *DiceStickersSlotMachine:
+		encodeDiceStickersSlotMachine(encoder, value)
+
+	case *ImportedContacts:
+		encodeImportedContacts(encoder, value)
+
+	case *SpeechRecognitionResultPending:
+		encodeSpeechRecognitionResultPending(encoder, value)
+
+	case *SpeechRecognitionResultText:
+		encodeSpeechRecognitionResultText(encoder, value)
+
+	case *SpeechRecognitionResultError:
+		encodeSpeechRecognitionResultError(encoder, value)
+
+	case *AttachmentMenuBotColor:
+		encodeAttachmentMenuBotColor(encoder, value)
+
+	case *AttachmentMenuBot:
+		encodeAttachmentMenuBot(encoder, value)
+
+	case *SentWebAppMessage:
+		encodeSentWebAppMessage(encoder, value)
+
+	case *HttpUrl:
+		encodeHttpUrl(encoder, value)
+
+	case *UserLink:
+		encodeUserLink(encoder, value)
+
+	case *InputInlineQueryResultAnimation:
+		encodeInputInlineQueryResultAnimation(encoder, value)
+
+	case *InputInlineQueryResultArticle:
+		encodeInputInlineQueryResultArticle(encoder, value)
+
+	case *InputInlineQueryResultAudio:
+		encodeInputInlineQueryResultAudio(encoder, value)
+
+	case *InputInlineQueryResultContact:
+		encodeInputInlineQueryResultContact(encoder, value)
+
+	case *InputInlineQueryResultDocument:
+		encodeInputInlineQueryResultDocument(encoder, value)
+
+	case *InputInlineQueryResultGame:
+		encodeInputInlineQueryResultGame(encoder, value)
+
+	case *InputInlineQueryResultLocation:
+		encodeInputInlineQueryResultLocation(encoder, value)
+
+	case *InputInlineQueryResultPhoto:
+		encodeInputInlineQueryResultPhoto(encoder, value)
+
+	case *InputInlineQueryResultSticker:
+		encodeInputInlineQueryResultSticker(encoder, value)
+
+	case *InputInlineQueryResultVenue:
+		encodeInputInlineQueryResultVenue(encoder, value)
+
+	case *InputInlineQueryResultVideo:
+		encodeInputInlineQueryResultVideo(encoder, value)
+
+	case *InputInlineQueryResultVoiceNote:
+		encodeInputInlineQueryResultVoiceNote(encoder, value)
+
+	case *InlineQueryResultArticle:
+		encodeInlineQueryResultArticle(encoder, value)
+
+	case *InlineQueryResultContact:
+		encodeInlineQueryResultContact(encoder, value)
+
+	case *InlineQueryResultLocation:
+		encodeInlineQueryResultLocation(encoder, value)
+
+	case *InlineQueryResultVenue:
+		encodeInlineQueryResultVenue(encoder, value)
+
+	case *InlineQueryResultGame:
+		encodeInlineQueryResultGame(encoder, value)
+
+	case *InlineQueryResultAnimation:
+		encodeInlineQueryResultAnimation(encoder, value)
+
+	case *InlineQueryResultAudio:
+		encodeInlineQueryResultAudio(encoder, value)
+
+	case *InlineQueryResultDocument:
+		encodeInlineQueryResultDocument(encoder, value)
+
+	case *InlineQueryResultPhoto:
+		encodeInlineQueryResultPhoto(encoder, value)
+
+	case *InlineQueryResultSticker:
+		encodeInlineQueryResultSticker(encoder, value)
+
+	case *InlineQueryResultVideo:
+		encodeInlineQueryResultVideo(encoder, value)
+
+	case *InlineQueryResultVoiceNote:
+		encodeInlineQueryResultVoiceNote(encoder, value)
+
+	case *InlineQueryResultsButtonTypeStartBot:
+		encodeInlineQueryResultsButtonTypeStartBot(encoder, value)
+
+	case *InlineQueryResultsButtonTypeWebApp:
+		encodeInlineQueryResultsButtonTypeWebApp(encoder, value)
+
+	case *InlineQueryResultsButton:
+		encodeInlineQueryResultsButton(encoder, value)
+
+	case *InlineQueryResults:
+		encodeInlineQueryResults(encoder, value)
+
+	case *CallbackQueryPayloadData:
+		encodeCallbackQueryPayloadData(encoder, value)
+
+	case *CallbackQueryPayloadDataWithPassword:
+		encodeCallbackQueryPayloadDataWithPassword(encoder, value)
+
+	case *CallbackQueryPayloadGame:
+		encodeCallbackQueryPayloadGame(encoder, value)
+
+	case *CallbackQueryAnswer:
+		encodeCallbackQueryAnswer(encoder, value)
+
+	case *CustomRequestResult:
+		encodeCustomRequestResult(encoder, value)
+
+	case *GameHighScore:
+		encodeGameHighScore(encoder, value)
+
+	case *GameHighScores:
+		encodeGameHighScores(encoder, value)
+
+	case *ChatEventMessageEdited:
+		encodeChatEventMessageEdited(encoder, value)
+
+	case *ChatEventMessageDeleted:
+		encodeChatEventMessageDeleted(encoder, value)
+
+	case *ChatEventMessagePinned:
+		encodeChatEventMessagePinned(encoder, value)
+
+	case *ChatEventMessageUnpinned:
+		encodeChatEventMessageUnpinned(encoder, value)
+
+	case *ChatEventPollStopped:
+		encodeChatEventPollStopped(encoder, value)
+
+	case *ChatEventMemberJoined:
+		encodeChatEventMemberJoined(encoder, value)
+
+	case *ChatEventMemberJoinedByInviteLink:
+		encodeChatEventMemberJoinedByInviteLink(encoder, value)
+
+	case *ChatEventMemberJoinedByRequest:
+		encodeChatEventMemberJoinedByRequest(encoder, value)
+
+	case *ChatEventMemberInvited:
+		encodeChatEventMemberInvited(encoder, value)
+
+	case *ChatEventMemberLeft:
+		encodeChatEventMemberLeft(encoder, value)
+
+	case *ChatEventMemberPromoted:
+		encodeChatEventMemberPromoted(encoder, value)
+
+	case *ChatEventMemberRestricted:
+		encodeChatEventMemberRestricted(encoder, value)
+
+	case *ChatEventAvailableReactionsChanged:
+		encodeChatEventAvailableReactionsChanged(encoder, value)
+
+	case *ChatEventDescriptionChanged:
+		encodeChatEventDescriptionChanged(encoder, value)
+
+	case *ChatEventLinkedChatChanged:
+		encodeChatEventLinkedChatChanged(encoder, value)
+
+	case *ChatEventLocationChanged:
+		encodeChatEventLocationChanged(encoder, value)
+
+	case *ChatEventMessageAutoDeleteTimeChanged:
+		encodeChatEventMessageAutoDeleteTimeChanged(encoder, value)
+
+	case *ChatEventPermissionsChanged:
+		encodeChatEventPermissionsChanged(encoder, value)
+
+	case *ChatEventPhotoChanged:
+		encodeChatEventPhotoChanged(encoder, value)
+
+	case *ChatEventSlowModeDelayChanged:
+		encodeChatEventSlowModeDelayChanged(encoder, value)
+
+	case *ChatEventStickerSetChanged:
+		encodeChatEventStickerSetChanged(encoder, value)
+
+	case *ChatEventTitleChanged:
+		encodeChatEventTitleChanged(encoder, value)
+
+	case *ChatEventUsernameChanged:
+		encodeChatEventUsernameChanged(encoder, value)
+
+	case *ChatEventActiveUsernamesChanged:
+		encodeChatEventActiveUsernamesChanged(encoder, value)
+
+	case *ChatEventHasProtectedContentToggled:
+		encodeChatEventHasProtectedContentToggled(encoder, value)
+
+	case *ChatEventInvitesToggled:
+		encodeChatEventInvitesToggled(encoder, value)
+
+	case *ChatEventIsAllHistoryAvailableToggled:
+		encodeChatEventIsAllHistoryAvailableToggled(encoder, value)
+
+	case *ChatEventHasAggressiveAntiSpamEnabledToggled:
+		encodeChatEventHasAggressiveAntiSpamEnabledToggled(encoder, value)
+
+	case *ChatEventSignMessagesToggled:
+		encodeChatEventSignMessagesToggled(encoder, value)
+
+	case *ChatEventInviteLinkEdited:
+		encodeChatEventInviteLinkEdited(encoder, value)
+
+	case *ChatEventInviteLinkRevoked:
+		encodeChatEventInviteLinkRevoked(encoder, value)
+
+	case *ChatEventInviteLinkDeleted:
+		encodeChatEventInviteLinkDeleted(encoder, value)
+
+	case *ChatEventVideoChatCreated:
+		encodeChatEventVideoChatCreated(encoder, value)
+
+	case *ChatEventVideoChatEnded:
+		encodeChatEventVideoChatEnded(encoder, value)
+
+	case *ChatEventVideoChatMuteNewParticipantsToggled:
+		encodeChatEventVideoChatMuteNewParticipantsToggled(encoder, value)
+
+	case *ChatEventVideoChatParticipantIsMutedToggled:
+		encodeChatEventVideoChatParticipantIsMutedToggled(encoder, value)
+
+	case *ChatEventVideoChatParticipantVolumeLevelChanged:
+		encodeChatEventVideoChatParticipantVolumeLevelChanged(encoder, value)
+
+	case *ChatEventIsForumToggled:
+		encodeChatEventIsForumToggled(encoder, value)
+
+	case *ChatEventForumTopicCreated:
+		encodeChatEventForumTopicCreated(encoder, value)
+
+	case *ChatEventForumTopicEdited:
+		encodeChatEventForumTopicEdited(encoder, value)
+
+	case *ChatEventForumTopicToggleIsClosed:
+		encodeChatEventForumTopicToggleIsClosed(encoder, value)
+
+	case *ChatEventForumTopicToggleIsHidden:
+		encodeChatEventForumTopicToggleIsHidden(encoder, value)
+
+	case *ChatEventForumTopicDeleted:
+		encodeChatEventForumTopicDeleted(encoder, value)
+
+	case *ChatEventForumTopicPinned:
+		encodeChatEventForumTopicPinned(encoder, value)
+
+	case *ChatEvent:
+		encodeChatEvent(encoder, value)
+
+	case *ChatEvents:
+		encodeChatEvents(encoder, value)
+
+	case *ChatEventLogFilters:
+		encodeChatEventLogFilters(encoder, value)
+
+	case *LanguagePackStringValueOrdinary:
+		encodeLanguagePackStringValueOrdinary(encoder, value)
+
+	case *LanguagePackStringValuePluralized:
+		encodeLanguagePackStringValuePluralized(encoder, value)
+
+	case *LanguagePackStringValueDeleted:
+		encodeLanguagePackStringValueDeleted(encoder, value)
+
+	case *LanguagePackString:
+		encodeLanguagePackString(encoder, value)
+
+	case *LanguagePackStrings:
+		encodeLanguagePackStrings(encoder, value)
+
+	case *LanguagePackInfo:
+		encodeLanguagePackInfo(encoder, value)
+
+	case *LocalizationTargetInfo:
+		encodeLocalizationTargetInfo(encoder, value)
+
+	case *PremiumLimitTypeSupergroupCount:
+		encodePremiumLimitTypeSupergroupCount(encoder, value)
+
+	case *PremiumLimitTypePinnedChatCount:
+		encodePremiumLimitTypePinnedChatCount(encoder, value)
+
+	case *PremiumLimitTypeCreatedPublicChatCount:
+		encodePremiumLimitTypeCreatedPublicChatCount(encoder, value)
+
+	case *PremiumLimitTypeSavedAnimationCount:
+		encodePremiumLimitTypeSavedAnimationCount(encoder, value)
+
+	case *PremiumLimitTypeFavoriteStickerCount:
+		encodePremiumLimitTypeFavoriteStickerCount(encoder, value)
+
+	case *PremiumLimitTypeChatFolderCount:
+		encodePremiumLimitTypeChatFolderCount(encoder, value)
+
+	case *PremiumLimitTypeChatFolderChosenChatCount:
+		encodePremiumLimitTypeChatFolderChosenChatCount(encoder, value)
+
+	case *PremiumLimitTypePinnedArchivedChatCount:
+		encodePremiumLimitTypePinnedArchivedChatCount(encoder, value)
+
+	case *PremiumLimitTypeCaptionLength:
+		encodePremiumLimitTypeCaptionLength(encoder, value)
+
+	case *PremiumLimitTypeBioLength:
+		encodePremiumLimitTypeBioLength(encoder, value)
+
+	case *PremiumLimitTypeChatFolderInviteLinkCount:
+		encodePremiumLimitTypeChatFolderInviteLinkCount(encoder, value)
+
+	case *PremiumLimitTypeShareableChatFolderCount:
+		encodePremiumLimitTypeShareableChatFolderCount(encoder, value)
+
+	case *PremiumFeatureIncreasedLimits:
+		encodePremiumFeatureIncreasedLimits(encoder, value)
+
+	case *PremiumFeatureIncreasedUploadFileSize:
+		encodePremiumFeatureIncreasedUploadFileSize(encoder, value)
+
+	case *PremiumFeatureImprovedDownloadSpeed:
+		encodePremiumFeatureImprovedDownloadSpeed(encoder, value)
+
+	case *PremiumFeatureVoiceRecognition:
+		encodePremiumFeatureVoiceRecognition(encoder, value)
+
+	case *PremiumFeatureDisabledAds:
+		encodePremiumFeatureDisabledAds(encoder, value)
+
+	case *PremiumFeatureUniqueReactions:
+		encodePremiumFeatureUniqueReactions(encoder, value)
+
+	case *PremiumFeatureUniqueStickers:
+		encodePremiumFeatureUniqueStickers(encoder, value)
+
+	case *PremiumFeatureCustomEmoji:
+		encodePremiumFeatureCustomEmoji(encoder, value)
+
+	case *PremiumFeatureAdvancedChatManagement:
+		encodePremiumFeatureAdvancedChatManagement(encoder, value)
+
+	case *PremiumFeatureProfileBadge:
+		encodePremiumFeatureProfileBadge(encoder, value)
+
+	case *PremiumFeatureEmojiStatus:
+		encodePremiumFeatureEmojiStatus(encoder, value)
+
+	case *PremiumFeatureAnimatedProfilePhoto:
+		encodePremiumFeatureAnimatedProfilePhoto(encoder, value)
+
+	case *PremiumFeatureForumTopicIcon:
+		encodePremiumFeatureForumTopicIcon(encoder, value)
+
+	case *PremiumFeatureAppIcons:
+		encodePremiumFeatureAppIcons(encoder, value)
+
+	case *PremiumFeatureRealTimeChatTranslation:
+		encodePremiumFeatureRealTimeChatTranslation(encoder, value)
+
+	case *PremiumLimit:
+		encodePremiumLimit(encoder, value)
+
+	case *PremiumFeatures:
+		encodePremiumFeatures(encoder, value)
+
+	case *PremiumSourceLimitExceeded:
+		encodePremiumSourceLimitExceeded(encoder, value)
+
+	case *PremiumSourceFeature:
+		encodePremiumSourceFeature(encoder, value)
+
+	case *PremiumSourceLink:
+		encodePremiumSourceLink(encoder, value)
+
+	case *PremiumSourceSettings:
+		encodePremiumSourceSettings(encoder, value)
+
+	case *PremiumFeaturePromotionAnimation:
+		encodePremiumFeaturePromotionAnimation(encoder, value)
+
+	case *PremiumState:
+		encodePremiumState(encoder, value)
+
+	case *StorePaymentPurposePremiumSubscription:
+		encodeStorePaymentPurposePremiumSubscription(encoder, value)
+
+	case *StorePaymentPurposeGiftedPremium:
+		encodeStorePaymentPurposeGiftedPremium(encoder, value)
+
+	case *DeviceTokenFirebaseCloudMessaging:
+		encodeDeviceTokenFirebaseCloudMessaging(encoder, value)
+
+	case *DeviceTokenApplePush:
+		encodeDeviceTokenApplePush(encoder, value)
+
+	case *DeviceTokenApplePushVoIP:
+		encodeDeviceTokenApplePushVoIP(encoder, value)
+
+	case *DeviceTokenWindowsPush:
+		encodeDeviceTokenWindowsPush(encoder, value)
+
+	case *DeviceTokenMicrosoftPush:
+		encodeDeviceTokenMicrosoftPush(encoder, value)
+
+	case *DeviceTokenMicrosoftPushVoIP:
+		encodeDeviceTokenMicrosoftPushVoIP(encoder, value)
+
+	case *DeviceTokenWebPush:
+		encodeDeviceTokenWebPush(encoder, value)
+
+	case *DeviceTokenSimplePush:
+		encodeDeviceTokenSimplePush(encoder, value)
+
+	case *DeviceTokenUbuntuPush:
+		encodeDeviceTokenUbuntuPush(encoder, value)
+
+	case *DeviceTokenBlackBerryPush:
+		encodeDeviceTokenBlackBerryPush(encoder, value)
+
+	case *DeviceTokenTizenPush:
+		encodeDeviceTokenTizenPush(encoder, value)
+
+	case *DeviceTokenHuaweiPush:
+		encodeDeviceTokenHuaweiPush(encoder, value)
+
+	case *PushReceiverId:
+		encodePushReceiverId(encoder, value)
+
+	case *BackgroundFillSolid:
+		encodeBackgroundFillSolid(encoder, value)
+
+	case *BackgroundFillGradient:
+		encodeBackgroundFillGradient(encoder, value)
+
+	case *BackgroundFillFreeformGradient:
+		encodeBackgroundFillFreeformGradient(encoder, value)
+
+	case *BackgroundTypeWallpaper:
+		encodeBackgroundTypeWallpaper(encoder, value)
+
+	case *BackgroundTypePattern:
+		encodeBackgroundTypePattern(encoder, value)
+
+	case *BackgroundTypeFill:
+		encodeBackgroundTypeFill(encoder, value)
+
+	case *InputBackgroundLocal:
+		encodeInputBackgroundLocal(encoder, value)
+
+	case *InputBackgroundRemote:
+		encodeInputBackgroundRemote(encoder, value)
+
+	case *InputBackgroundPrevious:
+		encodeInputBackgroundPrevious(encoder, value)
+
+	case *ThemeSettings:
+		encodeThemeSettings(encoder, value)
+
+	case *ChatTheme:
+		encodeChatTheme(encoder, value)
+
+	case *Hashtags:
+		encodeHashtags(encoder, value)
+
+	case *CanTransferOwnershipResultOk:
+		encodeCanTransferOwnershipResultOk(encoder, value)
+
+	case *CanTransferOwnershipResultPasswordNeeded:
+		encodeCanTransferOwnershipResultPasswordNeeded(encoder, value)
+
+	case *CanTransferOwnershipResultPasswordTooFresh:
+		encodeCanTransferOwnershipResultPasswordTooFresh(encoder, value)
+
+	case *CanTransferOwnershipResultSessionTooFresh:
+		encodeCanTransferOwnershipResultSessionTooFresh(encoder, value)
+
+	case *CheckChatUsernameResultOk:
+		encodeCheckChatUsernameResultOk(encoder, value)
+
+	case *CheckChatUsernameResultUsernameInvalid:
+		encodeCheckChatUsernameResultUsernameInvalid(encoder, value)
+
+	case *CheckChatUsernameResultUsernameOccupied:
+		encodeCheckChatUsernameResultUsernameOccupied(encoder, value)
+
+	case *CheckChatUsernameResultUsernamePurchasable:
+		encodeCheckChatUsernameResultUsernamePurchasable(encoder, value)
+
+	case *CheckChatUsernameResultPublicChatsTooMany:
+		encodeCheckChatUsernameResultPublicChatsTooMany(encoder, value)
+
+	case *CheckChatUsernameResultPublicGroupsUnavailable:
+		encodeCheckChatUsernameResultPublicGroupsUnavailable(encoder, value)
+
+	case *CheckStickerSetNameResultOk:
+		encodeCheckStickerSetNameResultOk(encoder, value)
+
+	case *CheckStickerSetNameResultNameInvalid:
+		encodeCheckStickerSetNameResultNameInvalid(encoder, value)
+
+	case *CheckStickerSetNameResultNameOccupied:
+		encodeCheckStickerSetNameResultNameOccupied(encoder, value)
+
+	case *ResetPasswordResultOk:
+		encodeResetPasswordResultOk(encoder, value)
+
+	case *ResetPasswordResultPending:
+		encodeResetPasswordResultPending(encoder, value)
+
+	case *ResetPasswordResultDeclined:
+		encodeResetPasswordResultDeclined(encoder, value)
+
+	case *MessageFileTypePrivate:
+		encodeMessageFileTypePrivate(encoder, value)
+
+	case *MessageFileTypeGroup:
+		encodeMessageFileTypeGroup(encoder, value)
+
+	case *MessageFileTypeUnknown:
+		encodeMessageFileTypeUnknown(encoder, value)
+
+	case *PushMessageContentHidden:
+		encodePushMessageContentHidden(encoder, value)
+
+	case *PushMessageContentAnimation:
+		encodePushMessageContentAnimation(encoder, value)
+
+	case *PushMessageContentAudio:
+		encodePushMessageContentAudio(encoder, value)
+
+	case *PushMessageContentContact:
+		encodePushMessageContentContact(encoder, value)
+
+	case *PushMessageContentContactRegistered:
+		encodePushMessageContentContactRegistered(encoder, value)
+
+	case *PushMessageContentDocument:
+		encodePushMessageContentDocument(encoder, value)
+
+	case *PushMessageContentGame:
+		encodePushMessageContentGame(encoder, value)
+
+	case *PushMessageContentGameScore:
+		encodePushMessageContentGameScore(encoder, value)
+
+	case *PushMessageContentInvoice:
+		encodePushMessageContentInvoice(encoder, value)
+
+	case *PushMessageContentLocation:
+		encodePushMessageContentLocation(encoder, value)
+
+	case *PushMessageContentPhoto:
+		encodePushMessageContentPhoto(encoder, value)
+
+	case *PushMessageContentPoll:
+		encodePushMessageContentPoll(encoder, value)
+
+	case *PushMessageContentScreenshotTaken:
+		encodePushMessageContentScreenshotTaken(encoder, value)
+
+	case *PushMessageContentSticker:
+		encodePushMessageContentSticker(encoder, value)
+
+	case *PushMessageContentText:
+		encodePushMessageContentText(encoder, value)
+
+	case *PushMessageContentVideo:
+		encodePushMessageContentVideo(encoder, value)
+
+	case *PushMessageContentVideoNote:
+		encodePushMessageContentVideoNote(encoder, value)
+
+	case *PushMessageContentVoiceNote:
+		encodePushMessageContentVoiceNote(encoder, value)
+
+	case *PushMessageContentBasicGroupChatCreate:
+		encodePushMessageContentBasicGroupChatCreate(encoder, value)
+
+	case *PushMessageContentChatAddMembers:
+		encodePushMessageContentChatAddMembers(encoder, value)
+
+	case *PushMessageContentChatChangePhoto:
+		encodePushMessageContentChatChangePhoto(encoder, value)
+
+	case *PushMessageContentChatChangeTitle:
+		encodePushMessageContentChatChangeTitle(encoder, value)
+
+	case *PushMessageContentChatSetBackground:
+		encodePushMessageContentChatSetBackground(encoder, value)
+
+	case *PushMessageContentChatSetTheme:
+		encodePushMessageContentChatSetTheme(encoder, value)
+
+	case *PushMessageContentChatDeleteMember:
+		encodePushMessageContentChatDeleteMember(encoder, value)
+
+	case *PushMessageContentChatJoinByLink:
+		encodePushMessageContentChatJoinByLink(encoder, value)
+
+	case *PushMessageContentChatJoinByRequest:
+		encodePushMessageContentChatJoinByRequest(encoder, value)
+
+	case *PushMessageContentRecurringPayment:
+		encodePushMessageContentRecurringPayment(encoder, value)
+
+	case *PushMessageContentSuggestProfilePhoto:
+		encodePushMessageContentSuggestProfilePhoto(encoder, value)
+
+	case *PushMessageContentMessageForwards:
+		encodePushMessageContentMessageForwards(encoder, value)
+
+	case *PushMessageContentMediaAlbum:
+		encodePushMessageContentMediaAlbum(encoder, value)
+
+	case *NotificationTypeNewMessage:
+		encodeNotificationTypeNewMessage(encoder, value)
+
+	case *NotificationTypeNewSecretChat:
+		encodeNotificationTypeNewSecretChat(encoder, value)
+
+	case *NotificationTypeNewCall:
+		encodeNotificationTypeNewCall(encoder, value)
+
+	case *NotificationTypeNewPushMessage:
+		encodeNotificationTypeNewPushMessage(encoder, value)
+
+	case *NotificationGroupTypeMessages:
+		encodeNotificationGroupTypeMessages(encoder, value)
+
+	case *NotificationGroupTypeMentions:
+		encodeNotificationGroupTypeMentions(encoder, value)
+
+	case *NotificationGroupTypeSecretChat:
+		encodeNotificationGroupTypeSecretChat(encoder, value)
+
+	case *NotificationGroupTypeCalls:
+		encodeNotificationGroupTypeCalls(encoder, value)
+
+	case *NotificationSound:
+		encodeNotificationSound(encoder, value)
+
+	case *NotificationSounds:
+		encodeNotificationSounds(encoder, value)
+
+	case *Notification:
+		encodeNotification(encoder, value)
+
+	case *NotificationGroup:
+		encodeNotificationGroup(encoder, value)
+
+	case *OptionValueBoolean:
+		encodeOptionValueBoolean(encoder, value)
+
+	case *OptionValueEmpty:
+		encodeOptionValueEmpty(encoder, value)
+
+	case *OptionValueInteger:
+		encodeOptionValueInteger(encoder, value)
+
+	case *OptionValueString:
+		encodeOptionValueString(encoder, value)
+
+	case *JsonObjectMember:
+		encodeJsonObjectMember(encoder, value)
+
+	case *JsonValueNull:
+		encodeJsonValueNull(encoder, value)
+
+	case *JsonValueBoolean:
+		encodeJsonValueBoolean(encoder, value)
+
+	case *JsonValueNumber:
+		encodeJsonValueNumber(encoder, value)
+
+	case *JsonValueString:
+		encodeJsonValueString(encoder, value)
+
+	case *JsonValueArray:
+		encodeJsonValueArray(encoder, value)
+
+	case *JsonValueObject:
+		encodeJsonValueObject(encoder, value)
+
+	case *UserPrivacySettingRuleAllowAll:
+		encodeUserPrivacySettingRuleAllowAll(encoder, value)
+
+	case *UserPrivacySettingRuleAllowContacts:
+		encodeUserPrivacySettingRuleAllowContacts(encoder, value)
+
+	case *UserPrivacySettingRuleAllowUsers:
+		encodeUserPrivacySettingRuleAllowUsers(encoder, value)
+
+	case *UserPrivacySettingRuleAllowChatMembers:
+		encodeUserPrivacySettingRuleAllowChatMembers(encoder, value)
+
+	case *UserPrivacySettingRuleRestrictAll:
+		encodeUserPrivacySettingRuleRestrictAll(encoder, value)
+
+	case *UserPrivacySettingRuleRestrictContacts:
+		encodeUserPrivacySettingRuleRestrictContacts(encoder, value)
+
+	case *UserPrivacySettingRuleRestrictUsers:
+		encodeUserPrivacySettingRuleRestrictUsers(encoder, value)
+
+	case *UserPrivacySettingRuleRestrictChatMembers:
+		encodeUserPrivacySettingRuleRestrictChatMembers(encoder, value)
+
+	case *UserPrivacySettingRules:
+		encodeUserPrivacySettingRules(encoder, value)
+
+	case *UserPrivacySettingShowStatus:
+		encodeUserPrivacySettingShowStatus(encoder, value)
+
+	case *UserPrivacySettingShowProfilePhoto:
+		encodeUserPrivacySettingShowProfilePhoto(encoder, value)
+
+	case *UserPrivacySettingShowLinkInForwardedMessages:
+		encodeUserPrivacySettingShowLinkInForwardedMessages(encoder, value)
+
+	case *UserPrivacySettingShowPhoneNumber:
+		encodeUserPrivacySettingShowPhoneNumber(encoder, value)
+
+	case *UserPrivacySettingAllowChatInvites:
+		encodeUserPrivacySettingAllowChatInvites(encoder, value)
+
+	case *UserPrivacySettingAllowCalls:
+		encodeUserPrivacySettingAllowCalls(encoder, value)
+
+	case *UserPrivacySettingAllowPeerToPeerCalls:
+		encodeUserPrivacySettingAllowPeerToPeerCalls(encoder, value)
+
+	case *UserPrivacySettingAllowFindingByPhoneNumber:
+		encodeUserPrivacySettingAllowFindingByPhoneNumber(encoder, value)
+
+	case *UserPrivacySettingAllowPrivateVoiceAndVideoNoteMessages:
+		encodeUserPrivacySettingAllowPrivateVoiceAndVideoNoteMessages(encoder, value)
+
+	case *AccountTtl:
+		encodeAccountTtl(encoder, value)
+
+	case *MessageAutoDeleteTime:
+		encodeMessageAutoDeleteTime(encoder, value)
+
+	case *SessionTypeAndroid:
+		encodeSessionTypeAndroid(encoder, value)
+
+	case *SessionTypeApple:
+		encodeSessionTypeApple(encoder, value)
+
+	case *SessionTypeBrave:
+		encodeSessionTypeBrave(encoder, value)
+
+	case *SessionTypeChrome:
+		encodeSessionTypeChrome(encoder, value)
+
+	case *SessionTypeEdge:
+		encodeSessionTypeEdge(encoder, value)
+
+	case *SessionTypeFirefox:
+		encodeSessionTypeFirefox(encoder, value)
+
+	case *SessionTypeIpad:
+		encodeSessionTypeIpad(encoder, value)
+
+	case *SessionTypeIphone:
+		encodeSessionTypeIphone(encoder, value)
+
+	case *SessionTypeLinux:
+		encodeSessionTypeLinux(encoder, value)
+
+	case *SessionTypeMac:
+		encodeSessionTypeMac(encoder, value)
+
+	case *SessionTypeOpera:
+		encodeSessionTypeOpera(encoder, value)
+
+	case *SessionTypeSafari:
+		encodeSessionTypeSafari(encoder, value)
+
+	case *SessionTypeUbuntu:
+		encodeSessionTypeUbuntu(encoder, value)
+
+	case *SessionTypeUnknown:
+		encodeSessionTypeUnknown(encoder, value)
+
+	case *SessionTypeVivaldi:
+		encodeSessionTypeVivaldi(encoder, value)
+
+	case *SessionTypeWindows:
+		encodeSessionTypeWindows(encoder, value)
+
+	case *SessionTypeXbox:
+		encodeSessionTypeXbox(encoder, value)
+
+	case *Session:
+		encodeSession(encoder, value)
+
+	case *Sessions:
+		encodeSessions(encoder, value)
+
+	case *ConnectedWebsite:
+		encodeConnectedWebsite(encoder, value)
+
+	case *ConnectedWebsites:
+		encodeConnectedWebsites(encoder, value)
+
+	case *ChatReportReasonSpam:
+		encodeChatReportReasonSpam(encoder, value)
+
+	case *ChatReportReasonViolence:
+		encodeChatReportReasonViolence(encoder, value)
+
+	case *ChatReportReasonPornography:
+		encodeChatReportReasonPornography(encoder, value)
+
+	case *ChatReportReasonChildAbuse:
+		encodeChatReportReasonChildAbuse(encoder, value)
+
+	case *ChatReportReasonCopyright:
+		encodeChatReportReasonCopyright(encoder, value)
+
+	case *ChatReportReasonUnrelatedLocation:
+		encodeChatReportReasonUnrelatedLocation(encoder, value)
+
+	case *ChatReportReasonFake:
+		encodeChatReportReasonFake(encoder, value)
+
+	case *ChatReportReasonIllegalDrugs:
+		encodeChatReportReasonIllegalDrugs(encoder, value)
+
+	case *ChatReportReasonPersonalDetails:
+		encodeChatReportReasonPersonalDetails(encoder, value)
+
+	case *ChatReportReasonCustom:
+		encodeChatReportReasonCustom(encoder, value)
+
+	case *TargetChatCurrent:
+		encodeTargetChatCurrent(encoder, value)
+
+	case *TargetChatChosen:
+		encodeTargetChatChosen(encoder, value)
+
+	case *TargetChatInternalLink:
+		encodeTargetChatInternalLink(encoder, value)
+
+	case *InternalLinkTypeActiveSessions:
+		encodeInternalLinkTypeActiveSessions(encoder, value)
+
+	case *InternalLinkTypeAttachmentMenuBot:
+		encodeInternalLinkTypeAttachmentMenuBot(encoder, value)
+
+	case *InternalLinkTypeAuthenticationCode:
+		encodeInternalLinkTypeAuthenticationCode(encoder, value)
+
+	case *InternalLinkTypeBackground:
+		encodeInternalLinkTypeBackground(encoder, value)
+
+	case *InternalLinkTypeBotAddToChannel:
+		encodeInternalLinkTypeBotAddToChannel(encoder, value)
+
+	case *InternalLinkTypeBotStart:
+		encodeInternalLinkTypeBotStart(encoder, value)
+
+	case *InternalLinkTypeBotStartInGroup:
+		encodeInternalLinkTypeBotStartInGroup(encoder, value)
+
+	case *InternalLinkTypeChangePhoneNumber:
+		encodeInternalLinkTypeChangePhoneNumber(encoder, value)
+
+	case *InternalLinkTypeChatFolderInvite:
+		encodeInternalLinkTypeChatFolderInvite(encoder, value)
+
+	case *InternalLinkTypeChatFolderSettings:
+		encodeInternalLinkTypeChatFolderSettings(encoder, value)
+
+	case *InternalLinkTypeChatInvite:
+		encodeInternalLinkTypeChatInvite(encoder, value)
+
+	case *InternalLinkTypeDefaultMessageAutoDeleteTimerSettings:
+		encodeInternalLinkTypeDefaultMessageAutoDeleteTimerSettings(encoder, value)
+
+	case *InternalLinkTypeEditProfileSettings:
+		encodeInternalLinkTypeEditProfileSettings(encoder, value)
+
+	case *InternalLinkTypeGame:
+		encodeInternalLinkTypeGame(encoder, value)
+
+	case *InternalLinkTypeInstantView:
+		encodeInternalLinkTypeInstantView(encoder, value)
+
+	case *InternalLinkTypeInvoice:
+		encodeInternalLinkTypeInvoice(encoder, value)
+
+	case *InternalLinkTypeLanguagePack:
+		encodeInternalLinkTypeLanguagePack(encoder, value)
+
+	case *InternalLinkTypeLanguageSettings:
+		encodeInternalLinkTypeLanguageSettings(encoder, value)
+
+	case *InternalLinkTypeMessage:
+		encodeInternalLinkTypeMessage(encoder, value)
+
+	case *InternalLinkTypeMessageDraft:
+		encodeInternalLinkTypeMessageDraft(encoder, value)
+
+	case *InternalLinkTypePassportDataRequest:
+		encodeInternalLinkTypePassportDataRequest(encoder, value)
+
+	case *InternalLinkTypePhoneNumberConfirmation:
+		encodeInternalLinkTypePhoneNumberConfirmation(encoder, value)
+
+	case *InternalLinkTypePremiumFeatures:
+		encodeInternalLinkTypePremiumFeatures(encoder, value)
+
+	case *InternalLinkTypePrivacyAndSecuritySettings:
+		encodeInternalLinkTypePrivacyAndSecuritySettings(encoder, value)
+
+	case *InternalLinkTypeProxy:
+		encodeInternalLinkTypeProxy(encoder, value)
+
+	case *InternalLinkTypePublicChat:
+		encodeInternalLinkTypePublicChat(encoder, value)
+
+	case *InternalLinkTypeQrCodeAuthentication:
+		encodeInternalLinkTypeQrCodeAuthentication(encoder, value)
+
+	case *InternalLinkTypeRestorePurchases:
+		encodeInternalLinkTypeRestorePurchases(encoder, value)
+
+	case *InternalLinkTypeSettings:
+		encodeInternalLinkTypeSettings(encoder, value)
+
+	case *InternalLinkTypeStickerSet:
+		encodeInternalLinkTypeStickerSet(encoder, value)
+
+	case *InternalLinkTypeTheme:
+		encodeInternalLinkTypeTheme(encoder, value)
+
+	case *InternalLinkTypeThemeSettings:
+		encodeInternalLinkTypeThemeSettings(encoder, value)
+
+	case *InternalLinkTypeUnknownDeepLink:
+		encodeInternalLinkTypeUnknownDeepLink(encoder, value)
+
+	case *InternalLinkTypeUnsupportedProxy:
+		encodeInternalLinkTypeUnsupportedProxy(encoder, value)
+
+	case *InternalLinkTypeUserPhoneNumber:
+		encodeInternalLinkTypeUserPhoneNumber(encoder, value)
+
+	case *InternalLinkTypeUserToken:
+		encodeInternalLinkTypeUserToken(encoder, value)
+
+	case *InternalLinkTypeVideoChat:
+		encodeInternalLinkTypeVideoChat(encoder, value)
+
+	case *InternalLinkTypeWebApp:
+		encodeInternalLinkTypeWebApp(encoder, value)
+
+	case *MessageLink:
+		encodeMessageLink(encoder, value)
+
+	case *MessageLinkInfo:
+		encodeMessageLinkInfo(encoder, value)
+
+	case *FilePart:
+		encodeFilePart(encoder, value)
+
+	case *FileTypeNone:
+		encodeFileTypeNone(encoder, value)
+
+	case *FileTypeAnimation:
+		encodeFileTypeAnimation(encoder, value)
+
+	case *FileTypeAudio:
+		encodeFileTypeAudio(encoder, value)
+
+	case *FileTypeDocument:
+		encodeFileTypeDocument(encoder, value)
+
+	case *FileTypeNotificationSound:
+		encodeFileTypeNotificationSound(encoder, value)
+
+	case *FileTypePhoto:
+		encodeFileTypePhoto(encoder, value)
+
+	case *FileTypeProfilePhoto:
+		encodeFileTypeProfilePhoto(encoder, value)
+
+	case *FileTypeSecret:
+		encodeFileTypeSecret(encoder, value)
+
+	case *FileTypeSecretThumbnail:
+		encodeFileTypeSecretThumbnail(encoder, value)
+
+	case *FileTypeSecure:
+		encodeFileTypeSecure(encoder, value)
+
+	case *FileTypeSticker:
+		encodeFileTypeSticker(encoder, value)
+
+	case *FileTypeThumbnail:
+		encodeFileTypeThumbnail(encoder, value)
+
+	case *FileTypeUnknown:
+		encodeFileTypeUnknown(encoder, value)
+
+	case *FileTypeVideo:
+		encodeFileTypeVideo(encoder, value)
+
+	case *FileTypeVideoNote:
+		encodeFileTypeVideoNote(encoder, value)
+
+	case *FileTypeVoiceNote:
+		encodeFileTypeVoiceNote(encoder, value)
+
+	case *FileTypeWallpaper:
+		encodeFileTypeWallpaper(encoder, value)
+
+	case *StorageStatisticsByFileType:
+		encodeStorageStatisticsByFileType(encoder, value)
+
+	case *StorageStatisticsByChat:
+		encodeStorageStatisticsByChat(encoder, value)
+
+	case *StorageStatistics:
+		encodeStorageStatistics(encoder, value)
+
+	case *StorageStatisticsFast:
+		encodeStorageStatisticsFast(encoder, value)
+
+	case *DatabaseStatistics:
+		encodeDatabaseStatistics(encoder, value)
+
+	case *MemoryStatistics:
+		encodeMemoryStatistics(encoder, value)
+
+	case *NetworkTypeNone:
+		encodeNetworkTypeNone(encoder, value)
+
+	case *NetworkTypeMobile:
+		encodeNetworkTypeMobile(encoder, value)
+
+	case *NetworkTypeMobileRoaming:
+		encodeNetworkTypeMobileRoaming(encoder, value)
+
+	case *NetworkTypeWiFi:
+		encodeNetworkTypeWiFi(encoder, value)
+
+	case *NetworkTypeOther:
+		encodeNetworkTypeOther(encoder, value)
+
+	case *NetworkStatisticsEntryFile:
+		encodeNetworkStatisticsEntryFile(encoder, value)
+
+	case *NetworkStatisticsEntryCall:
+		encodeNetworkStatisticsEntryCall(encoder, value)
+
+	case *NetworkStatistics:
+		encodeNetworkStatistics(encoder, value)
+
+	case *AutoDownloadSettings:
+		encodeAutoDownloadSettings(encoder, value)
+
+	case *AutoDownloadSettingsPresets:
+		encodeAutoDownloadSettingsPresets(encoder, value)
+
+	case *AutosaveSettingsScopePrivateChats:
+		encodeAutosaveSettingsScopePrivateChats(encoder, value)
+
+	case *AutosaveSettingsScopeGroupChats:
+		encodeAutosaveSettingsScopeGroupChats(encoder, value)
+
+	case *AutosaveSettingsScopeChannelChats:
+		encodeAutosaveSettingsScopeChannelChats(encoder, value)
+
+	case *AutosaveSettingsScopeChat:
+		encodeAutosaveSettingsScopeChat(encoder, value)
+
+	case *ScopeAutosaveSettings:
+		encodeScopeAutosaveSettings(encoder, value)
+
+	case *AutosaveSettingsException:
+		encodeAutosaveSettingsException(encoder, value)
+
+	case *AutosaveSettings:
+		encodeAutosaveSettings(encoder, value)
+
+	case *ConnectionStateWaitingForNetwork:
+		encodeConnectionStateWaitingForNetwork(encoder, value)
+
+	case *ConnectionStateConnectingToProxy:
+		encodeConnectionStateConnectingToProxy(encoder, value)
+
+	case *ConnectionStateConnecting:
+		encodeConnectionStateConnecting(encoder, value)
+
+	case *ConnectionStateUpdating:
+		encodeConnectionStateUpdating(encoder, value)
+
+	case *ConnectionStateReady:
+		encodeConnectionStateReady(encoder, value)
+
+	case *TopChatCategoryUsers:
+		encodeTopChatCategoryUsers(encoder, value)
+
+	case *TopChatCategoryBots:
+		encodeTopChatCategoryBots(encoder, value)
+
+	case *TopChatCategoryGroups:
+		encodeTopChatCategoryGroups(encoder, value)
+
+	case *TopChatCategoryChannels:
+		encodeTopChatCategoryChannels(encoder, value)
+
+	case *TopChatCategoryInlineBots:
+		encodeTopChatCategoryInlineBots(encoder, value)
+
+	case *TopChatCategoryCalls:
+		encodeTopChatCategoryCalls(encoder, value)
+
+	case *TopChatCategoryForwardChats:
+		encodeTopChatCategoryForwardChats(encoder, value)
+
+	case *TMeUrlTypeUser:
+		encodeTMeUrlTypeUser(encoder, value)
+
+	case *TMeUrlTypeSupergroup:
+		encodeTMeUrlTypeSupergroup(encoder, value)
+
+	case *TMeUrlTypeChatInvite:
+		encodeTMeUrlTypeChatInvite(encoder, value)
+
+	case *TMeUrlTypeStickerSet:
+		encodeTMeUrlTypeStickerSet(encoder, value)
+
+	case *TMeUrl:
+		encodeTMeUrl(encoder, value)
+
+	case *TMeUrls:
+		encodeTMeUrls(encoder, value)
+
+	case *SuggestedActionEnableArchiveAndMuteNewChats:
+		encodeSuggestedActionEnableArchiveAndMuteNewChats(encoder, value)
+
+	case *SuggestedActionCheckPassword:
+		encodeSuggestedActionCheckPassword(encoder, value)
+
+	case *SuggestedActionCheckPhoneNumber:
+		encodeSuggestedActionCheckPhoneNumber(encoder, value)
+
+	case *SuggestedActionViewChecksHint:
+		encodeSuggestedActionViewChecksHint(encoder, value)
+
+	case *SuggestedActionConvertToBroadcastGroup:
+		encodeSuggestedActionConvertToBroadcastGroup(encoder, value)
+
+	case *SuggestedActionSetPassword:
+		encodeSuggestedActionSetPassword(encoder, value)
+
+	case *SuggestedActionUpgradePremium:
+		encodeSuggestedActionUpgradePremium(encoder, value)
+
+	case *SuggestedActionSubscribeToAnnualPremium:
+		encodeSuggestedActionSubscribeToAnnualPremium(encoder, value)
+
+	case *Count:
+		encodeCount(encoder, value)
+
+	case *Text:
+		encodeText(encoder, value)
+
+	case *Seconds:
+		encodeSeconds(encoder, value)
+
+	case *FileDownloadedPrefixSize:
+		encodeFileDownloadedPrefixSize(encoder, value)
+
+	case *DeepLinkInfo:
+		encodeDeepLinkInfo(encoder, value)
+
+	case *TextParseModeMarkdown:
+		encodeTextParseModeMarkdown(encoder, value)
+
+	case *TextParseModeHTML:
+		encodeTextParseModeHTML(encoder, value)
+
+	case *ProxyTypeSocks5:
+		encodeProxyTypeSocks5(encoder, value)
+
+	case *ProxyTypeHttp:
+		encodeProxyTypeHttp(encoder, value)
+
+	case *ProxyTypeMtproto:
+		encodeProxyTypeMtproto(encoder, value)
+
+	case *Proxy:
+		encodeProxy(encoder, value)
+
+	case *Proxies:
+		encodeProxies(encoder, value)
+
+	case *InputSticker:
+		encodeInputSticker(encoder, value)
+
+	case *DateRange:
+		encodeDateRange(encoder, value)
+
+	case *StatisticalValue:
+		encodeStatisticalValue(encoder, value)
+
+	case *StatisticalGraphData:
+		encodeStatisticalGraphData(encoder, value)
+
+	case *StatisticalGraphAsync:
+		encodeStatisticalGraphAsync(encoder, value)
+
+	case *StatisticalGraphError:
+		encodeStatisticalGraphError(encoder, value)
+
+	case *ChatStatisticsMessageInteractionInfo:
+		encodeChatStatisticsMessageInteractionInfo(encoder, value)
+
+	case *ChatStatisticsMessageSenderInfo:
+		encodeChatStatisticsMessageSenderInfo(encoder, value)
+
+	case *ChatStatisticsAdministratorActionsInfo:
+		encodeChatStatisticsAdministratorActionsInfo(encoder, value)
+
+	case *ChatStatisticsInviterInfo:
+		encodeChatStatisticsInviterInfo(encoder, value)
+
+	case *ChatStatisticsSupergroup:
+		encodeChatStatisticsSupergroup(encoder, value)
+
+	case *ChatStatisticsChannel:
+		encodeChatStatisticsChannel(encoder, value)
+
+	case *MessageStatistics:
+		encodeMessageStatistics(encoder, value)
+
+	case *Point:
+		encodePoint(encoder, value)
+
+	case *VectorPathCommandLine:
+		encodeVectorPathCommandLine(encoder, value)
+
+	case *VectorPathCommandCubicBezierCurve:
+		encodeVectorPathCommandCubicBezierCurve(encoder, value)
+
+	case *BotCommandScopeDefault:
+		encodeBotCommandScopeDefault(encoder, value)
+
+	case *BotCommandScopeAllPrivateChats:
+		encodeBotCommandScopeAllPrivateChats(encoder, value)
+
+	case *BotCommandScopeAllGroupChats:
+		encodeBotCommandScopeAllGroupChats(encoder, value)
+
+	case *BotCommandScopeAllChatAdministrators:
+		encodeBotCommandScopeAllChatAdministrators(encoder, value)
+
+	case *BotCommandScopeChat:
+		encodeBotCommandScopeChat(encoder, value)
+
+	case *BotCommandScopeChatAdministrators:
+		encodeBotCommandScopeChatAdministrators(encoder, value)
+
+	case *BotCommandScopeChatMember:
+		encodeBotCommandScopeChatMember(encoder, value)
+
+	case *UpdateAuthorizationState:
+		encodeUpdateAuthorizationState(encoder, value)
+
+	case *UpdateNewMessage:
+		encodeUpdateNewMessage(encoder, value)
+
+	case *UpdateMessageSendAcknowledged:
+		encodeUpdateMessageSendAcknowledged(encoder, value)
+
+	case *UpdateMessageSendSucceeded:
+		encodeUpdateMessageSendSucceeded(encoder, value)
+
+	case *UpdateMessageSendFailed:
+		encodeUpdateMessageSendFailed(encoder, value)
+
+	case *UpdateMessageContent:
+		encodeUpdateMessageContent(encoder, value)
+
+	case *UpdateMessageEdited:
+		encodeUpdateMessageEdited(encoder, value)
+
+	case *UpdateMessageIsPinned:
+		encodeUpdateMessageIsPinned(encoder, value)
+
+	case *UpdateMessageInteractionInfo:
+		encodeUpdateMessageInteractionInfo(encoder, value)
+
+	case *UpdateMessageContentOpened:
+		encodeUpdateMessageContentOpened(encoder, value)
+
+	case *UpdateMessageMentionRead:
+		encodeUpdateMessageMentionRead(encoder, value)
+
+	case *UpdateMessageUnreadReactions:
+		encodeUpdateMessageUnreadReactions(encoder, value)
+
+	case *UpdateMessageLiveLocationViewed:
+		encodeUpdateMessageLiveLocationViewed(encoder, value)
+
+	case *UpdateNewChat:
+		encodeUpdateNewChat(encoder, value)
+
+	case *UpdateChatTitle:
+		encodeUpdateChatTitle(encoder, value)
+
+	case *UpdateChatPhoto:
+		encodeUpdateChatPhoto(encoder, value)
+
+	case *UpdateChatPermissions:
+		encodeUpdateChatPermissions(encoder, value)
+
+	case *UpdateChatLastMessage:
+		encodeUpdateChatLastMessage(encoder, value)
+
+	case *UpdateChatPosition:
+		encodeUpdateChatPosition(encoder, value)
+
+	case *UpdateChatReadInbox:
+		encodeUpdateChatReadInbox(encoder, value)
+
+	case *UpdateChatReadOutbox:
+		encodeUpdateChatReadOutbox(encoder, value)
+
+	case *UpdateChatActionBar:
+		encodeUpdateChatActionBar(encoder, value)
+
+	case *UpdateChatAvailableReactions:
+		encodeUpdateChatAvailableReactions(encoder, value)
+
+	case *UpdateChatDraftMessage:
+		encodeUpdateChatDraftMessage(encoder, value)
+
+	case *UpdateChatMessageSender:
+		encodeUpdateChatMessageSender(encoder, value)
+
+	case *UpdateChatMessageAutoDeleteTime:
+		encodeUpdateChatMessageAutoDeleteTime(encoder, value)
+
+	case *UpdateChatNotificationSettings:
+		encodeUpdateChatNotificationSettings(encoder, value)
+
+	case *UpdateChatPendingJoinRequests:
+		encodeUpdateChatPendingJoinRequests(encoder, value)
+
+	case *UpdateChatReplyMarkup:
+		encodeUpdateChatReplyMarkup(encoder, value)
+
+	case *UpdateChatBackground:
+		encodeUpdateChatBackground(encoder, value)
+
+	case *UpdateChatTheme:
+		encodeUpdateChatTheme(encoder, value)
+
+	case *UpdateChatUnreadMentionCount:
+		encodeUpdateChatUnreadMentionCount(encoder, value)
+
+	case *UpdateChatUnreadReactionCount:
+		encodeUpdateChatUnreadReactionCount(encoder, value)
+
+	case *UpdateChatVideoChat:
+		encodeUpdateChatVideoChat(encoder, value)
+
+	case *UpdateChatDefaultDisableNotification:
+		encodeUpdateChatDefaultDisableNotification(encoder, value)
+
+	case *UpdateChatHasProtectedContent:
+		encodeUpdateChatHasProtectedContent(encoder, value)
+
+	case *UpdateChatIsTranslatable:
+		encodeUpdateChatIsTranslatable(encoder, value)
+
+	case *UpdateChatIsMarkedAsUnread:
+		encodeUpdateChatIsMarkedAsUnread(encoder, value)
+
+	case *UpdateChatIsBlocked:
+		encodeUpdateChatIsBlocked(encoder, value)
+
+	case *UpdateChatHasScheduledMessages:
+		encodeUpdateChatHasScheduledMessages(encoder, value)
+
+	case *UpdateChatFolders:
+		encodeUpdateChatFolders(encoder, value)
+
+	case *UpdateChatOnlineMemberCount:
+		encodeUpdateChatOnlineMemberCount(encoder, value)
+
+	case *UpdateForumTopicInfo:
+		encodeUpdateForumTopicInfo(encoder, value)
+
+	case *UpdateScopeNotificationSettings:
+		encodeUpdateScopeNotificationSettings(encoder, value)
+
+	case *UpdateNotification:
+		encodeUpdateNotification(encoder, value)
+
+	case *UpdateNotificationGroup:
+		encodeUpdateNotificationGroup(encoder, value)
+
+	case *UpdateActiveNotifications:
+		encodeUpdateActiveNotifications(encoder, value)
+
+	case *UpdateHavePendingNotifications:
+		encodeUpdateHavePendingNotifications(encoder, value)
+
+	case *UpdateDeleteMessages:
+		encodeUpdateDeleteMessages(encoder, value)
+
+	case *UpdateChatAction:
+		encodeUpdateChatAction(encoder, value)
+
+	case *UpdateUserStatus:
+		encodeUpdateUserStatus(encoder, value)
+
+	case *UpdateUser:
+		encodeUpdateUser(encoder, value)
+
+	case *UpdateAccessHash:
+		encodeUpdateAccessHash(encoder, value)
+
+	case *UpdateBasicGroup:
+		encodeUpdateBasicGroup(encoder, value)
+
+	case *UpdateSupergroup:
+		encodeUpdateSupergroup(encoder, value)
+
+	case *UpdateSecretChat:
+		encodeUpdateSecretChat(encoder, value)
+
+	case *UpdateUserFullInfo:
+		encodeUpdateUserFullInfo(encoder, value)
+
+	case *UpdateBasicGroupFullInfo:
+		encodeUpdateBasicGroupFullInfo(encoder, value)
+
+	case *UpdateSupergroupFullInfo:
+		encodeUpdateSupergroupFullInfo(encoder, value)
+
+	case *UpdateServiceNotification:
+		encodeUpdateServiceNotification(encoder, value)
+
+	case *UpdateFile:
+		encodeUpdateFile(encoder, value)
+
+	case *UpdateFileGenerationStart:
+		encodeUpdateFileGenerationStart(encoder, value)
+
+	case *UpdateFileGenerationStop:
+		encodeUpdateFileGenerationStop(encoder, value)
+
+	case *UpdateFileDownloads:
+		encodeUpdateFileDownloads(encoder, value)
+
+	case *UpdateFileAddedToDownloads:
+		encodeUpdateFileAddedToDownloads(encoder, value)
+
+	case *UpdateFileDownload:
+		encodeUpdateFileDownload(encoder, value)
+
+	case *UpdateFileRemovedFromDownloads:
+		encodeUpdateFileRemovedFromDownloads(encoder, value)
+
+	case *UpdateCall:
+		encodeUpdateCall(encoder, value)
+
+	case *UpdateGroupCall:
+		encodeUpdateGroupCall(encoder, value)
+
+	case *UpdateGroupCallParticipant:
+		encodeUpdateGroupCallParticipant(encoder, value)
+
+	case *UpdateNewCallSignalingData:
+		encodeUpdateNewCallSignalingData(encoder, value)
+
+	case *UpdateUserPrivacySettingRules:
+		encodeUpdateUserPrivacySettingRules(encoder, value)
+
+	case *UpdateUnreadMessageCount:
+		encodeUpdateUnreadMessageCount(encoder, value)
+
+	case *UpdateUnreadChatCount:
+		encodeUpdateUnreadChatCount(encoder, value)
+
+	case *UpdateOption:
+		encodeUpdateOption(encoder, value)
+
+	case *UpdateStickerSet:
+		encodeUpdateStickerSet(encoder, value)
+
+	case *UpdateInstalledStickerSets:
+		encodeUpdateInstalledStickerSets(encoder, value)
+
+	case *UpdateTrendingStickerSets:
+		encodeUpdateTrendingStickerSets(encoder, value)
+
+	case *UpdateRecentStickers:
+		encodeUpdateRecentStickers(encoder, value)
+
+	case *UpdateFavoriteStickers:
+		encodeUpdateFavoriteStickers(encoder, value)
+
+	case *UpdateSavedAnimations:
+		encodeUpdateSavedAnimations(encoder, value)
+
+	case *UpdateSavedNotificationSounds:
+		encodeUpdateSavedNotificationSounds(encoder, value)
+
+	case *UpdateSelectedBackground:
+		encodeUpdateSelectedBackground(encoder, value)
+
+	case *UpdateChatThemes:
+		encodeUpdateChatThemes(encoder, value)
+
+	case *UpdateLanguagePackStrings:
+		encodeUpdateLanguagePackStrings(encoder, value)
+
+	case *UpdateConnectionState:
+		encodeUpdateConnectionState(encoder, value)
+
+	case *UpdateTermsOfService:
+		encodeUpdateTermsOfService(encoder, value)
+
+	case *UpdateUsersNearby:
+		encodeUpdateUsersNearby(encoder, value)
+
+	case *UpdateAttachmentMenuBots:
+		encodeUpdateAttachmentMenuBots(encoder, value)
+
+	case *UpdateWebAppMessageSent:
+		encodeUpdateWebAppMessageSent(encoder, value)
+
+	case *UpdateActiveEmojiReactions:
+		encodeUpdateActiveEmojiReactions(encoder, value)
+
+	case *UpdateDefaultReactionType:
+		encodeUpdateDefaultReactionType(encoder, value)
+
+	case *UpdateDiceEmojis:
+		encodeUpdateDiceEmojis(encoder, value)
+
+	case *UpdateAnimatedEmojiMessageClicked:
+		encodeUpdateAnimatedEmojiMessageClicked(encoder, value)
+
+	case *UpdateAnimationSearchParameters:
+		encodeUpdateAnimationSearchParameters(encoder, value)
+
+	case *UpdateSuggestedActions:
+		encodeUpdateSuggestedActions(encoder, value)
+
+	case *UpdateAddChatMembersPrivacyForbidden:
+		encodeUpdateAddChatMembersPrivacyForbidden(encoder, value)
+
+	case *UpdateAutosaveSettings:
+		encodeUpdateAutosaveSettings(encoder, value)
+
+	case *UpdateNewInlineQuery:
+		encodeUpdateNewInlineQuery(encoder, value)
+
+	case *UpdateNewChosenInlineResult:
+		encodeUpdateNewChosenInlineResult(encoder, value)
+
+	case *UpdateNewCallbackQuery:
+		encodeUpdateNewCallbackQuery(encoder, value)
+
+	case *UpdateNewInlineCallbackQuery:
+		encodeUpdateNewInlineCallbackQuery(encoder, value)
+
+	case *UpdateNewShippingQuery:
+		encodeUpdateNewShippingQuery(encoder, value)
+
+	case *UpdateNewPreCheckoutQuery:
+		encodeUpdateNewPreCheckoutQuery(encoder, value)
+
+	case *UpdateNewCustomEvent:
+		encodeUpdateNewCustomEvent(encoder, value)
+
+	case *UpdateNewCustomQuery:
+		encodeUpdateNewCustomQuery(encoder, value)
+
+	case *UpdatePoll:
+		encodeUpdatePoll(encoder, value)
+
+	case *UpdatePollAnswer:
+		encodeUpdatePollAnswer(encoder, value)
+
+	case *UpdateChatMember:
+		encodeUpdateChatMember(encoder, value)
+
+	case *UpdateNewChatJoinRequest:
+		encodeUpdateNewChatJoinRequest(encoder, value)
+
+	case *Updates:
+		encodeUpdates(encoder, value)
+
+	case *LogStreamDefault:
+		encodeLogStreamDefault(encoder, value)
+
+	case *LogStreamFile:
+		encodeLogStreamFile(encoder, value)
+
+	case *LogStreamEmpty:
+		encodeLogStreamEmpty(encoder, value)
+
+	case *LogVerbosityLevel:
+		encodeLogVerbosityLevel(encoder, value)
+
+	case *LogTags:
+		encodeLogTags(encoder, value)
+
+	case *UserSupportInfo:
+		encodeUserSupportInfo(encoder, value)
+
+	case *TestInt:
+		encodeTestInt(encoder, value)
+
+	case *TestString:
+		encodeTestString(encoder, value)
+
+	case *TestBytes:
+		encodeTestBytes(encoder, value)
+
+	case *TestVectorInt:
+		encodeTestVectorInt(encoder, value)
+
+	case *TestVectorIntObject:
+		encodeTestVectorIntObject(encoder, value)
+
+	case *TestVectorString:
+		encodeTestVectorString(encoder, value)
+
+	case *TestVectorStringObject:
+		encodeTestVectorStringObject(encoder, value)
+
+	default:
+		encoder.marshaler(value)
+	}
+}
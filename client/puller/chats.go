@@ -1,62 +1,88 @@
 package puller
 
 import (
-	"math"
-
 	"github.com/megaplan/go-tdlib/client"
 )
 
+// Chats pulls all chats of the main chat list in the order of their positions
 func Chats(tdlibClient *client.Client) (chan *client.Chat, chan error) {
+	return ChatListChats(tdlibClient, &client.ChatListMain{})
+}
+
+// ChatListChats pulls all chats of the chat list in the order of their positions.
+// The list is loaded with LoadChats until TDLib has it all, including the chats loaded by the client before the pull.
+// Pass nil to pull the main chat list
+func ChatListChats(tdlibClient *client.Client, chatList client.ChatList) (chan *client.Chat, chan error) {
+	if chatList == nil {
+		chatList = &client.ChatListMain{}
+	}
+
 	chatChan := make(chan *client.Chat, 10)
 	errChan := make(chan error, 1)
 
-	var offsetOrder client.JsonInt64 = math.MaxInt64
-	var offsetChatId int64 = 0
 	var limit int32 = 100
 
-	go chats(tdlibClient, chatChan, errChan, offsetOrder, offsetChatId, limit)
+	go chats(tdlibClient, chatChan, errChan, chatList, limit)
 
 	return chatChan, errChan
 }
 
-func chats(tdlibClient *client.Client, chatChan chan *client.Chat, errChan chan error, offsetOrder client.JsonInt64, offsetChatId int64, limit int32) {
+func chats(tdlibClient *client.Client, chatChan chan *client.Chat, errChan chan error, chatList client.ChatList, limit int32) {
 	defer func() {
 		close(chatChan)
 		close(errChan)
 	}()
 
 	for {
-		chats, err := tdlibClient.GetChats(&client.GetChatsRequest{
-			OffsetOrder:  offsetOrder,
-			OffsetChatId: offsetChatId,
-			Limit:        limit,
+		_, err := tdlibClient.LoadChats(&client.LoadChatsRequest{
+			ChatList: chatList,
+			Limit:    limit,
 		})
+		if client.IsNotFound(err) {
+			// all chats are loaded
+			break
+		}
+
 		if err != nil {
 			errChan <- err
 
 			return
 		}
+	}
+
+	// the ordered identifiers are returned by GetChats, the limit is raised until the whole list fits
+	var chatIds []int64
+	for {
+		chats, err := tdlibClient.GetChats(&client.GetChatsRequest{
+			ChatList: chatList,
+			Limit:    limit,
+		})
+		if err != nil {
+			errChan <- err
 
-		if len(chats.ChatIds) == 0 {
-			errChan <- EOP
+			return
+		}
 
+		chatIds = chats.ChatIds
+		if int32(len(chatIds)) < limit {
 			break
 		}
 
-		for _, chatId := range chats.ChatIds {
-			chat, err := tdlibClient.GetChat(&client.GetChatRequest{
-				ChatId: chatId,
-			})
-			if err != nil {
-				errChan <- err
-
-				return
-			}
+		limit *= 2
+	}
 
-			offsetOrder = chat.Order
-			offsetChatId = chat.Id
+	for _, chatId := range chatIds {
+		chat, err := tdlibClient.GetChat(&client.GetChatRequest{
+			ChatId: chatId,
+		})
+		if err != nil {
+			errChan <- err
 
-			chatChan <- chat
+			return
 		}
+
+		chatChan <- chat
 	}
+
+	errChan <- EOP
 }
@@ -0,0 +1,141 @@
+package puller_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/megaplan/go-tdlib/client"
+	"github.com/megaplan/go-tdlib/client/puller"
+	"github.com/megaplan/go-tdlib/client/tdtest"
+)
+
+// chatsServer answers the chat list requests with the chats in the order of the list.
+// The list is loaded by the number of loadChats calls
+func chatsServer(chatIds []int64, loads int) *tdtest.Server {
+	server := tdtest.NewServer()
+
+	server.Handle("loadChats", func(req *tdtest.Request) client.Type {
+		if loads == 0 {
+			return &client.Error{Code: 404, Message: "Not Found"}
+		}
+		loads--
+
+		return &client.Ok{}
+	})
+
+	server.Handle("getChats", func(req *tdtest.Request) client.Type {
+		var getChatsRequest struct {
+			Limit int `json:"limit"`
+		}
+
+		err := req.Decode(&getChatsRequest)
+		if err != nil {
+			return &client.Error{Code: 400, Message: err.Error()}
+		}
+
+		ids := chatIds
+		if len(ids) > getChatsRequest.Limit {
+			ids = ids[:getChatsRequest.Limit]
+		}
+
+		return &client.Chats{TotalCount: int32(len(chatIds)), ChatIds: ids}
+	})
+
+	server.Handle("getChat", func(req *tdtest.Request) client.Type {
+		var getChatRequest client.GetChatRequest
+
+		err := req.Decode(&getChatRequest)
+		if err != nil {
+			return &client.Error{Code: 400, Message: err.Error()}
+		}
+
+		return &client.Chat{Id: getChatRequest.ChatId}
+	})
+
+	return server
+}
+
+func pullChats(t *testing.T, server *tdtest.Server, chatList client.ChatList) []int64 {
+	tdlibClient, err := client.NewClient(client.SessionAuthorizer(&client.TdlibParameters{}), client.WithTransport(server))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tdlibClient.Shutdown()
+
+	chatChan, errChan := puller.ChatListChats(tdlibClient, chatList)
+
+	chatIds := []int64{}
+	for chat := range chatChan {
+		chatIds = append(chatIds, chat.Id)
+	}
+
+	err = <-errChan
+	if !errors.Is(err, puller.EOP) {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	return chatIds
+}
+
+func equalChatIds(chatIds []int64, expected []int64) bool {
+	if len(chatIds) != len(expected) {
+		return false
+	}
+
+	for i := range chatIds {
+		if chatIds[i] != expected[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func TestChats(t *testing.T) {
+	expected := []int64{}
+	for chatId := int64(250); chatId > 0; chatId-- {
+		expected = append(expected, chatId)
+	}
+
+	server := chatsServer(expected, 3)
+
+	chatIds := pullChats(t, server, nil)
+	if !equalChatIds(chatIds, expected) {
+		t.Fatalf("unexpected chats %v", chatIds)
+	}
+
+	if len(server.Requests("loadChats")) != 4 {
+		t.Fatalf("loadChats is sent %d times", len(server.Requests("loadChats")))
+	}
+}
+
+func TestChatsAlreadyLoaded(t *testing.T) {
+	// loadChats fails with 404 right away when the client has loaded the list before
+	server := chatsServer([]int64{3, 1, 2}, 0)
+
+	chatIds := pullChats(t, server, &client.ChatListArchive{})
+	if !equalChatIds(chatIds, []int64{3, 1, 2}) {
+		t.Fatalf("unexpected chats %v", chatIds)
+	}
+}
+
+func TestChatsError(t *testing.T) {
+	server := chatsServer([]int64{1}, 0)
+	server.Handle("getChat", tdtest.Result(&client.Error{Code: 400, Message: "CHAT_INVALID"}))
+
+	tdlibClient, err := client.NewClient(client.SessionAuthorizer(&client.TdlibParameters{}), client.WithTransport(server))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tdlibClient.Shutdown()
+
+	chatChan, errChan := puller.Chats(tdlibClient)
+	for range chatChan {
+		t.Fatal("unexpected chat")
+	}
+
+	err = <-errChan
+	if !client.IsBadRequest(err) {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
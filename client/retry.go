@@ -0,0 +1,262 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RetryPolicy repeats requests failed with FloodWaitError after the duration requested by the server
+type RetryPolicy struct {
+	// Maximum number of retries of the request
+	MaxRetries int
+	// The request isn't repeated if the server asks to wait longer; 0 means no limit
+	MaxRetryAfter time.Duration
+	// Reports whether the request can be repeated; IsIdempotentRequest is used if nil
+	IsRetryable func(req Request) bool
+}
+
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries:    3,
+		MaxRetryAfter: 1 * time.Minute,
+		IsRetryable:   IsIdempotentRequest,
+	}
+}
+
+// WithRetryPolicy adds RetryInterceptor to the interceptors
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return WithInterceptor(RetryInterceptor(policy))
+}
+
+// IsIdempotentRequest reports whether the request doesn't change anything, so it is safe to repeat it
+func IsIdempotentRequest(req Request) bool {
+	return strings.HasPrefix(req.Type, "get") || strings.HasPrefix(req.Type, "search")
+}
+
+// RetryInterceptor repeats requests according to the policy. Every retry is passed to the next interceptors
+// with the attempt number appended to @extra, so a late response to the previous attempt isn't taken for the response to the retry
+func RetryInterceptor(policy RetryPolicy) Interceptor {
+	isRetryable := policy.IsRetryable
+	if isRetryable == nil {
+		isRetryable = IsIdempotentRequest
+	}
+
+	return func(ctx context.Context, req Request, next Handler) (*Response, error) {
+		extra := req.Extra
+
+		for attempt := 0; ; attempt++ {
+			if attempt > 0 && extra != "" {
+				req.Extra = extra + ":" + strconv.Itoa(attempt)
+			}
+
+			response, err := next(ctx, req)
+			if err != nil || response.Type != "error" || attempt >= policy.MaxRetries || !isRetryable(req) {
+				return response, err
+			}
+
+			retryAfter, ok := RetryAfter(buildResponseError(response.Data))
+			if !ok || (policy.MaxRetryAfter > 0 && retryAfter > policy.MaxRetryAfter) {
+				return response, nil
+			}
+
+			deadline, ok := ctx.Deadline()
+			if ok && time.Until(deadline) < retryAfter {
+				return response, nil
+			}
+
+			err = sleep(ctx, retryAfter)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+// RateLimit is a token bucket limit of requests
+type RateLimit struct {
+	// Requests per second; the limit is disabled if the rate isn't positive
+	Rate float64
+	// Maximum number of requests sent at once
+	Burst int
+	// Splits requests to separate buckets, for example by chat. All requests share one bucket if nil
+	Key func(req Request) string
+}
+
+// WithRateLimit adds RateLimitInterceptor to the interceptors
+func WithRateLimit(method string, limit RateLimit) Option {
+	return WithInterceptor(RateLimitInterceptor(method, limit))
+}
+
+// RateLimitInterceptor limits the requests of the method. Empty method limits all requests
+func RateLimitInterceptor(method string, limit RateLimit) Interceptor {
+	return newRateLimiter(method, limit).intercept
+}
+
+// chatIdRequest is implemented by the generated requests with chat_id parameter
+type chatIdRequest interface {
+	chatId() int64
+}
+
+// ChatIdKey splits requests by chat_id parameter
+func ChatIdKey(req Request) string {
+	switch data := req.Data.(type) {
+	case chatIdRequest:
+		return strconv.FormatInt(data.chatId(), 10)
+
+	case map[string]interface{}:
+		chatId, ok := data["chat_id"]
+		if !ok {
+			return ""
+		}
+
+		return fmt.Sprint(chatId)
+	}
+
+	return ""
+}
+
+// idle full buckets are evicted by the sweep every bucketsSweepInterval requests
+const bucketsSweepInterval = 1000
+
+type rateLimiter struct {
+	mu       sync.Mutex
+	method   string
+	limit    RateLimit
+	buckets  map[string]*tokenBucket
+	requests int
+}
+
+func newRateLimiter(method string, limit RateLimit) *rateLimiter {
+	return &rateLimiter{
+		method:  method,
+		limit:   limit,
+		buckets: map[string]*tokenBucket{},
+	}
+}
+
+func (limiter *rateLimiter) intercept(ctx context.Context, req Request, next Handler) (*Response, error) {
+	if limiter.limit.Rate > 0 && (limiter.method == "" || limiter.method == req.Type) {
+		bucket, delay := limiter.reserve(req)
+
+		err := bucket.wait(ctx, delay)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return next(ctx, req)
+}
+
+// reserve takes a token of the bucket of the request. The token is reserved under the lock, so the bucket isn't swept in the meantime
+func (limiter *rateLimiter) reserve(req Request) (*tokenBucket, time.Duration) {
+	key := ""
+	if limiter.limit.Key != nil {
+		key = limiter.limit.Key(req)
+	}
+
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	limiter.requests++
+	if limiter.requests%bucketsSweepInterval == 0 {
+		limiter.sweep()
+	}
+
+	bucket, ok := limiter.buckets[key]
+	if !ok {
+		bucket = newTokenBucket(limiter.limit.Rate, limiter.limit.Burst)
+		limiter.buckets[key] = bucket
+	}
+
+	return bucket, bucket.reserve()
+}
+
+// sweep removes the buckets which are full again, so the map doesn't grow with every key ever seen.
+// A removed bucket is created full on the next request, so the limit is the same
+func (limiter *rateLimiter) sweep() {
+	now := time.Now()
+	for key, bucket := range limiter.buckets {
+		if bucket.isFull(now) {
+			delete(limiter.buckets, key)
+		}
+	}
+}
+
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &tokenBucket{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// reserve takes a token in advance, so concurrent requests are queued. Returns the delay until the token is available
+func (bucket *tokenBucket) reserve() time.Duration {
+	bucket.mu.Lock()
+	defer bucket.mu.Unlock()
+
+	now := time.Now()
+	bucket.tokens += now.Sub(bucket.last).Seconds() * bucket.rate
+	if bucket.tokens > bucket.burst {
+		bucket.tokens = bucket.burst
+	}
+	bucket.last = now
+
+	bucket.tokens--
+
+	return time.Duration(-bucket.tokens / bucket.rate * float64(time.Second))
+}
+
+// wait sleeps the delay of the reserved token. The token is returned if ctx is done first
+func (bucket *tokenBucket) wait(ctx context.Context, delay time.Duration) error {
+	if delay <= 0 {
+		return nil
+	}
+
+	err := sleep(ctx, delay)
+	if err != nil {
+		bucket.mu.Lock()
+		bucket.tokens++
+		bucket.mu.Unlock()
+	}
+
+	return err
+}
+
+// isFull reports whether the bucket is refilled up to the burst by now
+func (bucket *tokenBucket) isFull(now time.Time) bool {
+	bucket.mu.Lock()
+	defer bucket.mu.Unlock()
+
+	return bucket.tokens+now.Sub(bucket.last).Seconds()*bucket.rate >= bucket.burst
+}
+
+func sleep(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
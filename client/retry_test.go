@@ -0,0 +1,212 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func floodWaitResponse(message string) *Response {
+	return &Response{
+		meta: meta{
+			Type: "error",
+		},
+		Data: json.RawMessage(`{"@type":"error","code":429,"message":"` + message + `"}`),
+	}
+}
+
+func TestRetryInterceptor(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      Request
+		message  string
+		attempts int
+	}{
+		{"retry", Request{meta: meta{Type: "getChat"}}, "FLOOD_WAIT_0", 4},
+		{"not idempotent", Request{meta: meta{Type: "sendMessage"}}, "FLOOD_WAIT_0", 1},
+		{"too long wait", Request{meta: meta{Type: "getChat"}}, "FLOOD_WAIT_3600", 1},
+		{"unknown wait", Request{meta: meta{Type: "getChat"}}, "Too Many Requests", 1},
+	}
+
+	for _, test := range tests {
+		attempts := 0
+		next := func(ctx context.Context, req Request) (*Response, error) {
+			attempts++
+			return floodWaitResponse(test.message), nil
+		}
+
+		response, err := RetryInterceptor(DefaultRetryPolicy())(context.Background(), test.req, next)
+		if err != nil || response.Type != "error" {
+			t.Errorf("%s: expected the last error response, got %v %v", test.name, response, err)
+		}
+
+		if attempts != test.attempts {
+			t.Errorf("%s: expected %d attempts, got %d", test.name, test.attempts, attempts)
+		}
+	}
+}
+
+func TestRetryInterceptorExtra(t *testing.T) {
+	extras := map[string]bool{}
+	next := func(ctx context.Context, req Request) (*Response, error) {
+		if extras[req.Extra] {
+			t.Errorf("extra %s is reused", req.Extra)
+		}
+		extras[req.Extra] = true
+
+		return floodWaitResponse("FLOOD_WAIT_0"), nil
+	}
+
+	req := Request{meta: meta{Type: "getChat", Extra: "extra"}}
+
+	_, err := RetryInterceptor(DefaultRetryPolicy())(context.Background(), req, next)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(extras) != 4 || !extras["extra"] {
+		t.Fatalf("expected 4 attempts starting with the original extra, got %v", extras)
+	}
+}
+
+func TestRetryInterceptorContext(t *testing.T) {
+	next := func(ctx context.Context, req Request) (*Response, error) {
+		return floodWaitResponse("FLOOD_WAIT_30"), nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+
+	// the deadline is closer than the requested wait, so the error is returned at once
+	response, err := RetryInterceptor(DefaultRetryPolicy())(ctx, Request{meta: meta{Type: "getChat"}}, next)
+	if err != nil || response.Type != "error" {
+		t.Fatalf("expected the error response, got %v %v", response, err)
+	}
+
+	if time.Since(start) > time.Second {
+		t.Fatalf("the request is retried after the deadline")
+	}
+}
+
+func TestRateLimitInterceptor(t *testing.T) {
+	limit := RateLimit{
+		Rate:  20,
+		Burst: 2,
+		Key:   ChatIdKey,
+	}
+
+	interceptor := RateLimitInterceptor("sendMessage", limit)
+
+	next := func(ctx context.Context, req Request) (*Response, error) {
+		return &Response{}, nil
+	}
+
+	send := func(req Request) time.Duration {
+		start := time.Now()
+
+		_, err := interceptor(context.Background(), req, next)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return time.Since(start)
+	}
+
+	chat := func(chatId int64) Request {
+		return Request{
+			meta: meta{Type: "sendMessage"},
+			Data: &SendMessageRequest{ChatId: chatId},
+		}
+	}
+
+	// the burst is sent at once
+	if send(chat(1)) > 20*time.Millisecond || send(chat(1)) > 20*time.Millisecond {
+		t.Fatal("the burst is delayed")
+	}
+
+	// the third request waits for a token
+	if send(chat(1)) < 30*time.Millisecond {
+		t.Fatal("the request isn't delayed")
+	}
+
+	// other chats and methods have their own limits
+	if send(chat(2)) > 20*time.Millisecond {
+		t.Fatal("the request of another chat is delayed")
+	}
+
+	if send(Request{meta: meta{Type: "getChat"}, Data: &GetChatRequest{ChatId: 1}}) > 20*time.Millisecond {
+		t.Fatal("the request of another method is delayed")
+	}
+}
+
+func TestRateLimitInterceptorContext(t *testing.T) {
+	interceptor := RateLimitInterceptor("", RateLimit{Rate: 0.001, Burst: 1})
+
+	next := func(ctx context.Context, req Request) (*Response, error) {
+		return &Response{}, nil
+	}
+
+	_, err := interceptor(context.Background(), Request{}, next)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err = interceptor(ctx, Request{}, next)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestRateLimiterSweep(t *testing.T) {
+	limiter := newRateLimiter("", RateLimit{
+		Rate:  1000,
+		Burst: 1,
+		Key:   ChatIdKey,
+	})
+
+	for chatId := 1; chatId < bucketsSweepInterval; chatId++ {
+		limiter.reserve(Request{Data: map[string]interface{}{"chat_id": chatId}})
+	}
+
+	if len(limiter.buckets) != bucketsSweepInterval-1 {
+		t.Fatalf("expected a bucket per chat, got %d", len(limiter.buckets))
+	}
+
+	time.Sleep(10 * time.Millisecond)
+
+	// the buckets are refilled, so they are swept on the next request
+	limiter.reserve(Request{Data: map[string]interface{}{"chat_id": 0}})
+
+	if len(limiter.buckets) != 1 {
+		t.Fatalf("expected idle buckets to be swept, got %d", len(limiter.buckets))
+	}
+}
+
+func TestChatIdKey(t *testing.T) {
+	tests := []struct {
+		data interface{}
+		key  string
+	}{
+		{&SendMessageRequest{ChatId: -100123}, "-100123"},
+		{&GetChatHistoryRequest{ChatId: 7, Limit: 10}, "7"},
+		{&CreatePrivateChatRequest{UserId: 5}, ""},
+		{map[string]interface{}{"chat_id": 42}, "42"},
+		{&GetChatsRequest{Limit: 10}, ""},
+		{map[string]interface{}{}, ""},
+		{nil, ""},
+	}
+
+	for _, test := range tests {
+		key := ChatIdKey(Request{Data: test.data})
+		if key != test.key {
+			t.Errorf("%#v: expected %q, got %q", test.data, test.key, key)
+		}
+	}
+}
@@ -0,0 +1,241 @@
+package client
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// binlog files of production and test environments
+var sessionBinlogs = []string{"td.binlog", "td_test.binlog"}
+
+// HasBinlog reports whether TDLib binlog exists in the database directory. The authorization state isn't checked,
+// so the session may be not authorized, for example if it is terminated from another device or the login wasn't finished.
+// Use SessionAuthorizer to find it out, it fails with ErrNeedsLogin in this case
+func HasBinlog(databaseDirectory string) bool {
+	for _, binlog := range sessionBinlogs {
+		info, err := os.Stat(filepath.Join(databaseDirectory, binlog))
+		if err == nil && info.Mode().IsRegular() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ListSessions returns sorted keys of the accounts having TDLib binlog in the root directory of Manager, see HasBinlog
+func ListSessions(root string) ([]string, error) {
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := []string{}
+	for _, entry := range entries {
+		if entry.IsDir() && HasBinlog(filepath.Join(root, entry.Name(), "database")) {
+			keys = append(keys, entry.Name())
+		}
+	}
+	sort.Strings(keys)
+
+	return keys, nil
+}
+
+// CopySession copies the session directory to the new directory outside of it. The session mustn't be used by a running client
+func CopySession(src string, dst string) error {
+	inside, err := isInside(src, dst)
+	if err != nil {
+		return err
+	}
+	if inside {
+		return fmt.Errorf("%s is inside the session directory %s", dst, src)
+	}
+
+	return filepath.WalkDir(src, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() {
+			return os.MkdirAll(target, info.Mode().Perm()|0700)
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		return copyFile(path, target, info.Mode().Perm())
+	})
+}
+
+// ArchiveSession writes the session directory to w as gzipped tar. The session mustn't be used by a running client
+func ArchiveSession(src string, w io.Writer) error {
+	gzipWriter := gzip.NewWriter(w)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	err := filepath.WalkDir(src, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, path)
+		if err != nil || rel == "." {
+			return err
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() && !info.IsDir() {
+			return nil
+		}
+
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(rel)
+
+		err = tarWriter.WriteHeader(header)
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			return nil
+		}
+
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		_, err = io.Copy(tarWriter, file)
+
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	err = tarWriter.Close()
+	if err != nil {
+		return err
+	}
+
+	return gzipWriter.Close()
+}
+
+// ExtractSession restores the session directory archived by ArchiveSession
+func ExtractSession(r io.Reader, dst string) error {
+	gzipReader, err := gzip.NewReader(r)
+	if err != nil {
+		return err
+	}
+	defer gzipReader.Close()
+
+	tarReader := tar.NewReader(gzipReader)
+
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		// the entry of the directory itself, for example "./", is allowed
+		target := filepath.Join(dst, filepath.FromSlash(header.Name))
+		inside, err := isInside(dst, target)
+		if err != nil {
+			return err
+		}
+		if !inside {
+			return fmt.Errorf("invalid archive entry: %s", header.Name)
+		}
+
+		mode := os.FileMode(header.Mode).Perm()
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			err = os.MkdirAll(target, mode|0700)
+
+		case tar.TypeReg:
+			err = writeFile(target, tarReader, mode)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// isInside reports whether the path is the directory or is inside of it
+func isInside(dir string, path string) (bool, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return false, err
+	}
+
+	path, err = filepath.Abs(path)
+	if err != nil {
+		return false, err
+	}
+
+	rel, err := filepath.Rel(dir, path)
+	if err != nil {
+		return false, nil
+	}
+
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(os.PathSeparator)), nil
+}
+
+func copyFile(src string, dst string, mode os.FileMode) error {
+	file, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return writeFile(dst, file, mode)
+}
+
+func writeFile(path string, r io.Reader, mode os.FileMode) error {
+	err := os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(file, r)
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
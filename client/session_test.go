@@ -0,0 +1,207 @@
+package client_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/megaplan/go-tdlib/client"
+)
+
+// newSession creates the directory of Manager account with the binlog and a file
+func newSession(t *testing.T, root string, key string) string {
+	dir := filepath.Join(root, key)
+
+	err := os.MkdirAll(filepath.Join(dir, "database"), 0700)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = os.WriteFile(filepath.Join(dir, "database", "td.binlog"), []byte("binlog"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = os.MkdirAll(filepath.Join(dir, "files", "photos"), 0700)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = os.WriteFile(filepath.Join(dir, "files", "photos", "1.jpg"), []byte("photo"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return dir
+}
+
+func assertSameSession(t *testing.T, expected string, actual string) {
+	for _, name := range []string{"database/td.binlog", "files/photos/1.jpg"} {
+		expectedData, err := os.ReadFile(filepath.Join(expected, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		actualData, err := os.ReadFile(filepath.Join(actual, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(expectedData, actualData) {
+			t.Fatalf("%s: expected %q, got %q", name, expectedData, actualData)
+		}
+	}
+}
+
+func TestListSessions(t *testing.T) {
+	root := t.TempDir()
+	newSession(t, root, "b")
+	newSession(t, root, "a")
+
+	err := os.MkdirAll(filepath.Join(root, "empty", "database"), 0700)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keys, err := client.ListSessions(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(keys, []string{"a", "b"}) {
+		t.Fatalf("unexpected sessions %v", keys)
+	}
+
+	if client.HasBinlog(filepath.Join(root, "empty", "database")) {
+		t.Fatal("directory without binlog is reported")
+	}
+}
+
+func TestCopySession(t *testing.T) {
+	root := t.TempDir()
+	src := newSession(t, root, "account")
+
+	dst := filepath.Join(root, "backup", "account")
+
+	err := client.CopySession(src, dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertSameSession(t, src, dst)
+
+	// a copy into the session itself would copy the copy
+	for _, dst := range []string{src, filepath.Join(src, "backup"), filepath.Join(src, "files", "..", "backup")} {
+		err = client.CopySession(src, dst)
+		if err == nil {
+			t.Errorf("session is copied into itself: %s", dst)
+		}
+	}
+
+	// a sibling directory with the same prefix is outside
+	err = client.CopySession(src, src+"-copy")
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestArchiveSession(t *testing.T) {
+	root := t.TempDir()
+	src := newSession(t, root, "account")
+
+	var archive bytes.Buffer
+
+	err := client.ArchiveSession(src, &archive)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dst := filepath.Join(root, "restored")
+
+	err = client.ExtractSession(bytes.NewReader(archive.Bytes()), dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertSameSession(t, src, dst)
+}
+
+// archive returns gzipped tar of the entries, a name ending with a slash is a directory
+func archive(t *testing.T, names ...string) []byte {
+	var buf bytes.Buffer
+
+	gzipWriter := gzip.NewWriter(&buf)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	for _, name := range names {
+		header := &tar.Header{Name: name, Mode: 0600, Typeflag: tar.TypeReg, Size: int64(len(name))}
+		if name[len(name)-1] == '/' {
+			header = &tar.Header{Name: name, Mode: 0700, Typeflag: tar.TypeDir}
+		}
+
+		err := tarWriter.WriteHeader(header)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if header.Typeflag == tar.TypeReg {
+			_, err = tarWriter.Write([]byte(name))
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	err := tarWriter.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = gzipWriter.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.Bytes()
+}
+
+func TestExtractSession(t *testing.T) {
+	root := t.TempDir()
+	dst := filepath.Join(root, "account")
+
+	// archives made by tar -C dir . start with the directory itself
+	err := client.ExtractSession(bytes.NewReader(archive(t, "./", "./database/", "./database/td.binlog")), dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !client.HasBinlog(filepath.Join(dst, "database")) {
+		t.Fatal("binlog isn't extracted")
+	}
+
+	traversals := [][]string{
+		{"../evil"},
+		{"database/../../evil"},
+		{"../account-evil/"},
+	}
+
+	for _, names := range traversals {
+		err = client.ExtractSession(bytes.NewReader(archive(t, names...)), dst)
+		if err == nil {
+			t.Errorf("%v is extracted", names)
+		}
+	}
+
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(entries) != 1 {
+		t.Fatalf("files are written outside the session directory: %v", entries)
+	}
+}
@@ -1,50 +1,76 @@
 package client
 
-/*
-#include <stdlib.h>
-#include <string.h>
-#include <td/telegram/td_json_client.h>
-*/
-import "C"
-
 import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"log"
+	"reflect"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
-	"unsafe"
 )
 
-var tdlibInstance *tdlib
+var errTransportNotAvailable = errors.New("TDLib transport is not available, the package is built without cgo")
+
+// Deprecated: Class was generated by mistake from the second line of DeviceToken description
+// and is equal to ClassPremiumState. Use the constant of the class
+const Class = ClassPremiumState
+
+var (
+	tdlibInstancesMu sync.Mutex
+	// instances are keyed by transportKey and removed when their receivers stop
+	tdlibInstances = map[interface{}]*tdlib{}
+)
 
-func init() {
-	tdlibInstance = &tdlib{
-		timeout: 60 * time.Second,
-		clients: map[int]*Client{},
+// transportKey identifies the transport in tdlibInstances. Comparable transports are keyed by their values,
+// so clients of the same pointer share the receiver. A non-comparable transport can't be identified,
+// so every client of it gets a separate receiver
+func transportKey(transport Transport) interface{} {
+	if !reflect.TypeOf(transport).Comparable() {
+		return new(struct{ Transport })
 	}
-}
 
-type tdlib struct {
-	once    sync.Once
-	timeout time.Duration
-	mu      sync.Mutex
-	clients map[int]*Client
+	return transport
 }
 
-func (instance *tdlib) addClient(client *Client) {
-	instance.mu.Lock()
-	defer instance.mu.Unlock()
+// addTdlibClient registers the client in the instance receiving updates and responses of all clients of the transport.
+// The receiver of the instance is started with the first client and stops when the last client is removed
+func addTdlibClient(client *Client) *tdlib {
+	tdlibInstancesMu.Lock()
+	defer tdlibInstancesMu.Unlock()
 
-	instance.clients[client.jsonClient.id] = client
+	key := transportKey(client.transport)
+
+	instance, ok := tdlibInstances[key]
+	if !ok {
+		instance = &tdlib{
+			key:       key,
+			transport: client.transport,
+			timeout:   60 * time.Second,
+			clients:   map[int]*Client{},
+		}
+		tdlibInstances[key] = instance
 
-	instance.once.Do(func() {
 		go instance.receiver()
-	})
+	}
+
+	instance.mu.Lock()
+	instance.clients[client.jsonClient.id] = client
+	instance.mu.Unlock()
+
+	return instance
 }
 
+type tdlib struct {
+	key       interface{}
+	transport Transport
+	timeout   time.Duration
+	mu        sync.Mutex
+	clients   map[int]*Client
+}
+
+// removeClient unregisters the client. The receiver stops after the current Receive call if no clients are left
 func (instance *tdlib) removeClient(client *Client) {
 	instance.mu.Lock()
 	defer instance.mu.Unlock()
@@ -52,6 +78,23 @@ func (instance *tdlib) removeClient(client *Client) {
 	delete(instance.clients, client.jsonClient.id)
 }
 
+// stopIfIdle removes the instance without clients, so the next client of the transport starts a new receiver
+func (instance *tdlib) stopIfIdle() bool {
+	tdlibInstancesMu.Lock()
+	defer tdlibInstancesMu.Unlock()
+
+	instance.mu.Lock()
+	defer instance.mu.Unlock()
+
+	if len(instance.clients) > 0 {
+		return false
+	}
+
+	delete(tdlibInstances, instance.key)
+
+	return true
+}
+
 func (instance *tdlib) getClient(id int) (*Client, error) {
 	instance.mu.Lock()
 	defer instance.mu.Unlock()
@@ -66,97 +109,124 @@ func (instance *tdlib) getClient(id int) (*Client, error) {
 
 func (instance *tdlib) receiver() {
 	for {
-		resp, err := instance.receive(instance.timeout)
+		data := instance.transport.Receive(instance.timeout)
+
+		if instance.stopIfIdle() {
+			return
+		}
+
+		if data == nil {
+			continue
+		}
+
+		resp, err := parseResponse(data)
 		if err != nil {
+			diagnose(Diagnostic{
+				Kind: DiagnosticUnmarshalError,
+				Err:  err,
+				Data: data,
+			})
 			continue
 		}
 
 		client, err := instance.getClient(resp.ClientId)
 		if err != nil {
-			log.Print(err)
+			diagnose(Diagnostic{
+				Kind:     DiagnosticUnknownClient,
+				Type:     resp.Type,
+				Extra:    resp.Extra,
+				ClientId: resp.ClientId,
+				Err:      err,
+				Data:     resp.Data,
+			})
 			continue
 		}
 
-		client.responses <- resp
+		client.deliver(resp)
 	}
 }
 
-// Receives incoming updates and request responses from the TDLib client. May be called from any thread, but
-// shouldn't be called simultaneously from two different threads.
-// Returned pointer will be deallocated by TDLib during next call to td_json_client_receive or td_json_client_execute
-// in the same thread, so it can't be used after that.
-func (instance *tdlib) receive(timeout time.Duration) (*Response, error) {
-	result := C.td_receive(C.double(float64(timeout) / float64(time.Second)))
-	if result == nil {
-		return nil, errors.New("update receiving timeout")
-	}
+func Execute(req Request) (*Response, error) {
+	return execute(defaultTransport, req)
+}
 
-	resultLen := C.strlen(result)
-	data := C.GoBytes(unsafe.Pointer(result), C.int(resultLen))
+func execute(transport Transport, req Request) (*Response, error) {
+	if transport == nil {
+		return nil, errTransportNotAvailable
+	}
 
-	var resp Response
+	data, _ := req.MarshalJSON()
 
-	err := json.Unmarshal(data, &resp)
-	if err != nil {
-		return nil, err
+	result := transport.Execute(data)
+	if result == nil {
+		return nil, errors.New("request can't be parsed")
 	}
 
-	resp.Data = data
-
-	return &resp, nil
+	return parseResponse(result)
 }
 
-func Execute(req Request) (*Response, error) {
-	data, _ := json.Marshal(req)
+// parseResponse reads only the meta of the response. The data is unmarshaled by the client if needed.
+// The result of the methods returning vectors is a JSON array, it is kept without the meta
+func parseResponse(data []byte) (*Response, error) {
+	decoder := newDecoder(data)
 
-	query := C.CString(string(data))
-	defer C.free(unsafe.Pointer(query))
-	result := C.td_execute(query)
-	if result == nil {
-		return nil, errors.New("request can't be parsed")
-	}
+	if decoder.peek() == '[' {
+		decoder.skip()
 
-	resultLen := C.strlen(result)
-	data = C.GoBytes(unsafe.Pointer(result), C.int(resultLen))
+		err := decoder.finish()
+		if err != nil {
+			return nil, err
+		}
 
-	var resp Response
+		return &Response{
+			Data: data,
+		}, nil
+	}
 
-	err := json.Unmarshal(data, &resp)
+	meta := decoder.meta()
+
+	err := decoder.finish()
 	if err != nil {
 		return nil, err
 	}
 
-	resp.Data = data
-
-	return &resp, nil
+	return &Response{
+		meta: meta,
+		Data: data,
+	}, nil
 }
 
 type JsonClient struct {
-	id int
+	id        int
+	transport Transport
 }
 
 func NewJsonClient() *JsonClient {
+	return newJsonClient(defaultTransport)
+}
+
+func newJsonClient(transport Transport) *JsonClient {
+	if transport == nil {
+		panic(errTransportNotAvailable)
+	}
+
 	return &JsonClient{
-		id: int(C.td_create_client_id()),
+		id:        transport.CreateClientId(),
+		transport: transport,
 	}
 }
 
 // Sends request to the TDLib client. May be called from any thread.
 func (jsonClient *JsonClient) Send(req Request) {
-	data, _ := json.Marshal(req)
-
-	query := C.CString(string(data))
-	defer C.free(unsafe.Pointer(query))
+	data, _ := req.MarshalJSON()
 
-	C.td_send(C.int(jsonClient.id), query)
+	jsonClient.transport.Send(jsonClient.id, data)
 }
 
 // Synchronously executes TDLib request. May be called from any thread.
 // Only a few requests can be executed synchronously.
-// Returned pointer will be deallocated by TDLib during next call to td_json_client_receive or td_json_client_execute
-// in the same thread, so it can't be used after that.
 func (jsonClient *JsonClient) Execute(req Request) (*Response, error) {
-	return Execute(req)
+	return execute(jsonClient.transport, req)
 }
 
 type meta struct {
@@ -167,40 +237,21 @@ type meta struct {
 
 type Request struct {
 	meta
-	Data map[string]interface{}
+	// Parameters of the request: generated *XxxRequest, map[string]interface{} or nil
+	Data interface{}
 }
 
+// MarshalJSON encodes the parameters of the request with @type and @extra
 func (req Request) MarshalJSON() ([]byte, error) {
-	req.Data["@type"] = req.Type
-	req.Data["@extra"] = req.Extra
-
-	return json.Marshal(req.Data)
+	return encodeRequest(req.Type, req.Extra, req.Data)
 }
 
+// Response is a TDLib object with the meta or a JSON array of the objects with empty meta
 type Response struct {
 	meta
 	Data json.RawMessage
 }
 
-type ResponseError struct {
-	Err *Error
-}
-
-func (responseError ResponseError) Error() string {
-	return fmt.Sprintf("%d %s", responseError.Err.Code, responseError.Err.Message)
-}
-
-func buildResponseError(data json.RawMessage) error {
-	respErr, err := UnmarshalError(data)
-	if err != nil {
-		return err
-	}
-
-	return ResponseError{
-		Err: respErr,
-	}
-}
-
 // JsonInt64 alias for int64, in order to deal with json big number problem
 type JsonInt64 int64
 
@@ -229,3 +280,35 @@ type Type interface {
 	GetType() string
 	GetClass() string
 }
+
+// UnknownType is a type missing in the schema. It keeps @type and raw JSON of the object
+type UnknownType struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownType) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+// GetClass returns an empty string, the class of the type is unknown
+func (*UnknownType) GetClass() string {
+	return ""
+}
+
+func (entity *UnknownType) GetType() string {
+	return entity.Type
+}
+
+// unknownType keeps the type missing in the schema. Updates are recognized by the name prefix
+func unknownType(meta meta, data json.RawMessage) Type {
+	if strings.HasPrefix(meta.Type, "update") {
+		return &UnknownUpdate{meta: meta, Raw: data}
+	}
+
+	return &UnknownType{meta: meta, Raw: data}
+}
@@ -0,0 +1,104 @@
+package client
+
+import (
+	"testing"
+	"time"
+)
+
+// listTransport isn't comparable because of the slice
+type listTransport struct {
+	received []byte
+}
+
+func (transport listTransport) CreateClientId() int {
+	return 1
+}
+
+func (transport listTransport) Send(clientId int, request []byte) {
+}
+
+func (transport listTransport) Receive(timeout time.Duration) []byte {
+	time.Sleep(time.Millisecond)
+
+	return transport.received
+}
+
+func (transport listTransport) Execute(request []byte) []byte {
+	return nil
+}
+
+type pointerTransport struct {
+	listTransport
+	lastClientId int
+}
+
+func (transport *pointerTransport) CreateClientId() int {
+	transport.lastClientId++
+
+	return transport.lastClientId
+}
+
+func hasTdlibInstance(instance *tdlib) bool {
+	tdlibInstancesMu.Lock()
+	defer tdlibInstancesMu.Unlock()
+
+	return tdlibInstances[instance.key] == instance
+}
+
+// waitTdlibStopped waits until the receiver of the instance stops
+func waitTdlibStopped(t *testing.T, instance *tdlib) {
+	deadline := time.Now().Add(time.Second)
+	for hasTdlibInstance(instance) {
+		if time.Now().After(deadline) {
+			t.Fatal("receiver isn't stopped after the last client is removed")
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestTdlibInstances(t *testing.T) {
+	transport := &pointerTransport{}
+
+	first := createClient(WithTransport(transport))
+	second := createClient(WithTransport(transport))
+
+	if first.tdlib != second.tdlib {
+		t.Fatal("clients of the same transport don't share the receiver")
+	}
+
+	first.Shutdown()
+
+	time.Sleep(10 * time.Millisecond)
+	if !hasTdlibInstance(second.tdlib) {
+		t.Fatal("receiver is stopped with a client left")
+	}
+
+	second.Shutdown()
+	waitTdlibStopped(t, second.tdlib)
+
+	// the next client starts a new receiver
+	third := createClient(WithTransport(transport))
+	defer third.Shutdown()
+
+	if third.tdlib == second.tdlib || !hasTdlibInstance(third.tdlib) {
+		t.Fatal("receiver isn't started for the new client")
+	}
+}
+
+func TestTdlibNotComparableTransport(t *testing.T) {
+	transport := listTransport{}
+
+	first := createClient(WithTransport(transport))
+	second := createClient(WithTransport(transport))
+
+	if first.tdlib == second.tdlib {
+		t.Fatal("clients of a not comparable transport share the receiver")
+	}
+
+	first.Shutdown()
+	second.Shutdown()
+
+	waitTdlibStopped(t, first.tdlib)
+	waitTdlibStopped(t, second.tdlib)
+}
@@ -0,0 +1,359 @@
+package tdtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/megaplan/go-tdlib/client"
+)
+
+// Handler returns the result of the request. Return *client.Error to fail the request
+type Handler func(req *Request) client.Type
+
+// Result returns a handler answering every request with the result
+func Result(result client.Type) Handler {
+	return func(req *Request) client.Type {
+		return result
+	}
+}
+
+// Vector is the result of the methods returning vectors. It is answered with a JSON array without @extra and @client_id,
+// so only executed requests get it
+type Vector []client.Type
+
+func (Vector) GetType() string {
+	return "vector"
+}
+
+func (Vector) GetClass() string {
+	return "Vector"
+}
+
+// Request is a request received by the server
+type Request struct {
+	ClientId int
+	Type     string
+	Extra    string
+	Data     json.RawMessage
+}
+
+// Decode unmarshals the request parameters to v
+func (req *Request) Decode(v interface{}) error {
+	return json.Unmarshal(req.Data, v)
+}
+
+// Server is a fake TDLib implementing client.Transport.
+// Requests are answered by the handlers registered for their @type, updates are injected with SendUpdate.
+// Handlers are called asynchronously like TDLib does, the requests of a client are answered in the order they were sent
+type Server struct {
+	mu                 sync.Mutex
+	lastClientId       int
+	clientIds          []int
+	handlers           map[string]Handler
+	requests           []*Request
+	authorizationState client.AuthorizationState
+	started            map[int]bool
+	// requests waiting for the answer by client
+	pending   map[int][]*Request
+	answering map[int]bool
+	queue     [][]byte
+	notify    chan struct{}
+	closeOnce sync.Once
+	closed    chan struct{}
+}
+
+// NewServer returns a server of already authorized clients
+func NewServer() *Server {
+	server := &Server{
+		handlers:           map[string]Handler{},
+		authorizationState: &client.AuthorizationStateReady{},
+		started:            map[int]bool{},
+		pending:            map[int][]*Request{},
+		answering:          map[int]bool{},
+		notify:             make(chan struct{}, 1),
+		closed:             make(chan struct{}),
+	}
+
+	server.Handle("getAuthorizationState", func(req *Request) client.Type {
+		server.mu.Lock()
+		defer server.mu.Unlock()
+
+		return server.authorizationState.(client.Type)
+	})
+
+	return server
+}
+
+// Handle registers the handler of the requests of the type, for example "getMe"
+func (server *Server) Handle(typ string, handler Handler) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	server.handlers[typ] = handler
+}
+
+// SetAuthorizationState changes the state sent to a client on its first request and returned by getAuthorizationState
+func (server *Server) SetAuthorizationState(state client.AuthorizationState) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	server.authorizationState = state
+}
+
+// SendUpdate passes the update to the listeners of the client
+func (server *Server) SendUpdate(clientId int, update client.Type) error {
+	data, err := marshal(update, "", clientId)
+	if err != nil {
+		return err
+	}
+
+	server.push(data)
+
+	return nil
+}
+
+// Broadcast passes the update to the listeners of all clients
+func (server *Server) Broadcast(update client.Type) error {
+	for _, clientId := range server.ClientIds() {
+		err := server.SendUpdate(clientId, update)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ClientIds returns identifiers of all created clients
+func (server *Server) ClientIds() []int {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	return append([]int{}, server.clientIds...)
+}
+
+// Requests returns all received requests of the types in the order they were sent. All requests are returned if no type is passed
+func (server *Server) Requests(types ...string) []*Request {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	requests := []*Request{}
+	for _, req := range server.requests {
+		if len(types) == 0 || contains(types, req.Type) {
+			requests = append(requests, req)
+		}
+	}
+
+	return requests
+}
+
+func (server *Server) CreateClientId() int {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	server.lastClientId++
+	server.clientIds = append(server.clientIds, server.lastClientId)
+
+	return server.lastClientId
+}
+
+func (server *Server) Send(clientId int, request []byte) {
+	req, err := parseRequest(clientId, request)
+	if err != nil {
+		return
+	}
+
+	server.mu.Lock()
+	server.requests = append(server.requests, req)
+	first := !server.started[clientId]
+	server.started[clientId] = true
+	state := server.authorizationState
+	server.mu.Unlock()
+
+	// TDLib sends the authorization state as soon as the first request is received
+	if first {
+		server.SendUpdate(clientId, &client.UpdateAuthorizationState{
+			AuthorizationState: state,
+		})
+	}
+
+	server.answer(req)
+}
+
+// answer queues the request. A goroutine of the client answers the queued requests one by one and exits when the queue is empty
+func (server *Server) answer(req *Request) {
+	server.mu.Lock()
+	server.pending[req.ClientId] = append(server.pending[req.ClientId], req)
+	answering := server.answering[req.ClientId]
+	server.answering[req.ClientId] = true
+	server.mu.Unlock()
+
+	if !answering {
+		go server.answerPending(req.ClientId)
+	}
+}
+
+func (server *Server) answerPending(clientId int) {
+	for {
+		server.mu.Lock()
+		pending := server.pending[clientId]
+		if len(pending) == 0 {
+			delete(server.pending, clientId)
+			delete(server.answering, clientId)
+			server.mu.Unlock()
+			return
+		}
+		req := pending[0]
+		server.pending[clientId] = pending[1:]
+		server.mu.Unlock()
+
+		data, err := marshal(server.handle(req), req.Extra, clientId)
+		if err != nil {
+			continue
+		}
+
+		server.push(data)
+	}
+}
+
+func (server *Server) Receive(timeout time.Duration) []byte {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+		data := server.pop()
+		if data != nil {
+			return data
+		}
+
+		select {
+		case <-server.notify:
+		case <-timer.C:
+			return nil
+		case <-server.closed:
+			return nil
+		}
+	}
+}
+
+// Close makes Receive return without waiting, so the receiver of the server stops at once instead of after the receive timeout.
+// Call it after the clients of the server are shut down
+func (server *Server) Close() {
+	server.closeOnce.Do(func() {
+		close(server.closed)
+	})
+}
+
+func (server *Server) Execute(request []byte) []byte {
+	req, err := parseRequest(0, request)
+	if err != nil {
+		return nil
+	}
+
+	server.mu.Lock()
+	server.requests = append(server.requests, req)
+	server.mu.Unlock()
+
+	data, err := marshal(server.handle(req), req.Extra, 0)
+	if err != nil {
+		return nil
+	}
+
+	return data
+}
+
+func (server *Server) handle(req *Request) client.Type {
+	server.mu.Lock()
+	handler, ok := server.handlers[req.Type]
+	server.mu.Unlock()
+
+	if !ok {
+		return &client.Error{
+			Code:    400,
+			Message: fmt.Sprintf("tdtest: no handler for %s", req.Type),
+		}
+	}
+
+	return handler(req)
+}
+
+func (server *Server) push(data []byte) {
+	server.mu.Lock()
+	server.queue = append(server.queue, data)
+	server.mu.Unlock()
+
+	select {
+	case server.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (server *Server) pop() []byte {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	if len(server.queue) == 0 {
+		return nil
+	}
+
+	data := server.queue[0]
+	server.queue = server.queue[1:]
+
+	return data
+}
+
+func parseRequest(clientId int, data []byte) (*Request, error) {
+	var meta struct {
+		Type  string `json:"@type"`
+		Extra string `json:"@extra"`
+	}
+
+	err := json.Unmarshal(data, &meta)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Request{
+		ClientId: clientId,
+		Type:     meta.Type,
+		Extra:    meta.Extra,
+		Data:     data,
+	}, nil
+}
+
+// marshal adds @extra and @client_id to the object
+func marshal(typ client.Type, extra string, clientId int) ([]byte, error) {
+	data, err := json.Marshal(typ)
+	if err != nil {
+		return nil, err
+	}
+
+	_, ok := typ.(Vector)
+	if ok {
+		return data, nil
+	}
+
+	var fields map[string]json.RawMessage
+
+	err = json.Unmarshal(data, &fields)
+	if err != nil {
+		return nil, err
+	}
+
+	fields["@extra"], _ = json.Marshal(extra)
+	fields["@client_id"], _ = json.Marshal(clientId)
+
+	return json.Marshal(fields)
+}
+
+func contains(types []string, typ string) bool {
+	for _, t := range types {
+		if t == typ {
+			return true
+		}
+	}
+
+	return false
+}
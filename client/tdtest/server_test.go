@@ -0,0 +1,135 @@
+package tdtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/megaplan/go-tdlib/client"
+)
+
+func newClient(t *testing.T, server *Server, options ...client.Option) *client.Client {
+	tdlibClient, err := client.NewClient(client.SessionAuthorizer(&client.TdlibParameters{}), append(options, client.WithTransport(server))...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(tdlibClient.Shutdown)
+
+	return tdlibClient
+}
+
+func TestServerResult(t *testing.T) {
+	server := NewServer()
+	server.Handle("getChat", func(req *Request) client.Type {
+		var getChatRequest client.GetChatRequest
+
+		err := req.Decode(&getChatRequest)
+		if err != nil {
+			return &client.Error{Code: 400, Message: err.Error()}
+		}
+
+		return &client.Chat{Id: getChatRequest.ChatId, Title: "test"}
+	})
+
+	tdlibClient := newClient(t, server)
+
+	chat, err := tdlibClient.GetChat(&client.GetChatRequest{ChatId: 42})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if chat.Id != 42 || chat.Title != "test" {
+		t.Fatalf("unexpected chat %#v", chat)
+	}
+
+	_, err = tdlibClient.GetMe()
+	if !client.IsBadRequest(err) {
+		t.Fatalf("expected bad request for a request without handler, got %v", err)
+	}
+
+	requests := server.Requests("getChat", "getMe")
+	if len(requests) != 2 || requests[0].Type != "getChat" || requests[1].Type != "getMe" {
+		t.Fatalf("unexpected requests %v", requests)
+	}
+}
+
+func TestServerSlowHandler(t *testing.T) {
+	server := NewServer()
+	server.Handle("getChat", func(req *Request) client.Type {
+		time.Sleep(300 * time.Millisecond)
+
+		return &client.Chat{}
+	})
+
+	tdlibClient := newClient(t, server)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+
+	_, err := tdlibClient.GetChatContext(ctx, &client.GetChatRequest{ChatId: 1})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > 200*time.Millisecond {
+		t.Fatalf("the client is blocked by the handler for %s", elapsed)
+	}
+}
+
+func TestServerSendUpdate(t *testing.T) {
+	server := NewServer()
+	tdlibClient := newClient(t, server)
+
+	listener := tdlibClient.GetListener(client.WithFilter(client.TypeFilter(client.TypeUpdateNewChat)))
+	defer listener.Close()
+
+	err := server.Broadcast(&client.UpdateNewChat{Chat: &client.Chat{Id: 7}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case update := <-listener.Updates:
+		if update.(*client.UpdateNewChat).Chat.Id != 7 {
+			t.Fatalf("unexpected update %#v", update)
+		}
+
+	case <-time.After(time.Second):
+		t.Fatal("update isn't received")
+	}
+}
+
+func TestServerVector(t *testing.T) {
+	server := NewServer()
+	server.Handle("getTestMessages", Result(Vector{&client.Message{Id: 1}, &client.Message{Id: 2}}))
+
+	tdlibClient := newClient(t, server)
+
+	var req client.Request
+	req.Type = "getTestMessages"
+
+	// the result of the method returning a vector is an array without the meta
+	response, err := tdlibClient.Execute(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if response.Type != "" {
+		t.Fatalf("unexpected type %s", response.Type)
+	}
+
+	var messages []*client.Message
+
+	err = json.Unmarshal(response.Data, &messages)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(messages) != 2 || messages[0].Id != 1 || messages[1].Id != 2 {
+		t.Fatalf("unexpected messages %s", response.Data)
+	}
+}
@@ -0,0 +1,28 @@
+package client
+
+import (
+	"time"
+)
+
+// Transport is the TDLib JSON interface used by the client.
+// TDLib is used by default if the package is built with cgo.
+// Clients of the same transport share one receiver, the transport is identified by its value, so pass a pointer
+// to share a stateful transport. The receiver stops when the last client of the transport is shut down
+type Transport interface {
+	// Returns an identifier of a new TDLib instance. The instance doesn't send updates until the first request is sent to it
+	CreateClientId() int
+	// Sends request to the TDLib instance. May be called from any thread
+	Send(clientId int, request []byte)
+	// Receives incoming update or response of any TDLib instance. Returns nil if nothing was received during the timeout.
+	// Isn't called simultaneously from two different threads
+	Receive(timeout time.Duration) []byte
+	// Synchronously executes TDLib request. Returns nil if the request can't be parsed
+	Execute(request []byte) []byte
+}
+
+// WithTransport replaces default TDLib transport, for example with in-memory implementation in tests
+func WithTransport(transport Transport) Option {
+	return func(client *Client) {
+		client.transport = transport
+	}
+}
@@ -0,0 +1,7 @@
+//go:build !cgo
+// +build !cgo
+
+package client
+
+// TDLib can't be used without cgo, so the transport must be passed with WithTransport option
+var defaultTransport Transport
@@ -0,0 +1,63 @@
+//go:build cgo
+// +build cgo
+
+package client
+
+/*
+#include <stdlib.h>
+#include <string.h>
+#include <td/telegram/td_json_client.h>
+*/
+import "C"
+
+import (
+	"time"
+	"unsafe"
+)
+
+var defaultTransport Transport = tdjsonTransport{}
+
+type tdjsonTransport struct{}
+
+func (tdjsonTransport) CreateClientId() int {
+	return int(C.td_create_client_id())
+}
+
+// Sends request to the TDLib client. May be called from any thread.
+func (tdjsonTransport) Send(clientId int, request []byte) {
+	query := C.CString(string(request))
+	defer C.free(unsafe.Pointer(query))
+
+	C.td_send(C.int(clientId), query)
+}
+
+// Receives incoming updates and request responses from the TDLib client. May be called from any thread, but
+// shouldn't be called simultaneously from two different threads.
+// The result is copied from TDLib buffer, so it may be kept after the next call.
+func (tdjsonTransport) Receive(timeout time.Duration) []byte {
+	result := C.td_receive(C.double(float64(timeout) / float64(time.Second)))
+	if result == nil {
+		return nil
+	}
+
+	resultLen := C.strlen(result)
+
+	return C.GoBytes(unsafe.Pointer(result), C.int(resultLen))
+}
+
+// Synchronously executes TDLib request. May be called from any thread.
+// Only a few requests can be executed synchronously.
+// The result is copied from TDLib buffer, so it may be kept after the next call.
+func (tdjsonTransport) Execute(request []byte) []byte {
+	query := C.CString(string(request))
+	defer C.free(unsafe.Pointer(query))
+
+	result := C.td_execute(query)
+	if result == nil {
+		return nil
+	}
+
+	resultLen := C.strlen(result)
+
+	return C.GoBytes(unsafe.Pointer(result), C.int(resultLen))
+}
@@ -6,6 +6,13 @@ import (
 	"encoding/json"
 )
 
+const (
+	// TDLib version the code is generated for
+	TdlibVersion = "v1.8.14"
+	// SHA-256 of td_api.tl the code is generated from
+	TdlibSchemaChecksum = "d8ecd0788824efa99efed80fe2a965d0127fb85f80e873b29a1e7fefe5445edb"
+)
+
 const (
 	ClassAuthenticationCodeType                 = "AuthenticationCodeType"
 	ClassEmailAddressAuthentication             = "EmailAddressAuthentication"
@@ -339,7 +346,6 @@ const (
 	ClassPremiumFeatures                        = "PremiumFeatures"
 	ClassPremiumFeaturePromotionAnimation       = "PremiumFeaturePromotionAnimation"
 	ClassPremiumState                           = "PremiumState"
-	Class                                       //-To = "https://my.telegram.org"
 	ClassPushReceiverId                         = "PushReceiverId"
 	ClassThemeSettings                          = "ThemeSettings"
 	ClassChatTheme                              = "ChatTheme"
@@ -1971,7 +1977,7 @@ type StorePaymentPurpose interface {
 	StorePaymentPurposeType() string
 }
 
-// Represents a data needed to subscribe for push notifications through registerDevice method.
+// Represents a data needed to subscribe for push notifications through registerDevice method. To use specific push notification service, the correct application platform must be specified and a valid server authentication data must be uploaded at https://my.telegram.org
 type DeviceToken interface {
 	DeviceTokenType() string
 }
@@ -2151,6 +2157,2866 @@ type LogStream interface {
 	LogStreamType() string
 }
 
+// UnknownAuthenticationCodeType is a subtype of AuthenticationCodeType missing in the schema. It keeps @type and raw JSON of the object
+type UnknownAuthenticationCodeType struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownAuthenticationCodeType) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownAuthenticationCodeType) GetClass() string {
+	return ClassAuthenticationCodeType
+}
+
+func (entity *UnknownAuthenticationCodeType) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownAuthenticationCodeType) AuthenticationCodeTypeType() string {
+	return entity.Type
+}
+
+// UnknownEmailAddressAuthentication is a subtype of EmailAddressAuthentication missing in the schema. It keeps @type and raw JSON of the object
+type UnknownEmailAddressAuthentication struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownEmailAddressAuthentication) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownEmailAddressAuthentication) GetClass() string {
+	return ClassEmailAddressAuthentication
+}
+
+func (entity *UnknownEmailAddressAuthentication) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownEmailAddressAuthentication) EmailAddressAuthenticationType() string {
+	return entity.Type
+}
+
+// UnknownEmailAddressResetState is a subtype of EmailAddressResetState missing in the schema. It keeps @type and raw JSON of the object
+type UnknownEmailAddressResetState struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownEmailAddressResetState) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownEmailAddressResetState) GetClass() string {
+	return ClassEmailAddressResetState
+}
+
+func (entity *UnknownEmailAddressResetState) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownEmailAddressResetState) EmailAddressResetStateType() string {
+	return entity.Type
+}
+
+// UnknownAuthorizationState is a subtype of AuthorizationState missing in the schema. It keeps @type and raw JSON of the object
+type UnknownAuthorizationState struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownAuthorizationState) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownAuthorizationState) GetClass() string {
+	return ClassAuthorizationState
+}
+
+func (entity *UnknownAuthorizationState) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownAuthorizationState) AuthorizationStateType() string {
+	return entity.Type
+}
+
+// UnknownInputFile is a subtype of InputFile missing in the schema. It keeps @type and raw JSON of the object
+type UnknownInputFile struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownInputFile) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownInputFile) GetClass() string {
+	return ClassInputFile
+}
+
+func (entity *UnknownInputFile) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownInputFile) InputFileType() string {
+	return entity.Type
+}
+
+// UnknownThumbnailFormat is a subtype of ThumbnailFormat missing in the schema. It keeps @type and raw JSON of the object
+type UnknownThumbnailFormat struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownThumbnailFormat) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownThumbnailFormat) GetClass() string {
+	return ClassThumbnailFormat
+}
+
+func (entity *UnknownThumbnailFormat) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownThumbnailFormat) ThumbnailFormatType() string {
+	return entity.Type
+}
+
+// UnknownMaskPoint is a subtype of MaskPoint missing in the schema. It keeps @type and raw JSON of the object
+type UnknownMaskPoint struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownMaskPoint) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownMaskPoint) GetClass() string {
+	return ClassMaskPoint
+}
+
+func (entity *UnknownMaskPoint) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownMaskPoint) MaskPointType() string {
+	return entity.Type
+}
+
+// UnknownStickerFormat is a subtype of StickerFormat missing in the schema. It keeps @type and raw JSON of the object
+type UnknownStickerFormat struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownStickerFormat) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownStickerFormat) GetClass() string {
+	return ClassStickerFormat
+}
+
+func (entity *UnknownStickerFormat) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownStickerFormat) StickerFormatType() string {
+	return entity.Type
+}
+
+// UnknownStickerType is a subtype of StickerType missing in the schema. It keeps @type and raw JSON of the object
+type UnknownStickerType struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownStickerType) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownStickerType) GetClass() string {
+	return ClassStickerType
+}
+
+func (entity *UnknownStickerType) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownStickerType) StickerTypeType() string {
+	return entity.Type
+}
+
+// UnknownStickerFullType is a subtype of StickerFullType missing in the schema. It keeps @type and raw JSON of the object
+type UnknownStickerFullType struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownStickerFullType) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownStickerFullType) GetClass() string {
+	return ClassStickerFullType
+}
+
+func (entity *UnknownStickerFullType) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownStickerFullType) StickerFullTypeType() string {
+	return entity.Type
+}
+
+// UnknownPollType is a subtype of PollType missing in the schema. It keeps @type and raw JSON of the object
+type UnknownPollType struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownPollType) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownPollType) GetClass() string {
+	return ClassPollType
+}
+
+func (entity *UnknownPollType) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownPollType) PollTypeType() string {
+	return entity.Type
+}
+
+// UnknownUserType is a subtype of UserType missing in the schema. It keeps @type and raw JSON of the object
+type UnknownUserType struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownUserType) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownUserType) GetClass() string {
+	return ClassUserType
+}
+
+func (entity *UnknownUserType) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownUserType) UserTypeType() string {
+	return entity.Type
+}
+
+// UnknownAccessHashType is a subtype of AccessHashType missing in the schema. It keeps @type and raw JSON of the object
+type UnknownAccessHashType struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownAccessHashType) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownAccessHashType) GetClass() string {
+	return ClassAccessHashType
+}
+
+func (entity *UnknownAccessHashType) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownAccessHashType) AccessHashTypeType() string {
+	return entity.Type
+}
+
+// UnknownChatPhotoStickerType is a subtype of ChatPhotoStickerType missing in the schema. It keeps @type and raw JSON of the object
+type UnknownChatPhotoStickerType struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownChatPhotoStickerType) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownChatPhotoStickerType) GetClass() string {
+	return ClassChatPhotoStickerType
+}
+
+func (entity *UnknownChatPhotoStickerType) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownChatPhotoStickerType) ChatPhotoStickerTypeType() string {
+	return entity.Type
+}
+
+// UnknownInputChatPhoto is a subtype of InputChatPhoto missing in the schema. It keeps @type and raw JSON of the object
+type UnknownInputChatPhoto struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownInputChatPhoto) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownInputChatPhoto) GetClass() string {
+	return ClassInputChatPhoto
+}
+
+func (entity *UnknownInputChatPhoto) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownInputChatPhoto) InputChatPhotoType() string {
+	return entity.Type
+}
+
+// UnknownChatMemberStatus is a subtype of ChatMemberStatus missing in the schema. It keeps @type and raw JSON of the object
+type UnknownChatMemberStatus struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownChatMemberStatus) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownChatMemberStatus) GetClass() string {
+	return ClassChatMemberStatus
+}
+
+func (entity *UnknownChatMemberStatus) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownChatMemberStatus) ChatMemberStatusType() string {
+	return entity.Type
+}
+
+// UnknownChatMembersFilter is a subtype of ChatMembersFilter missing in the schema. It keeps @type and raw JSON of the object
+type UnknownChatMembersFilter struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownChatMembersFilter) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownChatMembersFilter) GetClass() string {
+	return ClassChatMembersFilter
+}
+
+func (entity *UnknownChatMembersFilter) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownChatMembersFilter) ChatMembersFilterType() string {
+	return entity.Type
+}
+
+// UnknownSupergroupMembersFilter is a subtype of SupergroupMembersFilter missing in the schema. It keeps @type and raw JSON of the object
+type UnknownSupergroupMembersFilter struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownSupergroupMembersFilter) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownSupergroupMembersFilter) GetClass() string {
+	return ClassSupergroupMembersFilter
+}
+
+func (entity *UnknownSupergroupMembersFilter) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownSupergroupMembersFilter) SupergroupMembersFilterType() string {
+	return entity.Type
+}
+
+// UnknownSecretChatState is a subtype of SecretChatState missing in the schema. It keeps @type and raw JSON of the object
+type UnknownSecretChatState struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownSecretChatState) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownSecretChatState) GetClass() string {
+	return ClassSecretChatState
+}
+
+func (entity *UnknownSecretChatState) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownSecretChatState) SecretChatStateType() string {
+	return entity.Type
+}
+
+// UnknownMessageSender is a subtype of MessageSender missing in the schema. It keeps @type and raw JSON of the object
+type UnknownMessageSender struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownMessageSender) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownMessageSender) GetClass() string {
+	return ClassMessageSender
+}
+
+func (entity *UnknownMessageSender) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownMessageSender) MessageSenderType() string {
+	return entity.Type
+}
+
+// UnknownMessageForwardOrigin is a subtype of MessageForwardOrigin missing in the schema. It keeps @type and raw JSON of the object
+type UnknownMessageForwardOrigin struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownMessageForwardOrigin) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownMessageForwardOrigin) GetClass() string {
+	return ClassMessageForwardOrigin
+}
+
+func (entity *UnknownMessageForwardOrigin) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownMessageForwardOrigin) MessageForwardOriginType() string {
+	return entity.Type
+}
+
+// UnknownReactionType is a subtype of ReactionType missing in the schema. It keeps @type and raw JSON of the object
+type UnknownReactionType struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownReactionType) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownReactionType) GetClass() string {
+	return ClassReactionType
+}
+
+func (entity *UnknownReactionType) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownReactionType) ReactionTypeType() string {
+	return entity.Type
+}
+
+// UnknownMessageSendingState is a subtype of MessageSendingState missing in the schema. It keeps @type and raw JSON of the object
+type UnknownMessageSendingState struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownMessageSendingState) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownMessageSendingState) GetClass() string {
+	return ClassMessageSendingState
+}
+
+func (entity *UnknownMessageSendingState) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownMessageSendingState) MessageSendingStateType() string {
+	return entity.Type
+}
+
+// UnknownMessageSource is a subtype of MessageSource missing in the schema. It keeps @type and raw JSON of the object
+type UnknownMessageSource struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownMessageSource) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownMessageSource) GetClass() string {
+	return ClassMessageSource
+}
+
+func (entity *UnknownMessageSource) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownMessageSource) MessageSourceType() string {
+	return entity.Type
+}
+
+// UnknownNotificationSettingsScope is a subtype of NotificationSettingsScope missing in the schema. It keeps @type and raw JSON of the object
+type UnknownNotificationSettingsScope struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownNotificationSettingsScope) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownNotificationSettingsScope) GetClass() string {
+	return ClassNotificationSettingsScope
+}
+
+func (entity *UnknownNotificationSettingsScope) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownNotificationSettingsScope) NotificationSettingsScopeType() string {
+	return entity.Type
+}
+
+// UnknownChatType is a subtype of ChatType missing in the schema. It keeps @type and raw JSON of the object
+type UnknownChatType struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownChatType) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownChatType) GetClass() string {
+	return ClassChatType
+}
+
+func (entity *UnknownChatType) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownChatType) ChatTypeType() string {
+	return entity.Type
+}
+
+// UnknownChatList is a subtype of ChatList missing in the schema. It keeps @type and raw JSON of the object
+type UnknownChatList struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownChatList) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownChatList) GetClass() string {
+	return ClassChatList
+}
+
+func (entity *UnknownChatList) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownChatList) ChatListType() string {
+	return entity.Type
+}
+
+// UnknownChatSource is a subtype of ChatSource missing in the schema. It keeps @type and raw JSON of the object
+type UnknownChatSource struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownChatSource) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownChatSource) GetClass() string {
+	return ClassChatSource
+}
+
+func (entity *UnknownChatSource) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownChatSource) ChatSourceType() string {
+	return entity.Type
+}
+
+// UnknownChatAvailableReactions is a subtype of ChatAvailableReactions missing in the schema. It keeps @type and raw JSON of the object
+type UnknownChatAvailableReactions struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownChatAvailableReactions) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownChatAvailableReactions) GetClass() string {
+	return ClassChatAvailableReactions
+}
+
+func (entity *UnknownChatAvailableReactions) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownChatAvailableReactions) ChatAvailableReactionsType() string {
+	return entity.Type
+}
+
+// UnknownPublicChatType is a subtype of PublicChatType missing in the schema. It keeps @type and raw JSON of the object
+type UnknownPublicChatType struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownPublicChatType) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownPublicChatType) GetClass() string {
+	return ClassPublicChatType
+}
+
+func (entity *UnknownPublicChatType) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownPublicChatType) PublicChatTypeType() string {
+	return entity.Type
+}
+
+// UnknownChatActionBar is a subtype of ChatActionBar missing in the schema. It keeps @type and raw JSON of the object
+type UnknownChatActionBar struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownChatActionBar) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownChatActionBar) GetClass() string {
+	return ClassChatActionBar
+}
+
+func (entity *UnknownChatActionBar) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownChatActionBar) ChatActionBarType() string {
+	return entity.Type
+}
+
+// UnknownKeyboardButtonType is a subtype of KeyboardButtonType missing in the schema. It keeps @type and raw JSON of the object
+type UnknownKeyboardButtonType struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownKeyboardButtonType) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownKeyboardButtonType) GetClass() string {
+	return ClassKeyboardButtonType
+}
+
+func (entity *UnknownKeyboardButtonType) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownKeyboardButtonType) KeyboardButtonTypeType() string {
+	return entity.Type
+}
+
+// UnknownInlineKeyboardButtonType is a subtype of InlineKeyboardButtonType missing in the schema. It keeps @type and raw JSON of the object
+type UnknownInlineKeyboardButtonType struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownInlineKeyboardButtonType) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownInlineKeyboardButtonType) GetClass() string {
+	return ClassInlineKeyboardButtonType
+}
+
+func (entity *UnknownInlineKeyboardButtonType) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownInlineKeyboardButtonType) InlineKeyboardButtonTypeType() string {
+	return entity.Type
+}
+
+// UnknownReplyMarkup is a subtype of ReplyMarkup missing in the schema. It keeps @type and raw JSON of the object
+type UnknownReplyMarkup struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownReplyMarkup) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownReplyMarkup) GetClass() string {
+	return ClassReplyMarkup
+}
+
+func (entity *UnknownReplyMarkup) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownReplyMarkup) ReplyMarkupType() string {
+	return entity.Type
+}
+
+// UnknownLoginUrlInfo is a subtype of LoginUrlInfo missing in the schema. It keeps @type and raw JSON of the object
+type UnknownLoginUrlInfo struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownLoginUrlInfo) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownLoginUrlInfo) GetClass() string {
+	return ClassLoginUrlInfo
+}
+
+func (entity *UnknownLoginUrlInfo) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownLoginUrlInfo) LoginUrlInfoType() string {
+	return entity.Type
+}
+
+// UnknownRichText is a subtype of RichText missing in the schema. It keeps @type and raw JSON of the object
+type UnknownRichText struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownRichText) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownRichText) GetClass() string {
+	return ClassRichText
+}
+
+func (entity *UnknownRichText) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownRichText) RichTextType() string {
+	return entity.Type
+}
+
+// UnknownPageBlockHorizontalAlignment is a subtype of PageBlockHorizontalAlignment missing in the schema. It keeps @type and raw JSON of the object
+type UnknownPageBlockHorizontalAlignment struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownPageBlockHorizontalAlignment) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownPageBlockHorizontalAlignment) GetClass() string {
+	return ClassPageBlockHorizontalAlignment
+}
+
+func (entity *UnknownPageBlockHorizontalAlignment) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownPageBlockHorizontalAlignment) PageBlockHorizontalAlignmentType() string {
+	return entity.Type
+}
+
+// UnknownPageBlockVerticalAlignment is a subtype of PageBlockVerticalAlignment missing in the schema. It keeps @type and raw JSON of the object
+type UnknownPageBlockVerticalAlignment struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownPageBlockVerticalAlignment) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownPageBlockVerticalAlignment) GetClass() string {
+	return ClassPageBlockVerticalAlignment
+}
+
+func (entity *UnknownPageBlockVerticalAlignment) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownPageBlockVerticalAlignment) PageBlockVerticalAlignmentType() string {
+	return entity.Type
+}
+
+// UnknownPageBlock is a subtype of PageBlock missing in the schema. It keeps @type and raw JSON of the object
+type UnknownPageBlock struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownPageBlock) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownPageBlock) GetClass() string {
+	return ClassPageBlock
+}
+
+func (entity *UnknownPageBlock) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownPageBlock) PageBlockType() string {
+	return entity.Type
+}
+
+// UnknownInputCredentials is a subtype of InputCredentials missing in the schema. It keeps @type and raw JSON of the object
+type UnknownInputCredentials struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownInputCredentials) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownInputCredentials) GetClass() string {
+	return ClassInputCredentials
+}
+
+func (entity *UnknownInputCredentials) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownInputCredentials) InputCredentialsType() string {
+	return entity.Type
+}
+
+// UnknownPaymentProvider is a subtype of PaymentProvider missing in the schema. It keeps @type and raw JSON of the object
+type UnknownPaymentProvider struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownPaymentProvider) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownPaymentProvider) GetClass() string {
+	return ClassPaymentProvider
+}
+
+func (entity *UnknownPaymentProvider) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownPaymentProvider) PaymentProviderType() string {
+	return entity.Type
+}
+
+// UnknownInputInvoice is a subtype of InputInvoice missing in the schema. It keeps @type and raw JSON of the object
+type UnknownInputInvoice struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownInputInvoice) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownInputInvoice) GetClass() string {
+	return ClassInputInvoice
+}
+
+func (entity *UnknownInputInvoice) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownInputInvoice) InputInvoiceType() string {
+	return entity.Type
+}
+
+// UnknownMessageExtendedMedia is a subtype of MessageExtendedMedia missing in the schema. It keeps @type and raw JSON of the object
+type UnknownMessageExtendedMedia struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownMessageExtendedMedia) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownMessageExtendedMedia) GetClass() string {
+	return ClassMessageExtendedMedia
+}
+
+func (entity *UnknownMessageExtendedMedia) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownMessageExtendedMedia) MessageExtendedMediaType() string {
+	return entity.Type
+}
+
+// UnknownPassportElementType is a subtype of PassportElementType missing in the schema. It keeps @type and raw JSON of the object
+type UnknownPassportElementType struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownPassportElementType) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownPassportElementType) GetClass() string {
+	return ClassPassportElementType
+}
+
+func (entity *UnknownPassportElementType) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownPassportElementType) PassportElementTypeType() string {
+	return entity.Type
+}
+
+// UnknownPassportElement is a subtype of PassportElement missing in the schema. It keeps @type and raw JSON of the object
+type UnknownPassportElement struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownPassportElement) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownPassportElement) GetClass() string {
+	return ClassPassportElement
+}
+
+func (entity *UnknownPassportElement) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownPassportElement) PassportElementType() string {
+	return entity.Type
+}
+
+// UnknownInputPassportElement is a subtype of InputPassportElement missing in the schema. It keeps @type and raw JSON of the object
+type UnknownInputPassportElement struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownInputPassportElement) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownInputPassportElement) GetClass() string {
+	return ClassInputPassportElement
+}
+
+func (entity *UnknownInputPassportElement) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownInputPassportElement) InputPassportElementType() string {
+	return entity.Type
+}
+
+// UnknownPassportElementErrorSource is a subtype of PassportElementErrorSource missing in the schema. It keeps @type and raw JSON of the object
+type UnknownPassportElementErrorSource struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownPassportElementErrorSource) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownPassportElementErrorSource) GetClass() string {
+	return ClassPassportElementErrorSource
+}
+
+func (entity *UnknownPassportElementErrorSource) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownPassportElementErrorSource) PassportElementErrorSourceType() string {
+	return entity.Type
+}
+
+// UnknownInputPassportElementErrorSource is a subtype of InputPassportElementErrorSource missing in the schema. It keeps @type and raw JSON of the object
+type UnknownInputPassportElementErrorSource struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownInputPassportElementErrorSource) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownInputPassportElementErrorSource) GetClass() string {
+	return ClassInputPassportElementErrorSource
+}
+
+func (entity *UnknownInputPassportElementErrorSource) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownInputPassportElementErrorSource) InputPassportElementErrorSourceType() string {
+	return entity.Type
+}
+
+// UnknownMessageContent is a subtype of MessageContent missing in the schema. It keeps @type and raw JSON of the object
+type UnknownMessageContent struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownMessageContent) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownMessageContent) GetClass() string {
+	return ClassMessageContent
+}
+
+func (entity *UnknownMessageContent) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownMessageContent) MessageContentType() string {
+	return entity.Type
+}
+
+// UnknownTextEntityType is a subtype of TextEntityType missing in the schema. It keeps @type and raw JSON of the object
+type UnknownTextEntityType struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownTextEntityType) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownTextEntityType) GetClass() string {
+	return ClassTextEntityType
+}
+
+func (entity *UnknownTextEntityType) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownTextEntityType) TextEntityTypeType() string {
+	return entity.Type
+}
+
+// UnknownMessageSchedulingState is a subtype of MessageSchedulingState missing in the schema. It keeps @type and raw JSON of the object
+type UnknownMessageSchedulingState struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownMessageSchedulingState) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownMessageSchedulingState) GetClass() string {
+	return ClassMessageSchedulingState
+}
+
+func (entity *UnknownMessageSchedulingState) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownMessageSchedulingState) MessageSchedulingStateType() string {
+	return entity.Type
+}
+
+// UnknownInputMessageContent is a subtype of InputMessageContent missing in the schema. It keeps @type and raw JSON of the object
+type UnknownInputMessageContent struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownInputMessageContent) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownInputMessageContent) GetClass() string {
+	return ClassInputMessageContent
+}
+
+func (entity *UnknownInputMessageContent) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownInputMessageContent) InputMessageContentType() string {
+	return entity.Type
+}
+
+// UnknownSearchMessagesFilter is a subtype of SearchMessagesFilter missing in the schema. It keeps @type and raw JSON of the object
+type UnknownSearchMessagesFilter struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownSearchMessagesFilter) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownSearchMessagesFilter) GetClass() string {
+	return ClassSearchMessagesFilter
+}
+
+func (entity *UnknownSearchMessagesFilter) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownSearchMessagesFilter) SearchMessagesFilterType() string {
+	return entity.Type
+}
+
+// UnknownChatAction is a subtype of ChatAction missing in the schema. It keeps @type and raw JSON of the object
+type UnknownChatAction struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownChatAction) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownChatAction) GetClass() string {
+	return ClassChatAction
+}
+
+func (entity *UnknownChatAction) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownChatAction) ChatActionType() string {
+	return entity.Type
+}
+
+// UnknownUserStatus is a subtype of UserStatus missing in the schema. It keeps @type and raw JSON of the object
+type UnknownUserStatus struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownUserStatus) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownUserStatus) GetClass() string {
+	return ClassUserStatus
+}
+
+func (entity *UnknownUserStatus) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownUserStatus) UserStatusType() string {
+	return entity.Type
+}
+
+// UnknownEmojiCategoryType is a subtype of EmojiCategoryType missing in the schema. It keeps @type and raw JSON of the object
+type UnknownEmojiCategoryType struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownEmojiCategoryType) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownEmojiCategoryType) GetClass() string {
+	return ClassEmojiCategoryType
+}
+
+func (entity *UnknownEmojiCategoryType) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownEmojiCategoryType) EmojiCategoryTypeType() string {
+	return entity.Type
+}
+
+// UnknownCallDiscardReason is a subtype of CallDiscardReason missing in the schema. It keeps @type and raw JSON of the object
+type UnknownCallDiscardReason struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownCallDiscardReason) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownCallDiscardReason) GetClass() string {
+	return ClassCallDiscardReason
+}
+
+func (entity *UnknownCallDiscardReason) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownCallDiscardReason) CallDiscardReasonType() string {
+	return entity.Type
+}
+
+// UnknownCallServerType is a subtype of CallServerType missing in the schema. It keeps @type and raw JSON of the object
+type UnknownCallServerType struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownCallServerType) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownCallServerType) GetClass() string {
+	return ClassCallServerType
+}
+
+func (entity *UnknownCallServerType) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownCallServerType) CallServerTypeType() string {
+	return entity.Type
+}
+
+// UnknownCallState is a subtype of CallState missing in the schema. It keeps @type and raw JSON of the object
+type UnknownCallState struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownCallState) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownCallState) GetClass() string {
+	return ClassCallState
+}
+
+func (entity *UnknownCallState) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownCallState) CallStateType() string {
+	return entity.Type
+}
+
+// UnknownGroupCallVideoQuality is a subtype of GroupCallVideoQuality missing in the schema. It keeps @type and raw JSON of the object
+type UnknownGroupCallVideoQuality struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownGroupCallVideoQuality) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownGroupCallVideoQuality) GetClass() string {
+	return ClassGroupCallVideoQuality
+}
+
+func (entity *UnknownGroupCallVideoQuality) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownGroupCallVideoQuality) GroupCallVideoQualityType() string {
+	return entity.Type
+}
+
+// UnknownCallProblem is a subtype of CallProblem missing in the schema. It keeps @type and raw JSON of the object
+type UnknownCallProblem struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownCallProblem) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownCallProblem) GetClass() string {
+	return ClassCallProblem
+}
+
+func (entity *UnknownCallProblem) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownCallProblem) CallProblemType() string {
+	return entity.Type
+}
+
+// UnknownFirebaseAuthenticationSettings is a subtype of FirebaseAuthenticationSettings missing in the schema. It keeps @type and raw JSON of the object
+type UnknownFirebaseAuthenticationSettings struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownFirebaseAuthenticationSettings) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownFirebaseAuthenticationSettings) GetClass() string {
+	return ClassFirebaseAuthenticationSettings
+}
+
+func (entity *UnknownFirebaseAuthenticationSettings) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownFirebaseAuthenticationSettings) FirebaseAuthenticationSettingsType() string {
+	return entity.Type
+}
+
+// UnknownDiceStickers is a subtype of DiceStickers missing in the schema. It keeps @type and raw JSON of the object
+type UnknownDiceStickers struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownDiceStickers) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownDiceStickers) GetClass() string {
+	return ClassDiceStickers
+}
+
+func (entity *UnknownDiceStickers) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownDiceStickers) DiceStickersType() string {
+	return entity.Type
+}
+
+// UnknownSpeechRecognitionResult is a subtype of SpeechRecognitionResult missing in the schema. It keeps @type and raw JSON of the object
+type UnknownSpeechRecognitionResult struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownSpeechRecognitionResult) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownSpeechRecognitionResult) GetClass() string {
+	return ClassSpeechRecognitionResult
+}
+
+func (entity *UnknownSpeechRecognitionResult) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownSpeechRecognitionResult) SpeechRecognitionResultType() string {
+	return entity.Type
+}
+
+// UnknownInputInlineQueryResult is a subtype of InputInlineQueryResult missing in the schema. It keeps @type and raw JSON of the object
+type UnknownInputInlineQueryResult struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownInputInlineQueryResult) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownInputInlineQueryResult) GetClass() string {
+	return ClassInputInlineQueryResult
+}
+
+func (entity *UnknownInputInlineQueryResult) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownInputInlineQueryResult) InputInlineQueryResultType() string {
+	return entity.Type
+}
+
+// UnknownInlineQueryResult is a subtype of InlineQueryResult missing in the schema. It keeps @type and raw JSON of the object
+type UnknownInlineQueryResult struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownInlineQueryResult) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownInlineQueryResult) GetClass() string {
+	return ClassInlineQueryResult
+}
+
+func (entity *UnknownInlineQueryResult) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownInlineQueryResult) InlineQueryResultType() string {
+	return entity.Type
+}
+
+// UnknownInlineQueryResultsButtonType is a subtype of InlineQueryResultsButtonType missing in the schema. It keeps @type and raw JSON of the object
+type UnknownInlineQueryResultsButtonType struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownInlineQueryResultsButtonType) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownInlineQueryResultsButtonType) GetClass() string {
+	return ClassInlineQueryResultsButtonType
+}
+
+func (entity *UnknownInlineQueryResultsButtonType) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownInlineQueryResultsButtonType) InlineQueryResultsButtonTypeType() string {
+	return entity.Type
+}
+
+// UnknownCallbackQueryPayload is a subtype of CallbackQueryPayload missing in the schema. It keeps @type and raw JSON of the object
+type UnknownCallbackQueryPayload struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownCallbackQueryPayload) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownCallbackQueryPayload) GetClass() string {
+	return ClassCallbackQueryPayload
+}
+
+func (entity *UnknownCallbackQueryPayload) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownCallbackQueryPayload) CallbackQueryPayloadType() string {
+	return entity.Type
+}
+
+// UnknownChatEventAction is a subtype of ChatEventAction missing in the schema. It keeps @type and raw JSON of the object
+type UnknownChatEventAction struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownChatEventAction) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownChatEventAction) GetClass() string {
+	return ClassChatEventAction
+}
+
+func (entity *UnknownChatEventAction) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownChatEventAction) ChatEventActionType() string {
+	return entity.Type
+}
+
+// UnknownLanguagePackStringValue is a subtype of LanguagePackStringValue missing in the schema. It keeps @type and raw JSON of the object
+type UnknownLanguagePackStringValue struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownLanguagePackStringValue) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownLanguagePackStringValue) GetClass() string {
+	return ClassLanguagePackStringValue
+}
+
+func (entity *UnknownLanguagePackStringValue) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownLanguagePackStringValue) LanguagePackStringValueType() string {
+	return entity.Type
+}
+
+// UnknownPremiumLimitType is a subtype of PremiumLimitType missing in the schema. It keeps @type and raw JSON of the object
+type UnknownPremiumLimitType struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownPremiumLimitType) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownPremiumLimitType) GetClass() string {
+	return ClassPremiumLimitType
+}
+
+func (entity *UnknownPremiumLimitType) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownPremiumLimitType) PremiumLimitTypeType() string {
+	return entity.Type
+}
+
+// UnknownPremiumFeature is a subtype of PremiumFeature missing in the schema. It keeps @type and raw JSON of the object
+type UnknownPremiumFeature struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownPremiumFeature) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownPremiumFeature) GetClass() string {
+	return ClassPremiumFeature
+}
+
+func (entity *UnknownPremiumFeature) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownPremiumFeature) PremiumFeatureType() string {
+	return entity.Type
+}
+
+// UnknownPremiumSource is a subtype of PremiumSource missing in the schema. It keeps @type and raw JSON of the object
+type UnknownPremiumSource struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownPremiumSource) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownPremiumSource) GetClass() string {
+	return ClassPremiumSource
+}
+
+func (entity *UnknownPremiumSource) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownPremiumSource) PremiumSourceType() string {
+	return entity.Type
+}
+
+// UnknownStorePaymentPurpose is a subtype of StorePaymentPurpose missing in the schema. It keeps @type and raw JSON of the object
+type UnknownStorePaymentPurpose struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownStorePaymentPurpose) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownStorePaymentPurpose) GetClass() string {
+	return ClassStorePaymentPurpose
+}
+
+func (entity *UnknownStorePaymentPurpose) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownStorePaymentPurpose) StorePaymentPurposeType() string {
+	return entity.Type
+}
+
+// UnknownDeviceToken is a subtype of DeviceToken missing in the schema. It keeps @type and raw JSON of the object
+type UnknownDeviceToken struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownDeviceToken) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownDeviceToken) GetClass() string {
+	return ClassDeviceToken
+}
+
+func (entity *UnknownDeviceToken) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownDeviceToken) DeviceTokenType() string {
+	return entity.Type
+}
+
+// UnknownBackgroundFill is a subtype of BackgroundFill missing in the schema. It keeps @type and raw JSON of the object
+type UnknownBackgroundFill struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownBackgroundFill) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownBackgroundFill) GetClass() string {
+	return ClassBackgroundFill
+}
+
+func (entity *UnknownBackgroundFill) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownBackgroundFill) BackgroundFillType() string {
+	return entity.Type
+}
+
+// UnknownBackgroundType is a subtype of BackgroundType missing in the schema. It keeps @type and raw JSON of the object
+type UnknownBackgroundType struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownBackgroundType) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownBackgroundType) GetClass() string {
+	return ClassBackgroundType
+}
+
+func (entity *UnknownBackgroundType) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownBackgroundType) BackgroundTypeType() string {
+	return entity.Type
+}
+
+// UnknownInputBackground is a subtype of InputBackground missing in the schema. It keeps @type and raw JSON of the object
+type UnknownInputBackground struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownInputBackground) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownInputBackground) GetClass() string {
+	return ClassInputBackground
+}
+
+func (entity *UnknownInputBackground) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownInputBackground) InputBackgroundType() string {
+	return entity.Type
+}
+
+// UnknownCanTransferOwnershipResult is a subtype of CanTransferOwnershipResult missing in the schema. It keeps @type and raw JSON of the object
+type UnknownCanTransferOwnershipResult struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownCanTransferOwnershipResult) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownCanTransferOwnershipResult) GetClass() string {
+	return ClassCanTransferOwnershipResult
+}
+
+func (entity *UnknownCanTransferOwnershipResult) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownCanTransferOwnershipResult) CanTransferOwnershipResultType() string {
+	return entity.Type
+}
+
+// UnknownCheckChatUsernameResult is a subtype of CheckChatUsernameResult missing in the schema. It keeps @type and raw JSON of the object
+type UnknownCheckChatUsernameResult struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownCheckChatUsernameResult) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownCheckChatUsernameResult) GetClass() string {
+	return ClassCheckChatUsernameResult
+}
+
+func (entity *UnknownCheckChatUsernameResult) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownCheckChatUsernameResult) CheckChatUsernameResultType() string {
+	return entity.Type
+}
+
+// UnknownCheckStickerSetNameResult is a subtype of CheckStickerSetNameResult missing in the schema. It keeps @type and raw JSON of the object
+type UnknownCheckStickerSetNameResult struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownCheckStickerSetNameResult) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownCheckStickerSetNameResult) GetClass() string {
+	return ClassCheckStickerSetNameResult
+}
+
+func (entity *UnknownCheckStickerSetNameResult) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownCheckStickerSetNameResult) CheckStickerSetNameResultType() string {
+	return entity.Type
+}
+
+// UnknownResetPasswordResult is a subtype of ResetPasswordResult missing in the schema. It keeps @type and raw JSON of the object
+type UnknownResetPasswordResult struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownResetPasswordResult) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownResetPasswordResult) GetClass() string {
+	return ClassResetPasswordResult
+}
+
+func (entity *UnknownResetPasswordResult) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownResetPasswordResult) ResetPasswordResultType() string {
+	return entity.Type
+}
+
+// UnknownMessageFileType is a subtype of MessageFileType missing in the schema. It keeps @type and raw JSON of the object
+type UnknownMessageFileType struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownMessageFileType) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownMessageFileType) GetClass() string {
+	return ClassMessageFileType
+}
+
+func (entity *UnknownMessageFileType) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownMessageFileType) MessageFileTypeType() string {
+	return entity.Type
+}
+
+// UnknownPushMessageContent is a subtype of PushMessageContent missing in the schema. It keeps @type and raw JSON of the object
+type UnknownPushMessageContent struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownPushMessageContent) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownPushMessageContent) GetClass() string {
+	return ClassPushMessageContent
+}
+
+func (entity *UnknownPushMessageContent) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownPushMessageContent) PushMessageContentType() string {
+	return entity.Type
+}
+
+// UnknownNotificationType is a subtype of NotificationType missing in the schema. It keeps @type and raw JSON of the object
+type UnknownNotificationType struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownNotificationType) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownNotificationType) GetClass() string {
+	return ClassNotificationType
+}
+
+func (entity *UnknownNotificationType) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownNotificationType) NotificationTypeType() string {
+	return entity.Type
+}
+
+// UnknownNotificationGroupType is a subtype of NotificationGroupType missing in the schema. It keeps @type and raw JSON of the object
+type UnknownNotificationGroupType struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownNotificationGroupType) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownNotificationGroupType) GetClass() string {
+	return ClassNotificationGroupType
+}
+
+func (entity *UnknownNotificationGroupType) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownNotificationGroupType) NotificationGroupTypeType() string {
+	return entity.Type
+}
+
+// UnknownOptionValue is a subtype of OptionValue missing in the schema. It keeps @type and raw JSON of the object
+type UnknownOptionValue struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownOptionValue) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownOptionValue) GetClass() string {
+	return ClassOptionValue
+}
+
+func (entity *UnknownOptionValue) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownOptionValue) OptionValueType() string {
+	return entity.Type
+}
+
+// UnknownJsonValue is a subtype of JsonValue missing in the schema. It keeps @type and raw JSON of the object
+type UnknownJsonValue struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownJsonValue) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownJsonValue) GetClass() string {
+	return ClassJsonValue
+}
+
+func (entity *UnknownJsonValue) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownJsonValue) JsonValueType() string {
+	return entity.Type
+}
+
+// UnknownUserPrivacySettingRule is a subtype of UserPrivacySettingRule missing in the schema. It keeps @type and raw JSON of the object
+type UnknownUserPrivacySettingRule struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownUserPrivacySettingRule) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownUserPrivacySettingRule) GetClass() string {
+	return ClassUserPrivacySettingRule
+}
+
+func (entity *UnknownUserPrivacySettingRule) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownUserPrivacySettingRule) UserPrivacySettingRuleType() string {
+	return entity.Type
+}
+
+// UnknownUserPrivacySetting is a subtype of UserPrivacySetting missing in the schema. It keeps @type and raw JSON of the object
+type UnknownUserPrivacySetting struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownUserPrivacySetting) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownUserPrivacySetting) GetClass() string {
+	return ClassUserPrivacySetting
+}
+
+func (entity *UnknownUserPrivacySetting) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownUserPrivacySetting) UserPrivacySettingType() string {
+	return entity.Type
+}
+
+// UnknownSessionType is a subtype of SessionType missing in the schema. It keeps @type and raw JSON of the object
+type UnknownSessionType struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownSessionType) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownSessionType) GetClass() string {
+	return ClassSessionType
+}
+
+func (entity *UnknownSessionType) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownSessionType) SessionTypeType() string {
+	return entity.Type
+}
+
+// UnknownChatReportReason is a subtype of ChatReportReason missing in the schema. It keeps @type and raw JSON of the object
+type UnknownChatReportReason struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownChatReportReason) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownChatReportReason) GetClass() string {
+	return ClassChatReportReason
+}
+
+func (entity *UnknownChatReportReason) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownChatReportReason) ChatReportReasonType() string {
+	return entity.Type
+}
+
+// UnknownTargetChat is a subtype of TargetChat missing in the schema. It keeps @type and raw JSON of the object
+type UnknownTargetChat struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownTargetChat) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownTargetChat) GetClass() string {
+	return ClassTargetChat
+}
+
+func (entity *UnknownTargetChat) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownTargetChat) TargetChatType() string {
+	return entity.Type
+}
+
+// UnknownInternalLinkType is a subtype of InternalLinkType missing in the schema. It keeps @type and raw JSON of the object
+type UnknownInternalLinkType struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownInternalLinkType) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownInternalLinkType) GetClass() string {
+	return ClassInternalLinkType
+}
+
+func (entity *UnknownInternalLinkType) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownInternalLinkType) InternalLinkTypeType() string {
+	return entity.Type
+}
+
+// UnknownFileType is a subtype of FileType missing in the schema. It keeps @type and raw JSON of the object
+type UnknownFileType struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownFileType) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownFileType) GetClass() string {
+	return ClassFileType
+}
+
+func (entity *UnknownFileType) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownFileType) FileTypeType() string {
+	return entity.Type
+}
+
+// UnknownNetworkType is a subtype of NetworkType missing in the schema. It keeps @type and raw JSON of the object
+type UnknownNetworkType struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownNetworkType) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownNetworkType) GetClass() string {
+	return ClassNetworkType
+}
+
+func (entity *UnknownNetworkType) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownNetworkType) NetworkTypeType() string {
+	return entity.Type
+}
+
+// UnknownNetworkStatisticsEntry is a subtype of NetworkStatisticsEntry missing in the schema. It keeps @type and raw JSON of the object
+type UnknownNetworkStatisticsEntry struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownNetworkStatisticsEntry) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownNetworkStatisticsEntry) GetClass() string {
+	return ClassNetworkStatisticsEntry
+}
+
+func (entity *UnknownNetworkStatisticsEntry) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownNetworkStatisticsEntry) NetworkStatisticsEntryType() string {
+	return entity.Type
+}
+
+// UnknownAutosaveSettingsScope is a subtype of AutosaveSettingsScope missing in the schema. It keeps @type and raw JSON of the object
+type UnknownAutosaveSettingsScope struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownAutosaveSettingsScope) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownAutosaveSettingsScope) GetClass() string {
+	return ClassAutosaveSettingsScope
+}
+
+func (entity *UnknownAutosaveSettingsScope) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownAutosaveSettingsScope) AutosaveSettingsScopeType() string {
+	return entity.Type
+}
+
+// UnknownConnectionState is a subtype of ConnectionState missing in the schema. It keeps @type and raw JSON of the object
+type UnknownConnectionState struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownConnectionState) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownConnectionState) GetClass() string {
+	return ClassConnectionState
+}
+
+func (entity *UnknownConnectionState) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownConnectionState) ConnectionStateType() string {
+	return entity.Type
+}
+
+// UnknownTopChatCategory is a subtype of TopChatCategory missing in the schema. It keeps @type and raw JSON of the object
+type UnknownTopChatCategory struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownTopChatCategory) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownTopChatCategory) GetClass() string {
+	return ClassTopChatCategory
+}
+
+func (entity *UnknownTopChatCategory) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownTopChatCategory) TopChatCategoryType() string {
+	return entity.Type
+}
+
+// UnknownTMeUrlType is a subtype of TMeUrlType missing in the schema. It keeps @type and raw JSON of the object
+type UnknownTMeUrlType struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownTMeUrlType) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownTMeUrlType) GetClass() string {
+	return ClassTMeUrlType
+}
+
+func (entity *UnknownTMeUrlType) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownTMeUrlType) TMeUrlTypeType() string {
+	return entity.Type
+}
+
+// UnknownSuggestedAction is a subtype of SuggestedAction missing in the schema. It keeps @type and raw JSON of the object
+type UnknownSuggestedAction struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownSuggestedAction) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownSuggestedAction) GetClass() string {
+	return ClassSuggestedAction
+}
+
+func (entity *UnknownSuggestedAction) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownSuggestedAction) SuggestedActionType() string {
+	return entity.Type
+}
+
+// UnknownTextParseMode is a subtype of TextParseMode missing in the schema. It keeps @type and raw JSON of the object
+type UnknownTextParseMode struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownTextParseMode) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownTextParseMode) GetClass() string {
+	return ClassTextParseMode
+}
+
+func (entity *UnknownTextParseMode) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownTextParseMode) TextParseModeType() string {
+	return entity.Type
+}
+
+// UnknownProxyType is a subtype of ProxyType missing in the schema. It keeps @type and raw JSON of the object
+type UnknownProxyType struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownProxyType) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownProxyType) GetClass() string {
+	return ClassProxyType
+}
+
+func (entity *UnknownProxyType) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownProxyType) ProxyTypeType() string {
+	return entity.Type
+}
+
+// UnknownStatisticalGraph is a subtype of StatisticalGraph missing in the schema. It keeps @type and raw JSON of the object
+type UnknownStatisticalGraph struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownStatisticalGraph) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownStatisticalGraph) GetClass() string {
+	return ClassStatisticalGraph
+}
+
+func (entity *UnknownStatisticalGraph) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownStatisticalGraph) StatisticalGraphType() string {
+	return entity.Type
+}
+
+// UnknownChatStatistics is a subtype of ChatStatistics missing in the schema. It keeps @type and raw JSON of the object
+type UnknownChatStatistics struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownChatStatistics) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownChatStatistics) GetClass() string {
+	return ClassChatStatistics
+}
+
+func (entity *UnknownChatStatistics) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownChatStatistics) ChatStatisticsType() string {
+	return entity.Type
+}
+
+// UnknownVectorPathCommand is a subtype of VectorPathCommand missing in the schema. It keeps @type and raw JSON of the object
+type UnknownVectorPathCommand struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownVectorPathCommand) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownVectorPathCommand) GetClass() string {
+	return ClassVectorPathCommand
+}
+
+func (entity *UnknownVectorPathCommand) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownVectorPathCommand) VectorPathCommandType() string {
+	return entity.Type
+}
+
+// UnknownBotCommandScope is a subtype of BotCommandScope missing in the schema. It keeps @type and raw JSON of the object
+type UnknownBotCommandScope struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownBotCommandScope) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownBotCommandScope) GetClass() string {
+	return ClassBotCommandScope
+}
+
+func (entity *UnknownBotCommandScope) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownBotCommandScope) BotCommandScopeType() string {
+	return entity.Type
+}
+
+// UnknownUpdate is a subtype of Update missing in the schema. It keeps @type and raw JSON of the object
+type UnknownUpdate struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownUpdate) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownUpdate) GetClass() string {
+	return ClassUpdate
+}
+
+func (entity *UnknownUpdate) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownUpdate) UpdateType() string {
+	return entity.Type
+}
+
+// UnknownLogStream is a subtype of LogStream missing in the schema. It keeps @type and raw JSON of the object
+type UnknownLogStream struct {
+	meta
+	Raw json.RawMessage `json:"-"`
+}
+
+func (entity *UnknownLogStream) MarshalJSON() ([]byte, error) {
+	if entity.Raw != nil {
+		return entity.Raw, nil
+	}
+
+	return json.Marshal(entity.meta)
+}
+
+func (*UnknownLogStream) GetClass() string {
+	return ClassLogStream
+}
+
+func (entity *UnknownLogStream) GetType() string {
+	return entity.Type
+}
+
+func (entity *UnknownLogStream) LogStreamType() string {
+	return entity.Type
+}
+
 // An object of this type can be returned on every function call, in case of an error
 type Error struct {
 	meta
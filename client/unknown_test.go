@@ -0,0 +1,121 @@
+package client_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/megaplan/go-tdlib/client"
+	"github.com/megaplan/go-tdlib/client/tdtest"
+)
+
+// rawType is an object of the type missing in the schema
+type rawType string
+
+func (rawType) GetType() string {
+	return "updateNewFeature"
+}
+
+func (rawType) GetClass() string {
+	return client.ClassUpdate
+}
+
+func (raw rawType) MarshalJSON() ([]byte, error) {
+	return []byte(raw), nil
+}
+
+func TestUnknownUpdate(t *testing.T) {
+	server := tdtest.NewServer()
+	tdlibClient := newTestClient(t, server)
+
+	listener := tdlibClient.GetListener(client.WithFilter(client.TypeFilter("updateNewFeature")))
+	defer listener.Close()
+
+	err := server.SendUpdate(server.ClientIds()[0], rawType(`{"@type":"updateNewFeature","feature_id":7}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the response is received after the update
+	_, err = tdlibClient.GetAuthorizationState()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(listener.Updates) != 1 {
+		t.Fatalf("expected 1 update, got %d", len(listener.Updates))
+	}
+
+	update, ok := (<-listener.Updates).(*client.UnknownUpdate)
+	if !ok {
+		t.Fatal("the update isn't UnknownUpdate")
+	}
+
+	if update.GetType() != "updateNewFeature" || update.GetClass() != client.ClassUpdate {
+		t.Fatalf("unexpected type %s of class %s", update.GetType(), update.GetClass())
+	}
+
+	var feature struct {
+		FeatureId int `json:"feature_id"`
+	}
+
+	err = json.Unmarshal(update.Raw, &feature)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feature.FeatureId != 7 {
+		t.Fatalf("the raw JSON isn't kept: %s", update.Raw)
+	}
+}
+
+func TestUnknownNestedType(t *testing.T) {
+	data := json.RawMessage(`{"@type":"message","id":5,"content":{"@type":"messageNewFeature","text":"text"}}`)
+
+	message, err := client.UnmarshalMessage(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if message.Id != 5 {
+		t.Fatalf("unexpected message %d", message.Id)
+	}
+
+	content, ok := message.Content.(*client.UnknownMessageContent)
+	if !ok {
+		t.Fatalf("unexpected content %T", message.Content)
+	}
+
+	if content.MessageContentType() != "messageNewFeature" || content.GetClass() != client.ClassMessageContent {
+		t.Fatalf("unexpected type %s of class %s", content.MessageContentType(), content.GetClass())
+	}
+
+	// the object is marshaled back as it is received
+	marshaled, err := json.Marshal(message.Content)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(marshaled) != `{"@type":"messageNewFeature","text":"text"}` {
+		t.Fatalf("unexpected JSON %s", marshaled)
+	}
+}
+
+func TestUnknownType(t *testing.T) {
+	typ, err := client.UnmarshalType(json.RawMessage(`{"@type":"newFeature","enabled":true}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	unknown, ok := typ.(*client.UnknownType)
+	if !ok {
+		t.Fatalf("unexpected type %T", typ)
+	}
+
+	if unknown.GetType() != "newFeature" || unknown.GetClass() != "" {
+		t.Fatalf("unexpected type %s of class %q", unknown.GetType(), unknown.GetClass())
+	}
+
+	if string(unknown.Raw) != `{"@type":"newFeature","enabled":true}` {
+		t.Fatalf("the raw JSON isn't kept: %s", unknown.Raw)
+	}
+}
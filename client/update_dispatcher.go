@@ -0,0 +1,773 @@
+// AUTOGENERATED
+
+package client
+
+// The user authorization state has changed
+func (dispatcher *Dispatcher) OnAuthorizationState(handler func(update *UpdateAuthorizationState)) {
+	dispatcher.Handle(TypeUpdateAuthorizationState, func(update Type) {
+		handler(update.(*UpdateAuthorizationState))
+	})
+}
+
+// A new message was received; can also be an outgoing message
+func (dispatcher *Dispatcher) OnNewMessage(handler func(update *UpdateNewMessage)) {
+	dispatcher.Handle(TypeUpdateNewMessage, func(update Type) {
+		handler(update.(*UpdateNewMessage))
+	})
+}
+
+// A request to send a message has reached the Telegram server. This doesn't mean that the message will be sent successfully or even that the send message request will be processed. This update will be sent only if the option "use_quick_ack" is set to true. This update may be sent multiple times for the same message
+func (dispatcher *Dispatcher) OnMessageSendAcknowledged(handler func(update *UpdateMessageSendAcknowledged)) {
+	dispatcher.Handle(TypeUpdateMessageSendAcknowledged, func(update Type) {
+		handler(update.(*UpdateMessageSendAcknowledged))
+	})
+}
+
+// A message has been successfully sent
+func (dispatcher *Dispatcher) OnMessageSendSucceeded(handler func(update *UpdateMessageSendSucceeded)) {
+	dispatcher.Handle(TypeUpdateMessageSendSucceeded, func(update Type) {
+		handler(update.(*UpdateMessageSendSucceeded))
+	})
+}
+
+// A message failed to send. Be aware that some messages being sent can be irrecoverably deleted, in which case updateDeleteMessages will be received instead of this update
+func (dispatcher *Dispatcher) OnMessageSendFailed(handler func(update *UpdateMessageSendFailed)) {
+	dispatcher.Handle(TypeUpdateMessageSendFailed, func(update Type) {
+		handler(update.(*UpdateMessageSendFailed))
+	})
+}
+
+// The message content has changed
+func (dispatcher *Dispatcher) OnMessageContent(handler func(update *UpdateMessageContent)) {
+	dispatcher.Handle(TypeUpdateMessageContent, func(update Type) {
+		handler(update.(*UpdateMessageContent))
+	})
+}
+
+// A message was edited. Changes in the message content will come in a separate updateMessageContent
+func (dispatcher *Dispatcher) OnMessageEdited(handler func(update *UpdateMessageEdited)) {
+	dispatcher.Handle(TypeUpdateMessageEdited, func(update Type) {
+		handler(update.(*UpdateMessageEdited))
+	})
+}
+
+// The message pinned state was changed
+func (dispatcher *Dispatcher) OnMessageIsPinned(handler func(update *UpdateMessageIsPinned)) {
+	dispatcher.Handle(TypeUpdateMessageIsPinned, func(update Type) {
+		handler(update.(*UpdateMessageIsPinned))
+	})
+}
+
+// The information about interactions with a message has changed
+func (dispatcher *Dispatcher) OnMessageInteractionInfo(handler func(update *UpdateMessageInteractionInfo)) {
+	dispatcher.Handle(TypeUpdateMessageInteractionInfo, func(update Type) {
+		handler(update.(*UpdateMessageInteractionInfo))
+	})
+}
+
+// The message content was opened. Updates voice note messages to "listened", video note messages to "viewed" and starts the self-destruct timer
+func (dispatcher *Dispatcher) OnMessageContentOpened(handler func(update *UpdateMessageContentOpened)) {
+	dispatcher.Handle(TypeUpdateMessageContentOpened, func(update Type) {
+		handler(update.(*UpdateMessageContentOpened))
+	})
+}
+
+// A message with an unread mention was read
+func (dispatcher *Dispatcher) OnMessageMentionRead(handler func(update *UpdateMessageMentionRead)) {
+	dispatcher.Handle(TypeUpdateMessageMentionRead, func(update Type) {
+		handler(update.(*UpdateMessageMentionRead))
+	})
+}
+
+// The list of unread reactions added to a message was changed
+func (dispatcher *Dispatcher) OnMessageUnreadReactions(handler func(update *UpdateMessageUnreadReactions)) {
+	dispatcher.Handle(TypeUpdateMessageUnreadReactions, func(update Type) {
+		handler(update.(*UpdateMessageUnreadReactions))
+	})
+}
+
+// A message with a live location was viewed. When the update is received, the application is supposed to update the live location
+func (dispatcher *Dispatcher) OnMessageLiveLocationViewed(handler func(update *UpdateMessageLiveLocationViewed)) {
+	dispatcher.Handle(TypeUpdateMessageLiveLocationViewed, func(update Type) {
+		handler(update.(*UpdateMessageLiveLocationViewed))
+	})
+}
+
+// A new chat has been loaded/created. This update is guaranteed to come before the chat identifier is returned to the application. The chat field changes will be reported through separate updates
+func (dispatcher *Dispatcher) OnNewChat(handler func(update *UpdateNewChat)) {
+	dispatcher.Handle(TypeUpdateNewChat, func(update Type) {
+		handler(update.(*UpdateNewChat))
+	})
+}
+
+// The title of a chat was changed
+func (dispatcher *Dispatcher) OnChatTitle(handler func(update *UpdateChatTitle)) {
+	dispatcher.Handle(TypeUpdateChatTitle, func(update Type) {
+		handler(update.(*UpdateChatTitle))
+	})
+}
+
+// A chat photo was changed
+func (dispatcher *Dispatcher) OnChatPhoto(handler func(update *UpdateChatPhoto)) {
+	dispatcher.Handle(TypeUpdateChatPhoto, func(update Type) {
+		handler(update.(*UpdateChatPhoto))
+	})
+}
+
+// Chat permissions was changed
+func (dispatcher *Dispatcher) OnChatPermissions(handler func(update *UpdateChatPermissions)) {
+	dispatcher.Handle(TypeUpdateChatPermissions, func(update Type) {
+		handler(update.(*UpdateChatPermissions))
+	})
+}
+
+// The last message of a chat was changed. If last_message is null, then the last message in the chat became unknown. Some new unknown messages might be added to the chat in this case
+func (dispatcher *Dispatcher) OnChatLastMessage(handler func(update *UpdateChatLastMessage)) {
+	dispatcher.Handle(TypeUpdateChatLastMessage, func(update Type) {
+		handler(update.(*UpdateChatLastMessage))
+	})
+}
+
+// The position of a chat in a chat list has changed. An updateChatLastMessage or updateChatDraftMessage update might be sent instead of the update
+func (dispatcher *Dispatcher) OnChatPosition(handler func(update *UpdateChatPosition)) {
+	dispatcher.Handle(TypeUpdateChatPosition, func(update Type) {
+		handler(update.(*UpdateChatPosition))
+	})
+}
+
+// Incoming messages were read or the number of unread messages has been changed
+func (dispatcher *Dispatcher) OnChatReadInbox(handler func(update *UpdateChatReadInbox)) {
+	dispatcher.Handle(TypeUpdateChatReadInbox, func(update Type) {
+		handler(update.(*UpdateChatReadInbox))
+	})
+}
+
+// Outgoing messages were read
+func (dispatcher *Dispatcher) OnChatReadOutbox(handler func(update *UpdateChatReadOutbox)) {
+	dispatcher.Handle(TypeUpdateChatReadOutbox, func(update Type) {
+		handler(update.(*UpdateChatReadOutbox))
+	})
+}
+
+// The chat action bar was changed
+func (dispatcher *Dispatcher) OnChatActionBar(handler func(update *UpdateChatActionBar)) {
+	dispatcher.Handle(TypeUpdateChatActionBar, func(update Type) {
+		handler(update.(*UpdateChatActionBar))
+	})
+}
+
+// The chat available reactions were changed
+func (dispatcher *Dispatcher) OnChatAvailableReactions(handler func(update *UpdateChatAvailableReactions)) {
+	dispatcher.Handle(TypeUpdateChatAvailableReactions, func(update Type) {
+		handler(update.(*UpdateChatAvailableReactions))
+	})
+}
+
+// A chat draft has changed. Be aware that the update may come in the currently opened chat but with old content of the draft. If the user has changed the content of the draft, this update mustn't be applied
+func (dispatcher *Dispatcher) OnChatDraftMessage(handler func(update *UpdateChatDraftMessage)) {
+	dispatcher.Handle(TypeUpdateChatDraftMessage, func(update Type) {
+		handler(update.(*UpdateChatDraftMessage))
+	})
+}
+
+// The message sender that is selected to send messages in a chat has changed
+func (dispatcher *Dispatcher) OnChatMessageSender(handler func(update *UpdateChatMessageSender)) {
+	dispatcher.Handle(TypeUpdateChatMessageSender, func(update Type) {
+		handler(update.(*UpdateChatMessageSender))
+	})
+}
+
+// The message auto-delete or self-destruct timer setting for a chat was changed
+func (dispatcher *Dispatcher) OnChatMessageAutoDeleteTime(handler func(update *UpdateChatMessageAutoDeleteTime)) {
+	dispatcher.Handle(TypeUpdateChatMessageAutoDeleteTime, func(update Type) {
+		handler(update.(*UpdateChatMessageAutoDeleteTime))
+	})
+}
+
+// Notification settings for a chat were changed
+func (dispatcher *Dispatcher) OnChatNotificationSettings(handler func(update *UpdateChatNotificationSettings)) {
+	dispatcher.Handle(TypeUpdateChatNotificationSettings, func(update Type) {
+		handler(update.(*UpdateChatNotificationSettings))
+	})
+}
+
+// The chat pending join requests were changed
+func (dispatcher *Dispatcher) OnChatPendingJoinRequests(handler func(update *UpdateChatPendingJoinRequests)) {
+	dispatcher.Handle(TypeUpdateChatPendingJoinRequests, func(update Type) {
+		handler(update.(*UpdateChatPendingJoinRequests))
+	})
+}
+
+// The default chat reply markup was changed. Can occur because new messages with reply markup were received or because an old reply markup was hidden by the user
+func (dispatcher *Dispatcher) OnChatReplyMarkup(handler func(update *UpdateChatReplyMarkup)) {
+	dispatcher.Handle(TypeUpdateChatReplyMarkup, func(update Type) {
+		handler(update.(*UpdateChatReplyMarkup))
+	})
+}
+
+// The chat background was changed
+func (dispatcher *Dispatcher) OnChatBackground(handler func(update *UpdateChatBackground)) {
+	dispatcher.Handle(TypeUpdateChatBackground, func(update Type) {
+		handler(update.(*UpdateChatBackground))
+	})
+}
+
+// The chat theme was changed
+func (dispatcher *Dispatcher) OnChatTheme(handler func(update *UpdateChatTheme)) {
+	dispatcher.Handle(TypeUpdateChatTheme, func(update Type) {
+		handler(update.(*UpdateChatTheme))
+	})
+}
+
+// The chat unread_mention_count has changed
+func (dispatcher *Dispatcher) OnChatUnreadMentionCount(handler func(update *UpdateChatUnreadMentionCount)) {
+	dispatcher.Handle(TypeUpdateChatUnreadMentionCount, func(update Type) {
+		handler(update.(*UpdateChatUnreadMentionCount))
+	})
+}
+
+// The chat unread_reaction_count has changed
+func (dispatcher *Dispatcher) OnChatUnreadReactionCount(handler func(update *UpdateChatUnreadReactionCount)) {
+	dispatcher.Handle(TypeUpdateChatUnreadReactionCount, func(update Type) {
+		handler(update.(*UpdateChatUnreadReactionCount))
+	})
+}
+
+// A chat video chat state has changed
+func (dispatcher *Dispatcher) OnChatVideoChat(handler func(update *UpdateChatVideoChat)) {
+	dispatcher.Handle(TypeUpdateChatVideoChat, func(update Type) {
+		handler(update.(*UpdateChatVideoChat))
+	})
+}
+
+// The value of the default disable_notification parameter, used when a message is sent to the chat, was changed
+func (dispatcher *Dispatcher) OnChatDefaultDisableNotification(handler func(update *UpdateChatDefaultDisableNotification)) {
+	dispatcher.Handle(TypeUpdateChatDefaultDisableNotification, func(update Type) {
+		handler(update.(*UpdateChatDefaultDisableNotification))
+	})
+}
+
+// A chat content was allowed or restricted for saving
+func (dispatcher *Dispatcher) OnChatHasProtectedContent(handler func(update *UpdateChatHasProtectedContent)) {
+	dispatcher.Handle(TypeUpdateChatHasProtectedContent, func(update Type) {
+		handler(update.(*UpdateChatHasProtectedContent))
+	})
+}
+
+// Translation of chat messages was enabled or disabled
+func (dispatcher *Dispatcher) OnChatIsTranslatable(handler func(update *UpdateChatIsTranslatable)) {
+	dispatcher.Handle(TypeUpdateChatIsTranslatable, func(update Type) {
+		handler(update.(*UpdateChatIsTranslatable))
+	})
+}
+
+// A chat was marked as unread or was read
+func (dispatcher *Dispatcher) OnChatIsMarkedAsUnread(handler func(update *UpdateChatIsMarkedAsUnread)) {
+	dispatcher.Handle(TypeUpdateChatIsMarkedAsUnread, func(update Type) {
+		handler(update.(*UpdateChatIsMarkedAsUnread))
+	})
+}
+
+// A chat was blocked or unblocked
+func (dispatcher *Dispatcher) OnChatIsBlocked(handler func(update *UpdateChatIsBlocked)) {
+	dispatcher.Handle(TypeUpdateChatIsBlocked, func(update Type) {
+		handler(update.(*UpdateChatIsBlocked))
+	})
+}
+
+// A chat's has_scheduled_messages field has changed
+func (dispatcher *Dispatcher) OnChatHasScheduledMessages(handler func(update *UpdateChatHasScheduledMessages)) {
+	dispatcher.Handle(TypeUpdateChatHasScheduledMessages, func(update Type) {
+		handler(update.(*UpdateChatHasScheduledMessages))
+	})
+}
+
+// The list of chat folders or a chat folder has changed
+func (dispatcher *Dispatcher) OnChatFolders(handler func(update *UpdateChatFolders)) {
+	dispatcher.Handle(TypeUpdateChatFolders, func(update Type) {
+		handler(update.(*UpdateChatFolders))
+	})
+}
+
+// The number of online group members has changed. This update with non-zero number of online group members is sent only for currently opened chats. There is no guarantee that it will be sent just after the number of online users has changed
+func (dispatcher *Dispatcher) OnChatOnlineMemberCount(handler func(update *UpdateChatOnlineMemberCount)) {
+	dispatcher.Handle(TypeUpdateChatOnlineMemberCount, func(update Type) {
+		handler(update.(*UpdateChatOnlineMemberCount))
+	})
+}
+
+// Basic information about a topic in a forum chat was changed
+func (dispatcher *Dispatcher) OnForumTopicInfo(handler func(update *UpdateForumTopicInfo)) {
+	dispatcher.Handle(TypeUpdateForumTopicInfo, func(update Type) {
+		handler(update.(*UpdateForumTopicInfo))
+	})
+}
+
+// Notification settings for some type of chats were updated
+func (dispatcher *Dispatcher) OnScopeNotificationSettings(handler func(update *UpdateScopeNotificationSettings)) {
+	dispatcher.Handle(TypeUpdateScopeNotificationSettings, func(update Type) {
+		handler(update.(*UpdateScopeNotificationSettings))
+	})
+}
+
+// A notification was changed
+func (dispatcher *Dispatcher) OnNotification(handler func(update *UpdateNotification)) {
+	dispatcher.Handle(TypeUpdateNotification, func(update Type) {
+		handler(update.(*UpdateNotification))
+	})
+}
+
+// A list of active notifications in a notification group has changed
+func (dispatcher *Dispatcher) OnNotificationGroup(handler func(update *UpdateNotificationGroup)) {
+	dispatcher.Handle(TypeUpdateNotificationGroup, func(update Type) {
+		handler(update.(*UpdateNotificationGroup))
+	})
+}
+
+// Contains active notifications that was shown on previous application launches. This update is sent only if the message database is used. In that case it comes once before any updateNotification and updateNotificationGroup update
+func (dispatcher *Dispatcher) OnActiveNotifications(handler func(update *UpdateActiveNotifications)) {
+	dispatcher.Handle(TypeUpdateActiveNotifications, func(update Type) {
+		handler(update.(*UpdateActiveNotifications))
+	})
+}
+
+// Describes whether there are some pending notification updates. Can be used to prevent application from killing, while there are some pending notifications
+func (dispatcher *Dispatcher) OnHavePendingNotifications(handler func(update *UpdateHavePendingNotifications)) {
+	dispatcher.Handle(TypeUpdateHavePendingNotifications, func(update Type) {
+		handler(update.(*UpdateHavePendingNotifications))
+	})
+}
+
+// Some messages were deleted
+func (dispatcher *Dispatcher) OnDeleteMessages(handler func(update *UpdateDeleteMessages)) {
+	dispatcher.Handle(TypeUpdateDeleteMessages, func(update Type) {
+		handler(update.(*UpdateDeleteMessages))
+	})
+}
+
+// A message sender activity in the chat has changed
+func (dispatcher *Dispatcher) OnChatAction(handler func(update *UpdateChatAction)) {
+	dispatcher.Handle(TypeUpdateChatAction, func(update Type) {
+		handler(update.(*UpdateChatAction))
+	})
+}
+
+// The user went online or offline
+func (dispatcher *Dispatcher) OnUserStatus(handler func(update *UpdateUserStatus)) {
+	dispatcher.Handle(TypeUpdateUserStatus, func(update Type) {
+		handler(update.(*UpdateUserStatus))
+	})
+}
+
+// Some data of a user has changed. This update is guaranteed to come before the user identifier is returned to the application
+func (dispatcher *Dispatcher) OnUser(handler func(update *UpdateUser)) {
+	dispatcher.Handle(TypeUpdateUser, func(update Type) {
+		handler(update.(*UpdateUser))
+	})
+}
+
+// Some data of a user or a chat has changed. This update is guaranteed to come before the user or chat identifier is returned to the application
+func (dispatcher *Dispatcher) OnAccessHash(handler func(update *UpdateAccessHash)) {
+	dispatcher.Handle(TypeUpdateAccessHash, func(update Type) {
+		handler(update.(*UpdateAccessHash))
+	})
+}
+
+// Some data of a basic group has changed. This update is guaranteed to come before the basic group identifier is returned to the application
+func (dispatcher *Dispatcher) OnBasicGroup(handler func(update *UpdateBasicGroup)) {
+	dispatcher.Handle(TypeUpdateBasicGroup, func(update Type) {
+		handler(update.(*UpdateBasicGroup))
+	})
+}
+
+// Some data of a supergroup or a channel has changed. This update is guaranteed to come before the supergroup identifier is returned to the application
+func (dispatcher *Dispatcher) OnSupergroup(handler func(update *UpdateSupergroup)) {
+	dispatcher.Handle(TypeUpdateSupergroup, func(update Type) {
+		handler(update.(*UpdateSupergroup))
+	})
+}
+
+// Some data of a secret chat has changed. This update is guaranteed to come before the secret chat identifier is returned to the application
+func (dispatcher *Dispatcher) OnSecretChat(handler func(update *UpdateSecretChat)) {
+	dispatcher.Handle(TypeUpdateSecretChat, func(update Type) {
+		handler(update.(*UpdateSecretChat))
+	})
+}
+
+// Some data in userFullInfo has been changed
+func (dispatcher *Dispatcher) OnUserFullInfo(handler func(update *UpdateUserFullInfo)) {
+	dispatcher.Handle(TypeUpdateUserFullInfo, func(update Type) {
+		handler(update.(*UpdateUserFullInfo))
+	})
+}
+
+// Some data in basicGroupFullInfo has been changed
+func (dispatcher *Dispatcher) OnBasicGroupFullInfo(handler func(update *UpdateBasicGroupFullInfo)) {
+	dispatcher.Handle(TypeUpdateBasicGroupFullInfo, func(update Type) {
+		handler(update.(*UpdateBasicGroupFullInfo))
+	})
+}
+
+// Some data in supergroupFullInfo has been changed
+func (dispatcher *Dispatcher) OnSupergroupFullInfo(handler func(update *UpdateSupergroupFullInfo)) {
+	dispatcher.Handle(TypeUpdateSupergroupFullInfo, func(update Type) {
+		handler(update.(*UpdateSupergroupFullInfo))
+	})
+}
+
+// A service notification from the server was received. Upon receiving this the application must show a popup with the content of the notification
+func (dispatcher *Dispatcher) OnServiceNotification(handler func(update *UpdateServiceNotification)) {
+	dispatcher.Handle(TypeUpdateServiceNotification, func(update Type) {
+		handler(update.(*UpdateServiceNotification))
+	})
+}
+
+// Information about a file was updated
+func (dispatcher *Dispatcher) OnFile(handler func(update *UpdateFile)) {
+	dispatcher.Handle(TypeUpdateFile, func(update Type) {
+		handler(update.(*UpdateFile))
+	})
+}
+
+// The file generation process needs to be started by the application
+func (dispatcher *Dispatcher) OnFileGenerationStart(handler func(update *UpdateFileGenerationStart)) {
+	dispatcher.Handle(TypeUpdateFileGenerationStart, func(update Type) {
+		handler(update.(*UpdateFileGenerationStart))
+	})
+}
+
+// File generation is no longer needed
+func (dispatcher *Dispatcher) OnFileGenerationStop(handler func(update *UpdateFileGenerationStop)) {
+	dispatcher.Handle(TypeUpdateFileGenerationStop, func(update Type) {
+		handler(update.(*UpdateFileGenerationStop))
+	})
+}
+
+// The state of the file download list has changed
+func (dispatcher *Dispatcher) OnFileDownloads(handler func(update *UpdateFileDownloads)) {
+	dispatcher.Handle(TypeUpdateFileDownloads, func(update Type) {
+		handler(update.(*UpdateFileDownloads))
+	})
+}
+
+// A file was added to the file download list. This update is sent only after file download list is loaded for the first time
+func (dispatcher *Dispatcher) OnFileAddedToDownloads(handler func(update *UpdateFileAddedToDownloads)) {
+	dispatcher.Handle(TypeUpdateFileAddedToDownloads, func(update Type) {
+		handler(update.(*UpdateFileAddedToDownloads))
+	})
+}
+
+// A file download was changed. This update is sent only after file download list is loaded for the first time
+func (dispatcher *Dispatcher) OnFileDownload(handler func(update *UpdateFileDownload)) {
+	dispatcher.Handle(TypeUpdateFileDownload, func(update Type) {
+		handler(update.(*UpdateFileDownload))
+	})
+}
+
+// A file was removed from the file download list. This update is sent only after file download list is loaded for the first time
+func (dispatcher *Dispatcher) OnFileRemovedFromDownloads(handler func(update *UpdateFileRemovedFromDownloads)) {
+	dispatcher.Handle(TypeUpdateFileRemovedFromDownloads, func(update Type) {
+		handler(update.(*UpdateFileRemovedFromDownloads))
+	})
+}
+
+// New call was created or information about a call was updated
+func (dispatcher *Dispatcher) OnCall(handler func(update *UpdateCall)) {
+	dispatcher.Handle(TypeUpdateCall, func(update Type) {
+		handler(update.(*UpdateCall))
+	})
+}
+
+// Information about a group call was updated
+func (dispatcher *Dispatcher) OnGroupCall(handler func(update *UpdateGroupCall)) {
+	dispatcher.Handle(TypeUpdateGroupCall, func(update Type) {
+		handler(update.(*UpdateGroupCall))
+	})
+}
+
+// Information about a group call participant was changed. The updates are sent only after the group call is received through getGroupCall and only if the call is joined or being joined
+func (dispatcher *Dispatcher) OnGroupCallParticipant(handler func(update *UpdateGroupCallParticipant)) {
+	dispatcher.Handle(TypeUpdateGroupCallParticipant, func(update Type) {
+		handler(update.(*UpdateGroupCallParticipant))
+	})
+}
+
+// New call signaling data arrived
+func (dispatcher *Dispatcher) OnNewCallSignalingData(handler func(update *UpdateNewCallSignalingData)) {
+	dispatcher.Handle(TypeUpdateNewCallSignalingData, func(update Type) {
+		handler(update.(*UpdateNewCallSignalingData))
+	})
+}
+
+// Some privacy setting rules have been changed
+func (dispatcher *Dispatcher) OnUserPrivacySettingRules(handler func(update *UpdateUserPrivacySettingRules)) {
+	dispatcher.Handle(TypeUpdateUserPrivacySettingRules, func(update Type) {
+		handler(update.(*UpdateUserPrivacySettingRules))
+	})
+}
+
+// Number of unread messages in a chat list has changed. This update is sent only if the message database is used
+func (dispatcher *Dispatcher) OnUnreadMessageCount(handler func(update *UpdateUnreadMessageCount)) {
+	dispatcher.Handle(TypeUpdateUnreadMessageCount, func(update Type) {
+		handler(update.(*UpdateUnreadMessageCount))
+	})
+}
+
+// Number of unread chats, i.e. with unread messages or marked as unread, has changed. This update is sent only if the message database is used
+func (dispatcher *Dispatcher) OnUnreadChatCount(handler func(update *UpdateUnreadChatCount)) {
+	dispatcher.Handle(TypeUpdateUnreadChatCount, func(update Type) {
+		handler(update.(*UpdateUnreadChatCount))
+	})
+}
+
+// An option changed its value
+func (dispatcher *Dispatcher) OnOption(handler func(update *UpdateOption)) {
+	dispatcher.Handle(TypeUpdateOption, func(update Type) {
+		handler(update.(*UpdateOption))
+	})
+}
+
+// A sticker set has changed
+func (dispatcher *Dispatcher) OnStickerSet(handler func(update *UpdateStickerSet)) {
+	dispatcher.Handle(TypeUpdateStickerSet, func(update Type) {
+		handler(update.(*UpdateStickerSet))
+	})
+}
+
+// The list of installed sticker sets was updated
+func (dispatcher *Dispatcher) OnInstalledStickerSets(handler func(update *UpdateInstalledStickerSets)) {
+	dispatcher.Handle(TypeUpdateInstalledStickerSets, func(update Type) {
+		handler(update.(*UpdateInstalledStickerSets))
+	})
+}
+
+// The list of trending sticker sets was updated or some of them were viewed
+func (dispatcher *Dispatcher) OnTrendingStickerSets(handler func(update *UpdateTrendingStickerSets)) {
+	dispatcher.Handle(TypeUpdateTrendingStickerSets, func(update Type) {
+		handler(update.(*UpdateTrendingStickerSets))
+	})
+}
+
+// The list of recently used stickers was updated
+func (dispatcher *Dispatcher) OnRecentStickers(handler func(update *UpdateRecentStickers)) {
+	dispatcher.Handle(TypeUpdateRecentStickers, func(update Type) {
+		handler(update.(*UpdateRecentStickers))
+	})
+}
+
+// The list of favorite stickers was updated
+func (dispatcher *Dispatcher) OnFavoriteStickers(handler func(update *UpdateFavoriteStickers)) {
+	dispatcher.Handle(TypeUpdateFavoriteStickers, func(update Type) {
+		handler(update.(*UpdateFavoriteStickers))
+	})
+}
+
+// The list of saved animations was updated
+func (dispatcher *Dispatcher) OnSavedAnimations(handler func(update *UpdateSavedAnimations)) {
+	dispatcher.Handle(TypeUpdateSavedAnimations, func(update Type) {
+		handler(update.(*UpdateSavedAnimations))
+	})
+}
+
+// The list of saved notifications sounds was updated. This update may not be sent until information about a notification sound was requested for the first time
+func (dispatcher *Dispatcher) OnSavedNotificationSounds(handler func(update *UpdateSavedNotificationSounds)) {
+	dispatcher.Handle(TypeUpdateSavedNotificationSounds, func(update Type) {
+		handler(update.(*UpdateSavedNotificationSounds))
+	})
+}
+
+// The selected background has changed
+func (dispatcher *Dispatcher) OnSelectedBackground(handler func(update *UpdateSelectedBackground)) {
+	dispatcher.Handle(TypeUpdateSelectedBackground, func(update Type) {
+		handler(update.(*UpdateSelectedBackground))
+	})
+}
+
+// The list of available chat themes has changed
+func (dispatcher *Dispatcher) OnChatThemes(handler func(update *UpdateChatThemes)) {
+	dispatcher.Handle(TypeUpdateChatThemes, func(update Type) {
+		handler(update.(*UpdateChatThemes))
+	})
+}
+
+// Some language pack strings have been updated
+func (dispatcher *Dispatcher) OnLanguagePackStrings(handler func(update *UpdateLanguagePackStrings)) {
+	dispatcher.Handle(TypeUpdateLanguagePackStrings, func(update Type) {
+		handler(update.(*UpdateLanguagePackStrings))
+	})
+}
+
+// The connection state has changed. This update must be used only to show a human-readable description of the connection state
+func (dispatcher *Dispatcher) OnConnectionState(handler func(update *UpdateConnectionState)) {
+	dispatcher.Handle(TypeUpdateConnectionState, func(update Type) {
+		handler(update.(*UpdateConnectionState))
+	})
+}
+
+// New terms of service must be accepted by the user. If the terms of service are declined, then the deleteAccount method must be called with the reason "Decline ToS update"
+func (dispatcher *Dispatcher) OnTermsOfService(handler func(update *UpdateTermsOfService)) {
+	dispatcher.Handle(TypeUpdateTermsOfService, func(update Type) {
+		handler(update.(*UpdateTermsOfService))
+	})
+}
+
+// The list of users nearby has changed. The update is guaranteed to be sent only 60 seconds after a successful searchChatsNearby request
+func (dispatcher *Dispatcher) OnUsersNearby(handler func(update *UpdateUsersNearby)) {
+	dispatcher.Handle(TypeUpdateUsersNearby, func(update Type) {
+		handler(update.(*UpdateUsersNearby))
+	})
+}
+
+// The list of bots added to attachment menu has changed
+func (dispatcher *Dispatcher) OnAttachmentMenuBots(handler func(update *UpdateAttachmentMenuBots)) {
+	dispatcher.Handle(TypeUpdateAttachmentMenuBots, func(update Type) {
+		handler(update.(*UpdateAttachmentMenuBots))
+	})
+}
+
+// A message was sent by an opened Web App, so the Web App needs to be closed
+func (dispatcher *Dispatcher) OnWebAppMessageSent(handler func(update *UpdateWebAppMessageSent)) {
+	dispatcher.Handle(TypeUpdateWebAppMessageSent, func(update Type) {
+		handler(update.(*UpdateWebAppMessageSent))
+	})
+}
+
+// The list of active emoji reactions has changed
+func (dispatcher *Dispatcher) OnActiveEmojiReactions(handler func(update *UpdateActiveEmojiReactions)) {
+	dispatcher.Handle(TypeUpdateActiveEmojiReactions, func(update Type) {
+		handler(update.(*UpdateActiveEmojiReactions))
+	})
+}
+
+// The type of default reaction has changed
+func (dispatcher *Dispatcher) OnDefaultReactionType(handler func(update *UpdateDefaultReactionType)) {
+	dispatcher.Handle(TypeUpdateDefaultReactionType, func(update Type) {
+		handler(update.(*UpdateDefaultReactionType))
+	})
+}
+
+// The list of supported dice emojis has changed
+func (dispatcher *Dispatcher) OnDiceEmojis(handler func(update *UpdateDiceEmojis)) {
+	dispatcher.Handle(TypeUpdateDiceEmojis, func(update Type) {
+		handler(update.(*UpdateDiceEmojis))
+	})
+}
+
+// Some animated emoji message was clicked and a big animated sticker must be played if the message is visible on the screen. chatActionWatchingAnimations with the text of the message needs to be sent if the sticker is played
+func (dispatcher *Dispatcher) OnAnimatedEmojiMessageClicked(handler func(update *UpdateAnimatedEmojiMessageClicked)) {
+	dispatcher.Handle(TypeUpdateAnimatedEmojiMessageClicked, func(update Type) {
+		handler(update.(*UpdateAnimatedEmojiMessageClicked))
+	})
+}
+
+// The parameters of animation search through getOption("animation_search_bot_username") bot has changed
+func (dispatcher *Dispatcher) OnAnimationSearchParameters(handler func(update *UpdateAnimationSearchParameters)) {
+	dispatcher.Handle(TypeUpdateAnimationSearchParameters, func(update Type) {
+		handler(update.(*UpdateAnimationSearchParameters))
+	})
+}
+
+// The list of suggested to the user actions has changed
+func (dispatcher *Dispatcher) OnSuggestedActions(handler func(update *UpdateSuggestedActions)) {
+	dispatcher.Handle(TypeUpdateSuggestedActions, func(update Type) {
+		handler(update.(*UpdateSuggestedActions))
+	})
+}
+
+// Adding users to a chat has failed because of their privacy settings. An invite link can be shared with the users if appropriate
+func (dispatcher *Dispatcher) OnAddChatMembersPrivacyForbidden(handler func(update *UpdateAddChatMembersPrivacyForbidden)) {
+	dispatcher.Handle(TypeUpdateAddChatMembersPrivacyForbidden, func(update Type) {
+		handler(update.(*UpdateAddChatMembersPrivacyForbidden))
+	})
+}
+
+// Autosave settings for some type of chats were updated
+func (dispatcher *Dispatcher) OnAutosaveSettings(handler func(update *UpdateAutosaveSettings)) {
+	dispatcher.Handle(TypeUpdateAutosaveSettings, func(update Type) {
+		handler(update.(*UpdateAutosaveSettings))
+	})
+}
+
+// A new incoming inline query; for bots only
+func (dispatcher *Dispatcher) OnNewInlineQuery(handler func(update *UpdateNewInlineQuery)) {
+	dispatcher.Handle(TypeUpdateNewInlineQuery, func(update Type) {
+		handler(update.(*UpdateNewInlineQuery))
+	})
+}
+
+// The user has chosen a result of an inline query; for bots only
+func (dispatcher *Dispatcher) OnNewChosenInlineResult(handler func(update *UpdateNewChosenInlineResult)) {
+	dispatcher.Handle(TypeUpdateNewChosenInlineResult, func(update Type) {
+		handler(update.(*UpdateNewChosenInlineResult))
+	})
+}
+
+// A new incoming callback query; for bots only
+func (dispatcher *Dispatcher) OnNewCallbackQuery(handler func(update *UpdateNewCallbackQuery)) {
+	dispatcher.Handle(TypeUpdateNewCallbackQuery, func(update Type) {
+		handler(update.(*UpdateNewCallbackQuery))
+	})
+}
+
+// A new incoming callback query from a message sent via a bot; for bots only
+func (dispatcher *Dispatcher) OnNewInlineCallbackQuery(handler func(update *UpdateNewInlineCallbackQuery)) {
+	dispatcher.Handle(TypeUpdateNewInlineCallbackQuery, func(update Type) {
+		handler(update.(*UpdateNewInlineCallbackQuery))
+	})
+}
+
+// A new incoming shipping query; for bots only. Only for invoices with flexible price
+func (dispatcher *Dispatcher) OnNewShippingQuery(handler func(update *UpdateNewShippingQuery)) {
+	dispatcher.Handle(TypeUpdateNewShippingQuery, func(update Type) {
+		handler(update.(*UpdateNewShippingQuery))
+	})
+}
+
+// A new incoming pre-checkout query; for bots only. Contains full information about a checkout
+func (dispatcher *Dispatcher) OnNewPreCheckoutQuery(handler func(update *UpdateNewPreCheckoutQuery)) {
+	dispatcher.Handle(TypeUpdateNewPreCheckoutQuery, func(update Type) {
+		handler(update.(*UpdateNewPreCheckoutQuery))
+	})
+}
+
+// A new incoming event; for bots only
+func (dispatcher *Dispatcher) OnNewCustomEvent(handler func(update *UpdateNewCustomEvent)) {
+	dispatcher.Handle(TypeUpdateNewCustomEvent, func(update Type) {
+		handler(update.(*UpdateNewCustomEvent))
+	})
+}
+
+// A new incoming query; for bots only
+func (dispatcher *Dispatcher) OnNewCustomQuery(handler func(update *UpdateNewCustomQuery)) {
+	dispatcher.Handle(TypeUpdateNewCustomQuery, func(update Type) {
+		handler(update.(*UpdateNewCustomQuery))
+	})
+}
+
+// A poll was updated; for bots only
+func (dispatcher *Dispatcher) OnPoll(handler func(update *UpdatePoll)) {
+	dispatcher.Handle(TypeUpdatePoll, func(update Type) {
+		handler(update.(*UpdatePoll))
+	})
+}
+
+// A user changed the answer to a poll; for bots only
+func (dispatcher *Dispatcher) OnPollAnswer(handler func(update *UpdatePollAnswer)) {
+	dispatcher.Handle(TypeUpdatePollAnswer, func(update Type) {
+		handler(update.(*UpdatePollAnswer))
+	})
+}
+
+// User rights changed in a chat; for bots only
+func (dispatcher *Dispatcher) OnChatMember(handler func(update *UpdateChatMember)) {
+	dispatcher.Handle(TypeUpdateChatMember, func(update Type) {
+		handler(update.(*UpdateChatMember))
+	})
+}
+
+// A user sent a join request to a chat; for bots only
+func (dispatcher *Dispatcher) OnNewChatJoinRequest(handler func(update *UpdateNewChatJoinRequest)) {
+	dispatcher.Handle(TypeUpdateNewChatJoinRequest, func(update Type) {
+		handler(update.(*UpdateNewChatJoinRequest))
+	})
+}
@@ -0,0 +1,5313 @@
+// AUTOGENERATED
+
+package client
+
+import (
+	"fmt"
+)
+
+// AuthenticationCodeTypeVisitor has a method for every subtype of AuthenticationCodeType. A new subtype breaks the build of the visitor implementations
+type AuthenticationCodeTypeVisitor interface {
+	VisitAuthenticationCodeTypeTelegramMessage(value *AuthenticationCodeTypeTelegramMessage) error
+	VisitAuthenticationCodeTypeSms(value *AuthenticationCodeTypeSms) error
+	VisitAuthenticationCodeTypeCall(value *AuthenticationCodeTypeCall) error
+	VisitAuthenticationCodeTypeFlashCall(value *AuthenticationCodeTypeFlashCall) error
+	VisitAuthenticationCodeTypeMissedCall(value *AuthenticationCodeTypeMissedCall) error
+	VisitAuthenticationCodeTypeFragment(value *AuthenticationCodeTypeFragment) error
+	VisitAuthenticationCodeTypeFirebaseAndroid(value *AuthenticationCodeTypeFirebaseAndroid) error
+	VisitAuthenticationCodeTypeFirebaseIos(value *AuthenticationCodeTypeFirebaseIos) error
+}
+
+// VisitAuthenticationCodeType calls the method of the visitor for the subtype of the value
+func VisitAuthenticationCodeType(value AuthenticationCodeType, visitor AuthenticationCodeTypeVisitor) error {
+	switch value := value.(type) {
+	case *AuthenticationCodeTypeTelegramMessage:
+		return visitor.VisitAuthenticationCodeTypeTelegramMessage(value)
+
+	case *AuthenticationCodeTypeSms:
+		return visitor.VisitAuthenticationCodeTypeSms(value)
+
+	case *AuthenticationCodeTypeCall:
+		return visitor.VisitAuthenticationCodeTypeCall(value)
+
+	case *AuthenticationCodeTypeFlashCall:
+		return visitor.VisitAuthenticationCodeTypeFlashCall(value)
+
+	case *AuthenticationCodeTypeMissedCall:
+		return visitor.VisitAuthenticationCodeTypeMissedCall(value)
+
+	case *AuthenticationCodeTypeFragment:
+		return visitor.VisitAuthenticationCodeTypeFragment(value)
+
+	case *AuthenticationCodeTypeFirebaseAndroid:
+		return visitor.VisitAuthenticationCodeTypeFirebaseAndroid(value)
+
+	case *AuthenticationCodeTypeFirebaseIos:
+		return visitor.VisitAuthenticationCodeTypeFirebaseIos(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.AuthenticationCodeTypeType())
+	}
+}
+
+// EmailAddressAuthenticationVisitor has a method for every subtype of EmailAddressAuthentication. A new subtype breaks the build of the visitor implementations
+type EmailAddressAuthenticationVisitor interface {
+	VisitEmailAddressAuthenticationCode(value *EmailAddressAuthenticationCode) error
+	VisitEmailAddressAuthenticationAppleId(value *EmailAddressAuthenticationAppleId) error
+	VisitEmailAddressAuthenticationGoogleId(value *EmailAddressAuthenticationGoogleId) error
+}
+
+// VisitEmailAddressAuthentication calls the method of the visitor for the subtype of the value
+func VisitEmailAddressAuthentication(value EmailAddressAuthentication, visitor EmailAddressAuthenticationVisitor) error {
+	switch value := value.(type) {
+	case *EmailAddressAuthenticationCode:
+		return visitor.VisitEmailAddressAuthenticationCode(value)
+
+	case *EmailAddressAuthenticationAppleId:
+		return visitor.VisitEmailAddressAuthenticationAppleId(value)
+
+	case *EmailAddressAuthenticationGoogleId:
+		return visitor.VisitEmailAddressAuthenticationGoogleId(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.EmailAddressAuthenticationType())
+	}
+}
+
+// EmailAddressResetStateVisitor has a method for every subtype of EmailAddressResetState. A new subtype breaks the build of the visitor implementations
+type EmailAddressResetStateVisitor interface {
+	VisitEmailAddressResetStateAvailable(value *EmailAddressResetStateAvailable) error
+	VisitEmailAddressResetStatePending(value *EmailAddressResetStatePending) error
+}
+
+// VisitEmailAddressResetState calls the method of the visitor for the subtype of the value
+func VisitEmailAddressResetState(value EmailAddressResetState, visitor EmailAddressResetStateVisitor) error {
+	switch value := value.(type) {
+	case *EmailAddressResetStateAvailable:
+		return visitor.VisitEmailAddressResetStateAvailable(value)
+
+	case *EmailAddressResetStatePending:
+		return visitor.VisitEmailAddressResetStatePending(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.EmailAddressResetStateType())
+	}
+}
+
+// AuthorizationStateVisitor has a method for every subtype of AuthorizationState. A new subtype breaks the build of the visitor implementations
+type AuthorizationStateVisitor interface {
+	VisitAuthorizationStateWaitTdlibParameters(value *AuthorizationStateWaitTdlibParameters) error
+	VisitAuthorizationStateWaitPhoneNumber(value *AuthorizationStateWaitPhoneNumber) error
+	VisitAuthorizationStateWaitEmailAddress(value *AuthorizationStateWaitEmailAddress) error
+	VisitAuthorizationStateWaitEmailCode(value *AuthorizationStateWaitEmailCode) error
+	VisitAuthorizationStateWaitCode(value *AuthorizationStateWaitCode) error
+	VisitAuthorizationStateWaitOtherDeviceConfirmation(value *AuthorizationStateWaitOtherDeviceConfirmation) error
+	VisitAuthorizationStateWaitRegistration(value *AuthorizationStateWaitRegistration) error
+	VisitAuthorizationStateWaitPassword(value *AuthorizationStateWaitPassword) error
+	VisitAuthorizationStateReady(value *AuthorizationStateReady) error
+	VisitAuthorizationStateLoggingOut(value *AuthorizationStateLoggingOut) error
+	VisitAuthorizationStateClosing(value *AuthorizationStateClosing) error
+	VisitAuthorizationStateClosed(value *AuthorizationStateClosed) error
+}
+
+// VisitAuthorizationState calls the method of the visitor for the subtype of the value
+func VisitAuthorizationState(value AuthorizationState, visitor AuthorizationStateVisitor) error {
+	switch value := value.(type) {
+	case *AuthorizationStateWaitTdlibParameters:
+		return visitor.VisitAuthorizationStateWaitTdlibParameters(value)
+
+	case *AuthorizationStateWaitPhoneNumber:
+		return visitor.VisitAuthorizationStateWaitPhoneNumber(value)
+
+	case *AuthorizationStateWaitEmailAddress:
+		return visitor.VisitAuthorizationStateWaitEmailAddress(value)
+
+	case *AuthorizationStateWaitEmailCode:
+		return visitor.VisitAuthorizationStateWaitEmailCode(value)
+
+	case *AuthorizationStateWaitCode:
+		return visitor.VisitAuthorizationStateWaitCode(value)
+
+	case *AuthorizationStateWaitOtherDeviceConfirmation:
+		return visitor.VisitAuthorizationStateWaitOtherDeviceConfirmation(value)
+
+	case *AuthorizationStateWaitRegistration:
+		return visitor.VisitAuthorizationStateWaitRegistration(value)
+
+	case *AuthorizationStateWaitPassword:
+		return visitor.VisitAuthorizationStateWaitPassword(value)
+
+	case *AuthorizationStateReady:
+		return visitor.VisitAuthorizationStateReady(value)
+
+	case *AuthorizationStateLoggingOut:
+		return visitor.VisitAuthorizationStateLoggingOut(value)
+
+	case *AuthorizationStateClosing:
+		return visitor.VisitAuthorizationStateClosing(value)
+
+	case *AuthorizationStateClosed:
+		return visitor.VisitAuthorizationStateClosed(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.AuthorizationStateType())
+	}
+}
+
+// InputFileVisitor has a method for every subtype of InputFile. A new subtype breaks the build of the visitor implementations
+type InputFileVisitor interface {
+	VisitInputFileId(value *InputFileId) error
+	VisitInputFileRemote(value *InputFileRemote) error
+	VisitInputFileLocal(value *InputFileLocal) error
+	VisitInputFileGenerated(value *InputFileGenerated) error
+}
+
+// VisitInputFile calls the method of the visitor for the subtype of the value
+func VisitInputFile(value InputFile, visitor InputFileVisitor) error {
+	switch value := value.(type) {
+	case *InputFileId:
+		return visitor.VisitInputFileId(value)
+
+	case *InputFileRemote:
+		return visitor.VisitInputFileRemote(value)
+
+	case *InputFileLocal:
+		return visitor.VisitInputFileLocal(value)
+
+	case *InputFileGenerated:
+		return visitor.VisitInputFileGenerated(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.InputFileType())
+	}
+}
+
+// ThumbnailFormatVisitor has a method for every subtype of ThumbnailFormat. A new subtype breaks the build of the visitor implementations
+type ThumbnailFormatVisitor interface {
+	VisitThumbnailFormatJpeg(value *ThumbnailFormatJpeg) error
+	VisitThumbnailFormatGif(value *ThumbnailFormatGif) error
+	VisitThumbnailFormatMpeg4(value *ThumbnailFormatMpeg4) error
+	VisitThumbnailFormatPng(value *ThumbnailFormatPng) error
+	VisitThumbnailFormatTgs(value *ThumbnailFormatTgs) error
+	VisitThumbnailFormatWebm(value *ThumbnailFormatWebm) error
+	VisitThumbnailFormatWebp(value *ThumbnailFormatWebp) error
+}
+
+// VisitThumbnailFormat calls the method of the visitor for the subtype of the value
+func VisitThumbnailFormat(value ThumbnailFormat, visitor ThumbnailFormatVisitor) error {
+	switch value := value.(type) {
+	case *ThumbnailFormatJpeg:
+		return visitor.VisitThumbnailFormatJpeg(value)
+
+	case *ThumbnailFormatGif:
+		return visitor.VisitThumbnailFormatGif(value)
+
+	case *ThumbnailFormatMpeg4:
+		return visitor.VisitThumbnailFormatMpeg4(value)
+
+	case *ThumbnailFormatPng:
+		return visitor.VisitThumbnailFormatPng(value)
+
+	case *ThumbnailFormatTgs:
+		return visitor.VisitThumbnailFormatTgs(value)
+
+	case *ThumbnailFormatWebm:
+		return visitor.VisitThumbnailFormatWebm(value)
+
+	case *ThumbnailFormatWebp:
+		return visitor.VisitThumbnailFormatWebp(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.ThumbnailFormatType())
+	}
+}
+
+// MaskPointVisitor has a method for every subtype of MaskPoint. A new subtype breaks the build of the visitor implementations
+type MaskPointVisitor interface {
+	VisitMaskPointForehead(value *MaskPointForehead) error
+	VisitMaskPointEyes(value *MaskPointEyes) error
+	VisitMaskPointMouth(value *MaskPointMouth) error
+	VisitMaskPointChin(value *MaskPointChin) error
+}
+
+// VisitMaskPoint calls the method of the visitor for the subtype of the value
+func VisitMaskPoint(value MaskPoint, visitor MaskPointVisitor) error {
+	switch value := value.(type) {
+	case *MaskPointForehead:
+		return visitor.VisitMaskPointForehead(value)
+
+	case *MaskPointEyes:
+		return visitor.VisitMaskPointEyes(value)
+
+	case *MaskPointMouth:
+		return visitor.VisitMaskPointMouth(value)
+
+	case *MaskPointChin:
+		return visitor.VisitMaskPointChin(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.MaskPointType())
+	}
+}
+
+// StickerFormatVisitor has a method for every subtype of StickerFormat. A new subtype breaks the build of the visitor implementations
+type StickerFormatVisitor interface {
+	VisitStickerFormatWebp(value *StickerFormatWebp) error
+	VisitStickerFormatTgs(value *StickerFormatTgs) error
+	VisitStickerFormatWebm(value *StickerFormatWebm) error
+}
+
+// VisitStickerFormat calls the method of the visitor for the subtype of the value
+func VisitStickerFormat(value StickerFormat, visitor StickerFormatVisitor) error {
+	switch value := value.(type) {
+	case *StickerFormatWebp:
+		return visitor.VisitStickerFormatWebp(value)
+
+	case *StickerFormatTgs:
+		return visitor.VisitStickerFormatTgs(value)
+
+	case *StickerFormatWebm:
+		return visitor.VisitStickerFormatWebm(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.StickerFormatType())
+	}
+}
+
+// StickerTypeVisitor has a method for every subtype of StickerType. A new subtype breaks the build of the visitor implementations
+type StickerTypeVisitor interface {
+	VisitStickerTypeRegular(value *StickerTypeRegular) error
+	VisitStickerTypeMask(value *StickerTypeMask) error
+	VisitStickerTypeCustomEmoji(value *StickerTypeCustomEmoji) error
+}
+
+// VisitStickerType calls the method of the visitor for the subtype of the value
+func VisitStickerType(value StickerType, visitor StickerTypeVisitor) error {
+	switch value := value.(type) {
+	case *StickerTypeRegular:
+		return visitor.VisitStickerTypeRegular(value)
+
+	case *StickerTypeMask:
+		return visitor.VisitStickerTypeMask(value)
+
+	case *StickerTypeCustomEmoji:
+		return visitor.VisitStickerTypeCustomEmoji(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.StickerTypeType())
+	}
+}
+
+// StickerFullTypeVisitor has a method for every subtype of StickerFullType. A new subtype breaks the build of the visitor implementations
+type StickerFullTypeVisitor interface {
+	VisitStickerFullTypeRegular(value *StickerFullTypeRegular) error
+	VisitStickerFullTypeMask(value *StickerFullTypeMask) error
+	VisitStickerFullTypeCustomEmoji(value *StickerFullTypeCustomEmoji) error
+}
+
+// VisitStickerFullType calls the method of the visitor for the subtype of the value
+func VisitStickerFullType(value StickerFullType, visitor StickerFullTypeVisitor) error {
+	switch value := value.(type) {
+	case *StickerFullTypeRegular:
+		return visitor.VisitStickerFullTypeRegular(value)
+
+	case *StickerFullTypeMask:
+		return visitor.VisitStickerFullTypeMask(value)
+
+	case *StickerFullTypeCustomEmoji:
+		return visitor.VisitStickerFullTypeCustomEmoji(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.StickerFullTypeType())
+	}
+}
+
+// PollTypeVisitor has a method for every subtype of PollType. A new subtype breaks the build of the visitor implementations
+type PollTypeVisitor interface {
+	VisitPollTypeRegular(value *PollTypeRegular) error
+	VisitPollTypeQuiz(value *PollTypeQuiz) error
+}
+
+// VisitPollType calls the method of the visitor for the subtype of the value
+func VisitPollType(value PollType, visitor PollTypeVisitor) error {
+	switch value := value.(type) {
+	case *PollTypeRegular:
+		return visitor.VisitPollTypeRegular(value)
+
+	case *PollTypeQuiz:
+		return visitor.VisitPollTypeQuiz(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.PollTypeType())
+	}
+}
+
+// UserTypeVisitor has a method for every subtype of UserType. A new subtype breaks the build of the visitor implementations
+type UserTypeVisitor interface {
+	VisitUserTypeRegular(value *UserTypeRegular) error
+	VisitUserTypeDeleted(value *UserTypeDeleted) error
+	VisitUserTypeBot(value *UserTypeBot) error
+	VisitUserTypeUnknown(value *UserTypeUnknown) error
+}
+
+// VisitUserType calls the method of the visitor for the subtype of the value
+func VisitUserType(value UserType, visitor UserTypeVisitor) error {
+	switch value := value.(type) {
+	case *UserTypeRegular:
+		return visitor.VisitUserTypeRegular(value)
+
+	case *UserTypeDeleted:
+		return visitor.VisitUserTypeDeleted(value)
+
+	case *UserTypeBot:
+		return visitor.VisitUserTypeBot(value)
+
+	case *UserTypeUnknown:
+		return visitor.VisitUserTypeUnknown(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.UserTypeType())
+	}
+}
+
+// AccessHashTypeVisitor has a method for every subtype of AccessHashType. A new subtype breaks the build of the visitor implementations
+type AccessHashTypeVisitor interface {
+	VisitAccessHashTypeUser(value *AccessHashTypeUser) error
+	VisitAccessHashTypeChannel(value *AccessHashTypeChannel) error
+}
+
+// VisitAccessHashType calls the method of the visitor for the subtype of the value
+func VisitAccessHashType(value AccessHashType, visitor AccessHashTypeVisitor) error {
+	switch value := value.(type) {
+	case *AccessHashTypeUser:
+		return visitor.VisitAccessHashTypeUser(value)
+
+	case *AccessHashTypeChannel:
+		return visitor.VisitAccessHashTypeChannel(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.AccessHashTypeType())
+	}
+}
+
+// ChatPhotoStickerTypeVisitor has a method for every subtype of ChatPhotoStickerType. A new subtype breaks the build of the visitor implementations
+type ChatPhotoStickerTypeVisitor interface {
+	VisitChatPhotoStickerTypeRegularOrMask(value *ChatPhotoStickerTypeRegularOrMask) error
+	VisitChatPhotoStickerTypeCustomEmoji(value *ChatPhotoStickerTypeCustomEmoji) error
+}
+
+// VisitChatPhotoStickerType calls the method of the visitor for the subtype of the value
+func VisitChatPhotoStickerType(value ChatPhotoStickerType, visitor ChatPhotoStickerTypeVisitor) error {
+	switch value := value.(type) {
+	case *ChatPhotoStickerTypeRegularOrMask:
+		return visitor.VisitChatPhotoStickerTypeRegularOrMask(value)
+
+	case *ChatPhotoStickerTypeCustomEmoji:
+		return visitor.VisitChatPhotoStickerTypeCustomEmoji(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.ChatPhotoStickerTypeType())
+	}
+}
+
+// InputChatPhotoVisitor has a method for every subtype of InputChatPhoto. A new subtype breaks the build of the visitor implementations
+type InputChatPhotoVisitor interface {
+	VisitInputChatPhotoPrevious(value *InputChatPhotoPrevious) error
+	VisitInputChatPhotoStatic(value *InputChatPhotoStatic) error
+	VisitInputChatPhotoAnimation(value *InputChatPhotoAnimation) error
+	VisitInputChatPhotoSticker(value *InputChatPhotoSticker) error
+}
+
+// VisitInputChatPhoto calls the method of the visitor for the subtype of the value
+func VisitInputChatPhoto(value InputChatPhoto, visitor InputChatPhotoVisitor) error {
+	switch value := value.(type) {
+	case *InputChatPhotoPrevious:
+		return visitor.VisitInputChatPhotoPrevious(value)
+
+	case *InputChatPhotoStatic:
+		return visitor.VisitInputChatPhotoStatic(value)
+
+	case *InputChatPhotoAnimation:
+		return visitor.VisitInputChatPhotoAnimation(value)
+
+	case *InputChatPhotoSticker:
+		return visitor.VisitInputChatPhotoSticker(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.InputChatPhotoType())
+	}
+}
+
+// ChatMemberStatusVisitor has a method for every subtype of ChatMemberStatus. A new subtype breaks the build of the visitor implementations
+type ChatMemberStatusVisitor interface {
+	VisitChatMemberStatusCreator(value *ChatMemberStatusCreator) error
+	VisitChatMemberStatusAdministrator(value *ChatMemberStatusAdministrator) error
+	VisitChatMemberStatusMember(value *ChatMemberStatusMember) error
+	VisitChatMemberStatusRestricted(value *ChatMemberStatusRestricted) error
+	VisitChatMemberStatusLeft(value *ChatMemberStatusLeft) error
+	VisitChatMemberStatusBanned(value *ChatMemberStatusBanned) error
+}
+
+// VisitChatMemberStatus calls the method of the visitor for the subtype of the value
+func VisitChatMemberStatus(value ChatMemberStatus, visitor ChatMemberStatusVisitor) error {
+	switch value := value.(type) {
+	case *ChatMemberStatusCreator:
+		return visitor.VisitChatMemberStatusCreator(value)
+
+	case *ChatMemberStatusAdministrator:
+		return visitor.VisitChatMemberStatusAdministrator(value)
+
+	case *ChatMemberStatusMember:
+		return visitor.VisitChatMemberStatusMember(value)
+
+	case *ChatMemberStatusRestricted:
+		return visitor.VisitChatMemberStatusRestricted(value)
+
+	case *ChatMemberStatusLeft:
+		return visitor.VisitChatMemberStatusLeft(value)
+
+	case *ChatMemberStatusBanned:
+		return visitor.VisitChatMemberStatusBanned(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.ChatMemberStatusType())
+	}
+}
+
+// ChatMembersFilterVisitor has a method for every subtype of ChatMembersFilter. A new subtype breaks the build of the visitor implementations
+type ChatMembersFilterVisitor interface {
+	VisitChatMembersFilterContacts(value *ChatMembersFilterContacts) error
+	VisitChatMembersFilterAdministrators(value *ChatMembersFilterAdministrators) error
+	VisitChatMembersFilterMembers(value *ChatMembersFilterMembers) error
+	VisitChatMembersFilterMention(value *ChatMembersFilterMention) error
+	VisitChatMembersFilterRestricted(value *ChatMembersFilterRestricted) error
+	VisitChatMembersFilterBanned(value *ChatMembersFilterBanned) error
+	VisitChatMembersFilterBots(value *ChatMembersFilterBots) error
+}
+
+// VisitChatMembersFilter calls the method of the visitor for the subtype of the value
+func VisitChatMembersFilter(value ChatMembersFilter, visitor ChatMembersFilterVisitor) error {
+	switch value := value.(type) {
+	case *ChatMembersFilterContacts:
+		return visitor.VisitChatMembersFilterContacts(value)
+
+	case *ChatMembersFilterAdministrators:
+		return visitor.VisitChatMembersFilterAdministrators(value)
+
+	case *ChatMembersFilterMembers:
+		return visitor.VisitChatMembersFilterMembers(value)
+
+	case *ChatMembersFilterMention:
+		return visitor.VisitChatMembersFilterMention(value)
+
+	case *ChatMembersFilterRestricted:
+		return visitor.VisitChatMembersFilterRestricted(value)
+
+	case *ChatMembersFilterBanned:
+		return visitor.VisitChatMembersFilterBanned(value)
+
+	case *ChatMembersFilterBots:
+		return visitor.VisitChatMembersFilterBots(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.ChatMembersFilterType())
+	}
+}
+
+// SupergroupMembersFilterVisitor has a method for every subtype of SupergroupMembersFilter. A new subtype breaks the build of the visitor implementations
+type SupergroupMembersFilterVisitor interface {
+	VisitSupergroupMembersFilterRecent(value *SupergroupMembersFilterRecent) error
+	VisitSupergroupMembersFilterContacts(value *SupergroupMembersFilterContacts) error
+	VisitSupergroupMembersFilterAdministrators(value *SupergroupMembersFilterAdministrators) error
+	VisitSupergroupMembersFilterSearch(value *SupergroupMembersFilterSearch) error
+	VisitSupergroupMembersFilterRestricted(value *SupergroupMembersFilterRestricted) error
+	VisitSupergroupMembersFilterBanned(value *SupergroupMembersFilterBanned) error
+	VisitSupergroupMembersFilterMention(value *SupergroupMembersFilterMention) error
+	VisitSupergroupMembersFilterBots(value *SupergroupMembersFilterBots) error
+}
+
+// VisitSupergroupMembersFilter calls the method of the visitor for the subtype of the value
+func VisitSupergroupMembersFilter(value SupergroupMembersFilter, visitor SupergroupMembersFilterVisitor) error {
+	switch value := value.(type) {
+	case *SupergroupMembersFilterRecent:
+		return visitor.VisitSupergroupMembersFilterRecent(value)
+
+	case *SupergroupMembersFilterContacts:
+		return visitor.VisitSupergroupMembersFilterContacts(value)
+
+	case *SupergroupMembersFilterAdministrators:
+		return visitor.VisitSupergroupMembersFilterAdministrators(value)
+
+	case *SupergroupMembersFilterSearch:
+		return visitor.VisitSupergroupMembersFilterSearch(value)
+
+	case *SupergroupMembersFilterRestricted:
+		return visitor.VisitSupergroupMembersFilterRestricted(value)
+
+	case *SupergroupMembersFilterBanned:
+		return visitor.VisitSupergroupMembersFilterBanned(value)
+
+	case *SupergroupMembersFilterMention:
+		return visitor.VisitSupergroupMembersFilterMention(value)
+
+	case *SupergroupMembersFilterBots:
+		return visitor.VisitSupergroupMembersFilterBots(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.SupergroupMembersFilterType())
+	}
+}
+
+// SecretChatStateVisitor has a method for every subtype of SecretChatState. A new subtype breaks the build of the visitor implementations
+type SecretChatStateVisitor interface {
+	VisitSecretChatStatePending(value *SecretChatStatePending) error
+	VisitSecretChatStateReady(value *SecretChatStateReady) error
+	VisitSecretChatStateClosed(value *SecretChatStateClosed) error
+}
+
+// VisitSecretChatState calls the method of the visitor for the subtype of the value
+func VisitSecretChatState(value SecretChatState, visitor SecretChatStateVisitor) error {
+	switch value := value.(type) {
+	case *SecretChatStatePending:
+		return visitor.VisitSecretChatStatePending(value)
+
+	case *SecretChatStateReady:
+		return visitor.VisitSecretChatStateReady(value)
+
+	case *SecretChatStateClosed:
+		return visitor.VisitSecretChatStateClosed(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.SecretChatStateType())
+	}
+}
+
+// MessageSenderVisitor has a method for every subtype of MessageSender. A new subtype breaks the build of the visitor implementations
+type MessageSenderVisitor interface {
+	VisitMessageSenderUser(value *MessageSenderUser) error
+	VisitMessageSenderChat(value *MessageSenderChat) error
+}
+
+// VisitMessageSender calls the method of the visitor for the subtype of the value
+func VisitMessageSender(value MessageSender, visitor MessageSenderVisitor) error {
+	switch value := value.(type) {
+	case *MessageSenderUser:
+		return visitor.VisitMessageSenderUser(value)
+
+	case *MessageSenderChat:
+		return visitor.VisitMessageSenderChat(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.MessageSenderType())
+	}
+}
+
+// MessageForwardOriginVisitor has a method for every subtype of MessageForwardOrigin. A new subtype breaks the build of the visitor implementations
+type MessageForwardOriginVisitor interface {
+	VisitMessageForwardOriginUser(value *MessageForwardOriginUser) error
+	VisitMessageForwardOriginChat(value *MessageForwardOriginChat) error
+	VisitMessageForwardOriginHiddenUser(value *MessageForwardOriginHiddenUser) error
+	VisitMessageForwardOriginChannel(value *MessageForwardOriginChannel) error
+	VisitMessageForwardOriginMessageImport(value *MessageForwardOriginMessageImport) error
+}
+
+// VisitMessageForwardOrigin calls the method of the visitor for the subtype of the value
+func VisitMessageForwardOrigin(value MessageForwardOrigin, visitor MessageForwardOriginVisitor) error {
+	switch value := value.(type) {
+	case *MessageForwardOriginUser:
+		return visitor.VisitMessageForwardOriginUser(value)
+
+	case *MessageForwardOriginChat:
+		return visitor.VisitMessageForwardOriginChat(value)
+
+	case *MessageForwardOriginHiddenUser:
+		return visitor.VisitMessageForwardOriginHiddenUser(value)
+
+	case *MessageForwardOriginChannel:
+		return visitor.VisitMessageForwardOriginChannel(value)
+
+	case *MessageForwardOriginMessageImport:
+		return visitor.VisitMessageForwardOriginMessageImport(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.MessageForwardOriginType())
+	}
+}
+
+// ReactionTypeVisitor has a method for every subtype of ReactionType. A new subtype breaks the build of the visitor implementations
+type ReactionTypeVisitor interface {
+	VisitReactionTypeEmoji(value *ReactionTypeEmoji) error
+	VisitReactionTypeCustomEmoji(value *ReactionTypeCustomEmoji) error
+}
+
+// VisitReactionType calls the method of the visitor for the subtype of the value
+func VisitReactionType(value ReactionType, visitor ReactionTypeVisitor) error {
+	switch value := value.(type) {
+	case *ReactionTypeEmoji:
+		return visitor.VisitReactionTypeEmoji(value)
+
+	case *ReactionTypeCustomEmoji:
+		return visitor.VisitReactionTypeCustomEmoji(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.ReactionTypeType())
+	}
+}
+
+// MessageSendingStateVisitor has a method for every subtype of MessageSendingState. A new subtype breaks the build of the visitor implementations
+type MessageSendingStateVisitor interface {
+	VisitMessageSendingStatePending(value *MessageSendingStatePending) error
+	VisitMessageSendingStateFailed(value *MessageSendingStateFailed) error
+}
+
+// VisitMessageSendingState calls the method of the visitor for the subtype of the value
+func VisitMessageSendingState(value MessageSendingState, visitor MessageSendingStateVisitor) error {
+	switch value := value.(type) {
+	case *MessageSendingStatePending:
+		return visitor.VisitMessageSendingStatePending(value)
+
+	case *MessageSendingStateFailed:
+		return visitor.VisitMessageSendingStateFailed(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.MessageSendingStateType())
+	}
+}
+
+// MessageSourceVisitor has a method for every subtype of MessageSource. A new subtype breaks the build of the visitor implementations
+type MessageSourceVisitor interface {
+	VisitMessageSourceChatHistory(value *MessageSourceChatHistory) error
+	VisitMessageSourceMessageThreadHistory(value *MessageSourceMessageThreadHistory) error
+	VisitMessageSourceForumTopicHistory(value *MessageSourceForumTopicHistory) error
+	VisitMessageSourceHistoryPreview(value *MessageSourceHistoryPreview) error
+	VisitMessageSourceChatList(value *MessageSourceChatList) error
+	VisitMessageSourceSearch(value *MessageSourceSearch) error
+	VisitMessageSourceChatEventLog(value *MessageSourceChatEventLog) error
+	VisitMessageSourceNotification(value *MessageSourceNotification) error
+	VisitMessageSourceOther(value *MessageSourceOther) error
+}
+
+// VisitMessageSource calls the method of the visitor for the subtype of the value
+func VisitMessageSource(value MessageSource, visitor MessageSourceVisitor) error {
+	switch value := value.(type) {
+	case *MessageSourceChatHistory:
+		return visitor.VisitMessageSourceChatHistory(value)
+
+	case *MessageSourceMessageThreadHistory:
+		return visitor.VisitMessageSourceMessageThreadHistory(value)
+
+	case *MessageSourceForumTopicHistory:
+		return visitor.VisitMessageSourceForumTopicHistory(value)
+
+	case *MessageSourceHistoryPreview:
+		return visitor.VisitMessageSourceHistoryPreview(value)
+
+	case *MessageSourceChatList:
+		return visitor.VisitMessageSourceChatList(value)
+
+	case *MessageSourceSearch:
+		return visitor.VisitMessageSourceSearch(value)
+
+	case *MessageSourceChatEventLog:
+		return visitor.VisitMessageSourceChatEventLog(value)
+
+	case *MessageSourceNotification:
+		return visitor.VisitMessageSourceNotification(value)
+
+	case *MessageSourceOther:
+		return visitor.VisitMessageSourceOther(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.MessageSourceType())
+	}
+}
+
+// NotificationSettingsScopeVisitor has a method for every subtype of NotificationSettingsScope. A new subtype breaks the build of the visitor implementations
+type NotificationSettingsScopeVisitor interface {
+	VisitNotificationSettingsScopePrivateChats(value *NotificationSettingsScopePrivateChats) error
+	VisitNotificationSettingsScopeGroupChats(value *NotificationSettingsScopeGroupChats) error
+	VisitNotificationSettingsScopeChannelChats(value *NotificationSettingsScopeChannelChats) error
+}
+
+// VisitNotificationSettingsScope calls the method of the visitor for the subtype of the value
+func VisitNotificationSettingsScope(value NotificationSettingsScope, visitor NotificationSettingsScopeVisitor) error {
+	switch value := value.(type) {
+	case *NotificationSettingsScopePrivateChats:
+		return visitor.VisitNotificationSettingsScopePrivateChats(value)
+
+	case *NotificationSettingsScopeGroupChats:
+		return visitor.VisitNotificationSettingsScopeGroupChats(value)
+
+	case *NotificationSettingsScopeChannelChats:
+		return visitor.VisitNotificationSettingsScopeChannelChats(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.NotificationSettingsScopeType())
+	}
+}
+
+// ChatTypeVisitor has a method for every subtype of ChatType. A new subtype breaks the build of the visitor implementations
+type ChatTypeVisitor interface {
+	VisitChatTypePrivate(value *ChatTypePrivate) error
+	VisitChatTypeBasicGroup(value *ChatTypeBasicGroup) error
+	VisitChatTypeSupergroup(value *ChatTypeSupergroup) error
+	VisitChatTypeSecret(value *ChatTypeSecret) error
+}
+
+// VisitChatType calls the method of the visitor for the subtype of the value
+func VisitChatType(value ChatType, visitor ChatTypeVisitor) error {
+	switch value := value.(type) {
+	case *ChatTypePrivate:
+		return visitor.VisitChatTypePrivate(value)
+
+	case *ChatTypeBasicGroup:
+		return visitor.VisitChatTypeBasicGroup(value)
+
+	case *ChatTypeSupergroup:
+		return visitor.VisitChatTypeSupergroup(value)
+
+	case *ChatTypeSecret:
+		return visitor.VisitChatTypeSecret(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.ChatTypeType())
+	}
+}
+
+// ChatListVisitor has a method for every subtype of ChatList. A new subtype breaks the build of the visitor implementations
+type ChatListVisitor interface {
+	VisitChatListMain(value *ChatListMain) error
+	VisitChatListArchive(value *ChatListArchive) error
+	VisitChatListFolder(value *ChatListFolder) error
+}
+
+// VisitChatList calls the method of the visitor for the subtype of the value
+func VisitChatList(value ChatList, visitor ChatListVisitor) error {
+	switch value := value.(type) {
+	case *ChatListMain:
+		return visitor.VisitChatListMain(value)
+
+	case *ChatListArchive:
+		return visitor.VisitChatListArchive(value)
+
+	case *ChatListFolder:
+		return visitor.VisitChatListFolder(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.ChatListType())
+	}
+}
+
+// ChatSourceVisitor has a method for every subtype of ChatSource. A new subtype breaks the build of the visitor implementations
+type ChatSourceVisitor interface {
+	VisitChatSourceMtprotoProxy(value *ChatSourceMtprotoProxy) error
+	VisitChatSourcePublicServiceAnnouncement(value *ChatSourcePublicServiceAnnouncement) error
+}
+
+// VisitChatSource calls the method of the visitor for the subtype of the value
+func VisitChatSource(value ChatSource, visitor ChatSourceVisitor) error {
+	switch value := value.(type) {
+	case *ChatSourceMtprotoProxy:
+		return visitor.VisitChatSourceMtprotoProxy(value)
+
+	case *ChatSourcePublicServiceAnnouncement:
+		return visitor.VisitChatSourcePublicServiceAnnouncement(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.ChatSourceType())
+	}
+}
+
+// ChatAvailableReactionsVisitor has a method for every subtype of ChatAvailableReactions. A new subtype breaks the build of the visitor implementations
+type ChatAvailableReactionsVisitor interface {
+	VisitChatAvailableReactionsAll(value *ChatAvailableReactionsAll) error
+	VisitChatAvailableReactionsSome(value *ChatAvailableReactionsSome) error
+}
+
+// VisitChatAvailableReactions calls the method of the visitor for the subtype of the value
+func VisitChatAvailableReactions(value ChatAvailableReactions, visitor ChatAvailableReactionsVisitor) error {
+	switch value := value.(type) {
+	case *ChatAvailableReactionsAll:
+		return visitor.VisitChatAvailableReactionsAll(value)
+
+	case *ChatAvailableReactionsSome:
+		return visitor.VisitChatAvailableReactionsSome(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.ChatAvailableReactionsType())
+	}
+}
+
+// PublicChatTypeVisitor has a method for every subtype of PublicChatType. A new subtype breaks the build of the visitor implementations
+type PublicChatTypeVisitor interface {
+	VisitPublicChatTypeHasUsername(value *PublicChatTypeHasUsername) error
+	VisitPublicChatTypeIsLocationBased(value *PublicChatTypeIsLocationBased) error
+}
+
+// VisitPublicChatType calls the method of the visitor for the subtype of the value
+func VisitPublicChatType(value PublicChatType, visitor PublicChatTypeVisitor) error {
+	switch value := value.(type) {
+	case *PublicChatTypeHasUsername:
+		return visitor.VisitPublicChatTypeHasUsername(value)
+
+	case *PublicChatTypeIsLocationBased:
+		return visitor.VisitPublicChatTypeIsLocationBased(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.PublicChatTypeType())
+	}
+}
+
+// ChatActionBarVisitor has a method for every subtype of ChatActionBar. A new subtype breaks the build of the visitor implementations
+type ChatActionBarVisitor interface {
+	VisitChatActionBarReportSpam(value *ChatActionBarReportSpam) error
+	VisitChatActionBarReportUnrelatedLocation(value *ChatActionBarReportUnrelatedLocation) error
+	VisitChatActionBarInviteMembers(value *ChatActionBarInviteMembers) error
+	VisitChatActionBarReportAddBlock(value *ChatActionBarReportAddBlock) error
+	VisitChatActionBarAddContact(value *ChatActionBarAddContact) error
+	VisitChatActionBarSharePhoneNumber(value *ChatActionBarSharePhoneNumber) error
+	VisitChatActionBarJoinRequest(value *ChatActionBarJoinRequest) error
+}
+
+// VisitChatActionBar calls the method of the visitor for the subtype of the value
+func VisitChatActionBar(value ChatActionBar, visitor ChatActionBarVisitor) error {
+	switch value := value.(type) {
+	case *ChatActionBarReportSpam:
+		return visitor.VisitChatActionBarReportSpam(value)
+
+	case *ChatActionBarReportUnrelatedLocation:
+		return visitor.VisitChatActionBarReportUnrelatedLocation(value)
+
+	case *ChatActionBarInviteMembers:
+		return visitor.VisitChatActionBarInviteMembers(value)
+
+	case *ChatActionBarReportAddBlock:
+		return visitor.VisitChatActionBarReportAddBlock(value)
+
+	case *ChatActionBarAddContact:
+		return visitor.VisitChatActionBarAddContact(value)
+
+	case *ChatActionBarSharePhoneNumber:
+		return visitor.VisitChatActionBarSharePhoneNumber(value)
+
+	case *ChatActionBarJoinRequest:
+		return visitor.VisitChatActionBarJoinRequest(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.ChatActionBarType())
+	}
+}
+
+// KeyboardButtonTypeVisitor has a method for every subtype of KeyboardButtonType. A new subtype breaks the build of the visitor implementations
+type KeyboardButtonTypeVisitor interface {
+	VisitKeyboardButtonTypeText(value *KeyboardButtonTypeText) error
+	VisitKeyboardButtonTypeRequestPhoneNumber(value *KeyboardButtonTypeRequestPhoneNumber) error
+	VisitKeyboardButtonTypeRequestLocation(value *KeyboardButtonTypeRequestLocation) error
+	VisitKeyboardButtonTypeRequestPoll(value *KeyboardButtonTypeRequestPoll) error
+	VisitKeyboardButtonTypeRequestUser(value *KeyboardButtonTypeRequestUser) error
+	VisitKeyboardButtonTypeRequestChat(value *KeyboardButtonTypeRequestChat) error
+	VisitKeyboardButtonTypeWebApp(value *KeyboardButtonTypeWebApp) error
+}
+
+// VisitKeyboardButtonType calls the method of the visitor for the subtype of the value
+func VisitKeyboardButtonType(value KeyboardButtonType, visitor KeyboardButtonTypeVisitor) error {
+	switch value := value.(type) {
+	case *KeyboardButtonTypeText:
+		return visitor.VisitKeyboardButtonTypeText(value)
+
+	case *KeyboardButtonTypeRequestPhoneNumber:
+		return visitor.VisitKeyboardButtonTypeRequestPhoneNumber(value)
+
+	case *KeyboardButtonTypeRequestLocation:
+		return visitor.VisitKeyboardButtonTypeRequestLocation(value)
+
+	case *KeyboardButtonTypeRequestPoll:
+		return visitor.VisitKeyboardButtonTypeRequestPoll(value)
+
+	case *KeyboardButtonTypeRequestUser:
+		return visitor.VisitKeyboardButtonTypeRequestUser(value)
+
+	case *KeyboardButtonTypeRequestChat:
+		return visitor.VisitKeyboardButtonTypeRequestChat(value)
+
+	case *KeyboardButtonTypeWebApp:
+		return visitor.VisitKeyboardButtonTypeWebApp(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.KeyboardButtonTypeType())
+	}
+}
+
+// InlineKeyboardButtonTypeVisitor has a method for every subtype of InlineKeyboardButtonType. A new subtype breaks the build of the visitor implementations
+type InlineKeyboardButtonTypeVisitor interface {
+	VisitInlineKeyboardButtonTypeUrl(value *InlineKeyboardButtonTypeUrl) error
+	VisitInlineKeyboardButtonTypeLoginUrl(value *InlineKeyboardButtonTypeLoginUrl) error
+	VisitInlineKeyboardButtonTypeWebApp(value *InlineKeyboardButtonTypeWebApp) error
+	VisitInlineKeyboardButtonTypeCallback(value *InlineKeyboardButtonTypeCallback) error
+	VisitInlineKeyboardButtonTypeCallbackWithPassword(value *InlineKeyboardButtonTypeCallbackWithPassword) error
+	VisitInlineKeyboardButtonTypeCallbackGame(value *InlineKeyboardButtonTypeCallbackGame) error
+	VisitInlineKeyboardButtonTypeSwitchInline(value *InlineKeyboardButtonTypeSwitchInline) error
+	VisitInlineKeyboardButtonTypeBuy(value *InlineKeyboardButtonTypeBuy) error
+	VisitInlineKeyboardButtonTypeUser(value *InlineKeyboardButtonTypeUser) error
+}
+
+// VisitInlineKeyboardButtonType calls the method of the visitor for the subtype of the value
+func VisitInlineKeyboardButtonType(value InlineKeyboardButtonType, visitor InlineKeyboardButtonTypeVisitor) error {
+	switch value := value.(type) {
+	case *InlineKeyboardButtonTypeUrl:
+		return visitor.VisitInlineKeyboardButtonTypeUrl(value)
+
+	case *InlineKeyboardButtonTypeLoginUrl:
+		return visitor.VisitInlineKeyboardButtonTypeLoginUrl(value)
+
+	case *InlineKeyboardButtonTypeWebApp:
+		return visitor.VisitInlineKeyboardButtonTypeWebApp(value)
+
+	case *InlineKeyboardButtonTypeCallback:
+		return visitor.VisitInlineKeyboardButtonTypeCallback(value)
+
+	case *InlineKeyboardButtonTypeCallbackWithPassword:
+		return visitor.VisitInlineKeyboardButtonTypeCallbackWithPassword(value)
+
+	case *InlineKeyboardButtonTypeCallbackGame:
+		return visitor.VisitInlineKeyboardButtonTypeCallbackGame(value)
+
+	case *InlineKeyboardButtonTypeSwitchInline:
+		return visitor.VisitInlineKeyboardButtonTypeSwitchInline(value)
+
+	case *InlineKeyboardButtonTypeBuy:
+		return visitor.VisitInlineKeyboardButtonTypeBuy(value)
+
+	case *InlineKeyboardButtonTypeUser:
+		return visitor.VisitInlineKeyboardButtonTypeUser(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.InlineKeyboardButtonTypeType())
+	}
+}
+
+// ReplyMarkupVisitor has a method for every subtype of ReplyMarkup. A new subtype breaks the build of the visitor implementations
+type ReplyMarkupVisitor interface {
+	VisitReplyMarkupRemoveKeyboard(value *ReplyMarkupRemoveKeyboard) error
+	VisitReplyMarkupForceReply(value *ReplyMarkupForceReply) error
+	VisitReplyMarkupShowKeyboard(value *ReplyMarkupShowKeyboard) error
+	VisitReplyMarkupInlineKeyboard(value *ReplyMarkupInlineKeyboard) error
+}
+
+// VisitReplyMarkup calls the method of the visitor for the subtype of the value
+func VisitReplyMarkup(value ReplyMarkup, visitor ReplyMarkupVisitor) error {
+	switch value := value.(type) {
+	case *ReplyMarkupRemoveKeyboard:
+		return visitor.VisitReplyMarkupRemoveKeyboard(value)
+
+	case *ReplyMarkupForceReply:
+		return visitor.VisitReplyMarkupForceReply(value)
+
+	case *ReplyMarkupShowKeyboard:
+		return visitor.VisitReplyMarkupShowKeyboard(value)
+
+	case *ReplyMarkupInlineKeyboard:
+		return visitor.VisitReplyMarkupInlineKeyboard(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.ReplyMarkupType())
+	}
+}
+
+// LoginUrlInfoVisitor has a method for every subtype of LoginUrlInfo. A new subtype breaks the build of the visitor implementations
+type LoginUrlInfoVisitor interface {
+	VisitLoginUrlInfoOpen(value *LoginUrlInfoOpen) error
+	VisitLoginUrlInfoRequestConfirmation(value *LoginUrlInfoRequestConfirmation) error
+}
+
+// VisitLoginUrlInfo calls the method of the visitor for the subtype of the value
+func VisitLoginUrlInfo(value LoginUrlInfo, visitor LoginUrlInfoVisitor) error {
+	switch value := value.(type) {
+	case *LoginUrlInfoOpen:
+		return visitor.VisitLoginUrlInfoOpen(value)
+
+	case *LoginUrlInfoRequestConfirmation:
+		return visitor.VisitLoginUrlInfoRequestConfirmation(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.LoginUrlInfoType())
+	}
+}
+
+// RichTextVisitor has a method for every subtype of RichText. A new subtype breaks the build of the visitor implementations
+type RichTextVisitor interface {
+	VisitRichTextPlain(value *RichTextPlain) error
+	VisitRichTextBold(value *RichTextBold) error
+	VisitRichTextItalic(value *RichTextItalic) error
+	VisitRichTextUnderline(value *RichTextUnderline) error
+	VisitRichTextStrikethrough(value *RichTextStrikethrough) error
+	VisitRichTextFixed(value *RichTextFixed) error
+	VisitRichTextUrl(value *RichTextUrl) error
+	VisitRichTextEmailAddress(value *RichTextEmailAddress) error
+	VisitRichTextSubscript(value *RichTextSubscript) error
+	VisitRichTextSuperscript(value *RichTextSuperscript) error
+	VisitRichTextMarked(value *RichTextMarked) error
+	VisitRichTextPhoneNumber(value *RichTextPhoneNumber) error
+	VisitRichTextIcon(value *RichTextIcon) error
+	VisitRichTextReference(value *RichTextReference) error
+	VisitRichTextAnchor(value *RichTextAnchor) error
+	VisitRichTextAnchorLink(value *RichTextAnchorLink) error
+	VisitRichTexts(value *RichTexts) error
+}
+
+// VisitRichText calls the method of the visitor for the subtype of the value
+func VisitRichText(value RichText, visitor RichTextVisitor) error {
+	switch value := value.(type) {
+	case *RichTextPlain:
+		return visitor.VisitRichTextPlain(value)
+
+	case *RichTextBold:
+		return visitor.VisitRichTextBold(value)
+
+	case *RichTextItalic:
+		return visitor.VisitRichTextItalic(value)
+
+	case *RichTextUnderline:
+		return visitor.VisitRichTextUnderline(value)
+
+	case *RichTextStrikethrough:
+		return visitor.VisitRichTextStrikethrough(value)
+
+	case *RichTextFixed:
+		return visitor.VisitRichTextFixed(value)
+
+	case *RichTextUrl:
+		return visitor.VisitRichTextUrl(value)
+
+	case *RichTextEmailAddress:
+		return visitor.VisitRichTextEmailAddress(value)
+
+	case *RichTextSubscript:
+		return visitor.VisitRichTextSubscript(value)
+
+	case *RichTextSuperscript:
+		return visitor.VisitRichTextSuperscript(value)
+
+	case *RichTextMarked:
+		return visitor.VisitRichTextMarked(value)
+
+	case *RichTextPhoneNumber:
+		return visitor.VisitRichTextPhoneNumber(value)
+
+	case *RichTextIcon:
+		return visitor.VisitRichTextIcon(value)
+
+	case *RichTextReference:
+		return visitor.VisitRichTextReference(value)
+
+	case *RichTextAnchor:
+		return visitor.VisitRichTextAnchor(value)
+
+	case *RichTextAnchorLink:
+		return visitor.VisitRichTextAnchorLink(value)
+
+	case *RichTexts:
+		return visitor.VisitRichTexts(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.RichTextType())
+	}
+}
+
+// PageBlockHorizontalAlignmentVisitor has a method for every subtype of PageBlockHorizontalAlignment. A new subtype breaks the build of the visitor implementations
+type PageBlockHorizontalAlignmentVisitor interface {
+	VisitPageBlockHorizontalAlignmentLeft(value *PageBlockHorizontalAlignmentLeft) error
+	VisitPageBlockHorizontalAlignmentCenter(value *PageBlockHorizontalAlignmentCenter) error
+	VisitPageBlockHorizontalAlignmentRight(value *PageBlockHorizontalAlignmentRight) error
+}
+
+// VisitPageBlockHorizontalAlignment calls the method of the visitor for the subtype of the value
+func VisitPageBlockHorizontalAlignment(value PageBlockHorizontalAlignment, visitor PageBlockHorizontalAlignmentVisitor) error {
+	switch value := value.(type) {
+	case *PageBlockHorizontalAlignmentLeft:
+		return visitor.VisitPageBlockHorizontalAlignmentLeft(value)
+
+	case *PageBlockHorizontalAlignmentCenter:
+		return visitor.VisitPageBlockHorizontalAlignmentCenter(value)
+
+	case *PageBlockHorizontalAlignmentRight:
+		return visitor.VisitPageBlockHorizontalAlignmentRight(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.PageBlockHorizontalAlignmentType())
+	}
+}
+
+// PageBlockVerticalAlignmentVisitor has a method for every subtype of PageBlockVerticalAlignment. A new subtype breaks the build of the visitor implementations
+type PageBlockVerticalAlignmentVisitor interface {
+	VisitPageBlockVerticalAlignmentTop(value *PageBlockVerticalAlignmentTop) error
+	VisitPageBlockVerticalAlignmentMiddle(value *PageBlockVerticalAlignmentMiddle) error
+	VisitPageBlockVerticalAlignmentBottom(value *PageBlockVerticalAlignmentBottom) error
+}
+
+// VisitPageBlockVerticalAlignment calls the method of the visitor for the subtype of the value
+func VisitPageBlockVerticalAlignment(value PageBlockVerticalAlignment, visitor PageBlockVerticalAlignmentVisitor) error {
+	switch value := value.(type) {
+	case *PageBlockVerticalAlignmentTop:
+		return visitor.VisitPageBlockVerticalAlignmentTop(value)
+
+	case *PageBlockVerticalAlignmentMiddle:
+		return visitor.VisitPageBlockVerticalAlignmentMiddle(value)
+
+	case *PageBlockVerticalAlignmentBottom:
+		return visitor.VisitPageBlockVerticalAlignmentBottom(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.PageBlockVerticalAlignmentType())
+	}
+}
+
+// PageBlockVisitor has a method for every subtype of PageBlock. A new subtype breaks the build of the visitor implementations
+type PageBlockVisitor interface {
+	VisitPageBlockTitle(value *PageBlockTitle) error
+	VisitPageBlockSubtitle(value *PageBlockSubtitle) error
+	VisitPageBlockAuthorDate(value *PageBlockAuthorDate) error
+	VisitPageBlockHeader(value *PageBlockHeader) error
+	VisitPageBlockSubheader(value *PageBlockSubheader) error
+	VisitPageBlockKicker(value *PageBlockKicker) error
+	VisitPageBlockParagraph(value *PageBlockParagraph) error
+	VisitPageBlockPreformatted(value *PageBlockPreformatted) error
+	VisitPageBlockFooter(value *PageBlockFooter) error
+	VisitPageBlockDivider(value *PageBlockDivider) error
+	VisitPageBlockAnchor(value *PageBlockAnchor) error
+	VisitPageBlockList(value *PageBlockList) error
+	VisitPageBlockBlockQuote(value *PageBlockBlockQuote) error
+	VisitPageBlockPullQuote(value *PageBlockPullQuote) error
+	VisitPageBlockAnimation(value *PageBlockAnimation) error
+	VisitPageBlockAudio(value *PageBlockAudio) error
+	VisitPageBlockPhoto(value *PageBlockPhoto) error
+	VisitPageBlockVideo(value *PageBlockVideo) error
+	VisitPageBlockVoiceNote(value *PageBlockVoiceNote) error
+	VisitPageBlockCover(value *PageBlockCover) error
+	VisitPageBlockEmbedded(value *PageBlockEmbedded) error
+	VisitPageBlockEmbeddedPost(value *PageBlockEmbeddedPost) error
+	VisitPageBlockCollage(value *PageBlockCollage) error
+	VisitPageBlockSlideshow(value *PageBlockSlideshow) error
+	VisitPageBlockChatLink(value *PageBlockChatLink) error
+	VisitPageBlockTable(value *PageBlockTable) error
+	VisitPageBlockDetails(value *PageBlockDetails) error
+	VisitPageBlockRelatedArticles(value *PageBlockRelatedArticles) error
+	VisitPageBlockMap(value *PageBlockMap) error
+}
+
+// VisitPageBlock calls the method of the visitor for the subtype of the value
+func VisitPageBlock(value PageBlock, visitor PageBlockVisitor) error {
+	switch value := value.(type) {
+	case *PageBlockTitle:
+		return visitor.VisitPageBlockTitle(value)
+
+	case *PageBlockSubtitle:
+		return visitor.VisitPageBlockSubtitle(value)
+
+	case *PageBlockAuthorDate:
+		return visitor.VisitPageBlockAuthorDate(value)
+
+	case *PageBlockHeader:
+		return visitor.VisitPageBlockHeader(value)
+
+	case *PageBlockSubheader:
+		return visitor.VisitPageBlockSubheader(value)
+
+	case *PageBlockKicker:
+		return visitor.VisitPageBlockKicker(value)
+
+	case *PageBlockParagraph:
+		return visitor.VisitPageBlockParagraph(value)
+
+	case *PageBlockPreformatted:
+		return visitor.VisitPageBlockPreformatted(value)
+
+	case *PageBlockFooter:
+		return visitor.VisitPageBlockFooter(value)
+
+	case *PageBlockDivider:
+		return visitor.VisitPageBlockDivider(value)
+
+	case *PageBlockAnchor:
+		return visitor.VisitPageBlockAnchor(value)
+
+	case *PageBlockList:
+		return visitor.VisitPageBlockList(value)
+
+	case *PageBlockBlockQuote:
+		return visitor.VisitPageBlockBlockQuote(value)
+
+	case *PageBlockPullQuote:
+		return visitor.VisitPageBlockPullQuote(value)
+
+	case *PageBlockAnimation:
+		return visitor.VisitPageBlockAnimation(value)
+
+	case *PageBlockAudio:
+		return visitor.VisitPageBlockAudio(value)
+
+	case *PageBlockPhoto:
+		return visitor.VisitPageBlockPhoto(value)
+
+	case *PageBlockVideo:
+		return visitor.VisitPageBlockVideo(value)
+
+	case *PageBlockVoiceNote:
+		return visitor.VisitPageBlockVoiceNote(value)
+
+	case *PageBlockCover:
+		return visitor.VisitPageBlockCover(value)
+
+	case *PageBlockEmbedded:
+		return visitor.VisitPageBlockEmbedded(value)
+
+	case *PageBlockEmbeddedPost:
+		return visitor.VisitPageBlockEmbeddedPost(value)
+
+	case *PageBlockCollage:
+		return visitor.VisitPageBlockCollage(value)
+
+	case *PageBlockSlideshow:
+		return visitor.VisitPageBlockSlideshow(value)
+
+	case *PageBlockChatLink:
+		return visitor.VisitPageBlockChatLink(value)
+
+	case *PageBlockTable:
+		return visitor.VisitPageBlockTable(value)
+
+	case *PageBlockDetails:
+		return visitor.VisitPageBlockDetails(value)
+
+	case *PageBlockRelatedArticles:
+		return visitor.VisitPageBlockRelatedArticles(value)
+
+	case *PageBlockMap:
+		return visitor.VisitPageBlockMap(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.PageBlockType())
+	}
+}
+
+// InputCredentialsVisitor has a method for every subtype of InputCredentials. A new subtype breaks the build of the visitor implementations
+type InputCredentialsVisitor interface {
+	VisitInputCredentialsSaved(value *InputCredentialsSaved) error
+	VisitInputCredentialsNew(value *InputCredentialsNew) error
+	VisitInputCredentialsApplePay(value *InputCredentialsApplePay) error
+	VisitInputCredentialsGooglePay(value *InputCredentialsGooglePay) error
+}
+
+// VisitInputCredentials calls the method of the visitor for the subtype of the value
+func VisitInputCredentials(value InputCredentials, visitor InputCredentialsVisitor) error {
+	switch value := value.(type) {
+	case *InputCredentialsSaved:
+		return visitor.VisitInputCredentialsSaved(value)
+
+	case *InputCredentialsNew:
+		return visitor.VisitInputCredentialsNew(value)
+
+	case *InputCredentialsApplePay:
+		return visitor.VisitInputCredentialsApplePay(value)
+
+	case *InputCredentialsGooglePay:
+		return visitor.VisitInputCredentialsGooglePay(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.InputCredentialsType())
+	}
+}
+
+// PaymentProviderVisitor has a method for every subtype of PaymentProvider. A new subtype breaks the build of the visitor implementations
+type PaymentProviderVisitor interface {
+	VisitPaymentProviderSmartGlocal(value *PaymentProviderSmartGlocal) error
+	VisitPaymentProviderStripe(value *PaymentProviderStripe) error
+	VisitPaymentProviderOther(value *PaymentProviderOther) error
+}
+
+// VisitPaymentProvider calls the method of the visitor for the subtype of the value
+func VisitPaymentProvider(value PaymentProvider, visitor PaymentProviderVisitor) error {
+	switch value := value.(type) {
+	case *PaymentProviderSmartGlocal:
+		return visitor.VisitPaymentProviderSmartGlocal(value)
+
+	case *PaymentProviderStripe:
+		return visitor.VisitPaymentProviderStripe(value)
+
+	case *PaymentProviderOther:
+		return visitor.VisitPaymentProviderOther(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.PaymentProviderType())
+	}
+}
+
+// InputInvoiceVisitor has a method for every subtype of InputInvoice. A new subtype breaks the build of the visitor implementations
+type InputInvoiceVisitor interface {
+	VisitInputInvoiceMessage(value *InputInvoiceMessage) error
+	VisitInputInvoiceName(value *InputInvoiceName) error
+}
+
+// VisitInputInvoice calls the method of the visitor for the subtype of the value
+func VisitInputInvoice(value InputInvoice, visitor InputInvoiceVisitor) error {
+	switch value := value.(type) {
+	case *InputInvoiceMessage:
+		return visitor.VisitInputInvoiceMessage(value)
+
+	case *InputInvoiceName:
+		return visitor.VisitInputInvoiceName(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.InputInvoiceType())
+	}
+}
+
+// MessageExtendedMediaVisitor has a method for every subtype of MessageExtendedMedia. A new subtype breaks the build of the visitor implementations
+type MessageExtendedMediaVisitor interface {
+	VisitMessageExtendedMediaPreview(value *MessageExtendedMediaPreview) error
+	VisitMessageExtendedMediaPhoto(value *MessageExtendedMediaPhoto) error
+	VisitMessageExtendedMediaVideo(value *MessageExtendedMediaVideo) error
+	VisitMessageExtendedMediaUnsupported(value *MessageExtendedMediaUnsupported) error
+}
+
+// VisitMessageExtendedMedia calls the method of the visitor for the subtype of the value
+func VisitMessageExtendedMedia(value MessageExtendedMedia, visitor MessageExtendedMediaVisitor) error {
+	switch value := value.(type) {
+	case *MessageExtendedMediaPreview:
+		return visitor.VisitMessageExtendedMediaPreview(value)
+
+	case *MessageExtendedMediaPhoto:
+		return visitor.VisitMessageExtendedMediaPhoto(value)
+
+	case *MessageExtendedMediaVideo:
+		return visitor.VisitMessageExtendedMediaVideo(value)
+
+	case *MessageExtendedMediaUnsupported:
+		return visitor.VisitMessageExtendedMediaUnsupported(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.MessageExtendedMediaType())
+	}
+}
+
+// PassportElementTypeVisitor has a method for every subtype of PassportElementType. A new subtype breaks the build of the visitor implementations
+type PassportElementTypeVisitor interface {
+	VisitPassportElementTypePersonalDetails(value *PassportElementTypePersonalDetails) error
+	VisitPassportElementTypePassport(value *PassportElementTypePassport) error
+	VisitPassportElementTypeDriverLicense(value *PassportElementTypeDriverLicense) error
+	VisitPassportElementTypeIdentityCard(value *PassportElementTypeIdentityCard) error
+	VisitPassportElementTypeInternalPassport(value *PassportElementTypeInternalPassport) error
+	VisitPassportElementTypeAddress(value *PassportElementTypeAddress) error
+	VisitPassportElementTypeUtilityBill(value *PassportElementTypeUtilityBill) error
+	VisitPassportElementTypeBankStatement(value *PassportElementTypeBankStatement) error
+	VisitPassportElementTypeRentalAgreement(value *PassportElementTypeRentalAgreement) error
+	VisitPassportElementTypePassportRegistration(value *PassportElementTypePassportRegistration) error
+	VisitPassportElementTypeTemporaryRegistration(value *PassportElementTypeTemporaryRegistration) error
+	VisitPassportElementTypePhoneNumber(value *PassportElementTypePhoneNumber) error
+	VisitPassportElementTypeEmailAddress(value *PassportElementTypeEmailAddress) error
+}
+
+// VisitPassportElementType calls the method of the visitor for the subtype of the value
+func VisitPassportElementType(value PassportElementType, visitor PassportElementTypeVisitor) error {
+	switch value := value.(type) {
+	case *PassportElementTypePersonalDetails:
+		return visitor.VisitPassportElementTypePersonalDetails(value)
+
+	case *PassportElementTypePassport:
+		return visitor.VisitPassportElementTypePassport(value)
+
+	case *PassportElementTypeDriverLicense:
+		return visitor.VisitPassportElementTypeDriverLicense(value)
+
+	case *PassportElementTypeIdentityCard:
+		return visitor.VisitPassportElementTypeIdentityCard(value)
+
+	case *PassportElementTypeInternalPassport:
+		return visitor.VisitPassportElementTypeInternalPassport(value)
+
+	case *PassportElementTypeAddress:
+		return visitor.VisitPassportElementTypeAddress(value)
+
+	case *PassportElementTypeUtilityBill:
+		return visitor.VisitPassportElementTypeUtilityBill(value)
+
+	case *PassportElementTypeBankStatement:
+		return visitor.VisitPassportElementTypeBankStatement(value)
+
+	case *PassportElementTypeRentalAgreement:
+		return visitor.VisitPassportElementTypeRentalAgreement(value)
+
+	case *PassportElementTypePassportRegistration:
+		return visitor.VisitPassportElementTypePassportRegistration(value)
+
+	case *PassportElementTypeTemporaryRegistration:
+		return visitor.VisitPassportElementTypeTemporaryRegistration(value)
+
+	case *PassportElementTypePhoneNumber:
+		return visitor.VisitPassportElementTypePhoneNumber(value)
+
+	case *PassportElementTypeEmailAddress:
+		return visitor.VisitPassportElementTypeEmailAddress(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.PassportElementTypeType())
+	}
+}
+
+// PassportElementVisitor has a method for every subtype of PassportElement. A new subtype breaks the build of the visitor implementations
+type PassportElementVisitor interface {
+	VisitPassportElementPersonalDetails(value *PassportElementPersonalDetails) error
+	VisitPassportElementPassport(value *PassportElementPassport) error
+	VisitPassportElementDriverLicense(value *PassportElementDriverLicense) error
+	VisitPassportElementIdentityCard(value *PassportElementIdentityCard) error
+	VisitPassportElementInternalPassport(value *PassportElementInternalPassport) error
+	VisitPassportElementAddress(value *PassportElementAddress) error
+	VisitPassportElementUtilityBill(value *PassportElementUtilityBill) error
+	VisitPassportElementBankStatement(value *PassportElementBankStatement) error
+	VisitPassportElementRentalAgreement(value *PassportElementRentalAgreement) error
+	VisitPassportElementPassportRegistration(value *PassportElementPassportRegistration) error
+	VisitPassportElementTemporaryRegistration(value *PassportElementTemporaryRegistration) error
+	VisitPassportElementPhoneNumber(value *PassportElementPhoneNumber) error
+	VisitPassportElementEmailAddress(value *PassportElementEmailAddress) error
+}
+
+// VisitPassportElement calls the method of the visitor for the subtype of the value
+func VisitPassportElement(value PassportElement, visitor PassportElementVisitor) error {
+	switch value := value.(type) {
+	case *PassportElementPersonalDetails:
+		return visitor.VisitPassportElementPersonalDetails(value)
+
+	case *PassportElementPassport:
+		return visitor.VisitPassportElementPassport(value)
+
+	case *PassportElementDriverLicense:
+		return visitor.VisitPassportElementDriverLicense(value)
+
+	case *PassportElementIdentityCard:
+		return visitor.VisitPassportElementIdentityCard(value)
+
+	case *PassportElementInternalPassport:
+		return visitor.VisitPassportElementInternalPassport(value)
+
+	case *PassportElementAddress:
+		return visitor.VisitPassportElementAddress(value)
+
+	case *PassportElementUtilityBill:
+		return visitor.VisitPassportElementUtilityBill(value)
+
+	case *PassportElementBankStatement:
+		return visitor.VisitPassportElementBankStatement(value)
+
+	case *PassportElementRentalAgreement:
+		return visitor.VisitPassportElementRentalAgreement(value)
+
+	case *PassportElementPassportRegistration:
+		return visitor.VisitPassportElementPassportRegistration(value)
+
+	case *PassportElementTemporaryRegistration:
+		return visitor.VisitPassportElementTemporaryRegistration(value)
+
+	case *PassportElementPhoneNumber:
+		return visitor.VisitPassportElementPhoneNumber(value)
+
+	case *PassportElementEmailAddress:
+		return visitor.VisitPassportElementEmailAddress(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.PassportElementType())
+	}
+}
+
+// InputPassportElementVisitor has a method for every subtype of InputPassportElement. A new subtype breaks the build of the visitor implementations
+type InputPassportElementVisitor interface {
+	VisitInputPassportElementPersonalDetails(value *InputPassportElementPersonalDetails) error
+	VisitInputPassportElementPassport(value *InputPassportElementPassport) error
+	VisitInputPassportElementDriverLicense(value *InputPassportElementDriverLicense) error
+	VisitInputPassportElementIdentityCard(value *InputPassportElementIdentityCard) error
+	VisitInputPassportElementInternalPassport(value *InputPassportElementInternalPassport) error
+	VisitInputPassportElementAddress(value *InputPassportElementAddress) error
+	VisitInputPassportElementUtilityBill(value *InputPassportElementUtilityBill) error
+	VisitInputPassportElementBankStatement(value *InputPassportElementBankStatement) error
+	VisitInputPassportElementRentalAgreement(value *InputPassportElementRentalAgreement) error
+	VisitInputPassportElementPassportRegistration(value *InputPassportElementPassportRegistration) error
+	VisitInputPassportElementTemporaryRegistration(value *InputPassportElementTemporaryRegistration) error
+	VisitInputPassportElementPhoneNumber(value *InputPassportElementPhoneNumber) error
+	VisitInputPassportElementEmailAddress(value *InputPassportElementEmailAddress) error
+}
+
+// VisitInputPassportElement calls the method of the visitor for the subtype of the value
+func VisitInputPassportElement(value InputPassportElement, visitor InputPassportElementVisitor) error {
+	switch value := value.(type) {
+	case *InputPassportElementPersonalDetails:
+		return visitor.VisitInputPassportElementPersonalDetails(value)
+
+	case *InputPassportElementPassport:
+		return visitor.VisitInputPassportElementPassport(value)
+
+	case *InputPassportElementDriverLicense:
+		return visitor.VisitInputPassportElementDriverLicense(value)
+
+	case *InputPassportElementIdentityCard:
+		return visitor.VisitInputPassportElementIdentityCard(value)
+
+	case *InputPassportElementInternalPassport:
+		return visitor.VisitInputPassportElementInternalPassport(value)
+
+	case *InputPassportElementAddress:
+		return visitor.VisitInputPassportElementAddress(value)
+
+	case *InputPassportElementUtilityBill:
+		return visitor.VisitInputPassportElementUtilityBill(value)
+
+	case *InputPassportElementBankStatement:
+		return visitor.VisitInputPassportElementBankStatement(value)
+
+	case *InputPassportElementRentalAgreement:
+		return visitor.VisitInputPassportElementRentalAgreement(value)
+
+	case *InputPassportElementPassportRegistration:
+		return visitor.VisitInputPassportElementPassportRegistration(value)
+
+	case *InputPassportElementTemporaryRegistration:
+		return visitor.VisitInputPassportElementTemporaryRegistration(value)
+
+	case *InputPassportElementPhoneNumber:
+		return visitor.VisitInputPassportElementPhoneNumber(value)
+
+	case *InputPassportElementEmailAddress:
+		return visitor.VisitInputPassportElementEmailAddress(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.InputPassportElementType())
+	}
+}
+
+// PassportElementErrorSourceVisitor has a method for every subtype of PassportElementErrorSource. A new subtype breaks the build of the visitor implementations
+type PassportElementErrorSourceVisitor interface {
+	VisitPassportElementErrorSourceUnspecified(value *PassportElementErrorSourceUnspecified) error
+	VisitPassportElementErrorSourceDataField(value *PassportElementErrorSourceDataField) error
+	VisitPassportElementErrorSourceFrontSide(value *PassportElementErrorSourceFrontSide) error
+	VisitPassportElementErrorSourceReverseSide(value *PassportElementErrorSourceReverseSide) error
+	VisitPassportElementErrorSourceSelfie(value *PassportElementErrorSourceSelfie) error
+	VisitPassportElementErrorSourceTranslationFile(value *PassportElementErrorSourceTranslationFile) error
+	VisitPassportElementErrorSourceTranslationFiles(value *PassportElementErrorSourceTranslationFiles) error
+	VisitPassportElementErrorSourceFile(value *PassportElementErrorSourceFile) error
+	VisitPassportElementErrorSourceFiles(value *PassportElementErrorSourceFiles) error
+}
+
+// VisitPassportElementErrorSource calls the method of the visitor for the subtype of the value
+func VisitPassportElementErrorSource(value PassportElementErrorSource, visitor PassportElementErrorSourceVisitor) error {
+	switch value := value.(type) {
+	case *PassportElementErrorSourceUnspecified:
+		return visitor.VisitPassportElementErrorSourceUnspecified(value)
+
+	case *PassportElementErrorSourceDataField:
+		return visitor.VisitPassportElementErrorSourceDataField(value)
+
+	case *PassportElementErrorSourceFrontSide:
+		return visitor.VisitPassportElementErrorSourceFrontSide(value)
+
+	case *PassportElementErrorSourceReverseSide:
+		return visitor.VisitPassportElementErrorSourceReverseSide(value)
+
+	case *PassportElementErrorSourceSelfie:
+		return visitor.VisitPassportElementErrorSourceSelfie(value)
+
+	case *PassportElementErrorSourceTranslationFile:
+		return visitor.VisitPassportElementErrorSourceTranslationFile(value)
+
+	case *PassportElementErrorSourceTranslationFiles:
+		return visitor.VisitPassportElementErrorSourceTranslationFiles(value)
+
+	case *PassportElementErrorSourceFile:
+		return visitor.VisitPassportElementErrorSourceFile(value)
+
+	case *PassportElementErrorSourceFiles:
+		return visitor.VisitPassportElementErrorSourceFiles(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.PassportElementErrorSourceType())
+	}
+}
+
+// InputPassportElementErrorSourceVisitor has a method for every subtype of InputPassportElementErrorSource. A new subtype breaks the build of the visitor implementations
+type InputPassportElementErrorSourceVisitor interface {
+	VisitInputPassportElementErrorSourceUnspecified(value *InputPassportElementErrorSourceUnspecified) error
+	VisitInputPassportElementErrorSourceDataField(value *InputPassportElementErrorSourceDataField) error
+	VisitInputPassportElementErrorSourceFrontSide(value *InputPassportElementErrorSourceFrontSide) error
+	VisitInputPassportElementErrorSourceReverseSide(value *InputPassportElementErrorSourceReverseSide) error
+	VisitInputPassportElementErrorSourceSelfie(value *InputPassportElementErrorSourceSelfie) error
+	VisitInputPassportElementErrorSourceTranslationFile(value *InputPassportElementErrorSourceTranslationFile) error
+	VisitInputPassportElementErrorSourceTranslationFiles(value *InputPassportElementErrorSourceTranslationFiles) error
+	VisitInputPassportElementErrorSourceFile(value *InputPassportElementErrorSourceFile) error
+	VisitInputPassportElementErrorSourceFiles(value *InputPassportElementErrorSourceFiles) error
+}
+
+// VisitInputPassportElementErrorSource calls the method of the visitor for the subtype of the value
+func VisitInputPassportElementErrorSource(value InputPassportElementErrorSource, visitor InputPassportElementErrorSourceVisitor) error {
+	switch value := value.(type) {
+	case *InputPassportElementErrorSourceUnspecified:
+		return visitor.VisitInputPassportElementErrorSourceUnspecified(value)
+
+	case *InputPassportElementErrorSourceDataField:
+		return visitor.VisitInputPassportElementErrorSourceDataField(value)
+
+	case *InputPassportElementErrorSourceFrontSide:
+		return visitor.VisitInputPassportElementErrorSourceFrontSide(value)
+
+	case *InputPassportElementErrorSourceReverseSide:
+		return visitor.VisitInputPassportElementErrorSourceReverseSide(value)
+
+	case *InputPassportElementErrorSourceSelfie:
+		return visitor.VisitInputPassportElementErrorSourceSelfie(value)
+
+	case *InputPassportElementErrorSourceTranslationFile:
+		return visitor.VisitInputPassportElementErrorSourceTranslationFile(value)
+
+	case *InputPassportElementErrorSourceTranslationFiles:
+		return visitor.VisitInputPassportElementErrorSourceTranslationFiles(value)
+
+	case *InputPassportElementErrorSourceFile:
+		return visitor.VisitInputPassportElementErrorSourceFile(value)
+
+	case *InputPassportElementErrorSourceFiles:
+		return visitor.VisitInputPassportElementErrorSourceFiles(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.InputPassportElementErrorSourceType())
+	}
+}
+
+// MessageContentVisitor has a method for every subtype of MessageContent. A new subtype breaks the build of the visitor implementations
+type MessageContentVisitor interface {
+	VisitMessageText(value *MessageText) error
+	VisitMessageAnimation(value *MessageAnimation) error
+	VisitMessageAudio(value *MessageAudio) error
+	VisitMessageDocument(value *MessageDocument) error
+	VisitMessagePhoto(value *MessagePhoto) error
+	VisitMessageExpiredPhoto(value *MessageExpiredPhoto) error
+	VisitMessageSticker(value *MessageSticker) error
+	VisitMessageVideo(value *MessageVideo) error
+	VisitMessageExpiredVideo(value *MessageExpiredVideo) error
+	VisitMessageVideoNote(value *MessageVideoNote) error
+	VisitMessageVoiceNote(value *MessageVoiceNote) error
+	VisitMessageLocation(value *MessageLocation) error
+	VisitMessageVenue(value *MessageVenue) error
+	VisitMessageContact(value *MessageContact) error
+	VisitMessageAnimatedEmoji(value *MessageAnimatedEmoji) error
+	VisitMessageDice(value *MessageDice) error
+	VisitMessageGame(value *MessageGame) error
+	VisitMessagePoll(value *MessagePoll) error
+	VisitMessageInvoice(value *MessageInvoice) error
+	VisitMessageCall(value *MessageCall) error
+	VisitMessageVideoChatScheduled(value *MessageVideoChatScheduled) error
+	VisitMessageVideoChatStarted(value *MessageVideoChatStarted) error
+	VisitMessageVideoChatEnded(value *MessageVideoChatEnded) error
+	VisitMessageInviteVideoChatParticipants(value *MessageInviteVideoChatParticipants) error
+	VisitMessageBasicGroupChatCreate(value *MessageBasicGroupChatCreate) error
+	VisitMessageSupergroupChatCreate(value *MessageSupergroupChatCreate) error
+	VisitMessageChatChangeTitle(value *MessageChatChangeTitle) error
+	VisitMessageChatChangePhoto(value *MessageChatChangePhoto) error
+	VisitMessageChatDeletePhoto(value *MessageChatDeletePhoto) error
+	VisitMessageChatAddMembers(value *MessageChatAddMembers) error
+	VisitMessageChatJoinByLink(value *MessageChatJoinByLink) error
+	VisitMessageChatJoinByRequest(value *MessageChatJoinByRequest) error
+	VisitMessageChatDeleteMember(value *MessageChatDeleteMember) error
+	VisitMessageChatUpgradeTo(value *MessageChatUpgradeTo) error
+	VisitMessageChatUpgradeFrom(value *MessageChatUpgradeFrom) error
+	VisitMessagePinMessage(value *MessagePinMessage) error
+	VisitMessageScreenshotTaken(value *MessageScreenshotTaken) error
+	VisitMessageChatSetBackground(value *MessageChatSetBackground) error
+	VisitMessageChatSetTheme(value *MessageChatSetTheme) error
+	VisitMessageChatSetMessageAutoDeleteTime(value *MessageChatSetMessageAutoDeleteTime) error
+	VisitMessageForumTopicCreated(value *MessageForumTopicCreated) error
+	VisitMessageForumTopicEdited(value *MessageForumTopicEdited) error
+	VisitMessageForumTopicIsClosedToggled(value *MessageForumTopicIsClosedToggled) error
+	VisitMessageForumTopicIsHiddenToggled(value *MessageForumTopicIsHiddenToggled) error
+	VisitMessageSuggestProfilePhoto(value *MessageSuggestProfilePhoto) error
+	VisitMessageCustomServiceAction(value *MessageCustomServiceAction) error
+	VisitMessageGameScore(value *MessageGameScore) error
+	VisitMessagePaymentSuccessful(value *MessagePaymentSuccessful) error
+	VisitMessagePaymentSuccessfulBot(value *MessagePaymentSuccessfulBot) error
+	VisitMessageGiftedPremium(value *MessageGiftedPremium) error
+	VisitMessageContactRegistered(value *MessageContactRegistered) error
+	VisitMessageUserShared(value *MessageUserShared) error
+	VisitMessageChatShared(value *MessageChatShared) error
+	VisitMessageWebsiteConnected(value *MessageWebsiteConnected) error
+	VisitMessageBotWriteAccessAllowed(value *MessageBotWriteAccessAllowed) error
+	VisitMessageWebAppDataSent(value *MessageWebAppDataSent) error
+	VisitMessageWebAppDataReceived(value *MessageWebAppDataReceived) error
+	VisitMessagePassportDataSent(value *MessagePassportDataSent) error
+	VisitMessagePassportDataReceived(value *MessagePassportDataReceived) error
+	VisitMessageProximityAlertTriggered(value *MessageProximityAlertTriggered) error
+	VisitMessageUnsupported(value *MessageUnsupported) error
+}
+
+// VisitMessageContent calls the method of the visitor for the subtype of the value
+func VisitMessageContent(value MessageContent, visitor MessageContentVisitor) error {
+	switch value := value.(type) {
+	case *MessageText:
+		return visitor.VisitMessageText(value)
+
+	case *MessageAnimation:
+		return visitor.VisitMessageAnimation(value)
+
+	case *MessageAudio:
+		return visitor.VisitMessageAudio(value)
+
+	case *MessageDocument:
+		return visitor.VisitMessageDocument(value)
+
+	case *MessagePhoto:
+		return visitor.VisitMessagePhoto(value)
+
+	case *MessageExpiredPhoto:
+		return visitor.VisitMessageExpiredPhoto(value)
+
+	case *MessageSticker:
+		return visitor.VisitMessageSticker(value)
+
+	case *MessageVideo:
+		return visitor.VisitMessageVideo(value)
+
+	case *MessageExpiredVideo:
+		return visitor.VisitMessageExpiredVideo(value)
+
+	case *MessageVideoNote:
+		return visitor.VisitMessageVideoNote(value)
+
+	case *MessageVoiceNote:
+		return visitor.VisitMessageVoiceNote(value)
+
+	case *MessageLocation:
+		return visitor.VisitMessageLocation(value)
+
+	case *MessageVenue:
+		return visitor.VisitMessageVenue(value)
+
+	case *MessageContact:
+		return visitor.VisitMessageContact(value)
+
+	case *MessageAnimatedEmoji:
+		return visitor.VisitMessageAnimatedEmoji(value)
+
+	case *MessageDice:
+		return visitor.VisitMessageDice(value)
+
+	case *MessageGame:
+		return visitor.VisitMessageGame(value)
+
+	case *MessagePoll:
+		return visitor.VisitMessagePoll(value)
+
+	case *MessageInvoice:
+		return visitor.VisitMessageInvoice(value)
+
+	case *MessageCall:
+		return visitor.VisitMessageCall(value)
+
+	case *MessageVideoChatScheduled:
+		return visitor.VisitMessageVideoChatScheduled(value)
+
+	case *MessageVideoChatStarted:
+		return visitor.VisitMessageVideoChatStarted(value)
+
+	case *MessageVideoChatEnded:
+		return visitor.VisitMessageVideoChatEnded(value)
+
+	case *MessageInviteVideoChatParticipants:
+		return visitor.VisitMessageInviteVideoChatParticipants(value)
+
+	case *MessageBasicGroupChatCreate:
+		return visitor.VisitMessageBasicGroupChatCreate(value)
+
+	case *MessageSupergroupChatCreate:
+		return visitor.VisitMessageSupergroupChatCreate(value)
+
+	case *MessageChatChangeTitle:
+		return visitor.VisitMessageChatChangeTitle(value)
+
+	case *MessageChatChangePhoto:
+		return visitor.VisitMessageChatChangePhoto(value)
+
+	case *MessageChatDeletePhoto:
+		return visitor.VisitMessageChatDeletePhoto(value)
+
+	case *MessageChatAddMembers:
+		return visitor.VisitMessageChatAddMembers(value)
+
+	case *MessageChatJoinByLink:
+		return visitor.VisitMessageChatJoinByLink(value)
+
+	case *MessageChatJoinByRequest:
+		return visitor.VisitMessageChatJoinByRequest(value)
+
+	case *MessageChatDeleteMember:
+		return visitor.VisitMessageChatDeleteMember(value)
+
+	case *MessageChatUpgradeTo:
+		return visitor.VisitMessageChatUpgradeTo(value)
+
+	case *MessageChatUpgradeFrom:
+		return visitor.VisitMessageChatUpgradeFrom(value)
+
+	case *MessagePinMessage:
+		return visitor.VisitMessagePinMessage(value)
+
+	case *MessageScreenshotTaken:
+		return visitor.VisitMessageScreenshotTaken(value)
+
+	case *MessageChatSetBackground:
+		return visitor.VisitMessageChatSetBackground(value)
+
+	case *MessageChatSetTheme:
+		return visitor.VisitMessageChatSetTheme(value)
+
+	case *MessageChatSetMessageAutoDeleteTime:
+		return visitor.VisitMessageChatSetMessageAutoDeleteTime(value)
+
+	case *MessageForumTopicCreated:
+		return visitor.VisitMessageForumTopicCreated(value)
+
+	case *MessageForumTopicEdited:
+		return visitor.VisitMessageForumTopicEdited(value)
+
+	case *MessageForumTopicIsClosedToggled:
+		return visitor.VisitMessageForumTopicIsClosedToggled(value)
+
+	case *MessageForumTopicIsHiddenToggled:
+		return visitor.VisitMessageForumTopicIsHiddenToggled(value)
+
+	case *MessageSuggestProfilePhoto:
+		return visitor.VisitMessageSuggestProfilePhoto(value)
+
+	case *MessageCustomServiceAction:
+		return visitor.VisitMessageCustomServiceAction(value)
+
+	case *MessageGameScore:
+		return visitor.VisitMessageGameScore(value)
+
+	case *MessagePaymentSuccessful:
+		return visitor.VisitMessagePaymentSuccessful(value)
+
+	case *MessagePaymentSuccessfulBot:
+		return visitor.VisitMessagePaymentSuccessfulBot(value)
+
+	case *MessageGiftedPremium:
+		return visitor.VisitMessageGiftedPremium(value)
+
+	case *MessageContactRegistered:
+		return visitor.VisitMessageContactRegistered(value)
+
+	case *MessageUserShared:
+		return visitor.VisitMessageUserShared(value)
+
+	case *MessageChatShared:
+		return visitor.VisitMessageChatShared(value)
+
+	case *MessageWebsiteConnected:
+		return visitor.VisitMessageWebsiteConnected(value)
+
+	case *MessageBotWriteAccessAllowed:
+		return visitor.VisitMessageBotWriteAccessAllowed(value)
+
+	case *MessageWebAppDataSent:
+		return visitor.VisitMessageWebAppDataSent(value)
+
+	case *MessageWebAppDataReceived:
+		return visitor.VisitMessageWebAppDataReceived(value)
+
+	case *MessagePassportDataSent:
+		return visitor.VisitMessagePassportDataSent(value)
+
+	case *MessagePassportDataReceived:
+		return visitor.VisitMessagePassportDataReceived(value)
+
+	case *MessageProximityAlertTriggered:
+		return visitor.VisitMessageProximityAlertTriggered(value)
+
+	case *MessageUnsupported:
+		return visitor.VisitMessageUnsupported(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.MessageContentType())
+	}
+}
+
+// TextEntityTypeVisitor has a method for every subtype of TextEntityType. A new subtype breaks the build of the visitor implementations
+type TextEntityTypeVisitor interface {
+	VisitTextEntityTypeMention(value *TextEntityTypeMention) error
+	VisitTextEntityTypeHashtag(value *TextEntityTypeHashtag) error
+	VisitTextEntityTypeCashtag(value *TextEntityTypeCashtag) error
+	VisitTextEntityTypeBotCommand(value *TextEntityTypeBotCommand) error
+	VisitTextEntityTypeUrl(value *TextEntityTypeUrl) error
+	VisitTextEntityTypeEmailAddress(value *TextEntityTypeEmailAddress) error
+	VisitTextEntityTypePhoneNumber(value *TextEntityTypePhoneNumber) error
+	VisitTextEntityTypeBankCardNumber(value *TextEntityTypeBankCardNumber) error
+	VisitTextEntityTypeBold(value *TextEntityTypeBold) error
+	VisitTextEntityTypeItalic(value *TextEntityTypeItalic) error
+	VisitTextEntityTypeUnderline(value *TextEntityTypeUnderline) error
+	VisitTextEntityTypeStrikethrough(value *TextEntityTypeStrikethrough) error
+	VisitTextEntityTypeSpoiler(value *TextEntityTypeSpoiler) error
+	VisitTextEntityTypeCode(value *TextEntityTypeCode) error
+	VisitTextEntityTypePre(value *TextEntityTypePre) error
+	VisitTextEntityTypePreCode(value *TextEntityTypePreCode) error
+	VisitTextEntityTypeTextUrl(value *TextEntityTypeTextUrl) error
+	VisitTextEntityTypeMentionName(value *TextEntityTypeMentionName) error
+	VisitTextEntityTypeCustomEmoji(value *TextEntityTypeCustomEmoji) error
+	VisitTextEntityTypeMediaTimestamp(value *TextEntityTypeMediaTimestamp) error
+}
+
+// VisitTextEntityType calls the method of the visitor for the subtype of the value
+func VisitTextEntityType(value TextEntityType, visitor TextEntityTypeVisitor) error {
+	switch value := value.(type) {
+	case *TextEntityTypeMention:
+		return visitor.VisitTextEntityTypeMention(value)
+
+	case *TextEntityTypeHashtag:
+		return visitor.VisitTextEntityTypeHashtag(value)
+
+	case *TextEntityTypeCashtag:
+		return visitor.VisitTextEntityTypeCashtag(value)
+
+	case *TextEntityTypeBotCommand:
+		return visitor.VisitTextEntityTypeBotCommand(value)
+
+	case *TextEntityTypeUrl:
+		return visitor.VisitTextEntityTypeUrl(value)
+
+	case *TextEntityTypeEmailAddress:
+		return visitor.VisitTextEntityTypeEmailAddress(value)
+
+	case *TextEntityTypePhoneNumber:
+		return visitor.VisitTextEntityTypePhoneNumber(value)
+
+	case *TextEntityTypeBankCardNumber:
+		return visitor.VisitTextEntityTypeBankCardNumber(value)
+
+	case *TextEntityTypeBold:
+		return visitor.VisitTextEntityTypeBold(value)
+
+	case *TextEntityTypeItalic:
+		return visitor.VisitTextEntityTypeItalic(value)
+
+	case *TextEntityTypeUnderline:
+		return visitor.VisitTextEntityTypeUnderline(value)
+
+	case *TextEntityTypeStrikethrough:
+		return visitor.VisitTextEntityTypeStrikethrough(value)
+
+	case *TextEntityTypeSpoiler:
+		return visitor.VisitTextEntityTypeSpoiler(value)
+
+	case *TextEntityTypeCode:
+		return visitor.VisitTextEntityTypeCode(value)
+
+	case *TextEntityTypePre:
+		return visitor.VisitTextEntityTypePre(value)
+
+	case *TextEntityTypePreCode:
+		return visitor.VisitTextEntityTypePreCode(value)
+
+	case *TextEntityTypeTextUrl:
+		return visitor.VisitTextEntityTypeTextUrl(value)
+
+	case *TextEntityTypeMentionName:
+		return visitor.VisitTextEntityTypeMentionName(value)
+
+	case *TextEntityTypeCustomEmoji:
+		return visitor.VisitTextEntityTypeCustomEmoji(value)
+
+	case *TextEntityTypeMediaTimestamp:
+		return visitor.VisitTextEntityTypeMediaTimestamp(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.TextEntityTypeType())
+	}
+}
+
+// MessageSchedulingStateVisitor has a method for every subtype of MessageSchedulingState. A new subtype breaks the build of the visitor implementations
+type MessageSchedulingStateVisitor interface {
+	VisitMessageSchedulingStateSendAtDate(value *MessageSchedulingStateSendAtDate) error
+	VisitMessageSchedulingStateSendWhenOnline(value *MessageSchedulingStateSendWhenOnline) error
+}
+
+// VisitMessageSchedulingState calls the method of the visitor for the subtype of the value
+func VisitMessageSchedulingState(value MessageSchedulingState, visitor MessageSchedulingStateVisitor) error {
+	switch value := value.(type) {
+	case *MessageSchedulingStateSendAtDate:
+		return visitor.VisitMessageSchedulingStateSendAtDate(value)
+
+	case *MessageSchedulingStateSendWhenOnline:
+		return visitor.VisitMessageSchedulingStateSendWhenOnline(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.MessageSchedulingStateType())
+	}
+}
+
+// InputMessageContentVisitor has a method for every subtype of InputMessageContent. A new subtype breaks the build of the visitor implementations
+type InputMessageContentVisitor interface {
+	VisitInputMessageText(value *InputMessageText) error
+	VisitInputMessageAnimation(value *InputMessageAnimation) error
+	VisitInputMessageAudio(value *InputMessageAudio) error
+	VisitInputMessageDocument(value *InputMessageDocument) error
+	VisitInputMessagePhoto(value *InputMessagePhoto) error
+	VisitInputMessageSticker(value *InputMessageSticker) error
+	VisitInputMessageVideo(value *InputMessageVideo) error
+	VisitInputMessageVideoNote(value *InputMessageVideoNote) error
+	VisitInputMessageVoiceNote(value *InputMessageVoiceNote) error
+	VisitInputMessageLocation(value *InputMessageLocation) error
+	VisitInputMessageVenue(value *InputMessageVenue) error
+	VisitInputMessageContact(value *InputMessageContact) error
+	VisitInputMessageDice(value *InputMessageDice) error
+	VisitInputMessageGame(value *InputMessageGame) error
+	VisitInputMessageInvoice(value *InputMessageInvoice) error
+	VisitInputMessagePoll(value *InputMessagePoll) error
+	VisitInputMessageForwarded(value *InputMessageForwarded) error
+}
+
+// VisitInputMessageContent calls the method of the visitor for the subtype of the value
+func VisitInputMessageContent(value InputMessageContent, visitor InputMessageContentVisitor) error {
+	switch value := value.(type) {
+	case *InputMessageText:
+		return visitor.VisitInputMessageText(value)
+
+	case *InputMessageAnimation:
+		return visitor.VisitInputMessageAnimation(value)
+
+	case *InputMessageAudio:
+		return visitor.VisitInputMessageAudio(value)
+
+	case *InputMessageDocument:
+		return visitor.VisitInputMessageDocument(value)
+
+	case *InputMessagePhoto:
+		return visitor.VisitInputMessagePhoto(value)
+
+	case *InputMessageSticker:
+		return visitor.VisitInputMessageSticker(value)
+
+	case *InputMessageVideo:
+		return visitor.VisitInputMessageVideo(value)
+
+	case *InputMessageVideoNote:
+		return visitor.VisitInputMessageVideoNote(value)
+
+	case *InputMessageVoiceNote:
+		return visitor.VisitInputMessageVoiceNote(value)
+
+	case *InputMessageLocation:
+		return visitor.VisitInputMessageLocation(value)
+
+	case *InputMessageVenue:
+		return visitor.VisitInputMessageVenue(value)
+
+	case *InputMessageContact:
+		return visitor.VisitInputMessageContact(value)
+
+	case *InputMessageDice:
+		return visitor.VisitInputMessageDice(value)
+
+	case *InputMessageGame:
+		return visitor.VisitInputMessageGame(value)
+
+	case *InputMessageInvoice:
+		return visitor.VisitInputMessageInvoice(value)
+
+	case *InputMessagePoll:
+		return visitor.VisitInputMessagePoll(value)
+
+	case *InputMessageForwarded:
+		return visitor.VisitInputMessageForwarded(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.InputMessageContentType())
+	}
+}
+
+// SearchMessagesFilterVisitor has a method for every subtype of SearchMessagesFilter. A new subtype breaks the build of the visitor implementations
+type SearchMessagesFilterVisitor interface {
+	VisitSearchMessagesFilterEmpty(value *SearchMessagesFilterEmpty) error
+	VisitSearchMessagesFilterAnimation(value *SearchMessagesFilterAnimation) error
+	VisitSearchMessagesFilterAudio(value *SearchMessagesFilterAudio) error
+	VisitSearchMessagesFilterDocument(value *SearchMessagesFilterDocument) error
+	VisitSearchMessagesFilterPhoto(value *SearchMessagesFilterPhoto) error
+	VisitSearchMessagesFilterVideo(value *SearchMessagesFilterVideo) error
+	VisitSearchMessagesFilterVoiceNote(value *SearchMessagesFilterVoiceNote) error
+	VisitSearchMessagesFilterPhotoAndVideo(value *SearchMessagesFilterPhotoAndVideo) error
+	VisitSearchMessagesFilterUrl(value *SearchMessagesFilterUrl) error
+	VisitSearchMessagesFilterChatPhoto(value *SearchMessagesFilterChatPhoto) error
+	VisitSearchMessagesFilterVideoNote(value *SearchMessagesFilterVideoNote) error
+	VisitSearchMessagesFilterVoiceAndVideoNote(value *SearchMessagesFilterVoiceAndVideoNote) error
+	VisitSearchMessagesFilterMention(value *SearchMessagesFilterMention) error
+	VisitSearchMessagesFilterUnreadMention(value *SearchMessagesFilterUnreadMention) error
+	VisitSearchMessagesFilterUnreadReaction(value *SearchMessagesFilterUnreadReaction) error
+	VisitSearchMessagesFilterFailedToSend(value *SearchMessagesFilterFailedToSend) error
+	VisitSearchMessagesFilterPinned(value *SearchMessagesFilterPinned) error
+}
+
+// VisitSearchMessagesFilter calls the method of the visitor for the subtype of the value
+func VisitSearchMessagesFilter(value SearchMessagesFilter, visitor SearchMessagesFilterVisitor) error {
+	switch value := value.(type) {
+	case *SearchMessagesFilterEmpty:
+		return visitor.VisitSearchMessagesFilterEmpty(value)
+
+	case *SearchMessagesFilterAnimation:
+		return visitor.VisitSearchMessagesFilterAnimation(value)
+
+	case *SearchMessagesFilterAudio:
+		return visitor.VisitSearchMessagesFilterAudio(value)
+
+	case *SearchMessagesFilterDocument:
+		return visitor.VisitSearchMessagesFilterDocument(value)
+
+	case *SearchMessagesFilterPhoto:
+		return visitor.VisitSearchMessagesFilterPhoto(value)
+
+	case *SearchMessagesFilterVideo:
+		return visitor.VisitSearchMessagesFilterVideo(value)
+
+	case *SearchMessagesFilterVoiceNote:
+		return visitor.VisitSearchMessagesFilterVoiceNote(value)
+
+	case *SearchMessagesFilterPhotoAndVideo:
+		return visitor.VisitSearchMessagesFilterPhotoAndVideo(value)
+
+	case *SearchMessagesFilterUrl:
+		return visitor.VisitSearchMessagesFilterUrl(value)
+
+	case *SearchMessagesFilterChatPhoto:
+		return visitor.VisitSearchMessagesFilterChatPhoto(value)
+
+	case *SearchMessagesFilterVideoNote:
+		return visitor.VisitSearchMessagesFilterVideoNote(value)
+
+	case *SearchMessagesFilterVoiceAndVideoNote:
+		return visitor.VisitSearchMessagesFilterVoiceAndVideoNote(value)
+
+	case *SearchMessagesFilterMention:
+		return visitor.VisitSearchMessagesFilterMention(value)
+
+	case *SearchMessagesFilterUnreadMention:
+		return visitor.VisitSearchMessagesFilterUnreadMention(value)
+
+	case *SearchMessagesFilterUnreadReaction:
+		return visitor.VisitSearchMessagesFilterUnreadReaction(value)
+
+	case *SearchMessagesFilterFailedToSend:
+		return visitor.VisitSearchMessagesFilterFailedToSend(value)
+
+	case *SearchMessagesFilterPinned:
+		return visitor.VisitSearchMessagesFilterPinned(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.SearchMessagesFilterType())
+	}
+}
+
+// ChatActionVisitor has a method for every subtype of ChatAction. A new subtype breaks the build of the visitor implementations
+type ChatActionVisitor interface {
+	VisitChatActionTyping(value *ChatActionTyping) error
+	VisitChatActionRecordingVideo(value *ChatActionRecordingVideo) error
+	VisitChatActionUploadingVideo(value *ChatActionUploadingVideo) error
+	VisitChatActionRecordingVoiceNote(value *ChatActionRecordingVoiceNote) error
+	VisitChatActionUploadingVoiceNote(value *ChatActionUploadingVoiceNote) error
+	VisitChatActionUploadingPhoto(value *ChatActionUploadingPhoto) error
+	VisitChatActionUploadingDocument(value *ChatActionUploadingDocument) error
+	VisitChatActionChoosingSticker(value *ChatActionChoosingSticker) error
+	VisitChatActionChoosingLocation(value *ChatActionChoosingLocation) error
+	VisitChatActionChoosingContact(value *ChatActionChoosingContact) error
+	VisitChatActionStartPlayingGame(value *ChatActionStartPlayingGame) error
+	VisitChatActionRecordingVideoNote(value *ChatActionRecordingVideoNote) error
+	VisitChatActionUploadingVideoNote(value *ChatActionUploadingVideoNote) error
+	VisitChatActionWatchingAnimations(value *ChatActionWatchingAnimations) error
+	VisitChatActionCancel(value *ChatActionCancel) error
+}
+
+// VisitChatAction calls the method of the visitor for the subtype of the value
+func VisitChatAction(value ChatAction, visitor ChatActionVisitor) error {
+	switch value := value.(type) {
+	case *ChatActionTyping:
+		return visitor.VisitChatActionTyping(value)
+
+	case *ChatActionRecordingVideo:
+		return visitor.VisitChatActionRecordingVideo(value)
+
+	case *ChatActionUploadingVideo:
+		return visitor.VisitChatActionUploadingVideo(value)
+
+	case *ChatActionRecordingVoiceNote:
+		return visitor.VisitChatActionRecordingVoiceNote(value)
+
+	case *ChatActionUploadingVoiceNote:
+		return visitor.VisitChatActionUploadingVoiceNote(value)
+
+	case *ChatActionUploadingPhoto:
+		return visitor.VisitChatActionUploadingPhoto(value)
+
+	case *ChatActionUploadingDocument:
+		return visitor.VisitChatActionUploadingDocument(value)
+
+	case *ChatActionChoosingSticker:
+		return visitor.VisitChatActionChoosingSticker(value)
+
+	case *ChatActionChoosingLocation:
+		return visitor.VisitChatActionChoosingLocation(value)
+
+	case *ChatActionChoosingContact:
+		return visitor.VisitChatActionChoosingContact(value)
+
+	case *ChatActionStartPlayingGame:
+		return visitor.VisitChatActionStartPlayingGame(value)
+
+	case *ChatActionRecordingVideoNote:
+		return visitor.VisitChatActionRecordingVideoNote(value)
+
+	case *ChatActionUploadingVideoNote:
+		return visitor.VisitChatActionUploadingVideoNote(value)
+
+	case *ChatActionWatchingAnimations:
+		return visitor.VisitChatActionWatchingAnimations(value)
+
+	case *ChatActionCancel:
+		return visitor.VisitChatActionCancel(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.ChatActionType())
+	}
+}
+
+// UserStatusVisitor has a method for every subtype of UserStatus. A new subtype breaks the build of the visitor implementations
+type UserStatusVisitor interface {
+	VisitUserStatusEmpty(value *UserStatusEmpty) error
+	VisitUserStatusOnline(value *UserStatusOnline) error
+	VisitUserStatusOffline(value *UserStatusOffline) error
+	VisitUserStatusRecently(value *UserStatusRecently) error
+	VisitUserStatusLastWeek(value *UserStatusLastWeek) error
+	VisitUserStatusLastMonth(value *UserStatusLastMonth) error
+}
+
+// VisitUserStatus calls the method of the visitor for the subtype of the value
+func VisitUserStatus(value UserStatus, visitor UserStatusVisitor) error {
+	switch value := value.(type) {
+	case *UserStatusEmpty:
+		return visitor.VisitUserStatusEmpty(value)
+
+	case *UserStatusOnline:
+		return visitor.VisitUserStatusOnline(value)
+
+	case *UserStatusOffline:
+		return visitor.VisitUserStatusOffline(value)
+
+	case *UserStatusRecently:
+		return visitor.VisitUserStatusRecently(value)
+
+	case *UserStatusLastWeek:
+		return visitor.VisitUserStatusLastWeek(value)
+
+	case *UserStatusLastMonth:
+		return visitor.VisitUserStatusLastMonth(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.UserStatusType())
+	}
+}
+
+// EmojiCategoryTypeVisitor has a method for every subtype of EmojiCategoryType. A new subtype breaks the build of the visitor implementations
+type EmojiCategoryTypeVisitor interface {
+	VisitEmojiCategoryTypeDefault(value *EmojiCategoryTypeDefault) error
+	VisitEmojiCategoryTypeEmojiStatus(value *EmojiCategoryTypeEmojiStatus) error
+	VisitEmojiCategoryTypeChatPhoto(value *EmojiCategoryTypeChatPhoto) error
+}
+
+// VisitEmojiCategoryType calls the method of the visitor for the subtype of the value
+func VisitEmojiCategoryType(value EmojiCategoryType, visitor EmojiCategoryTypeVisitor) error {
+	switch value := value.(type) {
+	case *EmojiCategoryTypeDefault:
+		return visitor.VisitEmojiCategoryTypeDefault(value)
+
+	case *EmojiCategoryTypeEmojiStatus:
+		return visitor.VisitEmojiCategoryTypeEmojiStatus(value)
+
+	case *EmojiCategoryTypeChatPhoto:
+		return visitor.VisitEmojiCategoryTypeChatPhoto(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.EmojiCategoryTypeType())
+	}
+}
+
+// CallDiscardReasonVisitor has a method for every subtype of CallDiscardReason. A new subtype breaks the build of the visitor implementations
+type CallDiscardReasonVisitor interface {
+	VisitCallDiscardReasonEmpty(value *CallDiscardReasonEmpty) error
+	VisitCallDiscardReasonMissed(value *CallDiscardReasonMissed) error
+	VisitCallDiscardReasonDeclined(value *CallDiscardReasonDeclined) error
+	VisitCallDiscardReasonDisconnected(value *CallDiscardReasonDisconnected) error
+	VisitCallDiscardReasonHungUp(value *CallDiscardReasonHungUp) error
+}
+
+// VisitCallDiscardReason calls the method of the visitor for the subtype of the value
+func VisitCallDiscardReason(value CallDiscardReason, visitor CallDiscardReasonVisitor) error {
+	switch value := value.(type) {
+	case *CallDiscardReasonEmpty:
+		return visitor.VisitCallDiscardReasonEmpty(value)
+
+	case *CallDiscardReasonMissed:
+		return visitor.VisitCallDiscardReasonMissed(value)
+
+	case *CallDiscardReasonDeclined:
+		return visitor.VisitCallDiscardReasonDeclined(value)
+
+	case *CallDiscardReasonDisconnected:
+		return visitor.VisitCallDiscardReasonDisconnected(value)
+
+	case *CallDiscardReasonHungUp:
+		return visitor.VisitCallDiscardReasonHungUp(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.CallDiscardReasonType())
+	}
+}
+
+// CallServerTypeVisitor has a method for every subtype of CallServerType. A new subtype breaks the build of the visitor implementations
+type CallServerTypeVisitor interface {
+	VisitCallServerTypeTelegramReflector(value *CallServerTypeTelegramReflector) error
+	VisitCallServerTypeWebrtc(value *CallServerTypeWebrtc) error
+}
+
+// VisitCallServerType calls the method of the visitor for the subtype of the value
+func VisitCallServerType(value CallServerType, visitor CallServerTypeVisitor) error {
+	switch value := value.(type) {
+	case *CallServerTypeTelegramReflector:
+		return visitor.VisitCallServerTypeTelegramReflector(value)
+
+	case *CallServerTypeWebrtc:
+		return visitor.VisitCallServerTypeWebrtc(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.CallServerTypeType())
+	}
+}
+
+// CallStateVisitor has a method for every subtype of CallState. A new subtype breaks the build of the visitor implementations
+type CallStateVisitor interface {
+	VisitCallStatePending(value *CallStatePending) error
+	VisitCallStateExchangingKeys(value *CallStateExchangingKeys) error
+	VisitCallStateReady(value *CallStateReady) error
+	VisitCallStateHangingUp(value *CallStateHangingUp) error
+	VisitCallStateDiscarded(value *CallStateDiscarded) error
+	VisitCallStateError(value *CallStateError) error
+}
+
+// VisitCallState calls the method of the visitor for the subtype of the value
+func VisitCallState(value CallState, visitor CallStateVisitor) error {
+	switch value := value.(type) {
+	case *CallStatePending:
+		return visitor.VisitCallStatePending(value)
+
+	case *CallStateExchangingKeys:
+		return visitor.VisitCallStateExchangingKeys(value)
+
+	case *CallStateReady:
+		return visitor.VisitCallStateReady(value)
+
+	case *CallStateHangingUp:
+		return visitor.VisitCallStateHangingUp(value)
+
+	case *CallStateDiscarded:
+		return visitor.VisitCallStateDiscarded(value)
+
+	case *CallStateError:
+		return visitor.VisitCallStateError(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.CallStateType())
+	}
+}
+
+// GroupCallVideoQualityVisitor has a method for every subtype of GroupCallVideoQuality. A new subtype breaks the build of the visitor implementations
+type GroupCallVideoQualityVisitor interface {
+	VisitGroupCallVideoQualityThumbnail(value *GroupCallVideoQualityThumbnail) error
+	VisitGroupCallVideoQualityMedium(value *GroupCallVideoQualityMedium) error
+	VisitGroupCallVideoQualityFull(value *GroupCallVideoQualityFull) error
+}
+
+// VisitGroupCallVideoQuality calls the method of the visitor for the subtype of the value
+func VisitGroupCallVideoQuality(value GroupCallVideoQuality, visitor GroupCallVideoQualityVisitor) error {
+	switch value := value.(type) {
+	case *GroupCallVideoQualityThumbnail:
+		return visitor.VisitGroupCallVideoQualityThumbnail(value)
+
+	case *GroupCallVideoQualityMedium:
+		return visitor.VisitGroupCallVideoQualityMedium(value)
+
+	case *GroupCallVideoQualityFull:
+		return visitor.VisitGroupCallVideoQualityFull(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.GroupCallVideoQualityType())
+	}
+}
+
+// CallProblemVisitor has a method for every subtype of CallProblem. A new subtype breaks the build of the visitor implementations
+type CallProblemVisitor interface {
+	VisitCallProblemEcho(value *CallProblemEcho) error
+	VisitCallProblemNoise(value *CallProblemNoise) error
+	VisitCallProblemInterruptions(value *CallProblemInterruptions) error
+	VisitCallProblemDistortedSpeech(value *CallProblemDistortedSpeech) error
+	VisitCallProblemSilentLocal(value *CallProblemSilentLocal) error
+	VisitCallProblemSilentRemote(value *CallProblemSilentRemote) error
+	VisitCallProblemDropped(value *CallProblemDropped) error
+	VisitCallProblemDistortedVideo(value *CallProblemDistortedVideo) error
+	VisitCallProblemPixelatedVideo(value *CallProblemPixelatedVideo) error
+}
+
+// VisitCallProblem calls the method of the visitor for the subtype of the value
+func VisitCallProblem(value CallProblem, visitor CallProblemVisitor) error {
+	switch value := value.(type) {
+	case *CallProblemEcho:
+		return visitor.VisitCallProblemEcho(value)
+
+	case *CallProblemNoise:
+		return visitor.VisitCallProblemNoise(value)
+
+	case *CallProblemInterruptions:
+		return visitor.VisitCallProblemInterruptions(value)
+
+	case *CallProblemDistortedSpeech:
+		return visitor.VisitCallProblemDistortedSpeech(value)
+
+	case *CallProblemSilentLocal:
+		return visitor.VisitCallProblemSilentLocal(value)
+
+	case *CallProblemSilentRemote:
+		return visitor.VisitCallProblemSilentRemote(value)
+
+	case *CallProblemDropped:
+		return visitor.VisitCallProblemDropped(value)
+
+	case *CallProblemDistortedVideo:
+		return visitor.VisitCallProblemDistortedVideo(value)
+
+	case *CallProblemPixelatedVideo:
+		return visitor.VisitCallProblemPixelatedVideo(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.CallProblemType())
+	}
+}
+
+// FirebaseAuthenticationSettingsVisitor has a method for every subtype of FirebaseAuthenticationSettings. A new subtype breaks the build of the visitor implementations
+type FirebaseAuthenticationSettingsVisitor interface {
+	VisitFirebaseAuthenticationSettingsAndroid(value *FirebaseAuthenticationSettingsAndroid) error
+	VisitFirebaseAuthenticationSettingsIos(value *FirebaseAuthenticationSettingsIos) error
+}
+
+// VisitFirebaseAuthenticationSettings calls the method of the visitor for the subtype of the value
+func VisitFirebaseAuthenticationSettings(value FirebaseAuthenticationSettings, visitor FirebaseAuthenticationSettingsVisitor) error {
+	switch value := value.(type) {
+	case *FirebaseAuthenticationSettingsAndroid:
+		return visitor.VisitFirebaseAuthenticationSettingsAndroid(value)
+
+	case *FirebaseAuthenticationSettingsIos:
+		return visitor.VisitFirebaseAuthenticationSettingsIos(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.FirebaseAuthenticationSettingsType())
+	}
+}
+
+// DiceStickersVisitor has a method for every subtype of DiceStickers. A new subtype breaks the build of the visitor implementations
+type DiceStickersVisitor interface {
+	VisitDiceStickersRegular(value *DiceStickersRegular) error
+	VisitDiceStickersSlotMachine(value *DiceStickersSlotMachine) error
+}
+
+// VisitDiceStickers calls the method of the visitor for the subtype of the value
+func VisitDiceStickers(value DiceStickers, visitor DiceStickersVisitor) error {
+	switch value := value.(type) {
+	case *DiceStickersRegular:
+		return visitor.VisitDiceStickersRegular(value)
+
+	case *DiceStickersSlotMachine:
+		return visitor.VisitDiceStickersSlotMachine(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.DiceStickersType())
+	}
+}
+
+// SpeechRecognitionResultVisitor has a method for every subtype of SpeechRecognitionResult. A new subtype breaks the build of the visitor implementations
+type SpeechRecognitionResultVisitor interface {
+	VisitSpeechRecognitionResultPending(value *SpeechRecognitionResultPending) error
+	VisitSpeechRecognitionResultText(value *SpeechRecognitionResultText) error
+	VisitSpeechRecognitionResultError(value *SpeechRecognitionResultError) error
+}
+
+// VisitSpeechRecognitionResult calls the method of the visitor for the subtype of the value
+func VisitSpeechRecognitionResult(value SpeechRecognitionResult, visitor SpeechRecognitionResultVisitor) error {
+	switch value := value.(type) {
+	case *SpeechRecognitionResultPending:
+		return visitor.VisitSpeechRecognitionResultPending(value)
+
+	case *SpeechRecognitionResultText:
+		return visitor.VisitSpeechRecognitionResultText(value)
+
+	case *SpeechRecognitionResultError:
+		return visitor.VisitSpeechRecognitionResultError(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.SpeechRecognitionResultType())
+	}
+}
+
+// InputInlineQueryResultVisitor has a method for every subtype of InputInlineQueryResult. A new subtype breaks the build of the visitor implementations
+type InputInlineQueryResultVisitor interface {
+	VisitInputInlineQueryResultAnimation(value *InputInlineQueryResultAnimation) error
+	VisitInputInlineQueryResultArticle(value *InputInlineQueryResultArticle) error
+	VisitInputInlineQueryResultAudio(value *InputInlineQueryResultAudio) error
+	VisitInputInlineQueryResultContact(value *InputInlineQueryResultContact) error
+	VisitInputInlineQueryResultDocument(value *InputInlineQueryResultDocument) error
+	VisitInputInlineQueryResultGame(value *InputInlineQueryResultGame) error
+	VisitInputInlineQueryResultLocation(value *InputInlineQueryResultLocation) error
+	VisitInputInlineQueryResultPhoto(value *InputInlineQueryResultPhoto) error
+	VisitInputInlineQueryResultSticker(value *InputInlineQueryResultSticker) error
+	VisitInputInlineQueryResultVenue(value *InputInlineQueryResultVenue) error
+	VisitInputInlineQueryResultVideo(value *InputInlineQueryResultVideo) error
+	VisitInputInlineQueryResultVoiceNote(value *InputInlineQueryResultVoiceNote) error
+}
+
+// VisitInputInlineQueryResult calls the method of the visitor for the subtype of the value
+func VisitInputInlineQueryResult(value InputInlineQueryResult, visitor InputInlineQueryResultVisitor) error {
+	switch value := value.(type) {
+	case *InputInlineQueryResultAnimation:
+		return visitor.VisitInputInlineQueryResultAnimation(value)
+
+	case *InputInlineQueryResultArticle:
+		return visitor.VisitInputInlineQueryResultArticle(value)
+
+	case *InputInlineQueryResultAudio:
+		return visitor.VisitInputInlineQueryResultAudio(value)
+
+	case *InputInlineQueryResultContact:
+		return visitor.VisitInputInlineQueryResultContact(value)
+
+	case *InputInlineQueryResultDocument:
+		return visitor.VisitInputInlineQueryResultDocument(value)
+
+	case *InputInlineQueryResultGame:
+		return visitor.VisitInputInlineQueryResultGame(value)
+
+	case *InputInlineQueryResultLocation:
+		return visitor.VisitInputInlineQueryResultLocation(value)
+
+	case *InputInlineQueryResultPhoto:
+		return visitor.VisitInputInlineQueryResultPhoto(value)
+
+	case *InputInlineQueryResultSticker:
+		return visitor.VisitInputInlineQueryResultSticker(value)
+
+	case *InputInlineQueryResultVenue:
+		return visitor.VisitInputInlineQueryResultVenue(value)
+
+	case *InputInlineQueryResultVideo:
+		return visitor.VisitInputInlineQueryResultVideo(value)
+
+	case *InputInlineQueryResultVoiceNote:
+		return visitor.VisitInputInlineQueryResultVoiceNote(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.InputInlineQueryResultType())
+	}
+}
+
+// InlineQueryResultVisitor has a method for every subtype of InlineQueryResult. A new subtype breaks the build of the visitor implementations
+type InlineQueryResultVisitor interface {
+	VisitInlineQueryResultArticle(value *InlineQueryResultArticle) error
+	VisitInlineQueryResultContact(value *InlineQueryResultContact) error
+	VisitInlineQueryResultLocation(value *InlineQueryResultLocation) error
+	VisitInlineQueryResultVenue(value *InlineQueryResultVenue) error
+	VisitInlineQueryResultGame(value *InlineQueryResultGame) error
+	VisitInlineQueryResultAnimation(value *InlineQueryResultAnimation) error
+	VisitInlineQueryResultAudio(value *InlineQueryResultAudio) error
+	VisitInlineQueryResultDocument(value *InlineQueryResultDocument) error
+	VisitInlineQueryResultPhoto(value *InlineQueryResultPhoto) error
+	VisitInlineQueryResultSticker(value *InlineQueryResultSticker) error
+	VisitInlineQueryResultVideo(value *InlineQueryResultVideo) error
+	VisitInlineQueryResultVoiceNote(value *InlineQueryResultVoiceNote) error
+}
+
+// VisitInlineQueryResult calls the method of the visitor for the subtype of the value
+func VisitInlineQueryResult(value InlineQueryResult, visitor InlineQueryResultVisitor) error {
+	switch value := value.(type) {
+	case *InlineQueryResultArticle:
+		return visitor.VisitInlineQueryResultArticle(value)
+
+	case *InlineQueryResultContact:
+		return visitor.VisitInlineQueryResultContact(value)
+
+	case *InlineQueryResultLocation:
+		return visitor.VisitInlineQueryResultLocation(value)
+
+	case *InlineQueryResultVenue:
+		return visitor.VisitInlineQueryResultVenue(value)
+
+	case *InlineQueryResultGame:
+		return visitor.VisitInlineQueryResultGame(value)
+
+	case *InlineQueryResultAnimation:
+		return visitor.VisitInlineQueryResultAnimation(value)
+
+	case *InlineQueryResultAudio:
+		return visitor.VisitInlineQueryResultAudio(value)
+
+	case *InlineQueryResultDocument:
+		return visitor.VisitInlineQueryResultDocument(value)
+
+	case *InlineQueryResultPhoto:
+		return visitor.VisitInlineQueryResultPhoto(value)
+
+	case *InlineQueryResultSticker:
+		return visitor.VisitInlineQueryResultSticker(value)
+
+	case *InlineQueryResultVideo:
+		return visitor.VisitInlineQueryResultVideo(value)
+
+	case *InlineQueryResultVoiceNote:
+		return visitor.VisitInlineQueryResultVoiceNote(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.InlineQueryResultType())
+	}
+}
+
+// InlineQueryResultsButtonTypeVisitor has a method for every subtype of InlineQueryResultsButtonType. A new subtype breaks the build of the visitor implementations
+type InlineQueryResultsButtonTypeVisitor interface {
+	VisitInlineQueryResultsButtonTypeStartBot(value *InlineQueryResultsButtonTypeStartBot) error
+	VisitInlineQueryResultsButtonTypeWebApp(value *InlineQueryResultsButtonTypeWebApp) error
+}
+
+// VisitInlineQueryResultsButtonType calls the method of the visitor for the subtype of the value
+func VisitInlineQueryResultsButtonType(value InlineQueryResultsButtonType, visitor InlineQueryResultsButtonTypeVisitor) error {
+	switch value := value.(type) {
+	case *InlineQueryResultsButtonTypeStartBot:
+		return visitor.VisitInlineQueryResultsButtonTypeStartBot(value)
+
+	case *InlineQueryResultsButtonTypeWebApp:
+		return visitor.VisitInlineQueryResultsButtonTypeWebApp(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.InlineQueryResultsButtonTypeType())
+	}
+}
+
+// CallbackQueryPayloadVisitor has a method for every subtype of CallbackQueryPayload. A new subtype breaks the build of the visitor implementations
+type CallbackQueryPayloadVisitor interface {
+	VisitCallbackQueryPayloadData(value *CallbackQueryPayloadData) error
+	VisitCallbackQueryPayloadDataWithPassword(value *CallbackQueryPayloadDataWithPassword) error
+	VisitCallbackQueryPayloadGame(value *CallbackQueryPayloadGame) error
+}
+
+// VisitCallbackQueryPayload calls the method of the visitor for the subtype of the value
+func VisitCallbackQueryPayload(value CallbackQueryPayload, visitor CallbackQueryPayloadVisitor) error {
+	switch value := value.(type) {
+	case *CallbackQueryPayloadData:
+		return visitor.VisitCallbackQueryPayloadData(value)
+
+	case *CallbackQueryPayloadDataWithPassword:
+		return visitor.VisitCallbackQueryPayloadDataWithPassword(value)
+
+	case *CallbackQueryPayloadGame:
+		return visitor.VisitCallbackQueryPayloadGame(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.CallbackQueryPayloadType())
+	}
+}
+
+// ChatEventActionVisitor has a method for every subtype of ChatEventAction. A new subtype breaks the build of the visitor implementations
+type ChatEventActionVisitor interface {
+	VisitChatEventMessageEdited(value *ChatEventMessageEdited) error
+	VisitChatEventMessageDeleted(value *ChatEventMessageDeleted) error
+	VisitChatEventMessagePinned(value *ChatEventMessagePinned) error
+	VisitChatEventMessageUnpinned(value *ChatEventMessageUnpinned) error
+	VisitChatEventPollStopped(value *ChatEventPollStopped) error
+	VisitChatEventMemberJoined(value *ChatEventMemberJoined) error
+	VisitChatEventMemberJoinedByInviteLink(value *ChatEventMemberJoinedByInviteLink) error
+	VisitChatEventMemberJoinedByRequest(value *ChatEventMemberJoinedByRequest) error
+	VisitChatEventMemberInvited(value *ChatEventMemberInvited) error
+	VisitChatEventMemberLeft(value *ChatEventMemberLeft) error
+	VisitChatEventMemberPromoted(value *ChatEventMemberPromoted) error
+	VisitChatEventMemberRestricted(value *ChatEventMemberRestricted) error
+	VisitChatEventAvailableReactionsChanged(value *ChatEventAvailableReactionsChanged) error
+	VisitChatEventDescriptionChanged(value *ChatEventDescriptionChanged) error
+	VisitChatEventLinkedChatChanged(value *ChatEventLinkedChatChanged) error
+	VisitChatEventLocationChanged(value *ChatEventLocationChanged) error
+	VisitChatEventMessageAutoDeleteTimeChanged(value *ChatEventMessageAutoDeleteTimeChanged) error
+	VisitChatEventPermissionsChanged(value *ChatEventPermissionsChanged) error
+	VisitChatEventPhotoChanged(value *ChatEventPhotoChanged) error
+	VisitChatEventSlowModeDelayChanged(value *ChatEventSlowModeDelayChanged) error
+	VisitChatEventStickerSetChanged(value *ChatEventStickerSetChanged) error
+	VisitChatEventTitleChanged(value *ChatEventTitleChanged) error
+	VisitChatEventUsernameChanged(value *ChatEventUsernameChanged) error
+	VisitChatEventActiveUsernamesChanged(value *ChatEventActiveUsernamesChanged) error
+	VisitChatEventHasProtectedContentToggled(value *ChatEventHasProtectedContentToggled) error
+	VisitChatEventInvitesToggled(value *ChatEventInvitesToggled) error
+	VisitChatEventIsAllHistoryAvailableToggled(value *ChatEventIsAllHistoryAvailableToggled) error
+	VisitChatEventHasAggressiveAntiSpamEnabledToggled(value *ChatEventHasAggressiveAntiSpamEnabledToggled) error
+	VisitChatEventSignMessagesToggled(value *ChatEventSignMessagesToggled) error
+	VisitChatEventInviteLinkEdited(value *ChatEventInviteLinkEdited) error
+	VisitChatEventInviteLinkRevoked(value *ChatEventInviteLinkRevoked) error
+	VisitChatEventInviteLinkDeleted(value *ChatEventInviteLinkDeleted) error
+	VisitChatEventVideoChatCreated(value *ChatEventVideoChatCreated) error
+	VisitChatEventVideoChatEnded(value *ChatEventVideoChatEnded) error
+	VisitChatEventVideoChatMuteNewParticipantsToggled(value *ChatEventVideoChatMuteNewParticipantsToggled) error
+	VisitChatEventVideoChatParticipantIsMutedToggled(value *ChatEventVideoChatParticipantIsMutedToggled) error
+	VisitChatEventVideoChatParticipantVolumeLevelChanged(value *ChatEventVideoChatParticipantVolumeLevelChanged) error
+	VisitChatEventIsForumToggled(value *ChatEventIsForumToggled) error
+	VisitChatEventForumTopicCreated(value *ChatEventForumTopicCreated) error
+	VisitChatEventForumTopicEdited(value *ChatEventForumTopicEdited) error
+	VisitChatEventForumTopicToggleIsClosed(value *ChatEventForumTopicToggleIsClosed) error
+	VisitChatEventForumTopicToggleIsHidden(value *ChatEventForumTopicToggleIsHidden) error
+	VisitChatEventForumTopicDeleted(value *ChatEventForumTopicDeleted) error
+	VisitChatEventForumTopicPinned(value *ChatEventForumTopicPinned) error
+}
+
+// VisitChatEventAction calls the method of the visitor for the subtype of the value
+func VisitChatEventAction(value ChatEventAction, visitor ChatEventActionVisitor) error {
+	switch value := value.(type) {
+	case *ChatEventMessageEdited:
+		return visitor.VisitChatEventMessageEdited(value)
+
+	case *ChatEventMessageDeleted:
+		return visitor.VisitChatEventMessageDeleted(value)
+
+	case *ChatEventMessagePinned:
+		return visitor.VisitChatEventMessagePinned(value)
+
+	case *ChatEventMessageUnpinned:
+		return visitor.VisitChatEventMessageUnpinned(value)
+
+	case *ChatEventPollStopped:
+		return visitor.VisitChatEventPollStopped(value)
+
+	case *ChatEventMemberJoined:
+		return visitor.VisitChatEventMemberJoined(value)
+
+	case *ChatEventMemberJoinedByInviteLink:
+		return visitor.VisitChatEventMemberJoinedByInviteLink(value)
+
+	case *ChatEventMemberJoinedByRequest:
+		return visitor.VisitChatEventMemberJoinedByRequest(value)
+
+	case *ChatEventMemberInvited:
+		return visitor.VisitChatEventMemberInvited(value)
+
+	case *ChatEventMemberLeft:
+		return visitor.VisitChatEventMemberLeft(value)
+
+	case *ChatEventMemberPromoted:
+		return visitor.VisitChatEventMemberPromoted(value)
+
+	case *ChatEventMemberRestricted:
+		return visitor.VisitChatEventMemberRestricted(value)
+
+	case *ChatEventAvailableReactionsChanged:
+		return visitor.VisitChatEventAvailableReactionsChanged(value)
+
+	case *ChatEventDescriptionChanged:
+		return visitor.VisitChatEventDescriptionChanged(value)
+
+	case *ChatEventLinkedChatChanged:
+		return visitor.VisitChatEventLinkedChatChanged(value)
+
+	case *ChatEventLocationChanged:
+		return visitor.VisitChatEventLocationChanged(value)
+
+	case *ChatEventMessageAutoDeleteTimeChanged:
+		return visitor.VisitChatEventMessageAutoDeleteTimeChanged(value)
+
+	case *ChatEventPermissionsChanged:
+		return visitor.VisitChatEventPermissionsChanged(value)
+
+	case *ChatEventPhotoChanged:
+		return visitor.VisitChatEventPhotoChanged(value)
+
+	case *ChatEventSlowModeDelayChanged:
+		return visitor.VisitChatEventSlowModeDelayChanged(value)
+
+	case *ChatEventStickerSetChanged:
+		return visitor.VisitChatEventStickerSetChanged(value)
+
+	case *ChatEventTitleChanged:
+		return visitor.VisitChatEventTitleChanged(value)
+
+	case *ChatEventUsernameChanged:
+		return visitor.VisitChatEventUsernameChanged(value)
+
+	case *ChatEventActiveUsernamesChanged:
+		return visitor.VisitChatEventActiveUsernamesChanged(value)
+
+	case *ChatEventHasProtectedContentToggled:
+		return visitor.VisitChatEventHasProtectedContentToggled(value)
+
+	case *ChatEventInvitesToggled:
+		return visitor.VisitChatEventInvitesToggled(value)
+
+	case *ChatEventIsAllHistoryAvailableToggled:
+		return visitor.VisitChatEventIsAllHistoryAvailableToggled(value)
+
+	case *ChatEventHasAggressiveAntiSpamEnabledToggled:
+		return visitor.VisitChatEventHasAggressiveAntiSpamEnabledToggled(value)
+
+	case *ChatEventSignMessagesToggled:
+		return visitor.VisitChatEventSignMessagesToggled(value)
+
+	case *ChatEventInviteLinkEdited:
+		return visitor.VisitChatEventInviteLinkEdited(value)
+
+	case *ChatEventInviteLinkRevoked:
+		return visitor.VisitChatEventInviteLinkRevoked(value)
+
+	case *ChatEventInviteLinkDeleted:
+		return visitor.VisitChatEventInviteLinkDeleted(value)
+
+	case *ChatEventVideoChatCreated:
+		return visitor.VisitChatEventVideoChatCreated(value)
+
+	case *ChatEventVideoChatEnded:
+		return visitor.VisitChatEventVideoChatEnded(value)
+
+	case *ChatEventVideoChatMuteNewParticipantsToggled:
+		return visitor.VisitChatEventVideoChatMuteNewParticipantsToggled(value)
+
+	case *ChatEventVideoChatParticipantIsMutedToggled:
+		return visitor.VisitChatEventVideoChatParticipantIsMutedToggled(value)
+
+	case *ChatEventVideoChatParticipantVolumeLevelChanged:
+		return visitor.VisitChatEventVideoChatParticipantVolumeLevelChanged(value)
+
+	case *ChatEventIsForumToggled:
+		return visitor.VisitChatEventIsForumToggled(value)
+
+	case *ChatEventForumTopicCreated:
+		return visitor.VisitChatEventForumTopicCreated(value)
+
+	case *ChatEventForumTopicEdited:
+		return visitor.VisitChatEventForumTopicEdited(value)
+
+	case *ChatEventForumTopicToggleIsClosed:
+		return visitor.VisitChatEventForumTopicToggleIsClosed(value)
+
+	case *ChatEventForumTopicToggleIsHidden:
+		return visitor.VisitChatEventForumTopicToggleIsHidden(value)
+
+	case *ChatEventForumTopicDeleted:
+		return visitor.VisitChatEventForumTopicDeleted(value)
+
+	case *ChatEventForumTopicPinned:
+		return visitor.VisitChatEventForumTopicPinned(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.ChatEventActionType())
+	}
+}
+
+// LanguagePackStringValueVisitor has a method for every subtype of LanguagePackStringValue. A new subtype breaks the build of the visitor implementations
+type LanguagePackStringValueVisitor interface {
+	VisitLanguagePackStringValueOrdinary(value *LanguagePackStringValueOrdinary) error
+	VisitLanguagePackStringValuePluralized(value *LanguagePackStringValuePluralized) error
+	VisitLanguagePackStringValueDeleted(value *LanguagePackStringValueDeleted) error
+}
+
+// VisitLanguagePackStringValue calls the method of the visitor for the subtype of the value
+func VisitLanguagePackStringValue(value LanguagePackStringValue, visitor LanguagePackStringValueVisitor) error {
+	switch value := value.(type) {
+	case *LanguagePackStringValueOrdinary:
+		return visitor.VisitLanguagePackStringValueOrdinary(value)
+
+	case *LanguagePackStringValuePluralized:
+		return visitor.VisitLanguagePackStringValuePluralized(value)
+
+	case *LanguagePackStringValueDeleted:
+		return visitor.VisitLanguagePackStringValueDeleted(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.LanguagePackStringValueType())
+	}
+}
+
+// PremiumLimitTypeVisitor has a method for every subtype of PremiumLimitType. A new subtype breaks the build of the visitor implementations
+type PremiumLimitTypeVisitor interface {
+	VisitPremiumLimitTypeSupergroupCount(value *PremiumLimitTypeSupergroupCount) error
+	VisitPremiumLimitTypePinnedChatCount(value *PremiumLimitTypePinnedChatCount) error
+	VisitPremiumLimitTypeCreatedPublicChatCount(value *PremiumLimitTypeCreatedPublicChatCount) error
+	VisitPremiumLimitTypeSavedAnimationCount(value *PremiumLimitTypeSavedAnimationCount) error
+	VisitPremiumLimitTypeFavoriteStickerCount(value *PremiumLimitTypeFavoriteStickerCount) error
+	VisitPremiumLimitTypeChatFolderCount(value *PremiumLimitTypeChatFolderCount) error
+	VisitPremiumLimitTypeChatFolderChosenChatCount(value *PremiumLimitTypeChatFolderChosenChatCount) error
+	VisitPremiumLimitTypePinnedArchivedChatCount(value *PremiumLimitTypePinnedArchivedChatCount) error
+	VisitPremiumLimitTypeCaptionLength(value *PremiumLimitTypeCaptionLength) error
+	VisitPremiumLimitTypeBioLength(value *PremiumLimitTypeBioLength) error
+	VisitPremiumLimitTypeChatFolderInviteLinkCount(value *PremiumLimitTypeChatFolderInviteLinkCount) error
+	VisitPremiumLimitTypeShareableChatFolderCount(value *PremiumLimitTypeShareableChatFolderCount) error
+}
+
+// VisitPremiumLimitType calls the method of the visitor for the subtype of the value
+func VisitPremiumLimitType(value PremiumLimitType, visitor PremiumLimitTypeVisitor) error {
+	switch value := value.(type) {
+	case *PremiumLimitTypeSupergroupCount:
+		return visitor.VisitPremiumLimitTypeSupergroupCount(value)
+
+	case *PremiumLimitTypePinnedChatCount:
+		return visitor.VisitPremiumLimitTypePinnedChatCount(value)
+
+	case *PremiumLimitTypeCreatedPublicChatCount:
+		return visitor.VisitPremiumLimitTypeCreatedPublicChatCount(value)
+
+	case *PremiumLimitTypeSavedAnimationCount:
+		return visitor.VisitPremiumLimitTypeSavedAnimationCount(value)
+
+	case *PremiumLimitTypeFavoriteStickerCount:
+		return visitor.VisitPremiumLimitTypeFavoriteStickerCount(value)
+
+	case *PremiumLimitTypeChatFolderCount:
+		return visitor.VisitPremiumLimitTypeChatFolderCount(value)
+
+	case *PremiumLimitTypeChatFolderChosenChatCount:
+		return visitor.VisitPremiumLimitTypeChatFolderChosenChatCount(value)
+
+	case *PremiumLimitTypePinnedArchivedChatCount:
+		return visitor.VisitPremiumLimitTypePinnedArchivedChatCount(value)
+
+	case *PremiumLimitTypeCaptionLength:
+		return visitor.VisitPremiumLimitTypeCaptionLength(value)
+
+	case *PremiumLimitTypeBioLength:
+		return visitor.VisitPremiumLimitTypeBioLength(value)
+
+	case *PremiumLimitTypeChatFolderInviteLinkCount:
+		return visitor.VisitPremiumLimitTypeChatFolderInviteLinkCount(value)
+
+	case *PremiumLimitTypeShareableChatFolderCount:
+		return visitor.VisitPremiumLimitTypeShareableChatFolderCount(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.PremiumLimitTypeType())
+	}
+}
+
+// PremiumFeatureVisitor has a method for every subtype of PremiumFeature. A new subtype breaks the build of the visitor implementations
+type PremiumFeatureVisitor interface {
+	VisitPremiumFeatureIncreasedLimits(value *PremiumFeatureIncreasedLimits) error
+	VisitPremiumFeatureIncreasedUploadFileSize(value *PremiumFeatureIncreasedUploadFileSize) error
+	VisitPremiumFeatureImprovedDownloadSpeed(value *PremiumFeatureImprovedDownloadSpeed) error
+	VisitPremiumFeatureVoiceRecognition(value *PremiumFeatureVoiceRecognition) error
+	VisitPremiumFeatureDisabledAds(value *PremiumFeatureDisabledAds) error
+	VisitPremiumFeatureUniqueReactions(value *PremiumFeatureUniqueReactions) error
+	VisitPremiumFeatureUniqueStickers(value *PremiumFeatureUniqueStickers) error
+	VisitPremiumFeatureCustomEmoji(value *PremiumFeatureCustomEmoji) error
+	VisitPremiumFeatureAdvancedChatManagement(value *PremiumFeatureAdvancedChatManagement) error
+	VisitPremiumFeatureProfileBadge(value *PremiumFeatureProfileBadge) error
+	VisitPremiumFeatureEmojiStatus(value *PremiumFeatureEmojiStatus) error
+	VisitPremiumFeatureAnimatedProfilePhoto(value *PremiumFeatureAnimatedProfilePhoto) error
+	VisitPremiumFeatureForumTopicIcon(value *PremiumFeatureForumTopicIcon) error
+	VisitPremiumFeatureAppIcons(value *PremiumFeatureAppIcons) error
+	VisitPremiumFeatureRealTimeChatTranslation(value *PremiumFeatureRealTimeChatTranslation) error
+}
+
+// VisitPremiumFeature calls the method of the visitor for the subtype of the value
+func VisitPremiumFeature(value PremiumFeature, visitor PremiumFeatureVisitor) error {
+	switch value := value.(type) {
+	case *PremiumFeatureIncreasedLimits:
+		return visitor.VisitPremiumFeatureIncreasedLimits(value)
+
+	case *PremiumFeatureIncreasedUploadFileSize:
+		return visitor.VisitPremiumFeatureIncreasedUploadFileSize(value)
+
+	case *PremiumFeatureImprovedDownloadSpeed:
+		return visitor.VisitPremiumFeatureImprovedDownloadSpeed(value)
+
+	case *PremiumFeatureVoiceRecognition:
+		return visitor.VisitPremiumFeatureVoiceRecognition(value)
+
+	case *PremiumFeatureDisabledAds:
+		return visitor.VisitPremiumFeatureDisabledAds(value)
+
+	case *PremiumFeatureUniqueReactions:
+		return visitor.VisitPremiumFeatureUniqueReactions(value)
+
+	case *PremiumFeatureUniqueStickers:
+		return visitor.VisitPremiumFeatureUniqueStickers(value)
+
+	case *PremiumFeatureCustomEmoji:
+		return visitor.VisitPremiumFeatureCustomEmoji(value)
+
+	case *PremiumFeatureAdvancedChatManagement:
+		return visitor.VisitPremiumFeatureAdvancedChatManagement(value)
+
+	case *PremiumFeatureProfileBadge:
+		return visitor.VisitPremiumFeatureProfileBadge(value)
+
+	case *PremiumFeatureEmojiStatus:
+		return visitor.VisitPremiumFeatureEmojiStatus(value)
+
+	case *PremiumFeatureAnimatedProfilePhoto:
+		return visitor.VisitPremiumFeatureAnimatedProfilePhoto(value)
+
+	case *PremiumFeatureForumTopicIcon:
+		return visitor.VisitPremiumFeatureForumTopicIcon(value)
+
+	case *PremiumFeatureAppIcons:
+		return visitor.VisitPremiumFeatureAppIcons(value)
+
+	case *PremiumFeatureRealTimeChatTranslation:
+		return visitor.VisitPremiumFeatureRealTimeChatTranslation(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.PremiumFeatureType())
+	}
+}
+
+// PremiumSourceVisitor has a method for every subtype of PremiumSource. A new subtype breaks the build of the visitor implementations
+type PremiumSourceVisitor interface {
+	VisitPremiumSourceLimitExceeded(value *PremiumSourceLimitExceeded) error
+	VisitPremiumSourceFeature(value *PremiumSourceFeature) error
+	VisitPremiumSourceLink(value *PremiumSourceLink) error
+	VisitPremiumSourceSettings(value *PremiumSourceSettings) error
+}
+
+// VisitPremiumSource calls the method of the visitor for the subtype of the value
+func VisitPremiumSource(value PremiumSource, visitor PremiumSourceVisitor) error {
+	switch value := value.(type) {
+	case *PremiumSourceLimitExceeded:
+		return visitor.VisitPremiumSourceLimitExceeded(value)
+
+	case *PremiumSourceFeature:
+		return visitor.VisitPremiumSourceFeature(value)
+
+	case *PremiumSourceLink:
+		return visitor.VisitPremiumSourceLink(value)
+
+	case *PremiumSourceSettings:
+		return visitor.VisitPremiumSourceSettings(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.PremiumSourceType())
+	}
+}
+
+// StorePaymentPurposeVisitor has a method for every subtype of StorePaymentPurpose. A new subtype breaks the build of the visitor implementations
+type StorePaymentPurposeVisitor interface {
+	VisitStorePaymentPurposePremiumSubscription(value *StorePaymentPurposePremiumSubscription) error
+	VisitStorePaymentPurposeGiftedPremium(value *StorePaymentPurposeGiftedPremium) error
+}
+
+// VisitStorePaymentPurpose calls the method of the visitor for the subtype of the value
+func VisitStorePaymentPurpose(value StorePaymentPurpose, visitor StorePaymentPurposeVisitor) error {
+	switch value := value.(type) {
+	case *StorePaymentPurposePremiumSubscription:
+		return visitor.VisitStorePaymentPurposePremiumSubscription(value)
+
+	case *StorePaymentPurposeGiftedPremium:
+		return visitor.VisitStorePaymentPurposeGiftedPremium(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.StorePaymentPurposeType())
+	}
+}
+
+// DeviceTokenVisitor has a method for every subtype of DeviceToken. A new subtype breaks the build of the visitor implementations
+type DeviceTokenVisitor interface {
+	VisitDeviceTokenFirebaseCloudMessaging(value *DeviceTokenFirebaseCloudMessaging) error
+	VisitDeviceTokenApplePush(value *DeviceTokenApplePush) error
+	VisitDeviceTokenApplePushVoIP(value *DeviceTokenApplePushVoIP) error
+	VisitDeviceTokenWindowsPush(value *DeviceTokenWindowsPush) error
+	VisitDeviceTokenMicrosoftPush(value *DeviceTokenMicrosoftPush) error
+	VisitDeviceTokenMicrosoftPushVoIP(value *DeviceTokenMicrosoftPushVoIP) error
+	VisitDeviceTokenWebPush(value *DeviceTokenWebPush) error
+	VisitDeviceTokenSimplePush(value *DeviceTokenSimplePush) error
+	VisitDeviceTokenUbuntuPush(value *DeviceTokenUbuntuPush) error
+	VisitDeviceTokenBlackBerryPush(value *DeviceTokenBlackBerryPush) error
+	VisitDeviceTokenTizenPush(value *DeviceTokenTizenPush) error
+	VisitDeviceTokenHuaweiPush(value *DeviceTokenHuaweiPush) error
+}
+
+// VisitDeviceToken calls the method of the visitor for the subtype of the value
+func VisitDeviceToken(value DeviceToken, visitor DeviceTokenVisitor) error {
+	switch value := value.(type) {
+	case *DeviceTokenFirebaseCloudMessaging:
+		return visitor.VisitDeviceTokenFirebaseCloudMessaging(value)
+
+	case *DeviceTokenApplePush:
+		return visitor.VisitDeviceTokenApplePush(value)
+
+	case *DeviceTokenApplePushVoIP:
+		return visitor.VisitDeviceTokenApplePushVoIP(value)
+
+	case *DeviceTokenWindowsPush:
+		return visitor.VisitDeviceTokenWindowsPush(value)
+
+	case *DeviceTokenMicrosoftPush:
+		return visitor.VisitDeviceTokenMicrosoftPush(value)
+
+	case *DeviceTokenMicrosoftPushVoIP:
+		return visitor.VisitDeviceTokenMicrosoftPushVoIP(value)
+
+	case *DeviceTokenWebPush:
+		return visitor.VisitDeviceTokenWebPush(value)
+
+	case *DeviceTokenSimplePush:
+		return visitor.VisitDeviceTokenSimplePush(value)
+
+	case *DeviceTokenUbuntuPush:
+		return visitor.VisitDeviceTokenUbuntuPush(value)
+
+	case *DeviceTokenBlackBerryPush:
+		return visitor.VisitDeviceTokenBlackBerryPush(value)
+
+	case *DeviceTokenTizenPush:
+		return visitor.VisitDeviceTokenTizenPush(value)
+
+	case *DeviceTokenHuaweiPush:
+		return visitor.VisitDeviceTokenHuaweiPush(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.DeviceTokenType())
+	}
+}
+
+// BackgroundFillVisitor has a method for every subtype of BackgroundFill. A new subtype breaks the build of the visitor implementations
+type BackgroundFillVisitor interface {
+	VisitBackgroundFillSolid(value *BackgroundFillSolid) error
+	VisitBackgroundFillGradient(value *BackgroundFillGradient) error
+	VisitBackgroundFillFreeformGradient(value *BackgroundFillFreeformGradient) error
+}
+
+// VisitBackgroundFill calls the method of the visitor for the subtype of the value
+func VisitBackgroundFill(value BackgroundFill, visitor BackgroundFillVisitor) error {
+	switch value := value.(type) {
+	case *BackgroundFillSolid:
+		return visitor.VisitBackgroundFillSolid(value)
+
+	case *BackgroundFillGradient:
+		return visitor.VisitBackgroundFillGradient(value)
+
+	case *BackgroundFillFreeformGradient:
+		return visitor.VisitBackgroundFillFreeformGradient(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.BackgroundFillType())
+	}
+}
+
+// BackgroundTypeVisitor has a method for every subtype of BackgroundType. A new subtype breaks the build of the visitor implementations
+type BackgroundTypeVisitor interface {
+	VisitBackgroundTypeWallpaper(value *BackgroundTypeWallpaper) error
+	VisitBackgroundTypePattern(value *BackgroundTypePattern) error
+	VisitBackgroundTypeFill(value *BackgroundTypeFill) error
+}
+
+// VisitBackgroundType calls the method of the visitor for the subtype of the value
+func VisitBackgroundType(value BackgroundType, visitor BackgroundTypeVisitor) error {
+	switch value := value.(type) {
+	case *BackgroundTypeWallpaper:
+		return visitor.VisitBackgroundTypeWallpaper(value)
+
+	case *BackgroundTypePattern:
+		return visitor.VisitBackgroundTypePattern(value)
+
+	case *BackgroundTypeFill:
+		return visitor.VisitBackgroundTypeFill(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.BackgroundTypeType())
+	}
+}
+
+// InputBackgroundVisitor has a method for every subtype of InputBackground. A new subtype breaks the build of the visitor implementations
+type InputBackgroundVisitor interface {
+	VisitInputBackgroundLocal(value *InputBackgroundLocal) error
+	VisitInputBackgroundRemote(value *InputBackgroundRemote) error
+	VisitInputBackgroundPrevious(value *InputBackgroundPrevious) error
+}
+
+// VisitInputBackground calls the method of the visitor for the subtype of the value
+func VisitInputBackground(value InputBackground, visitor InputBackgroundVisitor) error {
+	switch value := value.(type) {
+	case *InputBackgroundLocal:
+		return visitor.VisitInputBackgroundLocal(value)
+
+	case *InputBackgroundRemote:
+		return visitor.VisitInputBackgroundRemote(value)
+
+	case *InputBackgroundPrevious:
+		return visitor.VisitInputBackgroundPrevious(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.InputBackgroundType())
+	}
+}
+
+// CanTransferOwnershipResultVisitor has a method for every subtype of CanTransferOwnershipResult. A new subtype breaks the build of the visitor implementations
+type CanTransferOwnershipResultVisitor interface {
+	VisitCanTransferOwnershipResultOk(value *CanTransferOwnershipResultOk) error
+	VisitCanTransferOwnershipResultPasswordNeeded(value *CanTransferOwnershipResultPasswordNeeded) error
+	VisitCanTransferOwnershipResultPasswordTooFresh(value *CanTransferOwnershipResultPasswordTooFresh) error
+	VisitCanTransferOwnershipResultSessionTooFresh(value *CanTransferOwnershipResultSessionTooFresh) error
+}
+
+// VisitCanTransferOwnershipResult calls the method of the visitor for the subtype of the value
+func VisitCanTransferOwnershipResult(value CanTransferOwnershipResult, visitor CanTransferOwnershipResultVisitor) error {
+	switch value := value.(type) {
+	case *CanTransferOwnershipResultOk:
+		return visitor.VisitCanTransferOwnershipResultOk(value)
+
+	case *CanTransferOwnershipResultPasswordNeeded:
+		return visitor.VisitCanTransferOwnershipResultPasswordNeeded(value)
+
+	case *CanTransferOwnershipResultPasswordTooFresh:
+		return visitor.VisitCanTransferOwnershipResultPasswordTooFresh(value)
+
+	case *CanTransferOwnershipResultSessionTooFresh:
+		return visitor.VisitCanTransferOwnershipResultSessionTooFresh(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.CanTransferOwnershipResultType())
+	}
+}
+
+// CheckChatUsernameResultVisitor has a method for every subtype of CheckChatUsernameResult. A new subtype breaks the build of the visitor implementations
+type CheckChatUsernameResultVisitor interface {
+	VisitCheckChatUsernameResultOk(value *CheckChatUsernameResultOk) error
+	VisitCheckChatUsernameResultUsernameInvalid(value *CheckChatUsernameResultUsernameInvalid) error
+	VisitCheckChatUsernameResultUsernameOccupied(value *CheckChatUsernameResultUsernameOccupied) error
+	VisitCheckChatUsernameResultUsernamePurchasable(value *CheckChatUsernameResultUsernamePurchasable) error
+	VisitCheckChatUsernameResultPublicChatsTooMany(value *CheckChatUsernameResultPublicChatsTooMany) error
+	VisitCheckChatUsernameResultPublicGroupsUnavailable(value *CheckChatUsernameResultPublicGroupsUnavailable) error
+}
+
+// VisitCheckChatUsernameResult calls the method of the visitor for the subtype of the value
+func VisitCheckChatUsernameResult(value CheckChatUsernameResult, visitor CheckChatUsernameResultVisitor) error {
+	switch value := value.(type) {
+	case *CheckChatUsernameResultOk:
+		return visitor.VisitCheckChatUsernameResultOk(value)
+
+	case *CheckChatUsernameResultUsernameInvalid:
+		return visitor.VisitCheckChatUsernameResultUsernameInvalid(value)
+
+	case *CheckChatUsernameResultUsernameOccupied:
+		return visitor.VisitCheckChatUsernameResultUsernameOccupied(value)
+
+	case *CheckChatUsernameResultUsernamePurchasable:
+		return visitor.VisitCheckChatUsernameResultUsernamePurchasable(value)
+
+	case *CheckChatUsernameResultPublicChatsTooMany:
+		return visitor.VisitCheckChatUsernameResultPublicChatsTooMany(value)
+
+	case *CheckChatUsernameResultPublicGroupsUnavailable:
+		return visitor.VisitCheckChatUsernameResultPublicGroupsUnavailable(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.CheckChatUsernameResultType())
+	}
+}
+
+// CheckStickerSetNameResultVisitor has a method for every subtype of CheckStickerSetNameResult. A new subtype breaks the build of the visitor implementations
+type CheckStickerSetNameResultVisitor interface {
+	VisitCheckStickerSetNameResultOk(value *CheckStickerSetNameResultOk) error
+	VisitCheckStickerSetNameResultNameInvalid(value *CheckStickerSetNameResultNameInvalid) error
+	VisitCheckStickerSetNameResultNameOccupied(value *CheckStickerSetNameResultNameOccupied) error
+}
+
+// VisitCheckStickerSetNameResult calls the method of the visitor for the subtype of the value
+func VisitCheckStickerSetNameResult(value CheckStickerSetNameResult, visitor CheckStickerSetNameResultVisitor) error {
+	switch value := value.(type) {
+	case *CheckStickerSetNameResultOk:
+		return visitor.VisitCheckStickerSetNameResultOk(value)
+
+	case *CheckStickerSetNameResultNameInvalid:
+		return visitor.VisitCheckStickerSetNameResultNameInvalid(value)
+
+	case *CheckStickerSetNameResultNameOccupied:
+		return visitor.VisitCheckStickerSetNameResultNameOccupied(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.CheckStickerSetNameResultType())
+	}
+}
+
+// ResetPasswordResultVisitor has a method for every subtype of ResetPasswordResult. A new subtype breaks the build of the visitor implementations
+type ResetPasswordResultVisitor interface {
+	VisitResetPasswordResultOk(value *ResetPasswordResultOk) error
+	VisitResetPasswordResultPending(value *ResetPasswordResultPending) error
+	VisitResetPasswordResultDeclined(value *ResetPasswordResultDeclined) error
+}
+
+// VisitResetPasswordResult calls the method of the visitor for the subtype of the value
+func VisitResetPasswordResult(value ResetPasswordResult, visitor ResetPasswordResultVisitor) error {
+	switch value := value.(type) {
+	case *ResetPasswordResultOk:
+		return visitor.VisitResetPasswordResultOk(value)
+
+	case *ResetPasswordResultPending:
+		return visitor.VisitResetPasswordResultPending(value)
+
+	case *ResetPasswordResultDeclined:
+		return visitor.VisitResetPasswordResultDeclined(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.ResetPasswordResultType())
+	}
+}
+
+// MessageFileTypeVisitor has a method for every subtype of MessageFileType. A new subtype breaks the build of the visitor implementations
+type MessageFileTypeVisitor interface {
+	VisitMessageFileTypePrivate(value *MessageFileTypePrivate) error
+	VisitMessageFileTypeGroup(value *MessageFileTypeGroup) error
+	VisitMessageFileTypeUnknown(value *MessageFileTypeUnknown) error
+}
+
+// VisitMessageFileType calls the method of the visitor for the subtype of the value
+func VisitMessageFileType(value MessageFileType, visitor MessageFileTypeVisitor) error {
+	switch value := value.(type) {
+	case *MessageFileTypePrivate:
+		return visitor.VisitMessageFileTypePrivate(value)
+
+	case *MessageFileTypeGroup:
+		return visitor.VisitMessageFileTypeGroup(value)
+
+	case *MessageFileTypeUnknown:
+		return visitor.VisitMessageFileTypeUnknown(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.MessageFileTypeType())
+	}
+}
+
+// PushMessageContentVisitor has a method for every subtype of PushMessageContent. A new subtype breaks the build of the visitor implementations
+type PushMessageContentVisitor interface {
+	VisitPushMessageContentHidden(value *PushMessageContentHidden) error
+	VisitPushMessageContentAnimation(value *PushMessageContentAnimation) error
+	VisitPushMessageContentAudio(value *PushMessageContentAudio) error
+	VisitPushMessageContentContact(value *PushMessageContentContact) error
+	VisitPushMessageContentContactRegistered(value *PushMessageContentContactRegistered) error
+	VisitPushMessageContentDocument(value *PushMessageContentDocument) error
+	VisitPushMessageContentGame(value *PushMessageContentGame) error
+	VisitPushMessageContentGameScore(value *PushMessageContentGameScore) error
+	VisitPushMessageContentInvoice(value *PushMessageContentInvoice) error
+	VisitPushMessageContentLocation(value *PushMessageContentLocation) error
+	VisitPushMessageContentPhoto(value *PushMessageContentPhoto) error
+	VisitPushMessageContentPoll(value *PushMessageContentPoll) error
+	VisitPushMessageContentScreenshotTaken(value *PushMessageContentScreenshotTaken) error
+	VisitPushMessageContentSticker(value *PushMessageContentSticker) error
+	VisitPushMessageContentText(value *PushMessageContentText) error
+	VisitPushMessageContentVideo(value *PushMessageContentVideo) error
+	VisitPushMessageContentVideoNote(value *PushMessageContentVideoNote) error
+	VisitPushMessageContentVoiceNote(value *PushMessageContentVoiceNote) error
+	VisitPushMessageContentBasicGroupChatCreate(value *PushMessageContentBasicGroupChatCreate) error
+	VisitPushMessageContentChatAddMembers(value *PushMessageContentChatAddMembers) error
+	VisitPushMessageContentChatChangePhoto(value *PushMessageContentChatChangePhoto) error
+	VisitPushMessageContentChatChangeTitle(value *PushMessageContentChatChangeTitle) error
+	VisitPushMessageContentChatSetBackground(value *PushMessageContentChatSetBackground) error
+	VisitPushMessageContentChatSetTheme(value *PushMessageContentChatSetTheme) error
+	VisitPushMessageContentChatDeleteMember(value *PushMessageContentChatDeleteMember) error
+	VisitPushMessageContentChatJoinByLink(value *PushMessageContentChatJoinByLink) error
+	VisitPushMessageContentChatJoinByRequest(value *PushMessageContentChatJoinByRequest) error
+	VisitPushMessageContentRecurringPayment(value *PushMessageContentRecurringPayment) error
+	VisitPushMessageContentSuggestProfilePhoto(value *PushMessageContentSuggestProfilePhoto) error
+	VisitPushMessageContentMessageForwards(value *PushMessageContentMessageForwards) error
+	VisitPushMessageContentMediaAlbum(value *PushMessageContentMediaAlbum) error
+}
+
+// VisitPushMessageContent calls the method of the visitor for the subtype of the value
+func VisitPushMessageContent(value PushMessageContent, visitor PushMessageContentVisitor) error {
+	switch value := value.(type) {
+	case *PushMessageContentHidden:
+		return visitor.VisitPushMessageContentHidden(value)
+
+	case *PushMessageContentAnimation:
+		return visitor.VisitPushMessageContentAnimation(value)
+
+	case *PushMessageContentAudio:
+		return visitor.VisitPushMessageContentAudio(value)
+
+	case *PushMessageContentContact:
+		return visitor.VisitPushMessageContentContact(value)
+
+	case *PushMessageContentContactRegistered:
+		return visitor.VisitPushMessageContentContactRegistered(value)
+
+	case *PushMessageContentDocument:
+		return visitor.VisitPushMessageContentDocument(value)
+
+	case *PushMessageContentGame:
+		return visitor.VisitPushMessageContentGame(value)
+
+	case *PushMessageContentGameScore:
+		return visitor.VisitPushMessageContentGameScore(value)
+
+	case *PushMessageContentInvoice:
+		return visitor.VisitPushMessageContentInvoice(value)
+
+	case *PushMessageContentLocation:
+		return visitor.VisitPushMessageContentLocation(value)
+
+	case *PushMessageContentPhoto:
+		return visitor.VisitPushMessageContentPhoto(value)
+
+	case *PushMessageContentPoll:
+		return visitor.VisitPushMessageContentPoll(value)
+
+	case *PushMessageContentScreenshotTaken:
+		return visitor.VisitPushMessageContentScreenshotTaken(value)
+
+	case *PushMessageContentSticker:
+		return visitor.VisitPushMessageContentSticker(value)
+
+	case *PushMessageContentText:
+		return visitor.VisitPushMessageContentText(value)
+
+	case *PushMessageContentVideo:
+		return visitor.VisitPushMessageContentVideo(value)
+
+	case *PushMessageContentVideoNote:
+		return visitor.VisitPushMessageContentVideoNote(value)
+
+	case *PushMessageContentVoiceNote:
+		return visitor.VisitPushMessageContentVoiceNote(value)
+
+	case *PushMessageContentBasicGroupChatCreate:
+		return visitor.VisitPushMessageContentBasicGroupChatCreate(value)
+
+	case *PushMessageContentChatAddMembers:
+		return visitor.VisitPushMessageContentChatAddMembers(value)
+
+	case *PushMessageContentChatChangePhoto:
+		return visitor.VisitPushMessageContentChatChangePhoto(value)
+
+	case *PushMessageContentChatChangeTitle:
+		return visitor.VisitPushMessageContentChatChangeTitle(value)
+
+	case *PushMessageContentChatSetBackground:
+		return visitor.VisitPushMessageContentChatSetBackground(value)
+
+	case *PushMessageContentChatSetTheme:
+		return visitor.VisitPushMessageContentChatSetTheme(value)
+
+	case *PushMessageContentChatDeleteMember:
+		return visitor.VisitPushMessageContentChatDeleteMember(value)
+
+	case *PushMessageContentChatJoinByLink:
+		return visitor.VisitPushMessageContentChatJoinByLink(value)
+
+	case *PushMessageContentChatJoinByRequest:
+		return visitor.VisitPushMessageContentChatJoinByRequest(value)
+
+	case *PushMessageContentRecurringPayment:
+		return visitor.VisitPushMessageContentRecurringPayment(value)
+
+	case *PushMessageContentSuggestProfilePhoto:
+		return visitor.VisitPushMessageContentSuggestProfilePhoto(value)
+
+	case *PushMessageContentMessageForwards:
+		return visitor.VisitPushMessageContentMessageForwards(value)
+
+	case *PushMessageContentMediaAlbum:
+		return visitor.VisitPushMessageContentMediaAlbum(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.PushMessageContentType())
+	}
+}
+
+// NotificationTypeVisitor has a method for every subtype of NotificationType. A new subtype breaks the build of the visitor implementations
+type NotificationTypeVisitor interface {
+	VisitNotificationTypeNewMessage(value *NotificationTypeNewMessage) error
+	VisitNotificationTypeNewSecretChat(value *NotificationTypeNewSecretChat) error
+	VisitNotificationTypeNewCall(value *NotificationTypeNewCall) error
+	VisitNotificationTypeNewPushMessage(value *NotificationTypeNewPushMessage) error
+}
+
+// VisitNotificationType calls the method of the visitor for the subtype of the value
+func VisitNotificationType(value NotificationType, visitor NotificationTypeVisitor) error {
+	switch value := value.(type) {
+	case *NotificationTypeNewMessage:
+		return visitor.VisitNotificationTypeNewMessage(value)
+
+	case *NotificationTypeNewSecretChat:
+		return visitor.VisitNotificationTypeNewSecretChat(value)
+
+	case *NotificationTypeNewCall:
+		return visitor.VisitNotificationTypeNewCall(value)
+
+	case *NotificationTypeNewPushMessage:
+		return visitor.VisitNotificationTypeNewPushMessage(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.NotificationTypeType())
+	}
+}
+
+// NotificationGroupTypeVisitor has a method for every subtype of NotificationGroupType. A new subtype breaks the build of the visitor implementations
+type NotificationGroupTypeVisitor interface {
+	VisitNotificationGroupTypeMessages(value *NotificationGroupTypeMessages) error
+	VisitNotificationGroupTypeMentions(value *NotificationGroupTypeMentions) error
+	VisitNotificationGroupTypeSecretChat(value *NotificationGroupTypeSecretChat) error
+	VisitNotificationGroupTypeCalls(value *NotificationGroupTypeCalls) error
+}
+
+// VisitNotificationGroupType calls the method of the visitor for the subtype of the value
+func VisitNotificationGroupType(value NotificationGroupType, visitor NotificationGroupTypeVisitor) error {
+	switch value := value.(type) {
+	case *NotificationGroupTypeMessages:
+		return visitor.VisitNotificationGroupTypeMessages(value)
+
+	case *NotificationGroupTypeMentions:
+		return visitor.VisitNotificationGroupTypeMentions(value)
+
+	case *NotificationGroupTypeSecretChat:
+		return visitor.VisitNotificationGroupTypeSecretChat(value)
+
+	case *NotificationGroupTypeCalls:
+		return visitor.VisitNotificationGroupTypeCalls(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.NotificationGroupTypeType())
+	}
+}
+
+// OptionValueVisitor has a method for every subtype of OptionValue. A new subtype breaks the build of the visitor implementations
+type OptionValueVisitor interface {
+	VisitOptionValueBoolean(value *OptionValueBoolean) error
+	VisitOptionValueEmpty(value *OptionValueEmpty) error
+	VisitOptionValueInteger(value *OptionValueInteger) error
+	VisitOptionValueString(value *OptionValueString) error
+}
+
+// VisitOptionValue calls the method of the visitor for the subtype of the value
+func VisitOptionValue(value OptionValue, visitor OptionValueVisitor) error {
+	switch value := value.(type) {
+	case *OptionValueBoolean:
+		return visitor.VisitOptionValueBoolean(value)
+
+	case *OptionValueEmpty:
+		return visitor.VisitOptionValueEmpty(value)
+
+	case *OptionValueInteger:
+		return visitor.VisitOptionValueInteger(value)
+
+	case *OptionValueString:
+		return visitor.VisitOptionValueString(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.OptionValueType())
+	}
+}
+
+// JsonValueVisitor has a method for every subtype of JsonValue. A new subtype breaks the build of the visitor implementations
+type JsonValueVisitor interface {
+	VisitJsonValueNull(value *JsonValueNull) error
+	VisitJsonValueBoolean(value *JsonValueBoolean) error
+	VisitJsonValueNumber(value *JsonValueNumber) error
+	VisitJsonValueString(value *JsonValueString) error
+	VisitJsonValueArray(value *JsonValueArray) error
+	VisitJsonValueObject(value *JsonValueObject) error
+}
+
+// VisitJsonValue calls the method of the visitor for the subtype of the value
+func VisitJsonValue(value JsonValue, visitor JsonValueVisitor) error {
+	switch value := value.(type) {
+	case *JsonValueNull:
+		return visitor.VisitJsonValueNull(value)
+
+	case *JsonValueBoolean:
+		return visitor.VisitJsonValueBoolean(value)
+
+	case *JsonValueNumber:
+		return visitor.VisitJsonValueNumber(value)
+
+	case *JsonValueString:
+		return visitor.VisitJsonValueString(value)
+
+	case *JsonValueArray:
+		return visitor.VisitJsonValueArray(value)
+
+	case *JsonValueObject:
+		return visitor.VisitJsonValueObject(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.JsonValueType())
+	}
+}
+
+// UserPrivacySettingRuleVisitor has a method for every subtype of UserPrivacySettingRule. A new subtype breaks the build of the visitor implementations
+type UserPrivacySettingRuleVisitor interface {
+	VisitUserPrivacySettingRuleAllowAll(value *UserPrivacySettingRuleAllowAll) error
+	VisitUserPrivacySettingRuleAllowContacts(value *UserPrivacySettingRuleAllowContacts) error
+	VisitUserPrivacySettingRuleAllowUsers(value *UserPrivacySettingRuleAllowUsers) error
+	VisitUserPrivacySettingRuleAllowChatMembers(value *UserPrivacySettingRuleAllowChatMembers) error
+	VisitUserPrivacySettingRuleRestrictAll(value *UserPrivacySettingRuleRestrictAll) error
+	VisitUserPrivacySettingRuleRestrictContacts(value *UserPrivacySettingRuleRestrictContacts) error
+	VisitUserPrivacySettingRuleRestrictUsers(value *UserPrivacySettingRuleRestrictUsers) error
+	VisitUserPrivacySettingRuleRestrictChatMembers(value *UserPrivacySettingRuleRestrictChatMembers) error
+}
+
+// VisitUserPrivacySettingRule calls the method of the visitor for the subtype of the value
+func VisitUserPrivacySettingRule(value UserPrivacySettingRule, visitor UserPrivacySettingRuleVisitor) error {
+	switch value := value.(type) {
+	case *UserPrivacySettingRuleAllowAll:
+		return visitor.VisitUserPrivacySettingRuleAllowAll(value)
+
+	case *UserPrivacySettingRuleAllowContacts:
+		return visitor.VisitUserPrivacySettingRuleAllowContacts(value)
+
+	case *UserPrivacySettingRuleAllowUsers:
+		return visitor.VisitUserPrivacySettingRuleAllowUsers(value)
+
+	case *UserPrivacySettingRuleAllowChatMembers:
+		return visitor.VisitUserPrivacySettingRuleAllowChatMembers(value)
+
+	case *UserPrivacySettingRuleRestrictAll:
+		return visitor.VisitUserPrivacySettingRuleRestrictAll(value)
+
+	case *UserPrivacySettingRuleRestrictContacts:
+		return visitor.VisitUserPrivacySettingRuleRestrictContacts(value)
+
+	case *UserPrivacySettingRuleRestrictUsers:
+		return visitor.VisitUserPrivacySettingRuleRestrictUsers(value)
+
+	case *UserPrivacySettingRuleRestrictChatMembers:
+		return visitor.VisitUserPrivacySettingRuleRestrictChatMembers(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.UserPrivacySettingRuleType())
+	}
+}
+
+// UserPrivacySettingVisitor has a method for every subtype of UserPrivacySetting. A new subtype breaks the build of the visitor implementations
+type UserPrivacySettingVisitor interface {
+	VisitUserPrivacySettingShowStatus(value *UserPrivacySettingShowStatus) error
+	VisitUserPrivacySettingShowProfilePhoto(value *UserPrivacySettingShowProfilePhoto) error
+	VisitUserPrivacySettingShowLinkInForwardedMessages(value *UserPrivacySettingShowLinkInForwardedMessages) error
+	VisitUserPrivacySettingShowPhoneNumber(value *UserPrivacySettingShowPhoneNumber) error
+	VisitUserPrivacySettingAllowChatInvites(value *UserPrivacySettingAllowChatInvites) error
+	VisitUserPrivacySettingAllowCalls(value *UserPrivacySettingAllowCalls) error
+	VisitUserPrivacySettingAllowPeerToPeerCalls(value *UserPrivacySettingAllowPeerToPeerCalls) error
+	VisitUserPrivacySettingAllowFindingByPhoneNumber(value *UserPrivacySettingAllowFindingByPhoneNumber) error
+	VisitUserPrivacySettingAllowPrivateVoiceAndVideoNoteMessages(value *UserPrivacySettingAllowPrivateVoiceAndVideoNoteMessages) error
+}
+
+// VisitUserPrivacySetting calls the method of the visitor for the subtype of the value
+func VisitUserPrivacySetting(value UserPrivacySetting, visitor UserPrivacySettingVisitor) error {
+	switch value := value.(type) {
+	case *UserPrivacySettingShowStatus:
+		return visitor.VisitUserPrivacySettingShowStatus(value)
+
+	case *UserPrivacySettingShowProfilePhoto:
+		return visitor.VisitUserPrivacySettingShowProfilePhoto(value)
+
+	case *UserPrivacySettingShowLinkInForwardedMessages:
+		return visitor.VisitUserPrivacySettingShowLinkInForwardedMessages(value)
+
+	case *UserPrivacySettingShowPhoneNumber:
+		return visitor.VisitUserPrivacySettingShowPhoneNumber(value)
+
+	case *UserPrivacySettingAllowChatInvites:
+		return visitor.VisitUserPrivacySettingAllowChatInvites(value)
+
+	case *UserPrivacySettingAllowCalls:
+		return visitor.VisitUserPrivacySettingAllowCalls(value)
+
+	case *UserPrivacySettingAllowPeerToPeerCalls:
+		return visitor.VisitUserPrivacySettingAllowPeerToPeerCalls(value)
+
+	case *UserPrivacySettingAllowFindingByPhoneNumber:
+		return visitor.VisitUserPrivacySettingAllowFindingByPhoneNumber(value)
+
+	case *UserPrivacySettingAllowPrivateVoiceAndVideoNoteMessages:
+		return visitor.VisitUserPrivacySettingAllowPrivateVoiceAndVideoNoteMessages(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.UserPrivacySettingType())
+	}
+}
+
+// SessionTypeVisitor has a method for every subtype of SessionType. A new subtype breaks the build of the visitor implementations
+type SessionTypeVisitor interface {
+	VisitSessionTypeAndroid(value *SessionTypeAndroid) error
+	VisitSessionTypeApple(value *SessionTypeApple) error
+	VisitSessionTypeBrave(value *SessionTypeBrave) error
+	VisitSessionTypeChrome(value *SessionTypeChrome) error
+	VisitSessionTypeEdge(value *SessionTypeEdge) error
+	VisitSessionTypeFirefox(value *SessionTypeFirefox) error
+	VisitSessionTypeIpad(value *SessionTypeIpad) error
+	VisitSessionTypeIphone(value *SessionTypeIphone) error
+	VisitSessionTypeLinux(value *SessionTypeLinux) error
+	VisitSessionTypeMac(value *SessionTypeMac) error
+	VisitSessionTypeOpera(value *SessionTypeOpera) error
+	VisitSessionTypeSafari(value *SessionTypeSafari) error
+	VisitSessionTypeUbuntu(value *SessionTypeUbuntu) error
+	VisitSessionTypeUnknown(value *SessionTypeUnknown) error
+	VisitSessionTypeVivaldi(value *SessionTypeVivaldi) error
+	VisitSessionTypeWindows(value *SessionTypeWindows) error
+	VisitSessionTypeXbox(value *SessionTypeXbox) error
+}
+
+// VisitSessionType calls the method of the visitor for the subtype of the value
+func VisitSessionType(value SessionType, visitor SessionTypeVisitor) error {
+	switch value := value.(type) {
+	case *SessionTypeAndroid:
+		return visitor.VisitSessionTypeAndroid(value)
+
+	case *SessionTypeApple:
+		return visitor.VisitSessionTypeApple(value)
+
+	case *SessionTypeBrave:
+		return visitor.VisitSessionTypeBrave(value)
+
+	case *SessionTypeChrome:
+		return visitor.VisitSessionTypeChrome(value)
+
+	case *SessionTypeEdge:
+		return visitor.VisitSessionTypeEdge(value)
+
+	case *SessionTypeFirefox:
+		return visitor.VisitSessionTypeFirefox(value)
+
+	case *SessionTypeIpad:
+		return visitor.VisitSessionTypeIpad(value)
+
+	case *SessionTypeIphone:
+		return visitor.VisitSessionTypeIphone(value)
+
+	case *SessionTypeLinux:
+		return visitor.VisitSessionTypeLinux(value)
+
+	case *SessionTypeMac:
+		return visitor.VisitSessionTypeMac(value)
+
+	case *SessionTypeOpera:
+		return visitor.VisitSessionTypeOpera(value)
+
+	case *SessionTypeSafari:
+		return visitor.VisitSessionTypeSafari(value)
+
+	case *SessionTypeUbuntu:
+		return visitor.VisitSessionTypeUbuntu(value)
+
+	case *SessionTypeUnknown:
+		return visitor.VisitSessionTypeUnknown(value)
+
+	case *SessionTypeVivaldi:
+		return visitor.VisitSessionTypeVivaldi(value)
+
+	case *SessionTypeWindows:
+		return visitor.VisitSessionTypeWindows(value)
+
+	case *SessionTypeXbox:
+		return visitor.VisitSessionTypeXbox(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.SessionTypeType())
+	}
+}
+
+// ChatReportReasonVisitor has a method for every subtype of ChatReportReason. A new subtype breaks the build of the visitor implementations
+type ChatReportReasonVisitor interface {
+	VisitChatReportReasonSpam(value *ChatReportReasonSpam) error
+	VisitChatReportReasonViolence(value *ChatReportReasonViolence) error
+	VisitChatReportReasonPornography(value *ChatReportReasonPornography) error
+	VisitChatReportReasonChildAbuse(value *ChatReportReasonChildAbuse) error
+	VisitChatReportReasonCopyright(value *ChatReportReasonCopyright) error
+	VisitChatReportReasonUnrelatedLocation(value *ChatReportReasonUnrelatedLocation) error
+	VisitChatReportReasonFake(value *ChatReportReasonFake) error
+	VisitChatReportReasonIllegalDrugs(value *ChatReportReasonIllegalDrugs) error
+	VisitChatReportReasonPersonalDetails(value *ChatReportReasonPersonalDetails) error
+	VisitChatReportReasonCustom(value *ChatReportReasonCustom) error
+}
+
+// VisitChatReportReason calls the method of the visitor for the subtype of the value
+func VisitChatReportReason(value ChatReportReason, visitor ChatReportReasonVisitor) error {
+	switch value := value.(type) {
+	case *ChatReportReasonSpam:
+		return visitor.VisitChatReportReasonSpam(value)
+
+	case *ChatReportReasonViolence:
+		return visitor.VisitChatReportReasonViolence(value)
+
+	case *ChatReportReasonPornography:
+		return visitor.VisitChatReportReasonPornography(value)
+
+	case *ChatReportReasonChildAbuse:
+		return visitor.VisitChatReportReasonChildAbuse(value)
+
+	case *ChatReportReasonCopyright:
+		return visitor.VisitChatReportReasonCopyright(value)
+
+	case *ChatReportReasonUnrelatedLocation:
+		return visitor.VisitChatReportReasonUnrelatedLocation(value)
+
+	case *ChatReportReasonFake:
+		return visitor.VisitChatReportReasonFake(value)
+
+	case *ChatReportReasonIllegalDrugs:
+		return visitor.VisitChatReportReasonIllegalDrugs(value)
+
+	case *ChatReportReasonPersonalDetails:
+		return visitor.VisitChatReportReasonPersonalDetails(value)
+
+	case *ChatReportReasonCustom:
+		return visitor.VisitChatReportReasonCustom(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.ChatReportReasonType())
+	}
+}
+
+// TargetChatVisitor has a method for every subtype of TargetChat. A new subtype breaks the build of the visitor implementations
+type TargetChatVisitor interface {
+	VisitTargetChatCurrent(value *TargetChatCurrent) error
+	VisitTargetChatChosen(value *TargetChatChosen) error
+	VisitTargetChatInternalLink(value *TargetChatInternalLink) error
+}
+
+// VisitTargetChat calls the method of the visitor for the subtype of the value
+func VisitTargetChat(value TargetChat, visitor TargetChatVisitor) error {
+	switch value := value.(type) {
+	case *TargetChatCurrent:
+		return visitor.VisitTargetChatCurrent(value)
+
+	case *TargetChatChosen:
+		return visitor.VisitTargetChatChosen(value)
+
+	case *TargetChatInternalLink:
+		return visitor.VisitTargetChatInternalLink(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.TargetChatType())
+	}
+}
+
+// InternalLinkTypeVisitor has a method for every subtype of InternalLinkType. A new subtype breaks the build of the visitor implementations
+type InternalLinkTypeVisitor interface {
+	VisitInternalLinkTypeActiveSessions(value *InternalLinkTypeActiveSessions) error
+	VisitInternalLinkTypeAttachmentMenuBot(value *InternalLinkTypeAttachmentMenuBot) error
+	VisitInternalLinkTypeAuthenticationCode(value *InternalLinkTypeAuthenticationCode) error
+	VisitInternalLinkTypeBackground(value *InternalLinkTypeBackground) error
+	VisitInternalLinkTypeBotAddToChannel(value *InternalLinkTypeBotAddToChannel) error
+	VisitInternalLinkTypeBotStart(value *InternalLinkTypeBotStart) error
+	VisitInternalLinkTypeBotStartInGroup(value *InternalLinkTypeBotStartInGroup) error
+	VisitInternalLinkTypeChangePhoneNumber(value *InternalLinkTypeChangePhoneNumber) error
+	VisitInternalLinkTypeChatFolderInvite(value *InternalLinkTypeChatFolderInvite) error
+	VisitInternalLinkTypeChatFolderSettings(value *InternalLinkTypeChatFolderSettings) error
+	VisitInternalLinkTypeChatInvite(value *InternalLinkTypeChatInvite) error
+	VisitInternalLinkTypeDefaultMessageAutoDeleteTimerSettings(value *InternalLinkTypeDefaultMessageAutoDeleteTimerSettings) error
+	VisitInternalLinkTypeEditProfileSettings(value *InternalLinkTypeEditProfileSettings) error
+	VisitInternalLinkTypeGame(value *InternalLinkTypeGame) error
+	VisitInternalLinkTypeInstantView(value *InternalLinkTypeInstantView) error
+	VisitInternalLinkTypeInvoice(value *InternalLinkTypeInvoice) error
+	VisitInternalLinkTypeLanguagePack(value *InternalLinkTypeLanguagePack) error
+	VisitInternalLinkTypeLanguageSettings(value *InternalLinkTypeLanguageSettings) error
+	VisitInternalLinkTypeMessage(value *InternalLinkTypeMessage) error
+	VisitInternalLinkTypeMessageDraft(value *InternalLinkTypeMessageDraft) error
+	VisitInternalLinkTypePassportDataRequest(value *InternalLinkTypePassportDataRequest) error
+	VisitInternalLinkTypePhoneNumberConfirmation(value *InternalLinkTypePhoneNumberConfirmation) error
+	VisitInternalLinkTypePremiumFeatures(value *InternalLinkTypePremiumFeatures) error
+	VisitInternalLinkTypePrivacyAndSecuritySettings(value *InternalLinkTypePrivacyAndSecuritySettings) error
+	VisitInternalLinkTypeProxy(value *InternalLinkTypeProxy) error
+	VisitInternalLinkTypePublicChat(value *InternalLinkTypePublicChat) error
+	VisitInternalLinkTypeQrCodeAuthentication(value *InternalLinkTypeQrCodeAuthentication) error
+	VisitInternalLinkTypeRestorePurchases(value *InternalLinkTypeRestorePurchases) error
+	VisitInternalLinkTypeSettings(value *InternalLinkTypeSettings) error
+	VisitInternalLinkTypeStickerSet(value *InternalLinkTypeStickerSet) error
+	VisitInternalLinkTypeTheme(value *InternalLinkTypeTheme) error
+	VisitInternalLinkTypeThemeSettings(value *InternalLinkTypeThemeSettings) error
+	VisitInternalLinkTypeUnknownDeepLink(value *InternalLinkTypeUnknownDeepLink) error
+	VisitInternalLinkTypeUnsupportedProxy(value *InternalLinkTypeUnsupportedProxy) error
+	VisitInternalLinkTypeUserPhoneNumber(value *InternalLinkTypeUserPhoneNumber) error
+	VisitInternalLinkTypeUserToken(value *InternalLinkTypeUserToken) error
+	VisitInternalLinkTypeVideoChat(value *InternalLinkTypeVideoChat) error
+	VisitInternalLinkTypeWebApp(value *InternalLinkTypeWebApp) error
+}
+
+// VisitInternalLinkType calls the method of the visitor for the subtype of the value
+func VisitInternalLinkType(value InternalLinkType, visitor InternalLinkTypeVisitor) error {
+	switch value := value.(type) {
+	case *InternalLinkTypeActiveSessions:
+		return visitor.VisitInternalLinkTypeActiveSessions(value)
+
+	case *InternalLinkTypeAttachmentMenuBot:
+		return visitor.VisitInternalLinkTypeAttachmentMenuBot(value)
+
+	case *InternalLinkTypeAuthenticationCode:
+		return visitor.VisitInternalLinkTypeAuthenticationCode(value)
+
+	case *InternalLinkTypeBackground:
+		return visitor.VisitInternalLinkTypeBackground(value)
+
+	case *InternalLinkTypeBotAddToChannel:
+		return visitor.VisitInternalLinkTypeBotAddToChannel(value)
+
+	case *InternalLinkTypeBotStart:
+		return visitor.VisitInternalLinkTypeBotStart(value)
+
+	case *InternalLinkTypeBotStartInGroup:
+		return visitor.VisitInternalLinkTypeBotStartInGroup(value)
+
+	case *InternalLinkTypeChangePhoneNumber:
+		return visitor.VisitInternalLinkTypeChangePhoneNumber(value)
+
+	case *InternalLinkTypeChatFolderInvite:
+		return visitor.VisitInternalLinkTypeChatFolderInvite(value)
+
+	case *InternalLinkTypeChatFolderSettings:
+		return visitor.VisitInternalLinkTypeChatFolderSettings(value)
+
+	case *InternalLinkTypeChatInvite:
+		return visitor.VisitInternalLinkTypeChatInvite(value)
+
+	case *InternalLinkTypeDefaultMessageAutoDeleteTimerSettings:
+		return visitor.VisitInternalLinkTypeDefaultMessageAutoDeleteTimerSettings(value)
+
+	case *InternalLinkTypeEditProfileSettings:
+		return visitor.VisitInternalLinkTypeEditProfileSettings(value)
+
+	case *InternalLinkTypeGame:
+		return visitor.VisitInternalLinkTypeGame(value)
+
+	case *InternalLinkTypeInstantView:
+		return visitor.VisitInternalLinkTypeInstantView(value)
+
+	case *InternalLinkTypeInvoice:
+		return visitor.VisitInternalLinkTypeInvoice(value)
+
+	case *InternalLinkTypeLanguagePack:
+		return visitor.VisitInternalLinkTypeLanguagePack(value)
+
+	case *InternalLinkTypeLanguageSettings:
+		return visitor.VisitInternalLinkTypeLanguageSettings(value)
+
+	case *InternalLinkTypeMessage:
+		return visitor.VisitInternalLinkTypeMessage(value)
+
+	case *InternalLinkTypeMessageDraft:
+		return visitor.VisitInternalLinkTypeMessageDraft(value)
+
+	case *InternalLinkTypePassportDataRequest:
+		return visitor.VisitInternalLinkTypePassportDataRequest(value)
+
+	case *InternalLinkTypePhoneNumberConfirmation:
+		return visitor.VisitInternalLinkTypePhoneNumberConfirmation(value)
+
+	case *InternalLinkTypePremiumFeatures:
+		return visitor.VisitInternalLinkTypePremiumFeatures(value)
+
+	case *InternalLinkTypePrivacyAndSecuritySettings:
+		return visitor.VisitInternalLinkTypePrivacyAndSecuritySettings(value)
+
+	case *InternalLinkTypeProxy:
+		return visitor.VisitInternalLinkTypeProxy(value)
+
+	case *InternalLinkTypePublicChat:
+		return visitor.VisitInternalLinkTypePublicChat(value)
+
+	case *InternalLinkTypeQrCodeAuthentication:
+		return visitor.VisitInternalLinkTypeQrCodeAuthentication(value)
+
+	case *InternalLinkTypeRestorePurchases:
+		return visitor.VisitInternalLinkTypeRestorePurchases(value)
+
+	case *InternalLinkTypeSettings:
+		return visitor.VisitInternalLinkTypeSettings(value)
+
+	case *InternalLinkTypeStickerSet:
+		return visitor.VisitInternalLinkTypeStickerSet(value)
+
+	case *InternalLinkTypeTheme:
+		return visitor.VisitInternalLinkTypeTheme(value)
+
+	case *InternalLinkTypeThemeSettings:
+		return visitor.VisitInternalLinkTypeThemeSettings(value)
+
+	case *InternalLinkTypeUnknownDeepLink:
+		return visitor.VisitInternalLinkTypeUnknownDeepLink(value)
+
+	case *InternalLinkTypeUnsupportedProxy:
+		return visitor.VisitInternalLinkTypeUnsupportedProxy(value)
+
+	case *InternalLinkTypeUserPhoneNumber:
+		return visitor.VisitInternalLinkTypeUserPhoneNumber(value)
+
+	case *InternalLinkTypeUserToken:
+		return visitor.VisitInternalLinkTypeUserToken(value)
+
+	case *InternalLinkTypeVideoChat:
+		return visitor.VisitInternalLinkTypeVideoChat(value)
+
+	case *InternalLinkTypeWebApp:
+		return visitor.VisitInternalLinkTypeWebApp(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.InternalLinkTypeType())
+	}
+}
+
+// FileTypeVisitor has a method for every subtype of FileType. A new subtype breaks the build of the visitor implementations
+type FileTypeVisitor interface {
+	VisitFileTypeNone(value *FileTypeNone) error
+	VisitFileTypeAnimation(value *FileTypeAnimation) error
+	VisitFileTypeAudio(value *FileTypeAudio) error
+	VisitFileTypeDocument(value *FileTypeDocument) error
+	VisitFileTypeNotificationSound(value *FileTypeNotificationSound) error
+	VisitFileTypePhoto(value *FileTypePhoto) error
+	VisitFileTypeProfilePhoto(value *FileTypeProfilePhoto) error
+	VisitFileTypeSecret(value *FileTypeSecret) error
+	VisitFileTypeSecretThumbnail(value *FileTypeSecretThumbnail) error
+	VisitFileTypeSecure(value *FileTypeSecure) error
+	VisitFileTypeSticker(value *FileTypeSticker) error
+	VisitFileTypeThumbnail(value *FileTypeThumbnail) error
+	VisitFileTypeUnknown(value *FileTypeUnknown) error
+	VisitFileTypeVideo(value *FileTypeVideo) error
+	VisitFileTypeVideoNote(value *FileTypeVideoNote) error
+	VisitFileTypeVoiceNote(value *FileTypeVoiceNote) error
+	VisitFileTypeWallpaper(value *FileTypeWallpaper) error
+}
+
+// VisitFileType calls the method of the visitor for the subtype of the value
+func VisitFileType(value FileType, visitor FileTypeVisitor) error {
+	switch value := value.(type) {
+	case *FileTypeNone:
+		return visitor.VisitFileTypeNone(value)
+
+	case *FileTypeAnimation:
+		return visitor.VisitFileTypeAnimation(value)
+
+	case *FileTypeAudio:
+		return visitor.VisitFileTypeAudio(value)
+
+	case *FileTypeDocument:
+		return visitor.VisitFileTypeDocument(value)
+
+	case *FileTypeNotificationSound:
+		return visitor.VisitFileTypeNotificationSound(value)
+
+	case *FileTypePhoto:
+		return visitor.VisitFileTypePhoto(value)
+
+	case *FileTypeProfilePhoto:
+		return visitor.VisitFileTypeProfilePhoto(value)
+
+	case *FileTypeSecret:
+		return visitor.VisitFileTypeSecret(value)
+
+	case *FileTypeSecretThumbnail:
+		return visitor.VisitFileTypeSecretThumbnail(value)
+
+	case *FileTypeSecure:
+		return visitor.VisitFileTypeSecure(value)
+
+	case *FileTypeSticker:
+		return visitor.VisitFileTypeSticker(value)
+
+	case *FileTypeThumbnail:
+		return visitor.VisitFileTypeThumbnail(value)
+
+	case *FileTypeUnknown:
+		return visitor.VisitFileTypeUnknown(value)
+
+	case *FileTypeVideo:
+		return visitor.VisitFileTypeVideo(value)
+
+	case *FileTypeVideoNote:
+		return visitor.VisitFileTypeVideoNote(value)
+
+	case *FileTypeVoiceNote:
+		return visitor.VisitFileTypeVoiceNote(value)
+
+	case *FileTypeWallpaper:
+		return visitor.VisitFileTypeWallpaper(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.FileTypeType())
+	}
+}
+
+// NetworkTypeVisitor has a method for every subtype of NetworkType. A new subtype breaks the build of the visitor implementations
+type NetworkTypeVisitor interface {
+	VisitNetworkTypeNone(value *NetworkTypeNone) error
+	VisitNetworkTypeMobile(value *NetworkTypeMobile) error
+	VisitNetworkTypeMobileRoaming(value *NetworkTypeMobileRoaming) error
+	VisitNetworkTypeWiFi(value *NetworkTypeWiFi) error
+	VisitNetworkTypeOther(value *NetworkTypeOther) error
+}
+
+// VisitNetworkType calls the method of the visitor for the subtype of the value
+func VisitNetworkType(value NetworkType, visitor NetworkTypeVisitor) error {
+	switch value := value.(type) {
+	case *NetworkTypeNone:
+		return visitor.VisitNetworkTypeNone(value)
+
+	case *NetworkTypeMobile:
+		return visitor.VisitNetworkTypeMobile(value)
+
+	case *NetworkTypeMobileRoaming:
+		return visitor.VisitNetworkTypeMobileRoaming(value)
+
+	case *NetworkTypeWiFi:
+		return visitor.VisitNetworkTypeWiFi(value)
+
+	case *NetworkTypeOther:
+		return visitor.VisitNetworkTypeOther(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.NetworkTypeType())
+	}
+}
+
+// NetworkStatisticsEntryVisitor has a method for every subtype of NetworkStatisticsEntry. A new subtype breaks the build of the visitor implementations
+type NetworkStatisticsEntryVisitor interface {
+	VisitNetworkStatisticsEntryFile(value *NetworkStatisticsEntryFile) error
+	VisitNetworkStatisticsEntryCall(value *NetworkStatisticsEntryCall) error
+}
+
+// VisitNetworkStatisticsEntry calls the method of the visitor for the subtype of the value
+func VisitNetworkStatisticsEntry(value NetworkStatisticsEntry, visitor NetworkStatisticsEntryVisitor) error {
+	switch value := value.(type) {
+	case *NetworkStatisticsEntryFile:
+		return visitor.VisitNetworkStatisticsEntryFile(value)
+
+	case *NetworkStatisticsEntryCall:
+		return visitor.VisitNetworkStatisticsEntryCall(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.NetworkStatisticsEntryType())
+	}
+}
+
+// AutosaveSettingsScopeVisitor has a method for every subtype of AutosaveSettingsScope. A new subtype breaks the build of the visitor implementations
+type AutosaveSettingsScopeVisitor interface {
+	VisitAutosaveSettingsScopePrivateChats(value *AutosaveSettingsScopePrivateChats) error
+	VisitAutosaveSettingsScopeGroupChats(value *AutosaveSettingsScopeGroupChats) error
+	VisitAutosaveSettingsScopeChannelChats(value *AutosaveSettingsScopeChannelChats) error
+	VisitAutosaveSettingsScopeChat(value *AutosaveSettingsScopeChat) error
+}
+
+// VisitAutosaveSettingsScope calls the method of the visitor for the subtype of the value
+func VisitAutosaveSettingsScope(value AutosaveSettingsScope, visitor AutosaveSettingsScopeVisitor) error {
+	switch value := value.(type) {
+	case *AutosaveSettingsScopePrivateChats:
+		return visitor.VisitAutosaveSettingsScopePrivateChats(value)
+
+	case *AutosaveSettingsScopeGroupChats:
+		return visitor.VisitAutosaveSettingsScopeGroupChats(value)
+
+	case *AutosaveSettingsScopeChannelChats:
+		return visitor.VisitAutosaveSettingsScopeChannelChats(value)
+
+	case *AutosaveSettingsScopeChat:
+		return visitor.VisitAutosaveSettingsScopeChat(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.AutosaveSettingsScopeType())
+	}
+}
+
+// ConnectionStateVisitor has a method for every subtype of ConnectionState. A new subtype breaks the build of the visitor implementations
+type ConnectionStateVisitor interface {
+	VisitConnectionStateWaitingForNetwork(value *ConnectionStateWaitingForNetwork) error
+	VisitConnectionStateConnectingToProxy(value *ConnectionStateConnectingToProxy) error
+	VisitConnectionStateConnecting(value *ConnectionStateConnecting) error
+	VisitConnectionStateUpdating(value *ConnectionStateUpdating) error
+	VisitConnectionStateReady(value *ConnectionStateReady) error
+}
+
+// VisitConnectionState calls the method of the visitor for the subtype of the value
+func VisitConnectionState(value ConnectionState, visitor ConnectionStateVisitor) error {
+	switch value := value.(type) {
+	case *ConnectionStateWaitingForNetwork:
+		return visitor.VisitConnectionStateWaitingForNetwork(value)
+
+	case *ConnectionStateConnectingToProxy:
+		return visitor.VisitConnectionStateConnectingToProxy(value)
+
+	case *ConnectionStateConnecting:
+		return visitor.VisitConnectionStateConnecting(value)
+
+	case *ConnectionStateUpdating:
+		return visitor.VisitConnectionStateUpdating(value)
+
+	case *ConnectionStateReady:
+		return visitor.VisitConnectionStateReady(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.ConnectionStateType())
+	}
+}
+
+// TopChatCategoryVisitor has a method for every subtype of TopChatCategory. A new subtype breaks the build of the visitor implementations
+type TopChatCategoryVisitor interface {
+	VisitTopChatCategoryUsers(value *TopChatCategoryUsers) error
+	VisitTopChatCategoryBots(value *TopChatCategoryBots) error
+	VisitTopChatCategoryGroups(value *TopChatCategoryGroups) error
+	VisitTopChatCategoryChannels(value *TopChatCategoryChannels) error
+	VisitTopChatCategoryInlineBots(value *TopChatCategoryInlineBots) error
+	VisitTopChatCategoryCalls(value *TopChatCategoryCalls) error
+	VisitTopChatCategoryForwardChats(value *TopChatCategoryForwardChats) error
+}
+
+// VisitTopChatCategory calls the method of the visitor for the subtype of the value
+func VisitTopChatCategory(value TopChatCategory, visitor TopChatCategoryVisitor) error {
+	switch value := value.(type) {
+	case *TopChatCategoryUsers:
+		return visitor.VisitTopChatCategoryUsers(value)
+
+	case *TopChatCategoryBots:
+		return visitor.VisitTopChatCategoryBots(value)
+
+	case *TopChatCategoryGroups:
+		return visitor.VisitTopChatCategoryGroups(value)
+
+	case *TopChatCategoryChannels:
+		return visitor.VisitTopChatCategoryChannels(value)
+
+	case *TopChatCategoryInlineBots:
+		return visitor.VisitTopChatCategoryInlineBots(value)
+
+	case *TopChatCategoryCalls:
+		return visitor.VisitTopChatCategoryCalls(value)
+
+	case *TopChatCategoryForwardChats:
+		return visitor.VisitTopChatCategoryForwardChats(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.TopChatCategoryType())
+	}
+}
+
+// TMeUrlTypeVisitor has a method for every subtype of TMeUrlType. A new subtype breaks the build of the visitor implementations
+type TMeUrlTypeVisitor interface {
+	VisitTMeUrlTypeUser(value *TMeUrlTypeUser) error
+	VisitTMeUrlTypeSupergroup(value *TMeUrlTypeSupergroup) error
+	VisitTMeUrlTypeChatInvite(value *TMeUrlTypeChatInvite) error
+	VisitTMeUrlTypeStickerSet(value *TMeUrlTypeStickerSet) error
+}
+
+// VisitTMeUrlType calls the method of the visitor for the subtype of the value
+func VisitTMeUrlType(value TMeUrlType, visitor TMeUrlTypeVisitor) error {
+	switch value := value.(type) {
+	case *TMeUrlTypeUser:
+		return visitor.VisitTMeUrlTypeUser(value)
+
+	case *TMeUrlTypeSupergroup:
+		return visitor.VisitTMeUrlTypeSupergroup(value)
+
+	case *TMeUrlTypeChatInvite:
+		return visitor.VisitTMeUrlTypeChatInvite(value)
+
+	case *TMeUrlTypeStickerSet:
+		return visitor.VisitTMeUrlTypeStickerSet(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.TMeUrlTypeType())
+	}
+}
+
+// SuggestedActionVisitor has a method for every subtype of SuggestedAction. A new subtype breaks the build of the visitor implementations
+type SuggestedActionVisitor interface {
+	VisitSuggestedActionEnableArchiveAndMuteNewChats(value *SuggestedActionEnableArchiveAndMuteNewChats) error
+	VisitSuggestedActionCheckPassword(value *SuggestedActionCheckPassword) error
+	VisitSuggestedActionCheckPhoneNumber(value *SuggestedActionCheckPhoneNumber) error
+	VisitSuggestedActionViewChecksHint(value *SuggestedActionViewChecksHint) error
+	VisitSuggestedActionConvertToBroadcastGroup(value *SuggestedActionConvertToBroadcastGroup) error
+	VisitSuggestedActionSetPassword(value *SuggestedActionSetPassword) error
+	VisitSuggestedActionUpgradePremium(value *SuggestedActionUpgradePremium) error
+	VisitSuggestedActionSubscribeToAnnualPremium(value *SuggestedActionSubscribeToAnnualPremium) error
+}
+
+// VisitSuggestedAction calls the method of the visitor for the subtype of the value
+func VisitSuggestedAction(value SuggestedAction, visitor SuggestedActionVisitor) error {
+	switch value := value.(type) {
+	case *SuggestedActionEnableArchiveAndMuteNewChats:
+		return visitor.VisitSuggestedActionEnableArchiveAndMuteNewChats(value)
+
+	case *SuggestedActionCheckPassword:
+		return visitor.VisitSuggestedActionCheckPassword(value)
+
+	case *SuggestedActionCheckPhoneNumber:
+		return visitor.VisitSuggestedActionCheckPhoneNumber(value)
+
+	case *SuggestedActionViewChecksHint:
+		return visitor.VisitSuggestedActionViewChecksHint(value)
+
+	case *SuggestedActionConvertToBroadcastGroup:
+		return visitor.VisitSuggestedActionConvertToBroadcastGroup(value)
+
+	case *SuggestedActionSetPassword:
+		return visitor.VisitSuggestedActionSetPassword(value)
+
+	case *SuggestedActionUpgradePremium:
+		return visitor.VisitSuggestedActionUpgradePremium(value)
+
+	case *SuggestedActionSubscribeToAnnualPremium:
+		return visitor.VisitSuggestedActionSubscribeToAnnualPremium(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.SuggestedActionType())
+	}
+}
+
+// TextParseModeVisitor has a method for every subtype of TextParseMode. A new subtype breaks the build of the visitor implementations
+type TextParseModeVisitor interface {
+	VisitTextParseModeMarkdown(value *TextParseModeMarkdown) error
+	VisitTextParseModeHTML(value *TextParseModeHTML) error
+}
+
+// VisitTextParseMode calls the method of the visitor for the subtype of the value
+func VisitTextParseMode(value TextParseMode, visitor TextParseModeVisitor) error {
+	switch value := value.(type) {
+	case *TextParseModeMarkdown:
+		return visitor.VisitTextParseModeMarkdown(value)
+
+	case *TextParseModeHTML:
+		return visitor.VisitTextParseModeHTML(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.TextParseModeType())
+	}
+}
+
+// ProxyTypeVisitor has a method for every subtype of ProxyType. A new subtype breaks the build of the visitor implementations
+type ProxyTypeVisitor interface {
+	VisitProxyTypeSocks5(value *ProxyTypeSocks5) error
+	VisitProxyTypeHttp(value *ProxyTypeHttp) error
+	VisitProxyTypeMtproto(value *ProxyTypeMtproto) error
+}
+
+// VisitProxyType calls the method of the visitor for the subtype of the value
+func VisitProxyType(value ProxyType, visitor ProxyTypeVisitor) error {
+	switch value := value.(type) {
+	case *ProxyTypeSocks5:
+		return visitor.VisitProxyTypeSocks5(value)
+
+	case *ProxyTypeHttp:
+		return visitor.VisitProxyTypeHttp(value)
+
+	case *ProxyTypeMtproto:
+		return visitor.VisitProxyTypeMtproto(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.ProxyTypeType())
+	}
+}
+
+// StatisticalGraphVisitor has a method for every subtype of StatisticalGraph. A new subtype breaks the build of the visitor implementations
+type StatisticalGraphVisitor interface {
+	VisitStatisticalGraphData(value *StatisticalGraphData) error
+	VisitStatisticalGraphAsync(value *StatisticalGraphAsync) error
+	VisitStatisticalGraphError(value *StatisticalGraphError) error
+}
+
+// VisitStatisticalGraph calls the method of the visitor for the subtype of the value
+func VisitStatisticalGraph(value StatisticalGraph, visitor StatisticalGraphVisitor) error {
+	switch value := value.(type) {
+	case *StatisticalGraphData:
+		return visitor.VisitStatisticalGraphData(value)
+
+	case *StatisticalGraphAsync:
+		return visitor.VisitStatisticalGraphAsync(value)
+
+	case *StatisticalGraphError:
+		return visitor.VisitStatisticalGraphError(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.StatisticalGraphType())
+	}
+}
+
+// ChatStatisticsVisitor has a method for every subtype of ChatStatistics. A new subtype breaks the build of the visitor implementations
+type ChatStatisticsVisitor interface {
+	VisitChatStatisticsSupergroup(value *ChatStatisticsSupergroup) error
+	VisitChatStatisticsChannel(value *ChatStatisticsChannel) error
+}
+
+// VisitChatStatistics calls the method of the visitor for the subtype of the value
+func VisitChatStatistics(value ChatStatistics, visitor ChatStatisticsVisitor) error {
+	switch value := value.(type) {
+	case *ChatStatisticsSupergroup:
+		return visitor.VisitChatStatisticsSupergroup(value)
+
+	case *ChatStatisticsChannel:
+		return visitor.VisitChatStatisticsChannel(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.ChatStatisticsType())
+	}
+}
+
+// VectorPathCommandVisitor has a method for every subtype of VectorPathCommand. A new subtype breaks the build of the visitor implementations
+type VectorPathCommandVisitor interface {
+	VisitVectorPathCommandLine(value *VectorPathCommandLine) error
+	VisitVectorPathCommandCubicBezierCurve(value *VectorPathCommandCubicBezierCurve) error
+}
+
+// VisitVectorPathCommand calls the method of the visitor for the subtype of the value
+func VisitVectorPathCommand(value VectorPathCommand, visitor VectorPathCommandVisitor) error {
+	switch value := value.(type) {
+	case *VectorPathCommandLine:
+		return visitor.VisitVectorPathCommandLine(value)
+
+	case *VectorPathCommandCubicBezierCurve:
+		return visitor.VisitVectorPathCommandCubicBezierCurve(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.VectorPathCommandType())
+	}
+}
+
+// BotCommandScopeVisitor has a method for every subtype of BotCommandScope. A new subtype breaks the build of the visitor implementations
+type BotCommandScopeVisitor interface {
+	VisitBotCommandScopeDefault(value *BotCommandScopeDefault) error
+	VisitBotCommandScopeAllPrivateChats(value *BotCommandScopeAllPrivateChats) error
+	VisitBotCommandScopeAllGroupChats(value *BotCommandScopeAllGroupChats) error
+	VisitBotCommandScopeAllChatAdministrators(value *BotCommandScopeAllChatAdministrators) error
+	VisitBotCommandScopeChat(value *BotCommandScopeChat) error
+	VisitBotCommandScopeChatAdministrators(value *BotCommandScopeChatAdministrators) error
+	VisitBotCommandScopeChatMember(value *BotCommandScopeChatMember) error
+}
+
+// VisitBotCommandScope calls the method of the visitor for the subtype of the value
+func VisitBotCommandScope(value BotCommandScope, visitor BotCommandScopeVisitor) error {
+	switch value := value.(type) {
+	case *BotCommandScopeDefault:
+		return visitor.VisitBotCommandScopeDefault(value)
+
+	case *BotCommandScopeAllPrivateChats:
+		return visitor.VisitBotCommandScopeAllPrivateChats(value)
+
+	case *BotCommandScopeAllGroupChats:
+		return visitor.VisitBotCommandScopeAllGroupChats(value)
+
+	case *BotCommandScopeAllChatAdministrators:
+		return visitor.VisitBotCommandScopeAllChatAdministrators(value)
+
+	case *BotCommandScopeChat:
+		return visitor.VisitBotCommandScopeChat(value)
+
+	case *BotCommandScopeChatAdministrators:
+		return visitor.VisitBotCommandScopeChatAdministrators(value)
+
+	case *BotCommandScopeChatMember:
+		return visitor.VisitBotCommandScopeChatMember(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.BotCommandScopeType())
+	}
+}
+
+// UpdateVisitor has a method for every subtype of Update. A new subtype breaks the build of the visitor implementations
+type UpdateVisitor interface {
+	VisitUpdateAuthorizationState(value *UpdateAuthorizationState) error
+	VisitUpdateNewMessage(value *UpdateNewMessage) error
+	VisitUpdateMessageSendAcknowledged(value *UpdateMessageSendAcknowledged) error
+	VisitUpdateMessageSendSucceeded(value *UpdateMessageSendSucceeded) error
+	VisitUpdateMessageSendFailed(value *UpdateMessageSendFailed) error
+	VisitUpdateMessageContent(value *UpdateMessageContent) error
+	VisitUpdateMessageEdited(value *UpdateMessageEdited) error
+	VisitUpdateMessageIsPinned(value *UpdateMessageIsPinned) error
+	VisitUpdateMessageInteractionInfo(value *UpdateMessageInteractionInfo) error
+	VisitUpdateMessageContentOpened(value *UpdateMessageContentOpened) error
+	VisitUpdateMessageMentionRead(value *UpdateMessageMentionRead) error
+	VisitUpdateMessageUnreadReactions(value *UpdateMessageUnreadReactions) error
+	VisitUpdateMessageLiveLocationViewed(value *UpdateMessageLiveLocationViewed) error
+	VisitUpdateNewChat(value *UpdateNewChat) error
+	VisitUpdateChatTitle(value *UpdateChatTitle) error
+	VisitUpdateChatPhoto(value *UpdateChatPhoto) error
+	VisitUpdateChatPermissions(value *UpdateChatPermissions) error
+	VisitUpdateChatLastMessage(value *UpdateChatLastMessage) error
+	VisitUpdateChatPosition(value *UpdateChatPosition) error
+	VisitUpdateChatReadInbox(value *UpdateChatReadInbox) error
+	VisitUpdateChatReadOutbox(value *UpdateChatReadOutbox) error
+	VisitUpdateChatActionBar(value *UpdateChatActionBar) error
+	VisitUpdateChatAvailableReactions(value *UpdateChatAvailableReactions) error
+	VisitUpdateChatDraftMessage(value *UpdateChatDraftMessage) error
+	VisitUpdateChatMessageSender(value *UpdateChatMessageSender) error
+	VisitUpdateChatMessageAutoDeleteTime(value *UpdateChatMessageAutoDeleteTime) error
+	VisitUpdateChatNotificationSettings(value *UpdateChatNotificationSettings) error
+	VisitUpdateChatPendingJoinRequests(value *UpdateChatPendingJoinRequests) error
+	VisitUpdateChatReplyMarkup(value *UpdateChatReplyMarkup) error
+	VisitUpdateChatBackground(value *UpdateChatBackground) error
+	VisitUpdateChatTheme(value *UpdateChatTheme) error
+	VisitUpdateChatUnreadMentionCount(value *UpdateChatUnreadMentionCount) error
+	VisitUpdateChatUnreadReactionCount(value *UpdateChatUnreadReactionCount) error
+	VisitUpdateChatVideoChat(value *UpdateChatVideoChat) error
+	VisitUpdateChatDefaultDisableNotification(value *UpdateChatDefaultDisableNotification) error
+	VisitUpdateChatHasProtectedContent(value *UpdateChatHasProtectedContent) error
+	VisitUpdateChatIsTranslatable(value *UpdateChatIsTranslatable) error
+	VisitUpdateChatIsMarkedAsUnread(value *UpdateChatIsMarkedAsUnread) error
+	VisitUpdateChatIsBlocked(value *UpdateChatIsBlocked) error
+	VisitUpdateChatHasScheduledMessages(value *UpdateChatHasScheduledMessages) error
+	VisitUpdateChatFolders(value *UpdateChatFolders) error
+	VisitUpdateChatOnlineMemberCount(value *UpdateChatOnlineMemberCount) error
+	VisitUpdateForumTopicInfo(value *UpdateForumTopicInfo) error
+	VisitUpdateScopeNotificationSettings(value *UpdateScopeNotificationSettings) error
+	VisitUpdateNotification(value *UpdateNotification) error
+	VisitUpdateNotificationGroup(value *UpdateNotificationGroup) error
+	VisitUpdateActiveNotifications(value *UpdateActiveNotifications) error
+	VisitUpdateHavePendingNotifications(value *UpdateHavePendingNotifications) error
+	VisitUpdateDeleteMessages(value *UpdateDeleteMessages) error
+	VisitUpdateChatAction(value *UpdateChatAction) error
+	VisitUpdateUserStatus(value *UpdateUserStatus) error
+	VisitUpdateUser(value *UpdateUser) error
+	VisitUpdateAccessHash(value *UpdateAccessHash) error
+	VisitUpdateBasicGroup(value *UpdateBasicGroup) error
+	VisitUpdateSupergroup(value *UpdateSupergroup) error
+	VisitUpdateSecretChat(value *UpdateSecretChat) error
+	VisitUpdateUserFullInfo(value *UpdateUserFullInfo) error
+	VisitUpdateBasicGroupFullInfo(value *UpdateBasicGroupFullInfo) error
+	VisitUpdateSupergroupFullInfo(value *UpdateSupergroupFullInfo) error
+	VisitUpdateServiceNotification(value *UpdateServiceNotification) error
+	VisitUpdateFile(value *UpdateFile) error
+	VisitUpdateFileGenerationStart(value *UpdateFileGenerationStart) error
+	VisitUpdateFileGenerationStop(value *UpdateFileGenerationStop) error
+	VisitUpdateFileDownloads(value *UpdateFileDownloads) error
+	VisitUpdateFileAddedToDownloads(value *UpdateFileAddedToDownloads) error
+	VisitUpdateFileDownload(value *UpdateFileDownload) error
+	VisitUpdateFileRemovedFromDownloads(value *UpdateFileRemovedFromDownloads) error
+	VisitUpdateCall(value *UpdateCall) error
+	VisitUpdateGroupCall(value *UpdateGroupCall) error
+	VisitUpdateGroupCallParticipant(value *UpdateGroupCallParticipant) error
+	VisitUpdateNewCallSignalingData(value *UpdateNewCallSignalingData) error
+	VisitUpdateUserPrivacySettingRules(value *UpdateUserPrivacySettingRules) error
+	VisitUpdateUnreadMessageCount(value *UpdateUnreadMessageCount) error
+	VisitUpdateUnreadChatCount(value *UpdateUnreadChatCount) error
+	VisitUpdateOption(value *UpdateOption) error
+	VisitUpdateStickerSet(value *UpdateStickerSet) error
+	VisitUpdateInstalledStickerSets(value *UpdateInstalledStickerSets) error
+	VisitUpdateTrendingStickerSets(value *UpdateTrendingStickerSets) error
+	VisitUpdateRecentStickers(value *UpdateRecentStickers) error
+	VisitUpdateFavoriteStickers(value *UpdateFavoriteStickers) error
+	VisitUpdateSavedAnimations(value *UpdateSavedAnimations) error
+	VisitUpdateSavedNotificationSounds(value *UpdateSavedNotificationSounds) error
+	VisitUpdateSelectedBackground(value *UpdateSelectedBackground) error
+	VisitUpdateChatThemes(value *UpdateChatThemes) error
+	VisitUpdateLanguagePackStrings(value *UpdateLanguagePackStrings) error
+	VisitUpdateConnectionState(value *UpdateConnectionState) error
+	VisitUpdateTermsOfService(value *UpdateTermsOfService) error
+	VisitUpdateUsersNearby(value *UpdateUsersNearby) error
+	VisitUpdateAttachmentMenuBots(value *UpdateAttachmentMenuBots) error
+	VisitUpdateWebAppMessageSent(value *UpdateWebAppMessageSent) error
+	VisitUpdateActiveEmojiReactions(value *UpdateActiveEmojiReactions) error
+	VisitUpdateDefaultReactionType(value *UpdateDefaultReactionType) error
+	VisitUpdateDiceEmojis(value *UpdateDiceEmojis) error
+	VisitUpdateAnimatedEmojiMessageClicked(value *UpdateAnimatedEmojiMessageClicked) error
+	VisitUpdateAnimationSearchParameters(value *UpdateAnimationSearchParameters) error
+	VisitUpdateSuggestedActions(value *UpdateSuggestedActions) error
+	VisitUpdateAddChatMembersPrivacyForbidden(value *UpdateAddChatMembersPrivacyForbidden) error
+	VisitUpdateAutosaveSettings(value *UpdateAutosaveSettings) error
+	VisitUpdateNewInlineQuery(value *UpdateNewInlineQuery) error
+	VisitUpdateNewChosenInlineResult(value *UpdateNewChosenInlineResult) error
+	VisitUpdateNewCallbackQuery(value *UpdateNewCallbackQuery) error
+	VisitUpdateNewInlineCallbackQuery(value *UpdateNewInlineCallbackQuery) error
+	VisitUpdateNewShippingQuery(value *UpdateNewShippingQuery) error
+	VisitUpdateNewPreCheckoutQuery(value *UpdateNewPreCheckoutQuery) error
+	VisitUpdateNewCustomEvent(value *UpdateNewCustomEvent) error
+	VisitUpdateNewCustomQuery(value *UpdateNewCustomQuery) error
+	VisitUpdatePoll(value *UpdatePoll) error
+	VisitUpdatePollAnswer(value *UpdatePollAnswer) error
+	VisitUpdateChatMember(value *UpdateChatMember) error
+	VisitUpdateNewChatJoinRequest(value *UpdateNewChatJoinRequest) error
+}
+
+// VisitUpdate calls the method of the visitor for the subtype of the value
+func VisitUpdate(value Update, visitor UpdateVisitor) error {
+	switch value := value.(type) {
+	case *UpdateAuthorizationState:
+		return visitor.VisitUpdateAuthorizationState(value)
+
+	case *UpdateNewMessage:
+		return visitor.VisitUpdateNewMessage(value)
+
+	case *UpdateMessageSendAcknowledged:
+		return visitor.VisitUpdateMessageSendAcknowledged(value)
+
+	case *UpdateMessageSendSucceeded:
+		return visitor.VisitUpdateMessageSendSucceeded(value)
+
+	case *UpdateMessageSendFailed:
+		return visitor.VisitUpdateMessageSendFailed(value)
+
+	case *UpdateMessageContent:
+		return visitor.VisitUpdateMessageContent(value)
+
+	case *UpdateMessageEdited:
+		return visitor.VisitUpdateMessageEdited(value)
+
+	case *UpdateMessageIsPinned:
+		return visitor.VisitUpdateMessageIsPinned(value)
+
+	case *UpdateMessageInteractionInfo:
+		return visitor.VisitUpdateMessageInteractionInfo(value)
+
+	case *UpdateMessageContentOpened:
+		return visitor.VisitUpdateMessageContentOpened(value)
+
+	case *UpdateMessageMentionRead:
+		return visitor.VisitUpdateMessageMentionRead(value)
+
+	case *UpdateMessageUnreadReactions:
+		return visitor.VisitUpdateMessageUnreadReactions(value)
+
+	case *UpdateMessageLiveLocationViewed:
+		return visitor.VisitUpdateMessageLiveLocationViewed(value)
+
+	case *UpdateNewChat:
+		return visitor.VisitUpdateNewChat(value)
+
+	case *UpdateChatTitle:
+		return visitor.VisitUpdateChatTitle(value)
+
+	case *UpdateChatPhoto:
+		return visitor.VisitUpdateChatPhoto(value)
+
+	case *UpdateChatPermissions:
+		return visitor.VisitUpdateChatPermissions(value)
+
+	case *UpdateChatLastMessage:
+		return visitor.VisitUpdateChatLastMessage(value)
+
+	case *UpdateChatPosition:
+		return visitor.VisitUpdateChatPosition(value)
+
+	case *UpdateChatReadInbox:
+		return visitor.VisitUpdateChatReadInbox(value)
+
+	case *UpdateChatReadOutbox:
+		return visitor.VisitUpdateChatReadOutbox(value)
+
+	case *UpdateChatActionBar:
+		return visitor.VisitUpdateChatActionBar(value)
+
+	case *UpdateChatAvailableReactions:
+		return visitor.VisitUpdateChatAvailableReactions(value)
+
+	case *UpdateChatDraftMessage:
+		return visitor.VisitUpdateChatDraftMessage(value)
+
+	case *UpdateChatMessageSender:
+		return visitor.VisitUpdateChatMessageSender(value)
+
+	case *UpdateChatMessageAutoDeleteTime:
+		return visitor.VisitUpdateChatMessageAutoDeleteTime(value)
+
+	case *UpdateChatNotificationSettings:
+		return visitor.VisitUpdateChatNotificationSettings(value)
+
+	case *UpdateChatPendingJoinRequests:
+		return visitor.VisitUpdateChatPendingJoinRequests(value)
+
+	case *UpdateChatReplyMarkup:
+		return visitor.VisitUpdateChatReplyMarkup(value)
+
+	case *UpdateChatBackground:
+		return visitor.VisitUpdateChatBackground(value)
+
+	case *UpdateChatTheme:
+		return visitor.VisitUpdateChatTheme(value)
+
+	case *UpdateChatUnreadMentionCount:
+		return visitor.VisitUpdateChatUnreadMentionCount(value)
+
+	case *UpdateChatUnreadReactionCount:
+		return visitor.VisitUpdateChatUnreadReactionCount(value)
+
+	case *UpdateChatVideoChat:
+		return visitor.VisitUpdateChatVideoChat(value)
+
+	case *UpdateChatDefaultDisableNotification:
+		return visitor.VisitUpdateChatDefaultDisableNotification(value)
+
+	case *UpdateChatHasProtectedContent:
+		return visitor.VisitUpdateChatHasProtectedContent(value)
+
+	case *UpdateChatIsTranslatable:
+		return visitor.VisitUpdateChatIsTranslatable(value)
+
+	case *UpdateChatIsMarkedAsUnread:
+		return visitor.VisitUpdateChatIsMarkedAsUnread(value)
+
+	case *UpdateChatIsBlocked:
+		return visitor.VisitUpdateChatIsBlocked(value)
+
+	case *UpdateChatHasScheduledMessages:
+		return visitor.VisitUpdateChatHasScheduledMessages(value)
+
+	case *UpdateChatFolders:
+		return visitor.VisitUpdateChatFolders(value)
+
+	case *UpdateChatOnlineMemberCount:
+		return visitor.VisitUpdateChatOnlineMemberCount(value)
+
+	case *UpdateForumTopicInfo:
+		return visitor.VisitUpdateForumTopicInfo(value)
+
+	case *UpdateScopeNotificationSettings:
+		return visitor.VisitUpdateScopeNotificationSettings(value)
+
+	case *UpdateNotification:
+		return visitor.VisitUpdateNotification(value)
+
+	case *UpdateNotificationGroup:
+		return visitor.VisitUpdateNotificationGroup(value)
+
+	case *UpdateActiveNotifications:
+		return visitor.VisitUpdateActiveNotifications(value)
+
+	case *UpdateHavePendingNotifications:
+		return visitor.VisitUpdateHavePendingNotifications(value)
+
+	case *UpdateDeleteMessages:
+		return visitor.VisitUpdateDeleteMessages(value)
+
+	case *UpdateChatAction:
+		return visitor.VisitUpdateChatAction(value)
+
+	case *UpdateUserStatus:
+		return visitor.VisitUpdateUserStatus(value)
+
+	case *UpdateUser:
+		return visitor.VisitUpdateUser(value)
+
+	case *UpdateAccessHash:
+		return visitor.VisitUpdateAccessHash(value)
+
+	case *UpdateBasicGroup:
+		return visitor.VisitUpdateBasicGroup(value)
+
+	case *UpdateSupergroup:
+		return visitor.VisitUpdateSupergroup(value)
+
+	case *UpdateSecretChat:
+		return visitor.VisitUpdateSecretChat(value)
+
+	case *UpdateUserFullInfo:
+		return visitor.VisitUpdateUserFullInfo(value)
+
+	case *UpdateBasicGroupFullInfo:
+		return visitor.VisitUpdateBasicGroupFullInfo(value)
+
+	case *UpdateSupergroupFullInfo:
+		return visitor.VisitUpdateSupergroupFullInfo(value)
+
+	case *UpdateServiceNotification:
+		return visitor.VisitUpdateServiceNotification(value)
+
+	case *UpdateFile:
+		return visitor.VisitUpdateFile(value)
+
+	case *UpdateFileGenerationStart:
+		return visitor.VisitUpdateFileGenerationStart(value)
+
+	case *UpdateFileGenerationStop:
+		return visitor.VisitUpdateFileGenerationStop(value)
+
+	case *UpdateFileDownloads:
+		return visitor.VisitUpdateFileDownloads(value)
+
+	case *UpdateFileAddedToDownloads:
+		return visitor.VisitUpdateFileAddedToDownloads(value)
+
+	case *UpdateFileDownload:
+		return visitor.VisitUpdateFileDownload(value)
+
+	case *UpdateFileRemovedFromDownloads:
+		return visitor.VisitUpdateFileRemovedFromDownloads(value)
+
+	case *UpdateCall:
+		return visitor.VisitUpdateCall(value)
+
+	case *UpdateGroupCall:
+		return visitor.VisitUpdateGroupCall(value)
+
+	case *UpdateGroupCallParticipant:
+		return visitor.VisitUpdateGroupCallParticipant(value)
+
+	case *UpdateNewCallSignalingData:
+		return visitor.VisitUpdateNewCallSignalingData(value)
+
+	case *UpdateUserPrivacySettingRules:
+		return visitor.VisitUpdateUserPrivacySettingRules(value)
+
+	case *UpdateUnreadMessageCount:
+		return visitor.VisitUpdateUnreadMessageCount(value)
+
+	case *UpdateUnreadChatCount:
+		return visitor.VisitUpdateUnreadChatCount(value)
+
+	case *UpdateOption:
+		return visitor.VisitUpdateOption(value)
+
+	case *UpdateStickerSet:
+		return visitor.VisitUpdateStickerSet(value)
+
+	case *UpdateInstalledStickerSets:
+		return visitor.VisitUpdateInstalledStickerSets(value)
+
+	case *UpdateTrendingStickerSets:
+		return visitor.VisitUpdateTrendingStickerSets(value)
+
+	case *UpdateRecentStickers:
+		return visitor.VisitUpdateRecentStickers(value)
+
+	case *UpdateFavoriteStickers:
+		return visitor.VisitUpdateFavoriteStickers(value)
+
+	case *UpdateSavedAnimations:
+		return visitor.VisitUpdateSavedAnimations(value)
+
+	case *UpdateSavedNotificationSounds:
+		return visitor.VisitUpdateSavedNotificationSounds(value)
+
+	case *UpdateSelectedBackground:
+		return visitor.VisitUpdateSelectedBackground(value)
+
+	case *UpdateChatThemes:
+		return visitor.VisitUpdateChatThemes(value)
+
+	case *UpdateLanguagePackStrings:
+		return visitor.VisitUpdateLanguagePackStrings(value)
+
+	case *UpdateConnectionState:
+		return visitor.VisitUpdateConnectionState(value)
+
+	case *UpdateTermsOfService:
+		return visitor.VisitUpdateTermsOfService(value)
+
+	case *UpdateUsersNearby:
+		return visitor.VisitUpdateUsersNearby(value)
+
+	case *UpdateAttachmentMenuBots:
+		return visitor.VisitUpdateAttachmentMenuBots(value)
+
+	case *UpdateWebAppMessageSent:
+		return visitor.VisitUpdateWebAppMessageSent(value)
+
+	case *UpdateActiveEmojiReactions:
+		return visitor.VisitUpdateActiveEmojiReactions(value)
+
+	case *UpdateDefaultReactionType:
+		return visitor.VisitUpdateDefaultReactionType(value)
+
+	case *UpdateDiceEmojis:
+		return visitor.VisitUpdateDiceEmojis(value)
+
+	case *UpdateAnimatedEmojiMessageClicked:
+		return visitor.VisitUpdateAnimatedEmojiMessageClicked(value)
+
+	case *UpdateAnimationSearchParameters:
+		return visitor.VisitUpdateAnimationSearchParameters(value)
+
+	case *UpdateSuggestedActions:
+		return visitor.VisitUpdateSuggestedActions(value)
+
+	case *UpdateAddChatMembersPrivacyForbidden:
+		return visitor.VisitUpdateAddChatMembersPrivacyForbidden(value)
+
+	case *UpdateAutosaveSettings:
+		return visitor.VisitUpdateAutosaveSettings(value)
+
+	case *UpdateNewInlineQuery:
+		return visitor.VisitUpdateNewInlineQuery(value)
+
+	case *UpdateNewChosenInlineResult:
+		return visitor.VisitUpdateNewChosenInlineResult(value)
+
+	case *UpdateNewCallbackQuery:
+		return visitor.VisitUpdateNewCallbackQuery(value)
+
+	case *UpdateNewInlineCallbackQuery:
+		return visitor.VisitUpdateNewInlineCallbackQuery(value)
+
+	case *UpdateNewShippingQuery:
+		return visitor.VisitUpdateNewShippingQuery(value)
+
+	case *UpdateNewPreCheckoutQuery:
+		return visitor.VisitUpdateNewPreCheckoutQuery(value)
+
+	case *UpdateNewCustomEvent:
+		return visitor.VisitUpdateNewCustomEvent(value)
+
+	case *UpdateNewCustomQuery:
+		return visitor.VisitUpdateNewCustomQuery(value)
+
+	case *UpdatePoll:
+		return visitor.VisitUpdatePoll(value)
+
+	case *UpdatePollAnswer:
+		return visitor.VisitUpdatePollAnswer(value)
+
+	case *UpdateChatMember:
+		return visitor.VisitUpdateChatMember(value)
+
+	case *UpdateNewChatJoinRequest:
+		return visitor.VisitUpdateNewChatJoinRequest(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.UpdateType())
+	}
+}
+
+// LogStreamVisitor has a method for every subtype of LogStream. A new subtype breaks the build of the visitor implementations
+type LogStreamVisitor interface {
+	VisitLogStreamDefault(value *LogStreamDefault) error
+	VisitLogStreamFile(value *LogStreamFile) error
+	VisitLogStreamEmpty(value *LogStreamEmpty) error
+}
+
+// VisitLogStream calls the method of the visitor for the subtype of the value
+func VisitLogStream(value LogStream, visitor LogStreamVisitor) error {
+	switch value := value.(type) {
+	case *LogStreamDefault:
+		return visitor.VisitLogStreamDefault(value)
+
+	case *LogStreamFile:
+		return visitor.VisitLogStreamFile(value)
+
+	case *LogStreamEmpty:
+		return visitor.VisitLogStreamEmpty(value)
+
+	case nil:
+		return fmt.Errorf("%w: nil", ErrUnknownType)
+
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, value.LogStreamType())
+	}
+}
@@ -0,0 +1,73 @@
+package client_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/megaplan/go-tdlib/client"
+)
+
+var errTgs = errors.New("tgs isn't supported")
+
+// stickerFormatVisitor records the visited subtypes
+type stickerFormatVisitor struct {
+	visited []string
+}
+
+func (visitor *stickerFormatVisitor) VisitStickerFormatWebp(value *client.StickerFormatWebp) error {
+	visitor.visited = append(visitor.visited, value.StickerFormatType())
+	return nil
+}
+
+func (visitor *stickerFormatVisitor) VisitStickerFormatTgs(value *client.StickerFormatTgs) error {
+	return errTgs
+}
+
+func (visitor *stickerFormatVisitor) VisitStickerFormatWebm(value *client.StickerFormatWebm) error {
+	visitor.visited = append(visitor.visited, value.StickerFormatType())
+	return nil
+}
+
+func TestVisit(t *testing.T) {
+	visitor := &stickerFormatVisitor{}
+
+	for _, format := range []client.StickerFormat{&client.StickerFormatWebm{}, &client.StickerFormatWebp{}} {
+		err := client.VisitStickerFormat(format, visitor)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if len(visitor.visited) != 2 || visitor.visited[0] != client.TypeStickerFormatWebm || visitor.visited[1] != client.TypeStickerFormatWebp {
+		t.Fatalf("unexpected visited subtypes %v", visitor.visited)
+	}
+
+	// the error of the visitor is returned as it is
+	err := client.VisitStickerFormat(&client.StickerFormatTgs{}, visitor)
+	if err != errTgs {
+		t.Fatalf("expected the visitor error, got %v", err)
+	}
+}
+
+func TestVisitUnknown(t *testing.T) {
+	visitor := &stickerFormatVisitor{}
+
+	format, err := client.UnmarshalStickerFormat([]byte(`{"@type":"stickerFormatNew"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = client.VisitStickerFormat(format, visitor)
+	if !errors.Is(err, client.ErrUnknownType) || err.Error() != "unknown type: stickerFormatNew" {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	err = client.VisitStickerFormat(nil, visitor)
+	if !errors.Is(err, client.ErrUnknownType) {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if len(visitor.visited) != 0 {
+		t.Fatalf("unexpected visited subtypes %v", visitor.visited)
+	}
+}
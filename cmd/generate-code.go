@@ -2,50 +2,114 @@ package main
 
 import (
 	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"flag"
 	"log"
-	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/megaplan/go-tdlib/codegen"
+	"github.com/megaplan/go-tdlib/internal/source"
 	"github.com/megaplan/go-tdlib/tlparser"
 )
 
 type config struct {
 	version             string
+	inputFilePath       string
+	codeInputFilePath   string
+	schemaFilePath      string
 	outputDirPath       string
 	packageName         string
 	functionFileName    string
 	typeFileName        string
 	unmarshalerFileName string
+	marshalerFileName   string
+	dispatcherFileName  string
+	visitorFileName     string
 }
 
 func main() {
 	var config config
 
 	flag.StringVar(&config.version, "version", "", "TDLib version")
+	flag.StringVar(&config.inputFilePath, "input", "", "td_api.tl file or - for stdin, downloaded for the version if empty")
+	flag.StringVar(&config.codeInputFilePath, "codeInput", "", "Td.cpp file or - for stdin. If empty, it is downloaded for the version when the input is downloaded, otherwise function types are kept from the existing schema output")
+	flag.StringVar(&config.schemaFilePath, "schemaOutput", "", "json schema file, not written if empty")
 	flag.StringVar(&config.outputDirPath, "outputDir", "./tdlib", "output directory")
 	flag.StringVar(&config.packageName, "package", "tdlib", "package name")
 	flag.StringVar(&config.functionFileName, "functionFile", "function.go", "functions filename")
 	flag.StringVar(&config.typeFileName, "typeFile", "type.go", "types filename")
 	flag.StringVar(&config.unmarshalerFileName, "unmarshalerFile", "unmarshaler.go", "unmarshalers filename")
+	flag.StringVar(&config.marshalerFileName, "marshalerFile", "marshaler.go", "marshalers filename")
+	flag.StringVar(&config.dispatcherFileName, "dispatcherFile", "update_dispatcher.go", "update dispatcher filename")
+	flag.StringVar(&config.visitorFileName, "visitorFile", "visitor.go", "visitors filename")
 
 	flag.Parse()
 
-	resp, err := http.Get("https://raw.githubusercontent.com/megaplan/tdlight/" + config.version + "/td/generate/scheme/td_api.tl")
+	if config.inputFilePath == "-" && config.codeInputFilePath == "-" {
+		log.Fatal("only one of input and codeInput can be read from stdin")
+	}
+
+	if config.inputFilePath != "" && config.codeInputFilePath == "" && config.schemaFilePath == "" {
+		log.Fatal("function types are kept from the schema output, set schemaOutput or codeInput")
+	}
+
+	input, err := source.Read(config.inputFilePath, config.version, "td/generate/scheme/td_api.tl")
 	if err != nil {
-		log.Fatalf("http.Get error: %s", err)
-		return
+		log.Fatalf("input read error: %s", err)
 	}
-	defer resp.Body.Close()
 
-	schema, err := tlparser.Parse(resp.Body)
+	schema, err := tlparser.Parse(bytes.NewReader(input))
 	if err != nil {
 		log.Fatalf("schema parse error: %s", err)
 		return
 	}
 
+	checksum := sha256.Sum256(input)
+	schema.Version = config.version
+	schema.Checksum = hex.EncodeToString(checksum[:])
+
+	if config.codeInputFilePath == "" && config.inputFilePath != "" {
+		err = source.KeepFunctionTypes(schema, config.schemaFilePath)
+		if err != nil {
+			log.Fatalf("keep function types error: %s", err)
+		}
+	} else {
+		code, err := source.Read(config.codeInputFilePath, config.version, "td/telegram/Td.cpp")
+		if err != nil {
+			log.Fatalf("code input read error: %s", err)
+		}
+
+		err = tlparser.ParseCode(bytes.NewReader(code), schema)
+		if err != nil {
+			log.Fatalf("parse code error: %s", err)
+		}
+	}
+
+	if config.schemaFilePath != "" {
+		err = os.MkdirAll(filepath.Dir(config.schemaFilePath), os.ModePerm)
+		if err != nil {
+			log.Fatalf("make dir error: %s", filepath.Dir(config.schemaFilePath))
+		}
+
+		schemaFile, err := os.OpenFile(config.schemaFilePath, os.O_CREATE|os.O_RDWR|os.O_TRUNC, os.ModePerm)
+		if err != nil {
+			log.Fatalf("schemaFile open error: %s", err)
+		}
+		defer schemaFile.Close()
+
+		data, err := json.MarshalIndent(schema, "", strings.Repeat(" ", 4))
+		if err != nil {
+			log.Fatalf("json marshal error: %s", err)
+		}
+
+		bufio.NewWriter(schemaFile).Write(data)
+	}
+
 	err = os.MkdirAll(config.outputDirPath, 0755)
 	if err != nil {
 		log.Fatalf("error creating %s: %s", config.outputDirPath, err)
@@ -83,4 +147,37 @@ func main() {
 	defer unmarshalerFile.Close()
 
 	bufio.NewWriter(unmarshalerFile).Write(codegen.GenerateUnmarshalers(schema, config.packageName))
+
+	marshalerFilePath := filepath.Join(config.outputDirPath, config.marshalerFileName)
+
+	os.Remove(marshalerFilePath)
+	marshalerFile, err := os.OpenFile(marshalerFilePath, os.O_CREATE|os.O_RDWR|os.O_TRUNC, os.ModePerm)
+	if err != nil {
+		log.Fatalf("marshalerFile open error: %s", err)
+	}
+	defer marshalerFile.Close()
+
+	bufio.NewWriter(marshalerFile).Write(codegen.GenerateMarshalers(schema, config.packageName))
+
+	dispatcherFilePath := filepath.Join(config.outputDirPath, config.dispatcherFileName)
+
+	os.Remove(dispatcherFilePath)
+	dispatcherFile, err := os.OpenFile(dispatcherFilePath, os.O_CREATE|os.O_RDWR|os.O_TRUNC, 0644)
+	if err != nil {
+		log.Fatalf("dispatcherFile open error: %s", err)
+	}
+	defer dispatcherFile.Close()
+
+	bufio.NewWriter(dispatcherFile).Write(codegen.GenerateDispatcher(schema, config.packageName))
+
+	visitorFilePath := filepath.Join(config.outputDirPath, config.visitorFileName)
+
+	os.Remove(visitorFilePath)
+	visitorFile, err := os.OpenFile(visitorFilePath, os.O_CREATE|os.O_RDWR|os.O_TRUNC, 0644)
+	if err != nil {
+		log.Fatalf("visitorFile open error: %s", err)
+	}
+	defer visitorFile.Close()
+
+	bufio.NewWriter(visitorFile).Write(codegen.GenerateVisitors(schema, config.packageName))
 }
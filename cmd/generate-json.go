@@ -2,50 +2,68 @@ package main
 
 import (
 	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"flag"
 	"log"
-	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
 
+	"github.com/megaplan/go-tdlib/internal/source"
 	"github.com/megaplan/go-tdlib/tlparser"
 )
 
 func main() {
 	var version string
+	var inputFilePath string
+	var codeInputFilePath string
 	var outputFilePath string
 
 	flag.StringVar(&version, "version", "", "TDLib version")
+	flag.StringVar(&inputFilePath, "input", "", "td_api.tl file or - for stdin, downloaded for the version if empty")
+	flag.StringVar(&codeInputFilePath, "codeInput", "", "Td.cpp file or - for stdin. If empty, it is downloaded for the version when the input is downloaded, otherwise function types are kept from the existing output")
 	flag.StringVar(&outputFilePath, "output", "./td_api.json", "json schema file")
 
 	flag.Parse()
 
-	resp, err := http.Get("https://raw.githubusercontent.com/megaplan/tdlight/" + version + "/td/generate/scheme/td_api.tl")
-	if err != nil {
-		log.Fatalf("http.Get error: %s", err)
-		return
+	if inputFilePath == "-" && codeInputFilePath == "-" {
+		log.Fatal("only one of input and codeInput can be read from stdin")
 	}
-	defer resp.Body.Close()
 
-	schema, err := tlparser.Parse(resp.Body)
+	input, err := source.Read(inputFilePath, version, "td/generate/scheme/td_api.tl")
 	if err != nil {
-		log.Fatalf("schema parse error: %s", err)
-		return
+		log.Fatalf("input read error: %s", err)
 	}
 
-	resp, err = http.Get("https://raw.githubusercontent.com/megaplan/tdlight/" + version + "/td/telegram/Td.cpp")
+	schema, err := tlparser.Parse(bytes.NewReader(input))
 	if err != nil {
-		log.Fatalf("http.Get error: %s", err)
+		log.Fatalf("schema parse error: %s", err)
 		return
 	}
-	defer resp.Body.Close()
 
-	err = tlparser.ParseCode(resp.Body, schema)
-	if err != nil {
-		log.Fatalf("parse code error: %s", err)
-		return
+	checksum := sha256.Sum256(input)
+	schema.Version = version
+	schema.Checksum = hex.EncodeToString(checksum[:])
+
+	if codeInputFilePath == "" && inputFilePath != "" {
+		err = source.KeepFunctionTypes(schema, outputFilePath)
+		if err != nil {
+			log.Fatalf("keep function types error: %s", err)
+		}
+	} else {
+		code, err := source.Read(codeInputFilePath, version, "td/telegram/Td.cpp")
+		if err != nil {
+			log.Fatalf("code input read error: %s", err)
+		}
+
+		err = tlparser.ParseCode(bytes.NewReader(code), schema)
+		if err != nil {
+			log.Fatalf("parse code error: %s", err)
+			return
+		}
 	}
 
 	err = os.MkdirAll(filepath.Dir(outputFilePath), os.ModePerm)
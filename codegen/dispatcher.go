@@ -0,0 +1,34 @@
+package codegen
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/megaplan/go-tdlib/tlparser"
+)
+
+const updateClass = "Update"
+
+func GenerateDispatcher(schema *tlparser.Schema, packageName string) []byte {
+	buf := bytes.NewBufferString("")
+
+	buf.WriteString(fmt.Sprintf("%s\n\npackage %s\n", header, packageName))
+
+	tdlibClass := TdlibClass(updateClass, schema)
+
+	for _, subType := range tdlibClass.GetSubTypes() {
+		buf.WriteString("\n")
+		buf.WriteString("// " + subType.GetType().Description)
+		buf.WriteString("\n")
+
+		buf.WriteString(fmt.Sprintf(`func (dispatcher *Dispatcher) On%s(handler func(update *%s)) {
+    dispatcher.Handle(%s, func(update Type) {
+        handler(update.(*%s))
+    })
+}
+`, strings.TrimPrefix(subType.ToGoType(), updateClass), subType.ToGoType(), subType.ToTypeConst(), subType.ToGoType()))
+	}
+
+	return buf.Bytes()
+}
@@ -12,9 +12,25 @@ func GenerateFunctions(schema *tlparser.Schema, packageName string) []byte {
 
 	buf.WriteString(fmt.Sprintf("%s\n\npackage %s\n\n", header, packageName))
 
-	buf.WriteString(`import (
-    "errors"
+	// lists of classes are unmarshaled from the raw list
+	hasListOfClassesReturn := false
+	for _, function := range schema.Functions {
+		tdlibFunctionReturn := TdlibFunctionReturn(function.Class, schema)
+		if tdlibFunctionReturn.IsList() && tdlibFunctionReturn.GetElement().IsClass() {
+			hasListOfClassesReturn = true
+		}
+	}
+
+	if hasListOfClassesReturn {
+		buf.WriteString(`import (
+    "context"
+    "encoding/json"
+)`)
+	} else {
+		buf.WriteString(`import (
+    "context"
 )`)
+	}
 
 	buf.WriteString("\n")
 
@@ -32,77 +48,38 @@ func GenerateFunctions(schema *tlparser.Schema, packageName string) []byte {
 				buf.WriteString(fmt.Sprintf("    %s %s `json:\"%s\"`\n", tdlibTypeProperty.ToGoName(), tdlibTypeProperty.ToGoType(), property.Name))
 			}
 			buf.WriteString("}\n")
-		}
 
-		if function.IsSynchronous {
-			buf.WriteString("\n")
-			buf.WriteString("// " + function.Description)
 			buf.WriteString("\n")
-
-			requestArgument := ""
-			if len(function.Properties) > 0 {
-				requestArgument = fmt.Sprintf("req *%sRequest", tdlibFunction.ToGoName())
+			buf.WriteString(fmt.Sprintf("func (req *%sRequest) encode(encoder *encoder) {\n", tdlibFunction.ToGoName()))
+			for _, property := range function.Properties {
+				buf.WriteString(encodeMember(property, "req", schema))
 			}
+			buf.WriteString("}\n")
 
-			buf.WriteString(fmt.Sprintf("func %s(%s) (%s, error) {\n", tdlibFunction.ToGoName(), requestArgument, tdlibFunctionReturn.ToGoReturn()))
-
-			if len(function.Properties) > 0 {
-				buf.WriteString(fmt.Sprintf(`    result, err := Execute(Request{
-        meta: meta{
-            Type: "%s",
-        },
-        Data: map[string]interface{}{
-`, function.Name))
-
-				for _, property := range function.Properties {
-					tdlibTypeProperty := TdlibTypeProperty(property.Name, property.Type, schema)
-
-					buf.WriteString(fmt.Sprintf("            \"%s\": req.%s,\n", property.Name, tdlibTypeProperty.ToGoName()))
+			for _, property := range function.Properties {
+				tdlibTypeProperty := TdlibTypeProperty(property.Name, property.Type, schema)
+				if property.Name == "chat_id" && tdlibTypeProperty.ToGoType() == "int64" {
+					buf.WriteString("\n")
+					buf.WriteString(fmt.Sprintf("func (req *%sRequest) chatId() int64 {\n", tdlibFunction.ToGoName()))
+					buf.WriteString("    return req.ChatId\n")
+					buf.WriteString("}\n")
 				}
-
-				buf.WriteString(`        },
-    })
-`)
-			} else {
-				buf.WriteString(fmt.Sprintf(`    result, err := Execute(Request{
-        meta: meta{
-            Type: "%s",
-        },
-        Data: map[string]interface{}{},
-    })
-`, function.Name))
 			}
+		}
 
-			buf.WriteString(`    if err != nil {
-        return nil, err
-    }
-
-    if result.Type == "error" {
-        return nil, buildResponseError(result.Data)
-    }
-
-`)
-
-			if tdlibFunctionReturn.IsClass() {
-				buf.WriteString("    switch result.Type {\n")
-
-				for _, subType := range tdlibFunctionReturn.GetClass().GetSubTypes() {
-					buf.WriteString(fmt.Sprintf(`    case %s:
-        return Unmarshal%s(result.Data)
-
-`, subType.ToTypeConst(), subType.ToGoType()))
+		requestArgument := ""
+		if len(function.Properties) > 0 {
+			requestArgument = fmt.Sprintf("req *%sRequest", tdlibFunction.ToGoName())
+		}
 
-				}
+		if function.IsSynchronous {
+			buf.WriteString("\n")
+			buf.WriteString("// " + function.Description)
+			buf.WriteString("\n")
 
-				buf.WriteString(`    default:
-        return nil, errors.New("invalid type")
-`)
+			buf.WriteString(fmt.Sprintf("func %s(%s) (%s, error) {\n", tdlibFunction.ToGoName(), requestArgument, tdlibFunctionReturn.ToGoReturn()))
 
-				buf.WriteString("   }\n")
-			} else {
-				buf.WriteString(fmt.Sprintf(`    return Unmarshal%s(result.Data)
-`, tdlibFunctionReturn.ToGoType()))
-			}
+			generateFunctionBody(buf, function, schema, "Execute")
 
 			buf.WriteString("}\n")
 		}
@@ -114,49 +91,101 @@ func GenerateFunctions(schema *tlparser.Schema, packageName string) []byte {
 		}
 		buf.WriteString("// " + function.Description)
 		buf.WriteString("\n")
+		generateFunctionTypeComment(buf, function)
 
-		requestArgument := ""
+		buf.WriteString(fmt.Sprintf("func (client *Client) %s(%s) (%s, error) {\n", tdlibFunction.ToGoName(), requestArgument, tdlibFunctionReturn.ToGoReturn()))
+
+		if function.IsSynchronous {
+			generateFunctionBody(buf, function, schema, "client.Execute")
+
+			buf.WriteString("}\n")
+
+			continue
+		}
+
+		callArgument := ""
 		if len(function.Properties) > 0 {
-			requestArgument = fmt.Sprintf("req *%sRequest", tdlibFunction.ToGoName())
+			callArgument = ", req"
 		}
 
-		buf.WriteString(fmt.Sprintf("func (client *Client) %s(%s) (%s, error) {\n", tdlibFunction.ToGoName(), requestArgument, tdlibFunctionReturn.ToGoReturn()))
+		buf.WriteString(fmt.Sprintf("    return client.%sContext(context.Background()%s)\n", tdlibFunction.ToGoName(), callArgument))
+		buf.WriteString("}\n")
+
+		buf.WriteString("\n")
+		buf.WriteString("// " + function.Description)
+		buf.WriteString("\n")
+		generateFunctionTypeComment(buf, function)
+
+		requestArgument = "ctx context.Context"
+		if len(function.Properties) > 0 {
+			requestArgument += fmt.Sprintf(", req *%sRequest", tdlibFunction.ToGoName())
+		}
+
+		buf.WriteString(fmt.Sprintf("func (client *Client) %sContext(%s) (%s, error) {\n", tdlibFunction.ToGoName(), requestArgument, tdlibFunctionReturn.ToGoReturn()))
+
+		switch function.Type {
+		case tlparser.FUNCTION_TYPE_USER:
+			buf.WriteString(fmt.Sprintf(`    err := client.checkUsersOnly(%q)
+    if err != nil {
+        return nil, err
+    }
 
-		if function.IsSynchronous {
-			requestArgument = ""
-			if len(function.Properties) > 0 {
-				requestArgument = "req"
-			}
-			buf.WriteString(fmt.Sprintf(`    return %s(%s)`, tdlibFunction.ToGoName(), requestArgument))
-		} else {
-			if len(function.Properties) > 0 {
-				buf.WriteString(fmt.Sprintf(`    result, err := client.Send(Request{
-        meta: meta{
-            Type: "%s",
-        },
-        Data: map[string]interface{}{
 `, function.Name))
 
-				for _, property := range function.Properties {
-					tdlibTypeProperty := TdlibTypeProperty(property.Name, property.Type, schema)
+		case tlparser.FUNCTION_TYPE_BOT:
+			buf.WriteString(fmt.Sprintf(`    err := client.checkBotsOnly(%q)
+    if err != nil {
+        return nil, err
+    }
 
-					buf.WriteString(fmt.Sprintf("            \"%s\": req.%s,\n", property.Name, tdlibTypeProperty.ToGoName()))
-				}
+`, function.Name))
+		}
+
+		generateFunctionBody(buf, function, schema, "client.SendContext", "ctx")
 
-				buf.WriteString(`        },
+		buf.WriteString("}\n")
+	}
+
+	return buf.Bytes()
+}
+
+// generateFunctionTypeComment annotates the methods available only to users or bots
+func generateFunctionTypeComment(buf *bytes.Buffer, function *tlparser.Function) {
+	switch function.Type {
+	case tlparser.FUNCTION_TYPE_USER:
+		buf.WriteString("//\n// Available only to users, bots get FunctionTypeError\n")
+
+	case tlparser.FUNCTION_TYPE_BOT:
+		buf.WriteString("//\n// Available only to bots, users get FunctionTypeError\n")
+	}
+}
+
+func generateFunctionBody(buf *bytes.Buffer, function *tlparser.Function, schema *tlparser.Schema, call string, callArguments ...string) {
+	tdlibFunctionReturn := TdlibFunctionReturn(function.Class, schema)
+
+	callArgument := ""
+	for _, argument := range callArguments {
+		callArgument += argument + ", "
+	}
+
+	if len(function.Properties) > 0 {
+		buf.WriteString(fmt.Sprintf(`    result, err := %s(%sRequest{
+        meta: meta{
+            Type: "%s",
+        },
+        Data: req,
     })
-`)
-			} else {
-				buf.WriteString(fmt.Sprintf(`    result, err := client.Send(Request{
+`, call, callArgument, function.Name))
+	} else {
+		buf.WriteString(fmt.Sprintf(`    result, err := %s(%sRequest{
         meta: meta{
             Type: "%s",
         },
-        Data: map[string]interface{}{},
     })
-`, function.Name))
-			}
+`, call, callArgument, function.Name))
+	}
 
-			buf.WriteString(`    if err != nil {
+	buf.WriteString(`    if err != nil {
         return nil, err
     }
 
@@ -166,30 +195,27 @@ func GenerateFunctions(schema *tlparser.Schema, packageName string) []byte {
 
 `)
 
-			if tdlibFunctionReturn.IsClass() {
-				buf.WriteString("    switch result.Type {\n")
+	switch {
+	case tdlibFunctionReturn.IsList() && tdlibFunctionReturn.GetElement().IsClass():
+		buf.WriteString(fmt.Sprintf(`    var list []json.RawMessage
 
-				for _, subType := range tdlibFunctionReturn.GetClass().GetSubTypes() {
-					buf.WriteString(fmt.Sprintf(`    case %s:
-        return Unmarshal%s(result.Data)
+    err = json.Unmarshal(result.Data, &list)
+    if err != nil {
+        return nil, err
+    }
 
-`, subType.ToTypeConst(), subType.ToGoType()))
+    return UnmarshalListOf%s(list)
+`, tdlibFunctionReturn.GetElement().ToGoType()))
 
-				}
+	case tdlibFunctionReturn.IsList():
+		buf.WriteString(fmt.Sprintf(`    decoder := newDecoder(result.Data)
+    list := decode%s(decoder)
 
-				buf.WriteString(`    default:
-        return nil, errors.New("invalid type")
-`)
+    return list, decoder.finish()
+`, codecName(vectorName(function.Class), schema)))
 
-				buf.WriteString("   }\n")
-			} else {
-				buf.WriteString(fmt.Sprintf(`    return Unmarshal%s(result.Data)
+	default:
+		buf.WriteString(fmt.Sprintf(`    return Unmarshal%s(result.Data)
 `, tdlibFunctionReturn.ToGoType()))
-			}
-		}
-
-		buf.WriteString("}\n")
 	}
-
-	return buf.Bytes()
 }
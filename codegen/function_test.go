@@ -0,0 +1,99 @@
+package codegen
+
+import (
+	"go/parser"
+	"go/token"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/megaplan/go-tdlib/tlparser"
+)
+
+const vectorSchema = `double ? = Double;
+string ? = String;
+
+int32 = Int32;
+int53 = Int53;
+int64 = Int64;
+bytes = Bytes;
+
+boolFalse = Bool;
+boolTrue = Bool;
+
+vector {t:Type} # [ t ] = Vector t;
+
+//@class MessageContent @description Contains the content of a message
+
+//@description A text message @text Text of the message
+messageText text:string = MessageContent;
+
+//@description Describes a message @id Message identifier @content Content of the message
+message id:int53 content:MessageContent = Message;
+
+---functions---
+
+//@description Returns messages of the chat @chat_id Chat identifier
+getChatMessageList chat_id:int53 = Vector<message>;
+
+//@description Returns contents of the messages of the chat @chat_id Chat identifier
+getChatMessageContents chat_id:int53 = Vector<MessageContent>;
+`
+
+func parseSchema(t *testing.T, data string) *tlparser.Schema {
+	schema, err := tlparser.Parse(strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return schema
+}
+
+// assertGoCode checks that the generated code is valid Go and contains the fragments
+func assertGoCode(t *testing.T, name string, code []byte, fragments ...string) {
+	_, err := parser.ParseFile(token.NewFileSet(), name, code, 0)
+	if err != nil {
+		t.Fatalf("%s: %s\n%s", name, err, code)
+	}
+
+	for _, fragment := range fragments {
+		if !strings.Contains(string(code), fragment) {
+			t.Errorf("%s: %q is missing", name, fragment)
+		}
+	}
+}
+
+func TestGenerateVectorReturns(t *testing.T) {
+	schema := parseSchema(t, vectorSchema)
+
+	assertGoCode(t, "function.go", GenerateFunctions(schema, "client"),
+		"func (client *Client) GetChatMessageList(req *GetChatMessageListRequest) ([]*Message, error)",
+		"list := decodeListOfMessage(decoder)",
+		"func (client *Client) GetChatMessageContents(req *GetChatMessageContentsRequest) ([]MessageContent, error)",
+		"return UnmarshalListOfMessageContent(list)",
+	)
+
+	assertGoCode(t, "unmarshaler.go", GenerateUnmarshalers(schema, "client"),
+		"func decodeListOfMessage(decoder *decoder) []*Message",
+	)
+}
+
+func TestGenerateUnsupportedReturn(t *testing.T) {
+	if os.Getenv("CODEGEN_UNSUPPORTED_RETURN") != "" {
+		schema := parseSchema(t, vectorSchema+"\n//@description Returns nothing known\ngetUnknown = unknownType;\n")
+		GenerateFunctions(schema, "client")
+		return
+	}
+
+	// the generator exits with the error instead of a panic
+	cmd := exec.Command(os.Args[0], "-test.run", "^TestGenerateUnsupportedReturn$")
+	cmd.Env = append(os.Environ(), "CODEGEN_UNSUPPORTED_RETURN=1")
+
+	output, err := cmd.CombinedOutput()
+
+	exitErr, ok := err.(*exec.ExitError)
+	if !ok || exitErr.ExitCode() != 1 || !strings.Contains(string(output), "unsupported return type unknownType") {
+		t.Fatalf("unexpected result %v: %s", err, output)
+	}
+}
@@ -0,0 +1,152 @@
+package codegen
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/megaplan/go-tdlib/tlparser"
+)
+
+func GenerateMarshalers(schema *tlparser.Schema, packageName string) []byte {
+	buf := bytes.NewBufferString("")
+
+	buf.WriteString(fmt.Sprintf("%s\n\npackage %s\n", header, packageName))
+
+	// lists of the encoder methods
+	lists := []string{"vector<int32>", "vector<int53>", "vector<string>"}
+
+	for _, class := range schema.Classes {
+		tdlibClass := TdlibClass(class.Name, schema)
+
+		buf.WriteString(fmt.Sprintf(`
+func encode%s(encoder *encoder, value %s) {
+    switch value := value.(type) {
+    case nil:
+        encoder.null()
+
+`, tdlibClass.ToGoType(), tdlibClass.ToGoType()))
+
+		for _, subType := range tdlibClass.GetSubTypes() {
+			buf.WriteString(fmt.Sprintf(`    case *%s:
+        encode%s(encoder, value)
+
+`, subType.ToGoType(), subType.ToGoType()))
+		}
+
+		buf.WriteString(`    default:
+        // the type missing in the schema keeps its raw JSON
+        encoder.marshaler(value)
+    }
+}
+`)
+	}
+
+	for _, typ := range schema.Types {
+		tdlibType := TdlibType(typ.Name, schema)
+
+		if tdlibType.IsList() || tdlibType.IsInternal() {
+			continue
+		}
+
+		buf.WriteString(fmt.Sprintf(`
+func encode%s(encoder *encoder, value *%s) {
+    if value == nil {
+        encoder.null()
+        return
+    }
+
+    encoder.beginObject()
+    value.encode(encoder)
+    encoder.endObject()
+}
+
+func (%s *%s) encode(encoder *encoder) {
+    encoder.meta(%s, %s.meta)
+`, tdlibType.ToGoType(), tdlibType.ToGoType(), typ.Name, tdlibType.ToGoType(), tdlibType.ToTypeConst(), typ.Name))
+
+		for _, property := range typ.Properties {
+			buf.WriteString(encodeMember(property, typ.Name, schema))
+
+			collectLists(property.Type, &lists)
+		}
+
+		buf.WriteString("}\n")
+	}
+
+	// lists of the request parameters
+	for _, function := range schema.Functions {
+		for _, property := range function.Properties {
+			collectLists(property.Type, &lists)
+		}
+	}
+
+	for _, list := range lists {
+		elementType := strings.TrimSuffix(strings.TrimPrefix(list, "vector<"), ">")
+
+		buf.WriteString(fmt.Sprintf(`
+func encode%s(encoder *encoder, list %s) {
+    if list == nil {
+        encoder.null()
+        return
+    }
+
+    encoder.beginArray()
+    for i, value := range list {
+        if i > 0 {
+            encoder.comma()
+        }
+        %s
+    }
+    encoder.endArray()
+}
+`, codecName(list, schema), TdlibTypeProperty("", list, schema).ToGoType(), encodeExpression(elementType, "value", schema)))
+	}
+
+	buf.WriteString(`
+func encodeType(encoder *encoder, value Type) {
+    switch value := value.(type) {
+    case nil:
+        encoder.null()
+
+`)
+
+	for _, typ := range schema.Types {
+		tdlibType := TdlibType(typ.Name, schema)
+
+		if tdlibType.IsList() || tdlibType.IsInternal() {
+			continue
+		}
+
+		buf.WriteString(fmt.Sprintf(`    case *%s:
+        encode%s(encoder, value)
+
+`, tdlibType.ToGoType(), tdlibType.ToGoType()))
+	}
+
+	buf.WriteString(`    default:
+        encoder.marshaler(value)
+    }
+}
+`)
+
+	return buf.Bytes()
+}
+
+// encodeMember returns the statements writing the property of the object or the request with the encoder
+func encodeMember(property *tlparser.Property, receiver string, schema *tlparser.Schema) string {
+	tdlibTypeProperty := TdlibTypeProperty(property.Name, property.Type, schema)
+	value := receiver + "." + tdlibTypeProperty.ToGoName()
+
+	method := tdlibTypeProperty.ToEncoderMethod()
+	if method != "" {
+		return fmt.Sprintf("    encoder.%s(%q, %s)\n", method, property.Name, value)
+	}
+
+	return fmt.Sprintf("    encoder.name(%q)\n    %s\n", property.Name, encodeExpression(property.Type, value, schema))
+}
+
+// encodeExpression returns the call writing the value of the property type with the encoder
+func encodeExpression(propertyType string, value string, schema *tlparser.Schema) string {
+	return fmt.Sprintf("encode%s(encoder, %s)", codecName(propertyType, schema), value)
+}
@@ -30,21 +30,34 @@ type tdlibFunctionReturn struct {
 
 func TdlibFunctionReturn(name string, schema *tlparser.Schema) *tdlibFunctionReturn {
 	return &tdlibFunctionReturn{
-		name:   name,
+		name:   vectorName(name),
 		schema: schema,
 	}
 }
 
+// vectorName writes the vectors of the returned type like the vectors of properties.
+// Functions return Vector<T>, while properties and the built-in type are vector<t>
+func vectorName(name string) string {
+	return strings.ReplaceAll(name, "Vector<", "vector<")
+}
+
+// IsType reports whether the returned class or the element of the returned vector has the type.
+// Elements of vectors can be bare types, for example vector<message>
 func (entity *tdlibFunctionReturn) IsType() bool {
-	return isType(entity.name, func(entity *tlparser.Type) string {
-		return entity.Class
-	}, entity.schema)
+	return entity.GetType() != nil
 }
 
 func (entity *tdlibFunctionReturn) GetType() *tdlibType {
-	return getType(entity.name, func(entity *tlparser.Type) string {
+	tdlibType := getType(entity.name, func(entity *tlparser.Type) string {
 		return entity.Class
 	}, entity.schema)
+	if tdlibType != nil {
+		return tdlibType
+	}
+
+	return getType(entity.name, func(entity *tlparser.Type) string {
+		return entity.Name
+	}, entity.schema)
 }
 
 func (entity *tdlibFunctionReturn) IsClass() bool {
@@ -59,15 +72,28 @@ func (entity *tdlibFunctionReturn) GetClass() *tdlibClass {
 	}, entity.schema)
 }
 
+func (entity *tdlibFunctionReturn) IsList() bool {
+	return strings.HasPrefix(entity.name, "vector<")
+}
+
+// GetElement returns the element of the returned vector
+func (entity *tdlibFunctionReturn) GetElement() *tdlibFunctionReturn {
+	return TdlibFunctionReturn(strings.TrimSuffix(strings.TrimPrefix(entity.name, "vector<"), ">"), entity.schema)
+}
+
 func (entity *tdlibFunctionReturn) ToGoReturn() string {
-	if strings.HasPrefix(entity.name, "vector<") {
-		log.Fatal("vectors are not supported")
+	if entity.IsList() {
+		return "[]" + entity.GetElement().ToGoReturn()
 	}
 
 	if entity.IsClass() {
 		return entity.GetClass().ToGoType()
 	}
 
+	if !entity.IsType() {
+		log.Fatalf("unsupported return type %s", entity.name)
+	}
+
 	if entity.GetType().IsInternal() {
 		return entity.GetType().ToGoType()
 	}
@@ -76,14 +102,18 @@ func (entity *tdlibFunctionReturn) ToGoReturn() string {
 }
 
 func (entity *tdlibFunctionReturn) ToGoType() string {
-	if strings.HasPrefix(entity.name, "vector<") {
-		log.Fatal("vectors are not supported")
+	if entity.IsList() {
+		return "[]" + entity.GetElement().ToGoReturn()
 	}
 
 	if entity.IsClass() {
 		return entity.GetClass().ToGoType()
 	}
 
+	if !entity.IsType() {
+		log.Fatalf("unsupported return type %s", entity.name)
+	}
+
 	return entity.GetType().ToGoType()
 }
 
@@ -325,6 +355,11 @@ func (entity *tdlibClass) ToGoType() string {
 	return firstUpper(entity.name)
 }
 
+// ToUnknownGoType returns the type of the subtypes missing in the schema
+func (entity *tdlibClass) ToUnknownGoType() string {
+	return "Unknown" + entity.ToGoType()
+}
+
 func (entity *tdlibClass) ToType() string {
 	return entity.ToGoType() + "Type"
 }
@@ -434,6 +469,43 @@ func (entity *tdlibTypeProperty) ToGoType() string {
 	return goType + "*" + entity.GetType().ToGoType()
 }
 
+// ToEncoderMethod returns the method of the encoder writing the property or an empty string for objects and their lists
+func (entity *tdlibTypeProperty) ToEncoderMethod() string {
+	switch entity.ToGoType() {
+	case "string":
+		return "String"
+
+	case "bool":
+		return "Bool"
+
+	case "int32":
+		return "Int32"
+
+	case "int64":
+		return "Int64"
+
+	case "JsonInt64":
+		return "JsonInt64"
+
+	case "float64":
+		return "Float64"
+
+	case "[]byte":
+		return "Bytes"
+
+	case "[]int32":
+		return "Int32s"
+
+	case "[]int64":
+		return "Int64s"
+
+	case "[]string":
+		return "Strings"
+	}
+
+	return ""
+}
+
 func isType(name string, field func(entity *tlparser.Type) string, schema *tlparser.Schema) bool {
 	name = normalizeEntityName(name)
 	for _, entity := range schema.Types {
@@ -18,6 +18,15 @@ func GenerateTypes(schema *tlparser.Schema, packageName string) []byte {
 
 `)
 
+	buf.WriteString(fmt.Sprintf(`const (
+    // TDLib version the code is generated for
+    TdlibVersion = %q
+    // SHA-256 of td_api.tl the code is generated from
+    TdlibSchemaChecksum = %q
+)
+
+`, schema.Version, schema.Checksum))
+
 	buf.WriteString("const (\n")
 	for _, entity := range schema.Classes {
 		tdlibClass := TdlibClass(entity.Name, schema)
@@ -57,6 +66,38 @@ type %s interface {
 `, class.Description, tdlibClass.ToGoType(), tdlibClass.ToGoType()))
 	}
 
+	for _, class := range schema.Classes {
+		tdlibClass := TdlibClass(class.Name, schema)
+
+		buf.WriteString(fmt.Sprintf(`// %s is a subtype of %s missing in the schema. It keeps @type and raw JSON of the object
+type %s struct {
+    meta
+    Raw json.RawMessage `+"`json:\"-\"`"+`
+}
+
+func (entity *%s) MarshalJSON() ([]byte, error) {
+    if entity.Raw != nil {
+        return entity.Raw, nil
+    }
+
+    return json.Marshal(entity.meta)
+}
+
+func (*%s) GetClass() string {
+    return %s
+}
+
+func (entity *%s) GetType() string {
+    return entity.Type
+}
+
+func (entity *%s) %sType() string {
+    return entity.Type
+}
+
+`, tdlibClass.ToUnknownGoType(), tdlibClass.ToGoType(), tdlibClass.ToUnknownGoType(), tdlibClass.ToUnknownGoType(), tdlibClass.ToUnknownGoType(), tdlibClass.ToClassConst(), tdlibClass.ToUnknownGoType(), tdlibClass.ToUnknownGoType(), tdlibClass.ToGoType()))
+	}
+
 	for _, typ := range schema.Types {
 		tdlibType := TdlibType(typ.Name, schema)
 		if tdlibType.IsInternal() {
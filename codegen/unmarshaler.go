@@ -3,6 +3,7 @@ package codegen
 import (
 	"bytes"
 	"fmt"
+	"strings"
 
 	"github.com/megaplan/go-tdlib/tlparser"
 )
@@ -23,30 +24,45 @@ func GenerateUnmarshalers(schema *tlparser.Schema, packageName string) []byte {
 		tdlibClass := TdlibClass(class.Name, schema)
 
 		buf.WriteString(fmt.Sprintf(`func Unmarshal%s(data json.RawMessage) (%s, error) {
-    var meta meta
+    decoder := newDecoder(data)
 
-    err := json.Unmarshal(data, &meta)
+    value := decode%s(decoder)
+
+    err := decoder.finish()
     if err != nil {
         return nil, err
     }
 
-    switch meta.Type {
-`, tdlibClass.ToGoType(), tdlibClass.ToGoType()))
+    if value == nil {
+        return nil, fmt.Errorf("Error unmarshaling. Empty type")
+    }
+
+    return value, nil
+}
+
+func decode%s(decoder *decoder) %s {
+    switch string(decoder.objectType()) {
+`, tdlibClass.ToGoType(), tdlibClass.ToGoType(), tdlibClass.ToGoType(), tdlibClass.ToGoType(), tdlibClass.ToGoType()))
 
 		for _, subType := range tdlibClass.GetSubTypes() {
 			buf.WriteString(fmt.Sprintf(`    case %s:
-        return Unmarshal%s(data)
+        return decode%s(decoder)
 
 `, subType.ToTypeConst(), subType.ToGoType()))
 
 		}
 
-		buf.WriteString(`    default:
-        return nil, fmt.Errorf("Error unmarshaling. Unknown type: " +  meta.Type)
+		buf.WriteString(fmt.Sprintf(`    case "":
+        decoder.skip()
+        return nil
+
+    default:
+        meta, data := decoder.unknown()
+        return &%s{meta: meta, Raw: data}
     }
 }
 
-`)
+`, tdlibClass.ToUnknownGoType()))
 
 		buf.WriteString(fmt.Sprintf(`func UnmarshalListOf%s(dataList []json.RawMessage) ([]%s, error) {
     list := []%s{}
@@ -66,6 +82,8 @@ func GenerateUnmarshalers(schema *tlparser.Schema, packageName string) []byte {
 
 	}
 
+	var lists []string
+
 	for _, typ := range schema.Types {
 		tdlibType := TdlibType(typ.Name, schema)
 
@@ -76,24 +94,106 @@ func GenerateUnmarshalers(schema *tlparser.Schema, packageName string) []byte {
 		buf.WriteString(fmt.Sprintf(`func Unmarshal%s(data json.RawMessage) (*%s, error) {
     var resp %s
 
-    err := json.Unmarshal(data, &resp)
+    decoder := newDecoder(data)
+    resp.decode(decoder)
 
-    return &resp, err
+    return &resp, decoder.finish()
 }
 
-`, tdlibType.ToGoType(), tdlibType.ToGoType(), tdlibType.ToGoType()))
+func decode%s(decoder *decoder) *%s {
+    if decoder.null() {
+        return nil
+    }
+
+    %s := &%s{
+        meta: meta{Type: %s},
+    }
+    %s.decode(decoder)
+
+    return %s
+}
+
+func (%s *%s) decode(decoder *decoder) {
+    if !decoder.beginObject() {
+        return
+    }
+
+    for decoder.more('}') {
+        key := decoder.key()
+
+        switch string(key) {
+`, tdlibType.ToGoType(), tdlibType.ToGoType(), tdlibType.ToGoType(), tdlibType.ToGoType(), tdlibType.ToGoType(), typ.Name, tdlibType.ToGoType(), tdlibType.ToTypeConst(), typ.Name, typ.Name, typ.Name, tdlibType.ToGoType()))
+
+		for _, property := range typ.Properties {
+			tdlibTypeProperty := TdlibTypeProperty(property.Name, property.Type, schema)
+
+			buf.WriteString(fmt.Sprintf(`        case "%s":
+            %s.%s = %s
+`, property.Name, typ.Name, tdlibTypeProperty.ToGoName(), decodeExpression(property.Type, schema)))
 
+			collectLists(property.Type, &lists)
+		}
+
+		buf.WriteString(fmt.Sprintf(`        default:
+            decoder.member(&%s.meta, key)
+        }
+    }
+}
+
+`, typ.Name))
+
+	}
+
+	// vectors returned by functions
+	for _, function := range schema.Functions {
+		collectLists(vectorName(function.Class), &lists)
+	}
+
+	for _, list := range lists {
+		elementType := strings.TrimSuffix(strings.TrimPrefix(list, "vector<"), ">")
+		goType := TdlibTypeProperty("", list, schema).ToGoType()
+
+		// lists of classes are never nil like UnmarshalListOf* returns
+		nullValue := "nil"
+		if !strings.HasPrefix(elementType, "vector<") && TdlibTypeProperty("", elementType, schema).IsClass() {
+			nullValue = goType + "{}"
+		}
+
+		buf.WriteString(fmt.Sprintf(`func decode%s(decoder *decoder) %s {
+    if !decoder.beginArray() {
+        return %s
+    }
+
+    list := %s{}
+    for decoder.more(']') {
+        list = append(list, %s)
+    }
+
+    return list
+}
+
+`, codecName(list, schema), goType, nullValue, goType, decodeExpression(elementType, schema)))
 	}
 
 	buf.WriteString(`func UnmarshalType(data json.RawMessage) (Type, error) {
-    var meta meta
+    decoder := newDecoder(data)
+
+    value := decodeType(decoder)
 
-    err := json.Unmarshal(data, &meta)
+    err := decoder.finish()
     if err != nil {
         return nil, err
     }
 
-    switch meta.Type {
+    if value == nil {
+        return nil, fmt.Errorf("Error unmarshaling. Empty type")
+    }
+
+    return value, nil
+}
+
+func decodeType(decoder *decoder) Type {
+    switch string(decoder.objectType()) {
 `)
 
 	for _, typ := range schema.Types {
@@ -104,17 +204,87 @@ func GenerateUnmarshalers(schema *tlparser.Schema, packageName string) []byte {
 		}
 
 		buf.WriteString(fmt.Sprintf(`    case %s:
-        return Unmarshal%s(data)
+        return decode%s(decoder)
 
 `, tdlibType.ToTypeConst(), tdlibType.ToGoType()))
 
 	}
 
-	buf.WriteString(`    default:
-        return nil, fmt.Errorf("Error unmarshaling. Unknown type: " +  meta.Type)
+	buf.WriteString(`    case "":
+        decoder.skip()
+        return nil
+
+    default:
+        return unknownType(decoder.unknown())
     }
 }
 `)
 
 	return buf.Bytes()
 }
+
+// decodeExpression returns the expression reading the value of the property type with the decoder
+func decodeExpression(propertyType string, schema *tlparser.Schema) string {
+	tdlibTypeProperty := TdlibTypeProperty("", propertyType, schema)
+
+	if !tdlibTypeProperty.IsList() && !tdlibTypeProperty.IsClass() && tdlibTypeProperty.GetType().IsInternal() {
+		return "decoder." + codecName(propertyType, schema) + "()"
+	}
+
+	return "decode" + codecName(propertyType, schema) + "(decoder)"
+}
+
+// codecName returns the name of the decoder method or the decode and encode functions of the property type
+func codecName(propertyType string, schema *tlparser.Schema) string {
+	if strings.HasPrefix(propertyType, "vector<") {
+		return "ListOf" + codecName(strings.TrimSuffix(strings.TrimPrefix(propertyType, "vector<"), ">"), schema)
+	}
+
+	tdlibTypeProperty := TdlibTypeProperty("", propertyType, schema)
+
+	if tdlibTypeProperty.IsClass() {
+		return tdlibTypeProperty.GetClass().ToGoType()
+	}
+
+	switch tdlibTypeProperty.ToGoType() {
+	case "string":
+		return "String"
+
+	case "bool":
+		return "Bool"
+
+	case "int32":
+		return "Int32"
+
+	case "int64":
+		return "Int64"
+
+	case "JsonInt64":
+		return "JsonInt64"
+
+	case "float64":
+		return "Float64"
+
+	case "[]byte":
+		return "Bytes"
+	}
+
+	return tdlibTypeProperty.GetType().ToGoType()
+}
+
+// collectLists appends the vector type and its nested vector types missing in the lists
+func collectLists(propertyType string, lists *[]string) {
+	if !strings.HasPrefix(propertyType, "vector<") {
+		return
+	}
+
+	collectLists(strings.TrimSuffix(strings.TrimPrefix(propertyType, "vector<"), ">"), lists)
+
+	for _, list := range *lists {
+		if list == propertyType {
+			return
+		}
+	}
+
+	*lists = append(*lists, propertyType)
+}
@@ -0,0 +1,57 @@
+package codegen
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/megaplan/go-tdlib/tlparser"
+)
+
+func GenerateVisitors(schema *tlparser.Schema, packageName string) []byte {
+	buf := bytes.NewBufferString("")
+
+	buf.WriteString(fmt.Sprintf("%s\n\npackage %s\n\n", header, packageName))
+
+	buf.WriteString(`import (
+    "fmt"
+)
+`)
+
+	for _, class := range schema.Classes {
+		tdlibClass := TdlibClass(class.Name, schema)
+
+		buf.WriteString("\n")
+		buf.WriteString(fmt.Sprintf("// %sVisitor has a method for every subtype of %s. A new subtype breaks the build of the visitor implementations\n", tdlibClass.ToGoType(), tdlibClass.ToGoType()))
+		buf.WriteString(fmt.Sprintf("type %sVisitor interface {\n", tdlibClass.ToGoType()))
+
+		for _, subType := range tdlibClass.GetSubTypes() {
+			buf.WriteString(fmt.Sprintf("    Visit%s(value *%s) error\n", subType.ToGoType(), subType.ToGoType()))
+		}
+
+		buf.WriteString("}\n")
+
+		buf.WriteString("\n")
+		buf.WriteString(fmt.Sprintf("// Visit%s calls the method of the visitor for the subtype of the value\n", tdlibClass.ToGoType()))
+		buf.WriteString(fmt.Sprintf(`func Visit%s(value %s, visitor %sVisitor) error {
+    switch value := value.(type) {
+`, tdlibClass.ToGoType(), tdlibClass.ToGoType(), tdlibClass.ToGoType()))
+
+		for _, subType := range tdlibClass.GetSubTypes() {
+			buf.WriteString(fmt.Sprintf(`    case *%s:
+        return visitor.Visit%s(value)
+
+`, subType.ToGoType(), subType.ToGoType()))
+		}
+
+		buf.WriteString(fmt.Sprintf(`    case nil:
+        return fmt.Errorf("%%w: nil", ErrUnknownType)
+
+    default:
+        return fmt.Errorf("%%w: %%s", ErrUnknownType, value.%sType())
+    }
+}
+`, tdlibClass.ToGoType()))
+	}
+
+	return buf.Bytes()
+}
@@ -0,0 +1,16 @@
+package codegen
+
+import (
+	"testing"
+)
+
+func TestGenerateVisitors(t *testing.T) {
+	schema := parseSchema(t, vectorSchema)
+
+	assertGoCode(t, "visitor.go", GenerateVisitors(schema, "client"),
+		"type MessageContentVisitor interface {\n    VisitMessageText(value *MessageText) error\n}",
+		"func VisitMessageContent(value MessageContent, visitor MessageContentVisitor) error {",
+		"case *MessageText:\n        return visitor.VisitMessageText(value)",
+		"return fmt.Errorf(\"%w: %s\", ErrUnknownType, value.MessageContentType())",
+	)
+}
@@ -1,12 +1,14 @@
 package main
 
 import (
+	"context"
 	"log"
 	"os"
 	"os/signal"
 	"path/filepath"
 	"strconv"
 	"syscall"
+	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/megaplan/go-tdlib/client"
@@ -79,7 +81,14 @@ func main() {
 	signal.Notify(ch, os.Interrupt, syscall.SIGTERM)
 	go func() {
 		<-ch
-		tdlibClient.Stop()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		err := tdlibClient.CloseAndWait(ctx)
+		if err != nil {
+			log.Printf("CloseAndWait error: %s", err)
+		}
 		os.Exit(1)
 	}()
 }
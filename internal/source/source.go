@@ -0,0 +1,66 @@
+// Package source reads the inputs of the code generators
+package source
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/megaplan/go-tdlib/tlparser"
+)
+
+// Read returns the content of the file, stdin for "-" or the file of TDLib repository for the version if the path is empty
+func Read(path string, version string, repositoryPath string) ([]byte, error) {
+	switch path {
+	case "":
+		resp, err := http.Get("https://raw.githubusercontent.com/megaplan/tdlight/" + version + "/" + repositoryPath)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("%s: %s", repositoryPath, resp.Status)
+		}
+
+		return io.ReadAll(resp.Body)
+
+	case "-":
+		return io.ReadAll(os.Stdin)
+	}
+
+	return os.ReadFile(path)
+}
+
+// KeepFunctionTypes copies function types from the schema previously written to the path.
+// Without the previous schema all functions would get the unknown type, so it is an error
+func KeepFunctionTypes(schema *tlparser.Schema, path string) error {
+	if path == "" {
+		return fmt.Errorf("no schema to keep function types from")
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var previousSchema tlparser.Schema
+
+	err = json.Unmarshal(data, &previousSchema)
+	if err != nil {
+		return err
+	}
+
+	functionTypes := map[string]tlparser.FunctionType{}
+	for _, function := range previousSchema.Functions {
+		functionTypes[function.Name] = function.Type
+	}
+
+	for _, function := range schema.Functions {
+		function.Type = functionTypes[function.Name]
+	}
+
+	return nil
+}
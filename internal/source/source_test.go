@@ -0,0 +1,83 @@
+package source
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/megaplan/go-tdlib/tlparser"
+)
+
+func TestRead(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "td_api.tl")
+
+	err := os.WriteFile(path, []byte("schema"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the local file is read without the network
+	data, err := Read(path, "v1.8.14", "td/generate/scheme/td_api.tl")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(data) != "schema" {
+		t.Fatalf("unexpected data %q", data)
+	}
+
+	_, err = Read(filepath.Join(t.TempDir(), "missing.tl"), "v1.8.14", "td/generate/scheme/td_api.tl")
+	if err == nil {
+		t.Fatal("missing file is read")
+	}
+}
+
+func TestKeepFunctionTypes(t *testing.T) {
+	previous := &tlparser.Schema{
+		Functions: []*tlparser.Function{
+			{Name: "getMe", Type: tlparser.FUNCTION_TYPE_COMMON},
+			{Name: "getChatStatistics", Type: tlparser.FUNCTION_TYPE_USER},
+		},
+	}
+
+	data, err := json.Marshal(previous)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(t.TempDir(), "td_api.json")
+
+	err = os.WriteFile(path, data, 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	schema := &tlparser.Schema{
+		Functions: []*tlparser.Function{
+			{Name: "getMe"},
+			{Name: "getChatStatistics"},
+			{Name: "newFunction"},
+		},
+	}
+
+	err = KeepFunctionTypes(schema, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []tlparser.FunctionType{tlparser.FUNCTION_TYPE_COMMON, tlparser.FUNCTION_TYPE_USER, tlparser.FUNCTION_TYPE_UNKNOWN}
+	for i, function := range schema.Functions {
+		if function.Type != expected[i] {
+			t.Errorf("%s: expected type %d, got %d", function.Name, expected[i], function.Type)
+		}
+	}
+
+	// without the previous schema all functions would become unknown
+	for _, path := range []string{"", filepath.Join(t.TempDir(), "missing.json")} {
+		err = KeepFunctionTypes(schema, path)
+		if err == nil {
+			t.Errorf("function types are kept from %q", path)
+		}
+	}
+}
@@ -31,6 +31,11 @@ func Parse(reader io.Reader) (*Schema, error) {
 		case strings.HasPrefix(line, "//@class"):
 			schema.Classes = append(schema.Classes, parseClass(line, scanner))
 
+		case strings.HasPrefix(line, "//-") && len(schema.Classes) > 0:
+			// continuation of the class description
+			class := schema.Classes[len(schema.Classes)-1]
+			class.Description += " " + strings.TrimLeft(line, "//-")
+
 		case strings.Contains(line, "---functions---"):
 			hitFunctions = true
 
@@ -0,0 +1,45 @@
+package tlparser
+
+import (
+	"strings"
+	"testing"
+)
+
+const testSchema = `//@class DeviceToken @description Represents a data needed to subscribe for push notifications.
+//-To use specific push notification service, the server authentication data must be uploaded
+
+//@description A token for Firebase Cloud Messaging @token Device registration token; may be empty to deregister a device
+deviceTokenFirebaseCloudMessaging token:string = DeviceToken;
+
+---functions---
+
+//@description Registers the currently used device for receiving push notifications @device_token Device token
+registerDevice device_token:DeviceToken = Ok;
+`
+
+func TestParse(t *testing.T) {
+	schema, err := Parse(strings.NewReader(testSchema))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(schema.Classes) != 1 || len(schema.Types) != 1 || len(schema.Functions) != 1 {
+		t.Fatalf("unexpected schema %d classes, %d types, %d functions", len(schema.Classes), len(schema.Types), len(schema.Functions))
+	}
+
+	// the continuation line is a part of the description, not a class
+	class := schema.Classes[0]
+	if class.Name != "DeviceToken" || class.Description != "Represents a data needed to subscribe for push notifications. To use specific push notification service, the server authentication data must be uploaded" {
+		t.Fatalf("unexpected class %#v", class)
+	}
+
+	typ := schema.Types[0]
+	if typ.Name != "deviceTokenFirebaseCloudMessaging" || typ.Class != "DeviceToken" || len(typ.Properties) != 1 || typ.Properties[0].Name != "token" {
+		t.Fatalf("unexpected type %#v", typ)
+	}
+
+	function := schema.Functions[0]
+	if function.Name != "registerDevice" || function.Class != "Ok" || len(function.Properties) != 1 {
+		t.Fatalf("unexpected function %#v", function)
+	}
+}
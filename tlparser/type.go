@@ -1,6 +1,10 @@
 package tlparser
 
 type Schema struct {
+	// TDLib version the schema is taken from
+	Version string `json:"version,omitempty"`
+	// SHA-256 of td_api.tl the schema is parsed from
+	Checksum  string      `json:"checksum,omitempty"`
 	Types     []*Type     `json:"types"`
 	Classes   []*Class    `json:"classes"`
 	Functions []*Function `json:"functions"`